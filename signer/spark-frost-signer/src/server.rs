@@ -261,4 +261,17 @@ impl FrostService for FrostServer {
             .map_err(Status::internal)
             .map(|_| Response::new(()))
     }
+
+    async fn validate_signature_shares(
+        &self,
+        request: Request<ValidateSignatureSharesRequest>,
+    ) -> Result<Response<ValidateSignatureSharesResponse>, Status> {
+        tracing::info!(
+            "Received frost validate signature shares request for {} shares",
+            request.get_ref().signature_shares.len()
+        );
+        spark_frost::signing::validate_signature_shares(request.get_ref())
+            .map_err(Status::invalid_argument)
+            .map(Response::new)
+    }
 }
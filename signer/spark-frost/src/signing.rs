@@ -360,6 +360,39 @@ pub fn aggregate_frost(req: &AggregateFrostRequest) -> Result<AggregateFrostResp
 }
 
 pub fn validate_signature_share(req: &ValidateSignatureShareRequest) -> Result<(), String> {
+    check_signature_share(req)?
+}
+
+/// Validates a batch of signature shares. Signature shares that are malformed or do not verify
+/// are reported in the response, while requests whose other fields cannot be parsed fail the
+/// whole batch, since they do not show that the participant misbehaved.
+pub fn validate_signature_shares(
+    req: &ValidateSignatureSharesRequest,
+) -> Result<ValidateSignatureSharesResponse, String> {
+    let results = req
+        .signature_shares
+        .iter()
+        .map(|share| {
+            check_signature_share(share).map(|result| match result {
+                Ok(()) => SignatureShareValidation {
+                    valid: true,
+                    reason: String::new(),
+                },
+                Err(reason) => SignatureShareValidation {
+                    valid: false,
+                    reason,
+                },
+            })
+        })
+        .collect::<Result<Vec<_>, String>>()?;
+    Ok(ValidateSignatureSharesResponse { results })
+}
+
+/// Checks the signature share of the request. The outer error is returned when the request cannot
+/// be checked, and the inner one when the signature share is malformed or does not verify.
+fn check_signature_share(
+    req: &ValidateSignatureShareRequest,
+) -> Result<Result<(), String>, String> {
     let identifier = match req.role {
         0 => hex_string_to_identifier(&req.identifier)
             .map_err(|e| format!("Failed to parse identifier: {:?}", e))?,
@@ -367,8 +400,10 @@ pub fn validate_signature_share(req: &ValidateSignatureShareRequest) -> Result<(
         _ => return Err("Invalid signing role".to_string()),
     };
 
-    let signature_share = SignatureShare::deserialize(req.signature_share.as_slice())
-        .map_err(|e| format!("Failed to parse signature share: {:?}", e))?;
+    let signature_share = match SignatureShare::deserialize(req.signature_share.as_slice()) {
+        Ok(signature_share) => signature_share,
+        Err(e) => return Ok(Err(format!("Failed to parse signature share: {:?}", e))),
+    };
     let verifying_key = verifying_key_from_bytes(req.verifying_key.clone())
         .map_err(|e| format!("Failed to parse verifying key: {:?}", e))?;
 
@@ -426,16 +461,17 @@ pub fn validate_signature_share(req: &ValidateSignatureShareRequest) -> Result<(
         _ => return Err("Invalid signing role".to_string()),
     };
 
-    frost_secp256k1_tr::verify_signature_share(
+    if let Err(e) = frost_secp256k1_tr::verify_signature_share(
         verify_identifier,
         verifying_share,
         &signature_share,
         &signing_package,
         result_tweaked.verifying_key(),
-    )
-    .map_err(|e| format!("Failed to verify signature share: {:?}", e))?;
+    ) {
+        return Ok(Err(format!("Failed to verify signature share: {:?}", e)));
+    }
 
     tracing::info!("Signature share is valid");
 
-    Ok(())
+    Ok(Ok(()))
 }
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
+	"github.com/lightsparkdev/spark/wallet"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// adminCommand is a subcommand of `operator admin` that calls the SparkAdminService.
+type adminCommand struct {
+	Name        string
+	Usage       string
+	Description string
+	Run         func(ctx context.Context, client pbadmin.SparkAdminServiceClient, args []string) (proto.Message, error)
+}
+
+var adminCommands = []adminCommand{
+	{
+		Name:        "tasks",
+		Usage:       "tasks [-runs N]",
+		Description: "List background tasks and their recent runs",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, args []string) (proto.Message, error) {
+			flags := flag.NewFlagSet("tasks", flag.ContinueOnError)
+			runs := flags.Uint("runs", 1, "Number of recent runs to show per task")
+			if err := flags.Parse(args); err != nil {
+				return nil, err
+			}
+			return client.ListTasks(ctx, &pbadmin.ListTasksRequest{RecentRunsLimit: uint32(*runs)})
+		},
+	},
+	{
+		Name:        "trigger-task",
+		Usage:       "trigger-task <name>",
+		Description: "Run a background task now",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, args []string) (proto.Message, error) {
+			if len(args) != 1 {
+				return nil, errors.New("expected a task name")
+			}
+			return client.TriggerTask(ctx, &pbadmin.TriggerTaskRequest{Name: args[0]})
+		},
+	},
+	{
+		Name:        "keyshares",
+		Usage:       "keyshares",
+		Description: "Show the signing keyshare pool",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, _ []string) (proto.Message, error) {
+			return client.GetKeysharePool(ctx, &pbadmin.GetKeysharePoolRequest{})
+		},
+	},
+	{
+		Name:        "trigger-dkg",
+		Usage:       "trigger-dkg",
+		Description: "Run a DKG round now",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, _ []string) (proto.Message, error) {
+			return client.TriggerDkg(ctx, &pbadmin.TriggerDkgRequest{})
+		},
+	},
+	{
+		Name:        "stuck-transfers",
+		Usage:       "stuck-transfers [-min-age 1h]",
+		Description: "Count unfinished transfers by status and age",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, args []string) (proto.Message, error) {
+			flags := flag.NewFlagSet("stuck-transfers", flag.ContinueOnError)
+			minAge := flags.Duration("min-age", time.Hour, "Only count transfers not updated for this long")
+			if err := flags.Parse(args); err != nil {
+				return nil, err
+			}
+			return client.ListStuckTransfers(ctx, &pbadmin.ListStuckTransfersRequest{
+				MinAgeSeconds: uint64(minAge.Seconds()),
+			})
+		},
+	},
+	{
+		Name:        "stuck-preimages",
+		Usage:       "stuck-preimages [-min-age 10m] [-limit 100]",
+		Description: "List preimage requests waiting for their preimage",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, args []string) (proto.Message, error) {
+			flags := flag.NewFlagSet("stuck-preimages", flag.ContinueOnError)
+			minAge := flags.Duration("min-age", 10*time.Minute, "Only list requests created this long ago")
+			limit := flags.Uint("limit", 100, "Maximum number of requests to list")
+			if err := flags.Parse(args); err != nil {
+				return nil, err
+			}
+			return client.ListStuckPreimageRequests(ctx, &pbadmin.ListStuckPreimageRequestsRequest{
+				MinAgeSeconds: uint64(minAge.Seconds()),
+				Limit:         uint32(*limit),
+			})
+		},
+	},
+	{
+		Name:        "chain",
+		Usage:       "chain",
+		Description: "Show the chain watcher height and lag per network",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, _ []string) (proto.Message, error) {
+			return client.GetChainStatus(ctx, &pbadmin.GetChainStatusRequest{})
+		},
+	},
+	{
+		Name:        "lrc20",
+		Usage:       "lrc20",
+		Description: "Show the LRC20 connection pool health per network",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, _ []string) (proto.Message, error) {
+			return client.GetLrc20PoolStatus(ctx, &pbadmin.GetLrc20PoolStatusRequest{})
+		},
+	},
+	{
+		Name:        "pauses",
+		Usage:       "pauses",
+		Description: "List networks with paused transfers or deposits",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, _ []string) (proto.Message, error) {
+			return client.ListNetworkPauses(ctx, &pbadmin.ListNetworkPausesRequest{})
+		},
+	},
+	{
+		Name:        "pause",
+		Usage:       "pause [-transfers] [-deposits] [-reason text] <network>",
+		Description: "Pause new transfers and/or deposits on a network; both if neither is given",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, args []string) (proto.Message, error) {
+			flags := flag.NewFlagSet("pause", flag.ContinueOnError)
+			transfers := flags.Bool("transfers", false, "Pause new transfers")
+			deposits := flags.Bool("deposits", false, "Pause new deposits")
+			reason := flags.String("reason", "", "Why the network is paused")
+			if err := flags.Parse(args); err != nil {
+				return nil, err
+			}
+			network, err := parseAdminNetwork(flags.Args())
+			if err != nil {
+				return nil, err
+			}
+			if !*transfers && !*deposits {
+				*transfers, *deposits = true, true
+			}
+			return client.SetNetworkPause(ctx, &pbadmin.SetNetworkPauseRequest{
+				Network:        network,
+				PauseTransfers: *transfers,
+				PauseDeposits:  *deposits,
+				Reason:         *reason,
+			})
+		},
+	},
+	{
+		Name:        "resume",
+		Usage:       "resume <network>",
+		Description: "Resume new transfers and deposits on a network",
+		Run: func(ctx context.Context, client pbadmin.SparkAdminServiceClient, args []string) (proto.Message, error) {
+			network, err := parseAdminNetwork(args)
+			if err != nil {
+				return nil, err
+			}
+			return client.SetNetworkPause(ctx, &pbadmin.SetNetworkPauseRequest{Network: network})
+		},
+	},
+}
+
+func parseAdminNetwork(args []string) (pb.Network, error) {
+	if len(args) != 1 {
+		return pb.Network_UNSPECIFIED, errors.New("expected a network")
+	}
+	network, ok := pb.Network_value[strings.ToUpper(args[0])]
+	if !ok || network == int32(pb.Network_UNSPECIFIED) {
+		return pb.Network_UNSPECIFIED, fmt.Errorf("invalid network %s", args[0])
+	}
+	return pb.Network(network), nil
+}
+
+// runAdmin runs `operator admin`, which calls the SparkAdminService of a running operator and
+// prints the response as JSON. It returns the process exit code.
+func runAdmin(args []string) int {
+	flags := flag.NewFlagSet("admin", flag.ContinueOnError)
+	address := flags.String("address", "localhost:8535", "Address of the operator")
+	keyPath := flags.String("key", "", "Identity private key of the operator, to authenticate with a session")
+	serverCertPath := flags.String("server-cert", "", "Certificate to verify the operator with; the connection is plaintext if neither this nor -client-cert is set")
+	clientCertPath := flags.String("client-cert", "", "Client certificate signed by the operator's admin client CA, to authenticate with mTLS")
+	clientKeyPath := flags.String("client-key", "", "Key of the client certificate")
+	timeout := flags.Duration("timeout", time.Minute, "Request timeout")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: operator admin [flags] <command> [args]\n\nCommands:\n")
+		for _, cmd := range adminCommands {
+			fmt.Fprintf(flags.Output(), "  %-55s %s\n", cmd.Usage, cmd.Description)
+		}
+		fmt.Fprintf(flags.Output(), "\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	var cmd *adminCommand
+	for i := range adminCommands {
+		if adminCommands[i].Name == flags.Arg(0) {
+			cmd = &adminCommands[i]
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(os.Stderr, "unknown command %s\n", flags.Arg(0))
+		flags.Usage()
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	conn, err := newAdminConnection(*address, *serverCertPath, *clientCertPath, *clientKeyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect to %s: %v\n", *address, err)
+		return 1
+	}
+	defer conn.Close()
+
+	if *keyPath != "" {
+		ctx, err = authenticateAdmin(ctx, conn, *keyPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to authenticate: %v\n", err)
+			return 1
+		}
+	}
+
+	resp, err := cmd.Run(ctx, pbadmin.NewSparkAdminServiceClient(conn), flags.Args()[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s failed: %v\n", cmd.Name, err)
+		return 1
+	}
+	fmt.Println(protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Format(resp))
+	return 0
+}
+
+func newAdminConnection(address, serverCertPath, clientCertPath, clientKeyPath string) (*grpc.ClientConn, error) {
+	if serverCertPath == "" && clientCertPath == "" {
+		return grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if serverCertPath != "" {
+		serverCert, err := os.ReadFile(serverCertPath)
+		if err != nil {
+			return nil, err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(serverCert) {
+			return nil, errors.New("failed to append server certificate")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+	if clientCertPath != "" {
+		clientCert, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+	return grpc.NewClient(address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+}
+
+// authenticateAdmin authenticates with the operator's identity key and returns a context carrying
+// the session token.
+func authenticateAdmin(ctx context.Context, conn *grpc.ClientConn, keyPath string) (context.Context, error) {
+	keyBytes, err := readIdentityKey(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	config := &wallet.Config{IdentityPrivateKey: *secp256k1.PrivKeyFromBytes(keyBytes)}
+	token, err := wallet.AuthenticateWithConnection(ctx, config, conn)
+	if err != nil {
+		return nil, err
+	}
+	return wallet.ContextWithToken(ctx, token), nil
+}
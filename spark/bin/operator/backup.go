@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/backup"
+)
+
+// backupFlags are the flags shared by `operator backup` and `operator restore`.
+type backupFlags struct {
+	keyPath       string
+	databasePath  string
+	aws           bool
+	keysharesOnly bool
+}
+
+func (f *backupFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.keyPath, "key", "", "Identity private key of the operator")
+	flags.StringVar(&f.databasePath, "database", "", "Path to the database")
+	flags.BoolVar(&f.aws, "aws", false, "Use AWS RDS")
+	flags.BoolVar(&f.keysharesOnly, "keyshares-only", false, "Only cover the signing keyshares")
+}
+
+func (f *backupFlags) mode() backup.Mode {
+	if f.keysharesOnly {
+		return backup.ModeKeyshares
+	}
+	return backup.ModeFull
+}
+
+// runBackup writes an encrypted backup of the database of the operator to a file.
+func runBackup(args []string) int {
+	flags := flag.NewFlagSet("backup", flag.ContinueOnError)
+	var common backupFlags
+	common.register(flags)
+	output := flags.String("output", "", "File to write the backup to")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: operator backup -key <file> -database <path> -output <file> [flags]\n\n")
+		fmt.Fprintf(flags.Output(), "Writes a consistent backup of the database, encrypted to and signed with the identity key.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if common.keyPath == "" || common.databasePath == "" || *output == "" {
+		flags.Usage()
+		return 2
+	}
+
+	ctx, done := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer done()
+	summary, err := writeBackup(ctx, &common, *output)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "backup failed: %v\n", err)
+		return 1
+	}
+	return printBackupSummary(summary)
+}
+
+func writeBackup(ctx context.Context, common *backupFlags, output string) (*backup.Summary, error) {
+	identityKey, err := readIdentityKey(common.keyPath)
+	if err != nil {
+		return nil, err
+	}
+	config := &so.Config{DatabasePath: common.databasePath, AWS: common.aws}
+	db, connector, err := openDatabase(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer connector.Close()
+	defer db.Close()
+
+	// The backup only gets its name once it is complete, so that a partial file is never mistaken
+	// for a backup.
+	file, err := os.OpenFile(output+".tmp", os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	summary, err := backup.Write(ctx, db, config.DatabaseDriver(), identityKey, common.mode(), file)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.Sync(); err != nil {
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(file.Name(), output); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// runRestore verifies a backup written by runBackup, and restores it into an empty database.
+func runRestore(args []string) int {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	var common backupFlags
+	common.register(flags)
+	input := flags.String("input", "", "File to read the backup from")
+	verifyOnly := flags.Bool("verify-only", false, "Only verify the backup, without restoring it")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: operator restore -key <file> -database <path> -input <file> [flags]\n\n")
+		fmt.Fprintf(flags.Output(), "Verifies that a backup was written by the operator with the identity key and restores it into\n")
+		fmt.Fprintf(flags.Output(), "a database migrated to the schema of this binary, whose restored tables must be empty.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if common.keyPath == "" || *input == "" || (common.databasePath == "" && !*verifyOnly) {
+		flags.Usage()
+		return 2
+	}
+
+	ctx, done := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer done()
+	summary, err := restoreBackup(ctx, &common, *input, *verifyOnly)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "restore failed: %v\n", err)
+		return 1
+	}
+	return printBackupSummary(summary)
+}
+
+func restoreBackup(ctx context.Context, common *backupFlags, input string, verifyOnly bool) (*backup.Summary, error) {
+	identityKey, err := readIdentityKey(common.keyPath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(filepath.Clean(input))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	if verifyOnly {
+		return backup.Verify(identityKey, file)
+	}
+
+	config := &so.Config{DatabasePath: common.databasePath, AWS: common.aws}
+	db, connector, err := openDatabase(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer connector.Close()
+	defer db.Close()
+	return backup.Restore(ctx, db, config.DatabaseDriver(), identityKey, common.mode(), file)
+}
+
+func printBackupSummary(summary *backup.Summary) int {
+	fields := map[string]any{
+		"format_version":      summary.Header.FormatVersion,
+		"mode":                summary.Header.Mode,
+		"created_at":          summary.Header.CreatedAt,
+		"identity_public_key": hex.EncodeToString(summary.Header.IdentityPublicKey),
+		"rows":                summary.Rows,
+	}
+	if len(summary.Dropped) > 0 {
+		fields["dropped"] = summary.Dropped
+	}
+	output, err := json.MarshalIndent(fields, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to print summary: %v\n", err)
+		return 1
+	}
+	fmt.Println(string(output))
+	return 0
+}
+
+// readIdentityKey reads the hex encoded identity private key of the operator from a file.
+func readIdentityKey(path string) ([]byte, error) {
+	keyHex, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(keyHex)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode identity private key: %w", err)
+	}
+	return key, nil
+}
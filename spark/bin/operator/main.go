@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"errors"
 	"flag"
@@ -13,6 +14,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"sort"
 	"strings"
 	"syscall"
 	"time"
@@ -21,29 +24,32 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/XSAM/otelsql"
-	"github.com/go-co-op/gocron/v2"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/jackc/pgx/v5/stdlib"
 	_ "github.com/lib/pq"
+	"github.com/lightsparkdev/spark"
 	"github.com/lightsparkdev/spark/common"
 	"github.com/lightsparkdev/spark/common/logging"
 	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
 	pbmock "github.com/lightsparkdev/spark/proto/mock"
 	pbspark "github.com/lightsparkdev/spark/proto/spark"
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
 	pbauthn "github.com/lightsparkdev/spark/proto/spark_authn"
 	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
 	pbtree "github.com/lightsparkdev/spark/proto/spark_tree"
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/authn"
 	"github.com/lightsparkdev/spark/so/authninternal"
-	"github.com/lightsparkdev/spark/so/chain"
+	"github.com/lightsparkdev/spark/so/authz"
 	"github.com/lightsparkdev/spark/so/dkg"
 	"github.com/lightsparkdev/spark/so/ent"
 	_ "github.com/lightsparkdev/spark/so/ent/runtime"
 	sparkerrors "github.com/lightsparkdev/spark/so/errors"
 	sparkgrpc "github.com/lightsparkdev/spark/so/grpc"
+	sparkhealth "github.com/lightsparkdev/spark/so/health"
 	"github.com/lightsparkdev/spark/so/helper"
+	"github.com/lightsparkdev/spark/so/keymanager"
 	"github.com/lightsparkdev/spark/so/lrc20"
 	"github.com/lightsparkdev/spark/so/middleware"
 	"github.com/lightsparkdev/spark/so/task"
@@ -75,6 +81,7 @@ type args struct {
 	RunningLocally             bool
 	ChallengeTimeout           time.Duration
 	SessionDuration            time.Duration
+	RefreshTokenDuration       time.Duration
 	AuthzEnforced              bool
 	DKGCoordinatorAddress      string
 	DisableDKG                 bool
@@ -82,6 +89,7 @@ type args struct {
 	AWS                        bool
 	ServerCertPath             string
 	ServerKeyPath              string
+	AdminClientCAPath          string
 	DKGLimitOverride           uint64
 	RunDirectory               string
 	ReturnDetailedPanicErrors  bool
@@ -90,6 +98,18 @@ type args struct {
 	RateLimiterWindow          time.Duration
 	RateLimiterMaxRequests     int
 	RateLimiterMethods         string
+	RateLimiterMethodLimits    string
+	RateLimiterMaxStreams      int
+	RateLimiterStore           string
+	RateLimiterRedisAddr       string
+}
+
+// RateLimiterMemcachedAddrsList returns the memcached addresses of the rate limiter.
+func (a *args) RateLimiterMemcachedAddrsList() []string {
+	if a.RateLimiterMemcachedAddrs == "" {
+		return nil
+	}
+	return strings.Split(a.RateLimiterMemcachedAddrs, ",")
 }
 
 func (a *args) SupportedNetworksList() []common.Network {
@@ -109,42 +129,52 @@ func (a *args) SupportedNetworksList() []common.Network {
 	return networks
 }
 
-func loadArgs() (*args, error) {
+// newArgs registers the flags of the server on the flag set, and returns the args they are
+// parsed into.
+func newArgs(flags *flag.FlagSet) *args {
 	args := &args{}
 
-	// Define flags
-	flag.StringVar(&args.LogLevel, "log-level", "debug", "Logging level: debug|info|warn|error")
-	flag.BoolVar(&args.LogJSON, "log-json", false, "Output logs in JSON format")
-	flag.BoolVar(&args.LogRequestStats, "log-request-stats", false, "Log request stats (requires log-json)")
-	flag.StringVar(&args.ConfigFilePath, "config", "so_config.yaml", "Path to config file")
-	flag.Uint64Var(&args.Index, "index", 0, "Index value")
-	flag.StringVar(&args.IdentityPrivateKeyFilePath, "key", "", "Identity private key")
-	flag.StringVar(&args.OperatorsFilePath, "operators", "", "Path to operators file")
-	flag.Uint64Var(&args.Threshold, "threshold", 0, "Threshold value")
-	flag.StringVar(&args.SignerAddress, "signer", "", "Signer address")
-	flag.Uint64Var(&args.Port, "port", 0, "Port value")
-	flag.StringVar(&args.DatabasePath, "database", "", "Path to database file")
-	flag.BoolVar(&args.RunningLocally, "local", false, "Running locally")
-	flag.DurationVar(&args.ChallengeTimeout, "challenge-timeout", time.Duration(time.Minute), "Challenge timeout")
-	flag.DurationVar(&args.SessionDuration, "session-duration", time.Duration(time.Minute*15), "Session duration")
-	flag.BoolVar(&args.AuthzEnforced, "authz-enforced", true, "Enforce authorization checks")
-	flag.StringVar(&args.DKGCoordinatorAddress, "dkg-address", "", "DKG coordinator address")
-	flag.BoolVar(&args.DisableDKG, "disable-dkg", false, "Disable DKG")
-	flag.StringVar(&args.SupportedNetworks, "supported-networks", "", "Supported networks")
-	flag.BoolVar(&args.AWS, "aws", false, "Use AWS RDS")
-	flag.StringVar(&args.ServerCertPath, "server-cert", "", "Path to server certificate")
-	flag.StringVar(&args.ServerKeyPath, "server-key", "", "Path to server key")
-	flag.Uint64Var(&args.DKGLimitOverride, "dkg-limit-override", 0, "Override the DKG limit")
-	flag.StringVar(&args.RunDirectory, "run-dir", "", "Run directory for resolving relative paths")
+	flags.StringVar(&args.LogLevel, "log-level", "debug", "Logging level: debug|info|warn|error")
+	flags.BoolVar(&args.LogJSON, "log-json", false, "Output logs in JSON format")
+	flags.BoolVar(&args.LogRequestStats, "log-request-stats", false, "Log request stats (requires log-json)")
+	flags.StringVar(&args.ConfigFilePath, "config", "so_config.yaml", "Path to config file")
+	flags.Uint64Var(&args.Index, "index", 0, "Index value")
+	flags.StringVar(&args.IdentityPrivateKeyFilePath, "key", "", "Identity private key")
+	flags.StringVar(&args.OperatorsFilePath, "operators", "", "Path to operators file")
+	flags.Uint64Var(&args.Threshold, "threshold", 0, "Threshold value")
+	flags.StringVar(&args.SignerAddress, "signer", "", "Signer address")
+	flags.Uint64Var(&args.Port, "port", 0, "Port value")
+	flags.StringVar(&args.DatabasePath, "database", "", "Path to database file")
+	flags.BoolVar(&args.RunningLocally, "local", false, "Running locally")
+	flags.DurationVar(&args.ChallengeTimeout, "challenge-timeout", time.Duration(time.Minute), "Challenge timeout")
+	flags.DurationVar(&args.SessionDuration, "session-duration", time.Duration(time.Minute*15), "Session duration")
+	flags.DurationVar(&args.RefreshTokenDuration, "refresh-token-duration", 7*24*time.Hour, "Refresh token duration, 0 to not issue refresh tokens")
+	flags.BoolVar(&args.AuthzEnforced, "authz-enforced", true, "Enforce authorization checks")
+	flags.StringVar(&args.DKGCoordinatorAddress, "dkg-address", "", "DKG coordinator address")
+	flags.BoolVar(&args.DisableDKG, "disable-dkg", false, "Disable DKG")
+	flags.StringVar(&args.SupportedNetworks, "supported-networks", "", "Supported networks")
+	flags.BoolVar(&args.AWS, "aws", false, "Use AWS RDS")
+	flags.StringVar(&args.ServerCertPath, "server-cert", "", "Path to server certificate")
+	flags.StringVar(&args.ServerKeyPath, "server-key", "", "Path to server key")
+	flags.StringVar(&args.AdminClientCAPath, "admin-client-ca", "", "Path to the CA certificate of admin clients allowed to authenticate with mTLS")
+	flags.Uint64Var(&args.DKGLimitOverride, "dkg-limit-override", 0, "Override the DKG limit")
+	flags.StringVar(&args.RunDirectory, "run-dir", "", "Run directory for resolving relative paths")
 	// TODO(CNT-154): Consider setting to false by default before productionization.
-	flag.BoolVar(&args.ReturnDetailedPanicErrors, "return-detailed-panic-errors", true, "Return detailed panic errors to client")
-	flag.BoolVar(&args.RateLimiterEnabled, "rate-limiter-enabled", false, "Enable rate limiting")
-	flag.StringVar(&args.RateLimiterMemcachedAddrs, "rate-limiter-memcached-addrs", "", "Comma-separated list of Memcached addresses")
-	flag.DurationVar(&args.RateLimiterWindow, "rate-limiter-window", 60*time.Second, "Rate limiter time window")
-	flag.IntVar(&args.RateLimiterMaxRequests, "rate-limiter-max-requests", 100, "Maximum requests allowed in the time window")
-	flag.StringVar(&args.RateLimiterMethods, "rate-limiter-methods", "", "Comma-separated list of methods to rate limit")
-
-	// Parse flags
+	flags.BoolVar(&args.ReturnDetailedPanicErrors, "return-detailed-panic-errors", true, "Return detailed panic errors to client")
+	flags.BoolVar(&args.RateLimiterEnabled, "rate-limiter-enabled", false, "Enable rate limiting")
+	flags.StringVar(&args.RateLimiterMemcachedAddrs, "rate-limiter-memcached-addrs", "", "Comma-separated list of Memcached addresses")
+	flags.DurationVar(&args.RateLimiterWindow, "rate-limiter-window", 60*time.Second, "Rate limiter time window")
+	flags.IntVar(&args.RateLimiterMaxRequests, "rate-limiter-max-requests", 100, "Maximum requests allowed in the time window")
+	flags.StringVar(&args.RateLimiterMethods, "rate-limiter-methods", "", "Comma-separated list of methods to rate limit")
+	flags.StringVar(&args.RateLimiterMethodLimits, "rate-limiter-method-limits", "", "Comma-separated list of per-method limits, e.g. /spark.SparkService/start_transfer=10/1m")
+	flags.IntVar(&args.RateLimiterMaxStreams, "rate-limiter-max-streams", 0, "Maximum concurrent event streams per client, 0 for no limit")
+	flags.StringVar(&args.RateLimiterStore, "rate-limiter-store", middleware.RateLimitStoreMemory, "Store for rate limit counters: memory, memcached or redis")
+	flags.StringVar(&args.RateLimiterRedisAddr, "rate-limiter-redis-addr", "", "Redis address, host:port or redis://[:password@]host:port")
+	return args
+}
+
+func loadArgs() (*args, error) {
+	args := newArgs(flag.CommandLine)
 	flag.Parse()
 
 	var level slog.Level
@@ -170,44 +200,45 @@ func loadArgs() (*args, error) {
 	}
 	slog.SetDefault(slog.New(handler))
 
-	if args.IdentityPrivateKeyFilePath == "" {
-		return nil, errors.New("identity private key file path is required")
+	if err := args.checkRequired(); err != nil {
+		return nil, err
 	}
+	return args, nil
+}
 
-	if args.OperatorsFilePath == "" {
-		return nil, errors.New("operators file is required")
+// checkRequired checks that the flags without a default value are set.
+func (a *args) checkRequired() error {
+	if a.IdentityPrivateKeyFilePath == "" {
+		return errors.New("identity private key file path is required")
 	}
 
-	if args.SignerAddress == "" {
-		return nil, errors.New("signer address is required")
+	if a.OperatorsFilePath == "" {
+		return errors.New("operators file is required")
 	}
 
-	if args.Port == 0 {
-		return nil, errors.New("port is required")
+	if a.SignerAddress == "" {
+		return errors.New("signer address is required")
 	}
 
-	if args.DatabasePath == "" {
-		return nil, errors.New("database path is required")
+	if a.Port == 0 {
+		return errors.New("port is required")
 	}
 
-	return args, nil
-}
-
-func createRateLimiter(config *so.Config) (*middleware.RateLimiter, error) {
-	if !config.RateLimiter.Enabled {
-		return nil, nil
+	if a.DatabasePath == "" {
+		return errors.New("database path is required")
 	}
 
-	return middleware.NewRateLimiter(config)
+	return nil
 }
 
-func main() {
-	args, err := loadArgs()
+// newConfig loads the config of the operator from the flags and the files they point to.
+func newConfig(args *args) (*so.Config, error) {
+	rateLimiterMethodLimits, err := middleware.ParseMethodLimits(args.RateLimiterMethodLimits)
 	if err != nil {
-		log.Fatalf("Failed to load args: %v", err)
+		return nil, fmt.Errorf("failed to parse rate limiter method limits: %w", err)
 	}
 
-	config, err := so.NewConfig(
+	return so.NewConfig(
 		args.ConfigFilePath,
 		args.Index,
 		args.IdentityPrivateKeyFilePath,
@@ -225,15 +256,55 @@ func main() {
 		args.RunDirectory,
 		args.ReturnDetailedPanicErrors,
 		so.RateLimiterConfig{
-			Enabled:     args.RateLimiterEnabled,
-			Window:      args.RateLimiterWindow,
-			MaxRequests: args.RateLimiterMaxRequests,
-			Methods:     strings.Split(args.RateLimiterMethods, ","),
+			Enabled:        args.RateLimiterEnabled,
+			Window:         args.RateLimiterWindow,
+			MaxRequests:    args.RateLimiterMaxRequests,
+			Methods:        strings.Split(args.RateLimiterMethods, ","),
+			MethodLimits:   rateLimiterMethodLimits,
+			MaxStreams:     args.RateLimiterMaxStreams,
+			Store:          args.RateLimiterStore,
+			MemcachedAddrs: args.RateLimiterMemcachedAddrsList(),
+			RedisAddr:      args.RateLimiterRedisAddr,
 		},
 	)
+}
+
+func createRateLimiter(config *so.Config) (*middleware.RateLimiter, error) {
+	if !config.RateLimiter.Enabled {
+		return nil, nil
+	}
+
+	return middleware.NewRateLimiter(config)
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "admin":
+			os.Exit(runAdmin(os.Args[2:]))
+		case "backup":
+			os.Exit(runBackup(os.Args[2:]))
+		case "restore":
+			os.Exit(runRestore(os.Args[2:]))
+		case "migrate-database":
+			os.Exit(runMigrateDatabase(os.Args[2:]))
+		case "validate-config":
+			os.Exit(runValidateConfig(os.Args[2:]))
+		}
+	}
+
+	args, err := loadArgs()
+	if err != nil {
+		log.Fatalf("Failed to load args: %v", err)
+	}
+
+	config, err := newConfig(args)
 	if err != nil {
 		log.Fatalf("Failed to create config: %v", err)
 	}
+	if err := config.Validate(); err != nil {
+		slog.Warn("The configuration is invalid and cannot be reloaded until it is fixed", "error", err)
+	}
 
 	sigCtx, done := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer done()
@@ -268,24 +339,51 @@ func main() {
 	}
 
 	dbDriver := config.DatabaseDriver()
-	connector, err := so.NewDBConnector(errCtx, config.DatabasePath, config.AWS)
+	db, connector, err := openDatabase(errCtx, config)
 	if err != nil {
 		log.Fatalf("Failed to create db connector: %v", err)
 	}
 	defer connector.Close()
 
-	var db entsql.ExecQuerier
-	if dbDriver == "postgres" {
-		db = stdlib.OpenDBFromPool(connector.Pool())
-	} else {
-		db = otelsql.OpenDB(connector, otelsql.WithSpanOptions(so.OtelSQLSpanOptions))
-	}
-
 	dialectDriver := entsql.NewDriver(dbDriver, entsql.Conn{ExecQuerier: db})
 	dbClient := ent.NewClient(ent.Driver(dialectDriver))
 	dbClient.Intercept(ent.DatabaseStatsInterceptor(10 * time.Second))
 	defer dbClient.Close()
 
+	keyManager, err := keymanager.New(errCtx, config.Encryption, config.RunDirectory)
+	if err != nil {
+		log.Fatalf("Failed to create key manager: %v", err)
+	}
+	var envelope *keymanager.Envelope
+	if keyManager != nil {
+		envelope = keymanager.NewEnvelope(keyManager)
+	} else {
+		slog.Warn("No key manager is configured, signing keyshares and nonces are stored in plaintext")
+	}
+	ent.UseEnvelopeEncryption(dbClient, envelope)
+	ent.UseMetrics(dbClient)
+
+	var readReplica *ent.ReadReplica
+	if config.ReadReplica.Enabled() {
+		if dbDriver != "postgres" || !strings.HasPrefix(config.ReadReplica.DatabasePath, "postgresql") {
+			log.Fatalf("Read replica requires PostgreSQL databases")
+		}
+		replicaConnector, err := so.NewDBConnector(errCtx, config.ReadReplica.DatabasePath, config.AWS)
+		if err != nil {
+			log.Fatalf("Failed to create read replica db connector: %v", err)
+		}
+		defer replicaConnector.Close()
+
+		replicaDB := stdlib.OpenDBFromPool(replicaConnector.Pool())
+		replicaClient := ent.NewClient(ent.Driver(entsql.NewDriver(dbDriver, entsql.Conn{ExecQuerier: replicaDB})))
+		replicaClient.Intercept(ent.DatabaseStatsInterceptor(10 * time.Second))
+		defer replicaClient.Close()
+		ent.UseEnvelopeEncryption(replicaClient, envelope)
+
+		readReplica = ent.NewReadReplica(replicaClient, ent.PostgresReplicaLag(replicaDB), sparkgrpc.ReadOnlyMethods, config.ReadReplica.MaxReplicaLag())
+		go readReplica.MonitorLag(logging.Inject(errCtx, slog.Default().With("component", "read_replica")))
+	}
+
 	if dbDriver == "sqlite3" {
 		sqliteDb, _ := sql.Open("sqlite3", config.DatabasePath)
 		if _, err := sqliteDb.ExecContext(errCtx, "PRAGMA journal_mode=WAL;"); err != nil {
@@ -311,57 +409,27 @@ func main() {
 	}
 	defer lrc20Client.Close() //nolint:errcheck
 
+	watchers := newChainWatchers(errCtx, errGrp, dbClient, lrc20Client)
 	for network, bitcoindConfig := range config.BitcoindConfigs {
-		errGrp.Go(func() error {
-			chainCtx, chainCancel := context.WithCancel(errCtx)
-			defer chainCancel()
-
-			logger := slog.Default().With("component", "chainwatcher", "network", network)
-			chainCtx = logging.Inject(chainCtx, logger)
-
-			err := chain.WatchChain(
-				chainCtx,
-				dbClient,
-				lrc20Client,
-				bitcoindConfig,
-			)
-			if err != nil {
-				logger.Error("Error in chain watcher", "error", err)
-				return err
-			}
-
-			if errCtx.Err() == nil {
-				// This technically isn't an error, but raise it as one because our chain watcher should never
-				// stop unless we explicitly tell it to when shutting down!
-				return fmt.Errorf("chain watcher stopped unexpectedly")
-			}
-
-			return nil
-		})
+		watchers.start(network, bitcoindConfig)
 	}
 
-	if !args.RunningLocally {
-		cronCtx, cronCancel := context.WithCancel(errCtx)
-		defer cronCancel()
+	cronCtx, cronCancel := context.WithCancel(errCtx)
+	defer cronCancel()
 
-		logger := slog.Default().With("component", "cron")
-		cronCtx = logging.Inject(cronCtx, logger)
+	cronLogger := slog.Default().With("component", "cron")
+	cronCtx = logging.Inject(cronCtx, cronLogger)
 
-		logger.Info("Starting scheduler")
-		scheduler, err := gocron.NewScheduler(
-			gocron.WithGlobalJobOptions(gocron.WithContext(cronCtx)),
-			gocron.WithLogger(logger),
-		)
-		if err != nil {
-			log.Fatalf("Failed to create scheduler: %v", err)
-		}
-		for _, task := range task.AllTasks() {
-
-			err := task.Schedule(scheduler, config, dbClient)
-			if err != nil {
-				log.Fatalf("Failed to create job: %v", err)
-			}
-		}
+	tasks := task.AllTasks()
+	if envelope != nil {
+		tasks = append(tasks, task.ReencryptSigningSecretsTask(envelope))
+	}
+	scheduler, err := task.NewScheduler(cronCtx, config, dbClient, tasks)
+	if err != nil {
+		log.Fatalf("Failed to create scheduler: %v", err)
+	}
+	if !args.RunningLocally {
+		cronLogger.Info("Starting scheduler")
 		scheduler.Start()
 		defer scheduler.Shutdown() //nolint:errcheck
 	}
@@ -370,6 +438,8 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create token verifier: %v", err)
 	}
+	sessionRevocations := ent.NewSessionRevocationChecker(dbClient)
+	go sessionRevocations.WatchRevocations(logging.Inject(errCtx, slog.Default().With("component", "session_revocations")))
 
 	var rateLimiter *middleware.RateLimiter
 	if config.RateLimiter.Enabled {
@@ -378,17 +448,19 @@ func main() {
 		if err != nil {
 			log.Fatalf("Failed to create rate limiter: %v", err)
 		}
+		defer rateLimiter.Close() //nolint:errcheck
 	}
 
+	operatorAuthenticator := sparkgrpc.NewOperatorAuthenticator(config, dbClient)
 	serverOpts := []grpc.ServerOption{
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			sparkerrors.ErrorInterceptor(),
 			helper.LogInterceptor(args.LogJSON && args.LogRequestStats),
 			sparkgrpc.PanicRecoveryInterceptor(config.ReturnDetailedPanicErrors),
-			ent.DbSessionMiddleware(dbClient),
-			authn.NewAuthnInterceptor(sessionTokenCreatorVerifier).AuthnInterceptor,
-			sparkgrpc.ValidationInterceptor(),
+			authn.NewAuthnInterceptor(sessionTokenCreatorVerifier).WithRevocationChecker(sessionRevocations).AuthnInterceptor,
+			operatorAuthenticator.UnaryServerInterceptor(),
+			// Throttle calls before they open a transaction or reserve an idempotency key.
 			func() grpc.UnaryServerInterceptor {
 				if rateLimiter != nil {
 					return rateLimiter.UnaryServerInterceptor()
@@ -397,9 +469,25 @@ func main() {
 					return handler(ctx, req)
 				}
 			}(),
+			ent.DbSessionMiddleware(dbClient, readReplica),
+			authz.ScopeInterceptor(),
+			sparkgrpc.AuthorizationInterceptor(config),
+			sparkgrpc.ValidationInterceptor(),
+			sparkgrpc.IdempotencyInterceptor(sparkgrpc.IdempotentMethods),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
-			authn.NewAuthnInterceptor(sessionTokenCreatorVerifier).StreamAuthnInterceptor,
+			authn.NewAuthnInterceptor(sessionTokenCreatorVerifier).WithRevocationChecker(sessionRevocations).StreamAuthnInterceptor,
+			operatorAuthenticator.StreamServerInterceptor(),
+			func() grpc.StreamServerInterceptor {
+				if rateLimiter != nil {
+					return rateLimiter.StreamServerInterceptor()
+				}
+				return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+					return handler(srv, ss)
+				}
+			}(),
+			authz.StreamScopeInterceptor(),
+			sparkgrpc.StreamAuthorizationInterceptor(config),
 			sparkgrpc.StreamValidationInterceptor(),
 		)),
 	}
@@ -423,6 +511,24 @@ func main() {
 			Certificates: []tls.Certificate{cert},
 			MinVersion:   tls.VersionTLS12,
 		}
+		if args.AdminClientCAPath != "" {
+			adminClientCA, err := os.ReadFile(args.AdminClientCAPath)
+			if err != nil {
+				log.Fatalf("Failed to read admin client CA: %v", err)
+			}
+			clientCAs := x509.NewCertPool()
+			if !clientCAs.AppendCertsFromPEM(adminClientCA) {
+				log.Fatalf("Failed to parse admin client CA")
+			}
+			// Client certificates are optional so that users can still connect, but those that are
+			// presented must be signed by the admin client CA. Only those the authorization policy
+			// lists grant access to the admin service.
+			tlsConfig.ClientCAs = clientCAs
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+			if !config.AuthorizationPolicy.HasAdminClientCertificates() {
+				slog.Warn("An admin client CA is configured, but no admin client certificates are listed in the authorization policy")
+			}
+		}
 	} else {
 		grpcServer = grpc.NewServer(serverOpts...)
 		tlsConfig = nil
@@ -443,6 +549,9 @@ func main() {
 	treeServer := sparkgrpc.NewSparkTreeServer(config, dbClient)
 	pbtree.RegisterSparkTreeServiceServer(grpcServer, treeServer)
 
+	adminServer := sparkgrpc.NewSparkAdminServer(config, scheduler, lrc20Client)
+	pbadmin.RegisterSparkAdminServiceServer(grpcServer, adminServer)
+
 	if args.RunningLocally {
 		mockServer := sparkgrpc.NewMockServer(config)
 		pbmock.RegisterMockServiceServer(grpcServer, mockServer)
@@ -450,9 +559,12 @@ func main() {
 	}
 
 	authnServer, err := sparkgrpc.NewAuthnServer(sparkgrpc.AuthnServerConfig{
-		IdentityPrivateKey: config.IdentityPrivateKey,
-		ChallengeTimeout:   args.ChallengeTimeout,
-		SessionDuration:    args.SessionDuration,
+		IdentityPrivateKey:   config.IdentityPrivateKey,
+		ChallengeTimeout:     args.ChallengeTimeout,
+		SessionDuration:      args.SessionDuration,
+		RefreshTokenDuration: args.RefreshTokenDuration,
+		Revocations:          sessionRevocations,
+		Operator:             config,
 	}, sessionTokenCreatorVerifier)
 	if err != nil {
 		log.Fatalf("Failed to create authentication server: %v", err)
@@ -461,7 +573,16 @@ func main() {
 
 	healthService := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthService)
-	healthService.SetServingStatus("spark-operator", grpc_health_v1.HealthCheckResponse_SERVING)
+	healthMonitor, err := newHealthMonitor(healthService, config, db, frostConnection, lrc20Client, !args.DisableDKG)
+	if err != nil {
+		log.Fatalf("Failed to create health monitor: %v", err)
+	}
+	healthCtx := logging.Inject(errCtx, slog.Default().With("component", "health"))
+	go healthMonitor.Run(healthCtx, spark.HealthCheckInterval)
+
+	configVersionGauge.Record(errCtx, int64(config.Reloadable().Version))
+	reloadCtx := logging.Inject(errCtx, slog.Default().With("component", "config"))
+	go reloadOnSignal(reloadCtx, args, config, rateLimiter, watchers)
 
 	wrappedGrpc := grpcweb.WrapServer(grpcServer,
 		grpcweb.WithOriginFunc(func(_ string) bool {
@@ -471,9 +592,10 @@ func main() {
 	)
 
 	mux := http.NewServeMux()
-	mux.Handle("/-/ready", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	mux.Handle("/-/live", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
+	mux.Handle("/-/ready", healthMonitor)
 	mux.Handle("/metrics", promhttp.Handler())
 	mux.HandleFunc("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if strings.ToLower(r.Header.Get("Content-Type")) == "application/grpc" {
@@ -559,6 +681,90 @@ func main() {
 	}
 }
 
+// openDatabase opens the database of the config.
+func openDatabase(ctx context.Context, config *so.Config) (*sql.DB, *so.DBConnector, error) {
+	connector, err := so.NewDBConnector(ctx, config.DatabasePath, config.AWS)
+	if err != nil {
+		return nil, nil, err
+	}
+	if config.DatabaseDriver() == "postgres" {
+		return stdlib.OpenDBFromPool(connector.Pool()), connector, nil
+	}
+	return otelsql.OpenDB(connector, otelsql.WithSpanOptions(so.OtelSQLSpanOptions)), connector, nil
+}
+
+// newHealthMonitor creates the monitor of the dependencies of the operator, and of the status of
+// its gRPC services. Token RPCs depend on the LRC20 nodes, and deposit RPCs on bitcoind.
+func newHealthMonitor(
+	server *health.Server,
+	config *so.Config,
+	db *sql.DB,
+	frostConnection *grpc.ClientConn,
+	lrc20Client *lrc20.Client,
+	dkgEnabled bool,
+) (*sparkhealth.Monitor, error) {
+	const (
+		database  = "database"
+		signer    = "signer"
+		operators = "operators"
+	)
+	dependencies := []sparkhealth.Dependency{
+		{Name: database, Check: sparkhealth.DatabaseCheck(db)},
+		{Name: signer, Check: sparkhealth.SignerCheck(frostConnection)},
+		{Name: operators, Check: sparkhealth.OperatorsCheck(config)},
+	}
+
+	networks := make([]string, 0, len(config.BitcoindConfigs))
+	for network := range config.BitcoindConfigs {
+		networks = append(networks, network)
+	}
+	sort.Strings(networks)
+	var chainDependencies []string
+	for _, network := range networks {
+		check, err := newBitcoindHealthCheck(config, network)
+		if err != nil {
+			return nil, err
+		}
+		name := "bitcoind/" + network
+		dependencies = append(dependencies, sparkhealth.Dependency{Name: name, Check: check.Check})
+		chainDependencies = append(chainDependencies, name)
+	}
+
+	var tokenDependencies []string
+	for _, network := range config.SupportedNetworks {
+		// Networks whose LRC20 RPCs are disabled are checked too, as they can be enabled on reload.
+		if _, ok := config.Lrc20Configs[network.String()]; !ok {
+			continue
+		}
+		name := "lrc20/" + network.String()
+		dependencies = append(dependencies, sparkhealth.Dependency{
+			Name: name,
+			Check: func(ctx context.Context) error {
+				return lrc20Client.CheckHealth(ctx, network)
+			},
+		})
+		tokenDependencies = append(tokenDependencies, name)
+	}
+
+	core := []string{database, signer}
+	distributed := []string{database, signer, operators}
+	services := []sparkhealth.Service{
+		{Name: sparkhealth.ServerService, Dependencies: core},
+		{Name: sparkhealth.OperatorService, Dependencies: core},
+		{Name: pbspark.SparkService_ServiceDesc.ServiceName, Dependencies: distributed},
+		{Name: sparkhealth.TokenService, Dependencies: slices.Concat(distributed, tokenDependencies)},
+		{Name: sparkhealth.DepositService, Dependencies: slices.Concat(distributed, chainDependencies)},
+		{Name: pbinternal.SparkInternalService_ServiceDesc.ServiceName, Dependencies: core},
+		{Name: pbtree.SparkTreeService_ServiceDesc.ServiceName, Dependencies: []string{database}},
+		{Name: pbadmin.SparkAdminService_ServiceDesc.ServiceName, Dependencies: []string{database}},
+		{Name: pbauthn.SparkAuthnService_ServiceDesc.ServiceName, Dependencies: []string{database}},
+	}
+	if dkgEnabled {
+		services = append(services, sparkhealth.Service{Name: pbdkg.DKGService_ServiceDesc.ServiceName, Dependencies: distributed})
+	}
+	return sparkhealth.NewMonitor(server, dependencies, services), nil
+}
+
 func runDKGOnStartup(ctx context.Context, dbClient *ent.Client, config *so.Config) {
 	time.Sleep(5 * time.Second)
 	err := ent.RunDKGIfNeeded(ctx, dbClient, config)
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/backup"
+)
+
+// runMigrateDatabase copies the database of the operator into another one, such as from SQLite to
+// Postgres.
+func runMigrateDatabase(args []string) int {
+	flags := flag.NewFlagSet("migrate-database", flag.ContinueOnError)
+	from := flags.String("from", "", "Path to the database to copy from")
+	fromAWS := flags.Bool("from-aws", false, "Use AWS RDS for the database to copy from")
+	to := flags.String("to", "", "Path to the database to copy to")
+	toAWS := flags.Bool("to-aws", false, "Use AWS RDS for the database to copy to")
+	watermarkPath := flags.String("watermark", "", "File the watermark of the previous run is read from and the watermark of this run written to")
+	final := flags.Bool("final", false, "Delete the rows no longer in the source and check the row counts and checksums of every table")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: operator migrate-database -from <path> -to <path> [flags]\n\n")
+		fmt.Fprintf(flags.Output(), "Copies every row of a database into another one migrated to the schema of this binary. Running\n")
+		fmt.Fprintf(flags.Output(), "it again with the same watermark file only copies what changed since. The last run, with the\n")
+		fmt.Fprintf(flags.Output(), "operator stopped, is made with -final, which checks that the row counts and checksums of both\n")
+		fmt.Fprintf(flags.Output(), "databases match.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *from == "" || *to == "" {
+		flags.Usage()
+		return 2
+	}
+
+	options := backup.CopyOptions{Final: *final}
+	if *watermarkPath != "" {
+		data, err := os.ReadFile(*watermarkPath)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "failed to read watermark: %v\n", err)
+			return 1
+		default:
+			options.Since = &backup.CopyWatermark{}
+			if err := json.Unmarshal(data, options.Since); err != nil {
+				fmt.Fprintf(os.Stderr, "failed to parse watermark: %v\n", err)
+				return 1
+			}
+		}
+	}
+
+	ctx, done := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer done()
+	result, err := migrateDatabase(ctx, &so.Config{DatabasePath: *from, AWS: *fromAWS}, &so.Config{DatabasePath: *to, AWS: *toAWS}, options)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migration failed: %v\n", err)
+		return 1
+	}
+	if *watermarkPath != "" {
+		data, err := json.Marshal(result.Watermark)
+		if err == nil {
+			err = os.WriteFile(*watermarkPath, data, 0o600)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write watermark: %v\n", err)
+			return 1
+		}
+	}
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to print summary: %v\n", err)
+		return 1
+	}
+	fmt.Println(string(output))
+	return 0
+}
+
+func migrateDatabase(ctx context.Context, from *so.Config, to *so.Config, options backup.CopyOptions) (*backup.CopyResult, error) {
+	source, sourceConnector, err := openDatabase(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open source database: %w", err)
+	}
+	defer sourceConnector.Close()
+	defer source.Close()
+	target, targetConnector, err := openDatabase(ctx, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open target database: %w", err)
+	}
+	defer targetConnector.Close()
+	defer target.Close()
+	return backup.Copy(ctx, source, from.DatabaseDriver(), target, to.DatabaseDriver(), options)
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightsparkdev/spark/common"
+	"github.com/lightsparkdev/spark/common/logging"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/chain"
+	"github.com/lightsparkdev/spark/so/ent"
+	sparkhealth "github.com/lightsparkdev/spark/so/health"
+	"github.com/lightsparkdev/spark/so/lrc20"
+	"github.com/lightsparkdev/spark/so/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	configVersionGauge   metric.Int64Gauge
+	configReloadsCounter metric.Int64Counter
+)
+
+func init() {
+	var err error
+	meter := otel.Meter("config")
+	configVersionGauge, err = meter.Int64Gauge(
+		"spark_config_version",
+		metric.WithDescription("Version of the active configuration, incremented by every reload that changes it"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	configReloadsCounter, err = meter.Int64Counter(
+		"spark_config_reloads",
+		metric.WithDescription("Number of reloads of the configuration, by whether they were applied"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// The results of a reload of the configuration.
+const (
+	reloadResultApplied   = "applied"
+	reloadResultUnchanged = "unchanged"
+	reloadResultRejected  = "rejected"
+)
+
+// reloadOnSignal reloads the configuration from the flags and the files they point to on every
+// SIGHUP, until the context is done.
+func reloadOnSignal(ctx context.Context, args *args, config *so.Config, rateLimiter *middleware.RateLimiter, watchers *chainWatchers) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+			result := reloadConfig(ctx, args, config, rateLimiter, watchers)
+			configReloadsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("result", result)))
+		}
+	}
+}
+
+// reloadConfig applies the settings of the configuration that can change at runtime, and returns
+// the result of the reload. Configurations that are invalid are rejected as a whole.
+func reloadConfig(ctx context.Context, args *args, config *so.Config, rateLimiter *middleware.RateLimiter, watchers *chainWatchers) string {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	newConfig, err := newConfig(args)
+	if err == nil {
+		err = newConfig.Validate()
+	}
+	if err != nil {
+		logger.Error("Rejected the reloaded configuration", "error", err)
+		return reloadResultRejected
+	}
+
+	result := config.Reload(newConfig)
+	if len(result.RestartRequired) > 0 {
+		logger.Warn("Changes to settings that only take effect on restart were ignored", "settings", result.RestartRequired)
+	}
+	if len(result.Changed) == 0 {
+		logger.Info("Reloaded the configuration without changes", "version", result.Version)
+		return reloadResultUnchanged
+	}
+
+	reloadable := config.Reloadable()
+	if rateLimiter != nil {
+		rateLimiter.UpdateLimits(config.GetRateLimiterConfig())
+	}
+	common.UpdateTracingSampling(reloadable.Tracing)
+	watchers.update(reloadable.BitcoindConfigs)
+	configVersionGauge.Record(ctx, int64(result.Version))
+	logger.Info("Reloaded the configuration", "version", result.Version, "changed", result.Changed)
+	return reloadResultApplied
+}
+
+// chainWatchers runs the chain watchers of the networks, and restarts those whose bitcoind
+// settings change on reload.
+type chainWatchers struct {
+	ctx         context.Context
+	group       *errgroup.Group
+	dbClient    *ent.Client
+	lrc20Client *lrc20.Client
+
+	mu       sync.Mutex
+	watchers map[string]*chainWatcher
+}
+
+type chainWatcher struct {
+	config so.BitcoindConfig
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newChainWatchers(ctx context.Context, group *errgroup.Group, dbClient *ent.Client, lrc20Client *lrc20.Client) *chainWatchers {
+	return &chainWatchers{
+		ctx:         ctx,
+		group:       group,
+		dbClient:    dbClient,
+		lrc20Client: lrc20Client,
+		watchers:    make(map[string]*chainWatcher),
+	}
+}
+
+// start starts the chain watcher of the network in the group. It fails the group if it stops
+// before the context of the group is done, unless it was stopped to be restarted.
+func (w *chainWatchers) start(network string, bitcoindConfig so.BitcoindConfig) {
+	chainCtx, chainCancel := context.WithCancel(w.ctx)
+	watcher := &chainWatcher{config: bitcoindConfig, cancel: chainCancel, done: make(chan struct{})}
+	w.mu.Lock()
+	w.watchers[network] = watcher
+	w.mu.Unlock()
+
+	w.group.Go(func() error {
+		defer close(watcher.done)
+		defer chainCancel()
+
+		logger := slog.Default().With("component", "chainwatcher", "network", network)
+		err := chain.WatchChain(
+			logging.Inject(chainCtx, logger),
+			w.dbClient,
+			w.lrc20Client,
+			bitcoindConfig,
+		)
+		if chainCtx.Err() != nil && w.ctx.Err() == nil {
+			logger.Info("Chain watcher stopped to restart with new settings")
+			return nil
+		}
+		if err != nil {
+			logger.Error("Error in chain watcher", "error", err)
+			return err
+		}
+
+		if w.ctx.Err() == nil {
+			// This technically isn't an error, but raise it as one because our chain watcher should never
+			// stop unless we explicitly tell it to when shutting down!
+			return fmt.Errorf("chain watcher stopped unexpectedly")
+		}
+
+		return nil
+	})
+}
+
+// update restarts the chain watchers whose bitcoind settings differ from the given ones. Networks
+// are only added and removed on restart.
+func (w *chainWatchers) update(bitcoindConfigs map[string]so.BitcoindConfig) {
+	w.mu.Lock()
+	restarted := make(map[string]*chainWatcher)
+	for network, watcher := range w.watchers {
+		if bitcoindConfig, ok := bitcoindConfigs[network]; ok && bitcoindConfig != watcher.config {
+			restarted[network] = watcher
+		}
+	}
+	w.mu.Unlock()
+
+	for network, watcher := range restarted {
+		if w.ctx.Err() != nil {
+			return
+		}
+		// The previous watcher is stopped first, so that a network is never scanned twice at once.
+		watcher.cancel()
+		<-watcher.done
+		w.start(network, bitcoindConfigs[network])
+	}
+}
+
+// bitcoindHealthCheck checks the bitcoin node of a network with its current settings, which can
+// change on reload.
+type bitcoindHealthCheck struct {
+	config  *so.Config
+	network string
+
+	mu       sync.Mutex
+	settings so.BitcoindConfig
+	client   *rpcclient.Client
+	check    func(context.Context) error
+}
+
+func newBitcoindHealthCheck(config *so.Config, network string) (*bitcoindHealthCheck, error) {
+	check := &bitcoindHealthCheck{config: config, network: network}
+	if _, err := check.current(); err != nil {
+		return nil, err
+	}
+	return check, nil
+}
+
+// Check checks that the bitcoin node answers RPCs.
+func (h *bitcoindHealthCheck) Check(ctx context.Context) error {
+	check, err := h.current()
+	if err != nil {
+		return err
+	}
+	return check(ctx)
+}
+
+// current returns the check of the bitcoin node with the current settings, connecting to it again
+// if they changed.
+func (h *bitcoindHealthCheck) current() (func(context.Context) error, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	settings := h.config.Reloadable().BitcoindConfigs[h.network]
+	if h.client != nil && settings == h.settings {
+		return h.check, nil
+	}
+	connConfig := chain.RPCClientConfig(settings)
+	client, err := rpcclient.New(&connConfig, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bitcoind client for network %s: %w", h.network, err)
+	}
+	if h.client != nil {
+		h.client.Shutdown()
+	}
+	h.settings, h.client, h.check = settings, client, sparkhealth.BitcoindCheck(client)
+	return h.check, nil
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/lightsparkdev/spark/common"
+	sparkhealth "github.com/lightsparkdev/spark/so/health"
+	"github.com/lightsparkdev/spark/so/keymanager"
+	"github.com/lightsparkdev/spark/so/lrc20"
+)
+
+// configCheck is the result of a check of the configuration.
+type configCheck struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
+
+// runValidateConfig checks the configuration the server would start with, given with the same
+// flags, and that the dependencies it configures can be reached.
+func runValidateConfig(arguments []string) int {
+	flags := flag.NewFlagSet("validate-config", flag.ContinueOnError)
+	args := newArgs(flags)
+	offline := flags.Bool("offline", false, "Only check the configuration and the files it points to, without connecting to its dependencies")
+	timeout := flags.Duration("timeout", 10*time.Second, "Timeout of the check of every dependency")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: operator validate-config [server flags] [flags]\n\n")
+		fmt.Fprintf(flags.Output(), "Checks the operators file, the identity key, the certificates and the networks of the\n")
+		fmt.Fprintf(flags.Output(), "configuration, and that the database, the signer, the other operators, bitcoind and the LRC20\n")
+		fmt.Fprintf(flags.Output(), "nodes can be reached. Takes the flags of the server.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(arguments); err != nil {
+		return 2
+	}
+	if err := args.checkRequired(); err != nil {
+		fmt.Fprintf(flags.Output(), "%v\n\n", err)
+		flags.Usage()
+		return 2
+	}
+	// The clients of the dependencies log their failures, which are already reported by the checks.
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	ctx, done := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer done()
+	checks := validateConfig(ctx, args, *offline, *timeout)
+
+	valid := true
+	for _, check := range checks {
+		valid = valid && check.Error == ""
+	}
+	output, err := json.MarshalIndent(map[string]any{"valid": valid, "checks": checks}, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to print checks: %v\n", err)
+		return 1
+	}
+	fmt.Println(string(output))
+	if !valid {
+		return 1
+	}
+	return 0
+}
+
+// validateConfig checks the configuration of the args and, unless offline, its dependencies.
+func validateConfig(ctx context.Context, args *args, offline bool, timeout time.Duration) []configCheck {
+	var checks []configCheck
+	report := func(name string, err error) {
+		var joined interface{ Unwrap() []error }
+		if errors.As(err, &joined) {
+			for _, err := range joined.Unwrap() {
+				checks = append(checks, configCheck{Name: name, Error: err.Error()})
+			}
+			return
+		}
+		check := configCheck{Name: name}
+		if err != nil {
+			check.Error = err.Error()
+		}
+		checks = append(checks, check)
+	}
+
+	config, err := newConfig(args)
+	if err != nil {
+		report("config", err)
+		return checks
+	}
+	report("config", config.Validate())
+	if args.AdminClientCAPath != "" {
+		err := validateCertificatePool(args.AdminClientCAPath)
+		if err == nil && !config.AuthorizationPolicy.HasAdminClientCertificates() {
+			err = fmt.Errorf("no client certificates signed by the admin client CA are listed in authorization.admin_client_certificates or authorization.admin_client_subjects")
+		}
+		report("admin_client_ca", err)
+	}
+	keyManager, err := keymanager.New(ctx, config.Encryption, config.RunDirectory)
+	report("encryption", err)
+	if offline {
+		return checks
+	}
+
+	check := func(name string, fn func(context.Context) error) {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		report(name, fn(checkCtx))
+	}
+
+	if keyManager != nil {
+		check("encryption_key", func(ctx context.Context) error {
+			wrappedKey, err := keyManager.EncryptDataKey(ctx, make([]byte, 32))
+			if err != nil {
+				return err
+			}
+			_, err = keyManager.DecryptDataKey(ctx, wrappedKey, keyManager.CurrentKeyVersion())
+			return err
+		})
+	}
+
+	db, connector, err := openDatabase(ctx, config)
+	if err != nil {
+		report("database", err)
+	} else {
+		defer connector.Close()
+		defer db.Close()
+		check("database", sparkhealth.DatabaseCheck(db))
+	}
+
+	frostConnection, err := common.NewGRPCConnectionWithoutTLS(args.SignerAddress, nil)
+	if err != nil {
+		report("signer", err)
+	} else {
+		defer frostConnection.Close()
+		check("signer", sparkhealth.SignerCheck(frostConnection))
+	}
+
+	check("operators", sparkhealth.OperatorsCheck(config))
+
+	for _, network := range config.SupportedNetworks {
+		if _, ok := config.BitcoindConfigs[network.String()]; !ok {
+			continue
+		}
+		bitcoindCheck, err := newBitcoindHealthCheck(config, network.String())
+		if err != nil {
+			report("bitcoind/"+network.String(), err)
+			continue
+		}
+		check("bitcoind/"+network.String(), bitcoindCheck.Check)
+	}
+
+	if len(config.Lrc20Configs) > 0 {
+		lrc20Client, err := lrc20.NewClient(config, slog.Default().With("component", "lrc20_client"))
+		if err != nil {
+			report("lrc20", err)
+			return checks
+		}
+		defer lrc20Client.Close() //nolint:errcheck
+		for _, network := range config.SupportedNetworks {
+			if _, ok := config.Lrc20Configs[network.String()]; !ok {
+				continue
+			}
+			check("lrc20/"+network.String(), func(ctx context.Context) error {
+				return lrc20Client.CheckHealth(ctx, network)
+			})
+		}
+	}
+	return checks
+}
+
+// validateCertificatePool checks that the file holds PEM encoded certificates.
+func validateCertificatePool(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM(data) {
+		return fmt.Errorf("%s holds no PEM encoded certificate", path)
+	}
+	return nil
+}
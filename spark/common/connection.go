@@ -6,12 +6,14 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/url"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/lightsparkdev/spark/common/logging"
+	soerrors "github.com/lightsparkdev/spark/so/errors"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -72,27 +74,44 @@ func LoggingUnaryClientInterceptor(
 	} else {
 		logger.Info("gRPC client request succeeded", "grpc_client_method", method, "grpc_client_duration", duration.Seconds())
 	}
+	// Payloads are only logged when debugging, and never with their sensitive fields.
+	if logger.Enabled(ctx, slog.LevelDebug) {
+		logger.Debug("gRPC client payloads", "grpc_client_method", method, "request", logging.RedactedPayload(req), "reply", logging.RedactedPayload(reply))
+	}
 	return err
 }
 
+// ErrorReasonUnaryClientInterceptor converts the errors of the operators that have a reason into
+// errors that match it with errors.Is, such as errors.Is(err, soerrors.ReasonLeafLocked).
+func ErrorReasonUnaryClientInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	return soerrors.FromStatusError(invoker(ctx, method, req, reply, cc, opts...))
+}
+
 // NewGRPCConnection creates a new gRPC connection to the given address. If certPath is nil, it
-// will create a connection without TLS.
-func NewGRPCConnection(address string, certPath *string, retryPolicy *RetryPolicyConfig) (*grpc.ClientConn, error) {
+// will create a connection without TLS. The options are added to those of the connection.
+func NewGRPCConnection(address string, certPath *string, retryPolicy *RetryPolicyConfig, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	if certPath == nil {
-		return NewGRPCConnectionWithoutTLS(address, retryPolicy)
+		return NewGRPCConnectionWithoutTLS(address, retryPolicy, opts...)
 	}
-	return NewGRPCConnectionWithCert(address, *certPath, retryPolicy)
+	return NewGRPCConnectionWithCert(address, *certPath, retryPolicy, opts...)
 }
 
 // NewGRPCConnection creates a new gRPC connection to the given address.
-func NewGRPCConnectionWithCert(address string, certPath string, retryPolicy *RetryPolicyConfig) (*grpc.ClientConn, error) {
+func NewGRPCConnectionWithCert(address string, certPath string, retryPolicy *RetryPolicyConfig, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	if len(certPath) == 0 {
-		return NewGRPCConnectionWithoutTLS(address, retryPolicy)
+		return NewGRPCConnectionWithoutTLS(address, retryPolicy, opts...)
 	}
 
 	clientOpts := []grpc.DialOption{
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
-		grpc.WithUnaryInterceptor(LoggingUnaryClientInterceptor),
+		grpc.WithChainUnaryInterceptor(LoggingUnaryClientInterceptor, ErrorReasonUnaryClientInterceptor),
 	}
 
 	if retryPolicy != nil {
@@ -128,6 +147,7 @@ func NewGRPCConnectionWithCert(address string, certPath string, retryPolicy *Ret
 			ServerName:         host,
 		})),
 	)
+	clientOpts = append(clientOpts, opts...)
 
 	conn, err := grpc.NewClient(address, clientOpts...)
 	if err != nil {
@@ -136,10 +156,10 @@ func NewGRPCConnectionWithCert(address string, certPath string, retryPolicy *Ret
 	return conn, nil
 }
 
-func NewGRPCConnectionWithoutTLS(address string, retryPolicy *RetryPolicyConfig) (*grpc.ClientConn, error) {
+func NewGRPCConnectionWithoutTLS(address string, retryPolicy *RetryPolicyConfig, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	clientOpts := []grpc.DialOption{
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
-		grpc.WithUnaryInterceptor(LoggingUnaryClientInterceptor),
+		grpc.WithChainUnaryInterceptor(LoggingUnaryClientInterceptor, ErrorReasonUnaryClientInterceptor),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	}
 
@@ -148,6 +168,7 @@ func NewGRPCConnectionWithoutTLS(address string, retryPolicy *RetryPolicyConfig)
 	} else {
 		clientOpts = append(clientOpts, grpc.WithDefaultServiceConfig(CreateRetryPolicy(DefaultRetryPolicy)))
 	}
+	clientOpts = append(clientOpts, opts...)
 
 	conn, err := grpc.NewClient(address, clientOpts...)
 	if err != nil {
@@ -159,6 +180,7 @@ func NewGRPCConnectionWithoutTLS(address string, retryPolicy *RetryPolicyConfig)
 func NewGRPCConnectionWithTestTLS(address string, retryPolicy *RetryPolicyConfig) (*grpc.ClientConn, error) {
 	clientOpts := []grpc.DialOption{
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(ErrorReasonUnaryClientInterceptor),
 		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
 			InsecureSkipVerify: true,
 		})),
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	pb "github.com/lightsparkdev/spark/proto/spark_authn"
+)
+
+// delegationGrantDomain separates the signatures of delegation grants from the signatures the
+// identity key makes for anything else.
+const delegationGrantDomain = "spark delegation grant v1"
+
+// DelegationGrantMessage returns the message an identity signs, hashed with sha256, to grant a
+// delegate key sessions for it. Every field is encoded in a fixed order with its length, so that a
+// grant has one encoding only and no other message encodes the same.
+func DelegationGrantMessage(grant *pb.DelegationGrant) []byte {
+	var message bytes.Buffer
+	writeField := func(field []byte) {
+		_ = binary.Write(&message, binary.BigEndian, uint32(len(field)))
+		message.Write(field)
+	}
+	writeField([]byte(delegationGrantDomain))
+	writeField(grant.GetIdentityPublicKey())
+	writeField(grant.GetDelegatePublicKey())
+	_ = binary.Write(&message, binary.BigEndian, uint32(len(grant.GetScopes())))
+	for _, scope := range grant.GetScopes() {
+		writeField([]byte(scope))
+	}
+	_ = binary.Write(&message, binary.BigEndian, grant.GetIssuedTimestamp())
+	_ = binary.Write(&message, binary.BigEndian, grant.GetExpirationTimestamp())
+	return message.Bytes()
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"testing"
+
+	pb "github.com/lightsparkdev/spark/proto/spark_authn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegationGrantMessage(t *testing.T) {
+	grant := &pb.DelegationGrant{
+		IdentityPublicKey:   []byte{0x02, 0x01},
+		DelegatePublicKey:   []byte{0x03, 0x02},
+		Scopes:              []string{"ab", "c"},
+		IssuedTimestamp:     1000,
+		ExpirationTimestamp: 2000,
+	}
+	message := DelegationGrantMessage(grant)
+	require.Equal(t, message, DelegationGrantMessage(grant))
+
+	// Moving bytes from one field to the next changes the message.
+	moved := &pb.DelegationGrant{
+		IdentityPublicKey:   grant.IdentityPublicKey,
+		DelegatePublicKey:   grant.DelegatePublicKey,
+		Scopes:              []string{"a", "bc"},
+		IssuedTimestamp:     grant.IssuedTimestamp,
+		ExpirationTimestamp: grant.ExpirationTimestamp,
+	}
+	require.NotEqual(t, message, DelegationGrantMessage(moved))
+	moved = &pb.DelegationGrant{
+		IdentityPublicKey:   []byte{0x02},
+		DelegatePublicKey:   []byte{0x01, 0x03, 0x02},
+		Scopes:              grant.Scopes,
+		IssuedTimestamp:     grant.IssuedTimestamp,
+		ExpirationTimestamp: grant.ExpirationTimestamp,
+	}
+	require.NotEqual(t, message, DelegationGrantMessage(moved))
+
+	// The message starts with the domain of delegation grants.
+	require.Equal(t, delegationGrantDomain, string(message[4:4+len(delegationGrantDomain)]))
+}
@@ -0,0 +1,62 @@
+package logging
+
+import (
+	"fmt"
+
+	pbcommon "github.com/lightsparkdev/spark/proto/common"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Redact returns a copy of the message with the fields marked with the (common.sensitive) option
+// cleared, in it and in every message it holds. The message itself is left as it is.
+func Redact(msg proto.Message) proto.Message {
+	if msg == nil {
+		return nil
+	}
+	redacted := proto.Clone(msg)
+	redactMessage(redacted.ProtoReflect())
+	return redacted
+}
+
+// RedactedPayload returns the text of a request or response to log or attach to a trace, with
+// its sensitive fields redacted. Values that are not protobuf messages are only described by
+// their type, as their sensitive fields are unknown.
+func RedactedPayload(payload any) string {
+	if msg, ok := payload.(proto.Message); ok {
+		return prototext.MarshalOptions{}.Format(Redact(msg))
+	}
+	return fmt.Sprintf("%T", payload)
+}
+
+func redactMessage(msg protoreflect.Message) {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case isSensitive(field):
+			msg.Clear(field)
+		case field.IsMap():
+			if field.MapValue().Message() != nil {
+				value.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+					redactMessage(value.Message())
+					return true
+				})
+			}
+		case field.IsList():
+			if field.Message() != nil {
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					redactMessage(list.Get(i).Message())
+				}
+			}
+		case field.Message() != nil:
+			redactMessage(value.Message())
+		}
+		return true
+	})
+}
+
+func isSensitive(field protoreflect.FieldDescriptor) bool {
+	sensitive, _ := proto.GetExtension(field.Options(), pbcommon.E_Sensitive).(bool)
+	return sensitive
+}
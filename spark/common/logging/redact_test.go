@@ -0,0 +1,62 @@
+package logging
+
+import (
+	"testing"
+
+	pbfrost "github.com/lightsparkdev/spark/proto/frost"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRedact(t *testing.T) {
+	request := &pb.StartTransferRequest{
+		TransferId: "transfer",
+		TransferPackage: &pb.TransferPackage{
+			KeyTweakPackage: map[string][]byte{"operator": []byte("tweak")},
+		},
+		LeavesToSend: []*pb.LeafRefundTxSigningJob{{LeafId: "leaf"}},
+	}
+	original := proto.Clone(request)
+
+	redacted := Redact(request).(*pb.StartTransferRequest)
+	require.Empty(t, redacted.TransferPackage.KeyTweakPackage)
+	require.Equal(t, "transfer", redacted.TransferId)
+	require.Equal(t, "leaf", redacted.LeavesToSend[0].LeafId)
+	require.True(t, proto.Equal(original, request))
+}
+
+func TestRedactNestedMessages(t *testing.T) {
+	request := &pb.ClaimTransferTweakKeysRequest{
+		TransferId: "transfer",
+		LeavesToReceive: []*pb.ClaimLeafKeyTweak{{
+			LeafId:           "leaf",
+			SecretShareTweak: &pb.SecretShare{SecretShare: []byte("secret"), Proofs: [][]byte{[]byte("proof")}},
+		}},
+	}
+
+	redacted := Redact(request).(*pb.ClaimTransferTweakKeysRequest)
+	require.Empty(t, redacted.LeavesToReceive[0].SecretShareTweak.SecretShare)
+	require.Equal(t, [][]byte{[]byte("proof")}, redacted.LeavesToReceive[0].SecretShareTweak.Proofs)
+	require.Equal(t, "leaf", redacted.LeavesToReceive[0].LeafId)
+
+	job := &pbfrost.FrostSigningJob{
+		JobId:      "job",
+		KeyPackage: &pbfrost.KeyPackage{SecretShare: []byte("secret"), Identifier: "operator"},
+		Nonce:      &pbfrost.SigningNonce{Hiding: []byte("hiding"), Binding: []byte("binding")},
+	}
+	redactedJob := Redact(job).(*pbfrost.FrostSigningJob)
+	require.Empty(t, redactedJob.KeyPackage.SecretShare)
+	require.Equal(t, "operator", redactedJob.KeyPackage.Identifier)
+	require.Empty(t, redactedJob.Nonce.Hiding)
+	require.Empty(t, redactedJob.Nonce.Binding)
+}
+
+func TestRedactedPayload(t *testing.T) {
+	payload := RedactedPayload(&pb.ProvidePreimageRequest{PaymentHash: []byte("hash"), Preimage: []byte("preimage")})
+	require.Contains(t, payload, "payment_hash")
+	require.NotContains(t, payload, "preimage")
+
+	require.Equal(t, "string", RedactedPayload("secret"))
+	require.Equal(t, "<nil>", RedactedPayload(nil))
+}
@@ -173,20 +173,31 @@ func SplitSecret(secret *big.Int, fieldModulus *big.Int, threshold int, numberOf
 
 // SplitSecretWithProofs splits a secret into a set of shares with proofs.
 func SplitSecretWithProofs(secret *big.Int, fieldModulus *big.Int, threshold int, numberOfShares int) ([]*VerifiableSecretShare, error) {
+	indices := make([]*big.Int, numberOfShares)
+	for i := range indices {
+		indices[i] = big.NewInt(int64(i + 1))
+	}
+	return SplitSecretWithProofsAt(secret, fieldModulus, threshold, indices)
+}
+
+// SplitSecretWithProofsAt splits a secret into shares at the given indices, with proofs.
+func SplitSecretWithProofsAt(secret *big.Int, fieldModulus *big.Int, threshold int, indices []*big.Int) ([]*VerifiableSecretShare, error) {
 	polynomial, err := generatePolynomialForSecretSharing(fieldModulus, secret, threshold)
 	if err != nil {
 		return nil, err
 	}
 
-	shares := make([]*VerifiableSecretShare, 0)
-	for i := 1; i <= numberOfShares; i++ {
-		share := polynomial.Evaluate(big.NewInt(int64(i)))
+	shares := make([]*VerifiableSecretShare, 0, len(indices))
+	for _, index := range indices {
+		if index.Sign() <= 0 {
+			return nil, fmt.Errorf("share index must be positive, got %s", index)
+		}
 		shares = append(shares, &VerifiableSecretShare{
 			SecretShare: SecretShare{
 				FieldModulus: fieldModulus,
 				Threshold:    threshold,
-				Index:        big.NewInt(int64(i)),
-				Share:        share,
+				Index:        index,
+				Share:        polynomial.Evaluate(index),
 			},
 			Proofs: polynomial.Proofs,
 		})
@@ -245,3 +256,98 @@ func ValidateShare(share *VerifiableSecretShare) error {
 
 	return nil
 }
+
+// SplitZeroWithProofs splits zero into shares at the given indices, with proofs. Adding the shares
+// to existing shares of a secret re-randomizes them without changing the secret.
+//
+// The constant coefficient is zero, so unlike SplitSecretWithProofs there is no proof for it:
+// Proofs[i] is the commitment to the coefficient of x^(i+1).
+func SplitZeroWithProofs(fieldModulus *big.Int, threshold int, indices []*big.Int) ([]*VerifiableSecretShare, error) {
+	if threshold < 2 {
+		return nil, fmt.Errorf("threshold must be at least 2 to split zero, got %d", threshold)
+	}
+	polynomial, err := generatePolynomialForSecretSharing(fieldModulus, big.NewInt(0), threshold)
+	if err != nil {
+		return nil, err
+	}
+	proofs := polynomial.Proofs[1:]
+
+	shares := make([]*VerifiableSecretShare, 0, len(indices))
+	for _, index := range indices {
+		if index.Sign() <= 0 {
+			return nil, fmt.Errorf("share index must be positive, got %s", index)
+		}
+		shares = append(shares, &VerifiableSecretShare{
+			SecretShare: SecretShare{
+				FieldModulus: fieldModulus,
+				Threshold:    threshold,
+				Index:        index,
+				Share:        polynomial.Evaluate(index),
+			},
+			Proofs: proofs,
+		})
+	}
+	return shares, nil
+}
+
+// SharePublicKey returns the public key of the share at the given index, computed from the proofs
+// of SplitSecretWithProofs.
+func SharePublicKey(proofs [][]byte, index *big.Int, fieldModulus *big.Int) (*secp256k1.PublicKey, error) {
+	if len(proofs) == 0 {
+		return nil, fmt.Errorf("no proofs")
+	}
+	constant, err := secp256k1.ParsePubKey(proofs[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(proofs) == 1 {
+		return constant, nil
+	}
+	rest, err := ZeroSharePublicKey(proofs[1:], index, fieldModulus)
+	if err != nil {
+		return nil, err
+	}
+	return common.AddPublicKeysRaw(constant, rest), nil
+}
+
+// ZeroSharePublicKey returns the public key of the share of zero at the given index, computed from
+// the proofs of SplitZeroWithProofs. It is what the public share at that index changes by when
+// the share is added to it.
+func ZeroSharePublicKey(proofs [][]byte, index *big.Int, fieldModulus *big.Int) (*secp256k1.PublicKey, error) {
+	if len(proofs) == 0 {
+		return nil, fmt.Errorf("no proofs")
+	}
+	curve := secp256k1.S256()
+	var result *secp256k1.PublicKey
+	for i, proof := range proofs {
+		pubkey, err := secp256k1.ParsePubKey(proof)
+		if err != nil {
+			return nil, err
+		}
+		value := new(big.Int).Exp(index, big.NewInt(int64(i+1)), fieldModulus)
+		resX, resY := curve.ScalarMult(pubkey.X(), pubkey.Y(), value.Bytes())
+		resPubkey := common.PublicKeyFromInts(resX, resY)
+		if result == nil {
+			result = resPubkey
+		} else {
+			result = common.AddPublicKeysRaw(result, resPubkey)
+		}
+	}
+	return result, nil
+}
+
+// ValidateZeroShare validates a share of zero created by SplitZeroWithProofs.
+func ValidateZeroShare(share *VerifiableSecretShare) error {
+	if len(share.Proofs) != share.Threshold-1 {
+		return fmt.Errorf("expected %d proofs, got %d", share.Threshold-1, len(share.Proofs))
+	}
+	resultPubkey, err := ZeroSharePublicKey(share.Proofs, share.Index, share.FieldModulus)
+	if err != nil {
+		return err
+	}
+	targetPubkey := secp256k1.NewPrivateKey(IntToScalar(share.Share)).PubKey()
+	if resultPubkey.X().Cmp(targetPubkey.X()) != 0 || resultPubkey.Y().Cmp(targetPubkey.Y()) != 0 {
+		return fmt.Errorf("share is not valid")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package secretsharing_test
 
 import (
 	"crypto/rand"
+	"math/big"
 	"testing"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
@@ -38,3 +39,51 @@ func TestSecretSharing(t *testing.T) {
 		t.Fatalf("secret %s does not match recovered secret %s", secret.String(), recoveredSecret.String())
 	}
 }
+
+func TestRefreshWithZeroShares(t *testing.T) {
+	fieldModulus := secp256k1.S256().N
+	secret, err := rand.Int(rand.Reader, fieldModulus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	threshold := 3
+	numberOfShares := 5
+
+	shares, err := secretsharing.SplitSecret(secret, fieldModulus, threshold, numberOfShares)
+	if err != nil {
+		t.Fatal(err)
+	}
+	indices := make([]*big.Int, numberOfShares)
+	for i, share := range shares {
+		indices[i] = share.Index
+	}
+
+	zeroShares, err := secretsharing.SplitZeroWithProofs(fieldModulus, threshold, indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, zeroShare := range zeroShares {
+		if err := secretsharing.ValidateZeroShare(zeroShare); err != nil {
+			t.Fatal(err)
+		}
+		shares[i].Share = new(big.Int).Add(shares[i].Share, zeroShare.Share)
+		shares[i].Share.Mod(shares[i].Share, fieldModulus)
+	}
+
+	tampered := *zeroShares[0]
+	tampered.Share = new(big.Int).Add(tampered.Share, big.NewInt(1))
+	if err := secretsharing.ValidateZeroShare(&tampered); err == nil {
+		t.Fatal("expected tampered share to be invalid")
+	}
+
+	// Any threshold of refreshed shares still recovers the secret.
+	for _, subset := range [][]*secretsharing.SecretShare{shares[:threshold], shares[numberOfShares-threshold:]} {
+		recoveredSecret, err := secretsharing.RecoverSecret(subset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if secret.Cmp(recoveredSecret) != 0 {
+			t.Fatalf("secret %s does not match recovered secret %s", secret.String(), recoveredSecret.String())
+		}
+	}
+}
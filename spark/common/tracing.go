@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -70,18 +71,7 @@ func ConfigureTracing(ctx context.Context, config TracingConfig) (func(context.C
 		return nil, err
 	}
 
-	sampler := trace.TraceIDRatioBased(config.GlobalSamplingRate)
-
-	// If we have span-specific configs, wrap with our custom sampler
-	if len(config.SpanSamplingConfig.PerSpanSamplingRates) > 0 ||
-		len(config.SpanSamplingConfig.AllowList) > 0 ||
-		len(config.SpanSamplingConfig.BlockList) > 0 {
-		sampler = &customSampler{
-			baseSampler:     sampler,
-			spanConfig:      config.SpanSamplingConfig,
-			allowListActive: len(config.SpanSamplingConfig.AllowList) > 0,
-		}
-	}
+	UpdateTracingSampling(config)
 
 	resource, err := resource.Merge(resource.NewWithAttributes(
 		semconv.SchemaURL,
@@ -97,7 +87,7 @@ func ConfigureTracing(ctx context.Context, config TracingConfig) (func(context.C
 			trace.WithBatchTimeout(10*time.Second),
 			trace.WithMaxExportBatchSize(1000),
 		),
-		trace.WithSampler(trace.ParentBased(sampler)),
+		trace.WithSampler(trace.ParentBased(tracingSampler)),
 		trace.WithResource(resource),
 	)
 
@@ -106,6 +96,57 @@ func ConfigureTracing(ctx context.Context, config TracingConfig) (func(context.C
 	return tp.Shutdown, nil
 }
 
+// tracingSampler is the sampler of the tracer provider configured by ConfigureTracing. Its
+// sampling rates are replaced by UpdateTracingSampling.
+var tracingSampler = &reloadableSampler{}
+
+// UpdateTracingSampling replaces the sampling rates of the tracer provider configured by
+// ConfigureTracing with those of config. The other settings of config are ignored.
+func UpdateTracingSampling(config TracingConfig) {
+	tracingSampler.store(newSampler(config))
+}
+
+func newSampler(config TracingConfig) trace.Sampler {
+	sampler := trace.TraceIDRatioBased(config.GlobalSamplingRate)
+
+	// If we have span-specific configs, wrap with our custom sampler
+	if len(config.SpanSamplingConfig.PerSpanSamplingRates) > 0 ||
+		len(config.SpanSamplingConfig.AllowList) > 0 ||
+		len(config.SpanSamplingConfig.BlockList) > 0 {
+		sampler = &customSampler{
+			baseSampler:     sampler,
+			spanConfig:      config.SpanSamplingConfig,
+			allowListActive: len(config.SpanSamplingConfig.AllowList) > 0,
+		}
+	}
+	return sampler
+}
+
+// reloadableSampler delegates to a sampler that can be replaced while spans are started.
+type reloadableSampler struct {
+	sampler atomic.Pointer[trace.Sampler]
+}
+
+func (s *reloadableSampler) store(sampler trace.Sampler) {
+	s.sampler.Store(&sampler)
+}
+
+func (s *reloadableSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	sampler := s.sampler.Load()
+	if sampler == nil {
+		return trace.SamplingResult{Decision: trace.Drop}
+	}
+	return (*sampler).ShouldSample(p)
+}
+
+func (s *reloadableSampler) Description() string {
+	sampler := s.sampler.Load()
+	if sampler == nil {
+		return "ReloadableSampler{}"
+	}
+	return fmt.Sprintf("ReloadableSampler{%s}", (*sampler).Description())
+}
+
 // customSampler implements trace.Sampler interface with advanced filtering
 type customSampler struct {
 	baseSampler     trace.Sampler
@@ -1,6 +1,9 @@
 package spark
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 const (
 	// DKGKeyThreshold is the number of keyshares required to start the DKG.
@@ -9,6 +12,153 @@ const (
 	// DKGKeyCount is the number of keyshares to generate during the DKG.
 	DKGKeyCount = 1000
 
+	// ShareRefreshInterval is how often every keyshare is proactively refreshed.
+	ShareRefreshInterval = 30 * 24 * time.Hour
+
+	// ShareRefreshBatchSize is the number of keyshares to refresh in one round.
+	ShareRefreshBatchSize = 100
+
+	// ReshareBatchSize is the number of keyshares to reshare to a new operator set in one round.
+	ReshareBatchSize = 100
+
+	// DKGRoundTimeout is how long a DKG session waits for a round before it is aborted.
+	DKGRoundTimeout = 2 * time.Minute
+
+	// DKGMaxAttempts is how many DKG sessions the coordinator runs for one batch of keys, each with
+	// fresh randomness, if the previous sessions failed.
+	DKGMaxAttempts = 3
+
+	// DKGBlameAlertThreshold is how many DKG sessions in a row an operator can be blamed for before
+	// the coordinator alerts, as keys cannot be generated until the operator is fixed.
+	DKGBlameAlertThreshold = 3
+
+	// SigningNonceExpiry is how long a signing nonce can be used after it is generated. Unused
+	// nonces are purged after that.
+	SigningNonceExpiry = 24 * time.Hour
+
+	// UsedSigningNonceRetention is how long a used signing nonce is kept, so that retries of the
+	// same signing job get the same signature share.
+	UsedSigningNonceRetention = 1 * time.Hour
+
+	// SigningNoncePurgeBatchSize is the number of signing nonces to purge in one round.
+	SigningNoncePurgeBatchSize = 10000
+
+	// RetentionArchiveBatchSize is the number of transfers or token outputs to archive into one
+	// archive.
+	RetentionArchiveBatchSize = 1000
+
+	// SigningMaxAttempts is how many subsets of the operators the signing coordinator tries before
+	// it gives up on a signing request.
+	SigningMaxAttempts = 3
+
+	// SigningOperatorTimeout is how long the signing coordinator waits for an operator in each
+	// signing round before it fails over to another operator.
+	SigningOperatorTimeout = 30 * time.Second
+
+	// SigningOperatorUnhealthyFailures is the number of consecutive failures after which an
+	// operator is only selected for signing if there are not enough healthy operators.
+	SigningOperatorUnhealthyFailures = 3
+
+	// SigningOperatorCooldown is how long an unhealthy operator is avoided after its last failure.
+	SigningOperatorCooldown = 1 * time.Minute
+
+	// ConsistencyAuditBatchSize is the number of keys of each kind the consistency audit compares
+	// in one round.
+	ConsistencyAuditBatchSize = 100
+
+	// ConsistencyAuditPageSize is the number of records the consistency audit reads in one query.
+	ConsistencyAuditPageSize = 1000
+
+	// ConsistencyAuditSettleTime is how long state must go without updates before the consistency
+	// audit compares it, so that operations still in flight are not reported as discrepancies.
+	ConsistencyAuditSettleTime = 10 * time.Minute
+
+	// IdempotencyKeyRetention is how long the response to a request sent with an idempotency key
+	// is kept for retries of the request.
+	IdempotencyKeyRetention = 24 * time.Hour
+
+	// IdempotencyKeyReservationTimeout is how long a request sent with an idempotency key may run
+	// before its reservation of the key is considered abandoned, for example because the operator
+	// stopped while running it, and a retry may run the request again.
+	IdempotencyKeyReservationTimeout = 5 * time.Minute
+
+	// IdempotencyKeyMaxLength is the maximum length of an idempotency key.
+	IdempotencyKeyMaxLength = 128
+
+	// IdempotencyKeyPurgeBatchSize is the number of expired idempotency keys to purge in one round.
+	IdempotencyKeyPurgeBatchSize = 10000
+
+	// SessionRevocationCacheTTL is how long the result of a session revocation check is cached.
+	SessionRevocationCacheTTL = 10 * time.Second
+
+	// SessionRevocationPollInterval is how often every server looks for revocations made by the
+	// others, and so about how long a revocation takes to reach every server.
+	SessionRevocationPollInterval = time.Second
+
+	// SessionRevocationWatchWindow is how far back the revocations made by other servers are looked
+	// for. It bounds the time between the revocation of a session at the coordinator and the commit
+	// of the revocation at every operator.
+	SessionRevocationWatchWindow = time.Minute
+
+	// SessionRevocationPurgeBatchSize is the number of expired session revocations to purge in one round.
+	SessionRevocationPurgeBatchSize = 10000
+
+	// DelegationGrantMaxDuration is the longest a delegation grant can be valid for.
+	DelegationGrantMaxDuration = 90 * 24 * time.Hour
+
+	// DefaultMaxUnusedDepositAddresses is the default number of deposit addresses an identity can
+	// hold that have not received funds yet.
+	DefaultMaxUnusedDepositAddresses = 100
+
+	// DefaultMaxPendingTreeAddresses is the default number of tree addresses an identity can hold
+	// that have not been used to create a tree yet.
+	DefaultMaxPendingTreeAddresses = 1000
+
+	// DefaultDepositAddressExpiry is the default time after which the keyshare of a deposit address
+	// that has not received funds is recycled.
+	DefaultDepositAddressExpiry = 30 * 24 * time.Hour
+
+	// DepositAddressRecycleGracePeriod is how long past the expiry of a deposit address every
+	// operator must have scanned the chain without seeing funds sent to it before its keyshare is
+	// recycled, so that deposits sent before it expired have confirmed.
+	DepositAddressRecycleGracePeriod = 7 * 24 * time.Hour
+
+	// DepositAddressRecycleBatchSize is the number of expired deposit addresses to recycle in one round.
+	DepositAddressRecycleBatchSize = 1000
+
+	// KeysharePoolDrainWindow is the period over which the rate the keyshare pool drains at is measured.
+	KeysharePoolDrainWindow = 15 * time.Minute
+
+	// KeysharePoolExhaustionAlertHorizon is how soon the keyshare pool must be on course to run out,
+	// at the rate it drained at over the last KeysharePoolDrainWindow, to raise an alert.
+	KeysharePoolExhaustionAlertHorizon = 6 * time.Hour
+
+	// HealthCheckInterval is the interval between two probes of the dependencies of the operator.
+	HealthCheckInterval = 10 * time.Second
+
+	// HealthCheckTimeout is how long a probe of a dependency may take before it counts as failed.
+	HealthCheckTimeout = 5 * time.Second
+
+	// DefaultReadReplicaMaxLag is how far the read replica may lag behind the primary database before
+	// reads go to the primary. The reads of an identity also go to the primary for this long, plus
+	// ReadReplicaLagCheckInterval, after it wrote.
+	DefaultReadReplicaMaxLag = time.Second
+
+	// ReadReplicaLagCheckInterval is the interval between two measurements of the lag of the read
+	// replica.
+	ReadReplicaLagCheckInterval = time.Second
+
+	// DefaultOperatorCallMaxClockSkew is how far the time an operator signed an internal call at may
+	// be from the time it is received at before the call is rejected.
+	DefaultOperatorCallMaxClockSkew = 30 * time.Second
+
+	// OperatorCallNoncePurgeBatchSize is the number of stale operator call nonces to purge in one round.
+	OperatorCallNoncePurgeBatchSize = 10000
+
+	// RecentWritePurgeBatchSize is the number of recent writes the read replica caught up with to
+	// purge in one round.
+	RecentWritePurgeBatchSize = 10000
+
 	// InitialTimeLock is the initial time lock for the deposit.
 	InitialTimeLock = 2000
 
@@ -9,6 +9,7 @@ package common
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -221,11 +222,28 @@ func (x *SigningResult) GetSignatureShare() []byte {
 	return nil
 }
 
+var file_common_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50000,
+		Name:          "common.sensitive",
+		Tag:           "varint,50000,opt,name=sensitive",
+		Filename:      "common.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional bool sensitive = 50000;
+	E_Sensitive = &file_common_proto_extTypes[0]
+)
+
 var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
 	"\n" +
-	"\fcommon.proto\x12\x06common\"\x87\x01\n" +
+	"\fcommon.proto\x12\x06common\x1a google/protobuf/descriptor.proto\"\x87\x01\n" +
 	"\n" +
 	"PackageMap\x12<\n" +
 	"\bpackages\x18\x01 \x03(\v2 .common.PackageMap.PackagesEntryR\bpackages\x1a;\n" +
@@ -243,7 +261,8 @@ const file_common_proto_rawDesc = "" +
 	"\tAGGREGATE\x10\x02\x12\v\n" +
 	"\aREFRESH\x10\x03\x12\n" +
 	"\n" +
-	"\x06EXTEND\x10\x04B-Z+github.com/lightsparkdev/spark/proto/commonb\x06proto3"
+	"\x06EXTEND\x10\x04:=\n" +
+	"\tsensitive\x12\x1d.google.protobuf.FieldOptions\x18І\x03 \x01(\bR\tsensitiveB-Z+github.com/lightsparkdev/spark/proto/commonb\x06proto3"
 
 var (
 	file_common_proto_rawDescOnce sync.Once
@@ -260,18 +279,20 @@ func file_common_proto_rawDescGZIP() []byte {
 var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_common_proto_goTypes = []any{
-	(SignatureIntent)(0),      // 0: common.SignatureIntent
-	(*PackageMap)(nil),        // 1: common.PackageMap
-	(*SigningCommitment)(nil), // 2: common.SigningCommitment
-	(*SigningResult)(nil),     // 3: common.SigningResult
-	nil,                       // 4: common.PackageMap.PackagesEntry
+	(SignatureIntent)(0),              // 0: common.SignatureIntent
+	(*PackageMap)(nil),                // 1: common.PackageMap
+	(*SigningCommitment)(nil),         // 2: common.SigningCommitment
+	(*SigningResult)(nil),             // 3: common.SigningResult
+	nil,                               // 4: common.PackageMap.PackagesEntry
+	(*descriptorpb.FieldOptions)(nil), // 5: google.protobuf.FieldOptions
 }
 var file_common_proto_depIdxs = []int32{
 	4, // 0: common.PackageMap.packages:type_name -> common.PackageMap.PackagesEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
+	5, // 1: common.sensitive:extendee -> google.protobuf.FieldOptions
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	1, // [1:2] is the sub-list for extension extendee
 	0, // [0:1] is the sub-list for field type_name
 }
 
@@ -287,13 +308,14 @@ func file_common_proto_init() {
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      1,
 			NumMessages:   4,
-			NumExtensions: 0,
+			NumExtensions: 1,
 			NumServices:   0,
 		},
 		GoTypes:           file_common_proto_goTypes,
 		DependencyIndexes: file_common_proto_depIdxs,
 		EnumInfos:         file_common_proto_enumTypes,
 		MessageInfos:      file_common_proto_msgTypes,
+		ExtensionInfos:    file_common_proto_extTypes,
 	}.Build()
 	File_common_proto = out.File
 	file_common_proto_goTypes = nil
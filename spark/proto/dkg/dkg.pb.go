@@ -23,6 +23,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DkgSessionStatus int32
+
+const (
+	DkgSessionStatus_DKG_SESSION_STATUS_UNSPECIFIED DkgSessionStatus = 0
+	DkgSessionStatus_DKG_SESSION_STATUS_IN_PROGRESS DkgSessionStatus = 1
+	DkgSessionStatus_DKG_SESSION_STATUS_COMPLETED   DkgSessionStatus = 2
+	DkgSessionStatus_DKG_SESSION_STATUS_ABORTED     DkgSessionStatus = 3
+)
+
+// Enum value maps for DkgSessionStatus.
+var (
+	DkgSessionStatus_name = map[int32]string{
+		0: "DKG_SESSION_STATUS_UNSPECIFIED",
+		1: "DKG_SESSION_STATUS_IN_PROGRESS",
+		2: "DKG_SESSION_STATUS_COMPLETED",
+		3: "DKG_SESSION_STATUS_ABORTED",
+	}
+	DkgSessionStatus_value = map[string]int32{
+		"DKG_SESSION_STATUS_UNSPECIFIED": 0,
+		"DKG_SESSION_STATUS_IN_PROGRESS": 1,
+		"DKG_SESSION_STATUS_COMPLETED":   2,
+		"DKG_SESSION_STATUS_ABORTED":     3,
+	}
+)
+
+func (x DkgSessionStatus) Enum() *DkgSessionStatus {
+	p := new(DkgSessionStatus)
+	*p = x
+	return p
+}
+
+func (x DkgSessionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DkgSessionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_dkg_proto_enumTypes[0].Descriptor()
+}
+
+func (DkgSessionStatus) Type() protoreflect.EnumType {
+	return &file_dkg_proto_enumTypes[0]
+}
+
+func (x DkgSessionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DkgSessionStatus.Descriptor instead.
+func (DkgSessionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{0}
+}
+
 // Initiate Distributed Key Generation Request
 type InitiateDkgRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -36,8 +88,12 @@ type InitiateDkgRequest struct {
 	MaxSigners uint64 `protobuf:"varint,4,opt,name=max_signers,json=maxSigners,proto3" json:"max_signers,omitempty"`
 	// The coordinator index.
 	CoordinatorIndex uint64 `protobuf:"varint,5,opt,name=coordinator_index,json=coordinatorIndex,proto3" json:"coordinator_index,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// The identifiers of the participants, max_signers of them. They must be all the signing
+	// operators, as keys held by only some of them could not be used by the others. All signing
+	// operators participate if empty.
+	ParticipantIdentifiers []string `protobuf:"bytes,6,rep,name=participant_identifiers,json=participantIdentifiers,proto3" json:"participant_identifiers,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *InitiateDkgRequest) Reset() {
@@ -105,6 +161,13 @@ func (x *InitiateDkgRequest) GetCoordinatorIndex() uint64 {
 	return 0
 }
 
+func (x *InitiateDkgRequest) GetParticipantIdentifiers() []string {
+	if x != nil {
+		return x.ParticipantIdentifiers
+	}
+	return nil
+}
+
 // Initiate Distributed Key Generation Response
 type InitiateDkgResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -486,6 +549,231 @@ func (*Round2PackagesResponse) Descriptor() ([]byte, []int) {
 	return file_dkg_proto_rawDescGZIP(), []int{7}
 }
 
+// A participant's complaint about another participant.
+type DkgComplaint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The identifier of the participant that is blamed.
+	AccusedIdentifier string `protobuf:"bytes,1,opt,name=accused_identifier,json=accusedIdentifier,proto3" json:"accused_identifier,omitempty"`
+	// Why the participant is blamed.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DkgComplaint) Reset() {
+	*x = DkgComplaint{}
+	mi := &file_dkg_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DkgComplaint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DkgComplaint) ProtoMessage() {}
+
+func (x *DkgComplaint) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DkgComplaint.ProtoReflect.Descriptor instead.
+func (*DkgComplaint) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DkgComplaint) GetAccusedIdentifier() string {
+	if x != nil {
+		return x.AccusedIdentifier
+	}
+	return ""
+}
+
+func (x *DkgComplaint) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetDkgSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId     string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDkgSessionRequest) Reset() {
+	*x = GetDkgSessionRequest{}
+	mi := &file_dkg_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDkgSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDkgSessionRequest) ProtoMessage() {}
+
+func (x *GetDkgSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDkgSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetDkgSessionRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetDkgSessionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetDkgSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The identifier of the participant.
+	Identifier string           `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Status     DkgSessionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=dkg.DkgSessionStatus" json:"status,omitempty"`
+	// The participants this participant blames for aborting the session.
+	Complaints []*DkgComplaint `protobuf:"bytes,3,rep,name=complaints,proto3" json:"complaints,omitempty"`
+	// Why the session was aborted.
+	AbortReason   string `protobuf:"bytes,4,opt,name=abort_reason,json=abortReason,proto3" json:"abort_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDkgSessionResponse) Reset() {
+	*x = GetDkgSessionResponse{}
+	mi := &file_dkg_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDkgSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDkgSessionResponse) ProtoMessage() {}
+
+func (x *GetDkgSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDkgSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetDkgSessionResponse) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetDkgSessionResponse) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *GetDkgSessionResponse) GetStatus() DkgSessionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DkgSessionStatus_DKG_SESSION_STATUS_UNSPECIFIED
+}
+
+func (x *GetDkgSessionResponse) GetComplaints() []*DkgComplaint {
+	if x != nil {
+		return x.Complaints
+	}
+	return nil
+}
+
+func (x *GetDkgSessionResponse) GetAbortReason() string {
+	if x != nil {
+		return x.AbortReason
+	}
+	return ""
+}
+
+type AbortDkgRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Why the session is aborted.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortDkgRequest) Reset() {
+	*x = AbortDkgRequest{}
+	mi := &file_dkg_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortDkgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortDkgRequest) ProtoMessage() {}
+
+func (x *AbortDkgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortDkgRequest.ProtoReflect.Descriptor instead.
+func (*AbortDkgRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AbortDkgRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AbortDkgRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type StartDkgRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The number of participants in the signing group.
@@ -496,7 +784,7 @@ type StartDkgRequest struct {
 
 func (x *StartDkgRequest) Reset() {
 	*x = StartDkgRequest{}
-	mi := &file_dkg_proto_msgTypes[8]
+	mi := &file_dkg_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -508,7 +796,7 @@ func (x *StartDkgRequest) String() string {
 func (*StartDkgRequest) ProtoMessage() {}
 
 func (x *StartDkgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dkg_proto_msgTypes[8]
+	mi := &file_dkg_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +809,7 @@ func (x *StartDkgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartDkgRequest.ProtoReflect.Descriptor instead.
 func (*StartDkgRequest) Descriptor() ([]byte, []int) {
-	return file_dkg_proto_rawDescGZIP(), []int{8}
+	return file_dkg_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *StartDkgRequest) GetCount() int32 {
@@ -531,64 +819,1287 @@ func (x *StartDkgRequest) GetCount() int32 {
 	return 0
 }
 
-var File_dkg_proto protoreflect.FileDescriptor
+type StartShareRefreshRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of keyshares to refresh.
+	BatchSize     uint32 `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_dkg_proto_rawDesc = "" +
-	"\n" +
-	"\tdkg.proto\x12\x03dkg\x1a\fcommon.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xbf\x01\n" +
-	"\x12InitiateDkgRequest\x12\x1d\n" +
-	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1b\n" +
-	"\tkey_count\x18\x02 \x01(\x04R\bkeyCount\x12\x1f\n" +
-	"\vmin_signers\x18\x03 \x01(\x04R\n" +
-	"minSigners\x12\x1f\n" +
-	"\vmax_signers\x18\x04 \x01(\x04R\n" +
-	"maxSigners\x12+\n" +
-	"\x11coordinator_index\x18\x05 \x01(\x04R\x10coordinatorIndex\"\\\n" +
-	"\x13InitiateDkgResponse\x12\x1e\n" +
-	"\n" +
-	"identifier\x18\x01 \x01(\tR\n" +
-	"identifier\x12%\n" +
-	"\x0eround1_package\x18\x02 \x03(\fR\rround1Package\"s\n" +
-	"\x15Round1PackagesRequest\x12\x1d\n" +
-	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\x12;\n" +
-	"\x0fround1_packages\x18\x02 \x03(\v2\x12.common.PackageMapR\x0eround1Packages\"c\n" +
-	"\x16Round1PackagesResponse\x12\x1e\n" +
-	"\n" +
-	"identifier\x18\x01 \x01(\tR\n" +
-	"identifier\x12)\n" +
-	"\x10round1_signature\x18\x02 \x01(\fR\x0fround1Signature\"\xdc\x01\n" +
-	"\x16Round1SignatureRequest\x12\x1d\n" +
-	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\x12^\n" +
-	"\x11round1_signatures\x18\x02 \x03(\v21.dkg.Round1SignatureRequest.Round1SignaturesEntryR\x10round1Signatures\x1aC\n" +
-	"\x15Round1SignaturesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"j\n" +
-	"\x17Round1SignatureResponse\x12\x1e\n" +
-	"\n" +
-	"identifier\x18\x01 \x01(\tR\n" +
-	"identifier\x12/\n" +
-	"\x13validation_failures\x18\x02 \x03(\tR\x12validationFailures\"\xaa\x01\n" +
-	"\x15Round2PackagesRequest\x12\x1d\n" +
-	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1e\n" +
-	"\n" +
-	"identifier\x18\x02 \x01(\tR\n" +
-	"identifier\x12'\n" +
-	"\x0fround2_packages\x18\x03 \x03(\fR\x0eround2Packages\x12)\n" +
-	"\x10round2_signature\x18\x04 \x01(\fR\x0fround2Signature\"\x18\n" +
-	"\x16Round2PackagesResponse\"'\n" +
-	"\x0fStartDkgRequest\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x05R\x05count2\xfb\x02\n" +
+func (x *StartShareRefreshRequest) Reset() {
+	*x = StartShareRefreshRequest{}
+	mi := &file_dkg_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartShareRefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartShareRefreshRequest) ProtoMessage() {}
+
+func (x *StartShareRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartShareRefreshRequest.ProtoReflect.Descriptor instead.
+func (*StartShareRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StartShareRefreshRequest) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type StartShareRefreshResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of keyshares that were refreshed.
+	RefreshedCount uint32 `protobuf:"varint,1,opt,name=refreshed_count,json=refreshedCount,proto3" json:"refreshed_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartShareRefreshResponse) Reset() {
+	*x = StartShareRefreshResponse{}
+	mi := &file_dkg_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartShareRefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartShareRefreshResponse) ProtoMessage() {}
+
+func (x *StartShareRefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartShareRefreshResponse.ProtoReflect.Descriptor instead.
+func (*StartShareRefreshResponse) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StartShareRefreshResponse) GetRefreshedCount() uint32 {
+	if x != nil {
+		return x.RefreshedCount
+	}
+	return 0
+}
+
+type InitiateShareRefreshRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The ids of the keyshares to refresh.
+	KeyshareIds   []string `protobuf:"bytes,2,rep,name=keyshare_ids,json=keyshareIds,proto3" json:"keyshare_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateShareRefreshRequest) Reset() {
+	*x = InitiateShareRefreshRequest{}
+	mi := &file_dkg_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateShareRefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateShareRefreshRequest) ProtoMessage() {}
+
+func (x *InitiateShareRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateShareRefreshRequest.ProtoReflect.Descriptor instead.
+func (*InitiateShareRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InitiateShareRefreshRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *InitiateShareRefreshRequest) GetKeyshareIds() []string {
+	if x != nil {
+		return x.KeyshareIds
+	}
+	return nil
+}
+
+// The shares of zero a participant dealt for one keyshare.
+type ShareRefreshPackage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Commitments to the coefficients of the polynomial, except the constant one which is zero.
+	Commitments [][]byte `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// The share for each operator, encrypted to its identity public key, keyed by identifier.
+	EncryptedShares map[string][]byte `protobuf:"bytes,2,rep,name=encrypted_shares,json=encryptedShares,proto3" json:"encrypted_shares,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShareRefreshPackage) Reset() {
+	*x = ShareRefreshPackage{}
+	mi := &file_dkg_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareRefreshPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareRefreshPackage) ProtoMessage() {}
+
+func (x *ShareRefreshPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareRefreshPackage.ProtoReflect.Descriptor instead.
+func (*ShareRefreshPackage) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ShareRefreshPackage) GetCommitments() [][]byte {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+func (x *ShareRefreshPackage) GetEncryptedShares() map[string][]byte {
+	if x != nil {
+		return x.EncryptedShares
+	}
+	return nil
+}
+
+type ShareRefreshContribution struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The identifier of the participant.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// One package per keyshare, in the order of the request.
+	Packages []*ShareRefreshPackage `protobuf:"bytes,2,rep,name=packages,proto3" json:"packages,omitempty"`
+	// The signature by the participant on the hash of the request id, keyshare ids and packages.
+	Signature     []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareRefreshContribution) Reset() {
+	*x = ShareRefreshContribution{}
+	mi := &file_dkg_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareRefreshContribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareRefreshContribution) ProtoMessage() {}
+
+func (x *ShareRefreshContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareRefreshContribution.ProtoReflect.Descriptor instead.
+func (*ShareRefreshContribution) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ShareRefreshContribution) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *ShareRefreshContribution) GetPackages() []*ShareRefreshPackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *ShareRefreshContribution) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type InitiateShareRefreshResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Contribution  *ShareRefreshContribution `protobuf:"bytes,1,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateShareRefreshResponse) Reset() {
+	*x = InitiateShareRefreshResponse{}
+	mi := &file_dkg_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateShareRefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateShareRefreshResponse) ProtoMessage() {}
+
+func (x *InitiateShareRefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateShareRefreshResponse.ProtoReflect.Descriptor instead.
+func (*InitiateShareRefreshResponse) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *InitiateShareRefreshResponse) GetContribution() *ShareRefreshContribution {
+	if x != nil {
+		return x.Contribution
+	}
+	return nil
+}
+
+type PrepareShareRefreshRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The contributions of all participants.
+	Contributions []*ShareRefreshContribution `protobuf:"bytes,2,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	// The ids of the keyshares to refresh, as in the initiate request.
+	KeyshareIds   []string `protobuf:"bytes,3,rep,name=keyshare_ids,json=keyshareIds,proto3" json:"keyshare_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareShareRefreshRequest) Reset() {
+	*x = PrepareShareRefreshRequest{}
+	mi := &file_dkg_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareShareRefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareShareRefreshRequest) ProtoMessage() {}
+
+func (x *PrepareShareRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareShareRefreshRequest.ProtoReflect.Descriptor instead.
+func (*PrepareShareRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PrepareShareRefreshRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PrepareShareRefreshRequest) GetContributions() []*ShareRefreshContribution {
+	if x != nil {
+		return x.Contributions
+	}
+	return nil
+}
+
+func (x *PrepareShareRefreshRequest) GetKeyshareIds() []string {
+	if x != nil {
+		return x.KeyshareIds
+	}
+	return nil
+}
+
+type CommitShareRefreshRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId     string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitShareRefreshRequest) Reset() {
+	*x = CommitShareRefreshRequest{}
+	mi := &file_dkg_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitShareRefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitShareRefreshRequest) ProtoMessage() {}
+
+func (x *CommitShareRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitShareRefreshRequest.ProtoReflect.Descriptor instead.
+func (*CommitShareRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CommitShareRefreshRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type CompleteShareRefreshRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId     string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteShareRefreshRequest) Reset() {
+	*x = CompleteShareRefreshRequest{}
+	mi := &file_dkg_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteShareRefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteShareRefreshRequest) ProtoMessage() {}
+
+func (x *CompleteShareRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteShareRefreshRequest.ProtoReflect.Descriptor instead.
+func (*CompleteShareRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CompleteShareRefreshRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type AbortShareRefreshRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId     string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortShareRefreshRequest) Reset() {
+	*x = AbortShareRefreshRequest{}
+	mi := &file_dkg_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortShareRefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortShareRefreshRequest) ProtoMessage() {}
+
+func (x *AbortShareRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortShareRefreshRequest.ProtoReflect.Descriptor instead.
+func (*AbortShareRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AbortShareRefreshRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type StartReshareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of keyshares to reshare.
+	BatchSize     uint32 `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartReshareRequest) Reset() {
+	*x = StartReshareRequest{}
+	mi := &file_dkg_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartReshareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReshareRequest) ProtoMessage() {}
+
+func (x *StartReshareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReshareRequest.ProtoReflect.Descriptor instead.
+func (*StartReshareRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StartReshareRequest) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type StartReshareResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of keyshares that were reshared.
+	ResharedCount uint32 `protobuf:"varint,1,opt,name=reshared_count,json=resharedCount,proto3" json:"reshared_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartReshareResponse) Reset() {
+	*x = StartReshareResponse{}
+	mi := &file_dkg_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartReshareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReshareResponse) ProtoMessage() {}
+
+func (x *StartReshareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReshareResponse.ProtoReflect.Descriptor instead.
+func (*StartReshareResponse) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StartReshareResponse) GetResharedCount() uint32 {
+	if x != nil {
+		return x.ResharedCount
+	}
+	return 0
+}
+
+// The keyshare being reshared, as the coordinator has it.
+type ReshareKeyshare struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the keyshare.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The public key of the keyshare.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The status of the keyshare.
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// The coordinator index of the keyshare.
+	CoordinatorIndex uint64 `protobuf:"varint,4,opt,name=coordinator_index,json=coordinatorIndex,proto3" json:"coordinator_index,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReshareKeyshare) Reset() {
+	*x = ReshareKeyshare{}
+	mi := &file_dkg_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReshareKeyshare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReshareKeyshare) ProtoMessage() {}
+
+func (x *ReshareKeyshare) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReshareKeyshare.ProtoReflect.Descriptor instead.
+func (*ReshareKeyshare) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReshareKeyshare) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReshareKeyshare) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ReshareKeyshare) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReshareKeyshare) GetCoordinatorIndex() uint64 {
+	if x != nil {
+		return x.CoordinatorIndex
+	}
+	return 0
+}
+
+type InitiateReshareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The keyshares to reshare.
+	Keyshares []*ReshareKeyshare `protobuf:"bytes,2,rep,name=keyshares,proto3" json:"keyshares,omitempty"`
+	// The identifiers of the operators of the previous operator set that deal the new shares.
+	DealerIdentifiers []string `protobuf:"bytes,3,rep,name=dealer_identifiers,json=dealerIdentifiers,proto3" json:"dealer_identifiers,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InitiateReshareRequest) Reset() {
+	*x = InitiateReshareRequest{}
+	mi := &file_dkg_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateReshareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateReshareRequest) ProtoMessage() {}
+
+func (x *InitiateReshareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateReshareRequest.ProtoReflect.Descriptor instead.
+func (*InitiateReshareRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InitiateReshareRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *InitiateReshareRequest) GetKeyshares() []*ReshareKeyshare {
+	if x != nil {
+		return x.Keyshares
+	}
+	return nil
+}
+
+func (x *InitiateReshareRequest) GetDealerIdentifiers() []string {
+	if x != nil {
+		return x.DealerIdentifiers
+	}
+	return nil
+}
+
+// The shares a dealer dealt for one keyshare.
+type ResharePackage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Commitments to the coefficients of the polynomial, starting with the constant one, which is
+	// the weighted share of the dealer.
+	Commitments [][]byte `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// The share for each operator of the current set, encrypted to its identity public key, keyed
+	// by identifier.
+	EncryptedShares map[string][]byte `protobuf:"bytes,2,rep,name=encrypted_shares,json=encryptedShares,proto3" json:"encrypted_shares,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResharePackage) Reset() {
+	*x = ResharePackage{}
+	mi := &file_dkg_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResharePackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResharePackage) ProtoMessage() {}
+
+func (x *ResharePackage) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResharePackage.ProtoReflect.Descriptor instead.
+func (*ResharePackage) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ResharePackage) GetCommitments() [][]byte {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+func (x *ResharePackage) GetEncryptedShares() map[string][]byte {
+	if x != nil {
+		return x.EncryptedShares
+	}
+	return nil
+}
+
+type ReshareContribution struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The identifier of the dealer.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// One package per keyshare, in the order of the request.
+	Packages []*ResharePackage `protobuf:"bytes,2,rep,name=packages,proto3" json:"packages,omitempty"`
+	// The signature by the dealer on the hash of the request and its packages.
+	Signature     []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReshareContribution) Reset() {
+	*x = ReshareContribution{}
+	mi := &file_dkg_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReshareContribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReshareContribution) ProtoMessage() {}
+
+func (x *ReshareContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReshareContribution.ProtoReflect.Descriptor instead.
+func (*ReshareContribution) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReshareContribution) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *ReshareContribution) GetPackages() []*ResharePackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *ReshareContribution) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type InitiateReshareResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The contribution of the participant, empty if it is not a dealer.
+	Contribution  *ReshareContribution `protobuf:"bytes,1,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateReshareResponse) Reset() {
+	*x = InitiateReshareResponse{}
+	mi := &file_dkg_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateReshareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateReshareResponse) ProtoMessage() {}
+
+func (x *InitiateReshareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateReshareResponse.ProtoReflect.Descriptor instead.
+func (*InitiateReshareResponse) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InitiateReshareResponse) GetContribution() *ReshareContribution {
+	if x != nil {
+		return x.Contribution
+	}
+	return nil
+}
+
+type PrepareReshareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The contributions of all dealers.
+	Contributions []*ReshareContribution `protobuf:"bytes,2,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	// The keyshares to reshare, as in the initiate request.
+	Keyshares []*ReshareKeyshare `protobuf:"bytes,3,rep,name=keyshares,proto3" json:"keyshares,omitempty"`
+	// The identifiers of the dealers, as in the initiate request.
+	DealerIdentifiers []string `protobuf:"bytes,4,rep,name=dealer_identifiers,json=dealerIdentifiers,proto3" json:"dealer_identifiers,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PrepareReshareRequest) Reset() {
+	*x = PrepareReshareRequest{}
+	mi := &file_dkg_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareReshareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareReshareRequest) ProtoMessage() {}
+
+func (x *PrepareReshareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareReshareRequest.ProtoReflect.Descriptor instead.
+func (*PrepareReshareRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PrepareReshareRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PrepareReshareRequest) GetContributions() []*ReshareContribution {
+	if x != nil {
+		return x.Contributions
+	}
+	return nil
+}
+
+func (x *PrepareReshareRequest) GetKeyshares() []*ReshareKeyshare {
+	if x != nil {
+		return x.Keyshares
+	}
+	return nil
+}
+
+func (x *PrepareReshareRequest) GetDealerIdentifiers() []string {
+	if x != nil {
+		return x.DealerIdentifiers
+	}
+	return nil
+}
+
+type CommitReshareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId     string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReshareRequest) Reset() {
+	*x = CommitReshareRequest{}
+	mi := &file_dkg_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReshareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReshareRequest) ProtoMessage() {}
+
+func (x *CommitReshareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReshareRequest.ProtoReflect.Descriptor instead.
+func (*CommitReshareRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CommitReshareRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type CompleteReshareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId     string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteReshareRequest) Reset() {
+	*x = CompleteReshareRequest{}
+	mi := &file_dkg_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteReshareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteReshareRequest) ProtoMessage() {}
+
+func (x *CompleteReshareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteReshareRequest.ProtoReflect.Descriptor instead.
+func (*CompleteReshareRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CompleteReshareRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type AbortReshareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An uuid to identify the request.
+	RequestId     string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortReshareRequest) Reset() {
+	*x = AbortReshareRequest{}
+	mi := &file_dkg_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortReshareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortReshareRequest) ProtoMessage() {}
+
+func (x *AbortReshareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dkg_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortReshareRequest.ProtoReflect.Descriptor instead.
+func (*AbortReshareRequest) Descriptor() ([]byte, []int) {
+	return file_dkg_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AbortReshareRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+var File_dkg_proto protoreflect.FileDescriptor
+
+const file_dkg_proto_rawDesc = "" +
+	"\n" +
+	"\tdkg.proto\x12\x03dkg\x1a\fcommon.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xf8\x01\n" +
+	"\x12InitiateDkgRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1b\n" +
+	"\tkey_count\x18\x02 \x01(\x04R\bkeyCount\x12\x1f\n" +
+	"\vmin_signers\x18\x03 \x01(\x04R\n" +
+	"minSigners\x12\x1f\n" +
+	"\vmax_signers\x18\x04 \x01(\x04R\n" +
+	"maxSigners\x12+\n" +
+	"\x11coordinator_index\x18\x05 \x01(\x04R\x10coordinatorIndex\x127\n" +
+	"\x17participant_identifiers\x18\x06 \x03(\tR\x16participantIdentifiers\"\\\n" +
+	"\x13InitiateDkgResponse\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x12%\n" +
+	"\x0eround1_package\x18\x02 \x03(\fR\rround1Package\"s\n" +
+	"\x15Round1PackagesRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12;\n" +
+	"\x0fround1_packages\x18\x02 \x03(\v2\x12.common.PackageMapR\x0eround1Packages\"c\n" +
+	"\x16Round1PackagesResponse\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x12)\n" +
+	"\x10round1_signature\x18\x02 \x01(\fR\x0fround1Signature\"\xdc\x01\n" +
+	"\x16Round1SignatureRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12^\n" +
+	"\x11round1_signatures\x18\x02 \x03(\v21.dkg.Round1SignatureRequest.Round1SignaturesEntryR\x10round1Signatures\x1aC\n" +
+	"\x15Round1SignaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"j\n" +
+	"\x17Round1SignatureResponse\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x12/\n" +
+	"\x13validation_failures\x18\x02 \x03(\tR\x12validationFailures\"\xb0\x01\n" +
+	"\x15Round2PackagesRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x02 \x01(\tR\n" +
+	"identifier\x12-\n" +
+	"\x0fround2_packages\x18\x03 \x03(\fB\x04\x80\xb5\x18\x01R\x0eround2Packages\x12)\n" +
+	"\x10round2_signature\x18\x04 \x01(\fR\x0fround2Signature\"\x18\n" +
+	"\x16Round2PackagesResponse\"U\n" +
+	"\fDkgComplaint\x12-\n" +
+	"\x12accused_identifier\x18\x01 \x01(\tR\x11accusedIdentifier\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"5\n" +
+	"\x14GetDkgSessionRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"\xbc\x01\n" +
+	"\x15GetDkgSessionResponse\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x12-\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x15.dkg.DkgSessionStatusR\x06status\x121\n" +
+	"\n" +
+	"complaints\x18\x03 \x03(\v2\x11.dkg.DkgComplaintR\n" +
+	"complaints\x12!\n" +
+	"\fabort_reason\x18\x04 \x01(\tR\vabortReason\"H\n" +
+	"\x0fAbortDkgRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"'\n" +
+	"\x0fStartDkgRequest\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"9\n" +
+	"\x18StartShareRefreshRequest\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x01 \x01(\rR\tbatchSize\"D\n" +
+	"\x19StartShareRefreshResponse\x12'\n" +
+	"\x0frefreshed_count\x18\x01 \x01(\rR\x0erefreshedCount\"_\n" +
+	"\x1bInitiateShareRefreshRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12!\n" +
+	"\fkeyshare_ids\x18\x02 \x03(\tR\vkeyshareIds\"\xdb\x01\n" +
+	"\x13ShareRefreshPackage\x12 \n" +
+	"\vcommitments\x18\x01 \x03(\fR\vcommitments\x12^\n" +
+	"\x10encrypted_shares\x18\x02 \x03(\v2-.dkg.ShareRefreshPackage.EncryptedSharesEntryB\x04\x80\xb5\x18\x01R\x0fencryptedShares\x1aB\n" +
+	"\x14EncryptedSharesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\x8e\x01\n" +
+	"\x18ShareRefreshContribution\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x124\n" +
+	"\bpackages\x18\x02 \x03(\v2\x18.dkg.ShareRefreshPackageR\bpackages\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\"a\n" +
+	"\x1cInitiateShareRefreshResponse\x12A\n" +
+	"\fcontribution\x18\x01 \x01(\v2\x1d.dkg.ShareRefreshContributionR\fcontribution\"\xa3\x01\n" +
+	"\x1aPrepareShareRefreshRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12C\n" +
+	"\rcontributions\x18\x02 \x03(\v2\x1d.dkg.ShareRefreshContributionR\rcontributions\x12!\n" +
+	"\fkeyshare_ids\x18\x03 \x03(\tR\vkeyshareIds\":\n" +
+	"\x19CommitShareRefreshRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"<\n" +
+	"\x1bCompleteShareRefreshRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"9\n" +
+	"\x18AbortShareRefreshRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"4\n" +
+	"\x13StartReshareRequest\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x01 \x01(\rR\tbatchSize\"=\n" +
+	"\x14StartReshareResponse\x12%\n" +
+	"\x0ereshared_count\x18\x01 \x01(\rR\rresharedCount\"\x85\x01\n" +
+	"\x0fReshareKeyshare\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\fR\tpublicKey\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12+\n" +
+	"\x11coordinator_index\x18\x04 \x01(\x04R\x10coordinatorIndex\"\x9a\x01\n" +
+	"\x16InitiateReshareRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x122\n" +
+	"\tkeyshares\x18\x02 \x03(\v2\x14.dkg.ReshareKeyshareR\tkeyshares\x12-\n" +
+	"\x12dealer_identifiers\x18\x03 \x03(\tR\x11dealerIdentifiers\"\xd1\x01\n" +
+	"\x0eResharePackage\x12 \n" +
+	"\vcommitments\x18\x01 \x03(\fR\vcommitments\x12Y\n" +
+	"\x10encrypted_shares\x18\x02 \x03(\v2(.dkg.ResharePackage.EncryptedSharesEntryB\x04\x80\xb5\x18\x01R\x0fencryptedShares\x1aB\n" +
+	"\x14EncryptedSharesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\x84\x01\n" +
+	"\x13ReshareContribution\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x12/\n" +
+	"\bpackages\x18\x02 \x03(\v2\x13.dkg.ResharePackageR\bpackages\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\"W\n" +
+	"\x17InitiateReshareResponse\x12<\n" +
+	"\fcontribution\x18\x01 \x01(\v2\x18.dkg.ReshareContributionR\fcontribution\"\xd9\x01\n" +
+	"\x15PrepareReshareRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12>\n" +
+	"\rcontributions\x18\x02 \x03(\v2\x18.dkg.ReshareContributionR\rcontributions\x122\n" +
+	"\tkeyshares\x18\x03 \x03(\v2\x14.dkg.ReshareKeyshareR\tkeyshares\x12-\n" +
+	"\x12dealer_identifiers\x18\x04 \x03(\tR\x11dealerIdentifiers\"5\n" +
+	"\x14CommitReshareRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"7\n" +
+	"\x16CompleteReshareRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"4\n" +
+	"\x13AbortReshareRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId*\x9c\x01\n" +
+	"\x10DkgSessionStatus\x12\"\n" +
+	"\x1eDKG_SESSION_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eDKG_SESSION_STATUS_IN_PROGRESS\x10\x01\x12 \n" +
+	"\x1cDKG_SESSION_STATUS_COMPLETED\x10\x02\x12\x1e\n" +
+	"\x1aDKG_SESSION_STATUS_ABORTED\x10\x032\xc2\v\n" +
 	"\n" +
 	"DKGService\x12;\n" +
 	"\tstart_dkg\x12\x14.dkg.StartDkgRequest\x1a\x16.google.protobuf.Empty\"\x00\x12C\n" +
 	"\finitiate_dkg\x12\x17.dkg.InitiateDkgRequest\x1a\x18.dkg.InitiateDkgResponse\"\x00\x12L\n" +
 	"\x0fround1_packages\x12\x1a.dkg.Round1PackagesRequest\x1a\x1b.dkg.Round1PackagesResponse\"\x00\x12O\n" +
 	"\x10round1_signature\x12\x1b.dkg.Round1SignatureRequest\x1a\x1c.dkg.Round1SignatureResponse\"\x00\x12L\n" +
-	"\x0fround2_packages\x12\x1a.dkg.Round2PackagesRequest\x1a\x1b.dkg.Round2PackagesResponse\"\x00B*Z(github.com/lightsparkdev/spark/proto/dkgb\x06proto3"
+	"\x0fround2_packages\x12\x1a.dkg.Round2PackagesRequest\x1a\x1b.dkg.Round2PackagesResponse\"\x00\x12J\n" +
+	"\x0fget_dkg_session\x12\x19.dkg.GetDkgSessionRequest\x1a\x1a.dkg.GetDkgSessionResponse\"\x00\x12;\n" +
+	"\tabort_dkg\x12\x14.dkg.AbortDkgRequest\x1a\x16.google.protobuf.Empty\"\x00\x12V\n" +
+	"\x13start_share_refresh\x12\x1d.dkg.StartShareRefreshRequest\x1a\x1e.dkg.StartShareRefreshResponse\"\x00\x12_\n" +
+	"\x16initiate_share_refresh\x12 .dkg.InitiateShareRefreshRequest\x1a!.dkg.InitiateShareRefreshResponse\"\x00\x12R\n" +
+	"\x15prepare_share_refresh\x12\x1f.dkg.PrepareShareRefreshRequest\x1a\x16.google.protobuf.Empty\"\x00\x12P\n" +
+	"\x14commit_share_refresh\x12\x1e.dkg.CommitShareRefreshRequest\x1a\x16.google.protobuf.Empty\"\x00\x12T\n" +
+	"\x16complete_share_refresh\x12 .dkg.CompleteShareRefreshRequest\x1a\x16.google.protobuf.Empty\"\x00\x12N\n" +
+	"\x13abort_share_refresh\x12\x1d.dkg.AbortShareRefreshRequest\x1a\x16.google.protobuf.Empty\"\x00\x12F\n" +
+	"\rstart_reshare\x12\x18.dkg.StartReshareRequest\x1a\x19.dkg.StartReshareResponse\"\x00\x12O\n" +
+	"\x10initiate_reshare\x12\x1b.dkg.InitiateReshareRequest\x1a\x1c.dkg.InitiateReshareResponse\"\x00\x12G\n" +
+	"\x0fprepare_reshare\x12\x1a.dkg.PrepareReshareRequest\x1a\x16.google.protobuf.Empty\"\x00\x12E\n" +
+	"\x0ecommit_reshare\x12\x19.dkg.CommitReshareRequest\x1a\x16.google.protobuf.Empty\"\x00\x12I\n" +
+	"\x10complete_reshare\x12\x1b.dkg.CompleteReshareRequest\x1a\x16.google.protobuf.Empty\"\x00\x12C\n" +
+	"\rabort_reshare\x12\x18.dkg.AbortReshareRequest\x1a\x16.google.protobuf.Empty\"\x00B*Z(github.com/lightsparkdev/spark/proto/dkgb\x06proto3"
 
 var (
 	file_dkg_proto_rawDescOnce sync.Once
@@ -602,39 +2113,108 @@ func file_dkg_proto_rawDescGZIP() []byte {
 	return file_dkg_proto_rawDescData
 }
 
-var file_dkg_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_dkg_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_dkg_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_dkg_proto_goTypes = []any{
-	(*InitiateDkgRequest)(nil),      // 0: dkg.InitiateDkgRequest
-	(*InitiateDkgResponse)(nil),     // 1: dkg.InitiateDkgResponse
-	(*Round1PackagesRequest)(nil),   // 2: dkg.Round1PackagesRequest
-	(*Round1PackagesResponse)(nil),  // 3: dkg.Round1PackagesResponse
-	(*Round1SignatureRequest)(nil),  // 4: dkg.Round1SignatureRequest
-	(*Round1SignatureResponse)(nil), // 5: dkg.Round1SignatureResponse
-	(*Round2PackagesRequest)(nil),   // 6: dkg.Round2PackagesRequest
-	(*Round2PackagesResponse)(nil),  // 7: dkg.Round2PackagesResponse
-	(*StartDkgRequest)(nil),         // 8: dkg.StartDkgRequest
-	nil,                             // 9: dkg.Round1SignatureRequest.Round1SignaturesEntry
-	(*common.PackageMap)(nil),       // 10: common.PackageMap
-	(*emptypb.Empty)(nil),           // 11: google.protobuf.Empty
+	(DkgSessionStatus)(0),                // 0: dkg.DkgSessionStatus
+	(*InitiateDkgRequest)(nil),           // 1: dkg.InitiateDkgRequest
+	(*InitiateDkgResponse)(nil),          // 2: dkg.InitiateDkgResponse
+	(*Round1PackagesRequest)(nil),        // 3: dkg.Round1PackagesRequest
+	(*Round1PackagesResponse)(nil),       // 4: dkg.Round1PackagesResponse
+	(*Round1SignatureRequest)(nil),       // 5: dkg.Round1SignatureRequest
+	(*Round1SignatureResponse)(nil),      // 6: dkg.Round1SignatureResponse
+	(*Round2PackagesRequest)(nil),        // 7: dkg.Round2PackagesRequest
+	(*Round2PackagesResponse)(nil),       // 8: dkg.Round2PackagesResponse
+	(*DkgComplaint)(nil),                 // 9: dkg.DkgComplaint
+	(*GetDkgSessionRequest)(nil),         // 10: dkg.GetDkgSessionRequest
+	(*GetDkgSessionResponse)(nil),        // 11: dkg.GetDkgSessionResponse
+	(*AbortDkgRequest)(nil),              // 12: dkg.AbortDkgRequest
+	(*StartDkgRequest)(nil),              // 13: dkg.StartDkgRequest
+	(*StartShareRefreshRequest)(nil),     // 14: dkg.StartShareRefreshRequest
+	(*StartShareRefreshResponse)(nil),    // 15: dkg.StartShareRefreshResponse
+	(*InitiateShareRefreshRequest)(nil),  // 16: dkg.InitiateShareRefreshRequest
+	(*ShareRefreshPackage)(nil),          // 17: dkg.ShareRefreshPackage
+	(*ShareRefreshContribution)(nil),     // 18: dkg.ShareRefreshContribution
+	(*InitiateShareRefreshResponse)(nil), // 19: dkg.InitiateShareRefreshResponse
+	(*PrepareShareRefreshRequest)(nil),   // 20: dkg.PrepareShareRefreshRequest
+	(*CommitShareRefreshRequest)(nil),    // 21: dkg.CommitShareRefreshRequest
+	(*CompleteShareRefreshRequest)(nil),  // 22: dkg.CompleteShareRefreshRequest
+	(*AbortShareRefreshRequest)(nil),     // 23: dkg.AbortShareRefreshRequest
+	(*StartReshareRequest)(nil),          // 24: dkg.StartReshareRequest
+	(*StartReshareResponse)(nil),         // 25: dkg.StartReshareResponse
+	(*ReshareKeyshare)(nil),              // 26: dkg.ReshareKeyshare
+	(*InitiateReshareRequest)(nil),       // 27: dkg.InitiateReshareRequest
+	(*ResharePackage)(nil),               // 28: dkg.ResharePackage
+	(*ReshareContribution)(nil),          // 29: dkg.ReshareContribution
+	(*InitiateReshareResponse)(nil),      // 30: dkg.InitiateReshareResponse
+	(*PrepareReshareRequest)(nil),        // 31: dkg.PrepareReshareRequest
+	(*CommitReshareRequest)(nil),         // 32: dkg.CommitReshareRequest
+	(*CompleteReshareRequest)(nil),       // 33: dkg.CompleteReshareRequest
+	(*AbortReshareRequest)(nil),          // 34: dkg.AbortReshareRequest
+	nil,                                  // 35: dkg.Round1SignatureRequest.Round1SignaturesEntry
+	nil,                                  // 36: dkg.ShareRefreshPackage.EncryptedSharesEntry
+	nil,                                  // 37: dkg.ResharePackage.EncryptedSharesEntry
+	(*common.PackageMap)(nil),            // 38: common.PackageMap
+	(*emptypb.Empty)(nil),                // 39: google.protobuf.Empty
 }
 var file_dkg_proto_depIdxs = []int32{
-	10, // 0: dkg.Round1PackagesRequest.round1_packages:type_name -> common.PackageMap
-	9,  // 1: dkg.Round1SignatureRequest.round1_signatures:type_name -> dkg.Round1SignatureRequest.Round1SignaturesEntry
-	8,  // 2: dkg.DKGService.start_dkg:input_type -> dkg.StartDkgRequest
-	0,  // 3: dkg.DKGService.initiate_dkg:input_type -> dkg.InitiateDkgRequest
-	2,  // 4: dkg.DKGService.round1_packages:input_type -> dkg.Round1PackagesRequest
-	4,  // 5: dkg.DKGService.round1_signature:input_type -> dkg.Round1SignatureRequest
-	6,  // 6: dkg.DKGService.round2_packages:input_type -> dkg.Round2PackagesRequest
-	11, // 7: dkg.DKGService.start_dkg:output_type -> google.protobuf.Empty
-	1,  // 8: dkg.DKGService.initiate_dkg:output_type -> dkg.InitiateDkgResponse
-	3,  // 9: dkg.DKGService.round1_packages:output_type -> dkg.Round1PackagesResponse
-	5,  // 10: dkg.DKGService.round1_signature:output_type -> dkg.Round1SignatureResponse
-	7,  // 11: dkg.DKGService.round2_packages:output_type -> dkg.Round2PackagesResponse
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	38, // 0: dkg.Round1PackagesRequest.round1_packages:type_name -> common.PackageMap
+	35, // 1: dkg.Round1SignatureRequest.round1_signatures:type_name -> dkg.Round1SignatureRequest.Round1SignaturesEntry
+	0,  // 2: dkg.GetDkgSessionResponse.status:type_name -> dkg.DkgSessionStatus
+	9,  // 3: dkg.GetDkgSessionResponse.complaints:type_name -> dkg.DkgComplaint
+	36, // 4: dkg.ShareRefreshPackage.encrypted_shares:type_name -> dkg.ShareRefreshPackage.EncryptedSharesEntry
+	17, // 5: dkg.ShareRefreshContribution.packages:type_name -> dkg.ShareRefreshPackage
+	18, // 6: dkg.InitiateShareRefreshResponse.contribution:type_name -> dkg.ShareRefreshContribution
+	18, // 7: dkg.PrepareShareRefreshRequest.contributions:type_name -> dkg.ShareRefreshContribution
+	26, // 8: dkg.InitiateReshareRequest.keyshares:type_name -> dkg.ReshareKeyshare
+	37, // 9: dkg.ResharePackage.encrypted_shares:type_name -> dkg.ResharePackage.EncryptedSharesEntry
+	28, // 10: dkg.ReshareContribution.packages:type_name -> dkg.ResharePackage
+	29, // 11: dkg.InitiateReshareResponse.contribution:type_name -> dkg.ReshareContribution
+	29, // 12: dkg.PrepareReshareRequest.contributions:type_name -> dkg.ReshareContribution
+	26, // 13: dkg.PrepareReshareRequest.keyshares:type_name -> dkg.ReshareKeyshare
+	13, // 14: dkg.DKGService.start_dkg:input_type -> dkg.StartDkgRequest
+	1,  // 15: dkg.DKGService.initiate_dkg:input_type -> dkg.InitiateDkgRequest
+	3,  // 16: dkg.DKGService.round1_packages:input_type -> dkg.Round1PackagesRequest
+	5,  // 17: dkg.DKGService.round1_signature:input_type -> dkg.Round1SignatureRequest
+	7,  // 18: dkg.DKGService.round2_packages:input_type -> dkg.Round2PackagesRequest
+	10, // 19: dkg.DKGService.get_dkg_session:input_type -> dkg.GetDkgSessionRequest
+	12, // 20: dkg.DKGService.abort_dkg:input_type -> dkg.AbortDkgRequest
+	14, // 21: dkg.DKGService.start_share_refresh:input_type -> dkg.StartShareRefreshRequest
+	16, // 22: dkg.DKGService.initiate_share_refresh:input_type -> dkg.InitiateShareRefreshRequest
+	20, // 23: dkg.DKGService.prepare_share_refresh:input_type -> dkg.PrepareShareRefreshRequest
+	21, // 24: dkg.DKGService.commit_share_refresh:input_type -> dkg.CommitShareRefreshRequest
+	22, // 25: dkg.DKGService.complete_share_refresh:input_type -> dkg.CompleteShareRefreshRequest
+	23, // 26: dkg.DKGService.abort_share_refresh:input_type -> dkg.AbortShareRefreshRequest
+	24, // 27: dkg.DKGService.start_reshare:input_type -> dkg.StartReshareRequest
+	27, // 28: dkg.DKGService.initiate_reshare:input_type -> dkg.InitiateReshareRequest
+	31, // 29: dkg.DKGService.prepare_reshare:input_type -> dkg.PrepareReshareRequest
+	32, // 30: dkg.DKGService.commit_reshare:input_type -> dkg.CommitReshareRequest
+	33, // 31: dkg.DKGService.complete_reshare:input_type -> dkg.CompleteReshareRequest
+	34, // 32: dkg.DKGService.abort_reshare:input_type -> dkg.AbortReshareRequest
+	39, // 33: dkg.DKGService.start_dkg:output_type -> google.protobuf.Empty
+	2,  // 34: dkg.DKGService.initiate_dkg:output_type -> dkg.InitiateDkgResponse
+	4,  // 35: dkg.DKGService.round1_packages:output_type -> dkg.Round1PackagesResponse
+	6,  // 36: dkg.DKGService.round1_signature:output_type -> dkg.Round1SignatureResponse
+	8,  // 37: dkg.DKGService.round2_packages:output_type -> dkg.Round2PackagesResponse
+	11, // 38: dkg.DKGService.get_dkg_session:output_type -> dkg.GetDkgSessionResponse
+	39, // 39: dkg.DKGService.abort_dkg:output_type -> google.protobuf.Empty
+	15, // 40: dkg.DKGService.start_share_refresh:output_type -> dkg.StartShareRefreshResponse
+	19, // 41: dkg.DKGService.initiate_share_refresh:output_type -> dkg.InitiateShareRefreshResponse
+	39, // 42: dkg.DKGService.prepare_share_refresh:output_type -> google.protobuf.Empty
+	39, // 43: dkg.DKGService.commit_share_refresh:output_type -> google.protobuf.Empty
+	39, // 44: dkg.DKGService.complete_share_refresh:output_type -> google.protobuf.Empty
+	39, // 45: dkg.DKGService.abort_share_refresh:output_type -> google.protobuf.Empty
+	25, // 46: dkg.DKGService.start_reshare:output_type -> dkg.StartReshareResponse
+	30, // 47: dkg.DKGService.initiate_reshare:output_type -> dkg.InitiateReshareResponse
+	39, // 48: dkg.DKGService.prepare_reshare:output_type -> google.protobuf.Empty
+	39, // 49: dkg.DKGService.commit_reshare:output_type -> google.protobuf.Empty
+	39, // 50: dkg.DKGService.complete_reshare:output_type -> google.protobuf.Empty
+	39, // 51: dkg.DKGService.abort_reshare:output_type -> google.protobuf.Empty
+	33, // [33:52] is the sub-list for method output_type
+	14, // [14:33] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_dkg_proto_init() }
@@ -647,13 +2227,14 @@ func file_dkg_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dkg_proto_rawDesc), len(file_dkg_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_dkg_proto_goTypes,
 		DependencyIndexes: file_dkg_proto_depIdxs,
+		EnumInfos:         file_dkg_proto_enumTypes,
 		MessageInfos:      file_dkg_proto_msgTypes,
 	}.Build()
 	File_dkg_proto = out.File
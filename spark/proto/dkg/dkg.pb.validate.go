@@ -915,44 +915,147 @@ var _ interface {
 	ErrorName() string
 } = Round2PackagesResponseValidationError{}
 
-// Validate checks the field values on StartDkgRequest with the rules defined
+// Validate checks the field values on DkgComplaint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DkgComplaint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DkgComplaint with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *StartDkgRequest) Validate() error {
+// result is a list of violation errors wrapped in DkgComplaintMultiError, or
+// nil if none found.
+func (m *DkgComplaint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DkgComplaint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccusedIdentifier
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return DkgComplaintMultiError(errors)
+	}
+
+	return nil
+}
+
+// DkgComplaintMultiError is an error wrapping multiple validation errors
+// returned by DkgComplaint.ValidateAll() if the designated constraints aren't met.
+type DkgComplaintMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DkgComplaintMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DkgComplaintMultiError) AllErrors() []error { return m }
+
+// DkgComplaintValidationError is the validation error returned by
+// DkgComplaint.Validate if the designated constraints aren't met.
+type DkgComplaintValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DkgComplaintValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DkgComplaintValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DkgComplaintValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DkgComplaintValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DkgComplaintValidationError) ErrorName() string { return "DkgComplaintValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DkgComplaintValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDkgComplaint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DkgComplaintValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DkgComplaintValidationError{}
+
+// Validate checks the field values on GetDkgSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDkgSessionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartDkgRequest with the rules
+// ValidateAll checks the field values on GetDkgSessionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StartDkgRequestMultiError, or nil if none found.
-func (m *StartDkgRequest) ValidateAll() error {
+// GetDkgSessionRequestMultiError, or nil if none found.
+func (m *GetDkgSessionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartDkgRequest) validate(all bool) error {
+func (m *GetDkgSessionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Count
+	// no validation rules for RequestId
 
 	if len(errors) > 0 {
-		return StartDkgRequestMultiError(errors)
+		return GetDkgSessionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartDkgRequestMultiError is an error wrapping multiple validation errors
-// returned by StartDkgRequest.ValidateAll() if the designated constraints
-// aren't met.
-type StartDkgRequestMultiError []error
+// GetDkgSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDkgSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDkgSessionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartDkgRequestMultiError) Error() string {
+func (m GetDkgSessionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -961,11 +1064,11 @@ func (m StartDkgRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartDkgRequestMultiError) AllErrors() []error { return m }
+func (m GetDkgSessionRequestMultiError) AllErrors() []error { return m }
 
-// StartDkgRequestValidationError is the validation error returned by
-// StartDkgRequest.Validate if the designated constraints aren't met.
-type StartDkgRequestValidationError struct {
+// GetDkgSessionRequestValidationError is the validation error returned by
+// GetDkgSessionRequest.Validate if the designated constraints aren't met.
+type GetDkgSessionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -973,22 +1076,24 @@ type StartDkgRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartDkgRequestValidationError) Field() string { return e.field }
+func (e GetDkgSessionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartDkgRequestValidationError) Reason() string { return e.reason }
+func (e GetDkgSessionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartDkgRequestValidationError) Cause() error { return e.cause }
+func (e GetDkgSessionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartDkgRequestValidationError) Key() bool { return e.key }
+func (e GetDkgSessionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartDkgRequestValidationError) ErrorName() string { return "StartDkgRequestValidationError" }
+func (e GetDkgSessionRequestValidationError) ErrorName() string {
+	return "GetDkgSessionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StartDkgRequestValidationError) Error() string {
+func (e GetDkgSessionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1000,14 +1105,14 @@ func (e StartDkgRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartDkgRequest.%s: %s%s",
+		"invalid %sGetDkgSessionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartDkgRequestValidationError{}
+var _ error = GetDkgSessionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1015,4 +1120,2803 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartDkgRequestValidationError{}
+} = GetDkgSessionRequestValidationError{}
+
+// Validate checks the field values on GetDkgSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDkgSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDkgSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDkgSessionResponseMultiError, or nil if none found.
+func (m *GetDkgSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDkgSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Identifier
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetComplaints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDkgSessionResponseValidationError{
+						field:  fmt.Sprintf("Complaints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDkgSessionResponseValidationError{
+						field:  fmt.Sprintf("Complaints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDkgSessionResponseValidationError{
+					field:  fmt.Sprintf("Complaints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AbortReason
+
+	if len(errors) > 0 {
+		return GetDkgSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDkgSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDkgSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDkgSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDkgSessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDkgSessionResponseMultiError) AllErrors() []error { return m }
+
+// GetDkgSessionResponseValidationError is the validation error returned by
+// GetDkgSessionResponse.Validate if the designated constraints aren't met.
+type GetDkgSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDkgSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDkgSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDkgSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDkgSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDkgSessionResponseValidationError) ErrorName() string {
+	return "GetDkgSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDkgSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDkgSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDkgSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDkgSessionResponseValidationError{}
+
+// Validate checks the field values on AbortDkgRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AbortDkgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AbortDkgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AbortDkgRequestMultiError, or nil if none found.
+func (m *AbortDkgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AbortDkgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return AbortDkgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AbortDkgRequestMultiError is an error wrapping multiple validation errors
+// returned by AbortDkgRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AbortDkgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AbortDkgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AbortDkgRequestMultiError) AllErrors() []error { return m }
+
+// AbortDkgRequestValidationError is the validation error returned by
+// AbortDkgRequest.Validate if the designated constraints aren't met.
+type AbortDkgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AbortDkgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AbortDkgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AbortDkgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AbortDkgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AbortDkgRequestValidationError) ErrorName() string { return "AbortDkgRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AbortDkgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAbortDkgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AbortDkgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AbortDkgRequestValidationError{}
+
+// Validate checks the field values on StartDkgRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StartDkgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartDkgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartDkgRequestMultiError, or nil if none found.
+func (m *StartDkgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartDkgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return StartDkgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartDkgRequestMultiError is an error wrapping multiple validation errors
+// returned by StartDkgRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StartDkgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartDkgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartDkgRequestMultiError) AllErrors() []error { return m }
+
+// StartDkgRequestValidationError is the validation error returned by
+// StartDkgRequest.Validate if the designated constraints aren't met.
+type StartDkgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartDkgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartDkgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartDkgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartDkgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartDkgRequestValidationError) ErrorName() string { return "StartDkgRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StartDkgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartDkgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartDkgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartDkgRequestValidationError{}
+
+// Validate checks the field values on StartShareRefreshRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartShareRefreshRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartShareRefreshRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartShareRefreshRequestMultiError, or nil if none found.
+func (m *StartShareRefreshRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartShareRefreshRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchSize
+
+	if len(errors) > 0 {
+		return StartShareRefreshRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartShareRefreshRequestMultiError is an error wrapping multiple validation
+// errors returned by StartShareRefreshRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartShareRefreshRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartShareRefreshRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartShareRefreshRequestMultiError) AllErrors() []error { return m }
+
+// StartShareRefreshRequestValidationError is the validation error returned by
+// StartShareRefreshRequest.Validate if the designated constraints aren't met.
+type StartShareRefreshRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartShareRefreshRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartShareRefreshRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartShareRefreshRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartShareRefreshRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartShareRefreshRequestValidationError) ErrorName() string {
+	return "StartShareRefreshRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartShareRefreshRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartShareRefreshRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartShareRefreshRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartShareRefreshRequestValidationError{}
+
+// Validate checks the field values on StartShareRefreshResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartShareRefreshResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartShareRefreshResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartShareRefreshResponseMultiError, or nil if none found.
+func (m *StartShareRefreshResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartShareRefreshResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshedCount
+
+	if len(errors) > 0 {
+		return StartShareRefreshResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartShareRefreshResponseMultiError is an error wrapping multiple validation
+// errors returned by StartShareRefreshResponse.ValidateAll() if the
+// designated constraints aren't met.
+type StartShareRefreshResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartShareRefreshResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartShareRefreshResponseMultiError) AllErrors() []error { return m }
+
+// StartShareRefreshResponseValidationError is the validation error returned by
+// StartShareRefreshResponse.Validate if the designated constraints aren't met.
+type StartShareRefreshResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartShareRefreshResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartShareRefreshResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartShareRefreshResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartShareRefreshResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartShareRefreshResponseValidationError) ErrorName() string {
+	return "StartShareRefreshResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartShareRefreshResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartShareRefreshResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartShareRefreshResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartShareRefreshResponseValidationError{}
+
+// Validate checks the field values on InitiateShareRefreshRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitiateShareRefreshRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitiateShareRefreshRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitiateShareRefreshRequestMultiError, or nil if none found.
+func (m *InitiateShareRefreshRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitiateShareRefreshRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return InitiateShareRefreshRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitiateShareRefreshRequestMultiError is an error wrapping multiple
+// validation errors returned by InitiateShareRefreshRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InitiateShareRefreshRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitiateShareRefreshRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitiateShareRefreshRequestMultiError) AllErrors() []error { return m }
+
+// InitiateShareRefreshRequestValidationError is the validation error returned
+// by InitiateShareRefreshRequest.Validate if the designated constraints
+// aren't met.
+type InitiateShareRefreshRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitiateShareRefreshRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitiateShareRefreshRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitiateShareRefreshRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitiateShareRefreshRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitiateShareRefreshRequestValidationError) ErrorName() string {
+	return "InitiateShareRefreshRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InitiateShareRefreshRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitiateShareRefreshRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitiateShareRefreshRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitiateShareRefreshRequestValidationError{}
+
+// Validate checks the field values on ShareRefreshPackage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShareRefreshPackage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareRefreshPackage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShareRefreshPackageMultiError, or nil if none found.
+func (m *ShareRefreshPackage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareRefreshPackage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EncryptedShares
+
+	if len(errors) > 0 {
+		return ShareRefreshPackageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareRefreshPackageMultiError is an error wrapping multiple validation
+// errors returned by ShareRefreshPackage.ValidateAll() if the designated
+// constraints aren't met.
+type ShareRefreshPackageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareRefreshPackageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareRefreshPackageMultiError) AllErrors() []error { return m }
+
+// ShareRefreshPackageValidationError is the validation error returned by
+// ShareRefreshPackage.Validate if the designated constraints aren't met.
+type ShareRefreshPackageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareRefreshPackageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareRefreshPackageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareRefreshPackageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareRefreshPackageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareRefreshPackageValidationError) ErrorName() string {
+	return "ShareRefreshPackageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShareRefreshPackageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareRefreshPackage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareRefreshPackageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareRefreshPackageValidationError{}
+
+// Validate checks the field values on ShareRefreshContribution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShareRefreshContribution) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareRefreshContribution with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShareRefreshContributionMultiError, or nil if none found.
+func (m *ShareRefreshContribution) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareRefreshContribution) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Identifier
+
+	for idx, item := range m.GetPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ShareRefreshContributionValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ShareRefreshContributionValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ShareRefreshContributionValidationError{
+					field:  fmt.Sprintf("Packages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return ShareRefreshContributionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareRefreshContributionMultiError is an error wrapping multiple validation
+// errors returned by ShareRefreshContribution.ValidateAll() if the designated
+// constraints aren't met.
+type ShareRefreshContributionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareRefreshContributionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareRefreshContributionMultiError) AllErrors() []error { return m }
+
+// ShareRefreshContributionValidationError is the validation error returned by
+// ShareRefreshContribution.Validate if the designated constraints aren't met.
+type ShareRefreshContributionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareRefreshContributionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareRefreshContributionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareRefreshContributionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareRefreshContributionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareRefreshContributionValidationError) ErrorName() string {
+	return "ShareRefreshContributionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShareRefreshContributionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareRefreshContribution.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareRefreshContributionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareRefreshContributionValidationError{}
+
+// Validate checks the field values on InitiateShareRefreshResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitiateShareRefreshResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitiateShareRefreshResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitiateShareRefreshResponseMultiError, or nil if none found.
+func (m *InitiateShareRefreshResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitiateShareRefreshResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContribution()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateShareRefreshResponseValidationError{
+					field:  "Contribution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InitiateShareRefreshResponseValidationError{
+					field:  "Contribution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContribution()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateShareRefreshResponseValidationError{
+				field:  "Contribution",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InitiateShareRefreshResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitiateShareRefreshResponseMultiError is an error wrapping multiple
+// validation errors returned by InitiateShareRefreshResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InitiateShareRefreshResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitiateShareRefreshResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitiateShareRefreshResponseMultiError) AllErrors() []error { return m }
+
+// InitiateShareRefreshResponseValidationError is the validation error returned
+// by InitiateShareRefreshResponse.Validate if the designated constraints
+// aren't met.
+type InitiateShareRefreshResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitiateShareRefreshResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitiateShareRefreshResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitiateShareRefreshResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitiateShareRefreshResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitiateShareRefreshResponseValidationError) ErrorName() string {
+	return "InitiateShareRefreshResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InitiateShareRefreshResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitiateShareRefreshResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitiateShareRefreshResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitiateShareRefreshResponseValidationError{}
+
+// Validate checks the field values on PrepareShareRefreshRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrepareShareRefreshRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrepareShareRefreshRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrepareShareRefreshRequestMultiError, or nil if none found.
+func (m *PrepareShareRefreshRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrepareShareRefreshRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	for idx, item := range m.GetContributions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PrepareShareRefreshRequestValidationError{
+						field:  fmt.Sprintf("Contributions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PrepareShareRefreshRequestValidationError{
+						field:  fmt.Sprintf("Contributions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PrepareShareRefreshRequestValidationError{
+					field:  fmt.Sprintf("Contributions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PrepareShareRefreshRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrepareShareRefreshRequestMultiError is an error wrapping multiple
+// validation errors returned by PrepareShareRefreshRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PrepareShareRefreshRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrepareShareRefreshRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrepareShareRefreshRequestMultiError) AllErrors() []error { return m }
+
+// PrepareShareRefreshRequestValidationError is the validation error returned
+// by PrepareShareRefreshRequest.Validate if the designated constraints aren't met.
+type PrepareShareRefreshRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrepareShareRefreshRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrepareShareRefreshRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrepareShareRefreshRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrepareShareRefreshRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrepareShareRefreshRequestValidationError) ErrorName() string {
+	return "PrepareShareRefreshRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrepareShareRefreshRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrepareShareRefreshRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrepareShareRefreshRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrepareShareRefreshRequestValidationError{}
+
+// Validate checks the field values on CommitShareRefreshRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitShareRefreshRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitShareRefreshRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitShareRefreshRequestMultiError, or nil if none found.
+func (m *CommitShareRefreshRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitShareRefreshRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return CommitShareRefreshRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitShareRefreshRequestMultiError is an error wrapping multiple validation
+// errors returned by CommitShareRefreshRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CommitShareRefreshRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitShareRefreshRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitShareRefreshRequestMultiError) AllErrors() []error { return m }
+
+// CommitShareRefreshRequestValidationError is the validation error returned by
+// CommitShareRefreshRequest.Validate if the designated constraints aren't met.
+type CommitShareRefreshRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitShareRefreshRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitShareRefreshRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitShareRefreshRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitShareRefreshRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitShareRefreshRequestValidationError) ErrorName() string {
+	return "CommitShareRefreshRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitShareRefreshRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitShareRefreshRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitShareRefreshRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitShareRefreshRequestValidationError{}
+
+// Validate checks the field values on CompleteShareRefreshRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteShareRefreshRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteShareRefreshRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteShareRefreshRequestMultiError, or nil if none found.
+func (m *CompleteShareRefreshRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteShareRefreshRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return CompleteShareRefreshRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteShareRefreshRequestMultiError is an error wrapping multiple
+// validation errors returned by CompleteShareRefreshRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CompleteShareRefreshRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteShareRefreshRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteShareRefreshRequestMultiError) AllErrors() []error { return m }
+
+// CompleteShareRefreshRequestValidationError is the validation error returned
+// by CompleteShareRefreshRequest.Validate if the designated constraints
+// aren't met.
+type CompleteShareRefreshRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteShareRefreshRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteShareRefreshRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteShareRefreshRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteShareRefreshRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteShareRefreshRequestValidationError) ErrorName() string {
+	return "CompleteShareRefreshRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteShareRefreshRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteShareRefreshRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteShareRefreshRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteShareRefreshRequestValidationError{}
+
+// Validate checks the field values on AbortShareRefreshRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AbortShareRefreshRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AbortShareRefreshRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AbortShareRefreshRequestMultiError, or nil if none found.
+func (m *AbortShareRefreshRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AbortShareRefreshRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return AbortShareRefreshRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AbortShareRefreshRequestMultiError is an error wrapping multiple validation
+// errors returned by AbortShareRefreshRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AbortShareRefreshRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AbortShareRefreshRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AbortShareRefreshRequestMultiError) AllErrors() []error { return m }
+
+// AbortShareRefreshRequestValidationError is the validation error returned by
+// AbortShareRefreshRequest.Validate if the designated constraints aren't met.
+type AbortShareRefreshRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AbortShareRefreshRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AbortShareRefreshRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AbortShareRefreshRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AbortShareRefreshRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AbortShareRefreshRequestValidationError) ErrorName() string {
+	return "AbortShareRefreshRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AbortShareRefreshRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAbortShareRefreshRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AbortShareRefreshRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AbortShareRefreshRequestValidationError{}
+
+// Validate checks the field values on StartReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartReshareRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartReshareRequestMultiError, or nil if none found.
+func (m *StartReshareRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartReshareRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchSize
+
+	if len(errors) > 0 {
+		return StartReshareRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartReshareRequestMultiError is an error wrapping multiple validation
+// errors returned by StartReshareRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartReshareRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartReshareRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartReshareRequestMultiError) AllErrors() []error { return m }
+
+// StartReshareRequestValidationError is the validation error returned by
+// StartReshareRequest.Validate if the designated constraints aren't met.
+type StartReshareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartReshareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartReshareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartReshareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartReshareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartReshareRequestValidationError) ErrorName() string {
+	return "StartReshareRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartReshareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartReshareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartReshareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartReshareRequestValidationError{}
+
+// Validate checks the field values on StartReshareResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartReshareResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartReshareResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartReshareResponseMultiError, or nil if none found.
+func (m *StartReshareResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartReshareResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResharedCount
+
+	if len(errors) > 0 {
+		return StartReshareResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartReshareResponseMultiError is an error wrapping multiple validation
+// errors returned by StartReshareResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartReshareResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartReshareResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartReshareResponseMultiError) AllErrors() []error { return m }
+
+// StartReshareResponseValidationError is the validation error returned by
+// StartReshareResponse.Validate if the designated constraints aren't met.
+type StartReshareResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartReshareResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartReshareResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartReshareResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartReshareResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartReshareResponseValidationError) ErrorName() string {
+	return "StartReshareResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartReshareResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartReshareResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartReshareResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartReshareResponseValidationError{}
+
+// Validate checks the field values on ReshareKeyshare with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReshareKeyshare) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReshareKeyshare with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReshareKeyshareMultiError, or nil if none found.
+func (m *ReshareKeyshare) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReshareKeyshare) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PublicKey
+
+	// no validation rules for Status
+
+	// no validation rules for CoordinatorIndex
+
+	if len(errors) > 0 {
+		return ReshareKeyshareMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReshareKeyshareMultiError is an error wrapping multiple validation errors
+// returned by ReshareKeyshare.ValidateAll() if the designated constraints
+// aren't met.
+type ReshareKeyshareMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReshareKeyshareMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReshareKeyshareMultiError) AllErrors() []error { return m }
+
+// ReshareKeyshareValidationError is the validation error returned by
+// ReshareKeyshare.Validate if the designated constraints aren't met.
+type ReshareKeyshareValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReshareKeyshareValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReshareKeyshareValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReshareKeyshareValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReshareKeyshareValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReshareKeyshareValidationError) ErrorName() string { return "ReshareKeyshareValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReshareKeyshareValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReshareKeyshare.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReshareKeyshareValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReshareKeyshareValidationError{}
+
+// Validate checks the field values on InitiateReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitiateReshareRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitiateReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitiateReshareRequestMultiError, or nil if none found.
+func (m *InitiateReshareRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitiateReshareRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	for idx, item := range m.GetKeyshares() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitiateReshareRequestValidationError{
+						field:  fmt.Sprintf("Keyshares[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitiateReshareRequestValidationError{
+						field:  fmt.Sprintf("Keyshares[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitiateReshareRequestValidationError{
+					field:  fmt.Sprintf("Keyshares[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InitiateReshareRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitiateReshareRequestMultiError is an error wrapping multiple validation
+// errors returned by InitiateReshareRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InitiateReshareRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitiateReshareRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitiateReshareRequestMultiError) AllErrors() []error { return m }
+
+// InitiateReshareRequestValidationError is the validation error returned by
+// InitiateReshareRequest.Validate if the designated constraints aren't met.
+type InitiateReshareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitiateReshareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitiateReshareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitiateReshareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitiateReshareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitiateReshareRequestValidationError) ErrorName() string {
+	return "InitiateReshareRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InitiateReshareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitiateReshareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitiateReshareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitiateReshareRequestValidationError{}
+
+// Validate checks the field values on ResharePackage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ResharePackage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResharePackage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResharePackageMultiError,
+// or nil if none found.
+func (m *ResharePackage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResharePackage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EncryptedShares
+
+	if len(errors) > 0 {
+		return ResharePackageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResharePackageMultiError is an error wrapping multiple validation errors
+// returned by ResharePackage.ValidateAll() if the designated constraints
+// aren't met.
+type ResharePackageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResharePackageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResharePackageMultiError) AllErrors() []error { return m }
+
+// ResharePackageValidationError is the validation error returned by
+// ResharePackage.Validate if the designated constraints aren't met.
+type ResharePackageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResharePackageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResharePackageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResharePackageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResharePackageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResharePackageValidationError) ErrorName() string { return "ResharePackageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResharePackageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResharePackage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResharePackageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResharePackageValidationError{}
+
+// Validate checks the field values on ReshareContribution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReshareContribution) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReshareContribution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReshareContributionMultiError, or nil if none found.
+func (m *ReshareContribution) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReshareContribution) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Identifier
+
+	for idx, item := range m.GetPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReshareContributionValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReshareContributionValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReshareContributionValidationError{
+					field:  fmt.Sprintf("Packages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return ReshareContributionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReshareContributionMultiError is an error wrapping multiple validation
+// errors returned by ReshareContribution.ValidateAll() if the designated
+// constraints aren't met.
+type ReshareContributionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReshareContributionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReshareContributionMultiError) AllErrors() []error { return m }
+
+// ReshareContributionValidationError is the validation error returned by
+// ReshareContribution.Validate if the designated constraints aren't met.
+type ReshareContributionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReshareContributionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReshareContributionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReshareContributionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReshareContributionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReshareContributionValidationError) ErrorName() string {
+	return "ReshareContributionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReshareContributionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReshareContribution.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReshareContributionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReshareContributionValidationError{}
+
+// Validate checks the field values on InitiateReshareResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitiateReshareResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitiateReshareResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitiateReshareResponseMultiError, or nil if none found.
+func (m *InitiateReshareResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitiateReshareResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContribution()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateReshareResponseValidationError{
+					field:  "Contribution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InitiateReshareResponseValidationError{
+					field:  "Contribution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContribution()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateReshareResponseValidationError{
+				field:  "Contribution",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InitiateReshareResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitiateReshareResponseMultiError is an error wrapping multiple validation
+// errors returned by InitiateReshareResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InitiateReshareResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitiateReshareResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitiateReshareResponseMultiError) AllErrors() []error { return m }
+
+// InitiateReshareResponseValidationError is the validation error returned by
+// InitiateReshareResponse.Validate if the designated constraints aren't met.
+type InitiateReshareResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitiateReshareResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitiateReshareResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitiateReshareResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitiateReshareResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitiateReshareResponseValidationError) ErrorName() string {
+	return "InitiateReshareResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InitiateReshareResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitiateReshareResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitiateReshareResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitiateReshareResponseValidationError{}
+
+// Validate checks the field values on PrepareReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrepareReshareRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrepareReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrepareReshareRequestMultiError, or nil if none found.
+func (m *PrepareReshareRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrepareReshareRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	for idx, item := range m.GetContributions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PrepareReshareRequestValidationError{
+						field:  fmt.Sprintf("Contributions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PrepareReshareRequestValidationError{
+						field:  fmt.Sprintf("Contributions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PrepareReshareRequestValidationError{
+					field:  fmt.Sprintf("Contributions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetKeyshares() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PrepareReshareRequestValidationError{
+						field:  fmt.Sprintf("Keyshares[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PrepareReshareRequestValidationError{
+						field:  fmt.Sprintf("Keyshares[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PrepareReshareRequestValidationError{
+					field:  fmt.Sprintf("Keyshares[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PrepareReshareRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrepareReshareRequestMultiError is an error wrapping multiple validation
+// errors returned by PrepareReshareRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PrepareReshareRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrepareReshareRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrepareReshareRequestMultiError) AllErrors() []error { return m }
+
+// PrepareReshareRequestValidationError is the validation error returned by
+// PrepareReshareRequest.Validate if the designated constraints aren't met.
+type PrepareReshareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrepareReshareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrepareReshareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrepareReshareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrepareReshareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrepareReshareRequestValidationError) ErrorName() string {
+	return "PrepareReshareRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrepareReshareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrepareReshareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrepareReshareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrepareReshareRequestValidationError{}
+
+// Validate checks the field values on CommitReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitReshareRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitReshareRequestMultiError, or nil if none found.
+func (m *CommitReshareRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitReshareRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return CommitReshareRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitReshareRequestMultiError is an error wrapping multiple validation
+// errors returned by CommitReshareRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CommitReshareRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitReshareRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitReshareRequestMultiError) AllErrors() []error { return m }
+
+// CommitReshareRequestValidationError is the validation error returned by
+// CommitReshareRequest.Validate if the designated constraints aren't met.
+type CommitReshareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitReshareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitReshareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitReshareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitReshareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitReshareRequestValidationError) ErrorName() string {
+	return "CommitReshareRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitReshareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitReshareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitReshareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitReshareRequestValidationError{}
+
+// Validate checks the field values on CompleteReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteReshareRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteReshareRequestMultiError, or nil if none found.
+func (m *CompleteReshareRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteReshareRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return CompleteReshareRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteReshareRequestMultiError is an error wrapping multiple validation
+// errors returned by CompleteReshareRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CompleteReshareRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteReshareRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteReshareRequestMultiError) AllErrors() []error { return m }
+
+// CompleteReshareRequestValidationError is the validation error returned by
+// CompleteReshareRequest.Validate if the designated constraints aren't met.
+type CompleteReshareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteReshareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteReshareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteReshareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteReshareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteReshareRequestValidationError) ErrorName() string {
+	return "CompleteReshareRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteReshareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteReshareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteReshareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteReshareRequestValidationError{}
+
+// Validate checks the field values on AbortReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AbortReshareRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AbortReshareRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AbortReshareRequestMultiError, or nil if none found.
+func (m *AbortReshareRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AbortReshareRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return AbortReshareRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AbortReshareRequestMultiError is an error wrapping multiple validation
+// errors returned by AbortReshareRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AbortReshareRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AbortReshareRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AbortReshareRequestMultiError) AllErrors() []error { return m }
+
+// AbortReshareRequestValidationError is the validation error returned by
+// AbortReshareRequest.Validate if the designated constraints aren't met.
+type AbortReshareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AbortReshareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AbortReshareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AbortReshareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AbortReshareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AbortReshareRequestValidationError) ErrorName() string {
+	return "AbortReshareRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AbortReshareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAbortReshareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AbortReshareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AbortReshareRequestValidationError{}
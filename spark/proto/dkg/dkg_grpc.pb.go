@@ -20,11 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DKGService_StartDkg_FullMethodName        = "/dkg.DKGService/start_dkg"
-	DKGService_InitiateDkg_FullMethodName     = "/dkg.DKGService/initiate_dkg"
-	DKGService_Round1Packages_FullMethodName  = "/dkg.DKGService/round1_packages"
-	DKGService_Round1Signature_FullMethodName = "/dkg.DKGService/round1_signature"
-	DKGService_Round2Packages_FullMethodName  = "/dkg.DKGService/round2_packages"
+	DKGService_StartDkg_FullMethodName             = "/dkg.DKGService/start_dkg"
+	DKGService_InitiateDkg_FullMethodName          = "/dkg.DKGService/initiate_dkg"
+	DKGService_Round1Packages_FullMethodName       = "/dkg.DKGService/round1_packages"
+	DKGService_Round1Signature_FullMethodName      = "/dkg.DKGService/round1_signature"
+	DKGService_Round2Packages_FullMethodName       = "/dkg.DKGService/round2_packages"
+	DKGService_GetDkgSession_FullMethodName        = "/dkg.DKGService/get_dkg_session"
+	DKGService_AbortDkg_FullMethodName             = "/dkg.DKGService/abort_dkg"
+	DKGService_StartShareRefresh_FullMethodName    = "/dkg.DKGService/start_share_refresh"
+	DKGService_InitiateShareRefresh_FullMethodName = "/dkg.DKGService/initiate_share_refresh"
+	DKGService_PrepareShareRefresh_FullMethodName  = "/dkg.DKGService/prepare_share_refresh"
+	DKGService_CommitShareRefresh_FullMethodName   = "/dkg.DKGService/commit_share_refresh"
+	DKGService_CompleteShareRefresh_FullMethodName = "/dkg.DKGService/complete_share_refresh"
+	DKGService_AbortShareRefresh_FullMethodName    = "/dkg.DKGService/abort_share_refresh"
+	DKGService_StartReshare_FullMethodName         = "/dkg.DKGService/start_reshare"
+	DKGService_InitiateReshare_FullMethodName      = "/dkg.DKGService/initiate_reshare"
+	DKGService_PrepareReshare_FullMethodName       = "/dkg.DKGService/prepare_reshare"
+	DKGService_CommitReshare_FullMethodName        = "/dkg.DKGService/commit_reshare"
+	DKGService_CompleteReshare_FullMethodName      = "/dkg.DKGService/complete_reshare"
+	DKGService_AbortReshare_FullMethodName         = "/dkg.DKGService/abort_reshare"
 )
 
 // DKGServiceClient is the client API for DKGService service.
@@ -73,6 +87,91 @@ type DKGServiceClient interface {
 	//
 	// This will return the acknowledgement of the round2 packages by the participant.
 	Round2Packages(ctx context.Context, in *Round2PackagesRequest, opts ...grpc.CallOption) (*Round2PackagesResponse, error)
+	// Get the state of a participant's DKG session.
+	//
+	// This will be called by the coordinator after delivering the round1 signatures, until every
+	// participant completed or aborted the session. A participant whose session is past its
+	// deadline aborts it, blaming the participants whose round2 packages it is missing.
+	GetDkgSession(ctx context.Context, in *GetDkgSessionRequest, opts ...grpc.CallOption) (*GetDkgSessionResponse, error)
+	// Abort a participant's DKG session.
+	//
+	// This will be called by the coordinator on every participant when the session failed.
+	AbortDkg(ctx context.Context, in *AbortDkgRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Start a proactive refresh of the keyshares this operator coordinates.
+	//
+	// This call will be made by a signing operator to the DKG coordinator. The coordinator picks
+	// keyshares that have not been refreshed for a while and re-randomizes every operator's share
+	// of them by adding shares of zero, which changes neither the public key nor the secret.
+	StartShareRefresh(ctx context.Context, in *StartShareRefreshRequest, opts ...grpc.CallOption) (*StartShareRefreshResponse, error)
+	// Initiate a share refresh for a participant.
+	//
+	// This will be called by the coordinator on each participant. The participant splits zero for
+	// each keyshare and returns the shares, each encrypted to the operator it is for, along with
+	// commitments to the polynomials.
+	InitiateShareRefresh(ctx context.Context, in *InitiateShareRefreshRequest, opts ...grpc.CallOption) (*InitiateShareRefreshResponse, error)
+	// Deliver the share refresh contributions of all participants.
+	//
+	// This will be called by the coordinator on each participant, after it has received the
+	// contributions of all participants. The participant validates the shares it received against
+	// the commitments and stores the prepared refresh, without applying it yet. The keyshares are
+	// not refreshed again until the refresh is completed or aborted.
+	PrepareShareRefresh(ctx context.Context, in *PrepareShareRefreshRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Apply a prepared share refresh.
+	//
+	// This will be called by the coordinator on each participant once all participants prepared
+	// the refresh. Applying a refresh more than once has no effect. The participant keeps what it
+	// needs to roll the refresh back until it is completed.
+	CommitShareRefresh(ctx context.Context, in *CommitShareRefreshRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Complete a committed share refresh.
+	//
+	// This will be called by the coordinator on each participant once all participants committed
+	// the refresh. From then on the refresh can no longer be rolled back.
+	CompleteShareRefresh(ctx context.Context, in *CompleteShareRefreshRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Abort a share refresh.
+	//
+	// This will be called by the coordinator on each participant when the refresh cannot be
+	// committed on all of them. A committed refresh is rolled back. Aborting a refresh the
+	// participant does not know has no effect.
+	AbortShareRefresh(ctx context.Context, in *AbortShareRefreshRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Start resharing the keyshares this operator coordinates to the current operator set.
+	//
+	// This call will be made by a signing operator to the DKG coordinator while a resharing from a
+	// previous operator set is in progress. The coordinator picks keyshares still held by the
+	// previous operator set and moves them to the current one, without changing their public keys.
+	StartReshare(ctx context.Context, in *StartReshareRequest, opts ...grpc.CallOption) (*StartReshareResponse, error)
+	// Initiate a reshare for a participant.
+	//
+	// This will be called by the coordinator on each operator of both operator sets. A dealer of
+	// the previous operator set splits its weighted share of each keyshare among the current
+	// operator set and returns the shares, each encrypted to the operator it is for, along with
+	// commitments to the polynomials. Other operators return an empty contribution.
+	InitiateReshare(ctx context.Context, in *InitiateReshareRequest, opts ...grpc.CallOption) (*InitiateReshareResponse, error)
+	// Deliver the reshare contributions of all dealers.
+	//
+	// This will be called by the coordinator on each operator of both operator sets, after it has
+	// received the contributions of all dealers. The operator validates the contributions and
+	// stores the prepared reshare, without applying it yet. The keyshares are neither reshared nor
+	// refreshed again until the reshare is completed or aborted.
+	PrepareReshare(ctx context.Context, in *PrepareReshareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Apply a prepared reshare.
+	//
+	// This will be called by the coordinator on each operator of both operator sets once all of
+	// them prepared the reshare. Operators of the current set store their new shares, operators
+	// that are no longer in it erase theirs. Applying a reshare more than once has no effect. The
+	// operator keeps what it needs to roll the reshare back until it is completed.
+	CommitReshare(ctx context.Context, in *CommitReshareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Complete a committed reshare.
+	//
+	// This will be called by the coordinator on each operator of both operator sets once all of
+	// them committed the reshare. From then on the reshare can no longer be rolled back, and the
+	// shares of the previous operator set are gone.
+	CompleteReshare(ctx context.Context, in *CompleteReshareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Abort a reshare.
+	//
+	// This will be called by the coordinator on each operator of both operator sets when the
+	// reshare cannot be committed on all of them. A committed reshare is rolled back. Aborting a
+	// reshare the operator does not know has no effect.
+	AbortReshare(ctx context.Context, in *AbortReshareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type dKGServiceClient struct {
@@ -133,6 +232,146 @@ func (c *dKGServiceClient) Round2Packages(ctx context.Context, in *Round2Package
 	return out, nil
 }
 
+func (c *dKGServiceClient) GetDkgSession(ctx context.Context, in *GetDkgSessionRequest, opts ...grpc.CallOption) (*GetDkgSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDkgSessionResponse)
+	err := c.cc.Invoke(ctx, DKGService_GetDkgSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) AbortDkg(ctx context.Context, in *AbortDkgRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DKGService_AbortDkg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) StartShareRefresh(ctx context.Context, in *StartShareRefreshRequest, opts ...grpc.CallOption) (*StartShareRefreshResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartShareRefreshResponse)
+	err := c.cc.Invoke(ctx, DKGService_StartShareRefresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) InitiateShareRefresh(ctx context.Context, in *InitiateShareRefreshRequest, opts ...grpc.CallOption) (*InitiateShareRefreshResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InitiateShareRefreshResponse)
+	err := c.cc.Invoke(ctx, DKGService_InitiateShareRefresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) PrepareShareRefresh(ctx context.Context, in *PrepareShareRefreshRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DKGService_PrepareShareRefresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) CommitShareRefresh(ctx context.Context, in *CommitShareRefreshRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DKGService_CommitShareRefresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) CompleteShareRefresh(ctx context.Context, in *CompleteShareRefreshRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DKGService_CompleteShareRefresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) AbortShareRefresh(ctx context.Context, in *AbortShareRefreshRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DKGService_AbortShareRefresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) StartReshare(ctx context.Context, in *StartReshareRequest, opts ...grpc.CallOption) (*StartReshareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartReshareResponse)
+	err := c.cc.Invoke(ctx, DKGService_StartReshare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) InitiateReshare(ctx context.Context, in *InitiateReshareRequest, opts ...grpc.CallOption) (*InitiateReshareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InitiateReshareResponse)
+	err := c.cc.Invoke(ctx, DKGService_InitiateReshare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) PrepareReshare(ctx context.Context, in *PrepareReshareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DKGService_PrepareReshare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) CommitReshare(ctx context.Context, in *CommitReshareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DKGService_CommitReshare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) CompleteReshare(ctx context.Context, in *CompleteReshareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DKGService_CompleteReshare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dKGServiceClient) AbortReshare(ctx context.Context, in *AbortReshareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DKGService_AbortReshare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DKGServiceServer is the server API for DKGService service.
 // All implementations must embed UnimplementedDKGServiceServer
 // for forward compatibility.
@@ -179,6 +418,91 @@ type DKGServiceServer interface {
 	//
 	// This will return the acknowledgement of the round2 packages by the participant.
 	Round2Packages(context.Context, *Round2PackagesRequest) (*Round2PackagesResponse, error)
+	// Get the state of a participant's DKG session.
+	//
+	// This will be called by the coordinator after delivering the round1 signatures, until every
+	// participant completed or aborted the session. A participant whose session is past its
+	// deadline aborts it, blaming the participants whose round2 packages it is missing.
+	GetDkgSession(context.Context, *GetDkgSessionRequest) (*GetDkgSessionResponse, error)
+	// Abort a participant's DKG session.
+	//
+	// This will be called by the coordinator on every participant when the session failed.
+	AbortDkg(context.Context, *AbortDkgRequest) (*emptypb.Empty, error)
+	// Start a proactive refresh of the keyshares this operator coordinates.
+	//
+	// This call will be made by a signing operator to the DKG coordinator. The coordinator picks
+	// keyshares that have not been refreshed for a while and re-randomizes every operator's share
+	// of them by adding shares of zero, which changes neither the public key nor the secret.
+	StartShareRefresh(context.Context, *StartShareRefreshRequest) (*StartShareRefreshResponse, error)
+	// Initiate a share refresh for a participant.
+	//
+	// This will be called by the coordinator on each participant. The participant splits zero for
+	// each keyshare and returns the shares, each encrypted to the operator it is for, along with
+	// commitments to the polynomials.
+	InitiateShareRefresh(context.Context, *InitiateShareRefreshRequest) (*InitiateShareRefreshResponse, error)
+	// Deliver the share refresh contributions of all participants.
+	//
+	// This will be called by the coordinator on each participant, after it has received the
+	// contributions of all participants. The participant validates the shares it received against
+	// the commitments and stores the prepared refresh, without applying it yet. The keyshares are
+	// not refreshed again until the refresh is completed or aborted.
+	PrepareShareRefresh(context.Context, *PrepareShareRefreshRequest) (*emptypb.Empty, error)
+	// Apply a prepared share refresh.
+	//
+	// This will be called by the coordinator on each participant once all participants prepared
+	// the refresh. Applying a refresh more than once has no effect. The participant keeps what it
+	// needs to roll the refresh back until it is completed.
+	CommitShareRefresh(context.Context, *CommitShareRefreshRequest) (*emptypb.Empty, error)
+	// Complete a committed share refresh.
+	//
+	// This will be called by the coordinator on each participant once all participants committed
+	// the refresh. From then on the refresh can no longer be rolled back.
+	CompleteShareRefresh(context.Context, *CompleteShareRefreshRequest) (*emptypb.Empty, error)
+	// Abort a share refresh.
+	//
+	// This will be called by the coordinator on each participant when the refresh cannot be
+	// committed on all of them. A committed refresh is rolled back. Aborting a refresh the
+	// participant does not know has no effect.
+	AbortShareRefresh(context.Context, *AbortShareRefreshRequest) (*emptypb.Empty, error)
+	// Start resharing the keyshares this operator coordinates to the current operator set.
+	//
+	// This call will be made by a signing operator to the DKG coordinator while a resharing from a
+	// previous operator set is in progress. The coordinator picks keyshares still held by the
+	// previous operator set and moves them to the current one, without changing their public keys.
+	StartReshare(context.Context, *StartReshareRequest) (*StartReshareResponse, error)
+	// Initiate a reshare for a participant.
+	//
+	// This will be called by the coordinator on each operator of both operator sets. A dealer of
+	// the previous operator set splits its weighted share of each keyshare among the current
+	// operator set and returns the shares, each encrypted to the operator it is for, along with
+	// commitments to the polynomials. Other operators return an empty contribution.
+	InitiateReshare(context.Context, *InitiateReshareRequest) (*InitiateReshareResponse, error)
+	// Deliver the reshare contributions of all dealers.
+	//
+	// This will be called by the coordinator on each operator of both operator sets, after it has
+	// received the contributions of all dealers. The operator validates the contributions and
+	// stores the prepared reshare, without applying it yet. The keyshares are neither reshared nor
+	// refreshed again until the reshare is completed or aborted.
+	PrepareReshare(context.Context, *PrepareReshareRequest) (*emptypb.Empty, error)
+	// Apply a prepared reshare.
+	//
+	// This will be called by the coordinator on each operator of both operator sets once all of
+	// them prepared the reshare. Operators of the current set store their new shares, operators
+	// that are no longer in it erase theirs. Applying a reshare more than once has no effect. The
+	// operator keeps what it needs to roll the reshare back until it is completed.
+	CommitReshare(context.Context, *CommitReshareRequest) (*emptypb.Empty, error)
+	// Complete a committed reshare.
+	//
+	// This will be called by the coordinator on each operator of both operator sets once all of
+	// them committed the reshare. From then on the reshare can no longer be rolled back, and the
+	// shares of the previous operator set are gone.
+	CompleteReshare(context.Context, *CompleteReshareRequest) (*emptypb.Empty, error)
+	// Abort a reshare.
+	//
+	// This will be called by the coordinator on each operator of both operator sets when the
+	// reshare cannot be committed on all of them. A committed reshare is rolled back. Aborting a
+	// reshare the operator does not know has no effect.
+	AbortReshare(context.Context, *AbortReshareRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedDKGServiceServer()
 }
 
@@ -204,6 +528,48 @@ func (UnimplementedDKGServiceServer) Round1Signature(context.Context, *Round1Sig
 func (UnimplementedDKGServiceServer) Round2Packages(context.Context, *Round2PackagesRequest) (*Round2PackagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Round2Packages not implemented")
 }
+func (UnimplementedDKGServiceServer) GetDkgSession(context.Context, *GetDkgSessionRequest) (*GetDkgSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDkgSession not implemented")
+}
+func (UnimplementedDKGServiceServer) AbortDkg(context.Context, *AbortDkgRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortDkg not implemented")
+}
+func (UnimplementedDKGServiceServer) StartShareRefresh(context.Context, *StartShareRefreshRequest) (*StartShareRefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartShareRefresh not implemented")
+}
+func (UnimplementedDKGServiceServer) InitiateShareRefresh(context.Context, *InitiateShareRefreshRequest) (*InitiateShareRefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateShareRefresh not implemented")
+}
+func (UnimplementedDKGServiceServer) PrepareShareRefresh(context.Context, *PrepareShareRefreshRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareShareRefresh not implemented")
+}
+func (UnimplementedDKGServiceServer) CommitShareRefresh(context.Context, *CommitShareRefreshRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitShareRefresh not implemented")
+}
+func (UnimplementedDKGServiceServer) CompleteShareRefresh(context.Context, *CompleteShareRefreshRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteShareRefresh not implemented")
+}
+func (UnimplementedDKGServiceServer) AbortShareRefresh(context.Context, *AbortShareRefreshRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortShareRefresh not implemented")
+}
+func (UnimplementedDKGServiceServer) StartReshare(context.Context, *StartReshareRequest) (*StartReshareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReshare not implemented")
+}
+func (UnimplementedDKGServiceServer) InitiateReshare(context.Context, *InitiateReshareRequest) (*InitiateReshareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateReshare not implemented")
+}
+func (UnimplementedDKGServiceServer) PrepareReshare(context.Context, *PrepareReshareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareReshare not implemented")
+}
+func (UnimplementedDKGServiceServer) CommitReshare(context.Context, *CommitReshareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReshare not implemented")
+}
+func (UnimplementedDKGServiceServer) CompleteReshare(context.Context, *CompleteReshareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteReshare not implemented")
+}
+func (UnimplementedDKGServiceServer) AbortReshare(context.Context, *AbortReshareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortReshare not implemented")
+}
 func (UnimplementedDKGServiceServer) mustEmbedUnimplementedDKGServiceServer() {}
 func (UnimplementedDKGServiceServer) testEmbeddedByValue()                    {}
 
@@ -315,6 +681,258 @@ func _DKGService_Round2Packages_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DKGService_GetDkgSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDkgSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).GetDkgSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_GetDkgSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).GetDkgSession(ctx, req.(*GetDkgSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_AbortDkg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortDkgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).AbortDkg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_AbortDkg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).AbortDkg(ctx, req.(*AbortDkgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_StartShareRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartShareRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).StartShareRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_StartShareRefresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).StartShareRefresh(ctx, req.(*StartShareRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_InitiateShareRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateShareRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).InitiateShareRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_InitiateShareRefresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).InitiateShareRefresh(ctx, req.(*InitiateShareRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_PrepareShareRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareShareRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).PrepareShareRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_PrepareShareRefresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).PrepareShareRefresh(ctx, req.(*PrepareShareRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_CommitShareRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitShareRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).CommitShareRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_CommitShareRefresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).CommitShareRefresh(ctx, req.(*CommitShareRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_CompleteShareRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteShareRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).CompleteShareRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_CompleteShareRefresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).CompleteShareRefresh(ctx, req.(*CompleteShareRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_AbortShareRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortShareRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).AbortShareRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_AbortShareRefresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).AbortShareRefresh(ctx, req.(*AbortShareRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_StartReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).StartReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_StartReshare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).StartReshare(ctx, req.(*StartReshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_InitiateReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateReshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).InitiateReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_InitiateReshare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).InitiateReshare(ctx, req.(*InitiateReshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_PrepareReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareReshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).PrepareReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_PrepareReshare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).PrepareReshare(ctx, req.(*PrepareReshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_CommitReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).CommitReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_CommitReshare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).CommitReshare(ctx, req.(*CommitReshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_CompleteReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteReshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).CompleteReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_CompleteReshare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).CompleteReshare(ctx, req.(*CompleteReshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DKGService_AbortReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortReshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGServiceServer).AbortReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGService_AbortReshare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGServiceServer).AbortReshare(ctx, req.(*AbortReshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DKGService_ServiceDesc is the grpc.ServiceDesc for DKGService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -342,6 +960,62 @@ var DKGService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "round2_packages",
 			Handler:    _DKGService_Round2Packages_Handler,
 		},
+		{
+			MethodName: "get_dkg_session",
+			Handler:    _DKGService_GetDkgSession_Handler,
+		},
+		{
+			MethodName: "abort_dkg",
+			Handler:    _DKGService_AbortDkg_Handler,
+		},
+		{
+			MethodName: "start_share_refresh",
+			Handler:    _DKGService_StartShareRefresh_Handler,
+		},
+		{
+			MethodName: "initiate_share_refresh",
+			Handler:    _DKGService_InitiateShareRefresh_Handler,
+		},
+		{
+			MethodName: "prepare_share_refresh",
+			Handler:    _DKGService_PrepareShareRefresh_Handler,
+		},
+		{
+			MethodName: "commit_share_refresh",
+			Handler:    _DKGService_CommitShareRefresh_Handler,
+		},
+		{
+			MethodName: "complete_share_refresh",
+			Handler:    _DKGService_CompleteShareRefresh_Handler,
+		},
+		{
+			MethodName: "abort_share_refresh",
+			Handler:    _DKGService_AbortShareRefresh_Handler,
+		},
+		{
+			MethodName: "start_reshare",
+			Handler:    _DKGService_StartReshare_Handler,
+		},
+		{
+			MethodName: "initiate_reshare",
+			Handler:    _DKGService_InitiateReshare_Handler,
+		},
+		{
+			MethodName: "prepare_reshare",
+			Handler:    _DKGService_PrepareReshare_Handler,
+		},
+		{
+			MethodName: "commit_reshare",
+			Handler:    _DKGService_CommitReshare_Handler,
+		},
+		{
+			MethodName: "complete_reshare",
+			Handler:    _DKGService_CompleteReshare_Handler,
+		},
+		{
+			MethodName: "abort_reshare",
+			Handler:    _DKGService_AbortReshare_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dkg.proto",
@@ -1287,6 +1287,154 @@ func (x *ValidateSignatureShareRequest) GetUserCommitments() *common.SigningComm
 	return nil
 }
 
+// Validate signature shares request
+//
+// This request validates a batch of signature shares at once. Signature shares that do not verify
+// are reported in the response, while requests that cannot be checked fail the whole batch.
+type ValidateSignatureSharesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The signature shares to validate.
+	SignatureShares []*ValidateSignatureShareRequest `protobuf:"bytes,1,rep,name=signature_shares,json=signatureShares,proto3" json:"signature_shares,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValidateSignatureSharesRequest) Reset() {
+	*x = ValidateSignatureSharesRequest{}
+	mi := &file_frost_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateSignatureSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSignatureSharesRequest) ProtoMessage() {}
+
+func (x *ValidateSignatureSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSignatureSharesRequest.ProtoReflect.Descriptor instead.
+func (*ValidateSignatureSharesRequest) Descriptor() ([]byte, []int) {
+	return file_frost_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ValidateSignatureSharesRequest) GetSignatureShares() []*ValidateSignatureShareRequest {
+	if x != nil {
+		return x.SignatureShares
+	}
+	return nil
+}
+
+type ValidateSignatureSharesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The result of every signature share, in the order of the request.
+	Results       []*SignatureShareValidation `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateSignatureSharesResponse) Reset() {
+	*x = ValidateSignatureSharesResponse{}
+	mi := &file_frost_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateSignatureSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSignatureSharesResponse) ProtoMessage() {}
+
+func (x *ValidateSignatureSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSignatureSharesResponse.ProtoReflect.Descriptor instead.
+func (*ValidateSignatureSharesResponse) Descriptor() ([]byte, []int) {
+	return file_frost_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ValidateSignatureSharesResponse) GetResults() []*SignatureShareValidation {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SignatureShareValidation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the signature share verifies.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Why the signature share does not verify.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignatureShareValidation) Reset() {
+	*x = SignatureShareValidation{}
+	mi := &file_frost_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignatureShareValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureShareValidation) ProtoMessage() {}
+
+func (x *SignatureShareValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignatureShareValidation.ProtoReflect.Descriptor instead.
+func (*SignatureShareValidation) Descriptor() ([]byte, []int) {
+	return file_frost_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SignatureShareValidation) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *SignatureShareValidation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_frost_proto protoreflect.FileDescriptor
 
 const file_frost_proto_rawDesc = "" +
@@ -1312,20 +1460,20 @@ const file_frost_proto_rawDesc = "" +
 	"\x10DkgRound2Request\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12D\n" +
-	"\x14round1_packages_maps\x18\x02 \x03(\v2\x12.common.PackageMapR\x12round1PackagesMaps\"P\n" +
-	"\x11DkgRound2Response\x12;\n" +
-	"\x0fround2_packages\x18\x01 \x03(\v2\x12.common.PackageMapR\x0eround2Packages\"\xbd\x01\n" +
+	"\x14round1_packages_maps\x18\x02 \x03(\v2\x12.common.PackageMapR\x12round1PackagesMaps\"V\n" +
+	"\x11DkgRound2Response\x12A\n" +
+	"\x0fround2_packages\x18\x01 \x03(\v2\x12.common.PackageMapB\x04\x80\xb5\x18\x01R\x0eround2Packages\"\xc3\x01\n" +
 	"\x10DkgRound3Request\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12D\n" +
-	"\x14round1_packages_maps\x18\x02 \x03(\v2\x12.common.PackageMapR\x12round1PackagesMaps\x12D\n" +
-	"\x14round2_packages_maps\x18\x03 \x03(\v2\x12.common.PackageMapR\x12round2PackagesMaps\"\x9a\x02\n" +
+	"\x14round1_packages_maps\x18\x02 \x03(\v2\x12.common.PackageMapR\x12round1PackagesMaps\x12J\n" +
+	"\x14round2_packages_maps\x18\x03 \x03(\v2\x12.common.PackageMapB\x04\x80\xb5\x18\x01R\x12round2PackagesMaps\"\xa0\x02\n" +
 	"\n" +
 	"KeyPackage\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
-	"identifier\x12!\n" +
-	"\fsecret_share\x18\x02 \x01(\fR\vsecretShare\x12H\n" +
+	"identifier\x12'\n" +
+	"\fsecret_share\x18\x02 \x01(\fB\x04\x80\xb5\x18\x01R\vsecretShare\x12H\n" +
 	"\rpublic_shares\x18\x03 \x03(\v2#.frost.KeyPackage.PublicSharesEntryR\fpublicShares\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x04 \x01(\fR\tpublicKey\x12\x1f\n" +
@@ -1335,10 +1483,10 @@ const file_frost_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"I\n" +
 	"\x11DkgRound3Response\x124\n" +
-	"\fkey_packages\x18\x01 \x03(\v2\x11.frost.KeyPackageR\vkeyPackages\"@\n" +
-	"\fSigningNonce\x12\x16\n" +
-	"\x06hiding\x18\x01 \x01(\fR\x06hiding\x12\x18\n" +
-	"\abinding\x18\x02 \x01(\fR\abinding\"I\n" +
+	"\fkey_packages\x18\x01 \x03(\v2\x11.frost.KeyPackageR\vkeyPackages\"L\n" +
+	"\fSigningNonce\x12\x1c\n" +
+	"\x06hiding\x18\x01 \x01(\fB\x04\x80\xb5\x18\x01R\x06hiding\x12\x1e\n" +
+	"\abinding\x18\x02 \x01(\fB\x04\x80\xb5\x18\x01R\abinding\"I\n" +
 	"\x11FrostNonceRequest\x124\n" +
 	"\fkey_packages\x18\x01 \x03(\v2\x11.frost.KeyPackageR\vkeyPackages\"~\n" +
 	"\x12SigningNonceResult\x12+\n" +
@@ -1401,11 +1549,18 @@ const file_frost_proto_rawDesc = "" +
 	"\x10user_commitments\x18\b \x01(\v2\x19.common.SigningCommitmentR\x0fuserCommitments\x1aY\n" +
 	"\x10CommitmentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.common.SigningCommitmentR\x05value:\x028\x01*'\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.common.SigningCommitmentR\x05value:\x028\x01\"q\n" +
+	"\x1eValidateSignatureSharesRequest\x12O\n" +
+	"\x10signature_shares\x18\x01 \x03(\v2$.frost.ValidateSignatureShareRequestR\x0fsignatureShares\"\\\n" +
+	"\x1fValidateSignatureSharesResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.frost.SignatureShareValidationR\aresults\"H\n" +
+	"\x18SignatureShareValidation\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason*'\n" +
 	"\vSigningRole\x12\x0e\n" +
 	"\n" +
 	"STATECHAIN\x10\x00\x12\b\n" +
-	"\x04USER\x10\x012\xc1\x04\n" +
+	"\x04USER\x10\x012\xaf\x05\n" +
 	"\fFrostService\x121\n" +
 	"\x04echo\x12\x12.frost.EchoRequest\x1a\x13.frost.EchoResponse\"\x00\x12A\n" +
 	"\n" +
@@ -1418,7 +1573,8 @@ const file_frost_proto_rawDesc = "" +
 	"\n" +
 	"sign_frost\x12\x17.frost.SignFrostRequest\x1a\x18.frost.SignFrostResponse\"\x00\x12P\n" +
 	"\x0faggregate_frost\x12\x1c.frost.AggregateFrostRequest\x1a\x1d.frost.AggregateFrostResponse\"\x00\x12Z\n" +
-	"\x18validate_signature_share\x12$.frost.ValidateSignatureShareRequest\x1a\x16.google.protobuf.Empty\"\x00B,Z*github.com/lightsparkdev/spark/proto/frostb\x06proto3"
+	"\x18validate_signature_share\x12$.frost.ValidateSignatureShareRequest\x1a\x16.google.protobuf.Empty\"\x00\x12l\n" +
+	"\x19validate_signature_shares\x12%.frost.ValidateSignatureSharesRequest\x1a&.frost.ValidateSignatureSharesResponse\"\x00B,Z*github.com/lightsparkdev/spark/proto/frostb\x06proto3"
 
 var (
 	file_frost_proto_rawDescOnce sync.Once
@@ -1433,90 +1589,97 @@ func file_frost_proto_rawDescGZIP() []byte {
 }
 
 var file_frost_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_frost_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_frost_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_frost_proto_goTypes = []any{
-	(SigningRole)(0),                      // 0: frost.SigningRole
-	(*EchoRequest)(nil),                   // 1: frost.EchoRequest
-	(*EchoResponse)(nil),                  // 2: frost.EchoResponse
-	(*DkgRound1Request)(nil),              // 3: frost.DkgRound1Request
-	(*DkgRound1Response)(nil),             // 4: frost.DkgRound1Response
-	(*DkgRound2Request)(nil),              // 5: frost.DkgRound2Request
-	(*DkgRound2Response)(nil),             // 6: frost.DkgRound2Response
-	(*DkgRound3Request)(nil),              // 7: frost.DkgRound3Request
-	(*KeyPackage)(nil),                    // 8: frost.KeyPackage
-	(*DkgRound3Response)(nil),             // 9: frost.DkgRound3Response
-	(*SigningNonce)(nil),                  // 10: frost.SigningNonce
-	(*FrostNonceRequest)(nil),             // 11: frost.FrostNonceRequest
-	(*SigningNonceResult)(nil),            // 12: frost.SigningNonceResult
-	(*FrostNonceResponse)(nil),            // 13: frost.FrostNonceResponse
-	(*FrostSigningJob)(nil),               // 14: frost.FrostSigningJob
-	(*SignFrostRequest)(nil),              // 15: frost.SignFrostRequest
-	(*SignFrostResponse)(nil),             // 16: frost.SignFrostResponse
-	(*AggregateFrostRequest)(nil),         // 17: frost.AggregateFrostRequest
-	(*AggregateFrostResponse)(nil),        // 18: frost.AggregateFrostResponse
-	(*ValidateSignatureShareRequest)(nil), // 19: frost.ValidateSignatureShareRequest
-	nil,                                   // 20: frost.KeyPackage.PublicSharesEntry
-	nil,                                   // 21: frost.FrostSigningJob.CommitmentsEntry
-	nil,                                   // 22: frost.SignFrostResponse.ResultsEntry
-	nil,                                   // 23: frost.AggregateFrostRequest.SignatureSharesEntry
-	nil,                                   // 24: frost.AggregateFrostRequest.PublicSharesEntry
-	nil,                                   // 25: frost.AggregateFrostRequest.CommitmentsEntry
-	nil,                                   // 26: frost.ValidateSignatureShareRequest.CommitmentsEntry
-	(*common.PackageMap)(nil),             // 27: common.PackageMap
-	(*common.SigningCommitment)(nil),      // 28: common.SigningCommitment
-	(*common.SigningResult)(nil),          // 29: common.SigningResult
-	(*emptypb.Empty)(nil),                 // 30: google.protobuf.Empty
+	(SigningRole)(0),                        // 0: frost.SigningRole
+	(*EchoRequest)(nil),                     // 1: frost.EchoRequest
+	(*EchoResponse)(nil),                    // 2: frost.EchoResponse
+	(*DkgRound1Request)(nil),                // 3: frost.DkgRound1Request
+	(*DkgRound1Response)(nil),               // 4: frost.DkgRound1Response
+	(*DkgRound2Request)(nil),                // 5: frost.DkgRound2Request
+	(*DkgRound2Response)(nil),               // 6: frost.DkgRound2Response
+	(*DkgRound3Request)(nil),                // 7: frost.DkgRound3Request
+	(*KeyPackage)(nil),                      // 8: frost.KeyPackage
+	(*DkgRound3Response)(nil),               // 9: frost.DkgRound3Response
+	(*SigningNonce)(nil),                    // 10: frost.SigningNonce
+	(*FrostNonceRequest)(nil),               // 11: frost.FrostNonceRequest
+	(*SigningNonceResult)(nil),              // 12: frost.SigningNonceResult
+	(*FrostNonceResponse)(nil),              // 13: frost.FrostNonceResponse
+	(*FrostSigningJob)(nil),                 // 14: frost.FrostSigningJob
+	(*SignFrostRequest)(nil),                // 15: frost.SignFrostRequest
+	(*SignFrostResponse)(nil),               // 16: frost.SignFrostResponse
+	(*AggregateFrostRequest)(nil),           // 17: frost.AggregateFrostRequest
+	(*AggregateFrostResponse)(nil),          // 18: frost.AggregateFrostResponse
+	(*ValidateSignatureShareRequest)(nil),   // 19: frost.ValidateSignatureShareRequest
+	(*ValidateSignatureSharesRequest)(nil),  // 20: frost.ValidateSignatureSharesRequest
+	(*ValidateSignatureSharesResponse)(nil), // 21: frost.ValidateSignatureSharesResponse
+	(*SignatureShareValidation)(nil),        // 22: frost.SignatureShareValidation
+	nil,                                     // 23: frost.KeyPackage.PublicSharesEntry
+	nil,                                     // 24: frost.FrostSigningJob.CommitmentsEntry
+	nil,                                     // 25: frost.SignFrostResponse.ResultsEntry
+	nil,                                     // 26: frost.AggregateFrostRequest.SignatureSharesEntry
+	nil,                                     // 27: frost.AggregateFrostRequest.PublicSharesEntry
+	nil,                                     // 28: frost.AggregateFrostRequest.CommitmentsEntry
+	nil,                                     // 29: frost.ValidateSignatureShareRequest.CommitmentsEntry
+	(*common.PackageMap)(nil),               // 30: common.PackageMap
+	(*common.SigningCommitment)(nil),        // 31: common.SigningCommitment
+	(*common.SigningResult)(nil),            // 32: common.SigningResult
+	(*emptypb.Empty)(nil),                   // 33: google.protobuf.Empty
 }
 var file_frost_proto_depIdxs = []int32{
-	27, // 0: frost.DkgRound2Request.round1_packages_maps:type_name -> common.PackageMap
-	27, // 1: frost.DkgRound2Response.round2_packages:type_name -> common.PackageMap
-	27, // 2: frost.DkgRound3Request.round1_packages_maps:type_name -> common.PackageMap
-	27, // 3: frost.DkgRound3Request.round2_packages_maps:type_name -> common.PackageMap
-	20, // 4: frost.KeyPackage.public_shares:type_name -> frost.KeyPackage.PublicSharesEntry
+	30, // 0: frost.DkgRound2Request.round1_packages_maps:type_name -> common.PackageMap
+	30, // 1: frost.DkgRound2Response.round2_packages:type_name -> common.PackageMap
+	30, // 2: frost.DkgRound3Request.round1_packages_maps:type_name -> common.PackageMap
+	30, // 3: frost.DkgRound3Request.round2_packages_maps:type_name -> common.PackageMap
+	23, // 4: frost.KeyPackage.public_shares:type_name -> frost.KeyPackage.PublicSharesEntry
 	8,  // 5: frost.DkgRound3Response.key_packages:type_name -> frost.KeyPackage
 	8,  // 6: frost.FrostNonceRequest.key_packages:type_name -> frost.KeyPackage
 	10, // 7: frost.SigningNonceResult.nonces:type_name -> frost.SigningNonce
-	28, // 8: frost.SigningNonceResult.commitments:type_name -> common.SigningCommitment
+	31, // 8: frost.SigningNonceResult.commitments:type_name -> common.SigningCommitment
 	12, // 9: frost.FrostNonceResponse.results:type_name -> frost.SigningNonceResult
 	8,  // 10: frost.FrostSigningJob.key_package:type_name -> frost.KeyPackage
 	10, // 11: frost.FrostSigningJob.nonce:type_name -> frost.SigningNonce
-	21, // 12: frost.FrostSigningJob.commitments:type_name -> frost.FrostSigningJob.CommitmentsEntry
-	28, // 13: frost.FrostSigningJob.user_commitments:type_name -> common.SigningCommitment
+	24, // 12: frost.FrostSigningJob.commitments:type_name -> frost.FrostSigningJob.CommitmentsEntry
+	31, // 13: frost.FrostSigningJob.user_commitments:type_name -> common.SigningCommitment
 	14, // 14: frost.SignFrostRequest.signing_jobs:type_name -> frost.FrostSigningJob
 	0,  // 15: frost.SignFrostRequest.role:type_name -> frost.SigningRole
-	22, // 16: frost.SignFrostResponse.results:type_name -> frost.SignFrostResponse.ResultsEntry
-	23, // 17: frost.AggregateFrostRequest.signature_shares:type_name -> frost.AggregateFrostRequest.SignatureSharesEntry
-	24, // 18: frost.AggregateFrostRequest.public_shares:type_name -> frost.AggregateFrostRequest.PublicSharesEntry
-	25, // 19: frost.AggregateFrostRequest.commitments:type_name -> frost.AggregateFrostRequest.CommitmentsEntry
-	28, // 20: frost.AggregateFrostRequest.user_commitments:type_name -> common.SigningCommitment
+	25, // 16: frost.SignFrostResponse.results:type_name -> frost.SignFrostResponse.ResultsEntry
+	26, // 17: frost.AggregateFrostRequest.signature_shares:type_name -> frost.AggregateFrostRequest.SignatureSharesEntry
+	27, // 18: frost.AggregateFrostRequest.public_shares:type_name -> frost.AggregateFrostRequest.PublicSharesEntry
+	28, // 19: frost.AggregateFrostRequest.commitments:type_name -> frost.AggregateFrostRequest.CommitmentsEntry
+	31, // 20: frost.AggregateFrostRequest.user_commitments:type_name -> common.SigningCommitment
 	0,  // 21: frost.ValidateSignatureShareRequest.role:type_name -> frost.SigningRole
-	26, // 22: frost.ValidateSignatureShareRequest.commitments:type_name -> frost.ValidateSignatureShareRequest.CommitmentsEntry
-	28, // 23: frost.ValidateSignatureShareRequest.user_commitments:type_name -> common.SigningCommitment
-	28, // 24: frost.FrostSigningJob.CommitmentsEntry.value:type_name -> common.SigningCommitment
-	29, // 25: frost.SignFrostResponse.ResultsEntry.value:type_name -> common.SigningResult
-	28, // 26: frost.AggregateFrostRequest.CommitmentsEntry.value:type_name -> common.SigningCommitment
-	28, // 27: frost.ValidateSignatureShareRequest.CommitmentsEntry.value:type_name -> common.SigningCommitment
-	1,  // 28: frost.FrostService.echo:input_type -> frost.EchoRequest
-	3,  // 29: frost.FrostService.dkg_round1:input_type -> frost.DkgRound1Request
-	5,  // 30: frost.FrostService.dkg_round2:input_type -> frost.DkgRound2Request
-	7,  // 31: frost.FrostService.dkg_round3:input_type -> frost.DkgRound3Request
-	11, // 32: frost.FrostService.frost_nonce:input_type -> frost.FrostNonceRequest
-	15, // 33: frost.FrostService.sign_frost:input_type -> frost.SignFrostRequest
-	17, // 34: frost.FrostService.aggregate_frost:input_type -> frost.AggregateFrostRequest
-	19, // 35: frost.FrostService.validate_signature_share:input_type -> frost.ValidateSignatureShareRequest
-	2,  // 36: frost.FrostService.echo:output_type -> frost.EchoResponse
-	4,  // 37: frost.FrostService.dkg_round1:output_type -> frost.DkgRound1Response
-	6,  // 38: frost.FrostService.dkg_round2:output_type -> frost.DkgRound2Response
-	9,  // 39: frost.FrostService.dkg_round3:output_type -> frost.DkgRound3Response
-	13, // 40: frost.FrostService.frost_nonce:output_type -> frost.FrostNonceResponse
-	16, // 41: frost.FrostService.sign_frost:output_type -> frost.SignFrostResponse
-	18, // 42: frost.FrostService.aggregate_frost:output_type -> frost.AggregateFrostResponse
-	30, // 43: frost.FrostService.validate_signature_share:output_type -> google.protobuf.Empty
-	36, // [36:44] is the sub-list for method output_type
-	28, // [28:36] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	29, // 22: frost.ValidateSignatureShareRequest.commitments:type_name -> frost.ValidateSignatureShareRequest.CommitmentsEntry
+	31, // 23: frost.ValidateSignatureShareRequest.user_commitments:type_name -> common.SigningCommitment
+	19, // 24: frost.ValidateSignatureSharesRequest.signature_shares:type_name -> frost.ValidateSignatureShareRequest
+	22, // 25: frost.ValidateSignatureSharesResponse.results:type_name -> frost.SignatureShareValidation
+	31, // 26: frost.FrostSigningJob.CommitmentsEntry.value:type_name -> common.SigningCommitment
+	32, // 27: frost.SignFrostResponse.ResultsEntry.value:type_name -> common.SigningResult
+	31, // 28: frost.AggregateFrostRequest.CommitmentsEntry.value:type_name -> common.SigningCommitment
+	31, // 29: frost.ValidateSignatureShareRequest.CommitmentsEntry.value:type_name -> common.SigningCommitment
+	1,  // 30: frost.FrostService.echo:input_type -> frost.EchoRequest
+	3,  // 31: frost.FrostService.dkg_round1:input_type -> frost.DkgRound1Request
+	5,  // 32: frost.FrostService.dkg_round2:input_type -> frost.DkgRound2Request
+	7,  // 33: frost.FrostService.dkg_round3:input_type -> frost.DkgRound3Request
+	11, // 34: frost.FrostService.frost_nonce:input_type -> frost.FrostNonceRequest
+	15, // 35: frost.FrostService.sign_frost:input_type -> frost.SignFrostRequest
+	17, // 36: frost.FrostService.aggregate_frost:input_type -> frost.AggregateFrostRequest
+	19, // 37: frost.FrostService.validate_signature_share:input_type -> frost.ValidateSignatureShareRequest
+	20, // 38: frost.FrostService.validate_signature_shares:input_type -> frost.ValidateSignatureSharesRequest
+	2,  // 39: frost.FrostService.echo:output_type -> frost.EchoResponse
+	4,  // 40: frost.FrostService.dkg_round1:output_type -> frost.DkgRound1Response
+	6,  // 41: frost.FrostService.dkg_round2:output_type -> frost.DkgRound2Response
+	9,  // 42: frost.FrostService.dkg_round3:output_type -> frost.DkgRound3Response
+	13, // 43: frost.FrostService.frost_nonce:output_type -> frost.FrostNonceResponse
+	16, // 44: frost.FrostService.sign_frost:output_type -> frost.SignFrostResponse
+	18, // 45: frost.FrostService.aggregate_frost:output_type -> frost.AggregateFrostResponse
+	33, // 46: frost.FrostService.validate_signature_share:output_type -> google.protobuf.Empty
+	21, // 47: frost.FrostService.validate_signature_shares:output_type -> frost.ValidateSignatureSharesResponse
+	39, // [39:48] is the sub-list for method output_type
+	30, // [30:39] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_frost_proto_init() }
@@ -1530,7 +1693,7 @@ func file_frost_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_frost_proto_rawDesc), len(file_frost_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   26,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2680,3 +2680,383 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ValidateSignatureShareRequestValidationError{}
+
+// Validate checks the field values on ValidateSignatureSharesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateSignatureSharesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateSignatureSharesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ValidateSignatureSharesRequestMultiError, or nil if none found.
+func (m *ValidateSignatureSharesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateSignatureSharesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSignatureShares() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateSignatureSharesRequestValidationError{
+						field:  fmt.Sprintf("SignatureShares[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateSignatureSharesRequestValidationError{
+						field:  fmt.Sprintf("SignatureShares[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateSignatureSharesRequestValidationError{
+					field:  fmt.Sprintf("SignatureShares[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidateSignatureSharesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateSignatureSharesRequestMultiError is an error wrapping multiple
+// validation errors returned by ValidateSignatureSharesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ValidateSignatureSharesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateSignatureSharesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateSignatureSharesRequestMultiError) AllErrors() []error { return m }
+
+// ValidateSignatureSharesRequestValidationError is the validation error
+// returned by ValidateSignatureSharesRequest.Validate if the designated
+// constraints aren't met.
+type ValidateSignatureSharesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateSignatureSharesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateSignatureSharesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateSignatureSharesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateSignatureSharesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateSignatureSharesRequestValidationError) ErrorName() string {
+	return "ValidateSignatureSharesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateSignatureSharesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateSignatureSharesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateSignatureSharesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateSignatureSharesRequestValidationError{}
+
+// Validate checks the field values on ValidateSignatureSharesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateSignatureSharesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateSignatureSharesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ValidateSignatureSharesResponseMultiError, or nil if none found.
+func (m *ValidateSignatureSharesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateSignatureSharesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateSignatureSharesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateSignatureSharesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateSignatureSharesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidateSignatureSharesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateSignatureSharesResponseMultiError is an error wrapping multiple
+// validation errors returned by ValidateSignatureSharesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ValidateSignatureSharesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateSignatureSharesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateSignatureSharesResponseMultiError) AllErrors() []error { return m }
+
+// ValidateSignatureSharesResponseValidationError is the validation error
+// returned by ValidateSignatureSharesResponse.Validate if the designated
+// constraints aren't met.
+type ValidateSignatureSharesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateSignatureSharesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateSignatureSharesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateSignatureSharesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateSignatureSharesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateSignatureSharesResponseValidationError) ErrorName() string {
+	return "ValidateSignatureSharesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateSignatureSharesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateSignatureSharesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateSignatureSharesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateSignatureSharesResponseValidationError{}
+
+// Validate checks the field values on SignatureShareValidation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SignatureShareValidation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignatureShareValidation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SignatureShareValidationMultiError, or nil if none found.
+func (m *SignatureShareValidation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignatureShareValidation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return SignatureShareValidationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignatureShareValidationMultiError is an error wrapping multiple validation
+// errors returned by SignatureShareValidation.ValidateAll() if the designated
+// constraints aren't met.
+type SignatureShareValidationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignatureShareValidationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignatureShareValidationMultiError) AllErrors() []error { return m }
+
+// SignatureShareValidationValidationError is the validation error returned by
+// SignatureShareValidation.Validate if the designated constraints aren't met.
+type SignatureShareValidationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignatureShareValidationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignatureShareValidationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignatureShareValidationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignatureShareValidationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignatureShareValidationValidationError) ErrorName() string {
+	return "SignatureShareValidationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SignatureShareValidationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignatureShareValidation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignatureShareValidationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignatureShareValidationValidationError{}
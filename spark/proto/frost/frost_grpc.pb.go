@@ -20,14 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FrostService_Echo_FullMethodName                   = "/frost.FrostService/echo"
-	FrostService_DkgRound1_FullMethodName              = "/frost.FrostService/dkg_round1"
-	FrostService_DkgRound2_FullMethodName              = "/frost.FrostService/dkg_round2"
-	FrostService_DkgRound3_FullMethodName              = "/frost.FrostService/dkg_round3"
-	FrostService_FrostNonce_FullMethodName             = "/frost.FrostService/frost_nonce"
-	FrostService_SignFrost_FullMethodName              = "/frost.FrostService/sign_frost"
-	FrostService_AggregateFrost_FullMethodName         = "/frost.FrostService/aggregate_frost"
-	FrostService_ValidateSignatureShare_FullMethodName = "/frost.FrostService/validate_signature_share"
+	FrostService_Echo_FullMethodName                    = "/frost.FrostService/echo"
+	FrostService_DkgRound1_FullMethodName               = "/frost.FrostService/dkg_round1"
+	FrostService_DkgRound2_FullMethodName               = "/frost.FrostService/dkg_round2"
+	FrostService_DkgRound3_FullMethodName               = "/frost.FrostService/dkg_round3"
+	FrostService_FrostNonce_FullMethodName              = "/frost.FrostService/frost_nonce"
+	FrostService_SignFrost_FullMethodName               = "/frost.FrostService/sign_frost"
+	FrostService_AggregateFrost_FullMethodName          = "/frost.FrostService/aggregate_frost"
+	FrostService_ValidateSignatureShare_FullMethodName  = "/frost.FrostService/validate_signature_share"
+	FrostService_ValidateSignatureShares_FullMethodName = "/frost.FrostService/validate_signature_shares"
 )
 
 // FrostServiceClient is the client API for FrostService service.
@@ -42,6 +43,7 @@ type FrostServiceClient interface {
 	SignFrost(ctx context.Context, in *SignFrostRequest, opts ...grpc.CallOption) (*SignFrostResponse, error)
 	AggregateFrost(ctx context.Context, in *AggregateFrostRequest, opts ...grpc.CallOption) (*AggregateFrostResponse, error)
 	ValidateSignatureShare(ctx context.Context, in *ValidateSignatureShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ValidateSignatureShares(ctx context.Context, in *ValidateSignatureSharesRequest, opts ...grpc.CallOption) (*ValidateSignatureSharesResponse, error)
 }
 
 type frostServiceClient struct {
@@ -132,6 +134,16 @@ func (c *frostServiceClient) ValidateSignatureShare(ctx context.Context, in *Val
 	return out, nil
 }
 
+func (c *frostServiceClient) ValidateSignatureShares(ctx context.Context, in *ValidateSignatureSharesRequest, opts ...grpc.CallOption) (*ValidateSignatureSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateSignatureSharesResponse)
+	err := c.cc.Invoke(ctx, FrostService_ValidateSignatureShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FrostServiceServer is the server API for FrostService service.
 // All implementations must embed UnimplementedFrostServiceServer
 // for forward compatibility.
@@ -144,6 +156,7 @@ type FrostServiceServer interface {
 	SignFrost(context.Context, *SignFrostRequest) (*SignFrostResponse, error)
 	AggregateFrost(context.Context, *AggregateFrostRequest) (*AggregateFrostResponse, error)
 	ValidateSignatureShare(context.Context, *ValidateSignatureShareRequest) (*emptypb.Empty, error)
+	ValidateSignatureShares(context.Context, *ValidateSignatureSharesRequest) (*ValidateSignatureSharesResponse, error)
 	mustEmbedUnimplementedFrostServiceServer()
 }
 
@@ -178,6 +191,9 @@ func (UnimplementedFrostServiceServer) AggregateFrost(context.Context, *Aggregat
 func (UnimplementedFrostServiceServer) ValidateSignatureShare(context.Context, *ValidateSignatureShareRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateSignatureShare not implemented")
 }
+func (UnimplementedFrostServiceServer) ValidateSignatureShares(context.Context, *ValidateSignatureSharesRequest) (*ValidateSignatureSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSignatureShares not implemented")
+}
 func (UnimplementedFrostServiceServer) mustEmbedUnimplementedFrostServiceServer() {}
 func (UnimplementedFrostServiceServer) testEmbeddedByValue()                      {}
 
@@ -343,6 +359,24 @@ func _FrostService_ValidateSignatureShare_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrostService_ValidateSignatureShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSignatureSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrostServiceServer).ValidateSignatureShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrostService_ValidateSignatureShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrostServiceServer).ValidateSignatureShares(ctx, req.(*ValidateSignatureSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FrostService_ServiceDesc is the grpc.ServiceDesc for FrostService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -382,6 +416,10 @@ var FrostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "validate_signature_share",
 			Handler:    _FrostService_ValidateSignatureShare_Handler,
 		},
+		{
+			MethodName: "validate_signature_shares",
+			Handler:    _FrostService_ValidateSignatureShares_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "frost.proto",
@@ -8,6 +8,7 @@ package lrc20
 
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/lightsparkdev/spark/proto/common"
 	spark "github.com/lightsparkdev/spark/proto/spark"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -600,7 +601,7 @@ var File_lrc20_proto protoreflect.FileDescriptor
 
 const file_lrc20_proto_rawDesc = "" +
 	"\n" +
-	"\vlrc20.proto\x12\x06rpc.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\vspark.proto\"\x82\x03\n" +
+	"\vlrc20.proto\x12\x06rpc.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\vspark.proto\x1a\fcommon.proto\"\x82\x03\n" +
 	"\x19SendSparkSignatureRequest\x12O\n" +
 	"\x17final_token_transaction\x18\x01 \x01(\v2\x17.spark.TokenTransactionR\x15finalTokenTransaction\x12g\n" +
 	"\x1coperator_specific_signatures\x18\x02 \x03(\v2%.spark.OperatorSpecificOwnerSignatureR\x1aoperatorSpecificSignatures\x12Z\n" +
@@ -608,10 +609,10 @@ const file_lrc20_proto_rawDesc = "" +
 	"\x12revocation_secrets\x18\x04 \x03(\v2 .spark.RevocationSecretWithIndexR\x11revocationSecrets\"\xab\x01\n" +
 	"\x1aSparkOperatorSignatureData\x12C\n" +
 	"\x18spark_operator_signature\x18\x01 \x01(\fB\t\xfaB\x06z\x04\x10@\x18IR\x16sparkOperatorSignature\x12H\n" +
-	"\x1coperator_identity_public_key\x18\x02 \x01(\fB\a\xfaB\x04z\x02h!R\x19operatorIdentityPublicKey\"\x9e\x01\n" +
+	"\x1coperator_identity_public_key\x18\x02 \x01(\fB\a\xfaB\x04z\x02h!R\x19operatorIdentityPublicKey\"\xa4\x01\n" +
 	"\x18SparkSignatureOutputData\x12,\n" +
-	"\x12spent_output_index\x18\x01 \x01(\rR\x10spentOutputIndex\x129\n" +
-	"\x16revocation_private_key\x18\x02 \x01(\fH\x00R\x14revocationPrivateKey\x88\x01\x01B\x19\n" +
+	"\x12spent_output_index\x18\x01 \x01(\rR\x10spentOutputIndex\x12?\n" +
+	"\x16revocation_private_key\x18\x02 \x01(\fB\x04\x80\xb5\x18\x01H\x00R\x14revocationPrivateKey\x88\x01\x01B\x19\n" +
 	"\x17_revocation_private_key\"T\n" +
 	"\x11GetSparkTxRequest\x12?\n" +
 	"\x1cfinal_token_transaction_hash\x18\x01 \x01(\fR\x19finalTokenTransactionHash\"g\n" +
@@ -793,7 +793,12 @@ type Address struct {
 	// The proof of possession of the address by the SE.
 	DepositAddressProof *DepositAddressProof `protobuf:"bytes,3,opt,name=deposit_address_proof,json=depositAddressProof,proto3" json:"deposit_address_proof,omitempty"`
 	// Is it a static deposit address
-	IsStatic      bool `protobuf:"varint,5,opt,name=is_static,json=isStatic,proto3" json:"is_static,omitempty"`
+	IsStatic bool `protobuf:"varint,5,opt,name=is_static,json=isStatic,proto3" json:"is_static,omitempty"`
+	// When the address expires if it has not received funds by then. Funds sent to an address
+	// after it expired may not be claimable, as its keyshare is recycled for new addresses once
+	// the operators have scanned the chain for a grace period past its expiry without seeing any.
+	// Static addresses never expire.
+	ExpiryTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,oneof" json:"expiry_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -856,6 +861,13 @@ func (x *Address) GetIsStatic() bool {
 	return false
 }
 
+func (x *Address) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
 // *
 // GenerateDepositAddressResponse is the response to the request to generate a deposit address.
 type GenerateDepositAddressResponse struct {
@@ -4455,13 +4467,16 @@ type TransferFilter struct {
 	//	*TransferFilter_ReceiverIdentityPublicKey
 	//	*TransferFilter_SenderIdentityPublicKey
 	//	*TransferFilter_SenderOrReceiverIdentityPublicKey
-	Participant   isTransferFilter_Participant `protobuf_oneof:"participant"`
-	TransferIds   []string                     `protobuf:"bytes,3,rep,name=transfer_ids,json=transferIds,proto3" json:"transfer_ids,omitempty"`
-	Limit         int64                        `protobuf:"varint,40,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int64                        `protobuf:"varint,50,opt,name=offset,proto3" json:"offset,omitempty"`
-	Types         []TransferType               `protobuf:"varint,70,rep,packed,name=types,proto3,enum=spark.TransferType" json:"types,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Participant isTransferFilter_Participant `protobuf_oneof:"participant"`
+	TransferIds []string                     `protobuf:"bytes,3,rep,name=transfer_ids,json=transferIds,proto3" json:"transfer_ids,omitempty"`
+	Limit       int64                        `protobuf:"varint,40,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset      int64                        `protobuf:"varint,50,opt,name=offset,proto3" json:"offset,omitempty"`
+	Types       []TransferType               `protobuf:"varint,70,rep,packed,name=types,proto3,enum=spark.TransferType" json:"types,omitempty"`
+	// Also returns transfers moved to the archive by the retention policy, after the others.
+	// Ignored when querying pending transfers.
+	IncludeArchived bool `protobuf:"varint,80,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *TransferFilter) Reset() {
@@ -4556,6 +4571,13 @@ func (x *TransferFilter) GetTypes() []TransferType {
 	return nil
 }
 
+func (x *TransferFilter) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type isTransferFilter_Participant interface {
 	isTransferFilter_Participant()
 }
@@ -7410,8 +7432,10 @@ type DepositAddressQueryResult struct {
 	UserSigningPublicKey []byte                 `protobuf:"bytes,2,opt,name=user_signing_public_key,json=userSigningPublicKey,proto3" json:"user_signing_public_key,omitempty"`
 	VerifyingPublicKey   []byte                 `protobuf:"bytes,3,opt,name=verifying_public_key,json=verifyingPublicKey,proto3" json:"verifying_public_key,omitempty"`
 	LeafId               *string                `protobuf:"bytes,4,opt,name=leaf_id,json=leafId,proto3,oneof" json:"leaf_id,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// When the address expires if it has not received funds by then.
+	ExpiryTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3,oneof" json:"expiry_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DepositAddressQueryResult) Reset() {
@@ -7472,6 +7496,13 @@ func (x *DepositAddressQueryResult) GetLeafId() string {
 	return ""
 }
 
+func (x *DepositAddressQueryResult) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
 type QueryUnusedDepositAddressesResponse struct {
 	state            protoimpl.MessageState       `protogen:"open.v1"`
 	DepositAddresses []*DepositAddressQueryResult `protobuf:"bytes,1,rep,name=deposit_addresses,json=depositAddresses,proto3" json:"deposit_addresses,omitempty"`
@@ -8089,12 +8120,15 @@ const file_spark_proto_rawDesc = "" +
 	"\n" +
 	"\b_leaf_idB\f\n" +
 	"\n" +
-	"_is_static\"\xb5\x01\n" +
+	"_is_static\"\x87\x02\n" +
 	"\aAddress\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12#\n" +
 	"\rverifying_key\x18\x02 \x01(\fR\fverifyingKey\x12N\n" +
 	"\x15deposit_address_proof\x18\x03 \x01(\v2\x1a.spark.DepositAddressProofR\x13depositAddressProof\x12\x1b\n" +
-	"\tis_static\x18\x05 \x01(\bR\bisStatic\"Y\n" +
+	"\tis_static\x18\x05 \x01(\bR\bisStatic\x12@\n" +
+	"\vexpiry_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"expiryTime\x88\x01\x01B\x0e\n" +
+	"\f_expiry_time\"Y\n" +
 	"\x1eGenerateDepositAddressResponse\x127\n" +
 	"\x0fdeposit_address\x18\x01 \x01(\v2\x0e.spark.AddressR\x0edepositAddress\"y\n" +
 	"\x04UTXO\x12\x15\n" +
@@ -8208,18 +8242,18 @@ const file_spark_proto_rawDesc = "" +
 	"\x1bSignTokenTransactionRequest\x12O\n" +
 	"\x17final_token_transaction\x18\x01 \x01(\v2\x17.spark.TokenTransactionR\x15finalTokenTransaction\x12g\n" +
 	"\x1coperator_specific_signatures\x18\x02 \x03(\v2%.spark.OperatorSpecificOwnerSignatureR\x1aoperatorSpecificSignatures\x127\n" +
-	"\x13identity_public_key\x18\x03 \x01(\fB\a\xfaB\x04z\x02h!R\x11identityPublicKey\"Y\n" +
+	"\x13identity_public_key\x18\x03 \x01(\fB\a\xfaB\x04z\x02h!R\x11identityPublicKey\"]\n" +
 	"\x11KeyshareWithIndex\x12\x1f\n" +
 	"\vinput_index\x18\x01 \x01(\rR\n" +
-	"inputIndex\x12#\n" +
-	"\bkeyshare\x18\x02 \x01(\fB\a\xfaB\x04z\x02h R\bkeyshare\"\xb0\x01\n" +
+	"inputIndex\x12'\n" +
+	"\bkeyshare\x18\x02 \x01(\fB\v\xfaB\x04z\x02h \x80\xb5\x18\x01R\bkeyshare\"\xb0\x01\n" +
 	"\x1cSignTokenTransactionResponse\x12C\n" +
 	"\x18spark_operator_signature\x18\x01 \x01(\fB\t\xfaB\x06z\x04\x10@\x18IR\x16sparkOperatorSignature\x12K\n" +
-	"\x14revocation_keyshares\x18\x02 \x03(\v2\x18.spark.KeyshareWithIndexR\x13revocationKeyshares\"r\n" +
+	"\x14revocation_keyshares\x18\x02 \x03(\v2\x18.spark.KeyshareWithIndexR\x13revocationKeyshares\"v\n" +
 	"\x19RevocationSecretWithIndex\x12\x1f\n" +
 	"\vinput_index\x18\x01 \x01(\rR\n" +
-	"inputIndex\x124\n" +
-	"\x11revocation_secret\x18\x02 \x01(\fB\a\xfaB\x04z\x02h R\x10revocationSecret\"\xfc\x01\n" +
+	"inputIndex\x128\n" +
+	"\x11revocation_secret\x18\x02 \x01(\fB\v\xfaB\x04z\x02h \x80\xb5\x18\x01R\x10revocationSecret\"\xfc\x01\n" +
 	"\x1fFinalizeTokenTransactionRequest\x12O\n" +
 	"\x17final_token_transaction\x18\x01 \x01(\v2\x17.spark.TokenTransactionR\x15finalTokenTransaction\x12O\n" +
 	"\x12revocation_secrets\x18\x02 \x03(\v2 .spark.RevocationSecretWithIndexR\x11revocationSecrets\x127\n" +
@@ -8280,9 +8314,9 @@ const file_spark_proto_rawDesc = "" +
 	"\x06intent\x18\x01 \x01(\x0e2\x17.common.SignatureIntentR\x06intent\x12>\n" +
 	"\x0fnode_signatures\x18\x02 \x03(\v2\x15.spark.NodeSignaturesR\x0enodeSignatures\"G\n" +
 	"\x1eFinalizeNodeSignaturesResponse\x12%\n" +
-	"\x05nodes\x18\x01 \x03(\v2\x0f.spark.TreeNodeR\x05nodes\"H\n" +
-	"\vSecretShare\x12!\n" +
-	"\fsecret_share\x18\x01 \x01(\fR\vsecretShare\x12\x16\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x0f.spark.TreeNodeR\x05nodes\"N\n" +
+	"\vSecretShare\x12'\n" +
+	"\fsecret_share\x18\x01 \x01(\fB\x04\x80\xb5\x18\x01R\vsecretShare\x12\x16\n" +
 	"\x06proofs\x18\x02 \x03(\fR\x06proofs\"%\n" +
 	"\vSecretProof\x12\x16\n" +
 	"\x06proofs\x18\x01 \x03(\fR\x06proofs\"w\n" +
@@ -8323,21 +8357,21 @@ const file_spark_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x12.spark.SecretProofR\x05value:\x028\x01\"\x8f\x01\n" +
 	"\x15StartTransferResponse\x12+\n" +
 	"\btransfer\x18\x01 \x01(\v2\x0f.spark.TransferR\btransfer\x12I\n" +
-	"\x0fsigning_results\x18\x02 \x03(\v2 .spark.LeafRefundTxSigningResultR\x0esigningResults\"\x9a\x02\n" +
+	"\x0fsigning_results\x18\x02 \x03(\v2 .spark.LeafRefundTxSigningResultR\x0esigningResults\"\xa0\x02\n" +
 	"\x0fTransferPackage\x12C\n" +
-	"\x0eleaves_to_send\x18\x01 \x03(\v2\x1d.spark.UserSignedTxSigningJobR\fleavesToSend\x12W\n" +
-	"\x11key_tweak_package\x18\x02 \x03(\v2+.spark.TransferPackage.KeyTweakPackageEntryR\x0fkeyTweakPackage\x12%\n" +
+	"\x0eleaves_to_send\x18\x01 \x03(\v2\x1d.spark.UserSignedTxSigningJobR\fleavesToSend\x12]\n" +
+	"\x11key_tweak_package\x18\x02 \x03(\v2+.spark.TransferPackage.KeyTweakPackageEntryB\x04\x80\xb5\x18\x01R\x0fkeyTweakPackage\x12%\n" +
 	"\x0euser_signature\x18\x03 \x01(\fR\ruserSignature\x1aB\n" +
 	"\x14KeyTweakPackageEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"R\n" +
 	"\x11SendLeafKeyTweaks\x12=\n" +
-	"\x0eleaves_to_send\x18\x01 \x03(\v2\x17.spark.SendLeafKeyTweakR\fleavesToSend\"\x81\x03\n" +
+	"\x0eleaves_to_send\x18\x01 \x03(\v2\x17.spark.SendLeafKeyTweakR\fleavesToSend\"\x87\x03\n" +
 	"\x10SendLeafKeyTweak\x12\x17\n" +
 	"\aleaf_id\x18\x01 \x01(\tR\x06leafId\x12@\n" +
 	"\x12secret_share_tweak\x18\x02 \x01(\v2\x12.spark.SecretShareR\x10secretShareTweak\x12^\n" +
-	"\x13pubkey_shares_tweak\x18\x03 \x03(\v2..spark.SendLeafKeyTweak.PubkeySharesTweakEntryR\x11pubkeySharesTweak\x12#\n" +
-	"\rsecret_cipher\x18\x04 \x01(\fR\fsecretCipher\x12\x1c\n" +
+	"\x13pubkey_shares_tweak\x18\x03 \x03(\v2..spark.SendLeafKeyTweak.PubkeySharesTweakEntryR\x11pubkeySharesTweak\x12)\n" +
+	"\rsecret_cipher\x18\x04 \x01(\fB\x04\x80\xb5\x18\x01R\fsecretCipher\x12\x1c\n" +
 	"\tsignature\x18\x05 \x01(\fR\tsignature\x12)\n" +
 	"\x10refund_signature\x18\x06 \x01(\fR\x0frefundSignature\x1aD\n" +
 	"\x16PubkeySharesTweakEntry\x12\x10\n" +
@@ -8363,12 +8397,12 @@ const file_spark_proto_rawDesc = "" +
 	"\fcreated_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
 	"\fupdated_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12'\n" +
 	"\x04type\x18\n" +
-	" \x01(\x0e2\x13.spark.TransferTypeR\x04type\"\xac\x01\n" +
+	" \x01(\x0e2\x13.spark.TransferTypeR\x04type\"\xb2\x01\n" +
 	"\fTransferLeaf\x12#\n" +
-	"\x04leaf\x18\x01 \x01(\v2\x0f.spark.TreeNodeR\x04leaf\x12#\n" +
-	"\rsecret_cipher\x18\x02 \x01(\fR\fsecretCipher\x12\x1c\n" +
+	"\x04leaf\x18\x01 \x01(\v2\x0f.spark.TreeNodeR\x04leaf\x12)\n" +
+	"\rsecret_cipher\x18\x02 \x01(\fB\x04\x80\xb5\x18\x01R\fsecretCipher\x12\x1c\n" +
 	"\tsignature\x18\x03 \x01(\fR\tsignature\x124\n" +
-	"\x16intermediate_refund_tx\x18\x04 \x01(\fR\x14intermediateRefundTx\"\xf2\x02\n" +
+	"\x16intermediate_refund_tx\x18\x04 \x01(\fR\x14intermediateRefundTx\"\x9d\x03\n" +
 	"\x0eTransferFilter\x12A\n" +
 	"\x1creceiver_identity_public_key\x18\x01 \x01(\fH\x00R\x19receiverIdentityPublicKey\x12=\n" +
 	"\x1asender_identity_public_key\x18\x02 \x01(\fH\x00R\x17senderIdentityPublicKey\x12S\n" +
@@ -8376,7 +8410,8 @@ const file_spark_proto_rawDesc = "" +
 	"\ftransfer_ids\x18\x03 \x03(\tR\vtransferIds\x12\x14\n" +
 	"\x05limit\x18( \x01(\x03R\x05limit\x12\x16\n" +
 	"\x06offset\x182 \x01(\x03R\x06offset\x12)\n" +
-	"\x05types\x18F \x03(\x0e2\x13.spark.TransferTypeR\x05typesB\r\n" +
+	"\x05types\x18F \x03(\x0e2\x13.spark.TransferTypeR\x05types\x12)\n" +
+	"\x10include_archived\x18P \x01(\bR\x0fincludeArchivedB\r\n" +
 	"\vparticipant\"_\n" +
 	"\x16QueryTransfersResponse\x12-\n" +
 	"\ttransfers\x18\x01 \x03(\v2\x0f.spark.TransferR\ttransfers\x12\x16\n" +
@@ -8456,9 +8491,9 @@ const file_spark_proto_rawDesc = "" +
 	"\bfee_sats\x18\x06 \x01(\x04R\afeeSats\"-\n" +
 	"\x06Reason\x12\x0f\n" +
 	"\vREASON_SEND\x10\x00\x12\x12\n" +
-	"\x0eREASON_RECEIVE\x10\x01\"g\n" +
-	"\x1cInitiatePreimageSwapResponse\x12\x1a\n" +
-	"\bpreimage\x18\x01 \x01(\fR\bpreimage\x12+\n" +
+	"\x0eREASON_RECEIVE\x10\x01\"m\n" +
+	"\x1cInitiatePreimageSwapResponse\x12 \n" +
+	"\bpreimage\x18\x01 \x01(\fB\x04\x80\xb5\x18\x01R\bpreimage\x12+\n" +
 	"\btransfer\x18\x02 \x01(\v2\x0f.spark.TransferR\btransfer\"2\n" +
 	"\bOutPoint\x12\x12\n" +
 	"\x04txid\x18\x01 \x01(\fR\x04txid\x12\x12\n" +
@@ -8546,10 +8581,10 @@ const file_spark_proto_rawDesc = "" +
 	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\x12.\n" +
 	"\x13identity_public_key\x18\x02 \x01(\fR\x11identityPublicKey\"i\n" +
 	"\x1eQueryUserSignedRefundsResponse\x12G\n" +
-	"\x13user_signed_refunds\x18\x01 \x03(\v2\x17.spark.UserSignedRefundR\x11userSignedRefunds\"\x87\x01\n" +
+	"\x13user_signed_refunds\x18\x01 \x03(\v2\x17.spark.UserSignedRefundR\x11userSignedRefunds\"\x8d\x01\n" +
 	"\x16ProvidePreimageRequest\x12!\n" +
-	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\x12\x1a\n" +
-	"\bpreimage\x18\x02 \x01(\fR\bpreimage\x12.\n" +
+	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\x12 \n" +
+	"\bpreimage\x18\x02 \x01(\fB\x04\x80\xb5\x18\x01R\bpreimage\x12.\n" +
 	"\x13identity_public_key\x18\x03 \x01(\fR\x11identityPublicKey\"F\n" +
 	"\x17ProvidePreimageResponse\x12+\n" +
 	"\btransfer\x18\x01 \x01(\v2\x0f.spark.TransferR\btransfer\"{\n" +
@@ -8579,14 +8614,17 @@ const file_spark_proto_rawDesc = "" +
 	"\x16CancelTransferResponse\x12+\n" +
 	"\btransfer\x18\x01 \x01(\v2\x0f.spark.TransferR\btransfer\"T\n" +
 	"\"QueryUnusedDepositAddressesRequest\x12.\n" +
-	"\x13identity_public_key\x18\x01 \x01(\fR\x11identityPublicKey\"\xe1\x01\n" +
+	"\x13identity_public_key\x18\x01 \x01(\fR\x11identityPublicKey\"\xb3\x02\n" +
 	"\x19DepositAddressQueryResult\x12'\n" +
 	"\x0fdeposit_address\x18\x01 \x01(\tR\x0edepositAddress\x125\n" +
 	"\x17user_signing_public_key\x18\x02 \x01(\fR\x14userSigningPublicKey\x120\n" +
 	"\x14verifying_public_key\x18\x03 \x01(\fR\x12verifyingPublicKey\x12&\n" +
-	"\aleaf_id\x18\x04 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06leafId\x88\x01\x01B\n" +
+	"\aleaf_id\x18\x04 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06leafId\x88\x01\x01\x12@\n" +
+	"\vexpiry_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"expiryTime\x88\x01\x01B\n" +
 	"\n" +
-	"\b_leaf_id\"t\n" +
+	"\b_leaf_idB\x0e\n" +
+	"\f_expiry_time\"t\n" +
 	"#QueryUnusedDepositAddressesResponse\x12M\n" +
 	"\x11deposit_addresses\x18\x01 \x03(\v2 .spark.DepositAddressQueryResultR\x10depositAddresses\"E\n" +
 	"\x13QueryBalanceRequest\x12.\n" +
@@ -8868,9 +8906,9 @@ var file_spark_proto_goTypes = []any{
 	nil,                                                     // 142: spark.GetSigningOperatorListResponse.SigningOperatorsEntry
 	nil,                                                     // 143: spark.QueryNodesResponse.NodesEntry
 	nil,                                                     // 144: spark.QueryBalanceResponse.NodeBalancesEntry
-	(*common.SigningCommitment)(nil),                        // 145: common.SigningCommitment
-	(common.SignatureIntent)(0),                             // 146: common.SignatureIntent
-	(*timestamppb.Timestamp)(nil),                           // 147: google.protobuf.Timestamp
+	(*timestamppb.Timestamp)(nil),                           // 145: google.protobuf.Timestamp
+	(*common.SigningCommitment)(nil),                        // 146: common.SigningCommitment
+	(common.SignatureIntent)(0),                             // 147: common.SignatureIntent
 	(*emptypb.Empty)(nil),                                   // 148: google.protobuf.Empty
 }
 var file_spark_proto_depIdxs = []int32{
@@ -8882,242 +8920,244 @@ var file_spark_proto_depIdxs = []int32{
 	131, // 5: spark.DepositAddressProof.address_signatures:type_name -> spark.DepositAddressProof.AddressSignaturesEntry
 	0,   // 6: spark.GenerateDepositAddressRequest.network:type_name -> spark.Network
 	11,  // 7: spark.Address.deposit_address_proof:type_name -> spark.DepositAddressProof
-	13,  // 8: spark.GenerateDepositAddressResponse.deposit_address:type_name -> spark.Address
-	0,   // 9: spark.UTXO.network:type_name -> spark.Network
-	145, // 10: spark.SigningJob.signing_nonce_commitment:type_name -> common.SigningCommitment
-	132, // 11: spark.SigningResult.public_keys:type_name -> spark.SigningResult.PublicKeysEntry
-	133, // 12: spark.SigningResult.signing_nonce_commitments:type_name -> spark.SigningResult.SigningNonceCommitmentsEntry
-	134, // 13: spark.SigningResult.signature_shares:type_name -> spark.SigningResult.SignatureSharesEntry
-	18,  // 14: spark.SigningResult.signing_keyshare:type_name -> spark.SigningKeyshare
-	19,  // 15: spark.NodeSignatureShares.node_tx_signing_result:type_name -> spark.SigningResult
-	19,  // 16: spark.NodeSignatureShares.refund_tx_signing_result:type_name -> spark.SigningResult
-	15,  // 17: spark.StartTreeCreationRequest.on_chain_utxo:type_name -> spark.UTXO
-	17,  // 18: spark.StartTreeCreationRequest.root_tx_signing_job:type_name -> spark.SigningJob
-	17,  // 19: spark.StartTreeCreationRequest.refund_tx_signing_job:type_name -> spark.SigningJob
-	20,  // 20: spark.StartTreeCreationResponse.root_node_signature_shares:type_name -> spark.NodeSignatureShares
-	15,  // 21: spark.StartDepositTreeCreationRequest.on_chain_utxo:type_name -> spark.UTXO
-	17,  // 22: spark.StartDepositTreeCreationRequest.root_tx_signing_job:type_name -> spark.SigningJob
-	17,  // 23: spark.StartDepositTreeCreationRequest.refund_tx_signing_job:type_name -> spark.SigningJob
-	20,  // 24: spark.StartDepositTreeCreationResponse.root_node_signature_shares:type_name -> spark.NodeSignatureShares
-	26,  // 25: spark.TokenTransferInput.outputs_to_spend:type_name -> spark.TokenOutputToSpend
-	28,  // 26: spark.TokenTransaction.mint_input:type_name -> spark.TokenMintInput
-	27,  // 27: spark.TokenTransaction.transfer_input:type_name -> spark.TokenTransferInput
-	29,  // 28: spark.TokenTransaction.token_outputs:type_name -> spark.TokenOutput
-	0,   // 29: spark.TokenTransaction.network:type_name -> spark.Network
-	30,  // 30: spark.TokenTransactionWithStatus.token_transaction:type_name -> spark.TokenTransaction
-	1,   // 31: spark.TokenTransactionWithStatus.status:type_name -> spark.TokenTransactionStatus
-	32,  // 32: spark.TokenTransactionSignatures.owner_signatures:type_name -> spark.SignatureWithIndex
-	30,  // 33: spark.StartTokenTransactionRequest.partial_token_transaction:type_name -> spark.TokenTransaction
-	33,  // 34: spark.StartTokenTransactionRequest.token_transaction_signatures:type_name -> spark.TokenTransactionSignatures
-	30,  // 35: spark.StartTokenTransactionResponse.final_token_transaction:type_name -> spark.TokenTransaction
-	18,  // 36: spark.StartTokenTransactionResponse.keyshare_info:type_name -> spark.SigningKeyshare
-	32,  // 37: spark.OperatorSpecificOwnerSignature.owner_signature:type_name -> spark.SignatureWithIndex
-	36,  // 38: spark.OperatorSpecificOwnerSignature.payload:type_name -> spark.OperatorSpecificTokenTransactionSignablePayload
-	30,  // 39: spark.SignTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
-	37,  // 40: spark.SignTokenTransactionRequest.operator_specific_signatures:type_name -> spark.OperatorSpecificOwnerSignature
-	39,  // 41: spark.SignTokenTransactionResponse.revocation_keyshares:type_name -> spark.KeyshareWithIndex
-	30,  // 42: spark.FinalizeTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
-	41,  // 43: spark.FinalizeTokenTransactionRequest.revocation_secrets:type_name -> spark.RevocationSecretWithIndex
-	43,  // 44: spark.FreezeTokensRequest.freeze_tokens_payload:type_name -> spark.FreezeTokensPayload
-	31,  // 45: spark.QueryTokenTransactionsResponse.token_transactions_with_status:type_name -> spark.TokenTransactionWithStatus
-	29,  // 46: spark.OutputWithPreviousTransactionData.output:type_name -> spark.TokenOutput
-	49,  // 47: spark.QueryTokenOutputsResponse.outputs_with_previous_transaction_data:type_name -> spark.OutputWithPreviousTransactionData
-	30,  // 48: spark.CancelSignedTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
-	18,  // 49: spark.TreeNode.signing_keyshare:type_name -> spark.SigningKeyshare
-	0,   // 50: spark.TreeNode.network:type_name -> spark.Network
-	146, // 51: spark.FinalizeNodeSignaturesRequest.intent:type_name -> common.SignatureIntent
-	21,  // 52: spark.FinalizeNodeSignaturesRequest.node_signatures:type_name -> spark.NodeSignatures
-	52,  // 53: spark.FinalizeNodeSignaturesResponse.nodes:type_name -> spark.TreeNode
-	17,  // 54: spark.LeafRefundTxSigningJob.refund_tx_signing_job:type_name -> spark.SigningJob
-	145, // 55: spark.UserSignedTxSigningJob.signing_nonce_commitment:type_name -> common.SigningCommitment
-	82,  // 56: spark.UserSignedTxSigningJob.signing_commitments:type_name -> spark.SigningCommitments
-	19,  // 57: spark.LeafRefundTxSigningResult.refund_tx_signing_result:type_name -> spark.SigningResult
-	58,  // 58: spark.StartUserSignedTransferRequest.leaves_to_send:type_name -> spark.UserSignedTxSigningJob
-	147, // 59: spark.StartUserSignedTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
-	57,  // 60: spark.StartTransferRequest.leaves_to_send:type_name -> spark.LeafRefundTxSigningJob
-	147, // 61: spark.StartTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
-	135, // 62: spark.StartTransferRequest.key_tweak_proofs:type_name -> spark.StartTransferRequest.KeyTweakProofsEntry
-	63,  // 63: spark.StartTransferRequest.transfer_package:type_name -> spark.TransferPackage
-	68,  // 64: spark.StartTransferResponse.transfer:type_name -> spark.Transfer
-	59,  // 65: spark.StartTransferResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
-	58,  // 66: spark.TransferPackage.leaves_to_send:type_name -> spark.UserSignedTxSigningJob
-	136, // 67: spark.TransferPackage.key_tweak_package:type_name -> spark.TransferPackage.KeyTweakPackageEntry
-	65,  // 68: spark.SendLeafKeyTweaks.leaves_to_send:type_name -> spark.SendLeafKeyTweak
-	55,  // 69: spark.SendLeafKeyTweak.secret_share_tweak:type_name -> spark.SecretShare
-	137, // 70: spark.SendLeafKeyTweak.pubkey_shares_tweak:type_name -> spark.SendLeafKeyTweak.PubkeySharesTweakEntry
-	65,  // 71: spark.FinalizeTransferRequest.leaves_to_send:type_name -> spark.SendLeafKeyTweak
-	68,  // 72: spark.FinalizeTransferResponse.transfer:type_name -> spark.Transfer
-	2,   // 73: spark.Transfer.status:type_name -> spark.TransferStatus
-	147, // 74: spark.Transfer.expiry_time:type_name -> google.protobuf.Timestamp
-	69,  // 75: spark.Transfer.leaves:type_name -> spark.TransferLeaf
-	147, // 76: spark.Transfer.created_time:type_name -> google.protobuf.Timestamp
-	147, // 77: spark.Transfer.updated_time:type_name -> google.protobuf.Timestamp
-	3,   // 78: spark.Transfer.type:type_name -> spark.TransferType
-	52,  // 79: spark.TransferLeaf.leaf:type_name -> spark.TreeNode
-	3,   // 80: spark.TransferFilter.types:type_name -> spark.TransferType
-	68,  // 81: spark.QueryTransfersResponse.transfers:type_name -> spark.Transfer
-	55,  // 82: spark.ClaimLeafKeyTweak.secret_share_tweak:type_name -> spark.SecretShare
-	138, // 83: spark.ClaimLeafKeyTweak.pubkey_shares_tweak:type_name -> spark.ClaimLeafKeyTweak.PubkeySharesTweakEntry
-	72,  // 84: spark.ClaimTransferTweakKeysRequest.leaves_to_receive:type_name -> spark.ClaimLeafKeyTweak
-	57,  // 85: spark.ClaimTransferSignRefundsRequest.signing_jobs:type_name -> spark.LeafRefundTxSigningJob
-	139, // 86: spark.ClaimTransferSignRefundsRequest.key_tweak_proofs:type_name -> spark.ClaimTransferSignRefundsRequest.KeyTweakProofsEntry
-	59,  // 87: spark.ClaimTransferSignRefundsResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
-	17,  // 88: spark.AggregateNodesRequest.signing_job:type_name -> spark.SigningJob
-	19,  // 89: spark.AggregateNodesResponse.aggregate_signature:type_name -> spark.SigningResult
-	55,  // 90: spark.StorePreimageShareRequest.preimage_share:type_name -> spark.SecretShare
-	140, // 91: spark.RequestedSigningCommitments.signing_nonce_commitments:type_name -> spark.RequestedSigningCommitments.SigningNonceCommitmentsEntry
-	79,  // 92: spark.GetSigningCommitmentsResponse.signing_commitments:type_name -> spark.RequestedSigningCommitments
-	141, // 93: spark.SigningCommitments.signing_commitments:type_name -> spark.SigningCommitments.SigningCommitmentsEntry
-	82,  // 94: spark.UserSignedRefund.signing_commitments:type_name -> spark.SigningCommitments
-	145, // 95: spark.UserSignedRefund.user_signature_commitment:type_name -> common.SigningCommitment
-	0,   // 96: spark.UserSignedRefund.network:type_name -> spark.Network
-	84,  // 97: spark.InvoiceAmount.invoice_amount_proof:type_name -> spark.InvoiceAmountProof
-	85,  // 98: spark.InitiatePreimageSwapRequest.invoice_amount:type_name -> spark.InvoiceAmount
-	5,   // 99: spark.InitiatePreimageSwapRequest.reason:type_name -> spark.InitiatePreimageSwapRequest.Reason
-	60,  // 100: spark.InitiatePreimageSwapRequest.transfer:type_name -> spark.StartUserSignedTransferRequest
-	68,  // 101: spark.InitiatePreimageSwapResponse.transfer:type_name -> spark.Transfer
-	61,  // 102: spark.CooperativeExitRequest.transfer:type_name -> spark.StartTransferRequest
-	68,  // 103: spark.CooperativeExitResponse.transfer:type_name -> spark.Transfer
-	59,  // 104: spark.CooperativeExitResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
-	61,  // 105: spark.CounterLeafSwapRequest.transfer:type_name -> spark.StartTransferRequest
-	68,  // 106: spark.CounterLeafSwapResponse.transfer:type_name -> spark.Transfer
-	59,  // 107: spark.CounterLeafSwapResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
-	17,  // 108: spark.RefreshTimelockRequest.signing_jobs:type_name -> spark.SigningJob
-	19,  // 109: spark.RefreshTimelockSigningResult.signing_result:type_name -> spark.SigningResult
-	94,  // 110: spark.RefreshTimelockResponse.signing_results:type_name -> spark.RefreshTimelockSigningResult
-	17,  // 111: spark.ExtendLeafRequest.node_tx_signing_job:type_name -> spark.SigningJob
-	17,  // 112: spark.ExtendLeafRequest.refund_tx_signing_job:type_name -> spark.SigningJob
-	19,  // 113: spark.ExtendLeafSigningResult.signing_result:type_name -> spark.SigningResult
-	97,  // 114: spark.ExtendLeafResponse.node_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
-	97,  // 115: spark.ExtendLeafResponse.refund_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
-	99,  // 116: spark.AddressRequestNode.children:type_name -> spark.AddressRequestNode
-	16,  // 117: spark.PrepareTreeAddressRequest.parent_node_output:type_name -> spark.NodeOutput
-	15,  // 118: spark.PrepareTreeAddressRequest.on_chain_utxo:type_name -> spark.UTXO
-	99,  // 119: spark.PrepareTreeAddressRequest.node:type_name -> spark.AddressRequestNode
-	13,  // 120: spark.AddressNode.address:type_name -> spark.Address
-	101, // 121: spark.AddressNode.children:type_name -> spark.AddressNode
-	101, // 122: spark.PrepareTreeAddressResponse.node:type_name -> spark.AddressNode
-	17,  // 123: spark.CreationNode.node_tx_signing_job:type_name -> spark.SigningJob
-	17,  // 124: spark.CreationNode.refund_tx_signing_job:type_name -> spark.SigningJob
-	103, // 125: spark.CreationNode.children:type_name -> spark.CreationNode
-	16,  // 126: spark.CreateTreeRequest.parent_node_output:type_name -> spark.NodeOutput
-	15,  // 127: spark.CreateTreeRequest.on_chain_utxo:type_name -> spark.UTXO
-	103, // 128: spark.CreateTreeRequest.node:type_name -> spark.CreationNode
-	19,  // 129: spark.CreationResponseNode.node_tx_signing_result:type_name -> spark.SigningResult
-	19,  // 130: spark.CreationResponseNode.refund_tx_signing_result:type_name -> spark.SigningResult
-	105, // 131: spark.CreationResponseNode.children:type_name -> spark.CreationResponseNode
-	105, // 132: spark.CreateTreeResponse.node:type_name -> spark.CreationResponseNode
-	142, // 133: spark.GetSigningOperatorListResponse.signing_operators:type_name -> spark.GetSigningOperatorListResponse.SigningOperatorsEntry
-	83,  // 134: spark.QueryUserSignedRefundsResponse.user_signed_refunds:type_name -> spark.UserSignedRefund
-	68,  // 135: spark.ProvidePreimageResponse.transfer:type_name -> spark.Transfer
-	114, // 136: spark.QueryNodesRequest.node_ids:type_name -> spark.TreeNodeIds
-	143, // 137: spark.QueryNodesResponse.nodes:type_name -> spark.QueryNodesResponse.NodesEntry
-	68,  // 138: spark.CancelTransferResponse.transfer:type_name -> spark.Transfer
-	120, // 139: spark.QueryUnusedDepositAddressesResponse.deposit_addresses:type_name -> spark.DepositAddressQueryResult
-	144, // 140: spark.QueryBalanceResponse.node_balances:type_name -> spark.QueryBalanceResponse.NodeBalancesEntry
-	15,  // 141: spark.InitiateUtxoSwapRequest.on_chain_utxo:type_name -> spark.UTXO
-	4,   // 142: spark.InitiateUtxoSwapRequest.request_type:type_name -> spark.UtxoSwapRequestType
-	60,  // 143: spark.InitiateUtxoSwapRequest.transfer:type_name -> spark.StartUserSignedTransferRequest
-	17,  // 144: spark.InitiateUtxoSwapRequest.spend_tx_signing_job:type_name -> spark.SigningJob
-	19,  // 145: spark.InitiateUtxoSwapResponse.spend_tx_signing_result:type_name -> spark.SigningResult
-	68,  // 146: spark.InitiateUtxoSwapResponse.transfer:type_name -> spark.Transfer
-	120, // 147: spark.InitiateUtxoSwapResponse.deposit_address:type_name -> spark.DepositAddressQueryResult
-	17,  // 148: spark.ExitSingleNodeTreeSigningJob.signing_job:type_name -> spark.SigningJob
-	19,  // 149: spark.ExitSingleNodeTreeSigningResult.signing_result:type_name -> spark.SigningResult
-	127, // 150: spark.ExitSingleNodeTreesRequest.signing_jobs:type_name -> spark.ExitSingleNodeTreeSigningJob
-	128, // 151: spark.ExitSingleNodeTreesResponse.signing_results:type_name -> spark.ExitSingleNodeTreeSigningResult
-	145, // 152: spark.SigningResult.SigningNonceCommitmentsEntry.value:type_name -> common.SigningCommitment
-	56,  // 153: spark.StartTransferRequest.KeyTweakProofsEntry.value:type_name -> spark.SecretProof
-	56,  // 154: spark.ClaimTransferSignRefundsRequest.KeyTweakProofsEntry.value:type_name -> spark.SecretProof
-	145, // 155: spark.RequestedSigningCommitments.SigningNonceCommitmentsEntry.value:type_name -> common.SigningCommitment
-	145, // 156: spark.SigningCommitments.SigningCommitmentsEntry.value:type_name -> common.SigningCommitment
-	107, // 157: spark.GetSigningOperatorListResponse.SigningOperatorsEntry.value:type_name -> spark.SigningOperatorInfo
-	52,  // 158: spark.QueryNodesResponse.NodesEntry.value:type_name -> spark.TreeNode
-	12,  // 159: spark.SparkService.generate_deposit_address:input_type -> spark.GenerateDepositAddressRequest
-	24,  // 160: spark.SparkService.start_deposit_tree_creation:input_type -> spark.StartDepositTreeCreationRequest
-	22,  // 161: spark.SparkService.start_tree_creation:input_type -> spark.StartTreeCreationRequest
-	53,  // 162: spark.SparkService.finalize_node_signatures:input_type -> spark.FinalizeNodeSignaturesRequest
-	61,  // 163: spark.SparkService.start_transfer:input_type -> spark.StartTransferRequest
-	66,  // 164: spark.SparkService.finalize_transfer:input_type -> spark.FinalizeTransferRequest
-	117, // 165: spark.SparkService.cancel_transfer:input_type -> spark.CancelTransferRequest
-	70,  // 166: spark.SparkService.query_pending_transfers:input_type -> spark.TransferFilter
-	70,  // 167: spark.SparkService.query_all_transfers:input_type -> spark.TransferFilter
-	73,  // 168: spark.SparkService.claim_transfer_tweak_keys:input_type -> spark.ClaimTransferTweakKeysRequest
-	74,  // 169: spark.SparkService.claim_transfer_sign_refunds:input_type -> spark.ClaimTransferSignRefundsRequest
-	76,  // 170: spark.SparkService.aggregate_nodes:input_type -> spark.AggregateNodesRequest
-	78,  // 171: spark.SparkService.store_preimage_share:input_type -> spark.StorePreimageShareRequest
-	80,  // 172: spark.SparkService.get_signing_commitments:input_type -> spark.GetSigningCommitmentsRequest
-	89,  // 173: spark.SparkService.cooperative_exit:input_type -> spark.CooperativeExitRequest
-	86,  // 174: spark.SparkService.initiate_preimage_swap:input_type -> spark.InitiatePreimageSwapRequest
-	111, // 175: spark.SparkService.provide_preimage:input_type -> spark.ProvidePreimageRequest
-	61,  // 176: spark.SparkService.start_leaf_swap:input_type -> spark.StartTransferRequest
-	91,  // 177: spark.SparkService.leaf_swap:input_type -> spark.CounterLeafSwapRequest
-	91,  // 178: spark.SparkService.counter_leaf_swap:input_type -> spark.CounterLeafSwapRequest
-	93,  // 179: spark.SparkService.refresh_timelock:input_type -> spark.RefreshTimelockRequest
-	96,  // 180: spark.SparkService.extend_leaf:input_type -> spark.ExtendLeafRequest
-	100, // 181: spark.SparkService.prepare_tree_address:input_type -> spark.PrepareTreeAddressRequest
-	104, // 182: spark.SparkService.create_tree:input_type -> spark.CreateTreeRequest
-	148, // 183: spark.SparkService.get_signing_operator_list:input_type -> google.protobuf.Empty
-	115, // 184: spark.SparkService.query_nodes:input_type -> spark.QueryNodesRequest
-	122, // 185: spark.SparkService.query_balance:input_type -> spark.QueryBalanceRequest
-	109, // 186: spark.SparkService.query_user_signed_refunds:input_type -> spark.QueryUserSignedRefundsRequest
-	34,  // 187: spark.SparkService.start_token_transaction:input_type -> spark.StartTokenTransactionRequest
-	38,  // 188: spark.SparkService.sign_token_transaction:input_type -> spark.SignTokenTransactionRequest
-	42,  // 189: spark.SparkService.finalize_token_transaction:input_type -> spark.FinalizeTokenTransactionRequest
-	44,  // 190: spark.SparkService.freeze_tokens:input_type -> spark.FreezeTokensRequest
-	46,  // 191: spark.SparkService.query_token_outputs:input_type -> spark.QueryTokenOutputsRequest
-	47,  // 192: spark.SparkService.query_token_transactions:input_type -> spark.QueryTokenTransactionsRequest
-	51,  // 193: spark.SparkService.cancel_signed_token_transaction:input_type -> spark.CancelSignedTokenTransactionRequest
-	113, // 194: spark.SparkService.return_lightning_payment:input_type -> spark.ReturnLightningPaymentRequest
-	119, // 195: spark.SparkService.query_unused_deposit_addresses:input_type -> spark.QueryUnusedDepositAddressesRequest
-	6,   // 196: spark.SparkService.subscribe_to_events:input_type -> spark.SubscribeToEventsRequest
-	125, // 197: spark.SparkService.initiate_utxo_swap:input_type -> spark.InitiateUtxoSwapRequest
-	129, // 198: spark.SparkService.exit_single_node_trees:input_type -> spark.ExitSingleNodeTreesRequest
-	14,  // 199: spark.SparkService.generate_deposit_address:output_type -> spark.GenerateDepositAddressResponse
-	25,  // 200: spark.SparkService.start_deposit_tree_creation:output_type -> spark.StartDepositTreeCreationResponse
-	23,  // 201: spark.SparkService.start_tree_creation:output_type -> spark.StartTreeCreationResponse
-	54,  // 202: spark.SparkService.finalize_node_signatures:output_type -> spark.FinalizeNodeSignaturesResponse
-	62,  // 203: spark.SparkService.start_transfer:output_type -> spark.StartTransferResponse
-	67,  // 204: spark.SparkService.finalize_transfer:output_type -> spark.FinalizeTransferResponse
-	118, // 205: spark.SparkService.cancel_transfer:output_type -> spark.CancelTransferResponse
-	71,  // 206: spark.SparkService.query_pending_transfers:output_type -> spark.QueryTransfersResponse
-	71,  // 207: spark.SparkService.query_all_transfers:output_type -> spark.QueryTransfersResponse
-	148, // 208: spark.SparkService.claim_transfer_tweak_keys:output_type -> google.protobuf.Empty
-	75,  // 209: spark.SparkService.claim_transfer_sign_refunds:output_type -> spark.ClaimTransferSignRefundsResponse
-	77,  // 210: spark.SparkService.aggregate_nodes:output_type -> spark.AggregateNodesResponse
-	148, // 211: spark.SparkService.store_preimage_share:output_type -> google.protobuf.Empty
-	81,  // 212: spark.SparkService.get_signing_commitments:output_type -> spark.GetSigningCommitmentsResponse
-	90,  // 213: spark.SparkService.cooperative_exit:output_type -> spark.CooperativeExitResponse
-	87,  // 214: spark.SparkService.initiate_preimage_swap:output_type -> spark.InitiatePreimageSwapResponse
-	112, // 215: spark.SparkService.provide_preimage:output_type -> spark.ProvidePreimageResponse
-	62,  // 216: spark.SparkService.start_leaf_swap:output_type -> spark.StartTransferResponse
-	92,  // 217: spark.SparkService.leaf_swap:output_type -> spark.CounterLeafSwapResponse
-	92,  // 218: spark.SparkService.counter_leaf_swap:output_type -> spark.CounterLeafSwapResponse
-	95,  // 219: spark.SparkService.refresh_timelock:output_type -> spark.RefreshTimelockResponse
-	98,  // 220: spark.SparkService.extend_leaf:output_type -> spark.ExtendLeafResponse
-	102, // 221: spark.SparkService.prepare_tree_address:output_type -> spark.PrepareTreeAddressResponse
-	106, // 222: spark.SparkService.create_tree:output_type -> spark.CreateTreeResponse
-	108, // 223: spark.SparkService.get_signing_operator_list:output_type -> spark.GetSigningOperatorListResponse
-	116, // 224: spark.SparkService.query_nodes:output_type -> spark.QueryNodesResponse
-	123, // 225: spark.SparkService.query_balance:output_type -> spark.QueryBalanceResponse
-	110, // 226: spark.SparkService.query_user_signed_refunds:output_type -> spark.QueryUserSignedRefundsResponse
-	35,  // 227: spark.SparkService.start_token_transaction:output_type -> spark.StartTokenTransactionResponse
-	40,  // 228: spark.SparkService.sign_token_transaction:output_type -> spark.SignTokenTransactionResponse
-	148, // 229: spark.SparkService.finalize_token_transaction:output_type -> google.protobuf.Empty
-	45,  // 230: spark.SparkService.freeze_tokens:output_type -> spark.FreezeTokensResponse
-	50,  // 231: spark.SparkService.query_token_outputs:output_type -> spark.QueryTokenOutputsResponse
-	48,  // 232: spark.SparkService.query_token_transactions:output_type -> spark.QueryTokenTransactionsResponse
-	148, // 233: spark.SparkService.cancel_signed_token_transaction:output_type -> google.protobuf.Empty
-	148, // 234: spark.SparkService.return_lightning_payment:output_type -> google.protobuf.Empty
-	121, // 235: spark.SparkService.query_unused_deposit_addresses:output_type -> spark.QueryUnusedDepositAddressesResponse
-	7,   // 236: spark.SparkService.subscribe_to_events:output_type -> spark.SubscribeToEventsResponse
-	126, // 237: spark.SparkService.initiate_utxo_swap:output_type -> spark.InitiateUtxoSwapResponse
-	130, // 238: spark.SparkService.exit_single_node_trees:output_type -> spark.ExitSingleNodeTreesResponse
-	199, // [199:239] is the sub-list for method output_type
-	159, // [159:199] is the sub-list for method input_type
-	159, // [159:159] is the sub-list for extension type_name
-	159, // [159:159] is the sub-list for extension extendee
-	0,   // [0:159] is the sub-list for field type_name
+	145, // 8: spark.Address.expiry_time:type_name -> google.protobuf.Timestamp
+	13,  // 9: spark.GenerateDepositAddressResponse.deposit_address:type_name -> spark.Address
+	0,   // 10: spark.UTXO.network:type_name -> spark.Network
+	146, // 11: spark.SigningJob.signing_nonce_commitment:type_name -> common.SigningCommitment
+	132, // 12: spark.SigningResult.public_keys:type_name -> spark.SigningResult.PublicKeysEntry
+	133, // 13: spark.SigningResult.signing_nonce_commitments:type_name -> spark.SigningResult.SigningNonceCommitmentsEntry
+	134, // 14: spark.SigningResult.signature_shares:type_name -> spark.SigningResult.SignatureSharesEntry
+	18,  // 15: spark.SigningResult.signing_keyshare:type_name -> spark.SigningKeyshare
+	19,  // 16: spark.NodeSignatureShares.node_tx_signing_result:type_name -> spark.SigningResult
+	19,  // 17: spark.NodeSignatureShares.refund_tx_signing_result:type_name -> spark.SigningResult
+	15,  // 18: spark.StartTreeCreationRequest.on_chain_utxo:type_name -> spark.UTXO
+	17,  // 19: spark.StartTreeCreationRequest.root_tx_signing_job:type_name -> spark.SigningJob
+	17,  // 20: spark.StartTreeCreationRequest.refund_tx_signing_job:type_name -> spark.SigningJob
+	20,  // 21: spark.StartTreeCreationResponse.root_node_signature_shares:type_name -> spark.NodeSignatureShares
+	15,  // 22: spark.StartDepositTreeCreationRequest.on_chain_utxo:type_name -> spark.UTXO
+	17,  // 23: spark.StartDepositTreeCreationRequest.root_tx_signing_job:type_name -> spark.SigningJob
+	17,  // 24: spark.StartDepositTreeCreationRequest.refund_tx_signing_job:type_name -> spark.SigningJob
+	20,  // 25: spark.StartDepositTreeCreationResponse.root_node_signature_shares:type_name -> spark.NodeSignatureShares
+	26,  // 26: spark.TokenTransferInput.outputs_to_spend:type_name -> spark.TokenOutputToSpend
+	28,  // 27: spark.TokenTransaction.mint_input:type_name -> spark.TokenMintInput
+	27,  // 28: spark.TokenTransaction.transfer_input:type_name -> spark.TokenTransferInput
+	29,  // 29: spark.TokenTransaction.token_outputs:type_name -> spark.TokenOutput
+	0,   // 30: spark.TokenTransaction.network:type_name -> spark.Network
+	30,  // 31: spark.TokenTransactionWithStatus.token_transaction:type_name -> spark.TokenTransaction
+	1,   // 32: spark.TokenTransactionWithStatus.status:type_name -> spark.TokenTransactionStatus
+	32,  // 33: spark.TokenTransactionSignatures.owner_signatures:type_name -> spark.SignatureWithIndex
+	30,  // 34: spark.StartTokenTransactionRequest.partial_token_transaction:type_name -> spark.TokenTransaction
+	33,  // 35: spark.StartTokenTransactionRequest.token_transaction_signatures:type_name -> spark.TokenTransactionSignatures
+	30,  // 36: spark.StartTokenTransactionResponse.final_token_transaction:type_name -> spark.TokenTransaction
+	18,  // 37: spark.StartTokenTransactionResponse.keyshare_info:type_name -> spark.SigningKeyshare
+	32,  // 38: spark.OperatorSpecificOwnerSignature.owner_signature:type_name -> spark.SignatureWithIndex
+	36,  // 39: spark.OperatorSpecificOwnerSignature.payload:type_name -> spark.OperatorSpecificTokenTransactionSignablePayload
+	30,  // 40: spark.SignTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
+	37,  // 41: spark.SignTokenTransactionRequest.operator_specific_signatures:type_name -> spark.OperatorSpecificOwnerSignature
+	39,  // 42: spark.SignTokenTransactionResponse.revocation_keyshares:type_name -> spark.KeyshareWithIndex
+	30,  // 43: spark.FinalizeTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
+	41,  // 44: spark.FinalizeTokenTransactionRequest.revocation_secrets:type_name -> spark.RevocationSecretWithIndex
+	43,  // 45: spark.FreezeTokensRequest.freeze_tokens_payload:type_name -> spark.FreezeTokensPayload
+	31,  // 46: spark.QueryTokenTransactionsResponse.token_transactions_with_status:type_name -> spark.TokenTransactionWithStatus
+	29,  // 47: spark.OutputWithPreviousTransactionData.output:type_name -> spark.TokenOutput
+	49,  // 48: spark.QueryTokenOutputsResponse.outputs_with_previous_transaction_data:type_name -> spark.OutputWithPreviousTransactionData
+	30,  // 49: spark.CancelSignedTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
+	18,  // 50: spark.TreeNode.signing_keyshare:type_name -> spark.SigningKeyshare
+	0,   // 51: spark.TreeNode.network:type_name -> spark.Network
+	147, // 52: spark.FinalizeNodeSignaturesRequest.intent:type_name -> common.SignatureIntent
+	21,  // 53: spark.FinalizeNodeSignaturesRequest.node_signatures:type_name -> spark.NodeSignatures
+	52,  // 54: spark.FinalizeNodeSignaturesResponse.nodes:type_name -> spark.TreeNode
+	17,  // 55: spark.LeafRefundTxSigningJob.refund_tx_signing_job:type_name -> spark.SigningJob
+	146, // 56: spark.UserSignedTxSigningJob.signing_nonce_commitment:type_name -> common.SigningCommitment
+	82,  // 57: spark.UserSignedTxSigningJob.signing_commitments:type_name -> spark.SigningCommitments
+	19,  // 58: spark.LeafRefundTxSigningResult.refund_tx_signing_result:type_name -> spark.SigningResult
+	58,  // 59: spark.StartUserSignedTransferRequest.leaves_to_send:type_name -> spark.UserSignedTxSigningJob
+	145, // 60: spark.StartUserSignedTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
+	57,  // 61: spark.StartTransferRequest.leaves_to_send:type_name -> spark.LeafRefundTxSigningJob
+	145, // 62: spark.StartTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
+	135, // 63: spark.StartTransferRequest.key_tweak_proofs:type_name -> spark.StartTransferRequest.KeyTweakProofsEntry
+	63,  // 64: spark.StartTransferRequest.transfer_package:type_name -> spark.TransferPackage
+	68,  // 65: spark.StartTransferResponse.transfer:type_name -> spark.Transfer
+	59,  // 66: spark.StartTransferResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
+	58,  // 67: spark.TransferPackage.leaves_to_send:type_name -> spark.UserSignedTxSigningJob
+	136, // 68: spark.TransferPackage.key_tweak_package:type_name -> spark.TransferPackage.KeyTweakPackageEntry
+	65,  // 69: spark.SendLeafKeyTweaks.leaves_to_send:type_name -> spark.SendLeafKeyTweak
+	55,  // 70: spark.SendLeafKeyTweak.secret_share_tweak:type_name -> spark.SecretShare
+	137, // 71: spark.SendLeafKeyTweak.pubkey_shares_tweak:type_name -> spark.SendLeafKeyTweak.PubkeySharesTweakEntry
+	65,  // 72: spark.FinalizeTransferRequest.leaves_to_send:type_name -> spark.SendLeafKeyTweak
+	68,  // 73: spark.FinalizeTransferResponse.transfer:type_name -> spark.Transfer
+	2,   // 74: spark.Transfer.status:type_name -> spark.TransferStatus
+	145, // 75: spark.Transfer.expiry_time:type_name -> google.protobuf.Timestamp
+	69,  // 76: spark.Transfer.leaves:type_name -> spark.TransferLeaf
+	145, // 77: spark.Transfer.created_time:type_name -> google.protobuf.Timestamp
+	145, // 78: spark.Transfer.updated_time:type_name -> google.protobuf.Timestamp
+	3,   // 79: spark.Transfer.type:type_name -> spark.TransferType
+	52,  // 80: spark.TransferLeaf.leaf:type_name -> spark.TreeNode
+	3,   // 81: spark.TransferFilter.types:type_name -> spark.TransferType
+	68,  // 82: spark.QueryTransfersResponse.transfers:type_name -> spark.Transfer
+	55,  // 83: spark.ClaimLeafKeyTweak.secret_share_tweak:type_name -> spark.SecretShare
+	138, // 84: spark.ClaimLeafKeyTweak.pubkey_shares_tweak:type_name -> spark.ClaimLeafKeyTweak.PubkeySharesTweakEntry
+	72,  // 85: spark.ClaimTransferTweakKeysRequest.leaves_to_receive:type_name -> spark.ClaimLeafKeyTweak
+	57,  // 86: spark.ClaimTransferSignRefundsRequest.signing_jobs:type_name -> spark.LeafRefundTxSigningJob
+	139, // 87: spark.ClaimTransferSignRefundsRequest.key_tweak_proofs:type_name -> spark.ClaimTransferSignRefundsRequest.KeyTweakProofsEntry
+	59,  // 88: spark.ClaimTransferSignRefundsResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
+	17,  // 89: spark.AggregateNodesRequest.signing_job:type_name -> spark.SigningJob
+	19,  // 90: spark.AggregateNodesResponse.aggregate_signature:type_name -> spark.SigningResult
+	55,  // 91: spark.StorePreimageShareRequest.preimage_share:type_name -> spark.SecretShare
+	140, // 92: spark.RequestedSigningCommitments.signing_nonce_commitments:type_name -> spark.RequestedSigningCommitments.SigningNonceCommitmentsEntry
+	79,  // 93: spark.GetSigningCommitmentsResponse.signing_commitments:type_name -> spark.RequestedSigningCommitments
+	141, // 94: spark.SigningCommitments.signing_commitments:type_name -> spark.SigningCommitments.SigningCommitmentsEntry
+	82,  // 95: spark.UserSignedRefund.signing_commitments:type_name -> spark.SigningCommitments
+	146, // 96: spark.UserSignedRefund.user_signature_commitment:type_name -> common.SigningCommitment
+	0,   // 97: spark.UserSignedRefund.network:type_name -> spark.Network
+	84,  // 98: spark.InvoiceAmount.invoice_amount_proof:type_name -> spark.InvoiceAmountProof
+	85,  // 99: spark.InitiatePreimageSwapRequest.invoice_amount:type_name -> spark.InvoiceAmount
+	5,   // 100: spark.InitiatePreimageSwapRequest.reason:type_name -> spark.InitiatePreimageSwapRequest.Reason
+	60,  // 101: spark.InitiatePreimageSwapRequest.transfer:type_name -> spark.StartUserSignedTransferRequest
+	68,  // 102: spark.InitiatePreimageSwapResponse.transfer:type_name -> spark.Transfer
+	61,  // 103: spark.CooperativeExitRequest.transfer:type_name -> spark.StartTransferRequest
+	68,  // 104: spark.CooperativeExitResponse.transfer:type_name -> spark.Transfer
+	59,  // 105: spark.CooperativeExitResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
+	61,  // 106: spark.CounterLeafSwapRequest.transfer:type_name -> spark.StartTransferRequest
+	68,  // 107: spark.CounterLeafSwapResponse.transfer:type_name -> spark.Transfer
+	59,  // 108: spark.CounterLeafSwapResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
+	17,  // 109: spark.RefreshTimelockRequest.signing_jobs:type_name -> spark.SigningJob
+	19,  // 110: spark.RefreshTimelockSigningResult.signing_result:type_name -> spark.SigningResult
+	94,  // 111: spark.RefreshTimelockResponse.signing_results:type_name -> spark.RefreshTimelockSigningResult
+	17,  // 112: spark.ExtendLeafRequest.node_tx_signing_job:type_name -> spark.SigningJob
+	17,  // 113: spark.ExtendLeafRequest.refund_tx_signing_job:type_name -> spark.SigningJob
+	19,  // 114: spark.ExtendLeafSigningResult.signing_result:type_name -> spark.SigningResult
+	97,  // 115: spark.ExtendLeafResponse.node_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
+	97,  // 116: spark.ExtendLeafResponse.refund_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
+	99,  // 117: spark.AddressRequestNode.children:type_name -> spark.AddressRequestNode
+	16,  // 118: spark.PrepareTreeAddressRequest.parent_node_output:type_name -> spark.NodeOutput
+	15,  // 119: spark.PrepareTreeAddressRequest.on_chain_utxo:type_name -> spark.UTXO
+	99,  // 120: spark.PrepareTreeAddressRequest.node:type_name -> spark.AddressRequestNode
+	13,  // 121: spark.AddressNode.address:type_name -> spark.Address
+	101, // 122: spark.AddressNode.children:type_name -> spark.AddressNode
+	101, // 123: spark.PrepareTreeAddressResponse.node:type_name -> spark.AddressNode
+	17,  // 124: spark.CreationNode.node_tx_signing_job:type_name -> spark.SigningJob
+	17,  // 125: spark.CreationNode.refund_tx_signing_job:type_name -> spark.SigningJob
+	103, // 126: spark.CreationNode.children:type_name -> spark.CreationNode
+	16,  // 127: spark.CreateTreeRequest.parent_node_output:type_name -> spark.NodeOutput
+	15,  // 128: spark.CreateTreeRequest.on_chain_utxo:type_name -> spark.UTXO
+	103, // 129: spark.CreateTreeRequest.node:type_name -> spark.CreationNode
+	19,  // 130: spark.CreationResponseNode.node_tx_signing_result:type_name -> spark.SigningResult
+	19,  // 131: spark.CreationResponseNode.refund_tx_signing_result:type_name -> spark.SigningResult
+	105, // 132: spark.CreationResponseNode.children:type_name -> spark.CreationResponseNode
+	105, // 133: spark.CreateTreeResponse.node:type_name -> spark.CreationResponseNode
+	142, // 134: spark.GetSigningOperatorListResponse.signing_operators:type_name -> spark.GetSigningOperatorListResponse.SigningOperatorsEntry
+	83,  // 135: spark.QueryUserSignedRefundsResponse.user_signed_refunds:type_name -> spark.UserSignedRefund
+	68,  // 136: spark.ProvidePreimageResponse.transfer:type_name -> spark.Transfer
+	114, // 137: spark.QueryNodesRequest.node_ids:type_name -> spark.TreeNodeIds
+	143, // 138: spark.QueryNodesResponse.nodes:type_name -> spark.QueryNodesResponse.NodesEntry
+	68,  // 139: spark.CancelTransferResponse.transfer:type_name -> spark.Transfer
+	145, // 140: spark.DepositAddressQueryResult.expiry_time:type_name -> google.protobuf.Timestamp
+	120, // 141: spark.QueryUnusedDepositAddressesResponse.deposit_addresses:type_name -> spark.DepositAddressQueryResult
+	144, // 142: spark.QueryBalanceResponse.node_balances:type_name -> spark.QueryBalanceResponse.NodeBalancesEntry
+	15,  // 143: spark.InitiateUtxoSwapRequest.on_chain_utxo:type_name -> spark.UTXO
+	4,   // 144: spark.InitiateUtxoSwapRequest.request_type:type_name -> spark.UtxoSwapRequestType
+	60,  // 145: spark.InitiateUtxoSwapRequest.transfer:type_name -> spark.StartUserSignedTransferRequest
+	17,  // 146: spark.InitiateUtxoSwapRequest.spend_tx_signing_job:type_name -> spark.SigningJob
+	19,  // 147: spark.InitiateUtxoSwapResponse.spend_tx_signing_result:type_name -> spark.SigningResult
+	68,  // 148: spark.InitiateUtxoSwapResponse.transfer:type_name -> spark.Transfer
+	120, // 149: spark.InitiateUtxoSwapResponse.deposit_address:type_name -> spark.DepositAddressQueryResult
+	17,  // 150: spark.ExitSingleNodeTreeSigningJob.signing_job:type_name -> spark.SigningJob
+	19,  // 151: spark.ExitSingleNodeTreeSigningResult.signing_result:type_name -> spark.SigningResult
+	127, // 152: spark.ExitSingleNodeTreesRequest.signing_jobs:type_name -> spark.ExitSingleNodeTreeSigningJob
+	128, // 153: spark.ExitSingleNodeTreesResponse.signing_results:type_name -> spark.ExitSingleNodeTreeSigningResult
+	146, // 154: spark.SigningResult.SigningNonceCommitmentsEntry.value:type_name -> common.SigningCommitment
+	56,  // 155: spark.StartTransferRequest.KeyTweakProofsEntry.value:type_name -> spark.SecretProof
+	56,  // 156: spark.ClaimTransferSignRefundsRequest.KeyTweakProofsEntry.value:type_name -> spark.SecretProof
+	146, // 157: spark.RequestedSigningCommitments.SigningNonceCommitmentsEntry.value:type_name -> common.SigningCommitment
+	146, // 158: spark.SigningCommitments.SigningCommitmentsEntry.value:type_name -> common.SigningCommitment
+	107, // 159: spark.GetSigningOperatorListResponse.SigningOperatorsEntry.value:type_name -> spark.SigningOperatorInfo
+	52,  // 160: spark.QueryNodesResponse.NodesEntry.value:type_name -> spark.TreeNode
+	12,  // 161: spark.SparkService.generate_deposit_address:input_type -> spark.GenerateDepositAddressRequest
+	24,  // 162: spark.SparkService.start_deposit_tree_creation:input_type -> spark.StartDepositTreeCreationRequest
+	22,  // 163: spark.SparkService.start_tree_creation:input_type -> spark.StartTreeCreationRequest
+	53,  // 164: spark.SparkService.finalize_node_signatures:input_type -> spark.FinalizeNodeSignaturesRequest
+	61,  // 165: spark.SparkService.start_transfer:input_type -> spark.StartTransferRequest
+	66,  // 166: spark.SparkService.finalize_transfer:input_type -> spark.FinalizeTransferRequest
+	117, // 167: spark.SparkService.cancel_transfer:input_type -> spark.CancelTransferRequest
+	70,  // 168: spark.SparkService.query_pending_transfers:input_type -> spark.TransferFilter
+	70,  // 169: spark.SparkService.query_all_transfers:input_type -> spark.TransferFilter
+	73,  // 170: spark.SparkService.claim_transfer_tweak_keys:input_type -> spark.ClaimTransferTweakKeysRequest
+	74,  // 171: spark.SparkService.claim_transfer_sign_refunds:input_type -> spark.ClaimTransferSignRefundsRequest
+	76,  // 172: spark.SparkService.aggregate_nodes:input_type -> spark.AggregateNodesRequest
+	78,  // 173: spark.SparkService.store_preimage_share:input_type -> spark.StorePreimageShareRequest
+	80,  // 174: spark.SparkService.get_signing_commitments:input_type -> spark.GetSigningCommitmentsRequest
+	89,  // 175: spark.SparkService.cooperative_exit:input_type -> spark.CooperativeExitRequest
+	86,  // 176: spark.SparkService.initiate_preimage_swap:input_type -> spark.InitiatePreimageSwapRequest
+	111, // 177: spark.SparkService.provide_preimage:input_type -> spark.ProvidePreimageRequest
+	61,  // 178: spark.SparkService.start_leaf_swap:input_type -> spark.StartTransferRequest
+	91,  // 179: spark.SparkService.leaf_swap:input_type -> spark.CounterLeafSwapRequest
+	91,  // 180: spark.SparkService.counter_leaf_swap:input_type -> spark.CounterLeafSwapRequest
+	93,  // 181: spark.SparkService.refresh_timelock:input_type -> spark.RefreshTimelockRequest
+	96,  // 182: spark.SparkService.extend_leaf:input_type -> spark.ExtendLeafRequest
+	100, // 183: spark.SparkService.prepare_tree_address:input_type -> spark.PrepareTreeAddressRequest
+	104, // 184: spark.SparkService.create_tree:input_type -> spark.CreateTreeRequest
+	148, // 185: spark.SparkService.get_signing_operator_list:input_type -> google.protobuf.Empty
+	115, // 186: spark.SparkService.query_nodes:input_type -> spark.QueryNodesRequest
+	122, // 187: spark.SparkService.query_balance:input_type -> spark.QueryBalanceRequest
+	109, // 188: spark.SparkService.query_user_signed_refunds:input_type -> spark.QueryUserSignedRefundsRequest
+	34,  // 189: spark.SparkService.start_token_transaction:input_type -> spark.StartTokenTransactionRequest
+	38,  // 190: spark.SparkService.sign_token_transaction:input_type -> spark.SignTokenTransactionRequest
+	42,  // 191: spark.SparkService.finalize_token_transaction:input_type -> spark.FinalizeTokenTransactionRequest
+	44,  // 192: spark.SparkService.freeze_tokens:input_type -> spark.FreezeTokensRequest
+	46,  // 193: spark.SparkService.query_token_outputs:input_type -> spark.QueryTokenOutputsRequest
+	47,  // 194: spark.SparkService.query_token_transactions:input_type -> spark.QueryTokenTransactionsRequest
+	51,  // 195: spark.SparkService.cancel_signed_token_transaction:input_type -> spark.CancelSignedTokenTransactionRequest
+	113, // 196: spark.SparkService.return_lightning_payment:input_type -> spark.ReturnLightningPaymentRequest
+	119, // 197: spark.SparkService.query_unused_deposit_addresses:input_type -> spark.QueryUnusedDepositAddressesRequest
+	6,   // 198: spark.SparkService.subscribe_to_events:input_type -> spark.SubscribeToEventsRequest
+	125, // 199: spark.SparkService.initiate_utxo_swap:input_type -> spark.InitiateUtxoSwapRequest
+	129, // 200: spark.SparkService.exit_single_node_trees:input_type -> spark.ExitSingleNodeTreesRequest
+	14,  // 201: spark.SparkService.generate_deposit_address:output_type -> spark.GenerateDepositAddressResponse
+	25,  // 202: spark.SparkService.start_deposit_tree_creation:output_type -> spark.StartDepositTreeCreationResponse
+	23,  // 203: spark.SparkService.start_tree_creation:output_type -> spark.StartTreeCreationResponse
+	54,  // 204: spark.SparkService.finalize_node_signatures:output_type -> spark.FinalizeNodeSignaturesResponse
+	62,  // 205: spark.SparkService.start_transfer:output_type -> spark.StartTransferResponse
+	67,  // 206: spark.SparkService.finalize_transfer:output_type -> spark.FinalizeTransferResponse
+	118, // 207: spark.SparkService.cancel_transfer:output_type -> spark.CancelTransferResponse
+	71,  // 208: spark.SparkService.query_pending_transfers:output_type -> spark.QueryTransfersResponse
+	71,  // 209: spark.SparkService.query_all_transfers:output_type -> spark.QueryTransfersResponse
+	148, // 210: spark.SparkService.claim_transfer_tweak_keys:output_type -> google.protobuf.Empty
+	75,  // 211: spark.SparkService.claim_transfer_sign_refunds:output_type -> spark.ClaimTransferSignRefundsResponse
+	77,  // 212: spark.SparkService.aggregate_nodes:output_type -> spark.AggregateNodesResponse
+	148, // 213: spark.SparkService.store_preimage_share:output_type -> google.protobuf.Empty
+	81,  // 214: spark.SparkService.get_signing_commitments:output_type -> spark.GetSigningCommitmentsResponse
+	90,  // 215: spark.SparkService.cooperative_exit:output_type -> spark.CooperativeExitResponse
+	87,  // 216: spark.SparkService.initiate_preimage_swap:output_type -> spark.InitiatePreimageSwapResponse
+	112, // 217: spark.SparkService.provide_preimage:output_type -> spark.ProvidePreimageResponse
+	62,  // 218: spark.SparkService.start_leaf_swap:output_type -> spark.StartTransferResponse
+	92,  // 219: spark.SparkService.leaf_swap:output_type -> spark.CounterLeafSwapResponse
+	92,  // 220: spark.SparkService.counter_leaf_swap:output_type -> spark.CounterLeafSwapResponse
+	95,  // 221: spark.SparkService.refresh_timelock:output_type -> spark.RefreshTimelockResponse
+	98,  // 222: spark.SparkService.extend_leaf:output_type -> spark.ExtendLeafResponse
+	102, // 223: spark.SparkService.prepare_tree_address:output_type -> spark.PrepareTreeAddressResponse
+	106, // 224: spark.SparkService.create_tree:output_type -> spark.CreateTreeResponse
+	108, // 225: spark.SparkService.get_signing_operator_list:output_type -> spark.GetSigningOperatorListResponse
+	116, // 226: spark.SparkService.query_nodes:output_type -> spark.QueryNodesResponse
+	123, // 227: spark.SparkService.query_balance:output_type -> spark.QueryBalanceResponse
+	110, // 228: spark.SparkService.query_user_signed_refunds:output_type -> spark.QueryUserSignedRefundsResponse
+	35,  // 229: spark.SparkService.start_token_transaction:output_type -> spark.StartTokenTransactionResponse
+	40,  // 230: spark.SparkService.sign_token_transaction:output_type -> spark.SignTokenTransactionResponse
+	148, // 231: spark.SparkService.finalize_token_transaction:output_type -> google.protobuf.Empty
+	45,  // 232: spark.SparkService.freeze_tokens:output_type -> spark.FreezeTokensResponse
+	50,  // 233: spark.SparkService.query_token_outputs:output_type -> spark.QueryTokenOutputsResponse
+	48,  // 234: spark.SparkService.query_token_transactions:output_type -> spark.QueryTokenTransactionsResponse
+	148, // 235: spark.SparkService.cancel_signed_token_transaction:output_type -> google.protobuf.Empty
+	148, // 236: spark.SparkService.return_lightning_payment:output_type -> google.protobuf.Empty
+	121, // 237: spark.SparkService.query_unused_deposit_addresses:output_type -> spark.QueryUnusedDepositAddressesResponse
+	7,   // 238: spark.SparkService.subscribe_to_events:output_type -> spark.SubscribeToEventsResponse
+	126, // 239: spark.SparkService.initiate_utxo_swap:output_type -> spark.InitiateUtxoSwapResponse
+	130, // 240: spark.SparkService.exit_single_node_trees:output_type -> spark.ExitSingleNodeTreesResponse
+	201, // [201:241] is the sub-list for method output_type
+	161, // [161:201] is the sub-list for method input_type
+	161, // [161:161] is the sub-list for extension type_name
+	161, // [161:161] is the sub-list for extension extendee
+	0,   // [0:161] is the sub-list for field type_name
 }
 
 func init() { file_spark_proto_init() }
@@ -9131,6 +9171,7 @@ func file_spark_proto_init() {
 		(*SubscribeToEventsResponse_Connected)(nil),
 	}
 	file_spark_proto_msgTypes[6].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[7].OneofWrappers = []any{}
 	file_spark_proto_msgTypes[23].OneofWrappers = []any{}
 	file_spark_proto_msgTypes[24].OneofWrappers = []any{
 		(*TokenTransaction_MintInput)(nil),
@@ -1045,6 +1045,39 @@ func (m *Address) validate(all bool) error {
 
 	// no validation rules for IsStatic
 
+	if m.ExpiryTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiryTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddressValidationError{
+						field:  "ExpiryTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddressValidationError{
+						field:  "ExpiryTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddressValidationError{
+					field:  "ExpiryTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return AddressMultiError(errors)
 	}
@@ -9363,6 +9396,8 @@ func (m *TransferFilter) validate(all bool) error {
 
 	// no validation rules for Offset
 
+	// no validation rules for IncludeArchived
+
 	switch v := m.Participant.(type) {
 	case *TransferFilter_ReceiverIdentityPublicKey:
 		if v == nil {
@@ -16498,6 +16533,39 @@ func (m *DepositAddressQueryResult) validate(all bool) error {
 
 	}
 
+	if m.ExpiryTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiryTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DepositAddressQueryResultValidationError{
+						field:  "ExpiryTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DepositAddressQueryResultValidationError{
+						field:  "ExpiryTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DepositAddressQueryResultValidationError{
+					field:  "ExpiryTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return DepositAddressQueryResultMultiError(errors)
 	}
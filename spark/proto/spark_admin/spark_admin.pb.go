@@ -0,0 +1,2134 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: spark_admin.proto
+
+package spark_admin
+
+import (
+	spark "github.com/lightsparkdev/spark/proto/spark"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TaskRunStatus int32
+
+const (
+	TaskRunStatus_TASK_RUN_STATUS_UNSPECIFIED TaskRunStatus = 0
+	TaskRunStatus_TASK_RUN_STATUS_RUNNING     TaskRunStatus = 1
+	TaskRunStatus_TASK_RUN_STATUS_SUCCEEDED   TaskRunStatus = 2
+	TaskRunStatus_TASK_RUN_STATUS_FAILED      TaskRunStatus = 3
+)
+
+// Enum value maps for TaskRunStatus.
+var (
+	TaskRunStatus_name = map[int32]string{
+		0: "TASK_RUN_STATUS_UNSPECIFIED",
+		1: "TASK_RUN_STATUS_RUNNING",
+		2: "TASK_RUN_STATUS_SUCCEEDED",
+		3: "TASK_RUN_STATUS_FAILED",
+	}
+	TaskRunStatus_value = map[string]int32{
+		"TASK_RUN_STATUS_UNSPECIFIED": 0,
+		"TASK_RUN_STATUS_RUNNING":     1,
+		"TASK_RUN_STATUS_SUCCEEDED":   2,
+		"TASK_RUN_STATUS_FAILED":      3,
+	}
+)
+
+func (x TaskRunStatus) Enum() *TaskRunStatus {
+	p := new(TaskRunStatus)
+	*p = x
+	return p
+}
+
+func (x TaskRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_spark_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskRunStatus) Type() protoreflect.EnumType {
+	return &file_spark_admin_proto_enumTypes[0]
+}
+
+func (x TaskRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRunStatus.Descriptor instead.
+func (TaskRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type TaskRunTrigger int32
+
+const (
+	TaskRunTrigger_TASK_RUN_TRIGGER_UNSPECIFIED TaskRunTrigger = 0
+	TaskRunTrigger_TASK_RUN_TRIGGER_SCHEDULED   TaskRunTrigger = 1
+	TaskRunTrigger_TASK_RUN_TRIGGER_MANUAL      TaskRunTrigger = 2
+)
+
+// Enum value maps for TaskRunTrigger.
+var (
+	TaskRunTrigger_name = map[int32]string{
+		0: "TASK_RUN_TRIGGER_UNSPECIFIED",
+		1: "TASK_RUN_TRIGGER_SCHEDULED",
+		2: "TASK_RUN_TRIGGER_MANUAL",
+	}
+	TaskRunTrigger_value = map[string]int32{
+		"TASK_RUN_TRIGGER_UNSPECIFIED": 0,
+		"TASK_RUN_TRIGGER_SCHEDULED":   1,
+		"TASK_RUN_TRIGGER_MANUAL":      2,
+	}
+)
+
+func (x TaskRunTrigger) Enum() *TaskRunTrigger {
+	p := new(TaskRunTrigger)
+	*p = x
+	return p
+}
+
+func (x TaskRunTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRunTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_spark_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (TaskRunTrigger) Type() protoreflect.EnumType {
+	return &file_spark_admin_proto_enumTypes[1]
+}
+
+func (x TaskRunTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRunTrigger.Descriptor instead.
+func (TaskRunTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type TaskRun struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskName string                 `protobuf:"bytes,2,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	Status   TaskRunStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=spark_admin.TaskRunStatus" json:"status,omitempty"`
+	Trigger  TaskRunTrigger         `protobuf:"varint,4,opt,name=trigger,proto3,enum=spark_admin.TaskRunTrigger" json:"trigger,omitempty"`
+	// The scheduler instance that executed the run.
+	Holder    string                 `protobuf:"bytes,5,opt,name=holder,proto3" json:"holder,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Unset while the run is still in progress.
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Error          string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	ItemsProcessed int64                  `protobuf:"varint,9,opt,name=items_processed,json=itemsProcessed,proto3" json:"items_processed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TaskRun) Reset() {
+	*x = TaskRun{}
+	mi := &file_spark_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRun) ProtoMessage() {}
+
+func (x *TaskRun) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRun.ProtoReflect.Descriptor instead.
+func (*TaskRun) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TaskRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskRun) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *TaskRun) GetStatus() TaskRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskRunStatus_TASK_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *TaskRun) GetTrigger() TaskRunTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return TaskRunTrigger_TASK_RUN_TRIGGER_UNSPECIFIED
+}
+
+func (x *TaskRun) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *TaskRun) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TaskRun) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *TaskRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TaskRun) GetItemsProcessed() int64 {
+	if x != nil {
+		return x.ItemsProcessed
+	}
+	return 0
+}
+
+type TaskInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Interval between two scheduled runs, in seconds.
+	IntervalSeconds uint64 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Most recent runs first.
+	RecentRuns    []*TaskRun `protobuf:"bytes,3,rep,name=recent_runs,json=recentRuns,proto3" json:"recent_runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskInfo) Reset() {
+	*x = TaskInfo{}
+	mi := &file_spark_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInfo) ProtoMessage() {}
+
+func (x *TaskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInfo.ProtoReflect.Descriptor instead.
+func (*TaskInfo) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TaskInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetIntervalSeconds() uint64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *TaskInfo) GetRecentRuns() []*TaskRun {
+	if x != nil {
+		return x.RecentRuns
+	}
+	return nil
+}
+
+type ListTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of recent runs to return per task. Defaults to 1.
+	RecentRunsLimit uint32 `protobuf:"varint,1,opt,name=recent_runs_limit,json=recentRunsLimit,proto3" json:"recent_runs_limit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_spark_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTasksRequest) GetRecentRunsLimit() uint32 {
+	if x != nil {
+		return x.RecentRunsLimit
+	}
+	return 0
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*TaskInfo            `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_spark_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTasksResponse) GetTasks() []*TaskInfo {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type TriggerTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerTaskRequest) Reset() {
+	*x = TriggerTaskRequest{}
+	mi := &file_spark_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerTaskRequest) ProtoMessage() {}
+
+func (x *TriggerTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerTaskRequest.ProtoReflect.Descriptor instead.
+func (*TriggerTaskRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TriggerTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TriggerTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *TaskRun               `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerTaskResponse) Reset() {
+	*x = TriggerTaskResponse{}
+	mi := &file_spark_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerTaskResponse) ProtoMessage() {}
+
+func (x *TriggerTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerTaskResponse.ProtoReflect.Descriptor instead.
+func (*TriggerTaskResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TriggerTaskResponse) GetRun() *TaskRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type GetKeysharePoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeysharePoolRequest) Reset() {
+	*x = GetKeysharePoolRequest{}
+	mi := &file_spark_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeysharePoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeysharePoolRequest) ProtoMessage() {}
+
+func (x *GetKeysharePoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeysharePoolRequest.ProtoReflect.Descriptor instead.
+func (*GetKeysharePoolRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{6}
+}
+
+type NetworkKeyshareCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       spark.Network          `protobuf:"varint,1,opt,name=network,proto3,enum=spark.Network" json:"network,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkKeyshareCount) Reset() {
+	*x = NetworkKeyshareCount{}
+	mi := &file_spark_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkKeyshareCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkKeyshareCount) ProtoMessage() {}
+
+func (x *NetworkKeyshareCount) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkKeyshareCount.ProtoReflect.Descriptor instead.
+func (*NetworkKeyshareCount) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NetworkKeyshareCount) GetNetwork() spark.Network {
+	if x != nil {
+		return x.Network
+	}
+	return spark.Network(0)
+}
+
+func (x *NetworkKeyshareCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetKeysharePoolResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of keyshares by status, e.g. AVAILABLE or IN_USE.
+	CountByStatus map[string]uint64 `protobuf:"bytes,1,rep,name=count_by_status,json=countByStatus,proto3" json:"count_by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Number of available keyshares this operator coordinated, which is what triggers a new DKG
+	// round when it falls below min_available.
+	AvailableCoordinated uint64 `protobuf:"varint,2,opt,name=available_coordinated,json=availableCoordinated,proto3" json:"available_coordinated,omitempty"`
+	MinAvailable         uint64 `protobuf:"varint,3,opt,name=min_available,json=minAvailable,proto3" json:"min_available,omitempty"`
+	// Available keyshares are not bound to a network until they are used, so only keyshares of
+	// tree nodes are counted per network.
+	InUseByNetwork []*NetworkKeyshareCount `protobuf:"bytes,4,rep,name=in_use_by_network,json=inUseByNetwork,proto3" json:"in_use_by_network,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetKeysharePoolResponse) Reset() {
+	*x = GetKeysharePoolResponse{}
+	mi := &file_spark_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeysharePoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeysharePoolResponse) ProtoMessage() {}
+
+func (x *GetKeysharePoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeysharePoolResponse.ProtoReflect.Descriptor instead.
+func (*GetKeysharePoolResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetKeysharePoolResponse) GetCountByStatus() map[string]uint64 {
+	if x != nil {
+		return x.CountByStatus
+	}
+	return nil
+}
+
+func (x *GetKeysharePoolResponse) GetAvailableCoordinated() uint64 {
+	if x != nil {
+		return x.AvailableCoordinated
+	}
+	return 0
+}
+
+func (x *GetKeysharePoolResponse) GetMinAvailable() uint64 {
+	if x != nil {
+		return x.MinAvailable
+	}
+	return 0
+}
+
+func (x *GetKeysharePoolResponse) GetInUseByNetwork() []*NetworkKeyshareCount {
+	if x != nil {
+		return x.InUseByNetwork
+	}
+	return nil
+}
+
+type TriggerDkgRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerDkgRequest) Reset() {
+	*x = TriggerDkgRequest{}
+	mi := &file_spark_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerDkgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerDkgRequest) ProtoMessage() {}
+
+func (x *TriggerDkgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerDkgRequest.ProtoReflect.Descriptor instead.
+func (*TriggerDkgRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{9}
+}
+
+type TriggerDkgResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerDkgResponse) Reset() {
+	*x = TriggerDkgResponse{}
+	mi := &file_spark_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerDkgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerDkgResponse) ProtoMessage() {}
+
+func (x *TriggerDkgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerDkgResponse.ProtoReflect.Descriptor instead.
+func (*TriggerDkgResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{10}
+}
+
+type ListStuckTransfersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only count transfers not updated for at least this long. Defaults to one hour.
+	MinAgeSeconds uint64 `protobuf:"varint,1,opt,name=min_age_seconds,json=minAgeSeconds,proto3" json:"min_age_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckTransfersRequest) Reset() {
+	*x = ListStuckTransfersRequest{}
+	mi := &file_spark_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckTransfersRequest) ProtoMessage() {}
+
+func (x *ListStuckTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListStuckTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListStuckTransfersRequest) GetMinAgeSeconds() uint64 {
+	if x != nil {
+		return x.MinAgeSeconds
+	}
+	return 0
+}
+
+type StuckTransferGroup struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The age range of the group, e.g. "1h-6h" or ">7d".
+	AgeBucket        string                 `protobuf:"bytes,2,opt,name=age_bucket,json=ageBucket,proto3" json:"age_bucket,omitempty"`
+	Count            uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	OldestTransferId string                 `protobuf:"bytes,4,opt,name=oldest_transfer_id,json=oldestTransferId,proto3" json:"oldest_transfer_id,omitempty"`
+	OldestUpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=oldest_update_time,json=oldestUpdateTime,proto3" json:"oldest_update_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StuckTransferGroup) Reset() {
+	*x = StuckTransferGroup{}
+	mi := &file_spark_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StuckTransferGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StuckTransferGroup) ProtoMessage() {}
+
+func (x *StuckTransferGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StuckTransferGroup.ProtoReflect.Descriptor instead.
+func (*StuckTransferGroup) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StuckTransferGroup) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StuckTransferGroup) GetAgeBucket() string {
+	if x != nil {
+		return x.AgeBucket
+	}
+	return ""
+}
+
+func (x *StuckTransferGroup) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StuckTransferGroup) GetOldestTransferId() string {
+	if x != nil {
+		return x.OldestTransferId
+	}
+	return ""
+}
+
+func (x *StuckTransferGroup) GetOldestUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldestUpdateTime
+	}
+	return nil
+}
+
+type ListStuckTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*StuckTransferGroup  `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckTransfersResponse) Reset() {
+	*x = ListStuckTransfersResponse{}
+	mi := &file_spark_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckTransfersResponse) ProtoMessage() {}
+
+func (x *ListStuckTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListStuckTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListStuckTransfersResponse) GetGroups() []*StuckTransferGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ListStuckPreimageRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only list requests created at least this long ago. Defaults to ten minutes.
+	MinAgeSeconds uint64 `protobuf:"varint,1,opt,name=min_age_seconds,json=minAgeSeconds,proto3" json:"min_age_seconds,omitempty"`
+	// Defaults to 100.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckPreimageRequestsRequest) Reset() {
+	*x = ListStuckPreimageRequestsRequest{}
+	mi := &file_spark_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckPreimageRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckPreimageRequestsRequest) ProtoMessage() {}
+
+func (x *ListStuckPreimageRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckPreimageRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListStuckPreimageRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListStuckPreimageRequestsRequest) GetMinAgeSeconds() uint64 {
+	if x != nil {
+		return x.MinAgeSeconds
+	}
+	return 0
+}
+
+func (x *ListStuckPreimageRequestsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type StuckPreimageRequest struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Id                        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PaymentHash               []byte                 `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	ReceiverIdentityPublicKey []byte                 `protobuf:"bytes,3,opt,name=receiver_identity_public_key,json=receiverIdentityPublicKey,proto3" json:"receiver_identity_public_key,omitempty"`
+	CreateTime                *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *StuckPreimageRequest) Reset() {
+	*x = StuckPreimageRequest{}
+	mi := &file_spark_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StuckPreimageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StuckPreimageRequest) ProtoMessage() {}
+
+func (x *StuckPreimageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StuckPreimageRequest.ProtoReflect.Descriptor instead.
+func (*StuckPreimageRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StuckPreimageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StuckPreimageRequest) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *StuckPreimageRequest) GetReceiverIdentityPublicKey() []byte {
+	if x != nil {
+		return x.ReceiverIdentityPublicKey
+	}
+	return nil
+}
+
+func (x *StuckPreimageRequest) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListStuckPreimageRequestsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest requests first.
+	Requests []*StuckPreimageRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// Total number of stuck requests, which may be more than the requests returned.
+	Total         uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckPreimageRequestsResponse) Reset() {
+	*x = ListStuckPreimageRequestsResponse{}
+	mi := &file_spark_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckPreimageRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckPreimageRequestsResponse) ProtoMessage() {}
+
+func (x *ListStuckPreimageRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckPreimageRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListStuckPreimageRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListStuckPreimageRequestsResponse) GetRequests() []*StuckPreimageRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListStuckPreimageRequestsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetChainStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChainStatusRequest) Reset() {
+	*x = GetChainStatusRequest{}
+	mi := &file_spark_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainStatusRequest) ProtoMessage() {}
+
+func (x *GetChainStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetChainStatusRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{17}
+}
+
+type ChainStatus struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network spark.Network          `protobuf:"varint,1,opt,name=network,proto3,enum=spark.Network" json:"network,omitempty"`
+	// The last block height processed by the chain watcher.
+	ProcessedHeight int64                  `protobuf:"varint,2,opt,name=processed_height,json=processedHeight,proto3" json:"processed_height,omitempty"`
+	ProcessedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=processed_time,json=processedTime,proto3" json:"processed_time,omitempty"`
+	// The block height of the bitcoin node. Unset if the node could not be reached.
+	NodeHeight *int64 `protobuf:"varint,4,opt,name=node_height,json=nodeHeight,proto3,oneof" json:"node_height,omitempty"`
+	// Number of blocks the chain watcher is behind the bitcoin node.
+	LagBlocks int64 `protobuf:"varint,5,opt,name=lag_blocks,json=lagBlocks,proto3" json:"lag_blocks,omitempty"`
+	// Why the status is incomplete, if it is.
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainStatus) Reset() {
+	*x = ChainStatus{}
+	mi := &file_spark_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainStatus) ProtoMessage() {}
+
+func (x *ChainStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainStatus.ProtoReflect.Descriptor instead.
+func (*ChainStatus) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChainStatus) GetNetwork() spark.Network {
+	if x != nil {
+		return x.Network
+	}
+	return spark.Network(0)
+}
+
+func (x *ChainStatus) GetProcessedHeight() int64 {
+	if x != nil {
+		return x.ProcessedHeight
+	}
+	return 0
+}
+
+func (x *ChainStatus) GetProcessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedTime
+	}
+	return nil
+}
+
+func (x *ChainStatus) GetNodeHeight() int64 {
+	if x != nil && x.NodeHeight != nil {
+		return *x.NodeHeight
+	}
+	return 0
+}
+
+func (x *ChainStatus) GetLagBlocks() int64 {
+	if x != nil {
+		return x.LagBlocks
+	}
+	return 0
+}
+
+func (x *ChainStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetChainStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Networks      []*ChainStatus         `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChainStatusResponse) Reset() {
+	*x = GetChainStatusResponse{}
+	mi := &file_spark_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainStatusResponse) ProtoMessage() {}
+
+func (x *GetChainStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetChainStatusResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetChainStatusResponse) GetNetworks() []*ChainStatus {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type GetLrc20PoolStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLrc20PoolStatusRequest) Reset() {
+	*x = GetLrc20PoolStatusRequest{}
+	mi := &file_spark_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLrc20PoolStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLrc20PoolStatusRequest) ProtoMessage() {}
+
+func (x *GetLrc20PoolStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLrc20PoolStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetLrc20PoolStatusRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{20}
+}
+
+type Lrc20PoolStatus struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network spark.Network          `protobuf:"varint,1,opt,name=network,proto3,enum=spark.Network" json:"network,omitempty"`
+	// The configured pool size. The pool may burst to twice this size under load.
+	MaxSize uint32 `protobuf:"varint,2,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// Connections currently owned by the pool, idle or in use.
+	OpenConnections uint32 `protobuf:"varint,3,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
+	IdleConnections uint32 `protobuf:"varint,4,opt,name=idle_connections,json=idleConnections,proto3" json:"idle_connections,omitempty"`
+	// Connections in a transient failure or shut down, awaiting replacement.
+	UnhealthyConnections uint32 `protobuf:"varint,5,opt,name=unhealthy_connections,json=unhealthyConnections,proto3" json:"unhealthy_connections,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Lrc20PoolStatus) Reset() {
+	*x = Lrc20PoolStatus{}
+	mi := &file_spark_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lrc20PoolStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lrc20PoolStatus) ProtoMessage() {}
+
+func (x *Lrc20PoolStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lrc20PoolStatus.ProtoReflect.Descriptor instead.
+func (*Lrc20PoolStatus) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Lrc20PoolStatus) GetNetwork() spark.Network {
+	if x != nil {
+		return x.Network
+	}
+	return spark.Network(0)
+}
+
+func (x *Lrc20PoolStatus) GetMaxSize() uint32 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *Lrc20PoolStatus) GetOpenConnections() uint32 {
+	if x != nil {
+		return x.OpenConnections
+	}
+	return 0
+}
+
+func (x *Lrc20PoolStatus) GetIdleConnections() uint32 {
+	if x != nil {
+		return x.IdleConnections
+	}
+	return 0
+}
+
+func (x *Lrc20PoolStatus) GetUnhealthyConnections() uint32 {
+	if x != nil {
+		return x.UnhealthyConnections
+	}
+	return 0
+}
+
+type GetLrc20PoolStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pools         []*Lrc20PoolStatus     `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLrc20PoolStatusResponse) Reset() {
+	*x = GetLrc20PoolStatusResponse{}
+	mi := &file_spark_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLrc20PoolStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLrc20PoolStatusResponse) ProtoMessage() {}
+
+func (x *GetLrc20PoolStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLrc20PoolStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetLrc20PoolStatusResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetLrc20PoolStatusResponse) GetPools() []*Lrc20PoolStatus {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type NetworkPause struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Network         spark.Network          `protobuf:"varint,1,opt,name=network,proto3,enum=spark.Network" json:"network,omitempty"`
+	TransfersPaused bool                   `protobuf:"varint,2,opt,name=transfers_paused,json=transfersPaused,proto3" json:"transfers_paused,omitempty"`
+	DepositsPaused  bool                   `protobuf:"varint,3,opt,name=deposits_paused,json=depositsPaused,proto3" json:"deposits_paused,omitempty"`
+	Reason          string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	UpdateTime      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NetworkPause) Reset() {
+	*x = NetworkPause{}
+	mi := &file_spark_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkPause) ProtoMessage() {}
+
+func (x *NetworkPause) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkPause.ProtoReflect.Descriptor instead.
+func (*NetworkPause) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NetworkPause) GetNetwork() spark.Network {
+	if x != nil {
+		return x.Network
+	}
+	return spark.Network(0)
+}
+
+func (x *NetworkPause) GetTransfersPaused() bool {
+	if x != nil {
+		return x.TransfersPaused
+	}
+	return false
+}
+
+func (x *NetworkPause) GetDepositsPaused() bool {
+	if x != nil {
+		return x.DepositsPaused
+	}
+	return false
+}
+
+func (x *NetworkPause) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *NetworkPause) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type SetNetworkPauseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Network        spark.Network          `protobuf:"varint,1,opt,name=network,proto3,enum=spark.Network" json:"network,omitempty"`
+	PauseTransfers bool                   `protobuf:"varint,2,opt,name=pause_transfers,json=pauseTransfers,proto3" json:"pause_transfers,omitempty"`
+	PauseDeposits  bool                   `protobuf:"varint,3,opt,name=pause_deposits,json=pauseDeposits,proto3" json:"pause_deposits,omitempty"`
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetNetworkPauseRequest) Reset() {
+	*x = SetNetworkPauseRequest{}
+	mi := &file_spark_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNetworkPauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNetworkPauseRequest) ProtoMessage() {}
+
+func (x *SetNetworkPauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNetworkPauseRequest.ProtoReflect.Descriptor instead.
+func (*SetNetworkPauseRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetNetworkPauseRequest) GetNetwork() spark.Network {
+	if x != nil {
+		return x.Network
+	}
+	return spark.Network(0)
+}
+
+func (x *SetNetworkPauseRequest) GetPauseTransfers() bool {
+	if x != nil {
+		return x.PauseTransfers
+	}
+	return false
+}
+
+func (x *SetNetworkPauseRequest) GetPauseDeposits() bool {
+	if x != nil {
+		return x.PauseDeposits
+	}
+	return false
+}
+
+func (x *SetNetworkPauseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetNetworkPauseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pause         *NetworkPause          `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNetworkPauseResponse) Reset() {
+	*x = SetNetworkPauseResponse{}
+	mi := &file_spark_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNetworkPauseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNetworkPauseResponse) ProtoMessage() {}
+
+func (x *SetNetworkPauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNetworkPauseResponse.ProtoReflect.Descriptor instead.
+func (*SetNetworkPauseResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetNetworkPauseResponse) GetPause() *NetworkPause {
+	if x != nil {
+		return x.Pause
+	}
+	return nil
+}
+
+type ListNetworkPausesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworkPausesRequest) Reset() {
+	*x = ListNetworkPausesRequest{}
+	mi := &file_spark_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworkPausesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworkPausesRequest) ProtoMessage() {}
+
+func (x *ListNetworkPausesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworkPausesRequest.ProtoReflect.Descriptor instead.
+func (*ListNetworkPausesRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{26}
+}
+
+type ListNetworkPausesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only networks with a paused operation are listed.
+	Pauses        []*NetworkPause `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworkPausesResponse) Reset() {
+	*x = ListNetworkPausesResponse{}
+	mi := &file_spark_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworkPausesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworkPausesResponse) ProtoMessage() {}
+
+func (x *ListNetworkPausesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworkPausesResponse.ProtoReflect.Descriptor instead.
+func (*ListNetworkPausesResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListNetworkPausesResponse) GetPauses() []*NetworkPause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+type ListConsistencyDiscrepanciesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Also list discrepancies that have since been resolved or repaired.
+	IncludeClosed bool `protobuf:"varint,1,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`
+	// Defaults to 100.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConsistencyDiscrepanciesRequest) Reset() {
+	*x = ListConsistencyDiscrepanciesRequest{}
+	mi := &file_spark_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConsistencyDiscrepanciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsistencyDiscrepanciesRequest) ProtoMessage() {}
+
+func (x *ListConsistencyDiscrepanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsistencyDiscrepanciesRequest.ProtoReflect.Descriptor instead.
+func (*ListConsistencyDiscrepanciesRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListConsistencyDiscrepanciesRequest) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+func (x *ListConsistencyDiscrepanciesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ConsistencyDiscrepancy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// TREE, TRANSFER or TOKEN_OUTPUT.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The tree ID, or the hex encoded owner public key.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// OPEN, RESOLVED or REPAIRED.
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// The hex encoded digest of every operator, by operator identifier. Empty for an operator that
+	// holds no state for the key.
+	Digests map[string]string `protobuf:"bytes,5,rep,name=digests,proto3" json:"digests,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The operators that disagree with the majority.
+	DivergentOperators []string               `protobuf:"bytes,6,rep,name=divergent_operators,json=divergentOperators,proto3" json:"divergent_operators,omitempty"`
+	CreateTime         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ConsistencyDiscrepancy) Reset() {
+	*x = ConsistencyDiscrepancy{}
+	mi := &file_spark_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencyDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyDiscrepancy) ProtoMessage() {}
+
+func (x *ConsistencyDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyDiscrepancy.ProtoReflect.Descriptor instead.
+func (*ConsistencyDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ConsistencyDiscrepancy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConsistencyDiscrepancy) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConsistencyDiscrepancy) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConsistencyDiscrepancy) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ConsistencyDiscrepancy) GetDigests() map[string]string {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+func (x *ConsistencyDiscrepancy) GetDivergentOperators() []string {
+	if x != nil {
+		return x.DivergentOperators
+	}
+	return nil
+}
+
+func (x *ConsistencyDiscrepancy) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ConsistencyDiscrepancy) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ListConsistencyDiscrepanciesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most recently updated first.
+	Discrepancies []*ConsistencyDiscrepancy `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConsistencyDiscrepanciesResponse) Reset() {
+	*x = ListConsistencyDiscrepanciesResponse{}
+	mi := &file_spark_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConsistencyDiscrepanciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsistencyDiscrepanciesResponse) ProtoMessage() {}
+
+func (x *ListConsistencyDiscrepanciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsistencyDiscrepanciesResponse.ProtoReflect.Descriptor instead.
+func (*ListConsistencyDiscrepanciesResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListConsistencyDiscrepanciesResponse) GetDiscrepancies() []*ConsistencyDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+var File_spark_admin_proto protoreflect.FileDescriptor
+
+const file_spark_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x11spark_admin.proto\x12\vspark_admin\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vspark.proto\"\xea\x02\n" +
+	"\aTaskRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttask_name\x18\x02 \x01(\tR\btaskName\x122\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1a.spark_admin.TaskRunStatusR\x06status\x125\n" +
+	"\atrigger\x18\x04 \x01(\x0e2\x1b.spark_admin.TaskRunTriggerR\atrigger\x12\x16\n" +
+	"\x06holder\x18\x05 \x01(\tR\x06holder\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12'\n" +
+	"\x0fitems_processed\x18\t \x01(\x03R\x0eitemsProcessed\"\x80\x01\n" +
+	"\bTaskInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
+	"\x10interval_seconds\x18\x02 \x01(\x04R\x0fintervalSeconds\x125\n" +
+	"\vrecent_runs\x18\x03 \x03(\v2\x14.spark_admin.TaskRunR\n" +
+	"recentRuns\">\n" +
+	"\x10ListTasksRequest\x12*\n" +
+	"\x11recent_runs_limit\x18\x01 \x01(\rR\x0frecentRunsLimit\"@\n" +
+	"\x11ListTasksResponse\x12+\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x15.spark_admin.TaskInfoR\x05tasks\"(\n" +
+	"\x12TriggerTaskRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"=\n" +
+	"\x13TriggerTaskResponse\x12&\n" +
+	"\x03run\x18\x01 \x01(\v2\x14.spark_admin.TaskRunR\x03run\"\x18\n" +
+	"\x16GetKeysharePoolRequest\"V\n" +
+	"\x14NetworkKeyshareCount\x12(\n" +
+	"\anetwork\x18\x01 \x01(\x0e2\x0e.spark.NetworkR\anetwork\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"\xe4\x02\n" +
+	"\x17GetKeysharePoolResponse\x12_\n" +
+	"\x0fcount_by_status\x18\x01 \x03(\v27.spark_admin.GetKeysharePoolResponse.CountByStatusEntryR\rcountByStatus\x123\n" +
+	"\x15available_coordinated\x18\x02 \x01(\x04R\x14availableCoordinated\x12#\n" +
+	"\rmin_available\x18\x03 \x01(\x04R\fminAvailable\x12L\n" +
+	"\x11in_use_by_network\x18\x04 \x03(\v2!.spark_admin.NetworkKeyshareCountR\x0einUseByNetwork\x1a@\n" +
+	"\x12CountByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"\x13\n" +
+	"\x11TriggerDkgRequest\"\x14\n" +
+	"\x12TriggerDkgResponse\"C\n" +
+	"\x19ListStuckTransfersRequest\x12&\n" +
+	"\x0fmin_age_seconds\x18\x01 \x01(\x04R\rminAgeSeconds\"\xd9\x01\n" +
+	"\x12StuckTransferGroup\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"age_bucket\x18\x02 \x01(\tR\tageBucket\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\x12,\n" +
+	"\x12oldest_transfer_id\x18\x04 \x01(\tR\x10oldestTransferId\x12H\n" +
+	"\x12oldest_update_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x10oldestUpdateTime\"U\n" +
+	"\x1aListStuckTransfersResponse\x127\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1f.spark_admin.StuckTransferGroupR\x06groups\"`\n" +
+	" ListStuckPreimageRequestsRequest\x12&\n" +
+	"\x0fmin_age_seconds\x18\x01 \x01(\x04R\rminAgeSeconds\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\xc7\x01\n" +
+	"\x14StuckPreimageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fpayment_hash\x18\x02 \x01(\fR\vpaymentHash\x12?\n" +
+	"\x1creceiver_identity_public_key\x18\x03 \x01(\fR\x19receiverIdentityPublicKey\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"x\n" +
+	"!ListStuckPreimageRequestsResponse\x12=\n" +
+	"\brequests\x18\x01 \x03(\v2!.spark_admin.StuckPreimageRequestR\brequests\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\x17\n" +
+	"\x15GetChainStatusRequest\"\x90\x02\n" +
+	"\vChainStatus\x12(\n" +
+	"\anetwork\x18\x01 \x01(\x0e2\x0e.spark.NetworkR\anetwork\x12)\n" +
+	"\x10processed_height\x18\x02 \x01(\x03R\x0fprocessedHeight\x12A\n" +
+	"\x0eprocessed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rprocessedTime\x12$\n" +
+	"\vnode_height\x18\x04 \x01(\x03H\x00R\n" +
+	"nodeHeight\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"lag_blocks\x18\x05 \x01(\x03R\tlagBlocks\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05errorB\x0e\n" +
+	"\f_node_height\"N\n" +
+	"\x16GetChainStatusResponse\x124\n" +
+	"\bnetworks\x18\x01 \x03(\v2\x18.spark_admin.ChainStatusR\bnetworks\"\x1b\n" +
+	"\x19GetLrc20PoolStatusRequest\"\xe1\x01\n" +
+	"\x0fLrc20PoolStatus\x12(\n" +
+	"\anetwork\x18\x01 \x01(\x0e2\x0e.spark.NetworkR\anetwork\x12\x19\n" +
+	"\bmax_size\x18\x02 \x01(\rR\amaxSize\x12)\n" +
+	"\x10open_connections\x18\x03 \x01(\rR\x0fopenConnections\x12)\n" +
+	"\x10idle_connections\x18\x04 \x01(\rR\x0fidleConnections\x123\n" +
+	"\x15unhealthy_connections\x18\x05 \x01(\rR\x14unhealthyConnections\"P\n" +
+	"\x1aGetLrc20PoolStatusResponse\x122\n" +
+	"\x05pools\x18\x01 \x03(\v2\x1c.spark_admin.Lrc20PoolStatusR\x05pools\"\xe1\x01\n" +
+	"\fNetworkPause\x12(\n" +
+	"\anetwork\x18\x01 \x01(\x0e2\x0e.spark.NetworkR\anetwork\x12)\n" +
+	"\x10transfers_paused\x18\x02 \x01(\bR\x0ftransfersPaused\x12'\n" +
+	"\x0fdeposits_paused\x18\x03 \x01(\bR\x0edepositsPaused\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12;\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\xaa\x01\n" +
+	"\x16SetNetworkPauseRequest\x12(\n" +
+	"\anetwork\x18\x01 \x01(\x0e2\x0e.spark.NetworkR\anetwork\x12'\n" +
+	"\x0fpause_transfers\x18\x02 \x01(\bR\x0epauseTransfers\x12%\n" +
+	"\x0epause_deposits\x18\x03 \x01(\bR\rpauseDeposits\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"J\n" +
+	"\x17SetNetworkPauseResponse\x12/\n" +
+	"\x05pause\x18\x01 \x01(\v2\x19.spark_admin.NetworkPauseR\x05pause\"\x1a\n" +
+	"\x18ListNetworkPausesRequest\"N\n" +
+	"\x19ListNetworkPausesResponse\x121\n" +
+	"\x06pauses\x18\x01 \x03(\v2\x19.spark_admin.NetworkPauseR\x06pauses\"b\n" +
+	"#ListConsistencyDiscrepanciesRequest\x12%\n" +
+	"\x0einclude_closed\x18\x01 \x01(\bR\rincludeClosed\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\x99\x03\n" +
+	"\x16ConsistencyDiscrepancy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12J\n" +
+	"\adigests\x18\x05 \x03(\v20.spark_admin.ConsistencyDiscrepancy.DigestsEntryR\adigests\x12/\n" +
+	"\x13divergent_operators\x18\x06 \x03(\tR\x12divergentOperators\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x1a:\n" +
+	"\fDigestsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"q\n" +
+	"$ListConsistencyDiscrepanciesResponse\x12I\n" +
+	"\rdiscrepancies\x18\x01 \x03(\v2#.spark_admin.ConsistencyDiscrepancyR\rdiscrepancies*\x88\x01\n" +
+	"\rTaskRunStatus\x12\x1f\n" +
+	"\x1bTASK_RUN_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TASK_RUN_STATUS_RUNNING\x10\x01\x12\x1d\n" +
+	"\x19TASK_RUN_STATUS_SUCCEEDED\x10\x02\x12\x1a\n" +
+	"\x16TASK_RUN_STATUS_FAILED\x10\x03*o\n" +
+	"\x0eTaskRunTrigger\x12 \n" +
+	"\x1cTASK_RUN_TRIGGER_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aTASK_RUN_TRIGGER_SCHEDULED\x10\x01\x12\x1b\n" +
+	"\x17TASK_RUN_TRIGGER_MANUAL\x10\x022\xf6\b\n" +
+	"\x11SparkAdminService\x12M\n" +
+	"\n" +
+	"list_tasks\x12\x1d.spark_admin.ListTasksRequest\x1a\x1e.spark_admin.ListTasksResponse\"\x00\x12S\n" +
+	"\ftrigger_task\x12\x1f.spark_admin.TriggerTaskRequest\x1a .spark_admin.TriggerTaskResponse\"\x00\x12`\n" +
+	"\x11get_keyshare_pool\x12#.spark_admin.GetKeysharePoolRequest\x1a$.spark_admin.GetKeysharePoolResponse\"\x00\x12P\n" +
+	"\vtrigger_dkg\x12\x1e.spark_admin.TriggerDkgRequest\x1a\x1f.spark_admin.TriggerDkgResponse\"\x00\x12i\n" +
+	"\x14list_stuck_transfers\x12&.spark_admin.ListStuckTransfersRequest\x1a'.spark_admin.ListStuckTransfersResponse\"\x00\x12\x7f\n" +
+	"\x1clist_stuck_preimage_requests\x12-.spark_admin.ListStuckPreimageRequestsRequest\x1a..spark_admin.ListStuckPreimageRequestsResponse\"\x00\x12]\n" +
+	"\x10get_chain_status\x12\".spark_admin.GetChainStatusRequest\x1a#.spark_admin.GetChainStatusResponse\"\x00\x12j\n" +
+	"\x15get_lrc20_pool_status\x12&.spark_admin.GetLrc20PoolStatusRequest\x1a'.spark_admin.GetLrc20PoolStatusResponse\"\x00\x12`\n" +
+	"\x11set_network_pause\x12#.spark_admin.SetNetworkPauseRequest\x1a$.spark_admin.SetNetworkPauseResponse\"\x00\x12f\n" +
+	"\x13list_network_pauses\x12%.spark_admin.ListNetworkPausesRequest\x1a&.spark_admin.ListNetworkPausesResponse\"\x00\x12\x87\x01\n" +
+	"\x1elist_consistency_discrepancies\x120.spark_admin.ListConsistencyDiscrepanciesRequest\x1a1.spark_admin.ListConsistencyDiscrepanciesResponse\"\x00B2Z0github.com/lightsparkdev/spark/proto/spark_adminb\x06proto3"
+
+var (
+	file_spark_admin_proto_rawDescOnce sync.Once
+	file_spark_admin_proto_rawDescData []byte
+)
+
+func file_spark_admin_proto_rawDescGZIP() []byte {
+	file_spark_admin_proto_rawDescOnce.Do(func() {
+		file_spark_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_spark_admin_proto_rawDesc), len(file_spark_admin_proto_rawDesc)))
+	})
+	return file_spark_admin_proto_rawDescData
+}
+
+var file_spark_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_spark_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_spark_admin_proto_goTypes = []any{
+	(TaskRunStatus)(0),                           // 0: spark_admin.TaskRunStatus
+	(TaskRunTrigger)(0),                          // 1: spark_admin.TaskRunTrigger
+	(*TaskRun)(nil),                              // 2: spark_admin.TaskRun
+	(*TaskInfo)(nil),                             // 3: spark_admin.TaskInfo
+	(*ListTasksRequest)(nil),                     // 4: spark_admin.ListTasksRequest
+	(*ListTasksResponse)(nil),                    // 5: spark_admin.ListTasksResponse
+	(*TriggerTaskRequest)(nil),                   // 6: spark_admin.TriggerTaskRequest
+	(*TriggerTaskResponse)(nil),                  // 7: spark_admin.TriggerTaskResponse
+	(*GetKeysharePoolRequest)(nil),               // 8: spark_admin.GetKeysharePoolRequest
+	(*NetworkKeyshareCount)(nil),                 // 9: spark_admin.NetworkKeyshareCount
+	(*GetKeysharePoolResponse)(nil),              // 10: spark_admin.GetKeysharePoolResponse
+	(*TriggerDkgRequest)(nil),                    // 11: spark_admin.TriggerDkgRequest
+	(*TriggerDkgResponse)(nil),                   // 12: spark_admin.TriggerDkgResponse
+	(*ListStuckTransfersRequest)(nil),            // 13: spark_admin.ListStuckTransfersRequest
+	(*StuckTransferGroup)(nil),                   // 14: spark_admin.StuckTransferGroup
+	(*ListStuckTransfersResponse)(nil),           // 15: spark_admin.ListStuckTransfersResponse
+	(*ListStuckPreimageRequestsRequest)(nil),     // 16: spark_admin.ListStuckPreimageRequestsRequest
+	(*StuckPreimageRequest)(nil),                 // 17: spark_admin.StuckPreimageRequest
+	(*ListStuckPreimageRequestsResponse)(nil),    // 18: spark_admin.ListStuckPreimageRequestsResponse
+	(*GetChainStatusRequest)(nil),                // 19: spark_admin.GetChainStatusRequest
+	(*ChainStatus)(nil),                          // 20: spark_admin.ChainStatus
+	(*GetChainStatusResponse)(nil),               // 21: spark_admin.GetChainStatusResponse
+	(*GetLrc20PoolStatusRequest)(nil),            // 22: spark_admin.GetLrc20PoolStatusRequest
+	(*Lrc20PoolStatus)(nil),                      // 23: spark_admin.Lrc20PoolStatus
+	(*GetLrc20PoolStatusResponse)(nil),           // 24: spark_admin.GetLrc20PoolStatusResponse
+	(*NetworkPause)(nil),                         // 25: spark_admin.NetworkPause
+	(*SetNetworkPauseRequest)(nil),               // 26: spark_admin.SetNetworkPauseRequest
+	(*SetNetworkPauseResponse)(nil),              // 27: spark_admin.SetNetworkPauseResponse
+	(*ListNetworkPausesRequest)(nil),             // 28: spark_admin.ListNetworkPausesRequest
+	(*ListNetworkPausesResponse)(nil),            // 29: spark_admin.ListNetworkPausesResponse
+	(*ListConsistencyDiscrepanciesRequest)(nil),  // 30: spark_admin.ListConsistencyDiscrepanciesRequest
+	(*ConsistencyDiscrepancy)(nil),               // 31: spark_admin.ConsistencyDiscrepancy
+	(*ListConsistencyDiscrepanciesResponse)(nil), // 32: spark_admin.ListConsistencyDiscrepanciesResponse
+	nil,                           // 33: spark_admin.GetKeysharePoolResponse.CountByStatusEntry
+	nil,                           // 34: spark_admin.ConsistencyDiscrepancy.DigestsEntry
+	(*timestamppb.Timestamp)(nil), // 35: google.protobuf.Timestamp
+	(spark.Network)(0),            // 36: spark.Network
+}
+var file_spark_admin_proto_depIdxs = []int32{
+	0,  // 0: spark_admin.TaskRun.status:type_name -> spark_admin.TaskRunStatus
+	1,  // 1: spark_admin.TaskRun.trigger:type_name -> spark_admin.TaskRunTrigger
+	35, // 2: spark_admin.TaskRun.start_time:type_name -> google.protobuf.Timestamp
+	35, // 3: spark_admin.TaskRun.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 4: spark_admin.TaskInfo.recent_runs:type_name -> spark_admin.TaskRun
+	3,  // 5: spark_admin.ListTasksResponse.tasks:type_name -> spark_admin.TaskInfo
+	2,  // 6: spark_admin.TriggerTaskResponse.run:type_name -> spark_admin.TaskRun
+	36, // 7: spark_admin.NetworkKeyshareCount.network:type_name -> spark.Network
+	33, // 8: spark_admin.GetKeysharePoolResponse.count_by_status:type_name -> spark_admin.GetKeysharePoolResponse.CountByStatusEntry
+	9,  // 9: spark_admin.GetKeysharePoolResponse.in_use_by_network:type_name -> spark_admin.NetworkKeyshareCount
+	35, // 10: spark_admin.StuckTransferGroup.oldest_update_time:type_name -> google.protobuf.Timestamp
+	14, // 11: spark_admin.ListStuckTransfersResponse.groups:type_name -> spark_admin.StuckTransferGroup
+	35, // 12: spark_admin.StuckPreimageRequest.create_time:type_name -> google.protobuf.Timestamp
+	17, // 13: spark_admin.ListStuckPreimageRequestsResponse.requests:type_name -> spark_admin.StuckPreimageRequest
+	36, // 14: spark_admin.ChainStatus.network:type_name -> spark.Network
+	35, // 15: spark_admin.ChainStatus.processed_time:type_name -> google.protobuf.Timestamp
+	20, // 16: spark_admin.GetChainStatusResponse.networks:type_name -> spark_admin.ChainStatus
+	36, // 17: spark_admin.Lrc20PoolStatus.network:type_name -> spark.Network
+	23, // 18: spark_admin.GetLrc20PoolStatusResponse.pools:type_name -> spark_admin.Lrc20PoolStatus
+	36, // 19: spark_admin.NetworkPause.network:type_name -> spark.Network
+	35, // 20: spark_admin.NetworkPause.update_time:type_name -> google.protobuf.Timestamp
+	36, // 21: spark_admin.SetNetworkPauseRequest.network:type_name -> spark.Network
+	25, // 22: spark_admin.SetNetworkPauseResponse.pause:type_name -> spark_admin.NetworkPause
+	25, // 23: spark_admin.ListNetworkPausesResponse.pauses:type_name -> spark_admin.NetworkPause
+	34, // 24: spark_admin.ConsistencyDiscrepancy.digests:type_name -> spark_admin.ConsistencyDiscrepancy.DigestsEntry
+	35, // 25: spark_admin.ConsistencyDiscrepancy.create_time:type_name -> google.protobuf.Timestamp
+	35, // 26: spark_admin.ConsistencyDiscrepancy.update_time:type_name -> google.protobuf.Timestamp
+	31, // 27: spark_admin.ListConsistencyDiscrepanciesResponse.discrepancies:type_name -> spark_admin.ConsistencyDiscrepancy
+	4,  // 28: spark_admin.SparkAdminService.list_tasks:input_type -> spark_admin.ListTasksRequest
+	6,  // 29: spark_admin.SparkAdminService.trigger_task:input_type -> spark_admin.TriggerTaskRequest
+	8,  // 30: spark_admin.SparkAdminService.get_keyshare_pool:input_type -> spark_admin.GetKeysharePoolRequest
+	11, // 31: spark_admin.SparkAdminService.trigger_dkg:input_type -> spark_admin.TriggerDkgRequest
+	13, // 32: spark_admin.SparkAdminService.list_stuck_transfers:input_type -> spark_admin.ListStuckTransfersRequest
+	16, // 33: spark_admin.SparkAdminService.list_stuck_preimage_requests:input_type -> spark_admin.ListStuckPreimageRequestsRequest
+	19, // 34: spark_admin.SparkAdminService.get_chain_status:input_type -> spark_admin.GetChainStatusRequest
+	22, // 35: spark_admin.SparkAdminService.get_lrc20_pool_status:input_type -> spark_admin.GetLrc20PoolStatusRequest
+	26, // 36: spark_admin.SparkAdminService.set_network_pause:input_type -> spark_admin.SetNetworkPauseRequest
+	28, // 37: spark_admin.SparkAdminService.list_network_pauses:input_type -> spark_admin.ListNetworkPausesRequest
+	30, // 38: spark_admin.SparkAdminService.list_consistency_discrepancies:input_type -> spark_admin.ListConsistencyDiscrepanciesRequest
+	5,  // 39: spark_admin.SparkAdminService.list_tasks:output_type -> spark_admin.ListTasksResponse
+	7,  // 40: spark_admin.SparkAdminService.trigger_task:output_type -> spark_admin.TriggerTaskResponse
+	10, // 41: spark_admin.SparkAdminService.get_keyshare_pool:output_type -> spark_admin.GetKeysharePoolResponse
+	12, // 42: spark_admin.SparkAdminService.trigger_dkg:output_type -> spark_admin.TriggerDkgResponse
+	15, // 43: spark_admin.SparkAdminService.list_stuck_transfers:output_type -> spark_admin.ListStuckTransfersResponse
+	18, // 44: spark_admin.SparkAdminService.list_stuck_preimage_requests:output_type -> spark_admin.ListStuckPreimageRequestsResponse
+	21, // 45: spark_admin.SparkAdminService.get_chain_status:output_type -> spark_admin.GetChainStatusResponse
+	24, // 46: spark_admin.SparkAdminService.get_lrc20_pool_status:output_type -> spark_admin.GetLrc20PoolStatusResponse
+	27, // 47: spark_admin.SparkAdminService.set_network_pause:output_type -> spark_admin.SetNetworkPauseResponse
+	29, // 48: spark_admin.SparkAdminService.list_network_pauses:output_type -> spark_admin.ListNetworkPausesResponse
+	32, // 49: spark_admin.SparkAdminService.list_consistency_discrepancies:output_type -> spark_admin.ListConsistencyDiscrepanciesResponse
+	39, // [39:50] is the sub-list for method output_type
+	28, // [28:39] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_spark_admin_proto_init() }
+func file_spark_admin_proto_init() {
+	if File_spark_admin_proto != nil {
+		return
+	}
+	file_spark_admin_proto_msgTypes[18].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spark_admin_proto_rawDesc), len(file_spark_admin_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   33,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_spark_admin_proto_goTypes,
+		DependencyIndexes: file_spark_admin_proto_depIdxs,
+		EnumInfos:         file_spark_admin_proto_enumTypes,
+		MessageInfos:      file_spark_admin_proto_msgTypes,
+	}.Build()
+	File_spark_admin_proto = out.File
+	file_spark_admin_proto_goTypes = nil
+	file_spark_admin_proto_depIdxs = nil
+}
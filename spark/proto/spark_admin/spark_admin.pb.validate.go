@@ -0,0 +1,3899 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: spark_admin.proto
+
+package spark_admin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	spark "github.com/lightsparkdev/spark/proto/spark"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = spark.Network(0)
+)
+
+// Validate checks the field values on TaskRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TaskRunMultiError, or nil if none found.
+func (m *TaskRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TaskName
+
+	// no validation rules for Status
+
+	// no validation rules for Trigger
+
+	// no validation rules for Holder
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskRunValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskRunValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskRunValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskRunValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskRunValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskRunValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for ItemsProcessed
+
+	if len(errors) > 0 {
+		return TaskRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskRunMultiError is an error wrapping multiple validation errors returned
+// by TaskRun.ValidateAll() if the designated constraints aren't met.
+type TaskRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskRunMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskRunMultiError) AllErrors() []error { return m }
+
+// TaskRunValidationError is the validation error returned by TaskRun.Validate
+// if the designated constraints aren't met.
+type TaskRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskRunValidationError) ErrorName() string { return "TaskRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskRunValidationError{}
+
+// Validate checks the field values on TaskInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaskInfoMultiError, or nil
+// if none found.
+func (m *TaskInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for IntervalSeconds
+
+	for idx, item := range m.GetRecentRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskInfoValidationError{
+						field:  fmt.Sprintf("RecentRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskInfoValidationError{
+						field:  fmt.Sprintf("RecentRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskInfoValidationError{
+					field:  fmt.Sprintf("RecentRuns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TaskInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskInfoMultiError is an error wrapping multiple validation errors returned
+// by TaskInfo.ValidateAll() if the designated constraints aren't met.
+type TaskInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskInfoMultiError) AllErrors() []error { return m }
+
+// TaskInfoValidationError is the validation error returned by
+// TaskInfo.Validate if the designated constraints aren't met.
+type TaskInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskInfoValidationError) ErrorName() string { return "TaskInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskInfoValidationError{}
+
+// Validate checks the field values on ListTasksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTasksRequestMultiError, or nil if none found.
+func (m *ListTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RecentRunsLimit
+
+	if len(errors) > 0 {
+		return ListTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTasksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksRequestMultiError) AllErrors() []error { return m }
+
+// ListTasksRequestValidationError is the validation error returned by
+// ListTasksRequest.Validate if the designated constraints aren't met.
+type ListTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksRequestValidationError) ErrorName() string { return "ListTasksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksRequestValidationError{}
+
+// Validate checks the field values on ListTasksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTasksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTasksResponseMultiError, or nil if none found.
+func (m *ListTasksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTasksResponseValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTasksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTasksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksResponseMultiError) AllErrors() []error { return m }
+
+// ListTasksResponseValidationError is the validation error returned by
+// ListTasksResponse.Validate if the designated constraints aren't met.
+type ListTasksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksResponseValidationError) ErrorName() string {
+	return "ListTasksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTasksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksResponseValidationError{}
+
+// Validate checks the field values on TriggerTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerTaskRequestMultiError, or nil if none found.
+func (m *TriggerTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return TriggerTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by TriggerTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerTaskRequestMultiError) AllErrors() []error { return m }
+
+// TriggerTaskRequestValidationError is the validation error returned by
+// TriggerTaskRequest.Validate if the designated constraints aren't met.
+type TriggerTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerTaskRequestValidationError) ErrorName() string {
+	return "TriggerTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerTaskRequestValidationError{}
+
+// Validate checks the field values on TriggerTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerTaskResponseMultiError, or nil if none found.
+func (m *TriggerTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerTaskResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerTaskResponseValidationError{
+					field:  "Run",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerTaskResponseValidationError{
+				field:  "Run",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TriggerTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerTaskResponseMultiError is an error wrapping multiple validation
+// errors returned by TriggerTaskResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerTaskResponseMultiError) AllErrors() []error { return m }
+
+// TriggerTaskResponseValidationError is the validation error returned by
+// TriggerTaskResponse.Validate if the designated constraints aren't met.
+type TriggerTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerTaskResponseValidationError) ErrorName() string {
+	return "TriggerTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerTaskResponseValidationError{}
+
+// Validate checks the field values on GetKeysharePoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKeysharePoolRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKeysharePoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKeysharePoolRequestMultiError, or nil if none found.
+func (m *GetKeysharePoolRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKeysharePoolRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetKeysharePoolRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKeysharePoolRequestMultiError is an error wrapping multiple validation
+// errors returned by GetKeysharePoolRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetKeysharePoolRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKeysharePoolRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKeysharePoolRequestMultiError) AllErrors() []error { return m }
+
+// GetKeysharePoolRequestValidationError is the validation error returned by
+// GetKeysharePoolRequest.Validate if the designated constraints aren't met.
+type GetKeysharePoolRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKeysharePoolRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKeysharePoolRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKeysharePoolRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKeysharePoolRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKeysharePoolRequestValidationError) ErrorName() string {
+	return "GetKeysharePoolRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKeysharePoolRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKeysharePoolRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKeysharePoolRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKeysharePoolRequestValidationError{}
+
+// Validate checks the field values on NetworkKeyshareCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NetworkKeyshareCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NetworkKeyshareCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NetworkKeyshareCountMultiError, or nil if none found.
+func (m *NetworkKeyshareCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NetworkKeyshareCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return NetworkKeyshareCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// NetworkKeyshareCountMultiError is an error wrapping multiple validation
+// errors returned by NetworkKeyshareCount.ValidateAll() if the designated
+// constraints aren't met.
+type NetworkKeyshareCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NetworkKeyshareCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NetworkKeyshareCountMultiError) AllErrors() []error { return m }
+
+// NetworkKeyshareCountValidationError is the validation error returned by
+// NetworkKeyshareCount.Validate if the designated constraints aren't met.
+type NetworkKeyshareCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NetworkKeyshareCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NetworkKeyshareCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NetworkKeyshareCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NetworkKeyshareCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NetworkKeyshareCountValidationError) ErrorName() string {
+	return "NetworkKeyshareCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NetworkKeyshareCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNetworkKeyshareCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NetworkKeyshareCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NetworkKeyshareCountValidationError{}
+
+// Validate checks the field values on GetKeysharePoolResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKeysharePoolResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKeysharePoolResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKeysharePoolResponseMultiError, or nil if none found.
+func (m *GetKeysharePoolResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKeysharePoolResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CountByStatus
+
+	// no validation rules for AvailableCoordinated
+
+	// no validation rules for MinAvailable
+
+	for idx, item := range m.GetInUseByNetwork() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetKeysharePoolResponseValidationError{
+						field:  fmt.Sprintf("InUseByNetwork[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetKeysharePoolResponseValidationError{
+						field:  fmt.Sprintf("InUseByNetwork[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetKeysharePoolResponseValidationError{
+					field:  fmt.Sprintf("InUseByNetwork[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetKeysharePoolResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKeysharePoolResponseMultiError is an error wrapping multiple validation
+// errors returned by GetKeysharePoolResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetKeysharePoolResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKeysharePoolResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKeysharePoolResponseMultiError) AllErrors() []error { return m }
+
+// GetKeysharePoolResponseValidationError is the validation error returned by
+// GetKeysharePoolResponse.Validate if the designated constraints aren't met.
+type GetKeysharePoolResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKeysharePoolResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKeysharePoolResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKeysharePoolResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKeysharePoolResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKeysharePoolResponseValidationError) ErrorName() string {
+	return "GetKeysharePoolResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKeysharePoolResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKeysharePoolResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKeysharePoolResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKeysharePoolResponseValidationError{}
+
+// Validate checks the field values on TriggerDkgRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TriggerDkgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerDkgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerDkgRequestMultiError, or nil if none found.
+func (m *TriggerDkgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerDkgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TriggerDkgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerDkgRequestMultiError is an error wrapping multiple validation errors
+// returned by TriggerDkgRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerDkgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerDkgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerDkgRequestMultiError) AllErrors() []error { return m }
+
+// TriggerDkgRequestValidationError is the validation error returned by
+// TriggerDkgRequest.Validate if the designated constraints aren't met.
+type TriggerDkgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerDkgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerDkgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerDkgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerDkgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerDkgRequestValidationError) ErrorName() string {
+	return "TriggerDkgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerDkgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerDkgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerDkgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerDkgRequestValidationError{}
+
+// Validate checks the field values on TriggerDkgResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerDkgResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerDkgResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerDkgResponseMultiError, or nil if none found.
+func (m *TriggerDkgResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerDkgResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TriggerDkgResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerDkgResponseMultiError is an error wrapping multiple validation errors
+// returned by TriggerDkgResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerDkgResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerDkgResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerDkgResponseMultiError) AllErrors() []error { return m }
+
+// TriggerDkgResponseValidationError is the validation error returned by
+// TriggerDkgResponse.Validate if the designated constraints aren't met.
+type TriggerDkgResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerDkgResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerDkgResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerDkgResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerDkgResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerDkgResponseValidationError) ErrorName() string {
+	return "TriggerDkgResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerDkgResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerDkgResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerDkgResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerDkgResponseValidationError{}
+
+// Validate checks the field values on ListStuckTransfersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStuckTransfersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStuckTransfersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStuckTransfersRequestMultiError, or nil if none found.
+func (m *ListStuckTransfersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStuckTransfersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MinAgeSeconds
+
+	if len(errors) > 0 {
+		return ListStuckTransfersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStuckTransfersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStuckTransfersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListStuckTransfersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStuckTransfersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStuckTransfersRequestMultiError) AllErrors() []error { return m }
+
+// ListStuckTransfersRequestValidationError is the validation error returned by
+// ListStuckTransfersRequest.Validate if the designated constraints aren't met.
+type ListStuckTransfersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStuckTransfersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStuckTransfersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStuckTransfersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStuckTransfersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStuckTransfersRequestValidationError) ErrorName() string {
+	return "ListStuckTransfersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStuckTransfersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStuckTransfersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStuckTransfersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStuckTransfersRequestValidationError{}
+
+// Validate checks the field values on StuckTransferGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StuckTransferGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StuckTransferGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StuckTransferGroupMultiError, or nil if none found.
+func (m *StuckTransferGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StuckTransferGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for AgeBucket
+
+	// no validation rules for Count
+
+	// no validation rules for OldestTransferId
+
+	if all {
+		switch v := interface{}(m.GetOldestUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StuckTransferGroupValidationError{
+					field:  "OldestUpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StuckTransferGroupValidationError{
+					field:  "OldestUpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOldestUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StuckTransferGroupValidationError{
+				field:  "OldestUpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StuckTransferGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// StuckTransferGroupMultiError is an error wrapping multiple validation errors
+// returned by StuckTransferGroup.ValidateAll() if the designated constraints
+// aren't met.
+type StuckTransferGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StuckTransferGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StuckTransferGroupMultiError) AllErrors() []error { return m }
+
+// StuckTransferGroupValidationError is the validation error returned by
+// StuckTransferGroup.Validate if the designated constraints aren't met.
+type StuckTransferGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StuckTransferGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StuckTransferGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StuckTransferGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StuckTransferGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StuckTransferGroupValidationError) ErrorName() string {
+	return "StuckTransferGroupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StuckTransferGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStuckTransferGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StuckTransferGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StuckTransferGroupValidationError{}
+
+// Validate checks the field values on ListStuckTransfersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStuckTransfersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStuckTransfersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStuckTransfersResponseMultiError, or nil if none found.
+func (m *ListStuckTransfersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStuckTransfersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStuckTransfersResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStuckTransfersResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStuckTransfersResponseValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListStuckTransfersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStuckTransfersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListStuckTransfersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListStuckTransfersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStuckTransfersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStuckTransfersResponseMultiError) AllErrors() []error { return m }
+
+// ListStuckTransfersResponseValidationError is the validation error returned
+// by ListStuckTransfersResponse.Validate if the designated constraints aren't met.
+type ListStuckTransfersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStuckTransfersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStuckTransfersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStuckTransfersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStuckTransfersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStuckTransfersResponseValidationError) ErrorName() string {
+	return "ListStuckTransfersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStuckTransfersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStuckTransfersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStuckTransfersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStuckTransfersResponseValidationError{}
+
+// Validate checks the field values on ListStuckPreimageRequestsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListStuckPreimageRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStuckPreimageRequestsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListStuckPreimageRequestsRequestMultiError, or nil if none found.
+func (m *ListStuckPreimageRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStuckPreimageRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MinAgeSeconds
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListStuckPreimageRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStuckPreimageRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListStuckPreimageRequestsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStuckPreimageRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStuckPreimageRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStuckPreimageRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListStuckPreimageRequestsRequestValidationError is the validation error
+// returned by ListStuckPreimageRequestsRequest.Validate if the designated
+// constraints aren't met.
+type ListStuckPreimageRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStuckPreimageRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStuckPreimageRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStuckPreimageRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStuckPreimageRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStuckPreimageRequestsRequestValidationError) ErrorName() string {
+	return "ListStuckPreimageRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStuckPreimageRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStuckPreimageRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStuckPreimageRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStuckPreimageRequestsRequestValidationError{}
+
+// Validate checks the field values on StuckPreimageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StuckPreimageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StuckPreimageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StuckPreimageRequestMultiError, or nil if none found.
+func (m *StuckPreimageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StuckPreimageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PaymentHash
+
+	// no validation rules for ReceiverIdentityPublicKey
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StuckPreimageRequestValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StuckPreimageRequestValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StuckPreimageRequestValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StuckPreimageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StuckPreimageRequestMultiError is an error wrapping multiple validation
+// errors returned by StuckPreimageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StuckPreimageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StuckPreimageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StuckPreimageRequestMultiError) AllErrors() []error { return m }
+
+// StuckPreimageRequestValidationError is the validation error returned by
+// StuckPreimageRequest.Validate if the designated constraints aren't met.
+type StuckPreimageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StuckPreimageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StuckPreimageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StuckPreimageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StuckPreimageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StuckPreimageRequestValidationError) ErrorName() string {
+	return "StuckPreimageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StuckPreimageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStuckPreimageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StuckPreimageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StuckPreimageRequestValidationError{}
+
+// Validate checks the field values on ListStuckPreimageRequestsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListStuckPreimageRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStuckPreimageRequestsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListStuckPreimageRequestsResponseMultiError, or nil if none found.
+func (m *ListStuckPreimageRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStuckPreimageRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStuckPreimageRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStuckPreimageRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStuckPreimageRequestsResponseValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListStuckPreimageRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStuckPreimageRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListStuckPreimageRequestsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListStuckPreimageRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStuckPreimageRequestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStuckPreimageRequestsResponseMultiError) AllErrors() []error { return m }
+
+// ListStuckPreimageRequestsResponseValidationError is the validation error
+// returned by ListStuckPreimageRequestsResponse.Validate if the designated
+// constraints aren't met.
+type ListStuckPreimageRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStuckPreimageRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStuckPreimageRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStuckPreimageRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStuckPreimageRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStuckPreimageRequestsResponseValidationError) ErrorName() string {
+	return "ListStuckPreimageRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStuckPreimageRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStuckPreimageRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStuckPreimageRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStuckPreimageRequestsResponseValidationError{}
+
+// Validate checks the field values on GetChainStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChainStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChainStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChainStatusRequestMultiError, or nil if none found.
+func (m *GetChainStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChainStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetChainStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChainStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetChainStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetChainStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChainStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChainStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetChainStatusRequestValidationError is the validation error returned by
+// GetChainStatusRequest.Validate if the designated constraints aren't met.
+type GetChainStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChainStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChainStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChainStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChainStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChainStatusRequestValidationError) ErrorName() string {
+	return "GetChainStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChainStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChainStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChainStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChainStatusRequestValidationError{}
+
+// Validate checks the field values on ChainStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChainStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChainStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChainStatusMultiError, or
+// nil if none found.
+func (m *ChainStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChainStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for ProcessedHeight
+
+	if all {
+		switch v := interface{}(m.GetProcessedTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChainStatusValidationError{
+					field:  "ProcessedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChainStatusValidationError{
+					field:  "ProcessedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProcessedTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChainStatusValidationError{
+				field:  "ProcessedTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LagBlocks
+
+	// no validation rules for Error
+
+	if m.NodeHeight != nil {
+		// no validation rules for NodeHeight
+	}
+
+	if len(errors) > 0 {
+		return ChainStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChainStatusMultiError is an error wrapping multiple validation errors
+// returned by ChainStatus.ValidateAll() if the designated constraints aren't met.
+type ChainStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChainStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChainStatusMultiError) AllErrors() []error { return m }
+
+// ChainStatusValidationError is the validation error returned by
+// ChainStatus.Validate if the designated constraints aren't met.
+type ChainStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChainStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChainStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChainStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChainStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChainStatusValidationError) ErrorName() string { return "ChainStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChainStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChainStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChainStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChainStatusValidationError{}
+
+// Validate checks the field values on GetChainStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChainStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChainStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChainStatusResponseMultiError, or nil if none found.
+func (m *GetChainStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChainStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNetworks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetChainStatusResponseValidationError{
+						field:  fmt.Sprintf("Networks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetChainStatusResponseValidationError{
+						field:  fmt.Sprintf("Networks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetChainStatusResponseValidationError{
+					field:  fmt.Sprintf("Networks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetChainStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChainStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetChainStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetChainStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChainStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChainStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetChainStatusResponseValidationError is the validation error returned by
+// GetChainStatusResponse.Validate if the designated constraints aren't met.
+type GetChainStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChainStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChainStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChainStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChainStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChainStatusResponseValidationError) ErrorName() string {
+	return "GetChainStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChainStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChainStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChainStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChainStatusResponseValidationError{}
+
+// Validate checks the field values on GetLrc20PoolStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLrc20PoolStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLrc20PoolStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLrc20PoolStatusRequestMultiError, or nil if none found.
+func (m *GetLrc20PoolStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLrc20PoolStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetLrc20PoolStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLrc20PoolStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetLrc20PoolStatusRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetLrc20PoolStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLrc20PoolStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLrc20PoolStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetLrc20PoolStatusRequestValidationError is the validation error returned by
+// GetLrc20PoolStatusRequest.Validate if the designated constraints aren't met.
+type GetLrc20PoolStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLrc20PoolStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLrc20PoolStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLrc20PoolStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLrc20PoolStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLrc20PoolStatusRequestValidationError) ErrorName() string {
+	return "GetLrc20PoolStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLrc20PoolStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLrc20PoolStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLrc20PoolStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLrc20PoolStatusRequestValidationError{}
+
+// Validate checks the field values on Lrc20PoolStatus with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Lrc20PoolStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Lrc20PoolStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Lrc20PoolStatusMultiError, or nil if none found.
+func (m *Lrc20PoolStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Lrc20PoolStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for MaxSize
+
+	// no validation rules for OpenConnections
+
+	// no validation rules for IdleConnections
+
+	// no validation rules for UnhealthyConnections
+
+	if len(errors) > 0 {
+		return Lrc20PoolStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// Lrc20PoolStatusMultiError is an error wrapping multiple validation errors
+// returned by Lrc20PoolStatus.ValidateAll() if the designated constraints
+// aren't met.
+type Lrc20PoolStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Lrc20PoolStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Lrc20PoolStatusMultiError) AllErrors() []error { return m }
+
+// Lrc20PoolStatusValidationError is the validation error returned by
+// Lrc20PoolStatus.Validate if the designated constraints aren't met.
+type Lrc20PoolStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Lrc20PoolStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Lrc20PoolStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Lrc20PoolStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Lrc20PoolStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Lrc20PoolStatusValidationError) ErrorName() string { return "Lrc20PoolStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Lrc20PoolStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLrc20PoolStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Lrc20PoolStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Lrc20PoolStatusValidationError{}
+
+// Validate checks the field values on GetLrc20PoolStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLrc20PoolStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLrc20PoolStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLrc20PoolStatusResponseMultiError, or nil if none found.
+func (m *GetLrc20PoolStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLrc20PoolStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPools() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetLrc20PoolStatusResponseValidationError{
+						field:  fmt.Sprintf("Pools[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetLrc20PoolStatusResponseValidationError{
+						field:  fmt.Sprintf("Pools[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetLrc20PoolStatusResponseValidationError{
+					field:  fmt.Sprintf("Pools[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetLrc20PoolStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLrc20PoolStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetLrc20PoolStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetLrc20PoolStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLrc20PoolStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLrc20PoolStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetLrc20PoolStatusResponseValidationError is the validation error returned
+// by GetLrc20PoolStatusResponse.Validate if the designated constraints aren't met.
+type GetLrc20PoolStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLrc20PoolStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLrc20PoolStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLrc20PoolStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLrc20PoolStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLrc20PoolStatusResponseValidationError) ErrorName() string {
+	return "GetLrc20PoolStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLrc20PoolStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLrc20PoolStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLrc20PoolStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLrc20PoolStatusResponseValidationError{}
+
+// Validate checks the field values on NetworkPause with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NetworkPause) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NetworkPause with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NetworkPauseMultiError, or
+// nil if none found.
+func (m *NetworkPause) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NetworkPause) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for TransfersPaused
+
+	// no validation rules for DepositsPaused
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NetworkPauseValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NetworkPauseValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NetworkPauseValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NetworkPauseMultiError(errors)
+	}
+
+	return nil
+}
+
+// NetworkPauseMultiError is an error wrapping multiple validation errors
+// returned by NetworkPause.ValidateAll() if the designated constraints aren't met.
+type NetworkPauseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NetworkPauseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NetworkPauseMultiError) AllErrors() []error { return m }
+
+// NetworkPauseValidationError is the validation error returned by
+// NetworkPause.Validate if the designated constraints aren't met.
+type NetworkPauseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NetworkPauseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NetworkPauseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NetworkPauseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NetworkPauseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NetworkPauseValidationError) ErrorName() string { return "NetworkPauseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NetworkPauseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNetworkPause.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NetworkPauseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NetworkPauseValidationError{}
+
+// Validate checks the field values on SetNetworkPauseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetNetworkPauseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetNetworkPauseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetNetworkPauseRequestMultiError, or nil if none found.
+func (m *SetNetworkPauseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetNetworkPauseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for PauseTransfers
+
+	// no validation rules for PauseDeposits
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return SetNetworkPauseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetNetworkPauseRequestMultiError is an error wrapping multiple validation
+// errors returned by SetNetworkPauseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetNetworkPauseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetNetworkPauseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetNetworkPauseRequestMultiError) AllErrors() []error { return m }
+
+// SetNetworkPauseRequestValidationError is the validation error returned by
+// SetNetworkPauseRequest.Validate if the designated constraints aren't met.
+type SetNetworkPauseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetNetworkPauseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetNetworkPauseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetNetworkPauseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetNetworkPauseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetNetworkPauseRequestValidationError) ErrorName() string {
+	return "SetNetworkPauseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetNetworkPauseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetNetworkPauseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetNetworkPauseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetNetworkPauseRequestValidationError{}
+
+// Validate checks the field values on SetNetworkPauseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetNetworkPauseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetNetworkPauseResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetNetworkPauseResponseMultiError, or nil if none found.
+func (m *SetNetworkPauseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetNetworkPauseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPause()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetNetworkPauseResponseValidationError{
+					field:  "Pause",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetNetworkPauseResponseValidationError{
+					field:  "Pause",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPause()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetNetworkPauseResponseValidationError{
+				field:  "Pause",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetNetworkPauseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetNetworkPauseResponseMultiError is an error wrapping multiple validation
+// errors returned by SetNetworkPauseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetNetworkPauseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetNetworkPauseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetNetworkPauseResponseMultiError) AllErrors() []error { return m }
+
+// SetNetworkPauseResponseValidationError is the validation error returned by
+// SetNetworkPauseResponse.Validate if the designated constraints aren't met.
+type SetNetworkPauseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetNetworkPauseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetNetworkPauseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetNetworkPauseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetNetworkPauseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetNetworkPauseResponseValidationError) ErrorName() string {
+	return "SetNetworkPauseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetNetworkPauseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetNetworkPauseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetNetworkPauseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetNetworkPauseResponseValidationError{}
+
+// Validate checks the field values on ListNetworkPausesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNetworkPausesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNetworkPausesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNetworkPausesRequestMultiError, or nil if none found.
+func (m *ListNetworkPausesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNetworkPausesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListNetworkPausesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNetworkPausesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNetworkPausesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNetworkPausesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNetworkPausesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNetworkPausesRequestMultiError) AllErrors() []error { return m }
+
+// ListNetworkPausesRequestValidationError is the validation error returned by
+// ListNetworkPausesRequest.Validate if the designated constraints aren't met.
+type ListNetworkPausesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNetworkPausesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNetworkPausesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNetworkPausesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNetworkPausesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNetworkPausesRequestValidationError) ErrorName() string {
+	return "ListNetworkPausesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNetworkPausesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNetworkPausesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNetworkPausesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNetworkPausesRequestValidationError{}
+
+// Validate checks the field values on ListNetworkPausesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNetworkPausesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNetworkPausesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNetworkPausesResponseMultiError, or nil if none found.
+func (m *ListNetworkPausesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNetworkPausesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPauses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNetworkPausesResponseValidationError{
+						field:  fmt.Sprintf("Pauses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNetworkPausesResponseValidationError{
+						field:  fmt.Sprintf("Pauses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNetworkPausesResponseValidationError{
+					field:  fmt.Sprintf("Pauses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListNetworkPausesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNetworkPausesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListNetworkPausesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListNetworkPausesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNetworkPausesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNetworkPausesResponseMultiError) AllErrors() []error { return m }
+
+// ListNetworkPausesResponseValidationError is the validation error returned by
+// ListNetworkPausesResponse.Validate if the designated constraints aren't met.
+type ListNetworkPausesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNetworkPausesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNetworkPausesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNetworkPausesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNetworkPausesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNetworkPausesResponseValidationError) ErrorName() string {
+	return "ListNetworkPausesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNetworkPausesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNetworkPausesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNetworkPausesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNetworkPausesResponseValidationError{}
+
+// Validate checks the field values on ListConsistencyDiscrepanciesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListConsistencyDiscrepanciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConsistencyDiscrepanciesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListConsistencyDiscrepanciesRequestMultiError, or nil if none found.
+func (m *ListConsistencyDiscrepanciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConsistencyDiscrepanciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeClosed
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListConsistencyDiscrepanciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConsistencyDiscrepanciesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListConsistencyDiscrepanciesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListConsistencyDiscrepanciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConsistencyDiscrepanciesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConsistencyDiscrepanciesRequestMultiError) AllErrors() []error { return m }
+
+// ListConsistencyDiscrepanciesRequestValidationError is the validation error
+// returned by ListConsistencyDiscrepanciesRequest.Validate if the designated
+// constraints aren't met.
+type ListConsistencyDiscrepanciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConsistencyDiscrepanciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConsistencyDiscrepanciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConsistencyDiscrepanciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConsistencyDiscrepanciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConsistencyDiscrepanciesRequestValidationError) ErrorName() string {
+	return "ListConsistencyDiscrepanciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConsistencyDiscrepanciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConsistencyDiscrepanciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConsistencyDiscrepanciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConsistencyDiscrepanciesRequestValidationError{}
+
+// Validate checks the field values on ConsistencyDiscrepancy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencyDiscrepancy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencyDiscrepancy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsistencyDiscrepancyMultiError, or nil if none found.
+func (m *ConsistencyDiscrepancy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencyDiscrepancy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	// no validation rules for Key
+
+	// no validation rules for Status
+
+	// no validation rules for Digests
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConsistencyDiscrepancyValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConsistencyDiscrepancyValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConsistencyDiscrepancyValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConsistencyDiscrepancyValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConsistencyDiscrepancyValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConsistencyDiscrepancyValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConsistencyDiscrepancyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyDiscrepancyMultiError is an error wrapping multiple validation
+// errors returned by ConsistencyDiscrepancy.ValidateAll() if the designated
+// constraints aren't met.
+type ConsistencyDiscrepancyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyDiscrepancyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyDiscrepancyMultiError) AllErrors() []error { return m }
+
+// ConsistencyDiscrepancyValidationError is the validation error returned by
+// ConsistencyDiscrepancy.Validate if the designated constraints aren't met.
+type ConsistencyDiscrepancyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyDiscrepancyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyDiscrepancyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyDiscrepancyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyDiscrepancyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyDiscrepancyValidationError) ErrorName() string {
+	return "ConsistencyDiscrepancyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsistencyDiscrepancyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencyDiscrepancy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyDiscrepancyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyDiscrepancyValidationError{}
+
+// Validate checks the field values on ListConsistencyDiscrepanciesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListConsistencyDiscrepanciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConsistencyDiscrepanciesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListConsistencyDiscrepanciesResponseMultiError, or nil if none found.
+func (m *ListConsistencyDiscrepanciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConsistencyDiscrepanciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDiscrepancies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListConsistencyDiscrepanciesResponseValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListConsistencyDiscrepanciesResponseValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListConsistencyDiscrepanciesResponseValidationError{
+					field:  fmt.Sprintf("Discrepancies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListConsistencyDiscrepanciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConsistencyDiscrepanciesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListConsistencyDiscrepanciesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListConsistencyDiscrepanciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConsistencyDiscrepanciesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConsistencyDiscrepanciesResponseMultiError) AllErrors() []error { return m }
+
+// ListConsistencyDiscrepanciesResponseValidationError is the validation error
+// returned by ListConsistencyDiscrepanciesResponse.Validate if the designated
+// constraints aren't met.
+type ListConsistencyDiscrepanciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConsistencyDiscrepanciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConsistencyDiscrepanciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConsistencyDiscrepanciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConsistencyDiscrepanciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConsistencyDiscrepanciesResponseValidationError) ErrorName() string {
+	return "ListConsistencyDiscrepanciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConsistencyDiscrepanciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConsistencyDiscrepanciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConsistencyDiscrepanciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConsistencyDiscrepanciesResponseValidationError{}
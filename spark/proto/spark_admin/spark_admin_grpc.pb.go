@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: spark_admin.proto
+
+package spark_admin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SparkAdminService_ListTasks_FullMethodName                    = "/spark_admin.SparkAdminService/list_tasks"
+	SparkAdminService_TriggerTask_FullMethodName                  = "/spark_admin.SparkAdminService/trigger_task"
+	SparkAdminService_GetKeysharePool_FullMethodName              = "/spark_admin.SparkAdminService/get_keyshare_pool"
+	SparkAdminService_TriggerDkg_FullMethodName                   = "/spark_admin.SparkAdminService/trigger_dkg"
+	SparkAdminService_ListStuckTransfers_FullMethodName           = "/spark_admin.SparkAdminService/list_stuck_transfers"
+	SparkAdminService_ListStuckPreimageRequests_FullMethodName    = "/spark_admin.SparkAdminService/list_stuck_preimage_requests"
+	SparkAdminService_GetChainStatus_FullMethodName               = "/spark_admin.SparkAdminService/get_chain_status"
+	SparkAdminService_GetLrc20PoolStatus_FullMethodName           = "/spark_admin.SparkAdminService/get_lrc20_pool_status"
+	SparkAdminService_SetNetworkPause_FullMethodName              = "/spark_admin.SparkAdminService/set_network_pause"
+	SparkAdminService_ListNetworkPauses_FullMethodName            = "/spark_admin.SparkAdminService/list_network_pauses"
+	SparkAdminService_ListConsistencyDiscrepancies_FullMethodName = "/spark_admin.SparkAdminService/list_consistency_discrepancies"
+)
+
+// SparkAdminServiceClient is the client API for SparkAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SparkAdminService exposes operational controls of a signing operator to the people running it.
+// It is not meant to be called by users or by other signing operators.
+type SparkAdminServiceClient interface {
+	// List the background tasks of the operator together with their most recent runs.
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// Run a background task now, outside of its schedule. The task runs asynchronously; poll
+	// list_tasks to follow its progress.
+	TriggerTask(ctx context.Context, in *TriggerTaskRequest, opts ...grpc.CallOption) (*TriggerTaskResponse, error)
+	// Count the signing keyshares of the operator by status, and the keyshares in use by network.
+	GetKeysharePool(ctx context.Context, in *GetKeysharePoolRequest, opts ...grpc.CallOption) (*GetKeysharePoolResponse, error)
+	// Run a DKG round now to generate new signing keyshares, regardless of the pool size.
+	TriggerDkg(ctx context.Context, in *TriggerDkgRequest, opts ...grpc.CallOption) (*TriggerDkgResponse, error)
+	// Count the transfers that have not reached a final status, grouped by status and by how long
+	// ago they were last updated.
+	ListStuckTransfers(ctx context.Context, in *ListStuckTransfersRequest, opts ...grpc.CallOption) (*ListStuckTransfersResponse, error)
+	// List the preimage requests that have been waiting for their preimage for too long.
+	ListStuckPreimageRequests(ctx context.Context, in *ListStuckPreimageRequestsRequest, opts ...grpc.CallOption) (*ListStuckPreimageRequestsResponse, error)
+	// Get the height processed by the chain watcher of every network, and how far it lags behind
+	// the bitcoin node.
+	GetChainStatus(ctx context.Context, in *GetChainStatusRequest, opts ...grpc.CallOption) (*GetChainStatusResponse, error)
+	// Get the health of the LRC20 connection pool of every network.
+	GetLrc20PoolStatus(ctx context.Context, in *GetLrc20PoolStatusRequest, opts ...grpc.CallOption) (*GetLrc20PoolStatusResponse, error)
+	// Pause or resume new transfers or deposits on a network. Pauses only apply to this operator;
+	// since every operator takes part in transfers and deposits, pausing one operator is enough to
+	// stop them, but all operators should be paused to get clear errors.
+	SetNetworkPause(ctx context.Context, in *SetNetworkPauseRequest, opts ...grpc.CallOption) (*SetNetworkPauseResponse, error)
+	// List the networks on which new transfers or deposits are paused.
+	ListNetworkPauses(ctx context.Context, in *ListNetworkPausesRequest, opts ...grpc.CallOption) (*ListNetworkPausesResponse, error)
+	// List the discrepancies found by the consistency audit between the state of this operator and
+	// the state of the other operators.
+	ListConsistencyDiscrepancies(ctx context.Context, in *ListConsistencyDiscrepanciesRequest, opts ...grpc.CallOption) (*ListConsistencyDiscrepanciesResponse, error)
+}
+
+type sparkAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSparkAdminServiceClient(cc grpc.ClientConnInterface) SparkAdminServiceClient {
+	return &sparkAdminServiceClient{cc}
+}
+
+func (c *sparkAdminServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) TriggerTask(ctx context.Context, in *TriggerTaskRequest, opts ...grpc.CallOption) (*TriggerTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerTaskResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_TriggerTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) GetKeysharePool(ctx context.Context, in *GetKeysharePoolRequest, opts ...grpc.CallOption) (*GetKeysharePoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKeysharePoolResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_GetKeysharePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) TriggerDkg(ctx context.Context, in *TriggerDkgRequest, opts ...grpc.CallOption) (*TriggerDkgResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerDkgResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_TriggerDkg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) ListStuckTransfers(ctx context.Context, in *ListStuckTransfersRequest, opts ...grpc.CallOption) (*ListStuckTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStuckTransfersResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_ListStuckTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) ListStuckPreimageRequests(ctx context.Context, in *ListStuckPreimageRequestsRequest, opts ...grpc.CallOption) (*ListStuckPreimageRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStuckPreimageRequestsResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_ListStuckPreimageRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) GetChainStatus(ctx context.Context, in *GetChainStatusRequest, opts ...grpc.CallOption) (*GetChainStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChainStatusResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_GetChainStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) GetLrc20PoolStatus(ctx context.Context, in *GetLrc20PoolStatusRequest, opts ...grpc.CallOption) (*GetLrc20PoolStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLrc20PoolStatusResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_GetLrc20PoolStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) SetNetworkPause(ctx context.Context, in *SetNetworkPauseRequest, opts ...grpc.CallOption) (*SetNetworkPauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNetworkPauseResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_SetNetworkPause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) ListNetworkPauses(ctx context.Context, in *ListNetworkPausesRequest, opts ...grpc.CallOption) (*ListNetworkPausesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNetworkPausesResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_ListNetworkPauses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) ListConsistencyDiscrepancies(ctx context.Context, in *ListConsistencyDiscrepanciesRequest, opts ...grpc.CallOption) (*ListConsistencyDiscrepanciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConsistencyDiscrepanciesResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_ListConsistencyDiscrepancies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SparkAdminServiceServer is the server API for SparkAdminService service.
+// All implementations must embed UnimplementedSparkAdminServiceServer
+// for forward compatibility.
+//
+// SparkAdminService exposes operational controls of a signing operator to the people running it.
+// It is not meant to be called by users or by other signing operators.
+type SparkAdminServiceServer interface {
+	// List the background tasks of the operator together with their most recent runs.
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// Run a background task now, outside of its schedule. The task runs asynchronously; poll
+	// list_tasks to follow its progress.
+	TriggerTask(context.Context, *TriggerTaskRequest) (*TriggerTaskResponse, error)
+	// Count the signing keyshares of the operator by status, and the keyshares in use by network.
+	GetKeysharePool(context.Context, *GetKeysharePoolRequest) (*GetKeysharePoolResponse, error)
+	// Run a DKG round now to generate new signing keyshares, regardless of the pool size.
+	TriggerDkg(context.Context, *TriggerDkgRequest) (*TriggerDkgResponse, error)
+	// Count the transfers that have not reached a final status, grouped by status and by how long
+	// ago they were last updated.
+	ListStuckTransfers(context.Context, *ListStuckTransfersRequest) (*ListStuckTransfersResponse, error)
+	// List the preimage requests that have been waiting for their preimage for too long.
+	ListStuckPreimageRequests(context.Context, *ListStuckPreimageRequestsRequest) (*ListStuckPreimageRequestsResponse, error)
+	// Get the height processed by the chain watcher of every network, and how far it lags behind
+	// the bitcoin node.
+	GetChainStatus(context.Context, *GetChainStatusRequest) (*GetChainStatusResponse, error)
+	// Get the health of the LRC20 connection pool of every network.
+	GetLrc20PoolStatus(context.Context, *GetLrc20PoolStatusRequest) (*GetLrc20PoolStatusResponse, error)
+	// Pause or resume new transfers or deposits on a network. Pauses only apply to this operator;
+	// since every operator takes part in transfers and deposits, pausing one operator is enough to
+	// stop them, but all operators should be paused to get clear errors.
+	SetNetworkPause(context.Context, *SetNetworkPauseRequest) (*SetNetworkPauseResponse, error)
+	// List the networks on which new transfers or deposits are paused.
+	ListNetworkPauses(context.Context, *ListNetworkPausesRequest) (*ListNetworkPausesResponse, error)
+	// List the discrepancies found by the consistency audit between the state of this operator and
+	// the state of the other operators.
+	ListConsistencyDiscrepancies(context.Context, *ListConsistencyDiscrepanciesRequest) (*ListConsistencyDiscrepanciesResponse, error)
+	mustEmbedUnimplementedSparkAdminServiceServer()
+}
+
+// UnimplementedSparkAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSparkAdminServiceServer struct{}
+
+func (UnimplementedSparkAdminServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) TriggerTask(context.Context, *TriggerTaskRequest) (*TriggerTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerTask not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) GetKeysharePool(context.Context, *GetKeysharePoolRequest) (*GetKeysharePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeysharePool not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) TriggerDkg(context.Context, *TriggerDkgRequest) (*TriggerDkgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerDkg not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) ListStuckTransfers(context.Context, *ListStuckTransfersRequest) (*ListStuckTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStuckTransfers not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) ListStuckPreimageRequests(context.Context, *ListStuckPreimageRequestsRequest) (*ListStuckPreimageRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStuckPreimageRequests not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) GetChainStatus(context.Context, *GetChainStatusRequest) (*GetChainStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainStatus not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) GetLrc20PoolStatus(context.Context, *GetLrc20PoolStatusRequest) (*GetLrc20PoolStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLrc20PoolStatus not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) SetNetworkPause(context.Context, *SetNetworkPauseRequest) (*SetNetworkPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNetworkPause not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) ListNetworkPauses(context.Context, *ListNetworkPausesRequest) (*ListNetworkPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNetworkPauses not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) ListConsistencyDiscrepancies(context.Context, *ListConsistencyDiscrepanciesRequest) (*ListConsistencyDiscrepanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConsistencyDiscrepancies not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) mustEmbedUnimplementedSparkAdminServiceServer() {}
+func (UnimplementedSparkAdminServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeSparkAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SparkAdminServiceServer will
+// result in compilation errors.
+type UnsafeSparkAdminServiceServer interface {
+	mustEmbedUnimplementedSparkAdminServiceServer()
+}
+
+func RegisterSparkAdminServiceServer(s grpc.ServiceRegistrar, srv SparkAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSparkAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SparkAdminService_ServiceDesc, srv)
+}
+
+func _SparkAdminService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_TriggerTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).TriggerTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_TriggerTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).TriggerTask(ctx, req.(*TriggerTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_GetKeysharePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeysharePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).GetKeysharePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_GetKeysharePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).GetKeysharePool(ctx, req.(*GetKeysharePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_TriggerDkg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerDkgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).TriggerDkg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_TriggerDkg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).TriggerDkg(ctx, req.(*TriggerDkgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_ListStuckTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStuckTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).ListStuckTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_ListStuckTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).ListStuckTransfers(ctx, req.(*ListStuckTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_ListStuckPreimageRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStuckPreimageRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).ListStuckPreimageRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_ListStuckPreimageRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).ListStuckPreimageRequests(ctx, req.(*ListStuckPreimageRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_GetChainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).GetChainStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_GetChainStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).GetChainStatus(ctx, req.(*GetChainStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_GetLrc20PoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLrc20PoolStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).GetLrc20PoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_GetLrc20PoolStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).GetLrc20PoolStatus(ctx, req.(*GetLrc20PoolStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_SetNetworkPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNetworkPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).SetNetworkPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_SetNetworkPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).SetNetworkPause(ctx, req.(*SetNetworkPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_ListNetworkPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetworkPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).ListNetworkPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_ListNetworkPauses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).ListNetworkPauses(ctx, req.(*ListNetworkPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_ListConsistencyDiscrepancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConsistencyDiscrepanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).ListConsistencyDiscrepancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_ListConsistencyDiscrepancies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).ListConsistencyDiscrepancies(ctx, req.(*ListConsistencyDiscrepanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SparkAdminService_ServiceDesc is the grpc.ServiceDesc for SparkAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SparkAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "spark_admin.SparkAdminService",
+	HandlerType: (*SparkAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "list_tasks",
+			Handler:    _SparkAdminService_ListTasks_Handler,
+		},
+		{
+			MethodName: "trigger_task",
+			Handler:    _SparkAdminService_TriggerTask_Handler,
+		},
+		{
+			MethodName: "get_keyshare_pool",
+			Handler:    _SparkAdminService_GetKeysharePool_Handler,
+		},
+		{
+			MethodName: "trigger_dkg",
+			Handler:    _SparkAdminService_TriggerDkg_Handler,
+		},
+		{
+			MethodName: "list_stuck_transfers",
+			Handler:    _SparkAdminService_ListStuckTransfers_Handler,
+		},
+		{
+			MethodName: "list_stuck_preimage_requests",
+			Handler:    _SparkAdminService_ListStuckPreimageRequests_Handler,
+		},
+		{
+			MethodName: "get_chain_status",
+			Handler:    _SparkAdminService_GetChainStatus_Handler,
+		},
+		{
+			MethodName: "get_lrc20_pool_status",
+			Handler:    _SparkAdminService_GetLrc20PoolStatus_Handler,
+		},
+		{
+			MethodName: "set_network_pause",
+			Handler:    _SparkAdminService_SetNetworkPause_Handler,
+		},
+		{
+			MethodName: "list_network_pauses",
+			Handler:    _SparkAdminService_ListNetworkPauses_Handler,
+		},
+		{
+			MethodName: "list_consistency_discrepancies",
+			Handler:    _SparkAdminService_ListConsistencyDiscrepancies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spark_admin.proto",
+}
@@ -7,6 +7,7 @@
 package spark_authn
 
 import (
+	_ "github.com/lightsparkdev/spark/proto/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -258,9 +259,12 @@ type VerifyChallengeRequest struct {
 	// Client's secp256k1 signature of the Challenge
 	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 	// Client's public key (uncompressed secp256k1 public key)
-	PublicKey     []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Set to open a delegated session on behalf of the identity that signed the grant. The
+	// challenge must then be for, and signed by, the delegate key of the grant.
+	DelegationGrant *SignedDelegationGrant `protobuf:"bytes,4,opt,name=delegation_grant,json=delegationGrant,proto3" json:"delegation_grant,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *VerifyChallengeRequest) Reset() {
@@ -314,6 +318,13 @@ func (x *VerifyChallengeRequest) GetPublicKey() []byte {
 	return nil
 }
 
+func (x *VerifyChallengeRequest) GetDelegationGrant() *SignedDelegationGrant {
+	if x != nil {
+		return x.DelegationGrant
+	}
+	return nil
+}
+
 // Response after successful authentication
 type VerifyChallengeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -321,8 +332,14 @@ type VerifyChallengeResponse struct {
 	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	// Token expiration timestamp (UTC Unix seconds)
 	ExpirationTimestamp int64 `protobuf:"varint,2,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// Token to get new session tokens with refresh_session, if the server issues refresh tokens
+	RefreshToken string `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Refresh token expiration timestamp (UTC Unix seconds)
+	RefreshExpirationTimestamp int64 `protobuf:"varint,4,opt,name=refresh_expiration_timestamp,json=refreshExpirationTimestamp,proto3" json:"refresh_expiration_timestamp,omitempty"`
+	// Identifies the session, e.g. to revoke it
+	SessionId     []byte `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VerifyChallengeResponse) Reset() {
@@ -369,11 +386,362 @@ func (x *VerifyChallengeResponse) GetExpirationTimestamp() int64 {
 	return 0
 }
 
+func (x *VerifyChallengeResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *VerifyChallengeResponse) GetRefreshExpirationTimestamp() int64 {
+	if x != nil {
+		return x.RefreshExpirationTimestamp
+	}
+	return 0
+}
+
+func (x *VerifyChallengeResponse) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+// DelegationGrant authorizes a delegate key to open sessions on behalf of an identity, limited to
+// scopes
+type DelegationGrant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The identity granting access (secp256k1 public key)
+	IdentityPublicKey []byte `protobuf:"bytes,1,opt,name=identity_public_key,json=identityPublicKey,proto3" json:"identity_public_key,omitempty"`
+	// The key allowed to authenticate on behalf of the identity (secp256k1 public key)
+	DelegatePublicKey []byte `protobuf:"bytes,2,opt,name=delegate_public_key,json=delegatePublicKey,proto3" json:"delegate_public_key,omitempty"`
+	// The scopes sessions opened with the grant are limited to: "read" for queries, "receive" for
+	// queries and claiming transfers and deposits
+	Scopes []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// When the grant was issued (UTC Unix seconds)
+	IssuedTimestamp int64 `protobuf:"varint,4,opt,name=issued_timestamp,json=issuedTimestamp,proto3" json:"issued_timestamp,omitempty"`
+	// When the grant expires (UTC Unix seconds). Sessions opened with the grant expire no later.
+	ExpirationTimestamp int64 `protobuf:"varint,5,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DelegationGrant) Reset() {
+	*x = DelegationGrant{}
+	mi := &file_spark_authn_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegationGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationGrant) ProtoMessage() {}
+
+func (x *DelegationGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_authn_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationGrant.ProtoReflect.Descriptor instead.
+func (*DelegationGrant) Descriptor() ([]byte, []int) {
+	return file_spark_authn_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DelegationGrant) GetIdentityPublicKey() []byte {
+	if x != nil {
+		return x.IdentityPublicKey
+	}
+	return nil
+}
+
+func (x *DelegationGrant) GetDelegatePublicKey() []byte {
+	if x != nil {
+		return x.DelegatePublicKey
+	}
+	return nil
+}
+
+func (x *DelegationGrant) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *DelegationGrant) GetIssuedTimestamp() int64 {
+	if x != nil {
+		return x.IssuedTimestamp
+	}
+	return 0
+}
+
+func (x *DelegationGrant) GetExpirationTimestamp() int64 {
+	if x != nil {
+		return x.ExpirationTimestamp
+	}
+	return 0
+}
+
+// SignedDelegationGrant is a DelegationGrant signed by its identity key
+type SignedDelegationGrant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Grant *DelegationGrant       `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	// The identity's secp256k1 signature of the sha256 hash of the grant's canonical encoding: the
+	// domain "spark delegation grant v1", the identity public key, the delegate public key, and each
+	// scope, each preceded by its length as a big-endian uint32, with the number of scopes as a
+	// big-endian uint32 before the scopes, followed by the issued and expiration timestamps as
+	// big-endian int64s
+	Signature     []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedDelegationGrant) Reset() {
+	*x = SignedDelegationGrant{}
+	mi := &file_spark_authn_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedDelegationGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedDelegationGrant) ProtoMessage() {}
+
+func (x *SignedDelegationGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_authn_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedDelegationGrant.ProtoReflect.Descriptor instead.
+func (*SignedDelegationGrant) Descriptor() ([]byte, []int) {
+	return file_spark_authn_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SignedDelegationGrant) GetGrant() *DelegationGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+func (x *SignedDelegationGrant) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type RefreshSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
+	mi := &file_spark_authn_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionRequest) ProtoMessage() {}
+
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_authn_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return file_spark_authn_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RefreshSessionRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Session token for subsequent API calls
+	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// Token expiration timestamp (UTC Unix seconds)
+	ExpirationTimestamp int64 `protobuf:"varint,2,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RefreshSessionResponse) Reset() {
+	*x = RefreshSessionResponse{}
+	mi := &file_spark_authn_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionResponse) ProtoMessage() {}
+
+func (x *RefreshSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_authn_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionResponse.ProtoReflect.Descriptor instead.
+func (*RefreshSessionResponse) Descriptor() ([]byte, []int) {
+	return file_spark_authn_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RefreshSessionResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *RefreshSessionResponse) GetExpirationTimestamp() int64 {
+	if x != nil {
+		return x.ExpirationTimestamp
+	}
+	return 0
+}
+
+type RevokeSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The session to revoke. Defaults to the session of the request.
+	SessionId []byte `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Revoke every session and delegation grant of the identity issued so far
+	AllSessions   bool `protobuf:"varint,2,opt,name=all_sessions,json=allSessions,proto3" json:"all_sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_spark_authn_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_authn_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_spark_authn_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *RevokeSessionRequest) GetAllSessions() bool {
+	if x != nil {
+		return x.AllSessions
+	}
+	return false
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_spark_authn_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_authn_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_spark_authn_proto_rawDescGZIP(), []int{11}
+}
+
 var File_spark_authn_proto protoreflect.FileDescriptor
 
 const file_spark_authn_proto_rawDesc = "" +
 	"\n" +
-	"\x11spark_authn.proto\x12\vspark_authn\"x\n" +
+	"\x11spark_authn.proto\x12\vspark_authn\x1a\fcommon.proto\"x\n" +
 	"\tChallenge\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1c\n" +
 	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x14\n" +
@@ -389,18 +757,44 @@ const file_spark_authn_proto_rawDesc = "" +
 	"\n" +
 	"public_key\x18\x01 \x01(\fR\tpublicKey\"h\n" +
 	"\x14GetChallengeResponse\x12P\n" +
-	"\x13protected_challenge\x18\x01 \x01(\v2\x1f.spark_authn.ProtectedChallengeR\x12protectedChallenge\"\xa7\x01\n" +
+	"\x13protected_challenge\x18\x01 \x01(\v2\x1f.spark_authn.ProtectedChallengeR\x12protectedChallenge\"\xf6\x01\n" +
 	"\x16VerifyChallengeRequest\x12P\n" +
 	"\x13protected_challenge\x18\x01 \x01(\v2\x1f.spark_authn.ProtectedChallengeR\x12protectedChallenge\x12\x1c\n" +
 	"\tsignature\x18\x02 \x01(\fR\tsignature\x12\x1d\n" +
 	"\n" +
-	"public_key\x18\x03 \x01(\fR\tpublicKey\"q\n" +
-	"\x17VerifyChallengeResponse\x12#\n" +
-	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x121\n" +
-	"\x14expiration_timestamp\x18\x02 \x01(\x03R\x13expirationTimestamp2\xcc\x01\n" +
+	"public_key\x18\x03 \x01(\fR\tpublicKey\x12M\n" +
+	"\x10delegation_grant\x18\x04 \x01(\v2\".spark_authn.SignedDelegationGrantR\x0fdelegationGrant\"\x83\x02\n" +
+	"\x17VerifyChallengeResponse\x12)\n" +
+	"\rsession_token\x18\x01 \x01(\tB\x04\x80\xb5\x18\x01R\fsessionToken\x121\n" +
+	"\x14expiration_timestamp\x18\x02 \x01(\x03R\x13expirationTimestamp\x12)\n" +
+	"\rrefresh_token\x18\x03 \x01(\tB\x04\x80\xb5\x18\x01R\frefreshToken\x12@\n" +
+	"\x1crefresh_expiration_timestamp\x18\x04 \x01(\x03R\x1arefreshExpirationTimestamp\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\fR\tsessionId\"\xe7\x01\n" +
+	"\x0fDelegationGrant\x12.\n" +
+	"\x13identity_public_key\x18\x01 \x01(\fR\x11identityPublicKey\x12.\n" +
+	"\x13delegate_public_key\x18\x02 \x01(\fR\x11delegatePublicKey\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12)\n" +
+	"\x10issued_timestamp\x18\x04 \x01(\x03R\x0fissuedTimestamp\x121\n" +
+	"\x14expiration_timestamp\x18\x05 \x01(\x03R\x13expirationTimestamp\"i\n" +
+	"\x15SignedDelegationGrant\x122\n" +
+	"\x05grant\x18\x01 \x01(\v2\x1c.spark_authn.DelegationGrantR\x05grant\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\"B\n" +
+	"\x15RefreshSessionRequest\x12)\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\x04\x80\xb5\x18\x01R\frefreshToken\"v\n" +
+	"\x16RefreshSessionResponse\x12)\n" +
+	"\rsession_token\x18\x01 \x01(\tB\x04\x80\xb5\x18\x01R\fsessionToken\x121\n" +
+	"\x14expiration_timestamp\x18\x02 \x01(\x03R\x13expirationTimestamp\"X\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\fR\tsessionId\x12!\n" +
+	"\fall_sessions\x18\x02 \x01(\bR\vallSessions\"\x17\n" +
+	"\x15RevokeSessionResponse2\x85\x03\n" +
 	"\x11SparkAuthnService\x12V\n" +
 	"\rget_challenge\x12 .spark_authn.GetChallengeRequest\x1a!.spark_authn.GetChallengeResponse\"\x00\x12_\n" +
-	"\x10verify_challenge\x12#.spark_authn.VerifyChallengeRequest\x1a$.spark_authn.VerifyChallengeResponse\"\x00B2Z0github.com/lightsparkdev/spark/proto/spark_authnb\x06proto3"
+	"\x10verify_challenge\x12#.spark_authn.VerifyChallengeRequest\x1a$.spark_authn.VerifyChallengeResponse\"\x00\x12\\\n" +
+	"\x0frefresh_session\x12\".spark_authn.RefreshSessionRequest\x1a#.spark_authn.RefreshSessionResponse\"\x00\x12Y\n" +
+	"\x0erevoke_session\x12!.spark_authn.RevokeSessionRequest\x1a\".spark_authn.RevokeSessionResponse\"\x00B2Z0github.com/lightsparkdev/spark/proto/spark_authnb\x06proto3"
 
 var (
 	file_spark_authn_proto_rawDescOnce sync.Once
@@ -414,7 +808,7 @@ func file_spark_authn_proto_rawDescGZIP() []byte {
 	return file_spark_authn_proto_rawDescData
 }
 
-var file_spark_authn_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_spark_authn_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_spark_authn_proto_goTypes = []any{
 	(*Challenge)(nil),               // 0: spark_authn.Challenge
 	(*ProtectedChallenge)(nil),      // 1: spark_authn.ProtectedChallenge
@@ -422,20 +816,32 @@ var file_spark_authn_proto_goTypes = []any{
 	(*GetChallengeResponse)(nil),    // 3: spark_authn.GetChallengeResponse
 	(*VerifyChallengeRequest)(nil),  // 4: spark_authn.VerifyChallengeRequest
 	(*VerifyChallengeResponse)(nil), // 5: spark_authn.VerifyChallengeResponse
+	(*DelegationGrant)(nil),         // 6: spark_authn.DelegationGrant
+	(*SignedDelegationGrant)(nil),   // 7: spark_authn.SignedDelegationGrant
+	(*RefreshSessionRequest)(nil),   // 8: spark_authn.RefreshSessionRequest
+	(*RefreshSessionResponse)(nil),  // 9: spark_authn.RefreshSessionResponse
+	(*RevokeSessionRequest)(nil),    // 10: spark_authn.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),   // 11: spark_authn.RevokeSessionResponse
 }
 var file_spark_authn_proto_depIdxs = []int32{
-	0, // 0: spark_authn.ProtectedChallenge.challenge:type_name -> spark_authn.Challenge
-	1, // 1: spark_authn.GetChallengeResponse.protected_challenge:type_name -> spark_authn.ProtectedChallenge
-	1, // 2: spark_authn.VerifyChallengeRequest.protected_challenge:type_name -> spark_authn.ProtectedChallenge
-	2, // 3: spark_authn.SparkAuthnService.get_challenge:input_type -> spark_authn.GetChallengeRequest
-	4, // 4: spark_authn.SparkAuthnService.verify_challenge:input_type -> spark_authn.VerifyChallengeRequest
-	3, // 5: spark_authn.SparkAuthnService.get_challenge:output_type -> spark_authn.GetChallengeResponse
-	5, // 6: spark_authn.SparkAuthnService.verify_challenge:output_type -> spark_authn.VerifyChallengeResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: spark_authn.ProtectedChallenge.challenge:type_name -> spark_authn.Challenge
+	1,  // 1: spark_authn.GetChallengeResponse.protected_challenge:type_name -> spark_authn.ProtectedChallenge
+	1,  // 2: spark_authn.VerifyChallengeRequest.protected_challenge:type_name -> spark_authn.ProtectedChallenge
+	7,  // 3: spark_authn.VerifyChallengeRequest.delegation_grant:type_name -> spark_authn.SignedDelegationGrant
+	6,  // 4: spark_authn.SignedDelegationGrant.grant:type_name -> spark_authn.DelegationGrant
+	2,  // 5: spark_authn.SparkAuthnService.get_challenge:input_type -> spark_authn.GetChallengeRequest
+	4,  // 6: spark_authn.SparkAuthnService.verify_challenge:input_type -> spark_authn.VerifyChallengeRequest
+	8,  // 7: spark_authn.SparkAuthnService.refresh_session:input_type -> spark_authn.RefreshSessionRequest
+	10, // 8: spark_authn.SparkAuthnService.revoke_session:input_type -> spark_authn.RevokeSessionRequest
+	3,  // 9: spark_authn.SparkAuthnService.get_challenge:output_type -> spark_authn.GetChallengeResponse
+	5,  // 10: spark_authn.SparkAuthnService.verify_challenge:output_type -> spark_authn.VerifyChallengeResponse
+	9,  // 11: spark_authn.SparkAuthnService.refresh_session:output_type -> spark_authn.RefreshSessionResponse
+	11, // 12: spark_authn.SparkAuthnService.revoke_session:output_type -> spark_authn.RevokeSessionResponse
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_spark_authn_proto_init() }
@@ -449,7 +855,7 @@ func file_spark_authn_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spark_authn_proto_rawDesc), len(file_spark_authn_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
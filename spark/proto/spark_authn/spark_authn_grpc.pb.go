@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	SparkAuthnService_GetChallenge_FullMethodName    = "/spark_authn.SparkAuthnService/get_challenge"
 	SparkAuthnService_VerifyChallenge_FullMethodName = "/spark_authn.SparkAuthnService/verify_challenge"
+	SparkAuthnService_RefreshSession_FullMethodName  = "/spark_authn.SparkAuthnService/refresh_session"
+	SparkAuthnService_RevokeSession_FullMethodName   = "/spark_authn.SparkAuthnService/revoke_session"
 )
 
 // SparkAuthnServiceClient is the client API for SparkAuthnService service.
@@ -31,6 +33,11 @@ type SparkAuthnServiceClient interface {
 	GetChallenge(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*GetChallengeResponse, error)
 	// Verify a signed challenge and return a session token
 	VerifyChallenge(ctx context.Context, in *VerifyChallengeRequest, opts ...grpc.CallOption) (*VerifyChallengeResponse, error)
+	// Exchange a refresh token for a new session token
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)
+	// Revoke the session of the request, another session of the same identity, or every session
+	// and delegation grant of the identity issued so far
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
 }
 
 type sparkAuthnServiceClient struct {
@@ -61,6 +68,26 @@ func (c *sparkAuthnServiceClient) VerifyChallenge(ctx context.Context, in *Verif
 	return out, nil
 }
 
+func (c *sparkAuthnServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshSessionResponse)
+	err := c.cc.Invoke(ctx, SparkAuthnService_RefreshSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAuthnServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, SparkAuthnService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SparkAuthnServiceServer is the server API for SparkAuthnService service.
 // All implementations must embed UnimplementedSparkAuthnServiceServer
 // for forward compatibility.
@@ -69,6 +96,11 @@ type SparkAuthnServiceServer interface {
 	GetChallenge(context.Context, *GetChallengeRequest) (*GetChallengeResponse, error)
 	// Verify a signed challenge and return a session token
 	VerifyChallenge(context.Context, *VerifyChallengeRequest) (*VerifyChallengeResponse, error)
+	// Exchange a refresh token for a new session token
+	RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error)
+	// Revoke the session of the request, another session of the same identity, or every session
+	// and delegation grant of the identity issued so far
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
 	mustEmbedUnimplementedSparkAuthnServiceServer()
 }
 
@@ -85,6 +117,12 @@ func (UnimplementedSparkAuthnServiceServer) GetChallenge(context.Context, *GetCh
 func (UnimplementedSparkAuthnServiceServer) VerifyChallenge(context.Context, *VerifyChallengeRequest) (*VerifyChallengeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyChallenge not implemented")
 }
+func (UnimplementedSparkAuthnServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedSparkAuthnServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
 func (UnimplementedSparkAuthnServiceServer) mustEmbedUnimplementedSparkAuthnServiceServer() {}
 func (UnimplementedSparkAuthnServiceServer) testEmbeddedByValue()                           {}
 
@@ -142,6 +180,42 @@ func _SparkAuthnService_VerifyChallenge_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SparkAuthnService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAuthnServiceServer).RefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAuthnService_RefreshSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAuthnServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAuthnService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAuthnServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAuthnService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAuthnServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SparkAuthnService_ServiceDesc is the grpc.ServiceDesc for SparkAuthnService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +231,14 @@ var SparkAuthnService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "verify_challenge",
 			Handler:    _SparkAuthnService_VerifyChallenge_Handler,
 		},
+		{
+			MethodName: "refresh_session",
+			Handler:    _SparkAuthnService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "revoke_session",
+			Handler:    _SparkAuthnService_RevokeSession_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "spark_authn.proto",
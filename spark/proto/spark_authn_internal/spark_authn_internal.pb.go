@@ -21,6 +21,54 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SessionTokenType int32
+
+const (
+	// Access tokens authenticate API calls
+	SessionTokenType_SESSION_TOKEN_TYPE_ACCESS SessionTokenType = 0
+	// Refresh tokens can only be exchanged for new access tokens
+	SessionTokenType_SESSION_TOKEN_TYPE_REFRESH SessionTokenType = 1
+)
+
+// Enum value maps for SessionTokenType.
+var (
+	SessionTokenType_name = map[int32]string{
+		0: "SESSION_TOKEN_TYPE_ACCESS",
+		1: "SESSION_TOKEN_TYPE_REFRESH",
+	}
+	SessionTokenType_value = map[string]int32{
+		"SESSION_TOKEN_TYPE_ACCESS":  0,
+		"SESSION_TOKEN_TYPE_REFRESH": 1,
+	}
+)
+
+func (x SessionTokenType) Enum() *SessionTokenType {
+	p := new(SessionTokenType)
+	*p = x
+	return p
+}
+
+func (x SessionTokenType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionTokenType) Descriptor() protoreflect.EnumDescriptor {
+	return file_spark_authn_internal_proto_enumTypes[0].Descriptor()
+}
+
+func (SessionTokenType) Type() protoreflect.EnumType {
+	return &file_spark_authn_internal_proto_enumTypes[0]
+}
+
+func (x SessionTokenType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionTokenType.Descriptor instead.
+func (SessionTokenType) EnumDescriptor() ([]byte, []int) {
+	return file_spark_authn_internal_proto_rawDescGZIP(), []int{0}
+}
+
 // Protected information about a session
 type Session struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -31,9 +79,19 @@ type Session struct {
 	// Random nonce for uniqueness
 	Nonce []byte `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// Public key this session is valid for
-	PublicKey     []byte `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PublicKey []byte `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Identifies the login the session belongs to. The access and refresh tokens of a login share
+	// it, and revoking it revokes them all.
+	SessionId []byte           `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	TokenType SessionTokenType `protobuf:"varint,6,opt,name=token_type,json=tokenType,proto3,enum=spark_authn.SessionTokenType" json:"token_type,omitempty"`
+	// When the login happened (UTC Unix milliseconds)
+	IssuedTimestampMillis int64 `protobuf:"varint,7,opt,name=issued_timestamp_millis,json=issuedTimestampMillis,proto3" json:"issued_timestamp_millis,omitempty"`
+	// The scopes the session is limited to. Empty for a session with full access.
+	Scopes []string `protobuf:"bytes,8,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// For a delegated session, the key that authenticated on behalf of public_key
+	DelegatePublicKey []byte `protobuf:"bytes,9,opt,name=delegate_public_key,json=delegatePublicKey,proto3" json:"delegate_public_key,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Session) Reset() {
@@ -94,6 +152,41 @@ func (x *Session) GetPublicKey() []byte {
 	return nil
 }
 
+func (x *Session) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *Session) GetTokenType() SessionTokenType {
+	if x != nil {
+		return x.TokenType
+	}
+	return SessionTokenType_SESSION_TOKEN_TYPE_ACCESS
+}
+
+func (x *Session) GetIssuedTimestampMillis() int64 {
+	if x != nil {
+		return x.IssuedTimestampMillis
+	}
+	return 0
+}
+
+func (x *Session) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *Session) GetDelegatePublicKey() []byte {
+	if x != nil {
+		return x.DelegatePublicKey
+	}
+	return nil
+}
+
 // ProtectedSession wraps Session with integrity protection
 type ProtectedSession struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -162,17 +255,27 @@ var File_spark_authn_internal_proto protoreflect.FileDescriptor
 
 const file_spark_authn_internal_proto_rawDesc = "" +
 	"\n" +
-	"\x1aspark_authn_internal.proto\x12\vspark_authn\"\x8b\x01\n" +
+	"\x1aspark_authn_internal.proto\x12\vspark_authn\"\xe8\x02\n" +
 	"\aSession\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x05R\aversion\x121\n" +
 	"\x14expiration_timestamp\x18\x02 \x01(\x03R\x13expirationTimestamp\x12\x14\n" +
 	"\x05nonce\x18\x03 \x01(\fR\x05nonce\x12\x1d\n" +
 	"\n" +
-	"public_key\x18\x04 \x01(\fR\tpublicKey\"p\n" +
+	"public_key\x18\x04 \x01(\fR\tpublicKey\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\fR\tsessionId\x12<\n" +
+	"\n" +
+	"token_type\x18\x06 \x01(\x0e2\x1d.spark_authn.SessionTokenTypeR\ttokenType\x126\n" +
+	"\x17issued_timestamp_millis\x18\a \x01(\x03R\x15issuedTimestampMillis\x12\x16\n" +
+	"\x06scopes\x18\b \x03(\tR\x06scopes\x12.\n" +
+	"\x13delegate_public_key\x18\t \x01(\fR\x11delegatePublicKey\"p\n" +
 	"\x10ProtectedSession\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x05R\aversion\x12.\n" +
 	"\asession\x18\x02 \x01(\v2\x14.spark_authn.SessionR\asession\x12\x12\n" +
-	"\x04hmac\x18\x03 \x01(\fR\x04hmacB;Z9github.com/lightsparkdev/spark/proto/spark_authn_internalb\x06proto3"
+	"\x04hmac\x18\x03 \x01(\fR\x04hmac*Q\n" +
+	"\x10SessionTokenType\x12\x1d\n" +
+	"\x19SESSION_TOKEN_TYPE_ACCESS\x10\x00\x12\x1e\n" +
+	"\x1aSESSION_TOKEN_TYPE_REFRESH\x10\x01B;Z9github.com/lightsparkdev/spark/proto/spark_authn_internalb\x06proto3"
 
 var (
 	file_spark_authn_internal_proto_rawDescOnce sync.Once
@@ -186,18 +289,21 @@ func file_spark_authn_internal_proto_rawDescGZIP() []byte {
 	return file_spark_authn_internal_proto_rawDescData
 }
 
+var file_spark_authn_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_spark_authn_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_spark_authn_internal_proto_goTypes = []any{
-	(*Session)(nil),          // 0: spark_authn.Session
-	(*ProtectedSession)(nil), // 1: spark_authn.ProtectedSession
+	(SessionTokenType)(0),    // 0: spark_authn.SessionTokenType
+	(*Session)(nil),          // 1: spark_authn.Session
+	(*ProtectedSession)(nil), // 2: spark_authn.ProtectedSession
 }
 var file_spark_authn_internal_proto_depIdxs = []int32{
-	0, // 0: spark_authn.ProtectedSession.session:type_name -> spark_authn.Session
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0, // 0: spark_authn.Session.token_type:type_name -> spark_authn.SessionTokenType
+	1, // 1: spark_authn.ProtectedSession.session:type_name -> spark_authn.Session
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_spark_authn_internal_proto_init() }
@@ -210,13 +316,14 @@ func file_spark_authn_internal_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spark_authn_internal_proto_rawDesc), len(file_spark_authn_internal_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_spark_authn_internal_proto_goTypes,
 		DependencyIndexes: file_spark_authn_internal_proto_depIdxs,
+		EnumInfos:         file_spark_authn_internal_proto_enumTypes,
 		MessageInfos:      file_spark_authn_internal_proto_msgTypes,
 	}.Build()
 	File_spark_authn_internal_proto = out.File
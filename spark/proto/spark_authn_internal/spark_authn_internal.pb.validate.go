@@ -64,6 +64,14 @@ func (m *Session) validate(all bool) error {
 
 	// no validation rules for PublicKey
 
+	// no validation rules for SessionId
+
+	// no validation rules for TokenType
+
+	// no validation rules for IssuedTimestampMillis
+
+	// no validation rules for DelegatePublicKey
+
 	if len(errors) > 0 {
 		return SessionMultiError(errors)
 	}
@@ -74,6 +74,63 @@ func (SettleKeyTweakAction) EnumDescriptor() ([]byte, []int) {
 	return file_spark_internal_proto_rawDescGZIP(), []int{0}
 }
 
+// ConsistencyKind is the kind of state compared by the consistency audit, and determines what the
+// keys of the audit are.
+type ConsistencyKind int32
+
+const (
+	ConsistencyKind_CONSISTENCY_KIND_UNSPECIFIED ConsistencyKind = 0
+	// The nodes of a tree, keyed by tree ID.
+	ConsistencyKind_CONSISTENCY_KIND_TREE ConsistencyKind = 1
+	// The transfers sent by an owner, keyed by hex encoded sender identity public key.
+	ConsistencyKind_CONSISTENCY_KIND_TRANSFER ConsistencyKind = 2
+	// The token outputs of an owner, keyed by hex encoded owner public key.
+	ConsistencyKind_CONSISTENCY_KIND_TOKEN_OUTPUT ConsistencyKind = 3
+)
+
+// Enum value maps for ConsistencyKind.
+var (
+	ConsistencyKind_name = map[int32]string{
+		0: "CONSISTENCY_KIND_UNSPECIFIED",
+		1: "CONSISTENCY_KIND_TREE",
+		2: "CONSISTENCY_KIND_TRANSFER",
+		3: "CONSISTENCY_KIND_TOKEN_OUTPUT",
+	}
+	ConsistencyKind_value = map[string]int32{
+		"CONSISTENCY_KIND_UNSPECIFIED":  0,
+		"CONSISTENCY_KIND_TREE":         1,
+		"CONSISTENCY_KIND_TRANSFER":     2,
+		"CONSISTENCY_KIND_TOKEN_OUTPUT": 3,
+	}
+)
+
+func (x ConsistencyKind) Enum() *ConsistencyKind {
+	p := new(ConsistencyKind)
+	*p = x
+	return p
+}
+
+func (x ConsistencyKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsistencyKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_spark_internal_proto_enumTypes[1].Descriptor()
+}
+
+func (ConsistencyKind) Type() protoreflect.EnumType {
+	return &file_spark_internal_proto_enumTypes[1]
+}
+
+func (x ConsistencyKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsistencyKind.Descriptor instead.
+func (ConsistencyKind) EnumDescriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{1}
+}
+
 type MarkKeysharesAsUsedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	KeyshareId    []string               `protobuf:"bytes,1,rep,name=keyshare_id,json=keyshareId,proto3" json:"keyshare_id,omitempty"`
@@ -125,7 +182,9 @@ type MarkKeyshareForDepositAddressRequest struct {
 	OwnerIdentityPublicKey []byte                 `protobuf:"bytes,3,opt,name=owner_identity_public_key,json=ownerIdentityPublicKey,proto3" json:"owner_identity_public_key,omitempty"`
 	OwnerSigningPublicKey  []byte                 `protobuf:"bytes,4,opt,name=owner_signing_public_key,json=ownerSigningPublicKey,proto3" json:"owner_signing_public_key,omitempty"`
 	// Static deposit address flag
-	IsStatic      *bool `protobuf:"varint,5,opt,name=is_static,json=isStatic,proto3,oneof" json:"is_static,omitempty"`
+	IsStatic *bool `protobuf:"varint,5,opt,name=is_static,json=isStatic,proto3,oneof" json:"is_static,omitempty"`
+	// When the address expires if it has not received funds by then.
+	ExpiryTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,oneof" json:"expiry_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -195,6 +254,13 @@ func (x *MarkKeyshareForDepositAddressRequest) GetIsStatic() bool {
 	return false
 }
 
+func (x *MarkKeyshareForDepositAddressRequest) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
 type MarkKeyshareForDepositAddressResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	AddressSignature []byte                 `protobuf:"bytes,1,opt,name=address_signature,json=addressSignature,proto3" json:"address_signature,omitempty"`
@@ -239,6 +305,105 @@ func (x *MarkKeyshareForDepositAddressResponse) GetAddressSignature() []byte {
 	return nil
 }
 
+type RecycleDepositAddressesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Addresses []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Only report which of the addresses would be recycled, without recycling them.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecycleDepositAddressesRequest) Reset() {
+	*x = RecycleDepositAddressesRequest{}
+	mi := &file_spark_internal_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecycleDepositAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecycleDepositAddressesRequest) ProtoMessage() {}
+
+func (x *RecycleDepositAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecycleDepositAddressesRequest.ProtoReflect.Descriptor instead.
+func (*RecycleDepositAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RecycleDepositAddressesRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *RecycleDepositAddressesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RecycleDepositAddressesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The addresses that can be recycled on this operator, and were unless the request was a dry
+	// run.
+	RecycledAddresses []string `protobuf:"bytes,1,rep,name=recycled_addresses,json=recycledAddresses,proto3" json:"recycled_addresses,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RecycleDepositAddressesResponse) Reset() {
+	*x = RecycleDepositAddressesResponse{}
+	mi := &file_spark_internal_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecycleDepositAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecycleDepositAddressesResponse) ProtoMessage() {}
+
+func (x *RecycleDepositAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecycleDepositAddressesResponse.ProtoReflect.Descriptor instead.
+func (*RecycleDepositAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecycleDepositAddressesResponse) GetRecycledAddresses() []string {
+	if x != nil {
+		return x.RecycledAddresses
+	}
+	return nil
+}
+
 type FrostRound1Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	KeyshareIds   []string               `protobuf:"bytes,1,rep,name=keyshare_ids,json=keyshareIds,proto3" json:"keyshare_ids,omitempty"`
@@ -248,7 +413,7 @@ type FrostRound1Request struct {
 
 func (x *FrostRound1Request) Reset() {
 	*x = FrostRound1Request{}
-	mi := &file_spark_internal_proto_msgTypes[3]
+	mi := &file_spark_internal_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -260,7 +425,7 @@ func (x *FrostRound1Request) String() string {
 func (*FrostRound1Request) ProtoMessage() {}
 
 func (x *FrostRound1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[3]
+	mi := &file_spark_internal_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,7 +438,7 @@ func (x *FrostRound1Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrostRound1Request.ProtoReflect.Descriptor instead.
 func (*FrostRound1Request) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{3}
+	return file_spark_internal_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FrostRound1Request) GetKeyshareIds() []string {
@@ -292,7 +457,7 @@ type FrostRound1Response struct {
 
 func (x *FrostRound1Response) Reset() {
 	*x = FrostRound1Response{}
-	mi := &file_spark_internal_proto_msgTypes[4]
+	mi := &file_spark_internal_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -304,7 +469,7 @@ func (x *FrostRound1Response) String() string {
 func (*FrostRound1Response) ProtoMessage() {}
 
 func (x *FrostRound1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[4]
+	mi := &file_spark_internal_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +482,7 @@ func (x *FrostRound1Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrostRound1Response.ProtoReflect.Descriptor instead.
 func (*FrostRound1Response) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{4}
+	return file_spark_internal_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *FrostRound1Response) GetSigningCommitments() []*common.SigningCommitment {
@@ -349,7 +514,7 @@ type SigningJob struct {
 
 func (x *SigningJob) Reset() {
 	*x = SigningJob{}
-	mi := &file_spark_internal_proto_msgTypes[5]
+	mi := &file_spark_internal_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -361,7 +526,7 @@ func (x *SigningJob) String() string {
 func (*SigningJob) ProtoMessage() {}
 
 func (x *SigningJob) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[5]
+	mi := &file_spark_internal_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +539,7 @@ func (x *SigningJob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SigningJob.ProtoReflect.Descriptor instead.
 func (*SigningJob) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{5}
+	return file_spark_internal_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SigningJob) GetJobId() string {
@@ -435,7 +600,7 @@ type FrostRound2Request struct {
 
 func (x *FrostRound2Request) Reset() {
 	*x = FrostRound2Request{}
-	mi := &file_spark_internal_proto_msgTypes[6]
+	mi := &file_spark_internal_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +612,7 @@ func (x *FrostRound2Request) String() string {
 func (*FrostRound2Request) ProtoMessage() {}
 
 func (x *FrostRound2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[6]
+	mi := &file_spark_internal_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +625,7 @@ func (x *FrostRound2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrostRound2Request.ProtoReflect.Descriptor instead.
 func (*FrostRound2Request) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{6}
+	return file_spark_internal_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *FrostRound2Request) GetSigningJobs() []*SigningJob {
@@ -479,7 +644,7 @@ type FrostRound2Response struct {
 
 func (x *FrostRound2Response) Reset() {
 	*x = FrostRound2Response{}
-	mi := &file_spark_internal_proto_msgTypes[7]
+	mi := &file_spark_internal_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +656,7 @@ func (x *FrostRound2Response) String() string {
 func (*FrostRound2Response) ProtoMessage() {}
 
 func (x *FrostRound2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[7]
+	mi := &file_spark_internal_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +669,7 @@ func (x *FrostRound2Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrostRound2Response.ProtoReflect.Descriptor instead.
 func (*FrostRound2Response) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{7}
+	return file_spark_internal_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *FrostRound2Response) GetResults() map[string]*common.SigningResult {
@@ -526,7 +691,7 @@ type PrepareSplitKeysharesRequest struct {
 
 func (x *PrepareSplitKeysharesRequest) Reset() {
 	*x = PrepareSplitKeysharesRequest{}
-	mi := &file_spark_internal_proto_msgTypes[8]
+	mi := &file_spark_internal_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -538,7 +703,7 @@ func (x *PrepareSplitKeysharesRequest) String() string {
 func (*PrepareSplitKeysharesRequest) ProtoMessage() {}
 
 func (x *PrepareSplitKeysharesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[8]
+	mi := &file_spark_internal_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +716,7 @@ func (x *PrepareSplitKeysharesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareSplitKeysharesRequest.ProtoReflect.Descriptor instead.
 func (*PrepareSplitKeysharesRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{8}
+	return file_spark_internal_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PrepareSplitKeysharesRequest) GetNodeId() string {
@@ -592,7 +757,7 @@ type FinalizeTreeCreationRequest struct {
 
 func (x *FinalizeTreeCreationRequest) Reset() {
 	*x = FinalizeTreeCreationRequest{}
-	mi := &file_spark_internal_proto_msgTypes[9]
+	mi := &file_spark_internal_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -604,7 +769,7 @@ func (x *FinalizeTreeCreationRequest) String() string {
 func (*FinalizeTreeCreationRequest) ProtoMessage() {}
 
 func (x *FinalizeTreeCreationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[9]
+	mi := &file_spark_internal_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +782,7 @@ func (x *FinalizeTreeCreationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeTreeCreationRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeTreeCreationRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{9}
+	return file_spark_internal_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *FinalizeTreeCreationRequest) GetNodes() []*TreeNode {
@@ -643,7 +808,7 @@ type FinalizeNodesAggregationRequest struct {
 
 func (x *FinalizeNodesAggregationRequest) Reset() {
 	*x = FinalizeNodesAggregationRequest{}
-	mi := &file_spark_internal_proto_msgTypes[10]
+	mi := &file_spark_internal_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -655,7 +820,7 @@ func (x *FinalizeNodesAggregationRequest) String() string {
 func (*FinalizeNodesAggregationRequest) ProtoMessage() {}
 
 func (x *FinalizeNodesAggregationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[10]
+	mi := &file_spark_internal_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +833,7 @@ func (x *FinalizeNodesAggregationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeNodesAggregationRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeNodesAggregationRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{10}
+	return file_spark_internal_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *FinalizeNodesAggregationRequest) GetNodes() []*TreeNode {
@@ -689,7 +854,7 @@ type FinalizeTransferRequest struct {
 
 func (x *FinalizeTransferRequest) Reset() {
 	*x = FinalizeTransferRequest{}
-	mi := &file_spark_internal_proto_msgTypes[11]
+	mi := &file_spark_internal_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -701,7 +866,7 @@ func (x *FinalizeTransferRequest) String() string {
 func (*FinalizeTransferRequest) ProtoMessage() {}
 
 func (x *FinalizeTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[11]
+	mi := &file_spark_internal_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +879,7 @@ func (x *FinalizeTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeTransferRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeTransferRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{11}
+	return file_spark_internal_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *FinalizeTransferRequest) GetTransferId() string {
@@ -747,7 +912,7 @@ type FinalizeRefreshTimelockRequest struct {
 
 func (x *FinalizeRefreshTimelockRequest) Reset() {
 	*x = FinalizeRefreshTimelockRequest{}
-	mi := &file_spark_internal_proto_msgTypes[12]
+	mi := &file_spark_internal_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -759,7 +924,7 @@ func (x *FinalizeRefreshTimelockRequest) String() string {
 func (*FinalizeRefreshTimelockRequest) ProtoMessage() {}
 
 func (x *FinalizeRefreshTimelockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[12]
+	mi := &file_spark_internal_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,7 +937,7 @@ func (x *FinalizeRefreshTimelockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeRefreshTimelockRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeRefreshTimelockRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{12}
+	return file_spark_internal_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *FinalizeRefreshTimelockRequest) GetNodes() []*TreeNode {
@@ -791,7 +956,7 @@ type FinalizeExtendLeafRequest struct {
 
 func (x *FinalizeExtendLeafRequest) Reset() {
 	*x = FinalizeExtendLeafRequest{}
-	mi := &file_spark_internal_proto_msgTypes[13]
+	mi := &file_spark_internal_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -803,7 +968,7 @@ func (x *FinalizeExtendLeafRequest) String() string {
 func (*FinalizeExtendLeafRequest) ProtoMessage() {}
 
 func (x *FinalizeExtendLeafRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[13]
+	mi := &file_spark_internal_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,7 +981,7 @@ func (x *FinalizeExtendLeafRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeExtendLeafRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeExtendLeafRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{13}
+	return file_spark_internal_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *FinalizeExtendLeafRequest) GetNode() *TreeNode {
@@ -846,7 +1011,7 @@ type TreeNode struct {
 
 func (x *TreeNode) Reset() {
 	*x = TreeNode{}
-	mi := &file_spark_internal_proto_msgTypes[14]
+	mi := &file_spark_internal_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -858,7 +1023,7 @@ func (x *TreeNode) String() string {
 func (*TreeNode) ProtoMessage() {}
 
 func (x *TreeNode) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[14]
+	mi := &file_spark_internal_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +1036,7 @@ func (x *TreeNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TreeNode.ProtoReflect.Descriptor instead.
 func (*TreeNode) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{14}
+	return file_spark_internal_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TreeNode) GetId() string {
@@ -967,7 +1132,7 @@ type InitiatePreimageSwapResponse struct {
 
 func (x *InitiatePreimageSwapResponse) Reset() {
 	*x = InitiatePreimageSwapResponse{}
-	mi := &file_spark_internal_proto_msgTypes[15]
+	mi := &file_spark_internal_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -979,7 +1144,7 @@ func (x *InitiatePreimageSwapResponse) String() string {
 func (*InitiatePreimageSwapResponse) ProtoMessage() {}
 
 func (x *InitiatePreimageSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[15]
+	mi := &file_spark_internal_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -992,7 +1157,7 @@ func (x *InitiatePreimageSwapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiatePreimageSwapResponse.ProtoReflect.Descriptor instead.
 func (*InitiatePreimageSwapResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{15}
+	return file_spark_internal_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *InitiatePreimageSwapResponse) GetPreimageShare() []byte {
@@ -1013,7 +1178,7 @@ type PrepareTreeAddressNode struct {
 
 func (x *PrepareTreeAddressNode) Reset() {
 	*x = PrepareTreeAddressNode{}
-	mi := &file_spark_internal_proto_msgTypes[16]
+	mi := &file_spark_internal_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1025,7 +1190,7 @@ func (x *PrepareTreeAddressNode) String() string {
 func (*PrepareTreeAddressNode) ProtoMessage() {}
 
 func (x *PrepareTreeAddressNode) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[16]
+	mi := &file_spark_internal_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,7 +1203,7 @@ func (x *PrepareTreeAddressNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareTreeAddressNode.ProtoReflect.Descriptor instead.
 func (*PrepareTreeAddressNode) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{16}
+	return file_spark_internal_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PrepareTreeAddressNode) GetSigningKeyshareId() string {
@@ -1075,7 +1240,7 @@ type PrepareTreeAddressRequest struct {
 
 func (x *PrepareTreeAddressRequest) Reset() {
 	*x = PrepareTreeAddressRequest{}
-	mi := &file_spark_internal_proto_msgTypes[17]
+	mi := &file_spark_internal_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1087,7 +1252,7 @@ func (x *PrepareTreeAddressRequest) String() string {
 func (*PrepareTreeAddressRequest) ProtoMessage() {}
 
 func (x *PrepareTreeAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[17]
+	mi := &file_spark_internal_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1100,7 +1265,7 @@ func (x *PrepareTreeAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareTreeAddressRequest.ProtoReflect.Descriptor instead.
 func (*PrepareTreeAddressRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{17}
+	return file_spark_internal_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PrepareTreeAddressRequest) GetTargetKeyshareId() string {
@@ -1140,7 +1305,7 @@ type PrepareTreeAddressResponse struct {
 
 func (x *PrepareTreeAddressResponse) Reset() {
 	*x = PrepareTreeAddressResponse{}
-	mi := &file_spark_internal_proto_msgTypes[18]
+	mi := &file_spark_internal_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1152,7 +1317,7 @@ func (x *PrepareTreeAddressResponse) String() string {
 func (*PrepareTreeAddressResponse) ProtoMessage() {}
 
 func (x *PrepareTreeAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[18]
+	mi := &file_spark_internal_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1165,7 +1330,7 @@ func (x *PrepareTreeAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareTreeAddressResponse.ProtoReflect.Descriptor instead.
 func (*PrepareTreeAddressResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{18}
+	return file_spark_internal_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PrepareTreeAddressResponse) GetSignatures() map[string][]byte {
@@ -1185,7 +1350,7 @@ type InitiateTransferLeaf struct {
 
 func (x *InitiateTransferLeaf) Reset() {
 	*x = InitiateTransferLeaf{}
-	mi := &file_spark_internal_proto_msgTypes[19]
+	mi := &file_spark_internal_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1197,7 +1362,7 @@ func (x *InitiateTransferLeaf) String() string {
 func (*InitiateTransferLeaf) ProtoMessage() {}
 
 func (x *InitiateTransferLeaf) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[19]
+	mi := &file_spark_internal_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,7 +1375,7 @@ func (x *InitiateTransferLeaf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateTransferLeaf.ProtoReflect.Descriptor instead.
 func (*InitiateTransferLeaf) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{19}
+	return file_spark_internal_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *InitiateTransferLeaf) GetLeafId() string {
@@ -1243,7 +1408,7 @@ type InitiateTransferRequest struct {
 
 func (x *InitiateTransferRequest) Reset() {
 	*x = InitiateTransferRequest{}
-	mi := &file_spark_internal_proto_msgTypes[20]
+	mi := &file_spark_internal_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1255,7 +1420,7 @@ func (x *InitiateTransferRequest) String() string {
 func (*InitiateTransferRequest) ProtoMessage() {}
 
 func (x *InitiateTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[20]
+	mi := &file_spark_internal_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,7 +1433,7 @@ func (x *InitiateTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateTransferRequest.ProtoReflect.Descriptor instead.
 func (*InitiateTransferRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{20}
+	return file_spark_internal_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *InitiateTransferRequest) GetTransferId() string {
@@ -1338,7 +1503,7 @@ type InitiateCooperativeExitRequest struct {
 
 func (x *InitiateCooperativeExitRequest) Reset() {
 	*x = InitiateCooperativeExitRequest{}
-	mi := &file_spark_internal_proto_msgTypes[21]
+	mi := &file_spark_internal_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1350,7 +1515,7 @@ func (x *InitiateCooperativeExitRequest) String() string {
 func (*InitiateCooperativeExitRequest) ProtoMessage() {}
 
 func (x *InitiateCooperativeExitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[21]
+	mi := &file_spark_internal_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,7 +1528,7 @@ func (x *InitiateCooperativeExitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateCooperativeExitRequest.ProtoReflect.Descriptor instead.
 func (*InitiateCooperativeExitRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{21}
+	return file_spark_internal_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *InitiateCooperativeExitRequest) GetTransfer() *InitiateTransferRequest {
@@ -1398,7 +1563,7 @@ type UpdatePreimageRequestRequest struct {
 
 func (x *UpdatePreimageRequestRequest) Reset() {
 	*x = UpdatePreimageRequestRequest{}
-	mi := &file_spark_internal_proto_msgTypes[22]
+	mi := &file_spark_internal_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1410,7 +1575,7 @@ func (x *UpdatePreimageRequestRequest) String() string {
 func (*UpdatePreimageRequestRequest) ProtoMessage() {}
 
 func (x *UpdatePreimageRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[22]
+	mi := &file_spark_internal_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1423,7 +1588,7 @@ func (x *UpdatePreimageRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePreimageRequestRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePreimageRequestRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{22}
+	return file_spark_internal_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UpdatePreimageRequestRequest) GetPreimageRequestId() string {
@@ -1459,7 +1624,7 @@ type StartTokenTransactionInternalRequest struct {
 
 func (x *StartTokenTransactionInternalRequest) Reset() {
 	*x = StartTokenTransactionInternalRequest{}
-	mi := &file_spark_internal_proto_msgTypes[23]
+	mi := &file_spark_internal_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1471,7 +1636,7 @@ func (x *StartTokenTransactionInternalRequest) String() string {
 func (*StartTokenTransactionInternalRequest) ProtoMessage() {}
 
 func (x *StartTokenTransactionInternalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[23]
+	mi := &file_spark_internal_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,7 +1649,7 @@ func (x *StartTokenTransactionInternalRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use StartTokenTransactionInternalRequest.ProtoReflect.Descriptor instead.
 func (*StartTokenTransactionInternalRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{23}
+	return file_spark_internal_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *StartTokenTransactionInternalRequest) GetFinalTokenTransaction() *spark.TokenTransaction {
@@ -1524,7 +1689,7 @@ type StartTokenTransactionInternalResponse struct {
 
 func (x *StartTokenTransactionInternalResponse) Reset() {
 	*x = StartTokenTransactionInternalResponse{}
-	mi := &file_spark_internal_proto_msgTypes[24]
+	mi := &file_spark_internal_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1536,7 +1701,7 @@ func (x *StartTokenTransactionInternalResponse) String() string {
 func (*StartTokenTransactionInternalResponse) ProtoMessage() {}
 
 func (x *StartTokenTransactionInternalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[24]
+	mi := &file_spark_internal_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,7 +1714,7 @@ func (x *StartTokenTransactionInternalResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use StartTokenTransactionInternalResponse.ProtoReflect.Descriptor instead.
 func (*StartTokenTransactionInternalResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{24}
+	return file_spark_internal_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *StartTokenTransactionInternalResponse) GetFinalTokenTransaction() *spark.TokenTransaction {
@@ -1569,7 +1734,7 @@ type InitiateSettleReceiverKeyTweakRequest struct {
 
 func (x *InitiateSettleReceiverKeyTweakRequest) Reset() {
 	*x = InitiateSettleReceiverKeyTweakRequest{}
-	mi := &file_spark_internal_proto_msgTypes[25]
+	mi := &file_spark_internal_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1581,7 +1746,7 @@ func (x *InitiateSettleReceiverKeyTweakRequest) String() string {
 func (*InitiateSettleReceiverKeyTweakRequest) ProtoMessage() {}
 
 func (x *InitiateSettleReceiverKeyTweakRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[25]
+	mi := &file_spark_internal_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1594,7 +1759,7 @@ func (x *InitiateSettleReceiverKeyTweakRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use InitiateSettleReceiverKeyTweakRequest.ProtoReflect.Descriptor instead.
 func (*InitiateSettleReceiverKeyTweakRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{25}
+	return file_spark_internal_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *InitiateSettleReceiverKeyTweakRequest) GetTransferId() string {
@@ -1621,7 +1786,7 @@ type SettleReceiverKeyTweakRequest struct {
 
 func (x *SettleReceiverKeyTweakRequest) Reset() {
 	*x = SettleReceiverKeyTweakRequest{}
-	mi := &file_spark_internal_proto_msgTypes[26]
+	mi := &file_spark_internal_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1633,7 +1798,7 @@ func (x *SettleReceiverKeyTweakRequest) String() string {
 func (*SettleReceiverKeyTweakRequest) ProtoMessage() {}
 
 func (x *SettleReceiverKeyTweakRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[26]
+	mi := &file_spark_internal_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1646,7 +1811,7 @@ func (x *SettleReceiverKeyTweakRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettleReceiverKeyTweakRequest.ProtoReflect.Descriptor instead.
 func (*SettleReceiverKeyTweakRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{26}
+	return file_spark_internal_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SettleReceiverKeyTweakRequest) GetTransferId() string {
@@ -1673,7 +1838,7 @@ type SettleSenderKeyTweakRequest struct {
 
 func (x *SettleSenderKeyTweakRequest) Reset() {
 	*x = SettleSenderKeyTweakRequest{}
-	mi := &file_spark_internal_proto_msgTypes[27]
+	mi := &file_spark_internal_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1685,7 +1850,7 @@ func (x *SettleSenderKeyTweakRequest) String() string {
 func (*SettleSenderKeyTweakRequest) ProtoMessage() {}
 
 func (x *SettleSenderKeyTweakRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[27]
+	mi := &file_spark_internal_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1698,7 +1863,7 @@ func (x *SettleSenderKeyTweakRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettleSenderKeyTweakRequest.ProtoReflect.Descriptor instead.
 func (*SettleSenderKeyTweakRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{27}
+	return file_spark_internal_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SettleSenderKeyTweakRequest) GetTransferId() string {
@@ -1725,7 +1890,7 @@ type CreateUtxoSwapResponse struct {
 
 func (x *CreateUtxoSwapResponse) Reset() {
 	*x = CreateUtxoSwapResponse{}
-	mi := &file_spark_internal_proto_msgTypes[28]
+	mi := &file_spark_internal_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1737,7 +1902,7 @@ func (x *CreateUtxoSwapResponse) String() string {
 func (*CreateUtxoSwapResponse) ProtoMessage() {}
 
 func (x *CreateUtxoSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[28]
+	mi := &file_spark_internal_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1750,7 +1915,7 @@ func (x *CreateUtxoSwapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUtxoSwapResponse.ProtoReflect.Descriptor instead.
 func (*CreateUtxoSwapResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{28}
+	return file_spark_internal_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CreateUtxoSwapResponse) GetUtxoDepositAddress() string {
@@ -1767,25 +1932,439 @@ func (x *CreateUtxoSwapResponse) GetTransfer() *spark.Transfer {
 	return nil
 }
 
-var File_spark_internal_proto protoreflect.FileDescriptor
+// ConsistencyRecord is the audited state of a single tree node, transfer or token output.
+type ConsistencyRecord struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Owner keys, only set for tree nodes and token outputs.
+	OwnerIdentityPublicKey []byte `protobuf:"bytes,3,opt,name=owner_identity_public_key,json=ownerIdentityPublicKey,proto3" json:"owner_identity_public_key,omitempty"`
+	OwnerSigningPublicKey  []byte `protobuf:"bytes,4,opt,name=owner_signing_public_key,json=ownerSigningPublicKey,proto3" json:"owner_signing_public_key,omitempty"`
+	// The refund transaction, only set for tree nodes.
+	RawRefundTx   []byte `protobuf:"bytes,5,opt,name=raw_refund_tx,json=rawRefundTx,proto3" json:"raw_refund_tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_spark_internal_proto_rawDesc = "" +
+func (x *ConsistencyRecord) Reset() {
+	*x = ConsistencyRecord{}
+	mi := &file_spark_internal_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencyRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyRecord) ProtoMessage() {}
+
+func (x *ConsistencyRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyRecord.ProtoReflect.Descriptor instead.
+func (*ConsistencyRecord) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ConsistencyRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConsistencyRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ConsistencyRecord) GetOwnerIdentityPublicKey() []byte {
+	if x != nil {
+		return x.OwnerIdentityPublicKey
+	}
+	return nil
+}
+
+func (x *ConsistencyRecord) GetOwnerSigningPublicKey() []byte {
+	if x != nil {
+		return x.OwnerSigningPublicKey
+	}
+	return nil
+}
+
+func (x *ConsistencyRecord) GetRawRefundTx() []byte {
+	if x != nil {
+		return x.RawRefundTx
+	}
+	return nil
+}
+
+type ConsistencyDigest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SHA-256 over the records of the key, ordered by ID.
+	Digest      []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	RecordCount uint32 `protobuf:"varint,2,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// The last time any record of the key was updated.
+	LastUpdateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConsistencyDigest) Reset() {
+	*x = ConsistencyDigest{}
+	mi := &file_spark_internal_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencyDigest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyDigest) ProtoMessage() {}
+
+func (x *ConsistencyDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyDigest.ProtoReflect.Descriptor instead.
+func (*ConsistencyDigest) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ConsistencyDigest) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *ConsistencyDigest) GetRecordCount() uint32 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *ConsistencyDigest) GetLastUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdateTime
+	}
+	return nil
+}
+
+type GetConsistencyDigestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ConsistencyKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=spark_internal.ConsistencyKind" json:"kind,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsistencyDigestsRequest) Reset() {
+	*x = GetConsistencyDigestsRequest{}
+	mi := &file_spark_internal_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsistencyDigestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsistencyDigestsRequest) ProtoMessage() {}
+
+func (x *GetConsistencyDigestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsistencyDigestsRequest.ProtoReflect.Descriptor instead.
+func (*GetConsistencyDigestsRequest) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetConsistencyDigestsRequest) GetKind() ConsistencyKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ConsistencyKind_CONSISTENCY_KIND_UNSPECIFIED
+}
+
+func (x *GetConsistencyDigestsRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type GetConsistencyDigestsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keys for which the operator holds no records are absent.
+	Digests       map[string]*ConsistencyDigest `protobuf:"bytes,1,rep,name=digests,proto3" json:"digests,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsistencyDigestsResponse) Reset() {
+	*x = GetConsistencyDigestsResponse{}
+	mi := &file_spark_internal_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsistencyDigestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsistencyDigestsResponse) ProtoMessage() {}
+
+func (x *GetConsistencyDigestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsistencyDigestsResponse.ProtoReflect.Descriptor instead.
+func (*GetConsistencyDigestsResponse) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetConsistencyDigestsResponse) GetDigests() map[string]*ConsistencyDigest {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+type GetConsistencyRecordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ConsistencyKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=spark_internal.ConsistencyKind" json:"kind,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsistencyRecordsRequest) Reset() {
+	*x = GetConsistencyRecordsRequest{}
+	mi := &file_spark_internal_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsistencyRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsistencyRecordsRequest) ProtoMessage() {}
+
+func (x *GetConsistencyRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsistencyRecordsRequest.ProtoReflect.Descriptor instead.
+func (*GetConsistencyRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetConsistencyRecordsRequest) GetKind() ConsistencyKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ConsistencyKind_CONSISTENCY_KIND_UNSPECIFIED
+}
+
+func (x *GetConsistencyRecordsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetConsistencyRecordsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by ID.
+	Records       []*ConsistencyRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsistencyRecordsResponse) Reset() {
+	*x = GetConsistencyRecordsResponse{}
+	mi := &file_spark_internal_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsistencyRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsistencyRecordsResponse) ProtoMessage() {}
+
+func (x *GetConsistencyRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsistencyRecordsResponse.ProtoReflect.Descriptor instead.
+func (*GetConsistencyRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetConsistencyRecordsResponse) GetRecords() []*ConsistencyRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type RevokeSessionInternalRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IdentityPublicKey []byte                 `protobuf:"bytes,1,opt,name=identity_public_key,json=identityPublicKey,proto3" json:"identity_public_key,omitempty"`
+	// The revoked session, or empty to revoke every session and delegation grant of the identity
+	// issued before the revoke time.
+	SessionId  []byte                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RevokeTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=revoke_time,json=revokeTime,proto3" json:"revoke_time,omitempty"`
+	// When every session the revocation revokes has expired anyway.
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevokeSessionInternalRequest) Reset() {
+	*x = RevokeSessionInternalRequest{}
+	mi := &file_spark_internal_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionInternalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionInternalRequest) ProtoMessage() {}
+
+func (x *RevokeSessionInternalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionInternalRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionInternalRequest) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RevokeSessionInternalRequest) GetIdentityPublicKey() []byte {
+	if x != nil {
+		return x.IdentityPublicKey
+	}
+	return nil
+}
+
+func (x *RevokeSessionInternalRequest) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *RevokeSessionInternalRequest) GetRevokeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokeTime
+	}
+	return nil
+}
+
+func (x *RevokeSessionInternalRequest) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+var File_spark_internal_proto protoreflect.FileDescriptor
+
+const file_spark_internal_proto_rawDesc = "" +
 	"\n" +
 	"\x14spark_internal.proto\x12\x0espark_internal\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\fcommon.proto\x1a\vspark.proto\"=\n" +
 	"\x1aMarkKeysharesAsUsedRequest\x12\x1f\n" +
 	"\vkeyshare_id\x18\x01 \x03(\tR\n" +
-	"keyshareId\"\x85\x02\n" +
+	"keyshareId\"\xd7\x02\n" +
 	"$MarkKeyshareForDepositAddressRequest\x12\x1f\n" +
 	"\vkeyshare_id\x18\x01 \x01(\tR\n" +
 	"keyshareId\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x129\n" +
 	"\x19owner_identity_public_key\x18\x03 \x01(\fR\x16ownerIdentityPublicKey\x127\n" +
 	"\x18owner_signing_public_key\x18\x04 \x01(\fR\x15ownerSigningPublicKey\x12 \n" +
-	"\tis_static\x18\x05 \x01(\bH\x00R\bisStatic\x88\x01\x01B\f\n" +
+	"\tis_static\x18\x05 \x01(\bH\x00R\bisStatic\x88\x01\x01\x12@\n" +
+	"\vexpiry_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"expiryTime\x88\x01\x01B\f\n" +
 	"\n" +
-	"_is_static\"T\n" +
+	"_is_staticB\x0e\n" +
+	"\f_expiry_time\"T\n" +
 	"%MarkKeyshareForDepositAddressResponse\x12+\n" +
-	"\x11address_signature\x18\x01 \x01(\fR\x10addressSignature\"7\n" +
+	"\x11address_signature\x18\x01 \x01(\fR\x10addressSignature\"W\n" +
+	"\x1eRecycleDepositAddressesRequest\x12\x1c\n" +
+	"\taddresses\x18\x01 \x03(\tR\taddresses\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"P\n" +
+	"\x1fRecycleDepositAddressesResponse\x12-\n" +
+	"\x12recycled_addresses\x18\x01 \x03(\tR\x11recycledAddresses\"7\n" +
 	"\x12FrostRound1Request\x12!\n" +
 	"\fkeyshare_ids\x18\x01 \x03(\tR\vkeyshareIds\"a\n" +
 	"\x13FrostRound1Response\x12J\n" +
@@ -1843,9 +2422,9 @@ const file_spark_internal_proto_rawDesc = "" +
 	" \x01(\tR\x11signingKeyshareId\x12\x12\n" +
 	"\x04vout\x18\v \x01(\rR\x04vout\x12'\n" +
 	"\x0frefund_timelock\x18\f \x01(\rR\x0erefundTimelockB\x11\n" +
-	"\x0f_parent_node_id\"E\n" +
-	"\x1cInitiatePreimageSwapResponse\x12%\n" +
-	"\x0epreimage_share\x18\x01 \x01(\fR\rpreimageShare\"\xb4\x01\n" +
+	"\x0f_parent_node_id\"K\n" +
+	"\x1cInitiatePreimageSwapResponse\x12+\n" +
+	"\x0epreimage_share\x18\x01 \x01(\fB\x04\x80\xb5\x18\x01R\rpreimageShare\"\xb4\x01\n" +
 	"\x16PrepareTreeAddressNode\x12.\n" +
 	"\x13signing_keyshare_id\x18\x01 \x01(\tR\x11signingKeyshareId\x12&\n" +
 	"\x0fuser_public_key\x18\x02 \x01(\fR\ruserPublicKey\x12B\n" +
@@ -1882,10 +2461,10 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\x1eInitiateCooperativeExitRequest\x12C\n" +
 	"\btransfer\x18\x01 \x01(\v2'.spark_internal.InitiateTransferRequestR\btransfer\x12\x17\n" +
 	"\aexit_id\x18\x02 \x01(\tR\x06exitId\x12\x1b\n" +
-	"\texit_txid\x18\x03 \x01(\fR\bexitTxid\"\x9a\x01\n" +
+	"\texit_txid\x18\x03 \x01(\fR\bexitTxid\"\xa0\x01\n" +
 	"\x1cUpdatePreimageRequestRequest\x12.\n" +
-	"\x13preimage_request_id\x18\x01 \x01(\tR\x11preimageRequestId\x12\x1a\n" +
-	"\bpreimage\x18\x02 \x01(\fR\bpreimage\x12.\n" +
+	"\x13preimage_request_id\x18\x01 \x01(\tR\x11preimageRequestId\x12 \n" +
+	"\bpreimage\x18\x02 \x01(\fB\x04\x80\xb5\x18\x01R\bpreimage\x12.\n" +
 	"\x13identity_public_key\x18\x03 \x01(\fR\x11identityPublicKey\"\xb5\x02\n" +
 	"$StartTokenTransactionInternalRequest\x12O\n" +
 	"\x17final_token_transaction\x18\x01 \x01(\v2\x17.spark.TokenTransactionR\x15finalTokenTransaction\x12c\n" +
@@ -1912,12 +2491,47 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\x06action\x18\x02 \x01(\x0e2$.spark_internal.SettleKeyTweakActionR\x06action\"u\n" +
 	"\x16CreateUtxoSwapResponse\x12.\n" +
 	"\x12UtxoDepositAddress\x18\x01 \x01(\tR\x12UtxoDepositAddress\x12+\n" +
-	"\btransfer\x18\x02 \x01(\v2\x0f.spark.TransferR\btransfer*:\n" +
+	"\btransfer\x18\x02 \x01(\v2\x0f.spark.TransferR\btransfer\"\xd3\x01\n" +
+	"\x11ConsistencyRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x129\n" +
+	"\x19owner_identity_public_key\x18\x03 \x01(\fR\x16ownerIdentityPublicKey\x127\n" +
+	"\x18owner_signing_public_key\x18\x04 \x01(\fR\x15ownerSigningPublicKey\x12\"\n" +
+	"\rraw_refund_tx\x18\x05 \x01(\fR\vrawRefundTx\"\x94\x01\n" +
+	"\x11ConsistencyDigest\x12\x16\n" +
+	"\x06digest\x18\x01 \x01(\fR\x06digest\x12!\n" +
+	"\frecord_count\x18\x02 \x01(\rR\vrecordCount\x12D\n" +
+	"\x10last_update_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastUpdateTime\"g\n" +
+	"\x1cGetConsistencyDigestsRequest\x123\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1f.spark_internal.ConsistencyKindR\x04kind\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\"\xd4\x01\n" +
+	"\x1dGetConsistencyDigestsResponse\x12T\n" +
+	"\adigests\x18\x01 \x03(\v2:.spark_internal.GetConsistencyDigestsResponse.DigestsEntryR\adigests\x1a]\n" +
+	"\fDigestsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x127\n" +
+	"\x05value\x18\x02 \x01(\v2!.spark_internal.ConsistencyDigestR\x05value:\x028\x01\"e\n" +
+	"\x1cGetConsistencyRecordsRequest\x123\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1f.spark_internal.ConsistencyKindR\x04kind\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\\\n" +
+	"\x1dGetConsistencyRecordsResponse\x12;\n" +
+	"\arecords\x18\x01 \x03(\v2!.spark_internal.ConsistencyRecordR\arecords\"\xef\x01\n" +
+	"\x1cRevokeSessionInternalRequest\x12.\n" +
+	"\x13identity_public_key\x18\x01 \x01(\fR\x11identityPublicKey\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\fR\tsessionId\x12;\n" +
+	"\vrevoke_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"revokeTime\x12C\n" +
+	"\x0fexpiration_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0eexpirationTime*:\n" +
 	"\x14SettleKeyTweakAction\x12\b\n" +
 	"\x04NONE\x10\x00\x12\n" +
 	"\n" +
 	"\x06COMMIT\x10\x01\x12\f\n" +
-	"\bROLLBACK\x10\x022\xc0\x13\n" +
+	"\bROLLBACK\x10\x02*\x90\x01\n" +
+	"\x0fConsistencyKind\x12 \n" +
+	"\x1cCONSISTENCY_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15CONSISTENCY_KIND_TREE\x10\x01\x12\x1d\n" +
+	"\x19CONSISTENCY_KIND_TRANSFER\x10\x02\x12!\n" +
+	"\x1dCONSISTENCY_KIND_TOKEN_OUTPUT\x10\x032\x97\x17\n" +
 	"\x14SparkInternalService\x12^\n" +
 	"\x16mark_keyshares_as_used\x12*.spark_internal.MarkKeysharesAsUsedRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\x92\x01\n" +
 	"!mark_keyshare_for_deposit_address\x124.spark_internal.MarkKeyshareForDepositAddressRequest\x1a5.spark_internal.MarkKeyshareForDepositAddressResponse\"\x00\x12_\n" +
@@ -1943,7 +2557,11 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\"initiate_settle_receiver_key_tweak\x125.spark_internal.InitiateSettleReceiverKeyTweakRequest\x1a\x16.google.protobuf.Empty\"\x00\x12d\n" +
 	"\x19settle_receiver_key_tweak\x12-.spark_internal.SettleReceiverKeyTweakRequest\x1a\x16.google.protobuf.Empty\"\x00\x12`\n" +
 	"\x17settle_sender_key_tweak\x12+.spark_internal.SettleSenderKeyTweakRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\\\n" +
-	"\x10create_utxo_swap\x12\x1e.spark.InitiateUtxoSwapRequest\x1a&.spark_internal.CreateUtxoSwapResponse\"\x00B5Z3github.com/lightsparkdev/spark/proto/spark_internalb\x06proto3"
+	"\x10create_utxo_swap\x12\x1e.spark.InitiateUtxoSwapRequest\x1a&.spark_internal.CreateUtxoSwapResponse\"\x00\x12x\n" +
+	"\x17get_consistency_digests\x12,.spark_internal.GetConsistencyDigestsRequest\x1a-.spark_internal.GetConsistencyDigestsResponse\"\x00\x12x\n" +
+	"\x17get_consistency_records\x12,.spark_internal.GetConsistencyRecordsRequest\x1a-.spark_internal.GetConsistencyRecordsResponse\"\x00\x12a\n" +
+	"\x17revoke_session_internal\x12,.spark_internal.RevokeSessionInternalRequest\x1a\x16.google.protobuf.Empty\"\x00\x12~\n" +
+	"\x19recycle_deposit_addresses\x12..spark_internal.RecycleDepositAddressesRequest\x1a/.spark_internal.RecycleDepositAddressesResponse\"\x00B5Z3github.com/lightsparkdev/spark/proto/spark_internalb\x06proto3"
 
 var (
 	file_spark_internal_proto_rawDescOnce sync.Once
@@ -1957,152 +2575,180 @@ func file_spark_internal_proto_rawDescGZIP() []byte {
 	return file_spark_internal_proto_rawDescData
 }
 
-var file_spark_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_spark_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_spark_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_spark_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_spark_internal_proto_goTypes = []any{
 	(SettleKeyTweakAction)(0),                     // 0: spark_internal.SettleKeyTweakAction
-	(*MarkKeysharesAsUsedRequest)(nil),            // 1: spark_internal.MarkKeysharesAsUsedRequest
-	(*MarkKeyshareForDepositAddressRequest)(nil),  // 2: spark_internal.MarkKeyshareForDepositAddressRequest
-	(*MarkKeyshareForDepositAddressResponse)(nil), // 3: spark_internal.MarkKeyshareForDepositAddressResponse
-	(*FrostRound1Request)(nil),                    // 4: spark_internal.FrostRound1Request
-	(*FrostRound1Response)(nil),                   // 5: spark_internal.FrostRound1Response
-	(*SigningJob)(nil),                            // 6: spark_internal.SigningJob
-	(*FrostRound2Request)(nil),                    // 7: spark_internal.FrostRound2Request
-	(*FrostRound2Response)(nil),                   // 8: spark_internal.FrostRound2Response
-	(*PrepareSplitKeysharesRequest)(nil),          // 9: spark_internal.PrepareSplitKeysharesRequest
-	(*FinalizeTreeCreationRequest)(nil),           // 10: spark_internal.FinalizeTreeCreationRequest
-	(*FinalizeNodesAggregationRequest)(nil),       // 11: spark_internal.FinalizeNodesAggregationRequest
-	(*FinalizeTransferRequest)(nil),               // 12: spark_internal.FinalizeTransferRequest
-	(*FinalizeRefreshTimelockRequest)(nil),        // 13: spark_internal.FinalizeRefreshTimelockRequest
-	(*FinalizeExtendLeafRequest)(nil),             // 14: spark_internal.FinalizeExtendLeafRequest
-	(*TreeNode)(nil),                              // 15: spark_internal.TreeNode
-	(*InitiatePreimageSwapResponse)(nil),          // 16: spark_internal.InitiatePreimageSwapResponse
-	(*PrepareTreeAddressNode)(nil),                // 17: spark_internal.PrepareTreeAddressNode
-	(*PrepareTreeAddressRequest)(nil),             // 18: spark_internal.PrepareTreeAddressRequest
-	(*PrepareTreeAddressResponse)(nil),            // 19: spark_internal.PrepareTreeAddressResponse
-	(*InitiateTransferLeaf)(nil),                  // 20: spark_internal.InitiateTransferLeaf
-	(*InitiateTransferRequest)(nil),               // 21: spark_internal.InitiateTransferRequest
-	(*InitiateCooperativeExitRequest)(nil),        // 22: spark_internal.InitiateCooperativeExitRequest
-	(*UpdatePreimageRequestRequest)(nil),          // 23: spark_internal.UpdatePreimageRequestRequest
-	(*StartTokenTransactionInternalRequest)(nil),  // 24: spark_internal.StartTokenTransactionInternalRequest
-	(*StartTokenTransactionInternalResponse)(nil), // 25: spark_internal.StartTokenTransactionInternalResponse
-	(*InitiateSettleReceiverKeyTweakRequest)(nil), // 26: spark_internal.InitiateSettleReceiverKeyTweakRequest
-	(*SettleReceiverKeyTweakRequest)(nil),         // 27: spark_internal.SettleReceiverKeyTweakRequest
-	(*SettleSenderKeyTweakRequest)(nil),           // 28: spark_internal.SettleSenderKeyTweakRequest
-	(*CreateUtxoSwapResponse)(nil),                // 29: spark_internal.CreateUtxoSwapResponse
-	nil,                                           // 30: spark_internal.SigningJob.CommitmentsEntry
-	nil,                                           // 31: spark_internal.FrostRound2Response.ResultsEntry
-	nil,                                           // 32: spark_internal.PrepareTreeAddressResponse.SignaturesEntry
-	nil,                                           // 33: spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry
-	nil,                                           // 34: spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry
-	(*common.SigningCommitment)(nil),              // 35: common.SigningCommitment
-	(spark.Network)(0),                            // 36: spark.Network
-	(*timestamppb.Timestamp)(nil),                 // 37: google.protobuf.Timestamp
-	(spark.TransferType)(0),                       // 38: spark.TransferType
-	(*spark.TransferPackage)(nil),                 // 39: spark.TransferPackage
-	(*spark.TokenTransaction)(nil),                // 40: spark.TokenTransaction
-	(*spark.TokenTransactionSignatures)(nil),      // 41: spark.TokenTransactionSignatures
-	(*spark.Transfer)(nil),                        // 42: spark.Transfer
-	(*common.SigningResult)(nil),                  // 43: common.SigningResult
-	(*spark.SecretProof)(nil),                     // 44: spark.SecretProof
-	(*spark.AggregateNodesRequest)(nil),           // 45: spark.AggregateNodesRequest
-	(*spark.InitiatePreimageSwapRequest)(nil),     // 46: spark.InitiatePreimageSwapRequest
-	(*spark.ProvidePreimageRequest)(nil),          // 47: spark.ProvidePreimageRequest
-	(*spark.ReturnLightningPaymentRequest)(nil),   // 48: spark.ReturnLightningPaymentRequest
-	(*spark.QueryTokenOutputsRequest)(nil),        // 49: spark.QueryTokenOutputsRequest
-	(*spark.CancelTransferRequest)(nil),           // 50: spark.CancelTransferRequest
-	(*spark.InitiateUtxoSwapRequest)(nil),         // 51: spark.InitiateUtxoSwapRequest
-	(*emptypb.Empty)(nil),                         // 52: google.protobuf.Empty
-	(*spark.QueryTokenOutputsResponse)(nil),       // 53: spark.QueryTokenOutputsResponse
+	(ConsistencyKind)(0),                          // 1: spark_internal.ConsistencyKind
+	(*MarkKeysharesAsUsedRequest)(nil),            // 2: spark_internal.MarkKeysharesAsUsedRequest
+	(*MarkKeyshareForDepositAddressRequest)(nil),  // 3: spark_internal.MarkKeyshareForDepositAddressRequest
+	(*MarkKeyshareForDepositAddressResponse)(nil), // 4: spark_internal.MarkKeyshareForDepositAddressResponse
+	(*RecycleDepositAddressesRequest)(nil),        // 5: spark_internal.RecycleDepositAddressesRequest
+	(*RecycleDepositAddressesResponse)(nil),       // 6: spark_internal.RecycleDepositAddressesResponse
+	(*FrostRound1Request)(nil),                    // 7: spark_internal.FrostRound1Request
+	(*FrostRound1Response)(nil),                   // 8: spark_internal.FrostRound1Response
+	(*SigningJob)(nil),                            // 9: spark_internal.SigningJob
+	(*FrostRound2Request)(nil),                    // 10: spark_internal.FrostRound2Request
+	(*FrostRound2Response)(nil),                   // 11: spark_internal.FrostRound2Response
+	(*PrepareSplitKeysharesRequest)(nil),          // 12: spark_internal.PrepareSplitKeysharesRequest
+	(*FinalizeTreeCreationRequest)(nil),           // 13: spark_internal.FinalizeTreeCreationRequest
+	(*FinalizeNodesAggregationRequest)(nil),       // 14: spark_internal.FinalizeNodesAggregationRequest
+	(*FinalizeTransferRequest)(nil),               // 15: spark_internal.FinalizeTransferRequest
+	(*FinalizeRefreshTimelockRequest)(nil),        // 16: spark_internal.FinalizeRefreshTimelockRequest
+	(*FinalizeExtendLeafRequest)(nil),             // 17: spark_internal.FinalizeExtendLeafRequest
+	(*TreeNode)(nil),                              // 18: spark_internal.TreeNode
+	(*InitiatePreimageSwapResponse)(nil),          // 19: spark_internal.InitiatePreimageSwapResponse
+	(*PrepareTreeAddressNode)(nil),                // 20: spark_internal.PrepareTreeAddressNode
+	(*PrepareTreeAddressRequest)(nil),             // 21: spark_internal.PrepareTreeAddressRequest
+	(*PrepareTreeAddressResponse)(nil),            // 22: spark_internal.PrepareTreeAddressResponse
+	(*InitiateTransferLeaf)(nil),                  // 23: spark_internal.InitiateTransferLeaf
+	(*InitiateTransferRequest)(nil),               // 24: spark_internal.InitiateTransferRequest
+	(*InitiateCooperativeExitRequest)(nil),        // 25: spark_internal.InitiateCooperativeExitRequest
+	(*UpdatePreimageRequestRequest)(nil),          // 26: spark_internal.UpdatePreimageRequestRequest
+	(*StartTokenTransactionInternalRequest)(nil),  // 27: spark_internal.StartTokenTransactionInternalRequest
+	(*StartTokenTransactionInternalResponse)(nil), // 28: spark_internal.StartTokenTransactionInternalResponse
+	(*InitiateSettleReceiverKeyTweakRequest)(nil), // 29: spark_internal.InitiateSettleReceiverKeyTweakRequest
+	(*SettleReceiverKeyTweakRequest)(nil),         // 30: spark_internal.SettleReceiverKeyTweakRequest
+	(*SettleSenderKeyTweakRequest)(nil),           // 31: spark_internal.SettleSenderKeyTweakRequest
+	(*CreateUtxoSwapResponse)(nil),                // 32: spark_internal.CreateUtxoSwapResponse
+	(*ConsistencyRecord)(nil),                     // 33: spark_internal.ConsistencyRecord
+	(*ConsistencyDigest)(nil),                     // 34: spark_internal.ConsistencyDigest
+	(*GetConsistencyDigestsRequest)(nil),          // 35: spark_internal.GetConsistencyDigestsRequest
+	(*GetConsistencyDigestsResponse)(nil),         // 36: spark_internal.GetConsistencyDigestsResponse
+	(*GetConsistencyRecordsRequest)(nil),          // 37: spark_internal.GetConsistencyRecordsRequest
+	(*GetConsistencyRecordsResponse)(nil),         // 38: spark_internal.GetConsistencyRecordsResponse
+	(*RevokeSessionInternalRequest)(nil),          // 39: spark_internal.RevokeSessionInternalRequest
+	nil,                                           // 40: spark_internal.SigningJob.CommitmentsEntry
+	nil,                                           // 41: spark_internal.FrostRound2Response.ResultsEntry
+	nil,                                           // 42: spark_internal.PrepareTreeAddressResponse.SignaturesEntry
+	nil,                                           // 43: spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry
+	nil,                                           // 44: spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry
+	nil,                                           // 45: spark_internal.GetConsistencyDigestsResponse.DigestsEntry
+	(*timestamppb.Timestamp)(nil),                 // 46: google.protobuf.Timestamp
+	(*common.SigningCommitment)(nil),              // 47: common.SigningCommitment
+	(spark.Network)(0),                            // 48: spark.Network
+	(spark.TransferType)(0),                       // 49: spark.TransferType
+	(*spark.TransferPackage)(nil),                 // 50: spark.TransferPackage
+	(*spark.TokenTransaction)(nil),                // 51: spark.TokenTransaction
+	(*spark.TokenTransactionSignatures)(nil),      // 52: spark.TokenTransactionSignatures
+	(*spark.Transfer)(nil),                        // 53: spark.Transfer
+	(*common.SigningResult)(nil),                  // 54: common.SigningResult
+	(*spark.SecretProof)(nil),                     // 55: spark.SecretProof
+	(*spark.AggregateNodesRequest)(nil),           // 56: spark.AggregateNodesRequest
+	(*spark.InitiatePreimageSwapRequest)(nil),     // 57: spark.InitiatePreimageSwapRequest
+	(*spark.ProvidePreimageRequest)(nil),          // 58: spark.ProvidePreimageRequest
+	(*spark.ReturnLightningPaymentRequest)(nil),   // 59: spark.ReturnLightningPaymentRequest
+	(*spark.QueryTokenOutputsRequest)(nil),        // 60: spark.QueryTokenOutputsRequest
+	(*spark.CancelTransferRequest)(nil),           // 61: spark.CancelTransferRequest
+	(*spark.InitiateUtxoSwapRequest)(nil),         // 62: spark.InitiateUtxoSwapRequest
+	(*emptypb.Empty)(nil),                         // 63: google.protobuf.Empty
+	(*spark.QueryTokenOutputsResponse)(nil),       // 64: spark.QueryTokenOutputsResponse
 }
 var file_spark_internal_proto_depIdxs = []int32{
-	35, // 0: spark_internal.FrostRound1Response.signing_commitments:type_name -> common.SigningCommitment
-	30, // 1: spark_internal.SigningJob.commitments:type_name -> spark_internal.SigningJob.CommitmentsEntry
-	35, // 2: spark_internal.SigningJob.user_commitments:type_name -> common.SigningCommitment
-	6,  // 3: spark_internal.FrostRound2Request.signing_jobs:type_name -> spark_internal.SigningJob
-	31, // 4: spark_internal.FrostRound2Response.results:type_name -> spark_internal.FrostRound2Response.ResultsEntry
-	15, // 5: spark_internal.FinalizeTreeCreationRequest.nodes:type_name -> spark_internal.TreeNode
-	36, // 6: spark_internal.FinalizeTreeCreationRequest.network:type_name -> spark.Network
-	15, // 7: spark_internal.FinalizeNodesAggregationRequest.nodes:type_name -> spark_internal.TreeNode
-	15, // 8: spark_internal.FinalizeTransferRequest.nodes:type_name -> spark_internal.TreeNode
-	37, // 9: spark_internal.FinalizeTransferRequest.timestamp:type_name -> google.protobuf.Timestamp
-	15, // 10: spark_internal.FinalizeRefreshTimelockRequest.nodes:type_name -> spark_internal.TreeNode
-	15, // 11: spark_internal.FinalizeExtendLeafRequest.node:type_name -> spark_internal.TreeNode
-	17, // 12: spark_internal.PrepareTreeAddressNode.children:type_name -> spark_internal.PrepareTreeAddressNode
-	17, // 13: spark_internal.PrepareTreeAddressRequest.node:type_name -> spark_internal.PrepareTreeAddressNode
-	36, // 14: spark_internal.PrepareTreeAddressRequest.network:type_name -> spark.Network
-	32, // 15: spark_internal.PrepareTreeAddressResponse.signatures:type_name -> spark_internal.PrepareTreeAddressResponse.SignaturesEntry
-	37, // 16: spark_internal.InitiateTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
-	20, // 17: spark_internal.InitiateTransferRequest.leaves:type_name -> spark_internal.InitiateTransferLeaf
-	33, // 18: spark_internal.InitiateTransferRequest.sender_key_tweak_proofs:type_name -> spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry
-	38, // 19: spark_internal.InitiateTransferRequest.type:type_name -> spark.TransferType
-	39, // 20: spark_internal.InitiateTransferRequest.transfer_package:type_name -> spark.TransferPackage
-	21, // 21: spark_internal.InitiateCooperativeExitRequest.transfer:type_name -> spark_internal.InitiateTransferRequest
-	40, // 22: spark_internal.StartTokenTransactionInternalRequest.final_token_transaction:type_name -> spark.TokenTransaction
-	41, // 23: spark_internal.StartTokenTransactionInternalRequest.token_transaction_signatures:type_name -> spark.TokenTransactionSignatures
-	40, // 24: spark_internal.StartTokenTransactionInternalResponse.final_token_transaction:type_name -> spark.TokenTransaction
-	34, // 25: spark_internal.InitiateSettleReceiverKeyTweakRequest.key_tweak_proofs:type_name -> spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry
-	0,  // 26: spark_internal.SettleSenderKeyTweakRequest.action:type_name -> spark_internal.SettleKeyTweakAction
-	42, // 27: spark_internal.CreateUtxoSwapResponse.transfer:type_name -> spark.Transfer
-	35, // 28: spark_internal.SigningJob.CommitmentsEntry.value:type_name -> common.SigningCommitment
-	43, // 29: spark_internal.FrostRound2Response.ResultsEntry.value:type_name -> common.SigningResult
-	44, // 30: spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry.value:type_name -> spark.SecretProof
-	44, // 31: spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry.value:type_name -> spark.SecretProof
-	1,  // 32: spark_internal.SparkInternalService.mark_keyshares_as_used:input_type -> spark_internal.MarkKeysharesAsUsedRequest
-	2,  // 33: spark_internal.SparkInternalService.mark_keyshare_for_deposit_address:input_type -> spark_internal.MarkKeyshareForDepositAddressRequest
-	10, // 34: spark_internal.SparkInternalService.finalize_tree_creation:input_type -> spark_internal.FinalizeTreeCreationRequest
-	4,  // 35: spark_internal.SparkInternalService.frost_round1:input_type -> spark_internal.FrostRound1Request
-	7,  // 36: spark_internal.SparkInternalService.frost_round2:input_type -> spark_internal.FrostRound2Request
-	9,  // 37: spark_internal.SparkInternalService.prepare_split_keyshares:input_type -> spark_internal.PrepareSplitKeysharesRequest
-	45, // 38: spark_internal.SparkInternalService.aggregate_nodes:input_type -> spark.AggregateNodesRequest
-	11, // 39: spark_internal.SparkInternalService.finalize_nodes_aggregation:input_type -> spark_internal.FinalizeNodesAggregationRequest
-	12, // 40: spark_internal.SparkInternalService.finalize_transfer:input_type -> spark_internal.FinalizeTransferRequest
-	13, // 41: spark_internal.SparkInternalService.finalize_refresh_timelock:input_type -> spark_internal.FinalizeRefreshTimelockRequest
-	14, // 42: spark_internal.SparkInternalService.finalize_extend_leaf:input_type -> spark_internal.FinalizeExtendLeafRequest
-	46, // 43: spark_internal.SparkInternalService.initiate_preimage_swap:input_type -> spark.InitiatePreimageSwapRequest
-	47, // 44: spark_internal.SparkInternalService.provide_preimage:input_type -> spark.ProvidePreimageRequest
-	23, // 45: spark_internal.SparkInternalService.update_preimage_request:input_type -> spark_internal.UpdatePreimageRequestRequest
-	18, // 46: spark_internal.SparkInternalService.prepare_tree_address:input_type -> spark_internal.PrepareTreeAddressRequest
-	21, // 47: spark_internal.SparkInternalService.initiate_transfer:input_type -> spark_internal.InitiateTransferRequest
-	22, // 48: spark_internal.SparkInternalService.initiate_cooperative_exit:input_type -> spark_internal.InitiateCooperativeExitRequest
-	48, // 49: spark_internal.SparkInternalService.return_lightning_payment:input_type -> spark.ReturnLightningPaymentRequest
-	24, // 50: spark_internal.SparkInternalService.start_token_transaction_internal:input_type -> spark_internal.StartTokenTransactionInternalRequest
-	49, // 51: spark_internal.SparkInternalService.query_token_outputs_internal:input_type -> spark.QueryTokenOutputsRequest
-	50, // 52: spark_internal.SparkInternalService.cancel_transfer:input_type -> spark.CancelTransferRequest
-	26, // 53: spark_internal.SparkInternalService.initiate_settle_receiver_key_tweak:input_type -> spark_internal.InitiateSettleReceiverKeyTweakRequest
-	27, // 54: spark_internal.SparkInternalService.settle_receiver_key_tweak:input_type -> spark_internal.SettleReceiverKeyTweakRequest
-	28, // 55: spark_internal.SparkInternalService.settle_sender_key_tweak:input_type -> spark_internal.SettleSenderKeyTweakRequest
-	51, // 56: spark_internal.SparkInternalService.create_utxo_swap:input_type -> spark.InitiateUtxoSwapRequest
-	52, // 57: spark_internal.SparkInternalService.mark_keyshares_as_used:output_type -> google.protobuf.Empty
-	3,  // 58: spark_internal.SparkInternalService.mark_keyshare_for_deposit_address:output_type -> spark_internal.MarkKeyshareForDepositAddressResponse
-	52, // 59: spark_internal.SparkInternalService.finalize_tree_creation:output_type -> google.protobuf.Empty
-	5,  // 60: spark_internal.SparkInternalService.frost_round1:output_type -> spark_internal.FrostRound1Response
-	8,  // 61: spark_internal.SparkInternalService.frost_round2:output_type -> spark_internal.FrostRound2Response
-	52, // 62: spark_internal.SparkInternalService.prepare_split_keyshares:output_type -> google.protobuf.Empty
-	52, // 63: spark_internal.SparkInternalService.aggregate_nodes:output_type -> google.protobuf.Empty
-	52, // 64: spark_internal.SparkInternalService.finalize_nodes_aggregation:output_type -> google.protobuf.Empty
-	52, // 65: spark_internal.SparkInternalService.finalize_transfer:output_type -> google.protobuf.Empty
-	52, // 66: spark_internal.SparkInternalService.finalize_refresh_timelock:output_type -> google.protobuf.Empty
-	52, // 67: spark_internal.SparkInternalService.finalize_extend_leaf:output_type -> google.protobuf.Empty
-	16, // 68: spark_internal.SparkInternalService.initiate_preimage_swap:output_type -> spark_internal.InitiatePreimageSwapResponse
-	52, // 69: spark_internal.SparkInternalService.provide_preimage:output_type -> google.protobuf.Empty
-	52, // 70: spark_internal.SparkInternalService.update_preimage_request:output_type -> google.protobuf.Empty
-	19, // 71: spark_internal.SparkInternalService.prepare_tree_address:output_type -> spark_internal.PrepareTreeAddressResponse
-	52, // 72: spark_internal.SparkInternalService.initiate_transfer:output_type -> google.protobuf.Empty
-	52, // 73: spark_internal.SparkInternalService.initiate_cooperative_exit:output_type -> google.protobuf.Empty
-	52, // 74: spark_internal.SparkInternalService.return_lightning_payment:output_type -> google.protobuf.Empty
-	52, // 75: spark_internal.SparkInternalService.start_token_transaction_internal:output_type -> google.protobuf.Empty
-	53, // 76: spark_internal.SparkInternalService.query_token_outputs_internal:output_type -> spark.QueryTokenOutputsResponse
-	52, // 77: spark_internal.SparkInternalService.cancel_transfer:output_type -> google.protobuf.Empty
-	52, // 78: spark_internal.SparkInternalService.initiate_settle_receiver_key_tweak:output_type -> google.protobuf.Empty
-	52, // 79: spark_internal.SparkInternalService.settle_receiver_key_tweak:output_type -> google.protobuf.Empty
-	52, // 80: spark_internal.SparkInternalService.settle_sender_key_tweak:output_type -> google.protobuf.Empty
-	29, // 81: spark_internal.SparkInternalService.create_utxo_swap:output_type -> spark_internal.CreateUtxoSwapResponse
-	57, // [57:82] is the sub-list for method output_type
-	32, // [32:57] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	46, // 0: spark_internal.MarkKeyshareForDepositAddressRequest.expiry_time:type_name -> google.protobuf.Timestamp
+	47, // 1: spark_internal.FrostRound1Response.signing_commitments:type_name -> common.SigningCommitment
+	40, // 2: spark_internal.SigningJob.commitments:type_name -> spark_internal.SigningJob.CommitmentsEntry
+	47, // 3: spark_internal.SigningJob.user_commitments:type_name -> common.SigningCommitment
+	9,  // 4: spark_internal.FrostRound2Request.signing_jobs:type_name -> spark_internal.SigningJob
+	41, // 5: spark_internal.FrostRound2Response.results:type_name -> spark_internal.FrostRound2Response.ResultsEntry
+	18, // 6: spark_internal.FinalizeTreeCreationRequest.nodes:type_name -> spark_internal.TreeNode
+	48, // 7: spark_internal.FinalizeTreeCreationRequest.network:type_name -> spark.Network
+	18, // 8: spark_internal.FinalizeNodesAggregationRequest.nodes:type_name -> spark_internal.TreeNode
+	18, // 9: spark_internal.FinalizeTransferRequest.nodes:type_name -> spark_internal.TreeNode
+	46, // 10: spark_internal.FinalizeTransferRequest.timestamp:type_name -> google.protobuf.Timestamp
+	18, // 11: spark_internal.FinalizeRefreshTimelockRequest.nodes:type_name -> spark_internal.TreeNode
+	18, // 12: spark_internal.FinalizeExtendLeafRequest.node:type_name -> spark_internal.TreeNode
+	20, // 13: spark_internal.PrepareTreeAddressNode.children:type_name -> spark_internal.PrepareTreeAddressNode
+	20, // 14: spark_internal.PrepareTreeAddressRequest.node:type_name -> spark_internal.PrepareTreeAddressNode
+	48, // 15: spark_internal.PrepareTreeAddressRequest.network:type_name -> spark.Network
+	42, // 16: spark_internal.PrepareTreeAddressResponse.signatures:type_name -> spark_internal.PrepareTreeAddressResponse.SignaturesEntry
+	46, // 17: spark_internal.InitiateTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
+	23, // 18: spark_internal.InitiateTransferRequest.leaves:type_name -> spark_internal.InitiateTransferLeaf
+	43, // 19: spark_internal.InitiateTransferRequest.sender_key_tweak_proofs:type_name -> spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry
+	49, // 20: spark_internal.InitiateTransferRequest.type:type_name -> spark.TransferType
+	50, // 21: spark_internal.InitiateTransferRequest.transfer_package:type_name -> spark.TransferPackage
+	24, // 22: spark_internal.InitiateCooperativeExitRequest.transfer:type_name -> spark_internal.InitiateTransferRequest
+	51, // 23: spark_internal.StartTokenTransactionInternalRequest.final_token_transaction:type_name -> spark.TokenTransaction
+	52, // 24: spark_internal.StartTokenTransactionInternalRequest.token_transaction_signatures:type_name -> spark.TokenTransactionSignatures
+	51, // 25: spark_internal.StartTokenTransactionInternalResponse.final_token_transaction:type_name -> spark.TokenTransaction
+	44, // 26: spark_internal.InitiateSettleReceiverKeyTweakRequest.key_tweak_proofs:type_name -> spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry
+	0,  // 27: spark_internal.SettleSenderKeyTweakRequest.action:type_name -> spark_internal.SettleKeyTweakAction
+	53, // 28: spark_internal.CreateUtxoSwapResponse.transfer:type_name -> spark.Transfer
+	46, // 29: spark_internal.ConsistencyDigest.last_update_time:type_name -> google.protobuf.Timestamp
+	1,  // 30: spark_internal.GetConsistencyDigestsRequest.kind:type_name -> spark_internal.ConsistencyKind
+	45, // 31: spark_internal.GetConsistencyDigestsResponse.digests:type_name -> spark_internal.GetConsistencyDigestsResponse.DigestsEntry
+	1,  // 32: spark_internal.GetConsistencyRecordsRequest.kind:type_name -> spark_internal.ConsistencyKind
+	33, // 33: spark_internal.GetConsistencyRecordsResponse.records:type_name -> spark_internal.ConsistencyRecord
+	46, // 34: spark_internal.RevokeSessionInternalRequest.revoke_time:type_name -> google.protobuf.Timestamp
+	46, // 35: spark_internal.RevokeSessionInternalRequest.expiration_time:type_name -> google.protobuf.Timestamp
+	47, // 36: spark_internal.SigningJob.CommitmentsEntry.value:type_name -> common.SigningCommitment
+	54, // 37: spark_internal.FrostRound2Response.ResultsEntry.value:type_name -> common.SigningResult
+	55, // 38: spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry.value:type_name -> spark.SecretProof
+	55, // 39: spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry.value:type_name -> spark.SecretProof
+	34, // 40: spark_internal.GetConsistencyDigestsResponse.DigestsEntry.value:type_name -> spark_internal.ConsistencyDigest
+	2,  // 41: spark_internal.SparkInternalService.mark_keyshares_as_used:input_type -> spark_internal.MarkKeysharesAsUsedRequest
+	3,  // 42: spark_internal.SparkInternalService.mark_keyshare_for_deposit_address:input_type -> spark_internal.MarkKeyshareForDepositAddressRequest
+	13, // 43: spark_internal.SparkInternalService.finalize_tree_creation:input_type -> spark_internal.FinalizeTreeCreationRequest
+	7,  // 44: spark_internal.SparkInternalService.frost_round1:input_type -> spark_internal.FrostRound1Request
+	10, // 45: spark_internal.SparkInternalService.frost_round2:input_type -> spark_internal.FrostRound2Request
+	12, // 46: spark_internal.SparkInternalService.prepare_split_keyshares:input_type -> spark_internal.PrepareSplitKeysharesRequest
+	56, // 47: spark_internal.SparkInternalService.aggregate_nodes:input_type -> spark.AggregateNodesRequest
+	14, // 48: spark_internal.SparkInternalService.finalize_nodes_aggregation:input_type -> spark_internal.FinalizeNodesAggregationRequest
+	15, // 49: spark_internal.SparkInternalService.finalize_transfer:input_type -> spark_internal.FinalizeTransferRequest
+	16, // 50: spark_internal.SparkInternalService.finalize_refresh_timelock:input_type -> spark_internal.FinalizeRefreshTimelockRequest
+	17, // 51: spark_internal.SparkInternalService.finalize_extend_leaf:input_type -> spark_internal.FinalizeExtendLeafRequest
+	57, // 52: spark_internal.SparkInternalService.initiate_preimage_swap:input_type -> spark.InitiatePreimageSwapRequest
+	58, // 53: spark_internal.SparkInternalService.provide_preimage:input_type -> spark.ProvidePreimageRequest
+	26, // 54: spark_internal.SparkInternalService.update_preimage_request:input_type -> spark_internal.UpdatePreimageRequestRequest
+	21, // 55: spark_internal.SparkInternalService.prepare_tree_address:input_type -> spark_internal.PrepareTreeAddressRequest
+	24, // 56: spark_internal.SparkInternalService.initiate_transfer:input_type -> spark_internal.InitiateTransferRequest
+	25, // 57: spark_internal.SparkInternalService.initiate_cooperative_exit:input_type -> spark_internal.InitiateCooperativeExitRequest
+	59, // 58: spark_internal.SparkInternalService.return_lightning_payment:input_type -> spark.ReturnLightningPaymentRequest
+	27, // 59: spark_internal.SparkInternalService.start_token_transaction_internal:input_type -> spark_internal.StartTokenTransactionInternalRequest
+	60, // 60: spark_internal.SparkInternalService.query_token_outputs_internal:input_type -> spark.QueryTokenOutputsRequest
+	61, // 61: spark_internal.SparkInternalService.cancel_transfer:input_type -> spark.CancelTransferRequest
+	29, // 62: spark_internal.SparkInternalService.initiate_settle_receiver_key_tweak:input_type -> spark_internal.InitiateSettleReceiverKeyTweakRequest
+	30, // 63: spark_internal.SparkInternalService.settle_receiver_key_tweak:input_type -> spark_internal.SettleReceiverKeyTweakRequest
+	31, // 64: spark_internal.SparkInternalService.settle_sender_key_tweak:input_type -> spark_internal.SettleSenderKeyTweakRequest
+	62, // 65: spark_internal.SparkInternalService.create_utxo_swap:input_type -> spark.InitiateUtxoSwapRequest
+	35, // 66: spark_internal.SparkInternalService.get_consistency_digests:input_type -> spark_internal.GetConsistencyDigestsRequest
+	37, // 67: spark_internal.SparkInternalService.get_consistency_records:input_type -> spark_internal.GetConsistencyRecordsRequest
+	39, // 68: spark_internal.SparkInternalService.revoke_session_internal:input_type -> spark_internal.RevokeSessionInternalRequest
+	5,  // 69: spark_internal.SparkInternalService.recycle_deposit_addresses:input_type -> spark_internal.RecycleDepositAddressesRequest
+	63, // 70: spark_internal.SparkInternalService.mark_keyshares_as_used:output_type -> google.protobuf.Empty
+	4,  // 71: spark_internal.SparkInternalService.mark_keyshare_for_deposit_address:output_type -> spark_internal.MarkKeyshareForDepositAddressResponse
+	63, // 72: spark_internal.SparkInternalService.finalize_tree_creation:output_type -> google.protobuf.Empty
+	8,  // 73: spark_internal.SparkInternalService.frost_round1:output_type -> spark_internal.FrostRound1Response
+	11, // 74: spark_internal.SparkInternalService.frost_round2:output_type -> spark_internal.FrostRound2Response
+	63, // 75: spark_internal.SparkInternalService.prepare_split_keyshares:output_type -> google.protobuf.Empty
+	63, // 76: spark_internal.SparkInternalService.aggregate_nodes:output_type -> google.protobuf.Empty
+	63, // 77: spark_internal.SparkInternalService.finalize_nodes_aggregation:output_type -> google.protobuf.Empty
+	63, // 78: spark_internal.SparkInternalService.finalize_transfer:output_type -> google.protobuf.Empty
+	63, // 79: spark_internal.SparkInternalService.finalize_refresh_timelock:output_type -> google.protobuf.Empty
+	63, // 80: spark_internal.SparkInternalService.finalize_extend_leaf:output_type -> google.protobuf.Empty
+	19, // 81: spark_internal.SparkInternalService.initiate_preimage_swap:output_type -> spark_internal.InitiatePreimageSwapResponse
+	63, // 82: spark_internal.SparkInternalService.provide_preimage:output_type -> google.protobuf.Empty
+	63, // 83: spark_internal.SparkInternalService.update_preimage_request:output_type -> google.protobuf.Empty
+	22, // 84: spark_internal.SparkInternalService.prepare_tree_address:output_type -> spark_internal.PrepareTreeAddressResponse
+	63, // 85: spark_internal.SparkInternalService.initiate_transfer:output_type -> google.protobuf.Empty
+	63, // 86: spark_internal.SparkInternalService.initiate_cooperative_exit:output_type -> google.protobuf.Empty
+	63, // 87: spark_internal.SparkInternalService.return_lightning_payment:output_type -> google.protobuf.Empty
+	63, // 88: spark_internal.SparkInternalService.start_token_transaction_internal:output_type -> google.protobuf.Empty
+	64, // 89: spark_internal.SparkInternalService.query_token_outputs_internal:output_type -> spark.QueryTokenOutputsResponse
+	63, // 90: spark_internal.SparkInternalService.cancel_transfer:output_type -> google.protobuf.Empty
+	63, // 91: spark_internal.SparkInternalService.initiate_settle_receiver_key_tweak:output_type -> google.protobuf.Empty
+	63, // 92: spark_internal.SparkInternalService.settle_receiver_key_tweak:output_type -> google.protobuf.Empty
+	63, // 93: spark_internal.SparkInternalService.settle_sender_key_tweak:output_type -> google.protobuf.Empty
+	32, // 94: spark_internal.SparkInternalService.create_utxo_swap:output_type -> spark_internal.CreateUtxoSwapResponse
+	36, // 95: spark_internal.SparkInternalService.get_consistency_digests:output_type -> spark_internal.GetConsistencyDigestsResponse
+	38, // 96: spark_internal.SparkInternalService.get_consistency_records:output_type -> spark_internal.GetConsistencyRecordsResponse
+	63, // 97: spark_internal.SparkInternalService.revoke_session_internal:output_type -> google.protobuf.Empty
+	6,  // 98: spark_internal.SparkInternalService.recycle_deposit_addresses:output_type -> spark_internal.RecycleDepositAddressesResponse
+	70, // [70:99] is the sub-list for method output_type
+	41, // [41:70] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_spark_internal_proto_init() }
@@ -2111,14 +2757,14 @@ func file_spark_internal_proto_init() {
 		return
 	}
 	file_spark_internal_proto_msgTypes[1].OneofWrappers = []any{}
-	file_spark_internal_proto_msgTypes[14].OneofWrappers = []any{}
+	file_spark_internal_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spark_internal_proto_rawDesc), len(file_spark_internal_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   34,
+			NumEnums:      2,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -176,6 +176,39 @@ func (m *MarkKeyshareForDepositAddressRequest) validate(all bool) error {
 		// no validation rules for IsStatic
 	}
 
+	if m.ExpiryTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiryTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MarkKeyshareForDepositAddressRequestValidationError{
+						field:  "ExpiryTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MarkKeyshareForDepositAddressRequestValidationError{
+						field:  "ExpiryTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MarkKeyshareForDepositAddressRequestValidationError{
+					field:  "ExpiryTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MarkKeyshareForDepositAddressRequestMultiError(errors)
 	}
@@ -365,6 +398,214 @@ var _ interface {
 	ErrorName() string
 } = MarkKeyshareForDepositAddressResponseValidationError{}
 
+// Validate checks the field values on RecycleDepositAddressesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecycleDepositAddressesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecycleDepositAddressesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecycleDepositAddressesRequestMultiError, or nil if none found.
+func (m *RecycleDepositAddressesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecycleDepositAddressesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return RecycleDepositAddressesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecycleDepositAddressesRequestMultiError is an error wrapping multiple
+// validation errors returned by RecycleDepositAddressesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RecycleDepositAddressesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecycleDepositAddressesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecycleDepositAddressesRequestMultiError) AllErrors() []error { return m }
+
+// RecycleDepositAddressesRequestValidationError is the validation error
+// returned by RecycleDepositAddressesRequest.Validate if the designated
+// constraints aren't met.
+type RecycleDepositAddressesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecycleDepositAddressesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecycleDepositAddressesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecycleDepositAddressesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecycleDepositAddressesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecycleDepositAddressesRequestValidationError) ErrorName() string {
+	return "RecycleDepositAddressesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecycleDepositAddressesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecycleDepositAddressesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecycleDepositAddressesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecycleDepositAddressesRequestValidationError{}
+
+// Validate checks the field values on RecycleDepositAddressesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecycleDepositAddressesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecycleDepositAddressesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecycleDepositAddressesResponseMultiError, or nil if none found.
+func (m *RecycleDepositAddressesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecycleDepositAddressesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RecycleDepositAddressesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecycleDepositAddressesResponseMultiError is an error wrapping multiple
+// validation errors returned by RecycleDepositAddressesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RecycleDepositAddressesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecycleDepositAddressesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecycleDepositAddressesResponseMultiError) AllErrors() []error { return m }
+
+// RecycleDepositAddressesResponseValidationError is the validation error
+// returned by RecycleDepositAddressesResponse.Validate if the designated
+// constraints aren't met.
+type RecycleDepositAddressesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecycleDepositAddressesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecycleDepositAddressesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecycleDepositAddressesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecycleDepositAddressesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecycleDepositAddressesResponseValidationError) ErrorName() string {
+	return "RecycleDepositAddressesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecycleDepositAddressesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecycleDepositAddressesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecycleDepositAddressesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecycleDepositAddressesResponseValidationError{}
+
 // Validate checks the field values on FrostRound1Request with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -3899,3 +4140,913 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CreateUtxoSwapResponseValidationError{}
+
+// Validate checks the field values on ConsistencyRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencyRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencyRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsistencyRecordMultiError, or nil if none found.
+func (m *ConsistencyRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencyRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for OwnerIdentityPublicKey
+
+	// no validation rules for OwnerSigningPublicKey
+
+	// no validation rules for RawRefundTx
+
+	if len(errors) > 0 {
+		return ConsistencyRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyRecordMultiError is an error wrapping multiple validation errors
+// returned by ConsistencyRecord.ValidateAll() if the designated constraints
+// aren't met.
+type ConsistencyRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyRecordMultiError) AllErrors() []error { return m }
+
+// ConsistencyRecordValidationError is the validation error returned by
+// ConsistencyRecord.Validate if the designated constraints aren't met.
+type ConsistencyRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyRecordValidationError) ErrorName() string {
+	return "ConsistencyRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsistencyRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencyRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyRecordValidationError{}
+
+// Validate checks the field values on ConsistencyDigest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencyDigest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencyDigest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsistencyDigestMultiError, or nil if none found.
+func (m *ConsistencyDigest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencyDigest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Digest
+
+	// no validation rules for RecordCount
+
+	if all {
+		switch v := interface{}(m.GetLastUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConsistencyDigestValidationError{
+					field:  "LastUpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConsistencyDigestValidationError{
+					field:  "LastUpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConsistencyDigestValidationError{
+				field:  "LastUpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConsistencyDigestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyDigestMultiError is an error wrapping multiple validation errors
+// returned by ConsistencyDigest.ValidateAll() if the designated constraints
+// aren't met.
+type ConsistencyDigestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyDigestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyDigestMultiError) AllErrors() []error { return m }
+
+// ConsistencyDigestValidationError is the validation error returned by
+// ConsistencyDigest.Validate if the designated constraints aren't met.
+type ConsistencyDigestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyDigestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyDigestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyDigestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyDigestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyDigestValidationError) ErrorName() string {
+	return "ConsistencyDigestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsistencyDigestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencyDigest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyDigestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyDigestValidationError{}
+
+// Validate checks the field values on GetConsistencyDigestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConsistencyDigestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConsistencyDigestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConsistencyDigestsRequestMultiError, or nil if none found.
+func (m *GetConsistencyDigestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConsistencyDigestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return GetConsistencyDigestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConsistencyDigestsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetConsistencyDigestsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetConsistencyDigestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConsistencyDigestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConsistencyDigestsRequestMultiError) AllErrors() []error { return m }
+
+// GetConsistencyDigestsRequestValidationError is the validation error returned
+// by GetConsistencyDigestsRequest.Validate if the designated constraints
+// aren't met.
+type GetConsistencyDigestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConsistencyDigestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConsistencyDigestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConsistencyDigestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConsistencyDigestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConsistencyDigestsRequestValidationError) ErrorName() string {
+	return "GetConsistencyDigestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConsistencyDigestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConsistencyDigestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConsistencyDigestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConsistencyDigestsRequestValidationError{}
+
+// Validate checks the field values on GetConsistencyDigestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConsistencyDigestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConsistencyDigestsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetConsistencyDigestsResponseMultiError, or nil if none found.
+func (m *GetConsistencyDigestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConsistencyDigestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetDigests()))
+		i := 0
+		for key := range m.GetDigests() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetDigests()[key]
+			_ = val
+
+			// no validation rules for Digests[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConsistencyDigestsResponseValidationError{
+							field:  fmt.Sprintf("Digests[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConsistencyDigestsResponseValidationError{
+							field:  fmt.Sprintf("Digests[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConsistencyDigestsResponseValidationError{
+						field:  fmt.Sprintf("Digests[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetConsistencyDigestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConsistencyDigestsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetConsistencyDigestsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetConsistencyDigestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConsistencyDigestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConsistencyDigestsResponseMultiError) AllErrors() []error { return m }
+
+// GetConsistencyDigestsResponseValidationError is the validation error
+// returned by GetConsistencyDigestsResponse.Validate if the designated
+// constraints aren't met.
+type GetConsistencyDigestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConsistencyDigestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConsistencyDigestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConsistencyDigestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConsistencyDigestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConsistencyDigestsResponseValidationError) ErrorName() string {
+	return "GetConsistencyDigestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConsistencyDigestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConsistencyDigestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConsistencyDigestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConsistencyDigestsResponseValidationError{}
+
+// Validate checks the field values on GetConsistencyRecordsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConsistencyRecordsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConsistencyRecordsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConsistencyRecordsRequestMultiError, or nil if none found.
+func (m *GetConsistencyRecordsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConsistencyRecordsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return GetConsistencyRecordsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConsistencyRecordsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetConsistencyRecordsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetConsistencyRecordsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConsistencyRecordsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConsistencyRecordsRequestMultiError) AllErrors() []error { return m }
+
+// GetConsistencyRecordsRequestValidationError is the validation error returned
+// by GetConsistencyRecordsRequest.Validate if the designated constraints
+// aren't met.
+type GetConsistencyRecordsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConsistencyRecordsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConsistencyRecordsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConsistencyRecordsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConsistencyRecordsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConsistencyRecordsRequestValidationError) ErrorName() string {
+	return "GetConsistencyRecordsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConsistencyRecordsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConsistencyRecordsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConsistencyRecordsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConsistencyRecordsRequestValidationError{}
+
+// Validate checks the field values on GetConsistencyRecordsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConsistencyRecordsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConsistencyRecordsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetConsistencyRecordsResponseMultiError, or nil if none found.
+func (m *GetConsistencyRecordsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConsistencyRecordsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConsistencyRecordsResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConsistencyRecordsResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConsistencyRecordsResponseValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetConsistencyRecordsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConsistencyRecordsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetConsistencyRecordsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetConsistencyRecordsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConsistencyRecordsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConsistencyRecordsResponseMultiError) AllErrors() []error { return m }
+
+// GetConsistencyRecordsResponseValidationError is the validation error
+// returned by GetConsistencyRecordsResponse.Validate if the designated
+// constraints aren't met.
+type GetConsistencyRecordsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConsistencyRecordsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConsistencyRecordsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConsistencyRecordsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConsistencyRecordsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConsistencyRecordsResponseValidationError) ErrorName() string {
+	return "GetConsistencyRecordsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConsistencyRecordsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConsistencyRecordsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConsistencyRecordsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConsistencyRecordsResponseValidationError{}
+
+// Validate checks the field values on RevokeSessionInternalRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionInternalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionInternalRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionInternalRequestMultiError, or nil if none found.
+func (m *RevokeSessionInternalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionInternalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IdentityPublicKey
+
+	// no validation rules for SessionId
+
+	if all {
+		switch v := interface{}(m.GetRevokeTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevokeSessionInternalRequestValidationError{
+					field:  "RevokeTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevokeSessionInternalRequestValidationError{
+					field:  "RevokeTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokeTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevokeSessionInternalRequestValidationError{
+				field:  "RevokeTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpirationTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevokeSessionInternalRequestValidationError{
+					field:  "ExpirationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevokeSessionInternalRequestValidationError{
+					field:  "ExpirationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpirationTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevokeSessionInternalRequestValidationError{
+				field:  "ExpirationTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevokeSessionInternalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionInternalRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeSessionInternalRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeSessionInternalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionInternalRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionInternalRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSessionInternalRequestValidationError is the validation error returned
+// by RevokeSessionInternalRequest.Validate if the designated constraints
+// aren't met.
+type RevokeSessionInternalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionInternalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionInternalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionInternalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionInternalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionInternalRequestValidationError) ErrorName() string {
+	return "RevokeSessionInternalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionInternalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionInternalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionInternalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionInternalRequestValidationError{}
@@ -46,6 +46,10 @@ const (
 	SparkInternalService_SettleReceiverKeyTweak_FullMethodName         = "/spark_internal.SparkInternalService/settle_receiver_key_tweak"
 	SparkInternalService_SettleSenderKeyTweak_FullMethodName           = "/spark_internal.SparkInternalService/settle_sender_key_tweak"
 	SparkInternalService_CreateUtxoSwap_FullMethodName                 = "/spark_internal.SparkInternalService/create_utxo_swap"
+	SparkInternalService_GetConsistencyDigests_FullMethodName          = "/spark_internal.SparkInternalService/get_consistency_digests"
+	SparkInternalService_GetConsistencyRecords_FullMethodName          = "/spark_internal.SparkInternalService/get_consistency_records"
+	SparkInternalService_RevokeSessionInternal_FullMethodName          = "/spark_internal.SparkInternalService/revoke_session_internal"
+	SparkInternalService_RecycleDepositAddresses_FullMethodName        = "/spark_internal.SparkInternalService/recycle_deposit_addresses"
 )
 
 // SparkInternalServiceClient is the client API for SparkInternalService service.
@@ -78,6 +82,17 @@ type SparkInternalServiceClient interface {
 	SettleSenderKeyTweak(ctx context.Context, in *SettleSenderKeyTweakRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Create UTXO swap record to claim UTXO by SSP in the static deposit flow
 	CreateUtxoSwap(ctx context.Context, in *spark.InitiateUtxoSwapRequest, opts ...grpc.CallOption) (*CreateUtxoSwapResponse, error)
+	// Compute a digest of the state held for each of the given keys, so that the consistency audit
+	// can compare the state of operators without transferring it.
+	GetConsistencyDigests(ctx context.Context, in *GetConsistencyDigestsRequest, opts ...grpc.CallOption) (*GetConsistencyDigestsResponse, error)
+	// Get the state held for a key, to repair an operator that disagrees with the majority.
+	GetConsistencyRecords(ctx context.Context, in *GetConsistencyRecordsRequest, opts ...grpc.CallOption) (*GetConsistencyRecordsResponse, error)
+	// Record the revocation of a session made at the coordinator, so that every operator rejects it.
+	RevokeSessionInternal(ctx context.Context, in *RevokeSessionInternalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Recycle the keyshares of deposit addresses that expired without receiving funds, once every
+	// operator has scanned the chain for a grace period past their expiry, so that they can be
+	// used for new addresses.
+	RecycleDepositAddresses(ctx context.Context, in *RecycleDepositAddressesRequest, opts ...grpc.CallOption) (*RecycleDepositAddressesResponse, error)
 }
 
 type sparkInternalServiceClient struct {
@@ -338,6 +353,46 @@ func (c *sparkInternalServiceClient) CreateUtxoSwap(ctx context.Context, in *spa
 	return out, nil
 }
 
+func (c *sparkInternalServiceClient) GetConsistencyDigests(ctx context.Context, in *GetConsistencyDigestsRequest, opts ...grpc.CallOption) (*GetConsistencyDigestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConsistencyDigestsResponse)
+	err := c.cc.Invoke(ctx, SparkInternalService_GetConsistencyDigests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkInternalServiceClient) GetConsistencyRecords(ctx context.Context, in *GetConsistencyRecordsRequest, opts ...grpc.CallOption) (*GetConsistencyRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConsistencyRecordsResponse)
+	err := c.cc.Invoke(ctx, SparkInternalService_GetConsistencyRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkInternalServiceClient) RevokeSessionInternal(ctx context.Context, in *RevokeSessionInternalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SparkInternalService_RevokeSessionInternal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkInternalServiceClient) RecycleDepositAddresses(ctx context.Context, in *RecycleDepositAddressesRequest, opts ...grpc.CallOption) (*RecycleDepositAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecycleDepositAddressesResponse)
+	err := c.cc.Invoke(ctx, SparkInternalService_RecycleDepositAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SparkInternalServiceServer is the server API for SparkInternalService service.
 // All implementations must embed UnimplementedSparkInternalServiceServer
 // for forward compatibility.
@@ -368,6 +423,17 @@ type SparkInternalServiceServer interface {
 	SettleSenderKeyTweak(context.Context, *SettleSenderKeyTweakRequest) (*emptypb.Empty, error)
 	// Create UTXO swap record to claim UTXO by SSP in the static deposit flow
 	CreateUtxoSwap(context.Context, *spark.InitiateUtxoSwapRequest) (*CreateUtxoSwapResponse, error)
+	// Compute a digest of the state held for each of the given keys, so that the consistency audit
+	// can compare the state of operators without transferring it.
+	GetConsistencyDigests(context.Context, *GetConsistencyDigestsRequest) (*GetConsistencyDigestsResponse, error)
+	// Get the state held for a key, to repair an operator that disagrees with the majority.
+	GetConsistencyRecords(context.Context, *GetConsistencyRecordsRequest) (*GetConsistencyRecordsResponse, error)
+	// Record the revocation of a session made at the coordinator, so that every operator rejects it.
+	RevokeSessionInternal(context.Context, *RevokeSessionInternalRequest) (*emptypb.Empty, error)
+	// Recycle the keyshares of deposit addresses that expired without receiving funds, once every
+	// operator has scanned the chain for a grace period past their expiry, so that they can be
+	// used for new addresses.
+	RecycleDepositAddresses(context.Context, *RecycleDepositAddressesRequest) (*RecycleDepositAddressesResponse, error)
 	mustEmbedUnimplementedSparkInternalServiceServer()
 }
 
@@ -453,6 +519,18 @@ func (UnimplementedSparkInternalServiceServer) SettleSenderKeyTweak(context.Cont
 func (UnimplementedSparkInternalServiceServer) CreateUtxoSwap(context.Context, *spark.InitiateUtxoSwapRequest) (*CreateUtxoSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUtxoSwap not implemented")
 }
+func (UnimplementedSparkInternalServiceServer) GetConsistencyDigests(context.Context, *GetConsistencyDigestsRequest) (*GetConsistencyDigestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsistencyDigests not implemented")
+}
+func (UnimplementedSparkInternalServiceServer) GetConsistencyRecords(context.Context, *GetConsistencyRecordsRequest) (*GetConsistencyRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsistencyRecords not implemented")
+}
+func (UnimplementedSparkInternalServiceServer) RevokeSessionInternal(context.Context, *RevokeSessionInternalRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessionInternal not implemented")
+}
+func (UnimplementedSparkInternalServiceServer) RecycleDepositAddresses(context.Context, *RecycleDepositAddressesRequest) (*RecycleDepositAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecycleDepositAddresses not implemented")
+}
 func (UnimplementedSparkInternalServiceServer) mustEmbedUnimplementedSparkInternalServiceServer() {}
 func (UnimplementedSparkInternalServiceServer) testEmbeddedByValue()                              {}
 
@@ -924,6 +1002,78 @@ func _SparkInternalService_CreateUtxoSwap_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SparkInternalService_GetConsistencyDigests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsistencyDigestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkInternalServiceServer).GetConsistencyDigests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkInternalService_GetConsistencyDigests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkInternalServiceServer).GetConsistencyDigests(ctx, req.(*GetConsistencyDigestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkInternalService_GetConsistencyRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsistencyRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkInternalServiceServer).GetConsistencyRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkInternalService_GetConsistencyRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkInternalServiceServer).GetConsistencyRecords(ctx, req.(*GetConsistencyRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkInternalService_RevokeSessionInternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionInternalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkInternalServiceServer).RevokeSessionInternal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkInternalService_RevokeSessionInternal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkInternalServiceServer).RevokeSessionInternal(ctx, req.(*RevokeSessionInternalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkInternalService_RecycleDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecycleDepositAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkInternalServiceServer).RecycleDepositAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkInternalService_RecycleDepositAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkInternalServiceServer).RecycleDepositAddresses(ctx, req.(*RecycleDepositAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SparkInternalService_ServiceDesc is the grpc.ServiceDesc for SparkInternalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1031,6 +1181,22 @@ var SparkInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "create_utxo_swap",
 			Handler:    _SparkInternalService_CreateUtxoSwap_Handler,
 		},
+		{
+			MethodName: "get_consistency_digests",
+			Handler:    _SparkInternalService_GetConsistencyDigests_Handler,
+		},
+		{
+			MethodName: "get_consistency_records",
+			Handler:    _SparkInternalService_GetConsistencyRecords_Handler,
+		},
+		{
+			MethodName: "revoke_session_internal",
+			Handler:    _SparkInternalService_RevokeSessionInternal_Handler,
+		},
+		{
+			MethodName: "recycle_deposit_addresses",
+			Handler:    _SparkInternalService_RecycleDepositAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "spark_internal.proto",
@@ -0,0 +1,54 @@
+// Package archive encodes and decodes archives: gzip compressed JSON records, one per line, which
+// hold the state moved out of its tables by the retention tasks. Archives are stored in the
+// database, so that they are replicated and backed up with the rest of the state.
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+)
+
+// maxRecordSize is the size of the largest record that can be read back.
+const maxRecordSize = 16 << 20
+
+// Encode returns the archive of the records.
+func Encode[T any](records []T) ([]byte, error) {
+	var buffer bytes.Buffer
+	compressed := gzip.NewWriter(&buffer)
+	encoder := json.NewEncoder(compressed)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return nil, fmt.Errorf("failed to write archive record: %w", err)
+		}
+	}
+	if err := compressed.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// Scan calls fn with every record of the archive, in order, until fn returns false. The record is
+// only valid during the call.
+func Scan(data []byte, fn func(record json.RawMessage) (bool, error)) error {
+	compressed, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer compressed.Close()
+
+	scanner := bufio.NewScanner(compressed)
+	scanner.Buffer(nil, maxRecordSize)
+	for scanner.Scan() {
+		more, err := fn(scanner.Bytes())
+		if err != nil || !more {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package archive
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testRecord struct {
+	ID   int    `json:"id"`
+	Data []byte `json:"data"`
+}
+
+func TestEncodeAndScan(t *testing.T) {
+	records := []testRecord{{ID: 1, Data: []byte("one")}, {ID: 2}, {ID: 3, Data: []byte("three")}}
+	data, err := Encode(records)
+	require.NoError(t, err)
+
+	var read []testRecord
+	err = Scan(data, func(line json.RawMessage) (bool, error) {
+		var record testRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return false, err
+		}
+		read = append(read, record)
+		return record.ID < 2, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, records[:2], read)
+}
+
+func TestScanRejectsCorruptArchives(t *testing.T) {
+	err := Scan([]byte("not an archive"), func(json.RawMessage) (bool, error) { return true, nil })
+	require.ErrorContains(t, err, "failed to read archive")
+}
@@ -32,6 +32,9 @@ type Session struct {
 	identityPublicKey      *secp256k1.PublicKey
 	identityPublicKeyBytes []byte
 	expirationTimestamp    int64
+	sessionID              []byte
+	scopes                 []string
+	delegatePublicKey      []byte
 }
 
 // IdentityPublicKey returns the public key
@@ -49,9 +52,33 @@ func (s *Session) ExpirationTimestamp() int64 {
 	return s.expirationTimestamp
 }
 
+// SessionID returns the identifier of the login the session belongs to
+func (s *Session) SessionID() []byte {
+	return s.sessionID
+}
+
+// Scopes returns the scopes the session is limited to, or nil for a session with full access
+func (s *Session) Scopes() []string {
+	return s.scopes
+}
+
+// DelegatePublicKey returns the key that authenticated on behalf of the identity, or nil if the
+// session is not delegated
+func (s *Session) DelegatePublicKey() []byte {
+	return s.delegatePublicKey
+}
+
+// SessionRevocationChecker checks sessions against the server-side revocation list.
+type SessionRevocationChecker interface {
+	// IsSessionRevoked returns whether the session with the given ID of the identity, or every
+	// session of the identity issued at the given time, was revoked.
+	IsSessionRevoked(ctx context.Context, identityPublicKey []byte, sessionID []byte, issuedTimestampMillis int64) (bool, error)
+}
+
 // AuthnInterceptor is an interceptor that validates session tokens and adds session info to the context.
 type AuthnInterceptor struct { //nolint:revive
 	sessionTokenCreatorVerifier *authninternal.SessionTokenCreatorVerifier
+	revocations                 SessionRevocationChecker
 }
 
 // NewAuthnInterceptor creates a new AuthnInterceptor
@@ -61,6 +88,12 @@ func NewAuthnInterceptor(sessionTokenCreatorVerifier *authninternal.SessionToken
 	}
 }
 
+// WithRevocationChecker makes the interceptor reject sessions that were revoked.
+func (i *AuthnInterceptor) WithRevocationChecker(revocations SessionRevocationChecker) *AuthnInterceptor {
+	i.revocations = revocations
+	return i
+}
+
 type wrappedServerStream struct {
 	grpc.ServerStream
 	ctx context.Context
@@ -115,6 +148,20 @@ func (i *AuthnInterceptor) authenticateContext(ctx context.Context) context.Cont
 		})
 	}
 
+	if i.revocations != nil {
+		revoked, err := i.revocations.IsSessionRevoked(ctx, sessionInfo.PublicKey, sessionInfo.SessionId, sessionInfo.IssuedTimestampMillis)
+		if err == nil && revoked {
+			err = fmt.Errorf("session has been revoked")
+		}
+		if err != nil {
+			wrappedErr := fmt.Errorf("failed to verify token: %w", err)
+			logger.Info("Authentication error", "error", wrappedErr)
+			return context.WithValue(ctx, authnContextKey, &AuthnContext{
+				Error: wrappedErr,
+			})
+		}
+	}
+
 	key, err := secp256k1.ParsePubKey(sessionInfo.PublicKey)
 	if err != nil {
 		wrappedErr := fmt.Errorf("failed to parse public key: %w", err)
@@ -129,6 +176,9 @@ func (i *AuthnInterceptor) authenticateContext(ctx context.Context) context.Cont
 			identityPublicKey:      key,
 			identityPublicKeyBytes: sessionInfo.PublicKey,
 			expirationTimestamp:    sessionInfo.ExpirationTimestamp,
+			sessionID:              sessionInfo.SessionId,
+			scopes:                 sessionInfo.Scopes,
+			delegatePublicKey:      sessionInfo.DelegatePublicKey,
 		},
 	})
 }
@@ -36,12 +36,39 @@ var (
 
 	// ErrTokenExpired is returned when the token has expired.
 	ErrTokenExpired = fmt.Errorf("token has expired")
+
+	// ErrWrongTokenType is returned when a refresh token is used as an access token, or the other way around.
+	ErrWrongTokenType = fmt.Errorf("wrong session token type")
 )
 
 // TokenCreationResult contains the token and its associated metadata
 type TokenCreationResult struct {
 	Token               string
 	ExpirationTimestamp int64
+	// Session is the session the token carries
+	Session *pb.Session
+}
+
+// SessionOptions are the properties of a session beyond its identity and expiration.
+type SessionOptions struct {
+	// SessionID identifies the login; a random one is generated if empty
+	SessionID []byte
+	// IssuedTimestampMillis is when the login happened; now if zero
+	IssuedTimestampMillis int64
+	// Scopes limit the session; empty for full access
+	Scopes []string
+	// DelegatePublicKey is the key that authenticated on behalf of the identity, for delegated sessions
+	DelegatePublicKey []byte
+}
+
+// SessionOptionsOf returns the options of an existing session, to issue more tokens for it.
+func SessionOptionsOf(session *pb.Session) SessionOptions {
+	return SessionOptions{
+		SessionID:             session.SessionId,
+		IssuedTimestampMillis: session.IssuedTimestampMillis,
+		Scopes:                session.Scopes,
+		DelegatePublicKey:     session.DelegatePublicKey,
+	}
 }
 
 // SessionTokenCreatorVerifier handles creation and verification of session tokens
@@ -81,18 +108,50 @@ func (stcv *SessionTokenCreatorVerifier) computeSessionHmac(sessionBytes []byte)
 
 // CreateToken generates a new session token and returns both the token and its expiration time
 func (stcv *SessionTokenCreatorVerifier) CreateToken(publicKey []byte, duration time.Duration) (*TokenCreationResult, error) {
+	return stcv.CreateTokenWithOptions(publicKey, duration, SessionOptions{})
+}
+
+// CreateTokenWithOptions generates a new access token for a session with the given options.
+func (stcv *SessionTokenCreatorVerifier) CreateTokenWithOptions(publicKey []byte, duration time.Duration, options SessionOptions) (*TokenCreationResult, error) {
+	return stcv.createToken(publicKey, duration, pb.SessionTokenType_SESSION_TOKEN_TYPE_ACCESS, options)
+}
+
+// CreateRefreshToken generates a refresh token, which can only be exchanged for new access tokens
+// of the same session.
+func (stcv *SessionTokenCreatorVerifier) CreateRefreshToken(publicKey []byte, duration time.Duration, options SessionOptions) (*TokenCreationResult, error) {
+	return stcv.createToken(publicKey, duration, pb.SessionTokenType_SESSION_TOKEN_TYPE_REFRESH, options)
+}
+
+func (stcv *SessionTokenCreatorVerifier) createToken(publicKey []byte, duration time.Duration, tokenType pb.SessionTokenType, options SessionOptions) (*TokenCreationResult, error) {
 	nonce := make([]byte, 32)
 	if _, err := rand.Read(nonce); err != nil {
 		return nil, fmt.Errorf("failed to generate nonce: %v", err)
 	}
 
+	sessionID := options.SessionID
+	if len(sessionID) == 0 {
+		sessionID = make([]byte, 16)
+		if _, err := rand.Read(sessionID); err != nil {
+			return nil, fmt.Errorf("failed to generate session id: %v", err)
+		}
+	}
+	issuedTimestampMillis := options.IssuedTimestampMillis
+	if issuedTimestampMillis == 0 {
+		issuedTimestampMillis = stcv.clock.Now().UnixMilli()
+	}
+
 	expirationTimestamp := stcv.clock.Now().Add(duration).Unix()
 
 	session := &pb.Session{
-		Version:             currentSessionVersion,
-		ExpirationTimestamp: expirationTimestamp,
-		Nonce:               nonce,
-		PublicKey:           publicKey,
+		Version:               currentSessionVersion,
+		ExpirationTimestamp:   expirationTimestamp,
+		Nonce:                 nonce,
+		PublicKey:             publicKey,
+		SessionId:             sessionID,
+		TokenType:             tokenType,
+		IssuedTimestampMillis: issuedTimestampMillis,
+		Scopes:                options.Scopes,
+		DelegatePublicKey:     options.DelegatePublicKey,
 	}
 
 	sessionBytes, err := proto.Marshal(session)
@@ -118,11 +177,21 @@ func (stcv *SessionTokenCreatorVerifier) CreateToken(publicKey []byte, duration
 	return &TokenCreationResult{
 		Token:               token,
 		ExpirationTimestamp: expirationTimestamp,
+		Session:             session,
 	}, nil
 }
 
-// VerifyToken validates a session token and returns the session data
+// VerifyToken validates an access token and returns the session data
 func (stcv *SessionTokenCreatorVerifier) VerifyToken(token string) (*pb.Session, error) {
+	return stcv.verifyToken(token, pb.SessionTokenType_SESSION_TOKEN_TYPE_ACCESS)
+}
+
+// VerifyRefreshToken validates a refresh token and returns the session data
+func (stcv *SessionTokenCreatorVerifier) VerifyRefreshToken(token string) (*pb.Session, error) {
+	return stcv.verifyToken(token, pb.SessionTokenType_SESSION_TOKEN_TYPE_REFRESH)
+}
+
+func (stcv *SessionTokenCreatorVerifier) verifyToken(token string, tokenType pb.SessionTokenType) (*pb.Session, error) {
 	protectedBytes, err := base64.URLEncoding.DecodeString(token)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrInvalidTokenEncoding, err)
@@ -154,5 +223,9 @@ func (stcv *SessionTokenCreatorVerifier) VerifyToken(token string) (*pb.Session,
 		return nil, ErrTokenExpired
 	}
 
+	if protected.Session.TokenType != tokenType {
+		return nil, ErrWrongTokenType
+	}
+
 	return protected.Session, nil
 }
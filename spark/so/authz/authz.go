@@ -35,6 +35,8 @@ type ErrorCode int
 const (
 	ErrorCodeNoSession ErrorCode = iota
 	ErrorCodeIdentityMismatch
+	ErrorCodeScope
+	ErrorCodePolicy
 )
 
 // ToGRPCError converts the auth error to an appropriate gRPC error
@@ -43,7 +45,7 @@ func (e *Error) ToGRPCError() error {
 	switch e.Code {
 	case ErrorCodeNoSession:
 		code = codes.Unauthenticated
-	case ErrorCodeIdentityMismatch:
+	case ErrorCodeIdentityMismatch, ErrorCodeScope, ErrorCodePolicy:
 		code = codes.PermissionDenied
 	default:
 		code = codes.Internal
@@ -0,0 +1,317 @@
+package authz
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
+	"github.com/lightsparkdev/spark/so/authn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Role is a role a caller of an RPC can hold.
+type Role string
+
+const (
+	// RoleAny is held by every caller, with or without a session.
+	RoleAny Role = "any"
+	// RoleUser is held by every caller with a valid session.
+	RoleUser Role = "user"
+	// RoleSSP is held by callers whose session identity is a configured SSP identity.
+	RoleSSP Role = "ssp"
+	// RoleOperator is held by callers whose session identity is a signing operator identity, or
+	// whose internal calls are signed by a signing operator.
+	RoleOperator Role = "operator"
+	// RoleAdmin is held by callers with a configured admin client certificate, or whose session
+	// identity is this operator's or a configured admin identity.
+	RoleAdmin Role = "admin"
+)
+
+var roles = []Role{RoleAny, RoleUser, RoleSSP, RoleOperator, RoleAdmin}
+
+// SessionIdentity is the condition value matching the identity public key of the caller's session.
+const SessionIdentity = "$session_identity"
+
+const (
+	policyDefaultAllow = "allow"
+	policyDefaultDeny  = "deny"
+)
+
+// PolicyConfig is the configuration for the authorization policy.
+type PolicyConfig struct {
+	// Default is the decision for methods no rule applies to: "allow" (the default) or "deny"
+	Default string `yaml:"default"`
+	// SSPIdentityPublicKeys are the hex encoded identity public keys holding the ssp role
+	SSPIdentityPublicKeys []string `yaml:"ssp_identity_public_keys"`
+	// AdminIdentityPublicKeys are the hex encoded identity public keys holding the admin role, in
+	// addition to this operator's own
+	AdminIdentityPublicKeys []string `yaml:"admin_identity_public_keys"`
+	// AdminClientCertificates are the hex encoded SHA-256 digests of the subject public key info of
+	// the client certificates holding the admin role, which must be signed by the admin client CA
+	AdminClientCertificates []string `yaml:"admin_client_certificates"`
+	// AdminClientSubjects are the subjects, in RFC 2253 form, of the client certificates holding
+	// the admin role, which must be signed by the admin client CA
+	AdminClientSubjects []string `yaml:"admin_client_subjects"`
+	// Rules are the rules of the policy
+	Rules []RuleConfig `yaml:"rules"`
+}
+
+// RuleConfig is a rule of the authorization policy. A call to a method the rule applies to is
+// allowed if the caller holds one of the roles and the request meets every condition.
+type RuleConfig struct {
+	// Methods are the full method names the rule applies to. A trailing * matches every method
+	// starting with the rest, e.g. /spark.SparkService/query_*.
+	Methods []string `yaml:"methods"`
+	// Roles are the roles allowed to call the methods
+	Roles []Role `yaml:"roles"`
+	// Conditions are the conditions the request must meet
+	Conditions []ConditionConfig `yaml:"conditions"`
+}
+
+// ConditionConfig is a condition on a field of the request.
+type ConditionConfig struct {
+	// Field is the name of the request field, with dots separating the fields of nested messages
+	Field string `yaml:"field"`
+	// Equals is the value the field must have: $session_identity for the identity public key of
+	// the caller's session, or a literal, hex encoded for bytes fields and by name for enums
+	Equals string `yaml:"equals"`
+}
+
+// Policy is a compiled authorization policy. A nil Policy allows everything but the methods of
+// the admin service, which it only allows to admins.
+type Policy struct {
+	defaultAllow  bool
+	ssp           [][]byte
+	admins        [][]byte
+	adminSPKIs    [][]byte
+	adminSubjects []string
+	rules         []RuleConfig
+}
+
+// NewPolicy validates and compiles the authorization policy configuration.
+func NewPolicy(config PolicyConfig) (*Policy, error) {
+	policy := &Policy{rules: config.Rules, adminSubjects: config.AdminClientSubjects}
+	switch config.Default {
+	case "", policyDefaultAllow:
+		policy.defaultAllow = true
+	case policyDefaultDeny:
+	default:
+		return nil, fmt.Errorf("invalid authorization policy default %q, must be %q or %q", config.Default, policyDefaultAllow, policyDefaultDeny)
+	}
+
+	var err error
+	if policy.ssp, err = decodeIdentityPublicKeys(config.SSPIdentityPublicKeys); err != nil {
+		return nil, fmt.Errorf("invalid ssp identity public key: %w", err)
+	}
+	if policy.admins, err = decodeIdentityPublicKeys(config.AdminIdentityPublicKeys); err != nil {
+		return nil, fmt.Errorf("invalid admin identity public key: %w", err)
+	}
+	for _, digest := range config.AdminClientCertificates {
+		decoded, err := hex.DecodeString(digest)
+		if err != nil || len(decoded) != sha256.Size {
+			return nil, fmt.Errorf("invalid admin client certificate %q, must be a hex encoded SHA-256 digest", digest)
+		}
+		policy.adminSPKIs = append(policy.adminSPKIs, decoded)
+	}
+
+	for i, rule := range config.Rules {
+		if len(rule.Methods) == 0 {
+			return nil, fmt.Errorf("authorization rule %d has no methods", i)
+		}
+		for _, method := range rule.Methods {
+			if !strings.HasPrefix(method, "/") || strings.Contains(strings.TrimSuffix(method, "*"), "*") {
+				return nil, fmt.Errorf("authorization rule %d has invalid method %q", i, method)
+			}
+		}
+		if len(rule.Roles) == 0 {
+			return nil, fmt.Errorf("authorization rule %d has no roles", i)
+		}
+		for _, role := range rule.Roles {
+			if !slices.Contains(roles, role) {
+				return nil, fmt.Errorf("authorization rule %d has unknown role %q", i, role)
+			}
+		}
+		for _, condition := range rule.Conditions {
+			if condition.Field == "" {
+				return nil, fmt.Errorf("authorization rule %d has a condition without a field", i)
+			}
+		}
+	}
+	return policy, nil
+}
+
+func decodeIdentityPublicKeys(keys []string) ([][]byte, error) {
+	decoded := make([][]byte, len(keys))
+	for i, key := range keys {
+		var err error
+		if decoded[i], err = hex.DecodeString(key); err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return decoded, nil
+}
+
+// SessionRoles returns the roles the policy grants the caller with the session in the context.
+// Roles that depend on the operator, like operator and admin through a client certificate, are
+// added by the caller.
+func (p *Policy) SessionRoles(ctx context.Context) map[Role]bool {
+	held := map[Role]bool{RoleAny: true}
+	session, err := authn.GetSessionFromContext(ctx)
+	if err != nil {
+		return held
+	}
+	held[RoleUser] = true
+	if p == nil {
+		return held
+	}
+	identity := session.IdentityPublicKeyBytes()
+	if slices.ContainsFunc(p.ssp, func(key []byte) bool { return bytes.Equal(key, identity) }) {
+		held[RoleSSP] = true
+	}
+	if slices.ContainsFunc(p.admins, func(key []byte) bool { return bytes.Equal(key, identity) }) {
+		held[RoleAdmin] = true
+	}
+	return held
+}
+
+// IsAdminClientCertificate returns whether the verified client certificate is one of the admin
+// client certificates of the policy. A nil Policy has none.
+func (p *Policy) IsAdminClientCertificate(cert *x509.Certificate) bool {
+	if p == nil || cert == nil {
+		return false
+	}
+	digest := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return slices.ContainsFunc(p.adminSPKIs, func(spki []byte) bool { return bytes.Equal(spki, digest[:]) }) ||
+		slices.Contains(p.adminSubjects, cert.Subject.String())
+}
+
+// HasAdminClientCertificates returns whether any client certificate holds the admin role.
+func (p *Policy) HasAdminClientCertificates() bool {
+	return p != nil && (len(p.adminSPKIs) > 0 || len(p.adminSubjects) > 0)
+}
+
+// Authorize checks the call to fullMethod with the given request, nil for streams, by a caller
+// holding the given roles against the policy. The call is allowed if any rule applying to the
+// method allows it or, when no rule applies, if the policy allows by default. The methods of the
+// admin service are only allowed to admins by default, whatever the default of the policy.
+func (p *Policy) Authorize(ctx context.Context, fullMethod string, req any, held map[Role]bool) error {
+	applies := false
+	var reasons []string
+	var rules []RuleConfig
+	if p != nil {
+		rules = p.rules
+	}
+	for i, rule := range rules {
+		if !slices.ContainsFunc(rule.Methods, func(method string) bool { return methodMatches(method, fullMethod) }) {
+			continue
+		}
+		applies = true
+		if !slices.ContainsFunc(rule.Roles, func(role Role) bool { return held[role] }) {
+			reasons = append(reasons, fmt.Sprintf("rule %d requires one of the roles %v", i, rule.Roles))
+			continue
+		}
+		if err := checkConditions(ctx, rule.Conditions, req); err != nil {
+			reasons = append(reasons, fmt.Sprintf("rule %d: %v", i, err))
+			continue
+		}
+		return nil
+	}
+
+	if !applies {
+		switch {
+		case strings.HasPrefix(fullMethod, "/"+pbadmin.SparkAdminService_ServiceDesc.ServiceName+"/"):
+			if held[RoleAdmin] {
+				return nil
+			}
+			reasons = append(reasons, "no rule applies and the admin service is only open to admins")
+		case p == nil || p.defaultAllow:
+			return nil
+		default:
+			reasons = append(reasons, "no rule applies and the policy denies by default")
+		}
+	}
+	code := ErrorCodePolicy
+	if !held[RoleUser] {
+		code = ErrorCodeNoSession
+	}
+	return &Error{
+		Code:    code,
+		Message: fmt.Sprintf("%s denied by authorization policy: %s", fullMethod, strings.Join(reasons, "; ")),
+	}
+}
+
+func methodMatches(pattern string, fullMethod string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(fullMethod, prefix)
+	}
+	return pattern == fullMethod
+}
+
+func checkConditions(ctx context.Context, conditions []ConditionConfig, req any) error {
+	if len(conditions) == 0 {
+		return nil
+	}
+	message, ok := req.(proto.Message)
+	if !ok {
+		return fmt.Errorf("conditions cannot be checked without a request")
+	}
+	for _, condition := range conditions {
+		value, err := requestFieldValue(message.ProtoReflect(), condition.Field)
+		if err != nil {
+			return err
+		}
+		want := condition.Equals
+		if want == SessionIdentity {
+			session, err := authn.GetSessionFromContext(ctx)
+			if err != nil {
+				return fmt.Errorf("condition on %s requires a session", condition.Field)
+			}
+			want = hex.EncodeToString(session.IdentityPublicKeyBytes())
+		}
+		if value != want {
+			return fmt.Errorf("%s is not %s", condition.Field, condition.Equals)
+		}
+	}
+	return nil
+}
+
+// requestFieldValue returns the value of the field at the dotted path in the message, hex encoded
+// for bytes and by name for enums.
+func requestFieldValue(message protoreflect.Message, path string) (string, error) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		field := message.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if field == nil || field.IsList() || field.IsMap() {
+			return "", fmt.Errorf("%s has no singular field %s", message.Descriptor().FullName(), name)
+		}
+		value := message.Get(field)
+		if i < len(names)-1 {
+			if field.Message() == nil {
+				return "", fmt.Errorf("%s.%s is not a message", message.Descriptor().FullName(), name)
+			}
+			message = value.Message()
+			continue
+		}
+		switch field.Kind() {
+		case protoreflect.BytesKind:
+			return hex.EncodeToString(value.Bytes()), nil
+		case protoreflect.EnumKind:
+			if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+				return string(enumValue.Name()), nil
+			}
+			return fmt.Sprint(value.Enum()), nil
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			return "", fmt.Errorf("%s.%s is a message", message.Descriptor().FullName(), name)
+		default:
+			return value.String(), nil
+		}
+	}
+	return "", fmt.Errorf("empty field path")
+}
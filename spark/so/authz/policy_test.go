@@ -0,0 +1,202 @@
+package authz
+
+import (
+	"context"
+	stdecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
+	"github.com/lightsparkdev/spark/so/authn"
+	"github.com/lightsparkdev/spark/so/authninternal"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// sessionContext returns a context with a session for the given identity.
+func sessionContext(t *testing.T, identityPublicKey []byte) context.Context {
+	serverKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	verifier, err := authninternal.NewSessionTokenCreatorVerifier(serverKey.Serialize(), nil)
+	require.NoError(t, err)
+	token, err := verifier.CreateToken(identityPublicKey, time.Hour)
+	require.NoError(t, err)
+
+	var ctx context.Context
+	_, err = authn.NewAuthnInterceptor(verifier).AuthnInterceptor(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token.Token)),
+		nil,
+		&grpc.UnaryServerInfo{},
+		func(c context.Context, _ interface{}) (interface{}, error) {
+			ctx = c
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+	return ctx
+}
+
+func newTestIdentity(t *testing.T) []byte {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	return key.PubKey().SerializeCompressed()
+}
+
+func TestNewPolicyRejectsInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config PolicyConfig
+	}{
+		{name: "default", config: PolicyConfig{Default: "maybe"}},
+		{name: "ssp key", config: PolicyConfig{SSPIdentityPublicKeys: []string{"not hex"}}},
+		{name: "no methods", config: PolicyConfig{Rules: []RuleConfig{{Roles: []Role{RoleUser}}}}},
+		{name: "relative method", config: PolicyConfig{Rules: []RuleConfig{{Methods: []string{"query_nodes"}, Roles: []Role{RoleUser}}}}},
+		{name: "inner wildcard", config: PolicyConfig{Rules: []RuleConfig{{Methods: []string{"/spark.*/query_nodes"}, Roles: []Role{RoleUser}}}}},
+		{name: "no roles", config: PolicyConfig{Rules: []RuleConfig{{Methods: []string{"/spark.SparkService/*"}}}}},
+		{name: "unknown role", config: PolicyConfig{Rules: []RuleConfig{{Methods: []string{"/spark.SparkService/*"}, Roles: []Role{"root"}}}}},
+		{name: "condition without field", config: PolicyConfig{Rules: []RuleConfig{{Methods: []string{"/spark.SparkService/*"}, Roles: []Role{RoleUser}, Conditions: []ConditionConfig{{Equals: "x"}}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewPolicy(tt.config)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestPolicyAuthorize(t *testing.T) {
+	user, ssp := newTestIdentity(t), newTestIdentity(t)
+	policy, err := NewPolicy(PolicyConfig{
+		Default:               policyDefaultDeny,
+		SSPIdentityPublicKeys: []string{hex.EncodeToString(ssp)},
+		Rules: []RuleConfig{
+			{Methods: []string{pb.SparkService_GetSigningOperatorList_FullMethodName}, Roles: []Role{RoleAny}},
+			{Methods: []string{pb.SparkService_InitiatePreimageSwap_FullMethodName}, Roles: []Role{RoleSSP}},
+			{
+				Methods:    []string{"/spark.SparkService/query_*"},
+				Roles:      []Role{RoleUser},
+				Conditions: []ConditionConfig{{Field: "identity_public_key", Equals: SessionIdentity}},
+			},
+			{
+				Methods:    []string{pb.SparkService_GenerateDepositAddress_FullMethodName},
+				Roles:      []Role{RoleUser},
+				Conditions: []ConditionConfig{{Field: "network", Equals: pb.Network_REGTEST.String()}},
+			},
+			{Methods: []string{pb.SparkService_GenerateDepositAddress_FullMethodName}, Roles: []Role{RoleSSP}},
+		},
+	})
+	require.NoError(t, err)
+
+	anonymousCtx := context.Background()
+	userCtx := sessionContext(t, user)
+	sspCtx := sessionContext(t, ssp)
+	authorize := func(ctx context.Context, method string, req any) codes.Code {
+		err := policy.Authorize(ctx, method, req, policy.SessionRoles(ctx))
+		if err == nil {
+			return codes.OK
+		}
+		return status.Code(err.(*Error).ToGRPCError())
+	}
+
+	require.Equal(t, codes.OK, authorize(anonymousCtx, pb.SparkService_GetSigningOperatorList_FullMethodName, nil))
+
+	require.Equal(t, codes.OK, authorize(sspCtx, pb.SparkService_InitiatePreimageSwap_FullMethodName, &pb.InitiatePreimageSwapRequest{}))
+	require.Equal(t, codes.PermissionDenied, authorize(userCtx, pb.SparkService_InitiatePreimageSwap_FullMethodName, &pb.InitiatePreimageSwapRequest{}))
+	require.Equal(t, codes.Unauthenticated, authorize(anonymousCtx, pb.SparkService_InitiatePreimageSwap_FullMethodName, &pb.InitiatePreimageSwapRequest{}))
+
+	// Users may only query their own balance.
+	require.Equal(t, codes.OK, authorize(userCtx, pb.SparkService_QueryBalance_FullMethodName, &pb.QueryBalanceRequest{IdentityPublicKey: user}))
+	require.Equal(t, codes.PermissionDenied, authorize(userCtx, pb.SparkService_QueryBalance_FullMethodName, &pb.QueryBalanceRequest{IdentityPublicKey: ssp}))
+	require.Equal(t, codes.PermissionDenied, authorize(userCtx, pb.SparkService_QueryBalance_FullMethodName, nil))
+
+	// Any rule applying to a method can allow the call.
+	require.Equal(t, codes.OK, authorize(userCtx, pb.SparkService_GenerateDepositAddress_FullMethodName, &pb.GenerateDepositAddressRequest{Network: pb.Network_REGTEST}))
+	require.Equal(t, codes.PermissionDenied, authorize(userCtx, pb.SparkService_GenerateDepositAddress_FullMethodName, &pb.GenerateDepositAddressRequest{Network: pb.Network_MAINNET}))
+	require.Equal(t, codes.OK, authorize(sspCtx, pb.SparkService_GenerateDepositAddress_FullMethodName, &pb.GenerateDepositAddressRequest{Network: pb.Network_MAINNET}))
+
+	// Methods no rule applies to are denied by default.
+	require.Equal(t, codes.PermissionDenied, authorize(userCtx, pb.SparkService_StartTransfer_FullMethodName, &pb.StartTransferRequest{}))
+	policy.defaultAllow = true
+	require.Equal(t, codes.OK, authorize(userCtx, pb.SparkService_StartTransfer_FullMethodName, &pb.StartTransferRequest{}))
+}
+
+func TestRequestFieldValue(t *testing.T) {
+	req := &pb.StartTransferRequest{
+		OwnerIdentityPublicKey: []byte{1, 2},
+		TransferPackage:        &pb.TransferPackage{UserSignature: []byte{3}},
+	}
+	value, err := requestFieldValue(req.ProtoReflect(), "owner_identity_public_key")
+	require.NoError(t, err)
+	require.Equal(t, "0102", value)
+	value, err = requestFieldValue(req.ProtoReflect(), "transfer_package.user_signature")
+	require.NoError(t, err)
+	require.Equal(t, "03", value)
+
+	for _, path := range []string{"missing", "transfer_package", "leaves_to_send", "owner_identity_public_key.x"} {
+		_, err = requestFieldValue(req.ProtoReflect(), path)
+		require.Error(t, err, path)
+	}
+}
+
+func TestPolicyAdminClientCertificates(t *testing.T) {
+	listed, unlisted := newTestCertificate(t, "listed"), newTestCertificate(t, "unlisted")
+	named := newTestCertificate(t, "named")
+	spki := sha256.Sum256(listed.RawSubjectPublicKeyInfo)
+	policy, err := NewPolicy(PolicyConfig{
+		AdminClientCertificates: []string{hex.EncodeToString(spki[:])},
+		AdminClientSubjects:     []string{named.Subject.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, policy.HasAdminClientCertificates())
+
+	require.True(t, policy.IsAdminClientCertificate(listed))
+	require.True(t, policy.IsAdminClientCertificate(named))
+	require.False(t, policy.IsAdminClientCertificate(unlisted))
+	require.False(t, policy.IsAdminClientCertificate(nil))
+
+	// Without a list, no certificate holds the admin role.
+	var empty *Policy
+	require.False(t, empty.IsAdminClientCertificate(listed))
+	_, err = NewPolicy(PolicyConfig{AdminClientCertificates: []string{"abcd"}})
+	require.Error(t, err)
+}
+
+func TestPolicyDeniesAdminServiceByDefault(t *testing.T) {
+	policy, err := NewPolicy(PolicyConfig{})
+	require.NoError(t, err)
+	ctx := sessionContext(t, newTestIdentity(t))
+
+	for _, policy := range []*Policy{policy, nil} {
+		require.Error(t, policy.Authorize(ctx, pbadmin.SparkAdminService_TriggerTask_FullMethodName, nil, policy.SessionRoles(ctx)))
+		require.NoError(t, policy.Authorize(ctx, pbadmin.SparkAdminService_TriggerTask_FullMethodName, nil, map[Role]bool{RoleAdmin: true}))
+		require.NoError(t, policy.Authorize(ctx, pb.SparkService_StartTransfer_FullMethodName, nil, policy.SessionRoles(ctx)))
+	}
+}
+
+func newTestCertificate(t *testing.T, commonName string) *x509.Certificate {
+	key, err := stdecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"spark"}},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
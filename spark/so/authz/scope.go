@@ -0,0 +1,96 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	pbauthn "github.com/lightsparkdev/spark/proto/spark_authn"
+	"github.com/lightsparkdev/spark/so/authn"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ScopeRead allows queries only.
+	ScopeRead = "read"
+	// ScopeReceive allows queries, receiving and claiming transfers, and deposits.
+	ScopeReceive = "receive"
+)
+
+var readMethods = []string{
+	pb.SparkService_QueryPendingTransfers_FullMethodName,
+	pb.SparkService_QueryAllTransfers_FullMethodName,
+	pb.SparkService_QueryNodes_FullMethodName,
+	pb.SparkService_QueryBalance_FullMethodName,
+	pb.SparkService_QueryUserSignedRefunds_FullMethodName,
+	pb.SparkService_QueryTokenOutputs_FullMethodName,
+	pb.SparkService_QueryTokenTransactions_FullMethodName,
+	pb.SparkService_QueryUnusedDepositAddresses_FullMethodName,
+	pb.SparkService_SubscribeToEvents_FullMethodName,
+	pb.SparkService_GetSigningOperatorList_FullMethodName,
+}
+
+// scopeMethods are the methods each scope allows.
+var scopeMethods = map[string][]string{
+	ScopeRead: readMethods,
+	ScopeReceive: append(slices.Clone(readMethods),
+		pb.SparkService_ClaimTransferTweakKeys_FullMethodName,
+		pb.SparkService_ClaimTransferSignRefunds_FullMethodName,
+		pb.SparkService_FinalizeNodeSignatures_FullMethodName,
+		pb.SparkService_GenerateDepositAddress_FullMethodName,
+		pb.SparkService_StartDepositTreeCreation_FullMethodName,
+	),
+}
+
+// ValidScope returns whether scope is a known scope.
+func ValidScope(scope string) bool {
+	_, ok := scopeMethods[scope]
+	return ok
+}
+
+// EnforceSessionScope checks that the session in the context, if it is limited to scopes, may call
+// the given method. Sessions with full access, and requests without a session, are not checked
+// here. The methods of the authentication service are always allowed so that a limited session
+// can be refreshed and revoked.
+func EnforceSessionScope(ctx context.Context, fullMethod string) error {
+	session, err := authn.GetSessionFromContext(ctx)
+	if err != nil || len(session.Scopes()) == 0 {
+		return nil
+	}
+	if strings.HasPrefix(fullMethod, "/"+pbauthn.SparkAuthnService_ServiceDesc.ServiceName+"/") {
+		return nil
+	}
+	for _, scope := range session.Scopes() {
+		if slices.Contains(scopeMethods[scope], fullMethod) {
+			return nil
+		}
+	}
+	return &Error{
+		Code:    ErrorCodeScope,
+		Message: "session scopes " + strings.Join(session.Scopes(), ", ") + " do not allow " + fullMethod,
+	}
+}
+
+// ScopeInterceptor rejects unary calls the session's scopes do not allow. The error is converted
+// to a gRPC error by the error interceptor.
+func ScopeInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := EnforceSessionScope(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamScopeInterceptor rejects streaming calls the session's scopes do not allow.
+func StreamScopeInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		var authzErr *Error
+		if err := EnforceSessionScope(ss.Context(), info.FullMethod); errors.As(err, &authzErr) {
+			return authzErr.ToGRPCError()
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,578 @@
+// Package backup writes and restores encrypted snapshots of the database of a signing operator.
+//
+// A backup is read from a single transaction, so it is consistent, and holds the rows of every
+// table in a form that is the same for SQLite and Postgres, so it can be restored into either. Its
+// body is compressed and encrypted with a random key, which is itself encrypted to the identity
+// public key of the operator, and signed with the identity private key. Only the operator can read
+// a backup, and a restore checks that the operator wrote it and that nothing is missing from it.
+//
+// Secrets that are encrypted at rest with an envelope key are backed up as they are stored, so
+// restoring them requires the same envelope key.
+//
+// Copy moves the database of an operator into another one directly, with the same conversions, for
+// example from SQLite to Postgres.
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	stdsql "database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	eciesgo "github.com/ecies/go/v2"
+	"github.com/lightsparkdev/spark/so/ent/signingnonce"
+)
+
+// FormatVersion is the version of the backup format written by this package.
+const FormatVersion = 1
+
+// maxHeaderSize is the size of the largest header that can be read back.
+const maxHeaderSize = 1 << 20
+
+// magic starts every backup.
+var magic = []byte("SPARKBACKUP\n")
+
+// Mode is what a backup or a restore covers.
+type Mode string
+
+const (
+	// ModeFull covers every table of the database.
+	ModeFull Mode = "full"
+	// ModeKeyshares covers only the signing keyshares, which cannot be recovered any other way.
+	ModeKeyshares Mode = "keyshares"
+)
+
+// Header is the unencrypted header of a backup. It is authenticated by the encryption of the body.
+type Header struct {
+	FormatVersion     int       `json:"format_version"`
+	Mode              Mode      `json:"mode"`
+	CreatedAt         time.Time `json:"created_at"`
+	IdentityPublicKey []byte    `json:"identity_public_key"`
+	// EncryptedKey is the key of the body, encrypted to the identity public key.
+	EncryptedKey []byte        `json:"encrypted_key"`
+	Tables       []TableHeader `json:"tables"`
+}
+
+// Summary describes a backup that was written, verified or restored.
+type Summary struct {
+	Header *Header
+	// Rows is the number of rows of every table of the backup.
+	Rows map[string]int64
+	// Dropped is the number of rows of every table that were restored and then deleted, because
+	// using them again would be unsafe.
+	Dropped map[string]int64
+}
+
+// record is a line of the body of a backup: a row of a table, or the trailer that ends the body.
+type record struct {
+	Table   string   `json:"table,omitempty"`
+	Values  []any    `json:"values,omitempty"`
+	Trailer *trailer `json:"trailer,omitempty"`
+}
+
+type trailer struct {
+	Rows map[string]int64 `json:"rows"`
+	// Signature signs the hash of the header and every row of the body with the identity key.
+	Signature []byte `json:"signature"`
+}
+
+// Write writes a backup of the tables of the mode in db, a database of the driver, to w.
+func Write(ctx context.Context, db *stdsql.DB, driver string, identityPrivateKey []byte, mode Mode, w io.Writer) (*Summary, error) {
+	tables, err := modeTables(mode)
+	if err != nil {
+		return nil, err
+	}
+	tableHeaders, err := planTables(tables)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, snapshotOptions(driver))
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin backup transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	identityKey := secp256k1.PrivKeyFromBytes(identityPrivateKey)
+	identityPublicKey := identityKey.PubKey().SerializeCompressed()
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	publicKey, err := eciesgo.NewPublicKeyFromBytes(identityPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := eciesgo.Encrypt(publicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt backup key: %w", err)
+	}
+	header := &Header{
+		FormatVersion:     FormatVersion,
+		Mode:              mode,
+		CreatedAt:         time.Now().UTC(),
+		IdentityPublicKey: identityPublicKey,
+		EncryptedKey:      encryptedKey,
+		Tables:            tableHeaders,
+	}
+	headerBytes, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	prefix := binary.BigEndian.AppendUint32(append([]byte{}, magic...), uint32(len(headerBytes)))
+	if _, err := w.Write(append(prefix, headerBytes...)); err != nil {
+		return nil, fmt.Errorf("failed to write backup header: %w", err)
+	}
+
+	body, err := newChunkWriter(w, key, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+	compressed := gzip.NewWriter(body)
+	digest := sha256.New()
+	digest.Write(headerBytes)
+	encoder := json.NewEncoder(io.MultiWriter(compressed, digest))
+
+	rows := make(map[string]int64, len(tableHeaders))
+	for _, table := range tableHeaders {
+		count, err := writeTable(ctx, tx, driver, table, encoder)
+		if err != nil {
+			return nil, fmt.Errorf("failed to back up table %s: %w", table.Name, err)
+		}
+		rows[table.Name] = count
+	}
+
+	signature := ecdsa.Sign(identityKey, digest.Sum(nil)).Serialize()
+	if err := json.NewEncoder(compressed).Encode(record{Trailer: &trailer{Rows: rows, Signature: signature}}); err != nil {
+		return nil, err
+	}
+	if err := compressed.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write backup: %w", err)
+	}
+	if err := body.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write backup: %w", err)
+	}
+	return &Summary{Header: header, Rows: rows}, nil
+}
+
+func writeTable(ctx context.Context, tx *stdsql.Tx, driver string, table TableHeader, encoder *json.Encoder) (int64, error) {
+	return scanTable(ctx, tx, driver, table, nil, func(values []any) error {
+		return encoder.Encode(record{Table: table.Name, Values: values})
+	})
+}
+
+// scanTable calls fn with the normalized values of the columns of every row of the table that
+// matches where, or of every row if where is nil, and returns the number of rows.
+func scanTable(ctx context.Context, tx *stdsql.Tx, driver string, table TableHeader, where *entsql.Predicate, fn func([]any) error) (int64, error) {
+	_, columns, err := schemaTable(table.Name)
+	if err != nil {
+		return 0, err
+	}
+	selector := entsql.Dialect(driver).Select(table.Columns...).From(entsql.Table(table.Name))
+	if where != nil {
+		selector.Where(where)
+	}
+	query, args := selector.Query()
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var count int64
+	values := make([]any, len(table.Columns))
+	pointers := make([]any, len(table.Columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return 0, err
+		}
+		normalized := make([]any, len(values))
+		for i, value := range values {
+			if normalized[i], err = normalizeValue(columns[table.Columns[i]], value); err != nil {
+				return 0, err
+			}
+		}
+		if err := fn(normalized); err != nil {
+			return 0, err
+		}
+		count++
+	}
+	return count, rows.Err()
+}
+
+// snapshotOptions returns the options of a transaction that reads a single snapshot of a database
+// of the driver. A repeatable read transaction does on Postgres, and SQLite transactions always do.
+func snapshotOptions(driver string) *stdsql.TxOptions {
+	if driver == "postgres" {
+		return &stdsql.TxOptions{Isolation: stdsql.LevelRepeatableRead, ReadOnly: true}
+	}
+	return nil
+}
+
+// Verify reads the backup from r and checks that it was written by the operator with the identity
+// key and that it is complete, without restoring it.
+func Verify(identityPrivateKey []byte, r io.Reader) (*Summary, error) {
+	return read(identityPrivateKey, r, func(*Header) error { return nil }, func(TableHeader, []any) error { return nil })
+}
+
+// Restore restores the tables of the mode from the backup in r into db, a database of the driver
+// with the schema of this binary and none of the rows of these tables. Nothing is restored unless
+// the whole backup is verified. Signing nonces not bound to a message in the backup are dropped,
+// as they may have been used since.
+func Restore(ctx context.Context, db *stdsql.DB, driver string, identityPrivateKey []byte, mode Mode, r io.Reader) (*Summary, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin restore transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	restorers := make(map[string]*tableWriter)
+	summary, err := read(identityPrivateKey, r,
+		func(header *Header) error {
+			if mode == ModeFull && header.Mode != ModeFull {
+				return fmt.Errorf("a full restore needs a full backup, not a %s backup", header.Mode)
+			}
+			tables, err := modeTables(mode)
+			if err != nil {
+				return err
+			}
+			wanted := make(map[string]bool, len(tables))
+			for _, table := range tables {
+				wanted[table.Name] = true
+			}
+			for _, table := range header.Tables {
+				if !wanted[table.Name] {
+					continue
+				}
+				if err := checkEmpty(ctx, tx, driver, table); err != nil {
+					return err
+				}
+				restorer, err := newTableWriter(ctx, tx, driver, table, false)
+				if err != nil {
+					return err
+				}
+				restorers[table.Name] = restorer
+			}
+			return nil
+		},
+		func(table TableHeader, values []any) error {
+			if restorer, ok := restorers[table.Name]; ok {
+				return restorer.add(values)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := make(map[string]int64, len(restorers))
+	for _, table := range summary.Header.Tables {
+		if restorer, ok := restorers[table.Name]; ok {
+			if err := restorer.flush(); err != nil {
+				return nil, err
+			}
+			restored[table.Name] = summary.Rows[table.Name]
+		}
+	}
+	// Deferred references are only set once every row they may refer to is restored.
+	for _, restorer := range restorers {
+		if err := restorer.restoreDeferred(); err != nil {
+			return nil, err
+		}
+	}
+	dropped := make(map[string]int64)
+	if _, ok := restorers[signingnonce.Table]; ok {
+		count, err := dropUnboundSigningNonces(ctx, tx, driver)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			dropped[signingnonce.Table] = count
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit restore: %w", err)
+	}
+	return &Summary{Header: summary.Header, Rows: restored, Dropped: dropped}, nil
+}
+
+// dropUnboundSigningNonces deletes the restored signing nonces that are not bound to a message,
+// and returns how many it deleted. Any of them may have been bound to a message after the backup
+// was written, and binding it to another one would reuse the nonce and leak the keyshare.
+func dropUnboundSigningNonces(ctx context.Context, tx *stdsql.Tx, driver string) (int64, error) {
+	query, args := entsql.Dialect(driver).Delete(signingnonce.Table).
+		Where(entsql.IsNull(signingnonce.FieldMessage)).
+		Query()
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to drop unbound signing nonces: %w", err)
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count dropped signing nonces: %w", err)
+	}
+	return count, nil
+}
+
+// read reads the backup in r, calling start with its header and row with each of its rows, and
+// checks the signature and the row counts of its trailer.
+func read(identityPrivateKey []byte, r io.Reader, start func(*Header) error, row func(TableHeader, []any) error) (*Summary, error) {
+	prefix := make([]byte, len(magic)+4)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, fmt.Errorf("failed to read backup header: %w", err)
+	}
+	if !bytes.Equal(prefix[:len(magic)], magic) {
+		return nil, errors.New("not a backup")
+	}
+	length := binary.BigEndian.Uint32(prefix[len(magic):])
+	if length > maxHeaderSize {
+		return nil, fmt.Errorf("backup header of %d bytes is too large", length)
+	}
+	headerBytes := make([]byte, length)
+	if _, err := io.ReadFull(r, headerBytes); err != nil {
+		return nil, fmt.Errorf("failed to read backup header: %w", err)
+	}
+	var header Header
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, fmt.Errorf("failed to parse backup header: %w", err)
+	}
+	if header.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("unsupported backup format version %d", header.FormatVersion)
+	}
+	identityKey := secp256k1.PrivKeyFromBytes(identityPrivateKey)
+	if !bytes.Equal(header.IdentityPublicKey, identityKey.PubKey().SerializeCompressed()) {
+		return nil, fmt.Errorf("backup was written by operator %x, not this one", header.IdentityPublicKey)
+	}
+	key, err := eciesgo.Decrypt(eciesgo.NewPrivateKeyFromBytes(identityPrivateKey), header.EncryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt backup key: %w", err)
+	}
+
+	tables := make(map[string]TableHeader, len(header.Tables))
+	for _, table := range header.Tables {
+		_, columns, err := schemaTable(table.Name)
+		if err != nil {
+			return nil, fmt.Errorf("backup is of a newer schema: %w", err)
+		}
+		for _, column := range table.Columns {
+			if columns[column] == nil {
+				return nil, fmt.Errorf("backup is of a newer schema: column %s.%s is not in the schema", table.Name, column)
+			}
+		}
+		tables[table.Name] = table
+	}
+	if err := start(&header); err != nil {
+		return nil, err
+	}
+
+	body, err := newChunkReader(r, key, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+	compressed, err := gzip.NewReader(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup: %w", err)
+	}
+	lines := bufio.NewReader(compressed)
+	digest := sha256.New()
+	digest.Write(headerBytes)
+	rows := make(map[string]int64, len(tables))
+	for {
+		line, err := lines.ReadBytes('\n')
+		if err != nil {
+			return nil, fmt.Errorf("failed to read backup: %w", err)
+		}
+		rec, err := decodeRecord(line)
+		if err != nil {
+			return nil, err
+		}
+		if rec.Trailer != nil {
+			if err := checkTrailer(rec.Trailer, identityKey.PubKey(), digest, &header, rows); err != nil {
+				return nil, err
+			}
+			break
+		}
+		digest.Write(line)
+
+		table, ok := tables[rec.Table]
+		if !ok || len(rec.Values) != len(table.Columns) {
+			return nil, fmt.Errorf("invalid row of table %s", rec.Table)
+		}
+		if err := row(table, rec.Values); err != nil {
+			return nil, fmt.Errorf("failed to restore row of table %s: %w", rec.Table, err)
+		}
+		rows[rec.Table]++
+	}
+	// Reading to the end checks that the body is neither truncated nor corrupted after the trailer.
+	switch _, err := lines.ReadByte(); {
+	case err == nil:
+		return nil, errors.New("backup continues after its trailer")
+	case err != io.EOF:
+		return nil, fmt.Errorf("failed to read backup: %w", err)
+	}
+	return &Summary{Header: &header, Rows: rows}, nil
+}
+
+func decodeRecord(line []byte) (*record, error) {
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.UseNumber()
+	var rec record
+	if err := decoder.Decode(&rec); err != nil {
+		return nil, fmt.Errorf("failed to parse backup record: %w", err)
+	}
+	return &rec, nil
+}
+
+func checkTrailer(trailer *trailer, identityPublicKey *secp256k1.PublicKey, digest hash.Hash, header *Header, rows map[string]int64) error {
+	signature, err := ecdsa.ParseDERSignature(trailer.Signature)
+	if err != nil {
+		return fmt.Errorf("failed to parse backup signature: %w", err)
+	}
+	if !signature.Verify(digest.Sum(nil), identityPublicKey) {
+		return errors.New("backup signature is invalid")
+	}
+	// The signature covers the rows that were read, but not that they were all of them.
+	for _, table := range header.Tables {
+		if rows[table.Name] != trailer.Rows[table.Name] {
+			return fmt.Errorf("backup holds %d rows of table %s instead of %d", rows[table.Name], table.Name, trailer.Rows[table.Name])
+		}
+	}
+	return nil
+}
+
+// tableWriter inserts the rows of a table in batches, or upserts them by their primary key.
+type tableWriter struct {
+	ctx      context.Context
+	tx       *stdsql.Tx
+	driver   string
+	table    TableHeader
+	columns  []*schema.Column
+	key      int
+	upsert   bool
+	deferred map[int]bool
+	batch    [][]any
+	// references are the values of the deferred columns of the restored rows, by column and key.
+	references map[string]map[any]any
+}
+
+func newTableWriter(ctx context.Context, tx *stdsql.Tx, driver string, table TableHeader, upsert bool) (*tableWriter, error) {
+	schemaTable, columns, err := schemaTable(table.Name)
+	if err != nil {
+		return nil, err
+	}
+	r := &tableWriter{
+		ctx:        ctx,
+		tx:         tx,
+		driver:     driver,
+		table:      table,
+		key:        -1,
+		upsert:     upsert,
+		deferred:   make(map[int]bool),
+		references: make(map[string]map[any]any),
+	}
+	for i, name := range table.Columns {
+		r.columns = append(r.columns, columns[name])
+		if name == schemaTable.PrimaryKey[0].Name {
+			r.key = i
+		}
+		for _, deferred := range table.Deferred {
+			if name == deferred {
+				r.deferred[i] = true
+				r.references[name] = make(map[any]any)
+			}
+		}
+	}
+	if r.key < 0 && (len(table.Deferred) > 0 || upsert) {
+		return nil, fmt.Errorf("table %s has no primary key", table.Name)
+	}
+	return r, nil
+}
+
+// checkEmpty fails unless the table has no rows.
+func checkEmpty(ctx context.Context, tx *stdsql.Tx, driver string, table TableHeader) error {
+	query, args := entsql.Dialect(driver).Select(table.Columns[0]).From(entsql.Table(table.Name)).Limit(1).Query()
+	var existing any
+	switch err := tx.QueryRowContext(ctx, query, args...).Scan(&existing); {
+	case err == nil:
+		return fmt.Errorf("table %s is not empty", table.Name)
+	case !errors.Is(err, stdsql.ErrNoRows):
+		return err
+	}
+	return nil
+}
+
+func (r *tableWriter) add(values []any) error {
+	row := make([]any, len(values))
+	for i, value := range values {
+		decoded, err := decodeValue(r.columns[i], value)
+		if err != nil {
+			return fmt.Errorf("invalid value of column %s: %w", r.table.Columns[i], err)
+		}
+		row[i] = decoded
+	}
+	for i := range r.deferred {
+		if row[i] != nil {
+			r.references[r.table.Columns[i]][row[r.key]] = row[i]
+			row[i] = nil
+		}
+	}
+	r.batch = append(r.batch, row)
+	// Batches stay well below the limits of both databases on the number of parameters.
+	if len(r.batch)*len(r.columns) >= 1000 {
+		return r.flush()
+	}
+	return nil
+}
+
+func (r *tableWriter) flush() error {
+	if len(r.batch) == 0 {
+		return nil
+	}
+	insert := entsql.Dialect(r.driver).Insert(r.table.Name).Columns(r.table.Columns...)
+	for _, row := range r.batch {
+		insert.Values(row...)
+	}
+	if r.upsert {
+		insert.OnConflict(entsql.ConflictColumns(r.table.Columns[r.key]), entsql.ResolveWithNewValues())
+	}
+	query, args := insert.Query()
+	if _, err := r.tx.ExecContext(r.ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert rows of table %s: %w", r.table.Name, err)
+	}
+	r.batch = r.batch[:0]
+	return nil
+}
+
+// restoreDeferred sets the deferred columns of the written rows.
+func (r *tableWriter) restoreDeferred() error {
+	for column, references := range r.references {
+		for key, value := range references {
+			query, args := entsql.Dialect(r.driver).Update(r.table.Name).
+				Set(column, value).
+				Where(entsql.EQ(r.table.Columns[r.key], key)).
+				Query()
+			if _, err := r.tx.ExecContext(r.ctx, query, args...); err != nil {
+				return fmt.Errorf("failed to restore %s.%s: %w", r.table.Name, column, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/treenode"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestDatabase(t *testing.T, name string) (*sql.DB, *ent.Client) {
+	db, err := sql.Open("sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	client := ent.NewClient(ent.Driver(entsql.OpenDB("sqlite3", db)))
+	require.NoError(t, client.Schema.Create(context.Background()))
+	return db, client
+}
+
+// populateTestDatabase creates a keyshare and a tree of two nodes, whose references to each other
+// cannot be restored in any order of the rows, and an archived transfer.
+func populateTestDatabase(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	keyshare := client.SigningKeyshare.Create().
+		SetStatus(schema.KeyshareStatusInUse).
+		SetSecretShare([]byte("plaintext secret share")).
+		SetPublicShares(map[string][]byte{"1": {1}}).
+		SetPublicKey([]byte("public key")).
+		SetMinSigners(2).
+		SetCoordinatorIndex(1 << 40).
+		SaveX(ctx)
+	tree := client.Tree.Create().
+		SetOwnerIdentityPubkey(bytes.Repeat([]byte{3}, 33)).
+		SetStatus(schema.TreeStatusAvailable).
+		SetNetwork(schema.NetworkRegtest).
+		SetBaseTxid(bytes.Repeat([]byte{4}, 32)).
+		SetVout(0).
+		SaveX(ctx)
+	createNode := func(parent *ent.TreeNode) *ent.TreeNode {
+		create := client.TreeNode.Create().
+			SetTree(tree).
+			SetSigningKeyshare(keyshare).
+			SetValue(1000).
+			SetStatus(schema.TreeNodeStatusAvailable).
+			SetVerifyingPubkey(bytes.Repeat([]byte{5}, 33)).
+			SetOwnerIdentityPubkey(bytes.Repeat([]byte{3}, 33)).
+			SetOwnerSigningPubkey(bytes.Repeat([]byte{6}, 33)).
+			SetRawTx([]byte{7}).
+			SetVout(0)
+		if parent != nil {
+			create.SetParent(parent)
+		}
+		return create.SaveX(ctx)
+	}
+	root := createNode(nil)
+	createNode(root)
+	tree.Update().SetRoot(root).ExecX(ctx)
+
+	archive := client.Archive.Create().
+		SetKind(ent.ArchiveKindTransfers).
+		SetRecordCount(1).
+		SetData([]byte("archived transfer")).
+		SaveX(ctx)
+	client.ArchivedTransfer.Create().
+		SetSenderIdentityPubkey(bytes.Repeat([]byte{1}, 33)).
+		SetReceiverIdentityPubkey(bytes.Repeat([]byte{2}, 33)).
+		SetTotalValue(1000).
+		SetStatus(schema.TransferStatusCompleted).
+		SetType(schema.TransferTypeTransfer).
+		SetTransferUpdateTime(time.Now()).
+		SetArchive(archive).
+		SaveX(ctx)
+}
+
+func testIdentityKey(t *testing.T) []byte {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	return key.Serialize()
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	ctx := context.Background()
+	sourceDB, source := openTestDatabase(t, "backup_source")
+	populateTestDatabase(t, source)
+	identityKey := testIdentityKey(t)
+
+	var backup bytes.Buffer
+	written, err := Write(ctx, sourceDB, "sqlite3", identityKey, ModeFull, &backup)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), written.Rows["tree_nodes"])
+	require.NotContains(t, backup.String(), "plaintext secret share")
+
+	verified, err := Verify(identityKey, bytes.NewReader(backup.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, written.Rows["tree_nodes"], verified.Rows["tree_nodes"])
+
+	targetDB, target := openTestDatabase(t, "backup_target")
+	restored, err := Restore(ctx, targetDB, "sqlite3", identityKey, ModeFull, bytes.NewReader(backup.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), restored.Rows["signing_keyshares"])
+
+	keyshare, sourceKeyshare := target.SigningKeyshare.Query().OnlyX(ctx), source.SigningKeyshare.Query().OnlyX(ctx)
+	require.Equal(t, sourceKeyshare.ID, keyshare.ID)
+	require.Equal(t, sourceKeyshare.SecretShare, keyshare.SecretShare)
+	require.Equal(t, sourceKeyshare.PublicShares, keyshare.PublicShares)
+	require.Equal(t, sourceKeyshare.CoordinatorIndex, keyshare.CoordinatorIndex)
+	require.True(t, sourceKeyshare.CreateTime.Equal(keyshare.CreateTime))
+	tree := target.Tree.Query().WithRoot().OnlyX(ctx)
+	require.NotNil(t, tree.Edges.Root)
+	require.Equal(t, 1, target.TreeNode.Query().Where(treenode.HasParent()).CountX(ctx))
+	// Archives are part of the state like any other.
+	archived := target.ArchivedTransfer.Query().WithArchive().OnlyX(ctx)
+	require.Equal(t, []byte("archived transfer"), archived.Edges.Archive.Data)
+
+	// Restoring twice would duplicate the state.
+	_, err = Restore(ctx, targetDB, "sqlite3", identityKey, ModeFull, bytes.NewReader(backup.Bytes()))
+	require.ErrorContains(t, err, "is not empty")
+}
+
+func TestRestoreDropsUnboundSigningNonces(t *testing.T) {
+	ctx := context.Background()
+	sourceDB, source := openTestDatabase(t, "backup_nonces_source")
+	identityKey := testIdentityKey(t)
+	bound := source.SigningNonce.Create().
+		SetNonce([]byte("bound nonce")).
+		SetNonceCommitment([]byte("bound commitment")).
+		SetMessage([]byte("message")).
+		SetBindingHash([]byte("binding")).
+		SetUsedTime(time.Now()).
+		SaveX(ctx)
+	unbound := source.SigningNonce.Create().
+		SetNonce([]byte("unbound nonce")).
+		SetNonceCommitment([]byte("unbound commitment")).
+		SaveX(ctx)
+
+	var backup bytes.Buffer
+	_, err := Write(ctx, sourceDB, "sqlite3", identityKey, ModeFull, &backup)
+	require.NoError(t, err)
+	// The nonce is used after the backup is written.
+	unbound.Update().
+		SetMessage([]byte("later message")).
+		SetBindingHash([]byte("later binding")).
+		SetUsedTime(time.Now()).
+		ExecX(ctx)
+
+	targetDB, target := openTestDatabase(t, "backup_nonces_target")
+	restored, err := Restore(ctx, targetDB, "sqlite3", identityKey, ModeFull, bytes.NewReader(backup.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), restored.Rows["signing_nonces"])
+	require.Equal(t, map[string]int64{"signing_nonces": 1}, restored.Dropped)
+
+	// Only the nonce bound in the backup is restored, so the other cannot sign a second message.
+	nonces := target.SigningNonce.Query().AllX(ctx)
+	require.Len(t, nonces, 1)
+	require.Equal(t, bound.ID, nonces[0].ID)
+	require.Equal(t, []byte("message"), nonces[0].Message)
+}
+
+func TestRestoreKeysharesOnly(t *testing.T) {
+	ctx := context.Background()
+	sourceDB, source := openTestDatabase(t, "backup_keyshares_source")
+	populateTestDatabase(t, source)
+	identityKey := testIdentityKey(t)
+
+	var backup bytes.Buffer
+	_, err := Write(ctx, sourceDB, "sqlite3", identityKey, ModeFull, &backup)
+	require.NoError(t, err)
+
+	targetDB, target := openTestDatabase(t, "backup_keyshares_target")
+	restored, err := Restore(ctx, targetDB, "sqlite3", identityKey, ModeKeyshares, bytes.NewReader(backup.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"signing_keyshares": 1}, restored.Rows)
+	require.Equal(t, 1, target.SigningKeyshare.Query().CountX(ctx))
+	require.Zero(t, target.TreeNode.Query().CountX(ctx))
+
+	// A backup of the keyshares cannot be restored in full.
+	var keysharesBackup bytes.Buffer
+	_, err = Write(ctx, sourceDB, "sqlite3", identityKey, ModeKeyshares, &keysharesBackup)
+	require.NoError(t, err)
+	emptyDB, _ := openTestDatabase(t, "backup_keyshares_empty")
+	_, err = Restore(ctx, emptyDB, "sqlite3", identityKey, ModeFull, &keysharesBackup)
+	require.ErrorContains(t, err, "needs a full backup")
+}
+
+func TestVerifyRejectsTamperedBackups(t *testing.T) {
+	ctx := context.Background()
+	sourceDB, source := openTestDatabase(t, "backup_tampered")
+	populateTestDatabase(t, source)
+	identityKey := testIdentityKey(t)
+
+	var backup bytes.Buffer
+	_, err := Write(ctx, sourceDB, "sqlite3", identityKey, ModeFull, &backup)
+	require.NoError(t, err)
+	data := backup.Bytes()
+
+	_, err = Verify(testIdentityKey(t), bytes.NewReader(data))
+	require.ErrorContains(t, err, "not this one")
+
+	corrupted := bytes.Clone(data)
+	corrupted[len(corrupted)-1] ^= 1
+	_, err = Verify(identityKey, bytes.NewReader(corrupted))
+	require.ErrorContains(t, err, "failed to decrypt chunk")
+
+	_, err = Verify(identityKey, bytes.NewReader(data[:len(data)-10]))
+	require.Error(t, err)
+
+	// The header is authenticated along with the body.
+	forged := bytes.Replace(data, []byte(`"mode":"full"`), []byte(`"mode":"keys"`), 1)
+	_, err = Verify(identityKey, bytes.NewReader(forged))
+	require.Error(t, err)
+}
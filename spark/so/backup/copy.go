@@ -0,0 +1,433 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	stdsql "database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/migrate"
+)
+
+// updateTimeColumn is the column that every table of the schema updates along with its rows.
+const updateTimeColumn = "update_time"
+
+// sqliteWriteMargin is how long before a SQLite source was locked for a copy a write that only
+// commits after the copy may have set its update time. Writes set their update time before they
+// wait for the lock of the database, which the operator waits for up to its busy timeout.
+const sqliteWriteMargin = time.Minute
+
+// deleteBatchSize is the number of rows deleted by a statement.
+const deleteBatchSize = 500
+
+// CopiedTable describes a table copied by Copy.
+type CopiedTable struct {
+	Name string `json:"name"`
+	// Copied is the number of rows inserted or updated in the target.
+	Copied int64 `json:"copied"`
+	// Deleted is the number of rows deleted from the target because they are not in the source.
+	Deleted int64 `json:"deleted"`
+	// Rows and Checksum are the number of rows and the checksum of the table, which are the same in
+	// the source and the target once it is copied.
+	Rows     int64  `json:"rows"`
+	Checksum string `json:"checksum"`
+}
+
+// CopyWatermark is how far a copy read its source. The next copy from the same source only reads
+// the rows written since.
+type CopyWatermark struct {
+	// Driver is the driver of the source.
+	Driver string `json:"driver"`
+	// TransactionID is, for a Postgres source, the oldest transaction that was still running when
+	// the copy read the source. Rows written by it or later transactions may not have been read.
+	TransactionID uint64 `json:"transaction_id,omitempty"`
+	// Time is, for a SQLite source, the earliest update time that rows not read by the copy may
+	// have.
+	Time time.Time `json:"time,omitempty"`
+}
+
+// CopyOptions are the options of Copy.
+type CopyOptions struct {
+	// Since is the watermark of the previous copy into the target, or nil to copy every row.
+	Since *CopyWatermark
+	// Final deletes the rows of the target that are no longer in the source, and checks the row
+	// counts and checksums of every table. Both read every row, so they are meant for the last copy,
+	// with the operator stopped.
+	Final bool
+}
+
+// CopyResult describes what Copy copied.
+type CopyResult struct {
+	Tables []CopiedTable `json:"tables"`
+	// Watermark is the watermark to pass to the next copy from the same source.
+	Watermark *CopyWatermark `json:"watermark"`
+}
+
+// Copy copies every table of source, a database of sourceDriver, into target, a database of
+// targetDriver, both with the schema of this binary. Rows keep their IDs, and their values are
+// converted between the drivers as they are for a backup.
+//
+// A copy without a watermark copies every row. A copy with the watermark of the previous copy only
+// reads the rows the source wrote since, so that a live source can be copied incrementally and only
+// the final copy needs the operator stopped. The source is read from a single snapshot, and nothing
+// is written to the target by a final copy unless the row counts and checksums of every table then
+// match.
+func Copy(ctx context.Context, source *stdsql.DB, sourceDriver string, target *stdsql.DB, targetDriver string, options CopyOptions) (*CopyResult, error) {
+	tables, err := planTables(migrate.Tables)
+	if err != nil {
+		return nil, err
+	}
+	sourceTx, watermark, err := beginCopySnapshot(ctx, source, sourceDriver)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = sourceTx.Rollback() }()
+	targetTx, err := target.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin target transaction: %w", err)
+	}
+	defer func() { _ = targetTx.Rollback() }()
+
+	since := options.Since
+	if since != nil && since.Driver != sourceDriver {
+		return nil, fmt.Errorf("watermark is of a %s database, but the source is a %s database", since.Driver, sourceDriver)
+	}
+	copied := make([]CopiedTable, len(tables))
+	writers := make([]*tableWriter, len(tables))
+	for i, table := range tables {
+		where, err := since.predicate(table)
+		if err != nil {
+			return nil, err
+		}
+		writer, err := newTableWriter(ctx, targetTx, targetDriver, table, true)
+		if err != nil {
+			return nil, err
+		}
+		count, err := scanTable(ctx, sourceTx, sourceDriver, table, where, writer.add)
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy table %s: %w", table.Name, err)
+		}
+		if err := writer.flush(); err != nil {
+			return nil, err
+		}
+		copied[i] = CopiedTable{Name: table.Name, Copied: count}
+		writers[i] = writer
+	}
+	// Deferred references are only set once every row they may refer to is copied.
+	for _, writer := range writers {
+		if err := writer.restoreDeferred(); err != nil {
+			return nil, err
+		}
+	}
+	if options.Final {
+		if err := finishCopy(ctx, sourceTx, sourceDriver, targetTx, targetDriver, tables, copied); err != nil {
+			return nil, err
+		}
+	}
+	if err := targetTx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit copy: %w", err)
+	}
+	return &CopyResult{Tables: copied, Watermark: watermark}, nil
+}
+
+// finishCopy deletes the rows of the target that are no longer in the source, and checks that the
+// row counts and checksums of every table match.
+func finishCopy(ctx context.Context, sourceTx *stdsql.Tx, sourceDriver string, targetTx *stdsql.Tx, targetDriver string, tables []TableHeader, copied []CopiedTable) error {
+	// Rows are deleted once every reference to them was copied from the source, which no longer
+	// has any. The deferred references of the deleted rows are cleared first, as they may refer to
+	// rows of tables that are deleted from later.
+	deleted := make([][]any, len(tables))
+	for i, table := range tables {
+		var err error
+		if deleted[i], err = missingKeys(ctx, sourceTx, sourceDriver, targetTx, targetDriver, table); err != nil {
+			return fmt.Errorf("failed to compare table %s: %w", table.Name, err)
+		}
+		copied[i].Deleted = int64(len(deleted[i]))
+		if err := clearDeferred(ctx, targetTx, targetDriver, table, deleted[i]); err != nil {
+			return err
+		}
+	}
+	for i := len(tables) - 1; i >= 0; i-- {
+		if err := deleteRows(ctx, targetTx, targetDriver, tables[i], deleted[i]); err != nil {
+			return err
+		}
+	}
+
+	for i, table := range tables {
+		sourceRows, sourceChecksum, err := checksumTable(ctx, sourceTx, sourceDriver, table)
+		if err != nil {
+			return fmt.Errorf("failed to checksum table %s of the source: %w", table.Name, err)
+		}
+		targetRows, targetChecksum, err := checksumTable(ctx, targetTx, targetDriver, table)
+		if err != nil {
+			return fmt.Errorf("failed to checksum table %s of the target: %w", table.Name, err)
+		}
+		if sourceRows != targetRows || sourceChecksum != targetChecksum {
+			return fmt.Errorf("table %s has %d rows with checksum %s in the source but %d rows with checksum %s in the target",
+				table.Name, sourceRows, sourceChecksum, targetRows, targetChecksum)
+		}
+		copied[i].Rows = sourceRows
+		copied[i].Checksum = sourceChecksum
+	}
+	return nil
+}
+
+// beginCopySnapshot begins the transaction the source is read from, and returns it along with its
+// watermark.
+func beginCopySnapshot(ctx context.Context, db *stdsql.DB, driver string) (*stdsql.Tx, *CopyWatermark, error) {
+	if driver == "postgres" {
+		tx, err := db.BeginTx(ctx, snapshotOptions(driver))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to begin source transaction: %w", err)
+		}
+		// As the first statement of the transaction, this takes the snapshot it reads from.
+		var xmin uint64
+		if err := tx.QueryRowContext(ctx, "SELECT txid_snapshot_xmin(txid_current_snapshot())").Scan(&xmin); err != nil {
+			_ = tx.Rollback()
+			return nil, nil, fmt.Errorf("failed to read source snapshot: %w", err)
+		}
+		return tx, &CopyWatermark{Driver: driver, TransactionID: xmin}, nil
+	}
+
+	// SQLite does not record which transaction wrote a row, so the snapshot is taken while no
+	// write is running, and later writes are found by their update time.
+	lock, err := db.Conn(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to source: %w", err)
+	}
+	defer lock.Close()
+	if _, err := lock.ExecContext(ctx, "BEGIN IMMEDIATE"); err != nil {
+		return nil, nil, fmt.Errorf("failed to lock source: %w", err)
+	}
+	defer func() { _, _ = lock.ExecContext(context.WithoutCancel(ctx), "ROLLBACK") }()
+	locked := time.Now()
+	tx, err := db.BeginTx(ctx, snapshotOptions(driver))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin source transaction: %w", err)
+	}
+	// SQLite takes the snapshot of a transaction on its first read.
+	var count int
+	if err := tx.QueryRowContext(ctx, "SELECT count(*) FROM sqlite_master").Scan(&count); err != nil {
+		_ = tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to read source snapshot: %w", err)
+	}
+	return tx, &CopyWatermark{Driver: driver, Time: locked.Add(-sqliteWriteMargin)}, nil
+}
+
+// predicate returns the predicate of the rows of the table written since the watermark, or nil to
+// copy all of them.
+func (w *CopyWatermark) predicate(table TableHeader) (*entsql.Predicate, error) {
+	if w == nil {
+		return nil, nil
+	}
+	if w.Driver == "postgres" {
+		// Transaction IDs of rows wrap around at 32 bits, so they are compared by their age, which
+		// is exact as long as fewer than 2^31 transactions run between two copies.
+		xmin := strconv.FormatUint(w.TransactionID%(1<<32), 10)
+		return entsql.P(func(b *entsql.Builder) {
+			b.WriteString("age(xmin) <= age(").Arg(xmin).WriteString("::text::xid)")
+		}), nil
+	}
+	_, columns, err := schemaTable(table.Name)
+	if err != nil {
+		return nil, err
+	}
+	if columns[updateTimeColumn] == nil {
+		return nil, nil
+	}
+	// The time keeps its zone, as SQLite compares times as the text they were written as.
+	return entsql.GTE(updateTimeColumn, w.Time.Local()), nil
+}
+
+// missingKeys returns the primary keys of the rows of the table in the target that are not in the
+// source, by merging the keys of both in order.
+func missingKeys(ctx context.Context, sourceTx *stdsql.Tx, sourceDriver string, targetTx *stdsql.Tx, targetDriver string, table TableHeader) ([]any, error) {
+	sourceKeys, err := orderedKeys(ctx, sourceTx, sourceDriver, table)
+	if err != nil {
+		return nil, err
+	}
+	defer sourceKeys.close()
+	targetKeys, err := orderedKeys(ctx, targetTx, targetDriver, table)
+	if err != nil {
+		return nil, err
+	}
+	defer targetKeys.close()
+
+	var missing []any
+	sourceKey, sourceOK, err := sourceKeys.next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		targetKey, ok, err := targetKeys.next()
+		if err != nil || !ok {
+			return missing, err
+		}
+		for sourceOK && sourceKey < targetKey {
+			if sourceKey, sourceOK, err = sourceKeys.next(); err != nil {
+				return nil, err
+			}
+		}
+		if !sourceOK || sourceKey != targetKey {
+			missing = append(missing, targetKey)
+		}
+	}
+}
+
+// keyCursor reads the primary keys of a table in order.
+type keyCursor struct {
+	rows   *stdsql.Rows
+	column *schema.Column
+}
+
+func orderedKeys(ctx context.Context, tx *stdsql.Tx, driver string, table TableHeader) (*keyCursor, error) {
+	schemaTable, _, err := schemaTable(table.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(schemaTable.PrimaryKey) != 1 {
+		return nil, fmt.Errorf("table %s has no single primary key", table.Name)
+	}
+	key := schemaTable.PrimaryKey[0]
+	// Keys are compared as strings, in which UUIDs sort as they do in both databases.
+	if key.Type != field.TypeUUID && key.Type != field.TypeString {
+		return nil, fmt.Errorf("table %s has a primary key of type %s", table.Name, key.Type)
+	}
+	query, args := entsql.Dialect(driver).Select(key.Name).
+		From(entsql.Table(table.Name)).
+		OrderBy(key.Name).
+		Query()
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &keyCursor{rows: rows, column: key}, nil
+}
+
+func (c *keyCursor) next() (string, bool, error) {
+	if !c.rows.Next() {
+		return "", false, c.rows.Err()
+	}
+	var value any
+	if err := c.rows.Scan(&value); err != nil {
+		return "", false, err
+	}
+	normalized, err := normalizeValue(c.column, value)
+	if err != nil {
+		return "", false, err
+	}
+	key, ok := normalized.(string)
+	if !ok {
+		return "", false, fmt.Errorf("unexpected %T key", normalized)
+	}
+	return key, true, nil
+}
+
+func (c *keyCursor) close() {
+	_ = c.rows.Close()
+}
+
+// clearDeferred sets the deferred columns of the rows of the table with the keys to NULL.
+func clearDeferred(ctx context.Context, tx *stdsql.Tx, driver string, table TableHeader, keys []any) error {
+	if len(table.Deferred) == 0 {
+		return nil
+	}
+	schemaTable, _, err := schemaTable(table.Name)
+	if err != nil {
+		return err
+	}
+	for start := 0; start < len(keys); start += deleteBatchSize {
+		update := entsql.Dialect(driver).Update(table.Name)
+		for _, column := range table.Deferred {
+			update.SetNull(column)
+		}
+		batch := keys[start:min(start+deleteBatchSize, len(keys))]
+		query, args := update.Where(entsql.In(schemaTable.PrimaryKey[0].Name, batch...)).Query()
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to clear references of table %s: %w", table.Name, err)
+		}
+	}
+	return nil
+}
+
+// deleteRows deletes the rows of the table with the keys.
+func deleteRows(ctx context.Context, tx *stdsql.Tx, driver string, table TableHeader, keys []any) error {
+	schemaTable, _, err := schemaTable(table.Name)
+	if err != nil {
+		return err
+	}
+	for start := 0; start < len(keys); start += deleteBatchSize {
+		batch := keys[start:min(start+deleteBatchSize, len(keys))]
+		query, args := entsql.Dialect(driver).Delete(table.Name).
+			Where(entsql.In(schemaTable.PrimaryKey[0].Name, batch...)).
+			Query()
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to delete rows of table %s: %w", table.Name, err)
+		}
+	}
+	return nil
+}
+
+// checksumTable returns the number of rows of the table and a checksum of their values that does
+// not depend on their order or on the database they are read from.
+func checksumTable(ctx context.Context, tx *stdsql.Tx, driver string, table TableHeader) (int64, string, error) {
+	_, columns, err := schemaTable(table.Name)
+	if err != nil {
+		return 0, "", err
+	}
+	var checksum [sha256.Size]byte
+	count, err := scanTable(ctx, tx, driver, table, nil, func(values []any) error {
+		canonical := make([]any, len(values))
+		for i, value := range values {
+			if canonical[i], err = canonicalValue(columns[table.Columns[i]], value); err != nil {
+				return err
+			}
+		}
+		data, err := json.Marshal(canonical)
+		if err != nil {
+			return err
+		}
+		// Rows are unique by their primary key, so combining their hashes with XOR neither depends
+		// on their order nor cancels any of them out.
+		digest := sha256.Sum256(data)
+		for i := range checksum {
+			checksum[i] ^= digest[i]
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, "", err
+	}
+	return count, hex.EncodeToString(checksum[:]), nil
+}
+
+// canonicalValue converts a normalized value into the form it is checksummed in. Times are
+// truncated to the microseconds that Postgres keeps, and JSON is stripped of the formatting that
+// Postgres does not keep.
+func canonicalValue(column *schema.Column, value any) (any, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Truncate(time.Microsecond).Format(time.RFC3339Nano), nil
+	case string:
+		if column.Type != field.TypeJSON {
+			return v, nil
+		}
+		var decoded any
+		if err := json.Unmarshal([]byte(v), &decoded); err != nil {
+			return nil, fmt.Errorf("invalid JSON in column %s: %w", column.Name, err)
+		}
+		data, err := json.Marshal(decoded)
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+	return value, nil
+}
@@ -0,0 +1,125 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/lib/pq"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/stretchr/testify/require"
+)
+
+func copiedTable(t *testing.T, copied []CopiedTable, name string) CopiedTable {
+	for _, table := range copied {
+		if table.Name == name {
+			return table
+		}
+	}
+	t.Fatalf("table %s was not copied", name)
+	return CopiedTable{}
+}
+
+func TestCopy(t *testing.T) {
+	sourceDB, source := openTestDatabase(t, "copy_source")
+	populateTestDatabase(t, source)
+	targetDB, target := openTestDatabase(t, "copy_target")
+	testCopy(t, sourceDB, source, "sqlite3", targetDB, target, "sqlite3")
+}
+
+// testCopy copies the populated source into the empty target, then copies the changes since.
+func testCopy(t *testing.T, sourceDB *sql.DB, source *ent.Client, sourceDriver string, targetDB *sql.DB, target *ent.Client, targetDriver string) {
+	ctx := context.Background()
+	result, err := Copy(ctx, sourceDB, sourceDriver, targetDB, targetDriver, CopyOptions{})
+	require.NoError(t, err)
+	require.Equal(t, CopiedTable{Name: "tree_nodes", Copied: 2}, copiedTable(t, result.Tables, "tree_nodes"))
+	require.Equal(t, sourceDriver, result.Watermark.Driver)
+
+	keyshare, sourceKeyshare := target.SigningKeyshare.Query().OnlyX(ctx), source.SigningKeyshare.Query().OnlyX(ctx)
+	require.Equal(t, sourceKeyshare.ID, keyshare.ID)
+	require.Equal(t, sourceKeyshare.SecretShare, keyshare.SecretShare)
+	require.Equal(t, sourceKeyshare.Status, keyshare.Status)
+	tree := target.Tree.Query().WithRoot().OnlyX(ctx)
+	require.NotNil(t, tree.Edges.Root)
+	require.Equal(t, 1, target.TreeNode.Query().Where(treenode.HasParent()).CountX(ctx))
+
+	// A later copy only reads the rows written since, and the final one deletes the rows that are
+	// no longer in the source and checks every table.
+	source.SigningKeyshare.UpdateOneID(sourceKeyshare.ID).SetStatus(schema.KeyshareStatusAvailable).ExecX(ctx)
+	result, err = Copy(ctx, sourceDB, sourceDriver, targetDB, targetDriver, CopyOptions{Since: result.Watermark})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), copiedTable(t, result.Tables, "signing_keyshares").Copied)
+	require.Equal(t, schema.KeyshareStatusAvailable, target.SigningKeyshare.Query().OnlyX(ctx).Status)
+
+	source.TreeNode.Delete().Where(treenode.HasParent()).ExecX(ctx)
+	result, err = Copy(ctx, sourceDB, sourceDriver, targetDB, targetDriver, CopyOptions{Since: result.Watermark, Final: true})
+	require.NoError(t, err)
+	nodes := copiedTable(t, result.Tables, "tree_nodes")
+	require.Equal(t, int64(1), nodes.Deleted)
+	require.Equal(t, int64(1), nodes.Rows)
+	require.NotEmpty(t, nodes.Checksum)
+	require.Equal(t, 1, target.TreeNode.Query().CountX(ctx))
+}
+
+func TestCopyRejectsMismatches(t *testing.T) {
+	ctx := context.Background()
+	sourceDB, source := openTestDatabase(t, "copy_mismatch_source")
+	populateTestDatabase(t, source)
+	targetDB, target := openTestDatabase(t, "copy_mismatch_target")
+	_, err := Copy(ctx, sourceDB, "sqlite3", targetDB, "sqlite3", CopyOptions{})
+	require.NoError(t, err)
+
+	// Changes to the target of rows that the source did not write since are not copied over
+	// again, and are caught by the checksums.
+	keyshare := target.SigningKeyshare.Query().OnlyX(ctx)
+	target.SigningKeyshare.UpdateOne(keyshare).SetMinSigners(3).ExecX(ctx)
+	source.TreeNode.Delete().Where(treenode.HasParent()).ExecX(ctx)
+	since := &CopyWatermark{Driver: "sqlite3", Time: time.Now().Add(time.Hour)}
+	_, err = Copy(ctx, sourceDB, "sqlite3", targetDB, "sqlite3", CopyOptions{Since: since, Final: true})
+	require.ErrorContains(t, err, "table signing_keyshares has 1 rows with checksum")
+
+	// Nothing is written to the target by a copy that fails.
+	require.Equal(t, 2, target.TreeNode.Query().CountX(ctx))
+
+	// Watermarks only apply to the source they were taken from.
+	_, err = Copy(ctx, sourceDB, "sqlite3", targetDB, "sqlite3", CopyOptions{Since: &CopyWatermark{Driver: "postgres"}})
+	require.ErrorContains(t, err, "watermark is of a postgres database")
+}
+
+// TestCopyToPostgres copies from SQLite into the empty Postgres database at
+// SPARK_TEST_POSTGRES_URL, and back.
+func TestCopyToPostgres(t *testing.T) {
+	url := os.Getenv("SPARK_TEST_POSTGRES_URL")
+	if url == "" {
+		t.Skip("SPARK_TEST_POSTGRES_URL is not set")
+	}
+	ctx := context.Background()
+	postgresDB, err := sql.Open("postgres", url)
+	require.NoError(t, err)
+	t.Cleanup(func() { postgresDB.Close() })
+	postgres := ent.NewClient(ent.Driver(entsql.OpenDB("postgres", postgresDB)))
+	require.NoError(t, postgres.Schema.Create(ctx))
+
+	sourceDB, source := openTestDatabase(t, "copy_to_postgres_source")
+	populateTestDatabase(t, source)
+	testCopy(t, sourceDB, source, "sqlite3", postgresDB, postgres, "postgres")
+
+	// A Postgres source finds the rows of transactions that commit after the copy, whatever their
+	// update time.
+	result, err := Copy(ctx, postgresDB, "postgres", sourceDB, "sqlite3", CopyOptions{Final: true})
+	require.NoError(t, err)
+	keyshare := postgres.SigningKeyshare.Query().OnlyX(ctx)
+	postgres.SigningKeyshare.UpdateOne(keyshare).
+		SetMinSigners(3).
+		SetUpdateTime(time.Now().Add(-time.Hour)).
+		ExecX(ctx)
+	result, err = Copy(ctx, postgresDB, "postgres", sourceDB, "sqlite3", CopyOptions{Since: result.Watermark, Final: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), copiedTable(t, result.Tables, "signing_keyshares").Copied)
+	require.Equal(t, int32(3), source.SigningKeyshare.Query().OnlyX(ctx).MinSigners)
+}
@@ -0,0 +1,153 @@
+package backup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// chunkSize is the size of the plaintext of every chunk but the last.
+const chunkSize = 64 << 10
+
+// The chunk stream splits the body of a backup into chunks sealed with AES-256-GCM. Each chunk is
+// framed by a flag that is set on the last chunk and the length of its ciphertext. The nonce of a
+// chunk is its index and the flag, and the header of the backup is the additional data of every
+// chunk, so chunks cannot be reordered, dropped, truncated or moved to another backup.
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(index uint64, last bool) []byte {
+	nonce := make([]byte, 12)
+	if last {
+		nonce[0] = 1
+	}
+	binary.BigEndian.PutUint64(nonce[4:], index)
+	return nonce
+}
+
+// chunkWriter encrypts what is written to it into a chunk stream. Close must be called to write
+// the last chunk.
+type chunkWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	header []byte
+	buf    []byte
+	index  uint64
+}
+
+func newChunkWriter(w io.Writer, key []byte, header []byte) (*chunkWriter, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &chunkWriter{w: w, aead: aead, header: header, buf: make([]byte, 0, chunkSize)}, nil
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		// A full chunk is only sealed once more data follows, so that the last chunk is never
+		// empty unless the whole stream is.
+		if len(c.buf) == chunkSize {
+			if err := c.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := min(len(p), chunkSize-len(c.buf))
+		c.buf = append(c.buf, p[:n]...)
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (c *chunkWriter) Close() error {
+	return c.seal(true)
+}
+
+func (c *chunkWriter) seal(last bool) error {
+	sealed := c.aead.Seal(nil, chunkNonce(c.index, last), c.buf, c.header)
+	frame := make([]byte, 5)
+	if last {
+		frame[0] = 1
+	}
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(sealed)))
+	if _, err := c.w.Write(frame); err != nil {
+		return err
+	}
+	if _, err := c.w.Write(sealed); err != nil {
+		return err
+	}
+	c.index++
+	c.buf = c.buf[:0]
+	return nil
+}
+
+// chunkReader decrypts a chunk stream. It fails with io.ErrUnexpectedEOF if the stream ends before
+// its last chunk.
+type chunkReader struct {
+	r      io.Reader
+	aead   cipher.AEAD
+	header []byte
+	buf    []byte
+	index  uint64
+	done   bool
+}
+
+func newChunkReader(r io.Reader, key []byte, header []byte) (*chunkReader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &chunkReader{r: r, aead: aead, header: header}, nil
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+		if err := c.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+func (c *chunkReader) open() error {
+	frame := make([]byte, 5)
+	if _, err := io.ReadFull(c.r, frame); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	last := frame[0] == 1
+	length := binary.BigEndian.Uint32(frame[1:])
+	if frame[0] > 1 || length > chunkSize+uint32(c.aead.Overhead()) {
+		return fmt.Errorf("invalid chunk %d", c.index)
+	}
+	sealed := make([]byte, length)
+	if _, err := io.ReadFull(c.r, sealed); err != nil {
+		return err
+	}
+	plaintext, err := c.aead.Open(sealed[:0], chunkNonce(c.index, last), sealed, c.header)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt chunk %d: %w", c.index, err)
+	}
+	c.buf = plaintext
+	c.index++
+	c.done = last
+	return nil
+}
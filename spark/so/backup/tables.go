@@ -0,0 +1,229 @@
+package backup
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/migrate"
+)
+
+// TableHeader describes a table of a backup.
+type TableHeader struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	// Deferred are the columns that refer to rows that may only be restored after the row itself.
+	// They are restored as NULL, and set once all the rows are restored.
+	Deferred []string `json:"deferred,omitempty"`
+}
+
+// modeTables returns the tables of the database that are backed up in the mode.
+func modeTables(mode Mode) ([]*schema.Table, error) {
+	switch mode {
+	case ModeFull:
+		return migrate.Tables, nil
+	case ModeKeyshares:
+		return []*schema.Table{migrate.SigningKeysharesTable}, nil
+	default:
+		return nil, fmt.Errorf("unknown backup mode %q", mode)
+	}
+}
+
+// planTables orders the tables so that the rows of every table can be restored after those of the
+// tables it refers to. References that cannot be ordered, such as those of a table to itself, are
+// deferred; they must be nullable. References to tables outside of the given ones are dropped.
+func planTables(tables []*schema.Table) ([]TableHeader, error) {
+	included := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		included[table.Name] = true
+	}
+	remaining := slices.Clone(tables)
+	slices.SortFunc(remaining, func(a, b *schema.Table) int { return strings.Compare(a.Name, b.Name) })
+
+	planned := make(map[string]bool, len(tables))
+	var headers []TableHeader
+	for len(remaining) > 0 {
+		// Tables only waiting on nullable references are planned when nothing else can be.
+		next := -1
+		for _, strict := range []bool{true, false} {
+			for i, table := range remaining {
+				if tableReady(table, included, planned, strict) {
+					next = i
+					break
+				}
+			}
+			if next >= 0 {
+				break
+			}
+		}
+		if next < 0 {
+			return nil, fmt.Errorf("tables %s refer to each other with required columns", remaining[0].Name)
+		}
+		table := remaining[next]
+		remaining = slices.Delete(remaining, next, next+1)
+
+		header := TableHeader{Name: table.Name}
+		dropped := make(map[string]bool)
+		for _, fk := range table.ForeignKeys {
+			for _, column := range fk.Columns {
+				switch {
+				case !included[fk.RefTable.Name]:
+					if !column.Nullable {
+						return nil, fmt.Errorf("table %s requires table %s", table.Name, fk.RefTable.Name)
+					}
+					dropped[column.Name] = true
+				case !planned[fk.RefTable.Name]:
+					header.Deferred = append(header.Deferred, column.Name)
+				}
+			}
+		}
+		for _, column := range table.Columns {
+			if !dropped[column.Name] {
+				header.Columns = append(header.Columns, column.Name)
+			}
+		}
+		planned[table.Name] = true
+		headers = append(headers, header)
+	}
+	return headers, nil
+}
+
+// tableReady returns whether the rows of the table can be restored once the planned tables are.
+// Unless strict, nullable references to tables that are not planned yet can be deferred.
+func tableReady(table *schema.Table, included map[string]bool, planned map[string]bool, strict bool) bool {
+	for _, fk := range table.ForeignKeys {
+		ref := fk.RefTable.Name
+		if !included[ref] || planned[ref] {
+			continue
+		}
+		for _, column := range fk.Columns {
+			if strict || !column.Nullable {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// schemaTable returns the table of this binary's schema with the name, with its columns by name.
+func schemaTable(name string) (*schema.Table, map[string]*schema.Column, error) {
+	for _, table := range migrate.Tables {
+		if table.Name == name {
+			columns := make(map[string]*schema.Column, len(table.Columns))
+			for _, column := range table.Columns {
+				columns[column.Name] = column
+			}
+			return table, columns, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("table %s is not in the schema", name)
+}
+
+// normalizeValue converts a value scanned from a column into a value that is the same whichever
+// database it was read from, which is also its JSON representation in a backup.
+func normalizeValue(column *schema.Column, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch column.Type {
+	case field.TypeBytes:
+		switch v := value.(type) {
+		case []byte:
+			return v, nil
+		case string:
+			return []byte(v), nil
+		}
+	case field.TypeTime:
+		if v, ok := value.(time.Time); ok {
+			return v.UTC(), nil
+		}
+	case field.TypeUUID:
+		var id uuid.UUID
+		var err error
+		switch v := value.(type) {
+		case string:
+			id, err = uuid.Parse(v)
+		case []byte:
+			if len(v) == 16 {
+				id, err = uuid.FromBytes(v)
+			} else {
+				id, err = uuid.ParseBytes(v)
+			}
+		case [16]byte:
+			id = uuid.UUID(v)
+		default:
+			err = fmt.Errorf("unexpected %T value", value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in column %s: %w", column.Name, err)
+		}
+		return id.String(), nil
+	case field.TypeJSON:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case []byte:
+			return string(v), nil
+		default:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			return string(data), nil
+		}
+	case field.TypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			return v != 0, nil
+		}
+	case field.TypeString, field.TypeEnum:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case []byte:
+			return string(v), nil
+		}
+	default:
+		return value, nil
+	}
+	return nil, fmt.Errorf("unexpected %T value in column %s", value, column.Name)
+}
+
+// decodeValue converts the JSON representation of a value in a backup, decoded with UseNumber,
+// into a value to write to the column.
+func decodeValue(column *schema.Column, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if number, ok := value.(json.Number); ok {
+		switch column.Type {
+		case field.TypeFloat32, field.TypeFloat64:
+			return strconv.ParseFloat(string(number), 64)
+		}
+		if v, err := strconv.ParseInt(string(number), 10, 64); err == nil {
+			return v, nil
+		}
+		return strconv.ParseUint(string(number), 10, 64)
+	}
+	// Values that are already normalized are written as they are.
+	s, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+	switch column.Type {
+	case field.TypeBytes:
+		return base64.StdEncoding.DecodeString(s)
+	case field.TypeTime:
+		return time.Parse(time.RFC3339Nano, s)
+	}
+	return s, nil
+}
@@ -28,9 +28,41 @@ import (
 	"github.com/lightsparkdev/spark/so/lrc20"
 	events "github.com/lightsparkdev/spark/so/stream"
 	"github.com/lightsparkdev/spark/so/watchtower"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"google.golang.org/protobuf/proto"
 )
 
+var (
+	chainHeightLagGauge      metric.Int64Gauge
+	depositsConfirmedCounter metric.Int64Counter
+)
+
+func init() {
+	meter := otel.Meter("chain")
+	var err error
+	chainHeightLagGauge, err = meter.Int64Gauge(
+		"spark_chain_height_lag",
+		metric.WithDescription("Number of blocks the chain watcher has yet to process behind the tip of bitcoind"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	depositsConfirmedCounter, err = meter.Int64Counter(
+		"spark_deposits_confirmed",
+		metric.WithDescription("Number of deposits to deposit addresses confirmed on chain"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// recordChainHeightLag records how far the processed height of the network is behind its tip.
+func recordChainHeightLag(ctx context.Context, network common.Network, tipHeight int64, processedHeight int64) {
+	chainHeightLagGauge.Record(ctx, max(0, tipHeight-processedHeight), metric.WithAttributes(attribute.String("network", network.String())))
+}
+
 func pollInterval(network common.Network) time.Duration {
 	switch network {
 	case common.Mainnet:
@@ -148,6 +180,8 @@ func scanChainUpdates(
 		return fmt.Errorf("failed to get block hash: %v", err)
 	}
 
+	recordChainHeightLag(ctx, network, latestBlockHeight, dbBlockHeight.Height)
+
 	dbChainTip := NewTip(dbBlockHeight.Height, *dbBlockHash)
 	difference, err := findDifference(dbChainTip, latestChainTip, bitcoinClient)
 	if err != nil {
@@ -303,6 +337,7 @@ func connectBlocks(
 		if err != nil {
 			return err
 		}
+		recordChainHeightLag(ctx, network, chainTips[len(chainTips)-1].Height, chainTip.Height)
 	}
 	return nil
 }
@@ -479,6 +514,10 @@ func handleBlock(
 				return fmt.Errorf("unable to store a new utxo: %v", err)
 			}
 			logger.Debug("Stored an L1 utxo to a static deposit address", "address", address.Address, "txid", hex.EncodeToString(txidBytes), "amount", utxo.amount)
+			depositsConfirmedCounter.Add(ctx, 1, metric.WithAttributes(
+				attribute.String("network", network.String()),
+				attribute.Bool("static", true),
+			))
 		}
 	}
 
@@ -504,6 +543,10 @@ func handleBlock(
 		if err != nil {
 			return err
 		}
+		depositsConfirmedCounter.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("network", network.String()),
+			attribute.Bool("static", false),
+		))
 		signingKeyShare, err := deposit.QuerySigningKeyshare().Only(ctx)
 		if err != nil {
 			return err
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/XSAM/otelsql"
@@ -21,8 +23,11 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/lightsparkdev/spark"
 	"github.com/lightsparkdev/spark/common"
 	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so/authz"
+	"github.com/lightsparkdev/spark/so/keymanager"
 	"github.com/lightsparkdev/spark/so/middleware"
 	"github.com/lightsparkdev/spark/so/utils"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
@@ -52,7 +57,8 @@ type Config struct {
 	DKGCoordinatorAddress string
 	// SupportedNetworks is the list of networks supported by the signing operator.
 	SupportedNetworks []common.Network
-	// BitcoindConfigs are the configurations for different bitcoin nodes.
+	// BitcoindConfigs are the configurations for different bitcoin nodes on startup. The current
+	// ones are those of Reloadable.
 	BitcoindConfigs map[string]BitcoindConfig
 	// AWS determines if the database is in AWS RDS.
 	AWS bool
@@ -61,7 +67,8 @@ type Config struct {
 	// ServerKeyPath is the path to the server key.
 	ServerKeyPath string
 	// Lrc20Configs are the configurations for different LRC20 nodes and
-	// token transaction withdrawal parameters.
+	// token transaction withdrawal parameters on startup. The current ones are returned by
+	// Lrc20Config.
 	Lrc20Configs map[string]Lrc20Config
 	// DKGLimitOverride is the override for the DKG limit.
 	DKGLimitOverride uint64
@@ -69,13 +76,53 @@ type Config struct {
 	RunDirectory string
 	// If true, return the details of the panic to the client instead of just 'Internal Server Error'
 	ReturnDetailedPanicErrors bool
-	// RateLimiter is the configuration for the rate limiter
+	// RateLimiter is the configuration for the rate limiter on startup. The current one is
+	// returned by GetRateLimiterConfig.
 	RateLimiter RateLimiterConfig
-	// Tracing configuration
+	// Tracing configuration on startup. The current one is that of Reloadable.
 	Tracing common.TracingConfig
 	// TokenTransactionExpiryDuration is the duration after which started token transactions expire
 	// after which the tx will be cancelled and the input TTXOs will be reset to a spendable state.
 	TokenTransactionExpiryDuration time.Duration
+	// Encryption is the configuration for encrypting signing keyshares and nonces at rest.
+	Encryption keymanager.Config
+	// OperatorSetEpoch is the epoch of the operator set in SigningOperatorMap. New keyshares are
+	// generated for this epoch.
+	OperatorSetEpoch uint64
+	// PreviousOperatorSet is the operator set keyshares are being reshared from, if a resharing to
+	// the current operator set is in progress.
+	PreviousOperatorSet *OperatorSet
+	// ConsistencyAudit is the configuration for auditing the state of this operator against the
+	// other operators.
+	ConsistencyAudit ConsistencyAuditConfig
+	// AuthorizationPolicy is the policy RPCs are authorized against. Nil allows every RPC.
+	AuthorizationPolicy *authz.Policy
+	// Quotas is the configuration for the per-identity limits on keyshares held in addresses.
+	Quotas QuotaConfig
+	// ReadReplica is the configuration for the read replica of the database.
+	ReadReplica ReadReplicaConfig
+	// Retention is the configuration for archiving finished transfers and spent token outputs.
+	Retention RetentionConfig
+	// OperatorAuthentication is the configuration for the authentication of the internal calls of
+	// the other operators.
+	OperatorAuthentication OperatorAuthenticationConfig
+	// reloadable is the part of the configuration that is reloaded while the operator runs. It
+	// is nil for configs that were not created by NewConfig, which only have their fields.
+	reloadable *atomic.Pointer[ReloadableConfig]
+}
+
+// OperatorSet is a set of signing operators that hold keyshares together.
+type OperatorSet struct {
+	// Epoch is the epoch of the operator set.
+	Epoch uint64
+	// Threshold is the threshold of the operator set.
+	Threshold uint64
+	// SigningOperatorMap is the map of signing operators in the set.
+	SigningOperatorMap map[string]*SigningOperator
+	// AllowJoiningOperators allows resharing keyshares to operators of the current set that are not
+	// in this one. Keyshares are all a reshare gives them, so they must hold the trees, transfers
+	// and deposit addresses of the other operators before it is set.
+	AllowJoiningOperators bool
 }
 
 // DatabaseDriver returns the database driver based on the database path.
@@ -94,6 +141,149 @@ type OperatorConfig struct {
 	Lrc20 map[string]Lrc20Config `yaml:"lrc20"`
 	// Tracing is the configuration for tracing
 	Tracing common.TracingConfig `yaml:"tracing"`
+	// Encryption is the configuration for encrypting signing keyshares and nonces at rest
+	Encryption keymanager.Config `yaml:"encryption"`
+	// OperatorSet is the configuration for the epoch of the operator set
+	OperatorSet OperatorSetConfig `yaml:"operator_set"`
+	// ConsistencyAudit is the configuration for the cross-operator consistency audit
+	ConsistencyAudit ConsistencyAuditConfig `yaml:"consistency_audit"`
+	// Authorization is the configuration for the authorization policy of RPCs
+	Authorization authz.PolicyConfig `yaml:"authorization"`
+	// Quotas is the configuration for the per-identity limits on keyshares held in addresses
+	Quotas QuotaConfig `yaml:"quotas"`
+	// ReadReplica is the configuration for the read replica of the database
+	ReadReplica ReadReplicaConfig `yaml:"read_replica"`
+	// Retention is the configuration for archiving finished transfers and spent token outputs
+	Retention RetentionConfig `yaml:"retention"`
+	// RateLimiter is the configuration for the rate limiter, which replaces the one of the flags
+	// when it is set, so that its limits can be reloaded
+	RateLimiter *RateLimiterConfig `yaml:"rate_limiter"`
+	// OperatorAuthentication is the configuration for the authentication of the internal calls of
+	// the other operators
+	OperatorAuthentication OperatorAuthenticationConfig `yaml:"operator_authentication"`
+}
+
+// OperatorAuthenticationConfig is the configuration for the authentication of internal calls
+// between operators, which are signed with the identity key of the calling operator.
+type OperatorAuthenticationConfig struct {
+	// Permissive lets unsigned internal calls through, with a warning, while operators that do not
+	// sign their calls yet are upgraded. Calls with invalid signatures are always rejected.
+	Permissive bool `yaml:"permissive"`
+	// MaxClockSkew is how far the time a call was signed at may be from the time it is received at.
+	MaxClockSkew time.Duration `yaml:"max_clock_skew"`
+}
+
+// MaxCallClockSkew returns how far the time a call was signed at may be from the time it is
+// received at.
+func (c OperatorAuthenticationConfig) MaxCallClockSkew() time.Duration {
+	if c.MaxClockSkew <= 0 {
+		return spark.DefaultOperatorCallMaxClockSkew
+	}
+	return c.MaxClockSkew
+}
+
+// QuotaConfig is the configuration for the per-identity limits on keyshares held in addresses.
+// Zero values use the defaults, and negative values disable the limit.
+type QuotaConfig struct {
+	// MaxUnusedDepositAddresses is the number of deposit addresses an identity can hold that have
+	// not received funds yet
+	MaxUnusedDepositAddresses int `yaml:"max_unused_deposit_addresses"`
+	// MaxPendingTreeAddresses is the number of tree addresses an identity can hold that have not
+	// been used to create a tree yet
+	MaxPendingTreeAddresses int `yaml:"max_pending_tree_addresses"`
+	// DepositAddressExpiry is the time after which the keyshare of a deposit address that has not
+	// received funds is recycled, after spark.DepositAddressRecycleGracePeriod. The address counts
+	// against MaxUnusedDepositAddresses until then
+	DepositAddressExpiry time.Duration `yaml:"deposit_address_expiry"`
+}
+
+// UnusedDepositAddressLimit returns the number of unused deposit addresses an identity can hold,
+// or 0 if there is no limit.
+func (c QuotaConfig) UnusedDepositAddressLimit() int {
+	return quotaOrDefault(c.MaxUnusedDepositAddresses, spark.DefaultMaxUnusedDepositAddresses)
+}
+
+// PendingTreeAddressLimit returns the number of pending tree addresses an identity can hold, or 0
+// if there is no limit.
+func (c QuotaConfig) PendingTreeAddressLimit() int {
+	return quotaOrDefault(c.MaxPendingTreeAddresses, spark.DefaultMaxPendingTreeAddresses)
+}
+
+// AddressExpiry returns the time after which unused deposit addresses expire, or 0 if they never
+// expire.
+func (c QuotaConfig) AddressExpiry() time.Duration {
+	return quotaOrDefault(c.DepositAddressExpiry, spark.DefaultDepositAddressExpiry)
+}
+
+func quotaOrDefault[T int | time.Duration](value, defaultValue T) T {
+	switch {
+	case value < 0:
+		return 0
+	case value == 0:
+		return defaultValue
+	default:
+		return value
+	}
+}
+
+// ReadReplicaConfig is the configuration for the read replica of the database, which serves the
+// read-only RPCs.
+type ReadReplicaConfig struct {
+	// DatabasePath is the path to the replica. Without it, every RPC uses the primary database.
+	DatabasePath string `yaml:"database_path"`
+	// MaxLag is how far the replica may lag behind the primary before reads go to the primary.
+	MaxLag time.Duration `yaml:"max_lag"`
+}
+
+// Enabled returns whether read-only RPCs are served by a read replica.
+func (c ReadReplicaConfig) Enabled() bool {
+	return c.DatabasePath != ""
+}
+
+// MaxReplicaLag returns how far the replica may lag behind the primary before reads go to the
+// primary.
+func (c ReadReplicaConfig) MaxReplicaLag() time.Duration {
+	if c.MaxLag <= 0 {
+		return spark.DefaultReadReplicaMaxLag
+	}
+	return c.MaxLag
+}
+
+// RetentionConfig is the configuration for archiving finished transfers and spent token outputs
+// into compressed archives in the database. Nothing is archived by default.
+type RetentionConfig struct {
+	// TransferRetention is how long finished transfers stay in the database before they are
+	// archived. Zero never archives them.
+	TransferRetention time.Duration `yaml:"transfer_retention"`
+	// TokenOutputRetention is how long spent token outputs keep their spend signatures in the
+	// database before they are archived. Zero never archives them.
+	TokenOutputRetention time.Duration `yaml:"token_output_retention"`
+}
+
+// ConsistencyAuditConfig is the configuration for the cross-operator consistency audit.
+type ConsistencyAuditConfig struct {
+	// Repair makes the audit overwrite local state that disagrees with the majority of operators
+	Repair bool `yaml:"repair"`
+}
+
+// OperatorSetConfig is the configuration for the epoch of the operator set.
+type OperatorSetConfig struct {
+	// Epoch is the epoch of the operator set in the operators file
+	Epoch uint64 `yaml:"epoch"`
+	// Previous is the operator set keyshares are being reshared from, if any
+	Previous *PreviousOperatorSetConfig `yaml:"previous"`
+}
+
+// PreviousOperatorSetConfig is the configuration for the operator set keyshares are being reshared from.
+type PreviousOperatorSetConfig struct {
+	// Epoch is the epoch of the previous operator set, which must be lower than the current one
+	Epoch uint64 `yaml:"epoch"`
+	// OperatorsFile is the path to the operators file of the previous operator set, relative to the run directory
+	OperatorsFile string `yaml:"operators_file"`
+	// Threshold is the threshold of the previous operator set
+	Threshold uint64 `yaml:"threshold"`
+	// AllowJoiningOperators allows resharing to operators that are not in the previous operator set
+	AllowJoiningOperators bool `yaml:"allow_joining_operators"`
 }
 
 // BitcoindConfig is the configuration for a bitcoind node.
@@ -134,10 +324,18 @@ type RateLimiterConfig struct {
 	Window time.Duration `yaml:"window"`
 	// MaxRequests is the maximum number of requests allowed in the window
 	MaxRequests int `yaml:"max_requests"`
-	// Methods is a list of methods to rate limit
-	// Note: This does not set up rate limiting across methods by IP,
-	// nor does it provide configuration for custom per-method rate limiting.
+	// Methods is a list of methods to rate limit to MaxRequests per Window
 	Methods []string `yaml:"methods"`
+	// MethodLimits are the methods with a limit of their own
+	MethodLimits map[string]middleware.RateLimit `yaml:"method_limits"`
+	// MaxStreams is the maximum number of concurrent event streams per client, 0 for no limit
+	MaxStreams int `yaml:"max_streams"`
+	// Store is the kind of store requests are counted in: memory, memcached or redis
+	Store string `yaml:"store"`
+	// MemcachedAddrs are the addresses of the memcached servers of the memcached store
+	MemcachedAddrs []string `yaml:"memcached_addrs"`
+	// RedisAddr is the address of the Redis server of the redis store
+	RedisAddr string `yaml:"redis_addr"`
 }
 
 // NewConfig creates a new config for the signing operator.
@@ -186,11 +384,32 @@ func NewConfig(
 
 	identifier := utils.IndexToIdentifier(index)
 
+	authorizationPolicy, err := authz.NewPolicy(operatorConfig.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	previousOperatorSet, err := loadPreviousOperatorSet(operatorConfig.OperatorSet, runDirectory)
+	if err != nil {
+		return nil, err
+	}
+
 	if dkgCoordinatorAddress == "" {
-		dkgCoordinatorAddress = signingOperatorMap[identifier].Address
+		operator, ok := signingOperatorMap[identifier]
+		if !ok && previousOperatorSet != nil {
+			operator, ok = previousOperatorSet.SigningOperatorMap[identifier]
+		}
+		if !ok {
+			return nil, fmt.Errorf("operator %s is not in the operator set", identifier)
+		}
+		dkgCoordinatorAddress = operator.Address
+	}
+
+	if operatorConfig.RateLimiter != nil {
+		rateLimiter = *operatorConfig.RateLimiter
 	}
 
-	return &Config{
+	config := &Config{
 		Index:                     index,
 		Identifier:                identifier,
 		IdentityPrivateKey:        identityPrivateKeyBytes,
@@ -211,9 +430,76 @@ func NewConfig(
 		ReturnDetailedPanicErrors: returnDetailedPanicErrors,
 		RateLimiter:               rateLimiter,
 		Tracing:                   operatorConfig.Tracing,
+		Encryption:                operatorConfig.Encryption,
+		OperatorSetEpoch:          operatorConfig.OperatorSet.Epoch,
+		PreviousOperatorSet:       previousOperatorSet,
+		ConsistencyAudit:          operatorConfig.ConsistencyAudit,
+		AuthorizationPolicy:       authorizationPolicy,
+		Quotas:                    operatorConfig.Quotas,
+		ReadReplica:               operatorConfig.ReadReplica,
+		Retention:                 operatorConfig.Retention,
+		OperatorAuthentication:    operatorConfig.OperatorAuthentication,
+		reloadable:                &atomic.Pointer[ReloadableConfig]{},
+	}
+	config.reloadable.Store(&ReloadableConfig{
+		Version:         1,
+		RateLimiter:     config.RateLimiter,
+		Lrc20Configs:    config.Lrc20Configs,
+		BitcoindConfigs: config.BitcoindConfigs,
+		Tracing:         config.Tracing,
+	})
+	return config, nil
+}
+
+func loadPreviousOperatorSet(config OperatorSetConfig, runDirectory string) (*OperatorSet, error) {
+	previous := config.Previous
+	if previous == nil {
+		return nil, nil
+	}
+	if previous.Epoch >= config.Epoch {
+		return nil, fmt.Errorf("previous operator set epoch %d must be lower than epoch %d", previous.Epoch, config.Epoch)
+	}
+	if previous.Threshold == 0 {
+		return nil, fmt.Errorf("previous operator set threshold must be set")
+	}
+	operatorsFile := previous.OperatorsFile
+	if !filepath.IsAbs(operatorsFile) {
+		operatorsFile = filepath.Join(runDirectory, operatorsFile)
+	}
+	operators, err := LoadOperators(operatorsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load previous operator set: %w", err)
+	}
+	if uint64(len(operators)) < previous.Threshold {
+		return nil, fmt.Errorf("previous operator set has %d operators, fewer than its threshold %d", len(operators), previous.Threshold)
+	}
+	return &OperatorSet{
+		Epoch:                 previous.Epoch,
+		Threshold:             previous.Threshold,
+		SigningOperatorMap:    operators,
+		AllowJoiningOperators: previous.AllowJoiningOperators,
 	}, nil
 }
 
+// InOperatorSet returns whether this operator is in the current operator set.
+func (c *Config) InOperatorSet() bool {
+	_, ok := c.SigningOperatorMap[c.Identifier]
+	return ok
+}
+
+// SigningOperator returns the signing operator with the given identifier from the current
+// operator set or, while a resharing is in progress, from the previous one.
+func (c *Config) SigningOperator(identifier string) (*SigningOperator, bool) {
+	if operator, ok := c.SigningOperatorMap[identifier]; ok {
+		return operator, true
+	}
+	if c.PreviousOperatorSet != nil {
+		operator, ok := c.PreviousOperatorSet.SigningOperatorMap[identifier]
+		return operator, ok
+	}
+	return nil, false
+}
+
 func (c *Config) IsNetworkSupported(network common.Network) bool {
 	for _, supportedNetwork := range c.SupportedNetworks {
 		if supportedNetwork == network {
@@ -399,13 +685,25 @@ func (c *Config) AuthzEnforced() bool {
 }
 
 func (c *Config) IdentityPublicKey() []byte {
-	return c.SigningOperatorMap[c.Identifier].IdentityPublicKey
+	operator, ok := c.SigningOperator(c.Identifier)
+	if !ok {
+		return nil
+	}
+	return operator.IdentityPublicKey
 }
 
+// GetRateLimiterConfig returns the current configuration of the rate limiter.
 func (c *Config) GetRateLimiterConfig() *middleware.RateLimiterConfig {
+	rateLimiter := c.Reloadable().RateLimiter
 	return &middleware.RateLimiterConfig{
-		Window:      c.RateLimiter.Window,
-		MaxRequests: c.RateLimiter.MaxRequests,
-		Methods:     c.RateLimiter.Methods,
+		Window:         rateLimiter.Window,
+		MaxRequests:    rateLimiter.MaxRequests,
+		Methods:        rateLimiter.Methods,
+		MethodLimits:   rateLimiter.MethodLimits,
+		StreamMethods:  []string{pb.SparkService_SubscribeToEvents_FullMethodName},
+		MaxStreams:     rateLimiter.MaxStreams,
+		Store:          rateLimiter.Store,
+		MemcachedAddrs: rateLimiter.MemcachedAddrs,
+		RedisAddr:      rateLimiter.RedisAddr,
 	}
 }
@@ -0,0 +1,104 @@
+package dkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightsparkdev/spark/common/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// commitAttempts is how many times the coordinator tries to commit a prepared share refresh or
+// reshare on each participant.
+const commitAttempts = 3
+
+// participantCommit is how the coordinator of a prepared share refresh or reshare commits,
+// completes and aborts it on the participants.
+//
+// The coordinator commits first and completes last, so that its own state tells whether the
+// request has to be committed on the other participants or aborted once it is interrupted.
+type participantCommit struct {
+	// name is the name of the request in errors and logs.
+	name      string
+	requestID string
+	commit    func(ctx context.Context, identifier string) error
+	complete  func(ctx context.Context, identifier string) error
+	abort     func(ctx context.Context, identifier string) error
+	// optional are the participants the request does not wait for. Their failures are only
+	// logged.
+	optional map[string]bool
+}
+
+// commitAll commits the request on the participants in order, the coordinator first, and
+// completes it on all of them once it is committed everywhere, the coordinator last.
+//
+// The request is aborted if the coordinator fails to commit it, or if a participant no longer has
+// it prepared. Otherwise it stays committed on the coordinator when other participants fail, so
+// that the next run commits it on them.
+func (c *participantCommit) commitAll(ctx context.Context, participants []string) error {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	failedOperators := make([]string, 0)
+	for i, identifier := range participants {
+		var err error
+		for attempt := 1; attempt <= commitAttempts; attempt++ {
+			err = c.commit(ctx, identifier)
+			if err == nil || status.Code(err) == codes.NotFound || status.Code(err) == codes.FailedPrecondition {
+				break
+			}
+			logger.Error("Failed to commit "+c.name, "request_id", c.requestID, "operator", identifier, "attempt", attempt, "error", err)
+		}
+		if err == nil {
+			continue
+		}
+		if c.optional[identifier] {
+			logger.Error("Optional participant did not commit "+c.name, "request_id", c.requestID, "operator", identifier, "error", err)
+			continue
+		}
+		if i == 0 || status.Code(err) == codes.NotFound || status.Code(err) == codes.FailedPrecondition {
+			err = fmt.Errorf("failed to commit %s %s on operator %s: %w", c.name, c.requestID, identifier, err)
+			return errors.Join(err, c.abortAll(ctx, participants))
+		}
+		failedOperators = append(failedOperators, identifier)
+	}
+	if len(failedOperators) > 0 {
+		return fmt.Errorf("%s %s was not committed on operators %v", c.name, c.requestID, failedOperators)
+	}
+
+	for i := len(participants) - 1; i >= 0; i-- {
+		if err := c.complete(ctx, participants[i]); err != nil {
+			if c.optional[participants[i]] {
+				logger.Error("Optional participant did not complete "+c.name, "request_id", c.requestID, "operator", participants[i], "error", err)
+				continue
+			}
+			return fmt.Errorf("failed to complete %s %s on operator %s: %w", c.name, c.requestID, participants[i], err)
+		}
+	}
+	return nil
+}
+
+// abortAll aborts the request on the participants, rolling it back where it was committed. The
+// coordinator aborts it last, and only once every other participant did, so that the next run
+// aborts it again otherwise.
+func (c *participantCommit) abortAll(ctx context.Context, participants []string) error {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	failedOperators := make([]string, 0)
+	for _, identifier := range participants[1:] {
+		if err := c.abort(ctx, identifier); err != nil {
+			logger.Error("Failed to abort "+c.name, "request_id", c.requestID, "operator", identifier, "error", err)
+			if !c.optional[identifier] {
+				failedOperators = append(failedOperators, identifier)
+			}
+		}
+	}
+	if len(failedOperators) > 0 {
+		return fmt.Errorf("%s %s was not aborted on operators %v", c.name, c.requestID, failedOperators)
+	}
+	if err := c.abort(ctx, participants[0]); err != nil {
+		return fmt.Errorf("failed to abort %s %s on operator %s: %w", c.name, c.requestID, participants[0], err)
+	}
+	return nil
+}
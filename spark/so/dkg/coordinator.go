@@ -2,22 +2,170 @@ package dkg
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/common/logging"
 	pbcommon "github.com/lightsparkdev/spark/proto/common"
 	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
 	"github.com/lightsparkdev/spark/so"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
+// dkgSessionPollInterval is how often the coordinator checks whether the participants completed a
+// DKG session.
+const dkgSessionPollInterval = time.Second
+
+var (
+	dkgBatchDuration      metric.Float64Histogram
+	dkgKeysGeneratedCount metric.Int64Counter
+	dkgBlamesCounter      metric.Int64Counter
+	dkgBlameAlerts        metric.Int64Counter
+)
+
+func init() {
+	meter := otel.Meter("dkg")
+	var err error
+	dkgBatchDuration, err = meter.Float64Histogram(
+		"spark_dkg_batch_duration",
+		metric.WithDescription("Duration of generating a batch of keys with DKG, including retried sessions"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	dkgKeysGeneratedCount, err = meter.Int64Counter(
+		"spark_dkg_keys_generated",
+		metric.WithDescription("Number of keys generated by DKG batches this operator coordinated"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	dkgBlamesCounter, err = meter.Int64Counter(
+		"spark_dkg_blames",
+		metric.WithDescription("Number of DKG sessions coordinated by this operator that failed blaming an operator, by operator"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	dkgBlameAlerts, err = meter.Int64Counter(
+		"spark_dkg_blame_alerts",
+		metric.WithDescription("Number of times an operator was blamed for too many DKG sessions in a row"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// dkgBlameTracker counts the DKG sessions each operator was blamed for since the last session that
+// succeeded.
+type dkgBlameTracker struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// dkgBlames tracks the operators blamed for the DKG sessions this operator coordinates.
+var dkgBlames = &dkgBlameTracker{counts: make(map[string]int)}
+
+// record records the outcome of a session, and returns the operators blamed for
+// spark.DKGBlameAlertThreshold sessions or more in a row, with how many.
+func (t *dkgBlameTracker) record(succeeded bool, blame map[string]string) map[string]int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if succeeded {
+		clear(t.counts)
+		return nil
+	}
+	alerts := make(map[string]int)
+	for identifier := range blame {
+		t.counts[identifier]++
+		if t.counts[identifier] >= spark.DKGBlameAlertThreshold {
+			alerts[identifier] = t.counts[identifier]
+		}
+	}
+	return alerts
+}
+
 // GenerateKeys runs the DKG protocol to generate the keys.
-func GenerateKeys(ctx context.Context, config *so.Config, keyCount uint64) error {
+//
+// Every signing operator participates, as keys held by only some of them could not be used by the
+// others, so operators blamed for a failed session cannot be left out of the next one. Instead,
+// failed sessions are retried with a new session, in which every participant draws fresh
+// randomness, up to spark.DKGMaxAttempts times in total. That recovers from operators that
+// misbehaved once; operators blamed for spark.DKGBlameAlertThreshold sessions in a row are
+// alerted on, as keys cannot be generated until they are fixed.
+func GenerateKeys(ctx context.Context, config *so.Config, keyCount uint64) (err error) {
+	start := time.Now()
+	defer func() {
+		result := "success"
+		if err != nil {
+			result = "failure"
+		} else {
+			dkgKeysGeneratedCount.Add(ctx, int64(keyCount))
+		}
+		dkgBatchDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attribute.String("result", result)))
+	}()
+
+	participants := sortedKeys(config.SigningOperatorMap)
+	return retryDkgSessions(ctx, dkgBlames, participants, func(ctx context.Context) (map[string]string, error) {
+		return runDkgSession(ctx, config, participants, keyCount)
+	})
+}
+
+// retryDkgSessions runs DKG sessions until one succeeds, up to spark.DKGMaxAttempts times, and
+// records the operators blamed for the failed ones.
+func retryDkgSessions(ctx context.Context, blames *dkgBlameTracker, participants []string, runSession func(context.Context) (map[string]string, error)) error {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	var err error
+	attempt := 0
+	blamed := make(map[string]string)
+	for attempt < spark.DKGMaxAttempts {
+		attempt++
+		var blame map[string]string
+		blame, err = runSession(ctx)
+		alerts := blames.record(err == nil, blame)
+		if err == nil {
+			return nil
+		}
+		logger.Error("DKG session failed", "attempt", attempt, "participants", participants, "blame", blame, "error", err)
+		for identifier, reason := range blame {
+			blamed[identifier] = reason
+			dkgBlamesCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("operator", identifier)))
+		}
+		for _, identifier := range sortedKeys(alerts) {
+			dkgBlameAlerts.Add(ctx, 1, metric.WithAttributes(attribute.String("operator", identifier)))
+			logger.Error("Operator keeps being blamed for failing DKG sessions, no keys can be generated until it is fixed",
+				"operator", identifier,
+				"sessions", alerts[identifier],
+				"reason", blame[identifier])
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if len(blamed) > 0 {
+		return fmt.Errorf("dkg failed after %d attempts, blaming operators %v: %w", attempt, blamed, err)
+	}
+	return fmt.Errorf("dkg failed after %d attempts: %w", attempt, err)
+}
+
+// runDkgSession runs one DKG session with the given participants. If it fails, it aborts the
+// session on every participant and returns the participants blamed for the failure, with the
+// reason, if any.
+func runDkgSession(ctx context.Context, config *so.Config, participants []string, keyCount uint64) (map[string]string, error) {
 	// Init clients
 	clientMap := make(map[string]pbdkg.DKGServiceClient)
-	for identifier, operator := range config.SigningOperatorMap {
-		connection, err := operator.NewGRPCConnection()
+	for _, identifier := range participants {
+		connection, err := config.NewOperatorConnection(config.SigningOperatorMap[identifier])
 		if err != nil {
-			return err
+			return nil, err
 		}
 		defer connection.Close()
 		client := pbdkg.NewDKGServiceClient(connection)
@@ -27,23 +175,39 @@ func GenerateKeys(ctx context.Context, config *so.Config, keyCount uint64) error
 	// Initiate DKG
 	requestID, err := uuid.NewV7()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	requestIDString := requestID.String()
+
+	blame, err := runDkgRounds(ctx, config, clientMap, requestIDString, participants, keyCount)
+	if err != nil {
+		abortDkgSession(ctx, clientMap, requestIDString, err.Error())
+		return blame, err
+	}
+	return nil, nil
+}
+
+func runDkgRounds(ctx context.Context, config *so.Config, clientMap map[string]pbdkg.DKGServiceClient, requestID string, participants []string, keyCount uint64) (map[string]string, error) {
 	initRequest := &pbdkg.InitiateDkgRequest{
-		RequestId:        requestIDString,
-		KeyCount:         keyCount,
-		MinSigners:       config.Threshold,
-		MaxSigners:       uint64(len(config.SigningOperatorMap)),
-		CoordinatorIndex: config.Index,
+		RequestId:              requestID,
+		KeyCount:               keyCount,
+		MinSigners:             config.Threshold,
+		MaxSigners:             uint64(len(participants)),
+		CoordinatorIndex:       config.Index,
+		ParticipantIdentifiers: participants,
 	}
 
 	round1Packages := make([]*pbcommon.PackageMap, int(keyCount))
 
-	for _, client := range clientMap {
-		round1Response, err := client.InitiateDkg(ctx, initRequest)
+	roundCtx, cancel := context.WithTimeout(ctx, spark.DKGRoundTimeout)
+	defer cancel()
+	for identifier, client := range clientMap {
+		round1Response, err := client.InitiateDkg(roundCtx, initRequest)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("failed to initiate dkg on operator %s: %w", identifier, err)
+		}
+		if round1Response.Identifier != identifier || len(round1Response.Round1Package) != int(keyCount) {
+			return map[string]string{identifier: "invalid round 1 package"}, fmt.Errorf("invalid round 1 package from operator %s", identifier)
 		}
 		for i, p := range round1Response.Round1Package {
 			if round1Packages[i] == nil {
@@ -58,41 +222,110 @@ func GenerateKeys(ctx context.Context, config *so.Config, keyCount uint64) error
 	// Round 1 Validation
 	round1Signatures := make(map[string][]byte)
 
-	for _, client := range clientMap {
+	roundCtx, cancel = context.WithTimeout(ctx, spark.DKGRoundTimeout)
+	defer cancel()
+	for identifier, client := range clientMap {
 		round1SignatureRequest := &pbdkg.Round1PackagesRequest{
-			RequestId:      requestIDString,
+			RequestId:      requestID,
 			Round1Packages: round1Packages,
 		}
-		round1SignatureResponse, err := client.Round1Packages(ctx, round1SignatureRequest)
+		round1SignatureResponse, err := client.Round1Packages(roundCtx, round1SignatureRequest)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("failed to deliver round 1 packages to operator %s: %w", identifier, err)
 		}
 		round1Signatures[round1SignatureResponse.Identifier] = round1SignatureResponse.Round1Signature
 	}
 
-	wg := sync.WaitGroup{}
-
 	// Round 1 Signature Delivery
-	for _, client := range clientMap {
+	roundCtx, cancel = context.WithTimeout(ctx, spark.DKGRoundTimeout)
+	defer cancel()
+	var mu sync.Mutex
+	blame := make(map[string]string)
+	errs := make(map[string]error)
+	wg := sync.WaitGroup{}
+	for identifier, client := range clientMap {
 		wg.Add(1)
-		go func(client pbdkg.DKGServiceClient) {
+		go func(identifier string, client pbdkg.DKGServiceClient) {
 			defer wg.Done()
 			round1SignatureRequest := &pbdkg.Round1SignatureRequest{
-				RequestId:        requestIDString,
+				RequestId:        requestID,
 				Round1Signatures: round1Signatures,
 			}
-			round1SignatureResponse, err := client.Round1Signature(ctx, round1SignatureRequest)
+			round1SignatureResponse, err := client.Round1Signature(roundCtx, round1SignatureRequest)
+
+			mu.Lock()
+			defer mu.Unlock()
 			if err != nil {
+				errs[identifier] = err
 				return
 			}
-
-			if len(round1SignatureResponse.ValidationFailures) > 0 {
-				return
+			for _, failure := range round1SignatureResponse.ValidationFailures {
+				blame[failure] = fmt.Sprintf("invalid round 1 signature reported by %s", identifier)
 			}
-		}(client)
+		}(identifier, client)
 	}
 
 	wg.Wait()
 
-	return nil
+	if len(blame) > 0 {
+		return blame, fmt.Errorf("invalid round 1 signatures from operators %v", sortedKeys(blame))
+	}
+
+	// Round 2 packages are exchanged between the participants directly, so wait for all of them to
+	// complete the session, or for one of them to abort it.
+	deadline := time.Now().Add(2 * spark.DKGRoundTimeout)
+	pending := make(map[string]pbdkg.DKGServiceClient, len(clientMap))
+	for identifier, client := range clientMap {
+		pending[identifier] = client
+	}
+	for {
+		for identifier, client := range pending {
+			session, err := client.GetDkgSession(ctx, &pbdkg.GetDkgSessionRequest{RequestId: requestID})
+			if err != nil {
+				errs[identifier] = err
+				continue
+			}
+			switch session.Status {
+			case pbdkg.DkgSessionStatus_DKG_SESSION_STATUS_COMPLETED:
+				delete(pending, identifier)
+			case pbdkg.DkgSessionStatus_DKG_SESSION_STATUS_ABORTED:
+				for _, complaint := range session.Complaints {
+					blame[complaint.AccusedIdentifier] = fmt.Sprintf("%s, reported by %s", complaint.Reason, identifier)
+				}
+				return blame, fmt.Errorf("dkg aborted by operator %s: %s", identifier, session.AbortReason)
+			}
+		}
+		if len(pending) == 0 {
+			return nil, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("dkg timed out waiting for operators %v: %v", sortedKeys(pending), errs)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(dkgSessionPollInterval):
+		}
+	}
+}
+
+// abortDkgSession aborts a failed DKG session on every participant, so that it does not wait for
+// its deadline.
+func abortDkgSession(ctx context.Context, clientMap map[string]pbdkg.DKGServiceClient, requestID string, reason string) {
+	logger := logging.GetLoggerFromContext(ctx)
+	for identifier, client := range clientMap {
+		_, err := client.AbortDkg(ctx, &pbdkg.AbortDkgRequest{RequestId: requestID, Reason: reason})
+		if err != nil {
+			logger.Warn("Failed to abort dkg session", "request_id", requestID, "operator", identifier, "error", err)
+		}
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
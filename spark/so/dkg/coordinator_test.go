@@ -0,0 +1,51 @@
+package dkg
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lightsparkdev/spark"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDkgSessions(t *testing.T) {
+	ctx := context.Background()
+	participants := []string{"a", "b", "c"}
+	blames := &dkgBlameTracker{counts: make(map[string]int)}
+
+	// A session failing blaming an operator is retried with every participant.
+	sessions := 0
+	err := retryDkgSessions(ctx, blames, participants, func(context.Context) (map[string]string, error) {
+		sessions++
+		if sessions == 1 {
+			return map[string]string{"b": "invalid round 1 package"}, fmt.Errorf("invalid round 1 package from operator b")
+		}
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, sessions)
+	require.Empty(t, blames.counts)
+
+	// An operator blamed for every session fails the batch, and is alerted on once it was blamed for
+	// spark.DKGBlameAlertThreshold sessions in a row.
+	sessions = 0
+	err = retryDkgSessions(ctx, blames, participants, func(context.Context) (map[string]string, error) {
+		sessions++
+		return map[string]string{"c": "invalid round 2 package"}, fmt.Errorf("dkg aborted by operator a")
+	})
+	require.ErrorContains(t, err, "blaming operators map[c:invalid round 2 package]")
+	require.Equal(t, spark.DKGMaxAttempts, sessions)
+	require.Equal(t, spark.DKGMaxAttempts, blames.counts["c"])
+	require.Equal(t, map[string]int{"c": spark.DKGMaxAttempts + 1}, blames.record(false, map[string]string{"c": "invalid round 2 package"}))
+
+	// Failures without blame are retried too, and do not count against anyone.
+	sessions = 0
+	err = retryDkgSessions(ctx, blames, participants, func(context.Context) (map[string]string, error) {
+		sessions++
+		return nil, fmt.Errorf("failed to initiate dkg on operator b")
+	})
+	require.Error(t, err)
+	require.Equal(t, spark.DKGMaxAttempts, sessions)
+	require.Equal(t, map[string]int{"c": spark.DKGMaxAttempts + 1}, blames.counts)
+}
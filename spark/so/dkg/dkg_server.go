@@ -2,13 +2,14 @@ package dkg
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
-	"github.com/lightsparkdev/spark/common"
 	pbcommon "github.com/lightsparkdev/spark/proto/common"
 	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
 	pbfrost "github.com/lightsparkdev/spark/proto/frost"
 	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -20,12 +21,16 @@ type Server struct {
 	frostConnection *grpc.ClientConn
 	state           *States
 	config          *so.Config
+	// shareRefreshLock makes sure this operator coordinates one share refresh at a time.
+	shareRefreshLock sync.Mutex
+	// reshareLock makes sure this operator coordinates one reshare at a time.
+	reshareLock sync.Mutex
 }
 
 // NewServer creates a new DKG server based on the given config.
 func NewServer(frostConnection *grpc.ClientConn, config *so.Config) *Server {
 	return &Server{
-		state:           &States{},
+		state:           NewStates(),
 		frostConnection: frostConnection,
 		config:          config,
 	}
@@ -41,7 +46,10 @@ func (s *Server) StartDkg(ctx context.Context, req *pbdkg.StartDkgRequest) (*emp
 // InitiateDkg initiates the DKG protocol.
 // It will be called by the coordinator. It will start the DKG round 1 and deliver the round 1 package to the coordinator.
 func (s *Server) InitiateDkg(ctx context.Context, req *pbdkg.InitiateDkgRequest) (*pbdkg.InitiateDkgResponse, error) {
-	if err := s.state.InitiateDkg(req.RequestId, req.MaxSigners, req.MinSigners, req.CoordinatorIndex); err != nil {
+	if err := checkDkgCoordinator(ctx, s.config, req.CoordinatorIndex); err != nil {
+		return nil, err
+	}
+	if err := s.state.InitiateDkg(ctx, s.config, req.RequestId, req.MaxSigners, req.MinSigners, req.CoordinatorIndex, req.ParticipantIdentifiers); err != nil {
 		return nil, err
 	}
 
@@ -54,12 +62,12 @@ func (s *Server) InitiateDkg(ctx context.Context, req *pbdkg.InitiateDkgRequest)
 		KeyCount:   req.KeyCount,
 	})
 	if err != nil {
-		s.state.RemoveState(req.RequestId)
+		s.state.removeState(req.RequestId)
 		return nil, err
 	}
 
-	if err := s.state.ProvideRound1Package(req.RequestId, round1Response.Round1Packages); err != nil {
-		s.state.RemoveState(req.RequestId)
+	if err := s.state.ProvideRound1Package(ctx, req.RequestId, round1Response.Round1Packages); err != nil {
+		s.state.removeState(req.RequestId)
 		return nil, err
 	}
 
@@ -73,13 +81,16 @@ func (s *Server) InitiateDkg(ctx context.Context, req *pbdkg.InitiateDkgRequest)
 // It will be called by the coordinator. This function will deliver the round 1 packages from the other operators.
 // The packages will be signed with this operator's identity key and sent the signature back to the coordinator.
 // It is used as a confirmation that the operator has received the round 1 packages.
-func (s *Server) Round1Packages(_ context.Context, req *pbdkg.Round1PackagesRequest) (*pbdkg.Round1PackagesResponse, error) {
+func (s *Server) Round1Packages(ctx context.Context, req *pbdkg.Round1PackagesRequest) (*pbdkg.Round1PackagesResponse, error) {
+	if err := checkSessionCoordinator(ctx, s.config, req.RequestId); err != nil {
+		return nil, err
+	}
 	round1Packages := make([]map[string][]byte, len(req.Round1Packages))
 	for i, p := range req.Round1Packages {
 		round1Packages[i] = p.Packages
 	}
 
-	if err := s.state.ReceivedRound1Packages(req.RequestId, s.config.Identifier, round1Packages); err != nil {
+	if err := s.state.ReceivedRound1Packages(ctx, req.RequestId, s.config.Identifier, round1Packages); err != nil {
 		return nil, err
 	}
 
@@ -98,7 +109,10 @@ func (s *Server) Round1Packages(_ context.Context, req *pbdkg.Round1PackagesRequ
 // It will be called by the coordinator. This function will validate the round 1 signatures of all other operators to make sure everyone receives the same round 1 packages.
 // Then it will start the DKG round 2, and distribute the round 2 package to the corresponding operators.
 func (s *Server) Round1Signature(ctx context.Context, req *pbdkg.Round1SignatureRequest) (*pbdkg.Round1SignatureResponse, error) {
-	validationFailures, err := s.state.ReceivedRound1Signature(req.RequestId, s.config.Identifier, req.Round1Signatures, s.config.SigningOperatorMap)
+	if err := checkSessionCoordinator(ctx, s.config, req.RequestId); err != nil {
+		return nil, err
+	}
+	validationFailures, err := s.state.ReceivedRound1Signature(ctx, req.RequestId, req.Round1Signatures, s.config)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +124,7 @@ func (s *Server) Round1Signature(ctx context.Context, req *pbdkg.Round1Signature
 		}, nil
 	}
 
-	state, err := s.state.GetState(req.RequestId)
+	state, err := s.state.GetState(ctx, req.RequestId)
 	if err != nil {
 		return nil, err
 	}
@@ -127,8 +141,10 @@ func (s *Server) Round1Signature(ctx context.Context, req *pbdkg.Round1Signature
 		Round1PackagesMaps: round1PackagesMaps,
 	})
 	if err != nil {
-		s.state.RemoveState(req.RequestId)
-		return nil, err
+		if abortErr := s.state.Abort(ctx, req.RequestId, fmt.Sprintf("round 2 failed: %v", err), nil); abortErr != nil {
+			return nil, abortErr
+		}
+		return nil, fmt.Errorf("dkg round 2 failed for request id %s: %v: %w", req.RequestId, err, ent.ErrNoRollback)
 	}
 
 	var wg sync.WaitGroup
@@ -136,9 +152,9 @@ func (s *Server) Round1Signature(ctx context.Context, req *pbdkg.Round1Signature
 	for identifier := range round2Response.Round2Packages[0].Packages {
 		operator := s.config.SigningOperatorMap[identifier]
 		wg.Add(1)
-		go func(identifier string, addr string) {
+		go func(identifier string) {
 			defer wg.Done()
-			connection, err := common.NewGRPCConnection(addr, operator.CertPath, nil)
+			connection, err := s.config.NewOperatorConnection(operator)
 			if err != nil {
 				return
 			}
@@ -165,7 +181,7 @@ func (s *Server) Round1Signature(ctx context.Context, req *pbdkg.Round1Signature
 			if err != nil {
 				return
 			}
-		}(identifier, operator.Address)
+		}(identifier)
 	}
 
 	wg.Wait()
@@ -185,8 +201,15 @@ func (s *Server) Round2Packages(ctx context.Context, req *pbdkg.Round2PackagesRe
 	if req.Identifier == s.config.Identifier {
 		return &pbdkg.Round2PackagesResponse{}, nil
 	}
+	sender, ok := s.config.SigningOperatorMap[req.Identifier]
+	if !ok {
+		return nil, fmt.Errorf("round 2 packages sender %s is not in the operator set", req.Identifier)
+	}
+	if err := s.config.CheckCallingOperator(ctx, sender.IdentityPublicKey); err != nil {
+		return nil, err
+	}
 
-	if err := s.state.ReceivedRound2Packages(req.RequestId, req.Identifier, req.Round2Packages, req.Round2Signature, s.frostConnection, s.config); err != nil {
+	if err := s.state.ReceivedRound2Packages(ctx, req.RequestId, req.Identifier, req.Round2Packages, req.Round2Signature, s.config); err != nil {
 		return nil, err
 	}
 
@@ -196,3 +219,146 @@ func (s *Server) Round2Packages(ctx context.Context, req *pbdkg.Round2PackagesRe
 
 	return &pbdkg.Round2PackagesResponse{}, nil
 }
+
+// GetDkgSession returns the state of this operator's DKG session.
+// It will be called by the coordinator until every participant completed or aborted the session.
+func (s *Server) GetDkgSession(ctx context.Context, req *pbdkg.GetDkgSessionRequest) (*pbdkg.GetDkgSessionResponse, error) {
+	if err := checkSessionCoordinator(ctx, s.config, req.RequestId); err != nil {
+		return nil, err
+	}
+	return s.state.Session(ctx, s.config, req.RequestId)
+}
+
+// AbortDkg aborts this operator's DKG session.
+// It will be called by the coordinator when the session failed.
+func (s *Server) AbortDkg(ctx context.Context, req *pbdkg.AbortDkgRequest) (*emptypb.Empty, error) {
+	if err := checkSessionCoordinator(ctx, s.config, req.RequestId); err != nil {
+		return nil, err
+	}
+	if err := s.state.Abort(ctx, req.RequestId, req.Reason, nil); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// StartShareRefresh refreshes a batch of the keyshares this operator coordinates.
+// It does nothing if a share refresh is already running.
+func (s *Server) StartShareRefresh(ctx context.Context, req *pbdkg.StartShareRefreshRequest) (*pbdkg.StartShareRefreshResponse, error) {
+	if !s.shareRefreshLock.TryLock() {
+		return &pbdkg.StartShareRefreshResponse{}, nil
+	}
+	defer s.shareRefreshLock.Unlock()
+
+	count, err := RefreshShares(ctx, s.config, int(req.BatchSize))
+	if err != nil {
+		return nil, err
+	}
+	return &pbdkg.StartShareRefreshResponse{RefreshedCount: uint32(count)}, nil
+}
+
+// InitiateShareRefresh deals the shares of zero of this operator for a share refresh.
+// It will be called by the coordinator.
+func (s *Server) InitiateShareRefresh(ctx context.Context, req *pbdkg.InitiateShareRefreshRequest) (*pbdkg.InitiateShareRefreshResponse, error) {
+	contribution, err := InitiateShareRefresh(ctx, s.config, req.RequestId, req.KeyshareIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pbdkg.InitiateShareRefreshResponse{Contribution: contribution}, nil
+}
+
+// PrepareShareRefresh validates the shares of zero this operator received for a share refresh.
+// It will be called by the coordinator once it has the contributions of all operators.
+func (s *Server) PrepareShareRefresh(ctx context.Context, req *pbdkg.PrepareShareRefreshRequest) (*emptypb.Empty, error) {
+	if err := PrepareShareRefresh(ctx, s.config, req.RequestId, req.KeyshareIds, req.Contributions); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CommitShareRefresh applies a prepared share refresh to this operator's keyshares.
+// It will be called by the coordinator once all operators prepared the refresh.
+func (s *Server) CommitShareRefresh(ctx context.Context, req *pbdkg.CommitShareRefreshRequest) (*emptypb.Empty, error) {
+	if err := CommitShareRefresh(ctx, s.config, req.RequestId); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CompleteShareRefresh marks a share refresh as completed, so that it can no longer be rolled back.
+// It will be called by the coordinator once all operators committed the refresh.
+func (s *Server) CompleteShareRefresh(ctx context.Context, req *pbdkg.CompleteShareRefreshRequest) (*emptypb.Empty, error) {
+	if err := CompleteShareRefresh(ctx, s.config, req.RequestId); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AbortShareRefresh aborts a share refresh, rolling it back if this operator committed it.
+// It will be called by the coordinator when the refresh cannot be committed on all operators.
+func (s *Server) AbortShareRefresh(ctx context.Context, req *pbdkg.AbortShareRefreshRequest) (*emptypb.Empty, error) {
+	if err := AbortShareRefresh(ctx, s.config, req.RequestId); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// StartReshare reshares a batch of the keyshares this operator coordinates from the previous
+// operator set to the current one. It does nothing if a reshare is already running.
+func (s *Server) StartReshare(ctx context.Context, req *pbdkg.StartReshareRequest) (*pbdkg.StartReshareResponse, error) {
+	if !s.reshareLock.TryLock() {
+		return &pbdkg.StartReshareResponse{}, nil
+	}
+	defer s.reshareLock.Unlock()
+
+	count, err := Reshare(ctx, s.config, int(req.BatchSize))
+	if err != nil {
+		return nil, err
+	}
+	return &pbdkg.StartReshareResponse{ResharedCount: uint32(count)}, nil
+}
+
+// InitiateReshare deals the new shares of this operator for a reshare, if it is a dealer.
+// It will be called by the coordinator.
+func (s *Server) InitiateReshare(ctx context.Context, req *pbdkg.InitiateReshareRequest) (*pbdkg.InitiateReshareResponse, error) {
+	contribution, err := InitiateReshare(ctx, s.config, req.RequestId, req.Keyshares, req.DealerIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	return &pbdkg.InitiateReshareResponse{Contribution: contribution}, nil
+}
+
+// PrepareReshare validates the contributions of the dealers of a reshare.
+// It will be called by the coordinator once it has the contributions of all dealers.
+func (s *Server) PrepareReshare(ctx context.Context, req *pbdkg.PrepareReshareRequest) (*emptypb.Empty, error) {
+	if err := PrepareReshare(ctx, s.config, req.RequestId, req.Keyshares, req.DealerIdentifiers, req.Contributions); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CommitReshare stores the new shares of a prepared reshare.
+// It will be called by the coordinator once all operators prepared the reshare.
+func (s *Server) CommitReshare(ctx context.Context, req *pbdkg.CommitReshareRequest) (*emptypb.Empty, error) {
+	if err := CommitReshare(ctx, s.config, req.RequestId); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CompleteReshare marks a reshare as completed, which erases the shares it replaced.
+// It will be called by the coordinator once all operators committed the reshare.
+func (s *Server) CompleteReshare(ctx context.Context, req *pbdkg.CompleteReshareRequest) (*emptypb.Empty, error) {
+	if err := CompleteReshare(ctx, s.config, req.RequestId); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AbortReshare aborts a reshare, restoring the keyshares it replaced if this operator committed it.
+// It will be called by the coordinator when the reshare cannot be committed on all operators.
+func (s *Server) AbortReshare(ctx context.Context, req *pbdkg.AbortReshareRequest) (*emptypb.Empty, error) {
+	if err := AbortReshare(ctx, s.config, req.RequestId); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
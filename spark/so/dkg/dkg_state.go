@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
 	pbcommon "github.com/lightsparkdev/spark/proto/common"
+	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
 	pbfrost "github.com/lightsparkdev/spark/proto/frost"
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/ent"
@@ -43,6 +47,8 @@ type State struct {
 	MinSigners uint64
 	// CoordinatorIndex is the index of the coordinator.
 	CoordinatorIndex uint64
+	// Participants is the sorted identifiers of the participants.
+	Participants []string
 	// Round1Package is the round 1 package.
 	Round1Package [][]byte
 	// ReceivedRound1Packages is the round 1 packages received from other operators.
@@ -51,9 +57,15 @@ type State struct {
 	ReceivedRound2Packages []map[string][]byte
 	// CreatedAt is the time when the DKG state was created.
 	CreatedAt time.Time
+	// Deadline is when the session is aborted if it has not moved on to the next state.
+	Deadline time.Time
 }
 
 // States is a collection of DKG states with a mutex for concurrent access.
+//
+// Every state is also stored as a DKG session in the database, up to the round 1 signatures, so
+// that a restarted operator can resume a session. Round 2 packages carry secret shares and are
+// only kept in memory: a session restarted after them is aborted.
 type States struct {
 	mu     sync.RWMutex
 	states map[string]*State
@@ -66,145 +78,294 @@ func NewStates() *States {
 	}
 }
 
-// GetState returns the DKG state for the given request id.
-// If the state does not exist, it returns an error.
-func (s *States) GetState(requestID string) (*State, error) {
+// GetState returns the DKG state for the given request id, restoring it from the database if this
+// operator restarted since. If the state does not exist, it returns an error.
+func (s *States) GetState(ctx context.Context, requestID string) (*State, error) {
 	s.mu.RLock()
-	defer s.mu.RUnlock()
 	state, ok := s.states[requestID]
-	if !ok {
+	s.mu.RUnlock()
+	if ok {
+		return state, nil
+	}
+
+	id, err := uuid.Parse(requestID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dkg request id %s: %w", requestID, err)
+	}
+	session, err := ent.GetDbFromContext(ctx).DkgSession.Get(ctx, id)
+	if ent.IsNotFound(err) {
 		return nil, fmt.Errorf("dkg state does not exist for request id: %s", requestID)
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	return state, nil
-}
+	var stateType StateType
+	switch session.Status {
+	case schema.DkgSessionStatusRound1:
+		stateType = Round1
+	case schema.DkgSessionStatusRound1Signature:
+		stateType = Round1Signature
+	default:
+		return nil, fmt.Errorf("dkg session %s is %s and cannot be resumed", requestID, session.Status)
+	}
+	state = &State{
+		Type:                   stateType,
+		MaxSigners:             session.MaxSigners,
+		MinSigners:             session.MinSigners,
+		CoordinatorIndex:       session.CoordinatorIndex,
+		Participants:           session.Participants,
+		Round1Package:          session.Round1Package,
+		ReceivedRound1Packages: session.ReceivedRound1Packages,
+		CreatedAt:              session.CreateTime,
+		Deadline:               session.Deadline,
+	}
 
-// InitiateDkg initializes a new DKG state for the given request id.
-// If the state already exists, it returns an error.
-func (s *States) InitiateDkg(requestID string, maxSigners uint64, minSigners uint64, coordinatorIndex uint64) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-
-	if _, ok := s.states[requestID]; ok {
-		return fmt.Errorf("dkg state already exists for request id: %s", requestID)
+	if existing, ok := s.states[requestID]; ok {
+		return existing, nil
 	}
-
 	if s.states == nil {
 		s.states = make(map[string]*State)
 	}
+	s.states[requestID] = state
+	return state, nil
+}
+
+// InitiateDkg initializes a new DKG state for the given request id.
+// If participants is empty, all signing operators participate. Otherwise they must still be all
+// the signing operators, as keys held by only some of them could not be used by the others.
+// If the state already exists, it returns an error.
+func (s *States) InitiateDkg(ctx context.Context, config *so.Config, requestID string, maxSigners uint64, minSigners uint64, coordinatorIndex uint64, participants []string) error {
+	id, err := uuid.Parse(requestID)
+	if err != nil {
+		return fmt.Errorf("invalid dkg request id %s: %w", requestID, err)
+	}
+	if len(participants) == 0 {
+		for identifier := range config.SigningOperatorMap {
+			participants = append(participants, identifier)
+		}
+	}
+	participants = slices.Clone(participants)
+	sort.Strings(participants)
+	if uint64(len(participants)) != maxSigners {
+		return fmt.Errorf("dkg has %d participants, expected %d", len(participants), maxSigners)
+	}
+	for i, identifier := range participants {
+		if _, ok := config.SigningOperatorMap[identifier]; !ok {
+			return fmt.Errorf("dkg participant %s is not a signing operator", identifier)
+		}
+		if i > 0 && participants[i-1] == identifier {
+			return fmt.Errorf("duplicate dkg participant %s", identifier)
+		}
+	}
+	if !slices.Contains(participants, config.Identifier) {
+		return fmt.Errorf("operator %s is not a participant of dkg %s", config.Identifier, requestID)
+	}
+	if len(participants) != len(config.SigningOperatorMap) {
+		return fmt.Errorf("dkg %s has participants %v, but keys must be held by all %d signing operators", requestID, participants, len(config.SigningOperatorMap))
+	}
 
-	s.states[requestID] = &State{
+	now := time.Now()
+	state := &State{
 		Type:             Initial,
 		MaxSigners:       maxSigners,
 		MinSigners:       minSigners,
 		CoordinatorIndex: coordinatorIndex,
-		CreatedAt:        time.Now(),
+		Participants:     participants,
+		CreatedAt:        now,
+		Deadline:         now.Add(spark.DKGRoundTimeout),
 	}
 
+	s.mu.Lock()
+	if _, ok := s.states[requestID]; ok {
+		s.mu.Unlock()
+		return fmt.Errorf("dkg state already exists for request id: %s", requestID)
+	}
+	if s.states == nil {
+		s.states = make(map[string]*State)
+	}
+	s.states[requestID] = state
+	s.mu.Unlock()
+
+	err = ent.GetDbFromContext(ctx).DkgSession.Create().
+		SetID(id).
+		SetStatus(schema.DkgSessionStatusInitial).
+		SetMinSigners(minSigners).
+		SetMaxSigners(maxSigners).
+		SetCoordinatorIndex(coordinatorIndex).
+		SetParticipants(participants).
+		SetDeadline(state.Deadline).
+		Exec(ctx)
+	if err != nil {
+		s.removeState(requestID)
+		return fmt.Errorf("failed to store dkg session %s: %w", requestID, err)
+	}
 	return nil
 }
 
 // ProvideRound1Package provides the round 1 package for the given request id.
 // If the state is not in the initial state, it returns an error.
-func (s *States) ProvideRound1Package(requestID string, round1Package [][]byte) error {
+func (s *States) ProvideRound1Package(ctx context.Context, requestID string, round1Package [][]byte) error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	state, ok := s.states[requestID]
 	if !ok {
+		s.mu.Unlock()
 		return fmt.Errorf("dkg state does not exist for request id: %s", requestID)
 	}
 
 	if state.Type != Initial {
+		s.mu.Unlock()
 		return fmt.Errorf("dkg state is not in initial state for request id: %s", requestID)
 	}
 
 	state.Round1Package = round1Package
 	state.Type = Round1
-	s.states[requestID] = state
-	return nil
+	state.Deadline = time.Now().Add(spark.DKGRoundTimeout)
+	deadline := state.Deadline
+	s.mu.Unlock()
+
+	return s.updateSession(ctx, requestID, func(update *ent.DkgSessionUpdateOne) {
+		update.SetStatus(schema.DkgSessionStatusRound1).
+			SetRound1Package(round1Package).
+			SetDeadline(deadline)
+	})
 }
 
 // ReceivedRound1Packages receives the round 1 packages from other operators.
 // If the state is not in the round 1 state, it returns an error.
-func (s *States) ReceivedRound1Packages(requestID string, selfIdentifier string, round1Packages []map[string][]byte) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	state, ok := s.states[requestID]
-	if !ok {
-		return fmt.Errorf("dkg state does not exist for request id: %s", requestID)
+func (s *States) ReceivedRound1Packages(ctx context.Context, requestID string, selfIdentifier string, round1Packages []map[string][]byte) error {
+	state, err := s.GetState(ctx, requestID)
+	if err != nil {
+		return err
 	}
 
+	s.mu.Lock()
 	if state.Type != Round1 {
+		s.mu.Unlock()
 		return fmt.Errorf("dkg state is not in round 1 state for request id: %s", requestID)
 	}
 
 	if len(round1Packages) != len(state.Round1Package) {
+		s.mu.Unlock()
 		return fmt.Errorf("received round 1 packages has wrong number of keys for request id: %s", requestID)
 	}
 
 	for i, p := range round1Packages {
 		selfPackage, ok := p[selfIdentifier]
 		if !ok {
+			s.mu.Unlock()
 			return fmt.Errorf("self package is not included in round 1 packages for request id: %s", requestID)
 		}
 
 		if !bytes.Equal(state.Round1Package[i], selfPackage) {
+			s.mu.Unlock()
 			return fmt.Errorf("round 1 package %d is not the same as the self package for request id: %s", i, requestID)
 		}
+
+		if len(p) != len(state.Participants) {
+			s.mu.Unlock()
+			return fmt.Errorf("round 1 package %d has %d packages, expected %d for request id: %s", i, len(p), len(state.Participants), requestID)
+		}
+		for _, identifier := range state.Participants {
+			if _, ok := p[identifier]; !ok {
+				s.mu.Unlock()
+				return fmt.Errorf("round 1 package %d is missing the package of %s for request id: %s", i, identifier, requestID)
+			}
+		}
 	}
 
 	state.Type = Round1Signature
 	state.ReceivedRound1Packages = round1Packages
-	s.states[requestID] = state
-	return nil
+	state.Deadline = time.Now().Add(spark.DKGRoundTimeout)
+	deadline := state.Deadline
+	s.mu.Unlock()
+
+	return s.updateSession(ctx, requestID, func(update *ent.DkgSessionUpdateOne) {
+		update.SetStatus(schema.DkgSessionStatusRound1Signature).
+			SetReceivedRound1Packages(round1Packages).
+			SetDeadline(deadline)
+	})
 }
 
 // ReceivedRound1Signature receives the round 1 signatures from other operators.
 // If the state is not in the round 1 signature state, it returns an error.
-func (s *States) ReceivedRound1Signature(requestID string, _ string, round1Signatures map[string][]byte, operatorMap map[string]*so.SigningOperator) ([]string, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	state, ok := s.states[requestID]
-	if !ok {
-		return nil, fmt.Errorf("dkg state does not exist for request id: %s", requestID)
+// If any signature is invalid, the session is aborted blaming the operators whose signatures are
+// invalid, and their identifiers are returned.
+func (s *States) ReceivedRound1Signature(ctx context.Context, requestID string, round1Signatures map[string][]byte, config *so.Config) ([]string, error) {
+	state, err := s.GetState(ctx, requestID)
+	if err != nil {
+		return nil, err
 	}
 
+	s.mu.Lock()
 	if state.Type != Round1Signature {
+		s.mu.Unlock()
 		return nil, fmt.Errorf("dkg state is not in round 1 signature state for request id: %s", requestID)
 	}
 
+	operatorMap := make(map[string]*so.SigningOperator, len(state.Participants))
+	for _, identifier := range state.Participants {
+		operatorMap[identifier] = config.SigningOperatorMap[identifier]
+	}
 	valid, validationFailures := validateRound1Signature(state.ReceivedRound1Packages, round1Signatures, operatorMap)
-	if !valid {
-		// Abort the DKG process
-		delete(s.states, requestID)
-
-		return validationFailures, nil
+	if valid {
+		state.Type = Round2
+		state.Deadline = time.Now().Add(spark.DKGRoundTimeout)
+		s.mu.Unlock()
+		return nil, nil
 	}
+	s.mu.Unlock()
 
-	state.Type = Round2
-	s.states[requestID] = state
-
-	return nil, nil
+	sort.Strings(validationFailures)
+	complaints := make(map[string]string, len(validationFailures))
+	for _, identifier := range validationFailures {
+		complaints[identifier] = "invalid round 1 signature"
+	}
+	if err := s.Abort(ctx, requestID, "invalid round 1 signatures", complaints); err != nil {
+		return nil, err
+	}
+	return validationFailures, nil
 }
 
 // ReceivedRound2Packages receives the round 2 packages from other operators.
 // If the state is not in the round 1 signature or round 2 state, it returns an error.
-func (s *States) ReceivedRound2Packages(requestID string, identifier string, round2Packages [][]byte, _ []byte, _ *grpc.ClientConn, _ *so.Config) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	state, ok := s.states[requestID]
-	if !ok {
-		return fmt.Errorf("dkg state does not exist for request id: %s", requestID)
+// If the packages are invalid, the session is aborted blaming the sender. The error then wraps
+// ent.ErrNoRollback, so that the abort is stored.
+func (s *States) ReceivedRound2Packages(ctx context.Context, requestID string, identifier string, round2Packages [][]byte, round2Signature []byte, config *so.Config) error {
+	state, err := s.GetState(ctx, requestID)
+	if err != nil {
+		return err
 	}
 
+	s.mu.Lock()
 	if state.Type != Round2 && state.Type != Round1Signature {
+		s.mu.Unlock()
 		return fmt.Errorf("dkg state is not in round 2 or round 1 signature state for request id: %s", requestID)
 	}
 
+	var complaint string
+	operator, isOperator := config.SigningOperatorMap[identifier]
+	switch {
+	case !isOperator || !slices.Contains(state.Participants, identifier):
+		s.mu.Unlock()
+		return fmt.Errorf("round 2 packages from %s, which is not a participant of dkg %s", identifier, requestID)
+	case len(round2Packages) != len(state.Round1Package):
+		complaint = fmt.Sprintf("sent %d round 2 packages, expected %d", len(round2Packages), len(state.Round1Package))
+	default:
+		if err := verifyHashSignature(round2PackageHash(round2Packages), round2Signature, operator.IdentityPublicKey); err != nil {
+			complaint = fmt.Sprintf("invalid round 2 signature: %v", err)
+		}
+	}
+	if complaint != "" {
+		s.mu.Unlock()
+		if err := s.Abort(ctx, requestID, "invalid round 2 packages", map[string]string{identifier: complaint}); err != nil {
+			return err
+		}
+		return fmt.Errorf("invalid round 2 packages from %s for request id %s: %s: %w", identifier, requestID, complaint, ent.ErrNoRollback)
+	}
+
 	if len(state.ReceivedRound2Packages) == 0 {
 		state.ReceivedRound2Packages = make([]map[string][]byte, len(round2Packages))
 		for i := range state.ReceivedRound2Packages {
@@ -215,41 +376,45 @@ func (s *States) ReceivedRound2Packages(requestID string, identifier string, rou
 	for i, p := range round2Packages {
 		state.ReceivedRound2Packages[i][identifier] = p
 	}
-
-	s.states[requestID] = state
+	s.mu.Unlock()
 	return nil
 }
 
 // ProceedToRound3 checks if we can proceed to round 3 for the given request id.
 // We can proceed to round 3 if we have received the round 2 packages from all operators as well as we got our own round 2 package.
-// If we can, it will perform the round 3.
+// If we can, it will perform the round 3 and complete the session.
 func (s *States) ProceedToRound3(ctx context.Context, requestID string, frostConnection *grpc.ClientConn, config *so.Config) error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	state, ok := s.states[requestID]
 	if !ok {
+		s.mu.Unlock()
 		// This call might be called twice per state. So this should not count as an error.
 		return nil
 	}
 
-	if len(state.ReceivedRound2Packages) == 0 {
+	if len(state.ReceivedRound2Packages) == 0 || int64(len(state.ReceivedRound2Packages[0])) != int64(state.MaxSigners-1) || state.Type != Round2 {
+		s.mu.Unlock()
 		return nil
 	}
-	if int64(len(state.ReceivedRound2Packages[0])) == int64(state.MaxSigners-1) && state.Type == Round2 {
-		delete(s.states, requestID)
+	delete(s.states, requestID)
+	s.mu.Unlock()
 
-		err := state.Round3(ctx, requestID, frostConnection, config)
-		if err != nil {
-			return err
+	if err := state.Round3(ctx, requestID, frostConnection, config); err != nil {
+		if abortErr := s.Abort(ctx, requestID, fmt.Sprintf("round 3 failed: %v", err), nil); abortErr != nil {
+			return abortErr
 		}
+		return fmt.Errorf("dkg round 3 failed for request id %s: %v: %w", requestID, err, ent.ErrNoRollback)
 	}
-	return nil
+	return s.updateSession(ctx, requestID, func(update *ent.DkgSessionUpdateOne) {
+		update.SetStatus(schema.DkgSessionStatusCompleted).
+			ClearRound1Package().
+			ClearReceivedRound1Packages()
+	})
 }
 
 // Round3 performs the round 3 of the DKG protocol.
 // This will generate the keyshares and store them in the database.
-func (s *State) Round3(ctx context.Context, requestID string, frostConnection *grpc.ClientConn, _ *so.Config) error {
+func (s *State) Round3(ctx context.Context, requestID string, frostConnection *grpc.ClientConn, config *so.Config) error {
 	round1PackagesMaps := make([]*pbcommon.PackageMap, len(s.ReceivedRound1Packages))
 	for i, p := range s.ReceivedRound1Packages {
 		round1PackagesMaps[i] = &pbcommon.PackageMap{
@@ -274,6 +439,14 @@ func (s *State) Round3(ctx context.Context, requestID string, frostConnection *g
 		return err
 	}
 
+	// Keys are only stored if every signing operator holds a share of them, as they are handed out
+	// for addresses that all operators sign for.
+	for i, key := range response.KeyPackages {
+		if len(key.PublicShares) != len(config.SigningOperatorMap) {
+			return fmt.Errorf("key %d has %d public shares, expected one for each of the %d signing operators", i, len(key.PublicShares), len(config.SigningOperatorMap))
+		}
+	}
+
 	db := ent.GetDbFromContext(ctx)
 	for i, key := range response.KeyPackages {
 		batchID, err := uuid.Parse(requestID)
@@ -281,7 +454,7 @@ func (s *State) Round3(ctx context.Context, requestID string, frostConnection *g
 			return err
 		}
 		keyID := deriveKeyIndex(batchID, uint16(i))
-		db.SigningKeyshare.Create().
+		err = db.SigningKeyshare.Create().
 			SetID(keyID).
 			SetStatus(schema.KeyshareStatusAvailable).
 			SetMinSigners(int32(s.MinSigners)).
@@ -289,14 +462,106 @@ func (s *State) Round3(ctx context.Context, requestID string, frostConnection *g
 			SetPublicShares(key.PublicShares).
 			SetPublicKey(key.PublicKey).
 			SetCoordinatorIndex(s.CoordinatorIndex).
-			SaveX(context.Background())
+			SetOperatorSetEpoch(config.OperatorSetEpoch).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to store keyshare %s: %w", keyID, err)
+		}
 	}
 
 	return nil
 }
 
-// RemoveState removes the DKG state for the given request id.
-func (s *States) RemoveState(requestID string) {
+// Abort aborts the DKG session for the given request id, blaming the given participants. Aborting
+// a session that is already completed or aborted does nothing.
+func (s *States) Abort(ctx context.Context, requestID string, reason string, complaints map[string]string) error {
+	s.removeState(requestID)
+
+	id, err := uuid.Parse(requestID)
+	if err != nil {
+		return fmt.Errorf("invalid dkg request id %s: %w", requestID, err)
+	}
+	db := ent.GetDbFromContext(ctx)
+	session, err := db.DkgSession.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if session.Status == schema.DkgSessionStatusCompleted || session.Status == schema.DkgSessionStatusAborted {
+		return nil
+	}
+	update := db.DkgSession.UpdateOne(session).
+		SetStatus(schema.DkgSessionStatusAborted).
+		SetAbortReason(reason).
+		ClearRound1Package().
+		ClearReceivedRound1Packages()
+	if len(complaints) > 0 {
+		update.SetComplaints(complaints)
+	}
+	return update.Exec(ctx)
+}
+
+// Session returns this operator's state of the DKG session for the given request id. A session
+// past its deadline is aborted first. The participants whose round 2 packages are missing are
+// named in the abort reason but not blamed, as they may only have been unreachable.
+func (s *States) Session(ctx context.Context, config *so.Config, requestID string) (*pbdkg.GetDkgSessionResponse, error) {
+	id, err := uuid.Parse(requestID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dkg request id %s: %w", requestID, err)
+	}
+
+	s.mu.RLock()
+	state, ok := s.states[requestID]
+	var expired bool
+	reason := "timed out"
+	if ok && time.Now().After(state.Deadline) {
+		expired = true
+		if state.Type == Round2 {
+			var missing []string
+			for _, identifier := range state.Participants {
+				if identifier == config.Identifier {
+					continue
+				}
+				if len(state.ReceivedRound2Packages) == 0 || state.ReceivedRound2Packages[0][identifier] == nil {
+					missing = append(missing, identifier)
+				}
+			}
+			reason = fmt.Sprintf("timed out waiting for round 2 packages from %v", missing)
+		}
+	}
+	s.mu.RUnlock()
+	if expired {
+		if err := s.Abort(ctx, requestID, reason, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	session, err := ent.GetDbFromContext(ctx).DkgSession.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("dkg session does not exist for request id: %s", requestID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return session.MarshalProto(config.Identifier), nil
+}
+
+func (s *States) updateSession(ctx context.Context, requestID string, apply func(*ent.DkgSessionUpdateOne)) error {
+	id, err := uuid.Parse(requestID)
+	if err != nil {
+		return fmt.Errorf("invalid dkg request id %s: %w", requestID, err)
+	}
+	update := ent.GetDbFromContext(ctx).DkgSession.UpdateOneID(id)
+	apply(update)
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update dkg session %s: %w", requestID, err)
+	}
+	return nil
+}
+
+func (s *States) removeState(requestID string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
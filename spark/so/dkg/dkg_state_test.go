@@ -0,0 +1,224 @@
+package dkg
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lightsparkdev/spark"
+	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/utils"
+	"github.com/stretchr/testify/require"
+)
+
+type dkgTestOperator struct {
+	config *so.Config
+	ctx    context.Context
+}
+
+// newDkgTestOperators returns count signing operators of the same set, each with its own database.
+func newDkgTestOperators(t *testing.T, count int) []*dkgTestOperator {
+	operatorMap := make(map[string]*so.SigningOperator, count)
+	operators := make([]*dkgTestOperator, count)
+	for i := range operators {
+		identityKey, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		identifier := utils.IndexToIdentifier(uint64(i))
+		operatorMap[identifier] = &so.SigningOperator{
+			ID:                uint64(i),
+			Identifier:        identifier,
+			IdentityPublicKey: identityKey.PubKey().SerializeCompressed(),
+		}
+
+		db := enttest.Open(t, "sqlite3", fmt.Sprintf("file:dkg_%s_%d?mode=memory&_fk=1", t.Name(), i))
+		t.Cleanup(func() { db.Close() })
+		tx, err := db.Tx(context.Background())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = tx.Rollback() })
+
+		operators[i] = &dkgTestOperator{
+			config: &so.Config{
+				Index:              uint64(i),
+				Identifier:         identifier,
+				IdentityPrivateKey: identityKey.Serialize(),
+				SigningOperatorMap: operatorMap,
+			},
+			ctx: context.WithValue(context.Background(), ent.TxKey, tx),
+		}
+	}
+	return operators
+}
+
+// startTestDkgSession takes a DKG session of one key on operators[0] up to the round 1
+// signatures, and returns the round 1 packages.
+func startTestDkgSession(t *testing.T, states *States, operators []*dkgTestOperator, requestID string) []map[string][]byte {
+	self := operators[0]
+	require.NoError(t, states.InitiateDkg(self.ctx, self.config, requestID, uint64(len(operators)), 2, 0, nil))
+	require.NoError(t, states.ProvideRound1Package(self.ctx, requestID, [][]byte{{0}}))
+
+	round1Packages := []map[string][]byte{make(map[string][]byte, len(operators))}
+	for i, operator := range operators {
+		round1Packages[0][operator.config.Identifier] = []byte{byte(i)}
+	}
+	require.NoError(t, states.ReceivedRound1Packages(self.ctx, requestID, self.config.Identifier, round1Packages))
+	return round1Packages
+}
+
+func signTestRound1Packages(t *testing.T, operators []*dkgTestOperator, round1Packages []map[string][]byte) map[string][]byte {
+	signatures := make(map[string][]byte, len(operators))
+	for _, operator := range operators {
+		signature, err := signRound1Packages(operator.config.IdentityPrivateKey, round1Packages)
+		require.NoError(t, err)
+		signatures[operator.config.Identifier] = signature
+	}
+	return signatures
+}
+
+func TestDkgSessionResumesAfterRestart(t *testing.T) {
+	operators := newDkgTestOperators(t, 3)
+	self := operators[0]
+	requestID := schema.NewID().String()
+	round1Packages := startTestDkgSession(t, NewStates(), operators, requestID)
+
+	restarted := NewStates()
+	state, err := restarted.GetState(self.ctx, requestID)
+	require.NoError(t, err)
+	require.Equal(t, Round1Signature, state.Type)
+	require.Equal(t, round1Packages, state.ReceivedRound1Packages)
+	require.Equal(t, [][]byte{{0}}, state.Round1Package)
+
+	failures, err := restarted.ReceivedRound1Signature(self.ctx, requestID, signTestRound1Packages(t, operators, round1Packages), self.config)
+	require.NoError(t, err)
+	require.Empty(t, failures)
+}
+
+func TestDkgSessionBlamesInvalidRound1Signature(t *testing.T) {
+	operators := newDkgTestOperators(t, 3)
+	self, culprit := operators[0], operators[2]
+	states := NewStates()
+	requestID := schema.NewID().String()
+	round1Packages := startTestDkgSession(t, states, operators, requestID)
+
+	signatures := signTestRound1Packages(t, operators, round1Packages)
+	signatures[culprit.config.Identifier], _ = signRound1Packages(culprit.config.IdentityPrivateKey, []map[string][]byte{{"tampered": {1}}})
+	failures, err := states.ReceivedRound1Signature(self.ctx, requestID, signatures, self.config)
+	require.NoError(t, err)
+	require.Equal(t, []string{culprit.config.Identifier}, failures)
+
+	session, err := states.Session(self.ctx, self.config, requestID)
+	require.NoError(t, err)
+	require.Equal(t, pbdkg.DkgSessionStatus_DKG_SESSION_STATUS_ABORTED, session.Status)
+	require.Len(t, session.Complaints, 1)
+	require.Equal(t, culprit.config.Identifier, session.Complaints[0].AccusedIdentifier)
+
+	// An aborted session cannot be resumed.
+	_, err = NewStates().GetState(self.ctx, requestID)
+	require.ErrorContains(t, err, "cannot be resumed")
+}
+
+func TestDkgSessionBlamesInvalidRound2Packages(t *testing.T) {
+	operators := newDkgTestOperators(t, 3)
+	self, culprit := operators[0], operators[1]
+	states := NewStates()
+	requestID := schema.NewID().String()
+	round1Packages := startTestDkgSession(t, states, operators, requestID)
+	failures, err := states.ReceivedRound1Signature(self.ctx, requestID, signTestRound1Packages(t, operators, round1Packages), self.config)
+	require.NoError(t, err)
+	require.Empty(t, failures)
+
+	round2Packages := [][]byte{{1, 2, 3}}
+	signature, err := signRound2Packages(operators[2].config.IdentityPrivateKey, round2Packages)
+	require.NoError(t, err)
+	err = states.ReceivedRound2Packages(self.ctx, requestID, culprit.config.Identifier, round2Packages, signature, self.config)
+	require.ErrorIs(t, err, ent.ErrNoRollback)
+
+	session, err := states.Session(self.ctx, self.config, requestID)
+	require.NoError(t, err)
+	require.Equal(t, pbdkg.DkgSessionStatus_DKG_SESSION_STATUS_ABORTED, session.Status)
+	require.Len(t, session.Complaints, 1)
+	require.Equal(t, culprit.config.Identifier, session.Complaints[0].AccusedIdentifier)
+	require.Contains(t, session.Complaints[0].Reason, "invalid round 2 signature")
+}
+
+func TestDkgSessionTimesOut(t *testing.T) {
+	operators := newDkgTestOperators(t, 3)
+	self, late := operators[0], operators[2]
+	states := NewStates()
+	requestID := schema.NewID().String()
+	round1Packages := startTestDkgSession(t, states, operators, requestID)
+	failures, err := states.ReceivedRound1Signature(self.ctx, requestID, signTestRound1Packages(t, operators, round1Packages), self.config)
+	require.NoError(t, err)
+	require.Empty(t, failures)
+
+	round2Packages := [][]byte{{1, 2, 3}}
+	signature, err := signRound2Packages(operators[1].config.IdentityPrivateKey, round2Packages)
+	require.NoError(t, err)
+	require.NoError(t, states.ReceivedRound2Packages(self.ctx, requestID, operators[1].config.Identifier, round2Packages, signature, self.config))
+
+	session, err := states.Session(self.ctx, self.config, requestID)
+	require.NoError(t, err)
+	require.Equal(t, pbdkg.DkgSessionStatus_DKG_SESSION_STATUS_IN_PROGRESS, session.Status)
+
+	state, err := states.GetState(self.ctx, requestID)
+	require.NoError(t, err)
+	state.Deadline = time.Now().Add(-time.Second)
+	session, err = states.Session(self.ctx, self.config, requestID)
+	require.NoError(t, err)
+	require.Equal(t, pbdkg.DkgSessionStatus_DKG_SESSION_STATUS_ABORTED, session.Status)
+	// The late operator may only have been unreachable, so it is not blamed.
+	require.Empty(t, session.Complaints)
+	require.Contains(t, session.AbortReason, late.config.Identifier)
+}
+
+func TestAbortExpiredDkgSessions(t *testing.T) {
+	operators := newDkgTestOperators(t, 3)
+	self := operators[0]
+	expiredID, activeID := schema.NewID(), schema.NewID()
+	startTestDkgSession(t, NewStates(), operators, expiredID.String())
+	startTestDkgSession(t, NewStates(), operators, activeID.String())
+
+	db := ent.GetDbFromContext(self.ctx)
+	require.NoError(t, db.DkgSession.UpdateOneID(expiredID).SetDeadline(time.Now().Add(-2*spark.DKGRoundTimeout)).Exec(self.ctx))
+
+	count, err := ent.AbortExpiredDkgSessions(self.ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	expired, err := db.DkgSession.Get(self.ctx, expiredID)
+	require.NoError(t, err)
+	require.Equal(t, schema.DkgSessionStatusAborted, expired.Status)
+	require.Empty(t, expired.ReceivedRound1Packages)
+	active, err := db.DkgSession.Get(self.ctx, activeID)
+	require.NoError(t, err)
+	require.Equal(t, schema.DkgSessionStatusRound1Signature, active.Status)
+}
+
+func TestDkgSessionRequiresParticipation(t *testing.T) {
+	operators := newDkgTestOperators(t, 3)
+	self := operators[0]
+	participants := []string{operators[1].config.Identifier, operators[2].config.Identifier}
+	err := NewStates().InitiateDkg(self.ctx, self.config, schema.NewID().String(), 2, 2, 0, participants)
+	require.ErrorContains(t, err, "is not a participant")
+
+	err = NewStates().InitiateDkg(context.Background(), self.config, schema.NewID().String(), 3, 2, 0, participants)
+	require.ErrorContains(t, err, "dkg has 2 participants, expected 3")
+}
+
+func TestDkgSessionRequiresAllOperators(t *testing.T) {
+	operators := newDkgTestOperators(t, 3)
+	self := operators[0]
+	// Keys held by only some of the operators could not be used by the others, so sessions
+	// without every operator are refused rather than generating them.
+	participants := []string{operators[0].config.Identifier, operators[1].config.Identifier}
+	err := NewStates().InitiateDkg(self.ctx, self.config, schema.NewID().String(), 2, 2, 0, participants)
+	require.ErrorContains(t, err, "keys must be held by all 3 signing operators")
+	require.Zero(t, ent.GetDbFromContext(self.ctx).DkgSession.Query().CountX(self.ctx))
+
+	require.NoError(t, NewStates().InitiateDkg(self.ctx, self.config, schema.NewID().String(), 3, 2, 0, nil))
+}
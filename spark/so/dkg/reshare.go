@@ -0,0 +1,981 @@
+package dkg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	eciesgo "github.com/ecies/go/v2"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/common"
+	"github.com/lightsparkdev/spark/common/logging"
+	secretsharing "github.com/lightsparkdev/spark/common/secret_sharing"
+	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/reshare"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Reshare moves up to batchSize keyshares this operator coordinates from the previous operator
+// set to the current one, and returns the number of keyshares reshared.
+//
+// Threshold operators of the previous set deal: each one multiplies its share by its Lagrange
+// coefficient, so that the weighted shares add up to the secret, and splits the result among the
+// current set with the current threshold. Each operator of the current set adds up the shares it
+// received to get its new share. The public key stays the same, and operators that are no longer
+// in the current set erase their shares. The dealers are the first operators of the previous set
+// by identifier; a dealer that fails to deal or to prepare the reshare is left out and the reshare
+// is started again without it.
+//
+// Every participant stores the reshare when it is prepared, and keeps what it needs to roll it
+// back until every participant committed it. The coordinator prepares first, and reshares that
+// were interrupted are finished before a new one starts. Operators that leave the operator set
+// without dealing are only asked to erase their shares; the reshare does not wait for them.
+//
+// Signing with a keyshare fails while the reshare is committed on some operators but not yet on
+// others. A joining operator only gets the keyshares, not the trees, transfers and deposit
+// addresses that refer to them, so keyshares are only reshared to joining operators once the
+// previous operator set allows them, which it should once they hold that data.
+func Reshare(ctx context.Context, config *so.Config, batchSize int) (int, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	previous := config.PreviousOperatorSet
+	if previous == nil {
+		return 0, nil
+	}
+	if joining := joiningOperators(config); len(joining) > 0 && !previous.AllowJoiningOperators {
+		return 0, fmt.Errorf("operators %v join the operator set, which the previous operator set does not allow", joining)
+	}
+
+	// Init clients for the operators of both sets.
+	clientMap := make(map[string]pbdkg.DKGServiceClient)
+	for _, operators := range []map[string]*so.SigningOperator{previous.SigningOperatorMap, config.SigningOperatorMap} {
+		for identifier, operator := range operators {
+			if _, ok := clientMap[identifier]; ok {
+				continue
+			}
+			connection, err := config.NewOperatorConnection(operator)
+			if err != nil {
+				return 0, err
+			}
+			defer connection.Close()
+			clientMap[identifier] = pbdkg.NewDKGServiceClient(connection)
+		}
+	}
+	participants := shareRefreshParticipants(config, clientMap)
+
+	if err := resumeReshares(ctx, config, clientMap, participants); err != nil {
+		return 0, err
+	}
+
+	db := ent.GetDbFromContext(ctx)
+	keyshares, err := db.SigningKeyshare.Query().
+		Where(
+			signingkeyshare.CoordinatorIndexEQ(config.Index),
+			signingkeyshare.OperatorSetEpochEQ(previous.Epoch),
+			signingkeyshare.PendingReshareIDIsNil(),
+			signingkeyshare.PendingShareRefreshIDIsNil(),
+		).
+		Order(ent.Asc(signingkeyshare.FieldID)).
+		Limit(batchSize).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(keyshares) == 0 {
+		return 0, nil
+	}
+	reshareKeyshares := make([]*pbdkg.ReshareKeyshare, len(keyshares))
+	for i, keyshare := range keyshares {
+		reshareKeyshares[i] = &pbdkg.ReshareKeyshare{
+			Id:               keyshare.ID.String(),
+			PublicKey:        keyshare.PublicKey,
+			Status:           string(keyshare.Status),
+			CoordinatorIndex: keyshare.CoordinatorIndex,
+		}
+	}
+
+	candidates := make([]string, 0, len(previous.SigningOperatorMap))
+	for identifier := range previous.SigningOperatorMap {
+		candidates = append(candidates, identifier)
+	}
+	sort.Strings(candidates)
+	for uint64(len(candidates)) >= previous.Threshold {
+		dealers := candidates[:previous.Threshold]
+		requestID, failedDealer, err := reshareWithDealers(ctx, config, clientMap, participants, reshareKeyshares, dealers)
+		if err == nil {
+			logger.Info("Reshared keyshares", "request_id", requestID, "count", len(keyshares), "epoch", config.OperatorSetEpoch)
+			return len(keyshares), nil
+		}
+		if failedDealer == "" {
+			return 0, err
+		}
+		logger.Error("Dealer failed, resharing without it", "request_id", requestID, "operator", failedDealer, "error", err)
+		candidates = slices.DeleteFunc(candidates, func(identifier string) bool { return identifier == failedDealer })
+	}
+	return 0, fmt.Errorf("fewer than %d operators of the previous operator set can deal", previous.Threshold)
+}
+
+// joiningOperators returns the identifiers of the operators of the current operator set that are
+// not in the previous one.
+func joiningOperators(config *so.Config) []string {
+	joining := make([]string, 0)
+	for identifier := range config.SigningOperatorMap {
+		if _, ok := config.PreviousOperatorSet.SigningOperatorMap[identifier]; !ok {
+			joining = append(joining, identifier)
+		}
+	}
+	sort.Strings(joining)
+	return joining
+}
+
+// reshareWithDealers reshares the keyshares with the given dealers and returns the id of the
+// request. If a dealer failed before the reshare could be committed, and it was aborted, the
+// dealer is returned along with the error, so that the reshare can be started again without it.
+func reshareWithDealers(ctx context.Context, config *so.Config, clientMap map[string]pbdkg.DKGServiceClient, participants []string, keyshares []*pbdkg.ReshareKeyshare, dealers []string) (string, string, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	requestID, err := uuid.NewV7()
+	if err != nil {
+		return "", "", err
+	}
+	requestIDString := requestID.String()
+	commit := reshareCommit(config, clientMap, requestIDString, dealers)
+
+	contributions := make([]*pbdkg.ReshareContribution, 0, len(dealers))
+	for _, identifier := range participants {
+		response, err := clientMap[identifier].InitiateReshare(ctx, &pbdkg.InitiateReshareRequest{
+			RequestId:         requestIDString,
+			Keyshares:         keyshares,
+			DealerIdentifiers: dealers,
+		})
+		if err != nil {
+			if commit.optional[identifier] {
+				logger.Error("Optional participant did not initiate reshare", "request_id", requestIDString, "operator", identifier, "error", err)
+				continue
+			}
+			err = fmt.Errorf("failed to initiate reshare on operator %s: %w", identifier, err)
+			if slices.Contains(dealers, identifier) {
+				return requestIDString, identifier, err
+			}
+			return requestIDString, "", err
+		}
+		if response.Contribution != nil {
+			contributions = append(contributions, response.Contribution)
+		}
+	}
+
+	for _, identifier := range participants {
+		_, err := clientMap[identifier].PrepareReshare(ctx, &pbdkg.PrepareReshareRequest{
+			RequestId:         requestIDString,
+			Contributions:     contributions,
+			Keyshares:         keyshares,
+			DealerIdentifiers: dealers,
+		})
+		if err != nil {
+			if commit.optional[identifier] {
+				logger.Error("Optional participant did not prepare reshare", "request_id", requestIDString, "operator", identifier, "error", err)
+				continue
+			}
+			err = fmt.Errorf("failed to prepare reshare on operator %s: %w", identifier, err)
+			if abortErr := commit.abortAll(ctx, participants); abortErr != nil {
+				return requestIDString, "", errors.Join(err, abortErr)
+			}
+			if slices.Contains(dealers, identifier) {
+				return requestIDString, identifier, err
+			}
+			return requestIDString, "", err
+		}
+	}
+
+	return requestIDString, "", commit.commitAll(ctx, participants)
+}
+
+// resumeReshares finishes the reshares this operator coordinates that were interrupted. Reshares
+// the coordinator committed are committed on the other participants, and reshares it prepared but
+// did not commit in time are aborted.
+func resumeReshares(ctx context.Context, config *so.Config, clientMap map[string]pbdkg.DKGServiceClient, participants []string) error {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	db := ent.GetDbFromContext(ctx)
+	reshares, err := db.Reshare.Query().
+		Where(
+			reshare.CoordinatorIndexEQ(config.Index),
+			reshare.StatusIn(schema.ShareRefreshStatusPrepared, schema.ShareRefreshStatusCommitted),
+		).
+		Order(ent.Asc(reshare.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, row := range reshares {
+		requestID := row.ID.String()
+		commit := reshareCommit(config, clientMap, requestID, row.Dealers)
+		if row.Status == schema.ShareRefreshStatusCommitted {
+			logger.Info("Resuming reshare", "request_id", requestID)
+			err = commit.commitAll(ctx, participants)
+		} else if time.Since(row.CreateTime) > shareRefreshStateTTL {
+			logger.Info("Aborting reshare", "request_id", requestID)
+			err = commit.abortAll(ctx, participants)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reshareCommit returns how the coordinator commits, completes and aborts a reshare on the
+// participants. Operators that are only in the previous operator set and do not deal are optional.
+func reshareCommit(config *so.Config, clientMap map[string]pbdkg.DKGServiceClient, requestID string, dealers []string) *participantCommit {
+	optional := make(map[string]bool)
+	for identifier := range clientMap {
+		if _, ok := config.SigningOperatorMap[identifier]; !ok && !slices.Contains(dealers, identifier) {
+			optional[identifier] = true
+		}
+	}
+	return &participantCommit{
+		name:      "reshare",
+		requestID: requestID,
+		commit: func(ctx context.Context, identifier string) error {
+			_, err := clientMap[identifier].CommitReshare(ctx, &pbdkg.CommitReshareRequest{RequestId: requestID})
+			return err
+		},
+		complete: func(ctx context.Context, identifier string) error {
+			_, err := clientMap[identifier].CompleteReshare(ctx, &pbdkg.CompleteReshareRequest{RequestId: requestID})
+			return err
+		},
+		abort: func(ctx context.Context, identifier string) error {
+			_, err := clientMap[identifier].AbortReshare(ctx, &pbdkg.AbortReshareRequest{RequestId: requestID})
+			return err
+		},
+		optional: optional,
+	}
+}
+
+// InitiateReshare checks a reshare request and, if this operator is one of its dealers, deals its
+// weighted shares of the keyshares to the current operator set and returns them signed with this
+// operator's identity key. Other operators return no contribution.
+func InitiateReshare(ctx context.Context, config *so.Config, requestID string, keyshares []*pbdkg.ReshareKeyshare, dealers []string) (*pbdkg.ReshareContribution, error) {
+	keyshareIDs, err := checkReshareRequest(ctx, config, keyshares, dealers)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := loadResharedKeyshares(ctx, config, keyshareIDs, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkReshareRows(config, rows); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(dealers, config.Identifier) {
+		return nil, nil
+	}
+	return dealReshare(ctx, config, requestID, keyshareIDs, keyshares, dealers)
+}
+
+// checkReshareRequest checks that the call was made by the coordinator of the keyshares to
+// reshare, and that the dealers are enough operators of the previous operator set, and returns the
+// ids of the keyshares.
+func checkReshareRequest(ctx context.Context, config *so.Config, keyshares []*pbdkg.ReshareKeyshare, dealers []string) ([]uuid.UUID, error) {
+	previous := config.PreviousOperatorSet
+	if previous == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no reshare is in progress")
+	}
+	if err := checkReshareCoordinator(ctx, config, keyshares); err != nil {
+		return nil, err
+	}
+	if _, inPrevious := previous.SigningOperatorMap[config.Identifier]; !inPrevious && !previous.AllowJoiningOperators {
+		return nil, status.Errorf(codes.FailedPrecondition, "operator %s joins the operator set, which the previous operator set does not allow", config.Identifier)
+	}
+
+	seenDealers := make(map[string]bool, len(dealers))
+	for _, dealer := range dealers {
+		if _, ok := previous.SigningOperatorMap[dealer]; !ok {
+			return nil, fmt.Errorf("dealer %s is not in the previous operator set", dealer)
+		}
+		if seenDealers[dealer] {
+			return nil, fmt.Errorf("duplicate dealer %s", dealer)
+		}
+		seenDealers[dealer] = true
+	}
+	if uint64(len(dealers)) < previous.Threshold {
+		return nil, fmt.Errorf("reshare has %d dealers, expected at least %d", len(dealers), previous.Threshold)
+	}
+	if len(keyshares) == 0 {
+		return nil, fmt.Errorf("reshare has no keyshares")
+	}
+
+	keyshareIDs := make([]uuid.UUID, len(keyshares))
+	seen := make(map[uuid.UUID]bool, len(keyshares))
+	for i, keyshare := range keyshares {
+		id, err := uuid.Parse(keyshare.Id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyshare id %s: %w", keyshare.Id, err)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("duplicate keyshare id %s", keyshare.Id)
+		}
+		if _, err := secp256k1.ParsePubKey(keyshare.PublicKey); err != nil {
+			return nil, fmt.Errorf("invalid public key of keyshare %s: %w", keyshare.Id, err)
+		}
+		if keyshare.CoordinatorIndex != keyshares[0].CoordinatorIndex {
+			return nil, fmt.Errorf("keyshares of the reshare have different coordinators")
+		}
+		seen[id] = true
+		keyshareIDs[i] = id
+	}
+	return keyshareIDs, nil
+}
+
+// checkReshareRows checks that the keyshares this operator has are still held by the previous
+// operator set, and that no other reshare or share refresh of them is pending.
+func checkReshareRows(config *so.Config, rows []*ent.SigningKeyshare) error {
+	for _, row := range rows {
+		if row.OperatorSetEpoch != config.PreviousOperatorSet.Epoch {
+			return status.Errorf(codes.FailedPrecondition, "keyshare %s is in operator set epoch %d, expected %d", row.ID, row.OperatorSetEpoch, config.PreviousOperatorSet.Epoch)
+		}
+		if row.PendingReshareID != uuid.Nil {
+			return status.Errorf(codes.FailedPrecondition, "keyshare %s has reshare %s pending", row.ID, row.PendingReshareID)
+		}
+		if row.PendingShareRefreshID != uuid.Nil {
+			return status.Errorf(codes.FailedPrecondition, "keyshare %s has share refresh %s pending", row.ID, row.PendingShareRefreshID)
+		}
+	}
+	return nil
+}
+
+// dealReshare splits this operator's weighted share of each keyshare among the current operator
+// set.
+func dealReshare(ctx context.Context, config *so.Config, requestID string, keyshareIDs []uuid.UUID, keyshares []*pbdkg.ReshareKeyshare, dealers []string) (*pbdkg.ReshareContribution, error) {
+	previous := config.PreviousOperatorSet
+	rows, err := loadResharedKeyshares(ctx, config, keyshareIDs, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) != len(keyshareIDs) {
+		return nil, fmt.Errorf("dealer is missing %d of the keyshares to reshare", len(keyshareIDs)-len(rows))
+	}
+
+	fieldModulus := secp256k1.S256().N
+	lagrangeCoefficient, err := dealerLagrangeCoefficient(config.Identifier, dealers)
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make([]string, 0, len(config.SigningOperatorMap))
+	for identifier := range config.SigningOperatorMap {
+		holders = append(holders, identifier)
+	}
+	sort.Strings(holders)
+	indices := make([]*big.Int, len(holders))
+	encryptionKeys := make([]*eciesgo.PublicKey, len(holders))
+	for i, identifier := range holders {
+		indices[i], err = identifierIndex(identifier)
+		if err != nil {
+			return nil, err
+		}
+		encryptionKeys[i], err = eciesgo.NewPublicKeyFromBytes(config.SigningOperatorMap[identifier].IdentityPublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid identity public key of operator %s: %w", identifier, err)
+		}
+	}
+
+	contribution := &pbdkg.ReshareContribution{
+		Identifier: config.Identifier,
+		Packages:   make([]*pbdkg.ResharePackage, len(rows)),
+	}
+	for i, row := range rows {
+		if row.OperatorSetEpoch != previous.Epoch {
+			return nil, fmt.Errorf("keyshare %s is in operator set epoch %d, expected %d", row.ID, row.OperatorSetEpoch, previous.Epoch)
+		}
+		if !bytes.Equal(row.PublicKey, keyshares[i].PublicKey) {
+			return nil, fmt.Errorf("public key of keyshare %s does not match", row.ID)
+		}
+		if uint64(row.MinSigners) > uint64(len(dealers)) {
+			return nil, fmt.Errorf("keyshare %s needs %d signers, reshare has %d dealers", row.ID, row.MinSigners, len(dealers))
+		}
+
+		weightedShare := new(big.Int).SetBytes(row.SecretShare)
+		weightedShare.Mul(weightedShare, lagrangeCoefficient)
+		weightedShare.Mod(weightedShare, fieldModulus)
+		shares, err := secretsharing.SplitSecretWithProofsAt(weightedShare, fieldModulus, int(config.Threshold), indices)
+		if err != nil {
+			return nil, fmt.Errorf("failed to split keyshare %s: %w", row.ID, err)
+		}
+
+		encryptedShares := make(map[string][]byte, len(holders))
+		for j, identifier := range holders {
+			encryptedShares[identifier], err = eciesgo.Encrypt(encryptionKeys[j], shares[j].Share.FillBytes(make([]byte, 32)))
+			if err != nil {
+				return nil, err
+			}
+		}
+		contribution.Packages[i] = &pbdkg.ResharePackage{
+			Commitments:     shares[0].Proofs,
+			EncryptedShares: encryptedShares,
+		}
+	}
+
+	contribution.Signature, err = signHash(config.IdentityPrivateKey, reshareContributionHash(requestID, keyshares, dealers, contribution))
+	if err != nil {
+		return nil, err
+	}
+	return contribution, nil
+}
+
+// PrepareReshare validates the contributions of the dealers, and stores the prepared reshare
+// without changing the keyshares yet. The keyshares this operator has are marked as pending, so
+// that they are neither reshared nor refreshed until the reshare is completed or aborted.
+// Preparing a reshare again has no effect.
+func PrepareReshare(ctx context.Context, config *so.Config, requestID string, keyshares []*pbdkg.ReshareKeyshare, dealers []string, contributions []*pbdkg.ReshareContribution) error {
+	reshareID, err := uuid.Parse(requestID)
+	if err != nil {
+		return fmt.Errorf("invalid reshare request id %s: %w", requestID, err)
+	}
+	keyshareIDs, err := checkReshareRequest(ctx, config, keyshares, dealers)
+	if err != nil {
+		return err
+	}
+	serializedKeyshares := make([][]byte, len(keyshares))
+	for i, keyshare := range keyshares {
+		serializedKeyshares[i], err = proto.Marshal(keyshare)
+		if err != nil {
+			return err
+		}
+	}
+
+	db := ent.GetDbFromContext(ctx)
+	existing, err := db.Reshare.Get(ctx, reshareID)
+	if err == nil {
+		if existing.Status != schema.ShareRefreshStatusPrepared || !slices.Equal(existing.Dealers, dealers) || !slices.EqualFunc(existing.Keyshares, serializedKeyshares, bytes.Equal) {
+			return status.Errorf(codes.FailedPrecondition, "reshare %s is already %s", requestID, existing.Status)
+		}
+		return nil
+	}
+	if !ent.IsNotFound(err) {
+		return err
+	}
+
+	rows, err := loadResharedKeyshares(ctx, config, keyshareIDs, true)
+	if err != nil {
+		return err
+	}
+	if err := checkReshareRows(config, rows); err != nil {
+		return err
+	}
+	if _, _, err := reshareShares(config, requestID, keyshareIDs, keyshares, dealers, contributions, rows); err != nil {
+		return err
+	}
+
+	serializedContributions := make([][]byte, len(contributions))
+	for i, contribution := range contributions {
+		serializedContributions[i], err = proto.Marshal(contribution)
+		if err != nil {
+			return err
+		}
+	}
+	err = db.Reshare.Create().
+		SetID(reshareID).
+		SetStatus(schema.ShareRefreshStatusPrepared).
+		SetCoordinatorIndex(keyshares[0].CoordinatorIndex).
+		SetKeyshares(serializedKeyshares).
+		SetDealers(dealers).
+		SetContributions(serializedContributions).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return db.SigningKeyshare.Update().
+		Where(signingkeyshare.IDIn(keyshareIDs...)).
+		SetPendingReshareID(reshareID).
+		Exec(ctx)
+}
+
+// reshareShares validates the contributions of the dealers to a reshare against the keyshares
+// this operator has, and returns the new shares of the keyshares. secretShares is nil for an
+// operator that is not in the current set.
+func reshareShares(config *so.Config, requestID string, keyshareIDs []uuid.UUID, keyshares []*pbdkg.ReshareKeyshare, dealers []string, contributions []*pbdkg.ReshareContribution, rows []*ent.SigningKeyshare) ([][]byte, []map[string][]byte, error) {
+	previous := config.PreviousOperatorSet
+
+	contributionMap := make(map[string]*pbdkg.ReshareContribution, len(contributions))
+	for _, contribution := range contributions {
+		if !slices.Contains(dealers, contribution.Identifier) {
+			return nil, nil, fmt.Errorf("reshare contribution from operator %s, which is not a dealer", contribution.Identifier)
+		}
+		operator := previous.SigningOperatorMap[contribution.Identifier]
+		hash := reshareContributionHash(requestID, keyshares, dealers, contribution)
+		if err := verifyHashSignature(hash, contribution.Signature, operator.IdentityPublicKey); err != nil {
+			return nil, nil, fmt.Errorf("invalid reshare contribution from operator %s: %w", contribution.Identifier, err)
+		}
+		if len(contribution.Packages) != len(keyshares) {
+			return nil, nil, fmt.Errorf("reshare contribution from operator %s has %d packages, expected %d", contribution.Identifier, len(contribution.Packages), len(keyshares))
+		}
+		for i, p := range contribution.Packages {
+			if uint64(len(p.Commitments)) != config.Threshold {
+				return nil, nil, fmt.Errorf("reshare contribution from operator %s has %d commitments for keyshare %s, expected %d", contribution.Identifier, len(p.Commitments), keyshares[i].Id, config.Threshold)
+			}
+		}
+		contributionMap[contribution.Identifier] = contribution
+	}
+	for _, dealer := range dealers {
+		if _, ok := contributionMap[dealer]; !ok {
+			return nil, nil, fmt.Errorf("missing reshare contribution from operator %s", dealer)
+		}
+	}
+
+	// Operators of the previous set check every dealer dealt its actual share, so that a dealer
+	// cannot make the new shares add up to a different secret with the same public key.
+	rowMap := make(map[uuid.UUID]*ent.SigningKeyshare, len(rows))
+	for _, row := range rows {
+		rowMap[row.ID] = row
+	}
+	var err error
+	lagrangeCoefficients := make(map[string]*big.Int, len(dealers))
+	for _, dealer := range dealers {
+		lagrangeCoefficients[dealer], err = dealerLagrangeCoefficient(dealer, dealers)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	fieldModulus := secp256k1.S256().N
+	_, inCurrentSet := config.SigningOperatorMap[config.Identifier]
+	var selfIndex *big.Int
+	var decryptionKey *eciesgo.PrivateKey
+	if inCurrentSet {
+		selfIndex, err = identifierIndex(config.Identifier)
+		if err != nil {
+			return nil, nil, err
+		}
+		decryptionKey = eciesgo.NewPrivateKeyFromBytes(config.IdentityPrivateKey)
+	}
+
+	var secretShares [][]byte
+	if inCurrentSet {
+		secretShares = make([][]byte, len(keyshares))
+	}
+	publicShares := make([]map[string][]byte, len(keyshares))
+	for i, keyshare := range keyshares {
+		row, hasRow := rowMap[keyshareIDs[i]]
+		if hasRow && row.OperatorSetEpoch == previous.Epoch && !bytes.Equal(row.PublicKey, keyshare.PublicKey) {
+			return nil, nil, fmt.Errorf("public key of keyshare %s does not match", keyshare.Id)
+		}
+
+		var publicKey *secp256k1.PublicKey
+		secretShare := big.NewInt(0)
+		newPublicShares := make(map[string]*secp256k1.PublicKey, len(config.SigningOperatorMap))
+		for _, dealer := range dealers {
+			resharePackage := contributionMap[dealer].Packages[i]
+			weightedPublicShare, err := secp256k1.ParsePubKey(resharePackage.Commitments[0])
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid commitments of keyshare %s from operator %s: %w", keyshare.Id, dealer, err)
+			}
+			if hasRow && row.OperatorSetEpoch == previous.Epoch {
+				expected, err := scalarMultPublicKey(row.PublicShares[dealer], lagrangeCoefficients[dealer])
+				if err != nil {
+					return nil, nil, fmt.Errorf("invalid public share of operator %s of keyshare %s: %w", dealer, keyshare.Id, err)
+				}
+				if !expected.IsEqual(weightedPublicShare) {
+					return nil, nil, fmt.Errorf("operator %s dealt a wrong share of keyshare %s", dealer, keyshare.Id)
+				}
+			}
+			if publicKey == nil {
+				publicKey = weightedPublicShare
+			} else {
+				publicKey = common.AddPublicKeysRaw(publicKey, weightedPublicShare)
+			}
+
+			for holder := range config.SigningOperatorMap {
+				holderIndex, err := identifierIndex(holder)
+				if err != nil {
+					return nil, nil, err
+				}
+				sharePublicKey, err := secretsharing.SharePublicKey(resharePackage.Commitments, holderIndex, fieldModulus)
+				if err != nil {
+					return nil, nil, fmt.Errorf("invalid commitments of keyshare %s from operator %s: %w", keyshare.Id, dealer, err)
+				}
+				if sum, ok := newPublicShares[holder]; ok {
+					newPublicShares[holder] = common.AddPublicKeysRaw(sum, sharePublicKey)
+				} else {
+					newPublicShares[holder] = sharePublicKey
+				}
+			}
+
+			if inCurrentSet {
+				shareBytes, err := eciesgo.Decrypt(decryptionKey, resharePackage.EncryptedShares[config.Identifier])
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to decrypt share of keyshare %s from operator %s: %w", keyshare.Id, dealer, err)
+				}
+				share := &secretsharing.VerifiableSecretShare{
+					SecretShare: secretsharing.SecretShare{
+						FieldModulus: fieldModulus,
+						Threshold:    int(config.Threshold),
+						Index:        selfIndex,
+						Share:        new(big.Int).SetBytes(shareBytes),
+					},
+					Proofs: resharePackage.Commitments,
+				}
+				if err := secretsharing.ValidateShare(share); err != nil {
+					return nil, nil, fmt.Errorf("invalid share of keyshare %s from operator %s: %w", keyshare.Id, dealer, err)
+				}
+				secretShare.Add(secretShare, share.Share)
+				secretShare.Mod(secretShare, fieldModulus)
+			}
+		}
+
+		if !bytes.Equal(publicKey.SerializeCompressed(), keyshare.PublicKey) {
+			return nil, nil, fmt.Errorf("reshared keyshare %s does not match its public key", keyshare.Id)
+		}
+		if inCurrentSet {
+			secretShares[i] = secretShare.FillBytes(make([]byte, 32))
+		}
+		publicShares[i] = make(map[string][]byte, len(newPublicShares))
+		for holder, publicShare := range newPublicShares {
+			publicShares[i][holder] = publicShare.SerializeCompressed()
+		}
+	}
+
+	return secretShares, publicShares, nil
+}
+
+// CommitReshare stores the new shares of a prepared reshare. Operators of the current set create
+// or update the keyshares, operators that are no longer in it erase their shares. The keyshares
+// as they were are kept, sealed at rest like the signing keyshares, until the reshare is
+// completed. Committing a reshare again has no effect.
+func CommitReshare(ctx context.Context, config *so.Config, requestID string) error {
+	row, err := loadReshare(ctx, config, requestID)
+	if err != nil {
+		return err
+	}
+	switch row.Status {
+	case schema.ShareRefreshStatusCommitted, schema.ShareRefreshStatusCompleted:
+		return nil
+	case schema.ShareRefreshStatusAborted:
+		return status.Errorf(codes.FailedPrecondition, "reshare %s was aborted", requestID)
+	}
+	if config.PreviousOperatorSet == nil {
+		return status.Errorf(codes.FailedPrecondition, "no reshare is in progress")
+	}
+
+	keyshares, keyshareIDs, err := storedReshareKeyshares(row)
+	if err != nil {
+		return err
+	}
+	contributions := make([]*pbdkg.ReshareContribution, len(row.Contributions))
+	for i, serialized := range row.Contributions {
+		contributions[i] = &pbdkg.ReshareContribution{}
+		if err := proto.Unmarshal(serialized, contributions[i]); err != nil {
+			return fmt.Errorf("invalid stored contribution of reshare %s: %w", requestID, err)
+		}
+	}
+
+	rows, err := loadResharedKeyshares(ctx, config, keyshareIDs, true)
+	if err != nil {
+		return err
+	}
+	secretShares, publicShares, err := reshareShares(config, requestID, keyshareIDs, keyshares, row.Dealers, contributions, rows)
+	if err != nil {
+		return err
+	}
+	rowMap := make(map[uuid.UUID]*ent.SigningKeyshare, len(rows))
+	for _, keyshareRow := range rows {
+		rowMap[keyshareRow.ID] = keyshareRow
+	}
+	db := ent.GetDbFromContext(ctx)
+	previousKeyshares := make([]schema.ResharedKeyshare, len(keyshareIDs))
+	for i, id := range keyshareIDs {
+		secretShare := []byte{}
+		if secretShares != nil {
+			secretShare = secretShares[i]
+		}
+		keyshareRow, ok := rowMap[id]
+		if !ok {
+			if secretShares == nil {
+				// Nothing to erase.
+				continue
+			}
+			keyshare := keyshares[i]
+			err = db.SigningKeyshare.Create().
+				SetID(id).
+				SetStatus(schema.SigningKeyshareStatus(keyshare.Status)).
+				SetSecretShare(secretShare).
+				SetPublicShares(publicShares[i]).
+				SetPublicKey(keyshare.PublicKey).
+				SetMinSigners(int32(config.Threshold)).
+				SetCoordinatorIndex(keyshare.CoordinatorIndex).
+				SetOperatorSetEpoch(config.OperatorSetEpoch).
+				SetPendingReshareID(row.ID).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create reshared keyshare %s: %w", id, err)
+			}
+			continue
+		}
+
+		previousKeyshares[i] = schema.ResharedKeyshare{
+			Existed:          true,
+			SecretShare:      keyshareRow.SecretShare,
+			PublicShares:     keyshareRow.PublicShares,
+			MinSigners:       keyshareRow.MinSigners,
+			OperatorSetEpoch: keyshareRow.OperatorSetEpoch,
+		}
+		err = db.SigningKeyshare.UpdateOne(keyshareRow).
+			SetSecretShare(secretShare).
+			SetPublicShares(publicShares[i]).
+			SetMinSigners(int32(config.Threshold)).
+			SetOperatorSetEpoch(config.OperatorSetEpoch).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update reshared keyshare %s: %w", id, err)
+		}
+	}
+	return db.Reshare.UpdateOne(row).
+		SetStatus(schema.ShareRefreshStatusCommitted).
+		SetPreviousKeyshares(previousKeyshares).
+		Exec(ctx)
+}
+
+// CompleteReshare marks a reshare every participant committed as completed, which erases the
+// keyshares as they were before it. Completing a reshare again has no effect.
+func CompleteReshare(ctx context.Context, config *so.Config, requestID string) error {
+	row, err := loadReshare(ctx, config, requestID)
+	if err != nil {
+		return err
+	}
+	switch row.Status {
+	case schema.ShareRefreshStatusCompleted:
+		return nil
+	case schema.ShareRefreshStatusPrepared, schema.ShareRefreshStatusAborted:
+		return status.Errorf(codes.FailedPrecondition, "reshare %s is %s", requestID, row.Status)
+	}
+	return finishReshare(ctx, row, schema.ShareRefreshStatusCompleted)
+}
+
+// AbortReshare aborts a reshare, and restores the keyshares as they were before it if it was
+// committed. Aborting a reshare this operator did not prepare, or aborting it again, has no
+// effect.
+func AbortReshare(ctx context.Context, config *so.Config, requestID string) error {
+	row, err := loadReshare(ctx, config, requestID)
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	switch row.Status {
+	case schema.ShareRefreshStatusAborted:
+		return nil
+	case schema.ShareRefreshStatusCompleted:
+		return status.Errorf(codes.FailedPrecondition, "reshare %s is completed", requestID)
+	case schema.ShareRefreshStatusCommitted:
+		if err := rollbackReshare(ctx, config, row); err != nil {
+			return err
+		}
+	}
+	return finishReshare(ctx, row, schema.ShareRefreshStatusAborted)
+}
+
+// rollbackReshare restores the keyshares of a committed reshare as they were before it. Keyshares
+// the reshare created are deleted.
+func rollbackReshare(ctx context.Context, config *so.Config, row *ent.Reshare) error {
+	_, keyshareIDs, err := storedReshareKeyshares(row)
+	if err != nil {
+		return err
+	}
+	if len(row.PreviousKeyshares) != len(keyshareIDs) {
+		return fmt.Errorf("reshare %s has %d previous keyshares, expected %d", row.ID, len(row.PreviousKeyshares), len(keyshareIDs))
+	}
+
+	db := ent.GetDbFromContext(ctx)
+	for i, id := range keyshareIDs {
+		previousKeyshare := row.PreviousKeyshares[i]
+		if !previousKeyshare.Existed {
+			_, err := db.SigningKeyshare.Delete().
+				Where(signingkeyshare.ID(id), signingkeyshare.PendingReshareIDEQ(row.ID)).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := db.SigningKeyshare.UpdateOneID(id).
+			SetSecretShare(previousKeyshare.SecretShare).
+			SetPublicShares(previousKeyshare.PublicShares).
+			SetMinSigners(previousKeyshare.MinSigners).
+			SetOperatorSetEpoch(previousKeyshare.OperatorSetEpoch).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to restore keyshare %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// storedReshareKeyshares returns the keyshares of a stored reshare and their ids.
+func storedReshareKeyshares(row *ent.Reshare) ([]*pbdkg.ReshareKeyshare, []uuid.UUID, error) {
+	keyshares := make([]*pbdkg.ReshareKeyshare, len(row.Keyshares))
+	keyshareIDs := make([]uuid.UUID, len(row.Keyshares))
+	for i, serialized := range row.Keyshares {
+		keyshares[i] = &pbdkg.ReshareKeyshare{}
+		if err := proto.Unmarshal(serialized, keyshares[i]); err != nil {
+			return nil, nil, fmt.Errorf("invalid stored keyshare of reshare %s: %w", row.ID, err)
+		}
+		id, err := uuid.Parse(keyshares[i].Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		keyshareIDs[i] = id
+	}
+	return keyshares, keyshareIDs, nil
+}
+
+// loadReshare loads this operator's state of a reshare for an update, and checks that the call
+// was made by its coordinator.
+func loadReshare(ctx context.Context, config *so.Config, requestID string) (*ent.Reshare, error) {
+	reshareID, err := uuid.Parse(requestID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reshare request id %s: %w", requestID, err)
+	}
+	query := ent.GetDbFromContext(ctx).Reshare.Query().Where(reshare.ID(reshareID))
+	// SQLite serializes writes anyway and does not support row locks.
+	if config.DatabaseDriver() == "postgres" {
+		query = query.ForUpdate()
+	}
+	row, err := query.Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "reshare %s does not exist", requestID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCoordinator(ctx, config, row.CoordinatorIndex); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// finishReshare sets the final status of a reshare, forgets its contributions and the keyshares
+// as they were before it, and releases its keyshares.
+func finishReshare(ctx context.Context, row *ent.Reshare, reshareStatus schema.ShareRefreshStatus) error {
+	db := ent.GetDbFromContext(ctx)
+	err := db.Reshare.UpdateOne(row).
+		SetStatus(reshareStatus).
+		ClearContributions().
+		ClearPreviousKeyshares().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return db.SigningKeyshare.Update().
+		Where(signingkeyshare.PendingReshareIDEQ(row.ID)).
+		ClearPendingReshareID().
+		Exec(ctx)
+}
+
+// checkReshareCoordinator checks that the call of the context was made by the coordinator of the
+// keyshares to reshare. Keyshares this operator has must be coordinated by the operator its own rows
+// record. The others, which operators that join the operator set do not have yet, are coordinated
+// by the operator the request names.
+func checkReshareCoordinator(ctx context.Context, config *so.Config, keyshares []*pbdkg.ReshareKeyshare) error {
+	keyshareIDs := make([]uuid.UUID, 0, len(keyshares))
+	for _, keyshare := range keyshares {
+		id, err := uuid.Parse(keyshare.Id)
+		if err != nil {
+			return fmt.Errorf("invalid keyshare id %s: %w", keyshare.Id, err)
+		}
+		keyshareIDs = append(keyshareIDs, id)
+	}
+	rows, err := loadResharedKeyshares(ctx, config, keyshareIDs, false)
+	if err != nil {
+		return err
+	}
+	recorded := make(map[uuid.UUID]uint64, len(rows))
+	for _, row := range rows {
+		recorded[row.ID] = row.CoordinatorIndex
+	}
+
+	coordinators := make(map[uint64]bool)
+	for i, keyshare := range keyshares {
+		coordinatorIndex, ok := recorded[keyshareIDs[i]]
+		if ok && coordinatorIndex != keyshare.CoordinatorIndex {
+			return fmt.Errorf("keyshare %s is coordinated by %d, not %d", keyshare.Id, coordinatorIndex, keyshare.CoordinatorIndex)
+		}
+		coordinators[keyshare.CoordinatorIndex] = true
+	}
+	for coordinatorIndex := range coordinators {
+		if err := checkCoordinator(ctx, config, coordinatorIndex); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadResharedKeyshares loads the keyshares being reshared that this operator has, in the given
+// order, skipping the ones it does not have. With forUpdate, they are locked for an update.
+func loadResharedKeyshares(ctx context.Context, config *so.Config, keyshareIDs []uuid.UUID, forUpdate bool) ([]*ent.SigningKeyshare, error) {
+	db := ent.GetDbFromContext(ctx)
+	query := db.SigningKeyshare.Query().Where(signingkeyshare.IDIn(keyshareIDs...))
+	// Keyshares can be tweaked concurrently. SQLite serializes writes anyway and does not support
+	// row locks.
+	if forUpdate && config.DatabaseDriver() == "postgres" {
+		query = query.ForUpdate()
+	}
+	keyshares, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyshareMap := make(map[uuid.UUID]*ent.SigningKeyshare, len(keyshares))
+	for _, keyshare := range keyshares {
+		keyshareMap[keyshare.ID] = keyshare
+	}
+
+	result := make([]*ent.SigningKeyshare, 0, len(keyshareIDs))
+	for _, id := range keyshareIDs {
+		if keyshare, ok := keyshareMap[id]; ok {
+			result = append(result, keyshare)
+		}
+	}
+	return result, nil
+}
+
+// dealerLagrangeCoefficient returns the Lagrange coefficient at zero of a dealer among all
+// dealers.
+func dealerLagrangeCoefficient(dealer string, dealers []string) (*big.Int, error) {
+	fieldModulus := secp256k1.S256().N
+	points := make([]*secretsharing.SecretShare, len(dealers))
+	for i, identifier := range dealers {
+		index, err := identifierIndex(identifier)
+		if err != nil {
+			return nil, err
+		}
+		points[i] = &secretsharing.SecretShare{FieldModulus: fieldModulus, Index: index}
+	}
+	index, err := identifierIndex(dealer)
+	if err != nil {
+		return nil, err
+	}
+	return secretsharing.ComputeLagrangeCoefficients(index, points)
+}
+
+// scalarMultPublicKey multiplies a serialized public key by a scalar.
+func scalarMultPublicKey(publicKey []byte, scalar *big.Int) (*secp256k1.PublicKey, error) {
+	pub, err := secp256k1.ParsePubKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	x, y := secp256k1.S256().ScalarMult(pub.X(), pub.Y(), scalar.Bytes())
+	return common.PublicKeyFromInts(x, y), nil
+}
@@ -0,0 +1,372 @@
+package dkg
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/google/uuid"
+	secretsharing "github.com/lightsparkdev/spark/common/secret_sharing"
+	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/utils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type reshareTestOperator struct {
+	config *so.Config
+	ctx    context.Context
+}
+
+// coordinated returns the context of a call to the operator made by the coordinator.
+func (o *reshareTestOperator) coordinated() context.Context {
+	coordinator, _ := o.config.SigningOperator(utils.IndexToIdentifier(0))
+	return so.WithCallingOperator(o.ctx, coordinator)
+}
+
+// reshareTestClient calls the reshare methods of an operator in process.
+type reshareTestClient struct {
+	pbdkg.DKGServiceClient
+	operator *reshareTestOperator
+	fail     bool
+}
+
+func (c *reshareTestClient) InitiateReshare(_ context.Context, req *pbdkg.InitiateReshareRequest, _ ...grpc.CallOption) (*pbdkg.InitiateReshareResponse, error) {
+	if c.fail {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	contribution, err := InitiateReshare(c.operator.coordinated(), c.operator.config, req.RequestId, req.Keyshares, req.DealerIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	return &pbdkg.InitiateReshareResponse{Contribution: contribution}, nil
+}
+
+func (c *reshareTestClient) PrepareReshare(_ context.Context, req *pbdkg.PrepareReshareRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, PrepareReshare(c.operator.coordinated(), c.operator.config, req.RequestId, req.Keyshares, req.DealerIdentifiers, req.Contributions)
+}
+
+func (c *reshareTestClient) CommitReshare(_ context.Context, req *pbdkg.CommitReshareRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, CommitReshare(c.operator.coordinated(), c.operator.config, req.RequestId)
+}
+
+func (c *reshareTestClient) CompleteReshare(_ context.Context, req *pbdkg.CompleteReshareRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, CompleteReshare(c.operator.coordinated(), c.operator.config, req.RequestId)
+}
+
+func (c *reshareTestClient) AbortReshare(_ context.Context, req *pbdkg.AbortReshareRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, AbortReshare(c.operator.coordinated(), c.operator.config, req.RequestId)
+}
+
+// newReshareTestOperators creates operators 0 to count-1. The previous operator set is made of the
+// operators in previous, the current one of the operators in current.
+func newReshareTestOperators(t *testing.T, count int, previous []int, previousThreshold uint64, current []int, threshold uint64) []*reshareTestOperator {
+	signingOperators := make([]*so.SigningOperator, count)
+	identityKeys := make([]*secp256k1.PrivateKey, count)
+	for i := range signingOperators {
+		identityKey, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		identityKeys[i] = identityKey
+		signingOperators[i] = &so.SigningOperator{
+			ID:                uint64(i),
+			Identifier:        utils.IndexToIdentifier(uint64(i)),
+			IdentityPublicKey: identityKey.PubKey().SerializeCompressed(),
+		}
+	}
+	operatorMap := func(indices []int) map[string]*so.SigningOperator {
+		m := make(map[string]*so.SigningOperator, len(indices))
+		for _, i := range indices {
+			m[signingOperators[i].Identifier] = signingOperators[i]
+		}
+		return m
+	}
+	previousOperatorSet := &so.OperatorSet{
+		Epoch:                 0,
+		Threshold:             previousThreshold,
+		SigningOperatorMap:    operatorMap(previous),
+		AllowJoiningOperators: true,
+	}
+	currentOperatorMap := operatorMap(current)
+
+	operators := make([]*reshareTestOperator, count)
+	for i := range operators {
+		db := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s_%d?mode=memory&_fk=1", t.Name(), i))
+		t.Cleanup(func() { db.Close() })
+		tx, err := db.Tx(context.Background())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = tx.Rollback() })
+
+		operators[i] = &reshareTestOperator{
+			config: &so.Config{
+				Index:               uint64(i),
+				Identifier:          signingOperators[i].Identifier,
+				IdentityPrivateKey:  identityKeys[i].Serialize(),
+				SigningOperatorMap:  currentOperatorMap,
+				Threshold:           threshold,
+				OperatorSetEpoch:    1,
+				PreviousOperatorSet: previousOperatorSet,
+			},
+			ctx: context.WithValue(context.Background(), ent.TxKey, tx),
+		}
+	}
+	return operators
+}
+
+// createReshareTestKeyshare splits a new secret among the operators of the previous set, and
+// returns it with the keyshare to reshare.
+func createReshareTestKeyshare(t *testing.T, operators []*reshareTestOperator, previous []int, threshold int) (*secp256k1.PrivateKey, *pbdkg.ReshareKeyshare) {
+	secret, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	shares, err := secretsharing.SplitSecret(new(big.Int).SetBytes(secret.Serialize()), secp256k1.S256().N, threshold, len(previous))
+	require.NoError(t, err)
+	publicShares := make(map[string][]byte, len(previous))
+	for i := range previous {
+		publicShares[operators[i].config.Identifier] = secp256k1.NewPrivateKey(secretsharing.IntToScalar(shares[i].Share)).PubKey().SerializeCompressed()
+	}
+
+	keyshareID := schema.NewID()
+	for i := range previous {
+		_, err := ent.GetDbFromContext(operators[i].ctx).SigningKeyshare.Create().
+			SetID(keyshareID).
+			SetStatus(schema.KeyshareStatusInUse).
+			SetSecretShare(shares[i].Share.FillBytes(make([]byte, 32))).
+			SetPublicShares(publicShares).
+			SetPublicKey(secret.PubKey().SerializeCompressed()).
+			SetMinSigners(int32(threshold)).
+			SetCoordinatorIndex(0).
+			Save(operators[i].ctx)
+		require.NoError(t, err)
+	}
+	return secret, &pbdkg.ReshareKeyshare{
+		Id:               keyshareID.String(),
+		PublicKey:        secret.PubKey().SerializeCompressed(),
+		Status:           string(schema.KeyshareStatusInUse),
+		CoordinatorIndex: 0,
+	}
+}
+
+// prepareReshareTest initiates and prepares a reshare of the keyshares on all operators.
+func prepareReshareTest(t *testing.T, operators []*reshareTestOperator, keyshares []*pbdkg.ReshareKeyshare, dealers []string) string {
+	requestID := schema.NewID().String()
+	contributions := make([]*pbdkg.ReshareContribution, 0, len(dealers))
+	for _, operator := range operators {
+		contribution, err := InitiateReshare(operator.coordinated(), operator.config, requestID, keyshares, dealers)
+		require.NoError(t, err)
+		if contribution != nil {
+			contributions = append(contributions, contribution)
+		}
+	}
+	for _, operator := range operators {
+		require.NoError(t, PrepareReshare(operator.coordinated(), operator.config, requestID, keyshares, dealers, contributions))
+	}
+	return requestID
+}
+
+// recoverResharedSecret recovers the secret of a keyshare from the shares of the given operators.
+func recoverResharedSecret(t *testing.T, operators []*reshareTestOperator, keyshareID uuid.UUID, threshold int) *big.Int {
+	shares := make([]*secretsharing.SecretShare, len(operators))
+	for i, operator := range operators {
+		keyshare, err := ent.GetDbFromContext(operator.ctx).SigningKeyshare.Get(operator.ctx, keyshareID)
+		require.NoError(t, err)
+		index, err := identifierIndex(operator.config.Identifier)
+		require.NoError(t, err)
+		shares[i] = &secretsharing.SecretShare{
+			FieldModulus: secp256k1.S256().N,
+			Threshold:    threshold,
+			Index:        index,
+			Share:        new(big.Int).SetBytes(keyshare.SecretShare),
+		}
+	}
+	recovered, err := secretsharing.RecoverSecret(shares)
+	require.NoError(t, err)
+	return recovered
+}
+
+func TestReshare(t *testing.T) {
+	// Operator 2 leaves and operator 3 joins.
+	previous, current := []int{0, 1, 2}, []int{0, 1, 3}
+	operators := newReshareTestOperators(t, 4, previous, 2, current, 2)
+	secret, keyshare := createReshareTestKeyshare(t, operators, previous, 2)
+	keyshareID := uuid.MustParse(keyshare.Id)
+
+	requestID := schema.NewID().String()
+	keyshares := []*pbdkg.ReshareKeyshare{keyshare}
+	dealers := []string{operators[0].config.Identifier, operators[1].config.Identifier}
+
+	// Only the coordinator of the keyshares can start their reshare.
+	other := operators[1].config.SigningOperatorMap[operators[1].config.Identifier]
+	_, err := InitiateReshare(so.WithCallingOperator(operators[3].ctx, other), operators[3].config, requestID, keyshares, dealers)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// A dealer has to be in the previous operator set.
+	_, err = InitiateReshare(operators[0].coordinated(), operators[0].config, requestID, keyshares, []string{operators[0].config.Identifier, operators[3].config.Identifier})
+	require.ErrorContains(t, err, "is not in the previous operator set")
+
+	// Joining operators only take part once the previous operator set allows them.
+	operators[3].config.PreviousOperatorSet.AllowJoiningOperators = false
+	_, err = InitiateReshare(operators[3].coordinated(), operators[3].config, requestID, keyshares, dealers)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Equal(t, []string{operators[3].config.Identifier}, joiningOperators(operators[3].config))
+	operators[3].config.PreviousOperatorSet.AllowJoiningOperators = true
+
+	contributions := make([]*pbdkg.ReshareContribution, 0, len(dealers))
+	for i, operator := range operators {
+		contribution, err := InitiateReshare(operator.coordinated(), operator.config, requestID, keyshares, dealers)
+		require.NoError(t, err)
+		if i < len(dealers) {
+			require.NotNil(t, contribution)
+			contributions = append(contributions, contribution)
+		} else {
+			require.Nil(t, contribution)
+		}
+	}
+
+	// A contribution the coordinator tampered with is rejected.
+	tampered := proto.Clone(contributions[1]).(*pbdkg.ReshareContribution)
+	tampered.Packages[0].Commitments[0] = contributions[0].Packages[0].Commitments[0]
+	err = PrepareReshare(operators[3].coordinated(), operators[3].config, requestID, keyshares, dealers, []*pbdkg.ReshareContribution{contributions[0], tampered})
+	require.ErrorContains(t, err, "invalid reshare contribution")
+
+	// Every dealer has to contribute.
+	err = PrepareReshare(operators[3].coordinated(), operators[3].config, requestID, keyshares, dealers, contributions[:1])
+	require.ErrorContains(t, err, "missing reshare contribution")
+
+	for _, operator := range operators {
+		require.NoError(t, PrepareReshare(operator.coordinated(), operator.config, requestID, keyshares, dealers, contributions))
+		// Preparing again has no effect.
+		require.NoError(t, PrepareReshare(operator.coordinated(), operator.config, requestID, keyshares, dealers, contributions))
+	}
+	// The keyshares are not reshared again while the reshare is pending.
+	_, err = InitiateReshare(operators[0].coordinated(), operators[0].config, schema.NewID().String(), keyshares, dealers)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	for _, operator := range operators {
+		require.NoError(t, CommitReshare(operator.coordinated(), operator.config, requestID))
+		// Committing again does not apply the reshare twice.
+		require.NoError(t, CommitReshare(operator.coordinated(), operator.config, requestID))
+	}
+	for _, operator := range operators {
+		require.NoError(t, CompleteReshare(operator.coordinated(), operator.config, requestID))
+		// A completed reshare cannot be rolled back.
+		err = AbortReshare(operator.coordinated(), operator.config, requestID)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		row, err := ent.GetDbFromContext(operator.ctx).Reshare.Get(operator.ctx, uuid.MustParse(requestID))
+		require.NoError(t, err)
+		require.Equal(t, schema.ShareRefreshStatusCompleted, row.Status)
+		require.Empty(t, row.Contributions)
+		require.Empty(t, row.PreviousKeyshares)
+	}
+
+	var newPublicShares map[string][]byte
+	for _, i := range current {
+		operator := operators[i]
+		keyshare, err := ent.GetDbFromContext(operator.ctx).SigningKeyshare.Get(operator.ctx, keyshareID)
+		require.NoError(t, err)
+		require.Equal(t, secret.PubKey().SerializeCompressed(), keyshare.PublicKey)
+		require.Equal(t, uint64(1), keyshare.OperatorSetEpoch)
+		require.Equal(t, schema.KeyshareStatusInUse, keyshare.Status)
+		require.Equal(t, uuid.Nil, keyshare.PendingReshareID)
+		require.Len(t, keyshare.PublicShares, len(current))
+		require.Equal(t, secp256k1.PrivKeyFromBytes(keyshare.SecretShare).PubKey().SerializeCompressed(), keyshare.PublicShares[operator.config.Identifier])
+		if newPublicShares == nil {
+			newPublicShares = keyshare.PublicShares
+		}
+		// Every operator agrees on every operator's new public share.
+		require.Equal(t, newPublicShares, keyshare.PublicShares)
+	}
+
+	// Any threshold of the current operator set recovers the secret, including the new operator.
+	recovered := recoverResharedSecret(t, []*reshareTestOperator{operators[1], operators[3]}, keyshareID, 2)
+	require.Equal(t, new(big.Int).SetBytes(secret.Serialize()), recovered)
+
+	// The operator that left erased its share.
+	removed, err := ent.GetDbFromContext(operators[2].ctx).SigningKeyshare.Get(operators[2].ctx, keyshareID)
+	require.NoError(t, err)
+	require.Empty(t, removed.SecretShare)
+	require.Equal(t, uint64(1), removed.OperatorSetEpoch)
+	require.Equal(t, newPublicShares, removed.PublicShares)
+}
+
+func TestReshareRollback(t *testing.T) {
+	previous, current := []int{0, 1, 2}, []int{0, 1, 3}
+	operators := newReshareTestOperators(t, 4, previous, 2, current, 2)
+	_, keyshare := createReshareTestKeyshare(t, operators, previous, 2)
+	keyshareID := uuid.MustParse(keyshare.Id)
+	original := make([]*ent.SigningKeyshare, len(previous))
+	for i := range previous {
+		var err error
+		original[i], err = ent.GetDbFromContext(operators[i].ctx).SigningKeyshare.Get(operators[i].ctx, keyshareID)
+		require.NoError(t, err)
+	}
+
+	dealers := []string{operators[0].config.Identifier, operators[1].config.Identifier}
+	requestID := prepareReshareTest(t, operators, []*pbdkg.ReshareKeyshare{keyshare}, dealers)
+	for _, i := range []int{0, 2, 3} {
+		require.NoError(t, CommitReshare(operators[i].coordinated(), operators[i].config, requestID))
+	}
+	for _, operator := range operators {
+		require.NoError(t, AbortReshare(operator.coordinated(), operator.config, requestID))
+		// An aborted reshare cannot be committed.
+		err := CommitReshare(operator.coordinated(), operator.config, requestID)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	}
+
+	// The operators are back to their shares from before the reshare, and the joining operator
+	// no longer has the keyshare.
+	for i := range previous {
+		keyshare, err := ent.GetDbFromContext(operators[i].ctx).SigningKeyshare.Get(operators[i].ctx, keyshareID)
+		require.NoError(t, err)
+		require.Equal(t, original[i].SecretShare, keyshare.SecretShare)
+		require.Equal(t, original[i].PublicShares, keyshare.PublicShares)
+		require.Equal(t, original[i].MinSigners, keyshare.MinSigners)
+		require.Equal(t, uint64(0), keyshare.OperatorSetEpoch)
+		require.Equal(t, uuid.Nil, keyshare.PendingReshareID)
+	}
+	_, err := ent.GetDbFromContext(operators[3].ctx).SigningKeyshare.Get(operators[3].ctx, keyshareID)
+	require.True(t, ent.IsNotFound(err))
+}
+
+func TestReshareWithoutFailedDealer(t *testing.T) {
+	previous, current := []int{0, 1, 2}, []int{0, 1, 2}
+	operators := newReshareTestOperators(t, 3, previous, 2, current, 2)
+	secret, keyshare := createReshareTestKeyshare(t, operators, previous, 2)
+	clients := make([]*reshareTestClient, len(operators))
+	clientMap := make(map[string]pbdkg.DKGServiceClient, len(operators))
+	for i, operator := range operators {
+		clients[i] = &reshareTestClient{operator: operator}
+		clientMap[operator.config.Identifier] = clients[i]
+	}
+	coordinator := operators[0]
+	participants := shareRefreshParticipants(coordinator.config, clientMap)
+	keyshares := []*pbdkg.ReshareKeyshare{keyshare}
+
+	// A dealer that fails is reported, so that the reshare is started again without it.
+	clients[1].fail = true
+	dealers := []string{operators[0].config.Identifier, operators[1].config.Identifier}
+	_, failedDealer, err := reshareWithDealers(coordinator.ctx, coordinator.config, clientMap, participants, keyshares, dealers)
+	require.Error(t, err)
+	require.Equal(t, operators[1].config.Identifier, failedDealer)
+
+	clients[1].fail = false
+	dealers = []string{operators[0].config.Identifier, operators[2].config.Identifier}
+	requestID, _, err := reshareWithDealers(coordinator.ctx, coordinator.config, clientMap, participants, keyshares, dealers)
+	require.NoError(t, err)
+	for _, operator := range operators {
+		row, err := ent.GetDbFromContext(operator.ctx).Reshare.Get(operator.ctx, uuid.MustParse(requestID))
+		require.NoError(t, err)
+		require.Equal(t, schema.ShareRefreshStatusCompleted, row.Status)
+	}
+	recovered := recoverResharedSecret(t, operators[:2], uuid.MustParse(keyshare.Id), 2)
+	require.Equal(t, new(big.Int).SetBytes(secret.Serialize()), recovered)
+}
@@ -0,0 +1,670 @@
+package dkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	eciesgo "github.com/ecies/go/v2"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/common"
+	"github.com/lightsparkdev/spark/common/logging"
+	secretsharing "github.com/lightsparkdev/spark/common/secret_sharing"
+	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// shareRefreshStateTTL is how long the coordinator waits for a prepared share refresh to be
+// committed before it aborts it.
+const shareRefreshStateTTL = 10 * time.Minute
+
+// RefreshShares runs a proactive share refresh of up to batchSize keyshares this operator
+// coordinates, oldest refresh first, and returns the number of keyshares refreshed.
+//
+// Every participant deals shares of zero for every keyshare to all operators holding it. Each
+// operator adds the shares it received to its secret share, and the matching public keys to the
+// public shares, so the secret and the public key stay the same while shares from before the
+// refresh become useless in combination with shares from after it.
+//
+// Every participant stores the refresh when it is prepared, and keeps what it needs to roll it
+// back until every participant committed it. The coordinator prepares first, and refreshes that
+// were interrupted are finished before a new one starts.
+//
+// Signing with a keyshare fails while the refresh is committed on some operators but not yet on
+// others.
+func RefreshShares(ctx context.Context, config *so.Config, batchSize int) (int, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	// Init clients
+	clientMap := make(map[string]pbdkg.DKGServiceClient)
+	for identifier, operator := range config.SigningOperatorMap {
+		connection, err := config.NewOperatorConnection(operator)
+		if err != nil {
+			return 0, err
+		}
+		defer connection.Close()
+		clientMap[identifier] = pbdkg.NewDKGServiceClient(connection)
+	}
+	participants := shareRefreshParticipants(config, clientMap)
+
+	if err := resumeShareRefreshes(ctx, config, clientMap, participants); err != nil {
+		return 0, err
+	}
+
+	db := ent.GetDbFromContext(ctx)
+	keyshareIDs, err := db.SigningKeyshare.Query().
+		Where(
+			signingkeyshare.CoordinatorIndexEQ(config.Index),
+			signingkeyshare.OperatorSetEpochEQ(config.OperatorSetEpoch),
+			signingkeyshare.PendingShareRefreshIDIsNil(),
+			signingkeyshare.PendingReshareIDIsNil(),
+			signingkeyshare.Or(
+				signingkeyshare.ShareRefreshTimeIsNil(),
+				signingkeyshare.ShareRefreshTimeLT(time.Now().Add(-spark.ShareRefreshInterval)),
+			),
+		).
+		Order(ent.Asc(signingkeyshare.FieldID)).
+		Limit(batchSize).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(keyshareIDs) == 0 {
+		return 0, nil
+	}
+
+	requestID, err := uuid.NewV7()
+	if err != nil {
+		return 0, err
+	}
+	requestIDString := requestID.String()
+	keyshareIDStrings := make([]string, len(keyshareIDs))
+	for i, id := range keyshareIDs {
+		keyshareIDStrings[i] = id.String()
+	}
+
+	contributions := make([]*pbdkg.ShareRefreshContribution, 0, len(participants))
+	for _, identifier := range participants {
+		response, err := clientMap[identifier].InitiateShareRefresh(ctx, &pbdkg.InitiateShareRefreshRequest{
+			RequestId:   requestIDString,
+			KeyshareIds: keyshareIDStrings,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to initiate share refresh on operator %s: %w", identifier, err)
+		}
+		contributions = append(contributions, response.Contribution)
+	}
+
+	for _, identifier := range participants {
+		_, err := clientMap[identifier].PrepareShareRefresh(ctx, &pbdkg.PrepareShareRefreshRequest{
+			RequestId:     requestIDString,
+			Contributions: contributions,
+			KeyshareIds:   keyshareIDStrings,
+		})
+		if err != nil {
+			err = fmt.Errorf("failed to prepare share refresh on operator %s: %w", identifier, err)
+			return 0, errors.Join(err, shareRefreshCommit(clientMap, requestIDString).abortAll(ctx, participants))
+		}
+	}
+
+	if err := shareRefreshCommit(clientMap, requestIDString).commitAll(ctx, participants); err != nil {
+		return 0, err
+	}
+	logger.Info("Refreshed keyshares", "request_id", requestIDString, "count", len(keyshareIDs))
+	return len(keyshareIDs), nil
+}
+
+// shareRefreshParticipants returns the identifiers of the participants of the share refreshes this
+// operator coordinates, this operator first.
+func shareRefreshParticipants(config *so.Config, clientMap map[string]pbdkg.DKGServiceClient) []string {
+	participants := make([]string, 0, len(clientMap))
+	for identifier := range clientMap {
+		if identifier != config.Identifier {
+			participants = append(participants, identifier)
+		}
+	}
+	sort.Strings(participants)
+	return append([]string{config.Identifier}, participants...)
+}
+
+// resumeShareRefreshes finishes the share refreshes this operator coordinates that were
+// interrupted. Refreshes the coordinator committed are committed on the other participants, and
+// refreshes it prepared but did not commit in time are aborted.
+func resumeShareRefreshes(ctx context.Context, config *so.Config, clientMap map[string]pbdkg.DKGServiceClient, participants []string) error {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	db := ent.GetDbFromContext(ctx)
+	refreshes, err := db.ShareRefresh.Query().
+		Where(
+			sharerefresh.CoordinatorIndexEQ(config.Index),
+			sharerefresh.StatusIn(schema.ShareRefreshStatusPrepared, schema.ShareRefreshStatusCommitted),
+		).
+		Order(ent.Asc(sharerefresh.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, refresh := range refreshes {
+		requestID := refresh.ID.String()
+		if refresh.Status == schema.ShareRefreshStatusCommitted {
+			logger.Info("Resuming share refresh", "request_id", requestID)
+			err = shareRefreshCommit(clientMap, requestID).commitAll(ctx, participants)
+		} else if time.Since(refresh.CreateTime) > shareRefreshStateTTL {
+			logger.Info("Aborting share refresh", "request_id", requestID)
+			err = shareRefreshCommit(clientMap, requestID).abortAll(ctx, participants)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shareRefreshCommit returns how the coordinator commits, completes and aborts a share refresh
+// on the participants.
+func shareRefreshCommit(clientMap map[string]pbdkg.DKGServiceClient, requestID string) *participantCommit {
+	return &participantCommit{
+		name:      "share refresh",
+		requestID: requestID,
+		commit: func(ctx context.Context, identifier string) error {
+			_, err := clientMap[identifier].CommitShareRefresh(ctx, &pbdkg.CommitShareRefreshRequest{RequestId: requestID})
+			return err
+		},
+		complete: func(ctx context.Context, identifier string) error {
+			_, err := clientMap[identifier].CompleteShareRefresh(ctx, &pbdkg.CompleteShareRefreshRequest{RequestId: requestID})
+			return err
+		},
+		abort: func(ctx context.Context, identifier string) error {
+			_, err := clientMap[identifier].AbortShareRefresh(ctx, &pbdkg.AbortShareRefreshRequest{RequestId: requestID})
+			return err
+		},
+	}
+}
+
+// InitiateShareRefresh deals shares of zero for the given keyshares to every operator holding
+// them, and returns them signed with this operator's identity key.
+func InitiateShareRefresh(ctx context.Context, config *so.Config, requestID string, keyshareIDStrings []string) (*pbdkg.ShareRefreshContribution, error) {
+	keyshareIDs, err := parseShareRefreshKeyshareIDs(keyshareIDStrings)
+	if err != nil {
+		return nil, err
+	}
+	keyshares, err := loadRefreshedKeyshares(ctx, config, keyshareIDs, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyshare := range keyshares {
+		if err := checkCoordinator(ctx, config, keyshare.CoordinatorIndex); err != nil {
+			return nil, err
+		}
+		if keyshare.PendingShareRefreshID != uuid.Nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "keyshare %s has share refresh %s pending", keyshare.ID, keyshare.PendingShareRefreshID)
+		}
+		if keyshare.PendingReshareID != uuid.Nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "keyshare %s has reshare %s pending", keyshare.ID, keyshare.PendingReshareID)
+		}
+	}
+
+	fieldModulus := secp256k1.S256().N
+	contribution := &pbdkg.ShareRefreshContribution{
+		Identifier: config.Identifier,
+		Packages:   make([]*pbdkg.ShareRefreshPackage, len(keyshares)),
+	}
+	for i, keyshare := range keyshares {
+		identifiers := make([]string, 0, len(keyshare.PublicShares))
+		for identifier := range keyshare.PublicShares {
+			identifiers = append(identifiers, identifier)
+		}
+		sort.Strings(identifiers)
+
+		indices := make([]*big.Int, len(identifiers))
+		for j, identifier := range identifiers {
+			indices[j], err = identifierIndex(identifier)
+			if err != nil {
+				return nil, err
+			}
+		}
+		shares, err := secretsharing.SplitZeroWithProofs(fieldModulus, int(keyshare.MinSigners), indices)
+		if err != nil {
+			return nil, fmt.Errorf("failed to split zero for keyshare %s: %w", keyshare.ID, err)
+		}
+
+		encryptedShares := make(map[string][]byte, len(identifiers))
+		for j, identifier := range identifiers {
+			operator, ok := config.SigningOperatorMap[identifier]
+			if !ok {
+				return nil, fmt.Errorf("keyshare %s is held by unknown operator %s", keyshare.ID, identifier)
+			}
+			publicKey, err := eciesgo.NewPublicKeyFromBytes(operator.IdentityPublicKey)
+			if err != nil {
+				return nil, fmt.Errorf("invalid identity public key of operator %s: %w", identifier, err)
+			}
+			encryptedShares[identifier], err = eciesgo.Encrypt(publicKey, shares[j].Share.FillBytes(make([]byte, 32)))
+			if err != nil {
+				return nil, err
+			}
+		}
+		contribution.Packages[i] = &pbdkg.ShareRefreshPackage{
+			Commitments:     shares[0].Proofs,
+			EncryptedShares: encryptedShares,
+		}
+	}
+
+	contribution.Signature, err = signHash(config.IdentityPrivateKey, shareRefreshContributionHash(requestID, keyshareIDStrings, contribution))
+	if err != nil {
+		return nil, err
+	}
+	return contribution, nil
+}
+
+// PrepareShareRefresh validates the shares of zero this operator received from every operator
+// holding the keyshares, and stores the prepared refresh without changing the keyshares yet. The
+// keyshares are marked as pending, so that they are not refreshed again until the refresh is
+// completed or aborted. Preparing a refresh again has no effect.
+func PrepareShareRefresh(ctx context.Context, config *so.Config, requestID string, keyshareIDStrings []string, contributions []*pbdkg.ShareRefreshContribution) error {
+	refreshID, err := uuid.Parse(requestID)
+	if err != nil {
+		return fmt.Errorf("invalid share refresh request id %s: %w", requestID, err)
+	}
+	keyshareIDs, err := parseShareRefreshKeyshareIDs(keyshareIDStrings)
+	if err != nil {
+		return err
+	}
+	if len(keyshareIDs) == 0 {
+		return fmt.Errorf("share refresh %s has no keyshares", requestID)
+	}
+
+	db := ent.GetDbFromContext(ctx)
+	existing, err := db.ShareRefresh.Get(ctx, refreshID)
+	if err == nil {
+		if existing.Status != schema.ShareRefreshStatusPrepared || !slices.Equal(existing.KeyshareIds, keyshareIDs) {
+			return status.Errorf(codes.FailedPrecondition, "share refresh %s is already %s", requestID, existing.Status)
+		}
+		return checkCoordinator(ctx, config, existing.CoordinatorIndex)
+	}
+	if !ent.IsNotFound(err) {
+		return err
+	}
+
+	keyshares, err := loadRefreshedKeyshares(ctx, config, keyshareIDs, true)
+	if err != nil {
+		return err
+	}
+	coordinatorIndex := keyshares[0].CoordinatorIndex
+	for _, keyshare := range keyshares {
+		if keyshare.CoordinatorIndex != coordinatorIndex {
+			return fmt.Errorf("keyshares of share refresh %s have different coordinators", requestID)
+		}
+		if keyshare.PendingShareRefreshID != uuid.Nil {
+			return status.Errorf(codes.FailedPrecondition, "keyshare %s has share refresh %s pending", keyshare.ID, keyshare.PendingShareRefreshID)
+		}
+		if keyshare.PendingReshareID != uuid.Nil {
+			return status.Errorf(codes.FailedPrecondition, "keyshare %s has reshare %s pending", keyshare.ID, keyshare.PendingReshareID)
+		}
+	}
+	if err := checkCoordinator(ctx, config, coordinatorIndex); err != nil {
+		return err
+	}
+	if _, _, err := shareRefreshTweaks(config, requestID, keyshareIDStrings, keyshares, contributions); err != nil {
+		return err
+	}
+
+	serializedContributions := make([][]byte, len(contributions))
+	for i, contribution := range contributions {
+		serializedContributions[i], err = proto.Marshal(contribution)
+		if err != nil {
+			return err
+		}
+	}
+	err = db.ShareRefresh.Create().
+		SetID(refreshID).
+		SetStatus(schema.ShareRefreshStatusPrepared).
+		SetCoordinatorIndex(coordinatorIndex).
+		SetKeyshareIds(keyshareIDs).
+		SetContributions(serializedContributions).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return db.SigningKeyshare.Update().
+		Where(signingkeyshare.IDIn(keyshareIDs...)).
+		SetPendingShareRefreshID(refreshID).
+		Exec(ctx)
+}
+
+// CommitShareRefresh applies a prepared share refresh to the keyshares. Committing a refresh
+// again has no effect.
+func CommitShareRefresh(ctx context.Context, config *so.Config, requestID string) error {
+	refresh, err := loadShareRefresh(ctx, config, requestID)
+	if err != nil {
+		return err
+	}
+	switch refresh.Status {
+	case schema.ShareRefreshStatusCommitted, schema.ShareRefreshStatusCompleted:
+		return nil
+	case schema.ShareRefreshStatusAborted:
+		return status.Errorf(codes.FailedPrecondition, "share refresh %s was aborted", requestID)
+	}
+
+	if err := applyShareRefresh(ctx, config, refresh, false); err != nil {
+		return err
+	}
+	return ent.GetDbFromContext(ctx).ShareRefresh.UpdateOne(refresh).
+		SetStatus(schema.ShareRefreshStatusCommitted).
+		Exec(ctx)
+}
+
+// CompleteShareRefresh marks a share refresh every participant committed as completed, so that
+// it can no longer be rolled back and its keyshares can be refreshed again. Completing a refresh
+// again has no effect.
+func CompleteShareRefresh(ctx context.Context, config *so.Config, requestID string) error {
+	refresh, err := loadShareRefresh(ctx, config, requestID)
+	if err != nil {
+		return err
+	}
+	switch refresh.Status {
+	case schema.ShareRefreshStatusCompleted:
+		return nil
+	case schema.ShareRefreshStatusPrepared, schema.ShareRefreshStatusAborted:
+		return status.Errorf(codes.FailedPrecondition, "share refresh %s is %s", requestID, refresh.Status)
+	}
+	return finishShareRefresh(ctx, refresh, schema.ShareRefreshStatusCompleted)
+}
+
+// AbortShareRefresh aborts a share refresh, and rolls it back if it was committed. Aborting a
+// refresh this operator did not prepare, or aborting it again, has no effect.
+func AbortShareRefresh(ctx context.Context, config *so.Config, requestID string) error {
+	refresh, err := loadShareRefresh(ctx, config, requestID)
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	switch refresh.Status {
+	case schema.ShareRefreshStatusAborted:
+		return nil
+	case schema.ShareRefreshStatusCompleted:
+		return status.Errorf(codes.FailedPrecondition, "share refresh %s is completed", requestID)
+	case schema.ShareRefreshStatusCommitted:
+		if err := applyShareRefresh(ctx, config, refresh, true); err != nil {
+			return err
+		}
+	}
+	return finishShareRefresh(ctx, refresh, schema.ShareRefreshStatusAborted)
+}
+
+// loadShareRefresh loads this operator's state of a share refresh for an update, and checks that
+// the call was made by its coordinator.
+func loadShareRefresh(ctx context.Context, config *so.Config, requestID string) (*ent.ShareRefresh, error) {
+	refreshID, err := uuid.Parse(requestID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid share refresh request id %s: %w", requestID, err)
+	}
+	query := ent.GetDbFromContext(ctx).ShareRefresh.Query().Where(sharerefresh.ID(refreshID))
+	// SQLite serializes writes anyway and does not support row locks.
+	if config.DatabaseDriver() == "postgres" {
+		query = query.ForUpdate()
+	}
+	refresh, err := query.Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "share refresh %s does not exist", requestID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCoordinator(ctx, config, refresh.CoordinatorIndex); err != nil {
+		return nil, err
+	}
+	return refresh, nil
+}
+
+// finishShareRefresh sets the final status of a share refresh, forgets its contributions and
+// releases its keyshares.
+func finishShareRefresh(ctx context.Context, refresh *ent.ShareRefresh, refreshStatus schema.ShareRefreshStatus) error {
+	db := ent.GetDbFromContext(ctx)
+	err := db.ShareRefresh.UpdateOne(refresh).
+		SetStatus(refreshStatus).
+		ClearContributions().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return db.SigningKeyshare.Update().
+		Where(
+			signingkeyshare.IDIn(refresh.KeyshareIds...),
+			signingkeyshare.PendingShareRefreshIDEQ(refresh.ID),
+		).
+		ClearPendingShareRefreshID().
+		Exec(ctx)
+}
+
+// applyShareRefresh adds what the keyshares change by in a share refresh to them or, to roll the
+// refresh back, subtracts it. The change is computed again from the stored contributions, so that
+// no secret is stored besides the keyshares.
+func applyShareRefresh(ctx context.Context, config *so.Config, refresh *ent.ShareRefresh, rollback bool) error {
+	keyshares, err := loadRefreshedKeyshares(ctx, config, refresh.KeyshareIds, true)
+	if err != nil {
+		return err
+	}
+	contributions := make([]*pbdkg.ShareRefreshContribution, len(refresh.Contributions))
+	for i, serialized := range refresh.Contributions {
+		contributions[i] = &pbdkg.ShareRefreshContribution{}
+		if err := proto.Unmarshal(serialized, contributions[i]); err != nil {
+			return fmt.Errorf("invalid stored contribution of share refresh %s: %w", refresh.ID, err)
+		}
+	}
+	keyshareIDStrings := make([]string, len(refresh.KeyshareIds))
+	for i, id := range refresh.KeyshareIds {
+		keyshareIDStrings[i] = id.String()
+	}
+	secretShareTweaks, publicSharesTweaks, err := shareRefreshTweaks(config, refresh.ID.String(), keyshareIDStrings, keyshares, contributions)
+	if err != nil {
+		return err
+	}
+
+	addPrivateKeys, addPublicKeys := common.AddPrivateKeys, common.AddPublicKeys
+	if rollback {
+		addPrivateKeys, addPublicKeys = common.SubtractPrivateKeys, common.SubtractPublicKeys
+	}
+	db := ent.GetDbFromContext(ctx)
+	now := time.Now()
+	for i, keyshare := range keyshares {
+		// The refresh and its status change are stored in the same transaction, so this only
+		// guards against a keyshare being changed twice.
+		if (keyshare.ShareRefreshID == refresh.ID) != rollback {
+			continue
+		}
+
+		secretShare, err := addPrivateKeys(keyshare.SecretShare, secretShareTweaks[i])
+		if err != nil {
+			return err
+		}
+		publicShares := make(map[string][]byte, len(keyshare.PublicShares))
+		for identifier, publicShare := range keyshare.PublicShares {
+			publicShares[identifier], err = addPublicKeys(publicShare, publicSharesTweaks[i][identifier])
+			if err != nil {
+				return fmt.Errorf("failed to refresh public share of operator %s of keyshare %s: %w", identifier, keyshare.ID, err)
+			}
+		}
+
+		update := db.SigningKeyshare.UpdateOne(keyshare).
+			SetSecretShare(secretShare).
+			SetPublicShares(publicShares)
+		if rollback {
+			// The keyshare is refreshed again soon.
+			update = update.ClearShareRefreshID().ClearShareRefreshTime()
+		} else {
+			update = update.SetShareRefreshID(refresh.ID).SetShareRefreshTime(now)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shareRefreshTweaks validates the contributions of all operators holding the keyshares to a
+// share refresh, and returns what this operator's secret share and the public shares of each
+// keyshare change by.
+func shareRefreshTweaks(config *so.Config, requestID string, keyshareIDStrings []string, keyshares []*ent.SigningKeyshare, contributions []*pbdkg.ShareRefreshContribution) ([][]byte, []map[string][]byte, error) {
+	contributionMap := make(map[string]*pbdkg.ShareRefreshContribution, len(contributions))
+	for _, contribution := range contributions {
+		operator, ok := config.SigningOperatorMap[contribution.Identifier]
+		if !ok {
+			return nil, nil, fmt.Errorf("share refresh contribution from unknown operator %s", contribution.Identifier)
+		}
+		hash := shareRefreshContributionHash(requestID, keyshareIDStrings, contribution)
+		if err := verifyHashSignature(hash, contribution.Signature, operator.IdentityPublicKey); err != nil {
+			return nil, nil, fmt.Errorf("invalid share refresh contribution from operator %s: %w", contribution.Identifier, err)
+		}
+		if len(contribution.Packages) != len(keyshares) {
+			return nil, nil, fmt.Errorf("share refresh contribution from operator %s has %d packages, expected %d", contribution.Identifier, len(contribution.Packages), len(keyshares))
+		}
+		contributionMap[contribution.Identifier] = contribution
+	}
+
+	fieldModulus := secp256k1.S256().N
+	selfIndex, err := identifierIndex(config.Identifier)
+	if err != nil {
+		return nil, nil, err
+	}
+	decryptionKey := eciesgo.NewPrivateKeyFromBytes(config.IdentityPrivateKey)
+
+	secretShareTweaks := make([][]byte, len(keyshares))
+	publicSharesTweaks := make([]map[string][]byte, len(keyshares))
+	for i, keyshare := range keyshares {
+		secretShareTweak := big.NewInt(0)
+		publicShareTweaks := make(map[string]*secp256k1.PublicKey, len(keyshare.PublicShares))
+		for dealer := range keyshare.PublicShares {
+			contribution, ok := contributionMap[dealer]
+			if !ok {
+				return nil, nil, fmt.Errorf("missing share refresh contribution from operator %s", dealer)
+			}
+			refreshPackage := contribution.Packages[i]
+
+			shareBytes, err := eciesgo.Decrypt(decryptionKey, refreshPackage.EncryptedShares[config.Identifier])
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to decrypt share of keyshare %s from operator %s: %w", keyshare.ID, dealer, err)
+			}
+			share := &secretsharing.VerifiableSecretShare{
+				SecretShare: secretsharing.SecretShare{
+					FieldModulus: fieldModulus,
+					Threshold:    int(keyshare.MinSigners),
+					Index:        selfIndex,
+					Share:        new(big.Int).SetBytes(shareBytes),
+				},
+				Proofs: refreshPackage.Commitments,
+			}
+			if err := secretsharing.ValidateZeroShare(share); err != nil {
+				return nil, nil, fmt.Errorf("invalid share of keyshare %s from operator %s: %w", keyshare.ID, dealer, err)
+			}
+			secretShareTweak.Add(secretShareTweak, share.Share)
+			secretShareTweak.Mod(secretShareTweak, fieldModulus)
+
+			for holder := range keyshare.PublicShares {
+				holderIndex, err := identifierIndex(holder)
+				if err != nil {
+					return nil, nil, err
+				}
+				publicKey, err := secretsharing.ZeroSharePublicKey(refreshPackage.Commitments, holderIndex, fieldModulus)
+				if err != nil {
+					return nil, nil, fmt.Errorf("invalid commitments of keyshare %s from operator %s: %w", keyshare.ID, dealer, err)
+				}
+				if sum, ok := publicShareTweaks[holder]; ok {
+					publicShareTweaks[holder] = common.AddPublicKeysRaw(sum, publicKey)
+				} else {
+					publicShareTweaks[holder] = publicKey
+				}
+			}
+		}
+
+		secretShareTweaks[i] = secretShareTweak.FillBytes(make([]byte, 32))
+		publicSharesTweaks[i] = make(map[string][]byte, len(publicShareTweaks))
+		for holder, publicKey := range publicShareTweaks {
+			publicSharesTweaks[i][holder] = publicKey.SerializeCompressed()
+		}
+	}
+	return secretShareTweaks, publicSharesTweaks, nil
+}
+
+// parseShareRefreshKeyshareIDs parses the ids of the keyshares of a share refresh.
+func parseShareRefreshKeyshareIDs(keyshareIDStrings []string) ([]uuid.UUID, error) {
+	keyshareIDs := make([]uuid.UUID, len(keyshareIDStrings))
+	seen := make(map[uuid.UUID]bool, len(keyshareIDStrings))
+	for i, idString := range keyshareIDStrings {
+		id, err := uuid.Parse(idString)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyshare id %s: %w", idString, err)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("duplicate keyshare id %s", idString)
+		}
+		seen[id] = true
+		keyshareIDs[i] = id
+	}
+	return keyshareIDs, nil
+}
+
+// loadRefreshedKeyshares loads the keyshares being refreshed, in the given order. Without
+// forUpdate, only their public parts are loaded.
+func loadRefreshedKeyshares(ctx context.Context, config *so.Config, keyshareIDs []uuid.UUID, forUpdate bool) ([]*ent.SigningKeyshare, error) {
+	db := ent.GetDbFromContext(ctx)
+	query := db.SigningKeyshare.Query().Where(signingkeyshare.IDIn(keyshareIDs...))
+	var keyshares []*ent.SigningKeyshare
+	var err error
+	if forUpdate {
+		// Keyshares can be tweaked concurrently. SQLite serializes writes anyway and does not
+		// support row locks.
+		if config.DatabaseDriver() == "postgres" {
+			query = query.ForUpdate()
+		}
+		keyshares, err = query.All(ctx)
+	} else {
+		keyshares, err = query.Select(
+			signingkeyshare.FieldPublicShares,
+			signingkeyshare.FieldMinSigners,
+			signingkeyshare.FieldCoordinatorIndex,
+			signingkeyshare.FieldPendingShareRefreshID,
+			signingkeyshare.FieldPendingReshareID,
+		).All(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	keyshareMap := make(map[uuid.UUID]*ent.SigningKeyshare, len(keyshares))
+	for _, keyshare := range keyshares {
+		keyshareMap[keyshare.ID] = keyshare
+	}
+
+	result := make([]*ent.SigningKeyshare, len(keyshareIDs))
+	for i, id := range keyshareIDs {
+		keyshare, ok := keyshareMap[id]
+		if !ok {
+			return nil, fmt.Errorf("keyshare %s not found", id)
+		}
+		result[i] = keyshare
+	}
+	return result, nil
+}
+
+// identifierIndex returns the shamir secret share index of an operator identifier.
+func identifierIndex(identifier string) (*big.Int, error) {
+	index, ok := new(big.Int).SetString(identifier, 16)
+	if !ok || index.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid operator identifier %s", identifier)
+	}
+	return index, nil
+}
@@ -0,0 +1,317 @@
+package dkg
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/google/uuid"
+	secretsharing "github.com/lightsparkdev/spark/common/secret_sharing"
+	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/utils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type shareRefreshTestOperator struct {
+	config *so.Config
+	ctx    context.Context
+}
+
+// coordinated returns the context of a call to the operator made by the coordinator.
+func (o *shareRefreshTestOperator) coordinated() context.Context {
+	coordinator := o.config.SigningOperatorMap[utils.IndexToIdentifier(0)]
+	return so.WithCallingOperator(o.ctx, coordinator)
+}
+
+// shareRefreshTestClient calls the share refresh methods of an operator in process.
+type shareRefreshTestClient struct {
+	pbdkg.DKGServiceClient
+	operator *shareRefreshTestOperator
+	fail     bool
+}
+
+func (c *shareRefreshTestClient) CommitShareRefresh(_ context.Context, req *pbdkg.CommitShareRefreshRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if c.fail {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return &emptypb.Empty{}, CommitShareRefresh(c.operator.coordinated(), c.operator.config, req.RequestId)
+}
+
+func (c *shareRefreshTestClient) CompleteShareRefresh(_ context.Context, req *pbdkg.CompleteShareRefreshRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, CompleteShareRefresh(c.operator.coordinated(), c.operator.config, req.RequestId)
+}
+
+func (c *shareRefreshTestClient) AbortShareRefresh(_ context.Context, req *pbdkg.AbortShareRefreshRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, AbortShareRefresh(c.operator.coordinated(), c.operator.config, req.RequestId)
+}
+
+func newShareRefreshTestOperators(t *testing.T, count int) []*shareRefreshTestOperator {
+	operatorMap := make(map[string]*so.SigningOperator, count)
+	operators := make([]*shareRefreshTestOperator, count)
+	for i := range operators {
+		identityKey, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		identifier := utils.IndexToIdentifier(uint64(i))
+		operatorMap[identifier] = &so.SigningOperator{
+			ID:                uint64(i),
+			Identifier:        identifier,
+			IdentityPublicKey: identityKey.PubKey().SerializeCompressed(),
+		}
+
+		db := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s_%d?mode=memory&_fk=1", t.Name(), i))
+		t.Cleanup(func() { db.Close() })
+		tx, err := db.Tx(context.Background())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = tx.Rollback() })
+
+		operators[i] = &shareRefreshTestOperator{
+			config: &so.Config{
+				Index:              uint64(i),
+				Identifier:         identifier,
+				IdentityPrivateKey: identityKey.Serialize(),
+				SigningOperatorMap: operatorMap,
+			},
+			ctx: context.WithValue(context.Background(), ent.TxKey, tx),
+		}
+	}
+	return operators
+}
+
+// createShareRefreshTestKeyshare splits a new secret among the operators, and returns it with the
+// shares and the id of the keyshare.
+func createShareRefreshTestKeyshare(t *testing.T, operators []*shareRefreshTestOperator, threshold int) (*secp256k1.PrivateKey, []*secretsharing.SecretShare, uuid.UUID) {
+	secret, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	shares, err := secretsharing.SplitSecret(new(big.Int).SetBytes(secret.Serialize()), secp256k1.S256().N, threshold, len(operators))
+	require.NoError(t, err)
+	publicShares := make(map[string][]byte, len(operators))
+	for i, operator := range operators {
+		publicShares[operator.config.Identifier] = secp256k1.NewPrivateKey(secretsharing.IntToScalar(shares[i].Share)).PubKey().SerializeCompressed()
+	}
+
+	keyshareID := schema.NewID()
+	for i, operator := range operators {
+		_, err := ent.GetDbFromContext(operator.ctx).SigningKeyshare.Create().
+			SetID(keyshareID).
+			SetStatus(schema.KeyshareStatusAvailable).
+			SetSecretShare(shares[i].Share.FillBytes(make([]byte, 32))).
+			SetPublicShares(publicShares).
+			SetPublicKey(secret.PubKey().SerializeCompressed()).
+			SetMinSigners(int32(threshold)).
+			SetCoordinatorIndex(0).
+			Save(operator.ctx)
+		require.NoError(t, err)
+	}
+	return secret, shares, keyshareID
+}
+
+// prepareShareRefreshTest initiates and prepares a share refresh of the keyshare on all operators.
+func prepareShareRefreshTest(t *testing.T, operators []*shareRefreshTestOperator, keyshareID uuid.UUID) string {
+	requestID := schema.NewID().String()
+	keyshareIDs := []string{keyshareID.String()}
+	contributions := make([]*pbdkg.ShareRefreshContribution, len(operators))
+	for i, operator := range operators {
+		var err error
+		contributions[i], err = InitiateShareRefresh(operator.coordinated(), operator.config, requestID, keyshareIDs)
+		require.NoError(t, err)
+	}
+	for _, operator := range operators {
+		require.NoError(t, PrepareShareRefresh(operator.coordinated(), operator.config, requestID, keyshareIDs, contributions))
+	}
+	return requestID
+}
+
+func TestShareRefresh(t *testing.T) {
+	threshold := 2
+	operators := newShareRefreshTestOperators(t, 3)
+	fieldModulus := secp256k1.S256().N
+	secret, shares, keyshareID := createShareRefreshTestKeyshare(t, operators, threshold)
+
+	requestID := schema.NewID().String()
+	keyshareIDs := []string{keyshareID.String()}
+	// Only the coordinator of the keyshares can start their refresh.
+	_, err := InitiateShareRefresh(operators[1].ctx, operators[1].config, requestID, keyshareIDs)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	other := operators[2].config.SigningOperatorMap[operators[2].config.Identifier]
+	_, err = InitiateShareRefresh(so.WithCallingOperator(operators[1].ctx, other), operators[1].config, requestID, keyshareIDs)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	contributions := make([]*pbdkg.ShareRefreshContribution, len(operators))
+	for i, operator := range operators {
+		contributions[i], err = InitiateShareRefresh(operator.coordinated(), operator.config, requestID, keyshareIDs)
+		require.NoError(t, err)
+	}
+
+	// A contribution the coordinator tampered with is rejected.
+	tampered := proto.Clone(contributions[1]).(*pbdkg.ShareRefreshContribution)
+	tampered.Packages[0].EncryptedShares = contributions[2].Packages[0].EncryptedShares
+	err = PrepareShareRefresh(operators[0].coordinated(), operators[0].config, requestID, keyshareIDs, []*pbdkg.ShareRefreshContribution{contributions[0], tampered, contributions[2]})
+	require.ErrorContains(t, err, "invalid share refresh contribution")
+
+	// Every operator has to contribute.
+	err = PrepareShareRefresh(operators[0].coordinated(), operators[0].config, requestID, keyshareIDs, contributions[:2])
+	require.ErrorContains(t, err, "missing share refresh contribution")
+
+	for _, operator := range operators {
+		require.NoError(t, PrepareShareRefresh(operator.coordinated(), operator.config, requestID, keyshareIDs, contributions))
+		// Preparing again has no effect.
+		require.NoError(t, PrepareShareRefresh(operator.coordinated(), operator.config, requestID, keyshareIDs, contributions))
+	}
+
+	// The keyshares are not refreshed again while the refresh is pending.
+	_, err = InitiateShareRefresh(operators[1].coordinated(), operators[1].config, schema.NewID().String(), keyshareIDs)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	// Only committed refreshes can be completed.
+	err = CompleteShareRefresh(operators[1].coordinated(), operators[1].config, requestID)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	for _, operator := range operators {
+		require.NoError(t, CommitShareRefresh(operator.coordinated(), operator.config, requestID))
+		// Committing again does not apply the refresh twice.
+		require.NoError(t, CommitShareRefresh(operator.coordinated(), operator.config, requestID))
+	}
+	for _, operator := range operators {
+		require.NoError(t, CompleteShareRefresh(operator.coordinated(), operator.config, requestID))
+		// A completed refresh cannot be rolled back.
+		err = AbortShareRefresh(operator.coordinated(), operator.config, requestID)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	}
+
+	refreshed := make([]*secretsharing.SecretShare, len(operators))
+	for i, operator := range operators {
+		keyshare, err := ent.GetDbFromContext(operator.ctx).SigningKeyshare.Get(operator.ctx, keyshareID)
+		require.NoError(t, err)
+		require.Equal(t, secret.PubKey().SerializeCompressed(), keyshare.PublicKey)
+		require.NotEqual(t, shares[i].Share.FillBytes(make([]byte, 32)), keyshare.SecretShare)
+		require.NotEmpty(t, keyshare.ShareRefreshTime)
+		require.Equal(t, uuid.Nil, keyshare.PendingShareRefreshID)
+
+		refreshed[i] = &secretsharing.SecretShare{
+			FieldModulus: fieldModulus,
+			Threshold:    threshold,
+			Index:        shares[i].Index,
+			Share:        new(big.Int).SetBytes(keyshare.SecretShare),
+		}
+		// Every operator agrees on every operator's new public share.
+		for j, other := range operators {
+			if j == i {
+				continue
+			}
+			otherKeyshare, err := ent.GetDbFromContext(other.ctx).SigningKeyshare.Get(other.ctx, keyshareID)
+			require.NoError(t, err)
+			require.Equal(t, keyshare.PublicShares, otherKeyshare.PublicShares)
+		}
+		require.Equal(t, secp256k1.PrivKeyFromBytes(keyshare.SecretShare).PubKey().SerializeCompressed(), keyshare.PublicShares[operator.config.Identifier])
+
+		// The contributions are forgotten once the refresh is completed.
+		refresh, err := ent.GetDbFromContext(operator.ctx).ShareRefresh.Get(operator.ctx, uuid.MustParse(requestID))
+		require.NoError(t, err)
+		require.Equal(t, schema.ShareRefreshStatusCompleted, refresh.Status)
+		require.Empty(t, refresh.Contributions)
+	}
+
+	recovered, err := secretsharing.RecoverSecret(refreshed[1:])
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).SetBytes(secret.Serialize()), recovered)
+
+	// Mixing shares from before and after the refresh does not recover the secret.
+	mixed, err := secretsharing.RecoverSecret([]*secretsharing.SecretShare{shares[0], refreshed[1]})
+	require.NoError(t, err)
+	require.NotEqual(t, new(big.Int).SetBytes(secret.Serialize()), mixed)
+}
+
+func TestShareRefreshRollback(t *testing.T) {
+	operators := newShareRefreshTestOperators(t, 3)
+	_, _, keyshareID := createShareRefreshTestKeyshare(t, operators, 2)
+	original := make([]*ent.SigningKeyshare, len(operators))
+	for i, operator := range operators {
+		var err error
+		original[i], err = ent.GetDbFromContext(operator.ctx).SigningKeyshare.Get(operator.ctx, keyshareID)
+		require.NoError(t, err)
+	}
+
+	requestID := prepareShareRefreshTest(t, operators, keyshareID)
+	for _, operator := range operators[:2] {
+		require.NoError(t, CommitShareRefresh(operator.coordinated(), operator.config, requestID))
+	}
+	for _, operator := range operators {
+		require.NoError(t, AbortShareRefresh(operator.coordinated(), operator.config, requestID))
+		// Aborting again has no effect, and an aborted refresh cannot be committed.
+		require.NoError(t, AbortShareRefresh(operator.coordinated(), operator.config, requestID))
+		err := CommitShareRefresh(operator.coordinated(), operator.config, requestID)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	}
+	// Aborting a refresh that was never prepared has no effect.
+	require.NoError(t, AbortShareRefresh(operators[0].coordinated(), operators[0].config, schema.NewID().String()))
+
+	// The committed operators are back to their shares from before the refresh.
+	for i, operator := range operators {
+		keyshare, err := ent.GetDbFromContext(operator.ctx).SigningKeyshare.Get(operator.ctx, keyshareID)
+		require.NoError(t, err)
+		require.Equal(t, original[i].SecretShare, keyshare.SecretShare)
+		require.Equal(t, original[i].PublicShares, keyshare.PublicShares)
+		require.Equal(t, uuid.Nil, keyshare.ShareRefreshID)
+		require.Equal(t, uuid.Nil, keyshare.PendingShareRefreshID)
+	}
+}
+
+func TestShareRefreshResume(t *testing.T) {
+	threshold := 2
+	operators := newShareRefreshTestOperators(t, 3)
+	secret, _, keyshareID := createShareRefreshTestKeyshare(t, operators, threshold)
+	clients := make([]*shareRefreshTestClient, len(operators))
+	clientMap := make(map[string]pbdkg.DKGServiceClient, len(operators))
+	for i, operator := range operators {
+		clients[i] = &shareRefreshTestClient{operator: operator}
+		clientMap[operator.config.Identifier] = clients[i]
+	}
+	coordinator := operators[0]
+	participants := shareRefreshParticipants(coordinator.config, clientMap)
+	require.Equal(t, coordinator.config.Identifier, participants[0])
+
+	// A refresh the coordinator committed is committed on the operators that failed, when resumed.
+	requestID := prepareShareRefreshTest(t, operators, keyshareID)
+	clients[2].fail = true
+	err := shareRefreshCommit(clientMap, requestID).commitAll(coordinator.ctx, participants)
+	require.ErrorContains(t, err, "was not committed on operators")
+	clients[2].fail = false
+	require.NoError(t, resumeShareRefreshes(coordinator.ctx, coordinator.config, clientMap, participants))
+
+	refreshed := make([]*secretsharing.SecretShare, len(operators))
+	for i, operator := range operators {
+		keyshare, err := ent.GetDbFromContext(operator.ctx).SigningKeyshare.Get(operator.ctx, keyshareID)
+		require.NoError(t, err)
+		require.Equal(t, uuid.Nil, keyshare.PendingShareRefreshID)
+		refreshed[i] = &secretsharing.SecretShare{
+			FieldModulus: secp256k1.S256().N,
+			Threshold:    threshold,
+			Index:        big.NewInt(int64(i + 1)),
+			Share:        new(big.Int).SetBytes(keyshare.SecretShare),
+		}
+	}
+	recovered, err := secretsharing.RecoverSecret([]*secretsharing.SecretShare{refreshed[0], refreshed[2]})
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).SetBytes(secret.Serialize()), recovered)
+
+	// A refresh the coordinator fails to commit is aborted everywhere.
+	requestID = prepareShareRefreshTest(t, operators, keyshareID)
+	clients[0].fail = true
+	err = shareRefreshCommit(clientMap, requestID).commitAll(coordinator.ctx, participants)
+	require.ErrorContains(t, err, "failed to commit share refresh")
+	for _, operator := range operators {
+		refresh, err := ent.GetDbFromContext(operator.ctx).ShareRefresh.Get(operator.ctx, uuid.MustParse(requestID))
+		require.NoError(t, err)
+		require.Equal(t, schema.ShareRefreshStatusAborted, refresh.Status)
+	}
+}
@@ -1,14 +1,21 @@
 package dkg
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/binary"
+	"fmt"
 	"sort"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"github.com/google/uuid"
+	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
 	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func round1PackageHash(maps []map[string][]byte) []byte {
@@ -100,9 +107,121 @@ func signRound2Packages(privateKey []byte, round2Packages [][]byte) ([]byte, err
 	return signHash(privateKey, hash)
 }
 
+func verifyHashSignature(hash []byte, signature []byte, publicKey []byte) error {
+	pub, err := secp256k1.ParsePubKey(publicKey)
+	if err != nil {
+		return err
+	}
+	sig, err := ecdsa.ParseDERSignature(signature)
+	if err != nil {
+		return err
+	}
+	if !sig.Verify(hash, pub) {
+		return fmt.Errorf("signature verification failed")
+	}
+	return nil
+}
+
+func shareRefreshContributionHash(requestID string, keyshareIDs []string, contribution *pbdkg.ShareRefreshContribution) []byte {
+	hasher := sha256.New()
+	hasher.Write([]byte(requestID))
+	hasher.Write([]byte(contribution.Identifier))
+	for _, id := range keyshareIDs {
+		hasher.Write([]byte(id))
+	}
+	for _, p := range contribution.Packages {
+		for _, commitment := range p.Commitments {
+			hasher.Write(commitment)
+		}
+		identifiers := make([]string, 0, len(p.EncryptedShares))
+		for identifier := range p.EncryptedShares {
+			identifiers = append(identifiers, identifier)
+		}
+		sort.Strings(identifiers)
+		for _, identifier := range identifiers {
+			hasher.Write([]byte(identifier))
+			hasher.Write(p.EncryptedShares[identifier])
+		}
+	}
+	return hasher.Sum(nil)
+}
+
+func reshareContributionHash(requestID string, keyshares []*pbdkg.ReshareKeyshare, dealers []string, contribution *pbdkg.ReshareContribution) []byte {
+	hasher := sha256.New()
+	hasher.Write([]byte(requestID))
+	hasher.Write([]byte(contribution.Identifier))
+	for _, keyshare := range keyshares {
+		hasher.Write([]byte(keyshare.Id))
+		hasher.Write(keyshare.PublicKey)
+		hasher.Write([]byte(keyshare.Status))
+		hasher.Write(binary.BigEndian.AppendUint64(nil, keyshare.CoordinatorIndex))
+	}
+	for _, dealer := range dealers {
+		hasher.Write([]byte(dealer))
+	}
+	for _, p := range contribution.Packages {
+		for _, commitment := range p.Commitments {
+			hasher.Write(commitment)
+		}
+		identifiers := make([]string, 0, len(p.EncryptedShares))
+		for identifier := range p.EncryptedShares {
+			identifiers = append(identifiers, identifier)
+		}
+		sort.Strings(identifiers)
+		for _, identifier := range identifiers {
+			hasher.Write([]byte(identifier))
+			hasher.Write(p.EncryptedShares[identifier])
+		}
+	}
+	return hasher.Sum(nil)
+}
+
 func deriveKeyIndex(batchID uuid.UUID, index uint16) uuid.UUID {
 	derivedID := batchID
 	// Write the index to the last 2 bytes
 	binary.BigEndian.PutUint16(derivedID[14:], index)
 	return derivedID
 }
+
+// checkCoordinator checks that the call of the context was made by the operator with the
+// coordinator index. Calls that are not signed by an operator are only accepted while operator
+// authentication is permissive.
+func checkCoordinator(ctx context.Context, config *so.Config, coordinatorIndex uint64) error {
+	coordinator, ok := config.SigningOperator(utils.IndexToIdentifier(coordinatorIndex))
+	if !ok {
+		return fmt.Errorf("coordinator %d is not an operator", coordinatorIndex)
+	}
+	return config.CheckCallingOperator(ctx, coordinator.IdentityPublicKey)
+}
+
+// checkDkgCoordinator checks that the call of the context was made by the DKG coordinator of this
+// operator, and that it is the operator with the coordinator index.
+func checkDkgCoordinator(ctx context.Context, config *so.Config, coordinatorIndex uint64) error {
+	coordinator, err := config.CheckDKGCoordinator(ctx)
+	if err != nil {
+		return err
+	}
+	if coordinator.Identifier != utils.IndexToIdentifier(coordinatorIndex) {
+		return status.Errorf(codes.PermissionDenied, "coordinator %d is not the DKG coordinator %s", coordinatorIndex, coordinator.Identifier)
+	}
+	return nil
+}
+
+// checkSessionCoordinator checks that the call of the context was made by the coordinator this
+// operator recorded for the DKG session. Sessions this operator does not know yet can only be
+// coordinated by its DKG coordinator.
+func checkSessionCoordinator(ctx context.Context, config *so.Config, requestID string) error {
+	id, err := uuid.Parse(requestID)
+	if err != nil {
+		return fmt.Errorf("invalid dkg request id %s: %w", requestID, err)
+	}
+	session, err := ent.GetDbFromContext(ctx).DkgSession.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		_, err = config.CheckDKGCoordinator(ctx)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	return checkCoordinator(ctx, config, session.CoordinatorIndex)
+}
@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+)
+
+// Archive is the model entity for the Archive schema.
+type Archive struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// RecordCount holds the value of the "record_count" field.
+	RecordCount int `json:"record_count,omitempty"`
+	// Data holds the value of the "data" field.
+	Data         []byte `json:"data,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Archive) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case archive.FieldData:
+			values[i] = new([]byte)
+		case archive.FieldRecordCount:
+			values[i] = new(sql.NullInt64)
+		case archive.FieldKind:
+			values[i] = new(sql.NullString)
+		case archive.FieldCreateTime, archive.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case archive.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Archive fields.
+func (a *Archive) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case archive.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				a.ID = *value
+			}
+		case archive.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				a.CreateTime = value.Time
+			}
+		case archive.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				a.UpdateTime = value.Time
+			}
+		case archive.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				a.Kind = value.String
+			}
+		case archive.FieldRecordCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field record_count", values[i])
+			} else if value.Valid {
+				a.RecordCount = int(value.Int64)
+			}
+		case archive.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil {
+				a.Data = *value
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Archive.
+// This includes values selected through modifiers, order, etc.
+func (a *Archive) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Archive.
+// Note that you need to call Archive.Unwrap() before calling this method if this Archive
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Archive) Update() *ArchiveUpdateOne {
+	return NewArchiveClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Archive entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Archive) Unwrap() *Archive {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Archive is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Archive) String() string {
+	var builder strings.Builder
+	builder.WriteString("Archive(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(a.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(a.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(a.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("record_count=")
+	builder.WriteString(fmt.Sprintf("%v", a.RecordCount))
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", a.Data))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Archives is a parsable slice of Archive.
+type Archives []*Archive
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archive
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldKind, v))
+}
+
+// RecordCount applies equality check predicate on the "record_count" field. It's identical to RecordCountEQ.
+func RecordCount(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldRecordCount, v))
+}
+
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v []byte) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldData, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Archive {
+	return predicate.Archive(sql.FieldContainsFold(FieldKind, v))
+}
+
+// RecordCountEQ applies the EQ predicate on the "record_count" field.
+func RecordCountEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldRecordCount, v))
+}
+
+// RecordCountNEQ applies the NEQ predicate on the "record_count" field.
+func RecordCountNEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldRecordCount, v))
+}
+
+// RecordCountIn applies the In predicate on the "record_count" field.
+func RecordCountIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldRecordCount, vs...))
+}
+
+// RecordCountNotIn applies the NotIn predicate on the "record_count" field.
+func RecordCountNotIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldRecordCount, vs...))
+}
+
+// RecordCountGT applies the GT predicate on the "record_count" field.
+func RecordCountGT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldRecordCount, v))
+}
+
+// RecordCountGTE applies the GTE predicate on the "record_count" field.
+func RecordCountGTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldRecordCount, v))
+}
+
+// RecordCountLT applies the LT predicate on the "record_count" field.
+func RecordCountLT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldRecordCount, v))
+}
+
+// RecordCountLTE applies the LTE predicate on the "record_count" field.
+func RecordCountLTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldRecordCount, v))
+}
+
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v []byte) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldData, v))
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v []byte) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldData, v))
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...[]byte) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldData, vs...))
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...[]byte) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldData, vs...))
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v []byte) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldData, v))
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v []byte) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldData, v))
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v []byte) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldData, v))
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v []byte) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldData, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Archive) predicate.Archive {
+	return predicate.Archive(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Archive) predicate.Archive {
+	return predicate.Archive(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Archive) predicate.Archive {
+	return predicate.Archive(sql.NotPredicates(p))
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+)
+
+// ArchiveCreate is the builder for creating a Archive entity.
+type ArchiveCreate struct {
+	config
+	mutation *ArchiveMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ac *ArchiveCreate) SetCreateTime(t time.Time) *ArchiveCreate {
+	ac.mutation.SetCreateTime(t)
+	return ac
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ac *ArchiveCreate) SetNillableCreateTime(t *time.Time) *ArchiveCreate {
+	if t != nil {
+		ac.SetCreateTime(*t)
+	}
+	return ac
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ac *ArchiveCreate) SetUpdateTime(t time.Time) *ArchiveCreate {
+	ac.mutation.SetUpdateTime(t)
+	return ac
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ac *ArchiveCreate) SetNillableUpdateTime(t *time.Time) *ArchiveCreate {
+	if t != nil {
+		ac.SetUpdateTime(*t)
+	}
+	return ac
+}
+
+// SetKind sets the "kind" field.
+func (ac *ArchiveCreate) SetKind(s string) *ArchiveCreate {
+	ac.mutation.SetKind(s)
+	return ac
+}
+
+// SetRecordCount sets the "record_count" field.
+func (ac *ArchiveCreate) SetRecordCount(i int) *ArchiveCreate {
+	ac.mutation.SetRecordCount(i)
+	return ac
+}
+
+// SetData sets the "data" field.
+func (ac *ArchiveCreate) SetData(b []byte) *ArchiveCreate {
+	ac.mutation.SetData(b)
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *ArchiveCreate) SetID(u uuid.UUID) *ArchiveCreate {
+	ac.mutation.SetID(u)
+	return ac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ac *ArchiveCreate) SetNillableID(u *uuid.UUID) *ArchiveCreate {
+	if u != nil {
+		ac.SetID(*u)
+	}
+	return ac
+}
+
+// Mutation returns the ArchiveMutation object of the builder.
+func (ac *ArchiveCreate) Mutation() *ArchiveMutation {
+	return ac.mutation
+}
+
+// Save creates the Archive in the database.
+func (ac *ArchiveCreate) Save(ctx context.Context) (*Archive, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *ArchiveCreate) SaveX(ctx context.Context) *Archive {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *ArchiveCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *ArchiveCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *ArchiveCreate) defaults() {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		v := archive.DefaultCreateTime()
+		ac.mutation.SetCreateTime(v)
+	}
+	if _, ok := ac.mutation.UpdateTime(); !ok {
+		v := archive.DefaultUpdateTime()
+		ac.mutation.SetUpdateTime(v)
+	}
+	if _, ok := ac.mutation.ID(); !ok {
+		v := archive.DefaultID()
+		ac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *ArchiveCreate) check() error {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Archive.create_time"`)}
+	}
+	if _, ok := ac.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Archive.update_time"`)}
+	}
+	if _, ok := ac.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Archive.kind"`)}
+	}
+	if v, ok := ac.mutation.Kind(); ok {
+		if err := archive.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Archive.kind": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.RecordCount(); !ok {
+		return &ValidationError{Name: "record_count", err: errors.New(`ent: missing required field "Archive.record_count"`)}
+	}
+	if _, ok := ac.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "Archive.data"`)}
+	}
+	if v, ok := ac.mutation.Data(); ok {
+		if err := archive.DataValidator(v); err != nil {
+			return &ValidationError{Name: "data", err: fmt.Errorf(`ent: validator failed for field "Archive.data": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ac *ArchiveCreate) sqlSave(ctx context.Context) (*Archive, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *ArchiveCreate) createSpec() (*Archive, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Archive{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(archive.Table, sqlgraph.NewFieldSpec(archive.FieldID, field.TypeUUID))
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ac.mutation.CreateTime(); ok {
+		_spec.SetField(archive.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ac.mutation.UpdateTime(); ok {
+		_spec.SetField(archive.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ac.mutation.Kind(); ok {
+		_spec.SetField(archive.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := ac.mutation.RecordCount(); ok {
+		_spec.SetField(archive.FieldRecordCount, field.TypeInt, value)
+		_node.RecordCount = value
+	}
+	if value, ok := ac.mutation.Data(); ok {
+		_spec.SetField(archive.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	return _node, _spec
+}
+
+// ArchiveCreateBulk is the builder for creating many Archive entities in bulk.
+type ArchiveCreateBulk struct {
+	config
+	err      error
+	builders []*ArchiveCreate
+}
+
+// Save creates the Archive entities in the database.
+func (acb *ArchiveCreateBulk) Save(ctx context.Context) ([]*Archive, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Archive, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArchiveMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *ArchiveCreateBulk) SaveX(ctx context.Context) []*Archive {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *ArchiveCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *ArchiveCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ArchiveDelete is the builder for deleting a Archive entity.
+type ArchiveDelete struct {
+	config
+	hooks    []Hook
+	mutation *ArchiveMutation
+}
+
+// Where appends a list predicates to the ArchiveDelete builder.
+func (ad *ArchiveDelete) Where(ps ...predicate.Archive) *ArchiveDelete {
+	ad.mutation.Where(ps...)
+	return ad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ad *ArchiveDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ad.sqlExec, ad.mutation, ad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ad *ArchiveDelete) ExecX(ctx context.Context) int {
+	n, err := ad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ad *ArchiveDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(archive.Table, sqlgraph.NewFieldSpec(archive.FieldID, field.TypeUUID))
+	if ps := ad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ad.mutation.done = true
+	return affected, err
+}
+
+// ArchiveDeleteOne is the builder for deleting a single Archive entity.
+type ArchiveDeleteOne struct {
+	ad *ArchiveDelete
+}
+
+// Where appends a list predicates to the ArchiveDelete builder.
+func (ado *ArchiveDeleteOne) Where(ps ...predicate.Archive) *ArchiveDeleteOne {
+	ado.ad.mutation.Where(ps...)
+	return ado
+}
+
+// Exec executes the deletion query.
+func (ado *ArchiveDeleteOne) Exec(ctx context.Context) error {
+	n, err := ado.ad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{archive.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ado *ArchiveDeleteOne) ExecX(ctx context.Context) {
+	if err := ado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ArchiveUpdate is the builder for updating Archive entities.
+type ArchiveUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArchiveMutation
+}
+
+// Where appends a list predicates to the ArchiveUpdate builder.
+func (au *ArchiveUpdate) Where(ps ...predicate.Archive) *ArchiveUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (au *ArchiveUpdate) SetUpdateTime(t time.Time) *ArchiveUpdate {
+	au.mutation.SetUpdateTime(t)
+	return au
+}
+
+// Mutation returns the ArchiveMutation object of the builder.
+func (au *ArchiveUpdate) Mutation() *ArchiveMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *ArchiveUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *ArchiveUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *ArchiveUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *ArchiveUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *ArchiveUpdate) defaults() {
+	if _, ok := au.mutation.UpdateTime(); !ok {
+		v := archive.UpdateDefaultUpdateTime()
+		au.mutation.SetUpdateTime(v)
+	}
+}
+
+func (au *ArchiveUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(archive.Table, archive.Columns, sqlgraph.NewFieldSpec(archive.FieldID, field.TypeUUID))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.UpdateTime(); ok {
+		_spec.SetField(archive.FieldUpdateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// ArchiveUpdateOne is the builder for updating a single Archive entity.
+type ArchiveUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArchiveMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (auo *ArchiveUpdateOne) SetUpdateTime(t time.Time) *ArchiveUpdateOne {
+	auo.mutation.SetUpdateTime(t)
+	return auo
+}
+
+// Mutation returns the ArchiveMutation object of the builder.
+func (auo *ArchiveUpdateOne) Mutation() *ArchiveMutation {
+	return auo.mutation
+}
+
+// Where appends a list predicates to the ArchiveUpdate builder.
+func (auo *ArchiveUpdateOne) Where(ps ...predicate.Archive) *ArchiveUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *ArchiveUpdateOne) Select(field string, fields ...string) *ArchiveUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Archive entity.
+func (auo *ArchiveUpdateOne) Save(ctx context.Context) (*Archive, error) {
+	auo.defaults()
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *ArchiveUpdateOne) SaveX(ctx context.Context) *Archive {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *ArchiveUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *ArchiveUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *ArchiveUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdateTime(); !ok {
+		v := archive.UpdateDefaultUpdateTime()
+		auo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (auo *ArchiveUpdateOne) sqlSave(ctx context.Context) (_node *Archive, err error) {
+	_spec := sqlgraph.NewUpdateSpec(archive.Table, archive.Columns, sqlgraph.NewFieldSpec(archive.FieldID, field.TypeUUID))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Archive.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archive.FieldID)
+		for _, f := range fields {
+			if !archive.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != archive.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.UpdateTime(); ok {
+		_spec.SetField(archive.FieldUpdateTime, field.TypeTime, value)
+	}
+	_node = &Archive{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ArchivedTransfer is the model entity for the ArchivedTransfer schema.
+type ArchivedTransfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// SenderIdentityPubkey holds the value of the "sender_identity_pubkey" field.
+	SenderIdentityPubkey []byte `json:"sender_identity_pubkey,omitempty"`
+	// ReceiverIdentityPubkey holds the value of the "receiver_identity_pubkey" field.
+	ReceiverIdentityPubkey []byte `json:"receiver_identity_pubkey,omitempty"`
+	// TotalValue holds the value of the "total_value" field.
+	TotalValue uint64 `json:"total_value,omitempty"`
+	// Status holds the value of the "status" field.
+	Status schema.TransferStatus `json:"status,omitempty"`
+	// Type holds the value of the "type" field.
+	Type schema.TransferType `json:"type,omitempty"`
+	// TransferUpdateTime holds the value of the "transfer_update_time" field.
+	TransferUpdateTime time.Time `json:"transfer_update_time,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ArchivedTransferQuery when eager-loading is set.
+	Edges                     ArchivedTransferEdges `json:"edges"`
+	archived_transfer_archive *uuid.UUID
+	selectValues              sql.SelectValues
+}
+
+// ArchivedTransferEdges holds the relations/edges for other nodes in the graph.
+type ArchivedTransferEdges struct {
+	// Archive holds the value of the archive edge.
+	Archive *Archive `json:"archive,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ArchiveOrErr returns the Archive value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArchivedTransferEdges) ArchiveOrErr() (*Archive, error) {
+	if e.Archive != nil {
+		return e.Archive, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: archive.Label}
+	}
+	return nil, &NotLoadedError{edge: "archive"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArchivedTransfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case archivedtransfer.FieldSenderIdentityPubkey, archivedtransfer.FieldReceiverIdentityPubkey:
+			values[i] = new([]byte)
+		case archivedtransfer.FieldTotalValue:
+			values[i] = new(sql.NullInt64)
+		case archivedtransfer.FieldStatus, archivedtransfer.FieldType:
+			values[i] = new(sql.NullString)
+		case archivedtransfer.FieldCreateTime, archivedtransfer.FieldUpdateTime, archivedtransfer.FieldTransferUpdateTime:
+			values[i] = new(sql.NullTime)
+		case archivedtransfer.FieldID:
+			values[i] = new(uuid.UUID)
+		case archivedtransfer.ForeignKeys[0]: // archived_transfer_archive
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArchivedTransfer fields.
+func (at *ArchivedTransfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case archivedtransfer.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				at.ID = *value
+			}
+		case archivedtransfer.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				at.CreateTime = value.Time
+			}
+		case archivedtransfer.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				at.UpdateTime = value.Time
+			}
+		case archivedtransfer.FieldSenderIdentityPubkey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_identity_pubkey", values[i])
+			} else if value != nil {
+				at.SenderIdentityPubkey = *value
+			}
+		case archivedtransfer.FieldReceiverIdentityPubkey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field receiver_identity_pubkey", values[i])
+			} else if value != nil {
+				at.ReceiverIdentityPubkey = *value
+			}
+		case archivedtransfer.FieldTotalValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_value", values[i])
+			} else if value.Valid {
+				at.TotalValue = uint64(value.Int64)
+			}
+		case archivedtransfer.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				at.Status = schema.TransferStatus(value.String)
+			}
+		case archivedtransfer.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				at.Type = schema.TransferType(value.String)
+			}
+		case archivedtransfer.FieldTransferUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer_update_time", values[i])
+			} else if value.Valid {
+				at.TransferUpdateTime = value.Time
+			}
+		case archivedtransfer.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_transfer_archive", values[i])
+			} else if value.Valid {
+				at.archived_transfer_archive = new(uuid.UUID)
+				*at.archived_transfer_archive = *value.S.(*uuid.UUID)
+			}
+		default:
+			at.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArchivedTransfer.
+// This includes values selected through modifiers, order, etc.
+func (at *ArchivedTransfer) Value(name string) (ent.Value, error) {
+	return at.selectValues.Get(name)
+}
+
+// QueryArchive queries the "archive" edge of the ArchivedTransfer entity.
+func (at *ArchivedTransfer) QueryArchive() *ArchiveQuery {
+	return NewArchivedTransferClient(at.config).QueryArchive(at)
+}
+
+// Update returns a builder for updating this ArchivedTransfer.
+// Note that you need to call ArchivedTransfer.Unwrap() before calling this method if this ArchivedTransfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (at *ArchivedTransfer) Update() *ArchivedTransferUpdateOne {
+	return NewArchivedTransferClient(at.config).UpdateOne(at)
+}
+
+// Unwrap unwraps the ArchivedTransfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (at *ArchivedTransfer) Unwrap() *ArchivedTransfer {
+	_tx, ok := at.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArchivedTransfer is not a transactional entity")
+	}
+	at.config.driver = _tx.drv
+	return at
+}
+
+// String implements the fmt.Stringer.
+func (at *ArchivedTransfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArchivedTransfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", at.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(at.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(at.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("sender_identity_pubkey=")
+	builder.WriteString(fmt.Sprintf("%v", at.SenderIdentityPubkey))
+	builder.WriteString(", ")
+	builder.WriteString("receiver_identity_pubkey=")
+	builder.WriteString(fmt.Sprintf("%v", at.ReceiverIdentityPubkey))
+	builder.WriteString(", ")
+	builder.WriteString("total_value=")
+	builder.WriteString(fmt.Sprintf("%v", at.TotalValue))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", at.Status))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", at.Type))
+	builder.WriteString(", ")
+	builder.WriteString("transfer_update_time=")
+	builder.WriteString(at.TransferUpdateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArchivedTransfers is a parsable slice of ArchivedTransfer.
+type ArchivedTransfers []*ArchivedTransfer
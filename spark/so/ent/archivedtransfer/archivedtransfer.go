@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archivedtransfer
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+const (
+	// Label holds the string label denoting the archivedtransfer type in the database.
+	Label = "archived_transfer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldSenderIdentityPubkey holds the string denoting the sender_identity_pubkey field in the database.
+	FieldSenderIdentityPubkey = "sender_identity_pubkey"
+	// FieldReceiverIdentityPubkey holds the string denoting the receiver_identity_pubkey field in the database.
+	FieldReceiverIdentityPubkey = "receiver_identity_pubkey"
+	// FieldTotalValue holds the string denoting the total_value field in the database.
+	FieldTotalValue = "total_value"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldTransferUpdateTime holds the string denoting the transfer_update_time field in the database.
+	FieldTransferUpdateTime = "transfer_update_time"
+	// EdgeArchive holds the string denoting the archive edge name in mutations.
+	EdgeArchive = "archive"
+	// Table holds the table name of the archivedtransfer in the database.
+	Table = "archived_transfers"
+	// ArchiveTable is the table that holds the archive relation/edge.
+	ArchiveTable = "archived_transfers"
+	// ArchiveInverseTable is the table name for the Archive entity.
+	// It exists in this package in order to avoid circular dependency with the "archive" package.
+	ArchiveInverseTable = "archives"
+	// ArchiveColumn is the table column denoting the archive relation/edge.
+	ArchiveColumn = "archived_transfer_archive"
+)
+
+// Columns holds all SQL columns for archivedtransfer fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldSenderIdentityPubkey,
+	FieldReceiverIdentityPubkey,
+	FieldTotalValue,
+	FieldStatus,
+	FieldType,
+	FieldTransferUpdateTime,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "archived_transfers"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"archived_transfer_archive",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// SenderIdentityPubkeyValidator is a validator for the "sender_identity_pubkey" field. It is called by the builders before save.
+	SenderIdentityPubkeyValidator func([]byte) error
+	// ReceiverIdentityPubkeyValidator is a validator for the "receiver_identity_pubkey" field. It is called by the builders before save.
+	ReceiverIdentityPubkeyValidator func([]byte) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s schema.TransferStatus) error {
+	switch s {
+	case "SENDER_INITIATED", "SENDER_INITIATED_COORDINATOR", "SENDER_KEY_TWEAK_PENDING", "SENDER_KEY_TWEAKED", "RECEIVER_KEY_TWEAKED", "RECEIVER_KEY_TWEAK_LOCKED", "RECEIVER_REFUND_SIGNED", "COMPLETED", "EXPIRED", "RETURNED", "RECEIVER_KEY_TWEAK_APPLIED":
+		return nil
+	default:
+		return fmt.Errorf("archivedtransfer: invalid enum value for status field: %q", s)
+	}
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type schema.TransferType) error {
+	switch _type {
+	case "PREIMAGE_SWAP", "COOPERATIVE_EXIT", "TRANSFER", "SWAP", "COUNTER_SWAP", "UTXO_SWAP":
+		return nil
+	default:
+		return fmt.Errorf("archivedtransfer: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the ArchivedTransfer queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByTotalValue orders the results by the total_value field.
+func ByTotalValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalValue, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByTransferUpdateTime orders the results by the transfer_update_time field.
+func ByTransferUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransferUpdateTime, opts...).ToFunc()
+}
+
+// ByArchiveField orders the results by archive field.
+func ByArchiveField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArchiveStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newArchiveStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArchiveInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ArchiveTable, ArchiveColumn),
+	)
+}
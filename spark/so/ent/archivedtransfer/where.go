@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archivedtransfer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// SenderIdentityPubkey applies equality check predicate on the "sender_identity_pubkey" field. It's identical to SenderIdentityPubkeyEQ.
+func SenderIdentityPubkey(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldSenderIdentityPubkey, v))
+}
+
+// ReceiverIdentityPubkey applies equality check predicate on the "receiver_identity_pubkey" field. It's identical to ReceiverIdentityPubkeyEQ.
+func ReceiverIdentityPubkey(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldReceiverIdentityPubkey, v))
+}
+
+// TotalValue applies equality check predicate on the "total_value" field. It's identical to TotalValueEQ.
+func TotalValue(v uint64) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldTotalValue, v))
+}
+
+// TransferUpdateTime applies equality check predicate on the "transfer_update_time" field. It's identical to TransferUpdateTimeEQ.
+func TransferUpdateTime(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldTransferUpdateTime, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// SenderIdentityPubkeyEQ applies the EQ predicate on the "sender_identity_pubkey" field.
+func SenderIdentityPubkeyEQ(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldSenderIdentityPubkey, v))
+}
+
+// SenderIdentityPubkeyNEQ applies the NEQ predicate on the "sender_identity_pubkey" field.
+func SenderIdentityPubkeyNEQ(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNEQ(FieldSenderIdentityPubkey, v))
+}
+
+// SenderIdentityPubkeyIn applies the In predicate on the "sender_identity_pubkey" field.
+func SenderIdentityPubkeyIn(vs ...[]byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldIn(FieldSenderIdentityPubkey, vs...))
+}
+
+// SenderIdentityPubkeyNotIn applies the NotIn predicate on the "sender_identity_pubkey" field.
+func SenderIdentityPubkeyNotIn(vs ...[]byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNotIn(FieldSenderIdentityPubkey, vs...))
+}
+
+// SenderIdentityPubkeyGT applies the GT predicate on the "sender_identity_pubkey" field.
+func SenderIdentityPubkeyGT(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGT(FieldSenderIdentityPubkey, v))
+}
+
+// SenderIdentityPubkeyGTE applies the GTE predicate on the "sender_identity_pubkey" field.
+func SenderIdentityPubkeyGTE(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGTE(FieldSenderIdentityPubkey, v))
+}
+
+// SenderIdentityPubkeyLT applies the LT predicate on the "sender_identity_pubkey" field.
+func SenderIdentityPubkeyLT(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLT(FieldSenderIdentityPubkey, v))
+}
+
+// SenderIdentityPubkeyLTE applies the LTE predicate on the "sender_identity_pubkey" field.
+func SenderIdentityPubkeyLTE(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLTE(FieldSenderIdentityPubkey, v))
+}
+
+// ReceiverIdentityPubkeyEQ applies the EQ predicate on the "receiver_identity_pubkey" field.
+func ReceiverIdentityPubkeyEQ(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldReceiverIdentityPubkey, v))
+}
+
+// ReceiverIdentityPubkeyNEQ applies the NEQ predicate on the "receiver_identity_pubkey" field.
+func ReceiverIdentityPubkeyNEQ(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNEQ(FieldReceiverIdentityPubkey, v))
+}
+
+// ReceiverIdentityPubkeyIn applies the In predicate on the "receiver_identity_pubkey" field.
+func ReceiverIdentityPubkeyIn(vs ...[]byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldIn(FieldReceiverIdentityPubkey, vs...))
+}
+
+// ReceiverIdentityPubkeyNotIn applies the NotIn predicate on the "receiver_identity_pubkey" field.
+func ReceiverIdentityPubkeyNotIn(vs ...[]byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNotIn(FieldReceiverIdentityPubkey, vs...))
+}
+
+// ReceiverIdentityPubkeyGT applies the GT predicate on the "receiver_identity_pubkey" field.
+func ReceiverIdentityPubkeyGT(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGT(FieldReceiverIdentityPubkey, v))
+}
+
+// ReceiverIdentityPubkeyGTE applies the GTE predicate on the "receiver_identity_pubkey" field.
+func ReceiverIdentityPubkeyGTE(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGTE(FieldReceiverIdentityPubkey, v))
+}
+
+// ReceiverIdentityPubkeyLT applies the LT predicate on the "receiver_identity_pubkey" field.
+func ReceiverIdentityPubkeyLT(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLT(FieldReceiverIdentityPubkey, v))
+}
+
+// ReceiverIdentityPubkeyLTE applies the LTE predicate on the "receiver_identity_pubkey" field.
+func ReceiverIdentityPubkeyLTE(v []byte) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLTE(FieldReceiverIdentityPubkey, v))
+}
+
+// TotalValueEQ applies the EQ predicate on the "total_value" field.
+func TotalValueEQ(v uint64) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldTotalValue, v))
+}
+
+// TotalValueNEQ applies the NEQ predicate on the "total_value" field.
+func TotalValueNEQ(v uint64) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNEQ(FieldTotalValue, v))
+}
+
+// TotalValueIn applies the In predicate on the "total_value" field.
+func TotalValueIn(vs ...uint64) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldIn(FieldTotalValue, vs...))
+}
+
+// TotalValueNotIn applies the NotIn predicate on the "total_value" field.
+func TotalValueNotIn(vs ...uint64) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNotIn(FieldTotalValue, vs...))
+}
+
+// TotalValueGT applies the GT predicate on the "total_value" field.
+func TotalValueGT(v uint64) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGT(FieldTotalValue, v))
+}
+
+// TotalValueGTE applies the GTE predicate on the "total_value" field.
+func TotalValueGTE(v uint64) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGTE(FieldTotalValue, v))
+}
+
+// TotalValueLT applies the LT predicate on the "total_value" field.
+func TotalValueLT(v uint64) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLT(FieldTotalValue, v))
+}
+
+// TotalValueLTE applies the LTE predicate on the "total_value" field.
+func TotalValueLTE(v uint64) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLTE(FieldTotalValue, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v schema.TransferStatus) predicate.ArchivedTransfer {
+	vc := v
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v schema.TransferStatus) predicate.ArchivedTransfer {
+	vc := v
+	return predicate.ArchivedTransfer(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...schema.TransferStatus) predicate.ArchivedTransfer {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ArchivedTransfer(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...schema.TransferStatus) predicate.ArchivedTransfer {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ArchivedTransfer(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v schema.TransferType) predicate.ArchivedTransfer {
+	vc := v
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldType, vc))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v schema.TransferType) predicate.ArchivedTransfer {
+	vc := v
+	return predicate.ArchivedTransfer(sql.FieldNEQ(FieldType, vc))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...schema.TransferType) predicate.ArchivedTransfer {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ArchivedTransfer(sql.FieldIn(FieldType, v...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...schema.TransferType) predicate.ArchivedTransfer {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ArchivedTransfer(sql.FieldNotIn(FieldType, v...))
+}
+
+// TransferUpdateTimeEQ applies the EQ predicate on the "transfer_update_time" field.
+func TransferUpdateTimeEQ(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldEQ(FieldTransferUpdateTime, v))
+}
+
+// TransferUpdateTimeNEQ applies the NEQ predicate on the "transfer_update_time" field.
+func TransferUpdateTimeNEQ(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNEQ(FieldTransferUpdateTime, v))
+}
+
+// TransferUpdateTimeIn applies the In predicate on the "transfer_update_time" field.
+func TransferUpdateTimeIn(vs ...time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldIn(FieldTransferUpdateTime, vs...))
+}
+
+// TransferUpdateTimeNotIn applies the NotIn predicate on the "transfer_update_time" field.
+func TransferUpdateTimeNotIn(vs ...time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldNotIn(FieldTransferUpdateTime, vs...))
+}
+
+// TransferUpdateTimeGT applies the GT predicate on the "transfer_update_time" field.
+func TransferUpdateTimeGT(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGT(FieldTransferUpdateTime, v))
+}
+
+// TransferUpdateTimeGTE applies the GTE predicate on the "transfer_update_time" field.
+func TransferUpdateTimeGTE(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldGTE(FieldTransferUpdateTime, v))
+}
+
+// TransferUpdateTimeLT applies the LT predicate on the "transfer_update_time" field.
+func TransferUpdateTimeLT(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLT(FieldTransferUpdateTime, v))
+}
+
+// TransferUpdateTimeLTE applies the LTE predicate on the "transfer_update_time" field.
+func TransferUpdateTimeLTE(v time.Time) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.FieldLTE(FieldTransferUpdateTime, v))
+}
+
+// HasArchive applies the HasEdge predicate on the "archive" edge.
+func HasArchive() predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArchiveTable, ArchiveColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArchiveWith applies the HasEdge predicate on the "archive" edge with a given conditions (other predicates).
+func HasArchiveWith(preds ...predicate.Archive) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(func(s *sql.Selector) {
+		step := newArchiveStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArchivedTransfer) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArchivedTransfer) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArchivedTransfer) predicate.ArchivedTransfer {
+	return predicate.ArchivedTransfer(sql.NotPredicates(p))
+}
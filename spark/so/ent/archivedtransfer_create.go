@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ArchivedTransferCreate is the builder for creating a ArchivedTransfer entity.
+type ArchivedTransferCreate struct {
+	config
+	mutation *ArchivedTransferMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (atc *ArchivedTransferCreate) SetCreateTime(t time.Time) *ArchivedTransferCreate {
+	atc.mutation.SetCreateTime(t)
+	return atc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (atc *ArchivedTransferCreate) SetNillableCreateTime(t *time.Time) *ArchivedTransferCreate {
+	if t != nil {
+		atc.SetCreateTime(*t)
+	}
+	return atc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (atc *ArchivedTransferCreate) SetUpdateTime(t time.Time) *ArchivedTransferCreate {
+	atc.mutation.SetUpdateTime(t)
+	return atc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (atc *ArchivedTransferCreate) SetNillableUpdateTime(t *time.Time) *ArchivedTransferCreate {
+	if t != nil {
+		atc.SetUpdateTime(*t)
+	}
+	return atc
+}
+
+// SetSenderIdentityPubkey sets the "sender_identity_pubkey" field.
+func (atc *ArchivedTransferCreate) SetSenderIdentityPubkey(b []byte) *ArchivedTransferCreate {
+	atc.mutation.SetSenderIdentityPubkey(b)
+	return atc
+}
+
+// SetReceiverIdentityPubkey sets the "receiver_identity_pubkey" field.
+func (atc *ArchivedTransferCreate) SetReceiverIdentityPubkey(b []byte) *ArchivedTransferCreate {
+	atc.mutation.SetReceiverIdentityPubkey(b)
+	return atc
+}
+
+// SetTotalValue sets the "total_value" field.
+func (atc *ArchivedTransferCreate) SetTotalValue(u uint64) *ArchivedTransferCreate {
+	atc.mutation.SetTotalValue(u)
+	return atc
+}
+
+// SetStatus sets the "status" field.
+func (atc *ArchivedTransferCreate) SetStatus(ss schema.TransferStatus) *ArchivedTransferCreate {
+	atc.mutation.SetStatus(ss)
+	return atc
+}
+
+// SetType sets the "type" field.
+func (atc *ArchivedTransferCreate) SetType(st schema.TransferType) *ArchivedTransferCreate {
+	atc.mutation.SetType(st)
+	return atc
+}
+
+// SetTransferUpdateTime sets the "transfer_update_time" field.
+func (atc *ArchivedTransferCreate) SetTransferUpdateTime(t time.Time) *ArchivedTransferCreate {
+	atc.mutation.SetTransferUpdateTime(t)
+	return atc
+}
+
+// SetID sets the "id" field.
+func (atc *ArchivedTransferCreate) SetID(u uuid.UUID) *ArchivedTransferCreate {
+	atc.mutation.SetID(u)
+	return atc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (atc *ArchivedTransferCreate) SetNillableID(u *uuid.UUID) *ArchivedTransferCreate {
+	if u != nil {
+		atc.SetID(*u)
+	}
+	return atc
+}
+
+// SetArchiveID sets the "archive" edge to the Archive entity by ID.
+func (atc *ArchivedTransferCreate) SetArchiveID(id uuid.UUID) *ArchivedTransferCreate {
+	atc.mutation.SetArchiveID(id)
+	return atc
+}
+
+// SetArchive sets the "archive" edge to the Archive entity.
+func (atc *ArchivedTransferCreate) SetArchive(a *Archive) *ArchivedTransferCreate {
+	return atc.SetArchiveID(a.ID)
+}
+
+// Mutation returns the ArchivedTransferMutation object of the builder.
+func (atc *ArchivedTransferCreate) Mutation() *ArchivedTransferMutation {
+	return atc.mutation
+}
+
+// Save creates the ArchivedTransfer in the database.
+func (atc *ArchivedTransferCreate) Save(ctx context.Context) (*ArchivedTransfer, error) {
+	atc.defaults()
+	return withHooks(ctx, atc.sqlSave, atc.mutation, atc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *ArchivedTransferCreate) SaveX(ctx context.Context) *ArchivedTransfer {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atc *ArchivedTransferCreate) Exec(ctx context.Context) error {
+	_, err := atc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atc *ArchivedTransferCreate) ExecX(ctx context.Context) {
+	if err := atc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *ArchivedTransferCreate) defaults() {
+	if _, ok := atc.mutation.CreateTime(); !ok {
+		v := archivedtransfer.DefaultCreateTime()
+		atc.mutation.SetCreateTime(v)
+	}
+	if _, ok := atc.mutation.UpdateTime(); !ok {
+		v := archivedtransfer.DefaultUpdateTime()
+		atc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := atc.mutation.ID(); !ok {
+		v := archivedtransfer.DefaultID()
+		atc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *ArchivedTransferCreate) check() error {
+	if _, ok := atc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ArchivedTransfer.create_time"`)}
+	}
+	if _, ok := atc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ArchivedTransfer.update_time"`)}
+	}
+	if _, ok := atc.mutation.SenderIdentityPubkey(); !ok {
+		return &ValidationError{Name: "sender_identity_pubkey", err: errors.New(`ent: missing required field "ArchivedTransfer.sender_identity_pubkey"`)}
+	}
+	if v, ok := atc.mutation.SenderIdentityPubkey(); ok {
+		if err := archivedtransfer.SenderIdentityPubkeyValidator(v); err != nil {
+			return &ValidationError{Name: "sender_identity_pubkey", err: fmt.Errorf(`ent: validator failed for field "ArchivedTransfer.sender_identity_pubkey": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.ReceiverIdentityPubkey(); !ok {
+		return &ValidationError{Name: "receiver_identity_pubkey", err: errors.New(`ent: missing required field "ArchivedTransfer.receiver_identity_pubkey"`)}
+	}
+	if v, ok := atc.mutation.ReceiverIdentityPubkey(); ok {
+		if err := archivedtransfer.ReceiverIdentityPubkeyValidator(v); err != nil {
+			return &ValidationError{Name: "receiver_identity_pubkey", err: fmt.Errorf(`ent: validator failed for field "ArchivedTransfer.receiver_identity_pubkey": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.TotalValue(); !ok {
+		return &ValidationError{Name: "total_value", err: errors.New(`ent: missing required field "ArchivedTransfer.total_value"`)}
+	}
+	if _, ok := atc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ArchivedTransfer.status"`)}
+	}
+	if v, ok := atc.mutation.Status(); ok {
+		if err := archivedtransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ArchivedTransfer.status": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ArchivedTransfer.type"`)}
+	}
+	if v, ok := atc.mutation.GetType(); ok {
+		if err := archivedtransfer.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ArchivedTransfer.type": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.TransferUpdateTime(); !ok {
+		return &ValidationError{Name: "transfer_update_time", err: errors.New(`ent: missing required field "ArchivedTransfer.transfer_update_time"`)}
+	}
+	if len(atc.mutation.ArchiveIDs()) == 0 {
+		return &ValidationError{Name: "archive", err: errors.New(`ent: missing required edge "ArchivedTransfer.archive"`)}
+	}
+	return nil
+}
+
+func (atc *ArchivedTransferCreate) sqlSave(ctx context.Context) (*ArchivedTransfer, error) {
+	if err := atc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	atc.mutation.id = &_node.ID
+	atc.mutation.done = true
+	return _node, nil
+}
+
+func (atc *ArchivedTransferCreate) createSpec() (*ArchivedTransfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArchivedTransfer{config: atc.config}
+		_spec = sqlgraph.NewCreateSpec(archivedtransfer.Table, sqlgraph.NewFieldSpec(archivedtransfer.FieldID, field.TypeUUID))
+	)
+	if id, ok := atc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := atc.mutation.CreateTime(); ok {
+		_spec.SetField(archivedtransfer.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := atc.mutation.UpdateTime(); ok {
+		_spec.SetField(archivedtransfer.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := atc.mutation.SenderIdentityPubkey(); ok {
+		_spec.SetField(archivedtransfer.FieldSenderIdentityPubkey, field.TypeBytes, value)
+		_node.SenderIdentityPubkey = value
+	}
+	if value, ok := atc.mutation.ReceiverIdentityPubkey(); ok {
+		_spec.SetField(archivedtransfer.FieldReceiverIdentityPubkey, field.TypeBytes, value)
+		_node.ReceiverIdentityPubkey = value
+	}
+	if value, ok := atc.mutation.TotalValue(); ok {
+		_spec.SetField(archivedtransfer.FieldTotalValue, field.TypeUint64, value)
+		_node.TotalValue = value
+	}
+	if value, ok := atc.mutation.Status(); ok {
+		_spec.SetField(archivedtransfer.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := atc.mutation.GetType(); ok {
+		_spec.SetField(archivedtransfer.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := atc.mutation.TransferUpdateTime(); ok {
+		_spec.SetField(archivedtransfer.FieldTransferUpdateTime, field.TypeTime, value)
+		_node.TransferUpdateTime = value
+	}
+	if nodes := atc.mutation.ArchiveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   archivedtransfer.ArchiveTable,
+			Columns: []string{archivedtransfer.ArchiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archive.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.archived_transfer_archive = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ArchivedTransferCreateBulk is the builder for creating many ArchivedTransfer entities in bulk.
+type ArchivedTransferCreateBulk struct {
+	config
+	err      error
+	builders []*ArchivedTransferCreate
+}
+
+// Save creates the ArchivedTransfer entities in the database.
+func (atcb *ArchivedTransferCreateBulk) Save(ctx context.Context) ([]*ArchivedTransfer, error) {
+	if atcb.err != nil {
+		return nil, atcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*ArchivedTransfer, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArchivedTransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *ArchivedTransferCreateBulk) SaveX(ctx context.Context) []*ArchivedTransfer {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atcb *ArchivedTransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := atcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atcb *ArchivedTransferCreateBulk) ExecX(ctx context.Context) {
+	if err := atcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ArchivedTransferDelete is the builder for deleting a ArchivedTransfer entity.
+type ArchivedTransferDelete struct {
+	config
+	hooks    []Hook
+	mutation *ArchivedTransferMutation
+}
+
+// Where appends a list predicates to the ArchivedTransferDelete builder.
+func (atd *ArchivedTransferDelete) Where(ps ...predicate.ArchivedTransfer) *ArchivedTransferDelete {
+	atd.mutation.Where(ps...)
+	return atd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (atd *ArchivedTransferDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, atd.sqlExec, atd.mutation, atd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atd *ArchivedTransferDelete) ExecX(ctx context.Context) int {
+	n, err := atd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (atd *ArchivedTransferDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(archivedtransfer.Table, sqlgraph.NewFieldSpec(archivedtransfer.FieldID, field.TypeUUID))
+	if ps := atd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, atd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	atd.mutation.done = true
+	return affected, err
+}
+
+// ArchivedTransferDeleteOne is the builder for deleting a single ArchivedTransfer entity.
+type ArchivedTransferDeleteOne struct {
+	atd *ArchivedTransferDelete
+}
+
+// Where appends a list predicates to the ArchivedTransferDelete builder.
+func (atdo *ArchivedTransferDeleteOne) Where(ps ...predicate.ArchivedTransfer) *ArchivedTransferDeleteOne {
+	atdo.atd.mutation.Where(ps...)
+	return atdo
+}
+
+// Exec executes the deletion query.
+func (atdo *ArchivedTransferDeleteOne) Exec(ctx context.Context) error {
+	n, err := atdo.atd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{archivedtransfer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atdo *ArchivedTransferDeleteOne) ExecX(ctx context.Context) {
+	if err := atdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
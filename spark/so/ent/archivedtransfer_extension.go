@@ -0,0 +1,339 @@
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so/archive"
+	entarchive "github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
+	"github.com/lightsparkdev/spark/so/ent/cooperativeexit"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
+	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
+	"github.com/lightsparkdev/spark/so/ent/transfer"
+	"github.com/lightsparkdev/spark/so/ent/transferleaf"
+	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/ent/usersignedtransaction"
+	"github.com/lightsparkdev/spark/so/ent/utxoswap"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// The kinds of archives.
+const (
+	ArchiveKindTransfers    = "transfers"
+	ArchiveKindTokenOutputs = "token_outputs"
+)
+
+var archivedRecordsCounter metric.Int64Counter
+
+func init() {
+	var err error
+	archivedRecordsCounter, err = otel.Meter("archive").Int64Counter(
+		"spark_archived_records",
+		metric.WithDescription("Number of records moved to archives by the retention tasks, by kind"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// finishedTransferStatuses are the statuses after which a transfer never changes again.
+var finishedTransferStatuses = []schema.TransferStatus{
+	schema.TransferStatusCompleted,
+	schema.TransferStatusExpired,
+	schema.TransferStatusReturned,
+}
+
+// archivedTransferRecord is a transfer as it is written to an archive.
+type archivedTransferRecord struct {
+	ID       uuid.UUID       `json:"id"`
+	Transfer json.RawMessage `json:"transfer"`
+	// UserSignedTransactions are the transactions signed by the user for the preimage request
+	// that paid for the transfer, if any.
+	UserSignedTransactions []archivedUserSignedTransaction `json:"user_signed_transactions,omitempty"`
+}
+
+type archivedUserSignedTransaction struct {
+	ID                      uuid.UUID `json:"id"`
+	TreeNodeID              uuid.UUID `json:"tree_node_id"`
+	PaymentHash             []byte    `json:"payment_hash"`
+	Transaction             []byte    `json:"transaction"`
+	UserSignature           []byte    `json:"user_signature"`
+	SigningCommitments      []byte    `json:"signing_commitments"`
+	UserSignatureCommitment []byte    `json:"user_signature_commitment"`
+}
+
+// archivedTokenOutputRecord holds the spend of a token output whose signatures were cleared from the
+// database.
+type archivedTokenOutputRecord struct {
+	ID                                      uuid.UUID `json:"id"`
+	OwnerPublicKey                          []byte    `json:"owner_public_key"`
+	TokenPublicKey                          []byte    `json:"token_public_key"`
+	SpentTokenTransactionHash               []byte    `json:"spent_token_transaction_hash,omitempty"`
+	SpentTransactionInputVout               int32     `json:"spent_transaction_input_vout"`
+	SpentOwnershipSignature                 []byte    `json:"spent_ownership_signature"`
+	SpentOperatorSpecificOwnershipSignature []byte    `json:"spent_operator_specific_ownership_signature,omitempty"`
+}
+
+// transferArchivable matches finished transfers last updated before cutoff. Transfers that
+// cooperative exits or UTXO swaps refer to stay in the database, since those are still checked
+// against their transfer.
+func transferArchivable(cutoff time.Time) predicate.Transfer {
+	return transfer.And(
+		transfer.StatusIn(finishedTransferStatuses...),
+		transfer.UpdateTimeLT(cutoff),
+		func(s *sql.Selector) {
+			exits := sql.Table(cooperativeexit.Table)
+			swaps := sql.Table(utxoswap.Table)
+			s.Where(sql.And(
+				sql.NotExists(
+					sql.Select(exits.C(cooperativeexit.FieldID)).
+						From(exits).
+						Where(sql.ColumnsEQ(exits.C(cooperativeexit.TransferColumn), s.C(transfer.FieldID))),
+				),
+				sql.NotExists(
+					sql.Select(swaps.C(utxoswap.FieldID)).
+						From(swaps).
+						Where(sql.ColumnsEQ(swaps.C(utxoswap.TransferColumn), s.C(transfer.FieldID))),
+				),
+			))
+		},
+	)
+}
+
+// ArchiveTransfers moves up to limit finished transfers last updated before cutoff, with their leaves
+// and the transactions the user signed to pay for them, to a new archive. Each transfer leaves a
+// tombstone behind, which keeps its ID from being reused and lets it be read back from the
+// archive. The archive is created in the transaction in the context, so the transfers are only
+// deleted if it is stored.
+func ArchiveTransfers(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	db := GetDbFromContext(ctx)
+	transfers, err := db.Transfer.Query().
+		Where(transferArchivable(cutoff)).
+		Order(Asc(transfer.FieldUpdateTime)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query transfers to archive: %w", err)
+	}
+	if len(transfers) == 0 {
+		return 0, nil
+	}
+	ids := make([]uuid.UUID, len(transfers))
+	for i, t := range transfers {
+		ids[i] = t.ID
+	}
+
+	userSignedTransactions, err := db.UserSignedTransaction.Query().
+		Where(usersignedtransaction.HasPreimageRequestWith(preimagerequest.HasTransfersWith(transfer.IDIn(ids...)))).
+		WithTreeNode(func(q *TreeNodeQuery) { q.Select(treenode.FieldID) }).
+		WithPreimageRequest(func(q *PreimageRequestQuery) { q.WithTransfers(func(q *TransferQuery) { q.Select(transfer.FieldID) }) }).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query user signed transactions to archive: %w", err)
+	}
+	byTransfer := make(map[uuid.UUID][]archivedUserSignedTransaction)
+	userSignedTransactionIDs := make([]uuid.UUID, len(userSignedTransactions))
+	for i, tx := range userSignedTransactions {
+		userSignedTransactionIDs[i] = tx.ID
+		request := tx.Edges.PreimageRequest
+		transferID := request.Edges.Transfers.ID
+		byTransfer[transferID] = append(byTransfer[transferID], archivedUserSignedTransaction{
+			ID:                      tx.ID,
+			TreeNodeID:              tx.Edges.TreeNode.ID,
+			PaymentHash:             request.PaymentHash,
+			Transaction:             tx.Transaction,
+			UserSignature:           tx.UserSignature,
+			SigningCommitments:      tx.SigningCommitments,
+			UserSignatureCommitment: tx.UserSignatureCommitment,
+		})
+	}
+
+	records := make([]archivedTransferRecord, len(transfers))
+	for i, t := range transfers {
+		transferProto, err := t.MarshalProto(ctx)
+		if err != nil {
+			return 0, err
+		}
+		transferJSON, err := protojson.Marshal(transferProto)
+		if err != nil {
+			return 0, fmt.Errorf("failed to marshal transfer %s: %w", t.ID, err)
+		}
+		records[i] = archivedTransferRecord{
+			ID:                     t.ID,
+			Transfer:               transferJSON,
+			UserSignedTransactions: byTransfer[t.ID],
+		}
+	}
+	stored, err := createArchive(ctx, ArchiveKindTransfers, records)
+	if err != nil {
+		return 0, err
+	}
+
+	tombstones := make([]*ArchivedTransferCreate, len(transfers))
+	for i, t := range transfers {
+		tombstones[i] = db.ArchivedTransfer.Create().
+			SetID(t.ID).
+			SetSenderIdentityPubkey(t.SenderIdentityPubkey).
+			SetReceiverIdentityPubkey(t.ReceiverIdentityPubkey).
+			SetTotalValue(t.TotalValue).
+			SetStatus(t.Status).
+			SetType(t.Type).
+			SetTransferUpdateTime(t.UpdateTime).
+			SetArchive(stored)
+	}
+	if err := db.ArchivedTransfer.CreateBulk(tombstones...).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to create archived transfers: %w", err)
+	}
+	if _, err := db.UserSignedTransaction.Delete().Where(usersignedtransaction.IDIn(userSignedTransactionIDs...)).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to delete archived user signed transactions: %w", err)
+	}
+	if _, err := db.TransferLeaf.Delete().Where(transferleaf.HasTransferWith(transfer.IDIn(ids...))).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to delete archived transfer leaves: %w", err)
+	}
+	// Preimage requests stay, and are detached from their transfer by the database.
+	archived, err := db.Transfer.Delete().Where(transfer.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete archived transfers: %w", err)
+	}
+	archivedRecordsCounter.Add(ctx, int64(archived), metric.WithAttributes(attribute.String("kind", ArchiveKindTransfers)))
+	return archived, nil
+}
+
+// ArchiveTokenOutputs moves the spend signatures of up to limit token outputs spent by transactions
+// finalized before cutoff to a new archive, and clears them from their outputs. The outputs
+// themselves stay, since they are part of the history of their token.
+func ArchiveTokenOutputs(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	db := GetDbFromContext(ctx)
+	outputs, err := db.TokenOutput.Query().
+		Where(
+			tokenoutput.StatusEQ(schema.TokenOutputStatusSpentFinalized),
+			tokenoutput.SpentOwnershipSignatureNotNil(),
+			tokenoutput.UpdateTimeLT(cutoff),
+		).
+		WithOutputSpentTokenTransaction(func(q *TokenTransactionQuery) {
+			q.Select(tokentransaction.FieldFinalizedTokenTransactionHash)
+		}).
+		Order(Asc(tokenoutput.FieldUpdateTime)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query token outputs to archive: %w", err)
+	}
+	if len(outputs) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]uuid.UUID, len(outputs))
+	records := make([]archivedTokenOutputRecord, len(outputs))
+	for i, output := range outputs {
+		ids[i] = output.ID
+		records[i] = archivedTokenOutputRecord{
+			ID:                                      output.ID,
+			OwnerPublicKey:                          output.OwnerPublicKey,
+			TokenPublicKey:                          output.TokenPublicKey,
+			SpentTransactionInputVout:               output.SpentTransactionInputVout,
+			SpentOwnershipSignature:                 output.SpentOwnershipSignature,
+			SpentOperatorSpecificOwnershipSignature: output.SpentOperatorSpecificOwnershipSignature,
+		}
+		if spent := output.Edges.OutputSpentTokenTransaction; spent != nil {
+			records[i].SpentTokenTransactionHash = spent.FinalizedTokenTransactionHash
+		}
+	}
+	if _, err := createArchive(ctx, ArchiveKindTokenOutputs, records); err != nil {
+		return 0, err
+	}
+
+	archived, err := db.TokenOutput.Update().
+		Where(tokenoutput.IDIn(ids...)).
+		ClearSpentOwnershipSignature().
+		ClearSpentOperatorSpecificOwnershipSignature().
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to clear archived token output signatures: %w", err)
+	}
+	archivedRecordsCounter.Add(ctx, int64(archived), metric.WithAttributes(attribute.String("kind", ArchiveKindTokenOutputs)))
+	return archived, nil
+}
+
+// createArchive stores the records in a new archive of the kind.
+func createArchive[T any](ctx context.Context, kind string, records []T) (*Archive, error) {
+	data, err := archive.Encode(records)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := GetDbFromContext(ctx).Archive.Create().
+		SetKind(kind).
+		SetRecordCount(len(records)).
+		SetData(data).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s archive: %w", kind, err)
+	}
+	return stored, nil
+}
+
+// ReadArchivedTransfers reads the transfers of the tombstones back from their archives, in the
+// order of the tombstones.
+func ReadArchivedTransfers(ctx context.Context, archived []*ArchivedTransfer) ([]*pb.Transfer, error) {
+	ids := make([]uuid.UUID, len(archived))
+	for i, a := range archived {
+		ids[i] = a.ID
+	}
+	archiveIDs, err := GetDbFromContext(ctx).ArchivedTransfer.Query().
+		Where(archivedtransfer.IDIn(ids...)).
+		QueryArchive().
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archives of transfers: %w", err)
+	}
+	archives, err := GetDbFromContext(ctx).Archive.Query().
+		Where(entarchive.IDIn(archiveIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archives of transfers: %w", err)
+	}
+
+	indexes := make(map[uuid.UUID]int, len(archived))
+	for i, a := range archived {
+		indexes[a.ID] = i
+	}
+	transfers := make([]*pb.Transfer, len(archived))
+	remaining := len(indexes)
+	for _, stored := range archives {
+		err := archive.Scan(stored.Data, func(line json.RawMessage) (bool, error) {
+			var record archivedTransferRecord
+			if err := json.Unmarshal(line, &record); err != nil {
+				return false, fmt.Errorf("failed to parse archived transfer in archive %s: %w", stored.ID, err)
+			}
+			i, ok := indexes[record.ID]
+			if !ok || transfers[i] != nil {
+				return true, nil
+			}
+			transfers[i] = &pb.Transfer{}
+			if err := protojson.Unmarshal(record.Transfer, transfers[i]); err != nil {
+				return false, fmt.Errorf("failed to parse archived transfer %s: %w", record.ID, err)
+			}
+			remaining--
+			return remaining > 0, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if remaining > 0 {
+		return nil, fmt.Errorf("%d archived transfers are missing from their archives", remaining)
+	}
+	return transfers, nil
+}
@@ -0,0 +1,131 @@
+package ent_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestTransfer(ctx context.Context, t *testing.T, status schema.TransferStatus, updateTime time.Time) *ent.Transfer {
+	transfer, err := ent.GetDbFromContext(ctx).Transfer.Create().
+		SetSenderIdentityPubkey(bytes.Repeat([]byte{1}, 33)).
+		SetReceiverIdentityPubkey(bytes.Repeat([]byte{2}, 33)).
+		SetTotalValue(1000).
+		SetStatus(status).
+		SetType(schema.TransferTypeTransfer).
+		SetExpiryTime(updateTime).
+		SetUpdateTime(updateTime).
+		Save(ctx)
+	require.NoError(t, err)
+	return transfer
+}
+
+func TestArchiveTransfers(t *testing.T) {
+	ctx := newTestTxContext(t, "archive_transfers")
+	db := ent.GetDbFromContext(ctx)
+	now := time.Now()
+	old := createTestTransfer(ctx, t, schema.TransferStatusCompleted, now.Add(-48*time.Hour))
+	older := createTestTransfer(ctx, t, schema.TransferStatusExpired, now.Add(-72*time.Hour))
+	pending := createTestTransfer(ctx, t, schema.TransferStatusSenderKeyTweaked, now.Add(-72*time.Hour))
+	recent := createTestTransfer(ctx, t, schema.TransferStatusCompleted, now)
+
+	count, err := ent.ArchiveTransfers(ctx, now.Add(-24*time.Hour), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	count, err = ent.ArchiveTransfers(ctx, now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	count, err = ent.ArchiveTransfers(ctx, now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	ids, err := db.Transfer.Query().IDs(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{pending.ID, recent.ID}, ids)
+
+	tombstones, err := db.ArchivedTransfer.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, tombstones, 2)
+	// Each batch is archived into its own archive.
+	require.NotEqual(t, tombstones[0].QueryArchive().OnlyIDX(ctx), tombstones[1].QueryArchive().OnlyIDX(ctx))
+	require.Equal(t, 2, db.Archive.Query().Where(archive.Kind(ent.ArchiveKindTransfers)).CountX(ctx))
+
+	reversed := []*ent.ArchivedTransfer{tombstones[1], tombstones[0]}
+	transfers, err := ent.ReadArchivedTransfers(ctx, reversed)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	for i, transfer := range transfers {
+		require.Equal(t, reversed[i].ID.String(), transfer.Id)
+	}
+	byID := map[string]*ent.Transfer{old.ID.String(): old, older.ID.String(): older}
+	for _, transfer := range transfers {
+		original := byID[transfer.Id]
+		require.Equal(t, original.TotalValue, transfer.TotalValue)
+		require.Equal(t, original.SenderIdentityPubkey, transfer.SenderIdentityPublicKey)
+		require.Equal(t, original.UpdateTime.Unix(), transfer.UpdatedTime.AsTime().Unix())
+	}
+
+	missing := *tombstones[0]
+	missing.ID = uuid.New()
+	_, err = ent.ReadArchivedTransfers(ctx, []*ent.ArchivedTransfer{&missing})
+	require.ErrorContains(t, err, "missing")
+}
+
+func TestArchiveTokenOutputs(t *testing.T) {
+	ctx := newTestTxContext(t, "archive_token_outputs")
+	db := ent.GetDbFromContext(ctx)
+	keyshare, err := db.SigningKeyshare.Create().
+		SetStatus(schema.KeyshareStatusInUse).
+		SetSecretShare([]byte("secret")).
+		SetPublicShares(map[string][]byte{}).
+		SetPublicKey([]byte("revocation")).
+		SetMinSigners(2).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+
+	now := time.Now()
+	createOutput := func(status schema.TokenOutputStatus, updateTime time.Time) *ent.TokenOutput {
+		output, err := db.TokenOutput.Create().
+			SetStatus(status).
+			SetOwnerPublicKey([]byte("owner")).
+			SetWithdrawBondSats(1).
+			SetWithdrawRelativeBlockLocktime(1).
+			SetWithdrawRevocationCommitment([]byte("commitment")).
+			SetTokenPublicKey([]byte("token")).
+			SetTokenAmount([]byte{1}).
+			SetCreatedTransactionOutputVout(0).
+			SetSpentOwnershipSignature([]byte("signature")).
+			SetSpentTransactionInputVout(0).
+			SetSpentRevocationSecret([]byte("secret")).
+			SetRevocationKeyshare(keyshare).
+			SetUpdateTime(updateTime).
+			Save(ctx)
+		require.NoError(t, err)
+		return output
+	}
+	spent := createOutput(schema.TokenOutputStatusSpentFinalized, now.Add(-48*time.Hour))
+	createOutput(schema.TokenOutputStatusSpentStarted, now.Add(-48*time.Hour))
+	createOutput(schema.TokenOutputStatusSpentFinalized, now)
+
+	count, err := ent.ArchiveTokenOutputs(ctx, now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	archived, err := db.TokenOutput.Get(ctx, spent.ID)
+	require.NoError(t, err)
+	require.Nil(t, archived.SpentOwnershipSignature)
+	require.Equal(t, []byte("secret"), archived.SpentRevocationSecret)
+	remaining, err := db.TokenOutput.Query().Where(tokenoutput.SpentOwnershipSignatureNotNil()).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, remaining)
+	require.Equal(t, 1, db.Archive.Query().Where(archive.Kind(ent.ArchiveKindTokenOutputs)).CountX(ctx))
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ArchivedTransferQuery is the builder for querying ArchivedTransfer entities.
+type ArchivedTransferQuery struct {
+	config
+	ctx         *QueryContext
+	order       []archivedtransfer.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ArchivedTransfer
+	withArchive *ArchiveQuery
+	withFKs     bool
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ArchivedTransferQuery builder.
+func (atq *ArchivedTransferQuery) Where(ps ...predicate.ArchivedTransfer) *ArchivedTransferQuery {
+	atq.predicates = append(atq.predicates, ps...)
+	return atq
+}
+
+// Limit the number of records to be returned by this query.
+func (atq *ArchivedTransferQuery) Limit(limit int) *ArchivedTransferQuery {
+	atq.ctx.Limit = &limit
+	return atq
+}
+
+// Offset to start from.
+func (atq *ArchivedTransferQuery) Offset(offset int) *ArchivedTransferQuery {
+	atq.ctx.Offset = &offset
+	return atq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (atq *ArchivedTransferQuery) Unique(unique bool) *ArchivedTransferQuery {
+	atq.ctx.Unique = &unique
+	return atq
+}
+
+// Order specifies how the records should be ordered.
+func (atq *ArchivedTransferQuery) Order(o ...archivedtransfer.OrderOption) *ArchivedTransferQuery {
+	atq.order = append(atq.order, o...)
+	return atq
+}
+
+// QueryArchive chains the current query on the "archive" edge.
+func (atq *ArchivedTransferQuery) QueryArchive() *ArchiveQuery {
+	query := (&ArchiveClient{config: atq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := atq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := atq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archivedtransfer.Table, archivedtransfer.FieldID, selector),
+			sqlgraph.To(archive.Table, archive.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, archivedtransfer.ArchiveTable, archivedtransfer.ArchiveColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(atq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ArchivedTransfer entity from the query.
+// Returns a *NotFoundError when no ArchivedTransfer was found.
+func (atq *ArchivedTransferQuery) First(ctx context.Context) (*ArchivedTransfer, error) {
+	nodes, err := atq.Limit(1).All(setContextOp(ctx, atq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{archivedtransfer.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (atq *ArchivedTransferQuery) FirstX(ctx context.Context) *ArchivedTransfer {
+	node, err := atq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ArchivedTransfer ID from the query.
+// Returns a *NotFoundError when no ArchivedTransfer ID was found.
+func (atq *ArchivedTransferQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = atq.Limit(1).IDs(setContextOp(ctx, atq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{archivedtransfer.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (atq *ArchivedTransferQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := atq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ArchivedTransfer entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ArchivedTransfer entity is found.
+// Returns a *NotFoundError when no ArchivedTransfer entities are found.
+func (atq *ArchivedTransferQuery) Only(ctx context.Context) (*ArchivedTransfer, error) {
+	nodes, err := atq.Limit(2).All(setContextOp(ctx, atq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{archivedtransfer.Label}
+	default:
+		return nil, &NotSingularError{archivedtransfer.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (atq *ArchivedTransferQuery) OnlyX(ctx context.Context) *ArchivedTransfer {
+	node, err := atq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ArchivedTransfer ID in the query.
+// Returns a *NotSingularError when more than one ArchivedTransfer ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (atq *ArchivedTransferQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = atq.Limit(2).IDs(setContextOp(ctx, atq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{archivedtransfer.Label}
+	default:
+		err = &NotSingularError{archivedtransfer.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (atq *ArchivedTransferQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := atq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ArchivedTransfers.
+func (atq *ArchivedTransferQuery) All(ctx context.Context) ([]*ArchivedTransfer, error) {
+	ctx = setContextOp(ctx, atq.ctx, ent.OpQueryAll)
+	if err := atq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ArchivedTransfer, *ArchivedTransferQuery]()
+	return withInterceptors[[]*ArchivedTransfer](ctx, atq, qr, atq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (atq *ArchivedTransferQuery) AllX(ctx context.Context) []*ArchivedTransfer {
+	nodes, err := atq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ArchivedTransfer IDs.
+func (atq *ArchivedTransferQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if atq.ctx.Unique == nil && atq.path != nil {
+		atq.Unique(true)
+	}
+	ctx = setContextOp(ctx, atq.ctx, ent.OpQueryIDs)
+	if err = atq.Select(archivedtransfer.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (atq *ArchivedTransferQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := atq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (atq *ArchivedTransferQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, atq.ctx, ent.OpQueryCount)
+	if err := atq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, atq, querierCount[*ArchivedTransferQuery](), atq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (atq *ArchivedTransferQuery) CountX(ctx context.Context) int {
+	count, err := atq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (atq *ArchivedTransferQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, atq.ctx, ent.OpQueryExist)
+	switch _, err := atq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (atq *ArchivedTransferQuery) ExistX(ctx context.Context) bool {
+	exist, err := atq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ArchivedTransferQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (atq *ArchivedTransferQuery) Clone() *ArchivedTransferQuery {
+	if atq == nil {
+		return nil
+	}
+	return &ArchivedTransferQuery{
+		config:      atq.config,
+		ctx:         atq.ctx.Clone(),
+		order:       append([]archivedtransfer.OrderOption{}, atq.order...),
+		inters:      append([]Interceptor{}, atq.inters...),
+		predicates:  append([]predicate.ArchivedTransfer{}, atq.predicates...),
+		withArchive: atq.withArchive.Clone(),
+		// clone intermediate query.
+		sql:  atq.sql.Clone(),
+		path: atq.path,
+	}
+}
+
+// WithArchive tells the query-builder to eager-load the nodes that are connected to
+// the "archive" edge. The optional arguments are used to configure the query builder of the edge.
+func (atq *ArchivedTransferQuery) WithArchive(opts ...func(*ArchiveQuery)) *ArchivedTransferQuery {
+	query := (&ArchiveClient{config: atq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	atq.withArchive = query
+	return atq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ArchivedTransfer.Query().
+//		GroupBy(archivedtransfer.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (atq *ArchivedTransferQuery) GroupBy(field string, fields ...string) *ArchivedTransferGroupBy {
+	atq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ArchivedTransferGroupBy{build: atq}
+	grbuild.flds = &atq.ctx.Fields
+	grbuild.label = archivedtransfer.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ArchivedTransfer.Query().
+//		Select(archivedtransfer.FieldCreateTime).
+//		Scan(ctx, &v)
+func (atq *ArchivedTransferQuery) Select(fields ...string) *ArchivedTransferSelect {
+	atq.ctx.Fields = append(atq.ctx.Fields, fields...)
+	sbuild := &ArchivedTransferSelect{ArchivedTransferQuery: atq}
+	sbuild.label = archivedtransfer.Label
+	sbuild.flds, sbuild.scan = &atq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ArchivedTransferSelect configured with the given aggregations.
+func (atq *ArchivedTransferQuery) Aggregate(fns ...AggregateFunc) *ArchivedTransferSelect {
+	return atq.Select().Aggregate(fns...)
+}
+
+func (atq *ArchivedTransferQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range atq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, atq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range atq.ctx.Fields {
+		if !archivedtransfer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if atq.path != nil {
+		prev, err := atq.path(ctx)
+		if err != nil {
+			return err
+		}
+		atq.sql = prev
+	}
+	return nil
+}
+
+func (atq *ArchivedTransferQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ArchivedTransfer, error) {
+	var (
+		nodes       = []*ArchivedTransfer{}
+		withFKs     = atq.withFKs
+		_spec       = atq.querySpec()
+		loadedTypes = [1]bool{
+			atq.withArchive != nil,
+		}
+	)
+	if atq.withArchive != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, archivedtransfer.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ArchivedTransfer).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ArchivedTransfer{config: atq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(atq.modifiers) > 0 {
+		_spec.Modifiers = atq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, atq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := atq.withArchive; query != nil {
+		if err := atq.loadArchive(ctx, query, nodes, nil,
+			func(n *ArchivedTransfer, e *Archive) { n.Edges.Archive = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (atq *ArchivedTransferQuery) loadArchive(ctx context.Context, query *ArchiveQuery, nodes []*ArchivedTransfer, init func(*ArchivedTransfer), assign func(*ArchivedTransfer, *Archive)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ArchivedTransfer)
+	for i := range nodes {
+		if nodes[i].archived_transfer_archive == nil {
+			continue
+		}
+		fk := *nodes[i].archived_transfer_archive
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(archive.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "archived_transfer_archive" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (atq *ArchivedTransferQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := atq.querySpec()
+	if len(atq.modifiers) > 0 {
+		_spec.Modifiers = atq.modifiers
+	}
+	_spec.Node.Columns = atq.ctx.Fields
+	if len(atq.ctx.Fields) > 0 {
+		_spec.Unique = atq.ctx.Unique != nil && *atq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, atq.driver, _spec)
+}
+
+func (atq *ArchivedTransferQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(archivedtransfer.Table, archivedtransfer.Columns, sqlgraph.NewFieldSpec(archivedtransfer.FieldID, field.TypeUUID))
+	_spec.From = atq.sql
+	if unique := atq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if atq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := atq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archivedtransfer.FieldID)
+		for i := range fields {
+			if fields[i] != archivedtransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := atq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := atq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := atq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := atq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (atq *ArchivedTransferQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(atq.driver.Dialect())
+	t1 := builder.Table(archivedtransfer.Table)
+	columns := atq.ctx.Fields
+	if len(columns) == 0 {
+		columns = archivedtransfer.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if atq.sql != nil {
+		selector = atq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if atq.ctx.Unique != nil && *atq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range atq.modifiers {
+		m(selector)
+	}
+	for _, p := range atq.predicates {
+		p(selector)
+	}
+	for _, p := range atq.order {
+		p(selector)
+	}
+	if offset := atq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := atq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (atq *ArchivedTransferQuery) ForUpdate(opts ...sql.LockOption) *ArchivedTransferQuery {
+	if atq.driver.Dialect() == dialect.Postgres {
+		atq.Unique(false)
+	}
+	atq.modifiers = append(atq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return atq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (atq *ArchivedTransferQuery) ForShare(opts ...sql.LockOption) *ArchivedTransferQuery {
+	if atq.driver.Dialect() == dialect.Postgres {
+		atq.Unique(false)
+	}
+	atq.modifiers = append(atq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return atq
+}
+
+// ArchivedTransferGroupBy is the group-by builder for ArchivedTransfer entities.
+type ArchivedTransferGroupBy struct {
+	selector
+	build *ArchivedTransferQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (atgb *ArchivedTransferGroupBy) Aggregate(fns ...AggregateFunc) *ArchivedTransferGroupBy {
+	atgb.fns = append(atgb.fns, fns...)
+	return atgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (atgb *ArchivedTransferGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, atgb.build.ctx, ent.OpQueryGroupBy)
+	if err := atgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArchivedTransferQuery, *ArchivedTransferGroupBy](ctx, atgb.build, atgb, atgb.build.inters, v)
+}
+
+func (atgb *ArchivedTransferGroupBy) sqlScan(ctx context.Context, root *ArchivedTransferQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(atgb.fns))
+	for _, fn := range atgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*atgb.flds)+len(atgb.fns))
+		for _, f := range *atgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*atgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := atgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ArchivedTransferSelect is the builder for selecting fields of ArchivedTransfer entities.
+type ArchivedTransferSelect struct {
+	*ArchivedTransferQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ats *ArchivedTransferSelect) Aggregate(fns ...AggregateFunc) *ArchivedTransferSelect {
+	ats.fns = append(ats.fns, fns...)
+	return ats
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ats *ArchivedTransferSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ats.ctx, ent.OpQuerySelect)
+	if err := ats.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArchivedTransferQuery, *ArchivedTransferSelect](ctx, ats.ArchivedTransferQuery, ats, ats.inters, v)
+}
+
+func (ats *ArchivedTransferSelect) sqlScan(ctx context.Context, root *ArchivedTransferQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ats.fns))
+	for _, fn := range ats.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ats.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ats.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ArchivedTransferUpdate is the builder for updating ArchivedTransfer entities.
+type ArchivedTransferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArchivedTransferMutation
+}
+
+// Where appends a list predicates to the ArchivedTransferUpdate builder.
+func (atu *ArchivedTransferUpdate) Where(ps ...predicate.ArchivedTransfer) *ArchivedTransferUpdate {
+	atu.mutation.Where(ps...)
+	return atu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (atu *ArchivedTransferUpdate) SetUpdateTime(t time.Time) *ArchivedTransferUpdate {
+	atu.mutation.SetUpdateTime(t)
+	return atu
+}
+
+// Mutation returns the ArchivedTransferMutation object of the builder.
+func (atu *ArchivedTransferUpdate) Mutation() *ArchivedTransferMutation {
+	return atu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *ArchivedTransferUpdate) Save(ctx context.Context) (int, error) {
+	atu.defaults()
+	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *ArchivedTransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *ArchivedTransferUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *ArchivedTransferUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atu *ArchivedTransferUpdate) defaults() {
+	if _, ok := atu.mutation.UpdateTime(); !ok {
+		v := archivedtransfer.UpdateDefaultUpdateTime()
+		atu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atu *ArchivedTransferUpdate) check() error {
+	if atu.mutation.ArchiveCleared() && len(atu.mutation.ArchiveIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArchivedTransfer.archive"`)
+	}
+	return nil
+}
+
+func (atu *ArchivedTransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := atu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archivedtransfer.Table, archivedtransfer.Columns, sqlgraph.NewFieldSpec(archivedtransfer.FieldID, field.TypeUUID))
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.UpdateTime(); ok {
+		_spec.SetField(archivedtransfer.FieldUpdateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivedtransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atu.mutation.done = true
+	return n, nil
+}
+
+// ArchivedTransferUpdateOne is the builder for updating a single ArchivedTransfer entity.
+type ArchivedTransferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArchivedTransferMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (atuo *ArchivedTransferUpdateOne) SetUpdateTime(t time.Time) *ArchivedTransferUpdateOne {
+	atuo.mutation.SetUpdateTime(t)
+	return atuo
+}
+
+// Mutation returns the ArchivedTransferMutation object of the builder.
+func (atuo *ArchivedTransferUpdateOne) Mutation() *ArchivedTransferMutation {
+	return atuo.mutation
+}
+
+// Where appends a list predicates to the ArchivedTransferUpdate builder.
+func (atuo *ArchivedTransferUpdateOne) Where(ps ...predicate.ArchivedTransfer) *ArchivedTransferUpdateOne {
+	atuo.mutation.Where(ps...)
+	return atuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuo *ArchivedTransferUpdateOne) Select(field string, fields ...string) *ArchivedTransferUpdateOne {
+	atuo.fields = append([]string{field}, fields...)
+	return atuo
+}
+
+// Save executes the query and returns the updated ArchivedTransfer entity.
+func (atuo *ArchivedTransferUpdateOne) Save(ctx context.Context) (*ArchivedTransfer, error) {
+	atuo.defaults()
+	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *ArchivedTransferUpdateOne) SaveX(ctx context.Context) *ArchivedTransfer {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *ArchivedTransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *ArchivedTransferUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atuo *ArchivedTransferUpdateOne) defaults() {
+	if _, ok := atuo.mutation.UpdateTime(); !ok {
+		v := archivedtransfer.UpdateDefaultUpdateTime()
+		atuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atuo *ArchivedTransferUpdateOne) check() error {
+	if atuo.mutation.ArchiveCleared() && len(atuo.mutation.ArchiveIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArchivedTransfer.archive"`)
+	}
+	return nil
+}
+
+func (atuo *ArchivedTransferUpdateOne) sqlSave(ctx context.Context) (_node *ArchivedTransfer, err error) {
+	if err := atuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archivedtransfer.Table, archivedtransfer.Columns, sqlgraph.NewFieldSpec(archivedtransfer.FieldID, field.TypeUUID))
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArchivedTransfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archivedtransfer.FieldID)
+		for _, f := range fields {
+			if !archivedtransfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != archivedtransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.UpdateTime(); ok {
+		_spec.SetField(archivedtransfer.FieldUpdateTime, field.TypeTime, value)
+	}
+	_node = &ArchivedTransfer{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivedtransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuo.mutation.done = true
+	return _node, nil
+}
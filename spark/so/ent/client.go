@@ -16,13 +16,28 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
 	"github.com/lightsparkdev/spark/so/ent/blockheight"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
 	"github.com/lightsparkdev/spark/so/ent/cooperativeexit"
 	"github.com/lightsparkdev/spark/so/ent/depositaddress"
+	"github.com/lightsparkdev/spark/so/ent/dkgsession"
+	"github.com/lightsparkdev/spark/so/ent/idempotencykey"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
 	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
 	"github.com/lightsparkdev/spark/so/ent/preimageshare"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+	"github.com/lightsparkdev/spark/so/ent/reshare"
+	"github.com/lightsparkdev/spark/so/ent/sessionrevocation"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+	"github.com/lightsparkdev/spark/so/ent/signingincident"
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
+	"github.com/lightsparkdev/spark/so/ent/tasklock"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
 	"github.com/lightsparkdev/spark/so/ent/tokenleaf"
 	"github.com/lightsparkdev/spark/so/ent/tokenmint"
@@ -36,6 +51,8 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/usersignedtransaction"
 	"github.com/lightsparkdev/spark/so/ent/utxo"
 	"github.com/lightsparkdev/spark/so/ent/utxoswap"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -43,20 +60,50 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Archive is the client for interacting with the Archive builders.
+	Archive *ArchiveClient
+	// ArchivedTransfer is the client for interacting with the ArchivedTransfer builders.
+	ArchivedTransfer *ArchivedTransferClient
 	// BlockHeight is the client for interacting with the BlockHeight builders.
 	BlockHeight *BlockHeightClient
+	// ConsistencyAuditCursor is the client for interacting with the ConsistencyAuditCursor builders.
+	ConsistencyAuditCursor *ConsistencyAuditCursorClient
+	// ConsistencyDiscrepancy is the client for interacting with the ConsistencyDiscrepancy builders.
+	ConsistencyDiscrepancy *ConsistencyDiscrepancyClient
 	// CooperativeExit is the client for interacting with the CooperativeExit builders.
 	CooperativeExit *CooperativeExitClient
 	// DepositAddress is the client for interacting with the DepositAddress builders.
 	DepositAddress *DepositAddressClient
+	// DkgSession is the client for interacting with the DkgSession builders.
+	DkgSession *DkgSessionClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// NetworkPause is the client for interacting with the NetworkPause builders.
+	NetworkPause *NetworkPauseClient
+	// OperatorCallNonce is the client for interacting with the OperatorCallNonce builders.
+	OperatorCallNonce *OperatorCallNonceClient
 	// PreimageRequest is the client for interacting with the PreimageRequest builders.
 	PreimageRequest *PreimageRequestClient
 	// PreimageShare is the client for interacting with the PreimageShare builders.
 	PreimageShare *PreimageShareClient
+	// RecentWrite is the client for interacting with the RecentWrite builders.
+	RecentWrite *RecentWriteClient
+	// Reshare is the client for interacting with the Reshare builders.
+	Reshare *ReshareClient
+	// SessionRevocation is the client for interacting with the SessionRevocation builders.
+	SessionRevocation *SessionRevocationClient
+	// ShareRefresh is the client for interacting with the ShareRefresh builders.
+	ShareRefresh *ShareRefreshClient
+	// SigningIncident is the client for interacting with the SigningIncident builders.
+	SigningIncident *SigningIncidentClient
 	// SigningKeyshare is the client for interacting with the SigningKeyshare builders.
 	SigningKeyshare *SigningKeyshareClient
 	// SigningNonce is the client for interacting with the SigningNonce builders.
 	SigningNonce *SigningNonceClient
+	// TaskLock is the client for interacting with the TaskLock builders.
+	TaskLock *TaskLockClient
+	// TaskRun is the client for interacting with the TaskRun builders.
+	TaskRun *TaskRunClient
 	// TokenFreeze is the client for interacting with the TokenFreeze builders.
 	TokenFreeze *TokenFreezeClient
 	// TokenLeaf is the client for interacting with the TokenLeaf builders.
@@ -94,13 +141,28 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Archive = NewArchiveClient(c.config)
+	c.ArchivedTransfer = NewArchivedTransferClient(c.config)
 	c.BlockHeight = NewBlockHeightClient(c.config)
+	c.ConsistencyAuditCursor = NewConsistencyAuditCursorClient(c.config)
+	c.ConsistencyDiscrepancy = NewConsistencyDiscrepancyClient(c.config)
 	c.CooperativeExit = NewCooperativeExitClient(c.config)
 	c.DepositAddress = NewDepositAddressClient(c.config)
+	c.DkgSession = NewDkgSessionClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.NetworkPause = NewNetworkPauseClient(c.config)
+	c.OperatorCallNonce = NewOperatorCallNonceClient(c.config)
 	c.PreimageRequest = NewPreimageRequestClient(c.config)
 	c.PreimageShare = NewPreimageShareClient(c.config)
+	c.RecentWrite = NewRecentWriteClient(c.config)
+	c.Reshare = NewReshareClient(c.config)
+	c.SessionRevocation = NewSessionRevocationClient(c.config)
+	c.ShareRefresh = NewShareRefreshClient(c.config)
+	c.SigningIncident = NewSigningIncidentClient(c.config)
 	c.SigningKeyshare = NewSigningKeyshareClient(c.config)
 	c.SigningNonce = NewSigningNonceClient(c.config)
+	c.TaskLock = NewTaskLockClient(c.config)
+	c.TaskRun = NewTaskRunClient(c.config)
 	c.TokenFreeze = NewTokenFreezeClient(c.config)
 	c.TokenLeaf = NewTokenLeafClient(c.config)
 	c.TokenMint = NewTokenMintClient(c.config)
@@ -206,13 +268,28 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                     ctx,
 		config:                  cfg,
+		Archive:                 NewArchiveClient(cfg),
+		ArchivedTransfer:        NewArchivedTransferClient(cfg),
 		BlockHeight:             NewBlockHeightClient(cfg),
+		ConsistencyAuditCursor:  NewConsistencyAuditCursorClient(cfg),
+		ConsistencyDiscrepancy:  NewConsistencyDiscrepancyClient(cfg),
 		CooperativeExit:         NewCooperativeExitClient(cfg),
 		DepositAddress:          NewDepositAddressClient(cfg),
+		DkgSession:              NewDkgSessionClient(cfg),
+		IdempotencyKey:          NewIdempotencyKeyClient(cfg),
+		NetworkPause:            NewNetworkPauseClient(cfg),
+		OperatorCallNonce:       NewOperatorCallNonceClient(cfg),
 		PreimageRequest:         NewPreimageRequestClient(cfg),
 		PreimageShare:           NewPreimageShareClient(cfg),
+		RecentWrite:             NewRecentWriteClient(cfg),
+		Reshare:                 NewReshareClient(cfg),
+		SessionRevocation:       NewSessionRevocationClient(cfg),
+		ShareRefresh:            NewShareRefreshClient(cfg),
+		SigningIncident:         NewSigningIncidentClient(cfg),
 		SigningKeyshare:         NewSigningKeyshareClient(cfg),
 		SigningNonce:            NewSigningNonceClient(cfg),
+		TaskLock:                NewTaskLockClient(cfg),
+		TaskRun:                 NewTaskRunClient(cfg),
 		TokenFreeze:             NewTokenFreezeClient(cfg),
 		TokenLeaf:               NewTokenLeafClient(cfg),
 		TokenMint:               NewTokenMintClient(cfg),
@@ -245,13 +322,28 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                     ctx,
 		config:                  cfg,
+		Archive:                 NewArchiveClient(cfg),
+		ArchivedTransfer:        NewArchivedTransferClient(cfg),
 		BlockHeight:             NewBlockHeightClient(cfg),
+		ConsistencyAuditCursor:  NewConsistencyAuditCursorClient(cfg),
+		ConsistencyDiscrepancy:  NewConsistencyDiscrepancyClient(cfg),
 		CooperativeExit:         NewCooperativeExitClient(cfg),
 		DepositAddress:          NewDepositAddressClient(cfg),
+		DkgSession:              NewDkgSessionClient(cfg),
+		IdempotencyKey:          NewIdempotencyKeyClient(cfg),
+		NetworkPause:            NewNetworkPauseClient(cfg),
+		OperatorCallNonce:       NewOperatorCallNonceClient(cfg),
 		PreimageRequest:         NewPreimageRequestClient(cfg),
 		PreimageShare:           NewPreimageShareClient(cfg),
+		RecentWrite:             NewRecentWriteClient(cfg),
+		Reshare:                 NewReshareClient(cfg),
+		SessionRevocation:       NewSessionRevocationClient(cfg),
+		ShareRefresh:            NewShareRefreshClient(cfg),
+		SigningIncident:         NewSigningIncidentClient(cfg),
 		SigningKeyshare:         NewSigningKeyshareClient(cfg),
 		SigningNonce:            NewSigningNonceClient(cfg),
+		TaskLock:                NewTaskLockClient(cfg),
+		TaskRun:                 NewTaskRunClient(cfg),
 		TokenFreeze:             NewTokenFreezeClient(cfg),
 		TokenLeaf:               NewTokenLeafClient(cfg),
 		TokenMint:               NewTokenMintClient(cfg),
@@ -271,7 +363,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		BlockHeight.
+//		Archive.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -294,11 +386,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.BlockHeight, c.CooperativeExit, c.DepositAddress, c.PreimageRequest,
-		c.PreimageShare, c.SigningKeyshare, c.SigningNonce, c.TokenFreeze, c.TokenLeaf,
-		c.TokenMint, c.TokenOutput, c.TokenTransaction, c.TokenTransactionReceipt,
-		c.Transfer, c.TransferLeaf, c.Tree, c.TreeNode, c.UserSignedTransaction,
-		c.Utxo, c.UtxoSwap,
+		c.Archive, c.ArchivedTransfer, c.BlockHeight, c.ConsistencyAuditCursor,
+		c.ConsistencyDiscrepancy, c.CooperativeExit, c.DepositAddress, c.DkgSession,
+		c.IdempotencyKey, c.NetworkPause, c.OperatorCallNonce, c.PreimageRequest,
+		c.PreimageShare, c.RecentWrite, c.Reshare, c.SessionRevocation, c.ShareRefresh,
+		c.SigningIncident, c.SigningKeyshare, c.SigningNonce, c.TaskLock, c.TaskRun,
+		c.TokenFreeze, c.TokenLeaf, c.TokenMint, c.TokenOutput, c.TokenTransaction,
+		c.TokenTransactionReceipt, c.Transfer, c.TransferLeaf, c.Tree, c.TreeNode,
+		c.UserSignedTransaction, c.Utxo, c.UtxoSwap,
 	} {
 		n.Use(hooks...)
 	}
@@ -308,11 +403,14 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.BlockHeight, c.CooperativeExit, c.DepositAddress, c.PreimageRequest,
-		c.PreimageShare, c.SigningKeyshare, c.SigningNonce, c.TokenFreeze, c.TokenLeaf,
-		c.TokenMint, c.TokenOutput, c.TokenTransaction, c.TokenTransactionReceipt,
-		c.Transfer, c.TransferLeaf, c.Tree, c.TreeNode, c.UserSignedTransaction,
-		c.Utxo, c.UtxoSwap,
+		c.Archive, c.ArchivedTransfer, c.BlockHeight, c.ConsistencyAuditCursor,
+		c.ConsistencyDiscrepancy, c.CooperativeExit, c.DepositAddress, c.DkgSession,
+		c.IdempotencyKey, c.NetworkPause, c.OperatorCallNonce, c.PreimageRequest,
+		c.PreimageShare, c.RecentWrite, c.Reshare, c.SessionRevocation, c.ShareRefresh,
+		c.SigningIncident, c.SigningKeyshare, c.SigningNonce, c.TaskLock, c.TaskRun,
+		c.TokenFreeze, c.TokenLeaf, c.TokenMint, c.TokenOutput, c.TokenTransaction,
+		c.TokenTransactionReceipt, c.Transfer, c.TransferLeaf, c.Tree, c.TreeNode,
+		c.UserSignedTransaction, c.Utxo, c.UtxoSwap,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -321,20 +419,50 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ArchiveMutation:
+		return c.Archive.mutate(ctx, m)
+	case *ArchivedTransferMutation:
+		return c.ArchivedTransfer.mutate(ctx, m)
 	case *BlockHeightMutation:
 		return c.BlockHeight.mutate(ctx, m)
+	case *ConsistencyAuditCursorMutation:
+		return c.ConsistencyAuditCursor.mutate(ctx, m)
+	case *ConsistencyDiscrepancyMutation:
+		return c.ConsistencyDiscrepancy.mutate(ctx, m)
 	case *CooperativeExitMutation:
 		return c.CooperativeExit.mutate(ctx, m)
 	case *DepositAddressMutation:
 		return c.DepositAddress.mutate(ctx, m)
+	case *DkgSessionMutation:
+		return c.DkgSession.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
+	case *NetworkPauseMutation:
+		return c.NetworkPause.mutate(ctx, m)
+	case *OperatorCallNonceMutation:
+		return c.OperatorCallNonce.mutate(ctx, m)
 	case *PreimageRequestMutation:
 		return c.PreimageRequest.mutate(ctx, m)
 	case *PreimageShareMutation:
 		return c.PreimageShare.mutate(ctx, m)
+	case *RecentWriteMutation:
+		return c.RecentWrite.mutate(ctx, m)
+	case *ReshareMutation:
+		return c.Reshare.mutate(ctx, m)
+	case *SessionRevocationMutation:
+		return c.SessionRevocation.mutate(ctx, m)
+	case *ShareRefreshMutation:
+		return c.ShareRefresh.mutate(ctx, m)
+	case *SigningIncidentMutation:
+		return c.SigningIncident.mutate(ctx, m)
 	case *SigningKeyshareMutation:
 		return c.SigningKeyshare.mutate(ctx, m)
 	case *SigningNonceMutation:
 		return c.SigningNonce.mutate(ctx, m)
+	case *TaskLockMutation:
+		return c.TaskLock.mutate(ctx, m)
+	case *TaskRunMutation:
+		return c.TaskRun.mutate(ctx, m)
 	case *TokenFreezeMutation:
 		return c.TokenFreeze.mutate(ctx, m)
 	case *TokenLeafMutation:
@@ -366,6 +494,288 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// ArchiveClient is a client for the Archive schema.
+type ArchiveClient struct {
+	config
+}
+
+// NewArchiveClient returns a client for the Archive from the given config.
+func NewArchiveClient(c config) *ArchiveClient {
+	return &ArchiveClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `archive.Hooks(f(g(h())))`.
+func (c *ArchiveClient) Use(hooks ...Hook) {
+	c.hooks.Archive = append(c.hooks.Archive, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `archive.Intercept(f(g(h())))`.
+func (c *ArchiveClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Archive = append(c.inters.Archive, interceptors...)
+}
+
+// Create returns a builder for creating a Archive entity.
+func (c *ArchiveClient) Create() *ArchiveCreate {
+	mutation := newArchiveMutation(c.config, OpCreate)
+	return &ArchiveCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Archive entities.
+func (c *ArchiveClient) CreateBulk(builders ...*ArchiveCreate) *ArchiveCreateBulk {
+	return &ArchiveCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArchiveClient) MapCreateBulk(slice any, setFunc func(*ArchiveCreate, int)) *ArchiveCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArchiveCreateBulk{err: fmt.Errorf("calling to ArchiveClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ArchiveCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ArchiveCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Archive.
+func (c *ArchiveClient) Update() *ArchiveUpdate {
+	mutation := newArchiveMutation(c.config, OpUpdate)
+	return &ArchiveUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArchiveClient) UpdateOne(a *Archive) *ArchiveUpdateOne {
+	mutation := newArchiveMutation(c.config, OpUpdateOne, withArchive(a))
+	return &ArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArchiveClient) UpdateOneID(id uuid.UUID) *ArchiveUpdateOne {
+	mutation := newArchiveMutation(c.config, OpUpdateOne, withArchiveID(id))
+	return &ArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Archive.
+func (c *ArchiveClient) Delete() *ArchiveDelete {
+	mutation := newArchiveMutation(c.config, OpDelete)
+	return &ArchiveDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArchiveClient) DeleteOne(a *Archive) *ArchiveDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArchiveClient) DeleteOneID(id uuid.UUID) *ArchiveDeleteOne {
+	builder := c.Delete().Where(archive.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArchiveDeleteOne{builder}
+}
+
+// Query returns a query builder for Archive.
+func (c *ArchiveClient) Query() *ArchiveQuery {
+	return &ArchiveQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArchive},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Archive entity by its id.
+func (c *ArchiveClient) Get(ctx context.Context, id uuid.UUID) (*Archive, error) {
+	return c.Query().Where(archive.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArchiveClient) GetX(ctx context.Context, id uuid.UUID) *Archive {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ArchiveClient) Hooks() []Hook {
+	return c.hooks.Archive
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArchiveClient) Interceptors() []Interceptor {
+	return c.inters.Archive
+}
+
+func (c *ArchiveClient) mutate(ctx context.Context, m *ArchiveMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArchiveCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArchiveUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArchiveDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Archive mutation op: %q", m.Op())
+	}
+}
+
+// ArchivedTransferClient is a client for the ArchivedTransfer schema.
+type ArchivedTransferClient struct {
+	config
+}
+
+// NewArchivedTransferClient returns a client for the ArchivedTransfer from the given config.
+func NewArchivedTransferClient(c config) *ArchivedTransferClient {
+	return &ArchivedTransferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `archivedtransfer.Hooks(f(g(h())))`.
+func (c *ArchivedTransferClient) Use(hooks ...Hook) {
+	c.hooks.ArchivedTransfer = append(c.hooks.ArchivedTransfer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `archivedtransfer.Intercept(f(g(h())))`.
+func (c *ArchivedTransferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArchivedTransfer = append(c.inters.ArchivedTransfer, interceptors...)
+}
+
+// Create returns a builder for creating a ArchivedTransfer entity.
+func (c *ArchivedTransferClient) Create() *ArchivedTransferCreate {
+	mutation := newArchivedTransferMutation(c.config, OpCreate)
+	return &ArchivedTransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ArchivedTransfer entities.
+func (c *ArchivedTransferClient) CreateBulk(builders ...*ArchivedTransferCreate) *ArchivedTransferCreateBulk {
+	return &ArchivedTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArchivedTransferClient) MapCreateBulk(slice any, setFunc func(*ArchivedTransferCreate, int)) *ArchivedTransferCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArchivedTransferCreateBulk{err: fmt.Errorf("calling to ArchivedTransferClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ArchivedTransferCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ArchivedTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ArchivedTransfer.
+func (c *ArchivedTransferClient) Update() *ArchivedTransferUpdate {
+	mutation := newArchivedTransferMutation(c.config, OpUpdate)
+	return &ArchivedTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArchivedTransferClient) UpdateOne(at *ArchivedTransfer) *ArchivedTransferUpdateOne {
+	mutation := newArchivedTransferMutation(c.config, OpUpdateOne, withArchivedTransfer(at))
+	return &ArchivedTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArchivedTransferClient) UpdateOneID(id uuid.UUID) *ArchivedTransferUpdateOne {
+	mutation := newArchivedTransferMutation(c.config, OpUpdateOne, withArchivedTransferID(id))
+	return &ArchivedTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ArchivedTransfer.
+func (c *ArchivedTransferClient) Delete() *ArchivedTransferDelete {
+	mutation := newArchivedTransferMutation(c.config, OpDelete)
+	return &ArchivedTransferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArchivedTransferClient) DeleteOne(at *ArchivedTransfer) *ArchivedTransferDeleteOne {
+	return c.DeleteOneID(at.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArchivedTransferClient) DeleteOneID(id uuid.UUID) *ArchivedTransferDeleteOne {
+	builder := c.Delete().Where(archivedtransfer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArchivedTransferDeleteOne{builder}
+}
+
+// Query returns a query builder for ArchivedTransfer.
+func (c *ArchivedTransferClient) Query() *ArchivedTransferQuery {
+	return &ArchivedTransferQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArchivedTransfer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ArchivedTransfer entity by its id.
+func (c *ArchivedTransferClient) Get(ctx context.Context, id uuid.UUID) (*ArchivedTransfer, error) {
+	return c.Query().Where(archivedtransfer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArchivedTransferClient) GetX(ctx context.Context, id uuid.UUID) *ArchivedTransfer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryArchive queries the archive edge of a ArchivedTransfer.
+func (c *ArchivedTransferClient) QueryArchive(at *ArchivedTransfer) *ArchiveQuery {
+	query := (&ArchiveClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := at.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archivedtransfer.Table, archivedtransfer.FieldID, id),
+			sqlgraph.To(archive.Table, archive.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, archivedtransfer.ArchiveTable, archivedtransfer.ArchiveColumn),
+		)
+		fromV = sqlgraph.Neighbors(at.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ArchivedTransferClient) Hooks() []Hook {
+	return c.hooks.ArchivedTransfer
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArchivedTransferClient) Interceptors() []Interceptor {
+	return c.inters.ArchivedTransfer
+}
+
+func (c *ArchivedTransferClient) mutate(ctx context.Context, m *ArchivedTransferMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArchivedTransferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArchivedTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArchivedTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArchivedTransferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ArchivedTransfer mutation op: %q", m.Op())
+	}
+}
+
 // BlockHeightClient is a client for the BlockHeight schema.
 type BlockHeightClient struct {
 	config
@@ -499,107 +909,107 @@ func (c *BlockHeightClient) mutate(ctx context.Context, m *BlockHeightMutation)
 	}
 }
 
-// CooperativeExitClient is a client for the CooperativeExit schema.
-type CooperativeExitClient struct {
+// ConsistencyAuditCursorClient is a client for the ConsistencyAuditCursor schema.
+type ConsistencyAuditCursorClient struct {
 	config
 }
 
-// NewCooperativeExitClient returns a client for the CooperativeExit from the given config.
-func NewCooperativeExitClient(c config) *CooperativeExitClient {
-	return &CooperativeExitClient{config: c}
+// NewConsistencyAuditCursorClient returns a client for the ConsistencyAuditCursor from the given config.
+func NewConsistencyAuditCursorClient(c config) *ConsistencyAuditCursorClient {
+	return &ConsistencyAuditCursorClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `cooperativeexit.Hooks(f(g(h())))`.
-func (c *CooperativeExitClient) Use(hooks ...Hook) {
-	c.hooks.CooperativeExit = append(c.hooks.CooperativeExit, hooks...)
+// A call to `Use(f, g, h)` equals to `consistencyauditcursor.Hooks(f(g(h())))`.
+func (c *ConsistencyAuditCursorClient) Use(hooks ...Hook) {
+	c.hooks.ConsistencyAuditCursor = append(c.hooks.ConsistencyAuditCursor, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `cooperativeexit.Intercept(f(g(h())))`.
-func (c *CooperativeExitClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CooperativeExit = append(c.inters.CooperativeExit, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `consistencyauditcursor.Intercept(f(g(h())))`.
+func (c *ConsistencyAuditCursorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConsistencyAuditCursor = append(c.inters.ConsistencyAuditCursor, interceptors...)
 }
 
-// Create returns a builder for creating a CooperativeExit entity.
-func (c *CooperativeExitClient) Create() *CooperativeExitCreate {
-	mutation := newCooperativeExitMutation(c.config, OpCreate)
-	return &CooperativeExitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ConsistencyAuditCursor entity.
+func (c *ConsistencyAuditCursorClient) Create() *ConsistencyAuditCursorCreate {
+	mutation := newConsistencyAuditCursorMutation(c.config, OpCreate)
+	return &ConsistencyAuditCursorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CooperativeExit entities.
-func (c *CooperativeExitClient) CreateBulk(builders ...*CooperativeExitCreate) *CooperativeExitCreateBulk {
-	return &CooperativeExitCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ConsistencyAuditCursor entities.
+func (c *ConsistencyAuditCursorClient) CreateBulk(builders ...*ConsistencyAuditCursorCreate) *ConsistencyAuditCursorCreateBulk {
+	return &ConsistencyAuditCursorCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CooperativeExitClient) MapCreateBulk(slice any, setFunc func(*CooperativeExitCreate, int)) *CooperativeExitCreateBulk {
+func (c *ConsistencyAuditCursorClient) MapCreateBulk(slice any, setFunc func(*ConsistencyAuditCursorCreate, int)) *ConsistencyAuditCursorCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CooperativeExitCreateBulk{err: fmt.Errorf("calling to CooperativeExitClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ConsistencyAuditCursorCreateBulk{err: fmt.Errorf("calling to ConsistencyAuditCursorClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CooperativeExitCreate, rv.Len())
+	builders := make([]*ConsistencyAuditCursorCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CooperativeExitCreateBulk{config: c.config, builders: builders}
+	return &ConsistencyAuditCursorCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CooperativeExit.
-func (c *CooperativeExitClient) Update() *CooperativeExitUpdate {
-	mutation := newCooperativeExitMutation(c.config, OpUpdate)
-	return &CooperativeExitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ConsistencyAuditCursor.
+func (c *ConsistencyAuditCursorClient) Update() *ConsistencyAuditCursorUpdate {
+	mutation := newConsistencyAuditCursorMutation(c.config, OpUpdate)
+	return &ConsistencyAuditCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CooperativeExitClient) UpdateOne(ce *CooperativeExit) *CooperativeExitUpdateOne {
-	mutation := newCooperativeExitMutation(c.config, OpUpdateOne, withCooperativeExit(ce))
-	return &CooperativeExitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConsistencyAuditCursorClient) UpdateOne(cac *ConsistencyAuditCursor) *ConsistencyAuditCursorUpdateOne {
+	mutation := newConsistencyAuditCursorMutation(c.config, OpUpdateOne, withConsistencyAuditCursor(cac))
+	return &ConsistencyAuditCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CooperativeExitClient) UpdateOneID(id uuid.UUID) *CooperativeExitUpdateOne {
-	mutation := newCooperativeExitMutation(c.config, OpUpdateOne, withCooperativeExitID(id))
-	return &CooperativeExitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConsistencyAuditCursorClient) UpdateOneID(id uuid.UUID) *ConsistencyAuditCursorUpdateOne {
+	mutation := newConsistencyAuditCursorMutation(c.config, OpUpdateOne, withConsistencyAuditCursorID(id))
+	return &ConsistencyAuditCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CooperativeExit.
-func (c *CooperativeExitClient) Delete() *CooperativeExitDelete {
-	mutation := newCooperativeExitMutation(c.config, OpDelete)
-	return &CooperativeExitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ConsistencyAuditCursor.
+func (c *ConsistencyAuditCursorClient) Delete() *ConsistencyAuditCursorDelete {
+	mutation := newConsistencyAuditCursorMutation(c.config, OpDelete)
+	return &ConsistencyAuditCursorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CooperativeExitClient) DeleteOne(ce *CooperativeExit) *CooperativeExitDeleteOne {
-	return c.DeleteOneID(ce.ID)
+func (c *ConsistencyAuditCursorClient) DeleteOne(cac *ConsistencyAuditCursor) *ConsistencyAuditCursorDeleteOne {
+	return c.DeleteOneID(cac.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CooperativeExitClient) DeleteOneID(id uuid.UUID) *CooperativeExitDeleteOne {
-	builder := c.Delete().Where(cooperativeexit.ID(id))
+func (c *ConsistencyAuditCursorClient) DeleteOneID(id uuid.UUID) *ConsistencyAuditCursorDeleteOne {
+	builder := c.Delete().Where(consistencyauditcursor.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CooperativeExitDeleteOne{builder}
+	return &ConsistencyAuditCursorDeleteOne{builder}
 }
 
-// Query returns a query builder for CooperativeExit.
-func (c *CooperativeExitClient) Query() *CooperativeExitQuery {
-	return &CooperativeExitQuery{
+// Query returns a query builder for ConsistencyAuditCursor.
+func (c *ConsistencyAuditCursorClient) Query() *ConsistencyAuditCursorQuery {
+	return &ConsistencyAuditCursorQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCooperativeExit},
+		ctx:    &QueryContext{Type: TypeConsistencyAuditCursor},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a CooperativeExit entity by its id.
-func (c *CooperativeExitClient) Get(ctx context.Context, id uuid.UUID) (*CooperativeExit, error) {
-	return c.Query().Where(cooperativeexit.ID(id)).Only(ctx)
+// Get returns a ConsistencyAuditCursor entity by its id.
+func (c *ConsistencyAuditCursorClient) Get(ctx context.Context, id uuid.UUID) (*ConsistencyAuditCursor, error) {
+	return c.Query().Where(consistencyauditcursor.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CooperativeExitClient) GetX(ctx context.Context, id uuid.UUID) *CooperativeExit {
+func (c *ConsistencyAuditCursorClient) GetX(ctx context.Context, id uuid.UUID) *ConsistencyAuditCursor {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -607,97 +1017,363 @@ func (c *CooperativeExitClient) GetX(ctx context.Context, id uuid.UUID) *Coopera
 	return obj
 }
 
-// QueryTransfer queries the transfer edge of a CooperativeExit.
-func (c *CooperativeExitClient) QueryTransfer(ce *CooperativeExit) *TransferQuery {
-	query := (&TransferClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ce.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(cooperativeexit.Table, cooperativeexit.FieldID, id),
-			sqlgraph.To(transfer.Table, transfer.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, cooperativeexit.TransferTable, cooperativeexit.TransferColumn),
-		)
-		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *CooperativeExitClient) Hooks() []Hook {
-	return c.hooks.CooperativeExit
+func (c *ConsistencyAuditCursorClient) Hooks() []Hook {
+	return c.hooks.ConsistencyAuditCursor
 }
 
 // Interceptors returns the client interceptors.
-func (c *CooperativeExitClient) Interceptors() []Interceptor {
-	return c.inters.CooperativeExit
+func (c *ConsistencyAuditCursorClient) Interceptors() []Interceptor {
+	return c.inters.ConsistencyAuditCursor
 }
 
-func (c *CooperativeExitClient) mutate(ctx context.Context, m *CooperativeExitMutation) (Value, error) {
+func (c *ConsistencyAuditCursorClient) mutate(ctx context.Context, m *ConsistencyAuditCursorMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CooperativeExitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConsistencyAuditCursorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CooperativeExitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConsistencyAuditCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CooperativeExitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConsistencyAuditCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CooperativeExitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ConsistencyAuditCursorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown CooperativeExit mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ConsistencyAuditCursor mutation op: %q", m.Op())
 	}
 }
 
-// DepositAddressClient is a client for the DepositAddress schema.
-type DepositAddressClient struct {
+// ConsistencyDiscrepancyClient is a client for the ConsistencyDiscrepancy schema.
+type ConsistencyDiscrepancyClient struct {
 	config
 }
 
-// NewDepositAddressClient returns a client for the DepositAddress from the given config.
-func NewDepositAddressClient(c config) *DepositAddressClient {
-	return &DepositAddressClient{config: c}
+// NewConsistencyDiscrepancyClient returns a client for the ConsistencyDiscrepancy from the given config.
+func NewConsistencyDiscrepancyClient(c config) *ConsistencyDiscrepancyClient {
+	return &ConsistencyDiscrepancyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `depositaddress.Hooks(f(g(h())))`.
-func (c *DepositAddressClient) Use(hooks ...Hook) {
-	c.hooks.DepositAddress = append(c.hooks.DepositAddress, hooks...)
+// A call to `Use(f, g, h)` equals to `consistencydiscrepancy.Hooks(f(g(h())))`.
+func (c *ConsistencyDiscrepancyClient) Use(hooks ...Hook) {
+	c.hooks.ConsistencyDiscrepancy = append(c.hooks.ConsistencyDiscrepancy, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `depositaddress.Intercept(f(g(h())))`.
-func (c *DepositAddressClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DepositAddress = append(c.inters.DepositAddress, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `consistencydiscrepancy.Intercept(f(g(h())))`.
+func (c *ConsistencyDiscrepancyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConsistencyDiscrepancy = append(c.inters.ConsistencyDiscrepancy, interceptors...)
 }
 
-// Create returns a builder for creating a DepositAddress entity.
-func (c *DepositAddressClient) Create() *DepositAddressCreate {
-	mutation := newDepositAddressMutation(c.config, OpCreate)
-	return &DepositAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ConsistencyDiscrepancy entity.
+func (c *ConsistencyDiscrepancyClient) Create() *ConsistencyDiscrepancyCreate {
+	mutation := newConsistencyDiscrepancyMutation(c.config, OpCreate)
+	return &ConsistencyDiscrepancyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DepositAddress entities.
-func (c *DepositAddressClient) CreateBulk(builders ...*DepositAddressCreate) *DepositAddressCreateBulk {
-	return &DepositAddressCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ConsistencyDiscrepancy entities.
+func (c *ConsistencyDiscrepancyClient) CreateBulk(builders ...*ConsistencyDiscrepancyCreate) *ConsistencyDiscrepancyCreateBulk {
+	return &ConsistencyDiscrepancyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DepositAddressClient) MapCreateBulk(slice any, setFunc func(*DepositAddressCreate, int)) *DepositAddressCreateBulk {
+func (c *ConsistencyDiscrepancyClient) MapCreateBulk(slice any, setFunc func(*ConsistencyDiscrepancyCreate, int)) *ConsistencyDiscrepancyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DepositAddressCreateBulk{err: fmt.Errorf("calling to DepositAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ConsistencyDiscrepancyCreateBulk{err: fmt.Errorf("calling to ConsistencyDiscrepancyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DepositAddressCreate, rv.Len())
+	builders := make([]*ConsistencyDiscrepancyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DepositAddressCreateBulk{config: c.config, builders: builders}
+	return &ConsistencyDiscrepancyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DepositAddress.
-func (c *DepositAddressClient) Update() *DepositAddressUpdate {
+// Update returns an update builder for ConsistencyDiscrepancy.
+func (c *ConsistencyDiscrepancyClient) Update() *ConsistencyDiscrepancyUpdate {
+	mutation := newConsistencyDiscrepancyMutation(c.config, OpUpdate)
+	return &ConsistencyDiscrepancyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConsistencyDiscrepancyClient) UpdateOne(cd *ConsistencyDiscrepancy) *ConsistencyDiscrepancyUpdateOne {
+	mutation := newConsistencyDiscrepancyMutation(c.config, OpUpdateOne, withConsistencyDiscrepancy(cd))
+	return &ConsistencyDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConsistencyDiscrepancyClient) UpdateOneID(id uuid.UUID) *ConsistencyDiscrepancyUpdateOne {
+	mutation := newConsistencyDiscrepancyMutation(c.config, OpUpdateOne, withConsistencyDiscrepancyID(id))
+	return &ConsistencyDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConsistencyDiscrepancy.
+func (c *ConsistencyDiscrepancyClient) Delete() *ConsistencyDiscrepancyDelete {
+	mutation := newConsistencyDiscrepancyMutation(c.config, OpDelete)
+	return &ConsistencyDiscrepancyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConsistencyDiscrepancyClient) DeleteOne(cd *ConsistencyDiscrepancy) *ConsistencyDiscrepancyDeleteOne {
+	return c.DeleteOneID(cd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConsistencyDiscrepancyClient) DeleteOneID(id uuid.UUID) *ConsistencyDiscrepancyDeleteOne {
+	builder := c.Delete().Where(consistencydiscrepancy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConsistencyDiscrepancyDeleteOne{builder}
+}
+
+// Query returns a query builder for ConsistencyDiscrepancy.
+func (c *ConsistencyDiscrepancyClient) Query() *ConsistencyDiscrepancyQuery {
+	return &ConsistencyDiscrepancyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConsistencyDiscrepancy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConsistencyDiscrepancy entity by its id.
+func (c *ConsistencyDiscrepancyClient) Get(ctx context.Context, id uuid.UUID) (*ConsistencyDiscrepancy, error) {
+	return c.Query().Where(consistencydiscrepancy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConsistencyDiscrepancyClient) GetX(ctx context.Context, id uuid.UUID) *ConsistencyDiscrepancy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConsistencyDiscrepancyClient) Hooks() []Hook {
+	return c.hooks.ConsistencyDiscrepancy
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConsistencyDiscrepancyClient) Interceptors() []Interceptor {
+	return c.inters.ConsistencyDiscrepancy
+}
+
+func (c *ConsistencyDiscrepancyClient) mutate(ctx context.Context, m *ConsistencyDiscrepancyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConsistencyDiscrepancyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConsistencyDiscrepancyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConsistencyDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConsistencyDiscrepancyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConsistencyDiscrepancy mutation op: %q", m.Op())
+	}
+}
+
+// CooperativeExitClient is a client for the CooperativeExit schema.
+type CooperativeExitClient struct {
+	config
+}
+
+// NewCooperativeExitClient returns a client for the CooperativeExit from the given config.
+func NewCooperativeExitClient(c config) *CooperativeExitClient {
+	return &CooperativeExitClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `cooperativeexit.Hooks(f(g(h())))`.
+func (c *CooperativeExitClient) Use(hooks ...Hook) {
+	c.hooks.CooperativeExit = append(c.hooks.CooperativeExit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `cooperativeexit.Intercept(f(g(h())))`.
+func (c *CooperativeExitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CooperativeExit = append(c.inters.CooperativeExit, interceptors...)
+}
+
+// Create returns a builder for creating a CooperativeExit entity.
+func (c *CooperativeExitClient) Create() *CooperativeExitCreate {
+	mutation := newCooperativeExitMutation(c.config, OpCreate)
+	return &CooperativeExitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CooperativeExit entities.
+func (c *CooperativeExitClient) CreateBulk(builders ...*CooperativeExitCreate) *CooperativeExitCreateBulk {
+	return &CooperativeExitCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CooperativeExitClient) MapCreateBulk(slice any, setFunc func(*CooperativeExitCreate, int)) *CooperativeExitCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CooperativeExitCreateBulk{err: fmt.Errorf("calling to CooperativeExitClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CooperativeExitCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CooperativeExitCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CooperativeExit.
+func (c *CooperativeExitClient) Update() *CooperativeExitUpdate {
+	mutation := newCooperativeExitMutation(c.config, OpUpdate)
+	return &CooperativeExitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CooperativeExitClient) UpdateOne(ce *CooperativeExit) *CooperativeExitUpdateOne {
+	mutation := newCooperativeExitMutation(c.config, OpUpdateOne, withCooperativeExit(ce))
+	return &CooperativeExitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CooperativeExitClient) UpdateOneID(id uuid.UUID) *CooperativeExitUpdateOne {
+	mutation := newCooperativeExitMutation(c.config, OpUpdateOne, withCooperativeExitID(id))
+	return &CooperativeExitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CooperativeExit.
+func (c *CooperativeExitClient) Delete() *CooperativeExitDelete {
+	mutation := newCooperativeExitMutation(c.config, OpDelete)
+	return &CooperativeExitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CooperativeExitClient) DeleteOne(ce *CooperativeExit) *CooperativeExitDeleteOne {
+	return c.DeleteOneID(ce.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CooperativeExitClient) DeleteOneID(id uuid.UUID) *CooperativeExitDeleteOne {
+	builder := c.Delete().Where(cooperativeexit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CooperativeExitDeleteOne{builder}
+}
+
+// Query returns a query builder for CooperativeExit.
+func (c *CooperativeExitClient) Query() *CooperativeExitQuery {
+	return &CooperativeExitQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCooperativeExit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CooperativeExit entity by its id.
+func (c *CooperativeExitClient) Get(ctx context.Context, id uuid.UUID) (*CooperativeExit, error) {
+	return c.Query().Where(cooperativeexit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CooperativeExitClient) GetX(ctx context.Context, id uuid.UUID) *CooperativeExit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTransfer queries the transfer edge of a CooperativeExit.
+func (c *CooperativeExitClient) QueryTransfer(ce *CooperativeExit) *TransferQuery {
+	query := (&TransferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ce.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cooperativeexit.Table, cooperativeexit.FieldID, id),
+			sqlgraph.To(transfer.Table, transfer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, cooperativeexit.TransferTable, cooperativeexit.TransferColumn),
+		)
+		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CooperativeExitClient) Hooks() []Hook {
+	return c.hooks.CooperativeExit
+}
+
+// Interceptors returns the client interceptors.
+func (c *CooperativeExitClient) Interceptors() []Interceptor {
+	return c.inters.CooperativeExit
+}
+
+func (c *CooperativeExitClient) mutate(ctx context.Context, m *CooperativeExitMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CooperativeExitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CooperativeExitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CooperativeExitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CooperativeExitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CooperativeExit mutation op: %q", m.Op())
+	}
+}
+
+// DepositAddressClient is a client for the DepositAddress schema.
+type DepositAddressClient struct {
+	config
+}
+
+// NewDepositAddressClient returns a client for the DepositAddress from the given config.
+func NewDepositAddressClient(c config) *DepositAddressClient {
+	return &DepositAddressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `depositaddress.Hooks(f(g(h())))`.
+func (c *DepositAddressClient) Use(hooks ...Hook) {
+	c.hooks.DepositAddress = append(c.hooks.DepositAddress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `depositaddress.Intercept(f(g(h())))`.
+func (c *DepositAddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DepositAddress = append(c.inters.DepositAddress, interceptors...)
+}
+
+// Create returns a builder for creating a DepositAddress entity.
+func (c *DepositAddressClient) Create() *DepositAddressCreate {
+	mutation := newDepositAddressMutation(c.config, OpCreate)
+	return &DepositAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DepositAddress entities.
+func (c *DepositAddressClient) CreateBulk(builders ...*DepositAddressCreate) *DepositAddressCreateBulk {
+	return &DepositAddressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DepositAddressClient) MapCreateBulk(slice any, setFunc func(*DepositAddressCreate, int)) *DepositAddressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DepositAddressCreateBulk{err: fmt.Errorf("calling to DepositAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DepositAddressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DepositAddressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DepositAddress.
+func (c *DepositAddressClient) Update() *DepositAddressUpdate {
 	mutation := newDepositAddressMutation(c.config, OpUpdate)
 	return &DepositAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
@@ -829,107 +1505,1102 @@ func (c *DepositAddressClient) mutate(ctx context.Context, m *DepositAddressMuta
 	}
 }
 
-// PreimageRequestClient is a client for the PreimageRequest schema.
-type PreimageRequestClient struct {
+// DkgSessionClient is a client for the DkgSession schema.
+type DkgSessionClient struct {
 	config
 }
 
-// NewPreimageRequestClient returns a client for the PreimageRequest from the given config.
-func NewPreimageRequestClient(c config) *PreimageRequestClient {
-	return &PreimageRequestClient{config: c}
+// NewDkgSessionClient returns a client for the DkgSession from the given config.
+func NewDkgSessionClient(c config) *DkgSessionClient {
+	return &DkgSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `preimagerequest.Hooks(f(g(h())))`.
-func (c *PreimageRequestClient) Use(hooks ...Hook) {
-	c.hooks.PreimageRequest = append(c.hooks.PreimageRequest, hooks...)
+// A call to `Use(f, g, h)` equals to `dkgsession.Hooks(f(g(h())))`.
+func (c *DkgSessionClient) Use(hooks ...Hook) {
+	c.hooks.DkgSession = append(c.hooks.DkgSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `preimagerequest.Intercept(f(g(h())))`.
+// A call to `Intercept(f, g, h)` equals to `dkgsession.Intercept(f(g(h())))`.
+func (c *DkgSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DkgSession = append(c.inters.DkgSession, interceptors...)
+}
+
+// Create returns a builder for creating a DkgSession entity.
+func (c *DkgSessionClient) Create() *DkgSessionCreate {
+	mutation := newDkgSessionMutation(c.config, OpCreate)
+	return &DkgSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DkgSession entities.
+func (c *DkgSessionClient) CreateBulk(builders ...*DkgSessionCreate) *DkgSessionCreateBulk {
+	return &DkgSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DkgSessionClient) MapCreateBulk(slice any, setFunc func(*DkgSessionCreate, int)) *DkgSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DkgSessionCreateBulk{err: fmt.Errorf("calling to DkgSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DkgSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DkgSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DkgSession.
+func (c *DkgSessionClient) Update() *DkgSessionUpdate {
+	mutation := newDkgSessionMutation(c.config, OpUpdate)
+	return &DkgSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DkgSessionClient) UpdateOne(ds *DkgSession) *DkgSessionUpdateOne {
+	mutation := newDkgSessionMutation(c.config, OpUpdateOne, withDkgSession(ds))
+	return &DkgSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DkgSessionClient) UpdateOneID(id uuid.UUID) *DkgSessionUpdateOne {
+	mutation := newDkgSessionMutation(c.config, OpUpdateOne, withDkgSessionID(id))
+	return &DkgSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DkgSession.
+func (c *DkgSessionClient) Delete() *DkgSessionDelete {
+	mutation := newDkgSessionMutation(c.config, OpDelete)
+	return &DkgSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DkgSessionClient) DeleteOne(ds *DkgSession) *DkgSessionDeleteOne {
+	return c.DeleteOneID(ds.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DkgSessionClient) DeleteOneID(id uuid.UUID) *DkgSessionDeleteOne {
+	builder := c.Delete().Where(dkgsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DkgSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for DkgSession.
+func (c *DkgSessionClient) Query() *DkgSessionQuery {
+	return &DkgSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDkgSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DkgSession entity by its id.
+func (c *DkgSessionClient) Get(ctx context.Context, id uuid.UUID) (*DkgSession, error) {
+	return c.Query().Where(dkgsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DkgSessionClient) GetX(ctx context.Context, id uuid.UUID) *DkgSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DkgSessionClient) Hooks() []Hook {
+	return c.hooks.DkgSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *DkgSessionClient) Interceptors() []Interceptor {
+	return c.inters.DkgSession
+}
+
+func (c *DkgSessionClient) mutate(ctx context.Context, m *DkgSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DkgSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DkgSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DkgSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DkgSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DkgSession mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(ik *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(ik))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id uuid.UUID) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(ik *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(ik.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id uuid.UUID) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id uuid.UUID) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id uuid.UUID) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// NetworkPauseClient is a client for the NetworkPause schema.
+type NetworkPauseClient struct {
+	config
+}
+
+// NewNetworkPauseClient returns a client for the NetworkPause from the given config.
+func NewNetworkPauseClient(c config) *NetworkPauseClient {
+	return &NetworkPauseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `networkpause.Hooks(f(g(h())))`.
+func (c *NetworkPauseClient) Use(hooks ...Hook) {
+	c.hooks.NetworkPause = append(c.hooks.NetworkPause, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `networkpause.Intercept(f(g(h())))`.
+func (c *NetworkPauseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NetworkPause = append(c.inters.NetworkPause, interceptors...)
+}
+
+// Create returns a builder for creating a NetworkPause entity.
+func (c *NetworkPauseClient) Create() *NetworkPauseCreate {
+	mutation := newNetworkPauseMutation(c.config, OpCreate)
+	return &NetworkPauseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NetworkPause entities.
+func (c *NetworkPauseClient) CreateBulk(builders ...*NetworkPauseCreate) *NetworkPauseCreateBulk {
+	return &NetworkPauseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NetworkPauseClient) MapCreateBulk(slice any, setFunc func(*NetworkPauseCreate, int)) *NetworkPauseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NetworkPauseCreateBulk{err: fmt.Errorf("calling to NetworkPauseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NetworkPauseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NetworkPauseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NetworkPause.
+func (c *NetworkPauseClient) Update() *NetworkPauseUpdate {
+	mutation := newNetworkPauseMutation(c.config, OpUpdate)
+	return &NetworkPauseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NetworkPauseClient) UpdateOne(np *NetworkPause) *NetworkPauseUpdateOne {
+	mutation := newNetworkPauseMutation(c.config, OpUpdateOne, withNetworkPause(np))
+	return &NetworkPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NetworkPauseClient) UpdateOneID(id uuid.UUID) *NetworkPauseUpdateOne {
+	mutation := newNetworkPauseMutation(c.config, OpUpdateOne, withNetworkPauseID(id))
+	return &NetworkPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NetworkPause.
+func (c *NetworkPauseClient) Delete() *NetworkPauseDelete {
+	mutation := newNetworkPauseMutation(c.config, OpDelete)
+	return &NetworkPauseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NetworkPauseClient) DeleteOne(np *NetworkPause) *NetworkPauseDeleteOne {
+	return c.DeleteOneID(np.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NetworkPauseClient) DeleteOneID(id uuid.UUID) *NetworkPauseDeleteOne {
+	builder := c.Delete().Where(networkpause.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NetworkPauseDeleteOne{builder}
+}
+
+// Query returns a query builder for NetworkPause.
+func (c *NetworkPauseClient) Query() *NetworkPauseQuery {
+	return &NetworkPauseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNetworkPause},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NetworkPause entity by its id.
+func (c *NetworkPauseClient) Get(ctx context.Context, id uuid.UUID) (*NetworkPause, error) {
+	return c.Query().Where(networkpause.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NetworkPauseClient) GetX(ctx context.Context, id uuid.UUID) *NetworkPause {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NetworkPauseClient) Hooks() []Hook {
+	return c.hooks.NetworkPause
+}
+
+// Interceptors returns the client interceptors.
+func (c *NetworkPauseClient) Interceptors() []Interceptor {
+	return c.inters.NetworkPause
+}
+
+func (c *NetworkPauseClient) mutate(ctx context.Context, m *NetworkPauseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NetworkPauseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NetworkPauseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NetworkPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NetworkPauseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NetworkPause mutation op: %q", m.Op())
+	}
+}
+
+// OperatorCallNonceClient is a client for the OperatorCallNonce schema.
+type OperatorCallNonceClient struct {
+	config
+}
+
+// NewOperatorCallNonceClient returns a client for the OperatorCallNonce from the given config.
+func NewOperatorCallNonceClient(c config) *OperatorCallNonceClient {
+	return &OperatorCallNonceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `operatorcallnonce.Hooks(f(g(h())))`.
+func (c *OperatorCallNonceClient) Use(hooks ...Hook) {
+	c.hooks.OperatorCallNonce = append(c.hooks.OperatorCallNonce, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `operatorcallnonce.Intercept(f(g(h())))`.
+func (c *OperatorCallNonceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OperatorCallNonce = append(c.inters.OperatorCallNonce, interceptors...)
+}
+
+// Create returns a builder for creating a OperatorCallNonce entity.
+func (c *OperatorCallNonceClient) Create() *OperatorCallNonceCreate {
+	mutation := newOperatorCallNonceMutation(c.config, OpCreate)
+	return &OperatorCallNonceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OperatorCallNonce entities.
+func (c *OperatorCallNonceClient) CreateBulk(builders ...*OperatorCallNonceCreate) *OperatorCallNonceCreateBulk {
+	return &OperatorCallNonceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OperatorCallNonceClient) MapCreateBulk(slice any, setFunc func(*OperatorCallNonceCreate, int)) *OperatorCallNonceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OperatorCallNonceCreateBulk{err: fmt.Errorf("calling to OperatorCallNonceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OperatorCallNonceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OperatorCallNonceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OperatorCallNonce.
+func (c *OperatorCallNonceClient) Update() *OperatorCallNonceUpdate {
+	mutation := newOperatorCallNonceMutation(c.config, OpUpdate)
+	return &OperatorCallNonceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OperatorCallNonceClient) UpdateOne(ocn *OperatorCallNonce) *OperatorCallNonceUpdateOne {
+	mutation := newOperatorCallNonceMutation(c.config, OpUpdateOne, withOperatorCallNonce(ocn))
+	return &OperatorCallNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OperatorCallNonceClient) UpdateOneID(id uuid.UUID) *OperatorCallNonceUpdateOne {
+	mutation := newOperatorCallNonceMutation(c.config, OpUpdateOne, withOperatorCallNonceID(id))
+	return &OperatorCallNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OperatorCallNonce.
+func (c *OperatorCallNonceClient) Delete() *OperatorCallNonceDelete {
+	mutation := newOperatorCallNonceMutation(c.config, OpDelete)
+	return &OperatorCallNonceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OperatorCallNonceClient) DeleteOne(ocn *OperatorCallNonce) *OperatorCallNonceDeleteOne {
+	return c.DeleteOneID(ocn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OperatorCallNonceClient) DeleteOneID(id uuid.UUID) *OperatorCallNonceDeleteOne {
+	builder := c.Delete().Where(operatorcallnonce.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OperatorCallNonceDeleteOne{builder}
+}
+
+// Query returns a query builder for OperatorCallNonce.
+func (c *OperatorCallNonceClient) Query() *OperatorCallNonceQuery {
+	return &OperatorCallNonceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOperatorCallNonce},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OperatorCallNonce entity by its id.
+func (c *OperatorCallNonceClient) Get(ctx context.Context, id uuid.UUID) (*OperatorCallNonce, error) {
+	return c.Query().Where(operatorcallnonce.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OperatorCallNonceClient) GetX(ctx context.Context, id uuid.UUID) *OperatorCallNonce {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OperatorCallNonceClient) Hooks() []Hook {
+	return c.hooks.OperatorCallNonce
+}
+
+// Interceptors returns the client interceptors.
+func (c *OperatorCallNonceClient) Interceptors() []Interceptor {
+	return c.inters.OperatorCallNonce
+}
+
+func (c *OperatorCallNonceClient) mutate(ctx context.Context, m *OperatorCallNonceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OperatorCallNonceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OperatorCallNonceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OperatorCallNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OperatorCallNonceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OperatorCallNonce mutation op: %q", m.Op())
+	}
+}
+
+// PreimageRequestClient is a client for the PreimageRequest schema.
+type PreimageRequestClient struct {
+	config
+}
+
+// NewPreimageRequestClient returns a client for the PreimageRequest from the given config.
+func NewPreimageRequestClient(c config) *PreimageRequestClient {
+	return &PreimageRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `preimagerequest.Hooks(f(g(h())))`.
+func (c *PreimageRequestClient) Use(hooks ...Hook) {
+	c.hooks.PreimageRequest = append(c.hooks.PreimageRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `preimagerequest.Intercept(f(g(h())))`.
 func (c *PreimageRequestClient) Intercept(interceptors ...Interceptor) {
 	c.inters.PreimageRequest = append(c.inters.PreimageRequest, interceptors...)
 }
 
-// Create returns a builder for creating a PreimageRequest entity.
-func (c *PreimageRequestClient) Create() *PreimageRequestCreate {
-	mutation := newPreimageRequestMutation(c.config, OpCreate)
-	return &PreimageRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PreimageRequest entity.
+func (c *PreimageRequestClient) Create() *PreimageRequestCreate {
+	mutation := newPreimageRequestMutation(c.config, OpCreate)
+	return &PreimageRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PreimageRequest entities.
+func (c *PreimageRequestClient) CreateBulk(builders ...*PreimageRequestCreate) *PreimageRequestCreateBulk {
+	return &PreimageRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PreimageRequestClient) MapCreateBulk(slice any, setFunc func(*PreimageRequestCreate, int)) *PreimageRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PreimageRequestCreateBulk{err: fmt.Errorf("calling to PreimageRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PreimageRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PreimageRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PreimageRequest.
+func (c *PreimageRequestClient) Update() *PreimageRequestUpdate {
+	mutation := newPreimageRequestMutation(c.config, OpUpdate)
+	return &PreimageRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PreimageRequestClient) UpdateOne(pr *PreimageRequest) *PreimageRequestUpdateOne {
+	mutation := newPreimageRequestMutation(c.config, OpUpdateOne, withPreimageRequest(pr))
+	return &PreimageRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PreimageRequestClient) UpdateOneID(id uuid.UUID) *PreimageRequestUpdateOne {
+	mutation := newPreimageRequestMutation(c.config, OpUpdateOne, withPreimageRequestID(id))
+	return &PreimageRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PreimageRequest.
+func (c *PreimageRequestClient) Delete() *PreimageRequestDelete {
+	mutation := newPreimageRequestMutation(c.config, OpDelete)
+	return &PreimageRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PreimageRequestClient) DeleteOne(pr *PreimageRequest) *PreimageRequestDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PreimageRequestClient) DeleteOneID(id uuid.UUID) *PreimageRequestDeleteOne {
+	builder := c.Delete().Where(preimagerequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PreimageRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for PreimageRequest.
+func (c *PreimageRequestClient) Query() *PreimageRequestQuery {
+	return &PreimageRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePreimageRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PreimageRequest entity by its id.
+func (c *PreimageRequestClient) Get(ctx context.Context, id uuid.UUID) (*PreimageRequest, error) {
+	return c.Query().Where(preimagerequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PreimageRequestClient) GetX(ctx context.Context, id uuid.UUID) *PreimageRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTransactions queries the transactions edge of a PreimageRequest.
+func (c *PreimageRequestClient) QueryTransactions(pr *PreimageRequest) *UserSignedTransactionQuery {
+	query := (&UserSignedTransactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(preimagerequest.Table, preimagerequest.FieldID, id),
+			sqlgraph.To(usersignedtransaction.Table, usersignedtransaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, preimagerequest.TransactionsTable, preimagerequest.TransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPreimageShares queries the preimage_shares edge of a PreimageRequest.
+func (c *PreimageRequestClient) QueryPreimageShares(pr *PreimageRequest) *PreimageShareQuery {
+	query := (&PreimageShareClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(preimagerequest.Table, preimagerequest.FieldID, id),
+			sqlgraph.To(preimageshare.Table, preimageshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, preimagerequest.PreimageSharesTable, preimagerequest.PreimageSharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransfers queries the transfers edge of a PreimageRequest.
+func (c *PreimageRequestClient) QueryTransfers(pr *PreimageRequest) *TransferQuery {
+	query := (&TransferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(preimagerequest.Table, preimagerequest.FieldID, id),
+			sqlgraph.To(transfer.Table, transfer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, preimagerequest.TransfersTable, preimagerequest.TransfersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PreimageRequestClient) Hooks() []Hook {
+	return c.hooks.PreimageRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *PreimageRequestClient) Interceptors() []Interceptor {
+	return c.inters.PreimageRequest
+}
+
+func (c *PreimageRequestClient) mutate(ctx context.Context, m *PreimageRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PreimageRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PreimageRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PreimageRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PreimageRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PreimageRequest mutation op: %q", m.Op())
+	}
+}
+
+// PreimageShareClient is a client for the PreimageShare schema.
+type PreimageShareClient struct {
+	config
+}
+
+// NewPreimageShareClient returns a client for the PreimageShare from the given config.
+func NewPreimageShareClient(c config) *PreimageShareClient {
+	return &PreimageShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `preimageshare.Hooks(f(g(h())))`.
+func (c *PreimageShareClient) Use(hooks ...Hook) {
+	c.hooks.PreimageShare = append(c.hooks.PreimageShare, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `preimageshare.Intercept(f(g(h())))`.
+func (c *PreimageShareClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PreimageShare = append(c.inters.PreimageShare, interceptors...)
+}
+
+// Create returns a builder for creating a PreimageShare entity.
+func (c *PreimageShareClient) Create() *PreimageShareCreate {
+	mutation := newPreimageShareMutation(c.config, OpCreate)
+	return &PreimageShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PreimageShare entities.
+func (c *PreimageShareClient) CreateBulk(builders ...*PreimageShareCreate) *PreimageShareCreateBulk {
+	return &PreimageShareCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PreimageShareClient) MapCreateBulk(slice any, setFunc func(*PreimageShareCreate, int)) *PreimageShareCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PreimageShareCreateBulk{err: fmt.Errorf("calling to PreimageShareClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PreimageShareCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PreimageShareCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PreimageShare.
+func (c *PreimageShareClient) Update() *PreimageShareUpdate {
+	mutation := newPreimageShareMutation(c.config, OpUpdate)
+	return &PreimageShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PreimageShareClient) UpdateOne(ps *PreimageShare) *PreimageShareUpdateOne {
+	mutation := newPreimageShareMutation(c.config, OpUpdateOne, withPreimageShare(ps))
+	return &PreimageShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PreimageShareClient) UpdateOneID(id uuid.UUID) *PreimageShareUpdateOne {
+	mutation := newPreimageShareMutation(c.config, OpUpdateOne, withPreimageShareID(id))
+	return &PreimageShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PreimageShare.
+func (c *PreimageShareClient) Delete() *PreimageShareDelete {
+	mutation := newPreimageShareMutation(c.config, OpDelete)
+	return &PreimageShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PreimageShareClient) DeleteOne(ps *PreimageShare) *PreimageShareDeleteOne {
+	return c.DeleteOneID(ps.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PreimageShareClient) DeleteOneID(id uuid.UUID) *PreimageShareDeleteOne {
+	builder := c.Delete().Where(preimageshare.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PreimageShareDeleteOne{builder}
+}
+
+// Query returns a query builder for PreimageShare.
+func (c *PreimageShareClient) Query() *PreimageShareQuery {
+	return &PreimageShareQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePreimageShare},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PreimageShare entity by its id.
+func (c *PreimageShareClient) Get(ctx context.Context, id uuid.UUID) (*PreimageShare, error) {
+	return c.Query().Where(preimageshare.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PreimageShareClient) GetX(ctx context.Context, id uuid.UUID) *PreimageShare {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPreimageRequest queries the preimage_request edge of a PreimageShare.
+func (c *PreimageShareClient) QueryPreimageRequest(ps *PreimageShare) *PreimageRequestQuery {
+	query := (&PreimageRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ps.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(preimageshare.Table, preimageshare.FieldID, id),
+			sqlgraph.To(preimagerequest.Table, preimagerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, preimageshare.PreimageRequestTable, preimageshare.PreimageRequestColumn),
+		)
+		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PreimageShareClient) Hooks() []Hook {
+	return c.hooks.PreimageShare
+}
+
+// Interceptors returns the client interceptors.
+func (c *PreimageShareClient) Interceptors() []Interceptor {
+	return c.inters.PreimageShare
+}
+
+func (c *PreimageShareClient) mutate(ctx context.Context, m *PreimageShareMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PreimageShareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PreimageShareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PreimageShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PreimageShareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PreimageShare mutation op: %q", m.Op())
+	}
+}
+
+// RecentWriteClient is a client for the RecentWrite schema.
+type RecentWriteClient struct {
+	config
+}
+
+// NewRecentWriteClient returns a client for the RecentWrite from the given config.
+func NewRecentWriteClient(c config) *RecentWriteClient {
+	return &RecentWriteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recentwrite.Hooks(f(g(h())))`.
+func (c *RecentWriteClient) Use(hooks ...Hook) {
+	c.hooks.RecentWrite = append(c.hooks.RecentWrite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recentwrite.Intercept(f(g(h())))`.
+func (c *RecentWriteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecentWrite = append(c.inters.RecentWrite, interceptors...)
+}
+
+// Create returns a builder for creating a RecentWrite entity.
+func (c *RecentWriteClient) Create() *RecentWriteCreate {
+	mutation := newRecentWriteMutation(c.config, OpCreate)
+	return &RecentWriteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecentWrite entities.
+func (c *RecentWriteClient) CreateBulk(builders ...*RecentWriteCreate) *RecentWriteCreateBulk {
+	return &RecentWriteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecentWriteClient) MapCreateBulk(slice any, setFunc func(*RecentWriteCreate, int)) *RecentWriteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecentWriteCreateBulk{err: fmt.Errorf("calling to RecentWriteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecentWriteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecentWriteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecentWrite.
+func (c *RecentWriteClient) Update() *RecentWriteUpdate {
+	mutation := newRecentWriteMutation(c.config, OpUpdate)
+	return &RecentWriteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecentWriteClient) UpdateOne(rw *RecentWrite) *RecentWriteUpdateOne {
+	mutation := newRecentWriteMutation(c.config, OpUpdateOne, withRecentWrite(rw))
+	return &RecentWriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecentWriteClient) UpdateOneID(id uuid.UUID) *RecentWriteUpdateOne {
+	mutation := newRecentWriteMutation(c.config, OpUpdateOne, withRecentWriteID(id))
+	return &RecentWriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PreimageRequest entities.
-func (c *PreimageRequestClient) CreateBulk(builders ...*PreimageRequestCreate) *PreimageRequestCreateBulk {
-	return &PreimageRequestCreateBulk{config: c.config, builders: builders}
+// Delete returns a delete builder for RecentWrite.
+func (c *RecentWriteClient) Delete() *RecentWriteDelete {
+	mutation := newRecentWriteMutation(c.config, OpDelete)
+	return &RecentWriteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecentWriteClient) DeleteOne(rw *RecentWrite) *RecentWriteDeleteOne {
+	return c.DeleteOneID(rw.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecentWriteClient) DeleteOneID(id uuid.UUID) *RecentWriteDeleteOne {
+	builder := c.Delete().Where(recentwrite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecentWriteDeleteOne{builder}
+}
+
+// Query returns a query builder for RecentWrite.
+func (c *RecentWriteClient) Query() *RecentWriteQuery {
+	return &RecentWriteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecentWrite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecentWrite entity by its id.
+func (c *RecentWriteClient) Get(ctx context.Context, id uuid.UUID) (*RecentWrite, error) {
+	return c.Query().Where(recentwrite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecentWriteClient) GetX(ctx context.Context, id uuid.UUID) *RecentWrite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RecentWriteClient) Hooks() []Hook {
+	return c.hooks.RecentWrite
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecentWriteClient) Interceptors() []Interceptor {
+	return c.inters.RecentWrite
+}
+
+func (c *RecentWriteClient) mutate(ctx context.Context, m *RecentWriteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecentWriteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecentWriteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecentWriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecentWriteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecentWrite mutation op: %q", m.Op())
+	}
+}
+
+// ReshareClient is a client for the Reshare schema.
+type ReshareClient struct {
+	config
+}
+
+// NewReshareClient returns a client for the Reshare from the given config.
+func NewReshareClient(c config) *ReshareClient {
+	return &ReshareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reshare.Hooks(f(g(h())))`.
+func (c *ReshareClient) Use(hooks ...Hook) {
+	c.hooks.Reshare = append(c.hooks.Reshare, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reshare.Intercept(f(g(h())))`.
+func (c *ReshareClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Reshare = append(c.inters.Reshare, interceptors...)
+}
+
+// Create returns a builder for creating a Reshare entity.
+func (c *ReshareClient) Create() *ReshareCreate {
+	mutation := newReshareMutation(c.config, OpCreate)
+	return &ReshareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Reshare entities.
+func (c *ReshareClient) CreateBulk(builders ...*ReshareCreate) *ReshareCreateBulk {
+	return &ReshareCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PreimageRequestClient) MapCreateBulk(slice any, setFunc func(*PreimageRequestCreate, int)) *PreimageRequestCreateBulk {
+func (c *ReshareClient) MapCreateBulk(slice any, setFunc func(*ReshareCreate, int)) *ReshareCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PreimageRequestCreateBulk{err: fmt.Errorf("calling to PreimageRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReshareCreateBulk{err: fmt.Errorf("calling to ReshareClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PreimageRequestCreate, rv.Len())
+	builders := make([]*ReshareCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PreimageRequestCreateBulk{config: c.config, builders: builders}
+	return &ReshareCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PreimageRequest.
-func (c *PreimageRequestClient) Update() *PreimageRequestUpdate {
-	mutation := newPreimageRequestMutation(c.config, OpUpdate)
-	return &PreimageRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Reshare.
+func (c *ReshareClient) Update() *ReshareUpdate {
+	mutation := newReshareMutation(c.config, OpUpdate)
+	return &ReshareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PreimageRequestClient) UpdateOne(pr *PreimageRequest) *PreimageRequestUpdateOne {
-	mutation := newPreimageRequestMutation(c.config, OpUpdateOne, withPreimageRequest(pr))
-	return &PreimageRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReshareClient) UpdateOne(r *Reshare) *ReshareUpdateOne {
+	mutation := newReshareMutation(c.config, OpUpdateOne, withReshare(r))
+	return &ReshareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PreimageRequestClient) UpdateOneID(id uuid.UUID) *PreimageRequestUpdateOne {
-	mutation := newPreimageRequestMutation(c.config, OpUpdateOne, withPreimageRequestID(id))
-	return &PreimageRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReshareClient) UpdateOneID(id uuid.UUID) *ReshareUpdateOne {
+	mutation := newReshareMutation(c.config, OpUpdateOne, withReshareID(id))
+	return &ReshareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PreimageRequest.
-func (c *PreimageRequestClient) Delete() *PreimageRequestDelete {
-	mutation := newPreimageRequestMutation(c.config, OpDelete)
-	return &PreimageRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Reshare.
+func (c *ReshareClient) Delete() *ReshareDelete {
+	mutation := newReshareMutation(c.config, OpDelete)
+	return &ReshareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PreimageRequestClient) DeleteOne(pr *PreimageRequest) *PreimageRequestDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *ReshareClient) DeleteOne(r *Reshare) *ReshareDeleteOne {
+	return c.DeleteOneID(r.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PreimageRequestClient) DeleteOneID(id uuid.UUID) *PreimageRequestDeleteOne {
-	builder := c.Delete().Where(preimagerequest.ID(id))
+func (c *ReshareClient) DeleteOneID(id uuid.UUID) *ReshareDeleteOne {
+	builder := c.Delete().Where(reshare.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PreimageRequestDeleteOne{builder}
+	return &ReshareDeleteOne{builder}
 }
 
-// Query returns a query builder for PreimageRequest.
-func (c *PreimageRequestClient) Query() *PreimageRequestQuery {
-	return &PreimageRequestQuery{
+// Query returns a query builder for Reshare.
+func (c *ReshareClient) Query() *ReshareQuery {
+	return &ReshareQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePreimageRequest},
+		ctx:    &QueryContext{Type: TypeReshare},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PreimageRequest entity by its id.
-func (c *PreimageRequestClient) Get(ctx context.Context, id uuid.UUID) (*PreimageRequest, error) {
-	return c.Query().Where(preimagerequest.ID(id)).Only(ctx)
+// Get returns a Reshare entity by its id.
+func (c *ReshareClient) Get(ctx context.Context, id uuid.UUID) (*Reshare, error) {
+	return c.Query().Where(reshare.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PreimageRequestClient) GetX(ctx context.Context, id uuid.UUID) *PreimageRequest {
+func (c *ReshareClient) GetX(ctx context.Context, id uuid.UUID) *Reshare {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -937,180 +2608,398 @@ func (c *PreimageRequestClient) GetX(ctx context.Context, id uuid.UUID) *Preimag
 	return obj
 }
 
-// QueryTransactions queries the transactions edge of a PreimageRequest.
-func (c *PreimageRequestClient) QueryTransactions(pr *PreimageRequest) *UserSignedTransactionQuery {
-	query := (&UserSignedTransactionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(preimagerequest.Table, preimagerequest.FieldID, id),
-			sqlgraph.To(usersignedtransaction.Table, usersignedtransaction.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, preimagerequest.TransactionsTable, preimagerequest.TransactionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *ReshareClient) Hooks() []Hook {
+	return c.hooks.Reshare
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReshareClient) Interceptors() []Interceptor {
+	return c.inters.Reshare
+}
+
+func (c *ReshareClient) mutate(ctx context.Context, m *ReshareMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReshareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReshareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReshareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReshareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Reshare mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryPreimageShares queries the preimage_shares edge of a PreimageRequest.
-func (c *PreimageRequestClient) QueryPreimageShares(pr *PreimageRequest) *PreimageShareQuery {
-	query := (&PreimageShareClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(preimagerequest.Table, preimagerequest.FieldID, id),
-			sqlgraph.To(preimageshare.Table, preimageshare.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, preimagerequest.PreimageSharesTable, preimagerequest.PreimageSharesColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// SessionRevocationClient is a client for the SessionRevocation schema.
+type SessionRevocationClient struct {
+	config
+}
+
+// NewSessionRevocationClient returns a client for the SessionRevocation from the given config.
+func NewSessionRevocationClient(c config) *SessionRevocationClient {
+	return &SessionRevocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sessionrevocation.Hooks(f(g(h())))`.
+func (c *SessionRevocationClient) Use(hooks ...Hook) {
+	c.hooks.SessionRevocation = append(c.hooks.SessionRevocation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sessionrevocation.Intercept(f(g(h())))`.
+func (c *SessionRevocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SessionRevocation = append(c.inters.SessionRevocation, interceptors...)
+}
+
+// Create returns a builder for creating a SessionRevocation entity.
+func (c *SessionRevocationClient) Create() *SessionRevocationCreate {
+	mutation := newSessionRevocationMutation(c.config, OpCreate)
+	return &SessionRevocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SessionRevocation entities.
+func (c *SessionRevocationClient) CreateBulk(builders ...*SessionRevocationCreate) *SessionRevocationCreateBulk {
+	return &SessionRevocationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionRevocationClient) MapCreateBulk(slice any, setFunc func(*SessionRevocationCreate, int)) *SessionRevocationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionRevocationCreateBulk{err: fmt.Errorf("calling to SessionRevocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*SessionRevocationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionRevocationCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryTransfers queries the transfers edge of a PreimageRequest.
-func (c *PreimageRequestClient) QueryTransfers(pr *PreimageRequest) *TransferQuery {
-	query := (&TransferClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(preimagerequest.Table, preimagerequest.FieldID, id),
-			sqlgraph.To(transfer.Table, transfer.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, preimagerequest.TransfersTable, preimagerequest.TransfersColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// Update returns an update builder for SessionRevocation.
+func (c *SessionRevocationClient) Update() *SessionRevocationUpdate {
+	mutation := newSessionRevocationMutation(c.config, OpUpdate)
+	return &SessionRevocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionRevocationClient) UpdateOne(sr *SessionRevocation) *SessionRevocationUpdateOne {
+	mutation := newSessionRevocationMutation(c.config, OpUpdateOne, withSessionRevocation(sr))
+	return &SessionRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionRevocationClient) UpdateOneID(id uuid.UUID) *SessionRevocationUpdateOne {
+	mutation := newSessionRevocationMutation(c.config, OpUpdateOne, withSessionRevocationID(id))
+	return &SessionRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SessionRevocation.
+func (c *SessionRevocationClient) Delete() *SessionRevocationDelete {
+	mutation := newSessionRevocationMutation(c.config, OpDelete)
+	return &SessionRevocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionRevocationClient) DeleteOne(sr *SessionRevocation) *SessionRevocationDeleteOne {
+	return c.DeleteOneID(sr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionRevocationClient) DeleteOneID(id uuid.UUID) *SessionRevocationDeleteOne {
+	builder := c.Delete().Where(sessionrevocation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionRevocationDeleteOne{builder}
+}
+
+// Query returns a query builder for SessionRevocation.
+func (c *SessionRevocationClient) Query() *SessionRevocationQuery {
+	return &SessionRevocationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSessionRevocation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SessionRevocation entity by its id.
+func (c *SessionRevocationClient) Get(ctx context.Context, id uuid.UUID) (*SessionRevocation, error) {
+	return c.Query().Where(sessionrevocation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionRevocationClient) GetX(ctx context.Context, id uuid.UUID) *SessionRevocation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SessionRevocationClient) Hooks() []Hook {
+	return c.hooks.SessionRevocation
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionRevocationClient) Interceptors() []Interceptor {
+	return c.inters.SessionRevocation
+}
+
+func (c *SessionRevocationClient) mutate(ctx context.Context, m *SessionRevocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionRevocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionRevocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SessionRevocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SessionRevocation mutation op: %q", m.Op())
+	}
+}
+
+// ShareRefreshClient is a client for the ShareRefresh schema.
+type ShareRefreshClient struct {
+	config
+}
+
+// NewShareRefreshClient returns a client for the ShareRefresh from the given config.
+func NewShareRefreshClient(c config) *ShareRefreshClient {
+	return &ShareRefreshClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharerefresh.Hooks(f(g(h())))`.
+func (c *ShareRefreshClient) Use(hooks ...Hook) {
+	c.hooks.ShareRefresh = append(c.hooks.ShareRefresh, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sharerefresh.Intercept(f(g(h())))`.
+func (c *ShareRefreshClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShareRefresh = append(c.inters.ShareRefresh, interceptors...)
+}
+
+// Create returns a builder for creating a ShareRefresh entity.
+func (c *ShareRefreshClient) Create() *ShareRefreshCreate {
+	mutation := newShareRefreshMutation(c.config, OpCreate)
+	return &ShareRefreshCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShareRefresh entities.
+func (c *ShareRefreshClient) CreateBulk(builders ...*ShareRefreshCreate) *ShareRefreshCreateBulk {
+	return &ShareRefreshCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShareRefreshClient) MapCreateBulk(slice any, setFunc func(*ShareRefreshCreate, int)) *ShareRefreshCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShareRefreshCreateBulk{err: fmt.Errorf("calling to ShareRefreshClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShareRefreshCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShareRefreshCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShareRefresh.
+func (c *ShareRefreshClient) Update() *ShareRefreshUpdate {
+	mutation := newShareRefreshMutation(c.config, OpUpdate)
+	return &ShareRefreshUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareRefreshClient) UpdateOne(sr *ShareRefresh) *ShareRefreshUpdateOne {
+	mutation := newShareRefreshMutation(c.config, OpUpdateOne, withShareRefresh(sr))
+	return &ShareRefreshUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareRefreshClient) UpdateOneID(id uuid.UUID) *ShareRefreshUpdateOne {
+	mutation := newShareRefreshMutation(c.config, OpUpdateOne, withShareRefreshID(id))
+	return &ShareRefreshUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShareRefresh.
+func (c *ShareRefreshClient) Delete() *ShareRefreshDelete {
+	mutation := newShareRefreshMutation(c.config, OpDelete)
+	return &ShareRefreshDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShareRefreshClient) DeleteOne(sr *ShareRefresh) *ShareRefreshDeleteOne {
+	return c.DeleteOneID(sr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShareRefreshClient) DeleteOneID(id uuid.UUID) *ShareRefreshDeleteOne {
+	builder := c.Delete().Where(sharerefresh.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareRefreshDeleteOne{builder}
+}
+
+// Query returns a query builder for ShareRefresh.
+func (c *ShareRefreshClient) Query() *ShareRefreshQuery {
+	return &ShareRefreshQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShareRefresh},
+		inters: c.Interceptors(),
 	}
-	return query
+}
+
+// Get returns a ShareRefresh entity by its id.
+func (c *ShareRefreshClient) Get(ctx context.Context, id uuid.UUID) (*ShareRefresh, error) {
+	return c.Query().Where(sharerefresh.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareRefreshClient) GetX(ctx context.Context, id uuid.UUID) *ShareRefresh {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *PreimageRequestClient) Hooks() []Hook {
-	return c.hooks.PreimageRequest
+func (c *ShareRefreshClient) Hooks() []Hook {
+	return c.hooks.ShareRefresh
 }
 
 // Interceptors returns the client interceptors.
-func (c *PreimageRequestClient) Interceptors() []Interceptor {
-	return c.inters.PreimageRequest
+func (c *ShareRefreshClient) Interceptors() []Interceptor {
+	return c.inters.ShareRefresh
 }
 
-func (c *PreimageRequestClient) mutate(ctx context.Context, m *PreimageRequestMutation) (Value, error) {
+func (c *ShareRefreshClient) mutate(ctx context.Context, m *ShareRefreshMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PreimageRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShareRefreshCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PreimageRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShareRefreshUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PreimageRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShareRefreshUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PreimageRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ShareRefreshDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PreimageRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ShareRefresh mutation op: %q", m.Op())
 	}
 }
 
-// PreimageShareClient is a client for the PreimageShare schema.
-type PreimageShareClient struct {
+// SigningIncidentClient is a client for the SigningIncident schema.
+type SigningIncidentClient struct {
 	config
 }
 
-// NewPreimageShareClient returns a client for the PreimageShare from the given config.
-func NewPreimageShareClient(c config) *PreimageShareClient {
-	return &PreimageShareClient{config: c}
+// NewSigningIncidentClient returns a client for the SigningIncident from the given config.
+func NewSigningIncidentClient(c config) *SigningIncidentClient {
+	return &SigningIncidentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `preimageshare.Hooks(f(g(h())))`.
-func (c *PreimageShareClient) Use(hooks ...Hook) {
-	c.hooks.PreimageShare = append(c.hooks.PreimageShare, hooks...)
+// A call to `Use(f, g, h)` equals to `signingincident.Hooks(f(g(h())))`.
+func (c *SigningIncidentClient) Use(hooks ...Hook) {
+	c.hooks.SigningIncident = append(c.hooks.SigningIncident, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `preimageshare.Intercept(f(g(h())))`.
-func (c *PreimageShareClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PreimageShare = append(c.inters.PreimageShare, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `signingincident.Intercept(f(g(h())))`.
+func (c *SigningIncidentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SigningIncident = append(c.inters.SigningIncident, interceptors...)
 }
 
-// Create returns a builder for creating a PreimageShare entity.
-func (c *PreimageShareClient) Create() *PreimageShareCreate {
-	mutation := newPreimageShareMutation(c.config, OpCreate)
-	return &PreimageShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SigningIncident entity.
+func (c *SigningIncidentClient) Create() *SigningIncidentCreate {
+	mutation := newSigningIncidentMutation(c.config, OpCreate)
+	return &SigningIncidentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PreimageShare entities.
-func (c *PreimageShareClient) CreateBulk(builders ...*PreimageShareCreate) *PreimageShareCreateBulk {
-	return &PreimageShareCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SigningIncident entities.
+func (c *SigningIncidentClient) CreateBulk(builders ...*SigningIncidentCreate) *SigningIncidentCreateBulk {
+	return &SigningIncidentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PreimageShareClient) MapCreateBulk(slice any, setFunc func(*PreimageShareCreate, int)) *PreimageShareCreateBulk {
+func (c *SigningIncidentClient) MapCreateBulk(slice any, setFunc func(*SigningIncidentCreate, int)) *SigningIncidentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PreimageShareCreateBulk{err: fmt.Errorf("calling to PreimageShareClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SigningIncidentCreateBulk{err: fmt.Errorf("calling to SigningIncidentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PreimageShareCreate, rv.Len())
+	builders := make([]*SigningIncidentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PreimageShareCreateBulk{config: c.config, builders: builders}
+	return &SigningIncidentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PreimageShare.
-func (c *PreimageShareClient) Update() *PreimageShareUpdate {
-	mutation := newPreimageShareMutation(c.config, OpUpdate)
-	return &PreimageShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SigningIncident.
+func (c *SigningIncidentClient) Update() *SigningIncidentUpdate {
+	mutation := newSigningIncidentMutation(c.config, OpUpdate)
+	return &SigningIncidentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PreimageShareClient) UpdateOne(ps *PreimageShare) *PreimageShareUpdateOne {
-	mutation := newPreimageShareMutation(c.config, OpUpdateOne, withPreimageShare(ps))
-	return &PreimageShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SigningIncidentClient) UpdateOne(si *SigningIncident) *SigningIncidentUpdateOne {
+	mutation := newSigningIncidentMutation(c.config, OpUpdateOne, withSigningIncident(si))
+	return &SigningIncidentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PreimageShareClient) UpdateOneID(id uuid.UUID) *PreimageShareUpdateOne {
-	mutation := newPreimageShareMutation(c.config, OpUpdateOne, withPreimageShareID(id))
-	return &PreimageShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SigningIncidentClient) UpdateOneID(id uuid.UUID) *SigningIncidentUpdateOne {
+	mutation := newSigningIncidentMutation(c.config, OpUpdateOne, withSigningIncidentID(id))
+	return &SigningIncidentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PreimageShare.
-func (c *PreimageShareClient) Delete() *PreimageShareDelete {
-	mutation := newPreimageShareMutation(c.config, OpDelete)
-	return &PreimageShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SigningIncident.
+func (c *SigningIncidentClient) Delete() *SigningIncidentDelete {
+	mutation := newSigningIncidentMutation(c.config, OpDelete)
+	return &SigningIncidentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PreimageShareClient) DeleteOne(ps *PreimageShare) *PreimageShareDeleteOne {
-	return c.DeleteOneID(ps.ID)
+func (c *SigningIncidentClient) DeleteOne(si *SigningIncident) *SigningIncidentDeleteOne {
+	return c.DeleteOneID(si.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PreimageShareClient) DeleteOneID(id uuid.UUID) *PreimageShareDeleteOne {
-	builder := c.Delete().Where(preimageshare.ID(id))
+func (c *SigningIncidentClient) DeleteOneID(id uuid.UUID) *SigningIncidentDeleteOne {
+	builder := c.Delete().Where(signingincident.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PreimageShareDeleteOne{builder}
+	return &SigningIncidentDeleteOne{builder}
 }
 
-// Query returns a query builder for PreimageShare.
-func (c *PreimageShareClient) Query() *PreimageShareQuery {
-	return &PreimageShareQuery{
+// Query returns a query builder for SigningIncident.
+func (c *SigningIncidentClient) Query() *SigningIncidentQuery {
+	return &SigningIncidentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePreimageShare},
+		ctx:    &QueryContext{Type: TypeSigningIncident},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PreimageShare entity by its id.
-func (c *PreimageShareClient) Get(ctx context.Context, id uuid.UUID) (*PreimageShare, error) {
-	return c.Query().Where(preimageshare.ID(id)).Only(ctx)
+// Get returns a SigningIncident entity by its id.
+func (c *SigningIncidentClient) Get(ctx context.Context, id uuid.UUID) (*SigningIncident, error) {
+	return c.Query().Where(signingincident.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PreimageShareClient) GetX(ctx context.Context, id uuid.UUID) *PreimageShare {
+func (c *SigningIncidentClient) GetX(ctx context.Context, id uuid.UUID) *SigningIncident {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1118,44 +3007,28 @@ func (c *PreimageShareClient) GetX(ctx context.Context, id uuid.UUID) *PreimageS
 	return obj
 }
 
-// QueryPreimageRequest queries the preimage_request edge of a PreimageShare.
-func (c *PreimageShareClient) QueryPreimageRequest(ps *PreimageShare) *PreimageRequestQuery {
-	query := (&PreimageRequestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ps.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(preimageshare.Table, preimageshare.FieldID, id),
-			sqlgraph.To(preimagerequest.Table, preimagerequest.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, preimageshare.PreimageRequestTable, preimageshare.PreimageRequestColumn),
-		)
-		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PreimageShareClient) Hooks() []Hook {
-	return c.hooks.PreimageShare
+func (c *SigningIncidentClient) Hooks() []Hook {
+	return c.hooks.SigningIncident
 }
 
 // Interceptors returns the client interceptors.
-func (c *PreimageShareClient) Interceptors() []Interceptor {
-	return c.inters.PreimageShare
+func (c *SigningIncidentClient) Interceptors() []Interceptor {
+	return c.inters.SigningIncident
 }
 
-func (c *PreimageShareClient) mutate(ctx context.Context, m *PreimageShareMutation) (Value, error) {
+func (c *SigningIncidentClient) mutate(ctx context.Context, m *SigningIncidentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PreimageShareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SigningIncidentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PreimageShareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SigningIncidentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PreimageShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SigningIncidentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PreimageShareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SigningIncidentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PreimageShare mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SigningIncident mutation op: %q", m.Op())
 	}
 }
 
@@ -1425,6 +3298,272 @@ func (c *SigningNonceClient) mutate(ctx context.Context, m *SigningNonceMutation
 	}
 }
 
+// TaskLockClient is a client for the TaskLock schema.
+type TaskLockClient struct {
+	config
+}
+
+// NewTaskLockClient returns a client for the TaskLock from the given config.
+func NewTaskLockClient(c config) *TaskLockClient {
+	return &TaskLockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tasklock.Hooks(f(g(h())))`.
+func (c *TaskLockClient) Use(hooks ...Hook) {
+	c.hooks.TaskLock = append(c.hooks.TaskLock, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tasklock.Intercept(f(g(h())))`.
+func (c *TaskLockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskLock = append(c.inters.TaskLock, interceptors...)
+}
+
+// Create returns a builder for creating a TaskLock entity.
+func (c *TaskLockClient) Create() *TaskLockCreate {
+	mutation := newTaskLockMutation(c.config, OpCreate)
+	return &TaskLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskLock entities.
+func (c *TaskLockClient) CreateBulk(builders ...*TaskLockCreate) *TaskLockCreateBulk {
+	return &TaskLockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskLockClient) MapCreateBulk(slice any, setFunc func(*TaskLockCreate, int)) *TaskLockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskLockCreateBulk{err: fmt.Errorf("calling to TaskLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskLockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskLockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskLock.
+func (c *TaskLockClient) Update() *TaskLockUpdate {
+	mutation := newTaskLockMutation(c.config, OpUpdate)
+	return &TaskLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskLockClient) UpdateOne(tl *TaskLock) *TaskLockUpdateOne {
+	mutation := newTaskLockMutation(c.config, OpUpdateOne, withTaskLock(tl))
+	return &TaskLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskLockClient) UpdateOneID(id uuid.UUID) *TaskLockUpdateOne {
+	mutation := newTaskLockMutation(c.config, OpUpdateOne, withTaskLockID(id))
+	return &TaskLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskLock.
+func (c *TaskLockClient) Delete() *TaskLockDelete {
+	mutation := newTaskLockMutation(c.config, OpDelete)
+	return &TaskLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskLockClient) DeleteOne(tl *TaskLock) *TaskLockDeleteOne {
+	return c.DeleteOneID(tl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskLockClient) DeleteOneID(id uuid.UUID) *TaskLockDeleteOne {
+	builder := c.Delete().Where(tasklock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskLockDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskLock.
+func (c *TaskLockClient) Query() *TaskLockQuery {
+	return &TaskLockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskLock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskLock entity by its id.
+func (c *TaskLockClient) Get(ctx context.Context, id uuid.UUID) (*TaskLock, error) {
+	return c.Query().Where(tasklock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskLockClient) GetX(ctx context.Context, id uuid.UUID) *TaskLock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskLockClient) Hooks() []Hook {
+	return c.hooks.TaskLock
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskLockClient) Interceptors() []Interceptor {
+	return c.inters.TaskLock
+}
+
+func (c *TaskLockClient) mutate(ctx context.Context, m *TaskLockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskLock mutation op: %q", m.Op())
+	}
+}
+
+// TaskRunClient is a client for the TaskRun schema.
+type TaskRunClient struct {
+	config
+}
+
+// NewTaskRunClient returns a client for the TaskRun from the given config.
+func NewTaskRunClient(c config) *TaskRunClient {
+	return &TaskRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskrun.Hooks(f(g(h())))`.
+func (c *TaskRunClient) Use(hooks ...Hook) {
+	c.hooks.TaskRun = append(c.hooks.TaskRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskrun.Intercept(f(g(h())))`.
+func (c *TaskRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskRun = append(c.inters.TaskRun, interceptors...)
+}
+
+// Create returns a builder for creating a TaskRun entity.
+func (c *TaskRunClient) Create() *TaskRunCreate {
+	mutation := newTaskRunMutation(c.config, OpCreate)
+	return &TaskRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskRun entities.
+func (c *TaskRunClient) CreateBulk(builders ...*TaskRunCreate) *TaskRunCreateBulk {
+	return &TaskRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskRunClient) MapCreateBulk(slice any, setFunc func(*TaskRunCreate, int)) *TaskRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskRunCreateBulk{err: fmt.Errorf("calling to TaskRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskRun.
+func (c *TaskRunClient) Update() *TaskRunUpdate {
+	mutation := newTaskRunMutation(c.config, OpUpdate)
+	return &TaskRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskRunClient) UpdateOne(tr *TaskRun) *TaskRunUpdateOne {
+	mutation := newTaskRunMutation(c.config, OpUpdateOne, withTaskRun(tr))
+	return &TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskRunClient) UpdateOneID(id uuid.UUID) *TaskRunUpdateOne {
+	mutation := newTaskRunMutation(c.config, OpUpdateOne, withTaskRunID(id))
+	return &TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskRun.
+func (c *TaskRunClient) Delete() *TaskRunDelete {
+	mutation := newTaskRunMutation(c.config, OpDelete)
+	return &TaskRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskRunClient) DeleteOne(tr *TaskRun) *TaskRunDeleteOne {
+	return c.DeleteOneID(tr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskRunClient) DeleteOneID(id uuid.UUID) *TaskRunDeleteOne {
+	builder := c.Delete().Where(taskrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskRunDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskRun.
+func (c *TaskRunClient) Query() *TaskRunQuery {
+	return &TaskRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskRun entity by its id.
+func (c *TaskRunClient) Get(ctx context.Context, id uuid.UUID) (*TaskRun, error) {
+	return c.Query().Where(taskrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskRunClient) GetX(ctx context.Context, id uuid.UUID) *TaskRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskRunClient) Hooks() []Hook {
+	return c.hooks.TaskRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskRunClient) Interceptors() []Interceptor {
+	return c.inters.TaskRun
+}
+
+func (c *TaskRunClient) mutate(ctx context.Context, m *TaskRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskRun mutation op: %q", m.Op())
+	}
+}
+
 // TokenFreezeClient is a client for the TokenFreeze schema.
 type TokenFreezeClient struct {
 	config
@@ -3605,15 +5744,47 @@ func (c *UtxoSwapClient) mutate(ctx context.Context, m *UtxoSwapMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		BlockHeight, CooperativeExit, DepositAddress, PreimageRequest, PreimageShare,
-		SigningKeyshare, SigningNonce, TokenFreeze, TokenLeaf, TokenMint, TokenOutput,
-		TokenTransaction, TokenTransactionReceipt, Transfer, TransferLeaf, Tree,
-		TreeNode, UserSignedTransaction, Utxo, UtxoSwap []ent.Hook
+		Archive, ArchivedTransfer, BlockHeight, ConsistencyAuditCursor,
+		ConsistencyDiscrepancy, CooperativeExit, DepositAddress, DkgSession,
+		IdempotencyKey, NetworkPause, OperatorCallNonce, PreimageRequest,
+		PreimageShare, RecentWrite, Reshare, SessionRevocation, ShareRefresh,
+		SigningIncident, SigningKeyshare, SigningNonce, TaskLock, TaskRun, TokenFreeze,
+		TokenLeaf, TokenMint, TokenOutput, TokenTransaction, TokenTransactionReceipt,
+		Transfer, TransferLeaf, Tree, TreeNode, UserSignedTransaction, Utxo,
+		UtxoSwap []ent.Hook
 	}
 	inters struct {
-		BlockHeight, CooperativeExit, DepositAddress, PreimageRequest, PreimageShare,
-		SigningKeyshare, SigningNonce, TokenFreeze, TokenLeaf, TokenMint, TokenOutput,
-		TokenTransaction, TokenTransactionReceipt, Transfer, TransferLeaf, Tree,
-		TreeNode, UserSignedTransaction, Utxo, UtxoSwap []ent.Interceptor
+		Archive, ArchivedTransfer, BlockHeight, ConsistencyAuditCursor,
+		ConsistencyDiscrepancy, CooperativeExit, DepositAddress, DkgSession,
+		IdempotencyKey, NetworkPause, OperatorCallNonce, PreimageRequest,
+		PreimageShare, RecentWrite, Reshare, SessionRevocation, ShareRefresh,
+		SigningIncident, SigningKeyshare, SigningNonce, TaskLock, TaskRun, TokenFreeze,
+		TokenLeaf, TokenMint, TokenOutput, TokenTransaction, TokenTransactionReceipt,
+		Transfer, TransferLeaf, Tree, TreeNode, UserSignedTransaction, Utxo,
+		UtxoSwap []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
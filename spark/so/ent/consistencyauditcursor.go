@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ConsistencyAuditCursor is the model entity for the ConsistencyAuditCursor schema.
+type ConsistencyAuditCursor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind schema.ConsistencyKind `json:"kind,omitempty"`
+	// Key holds the value of the "key" field.
+	Key          string `json:"key,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConsistencyAuditCursor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case consistencyauditcursor.FieldKind, consistencyauditcursor.FieldKey:
+			values[i] = new(sql.NullString)
+		case consistencyauditcursor.FieldCreateTime, consistencyauditcursor.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case consistencyauditcursor.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConsistencyAuditCursor fields.
+func (cac *ConsistencyAuditCursor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case consistencyauditcursor.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cac.ID = *value
+			}
+		case consistencyauditcursor.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				cac.CreateTime = value.Time
+			}
+		case consistencyauditcursor.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				cac.UpdateTime = value.Time
+			}
+		case consistencyauditcursor.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				cac.Kind = schema.ConsistencyKind(value.String)
+			}
+		case consistencyauditcursor.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				cac.Key = value.String
+			}
+		default:
+			cac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConsistencyAuditCursor.
+// This includes values selected through modifiers, order, etc.
+func (cac *ConsistencyAuditCursor) Value(name string) (ent.Value, error) {
+	return cac.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConsistencyAuditCursor.
+// Note that you need to call ConsistencyAuditCursor.Unwrap() before calling this method if this ConsistencyAuditCursor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cac *ConsistencyAuditCursor) Update() *ConsistencyAuditCursorUpdateOne {
+	return NewConsistencyAuditCursorClient(cac.config).UpdateOne(cac)
+}
+
+// Unwrap unwraps the ConsistencyAuditCursor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cac *ConsistencyAuditCursor) Unwrap() *ConsistencyAuditCursor {
+	_tx, ok := cac.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConsistencyAuditCursor is not a transactional entity")
+	}
+	cac.config.driver = _tx.drv
+	return cac
+}
+
+// String implements the fmt.Stringer.
+func (cac *ConsistencyAuditCursor) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConsistencyAuditCursor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cac.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(cac.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(cac.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", cac.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(cac.Key)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConsistencyAuditCursors is a parsable slice of ConsistencyAuditCursor.
+type ConsistencyAuditCursors []*ConsistencyAuditCursor
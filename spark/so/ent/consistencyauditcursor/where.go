@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package consistencyauditcursor
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldEQ(FieldKey, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v schema.ConsistencyKind) predicate.ConsistencyAuditCursor {
+	vc := v
+	return predicate.ConsistencyAuditCursor(sql.FieldEQ(FieldKind, vc))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v schema.ConsistencyKind) predicate.ConsistencyAuditCursor {
+	vc := v
+	return predicate.ConsistencyAuditCursor(sql.FieldNEQ(FieldKind, vc))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...schema.ConsistencyKind) predicate.ConsistencyAuditCursor {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsistencyAuditCursor(sql.FieldIn(FieldKind, v...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...schema.ConsistencyKind) predicate.ConsistencyAuditCursor {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsistencyAuditCursor(sql.FieldNotIn(FieldKind, v...))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.FieldContainsFold(FieldKey, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConsistencyAuditCursor) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConsistencyAuditCursor) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConsistencyAuditCursor) predicate.ConsistencyAuditCursor {
+	return predicate.ConsistencyAuditCursor(sql.NotPredicates(p))
+}
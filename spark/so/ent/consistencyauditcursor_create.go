@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ConsistencyAuditCursorCreate is the builder for creating a ConsistencyAuditCursor entity.
+type ConsistencyAuditCursorCreate struct {
+	config
+	mutation *ConsistencyAuditCursorMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (cacc *ConsistencyAuditCursorCreate) SetCreateTime(t time.Time) *ConsistencyAuditCursorCreate {
+	cacc.mutation.SetCreateTime(t)
+	return cacc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (cacc *ConsistencyAuditCursorCreate) SetNillableCreateTime(t *time.Time) *ConsistencyAuditCursorCreate {
+	if t != nil {
+		cacc.SetCreateTime(*t)
+	}
+	return cacc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cacc *ConsistencyAuditCursorCreate) SetUpdateTime(t time.Time) *ConsistencyAuditCursorCreate {
+	cacc.mutation.SetUpdateTime(t)
+	return cacc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (cacc *ConsistencyAuditCursorCreate) SetNillableUpdateTime(t *time.Time) *ConsistencyAuditCursorCreate {
+	if t != nil {
+		cacc.SetUpdateTime(*t)
+	}
+	return cacc
+}
+
+// SetKind sets the "kind" field.
+func (cacc *ConsistencyAuditCursorCreate) SetKind(sk schema.ConsistencyKind) *ConsistencyAuditCursorCreate {
+	cacc.mutation.SetKind(sk)
+	return cacc
+}
+
+// SetKey sets the "key" field.
+func (cacc *ConsistencyAuditCursorCreate) SetKey(s string) *ConsistencyAuditCursorCreate {
+	cacc.mutation.SetKey(s)
+	return cacc
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (cacc *ConsistencyAuditCursorCreate) SetNillableKey(s *string) *ConsistencyAuditCursorCreate {
+	if s != nil {
+		cacc.SetKey(*s)
+	}
+	return cacc
+}
+
+// SetID sets the "id" field.
+func (cacc *ConsistencyAuditCursorCreate) SetID(u uuid.UUID) *ConsistencyAuditCursorCreate {
+	cacc.mutation.SetID(u)
+	return cacc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cacc *ConsistencyAuditCursorCreate) SetNillableID(u *uuid.UUID) *ConsistencyAuditCursorCreate {
+	if u != nil {
+		cacc.SetID(*u)
+	}
+	return cacc
+}
+
+// Mutation returns the ConsistencyAuditCursorMutation object of the builder.
+func (cacc *ConsistencyAuditCursorCreate) Mutation() *ConsistencyAuditCursorMutation {
+	return cacc.mutation
+}
+
+// Save creates the ConsistencyAuditCursor in the database.
+func (cacc *ConsistencyAuditCursorCreate) Save(ctx context.Context) (*ConsistencyAuditCursor, error) {
+	cacc.defaults()
+	return withHooks(ctx, cacc.sqlSave, cacc.mutation, cacc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cacc *ConsistencyAuditCursorCreate) SaveX(ctx context.Context) *ConsistencyAuditCursor {
+	v, err := cacc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cacc *ConsistencyAuditCursorCreate) Exec(ctx context.Context) error {
+	_, err := cacc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacc *ConsistencyAuditCursorCreate) ExecX(ctx context.Context) {
+	if err := cacc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cacc *ConsistencyAuditCursorCreate) defaults() {
+	if _, ok := cacc.mutation.CreateTime(); !ok {
+		v := consistencyauditcursor.DefaultCreateTime()
+		cacc.mutation.SetCreateTime(v)
+	}
+	if _, ok := cacc.mutation.UpdateTime(); !ok {
+		v := consistencyauditcursor.DefaultUpdateTime()
+		cacc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := cacc.mutation.Key(); !ok {
+		v := consistencyauditcursor.DefaultKey
+		cacc.mutation.SetKey(v)
+	}
+	if _, ok := cacc.mutation.ID(); !ok {
+		v := consistencyauditcursor.DefaultID()
+		cacc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cacc *ConsistencyAuditCursorCreate) check() error {
+	if _, ok := cacc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ConsistencyAuditCursor.create_time"`)}
+	}
+	if _, ok := cacc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ConsistencyAuditCursor.update_time"`)}
+	}
+	if _, ok := cacc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ConsistencyAuditCursor.kind"`)}
+	}
+	if v, ok := cacc.mutation.Kind(); ok {
+		if err := consistencyauditcursor.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ConsistencyAuditCursor.kind": %w`, err)}
+		}
+	}
+	if _, ok := cacc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ConsistencyAuditCursor.key"`)}
+	}
+	return nil
+}
+
+func (cacc *ConsistencyAuditCursorCreate) sqlSave(ctx context.Context) (*ConsistencyAuditCursor, error) {
+	if err := cacc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cacc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cacc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cacc.mutation.id = &_node.ID
+	cacc.mutation.done = true
+	return _node, nil
+}
+
+func (cacc *ConsistencyAuditCursorCreate) createSpec() (*ConsistencyAuditCursor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConsistencyAuditCursor{config: cacc.config}
+		_spec = sqlgraph.NewCreateSpec(consistencyauditcursor.Table, sqlgraph.NewFieldSpec(consistencyauditcursor.FieldID, field.TypeUUID))
+	)
+	if id, ok := cacc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cacc.mutation.CreateTime(); ok {
+		_spec.SetField(consistencyauditcursor.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := cacc.mutation.UpdateTime(); ok {
+		_spec.SetField(consistencyauditcursor.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := cacc.mutation.Kind(); ok {
+		_spec.SetField(consistencyauditcursor.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := cacc.mutation.Key(); ok {
+		_spec.SetField(consistencyauditcursor.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	return _node, _spec
+}
+
+// ConsistencyAuditCursorCreateBulk is the builder for creating many ConsistencyAuditCursor entities in bulk.
+type ConsistencyAuditCursorCreateBulk struct {
+	config
+	err      error
+	builders []*ConsistencyAuditCursorCreate
+}
+
+// Save creates the ConsistencyAuditCursor entities in the database.
+func (caccb *ConsistencyAuditCursorCreateBulk) Save(ctx context.Context) ([]*ConsistencyAuditCursor, error) {
+	if caccb.err != nil {
+		return nil, caccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(caccb.builders))
+	nodes := make([]*ConsistencyAuditCursor, len(caccb.builders))
+	mutators := make([]Mutator, len(caccb.builders))
+	for i := range caccb.builders {
+		func(i int, root context.Context) {
+			builder := caccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsistencyAuditCursorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, caccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, caccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, caccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (caccb *ConsistencyAuditCursorCreateBulk) SaveX(ctx context.Context) []*ConsistencyAuditCursor {
+	v, err := caccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (caccb *ConsistencyAuditCursorCreateBulk) Exec(ctx context.Context) error {
+	_, err := caccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (caccb *ConsistencyAuditCursorCreateBulk) ExecX(ctx context.Context) {
+	if err := caccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ConsistencyAuditCursorDelete is the builder for deleting a ConsistencyAuditCursor entity.
+type ConsistencyAuditCursorDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConsistencyAuditCursorMutation
+}
+
+// Where appends a list predicates to the ConsistencyAuditCursorDelete builder.
+func (cacd *ConsistencyAuditCursorDelete) Where(ps ...predicate.ConsistencyAuditCursor) *ConsistencyAuditCursorDelete {
+	cacd.mutation.Where(ps...)
+	return cacd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cacd *ConsistencyAuditCursorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cacd.sqlExec, cacd.mutation, cacd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacd *ConsistencyAuditCursorDelete) ExecX(ctx context.Context) int {
+	n, err := cacd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cacd *ConsistencyAuditCursorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(consistencyauditcursor.Table, sqlgraph.NewFieldSpec(consistencyauditcursor.FieldID, field.TypeUUID))
+	if ps := cacd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cacd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cacd.mutation.done = true
+	return affected, err
+}
+
+// ConsistencyAuditCursorDeleteOne is the builder for deleting a single ConsistencyAuditCursor entity.
+type ConsistencyAuditCursorDeleteOne struct {
+	cacd *ConsistencyAuditCursorDelete
+}
+
+// Where appends a list predicates to the ConsistencyAuditCursorDelete builder.
+func (cacdo *ConsistencyAuditCursorDeleteOne) Where(ps ...predicate.ConsistencyAuditCursor) *ConsistencyAuditCursorDeleteOne {
+	cacdo.cacd.mutation.Where(ps...)
+	return cacdo
+}
+
+// Exec executes the deletion query.
+func (cacdo *ConsistencyAuditCursorDeleteOne) Exec(ctx context.Context) error {
+	n, err := cacdo.cacd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{consistencyauditcursor.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacdo *ConsistencyAuditCursorDeleteOne) ExecX(ctx context.Context) {
+	if err := cacdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
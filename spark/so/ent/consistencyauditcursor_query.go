@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ConsistencyAuditCursorQuery is the builder for querying ConsistencyAuditCursor entities.
+type ConsistencyAuditCursorQuery struct {
+	config
+	ctx        *QueryContext
+	order      []consistencyauditcursor.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ConsistencyAuditCursor
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConsistencyAuditCursorQuery builder.
+func (cacq *ConsistencyAuditCursorQuery) Where(ps ...predicate.ConsistencyAuditCursor) *ConsistencyAuditCursorQuery {
+	cacq.predicates = append(cacq.predicates, ps...)
+	return cacq
+}
+
+// Limit the number of records to be returned by this query.
+func (cacq *ConsistencyAuditCursorQuery) Limit(limit int) *ConsistencyAuditCursorQuery {
+	cacq.ctx.Limit = &limit
+	return cacq
+}
+
+// Offset to start from.
+func (cacq *ConsistencyAuditCursorQuery) Offset(offset int) *ConsistencyAuditCursorQuery {
+	cacq.ctx.Offset = &offset
+	return cacq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cacq *ConsistencyAuditCursorQuery) Unique(unique bool) *ConsistencyAuditCursorQuery {
+	cacq.ctx.Unique = &unique
+	return cacq
+}
+
+// Order specifies how the records should be ordered.
+func (cacq *ConsistencyAuditCursorQuery) Order(o ...consistencyauditcursor.OrderOption) *ConsistencyAuditCursorQuery {
+	cacq.order = append(cacq.order, o...)
+	return cacq
+}
+
+// First returns the first ConsistencyAuditCursor entity from the query.
+// Returns a *NotFoundError when no ConsistencyAuditCursor was found.
+func (cacq *ConsistencyAuditCursorQuery) First(ctx context.Context) (*ConsistencyAuditCursor, error) {
+	nodes, err := cacq.Limit(1).All(setContextOp(ctx, cacq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{consistencyauditcursor.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cacq *ConsistencyAuditCursorQuery) FirstX(ctx context.Context) *ConsistencyAuditCursor {
+	node, err := cacq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConsistencyAuditCursor ID from the query.
+// Returns a *NotFoundError when no ConsistencyAuditCursor ID was found.
+func (cacq *ConsistencyAuditCursorQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cacq.Limit(1).IDs(setContextOp(ctx, cacq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{consistencyauditcursor.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cacq *ConsistencyAuditCursorQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cacq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConsistencyAuditCursor entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConsistencyAuditCursor entity is found.
+// Returns a *NotFoundError when no ConsistencyAuditCursor entities are found.
+func (cacq *ConsistencyAuditCursorQuery) Only(ctx context.Context) (*ConsistencyAuditCursor, error) {
+	nodes, err := cacq.Limit(2).All(setContextOp(ctx, cacq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{consistencyauditcursor.Label}
+	default:
+		return nil, &NotSingularError{consistencyauditcursor.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cacq *ConsistencyAuditCursorQuery) OnlyX(ctx context.Context) *ConsistencyAuditCursor {
+	node, err := cacq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConsistencyAuditCursor ID in the query.
+// Returns a *NotSingularError when more than one ConsistencyAuditCursor ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cacq *ConsistencyAuditCursorQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cacq.Limit(2).IDs(setContextOp(ctx, cacq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{consistencyauditcursor.Label}
+	default:
+		err = &NotSingularError{consistencyauditcursor.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cacq *ConsistencyAuditCursorQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cacq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConsistencyAuditCursors.
+func (cacq *ConsistencyAuditCursorQuery) All(ctx context.Context) ([]*ConsistencyAuditCursor, error) {
+	ctx = setContextOp(ctx, cacq.ctx, ent.OpQueryAll)
+	if err := cacq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConsistencyAuditCursor, *ConsistencyAuditCursorQuery]()
+	return withInterceptors[[]*ConsistencyAuditCursor](ctx, cacq, qr, cacq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cacq *ConsistencyAuditCursorQuery) AllX(ctx context.Context) []*ConsistencyAuditCursor {
+	nodes, err := cacq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConsistencyAuditCursor IDs.
+func (cacq *ConsistencyAuditCursorQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if cacq.ctx.Unique == nil && cacq.path != nil {
+		cacq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cacq.ctx, ent.OpQueryIDs)
+	if err = cacq.Select(consistencyauditcursor.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cacq *ConsistencyAuditCursorQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cacq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cacq *ConsistencyAuditCursorQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cacq.ctx, ent.OpQueryCount)
+	if err := cacq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cacq, querierCount[*ConsistencyAuditCursorQuery](), cacq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cacq *ConsistencyAuditCursorQuery) CountX(ctx context.Context) int {
+	count, err := cacq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cacq *ConsistencyAuditCursorQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cacq.ctx, ent.OpQueryExist)
+	switch _, err := cacq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cacq *ConsistencyAuditCursorQuery) ExistX(ctx context.Context) bool {
+	exist, err := cacq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConsistencyAuditCursorQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cacq *ConsistencyAuditCursorQuery) Clone() *ConsistencyAuditCursorQuery {
+	if cacq == nil {
+		return nil
+	}
+	return &ConsistencyAuditCursorQuery{
+		config:     cacq.config,
+		ctx:        cacq.ctx.Clone(),
+		order:      append([]consistencyauditcursor.OrderOption{}, cacq.order...),
+		inters:     append([]Interceptor{}, cacq.inters...),
+		predicates: append([]predicate.ConsistencyAuditCursor{}, cacq.predicates...),
+		// clone intermediate query.
+		sql:  cacq.sql.Clone(),
+		path: cacq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConsistencyAuditCursor.Query().
+//		GroupBy(consistencyauditcursor.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cacq *ConsistencyAuditCursorQuery) GroupBy(field string, fields ...string) *ConsistencyAuditCursorGroupBy {
+	cacq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConsistencyAuditCursorGroupBy{build: cacq}
+	grbuild.flds = &cacq.ctx.Fields
+	grbuild.label = consistencyauditcursor.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ConsistencyAuditCursor.Query().
+//		Select(consistencyauditcursor.FieldCreateTime).
+//		Scan(ctx, &v)
+func (cacq *ConsistencyAuditCursorQuery) Select(fields ...string) *ConsistencyAuditCursorSelect {
+	cacq.ctx.Fields = append(cacq.ctx.Fields, fields...)
+	sbuild := &ConsistencyAuditCursorSelect{ConsistencyAuditCursorQuery: cacq}
+	sbuild.label = consistencyauditcursor.Label
+	sbuild.flds, sbuild.scan = &cacq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConsistencyAuditCursorSelect configured with the given aggregations.
+func (cacq *ConsistencyAuditCursorQuery) Aggregate(fns ...AggregateFunc) *ConsistencyAuditCursorSelect {
+	return cacq.Select().Aggregate(fns...)
+}
+
+func (cacq *ConsistencyAuditCursorQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cacq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cacq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cacq.ctx.Fields {
+		if !consistencyauditcursor.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cacq.path != nil {
+		prev, err := cacq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cacq.sql = prev
+	}
+	return nil
+}
+
+func (cacq *ConsistencyAuditCursorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConsistencyAuditCursor, error) {
+	var (
+		nodes = []*ConsistencyAuditCursor{}
+		_spec = cacq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConsistencyAuditCursor).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConsistencyAuditCursor{config: cacq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(cacq.modifiers) > 0 {
+		_spec.Modifiers = cacq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cacq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (cacq *ConsistencyAuditCursorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cacq.querySpec()
+	if len(cacq.modifiers) > 0 {
+		_spec.Modifiers = cacq.modifiers
+	}
+	_spec.Node.Columns = cacq.ctx.Fields
+	if len(cacq.ctx.Fields) > 0 {
+		_spec.Unique = cacq.ctx.Unique != nil && *cacq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cacq.driver, _spec)
+}
+
+func (cacq *ConsistencyAuditCursorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(consistencyauditcursor.Table, consistencyauditcursor.Columns, sqlgraph.NewFieldSpec(consistencyauditcursor.FieldID, field.TypeUUID))
+	_spec.From = cacq.sql
+	if unique := cacq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cacq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cacq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consistencyauditcursor.FieldID)
+		for i := range fields {
+			if fields[i] != consistencyauditcursor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cacq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cacq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cacq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cacq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cacq *ConsistencyAuditCursorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cacq.driver.Dialect())
+	t1 := builder.Table(consistencyauditcursor.Table)
+	columns := cacq.ctx.Fields
+	if len(columns) == 0 {
+		columns = consistencyauditcursor.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cacq.sql != nil {
+		selector = cacq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cacq.ctx.Unique != nil && *cacq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range cacq.modifiers {
+		m(selector)
+	}
+	for _, p := range cacq.predicates {
+		p(selector)
+	}
+	for _, p := range cacq.order {
+		p(selector)
+	}
+	if offset := cacq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cacq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cacq *ConsistencyAuditCursorQuery) ForUpdate(opts ...sql.LockOption) *ConsistencyAuditCursorQuery {
+	if cacq.driver.Dialect() == dialect.Postgres {
+		cacq.Unique(false)
+	}
+	cacq.modifiers = append(cacq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cacq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cacq *ConsistencyAuditCursorQuery) ForShare(opts ...sql.LockOption) *ConsistencyAuditCursorQuery {
+	if cacq.driver.Dialect() == dialect.Postgres {
+		cacq.Unique(false)
+	}
+	cacq.modifiers = append(cacq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cacq
+}
+
+// ConsistencyAuditCursorGroupBy is the group-by builder for ConsistencyAuditCursor entities.
+type ConsistencyAuditCursorGroupBy struct {
+	selector
+	build *ConsistencyAuditCursorQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cacgb *ConsistencyAuditCursorGroupBy) Aggregate(fns ...AggregateFunc) *ConsistencyAuditCursorGroupBy {
+	cacgb.fns = append(cacgb.fns, fns...)
+	return cacgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cacgb *ConsistencyAuditCursorGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cacgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cacgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConsistencyAuditCursorQuery, *ConsistencyAuditCursorGroupBy](ctx, cacgb.build, cacgb, cacgb.build.inters, v)
+}
+
+func (cacgb *ConsistencyAuditCursorGroupBy) sqlScan(ctx context.Context, root *ConsistencyAuditCursorQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cacgb.fns))
+	for _, fn := range cacgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cacgb.flds)+len(cacgb.fns))
+		for _, f := range *cacgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cacgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cacgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConsistencyAuditCursorSelect is the builder for selecting fields of ConsistencyAuditCursor entities.
+type ConsistencyAuditCursorSelect struct {
+	*ConsistencyAuditCursorQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cacs *ConsistencyAuditCursorSelect) Aggregate(fns ...AggregateFunc) *ConsistencyAuditCursorSelect {
+	cacs.fns = append(cacs.fns, fns...)
+	return cacs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cacs *ConsistencyAuditCursorSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cacs.ctx, ent.OpQuerySelect)
+	if err := cacs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConsistencyAuditCursorQuery, *ConsistencyAuditCursorSelect](ctx, cacs.ConsistencyAuditCursorQuery, cacs, cacs.inters, v)
+}
+
+func (cacs *ConsistencyAuditCursorSelect) sqlScan(ctx context.Context, root *ConsistencyAuditCursorQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cacs.fns))
+	for _, fn := range cacs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cacs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cacs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
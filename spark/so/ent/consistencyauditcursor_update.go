@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ConsistencyAuditCursorUpdate is the builder for updating ConsistencyAuditCursor entities.
+type ConsistencyAuditCursorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConsistencyAuditCursorMutation
+}
+
+// Where appends a list predicates to the ConsistencyAuditCursorUpdate builder.
+func (cacu *ConsistencyAuditCursorUpdate) Where(ps ...predicate.ConsistencyAuditCursor) *ConsistencyAuditCursorUpdate {
+	cacu.mutation.Where(ps...)
+	return cacu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cacu *ConsistencyAuditCursorUpdate) SetUpdateTime(t time.Time) *ConsistencyAuditCursorUpdate {
+	cacu.mutation.SetUpdateTime(t)
+	return cacu
+}
+
+// SetKey sets the "key" field.
+func (cacu *ConsistencyAuditCursorUpdate) SetKey(s string) *ConsistencyAuditCursorUpdate {
+	cacu.mutation.SetKey(s)
+	return cacu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (cacu *ConsistencyAuditCursorUpdate) SetNillableKey(s *string) *ConsistencyAuditCursorUpdate {
+	if s != nil {
+		cacu.SetKey(*s)
+	}
+	return cacu
+}
+
+// Mutation returns the ConsistencyAuditCursorMutation object of the builder.
+func (cacu *ConsistencyAuditCursorUpdate) Mutation() *ConsistencyAuditCursorMutation {
+	return cacu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cacu *ConsistencyAuditCursorUpdate) Save(ctx context.Context) (int, error) {
+	cacu.defaults()
+	return withHooks(ctx, cacu.sqlSave, cacu.mutation, cacu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cacu *ConsistencyAuditCursorUpdate) SaveX(ctx context.Context) int {
+	affected, err := cacu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cacu *ConsistencyAuditCursorUpdate) Exec(ctx context.Context) error {
+	_, err := cacu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacu *ConsistencyAuditCursorUpdate) ExecX(ctx context.Context) {
+	if err := cacu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cacu *ConsistencyAuditCursorUpdate) defaults() {
+	if _, ok := cacu.mutation.UpdateTime(); !ok {
+		v := consistencyauditcursor.UpdateDefaultUpdateTime()
+		cacu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (cacu *ConsistencyAuditCursorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(consistencyauditcursor.Table, consistencyauditcursor.Columns, sqlgraph.NewFieldSpec(consistencyauditcursor.FieldID, field.TypeUUID))
+	if ps := cacu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cacu.mutation.UpdateTime(); ok {
+		_spec.SetField(consistencyauditcursor.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := cacu.mutation.Key(); ok {
+		_spec.SetField(consistencyauditcursor.FieldKey, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cacu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consistencyauditcursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cacu.mutation.done = true
+	return n, nil
+}
+
+// ConsistencyAuditCursorUpdateOne is the builder for updating a single ConsistencyAuditCursor entity.
+type ConsistencyAuditCursorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConsistencyAuditCursorMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cacuo *ConsistencyAuditCursorUpdateOne) SetUpdateTime(t time.Time) *ConsistencyAuditCursorUpdateOne {
+	cacuo.mutation.SetUpdateTime(t)
+	return cacuo
+}
+
+// SetKey sets the "key" field.
+func (cacuo *ConsistencyAuditCursorUpdateOne) SetKey(s string) *ConsistencyAuditCursorUpdateOne {
+	cacuo.mutation.SetKey(s)
+	return cacuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (cacuo *ConsistencyAuditCursorUpdateOne) SetNillableKey(s *string) *ConsistencyAuditCursorUpdateOne {
+	if s != nil {
+		cacuo.SetKey(*s)
+	}
+	return cacuo
+}
+
+// Mutation returns the ConsistencyAuditCursorMutation object of the builder.
+func (cacuo *ConsistencyAuditCursorUpdateOne) Mutation() *ConsistencyAuditCursorMutation {
+	return cacuo.mutation
+}
+
+// Where appends a list predicates to the ConsistencyAuditCursorUpdate builder.
+func (cacuo *ConsistencyAuditCursorUpdateOne) Where(ps ...predicate.ConsistencyAuditCursor) *ConsistencyAuditCursorUpdateOne {
+	cacuo.mutation.Where(ps...)
+	return cacuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cacuo *ConsistencyAuditCursorUpdateOne) Select(field string, fields ...string) *ConsistencyAuditCursorUpdateOne {
+	cacuo.fields = append([]string{field}, fields...)
+	return cacuo
+}
+
+// Save executes the query and returns the updated ConsistencyAuditCursor entity.
+func (cacuo *ConsistencyAuditCursorUpdateOne) Save(ctx context.Context) (*ConsistencyAuditCursor, error) {
+	cacuo.defaults()
+	return withHooks(ctx, cacuo.sqlSave, cacuo.mutation, cacuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cacuo *ConsistencyAuditCursorUpdateOne) SaveX(ctx context.Context) *ConsistencyAuditCursor {
+	node, err := cacuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cacuo *ConsistencyAuditCursorUpdateOne) Exec(ctx context.Context) error {
+	_, err := cacuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacuo *ConsistencyAuditCursorUpdateOne) ExecX(ctx context.Context) {
+	if err := cacuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cacuo *ConsistencyAuditCursorUpdateOne) defaults() {
+	if _, ok := cacuo.mutation.UpdateTime(); !ok {
+		v := consistencyauditcursor.UpdateDefaultUpdateTime()
+		cacuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (cacuo *ConsistencyAuditCursorUpdateOne) sqlSave(ctx context.Context) (_node *ConsistencyAuditCursor, err error) {
+	_spec := sqlgraph.NewUpdateSpec(consistencyauditcursor.Table, consistencyauditcursor.Columns, sqlgraph.NewFieldSpec(consistencyauditcursor.FieldID, field.TypeUUID))
+	id, ok := cacuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConsistencyAuditCursor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cacuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consistencyauditcursor.FieldID)
+		for _, f := range fields {
+			if !consistencyauditcursor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != consistencyauditcursor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cacuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cacuo.mutation.UpdateTime(); ok {
+		_spec.SetField(consistencyauditcursor.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := cacuo.mutation.Key(); ok {
+		_spec.SetField(consistencyauditcursor.FieldKey, field.TypeString, value)
+	}
+	_node = &ConsistencyAuditCursor{config: cacuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cacuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consistencyauditcursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cacuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ConsistencyDiscrepancy is the model entity for the ConsistencyDiscrepancy schema.
+type ConsistencyDiscrepancy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind schema.ConsistencyKind `json:"kind,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Status holds the value of the "status" field.
+	Status schema.ConsistencyDiscrepancyStatus `json:"status,omitempty"`
+	// Digests holds the value of the "digests" field.
+	Digests map[string]string `json:"digests,omitempty"`
+	// DivergentOperators holds the value of the "divergent_operators" field.
+	DivergentOperators []string `json:"divergent_operators,omitempty"`
+	selectValues       sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConsistencyDiscrepancy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case consistencydiscrepancy.FieldDigests, consistencydiscrepancy.FieldDivergentOperators:
+			values[i] = new([]byte)
+		case consistencydiscrepancy.FieldKind, consistencydiscrepancy.FieldKey, consistencydiscrepancy.FieldStatus:
+			values[i] = new(sql.NullString)
+		case consistencydiscrepancy.FieldCreateTime, consistencydiscrepancy.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case consistencydiscrepancy.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConsistencyDiscrepancy fields.
+func (cd *ConsistencyDiscrepancy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case consistencydiscrepancy.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cd.ID = *value
+			}
+		case consistencydiscrepancy.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				cd.CreateTime = value.Time
+			}
+		case consistencydiscrepancy.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				cd.UpdateTime = value.Time
+			}
+		case consistencydiscrepancy.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				cd.Kind = schema.ConsistencyKind(value.String)
+			}
+		case consistencydiscrepancy.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				cd.Key = value.String
+			}
+		case consistencydiscrepancy.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				cd.Status = schema.ConsistencyDiscrepancyStatus(value.String)
+			}
+		case consistencydiscrepancy.FieldDigests:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field digests", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cd.Digests); err != nil {
+					return fmt.Errorf("unmarshal field digests: %w", err)
+				}
+			}
+		case consistencydiscrepancy.FieldDivergentOperators:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field divergent_operators", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cd.DivergentOperators); err != nil {
+					return fmt.Errorf("unmarshal field divergent_operators: %w", err)
+				}
+			}
+		default:
+			cd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConsistencyDiscrepancy.
+// This includes values selected through modifiers, order, etc.
+func (cd *ConsistencyDiscrepancy) Value(name string) (ent.Value, error) {
+	return cd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConsistencyDiscrepancy.
+// Note that you need to call ConsistencyDiscrepancy.Unwrap() before calling this method if this ConsistencyDiscrepancy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cd *ConsistencyDiscrepancy) Update() *ConsistencyDiscrepancyUpdateOne {
+	return NewConsistencyDiscrepancyClient(cd.config).UpdateOne(cd)
+}
+
+// Unwrap unwraps the ConsistencyDiscrepancy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cd *ConsistencyDiscrepancy) Unwrap() *ConsistencyDiscrepancy {
+	_tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConsistencyDiscrepancy is not a transactional entity")
+	}
+	cd.config.driver = _tx.drv
+	return cd
+}
+
+// String implements the fmt.Stringer.
+func (cd *ConsistencyDiscrepancy) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConsistencyDiscrepancy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cd.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(cd.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(cd.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(cd.Key)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Status))
+	builder.WriteString(", ")
+	builder.WriteString("digests=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Digests))
+	builder.WriteString(", ")
+	builder.WriteString("divergent_operators=")
+	builder.WriteString(fmt.Sprintf("%v", cd.DivergentOperators))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConsistencyDiscrepancies is a parsable slice of ConsistencyDiscrepancy.
+type ConsistencyDiscrepancies []*ConsistencyDiscrepancy
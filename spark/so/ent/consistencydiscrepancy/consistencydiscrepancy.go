@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package consistencydiscrepancy
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+const (
+	// Label holds the string label denoting the consistencydiscrepancy type in the database.
+	Label = "consistency_discrepancy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDigests holds the string denoting the digests field in the database.
+	FieldDigests = "digests"
+	// FieldDivergentOperators holds the string denoting the divergent_operators field in the database.
+	FieldDivergentOperators = "divergent_operators"
+	// Table holds the table name of the consistencydiscrepancy in the database.
+	Table = "consistency_discrepancies"
+)
+
+// Columns holds all SQL columns for consistencydiscrepancy fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldKind,
+	FieldKey,
+	FieldStatus,
+	FieldDigests,
+	FieldDivergentOperators,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k schema.ConsistencyKind) error {
+	switch k {
+	case "TREE", "TRANSFER", "TOKEN_OUTPUT":
+		return nil
+	default:
+		return fmt.Errorf("consistencydiscrepancy: invalid enum value for kind field: %q", k)
+	}
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s schema.ConsistencyDiscrepancyStatus) error {
+	switch s {
+	case "OPEN", "RESOLVED", "REPAIRED":
+		return nil
+	default:
+		return fmt.Errorf("consistencydiscrepancy: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ConsistencyDiscrepancy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
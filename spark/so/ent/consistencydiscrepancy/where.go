@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package consistencydiscrepancy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldEQ(FieldKey, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v schema.ConsistencyKind) predicate.ConsistencyDiscrepancy {
+	vc := v
+	return predicate.ConsistencyDiscrepancy(sql.FieldEQ(FieldKind, vc))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v schema.ConsistencyKind) predicate.ConsistencyDiscrepancy {
+	vc := v
+	return predicate.ConsistencyDiscrepancy(sql.FieldNEQ(FieldKind, vc))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...schema.ConsistencyKind) predicate.ConsistencyDiscrepancy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsistencyDiscrepancy(sql.FieldIn(FieldKind, v...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...schema.ConsistencyKind) predicate.ConsistencyDiscrepancy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsistencyDiscrepancy(sql.FieldNotIn(FieldKind, v...))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.FieldContainsFold(FieldKey, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v schema.ConsistencyDiscrepancyStatus) predicate.ConsistencyDiscrepancy {
+	vc := v
+	return predicate.ConsistencyDiscrepancy(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v schema.ConsistencyDiscrepancyStatus) predicate.ConsistencyDiscrepancy {
+	vc := v
+	return predicate.ConsistencyDiscrepancy(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...schema.ConsistencyDiscrepancyStatus) predicate.ConsistencyDiscrepancy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsistencyDiscrepancy(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...schema.ConsistencyDiscrepancyStatus) predicate.ConsistencyDiscrepancy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ConsistencyDiscrepancy(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConsistencyDiscrepancy) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConsistencyDiscrepancy) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConsistencyDiscrepancy) predicate.ConsistencyDiscrepancy {
+	return predicate.ConsistencyDiscrepancy(sql.NotPredicates(p))
+}
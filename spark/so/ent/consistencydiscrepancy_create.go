@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ConsistencyDiscrepancyCreate is the builder for creating a ConsistencyDiscrepancy entity.
+type ConsistencyDiscrepancyCreate struct {
+	config
+	mutation *ConsistencyDiscrepancyMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (cdc *ConsistencyDiscrepancyCreate) SetCreateTime(t time.Time) *ConsistencyDiscrepancyCreate {
+	cdc.mutation.SetCreateTime(t)
+	return cdc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (cdc *ConsistencyDiscrepancyCreate) SetNillableCreateTime(t *time.Time) *ConsistencyDiscrepancyCreate {
+	if t != nil {
+		cdc.SetCreateTime(*t)
+	}
+	return cdc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cdc *ConsistencyDiscrepancyCreate) SetUpdateTime(t time.Time) *ConsistencyDiscrepancyCreate {
+	cdc.mutation.SetUpdateTime(t)
+	return cdc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (cdc *ConsistencyDiscrepancyCreate) SetNillableUpdateTime(t *time.Time) *ConsistencyDiscrepancyCreate {
+	if t != nil {
+		cdc.SetUpdateTime(*t)
+	}
+	return cdc
+}
+
+// SetKind sets the "kind" field.
+func (cdc *ConsistencyDiscrepancyCreate) SetKind(sk schema.ConsistencyKind) *ConsistencyDiscrepancyCreate {
+	cdc.mutation.SetKind(sk)
+	return cdc
+}
+
+// SetKey sets the "key" field.
+func (cdc *ConsistencyDiscrepancyCreate) SetKey(s string) *ConsistencyDiscrepancyCreate {
+	cdc.mutation.SetKey(s)
+	return cdc
+}
+
+// SetStatus sets the "status" field.
+func (cdc *ConsistencyDiscrepancyCreate) SetStatus(sds schema.ConsistencyDiscrepancyStatus) *ConsistencyDiscrepancyCreate {
+	cdc.mutation.SetStatus(sds)
+	return cdc
+}
+
+// SetDigests sets the "digests" field.
+func (cdc *ConsistencyDiscrepancyCreate) SetDigests(m map[string]string) *ConsistencyDiscrepancyCreate {
+	cdc.mutation.SetDigests(m)
+	return cdc
+}
+
+// SetDivergentOperators sets the "divergent_operators" field.
+func (cdc *ConsistencyDiscrepancyCreate) SetDivergentOperators(s []string) *ConsistencyDiscrepancyCreate {
+	cdc.mutation.SetDivergentOperators(s)
+	return cdc
+}
+
+// SetID sets the "id" field.
+func (cdc *ConsistencyDiscrepancyCreate) SetID(u uuid.UUID) *ConsistencyDiscrepancyCreate {
+	cdc.mutation.SetID(u)
+	return cdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cdc *ConsistencyDiscrepancyCreate) SetNillableID(u *uuid.UUID) *ConsistencyDiscrepancyCreate {
+	if u != nil {
+		cdc.SetID(*u)
+	}
+	return cdc
+}
+
+// Mutation returns the ConsistencyDiscrepancyMutation object of the builder.
+func (cdc *ConsistencyDiscrepancyCreate) Mutation() *ConsistencyDiscrepancyMutation {
+	return cdc.mutation
+}
+
+// Save creates the ConsistencyDiscrepancy in the database.
+func (cdc *ConsistencyDiscrepancyCreate) Save(ctx context.Context) (*ConsistencyDiscrepancy, error) {
+	cdc.defaults()
+	return withHooks(ctx, cdc.sqlSave, cdc.mutation, cdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cdc *ConsistencyDiscrepancyCreate) SaveX(ctx context.Context) *ConsistencyDiscrepancy {
+	v, err := cdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdc *ConsistencyDiscrepancyCreate) Exec(ctx context.Context) error {
+	_, err := cdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdc *ConsistencyDiscrepancyCreate) ExecX(ctx context.Context) {
+	if err := cdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cdc *ConsistencyDiscrepancyCreate) defaults() {
+	if _, ok := cdc.mutation.CreateTime(); !ok {
+		v := consistencydiscrepancy.DefaultCreateTime()
+		cdc.mutation.SetCreateTime(v)
+	}
+	if _, ok := cdc.mutation.UpdateTime(); !ok {
+		v := consistencydiscrepancy.DefaultUpdateTime()
+		cdc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := cdc.mutation.ID(); !ok {
+		v := consistencydiscrepancy.DefaultID()
+		cdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdc *ConsistencyDiscrepancyCreate) check() error {
+	if _, ok := cdc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ConsistencyDiscrepancy.create_time"`)}
+	}
+	if _, ok := cdc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ConsistencyDiscrepancy.update_time"`)}
+	}
+	if _, ok := cdc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ConsistencyDiscrepancy.kind"`)}
+	}
+	if v, ok := cdc.mutation.Kind(); ok {
+		if err := consistencydiscrepancy.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ConsistencyDiscrepancy.kind": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ConsistencyDiscrepancy.key"`)}
+	}
+	if _, ok := cdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ConsistencyDiscrepancy.status"`)}
+	}
+	if v, ok := cdc.mutation.Status(); ok {
+		if err := consistencydiscrepancy.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConsistencyDiscrepancy.status": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.Digests(); !ok {
+		return &ValidationError{Name: "digests", err: errors.New(`ent: missing required field "ConsistencyDiscrepancy.digests"`)}
+	}
+	if _, ok := cdc.mutation.DivergentOperators(); !ok {
+		return &ValidationError{Name: "divergent_operators", err: errors.New(`ent: missing required field "ConsistencyDiscrepancy.divergent_operators"`)}
+	}
+	return nil
+}
+
+func (cdc *ConsistencyDiscrepancyCreate) sqlSave(ctx context.Context) (*ConsistencyDiscrepancy, error) {
+	if err := cdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cdc.mutation.id = &_node.ID
+	cdc.mutation.done = true
+	return _node, nil
+}
+
+func (cdc *ConsistencyDiscrepancyCreate) createSpec() (*ConsistencyDiscrepancy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConsistencyDiscrepancy{config: cdc.config}
+		_spec = sqlgraph.NewCreateSpec(consistencydiscrepancy.Table, sqlgraph.NewFieldSpec(consistencydiscrepancy.FieldID, field.TypeUUID))
+	)
+	if id, ok := cdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cdc.mutation.CreateTime(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := cdc.mutation.UpdateTime(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := cdc.mutation.Kind(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := cdc.mutation.Key(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := cdc.mutation.Status(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := cdc.mutation.Digests(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldDigests, field.TypeJSON, value)
+		_node.Digests = value
+	}
+	if value, ok := cdc.mutation.DivergentOperators(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldDivergentOperators, field.TypeJSON, value)
+		_node.DivergentOperators = value
+	}
+	return _node, _spec
+}
+
+// ConsistencyDiscrepancyCreateBulk is the builder for creating many ConsistencyDiscrepancy entities in bulk.
+type ConsistencyDiscrepancyCreateBulk struct {
+	config
+	err      error
+	builders []*ConsistencyDiscrepancyCreate
+}
+
+// Save creates the ConsistencyDiscrepancy entities in the database.
+func (cdcb *ConsistencyDiscrepancyCreateBulk) Save(ctx context.Context) ([]*ConsistencyDiscrepancy, error) {
+	if cdcb.err != nil {
+		return nil, cdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cdcb.builders))
+	nodes := make([]*ConsistencyDiscrepancy, len(cdcb.builders))
+	mutators := make([]Mutator, len(cdcb.builders))
+	for i := range cdcb.builders {
+		func(i int, root context.Context) {
+			builder := cdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsistencyDiscrepancyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdcb *ConsistencyDiscrepancyCreateBulk) SaveX(ctx context.Context) []*ConsistencyDiscrepancy {
+	v, err := cdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdcb *ConsistencyDiscrepancyCreateBulk) Exec(ctx context.Context) error {
+	_, err := cdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdcb *ConsistencyDiscrepancyCreateBulk) ExecX(ctx context.Context) {
+	if err := cdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ConsistencyDiscrepancyDelete is the builder for deleting a ConsistencyDiscrepancy entity.
+type ConsistencyDiscrepancyDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConsistencyDiscrepancyMutation
+}
+
+// Where appends a list predicates to the ConsistencyDiscrepancyDelete builder.
+func (cdd *ConsistencyDiscrepancyDelete) Where(ps ...predicate.ConsistencyDiscrepancy) *ConsistencyDiscrepancyDelete {
+	cdd.mutation.Where(ps...)
+	return cdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cdd *ConsistencyDiscrepancyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdd.sqlExec, cdd.mutation, cdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdd *ConsistencyDiscrepancyDelete) ExecX(ctx context.Context) int {
+	n, err := cdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cdd *ConsistencyDiscrepancyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(consistencydiscrepancy.Table, sqlgraph.NewFieldSpec(consistencydiscrepancy.FieldID, field.TypeUUID))
+	if ps := cdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cdd.mutation.done = true
+	return affected, err
+}
+
+// ConsistencyDiscrepancyDeleteOne is the builder for deleting a single ConsistencyDiscrepancy entity.
+type ConsistencyDiscrepancyDeleteOne struct {
+	cdd *ConsistencyDiscrepancyDelete
+}
+
+// Where appends a list predicates to the ConsistencyDiscrepancyDelete builder.
+func (cddo *ConsistencyDiscrepancyDeleteOne) Where(ps ...predicate.ConsistencyDiscrepancy) *ConsistencyDiscrepancyDeleteOne {
+	cddo.cdd.mutation.Where(ps...)
+	return cddo
+}
+
+// Exec executes the deletion query.
+func (cddo *ConsistencyDiscrepancyDeleteOne) Exec(ctx context.Context) error {
+	n, err := cddo.cdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{consistencydiscrepancy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cddo *ConsistencyDiscrepancyDeleteOne) ExecX(ctx context.Context) {
+	if err := cddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
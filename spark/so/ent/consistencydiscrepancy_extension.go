@@ -0,0 +1,20 @@
+package ent
+
+import (
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MarshalProto converts a ConsistencyDiscrepancy to a spark admin protobuf ConsistencyDiscrepancy.
+func (d *ConsistencyDiscrepancy) MarshalProto() *pbadmin.ConsistencyDiscrepancy {
+	return &pbadmin.ConsistencyDiscrepancy{
+		Id:                 d.ID.String(),
+		Kind:               string(d.Kind),
+		Key:                d.Key,
+		Status:             string(d.Status),
+		Digests:            d.Digests,
+		DivergentOperators: d.DivergentOperators,
+		CreateTime:         timestamppb.New(d.CreateTime),
+		UpdateTime:         timestamppb.New(d.UpdateTime),
+	}
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ConsistencyDiscrepancyQuery is the builder for querying ConsistencyDiscrepancy entities.
+type ConsistencyDiscrepancyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []consistencydiscrepancy.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ConsistencyDiscrepancy
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConsistencyDiscrepancyQuery builder.
+func (cdq *ConsistencyDiscrepancyQuery) Where(ps ...predicate.ConsistencyDiscrepancy) *ConsistencyDiscrepancyQuery {
+	cdq.predicates = append(cdq.predicates, ps...)
+	return cdq
+}
+
+// Limit the number of records to be returned by this query.
+func (cdq *ConsistencyDiscrepancyQuery) Limit(limit int) *ConsistencyDiscrepancyQuery {
+	cdq.ctx.Limit = &limit
+	return cdq
+}
+
+// Offset to start from.
+func (cdq *ConsistencyDiscrepancyQuery) Offset(offset int) *ConsistencyDiscrepancyQuery {
+	cdq.ctx.Offset = &offset
+	return cdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cdq *ConsistencyDiscrepancyQuery) Unique(unique bool) *ConsistencyDiscrepancyQuery {
+	cdq.ctx.Unique = &unique
+	return cdq
+}
+
+// Order specifies how the records should be ordered.
+func (cdq *ConsistencyDiscrepancyQuery) Order(o ...consistencydiscrepancy.OrderOption) *ConsistencyDiscrepancyQuery {
+	cdq.order = append(cdq.order, o...)
+	return cdq
+}
+
+// First returns the first ConsistencyDiscrepancy entity from the query.
+// Returns a *NotFoundError when no ConsistencyDiscrepancy was found.
+func (cdq *ConsistencyDiscrepancyQuery) First(ctx context.Context) (*ConsistencyDiscrepancy, error) {
+	nodes, err := cdq.Limit(1).All(setContextOp(ctx, cdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{consistencydiscrepancy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cdq *ConsistencyDiscrepancyQuery) FirstX(ctx context.Context) *ConsistencyDiscrepancy {
+	node, err := cdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConsistencyDiscrepancy ID from the query.
+// Returns a *NotFoundError when no ConsistencyDiscrepancy ID was found.
+func (cdq *ConsistencyDiscrepancyQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cdq.Limit(1).IDs(setContextOp(ctx, cdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{consistencydiscrepancy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cdq *ConsistencyDiscrepancyQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConsistencyDiscrepancy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConsistencyDiscrepancy entity is found.
+// Returns a *NotFoundError when no ConsistencyDiscrepancy entities are found.
+func (cdq *ConsistencyDiscrepancyQuery) Only(ctx context.Context) (*ConsistencyDiscrepancy, error) {
+	nodes, err := cdq.Limit(2).All(setContextOp(ctx, cdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{consistencydiscrepancy.Label}
+	default:
+		return nil, &NotSingularError{consistencydiscrepancy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cdq *ConsistencyDiscrepancyQuery) OnlyX(ctx context.Context) *ConsistencyDiscrepancy {
+	node, err := cdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConsistencyDiscrepancy ID in the query.
+// Returns a *NotSingularError when more than one ConsistencyDiscrepancy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cdq *ConsistencyDiscrepancyQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cdq.Limit(2).IDs(setContextOp(ctx, cdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{consistencydiscrepancy.Label}
+	default:
+		err = &NotSingularError{consistencydiscrepancy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cdq *ConsistencyDiscrepancyQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConsistencyDiscrepancies.
+func (cdq *ConsistencyDiscrepancyQuery) All(ctx context.Context) ([]*ConsistencyDiscrepancy, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryAll)
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConsistencyDiscrepancy, *ConsistencyDiscrepancyQuery]()
+	return withInterceptors[[]*ConsistencyDiscrepancy](ctx, cdq, qr, cdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cdq *ConsistencyDiscrepancyQuery) AllX(ctx context.Context) []*ConsistencyDiscrepancy {
+	nodes, err := cdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConsistencyDiscrepancy IDs.
+func (cdq *ConsistencyDiscrepancyQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if cdq.ctx.Unique == nil && cdq.path != nil {
+		cdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryIDs)
+	if err = cdq.Select(consistencydiscrepancy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cdq *ConsistencyDiscrepancyQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cdq *ConsistencyDiscrepancyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryCount)
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cdq, querierCount[*ConsistencyDiscrepancyQuery](), cdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cdq *ConsistencyDiscrepancyQuery) CountX(ctx context.Context) int {
+	count, err := cdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cdq *ConsistencyDiscrepancyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryExist)
+	switch _, err := cdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cdq *ConsistencyDiscrepancyQuery) ExistX(ctx context.Context) bool {
+	exist, err := cdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConsistencyDiscrepancyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cdq *ConsistencyDiscrepancyQuery) Clone() *ConsistencyDiscrepancyQuery {
+	if cdq == nil {
+		return nil
+	}
+	return &ConsistencyDiscrepancyQuery{
+		config:     cdq.config,
+		ctx:        cdq.ctx.Clone(),
+		order:      append([]consistencydiscrepancy.OrderOption{}, cdq.order...),
+		inters:     append([]Interceptor{}, cdq.inters...),
+		predicates: append([]predicate.ConsistencyDiscrepancy{}, cdq.predicates...),
+		// clone intermediate query.
+		sql:  cdq.sql.Clone(),
+		path: cdq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConsistencyDiscrepancy.Query().
+//		GroupBy(consistencydiscrepancy.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cdq *ConsistencyDiscrepancyQuery) GroupBy(field string, fields ...string) *ConsistencyDiscrepancyGroupBy {
+	cdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConsistencyDiscrepancyGroupBy{build: cdq}
+	grbuild.flds = &cdq.ctx.Fields
+	grbuild.label = consistencydiscrepancy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ConsistencyDiscrepancy.Query().
+//		Select(consistencydiscrepancy.FieldCreateTime).
+//		Scan(ctx, &v)
+func (cdq *ConsistencyDiscrepancyQuery) Select(fields ...string) *ConsistencyDiscrepancySelect {
+	cdq.ctx.Fields = append(cdq.ctx.Fields, fields...)
+	sbuild := &ConsistencyDiscrepancySelect{ConsistencyDiscrepancyQuery: cdq}
+	sbuild.label = consistencydiscrepancy.Label
+	sbuild.flds, sbuild.scan = &cdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConsistencyDiscrepancySelect configured with the given aggregations.
+func (cdq *ConsistencyDiscrepancyQuery) Aggregate(fns ...AggregateFunc) *ConsistencyDiscrepancySelect {
+	return cdq.Select().Aggregate(fns...)
+}
+
+func (cdq *ConsistencyDiscrepancyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cdq.ctx.Fields {
+		if !consistencydiscrepancy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cdq.path != nil {
+		prev, err := cdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cdq.sql = prev
+	}
+	return nil
+}
+
+func (cdq *ConsistencyDiscrepancyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConsistencyDiscrepancy, error) {
+	var (
+		nodes = []*ConsistencyDiscrepancy{}
+		_spec = cdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConsistencyDiscrepancy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConsistencyDiscrepancy{config: cdq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(cdq.modifiers) > 0 {
+		_spec.Modifiers = cdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (cdq *ConsistencyDiscrepancyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cdq.querySpec()
+	if len(cdq.modifiers) > 0 {
+		_spec.Modifiers = cdq.modifiers
+	}
+	_spec.Node.Columns = cdq.ctx.Fields
+	if len(cdq.ctx.Fields) > 0 {
+		_spec.Unique = cdq.ctx.Unique != nil && *cdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cdq.driver, _spec)
+}
+
+func (cdq *ConsistencyDiscrepancyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(consistencydiscrepancy.Table, consistencydiscrepancy.Columns, sqlgraph.NewFieldSpec(consistencydiscrepancy.FieldID, field.TypeUUID))
+	_spec.From = cdq.sql
+	if unique := cdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consistencydiscrepancy.FieldID)
+		for i := range fields {
+			if fields[i] != consistencydiscrepancy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cdq *ConsistencyDiscrepancyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cdq.driver.Dialect())
+	t1 := builder.Table(consistencydiscrepancy.Table)
+	columns := cdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = consistencydiscrepancy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cdq.sql != nil {
+		selector = cdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cdq.ctx.Unique != nil && *cdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range cdq.modifiers {
+		m(selector)
+	}
+	for _, p := range cdq.predicates {
+		p(selector)
+	}
+	for _, p := range cdq.order {
+		p(selector)
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cdq *ConsistencyDiscrepancyQuery) ForUpdate(opts ...sql.LockOption) *ConsistencyDiscrepancyQuery {
+	if cdq.driver.Dialect() == dialect.Postgres {
+		cdq.Unique(false)
+	}
+	cdq.modifiers = append(cdq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cdq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cdq *ConsistencyDiscrepancyQuery) ForShare(opts ...sql.LockOption) *ConsistencyDiscrepancyQuery {
+	if cdq.driver.Dialect() == dialect.Postgres {
+		cdq.Unique(false)
+	}
+	cdq.modifiers = append(cdq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cdq
+}
+
+// ConsistencyDiscrepancyGroupBy is the group-by builder for ConsistencyDiscrepancy entities.
+type ConsistencyDiscrepancyGroupBy struct {
+	selector
+	build *ConsistencyDiscrepancyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cdgb *ConsistencyDiscrepancyGroupBy) Aggregate(fns ...AggregateFunc) *ConsistencyDiscrepancyGroupBy {
+	cdgb.fns = append(cdgb.fns, fns...)
+	return cdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cdgb *ConsistencyDiscrepancyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConsistencyDiscrepancyQuery, *ConsistencyDiscrepancyGroupBy](ctx, cdgb.build, cdgb, cdgb.build.inters, v)
+}
+
+func (cdgb *ConsistencyDiscrepancyGroupBy) sqlScan(ctx context.Context, root *ConsistencyDiscrepancyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cdgb.fns))
+	for _, fn := range cdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cdgb.flds)+len(cdgb.fns))
+		for _, f := range *cdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConsistencyDiscrepancySelect is the builder for selecting fields of ConsistencyDiscrepancy entities.
+type ConsistencyDiscrepancySelect struct {
+	*ConsistencyDiscrepancyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cds *ConsistencyDiscrepancySelect) Aggregate(fns ...AggregateFunc) *ConsistencyDiscrepancySelect {
+	cds.fns = append(cds.fns, fns...)
+	return cds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cds *ConsistencyDiscrepancySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cds.ctx, ent.OpQuerySelect)
+	if err := cds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConsistencyDiscrepancyQuery, *ConsistencyDiscrepancySelect](ctx, cds.ConsistencyDiscrepancyQuery, cds, cds.inters, v)
+}
+
+func (cds *ConsistencyDiscrepancySelect) sqlScan(ctx context.Context, root *ConsistencyDiscrepancyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cds.fns))
+	for _, fn := range cds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
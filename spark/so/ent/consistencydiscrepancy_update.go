@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ConsistencyDiscrepancyUpdate is the builder for updating ConsistencyDiscrepancy entities.
+type ConsistencyDiscrepancyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConsistencyDiscrepancyMutation
+}
+
+// Where appends a list predicates to the ConsistencyDiscrepancyUpdate builder.
+func (cdu *ConsistencyDiscrepancyUpdate) Where(ps ...predicate.ConsistencyDiscrepancy) *ConsistencyDiscrepancyUpdate {
+	cdu.mutation.Where(ps...)
+	return cdu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cdu *ConsistencyDiscrepancyUpdate) SetUpdateTime(t time.Time) *ConsistencyDiscrepancyUpdate {
+	cdu.mutation.SetUpdateTime(t)
+	return cdu
+}
+
+// SetStatus sets the "status" field.
+func (cdu *ConsistencyDiscrepancyUpdate) SetStatus(sds schema.ConsistencyDiscrepancyStatus) *ConsistencyDiscrepancyUpdate {
+	cdu.mutation.SetStatus(sds)
+	return cdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cdu *ConsistencyDiscrepancyUpdate) SetNillableStatus(sds *schema.ConsistencyDiscrepancyStatus) *ConsistencyDiscrepancyUpdate {
+	if sds != nil {
+		cdu.SetStatus(*sds)
+	}
+	return cdu
+}
+
+// SetDigests sets the "digests" field.
+func (cdu *ConsistencyDiscrepancyUpdate) SetDigests(m map[string]string) *ConsistencyDiscrepancyUpdate {
+	cdu.mutation.SetDigests(m)
+	return cdu
+}
+
+// SetDivergentOperators sets the "divergent_operators" field.
+func (cdu *ConsistencyDiscrepancyUpdate) SetDivergentOperators(s []string) *ConsistencyDiscrepancyUpdate {
+	cdu.mutation.SetDivergentOperators(s)
+	return cdu
+}
+
+// AppendDivergentOperators appends s to the "divergent_operators" field.
+func (cdu *ConsistencyDiscrepancyUpdate) AppendDivergentOperators(s []string) *ConsistencyDiscrepancyUpdate {
+	cdu.mutation.AppendDivergentOperators(s)
+	return cdu
+}
+
+// Mutation returns the ConsistencyDiscrepancyMutation object of the builder.
+func (cdu *ConsistencyDiscrepancyUpdate) Mutation() *ConsistencyDiscrepancyMutation {
+	return cdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cdu *ConsistencyDiscrepancyUpdate) Save(ctx context.Context) (int, error) {
+	cdu.defaults()
+	return withHooks(ctx, cdu.sqlSave, cdu.mutation, cdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdu *ConsistencyDiscrepancyUpdate) SaveX(ctx context.Context) int {
+	affected, err := cdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cdu *ConsistencyDiscrepancyUpdate) Exec(ctx context.Context) error {
+	_, err := cdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdu *ConsistencyDiscrepancyUpdate) ExecX(ctx context.Context) {
+	if err := cdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cdu *ConsistencyDiscrepancyUpdate) defaults() {
+	if _, ok := cdu.mutation.UpdateTime(); !ok {
+		v := consistencydiscrepancy.UpdateDefaultUpdateTime()
+		cdu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdu *ConsistencyDiscrepancyUpdate) check() error {
+	if v, ok := cdu.mutation.Status(); ok {
+		if err := consistencydiscrepancy.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConsistencyDiscrepancy.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cdu *ConsistencyDiscrepancyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(consistencydiscrepancy.Table, consistencydiscrepancy.Columns, sqlgraph.NewFieldSpec(consistencydiscrepancy.FieldID, field.TypeUUID))
+	if ps := cdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cdu.mutation.UpdateTime(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := cdu.mutation.Status(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cdu.mutation.Digests(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldDigests, field.TypeJSON, value)
+	}
+	if value, ok := cdu.mutation.DivergentOperators(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldDivergentOperators, field.TypeJSON, value)
+	}
+	if value, ok := cdu.mutation.AppendedDivergentOperators(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, consistencydiscrepancy.FieldDivergentOperators, value)
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consistencydiscrepancy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cdu.mutation.done = true
+	return n, nil
+}
+
+// ConsistencyDiscrepancyUpdateOne is the builder for updating a single ConsistencyDiscrepancy entity.
+type ConsistencyDiscrepancyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConsistencyDiscrepancyMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cduo *ConsistencyDiscrepancyUpdateOne) SetUpdateTime(t time.Time) *ConsistencyDiscrepancyUpdateOne {
+	cduo.mutation.SetUpdateTime(t)
+	return cduo
+}
+
+// SetStatus sets the "status" field.
+func (cduo *ConsistencyDiscrepancyUpdateOne) SetStatus(sds schema.ConsistencyDiscrepancyStatus) *ConsistencyDiscrepancyUpdateOne {
+	cduo.mutation.SetStatus(sds)
+	return cduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cduo *ConsistencyDiscrepancyUpdateOne) SetNillableStatus(sds *schema.ConsistencyDiscrepancyStatus) *ConsistencyDiscrepancyUpdateOne {
+	if sds != nil {
+		cduo.SetStatus(*sds)
+	}
+	return cduo
+}
+
+// SetDigests sets the "digests" field.
+func (cduo *ConsistencyDiscrepancyUpdateOne) SetDigests(m map[string]string) *ConsistencyDiscrepancyUpdateOne {
+	cduo.mutation.SetDigests(m)
+	return cduo
+}
+
+// SetDivergentOperators sets the "divergent_operators" field.
+func (cduo *ConsistencyDiscrepancyUpdateOne) SetDivergentOperators(s []string) *ConsistencyDiscrepancyUpdateOne {
+	cduo.mutation.SetDivergentOperators(s)
+	return cduo
+}
+
+// AppendDivergentOperators appends s to the "divergent_operators" field.
+func (cduo *ConsistencyDiscrepancyUpdateOne) AppendDivergentOperators(s []string) *ConsistencyDiscrepancyUpdateOne {
+	cduo.mutation.AppendDivergentOperators(s)
+	return cduo
+}
+
+// Mutation returns the ConsistencyDiscrepancyMutation object of the builder.
+func (cduo *ConsistencyDiscrepancyUpdateOne) Mutation() *ConsistencyDiscrepancyMutation {
+	return cduo.mutation
+}
+
+// Where appends a list predicates to the ConsistencyDiscrepancyUpdate builder.
+func (cduo *ConsistencyDiscrepancyUpdateOne) Where(ps ...predicate.ConsistencyDiscrepancy) *ConsistencyDiscrepancyUpdateOne {
+	cduo.mutation.Where(ps...)
+	return cduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cduo *ConsistencyDiscrepancyUpdateOne) Select(field string, fields ...string) *ConsistencyDiscrepancyUpdateOne {
+	cduo.fields = append([]string{field}, fields...)
+	return cduo
+}
+
+// Save executes the query and returns the updated ConsistencyDiscrepancy entity.
+func (cduo *ConsistencyDiscrepancyUpdateOne) Save(ctx context.Context) (*ConsistencyDiscrepancy, error) {
+	cduo.defaults()
+	return withHooks(ctx, cduo.sqlSave, cduo.mutation, cduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cduo *ConsistencyDiscrepancyUpdateOne) SaveX(ctx context.Context) *ConsistencyDiscrepancy {
+	node, err := cduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cduo *ConsistencyDiscrepancyUpdateOne) Exec(ctx context.Context) error {
+	_, err := cduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cduo *ConsistencyDiscrepancyUpdateOne) ExecX(ctx context.Context) {
+	if err := cduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cduo *ConsistencyDiscrepancyUpdateOne) defaults() {
+	if _, ok := cduo.mutation.UpdateTime(); !ok {
+		v := consistencydiscrepancy.UpdateDefaultUpdateTime()
+		cduo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cduo *ConsistencyDiscrepancyUpdateOne) check() error {
+	if v, ok := cduo.mutation.Status(); ok {
+		if err := consistencydiscrepancy.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConsistencyDiscrepancy.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cduo *ConsistencyDiscrepancyUpdateOne) sqlSave(ctx context.Context) (_node *ConsistencyDiscrepancy, err error) {
+	if err := cduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(consistencydiscrepancy.Table, consistencydiscrepancy.Columns, sqlgraph.NewFieldSpec(consistencydiscrepancy.FieldID, field.TypeUUID))
+	id, ok := cduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConsistencyDiscrepancy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consistencydiscrepancy.FieldID)
+		for _, f := range fields {
+			if !consistencydiscrepancy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != consistencydiscrepancy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cduo.mutation.UpdateTime(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := cduo.mutation.Status(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cduo.mutation.Digests(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldDigests, field.TypeJSON, value)
+	}
+	if value, ok := cduo.mutation.DivergentOperators(); ok {
+		_spec.SetField(consistencydiscrepancy.FieldDivergentOperators, field.TypeJSON, value)
+	}
+	if value, ok := cduo.mutation.AppendedDivergentOperators(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, consistencydiscrepancy.FieldDivergentOperators, value)
+		})
+	}
+	_node = &ConsistencyDiscrepancy{config: cduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consistencydiscrepancy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cduo.mutation.done = true
+	return _node, nil
+}
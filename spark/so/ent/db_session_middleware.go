@@ -14,24 +14,40 @@ type ContextKey string
 // TxKey is the context key for the database transaction.
 const TxKey ContextKey = "tx"
 
+// ClientKey is the context key for the database client the transaction was started from.
+const ClientKey ContextKey = "client"
+
 // ErrNoRollback is an error indicating that we should not rollback the DB transaction.
 var ErrNoRollback = errors.New("no rollback performed")
 
-// DbSessionMiddleware is a middleware to manage database sessions for each gRPC call.
-func DbSessionMiddleware(dbClient *Client) grpc.UnaryServerInterceptor {
+// DbSessionMiddleware is a middleware to manage database sessions for each gRPC call. With a read
+// replica, read-only methods run in a read-only transaction on the replica when it is fresh enough.
+// It must run after the authentication interceptor, for reads to follow the caller's writes.
+func DbSessionMiddleware(dbClient *Client, replica *ReadReplica) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		if info != nil && info.FullMethod == "/grpc.health.v1.Health/Check" {
 			return handler(ctx, req)
 		}
+		var fullMethod string
+		if info != nil {
+			fullMethod = info.FullMethod
+		}
 
 		// Start a transaction or session
-		tx, err := dbClient.Tx(ctx)
+		var tx *Tx
+		var err error
+		if replica.route(ctx, dbClient, fullMethod) {
+			tx, err = replica.beginReadOnlyTx(ctx)
+		} else {
+			tx, err = dbClient.Tx(ctx)
+		}
 		if err != nil {
 			return nil, err
 		}
 
 		// Attach the transaction to the context
 		ctx = context.WithValue(ctx, TxKey, tx)
+		ctx = context.WithValue(ctx, ClientKey, dbClient)
 		// Ensure rollback on panic
 		defer func() {
 			if r := recover(); r != nil {
@@ -53,6 +69,11 @@ func DbSessionMiddleware(dbClient *Client) grpc.UnaryServerInterceptor {
 			return nil, err
 		}
 
+		if dberr := replica.recordWrite(ctx, tx, fullMethod); dberr != nil {
+			logger.Error("Failed to record write for the read replica", "error", dberr)
+			_ = tx.Rollback()
+			return nil, dberr
+		}
 		if dberr := tx.Commit(); dberr != nil {
 			logger.Error("Failed to commit transaction", "error", dberr)
 			return nil, dberr
@@ -71,3 +92,11 @@ func DbSessionMiddleware(dbClient *Client) grpc.UnaryServerInterceptor {
 func GetDbFromContext(ctx context.Context) *Tx {
 	return ctx.Value(TxKey).(*Tx)
 }
+
+// GetClientFromContext returns the database client the transaction in the context was started
+// from, for writes that have to be stored even if the transaction is rolled back. It returns nil
+// if there is none.
+func GetClientFromContext(ctx context.Context) *Client {
+	client, _ := ctx.Value(ClientKey).(*Client)
+	return client
+}
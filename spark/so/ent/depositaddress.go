@@ -37,6 +37,10 @@ type DepositAddress struct {
 	NodeID uuid.UUID `json:"node_id,omitempty"`
 	// IsStatic holds the value of the "is_static" field.
 	IsStatic bool `json:"is_static,omitempty"`
+	// IsTreeAddress holds the value of the "is_tree_address" field.
+	IsTreeAddress bool `json:"is_tree_address,omitempty"`
+	// ExpiryTime holds the value of the "expiry_time" field.
+	ExpiryTime time.Time `json:"expiry_time,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DepositAddressQuery when eager-loading is set.
 	Edges                            DepositAddressEdges `json:"edges"`
@@ -93,13 +97,13 @@ func (*DepositAddress) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case depositaddress.FieldOwnerIdentityPubkey, depositaddress.FieldOwnerSigningPubkey:
 			values[i] = new([]byte)
-		case depositaddress.FieldIsStatic:
+		case depositaddress.FieldIsStatic, depositaddress.FieldIsTreeAddress:
 			values[i] = new(sql.NullBool)
 		case depositaddress.FieldConfirmationHeight:
 			values[i] = new(sql.NullInt64)
 		case depositaddress.FieldAddress, depositaddress.FieldConfirmationTxid:
 			values[i] = new(sql.NullString)
-		case depositaddress.FieldCreateTime, depositaddress.FieldUpdateTime:
+		case depositaddress.FieldCreateTime, depositaddress.FieldUpdateTime, depositaddress.FieldExpiryTime:
 			values[i] = new(sql.NullTime)
 		case depositaddress.FieldID, depositaddress.FieldNodeID:
 			values[i] = new(uuid.UUID)
@@ -180,6 +184,18 @@ func (da *DepositAddress) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				da.IsStatic = value.Bool
 			}
+		case depositaddress.FieldIsTreeAddress:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_tree_address", values[i])
+			} else if value.Valid {
+				da.IsTreeAddress = value.Bool
+			}
+		case depositaddress.FieldExpiryTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_time", values[i])
+			} else if value.Valid {
+				da.ExpiryTime = value.Time
+			}
 		case depositaddress.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field deposit_address_signing_keyshare", values[i])
@@ -264,6 +280,12 @@ func (da *DepositAddress) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_static=")
 	builder.WriteString(fmt.Sprintf("%v", da.IsStatic))
+	builder.WriteString(", ")
+	builder.WriteString("is_tree_address=")
+	builder.WriteString(fmt.Sprintf("%v", da.IsTreeAddress))
+	builder.WriteString(", ")
+	builder.WriteString("expiry_time=")
+	builder.WriteString(da.ExpiryTime.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
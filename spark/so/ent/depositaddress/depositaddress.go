@@ -33,6 +33,10 @@ const (
 	FieldNodeID = "node_id"
 	// FieldIsStatic holds the string denoting the is_static field in the database.
 	FieldIsStatic = "is_static"
+	// FieldIsTreeAddress holds the string denoting the is_tree_address field in the database.
+	FieldIsTreeAddress = "is_tree_address"
+	// FieldExpiryTime holds the string denoting the expiry_time field in the database.
+	FieldExpiryTime = "expiry_time"
 	// EdgeSigningKeyshare holds the string denoting the signing_keyshare edge name in mutations.
 	EdgeSigningKeyshare = "signing_keyshare"
 	// EdgeUtxo holds the string denoting the utxo edge name in mutations.
@@ -76,6 +80,8 @@ var Columns = []string{
 	FieldConfirmationTxid,
 	FieldNodeID,
 	FieldIsStatic,
+	FieldIsTreeAddress,
+	FieldExpiryTime,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "deposit_addresses"
@@ -114,6 +120,8 @@ var (
 	OwnerSigningPubkeyValidator func([]byte) error
 	// DefaultIsStatic holds the default value on creation for the "is_static" field.
 	DefaultIsStatic bool
+	// DefaultIsTreeAddress holds the default value on creation for the "is_tree_address" field.
+	DefaultIsTreeAddress bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -161,6 +169,16 @@ func ByIsStatic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsStatic, opts...).ToFunc()
 }
 
+// ByIsTreeAddress orders the results by the is_tree_address field.
+func ByIsTreeAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsTreeAddress, opts...).ToFunc()
+}
+
+// ByExpiryTime orders the results by the expiry_time field.
+func ByExpiryTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiryTime, opts...).ToFunc()
+}
+
 // BySigningKeyshareField orders the results by signing_keyshare field.
 func BySigningKeyshareField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -101,6 +101,16 @@ func IsStatic(v bool) predicate.DepositAddress {
 	return predicate.DepositAddress(sql.FieldEQ(FieldIsStatic, v))
 }
 
+// IsTreeAddress applies equality check predicate on the "is_tree_address" field. It's identical to IsTreeAddressEQ.
+func IsTreeAddress(v bool) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldEQ(FieldIsTreeAddress, v))
+}
+
+// ExpiryTime applies equality check predicate on the "expiry_time" field. It's identical to ExpiryTimeEQ.
+func ExpiryTime(v time.Time) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldEQ(FieldExpiryTime, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.DepositAddress {
 	return predicate.DepositAddress(sql.FieldEQ(FieldCreateTime, v))
@@ -511,6 +521,66 @@ func IsStaticNEQ(v bool) predicate.DepositAddress {
 	return predicate.DepositAddress(sql.FieldNEQ(FieldIsStatic, v))
 }
 
+// IsTreeAddressEQ applies the EQ predicate on the "is_tree_address" field.
+func IsTreeAddressEQ(v bool) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldEQ(FieldIsTreeAddress, v))
+}
+
+// IsTreeAddressNEQ applies the NEQ predicate on the "is_tree_address" field.
+func IsTreeAddressNEQ(v bool) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldNEQ(FieldIsTreeAddress, v))
+}
+
+// ExpiryTimeEQ applies the EQ predicate on the "expiry_time" field.
+func ExpiryTimeEQ(v time.Time) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldEQ(FieldExpiryTime, v))
+}
+
+// ExpiryTimeNEQ applies the NEQ predicate on the "expiry_time" field.
+func ExpiryTimeNEQ(v time.Time) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldNEQ(FieldExpiryTime, v))
+}
+
+// ExpiryTimeIn applies the In predicate on the "expiry_time" field.
+func ExpiryTimeIn(vs ...time.Time) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldIn(FieldExpiryTime, vs...))
+}
+
+// ExpiryTimeNotIn applies the NotIn predicate on the "expiry_time" field.
+func ExpiryTimeNotIn(vs ...time.Time) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldNotIn(FieldExpiryTime, vs...))
+}
+
+// ExpiryTimeGT applies the GT predicate on the "expiry_time" field.
+func ExpiryTimeGT(v time.Time) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldGT(FieldExpiryTime, v))
+}
+
+// ExpiryTimeGTE applies the GTE predicate on the "expiry_time" field.
+func ExpiryTimeGTE(v time.Time) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldGTE(FieldExpiryTime, v))
+}
+
+// ExpiryTimeLT applies the LT predicate on the "expiry_time" field.
+func ExpiryTimeLT(v time.Time) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldLT(FieldExpiryTime, v))
+}
+
+// ExpiryTimeLTE applies the LTE predicate on the "expiry_time" field.
+func ExpiryTimeLTE(v time.Time) predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldLTE(FieldExpiryTime, v))
+}
+
+// ExpiryTimeIsNil applies the IsNil predicate on the "expiry_time" field.
+func ExpiryTimeIsNil() predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldIsNull(FieldExpiryTime))
+}
+
+// ExpiryTimeNotNil applies the NotNil predicate on the "expiry_time" field.
+func ExpiryTimeNotNil() predicate.DepositAddress {
+	return predicate.DepositAddress(sql.FieldNotNull(FieldExpiryTime))
+}
+
 // HasSigningKeyshare applies the HasEdge predicate on the "signing_keyshare" edge.
 func HasSigningKeyshare() predicate.DepositAddress {
 	return predicate.DepositAddress(func(s *sql.Selector) {
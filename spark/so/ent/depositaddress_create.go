@@ -126,6 +126,34 @@ func (dac *DepositAddressCreate) SetNillableIsStatic(b *bool) *DepositAddressCre
 	return dac
 }
 
+// SetIsTreeAddress sets the "is_tree_address" field.
+func (dac *DepositAddressCreate) SetIsTreeAddress(b bool) *DepositAddressCreate {
+	dac.mutation.SetIsTreeAddress(b)
+	return dac
+}
+
+// SetNillableIsTreeAddress sets the "is_tree_address" field if the given value is not nil.
+func (dac *DepositAddressCreate) SetNillableIsTreeAddress(b *bool) *DepositAddressCreate {
+	if b != nil {
+		dac.SetIsTreeAddress(*b)
+	}
+	return dac
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (dac *DepositAddressCreate) SetExpiryTime(t time.Time) *DepositAddressCreate {
+	dac.mutation.SetExpiryTime(t)
+	return dac
+}
+
+// SetNillableExpiryTime sets the "expiry_time" field if the given value is not nil.
+func (dac *DepositAddressCreate) SetNillableExpiryTime(t *time.Time) *DepositAddressCreate {
+	if t != nil {
+		dac.SetExpiryTime(*t)
+	}
+	return dac
+}
+
 // SetID sets the "id" field.
 func (dac *DepositAddressCreate) SetID(u uuid.UUID) *DepositAddressCreate {
 	dac.mutation.SetID(u)
@@ -228,6 +256,10 @@ func (dac *DepositAddressCreate) defaults() {
 		v := depositaddress.DefaultIsStatic
 		dac.mutation.SetIsStatic(v)
 	}
+	if _, ok := dac.mutation.IsTreeAddress(); !ok {
+		v := depositaddress.DefaultIsTreeAddress
+		dac.mutation.SetIsTreeAddress(v)
+	}
 	if _, ok := dac.mutation.ID(); !ok {
 		v := depositaddress.DefaultID()
 		dac.mutation.SetID(v)
@@ -269,6 +301,9 @@ func (dac *DepositAddressCreate) check() error {
 	if _, ok := dac.mutation.IsStatic(); !ok {
 		return &ValidationError{Name: "is_static", err: errors.New(`ent: missing required field "DepositAddress.is_static"`)}
 	}
+	if _, ok := dac.mutation.IsTreeAddress(); !ok {
+		return &ValidationError{Name: "is_tree_address", err: errors.New(`ent: missing required field "DepositAddress.is_tree_address"`)}
+	}
 	if len(dac.mutation.SigningKeyshareIDs()) == 0 {
 		return &ValidationError{Name: "signing_keyshare", err: errors.New(`ent: missing required edge "DepositAddress.signing_keyshare"`)}
 	}
@@ -343,6 +378,14 @@ func (dac *DepositAddressCreate) createSpec() (*DepositAddress, *sqlgraph.Create
 		_spec.SetField(depositaddress.FieldIsStatic, field.TypeBool, value)
 		_node.IsStatic = value
 	}
+	if value, ok := dac.mutation.IsTreeAddress(); ok {
+		_spec.SetField(depositaddress.FieldIsTreeAddress, field.TypeBool, value)
+		_node.IsTreeAddress = value
+	}
+	if value, ok := dac.mutation.ExpiryTime(); ok {
+		_spec.SetField(depositaddress.FieldExpiryTime, field.TypeTime, value)
+		_node.ExpiryTime = value
+	}
 	if nodes := dac.mutation.SigningKeyshareIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,172 @@
+package ent
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent/depositaddress"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
+	"github.com/lightsparkdev/spark/so/ent/treenode"
+)
+
+// depositAddressUnused matches addresses that have not received funds, and whose keyshare is not
+// held by a tree node.
+func depositAddressUnused() predicate.DepositAddress {
+	return depositaddress.And(
+		depositaddress.ConfirmationHeightIsNil(),
+		depositaddress.ConfirmationTxidIsNil(),
+		depositaddress.Not(depositaddress.HasUtxo()),
+		depositaddress.Not(depositaddress.HasUtxoswaps()),
+		func(s *sql.Selector) {
+			nodes := sql.Table(treenode.Table)
+			s.Where(sql.NotExists(
+				sql.Select(nodes.C(treenode.FieldID)).
+					From(nodes).
+					Where(sql.ColumnsEQ(nodes.C(treenode.SigningKeyshareColumn), s.C(depositaddress.SigningKeyshareColumn))),
+			))
+		},
+	)
+}
+
+// depositAddressExpired matches deposit addresses that expired before cutoff without receiving
+// funds.
+func depositAddressExpired(cutoff time.Time) predicate.DepositAddress {
+	return depositaddress.And(
+		depositaddress.IsStatic(false),
+		depositaddress.IsTreeAddress(false),
+		depositaddress.ExpiryTimeLT(cutoff),
+		depositAddressUnused(),
+	)
+}
+
+// CountUnusedDepositAddresses counts the addresses of the identity that have not received funds,
+// either the tree addresses or the other deposit addresses. Expired addresses count until their
+// keyshares are recycled, so that an identity cannot hold more keyshares than its quota.
+func CountUnusedDepositAddresses(ctx context.Context, identityPublicKey []byte, treeAddresses bool) (int, error) {
+	return GetDbFromContext(ctx).DepositAddress.Query().
+		Where(
+			depositaddress.OwnerIdentityPubkey(identityPublicKey),
+			depositaddress.IsTreeAddress(treeAddresses),
+			depositAddressUnused(),
+		).
+		Count(ctx)
+}
+
+// depositAddressRecycleCutoff returns the time deposit addresses must have expired before for this
+// operator to recycle their keyshares: spark.DepositAddressRecycleGracePeriod before the chain was
+// last scanned on every network, or zero if it has not been scanned yet. Deposits sent to them
+// before they expired have confirmed by then, and would have been seen.
+func depositAddressRecycleCutoff(ctx context.Context) (time.Time, error) {
+	scans, err := GetDbFromContext(ctx).BlockHeight.Query().All(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to query block heights: %w", err)
+	}
+	if len(scans) == 0 {
+		return time.Time{}, nil
+	}
+	scanned := time.Now()
+	for _, scan := range scans {
+		if scan.UpdateTime.Before(scanned) {
+			scanned = scan.UpdateTime
+		}
+	}
+	return scanned.Add(-spark.DepositAddressRecycleGracePeriod), nil
+}
+
+// GetExpiredDepositAddresses returns up to limit deposit addresses that expired without receiving
+// funds and can be recycled, whose keyshares this operator coordinated.
+func GetExpiredDepositAddresses(ctx context.Context, config *so.Config, limit int) ([]string, error) {
+	cutoff, err := depositAddressRecycleCutoff(ctx)
+	if err != nil || cutoff.IsZero() {
+		return nil, err
+	}
+	return GetDbFromContext(ctx).DepositAddress.Query().
+		Where(
+			depositAddressExpired(cutoff),
+			depositaddress.HasSigningKeyshareWith(signingkeyshare.CoordinatorIndexEQ(config.Index)),
+		).
+		Order(depositaddress.ByExpiryTime()).
+		Limit(limit).
+		Select(depositaddress.FieldAddress).
+		Strings(ctx)
+}
+
+// RecycleDepositAddresses deletes the given addresses that expired without receiving funds and can
+// be recycled, and makes their keyshares available again. It returns the addresses that were
+// recycled, or with dryRun, the addresses that would be, without changing anything. Addresses that
+// are unknown, have not expired long enough or have received funds are skipped.
+func RecycleDepositAddresses(ctx context.Context, addresses []string, dryRun bool) ([]string, error) {
+	db := GetDbFromContext(ctx)
+	cutoff, err := depositAddressRecycleCutoff(ctx)
+	if err != nil || cutoff.IsZero() {
+		return nil, err
+	}
+
+	expired, err := db.DepositAddress.Query().
+		Where(depositaddress.AddressIn(addresses...), depositAddressExpired(cutoff)).
+		WithSigningKeyshare().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired deposit addresses: %w", err)
+	}
+
+	recycled := make([]string, 0, len(expired))
+	for _, address := range expired {
+		if dryRun {
+			recycled = append(recycled, address.Address)
+			continue
+		}
+		deleted, err := db.DepositAddress.Delete().
+			Where(depositaddress.ID(address.ID), depositAddressExpired(cutoff)).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete deposit address %s: %w", address.Address, err)
+		}
+		if deleted == 0 {
+			continue
+		}
+		// The keyshare is only used again if no other address holds it.
+		keyshareID := address.Edges.SigningKeyshare.ID
+		held, err := db.DepositAddress.Query().
+			Where(depositaddress.HasSigningKeyshareWith(signingkeyshare.ID(keyshareID))).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query deposit addresses of keyshare %s: %w", keyshareID, err)
+		}
+		if !held {
+			err = db.SigningKeyshare.UpdateOneID(keyshareID).
+				SetStatus(schema.KeyshareStatusAvailable).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to release keyshare %s: %w", keyshareID, err)
+			}
+			keysharesRecycledCounter.Add(ctx, 1)
+		}
+		recycled = append(recycled, address.Address)
+	}
+	return recycled, nil
+}
+
+// LockAddressQuota locks the address quota of the identity until the end of the transaction in the
+// context, so that concurrent requests of the identity count its unused addresses and create new
+// ones one after the other. SQLite serializes writes anyway and does not support locks.
+func LockAddressQuota(ctx context.Context, identityPublicKey []byte) error {
+	db := GetDbFromContext(ctx)
+	if db.driver.Dialect() != dialect.Postgres {
+		return nil
+	}
+	_, err := db.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtextextended($1, 0))",
+		"address_quota:"+hex.EncodeToString(identityPublicKey))
+	if err != nil {
+		return fmt.Errorf("failed to lock the address quota: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package ent_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDepositAddress(ctx context.Context, t *testing.T, address string, identity []byte, coordinatorIndex uint64) *ent.DepositAddress {
+	db := ent.GetDbFromContext(ctx)
+	keyshare, err := db.SigningKeyshare.Create().
+		SetStatus(schema.KeyshareStatusInUse).
+		SetSecretShare([]byte("secret")).
+		SetPublicShares(map[string][]byte{}).
+		SetPublicKey([]byte(address)).
+		SetMinSigners(2).
+		SetCoordinatorIndex(coordinatorIndex).
+		Save(ctx)
+	require.NoError(t, err)
+	depositAddress, err := db.DepositAddress.Create().
+		SetAddress(address).
+		SetOwnerIdentityPubkey(identity).
+		SetOwnerSigningPubkey([]byte("signing")).
+		SetSigningKeyshare(keyshare).
+		SetExpiryTime(time.Now().Add(-time.Minute)).
+		Save(ctx)
+	require.NoError(t, err)
+	return depositAddress
+}
+
+func TestCountUnusedDepositAddresses(t *testing.T) {
+	ctx := newTestTxContext(t, "count_unused_deposit_addresses")
+	db := ent.GetDbFromContext(ctx)
+	identity := []byte("identity")
+
+	unused := createTestDepositAddress(ctx, t, "unused", identity, 0)
+	_, err := unused.Update().SetExpiryTime(time.Now().Add(time.Hour)).Save(ctx)
+	require.NoError(t, err)
+	createTestDepositAddress(ctx, t, "expired", identity, 0)
+	static := createTestDepositAddress(ctx, t, "static", identity, 0)
+	_, err = static.Update().SetIsStatic(true).ClearExpiryTime().Save(ctx)
+	require.NoError(t, err)
+	funded := createTestDepositAddress(ctx, t, "funded", identity, 0)
+	_, err = funded.Update().SetConfirmationHeight(100).SetConfirmationTxid("txid").ClearExpiryTime().Save(ctx)
+	require.NoError(t, err)
+	tree := createTestDepositAddress(ctx, t, "tree", identity, 0)
+	_, err = tree.Update().SetIsTreeAddress(true).ClearExpiryTime().Save(ctx)
+	require.NoError(t, err)
+	createTestDepositAddress(ctx, t, "other identity", []byte("other"), 0)
+
+	count, err := ent.CountUnusedDepositAddresses(ctx, identity, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, count, "expired addresses count until they are recycled")
+	count, err = ent.CountUnusedDepositAddresses(ctx, identity, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	_, err = db.DepositAddress.Delete().Exec(ctx)
+	require.NoError(t, err)
+	count, err = ent.CountUnusedDepositAddresses(ctx, identity, false)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestRecycleDepositAddresses(t *testing.T) {
+	ctx := newTestTxContext(t, "recycle_deposit_addresses")
+	db := ent.GetDbFromContext(ctx)
+	identity := []byte("identity")
+	longExpired := time.Now().Add(-spark.DepositAddressRecycleGracePeriod - time.Hour)
+
+	expired := createTestDepositAddress(ctx, t, "expired", identity, 0)
+	_, err := expired.Update().SetExpiryTime(longExpired).Save(ctx)
+	require.NoError(t, err)
+	// Deposits sent before the address expired may not have been seen yet.
+	createTestDepositAddress(ctx, t, "recently expired", identity, 0)
+	notExpired := createTestDepositAddress(ctx, t, "not expired", identity, 0)
+	_, err = notExpired.Update().SetExpiryTime(time.Now().Add(time.Hour)).Save(ctx)
+	require.NoError(t, err)
+	static := createTestDepositAddress(ctx, t, "static", identity, 0)
+	_, err = static.Update().SetIsStatic(true).SetExpiryTime(longExpired).Save(ctx)
+	require.NoError(t, err)
+	funded := createTestDepositAddress(ctx, t, "funded", identity, 0)
+	_, err = funded.Update().SetConfirmationHeight(100).SetConfirmationTxid("txid").SetExpiryTime(longExpired).Save(ctx)
+	require.NoError(t, err)
+	otherCoordinator := createTestDepositAddress(ctx, t, "other coordinator", identity, 1)
+	_, err = otherCoordinator.Update().SetExpiryTime(longExpired).Save(ctx)
+	require.NoError(t, err)
+	all := []string{"expired", "recently expired", "not expired", "static", "funded", "other coordinator", "unknown"}
+
+	// Nothing is recycled before the chain was scanned past the grace period.
+	candidates, err := ent.GetExpiredDepositAddresses(ctx, &so.Config{Index: 0}, 10)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
+	scan, err := db.BlockHeight.Create().
+		SetHeight(100).
+		SetNetwork(schema.NetworkRegtest).
+		SetUpdateTime(longExpired.Add(-time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+	recycled, err := ent.RecycleDepositAddresses(ctx, all, true)
+	require.NoError(t, err)
+	require.Empty(t, recycled)
+
+	_, err = scan.Update().SetHeight(101).Save(ctx)
+	require.NoError(t, err)
+	candidates, err = ent.GetExpiredDepositAddresses(ctx, &so.Config{Index: 0}, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"expired"}, candidates)
+	recycled, err = ent.RecycleDepositAddresses(ctx, all, true)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"expired", "other coordinator"}, recycled)
+	require.Equal(t, 6, db.DepositAddress.Query().CountX(ctx))
+
+	expiredKeyshareID := expired.QuerySigningKeyshare().OnlyIDX(ctx)
+	fundedKeyshareID := funded.QuerySigningKeyshare().OnlyIDX(ctx)
+	recycled, err = ent.RecycleDepositAddresses(ctx, []string{"expired", "funded"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"expired"}, recycled)
+	require.Equal(t, 5, db.DepositAddress.Query().CountX(ctx))
+	require.Equal(t, schema.KeyshareStatusAvailable, db.SigningKeyshare.GetX(ctx, expiredKeyshareID).Status)
+	require.Equal(t, schema.KeyshareStatusInUse, db.SigningKeyshare.GetX(ctx, fundedKeyshareID).Status)
+
+	// Recycled addresses no longer count against the quota.
+	count, err := ent.CountUnusedDepositAddresses(ctx, identity, false)
+	require.NoError(t, err)
+	require.Equal(t, 4, count)
+}
@@ -118,6 +118,40 @@ func (dau *DepositAddressUpdate) SetNillableIsStatic(b *bool) *DepositAddressUpd
 	return dau
 }
 
+// SetIsTreeAddress sets the "is_tree_address" field.
+func (dau *DepositAddressUpdate) SetIsTreeAddress(b bool) *DepositAddressUpdate {
+	dau.mutation.SetIsTreeAddress(b)
+	return dau
+}
+
+// SetNillableIsTreeAddress sets the "is_tree_address" field if the given value is not nil.
+func (dau *DepositAddressUpdate) SetNillableIsTreeAddress(b *bool) *DepositAddressUpdate {
+	if b != nil {
+		dau.SetIsTreeAddress(*b)
+	}
+	return dau
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (dau *DepositAddressUpdate) SetExpiryTime(t time.Time) *DepositAddressUpdate {
+	dau.mutation.SetExpiryTime(t)
+	return dau
+}
+
+// SetNillableExpiryTime sets the "expiry_time" field if the given value is not nil.
+func (dau *DepositAddressUpdate) SetNillableExpiryTime(t *time.Time) *DepositAddressUpdate {
+	if t != nil {
+		dau.SetExpiryTime(*t)
+	}
+	return dau
+}
+
+// ClearExpiryTime clears the value of the "expiry_time" field.
+func (dau *DepositAddressUpdate) ClearExpiryTime() *DepositAddressUpdate {
+	dau.mutation.ClearExpiryTime()
+	return dau
+}
+
 // AddUtxoIDs adds the "utxo" edge to the Utxo entity by IDs.
 func (dau *DepositAddressUpdate) AddUtxoIDs(ids ...uuid.UUID) *DepositAddressUpdate {
 	dau.mutation.AddUtxoIDs(ids...)
@@ -278,6 +312,15 @@ func (dau *DepositAddressUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := dau.mutation.IsStatic(); ok {
 		_spec.SetField(depositaddress.FieldIsStatic, field.TypeBool, value)
 	}
+	if value, ok := dau.mutation.IsTreeAddress(); ok {
+		_spec.SetField(depositaddress.FieldIsTreeAddress, field.TypeBool, value)
+	}
+	if value, ok := dau.mutation.ExpiryTime(); ok {
+		_spec.SetField(depositaddress.FieldExpiryTime, field.TypeTime, value)
+	}
+	if dau.mutation.ExpiryTimeCleared() {
+		_spec.ClearField(depositaddress.FieldExpiryTime, field.TypeTime)
+	}
 	if dau.mutation.UtxoCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -475,6 +518,40 @@ func (dauo *DepositAddressUpdateOne) SetNillableIsStatic(b *bool) *DepositAddres
 	return dauo
 }
 
+// SetIsTreeAddress sets the "is_tree_address" field.
+func (dauo *DepositAddressUpdateOne) SetIsTreeAddress(b bool) *DepositAddressUpdateOne {
+	dauo.mutation.SetIsTreeAddress(b)
+	return dauo
+}
+
+// SetNillableIsTreeAddress sets the "is_tree_address" field if the given value is not nil.
+func (dauo *DepositAddressUpdateOne) SetNillableIsTreeAddress(b *bool) *DepositAddressUpdateOne {
+	if b != nil {
+		dauo.SetIsTreeAddress(*b)
+	}
+	return dauo
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (dauo *DepositAddressUpdateOne) SetExpiryTime(t time.Time) *DepositAddressUpdateOne {
+	dauo.mutation.SetExpiryTime(t)
+	return dauo
+}
+
+// SetNillableExpiryTime sets the "expiry_time" field if the given value is not nil.
+func (dauo *DepositAddressUpdateOne) SetNillableExpiryTime(t *time.Time) *DepositAddressUpdateOne {
+	if t != nil {
+		dauo.SetExpiryTime(*t)
+	}
+	return dauo
+}
+
+// ClearExpiryTime clears the value of the "expiry_time" field.
+func (dauo *DepositAddressUpdateOne) ClearExpiryTime() *DepositAddressUpdateOne {
+	dauo.mutation.ClearExpiryTime()
+	return dauo
+}
+
 // AddUtxoIDs adds the "utxo" edge to the Utxo entity by IDs.
 func (dauo *DepositAddressUpdateOne) AddUtxoIDs(ids ...uuid.UUID) *DepositAddressUpdateOne {
 	dauo.mutation.AddUtxoIDs(ids...)
@@ -665,6 +742,15 @@ func (dauo *DepositAddressUpdateOne) sqlSave(ctx context.Context) (_node *Deposi
 	if value, ok := dauo.mutation.IsStatic(); ok {
 		_spec.SetField(depositaddress.FieldIsStatic, field.TypeBool, value)
 	}
+	if value, ok := dauo.mutation.IsTreeAddress(); ok {
+		_spec.SetField(depositaddress.FieldIsTreeAddress, field.TypeBool, value)
+	}
+	if value, ok := dauo.mutation.ExpiryTime(); ok {
+		_spec.SetField(depositaddress.FieldExpiryTime, field.TypeTime, value)
+	}
+	if dauo.mutation.ExpiryTimeCleared() {
+		_spec.ClearField(depositaddress.FieldExpiryTime, field.TypeTime)
+	}
 	if dauo.mutation.UtxoCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
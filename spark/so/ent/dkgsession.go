@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/dkgsession"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// DkgSession is the model entity for the DkgSession schema.
+type DkgSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Status holds the value of the "status" field.
+	Status schema.DkgSessionStatus `json:"status,omitempty"`
+	// MinSigners holds the value of the "min_signers" field.
+	MinSigners uint64 `json:"min_signers,omitempty"`
+	// MaxSigners holds the value of the "max_signers" field.
+	MaxSigners uint64 `json:"max_signers,omitempty"`
+	// CoordinatorIndex holds the value of the "coordinator_index" field.
+	CoordinatorIndex uint64 `json:"coordinator_index,omitempty"`
+	// Participants holds the value of the "participants" field.
+	Participants []string `json:"participants,omitempty"`
+	// Round1Package holds the value of the "round1_package" field.
+	Round1Package [][]uint8 `json:"round1_package,omitempty"`
+	// ReceivedRound1Packages holds the value of the "received_round1_packages" field.
+	ReceivedRound1Packages []map[string][]uint8 `json:"received_round1_packages,omitempty"`
+	// Deadline holds the value of the "deadline" field.
+	Deadline time.Time `json:"deadline,omitempty"`
+	// Complaints holds the value of the "complaints" field.
+	Complaints map[string]string `json:"complaints,omitempty"`
+	// AbortReason holds the value of the "abort_reason" field.
+	AbortReason  string `json:"abort_reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DkgSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dkgsession.FieldParticipants, dkgsession.FieldRound1Package, dkgsession.FieldReceivedRound1Packages, dkgsession.FieldComplaints:
+			values[i] = new([]byte)
+		case dkgsession.FieldMinSigners, dkgsession.FieldMaxSigners, dkgsession.FieldCoordinatorIndex:
+			values[i] = new(sql.NullInt64)
+		case dkgsession.FieldStatus, dkgsession.FieldAbortReason:
+			values[i] = new(sql.NullString)
+		case dkgsession.FieldCreateTime, dkgsession.FieldUpdateTime, dkgsession.FieldDeadline:
+			values[i] = new(sql.NullTime)
+		case dkgsession.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DkgSession fields.
+func (ds *DkgSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dkgsession.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ds.ID = *value
+			}
+		case dkgsession.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ds.CreateTime = value.Time
+			}
+		case dkgsession.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ds.UpdateTime = value.Time
+			}
+		case dkgsession.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ds.Status = schema.DkgSessionStatus(value.String)
+			}
+		case dkgsession.FieldMinSigners:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_signers", values[i])
+			} else if value.Valid {
+				ds.MinSigners = uint64(value.Int64)
+			}
+		case dkgsession.FieldMaxSigners:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_signers", values[i])
+			} else if value.Valid {
+				ds.MaxSigners = uint64(value.Int64)
+			}
+		case dkgsession.FieldCoordinatorIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field coordinator_index", values[i])
+			} else if value.Valid {
+				ds.CoordinatorIndex = uint64(value.Int64)
+			}
+		case dkgsession.FieldParticipants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field participants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ds.Participants); err != nil {
+					return fmt.Errorf("unmarshal field participants: %w", err)
+				}
+			}
+		case dkgsession.FieldRound1Package:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field round1_package", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ds.Round1Package); err != nil {
+					return fmt.Errorf("unmarshal field round1_package: %w", err)
+				}
+			}
+		case dkgsession.FieldReceivedRound1Packages:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field received_round1_packages", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ds.ReceivedRound1Packages); err != nil {
+					return fmt.Errorf("unmarshal field received_round1_packages: %w", err)
+				}
+			}
+		case dkgsession.FieldDeadline:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deadline", values[i])
+			} else if value.Valid {
+				ds.Deadline = value.Time
+			}
+		case dkgsession.FieldComplaints:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field complaints", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ds.Complaints); err != nil {
+					return fmt.Errorf("unmarshal field complaints: %w", err)
+				}
+			}
+		case dkgsession.FieldAbortReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field abort_reason", values[i])
+			} else if value.Valid {
+				ds.AbortReason = value.String
+			}
+		default:
+			ds.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DkgSession.
+// This includes values selected through modifiers, order, etc.
+func (ds *DkgSession) Value(name string) (ent.Value, error) {
+	return ds.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DkgSession.
+// Note that you need to call DkgSession.Unwrap() before calling this method if this DkgSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ds *DkgSession) Update() *DkgSessionUpdateOne {
+	return NewDkgSessionClient(ds.config).UpdateOne(ds)
+}
+
+// Unwrap unwraps the DkgSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ds *DkgSession) Unwrap() *DkgSession {
+	_tx, ok := ds.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DkgSession is not a transactional entity")
+	}
+	ds.config.driver = _tx.drv
+	return ds
+}
+
+// String implements the fmt.Stringer.
+func (ds *DkgSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("DkgSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ds.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ds.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ds.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Status))
+	builder.WriteString(", ")
+	builder.WriteString("min_signers=")
+	builder.WriteString(fmt.Sprintf("%v", ds.MinSigners))
+	builder.WriteString(", ")
+	builder.WriteString("max_signers=")
+	builder.WriteString(fmt.Sprintf("%v", ds.MaxSigners))
+	builder.WriteString(", ")
+	builder.WriteString("coordinator_index=")
+	builder.WriteString(fmt.Sprintf("%v", ds.CoordinatorIndex))
+	builder.WriteString(", ")
+	builder.WriteString("participants=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Participants))
+	builder.WriteString(", ")
+	builder.WriteString("round1_package=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Round1Package))
+	builder.WriteString(", ")
+	builder.WriteString("received_round1_packages=")
+	builder.WriteString(fmt.Sprintf("%v", ds.ReceivedRound1Packages))
+	builder.WriteString(", ")
+	builder.WriteString("deadline=")
+	builder.WriteString(ds.Deadline.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("complaints=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Complaints))
+	builder.WriteString(", ")
+	builder.WriteString("abort_reason=")
+	builder.WriteString(ds.AbortReason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DkgSessions is a parsable slice of DkgSession.
+type DkgSessions []*DkgSession
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dkgsession
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+const (
+	// Label holds the string label denoting the dkgsession type in the database.
+	Label = "dkg_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMinSigners holds the string denoting the min_signers field in the database.
+	FieldMinSigners = "min_signers"
+	// FieldMaxSigners holds the string denoting the max_signers field in the database.
+	FieldMaxSigners = "max_signers"
+	// FieldCoordinatorIndex holds the string denoting the coordinator_index field in the database.
+	FieldCoordinatorIndex = "coordinator_index"
+	// FieldParticipants holds the string denoting the participants field in the database.
+	FieldParticipants = "participants"
+	// FieldRound1Package holds the string denoting the round1_package field in the database.
+	FieldRound1Package = "round1_package"
+	// FieldReceivedRound1Packages holds the string denoting the received_round1_packages field in the database.
+	FieldReceivedRound1Packages = "received_round1_packages"
+	// FieldDeadline holds the string denoting the deadline field in the database.
+	FieldDeadline = "deadline"
+	// FieldComplaints holds the string denoting the complaints field in the database.
+	FieldComplaints = "complaints"
+	// FieldAbortReason holds the string denoting the abort_reason field in the database.
+	FieldAbortReason = "abort_reason"
+	// Table holds the table name of the dkgsession in the database.
+	Table = "dkg_sessions"
+)
+
+// Columns holds all SQL columns for dkgsession fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldStatus,
+	FieldMinSigners,
+	FieldMaxSigners,
+	FieldCoordinatorIndex,
+	FieldParticipants,
+	FieldRound1Package,
+	FieldReceivedRound1Packages,
+	FieldDeadline,
+	FieldComplaints,
+	FieldAbortReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s schema.DkgSessionStatus) error {
+	switch s {
+	case "INITIAL", "ROUND1", "ROUND1_SIGNATURE", "COMPLETED", "ABORTED":
+		return nil
+	default:
+		return fmt.Errorf("dkgsession: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the DkgSession queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMinSigners orders the results by the min_signers field.
+func ByMinSigners(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinSigners, opts...).ToFunc()
+}
+
+// ByMaxSigners orders the results by the max_signers field.
+func ByMaxSigners(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxSigners, opts...).ToFunc()
+}
+
+// ByCoordinatorIndex orders the results by the coordinator_index field.
+func ByCoordinatorIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCoordinatorIndex, opts...).ToFunc()
+}
+
+// ByDeadline orders the results by the deadline field.
+func ByDeadline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeadline, opts...).ToFunc()
+}
+
+// ByAbortReason orders the results by the abort_reason field.
+func ByAbortReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbortReason, opts...).ToFunc()
+}
@@ -0,0 +1,482 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dkgsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// MinSigners applies equality check predicate on the "min_signers" field. It's identical to MinSignersEQ.
+func MinSigners(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldMinSigners, v))
+}
+
+// MaxSigners applies equality check predicate on the "max_signers" field. It's identical to MaxSignersEQ.
+func MaxSigners(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldMaxSigners, v))
+}
+
+// CoordinatorIndex applies equality check predicate on the "coordinator_index" field. It's identical to CoordinatorIndexEQ.
+func CoordinatorIndex(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldCoordinatorIndex, v))
+}
+
+// Deadline applies equality check predicate on the "deadline" field. It's identical to DeadlineEQ.
+func Deadline(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldDeadline, v))
+}
+
+// AbortReason applies equality check predicate on the "abort_reason" field. It's identical to AbortReasonEQ.
+func AbortReason(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldAbortReason, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v schema.DkgSessionStatus) predicate.DkgSession {
+	vc := v
+	return predicate.DkgSession(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v schema.DkgSessionStatus) predicate.DkgSession {
+	vc := v
+	return predicate.DkgSession(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...schema.DkgSessionStatus) predicate.DkgSession {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DkgSession(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...schema.DkgSessionStatus) predicate.DkgSession {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DkgSession(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// MinSignersEQ applies the EQ predicate on the "min_signers" field.
+func MinSignersEQ(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldMinSigners, v))
+}
+
+// MinSignersNEQ applies the NEQ predicate on the "min_signers" field.
+func MinSignersNEQ(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNEQ(FieldMinSigners, v))
+}
+
+// MinSignersIn applies the In predicate on the "min_signers" field.
+func MinSignersIn(vs ...uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIn(FieldMinSigners, vs...))
+}
+
+// MinSignersNotIn applies the NotIn predicate on the "min_signers" field.
+func MinSignersNotIn(vs ...uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotIn(FieldMinSigners, vs...))
+}
+
+// MinSignersGT applies the GT predicate on the "min_signers" field.
+func MinSignersGT(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGT(FieldMinSigners, v))
+}
+
+// MinSignersGTE applies the GTE predicate on the "min_signers" field.
+func MinSignersGTE(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGTE(FieldMinSigners, v))
+}
+
+// MinSignersLT applies the LT predicate on the "min_signers" field.
+func MinSignersLT(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLT(FieldMinSigners, v))
+}
+
+// MinSignersLTE applies the LTE predicate on the "min_signers" field.
+func MinSignersLTE(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLTE(FieldMinSigners, v))
+}
+
+// MaxSignersEQ applies the EQ predicate on the "max_signers" field.
+func MaxSignersEQ(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldMaxSigners, v))
+}
+
+// MaxSignersNEQ applies the NEQ predicate on the "max_signers" field.
+func MaxSignersNEQ(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNEQ(FieldMaxSigners, v))
+}
+
+// MaxSignersIn applies the In predicate on the "max_signers" field.
+func MaxSignersIn(vs ...uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIn(FieldMaxSigners, vs...))
+}
+
+// MaxSignersNotIn applies the NotIn predicate on the "max_signers" field.
+func MaxSignersNotIn(vs ...uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotIn(FieldMaxSigners, vs...))
+}
+
+// MaxSignersGT applies the GT predicate on the "max_signers" field.
+func MaxSignersGT(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGT(FieldMaxSigners, v))
+}
+
+// MaxSignersGTE applies the GTE predicate on the "max_signers" field.
+func MaxSignersGTE(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGTE(FieldMaxSigners, v))
+}
+
+// MaxSignersLT applies the LT predicate on the "max_signers" field.
+func MaxSignersLT(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLT(FieldMaxSigners, v))
+}
+
+// MaxSignersLTE applies the LTE predicate on the "max_signers" field.
+func MaxSignersLTE(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLTE(FieldMaxSigners, v))
+}
+
+// CoordinatorIndexEQ applies the EQ predicate on the "coordinator_index" field.
+func CoordinatorIndexEQ(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldCoordinatorIndex, v))
+}
+
+// CoordinatorIndexNEQ applies the NEQ predicate on the "coordinator_index" field.
+func CoordinatorIndexNEQ(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNEQ(FieldCoordinatorIndex, v))
+}
+
+// CoordinatorIndexIn applies the In predicate on the "coordinator_index" field.
+func CoordinatorIndexIn(vs ...uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIn(FieldCoordinatorIndex, vs...))
+}
+
+// CoordinatorIndexNotIn applies the NotIn predicate on the "coordinator_index" field.
+func CoordinatorIndexNotIn(vs ...uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotIn(FieldCoordinatorIndex, vs...))
+}
+
+// CoordinatorIndexGT applies the GT predicate on the "coordinator_index" field.
+func CoordinatorIndexGT(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGT(FieldCoordinatorIndex, v))
+}
+
+// CoordinatorIndexGTE applies the GTE predicate on the "coordinator_index" field.
+func CoordinatorIndexGTE(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGTE(FieldCoordinatorIndex, v))
+}
+
+// CoordinatorIndexLT applies the LT predicate on the "coordinator_index" field.
+func CoordinatorIndexLT(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLT(FieldCoordinatorIndex, v))
+}
+
+// CoordinatorIndexLTE applies the LTE predicate on the "coordinator_index" field.
+func CoordinatorIndexLTE(v uint64) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLTE(FieldCoordinatorIndex, v))
+}
+
+// Round1PackageIsNil applies the IsNil predicate on the "round1_package" field.
+func Round1PackageIsNil() predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIsNull(FieldRound1Package))
+}
+
+// Round1PackageNotNil applies the NotNil predicate on the "round1_package" field.
+func Round1PackageNotNil() predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotNull(FieldRound1Package))
+}
+
+// ReceivedRound1PackagesIsNil applies the IsNil predicate on the "received_round1_packages" field.
+func ReceivedRound1PackagesIsNil() predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIsNull(FieldReceivedRound1Packages))
+}
+
+// ReceivedRound1PackagesNotNil applies the NotNil predicate on the "received_round1_packages" field.
+func ReceivedRound1PackagesNotNil() predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotNull(FieldReceivedRound1Packages))
+}
+
+// DeadlineEQ applies the EQ predicate on the "deadline" field.
+func DeadlineEQ(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldDeadline, v))
+}
+
+// DeadlineNEQ applies the NEQ predicate on the "deadline" field.
+func DeadlineNEQ(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNEQ(FieldDeadline, v))
+}
+
+// DeadlineIn applies the In predicate on the "deadline" field.
+func DeadlineIn(vs ...time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIn(FieldDeadline, vs...))
+}
+
+// DeadlineNotIn applies the NotIn predicate on the "deadline" field.
+func DeadlineNotIn(vs ...time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotIn(FieldDeadline, vs...))
+}
+
+// DeadlineGT applies the GT predicate on the "deadline" field.
+func DeadlineGT(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGT(FieldDeadline, v))
+}
+
+// DeadlineGTE applies the GTE predicate on the "deadline" field.
+func DeadlineGTE(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGTE(FieldDeadline, v))
+}
+
+// DeadlineLT applies the LT predicate on the "deadline" field.
+func DeadlineLT(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLT(FieldDeadline, v))
+}
+
+// DeadlineLTE applies the LTE predicate on the "deadline" field.
+func DeadlineLTE(v time.Time) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLTE(FieldDeadline, v))
+}
+
+// ComplaintsIsNil applies the IsNil predicate on the "complaints" field.
+func ComplaintsIsNil() predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIsNull(FieldComplaints))
+}
+
+// ComplaintsNotNil applies the NotNil predicate on the "complaints" field.
+func ComplaintsNotNil() predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotNull(FieldComplaints))
+}
+
+// AbortReasonEQ applies the EQ predicate on the "abort_reason" field.
+func AbortReasonEQ(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEQ(FieldAbortReason, v))
+}
+
+// AbortReasonNEQ applies the NEQ predicate on the "abort_reason" field.
+func AbortReasonNEQ(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNEQ(FieldAbortReason, v))
+}
+
+// AbortReasonIn applies the In predicate on the "abort_reason" field.
+func AbortReasonIn(vs ...string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIn(FieldAbortReason, vs...))
+}
+
+// AbortReasonNotIn applies the NotIn predicate on the "abort_reason" field.
+func AbortReasonNotIn(vs ...string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotIn(FieldAbortReason, vs...))
+}
+
+// AbortReasonGT applies the GT predicate on the "abort_reason" field.
+func AbortReasonGT(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGT(FieldAbortReason, v))
+}
+
+// AbortReasonGTE applies the GTE predicate on the "abort_reason" field.
+func AbortReasonGTE(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldGTE(FieldAbortReason, v))
+}
+
+// AbortReasonLT applies the LT predicate on the "abort_reason" field.
+func AbortReasonLT(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLT(FieldAbortReason, v))
+}
+
+// AbortReasonLTE applies the LTE predicate on the "abort_reason" field.
+func AbortReasonLTE(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldLTE(FieldAbortReason, v))
+}
+
+// AbortReasonContains applies the Contains predicate on the "abort_reason" field.
+func AbortReasonContains(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldContains(FieldAbortReason, v))
+}
+
+// AbortReasonHasPrefix applies the HasPrefix predicate on the "abort_reason" field.
+func AbortReasonHasPrefix(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldHasPrefix(FieldAbortReason, v))
+}
+
+// AbortReasonHasSuffix applies the HasSuffix predicate on the "abort_reason" field.
+func AbortReasonHasSuffix(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldHasSuffix(FieldAbortReason, v))
+}
+
+// AbortReasonIsNil applies the IsNil predicate on the "abort_reason" field.
+func AbortReasonIsNil() predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldIsNull(FieldAbortReason))
+}
+
+// AbortReasonNotNil applies the NotNil predicate on the "abort_reason" field.
+func AbortReasonNotNil() predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldNotNull(FieldAbortReason))
+}
+
+// AbortReasonEqualFold applies the EqualFold predicate on the "abort_reason" field.
+func AbortReasonEqualFold(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldEqualFold(FieldAbortReason, v))
+}
+
+// AbortReasonContainsFold applies the ContainsFold predicate on the "abort_reason" field.
+func AbortReasonContainsFold(v string) predicate.DkgSession {
+	return predicate.DkgSession(sql.FieldContainsFold(FieldAbortReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DkgSession) predicate.DkgSession {
+	return predicate.DkgSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DkgSession) predicate.DkgSession {
+	return predicate.DkgSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DkgSession) predicate.DkgSession {
+	return predicate.DkgSession(sql.NotPredicates(p))
+}
@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/dkgsession"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// DkgSessionCreate is the builder for creating a DkgSession entity.
+type DkgSessionCreate struct {
+	config
+	mutation *DkgSessionMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (dsc *DkgSessionCreate) SetCreateTime(t time.Time) *DkgSessionCreate {
+	dsc.mutation.SetCreateTime(t)
+	return dsc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (dsc *DkgSessionCreate) SetNillableCreateTime(t *time.Time) *DkgSessionCreate {
+	if t != nil {
+		dsc.SetCreateTime(*t)
+	}
+	return dsc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (dsc *DkgSessionCreate) SetUpdateTime(t time.Time) *DkgSessionCreate {
+	dsc.mutation.SetUpdateTime(t)
+	return dsc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (dsc *DkgSessionCreate) SetNillableUpdateTime(t *time.Time) *DkgSessionCreate {
+	if t != nil {
+		dsc.SetUpdateTime(*t)
+	}
+	return dsc
+}
+
+// SetStatus sets the "status" field.
+func (dsc *DkgSessionCreate) SetStatus(sss schema.DkgSessionStatus) *DkgSessionCreate {
+	dsc.mutation.SetStatus(sss)
+	return dsc
+}
+
+// SetMinSigners sets the "min_signers" field.
+func (dsc *DkgSessionCreate) SetMinSigners(u uint64) *DkgSessionCreate {
+	dsc.mutation.SetMinSigners(u)
+	return dsc
+}
+
+// SetMaxSigners sets the "max_signers" field.
+func (dsc *DkgSessionCreate) SetMaxSigners(u uint64) *DkgSessionCreate {
+	dsc.mutation.SetMaxSigners(u)
+	return dsc
+}
+
+// SetCoordinatorIndex sets the "coordinator_index" field.
+func (dsc *DkgSessionCreate) SetCoordinatorIndex(u uint64) *DkgSessionCreate {
+	dsc.mutation.SetCoordinatorIndex(u)
+	return dsc
+}
+
+// SetParticipants sets the "participants" field.
+func (dsc *DkgSessionCreate) SetParticipants(s []string) *DkgSessionCreate {
+	dsc.mutation.SetParticipants(s)
+	return dsc
+}
+
+// SetRound1Package sets the "round1_package" field.
+func (dsc *DkgSessionCreate) SetRound1Package(u [][]uint8) *DkgSessionCreate {
+	dsc.mutation.SetRound1Package(u)
+	return dsc
+}
+
+// SetReceivedRound1Packages sets the "received_round1_packages" field.
+func (dsc *DkgSessionCreate) SetReceivedRound1Packages(m []map[string][]uint8) *DkgSessionCreate {
+	dsc.mutation.SetReceivedRound1Packages(m)
+	return dsc
+}
+
+// SetDeadline sets the "deadline" field.
+func (dsc *DkgSessionCreate) SetDeadline(t time.Time) *DkgSessionCreate {
+	dsc.mutation.SetDeadline(t)
+	return dsc
+}
+
+// SetComplaints sets the "complaints" field.
+func (dsc *DkgSessionCreate) SetComplaints(m map[string]string) *DkgSessionCreate {
+	dsc.mutation.SetComplaints(m)
+	return dsc
+}
+
+// SetAbortReason sets the "abort_reason" field.
+func (dsc *DkgSessionCreate) SetAbortReason(s string) *DkgSessionCreate {
+	dsc.mutation.SetAbortReason(s)
+	return dsc
+}
+
+// SetNillableAbortReason sets the "abort_reason" field if the given value is not nil.
+func (dsc *DkgSessionCreate) SetNillableAbortReason(s *string) *DkgSessionCreate {
+	if s != nil {
+		dsc.SetAbortReason(*s)
+	}
+	return dsc
+}
+
+// SetID sets the "id" field.
+func (dsc *DkgSessionCreate) SetID(u uuid.UUID) *DkgSessionCreate {
+	dsc.mutation.SetID(u)
+	return dsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dsc *DkgSessionCreate) SetNillableID(u *uuid.UUID) *DkgSessionCreate {
+	if u != nil {
+		dsc.SetID(*u)
+	}
+	return dsc
+}
+
+// Mutation returns the DkgSessionMutation object of the builder.
+func (dsc *DkgSessionCreate) Mutation() *DkgSessionMutation {
+	return dsc.mutation
+}
+
+// Save creates the DkgSession in the database.
+func (dsc *DkgSessionCreate) Save(ctx context.Context) (*DkgSession, error) {
+	dsc.defaults()
+	return withHooks(ctx, dsc.sqlSave, dsc.mutation, dsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dsc *DkgSessionCreate) SaveX(ctx context.Context) *DkgSession {
+	v, err := dsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dsc *DkgSessionCreate) Exec(ctx context.Context) error {
+	_, err := dsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsc *DkgSessionCreate) ExecX(ctx context.Context) {
+	if err := dsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsc *DkgSessionCreate) defaults() {
+	if _, ok := dsc.mutation.CreateTime(); !ok {
+		v := dkgsession.DefaultCreateTime()
+		dsc.mutation.SetCreateTime(v)
+	}
+	if _, ok := dsc.mutation.UpdateTime(); !ok {
+		v := dkgsession.DefaultUpdateTime()
+		dsc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := dsc.mutation.ID(); !ok {
+		v := dkgsession.DefaultID()
+		dsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsc *DkgSessionCreate) check() error {
+	if _, ok := dsc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "DkgSession.create_time"`)}
+	}
+	if _, ok := dsc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "DkgSession.update_time"`)}
+	}
+	if _, ok := dsc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DkgSession.status"`)}
+	}
+	if v, ok := dsc.mutation.Status(); ok {
+		if err := dkgsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DkgSession.status": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.MinSigners(); !ok {
+		return &ValidationError{Name: "min_signers", err: errors.New(`ent: missing required field "DkgSession.min_signers"`)}
+	}
+	if _, ok := dsc.mutation.MaxSigners(); !ok {
+		return &ValidationError{Name: "max_signers", err: errors.New(`ent: missing required field "DkgSession.max_signers"`)}
+	}
+	if _, ok := dsc.mutation.CoordinatorIndex(); !ok {
+		return &ValidationError{Name: "coordinator_index", err: errors.New(`ent: missing required field "DkgSession.coordinator_index"`)}
+	}
+	if _, ok := dsc.mutation.Participants(); !ok {
+		return &ValidationError{Name: "participants", err: errors.New(`ent: missing required field "DkgSession.participants"`)}
+	}
+	if _, ok := dsc.mutation.Deadline(); !ok {
+		return &ValidationError{Name: "deadline", err: errors.New(`ent: missing required field "DkgSession.deadline"`)}
+	}
+	return nil
+}
+
+func (dsc *DkgSessionCreate) sqlSave(ctx context.Context) (*DkgSession, error) {
+	if err := dsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dsc.mutation.id = &_node.ID
+	dsc.mutation.done = true
+	return _node, nil
+}
+
+func (dsc *DkgSessionCreate) createSpec() (*DkgSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DkgSession{config: dsc.config}
+		_spec = sqlgraph.NewCreateSpec(dkgsession.Table, sqlgraph.NewFieldSpec(dkgsession.FieldID, field.TypeUUID))
+	)
+	if id, ok := dsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dsc.mutation.CreateTime(); ok {
+		_spec.SetField(dkgsession.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := dsc.mutation.UpdateTime(); ok {
+		_spec.SetField(dkgsession.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := dsc.mutation.Status(); ok {
+		_spec.SetField(dkgsession.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dsc.mutation.MinSigners(); ok {
+		_spec.SetField(dkgsession.FieldMinSigners, field.TypeUint64, value)
+		_node.MinSigners = value
+	}
+	if value, ok := dsc.mutation.MaxSigners(); ok {
+		_spec.SetField(dkgsession.FieldMaxSigners, field.TypeUint64, value)
+		_node.MaxSigners = value
+	}
+	if value, ok := dsc.mutation.CoordinatorIndex(); ok {
+		_spec.SetField(dkgsession.FieldCoordinatorIndex, field.TypeUint64, value)
+		_node.CoordinatorIndex = value
+	}
+	if value, ok := dsc.mutation.Participants(); ok {
+		_spec.SetField(dkgsession.FieldParticipants, field.TypeJSON, value)
+		_node.Participants = value
+	}
+	if value, ok := dsc.mutation.Round1Package(); ok {
+		_spec.SetField(dkgsession.FieldRound1Package, field.TypeJSON, value)
+		_node.Round1Package = value
+	}
+	if value, ok := dsc.mutation.ReceivedRound1Packages(); ok {
+		_spec.SetField(dkgsession.FieldReceivedRound1Packages, field.TypeJSON, value)
+		_node.ReceivedRound1Packages = value
+	}
+	if value, ok := dsc.mutation.Deadline(); ok {
+		_spec.SetField(dkgsession.FieldDeadline, field.TypeTime, value)
+		_node.Deadline = value
+	}
+	if value, ok := dsc.mutation.Complaints(); ok {
+		_spec.SetField(dkgsession.FieldComplaints, field.TypeJSON, value)
+		_node.Complaints = value
+	}
+	if value, ok := dsc.mutation.AbortReason(); ok {
+		_spec.SetField(dkgsession.FieldAbortReason, field.TypeString, value)
+		_node.AbortReason = value
+	}
+	return _node, _spec
+}
+
+// DkgSessionCreateBulk is the builder for creating many DkgSession entities in bulk.
+type DkgSessionCreateBulk struct {
+	config
+	err      error
+	builders []*DkgSessionCreate
+}
+
+// Save creates the DkgSession entities in the database.
+func (dscb *DkgSessionCreateBulk) Save(ctx context.Context) ([]*DkgSession, error) {
+	if dscb.err != nil {
+		return nil, dscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dscb.builders))
+	nodes := make([]*DkgSession, len(dscb.builders))
+	mutators := make([]Mutator, len(dscb.builders))
+	for i := range dscb.builders {
+		func(i int, root context.Context) {
+			builder := dscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DkgSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dscb *DkgSessionCreateBulk) SaveX(ctx context.Context) []*DkgSession {
+	v, err := dscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dscb *DkgSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := dscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dscb *DkgSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := dscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/dkgsession"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// DkgSessionDelete is the builder for deleting a DkgSession entity.
+type DkgSessionDelete struct {
+	config
+	hooks    []Hook
+	mutation *DkgSessionMutation
+}
+
+// Where appends a list predicates to the DkgSessionDelete builder.
+func (dsd *DkgSessionDelete) Where(ps ...predicate.DkgSession) *DkgSessionDelete {
+	dsd.mutation.Where(ps...)
+	return dsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dsd *DkgSessionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dsd.sqlExec, dsd.mutation, dsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsd *DkgSessionDelete) ExecX(ctx context.Context) int {
+	n, err := dsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dsd *DkgSessionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dkgsession.Table, sqlgraph.NewFieldSpec(dkgsession.FieldID, field.TypeUUID))
+	if ps := dsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dsd.mutation.done = true
+	return affected, err
+}
+
+// DkgSessionDeleteOne is the builder for deleting a single DkgSession entity.
+type DkgSessionDeleteOne struct {
+	dsd *DkgSessionDelete
+}
+
+// Where appends a list predicates to the DkgSessionDelete builder.
+func (dsdo *DkgSessionDeleteOne) Where(ps ...predicate.DkgSession) *DkgSessionDeleteOne {
+	dsdo.dsd.mutation.Where(ps...)
+	return dsdo
+}
+
+// Exec executes the deletion query.
+func (dsdo *DkgSessionDeleteOne) Exec(ctx context.Context) error {
+	n, err := dsdo.dsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dkgsession.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsdo *DkgSessionDeleteOne) ExecX(ctx context.Context) {
+	if err := dsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,72 @@
+package ent
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/lightsparkdev/spark"
+	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
+	"github.com/lightsparkdev/spark/so/ent/dkgsession"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// MarshalProto converts a DkgSession to a dkg protobuf GetDkgSessionResponse for the given
+// operator.
+func (s *DkgSession) MarshalProto(identifier string) *pbdkg.GetDkgSessionResponse {
+	accused := make([]string, 0, len(s.Complaints))
+	for identifier := range s.Complaints {
+		accused = append(accused, identifier)
+	}
+	sort.Strings(accused)
+	complaints := make([]*pbdkg.DkgComplaint, len(accused))
+	for i, identifier := range accused {
+		complaints[i] = &pbdkg.DkgComplaint{
+			AccusedIdentifier: identifier,
+			Reason:            s.Complaints[identifier],
+		}
+	}
+
+	return &pbdkg.GetDkgSessionResponse{
+		Identifier:  identifier,
+		Status:      dkgSessionStatusProto(s.Status),
+		Complaints:  complaints,
+		AbortReason: s.AbortReason,
+	}
+}
+
+func dkgSessionStatusProto(status schema.DkgSessionStatus) pbdkg.DkgSessionStatus {
+	switch status {
+	case schema.DkgSessionStatusInitial, schema.DkgSessionStatusRound1, schema.DkgSessionStatusRound1Signature:
+		return pbdkg.DkgSessionStatus_DKG_SESSION_STATUS_IN_PROGRESS
+	case schema.DkgSessionStatusCompleted:
+		return pbdkg.DkgSessionStatus_DKG_SESSION_STATUS_COMPLETED
+	case schema.DkgSessionStatusAborted:
+		return pbdkg.DkgSessionStatus_DKG_SESSION_STATUS_ABORTED
+	default:
+		return pbdkg.DkgSessionStatus_DKG_SESSION_STATUS_UNSPECIFIED
+	}
+}
+
+// AbortExpiredDkgSessions aborts the DKG sessions that are a round past their deadline, such as
+// sessions whose coordinator stopped driving them, and returns how many it aborted. The deadline
+// of round 2 is not stored, hence the extra round.
+func AbortExpiredDkgSessions(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "DkgSession.AbortExpiredDkgSessions")
+	defer span.End()
+
+	return GetDbFromContext(ctx).DkgSession.Update().
+		Where(
+			dkgsession.StatusIn(
+				schema.DkgSessionStatusInitial,
+				schema.DkgSessionStatusRound1,
+				schema.DkgSessionStatusRound1Signature,
+			),
+			dkgsession.DeadlineLT(time.Now().Add(-spark.DKGRoundTimeout)),
+		).
+		SetStatus(schema.DkgSessionStatusAborted).
+		SetAbortReason("timed out").
+		ClearRound1Package().
+		ClearReceivedRound1Packages().
+		Save(ctx)
+}
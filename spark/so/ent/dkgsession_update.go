@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/dkgsession"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// DkgSessionUpdate is the builder for updating DkgSession entities.
+type DkgSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DkgSessionMutation
+}
+
+// Where appends a list predicates to the DkgSessionUpdate builder.
+func (dsu *DkgSessionUpdate) Where(ps ...predicate.DkgSession) *DkgSessionUpdate {
+	dsu.mutation.Where(ps...)
+	return dsu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (dsu *DkgSessionUpdate) SetUpdateTime(t time.Time) *DkgSessionUpdate {
+	dsu.mutation.SetUpdateTime(t)
+	return dsu
+}
+
+// SetStatus sets the "status" field.
+func (dsu *DkgSessionUpdate) SetStatus(sss schema.DkgSessionStatus) *DkgSessionUpdate {
+	dsu.mutation.SetStatus(sss)
+	return dsu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dsu *DkgSessionUpdate) SetNillableStatus(sss *schema.DkgSessionStatus) *DkgSessionUpdate {
+	if sss != nil {
+		dsu.SetStatus(*sss)
+	}
+	return dsu
+}
+
+// SetRound1Package sets the "round1_package" field.
+func (dsu *DkgSessionUpdate) SetRound1Package(u [][]uint8) *DkgSessionUpdate {
+	dsu.mutation.SetRound1Package(u)
+	return dsu
+}
+
+// AppendRound1Package appends u to the "round1_package" field.
+func (dsu *DkgSessionUpdate) AppendRound1Package(u [][]uint8) *DkgSessionUpdate {
+	dsu.mutation.AppendRound1Package(u)
+	return dsu
+}
+
+// ClearRound1Package clears the value of the "round1_package" field.
+func (dsu *DkgSessionUpdate) ClearRound1Package() *DkgSessionUpdate {
+	dsu.mutation.ClearRound1Package()
+	return dsu
+}
+
+// SetReceivedRound1Packages sets the "received_round1_packages" field.
+func (dsu *DkgSessionUpdate) SetReceivedRound1Packages(m []map[string][]uint8) *DkgSessionUpdate {
+	dsu.mutation.SetReceivedRound1Packages(m)
+	return dsu
+}
+
+// AppendReceivedRound1Packages appends m to the "received_round1_packages" field.
+func (dsu *DkgSessionUpdate) AppendReceivedRound1Packages(m []map[string][]uint8) *DkgSessionUpdate {
+	dsu.mutation.AppendReceivedRound1Packages(m)
+	return dsu
+}
+
+// ClearReceivedRound1Packages clears the value of the "received_round1_packages" field.
+func (dsu *DkgSessionUpdate) ClearReceivedRound1Packages() *DkgSessionUpdate {
+	dsu.mutation.ClearReceivedRound1Packages()
+	return dsu
+}
+
+// SetDeadline sets the "deadline" field.
+func (dsu *DkgSessionUpdate) SetDeadline(t time.Time) *DkgSessionUpdate {
+	dsu.mutation.SetDeadline(t)
+	return dsu
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (dsu *DkgSessionUpdate) SetNillableDeadline(t *time.Time) *DkgSessionUpdate {
+	if t != nil {
+		dsu.SetDeadline(*t)
+	}
+	return dsu
+}
+
+// SetComplaints sets the "complaints" field.
+func (dsu *DkgSessionUpdate) SetComplaints(m map[string]string) *DkgSessionUpdate {
+	dsu.mutation.SetComplaints(m)
+	return dsu
+}
+
+// ClearComplaints clears the value of the "complaints" field.
+func (dsu *DkgSessionUpdate) ClearComplaints() *DkgSessionUpdate {
+	dsu.mutation.ClearComplaints()
+	return dsu
+}
+
+// SetAbortReason sets the "abort_reason" field.
+func (dsu *DkgSessionUpdate) SetAbortReason(s string) *DkgSessionUpdate {
+	dsu.mutation.SetAbortReason(s)
+	return dsu
+}
+
+// SetNillableAbortReason sets the "abort_reason" field if the given value is not nil.
+func (dsu *DkgSessionUpdate) SetNillableAbortReason(s *string) *DkgSessionUpdate {
+	if s != nil {
+		dsu.SetAbortReason(*s)
+	}
+	return dsu
+}
+
+// ClearAbortReason clears the value of the "abort_reason" field.
+func (dsu *DkgSessionUpdate) ClearAbortReason() *DkgSessionUpdate {
+	dsu.mutation.ClearAbortReason()
+	return dsu
+}
+
+// Mutation returns the DkgSessionMutation object of the builder.
+func (dsu *DkgSessionUpdate) Mutation() *DkgSessionMutation {
+	return dsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dsu *DkgSessionUpdate) Save(ctx context.Context) (int, error) {
+	dsu.defaults()
+	return withHooks(ctx, dsu.sqlSave, dsu.mutation, dsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsu *DkgSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := dsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dsu *DkgSessionUpdate) Exec(ctx context.Context) error {
+	_, err := dsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsu *DkgSessionUpdate) ExecX(ctx context.Context) {
+	if err := dsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsu *DkgSessionUpdate) defaults() {
+	if _, ok := dsu.mutation.UpdateTime(); !ok {
+		v := dkgsession.UpdateDefaultUpdateTime()
+		dsu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsu *DkgSessionUpdate) check() error {
+	if v, ok := dsu.mutation.Status(); ok {
+		if err := dkgsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DkgSession.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dsu *DkgSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dkgsession.Table, dkgsession.Columns, sqlgraph.NewFieldSpec(dkgsession.FieldID, field.TypeUUID))
+	if ps := dsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsu.mutation.UpdateTime(); ok {
+		_spec.SetField(dkgsession.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := dsu.mutation.Status(); ok {
+		_spec.SetField(dkgsession.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dsu.mutation.Round1Package(); ok {
+		_spec.SetField(dkgsession.FieldRound1Package, field.TypeJSON, value)
+	}
+	if value, ok := dsu.mutation.AppendedRound1Package(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dkgsession.FieldRound1Package, value)
+		})
+	}
+	if dsu.mutation.Round1PackageCleared() {
+		_spec.ClearField(dkgsession.FieldRound1Package, field.TypeJSON)
+	}
+	if value, ok := dsu.mutation.ReceivedRound1Packages(); ok {
+		_spec.SetField(dkgsession.FieldReceivedRound1Packages, field.TypeJSON, value)
+	}
+	if value, ok := dsu.mutation.AppendedReceivedRound1Packages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dkgsession.FieldReceivedRound1Packages, value)
+		})
+	}
+	if dsu.mutation.ReceivedRound1PackagesCleared() {
+		_spec.ClearField(dkgsession.FieldReceivedRound1Packages, field.TypeJSON)
+	}
+	if value, ok := dsu.mutation.Deadline(); ok {
+		_spec.SetField(dkgsession.FieldDeadline, field.TypeTime, value)
+	}
+	if value, ok := dsu.mutation.Complaints(); ok {
+		_spec.SetField(dkgsession.FieldComplaints, field.TypeJSON, value)
+	}
+	if dsu.mutation.ComplaintsCleared() {
+		_spec.ClearField(dkgsession.FieldComplaints, field.TypeJSON)
+	}
+	if value, ok := dsu.mutation.AbortReason(); ok {
+		_spec.SetField(dkgsession.FieldAbortReason, field.TypeString, value)
+	}
+	if dsu.mutation.AbortReasonCleared() {
+		_spec.ClearField(dkgsession.FieldAbortReason, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dkgsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dsu.mutation.done = true
+	return n, nil
+}
+
+// DkgSessionUpdateOne is the builder for updating a single DkgSession entity.
+type DkgSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DkgSessionMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (dsuo *DkgSessionUpdateOne) SetUpdateTime(t time.Time) *DkgSessionUpdateOne {
+	dsuo.mutation.SetUpdateTime(t)
+	return dsuo
+}
+
+// SetStatus sets the "status" field.
+func (dsuo *DkgSessionUpdateOne) SetStatus(sss schema.DkgSessionStatus) *DkgSessionUpdateOne {
+	dsuo.mutation.SetStatus(sss)
+	return dsuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dsuo *DkgSessionUpdateOne) SetNillableStatus(sss *schema.DkgSessionStatus) *DkgSessionUpdateOne {
+	if sss != nil {
+		dsuo.SetStatus(*sss)
+	}
+	return dsuo
+}
+
+// SetRound1Package sets the "round1_package" field.
+func (dsuo *DkgSessionUpdateOne) SetRound1Package(u [][]uint8) *DkgSessionUpdateOne {
+	dsuo.mutation.SetRound1Package(u)
+	return dsuo
+}
+
+// AppendRound1Package appends u to the "round1_package" field.
+func (dsuo *DkgSessionUpdateOne) AppendRound1Package(u [][]uint8) *DkgSessionUpdateOne {
+	dsuo.mutation.AppendRound1Package(u)
+	return dsuo
+}
+
+// ClearRound1Package clears the value of the "round1_package" field.
+func (dsuo *DkgSessionUpdateOne) ClearRound1Package() *DkgSessionUpdateOne {
+	dsuo.mutation.ClearRound1Package()
+	return dsuo
+}
+
+// SetReceivedRound1Packages sets the "received_round1_packages" field.
+func (dsuo *DkgSessionUpdateOne) SetReceivedRound1Packages(m []map[string][]uint8) *DkgSessionUpdateOne {
+	dsuo.mutation.SetReceivedRound1Packages(m)
+	return dsuo
+}
+
+// AppendReceivedRound1Packages appends m to the "received_round1_packages" field.
+func (dsuo *DkgSessionUpdateOne) AppendReceivedRound1Packages(m []map[string][]uint8) *DkgSessionUpdateOne {
+	dsuo.mutation.AppendReceivedRound1Packages(m)
+	return dsuo
+}
+
+// ClearReceivedRound1Packages clears the value of the "received_round1_packages" field.
+func (dsuo *DkgSessionUpdateOne) ClearReceivedRound1Packages() *DkgSessionUpdateOne {
+	dsuo.mutation.ClearReceivedRound1Packages()
+	return dsuo
+}
+
+// SetDeadline sets the "deadline" field.
+func (dsuo *DkgSessionUpdateOne) SetDeadline(t time.Time) *DkgSessionUpdateOne {
+	dsuo.mutation.SetDeadline(t)
+	return dsuo
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (dsuo *DkgSessionUpdateOne) SetNillableDeadline(t *time.Time) *DkgSessionUpdateOne {
+	if t != nil {
+		dsuo.SetDeadline(*t)
+	}
+	return dsuo
+}
+
+// SetComplaints sets the "complaints" field.
+func (dsuo *DkgSessionUpdateOne) SetComplaints(m map[string]string) *DkgSessionUpdateOne {
+	dsuo.mutation.SetComplaints(m)
+	return dsuo
+}
+
+// ClearComplaints clears the value of the "complaints" field.
+func (dsuo *DkgSessionUpdateOne) ClearComplaints() *DkgSessionUpdateOne {
+	dsuo.mutation.ClearComplaints()
+	return dsuo
+}
+
+// SetAbortReason sets the "abort_reason" field.
+func (dsuo *DkgSessionUpdateOne) SetAbortReason(s string) *DkgSessionUpdateOne {
+	dsuo.mutation.SetAbortReason(s)
+	return dsuo
+}
+
+// SetNillableAbortReason sets the "abort_reason" field if the given value is not nil.
+func (dsuo *DkgSessionUpdateOne) SetNillableAbortReason(s *string) *DkgSessionUpdateOne {
+	if s != nil {
+		dsuo.SetAbortReason(*s)
+	}
+	return dsuo
+}
+
+// ClearAbortReason clears the value of the "abort_reason" field.
+func (dsuo *DkgSessionUpdateOne) ClearAbortReason() *DkgSessionUpdateOne {
+	dsuo.mutation.ClearAbortReason()
+	return dsuo
+}
+
+// Mutation returns the DkgSessionMutation object of the builder.
+func (dsuo *DkgSessionUpdateOne) Mutation() *DkgSessionMutation {
+	return dsuo.mutation
+}
+
+// Where appends a list predicates to the DkgSessionUpdate builder.
+func (dsuo *DkgSessionUpdateOne) Where(ps ...predicate.DkgSession) *DkgSessionUpdateOne {
+	dsuo.mutation.Where(ps...)
+	return dsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dsuo *DkgSessionUpdateOne) Select(field string, fields ...string) *DkgSessionUpdateOne {
+	dsuo.fields = append([]string{field}, fields...)
+	return dsuo
+}
+
+// Save executes the query and returns the updated DkgSession entity.
+func (dsuo *DkgSessionUpdateOne) Save(ctx context.Context) (*DkgSession, error) {
+	dsuo.defaults()
+	return withHooks(ctx, dsuo.sqlSave, dsuo.mutation, dsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsuo *DkgSessionUpdateOne) SaveX(ctx context.Context) *DkgSession {
+	node, err := dsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dsuo *DkgSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := dsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsuo *DkgSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := dsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsuo *DkgSessionUpdateOne) defaults() {
+	if _, ok := dsuo.mutation.UpdateTime(); !ok {
+		v := dkgsession.UpdateDefaultUpdateTime()
+		dsuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsuo *DkgSessionUpdateOne) check() error {
+	if v, ok := dsuo.mutation.Status(); ok {
+		if err := dkgsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DkgSession.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dsuo *DkgSessionUpdateOne) sqlSave(ctx context.Context) (_node *DkgSession, err error) {
+	if err := dsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dkgsession.Table, dkgsession.Columns, sqlgraph.NewFieldSpec(dkgsession.FieldID, field.TypeUUID))
+	id, ok := dsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DkgSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dkgsession.FieldID)
+		for _, f := range fields {
+			if !dkgsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dkgsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsuo.mutation.UpdateTime(); ok {
+		_spec.SetField(dkgsession.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := dsuo.mutation.Status(); ok {
+		_spec.SetField(dkgsession.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dsuo.mutation.Round1Package(); ok {
+		_spec.SetField(dkgsession.FieldRound1Package, field.TypeJSON, value)
+	}
+	if value, ok := dsuo.mutation.AppendedRound1Package(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dkgsession.FieldRound1Package, value)
+		})
+	}
+	if dsuo.mutation.Round1PackageCleared() {
+		_spec.ClearField(dkgsession.FieldRound1Package, field.TypeJSON)
+	}
+	if value, ok := dsuo.mutation.ReceivedRound1Packages(); ok {
+		_spec.SetField(dkgsession.FieldReceivedRound1Packages, field.TypeJSON, value)
+	}
+	if value, ok := dsuo.mutation.AppendedReceivedRound1Packages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dkgsession.FieldReceivedRound1Packages, value)
+		})
+	}
+	if dsuo.mutation.ReceivedRound1PackagesCleared() {
+		_spec.ClearField(dkgsession.FieldReceivedRound1Packages, field.TypeJSON)
+	}
+	if value, ok := dsuo.mutation.Deadline(); ok {
+		_spec.SetField(dkgsession.FieldDeadline, field.TypeTime, value)
+	}
+	if value, ok := dsuo.mutation.Complaints(); ok {
+		_spec.SetField(dkgsession.FieldComplaints, field.TypeJSON, value)
+	}
+	if dsuo.mutation.ComplaintsCleared() {
+		_spec.ClearField(dkgsession.FieldComplaints, field.TypeJSON)
+	}
+	if value, ok := dsuo.mutation.AbortReason(); ok {
+		_spec.SetField(dkgsession.FieldAbortReason, field.TypeString, value)
+	}
+	if dsuo.mutation.AbortReasonCleared() {
+		_spec.ClearField(dkgsession.FieldAbortReason, field.TypeString)
+	}
+	_node = &DkgSession{config: dsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dkgsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dsuo.mutation.done = true
+	return _node, nil
+}
@@ -12,13 +12,28 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
 	"github.com/lightsparkdev/spark/so/ent/blockheight"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
 	"github.com/lightsparkdev/spark/so/ent/cooperativeexit"
 	"github.com/lightsparkdev/spark/so/ent/depositaddress"
+	"github.com/lightsparkdev/spark/so/ent/dkgsession"
+	"github.com/lightsparkdev/spark/so/ent/idempotencykey"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
 	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
 	"github.com/lightsparkdev/spark/so/ent/preimageshare"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+	"github.com/lightsparkdev/spark/so/ent/reshare"
+	"github.com/lightsparkdev/spark/so/ent/sessionrevocation"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+	"github.com/lightsparkdev/spark/so/ent/signingincident"
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
+	"github.com/lightsparkdev/spark/so/ent/tasklock"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
 	"github.com/lightsparkdev/spark/so/ent/tokenleaf"
 	"github.com/lightsparkdev/spark/so/ent/tokenmint"
@@ -92,13 +107,28 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			archive.Table:                 archive.ValidColumn,
+			archivedtransfer.Table:        archivedtransfer.ValidColumn,
 			blockheight.Table:             blockheight.ValidColumn,
+			consistencyauditcursor.Table:  consistencyauditcursor.ValidColumn,
+			consistencydiscrepancy.Table:  consistencydiscrepancy.ValidColumn,
 			cooperativeexit.Table:         cooperativeexit.ValidColumn,
 			depositaddress.Table:          depositaddress.ValidColumn,
+			dkgsession.Table:              dkgsession.ValidColumn,
+			idempotencykey.Table:          idempotencykey.ValidColumn,
+			networkpause.Table:            networkpause.ValidColumn,
+			operatorcallnonce.Table:       operatorcallnonce.ValidColumn,
 			preimagerequest.Table:         preimagerequest.ValidColumn,
 			preimageshare.Table:           preimageshare.ValidColumn,
+			recentwrite.Table:             recentwrite.ValidColumn,
+			reshare.Table:                 reshare.ValidColumn,
+			sessionrevocation.Table:       sessionrevocation.ValidColumn,
+			sharerefresh.Table:            sharerefresh.ValidColumn,
+			signingincident.Table:         signingincident.ValidColumn,
 			signingkeyshare.Table:         signingkeyshare.ValidColumn,
 			signingnonce.Table:            signingnonce.ValidColumn,
+			tasklock.Table:                tasklock.ValidColumn,
+			taskrun.Table:                 taskrun.ValidColumn,
 			tokenfreeze.Table:             tokenfreeze.ValidColumn,
 			tokenleaf.Table:               tokenleaf.ValidColumn,
 			tokenmint.Table:               tokenmint.ValidColumn,
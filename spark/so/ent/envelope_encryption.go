@@ -0,0 +1,739 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/reshare"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
+	"github.com/lightsparkdev/spark/so/ent/signingnonce"
+	"github.com/lightsparkdev/spark/so/keymanager"
+)
+
+// UseEnvelopeEncryption makes the client encrypt the secret shares of signing keyshares, the
+// signing nonces, and the contributions and previous secret shares of share refreshes and reshares
+// with the given envelope when they are written, and decrypt them when they are read, so that the
+// rest of the code only ever sees plaintext. Rows written before encryption was
+// enabled are read as they are until ReencryptSigningSecrets encrypts them.
+//
+// The secrets written by one transaction share a data key, so that writing a batch of keyshares
+// or nonces wraps one data key with the key manager rather than one per row.
+//
+// If envelope is nil, secrets are written in plaintext, and reading an encrypted row fails rather
+// than returning ciphertext.
+func UseEnvelopeEncryption(client *Client, envelope *keymanager.Envelope) {
+	sealer := newEnvelopeSealer(envelope)
+	client.SigningKeyshare.Use(signingKeyshareEncryptionHook(sealer))
+	client.SigningKeyshare.Intercept(InterceptFunc(func(next Querier) Querier {
+		return QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+			v, err := next.Query(ctx, q)
+			if err != nil {
+				return nil, err
+			}
+			if keyshares, ok := v.([]*SigningKeyshare); ok {
+				for _, keyshare := range keyshares {
+					if err := openSigningKeyshare(ctx, envelope, keyshare); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return v, nil
+		})
+	}))
+
+	client.Reshare.Use(reshareEncryptionHook(sealer))
+	client.Reshare.Intercept(InterceptFunc(func(next Querier) Querier {
+		return QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+			v, err := next.Query(ctx, q)
+			if err != nil || isRawEnvelopeContext(ctx) {
+				return v, err
+			}
+			if rows, ok := v.([]*Reshare); ok {
+				for _, row := range rows {
+					if err := openReshare(ctx, envelope, row); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return v, nil
+		})
+	}))
+
+	client.ShareRefresh.Use(shareRefreshEncryptionHook(sealer))
+	client.ShareRefresh.Intercept(InterceptFunc(func(next Querier) Querier {
+		return QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+			v, err := next.Query(ctx, q)
+			if err != nil || isRawEnvelopeContext(ctx) {
+				return v, err
+			}
+			if rows, ok := v.([]*ShareRefresh); ok {
+				for _, row := range rows {
+					if err := openShareRefresh(ctx, envelope, row); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return v, nil
+		})
+	}))
+
+	client.SigningNonce.Use(signingNonceEncryptionHook(sealer))
+	client.SigningNonce.Intercept(InterceptFunc(func(next Querier) Querier {
+		return QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+			v, err := next.Query(ctx, q)
+			if err != nil {
+				return nil, err
+			}
+			if nonces, ok := v.([]*SigningNonce); ok {
+				for _, nonce := range nonces {
+					if err := openSigningNonce(ctx, envelope, nonce); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return v, nil
+		})
+	}))
+}
+
+// envelopeSealer seals secrets with the data key of the transaction in the context they are
+// written with, and a new data key when there is none.
+type envelopeSealer struct {
+	envelope *keymanager.Envelope
+
+	mu      sync.Mutex
+	batches map[*Tx]*keymanager.DataKeyBatch
+}
+
+func newEnvelopeSealer(envelope *keymanager.Envelope) *envelopeSealer {
+	return &envelopeSealer{envelope: envelope, batches: make(map[*Tx]*keymanager.DataKeyBatch)}
+}
+
+func (s *envelopeSealer) seal(ctx context.Context, plaintext []byte, additionalData []byte) (*keymanager.Sealed, error) {
+	tx, ok := ctx.Value(TxKey).(*Tx)
+	if !ok {
+		return s.envelope.Seal(ctx, plaintext, additionalData)
+	}
+	return s.envelope.SealInBatch(ctx, s.batch(tx), plaintext, additionalData)
+}
+
+// batch returns the data key batch of the transaction, which is forgotten when it ends.
+func (s *envelopeSealer) batch(tx *Tx) *keymanager.DataKeyBatch {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if batch, ok := s.batches[tx]; ok {
+		return batch
+	}
+	batch := keymanager.NewDataKeyBatch()
+	s.batches[tx] = batch
+	forget := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.batches, tx)
+	}
+	tx.OnCommit(func(next Committer) Committer {
+		return CommitFunc(func(ctx context.Context, tx *Tx) error {
+			defer forget()
+			return next.Commit(ctx, tx)
+		})
+	})
+	tx.OnRollback(func(next Rollbacker) Rollbacker {
+		return RollbackFunc(func(ctx context.Context, tx *Tx) error {
+			defer forget()
+			return next.Rollback(ctx, tx)
+		})
+	})
+	return batch
+}
+
+func signingKeyshareEncryptionHook(sealer *envelopeSealer) Hook {
+	envelope := sealer.envelope
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SigningKeyshareMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			secretShare, hasSecretShare := mutation.SecretShare()
+			if hasSecretShare {
+				if envelope != nil {
+					id, err := encryptedRowID(mutation.Op(), mutation.ID)
+					if err != nil {
+						return nil, err
+					}
+					sealed, err := sealer.seal(ctx, secretShare, envelopeAdditionalData(signingkeyshare.Table, id))
+					if err != nil {
+						return nil, fmt.Errorf("failed to encrypt secret share of signing keyshare %s: %w", id, err)
+					}
+					mutation.SetSecretShare(sealed.Ciphertext)
+					mutation.SetEncryptedDataKey(sealed.WrappedKey)
+					mutation.SetKeyVersion(sealed.KeyVersion)
+				} else if !mutation.Op().Is(OpCreate) {
+					mutation.ClearEncryptedDataKey()
+					mutation.ClearKeyVersion()
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			// Saving returns the row as stored, so hand back the plaintext.
+			if keyshare, ok := v.(*SigningKeyshare); ok {
+				if hasSecretShare {
+					keyshare.SecretShare = secretShare
+				} else if err := openSigningKeyshare(ctx, envelope, keyshare); err != nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+func signingNonceEncryptionHook(sealer *envelopeSealer) Hook {
+	envelope := sealer.envelope
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SigningNonceMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			// The nonce is immutable, so it can only be set on creation.
+			nonce, hasNonce := mutation.Nonce()
+			if hasNonce && envelope != nil {
+				id, err := encryptedRowID(mutation.Op(), mutation.ID)
+				if err != nil {
+					return nil, err
+				}
+				sealed, err := sealer.seal(ctx, nonce, envelopeAdditionalData(signingnonce.Table, id))
+				if err != nil {
+					return nil, fmt.Errorf("failed to encrypt signing nonce %s: %w", id, err)
+				}
+				mutation.SetNonce(sealed.Ciphertext)
+				mutation.SetEncryptedDataKey(sealed.WrappedKey)
+				mutation.SetKeyVersion(sealed.KeyVersion)
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			if signingNonce, ok := v.(*SigningNonce); ok {
+				if hasNonce {
+					signingNonce.Nonce = nonce
+				} else if err := openSigningNonce(ctx, envelope, signingNonce); err != nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+func reshareEncryptionHook(sealer *envelopeSealer) Hook {
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReshareMutation)
+			if !ok || isRawEnvelopeContext(ctx) {
+				return next.Mutate(ctx, m)
+			}
+			_, appendedContributions := mutation.AppendedContributions()
+			_, appendedPreviousKeyshares := mutation.AppendedPreviousKeyshares()
+			if appendedContributions || appendedPreviousKeyshares {
+				return nil, fmt.Errorf("encrypted fields of reshares cannot be appended to")
+			}
+
+			contributions, hasContributions := mutation.Contributions()
+			previousKeyshares, hasPreviousKeyshares := mutation.PreviousKeyshares()
+			if hasContributions || hasPreviousKeyshares {
+				id, err := encryptedRowID(mutation.Op(), mutation.ID)
+				if err != nil {
+					return nil, err
+				}
+				if hasContributions {
+					sealed, err := sealer.sealValues(ctx, reshare.Table, id, reshare.FieldContributions, contributions)
+					if err != nil {
+						return nil, fmt.Errorf("failed to encrypt contributions of reshare %s: %w", id, err)
+					}
+					mutation.SetContributions(sealed)
+				}
+				if hasPreviousKeyshares {
+					sealed, err := sealer.sealValues(ctx, reshare.Table, id, reshare.FieldPreviousKeyshares, previousSecretShares(previousKeyshares))
+					if err != nil {
+						return nil, fmt.Errorf("failed to encrypt previous keyshares of reshare %s: %w", id, err)
+					}
+					mutation.SetPreviousKeyshares(withPreviousSecretShares(previousKeyshares, sealed))
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			if row, ok := v.(*Reshare); ok {
+				if hasContributions {
+					row.Contributions = contributions
+				}
+				if hasPreviousKeyshares {
+					row.PreviousKeyshares = previousKeyshares
+				}
+				if err := openReshare(ctx, sealer.envelope, row); err != nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+func shareRefreshEncryptionHook(sealer *envelopeSealer) Hook {
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareRefreshMutation)
+			if !ok || isRawEnvelopeContext(ctx) {
+				return next.Mutate(ctx, m)
+			}
+			if _, appended := mutation.AppendedContributions(); appended {
+				return nil, fmt.Errorf("encrypted fields of share refreshes cannot be appended to")
+			}
+
+			contributions, hasContributions := mutation.Contributions()
+			if hasContributions {
+				id, err := encryptedRowID(mutation.Op(), mutation.ID)
+				if err != nil {
+					return nil, err
+				}
+				sealed, err := sealer.sealValues(ctx, sharerefresh.Table, id, sharerefresh.FieldContributions, contributions)
+				if err != nil {
+					return nil, fmt.Errorf("failed to encrypt contributions of share refresh %s: %w", id, err)
+				}
+				mutation.SetContributions(sealed)
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			if row, ok := v.(*ShareRefresh); ok {
+				if hasContributions {
+					row.Contributions = contributions
+				}
+				if err := openShareRefresh(ctx, sealer.envelope, row); err != nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+// sealValues seals each of the values of a field of a row, bound to the row, the field and its
+// position, into a single value. Empty values are left empty, and without an envelope the values
+// are returned as they are.
+func (s *envelopeSealer) sealValues(ctx context.Context, table string, id uuid.UUID, field string, values [][]byte) ([][]byte, error) {
+	if s.envelope == nil {
+		return values, nil
+	}
+	sealedValues := make([][]byte, len(values))
+	for i, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		sealed, err := s.seal(ctx, value, envelopeFieldAdditionalData(table, id, field, i))
+		if err != nil {
+			return nil, err
+		}
+		sealedValues[i] = sealed.Marshal()
+	}
+	return sealedValues, nil
+}
+
+// openValues opens the values of a field of a row sealed by sealValues. Values that are not sealed
+// are returned as they are.
+func openValues(ctx context.Context, envelope *keymanager.Envelope, table string, id uuid.UUID, field string, values [][]byte) ([][]byte, error) {
+	opened := make([][]byte, len(values))
+	for i, value := range values {
+		if !keymanager.IsMarshaledSealed(value) {
+			opened[i] = value
+			continue
+		}
+		if envelope == nil {
+			return nil, fmt.Errorf("%s of %s %s are encrypted but no key manager is configured", field, table, id)
+		}
+		sealed, err := keymanager.UnmarshalSealed(value)
+		if err != nil {
+			return nil, err
+		}
+		if opened[i], err = envelope.Open(ctx, sealed, envelopeFieldAdditionalData(table, id, field, i)); err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s of %s %s: %w", field, table, id, err)
+		}
+	}
+	return opened, nil
+}
+
+func openReshare(ctx context.Context, envelope *keymanager.Envelope, row *Reshare) error {
+	contributions, err := openValues(ctx, envelope, reshare.Table, row.ID, reshare.FieldContributions, row.Contributions)
+	if err != nil {
+		return err
+	}
+	secretShares, err := openValues(ctx, envelope, reshare.Table, row.ID, reshare.FieldPreviousKeyshares, previousSecretShares(row.PreviousKeyshares))
+	if err != nil {
+		return err
+	}
+	if row.Contributions != nil {
+		row.Contributions = contributions
+	}
+	if row.PreviousKeyshares != nil {
+		row.PreviousKeyshares = withPreviousSecretShares(row.PreviousKeyshares, secretShares)
+	}
+	return nil
+}
+
+func openShareRefresh(ctx context.Context, envelope *keymanager.Envelope, row *ShareRefresh) error {
+	contributions, err := openValues(ctx, envelope, sharerefresh.Table, row.ID, sharerefresh.FieldContributions, row.Contributions)
+	if err != nil {
+		return err
+	}
+	if row.Contributions != nil {
+		row.Contributions = contributions
+	}
+	return nil
+}
+
+func previousSecretShares(previousKeyshares []schema.ResharedKeyshare) [][]byte {
+	secretShares := make([][]byte, len(previousKeyshares))
+	for i, previousKeyshare := range previousKeyshares {
+		secretShares[i] = previousKeyshare.SecretShare
+	}
+	return secretShares
+}
+
+// withPreviousSecretShares returns a copy of the previous keyshares with the given secret shares.
+func withPreviousSecretShares(previousKeyshares []schema.ResharedKeyshare, secretShares [][]byte) []schema.ResharedKeyshare {
+	updated := slices.Clone(previousKeyshares)
+	for i := range updated {
+		updated[i].SecretShare = secretShares[i]
+	}
+	return updated
+}
+
+// rawEnvelopeKey marks a context in which the encrypted fields of share refreshes and reshares are
+// read and written as they are stored, to rewrap them.
+type rawEnvelopeKey struct{}
+
+func isRawEnvelopeContext(ctx context.Context) bool {
+	return ctx.Value(rawEnvelopeKey{}) != nil
+}
+
+// encryptedRowID returns the ID of the row being written. Secrets are bound to their row, so they
+// can only be written one row at a time.
+func encryptedRowID(op Op, id func() (uuid.UUID, bool)) (uuid.UUID, error) {
+	if !op.Is(OpCreate | OpUpdateOne) {
+		return uuid.Nil, fmt.Errorf("encrypted fields can only be written one row at a time, got %s", op)
+	}
+	rowID, ok := id()
+	if !ok {
+		return uuid.Nil, fmt.Errorf("encrypted fields can only be written to rows with an ID")
+	}
+	return rowID, nil
+}
+
+func envelopeAdditionalData(table string, id uuid.UUID) []byte {
+	return []byte(table + "/" + id.String())
+}
+
+func envelopeFieldAdditionalData(table string, id uuid.UUID, field string, index int) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", table, id, field, index))
+}
+
+func openSigningKeyshare(ctx context.Context, envelope *keymanager.Envelope, keyshare *SigningKeyshare) error {
+	// Nothing to decrypt if the row is in plaintext or the secret share was not selected.
+	if keyshare.KeyVersion == "" || len(keyshare.SecretShare) == 0 {
+		return nil
+	}
+	if envelope == nil {
+		return fmt.Errorf("signing keyshare %s is encrypted but no key manager is configured", keyshare.ID)
+	}
+	secretShare, err := envelope.Open(ctx, &keymanager.Sealed{
+		Ciphertext: keyshare.SecretShare,
+		WrappedKey: keyshare.EncryptedDataKey,
+		KeyVersion: keyshare.KeyVersion,
+	}, envelopeAdditionalData(signingkeyshare.Table, keyshare.ID))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt secret share of signing keyshare %s: %w", keyshare.ID, err)
+	}
+	keyshare.SecretShare = secretShare
+	return nil
+}
+
+func openSigningNonce(ctx context.Context, envelope *keymanager.Envelope, signingNonce *SigningNonce) error {
+	if signingNonce.KeyVersion == "" || len(signingNonce.Nonce) == 0 {
+		return nil
+	}
+	if envelope == nil {
+		return fmt.Errorf("signing nonce %s is encrypted but no key manager is configured", signingNonce.ID)
+	}
+	nonce, err := envelope.Open(ctx, &keymanager.Sealed{
+		Ciphertext: signingNonce.Nonce,
+		WrappedKey: signingNonce.EncryptedDataKey,
+		KeyVersion: signingNonce.KeyVersion,
+	}, envelopeAdditionalData(signingnonce.Table, signingNonce.ID))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt signing nonce %s: %w", signingNonce.ID, err)
+	}
+	signingNonce.Nonce = nonce
+	return nil
+}
+
+// ReencryptSigningSecrets brings up to batchSize signing keyshares and batchSize signing nonces
+// up to date with the current key encryption key, and returns how many rows it updated:
+//   - keyshares still stored in plaintext are encrypted;
+//   - data keys wrapped with an older key encryption key are rewrapped with the current one,
+//     without re-encrypting the secrets themselves.
+//
+// Nonces stored in plaintext are left as they are, since nonces are immutable and short-lived.
+// The contributions and previous secret shares of up to batchSize share refreshes and reshares
+// are brought up to date the same way. Every row is updated only if it did not change since it was
+// read, so this is safe to run concurrently with signing.
+func ReencryptSigningSecrets(ctx context.Context, db *Client, envelope *keymanager.Envelope, batchSize int) (int, error) {
+	updated := 0
+	keyVersion := envelope.KeyVersion()
+
+	plaintextKeyshares, err := db.SigningKeyshare.Query().
+		Where(signingkeyshare.KeyVersionIsNil()).
+		Limit(batchSize).
+		All(ctx)
+	if err != nil {
+		return updated, fmt.Errorf("failed to query plaintext signing keyshares: %w", err)
+	}
+	for _, keyshare := range plaintextKeyshares {
+		err := db.SigningKeyshare.UpdateOne(keyshare).
+			Where(
+				signingkeyshare.KeyVersionIsNil(),
+				signingkeyshare.SecretShareEQ(keyshare.SecretShare),
+			).
+			SetSecretShare(keyshare.SecretShare).
+			Exec(ctx)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return updated, fmt.Errorf("failed to encrypt signing keyshare %s: %w", keyshare.ID, err)
+		}
+		updated++
+	}
+
+	staleKeyshares, err := db.SigningKeyshare.Query().
+		Where(signingkeyshare.KeyVersionNEQ(keyVersion)).
+		Select(signingkeyshare.FieldID, signingkeyshare.FieldEncryptedDataKey, signingkeyshare.FieldKeyVersion).
+		Limit(batchSize).
+		All(ctx)
+	if err != nil {
+		return updated, fmt.Errorf("failed to query signing keyshares to rewrap: %w", err)
+	}
+	for _, keyshare := range staleKeyshares {
+		wrappedKey, newKeyVersion, err := envelope.Rewrap(ctx, keyshare.EncryptedDataKey, keyshare.KeyVersion)
+		if err != nil {
+			return updated, fmt.Errorf("failed to rewrap data key of signing keyshare %s: %w", keyshare.ID, err)
+		}
+		err = db.SigningKeyshare.UpdateOneID(keyshare.ID).
+			Where(signingkeyshare.EncryptedDataKeyEQ(keyshare.EncryptedDataKey)).
+			SetEncryptedDataKey(wrappedKey).
+			SetKeyVersion(newKeyVersion).
+			Exec(ctx)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return updated, fmt.Errorf("failed to update data key of signing keyshare %s: %w", keyshare.ID, err)
+		}
+		updated++
+	}
+
+	staleNonces, err := db.SigningNonce.Query().
+		Where(signingnonce.KeyVersionNEQ(keyVersion)).
+		Select(signingnonce.FieldID, signingnonce.FieldEncryptedDataKey, signingnonce.FieldKeyVersion).
+		Limit(batchSize).
+		All(ctx)
+	if err != nil {
+		return updated, fmt.Errorf("failed to query signing nonces to rewrap: %w", err)
+	}
+	for _, nonce := range staleNonces {
+		wrappedKey, newKeyVersion, err := envelope.Rewrap(ctx, nonce.EncryptedDataKey, nonce.KeyVersion)
+		if err != nil {
+			return updated, fmt.Errorf("failed to rewrap data key of signing nonce %s: %w", nonce.ID, err)
+		}
+		err = db.SigningNonce.UpdateOneID(nonce.ID).
+			Where(signingnonce.EncryptedDataKeyEQ(nonce.EncryptedDataKey)).
+			SetEncryptedDataKey(wrappedKey).
+			SetKeyVersion(newKeyVersion).
+			Exec(ctx)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return updated, fmt.Errorf("failed to update data key of signing nonce %s: %w", nonce.ID, err)
+		}
+		updated++
+	}
+
+	reshares, err := reencryptReshares(ctx, db, envelope, batchSize)
+	updated += reshares
+	if err != nil {
+		return updated, err
+	}
+	shareRefreshes, err := reencryptShareRefreshes(ctx, db, envelope, batchSize)
+	updated += shareRefreshes
+	return updated, err
+}
+
+func reencryptReshares(ctx context.Context, db *Client, envelope *keymanager.Envelope, batchSize int) (int, error) {
+	rawCtx := context.WithValue(ctx, rawEnvelopeKey{}, true)
+	updated := 0
+	var lastID uuid.UUID
+	for updated < batchSize {
+		rows, err := db.Reshare.Query().
+			Where(
+				reshare.IDGT(lastID),
+				reshare.Or(reshare.ContributionsNotNil(), reshare.PreviousKeysharesNotNil()),
+			).
+			Order(Asc(reshare.FieldID)).
+			Limit(batchSize).
+			All(rawCtx)
+		if err != nil {
+			return updated, fmt.Errorf("failed to query reshares to reencrypt: %w", err)
+		}
+		if len(rows) == 0 {
+			return updated, nil
+		}
+		for _, row := range rows {
+			lastID = row.ID
+			if updated == batchSize {
+				break
+			}
+			contributions, contributionsChanged, err := reencryptValues(ctx, envelope, reshare.Table, row.ID, reshare.FieldContributions, row.Contributions)
+			if err != nil {
+				return updated, err
+			}
+			secretShares, secretSharesChanged, err := reencryptValues(ctx, envelope, reshare.Table, row.ID, reshare.FieldPreviousKeyshares, previousSecretShares(row.PreviousKeyshares))
+			if err != nil {
+				return updated, err
+			}
+			if !contributionsChanged && !secretSharesChanged {
+				continue
+			}
+			update := db.Reshare.UpdateOneID(row.ID).Where(reshare.UpdateTimeEQ(row.UpdateTime))
+			if contributionsChanged {
+				update = update.SetContributions(contributions)
+			}
+			if secretSharesChanged {
+				update = update.SetPreviousKeyshares(withPreviousSecretShares(row.PreviousKeyshares, secretShares))
+			}
+			err = update.Exec(rawCtx)
+			if IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return updated, fmt.Errorf("failed to reencrypt reshare %s: %w", row.ID, err)
+			}
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+func reencryptShareRefreshes(ctx context.Context, db *Client, envelope *keymanager.Envelope, batchSize int) (int, error) {
+	rawCtx := context.WithValue(ctx, rawEnvelopeKey{}, true)
+	updated := 0
+	var lastID uuid.UUID
+	for updated < batchSize {
+		rows, err := db.ShareRefresh.Query().
+			Where(
+				sharerefresh.IDGT(lastID),
+				sharerefresh.ContributionsNotNil(),
+			).
+			Order(Asc(sharerefresh.FieldID)).
+			Limit(batchSize).
+			All(rawCtx)
+		if err != nil {
+			return updated, fmt.Errorf("failed to query share refreshes to reencrypt: %w", err)
+		}
+		if len(rows) == 0 {
+			return updated, nil
+		}
+		for _, row := range rows {
+			lastID = row.ID
+			if updated == batchSize {
+				break
+			}
+			contributions, changed, err := reencryptValues(ctx, envelope, sharerefresh.Table, row.ID, sharerefresh.FieldContributions, row.Contributions)
+			if err != nil {
+				return updated, err
+			}
+			if !changed {
+				continue
+			}
+			err = db.ShareRefresh.UpdateOneID(row.ID).
+				Where(sharerefresh.UpdateTimeEQ(row.UpdateTime)).
+				SetContributions(contributions).
+				Exec(rawCtx)
+			if IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return updated, fmt.Errorf("failed to reencrypt share refresh %s: %w", row.ID, err)
+			}
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// reencryptValues seals the values of a field of a row that are still stored in plaintext and
+// rewraps the data keys of the ones sealed under an older key encryption key, and returns whether
+// any of them changed.
+func reencryptValues(ctx context.Context, envelope *keymanager.Envelope, table string, id uuid.UUID, field string, values [][]byte) ([][]byte, bool, error) {
+	reencrypted := make([][]byte, len(values))
+	changed := false
+	for i, value := range values {
+		reencrypted[i] = value
+		if len(value) == 0 {
+			continue
+		}
+		if !keymanager.IsMarshaledSealed(value) {
+			sealed, err := envelope.Seal(ctx, value, envelopeFieldAdditionalData(table, id, field, i))
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to encrypt %s of %s %s: %w", field, table, id, err)
+			}
+			reencrypted[i] = sealed.Marshal()
+			changed = true
+			continue
+		}
+		sealed, err := keymanager.UnmarshalSealed(value)
+		if err != nil {
+			return nil, false, err
+		}
+		if sealed.KeyVersion == envelope.KeyVersion() {
+			continue
+		}
+		wrappedKey, keyVersion, err := envelope.Rewrap(ctx, sealed.WrappedKey, sealed.KeyVersion)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to rewrap data key of %s of %s %s: %w", field, table, id, err)
+		}
+		reencrypted[i] = (&keymanager.Sealed{
+			Ciphertext: sealed.Ciphertext,
+			WrappedKey: wrappedKey,
+			KeyVersion: keyVersion,
+		}).Marshal()
+		changed = true
+	}
+	return reencrypted, changed, nil
+}
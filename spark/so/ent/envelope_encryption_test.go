@@ -0,0 +1,293 @@
+package ent_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/keymanager"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEnvelope(t *testing.T, currentVersion string) *keymanager.Envelope {
+	keyManager, err := keymanager.NewLocalKeyManager(map[string][]byte{
+		"v1": bytes.Repeat([]byte{1}, 32),
+		"v2": bytes.Repeat([]byte{2}, 32),
+	}, currentVersion)
+	require.NoError(t, err)
+	return keymanager.NewEnvelope(keyManager)
+}
+
+func createTestKeyshare(ctx context.Context, t *testing.T, db *ent.Client, secretShare []byte) *ent.SigningKeyshare {
+	keyshare, err := db.SigningKeyshare.Create().
+		SetStatus(schema.KeyshareStatusAvailable).
+		SetSecretShare(secretShare).
+		SetPublicShares(map[string][]byte{}).
+		SetPublicKey(append([]byte{2}, secretShare...)).
+		SetMinSigners(2).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+	return keyshare
+}
+
+func TestEnvelopeEncryption(t *testing.T) {
+	ctx := context.Background()
+	dsn := "file:envelope_encryption?mode=memory&cache=shared&_fk=1"
+	raw := enttest.Open(t, "sqlite3", dsn)
+	defer raw.Close()
+	db := enttest.Open(t, "sqlite3", dsn)
+	defer db.Close()
+	ent.UseEnvelopeEncryption(db, newTestEnvelope(t, "v1"))
+
+	secretShare := []byte("secret share")
+	keyshare := createTestKeyshare(ctx, t, db, secretShare)
+	require.Equal(t, secretShare, keyshare.SecretShare)
+	require.Equal(t, "v1", keyshare.KeyVersion)
+
+	stored, err := raw.SigningKeyshare.Get(ctx, keyshare.ID)
+	require.NoError(t, err)
+	require.NotContains(t, string(stored.SecretShare), string(secretShare))
+	require.NotEmpty(t, stored.EncryptedDataKey)
+
+	loaded, err := db.SigningKeyshare.Get(ctx, keyshare.ID)
+	require.NoError(t, err)
+	require.Equal(t, secretShare, loaded.SecretShare)
+
+	nonce, err := db.SigningNonce.Create().
+		SetNonce([]byte("nonce")).
+		SetNonceCommitment([]byte("commitment")).
+		Save(ctx)
+	require.NoError(t, err)
+	storedNonce, err := raw.SigningNonce.Get(ctx, nonce.ID)
+	require.NoError(t, err)
+	require.NotEqual(t, []byte("nonce"), storedNonce.Nonce)
+	loadedNonce, err := db.SigningNonce.Get(ctx, nonce.ID)
+	require.NoError(t, err)
+	require.Equal(t, []byte("nonce"), loadedNonce.Nonce)
+
+	// Secrets are bound to their row.
+	_, err = db.SigningKeyshare.Update().SetSecretShare(secretShare).Save(ctx)
+	require.Error(t, err)
+	err = raw.SigningKeyshare.UpdateOneID(keyshare.ID).SetSecretShare(storedNonce.Nonce).
+		SetEncryptedDataKey(storedNonce.EncryptedDataKey).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.SigningKeyshare.Get(ctx, keyshare.ID)
+	require.Error(t, err)
+
+	// Encrypted rows cannot be read without a key manager.
+	noKeyManager := enttest.Open(t, "sqlite3", dsn)
+	defer noKeyManager.Close()
+	ent.UseEnvelopeEncryption(noKeyManager, nil)
+	_, err = noKeyManager.SigningNonce.Get(ctx, nonce.ID)
+	require.Error(t, err)
+}
+
+func TestReencryptSigningSecrets(t *testing.T) {
+	ctx := context.Background()
+	dsn := "file:reencrypt_signing_secrets?mode=memory&cache=shared&_fk=1"
+	raw := enttest.Open(t, "sqlite3", dsn)
+	defer raw.Close()
+
+	legacySecret := []byte("legacy secret share")
+	legacy := createTestKeyshare(ctx, t, raw, legacySecret)
+
+	v1 := enttest.Open(t, "sqlite3", dsn)
+	defer v1.Close()
+	ent.UseEnvelopeEncryption(v1, newTestEnvelope(t, "v1"))
+	secretShare := []byte("secret share")
+	keyshare := createTestKeyshare(ctx, t, v1, secretShare)
+	nonce, err := v1.SigningNonce.Create().
+		SetNonce([]byte("nonce")).
+		SetNonceCommitment([]byte("commitment")).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Plaintext rows are still readable once encryption is enabled.
+	loaded, err := v1.SigningKeyshare.Get(ctx, legacy.ID)
+	require.NoError(t, err)
+	require.Equal(t, legacySecret, loaded.SecretShare)
+
+	v2Envelope := newTestEnvelope(t, "v2")
+	v2 := enttest.Open(t, "sqlite3", dsn)
+	defer v2.Close()
+	ent.UseEnvelopeEncryption(v2, v2Envelope)
+
+	updated, err := ent.ReencryptSigningSecrets(ctx, v2, v2Envelope, 10)
+	require.NoError(t, err)
+	// The legacy keyshare is encrypted with v2 right away, so it is not rewrapped again.
+	require.Equal(t, 3, updated)
+	updated, err = ent.ReencryptSigningSecrets(ctx, v2, v2Envelope, 10)
+	require.NoError(t, err)
+	require.Zero(t, updated)
+
+	storedLegacy, err := raw.SigningKeyshare.Get(ctx, legacy.ID)
+	require.NoError(t, err)
+	require.Equal(t, "v2", storedLegacy.KeyVersion)
+	require.NotEqual(t, legacySecret, storedLegacy.SecretShare)
+
+	// Everything can be read with only the v2 key encryption key.
+	v2Only, err := keymanager.NewLocalKeyManager(map[string][]byte{"v2": bytes.Repeat([]byte{2}, 32)}, "v2")
+	require.NoError(t, err)
+	reader := enttest.Open(t, "sqlite3", dsn)
+	defer reader.Close()
+	ent.UseEnvelopeEncryption(reader, keymanager.NewEnvelope(v2Only))
+
+	for id, want := range map[*ent.SigningKeyshare][]byte{legacy: legacySecret, keyshare: secretShare} {
+		loaded, err := reader.SigningKeyshare.Get(ctx, id.ID)
+		require.NoError(t, err)
+		require.Equal(t, "v2", loaded.KeyVersion)
+		require.Equal(t, want, loaded.SecretShare)
+	}
+	loadedNonce, err := reader.SigningNonce.Get(ctx, nonce.ID)
+	require.NoError(t, err)
+	require.Equal(t, []byte("nonce"), loadedNonce.Nonce)
+}
+
+// countingKeyManager counts the data keys wrapped by a key manager.
+type countingKeyManager struct {
+	keymanager.KeyManager
+	encrypted int
+}
+
+func (k *countingKeyManager) EncryptDataKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	k.encrypted++
+	return k.KeyManager.EncryptDataKey(ctx, dataKey)
+}
+
+func TestEnvelopeEncryptionSharesDataKeyPerTransaction(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:envelope_encryption_batches?mode=memory&cache=shared&_fk=1")
+	defer db.Close()
+	localKeyManager, err := keymanager.NewLocalKeyManager(map[string][]byte{"v1": bytes.Repeat([]byte{1}, 32)}, "v1")
+	require.NoError(t, err)
+	keyManager := &countingKeyManager{KeyManager: localKeyManager}
+	ent.UseEnvelopeEncryption(db, keymanager.NewEnvelope(keyManager))
+
+	writeBatch := func(prefix byte) []*ent.SigningKeyshare {
+		tx, err := db.Tx(ctx)
+		require.NoError(t, err)
+		txCtx := context.WithValue(ctx, ent.TxKey, tx)
+		keyshares := make([]*ent.SigningKeyshare, 5)
+		for i := range keyshares {
+			keyshares[i] = createTestKeyshare(txCtx, t, tx.Client(), []byte{prefix, byte(i)})
+			_, err := tx.SigningNonce.Create().
+				SetNonce([]byte{prefix, byte(i)}).
+				SetNonceCommitment([]byte{prefix, byte(i)}).
+				Save(txCtx)
+			require.NoError(t, err)
+		}
+		require.NoError(t, tx.Commit())
+		return keyshares
+	}
+
+	// The rows of a transaction are sealed under one data key, wrapped with a single call.
+	first := writeBatch(1)
+	require.Equal(t, 1, keyManager.encrypted)
+	second := writeBatch(2)
+	require.Equal(t, 2, keyManager.encrypted)
+	require.NotEqual(t, first[0].EncryptedDataKey, second[0].EncryptedDataKey)
+
+	for i, keyshare := range first {
+		loaded, err := db.SigningKeyshare.Get(ctx, keyshare.ID)
+		require.NoError(t, err)
+		require.Equal(t, first[0].EncryptedDataKey, loaded.EncryptedDataKey)
+		require.Equal(t, []byte{1, byte(i)}, loaded.SecretShare)
+	}
+
+	// Rows written outside of a transaction each get their own data key.
+	createTestKeyshare(ctx, t, db, []byte("alone"))
+	require.Equal(t, 3, keyManager.encrypted)
+}
+
+func TestEnvelopeEncryptionOfReshareSecrets(t *testing.T) {
+	ctx := context.Background()
+	dsn := "file:envelope_encryption_reshares?mode=memory&cache=shared&_fk=1"
+	raw := enttest.Open(t, "sqlite3", dsn)
+	defer raw.Close()
+	v1 := enttest.Open(t, "sqlite3", dsn)
+	defer v1.Close()
+	ent.UseEnvelopeEncryption(v1, newTestEnvelope(t, "v1"))
+
+	contributions := [][]byte{[]byte("first contribution"), []byte("second contribution")}
+	previousKeyshares := []schema.ResharedKeyshare{
+		{Existed: true, SecretShare: []byte("previous share"), MinSigners: 2},
+		{Existed: false},
+	}
+	reshare, err := v1.Reshare.Create().
+		SetStatus(schema.ShareRefreshStatusCommitted).
+		SetCoordinatorIndex(0).
+		SetKeyshares([][]byte{}).
+		SetDealers([]string{}).
+		SetContributions(contributions).
+		SetPreviousKeyshares(previousKeyshares).
+		Save(ctx)
+	require.NoError(t, err)
+	require.Equal(t, contributions, reshare.Contributions)
+	require.Equal(t, previousKeyshares, reshare.PreviousKeyshares)
+	shareRefresh, err := v1.ShareRefresh.Create().
+		SetStatus(schema.ShareRefreshStatusPrepared).
+		SetCoordinatorIndex(0).
+		SetKeyshareIds([]uuid.UUID{}).
+		SetContributions(contributions).
+		Save(ctx)
+	require.NoError(t, err)
+	require.Equal(t, contributions, shareRefresh.Contributions)
+
+	// The secrets are sealed at rest, and read back in plaintext.
+	storedReshare, err := raw.Reshare.Get(ctx, reshare.ID)
+	require.NoError(t, err)
+	for i, contribution := range storedReshare.Contributions {
+		require.True(t, keymanager.IsMarshaledSealed(contribution))
+		require.NotContains(t, string(contribution), string(contributions[i]))
+	}
+	require.True(t, keymanager.IsMarshaledSealed(storedReshare.PreviousKeyshares[0].SecretShare))
+	require.Empty(t, storedReshare.PreviousKeyshares[1].SecretShare)
+	storedShareRefresh, err := raw.ShareRefresh.Get(ctx, shareRefresh.ID)
+	require.NoError(t, err)
+	for _, contribution := range storedShareRefresh.Contributions {
+		require.True(t, keymanager.IsMarshaledSealed(contribution))
+	}
+	loadedReshare, err := v1.Reshare.Get(ctx, reshare.ID)
+	require.NoError(t, err)
+	require.Equal(t, contributions, loadedReshare.Contributions)
+	require.Equal(t, previousKeyshares, loadedReshare.PreviousKeyshares)
+
+	// Secrets are bound to their row and field.
+	err = raw.ShareRefresh.UpdateOneID(shareRefresh.ID).SetContributions(storedReshare.Contributions).Exec(ctx)
+	require.NoError(t, err)
+	_, err = v1.ShareRefresh.Get(ctx, shareRefresh.ID)
+	require.Error(t, err)
+	err = raw.ShareRefresh.UpdateOneID(shareRefresh.ID).SetContributions(storedShareRefresh.Contributions).Exec(ctx)
+	require.NoError(t, err)
+
+	// Rotating the key encryption key rewraps them.
+	v2Envelope := newTestEnvelope(t, "v2")
+	v2 := enttest.Open(t, "sqlite3", dsn)
+	defer v2.Close()
+	ent.UseEnvelopeEncryption(v2, v2Envelope)
+	updated, err := ent.ReencryptSigningSecrets(ctx, v2, v2Envelope, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, updated)
+	updated, err = ent.ReencryptSigningSecrets(ctx, v2, v2Envelope, 10)
+	require.NoError(t, err)
+	require.Zero(t, updated)
+
+	v2Only, err := keymanager.NewLocalKeyManager(map[string][]byte{"v2": bytes.Repeat([]byte{2}, 32)}, "v2")
+	require.NoError(t, err)
+	reader := enttest.Open(t, "sqlite3", dsn)
+	defer reader.Close()
+	ent.UseEnvelopeEncryption(reader, keymanager.NewEnvelope(v2Only))
+	loadedReshare, err = reader.Reshare.Get(ctx, reshare.ID)
+	require.NoError(t, err)
+	require.Equal(t, contributions, loadedReshare.Contributions)
+	require.Equal(t, previousKeyshares, loadedReshare.PreviousKeyshares)
+	loadedShareRefresh, err := reader.ShareRefresh.Get(ctx, shareRefresh.ID)
+	require.NoError(t, err)
+	require.Equal(t, contributions, loadedShareRefresh.Contributions)
+}
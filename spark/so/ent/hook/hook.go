@@ -9,6 +9,30 @@ import (
 	"github.com/lightsparkdev/spark/so/ent"
 )
 
+// The ArchiveFunc type is an adapter to allow the use of ordinary
+// function as Archive mutator.
+type ArchiveFunc func(context.Context, *ent.ArchiveMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArchiveFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArchiveMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArchiveMutation", m)
+}
+
+// The ArchivedTransferFunc type is an adapter to allow the use of ordinary
+// function as ArchivedTransfer mutator.
+type ArchivedTransferFunc func(context.Context, *ent.ArchivedTransferMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArchivedTransferFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArchivedTransferMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArchivedTransferMutation", m)
+}
+
 // The BlockHeightFunc type is an adapter to allow the use of ordinary
 // function as BlockHeight mutator.
 type BlockHeightFunc func(context.Context, *ent.BlockHeightMutation) (ent.Value, error)
@@ -21,6 +45,30 @@ func (f BlockHeightFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlockHeightMutation", m)
 }
 
+// The ConsistencyAuditCursorFunc type is an adapter to allow the use of ordinary
+// function as ConsistencyAuditCursor mutator.
+type ConsistencyAuditCursorFunc func(context.Context, *ent.ConsistencyAuditCursorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConsistencyAuditCursorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConsistencyAuditCursorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConsistencyAuditCursorMutation", m)
+}
+
+// The ConsistencyDiscrepancyFunc type is an adapter to allow the use of ordinary
+// function as ConsistencyDiscrepancy mutator.
+type ConsistencyDiscrepancyFunc func(context.Context, *ent.ConsistencyDiscrepancyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConsistencyDiscrepancyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConsistencyDiscrepancyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConsistencyDiscrepancyMutation", m)
+}
+
 // The CooperativeExitFunc type is an adapter to allow the use of ordinary
 // function as CooperativeExit mutator.
 type CooperativeExitFunc func(context.Context, *ent.CooperativeExitMutation) (ent.Value, error)
@@ -45,6 +93,54 @@ func (f DepositAddressFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DepositAddressMutation", m)
 }
 
+// The DkgSessionFunc type is an adapter to allow the use of ordinary
+// function as DkgSession mutator.
+type DkgSessionFunc func(context.Context, *ent.DkgSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DkgSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DkgSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DkgSessionMutation", m)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *ent.IdempotencyKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdempotencyKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdempotencyKeyMutation", m)
+}
+
+// The NetworkPauseFunc type is an adapter to allow the use of ordinary
+// function as NetworkPause mutator.
+type NetworkPauseFunc func(context.Context, *ent.NetworkPauseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NetworkPauseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NetworkPauseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NetworkPauseMutation", m)
+}
+
+// The OperatorCallNonceFunc type is an adapter to allow the use of ordinary
+// function as OperatorCallNonce mutator.
+type OperatorCallNonceFunc func(context.Context, *ent.OperatorCallNonceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OperatorCallNonceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OperatorCallNonceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OperatorCallNonceMutation", m)
+}
+
 // The PreimageRequestFunc type is an adapter to allow the use of ordinary
 // function as PreimageRequest mutator.
 type PreimageRequestFunc func(context.Context, *ent.PreimageRequestMutation) (ent.Value, error)
@@ -69,6 +165,66 @@ func (f PreimageShareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PreimageShareMutation", m)
 }
 
+// The RecentWriteFunc type is an adapter to allow the use of ordinary
+// function as RecentWrite mutator.
+type RecentWriteFunc func(context.Context, *ent.RecentWriteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecentWriteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecentWriteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecentWriteMutation", m)
+}
+
+// The ReshareFunc type is an adapter to allow the use of ordinary
+// function as Reshare mutator.
+type ReshareFunc func(context.Context, *ent.ReshareMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReshareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReshareMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReshareMutation", m)
+}
+
+// The SessionRevocationFunc type is an adapter to allow the use of ordinary
+// function as SessionRevocation mutator.
+type SessionRevocationFunc func(context.Context, *ent.SessionRevocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionRevocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SessionRevocationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionRevocationMutation", m)
+}
+
+// The ShareRefreshFunc type is an adapter to allow the use of ordinary
+// function as ShareRefresh mutator.
+type ShareRefreshFunc func(context.Context, *ent.ShareRefreshMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareRefreshFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShareRefreshMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareRefreshMutation", m)
+}
+
+// The SigningIncidentFunc type is an adapter to allow the use of ordinary
+// function as SigningIncident mutator.
+type SigningIncidentFunc func(context.Context, *ent.SigningIncidentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SigningIncidentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SigningIncidentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SigningIncidentMutation", m)
+}
+
 // The SigningKeyshareFunc type is an adapter to allow the use of ordinary
 // function as SigningKeyshare mutator.
 type SigningKeyshareFunc func(context.Context, *ent.SigningKeyshareMutation) (ent.Value, error)
@@ -93,6 +249,30 @@ func (f SigningNonceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SigningNonceMutation", m)
 }
 
+// The TaskLockFunc type is an adapter to allow the use of ordinary
+// function as TaskLock mutator.
+type TaskLockFunc func(context.Context, *ent.TaskLockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskLockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskLockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskLockMutation", m)
+}
+
+// The TaskRunFunc type is an adapter to allow the use of ordinary
+// function as TaskRun mutator.
+type TaskRunFunc func(context.Context, *ent.TaskRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskRunMutation", m)
+}
+
 // The TokenFreezeFunc type is an adapter to allow the use of ordinary
 // function as TokenFreeze mutator.
 type TokenFreezeFunc func(context.Context, *ent.TokenFreezeMutation) (ent.Value, error)
@@ -0,0 +1,181 @@
+package ent
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/so/ent/idempotencykey"
+	"github.com/lightsparkdev/spark/so/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// ReserveIdempotencyKey looks up the request sent with the idempotency key for the method and
+// scope. If the key is new, or its previous use has expired, the key is reserved for this request
+// and the returned row has no response. Otherwise the returned row holds the response to the
+// earlier request.
+//
+// The reservation is committed right away, outside of the transaction in the context, so that
+// retries running concurrently with the request see it. Its response is stored by
+// CompleteIdempotencyKey in the transaction of the request, so that retries see the response once
+// the request has committed. A reservation without a response is abandoned after
+// spark.IdempotencyKeyReservationTimeout, in case the request never finished; the request it was
+// made for then fails to complete it.
+//
+// It returns an InvalidArgument error if the key was used for a request with a different hash,
+// and an Aborted error if a request with the key is still in progress.
+func ReserveIdempotencyKey(ctx context.Context, method string, scope string, key string, requestHash []byte) (*IdempotencyKey, error) {
+	db := idempotencyKeyClient(ctx)
+	existing, err := lookUpIdempotencyKey(ctx, db, method, scope, key, requestHash)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	reserved, err := db.Create().
+		SetMethod(method).
+		SetScope(scope).
+		SetKey(key).
+		SetRequestHash(requestHash).
+		Save(ctx)
+	if IsConstraintError(err) {
+		// A retry reserved the key concurrently. Its reservation is committed by now.
+		existing, err := lookUpIdempotencyKey(ctx, db, method, scope, key, requestHash)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return existing, nil
+		}
+		return nil, errors.AbortedErrorf("a request with idempotency key %s is in progress", key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	return reserved, nil
+}
+
+// lookUpIdempotencyKey returns the completed request sent with the idempotency key, or nil if the
+// key is free to be reserved. Expired keys and abandoned reservations are deleted.
+func lookUpIdempotencyKey(ctx context.Context, db *IdempotencyKeyClient, method string, scope string, key string, requestHash []byte) (*IdempotencyKey, error) {
+	existing, err := db.Query().
+		Where(
+			idempotencykey.MethodEQ(method),
+			idempotencykey.ScopeEQ(scope),
+			idempotencykey.KeyEQ(key),
+		).
+		Only(ctx)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up idempotency key: %w", err)
+	}
+
+	now := time.Now()
+	if existing.CreateTime.Before(now.Add(-spark.IdempotencyKeyRetention)) {
+		if err := db.DeleteOne(existing).Exec(ctx); err != nil && !IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete expired idempotency key: %w", err)
+		}
+		return nil, nil
+	}
+	if !bytes.Equal(existing.RequestHash, requestHash) {
+		return nil, errors.Reasonf(errors.ReasonIdempotencyKeyReused, "idempotency key %s was already used for a different request", key)
+	}
+	if existing.Completed() {
+		return existing, nil
+	}
+	if existing.CreateTime.Before(now.Add(-spark.IdempotencyKeyReservationTimeout)) {
+		if err := deleteIdempotencyKeyReservation(ctx, db, existing); err != nil {
+			return nil, fmt.Errorf("failed to delete abandoned idempotency key reservation: %w", err)
+		}
+		return nil, nil
+	}
+	return nil, errors.AbortedErrorf("a request with idempotency key %s is in progress", key)
+}
+
+// idempotencyKeyClient returns the client to reserve idempotency keys with, outside of the
+// transaction in the context when possible.
+func idempotencyKeyClient(ctx context.Context) *IdempotencyKeyClient {
+	if client := GetClientFromContext(ctx); client != nil {
+		return client.IdempotencyKey
+	}
+	return GetDbFromContext(ctx).IdempotencyKey
+}
+
+// deleteIdempotencyKeyReservation deletes the reservation of a key unless its request has
+// completed.
+func deleteIdempotencyKeyReservation(ctx context.Context, db *IdempotencyKeyClient, key *IdempotencyKey) error {
+	_, err := db.Delete().
+		Where(
+			idempotencykey.ID(key.ID),
+			idempotencykey.Or(idempotencykey.ResponseTypeIsNil(), idempotencykey.ResponseTypeEQ("")),
+		).
+		Exec(ctx)
+	return err
+}
+
+// Completed returns whether the request sent with the idempotency key has succeeded.
+func (k *IdempotencyKey) Completed() bool {
+	return k.ResponseType != ""
+}
+
+// CompleteIdempotencyKey stores the response to the request the key was reserved for, in the
+// transaction of the request. It fails if the reservation was abandoned in the meantime.
+func CompleteIdempotencyKey(ctx context.Context, key *IdempotencyKey, response proto.Message) error {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return GetDbFromContext(ctx).IdempotencyKey.UpdateOne(key).
+		SetResponseType(string(proto.MessageName(response))).
+		SetResponse(data).
+		Exec(ctx)
+}
+
+// ReleaseIdempotencyKey deletes the reservation of a key whose request failed, outside of the
+// transaction in the context like the reservation, so that the request can be retried with the
+// same key.
+func ReleaseIdempotencyKey(ctx context.Context, key *IdempotencyKey) error {
+	return deleteIdempotencyKeyReservation(ctx, idempotencyKeyClient(ctx), key)
+}
+
+// StoredResponse returns the response stored for a completed request.
+func (k *IdempotencyKey) StoredResponse() (proto.Message, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(k.ResponseType))
+	if err != nil {
+		return nil, fmt.Errorf("unknown response type %s: %w", k.ResponseType, err)
+	}
+	response := messageType.New().Interface()
+	if err := proto.Unmarshal(k.Response, response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return response, nil
+}
+
+// PurgeIdempotencyKeys deletes up to batchSize idempotency keys older than
+// spark.IdempotencyKeyRetention, and returns how many it deleted.
+func PurgeIdempotencyKeys(ctx context.Context, batchSize int) (int, error) {
+	db := GetDbFromContext(ctx)
+	ids, err := db.IdempotencyKey.Query().
+		Where(idempotencykey.CreateTimeLT(time.Now().Add(-spark.IdempotencyKeyRetention))).
+		Limit(batchSize).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query expired idempotency keys: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	purged, err := db.IdempotencyKey.Delete().Where(idempotencykey.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge idempotency keys: %w", err)
+	}
+	return purged, nil
+}
@@ -8,14 +8,29 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
 	"github.com/lightsparkdev/spark/so/ent/blockheight"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
 	"github.com/lightsparkdev/spark/so/ent/cooperativeexit"
 	"github.com/lightsparkdev/spark/so/ent/depositaddress"
+	"github.com/lightsparkdev/spark/so/ent/dkgsession"
+	"github.com/lightsparkdev/spark/so/ent/idempotencykey"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
 	"github.com/lightsparkdev/spark/so/ent/predicate"
 	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
 	"github.com/lightsparkdev/spark/so/ent/preimageshare"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+	"github.com/lightsparkdev/spark/so/ent/reshare"
+	"github.com/lightsparkdev/spark/so/ent/sessionrevocation"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+	"github.com/lightsparkdev/spark/so/ent/signingincident"
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
+	"github.com/lightsparkdev/spark/so/ent/tasklock"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
 	"github.com/lightsparkdev/spark/so/ent/tokenleaf"
 	"github.com/lightsparkdev/spark/so/ent/tokenmint"
@@ -87,6 +102,60 @@ func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
 	return f(ctx, query)
 }
 
+// The ArchiveFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ArchiveFunc func(context.Context, *ent.ArchiveQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ArchiveFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ArchiveQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ArchiveQuery", q)
+}
+
+// The TraverseArchive type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseArchive func(context.Context, *ent.ArchiveQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseArchive) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseArchive) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ArchiveQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ArchiveQuery", q)
+}
+
+// The ArchivedTransferFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ArchivedTransferFunc func(context.Context, *ent.ArchivedTransferQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ArchivedTransferFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ArchivedTransferQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ArchivedTransferQuery", q)
+}
+
+// The TraverseArchivedTransfer type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseArchivedTransfer func(context.Context, *ent.ArchivedTransferQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseArchivedTransfer) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseArchivedTransfer) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ArchivedTransferQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ArchivedTransferQuery", q)
+}
+
 // The BlockHeightFunc type is an adapter to allow the use of ordinary function as a Querier.
 type BlockHeightFunc func(context.Context, *ent.BlockHeightQuery) (ent.Value, error)
 
@@ -114,6 +183,60 @@ func (f TraverseBlockHeight) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.BlockHeightQuery", q)
 }
 
+// The ConsistencyAuditCursorFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ConsistencyAuditCursorFunc func(context.Context, *ent.ConsistencyAuditCursorQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ConsistencyAuditCursorFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ConsistencyAuditCursorQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ConsistencyAuditCursorQuery", q)
+}
+
+// The TraverseConsistencyAuditCursor type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseConsistencyAuditCursor func(context.Context, *ent.ConsistencyAuditCursorQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseConsistencyAuditCursor) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseConsistencyAuditCursor) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ConsistencyAuditCursorQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ConsistencyAuditCursorQuery", q)
+}
+
+// The ConsistencyDiscrepancyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ConsistencyDiscrepancyFunc func(context.Context, *ent.ConsistencyDiscrepancyQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ConsistencyDiscrepancyFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ConsistencyDiscrepancyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ConsistencyDiscrepancyQuery", q)
+}
+
+// The TraverseConsistencyDiscrepancy type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseConsistencyDiscrepancy func(context.Context, *ent.ConsistencyDiscrepancyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseConsistencyDiscrepancy) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseConsistencyDiscrepancy) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ConsistencyDiscrepancyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ConsistencyDiscrepancyQuery", q)
+}
+
 // The CooperativeExitFunc type is an adapter to allow the use of ordinary function as a Querier.
 type CooperativeExitFunc func(context.Context, *ent.CooperativeExitQuery) (ent.Value, error)
 
@@ -168,6 +291,114 @@ func (f TraverseDepositAddress) Traverse(ctx context.Context, q ent.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *ent.DepositAddressQuery", q)
 }
 
+// The DkgSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DkgSessionFunc func(context.Context, *ent.DkgSessionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DkgSessionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DkgSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DkgSessionQuery", q)
+}
+
+// The TraverseDkgSession type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDkgSession func(context.Context, *ent.DkgSessionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDkgSession) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDkgSession) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DkgSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DkgSessionQuery", q)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type IdempotencyKeyFunc func(context.Context, *ent.IdempotencyKeyQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f IdempotencyKeyFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.IdempotencyKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.IdempotencyKeyQuery", q)
+}
+
+// The TraverseIdempotencyKey type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseIdempotencyKey func(context.Context, *ent.IdempotencyKeyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseIdempotencyKey) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseIdempotencyKey) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.IdempotencyKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.IdempotencyKeyQuery", q)
+}
+
+// The NetworkPauseFunc type is an adapter to allow the use of ordinary function as a Querier.
+type NetworkPauseFunc func(context.Context, *ent.NetworkPauseQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f NetworkPauseFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.NetworkPauseQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.NetworkPauseQuery", q)
+}
+
+// The TraverseNetworkPause type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseNetworkPause func(context.Context, *ent.NetworkPauseQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseNetworkPause) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseNetworkPause) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.NetworkPauseQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.NetworkPauseQuery", q)
+}
+
+// The OperatorCallNonceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OperatorCallNonceFunc func(context.Context, *ent.OperatorCallNonceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OperatorCallNonceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OperatorCallNonceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OperatorCallNonceQuery", q)
+}
+
+// The TraverseOperatorCallNonce type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOperatorCallNonce func(context.Context, *ent.OperatorCallNonceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOperatorCallNonce) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOperatorCallNonce) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OperatorCallNonceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OperatorCallNonceQuery", q)
+}
+
 // The PreimageRequestFunc type is an adapter to allow the use of ordinary function as a Querier.
 type PreimageRequestFunc func(context.Context, *ent.PreimageRequestQuery) (ent.Value, error)
 
@@ -222,6 +453,141 @@ func (f TraversePreimageShare) Traverse(ctx context.Context, q ent.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *ent.PreimageShareQuery", q)
 }
 
+// The RecentWriteFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RecentWriteFunc func(context.Context, *ent.RecentWriteQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f RecentWriteFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.RecentWriteQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.RecentWriteQuery", q)
+}
+
+// The TraverseRecentWrite type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRecentWrite func(context.Context, *ent.RecentWriteQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRecentWrite) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRecentWrite) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RecentWriteQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.RecentWriteQuery", q)
+}
+
+// The ReshareFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ReshareFunc func(context.Context, *ent.ReshareQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ReshareFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ReshareQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ReshareQuery", q)
+}
+
+// The TraverseReshare type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseReshare func(context.Context, *ent.ReshareQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseReshare) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseReshare) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReshareQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ReshareQuery", q)
+}
+
+// The SessionRevocationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SessionRevocationFunc func(context.Context, *ent.SessionRevocationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SessionRevocationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SessionRevocationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SessionRevocationQuery", q)
+}
+
+// The TraverseSessionRevocation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSessionRevocation func(context.Context, *ent.SessionRevocationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSessionRevocation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSessionRevocation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SessionRevocationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SessionRevocationQuery", q)
+}
+
+// The ShareRefreshFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ShareRefreshFunc func(context.Context, *ent.ShareRefreshQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ShareRefreshFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ShareRefreshQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ShareRefreshQuery", q)
+}
+
+// The TraverseShareRefresh type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseShareRefresh func(context.Context, *ent.ShareRefreshQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseShareRefresh) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseShareRefresh) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ShareRefreshQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ShareRefreshQuery", q)
+}
+
+// The SigningIncidentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SigningIncidentFunc func(context.Context, *ent.SigningIncidentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SigningIncidentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SigningIncidentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SigningIncidentQuery", q)
+}
+
+// The TraverseSigningIncident type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSigningIncident func(context.Context, *ent.SigningIncidentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSigningIncident) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSigningIncident) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SigningIncidentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SigningIncidentQuery", q)
+}
+
 // The SigningKeyshareFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SigningKeyshareFunc func(context.Context, *ent.SigningKeyshareQuery) (ent.Value, error)
 
@@ -276,6 +642,60 @@ func (f TraverseSigningNonce) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.SigningNonceQuery", q)
 }
 
+// The TaskLockFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TaskLockFunc func(context.Context, *ent.TaskLockQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TaskLockFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TaskLockQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TaskLockQuery", q)
+}
+
+// The TraverseTaskLock type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTaskLock func(context.Context, *ent.TaskLockQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTaskLock) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTaskLock) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TaskLockQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TaskLockQuery", q)
+}
+
+// The TaskRunFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TaskRunFunc func(context.Context, *ent.TaskRunQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TaskRunFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TaskRunQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TaskRunQuery", q)
+}
+
+// The TraverseTaskRun type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTaskRun func(context.Context, *ent.TaskRunQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTaskRun) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTaskRun) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TaskRunQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TaskRunQuery", q)
+}
+
 // The TokenFreezeFunc type is an adapter to allow the use of ordinary function as a Querier.
 type TokenFreezeFunc func(context.Context, *ent.TokenFreezeQuery) (ent.Value, error)
 
@@ -630,20 +1050,50 @@ func (f TraverseUtxoSwap) Traverse(ctx context.Context, q ent.Query) error {
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
+	case *ent.ArchiveQuery:
+		return &query[*ent.ArchiveQuery, predicate.Archive, archive.OrderOption]{typ: ent.TypeArchive, tq: q}, nil
+	case *ent.ArchivedTransferQuery:
+		return &query[*ent.ArchivedTransferQuery, predicate.ArchivedTransfer, archivedtransfer.OrderOption]{typ: ent.TypeArchivedTransfer, tq: q}, nil
 	case *ent.BlockHeightQuery:
 		return &query[*ent.BlockHeightQuery, predicate.BlockHeight, blockheight.OrderOption]{typ: ent.TypeBlockHeight, tq: q}, nil
+	case *ent.ConsistencyAuditCursorQuery:
+		return &query[*ent.ConsistencyAuditCursorQuery, predicate.ConsistencyAuditCursor, consistencyauditcursor.OrderOption]{typ: ent.TypeConsistencyAuditCursor, tq: q}, nil
+	case *ent.ConsistencyDiscrepancyQuery:
+		return &query[*ent.ConsistencyDiscrepancyQuery, predicate.ConsistencyDiscrepancy, consistencydiscrepancy.OrderOption]{typ: ent.TypeConsistencyDiscrepancy, tq: q}, nil
 	case *ent.CooperativeExitQuery:
 		return &query[*ent.CooperativeExitQuery, predicate.CooperativeExit, cooperativeexit.OrderOption]{typ: ent.TypeCooperativeExit, tq: q}, nil
 	case *ent.DepositAddressQuery:
 		return &query[*ent.DepositAddressQuery, predicate.DepositAddress, depositaddress.OrderOption]{typ: ent.TypeDepositAddress, tq: q}, nil
+	case *ent.DkgSessionQuery:
+		return &query[*ent.DkgSessionQuery, predicate.DkgSession, dkgsession.OrderOption]{typ: ent.TypeDkgSession, tq: q}, nil
+	case *ent.IdempotencyKeyQuery:
+		return &query[*ent.IdempotencyKeyQuery, predicate.IdempotencyKey, idempotencykey.OrderOption]{typ: ent.TypeIdempotencyKey, tq: q}, nil
+	case *ent.NetworkPauseQuery:
+		return &query[*ent.NetworkPauseQuery, predicate.NetworkPause, networkpause.OrderOption]{typ: ent.TypeNetworkPause, tq: q}, nil
+	case *ent.OperatorCallNonceQuery:
+		return &query[*ent.OperatorCallNonceQuery, predicate.OperatorCallNonce, operatorcallnonce.OrderOption]{typ: ent.TypeOperatorCallNonce, tq: q}, nil
 	case *ent.PreimageRequestQuery:
 		return &query[*ent.PreimageRequestQuery, predicate.PreimageRequest, preimagerequest.OrderOption]{typ: ent.TypePreimageRequest, tq: q}, nil
 	case *ent.PreimageShareQuery:
 		return &query[*ent.PreimageShareQuery, predicate.PreimageShare, preimageshare.OrderOption]{typ: ent.TypePreimageShare, tq: q}, nil
+	case *ent.RecentWriteQuery:
+		return &query[*ent.RecentWriteQuery, predicate.RecentWrite, recentwrite.OrderOption]{typ: ent.TypeRecentWrite, tq: q}, nil
+	case *ent.ReshareQuery:
+		return &query[*ent.ReshareQuery, predicate.Reshare, reshare.OrderOption]{typ: ent.TypeReshare, tq: q}, nil
+	case *ent.SessionRevocationQuery:
+		return &query[*ent.SessionRevocationQuery, predicate.SessionRevocation, sessionrevocation.OrderOption]{typ: ent.TypeSessionRevocation, tq: q}, nil
+	case *ent.ShareRefreshQuery:
+		return &query[*ent.ShareRefreshQuery, predicate.ShareRefresh, sharerefresh.OrderOption]{typ: ent.TypeShareRefresh, tq: q}, nil
+	case *ent.SigningIncidentQuery:
+		return &query[*ent.SigningIncidentQuery, predicate.SigningIncident, signingincident.OrderOption]{typ: ent.TypeSigningIncident, tq: q}, nil
 	case *ent.SigningKeyshareQuery:
 		return &query[*ent.SigningKeyshareQuery, predicate.SigningKeyshare, signingkeyshare.OrderOption]{typ: ent.TypeSigningKeyshare, tq: q}, nil
 	case *ent.SigningNonceQuery:
 		return &query[*ent.SigningNonceQuery, predicate.SigningNonce, signingnonce.OrderOption]{typ: ent.TypeSigningNonce, tq: q}, nil
+	case *ent.TaskLockQuery:
+		return &query[*ent.TaskLockQuery, predicate.TaskLock, tasklock.OrderOption]{typ: ent.TypeTaskLock, tq: q}, nil
+	case *ent.TaskRunQuery:
+		return &query[*ent.TaskRunQuery, predicate.TaskRun, taskrun.OrderOption]{typ: ent.TypeTaskRun, tq: q}, nil
 	case *ent.TokenFreezeQuery:
 		return &query[*ent.TokenFreezeQuery, predicate.TokenFreeze, tokenfreeze.OrderOption]{typ: ent.TypeTokenFreeze, tq: q}, nil
 	case *ent.TokenLeafQuery:
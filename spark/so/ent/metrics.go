@@ -0,0 +1,149 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	businessMeter = otel.Meter("business")
+
+	transferTransitionsCounter      metric.Int64Counter
+	transferCompletionDuration      metric.Float64Histogram
+	tokenTransactionStatusesCounter metric.Int64Counter
+)
+
+func init() {
+	var err error
+	transferTransitionsCounter, err = businessMeter.Int64Counter(
+		"spark_transfer_status_transitions",
+		metric.WithDescription("Number of transfers moved from one status to another, by transfer type"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	transferCompletionDuration, err = businessMeter.Float64Histogram(
+		"spark_transfer_completion_duration",
+		metric.WithDescription("Time from the creation of a transfer to its completion"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(1, 5, 15, 60, 300, 900, 3600, 4*3600, 24*3600, 7*24*3600),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	tokenTransactionStatusesCounter, err = businessMeter.Int64Counter(
+		"spark_token_transaction_statuses",
+		metric.WithDescription("Number of token transactions moved to each status"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// metricsStatusUnknown is the previous status of rows changed by bulk updates, which do not load
+// the rows they change.
+const metricsStatusUnknown = "UNKNOWN"
+
+// UseMetrics makes the client count the status transitions of transfers and token transactions,
+// and measure how long transfers take to complete. Transitions are counted when they are written,
+// so a transition rolled back with its transaction is still counted.
+func UseMetrics(client *Client) {
+	client.Transfer.Use(transferMetricsHook())
+	client.TokenTransaction.Use(tokenTransactionMetricsHook())
+}
+
+func transferMetricsHook() Hook {
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TransferMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			status, ok := mutation.Status()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			from, err := previousStatus(ctx, mutation.Op(), mutation.OldStatus)
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil || from == string(status) {
+				return v, err
+			}
+
+			transferType := metricsStatusUnknown
+			count := int64(1)
+			switch result := v.(type) {
+			case *Transfer:
+				transferType = string(result.Type)
+				if status == schema.TransferStatusCompleted {
+					transferCompletionDuration.Record(ctx, time.Since(result.CreateTime).Seconds(),
+						metric.WithAttributes(attribute.String("type", transferType)))
+				}
+			case int:
+				count = int64(result)
+			}
+			transferTransitionsCounter.Add(ctx, count, metric.WithAttributes(
+				attribute.String("type", transferType),
+				attribute.String("from", from),
+				attribute.String("to", string(status)),
+			))
+			return v, nil
+		})
+	}
+}
+
+func tokenTransactionMetricsHook() Hook {
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TokenTransactionMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			status, ok := mutation.Status()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			from, err := previousStatus(ctx, mutation.Op(), mutation.OldStatus)
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil || from == string(status) {
+				return v, err
+			}
+
+			count := int64(1)
+			if updated, ok := v.(int); ok {
+				count = int64(updated)
+			}
+			tokenTransactionStatusesCounter.Add(ctx, count, metric.WithAttributes(attribute.String("status", string(status))))
+			return v, nil
+		})
+	}
+}
+
+// previousStatus returns the status of the row before the mutation: none for new rows, and
+// metricsStatusUnknown for bulk updates.
+func previousStatus[S ~string](ctx context.Context, op Op, oldStatus func(context.Context) (S, error)) (string, error) {
+	switch {
+	case op.Is(OpCreate):
+		return "NONE", nil
+	case op.Is(OpUpdateOne):
+		old, err := oldStatus(ctx)
+		if err != nil {
+			return "", err
+		}
+		return string(old), nil
+	default:
+		return metricsStatusUnknown, nil
+	}
+}
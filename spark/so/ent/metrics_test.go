@@ -0,0 +1,70 @@
+package ent_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// collectSum returns the value of the data point of the sum metric with the given attributes.
+func collectSum(t *testing.T, reader sdkmetric.Reader, name string, attrs ...attribute.KeyValue) int64 {
+	var data metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &data))
+	want := attribute.NewSet(attrs...)
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != name {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				if point.Attributes.Equals(&want) {
+					return point.Value
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func TestUseMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	db := enttest.Open(t, "sqlite3", "file:use_metrics?mode=memory&_fk=1")
+	defer db.Close()
+	ent.UseMetrics(db)
+	ctx := context.Background()
+
+	transfer := db.Transfer.Create().
+		SetSenderIdentityPubkey([]byte("sender")).
+		SetReceiverIdentityPubkey([]byte("receiver")).
+		SetTotalValue(1000).
+		SetStatus(schema.TransferStatusSenderInitiated).
+		SetType(schema.TransferTypeTransfer).
+		SetExpiryTime(time.Now().Add(time.Hour)).
+		SaveX(ctx)
+	transfer = transfer.Update().SetStatus(schema.TransferStatusCompleted).SaveX(ctx)
+	// Saving a transfer without changing its status is not a transition.
+	transfer.Update().SetStatus(schema.TransferStatusCompleted).SaveX(ctx)
+
+	transferType := attribute.String("type", string(schema.TransferTypeTransfer))
+	require.Equal(t, int64(1), collectSum(t, reader, "spark_transfer_status_transitions",
+		transferType, attribute.String("from", "NONE"), attribute.String("to", string(schema.TransferStatusSenderInitiated))))
+	require.Equal(t, int64(1), collectSum(t, reader, "spark_transfer_status_transitions",
+		transferType, attribute.String("from", string(schema.TransferStatusSenderInitiated)), attribute.String("to", string(schema.TransferStatusCompleted))))
+	require.Zero(t, collectSum(t, reader, "spark_transfer_status_transitions",
+		transferType, attribute.String("from", string(schema.TransferStatusCompleted)), attribute.String("to", string(schema.TransferStatusCompleted))))
+
+	db.Transfer.Update().SetStatus(schema.TransferStatusReturned).ExecX(ctx)
+	require.Equal(t, int64(1), collectSum(t, reader, "spark_transfer_status_transitions",
+		attribute.String("type", "UNKNOWN"), attribute.String("from", "UNKNOWN"), attribute.String("to", string(schema.TransferStatusReturned))))
+}
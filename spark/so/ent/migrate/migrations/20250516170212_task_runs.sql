@@ -0,0 +1,8 @@
+-- Create "task_locks" table
+CREATE TABLE "task_locks" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "holder" character varying NOT NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "task_locks_name_key" to table: "task_locks"
+CREATE UNIQUE INDEX "task_locks_name_key" ON "task_locks" ("name");
+-- Create "task_runs" table
+CREATE TABLE "task_runs" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "task_name" character varying NOT NULL, "status" character varying NOT NULL, "trigger" character varying NOT NULL, "holder" character varying NOT NULL, "end_time" timestamptz NULL, "error" character varying NULL, "items_processed" bigint NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- Create index "taskrun_task_name_create_time" to table: "task_runs"
+CREATE INDEX "taskrun_task_name_create_time" ON "task_runs" ("task_name", "create_time");
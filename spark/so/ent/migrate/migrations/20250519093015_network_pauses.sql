@@ -0,0 +1,4 @@
+-- Create "network_pauses" table
+CREATE TABLE "network_pauses" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "network" character varying NOT NULL, "transfers_paused" boolean NOT NULL DEFAULT false, "deposits_paused" boolean NOT NULL DEFAULT false, "reason" character varying NULL, PRIMARY KEY ("id"));
+-- Create index "networkpause_network" to table: "network_pauses"
+CREATE UNIQUE INDEX "networkpause_network" ON "network_pauses" ("network");
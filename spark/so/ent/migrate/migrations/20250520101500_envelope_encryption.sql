@@ -0,0 +1,8 @@
+-- Modify "signing_keyshares" table
+ALTER TABLE "signing_keyshares" ADD COLUMN "encrypted_data_key" bytea NULL, ADD COLUMN "key_version" character varying NULL;
+-- Create index "signingkeyshare_key_version" to table: "signing_keyshares"
+CREATE INDEX "signingkeyshare_key_version" ON "signing_keyshares" ("key_version");
+-- Modify "signing_nonces" table
+ALTER TABLE "signing_nonces" ADD COLUMN "encrypted_data_key" bytea NULL, ADD COLUMN "key_version" character varying NULL;
+-- Create index "signingnonce_key_version" to table: "signing_nonces"
+CREATE INDEX "signingnonce_key_version" ON "signing_nonces" ("key_version");
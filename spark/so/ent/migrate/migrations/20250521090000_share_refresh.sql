@@ -0,0 +1,2 @@
+-- Modify "signing_keyshares" table
+ALTER TABLE "signing_keyshares" ADD COLUMN "share_refresh_id" uuid NULL, ADD COLUMN "share_refresh_time" timestamptz NULL;
@@ -0,0 +1,4 @@
+-- Modify "signing_keyshares" table
+ALTER TABLE "signing_keyshares" ADD COLUMN "operator_set_epoch" bigint NOT NULL DEFAULT 0;
+-- Create index "signingkeyshare_operator_set_epoch" to table: "signing_keyshares"
+CREATE INDEX "signingkeyshare_operator_set_epoch" ON "signing_keyshares" ("operator_set_epoch");
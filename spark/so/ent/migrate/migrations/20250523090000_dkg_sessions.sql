@@ -0,0 +1,4 @@
+-- Create "dkg_sessions" table
+CREATE TABLE "dkg_sessions" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "status" character varying NOT NULL, "min_signers" bigint NOT NULL, "max_signers" bigint NOT NULL, "coordinator_index" bigint NOT NULL, "participants" jsonb NOT NULL, "round1_package" jsonb NULL, "received_round1_packages" jsonb NULL, "deadline" timestamptz NOT NULL, "complaints" jsonb NULL, "abort_reason" character varying NULL, PRIMARY KEY ("id"));
+-- Create index "dkgsession_status_deadline" to table: "dkg_sessions"
+CREATE INDEX "dkgsession_status_deadline" ON "dkg_sessions" ("status", "deadline");
@@ -0,0 +1,6 @@
+-- Modify "signing_nonces" table
+ALTER TABLE "signing_nonces" ADD COLUMN "binding_hash" bytea NULL, ADD COLUMN "used_time" timestamptz NULL;
+-- Create index "signingnonce_used_time" to table: "signing_nonces"
+CREATE INDEX "signingnonce_used_time" ON "signing_nonces" ("used_time");
+-- Create index "signingnonce_create_time" to table: "signing_nonces"
+CREATE INDEX "signingnonce_create_time" ON "signing_nonces" ("create_time");
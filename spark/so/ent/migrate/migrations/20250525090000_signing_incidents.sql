@@ -0,0 +1,6 @@
+-- Create "signing_incidents" table
+CREATE TABLE "signing_incidents" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "operator_identifier" character varying NOT NULL, "keyshare_id" uuid NOT NULL, "job_id" character varying NOT NULL, "message" bytea NOT NULL, "verifying_key" bytea NOT NULL, "public_share" bytea NOT NULL, "signature_share" bytea NOT NULL, "commitments" jsonb NOT NULL, "user_commitment" bytea NULL, "reason" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "signingincident_operator_identifier" to table: "signing_incidents"
+CREATE INDEX "signingincident_operator_identifier" ON "signing_incidents" ("operator_identifier");
+-- Create index "signingincident_create_time" to table: "signing_incidents"
+CREATE INDEX "signingincident_create_time" ON "signing_incidents" ("create_time");
@@ -0,0 +1,6 @@
+-- Create "consistency_discrepancies" table
+CREATE TABLE "consistency_discrepancies" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "kind" character varying NOT NULL, "key" character varying NOT NULL, "status" character varying NOT NULL, "digests" jsonb NOT NULL, "divergent_operators" jsonb NOT NULL, PRIMARY KEY ("id"));
+-- Create index "consistencydiscrepancy_kind_key_status" to table: "consistency_discrepancies"
+CREATE INDEX "consistencydiscrepancy_kind_key_status" ON "consistency_discrepancies" ("kind", "key", "status");
+-- Create index "consistencydiscrepancy_status_update_time" to table: "consistency_discrepancies"
+CREATE INDEX "consistencydiscrepancy_status_update_time" ON "consistency_discrepancies" ("status", "update_time");
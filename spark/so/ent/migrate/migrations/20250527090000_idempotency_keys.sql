@@ -0,0 +1,6 @@
+-- Create "idempotency_keys" table
+CREATE TABLE "idempotency_keys" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "method" character varying NOT NULL, "scope" character varying NOT NULL, "key" character varying NOT NULL, "request_hash" bytea NOT NULL, "response_type" character varying NULL, "response" bytea NULL, PRIMARY KEY ("id"));
+-- Create index "idempotencykey_method_scope_key" to table: "idempotency_keys"
+CREATE UNIQUE INDEX "idempotencykey_method_scope_key" ON "idempotency_keys" ("method", "scope", "key");
+-- Create index "idempotencykey_create_time" to table: "idempotency_keys"
+CREATE INDEX "idempotencykey_create_time" ON "idempotency_keys" ("create_time");
@@ -0,0 +1,6 @@
+-- Create "session_revocations" table
+CREATE TABLE "session_revocations" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "identity_public_key" bytea NOT NULL, "session_id" bytea NULL, "expiration_time" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "sessionrevocation_identity_public_key_session_id" to table: "session_revocations"
+CREATE INDEX "sessionrevocation_identity_public_key_session_id" ON "session_revocations" ("identity_public_key", "session_id");
+-- Create index "sessionrevocation_expiration_time" to table: "session_revocations"
+CREATE INDEX "sessionrevocation_expiration_time" ON "session_revocations" ("expiration_time");
@@ -0,0 +1,17 @@
+-- Modify "deposit_addresses" table
+ALTER TABLE "deposit_addresses" ADD COLUMN "is_tree_address" boolean NOT NULL DEFAULT false, ADD COLUMN "expiry_time" timestamptz NULL;
+-- Create index "depositaddress_expiry_time" to table: "deposit_addresses"
+CREATE INDEX "depositaddress_expiry_time" ON "deposit_addresses" ("expiry_time");
+-- Backfill "is_tree_address" from the non-root tree nodes that hold the keyshare of an address.
+-- Addresses of trees that were prepared but never created have no nodes, so they are backfilled as
+-- deposit addresses, and given an expiry below like those.
+UPDATE "deposit_addresses" SET "is_tree_address" = true
+WHERE EXISTS (
+  SELECT 1 FROM "tree_nodes"
+  WHERE "tree_nodes"."tree_node_signing_keyshare" = "deposit_addresses"."deposit_address_signing_keyshare"
+    AND "tree_nodes"."tree_node_parent" IS NOT NULL
+);
+-- Backfill "expiry_time" of existing deposit addresses with the default expiry, so that the
+-- keyshares of the ones that never received funds are recycled.
+UPDATE "deposit_addresses" SET "expiry_time" = "create_time" + interval '30 days'
+WHERE NOT "is_static" AND NOT "is_tree_address";
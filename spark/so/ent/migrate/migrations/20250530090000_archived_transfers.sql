@@ -0,0 +1,12 @@
+-- Create "archives" table
+CREATE TABLE "archives" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "kind" character varying NOT NULL, "record_count" bigint NOT NULL, "data" bytea NOT NULL, PRIMARY KEY ("id"));
+-- Create index "archive_kind" to table: "archives"
+CREATE INDEX "archive_kind" ON "archives" ("kind");
+-- Create "archived_transfers" table
+CREATE TABLE "archived_transfers" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "sender_identity_pubkey" bytea NOT NULL, "receiver_identity_pubkey" bytea NOT NULL, "total_value" bigint NOT NULL, "status" character varying NOT NULL, "type" character varying NOT NULL, "transfer_update_time" timestamptz NOT NULL, "archived_transfer_archive" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "archived_transfers_archives_archive" FOREIGN KEY ("archived_transfer_archive") REFERENCES "archives" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "archivedtransfer_sender_identity_pubkey" to table: "archived_transfers"
+CREATE INDEX "archivedtransfer_sender_identity_pubkey" ON "archived_transfers" ("sender_identity_pubkey");
+-- Create index "archivedtransfer_receiver_identity_pubkey" to table: "archived_transfers"
+CREATE INDEX "archivedtransfer_receiver_identity_pubkey" ON "archived_transfers" ("receiver_identity_pubkey");
+-- Create index "archivedtransfer_transfer_update_time" to table: "archived_transfers"
+CREATE INDEX "archivedtransfer_transfer_update_time" ON "archived_transfers" ("transfer_update_time");
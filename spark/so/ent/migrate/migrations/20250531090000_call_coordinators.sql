@@ -0,0 +1,4 @@
+-- Modify "transfers" table
+ALTER TABLE "transfers" ADD COLUMN "coordinator_identity_pubkey" bytea NULL, ADD COLUMN "claim_coordinator_identity_pubkey" bytea NULL;
+-- Modify "signing_nonces" table
+ALTER TABLE "signing_nonces" ADD COLUMN "coordinator_public_key" bytea NULL;
@@ -0,0 +1,6 @@
+-- Create "operator_call_nonces" table
+CREATE TABLE "operator_call_nonces" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "operator_identity_public_key" bytea NOT NULL, "nonce" bytea NOT NULL, "expiration_time" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "operatorcallnonce_operator_identity_public_key_nonce" to table: "operator_call_nonces"
+CREATE UNIQUE INDEX "operatorcallnonce_operator_identity_public_key_nonce" ON "operator_call_nonces" ("operator_identity_public_key", "nonce");
+-- Create index "operatorcallnonce_expiration_time" to table: "operator_call_nonces"
+CREATE INDEX "operatorcallnonce_expiration_time" ON "operator_call_nonces" ("expiration_time");
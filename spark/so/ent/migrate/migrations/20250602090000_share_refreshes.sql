@@ -0,0 +1,6 @@
+-- Modify "signing_keyshares" table
+ALTER TABLE "signing_keyshares" ADD COLUMN "pending_share_refresh_id" uuid NULL;
+-- Create "share_refreshes" table
+CREATE TABLE "share_refreshes" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "status" character varying NOT NULL, "coordinator_index" bigint NOT NULL, "keyshare_ids" jsonb NOT NULL, "contributions" jsonb NULL, PRIMARY KEY ("id"));
+-- Create index "sharerefresh_coordinator_index_status" to table: "share_refreshes"
+CREATE INDEX "sharerefresh_coordinator_index_status" ON "share_refreshes" ("coordinator_index", "status");
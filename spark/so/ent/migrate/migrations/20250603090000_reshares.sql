@@ -0,0 +1,6 @@
+-- Modify "signing_keyshares" table
+ALTER TABLE "signing_keyshares" ADD COLUMN "pending_reshare_id" uuid NULL;
+-- Create "reshares" table
+CREATE TABLE "reshares" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "status" character varying NOT NULL, "coordinator_index" bigint NOT NULL, "keyshares" jsonb NOT NULL, "dealers" jsonb NOT NULL, "contributions" jsonb NULL, "previous_keyshares" jsonb NULL, PRIMARY KEY ("id"));
+-- Create index "reshare_coordinator_index_status" to table: "reshares"
+CREATE INDEX "reshare_coordinator_index_status" ON "reshares" ("coordinator_index", "status");
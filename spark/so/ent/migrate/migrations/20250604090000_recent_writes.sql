@@ -0,0 +1,6 @@
+-- Create "recent_writes" table
+CREATE TABLE "recent_writes" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "identity_public_key" bytea NOT NULL, "expiration_time" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "recentwrite_identity_public_key_expiration_time" to table: "recent_writes"
+CREATE INDEX "recentwrite_identity_public_key_expiration_time" ON "recent_writes" ("identity_public_key", "expiration_time");
+-- Create index "recentwrite_expiration_time" to table: "recent_writes"
+CREATE INDEX "recentwrite_expiration_time" ON "recent_writes" ("expiration_time");
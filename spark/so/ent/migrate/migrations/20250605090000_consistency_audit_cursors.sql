@@ -0,0 +1,4 @@
+-- Create "consistency_audit_cursors" table
+CREATE TABLE "consistency_audit_cursors" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "kind" character varying NOT NULL, "key" character varying NOT NULL DEFAULT '', PRIMARY KEY ("id"));
+-- Create index "consistencyauditcursor_kind" to table: "consistency_audit_cursors"
+CREATE UNIQUE INDEX "consistencyauditcursor_kind" ON "consistency_audit_cursors" ("kind");
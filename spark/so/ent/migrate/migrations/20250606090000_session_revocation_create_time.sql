@@ -0,0 +1,2 @@
+-- Create index "sessionrevocation_create_time" to table: "session_revocations"
+CREATE INDEX "sessionrevocation_create_time" ON "session_revocations" ("create_time");
@@ -8,6 +8,72 @@ import (
 )
 
 var (
+	// ArchivesColumns holds the columns for the "archives" table.
+	ArchivesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "record_count", Type: field.TypeInt},
+		{Name: "data", Type: field.TypeBytes},
+	}
+	// ArchivesTable holds the schema information for the "archives" table.
+	ArchivesTable = &schema.Table{
+		Name:       "archives",
+		Columns:    ArchivesColumns,
+		PrimaryKey: []*schema.Column{ArchivesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "archive_kind",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivesColumns[3]},
+			},
+		},
+	}
+	// ArchivedTransfersColumns holds the columns for the "archived_transfers" table.
+	ArchivedTransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "sender_identity_pubkey", Type: field.TypeBytes},
+		{Name: "receiver_identity_pubkey", Type: field.TypeBytes},
+		{Name: "total_value", Type: field.TypeUint64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"SENDER_INITIATED", "SENDER_INITIATED_COORDINATOR", "SENDER_KEY_TWEAK_PENDING", "SENDER_KEY_TWEAKED", "RECEIVER_KEY_TWEAKED", "RECEIVER_KEY_TWEAK_LOCKED", "RECEIVER_REFUND_SIGNED", "COMPLETED", "EXPIRED", "RETURNED", "RECEIVER_KEY_TWEAK_APPLIED"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"PREIMAGE_SWAP", "COOPERATIVE_EXIT", "TRANSFER", "SWAP", "COUNTER_SWAP", "UTXO_SWAP"}},
+		{Name: "transfer_update_time", Type: field.TypeTime},
+		{Name: "archived_transfer_archive", Type: field.TypeUUID},
+	}
+	// ArchivedTransfersTable holds the schema information for the "archived_transfers" table.
+	ArchivedTransfersTable = &schema.Table{
+		Name:       "archived_transfers",
+		Columns:    ArchivedTransfersColumns,
+		PrimaryKey: []*schema.Column{ArchivedTransfersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "archived_transfers_archives_archive",
+				Columns:    []*schema.Column{ArchivedTransfersColumns[9]},
+				RefColumns: []*schema.Column{ArchivesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "archivedtransfer_sender_identity_pubkey",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivedTransfersColumns[3]},
+			},
+			{
+				Name:    "archivedtransfer_receiver_identity_pubkey",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivedTransfersColumns[4]},
+			},
+			{
+				Name:    "archivedtransfer_transfer_update_time",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivedTransfersColumns[8]},
+			},
+		},
+	}
 	// BlockHeightsColumns holds the columns for the "block_heights" table.
 	BlockHeightsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -22,6 +88,56 @@ var (
 		Columns:    BlockHeightsColumns,
 		PrimaryKey: []*schema.Column{BlockHeightsColumns[0]},
 	}
+	// ConsistencyAuditCursorsColumns holds the columns for the "consistency_audit_cursors" table.
+	ConsistencyAuditCursorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"TREE", "TRANSFER", "TOKEN_OUTPUT"}},
+		{Name: "key", Type: field.TypeString, Default: ""},
+	}
+	// ConsistencyAuditCursorsTable holds the schema information for the "consistency_audit_cursors" table.
+	ConsistencyAuditCursorsTable = &schema.Table{
+		Name:       "consistency_audit_cursors",
+		Columns:    ConsistencyAuditCursorsColumns,
+		PrimaryKey: []*schema.Column{ConsistencyAuditCursorsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "consistencyauditcursor_kind",
+				Unique:  true,
+				Columns: []*schema.Column{ConsistencyAuditCursorsColumns[3]},
+			},
+		},
+	}
+	// ConsistencyDiscrepanciesColumns holds the columns for the "consistency_discrepancies" table.
+	ConsistencyDiscrepanciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"TREE", "TRANSFER", "TOKEN_OUTPUT"}},
+		{Name: "key", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"OPEN", "RESOLVED", "REPAIRED"}},
+		{Name: "digests", Type: field.TypeJSON},
+		{Name: "divergent_operators", Type: field.TypeJSON},
+	}
+	// ConsistencyDiscrepanciesTable holds the schema information for the "consistency_discrepancies" table.
+	ConsistencyDiscrepanciesTable = &schema.Table{
+		Name:       "consistency_discrepancies",
+		Columns:    ConsistencyDiscrepanciesColumns,
+		PrimaryKey: []*schema.Column{ConsistencyDiscrepanciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "consistencydiscrepancy_kind_key_status",
+				Unique:  false,
+				Columns: []*schema.Column{ConsistencyDiscrepanciesColumns[3], ConsistencyDiscrepanciesColumns[4], ConsistencyDiscrepanciesColumns[5]},
+			},
+			{
+				Name:    "consistencydiscrepancy_status_update_time",
+				Unique:  false,
+				Columns: []*schema.Column{ConsistencyDiscrepanciesColumns[5], ConsistencyDiscrepanciesColumns[2]},
+			},
+		},
+	}
 	// CooperativeExitsColumns holds the columns for the "cooperative_exits" table.
 	CooperativeExitsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -64,6 +180,8 @@ var (
 		{Name: "confirmation_txid", Type: field.TypeString, Nullable: true},
 		{Name: "node_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "is_static", Type: field.TypeBool, Default: false},
+		{Name: "is_tree_address", Type: field.TypeBool, Default: false},
+		{Name: "expiry_time", Type: field.TypeTime, Nullable: true},
 		{Name: "deposit_address_signing_keyshare", Type: field.TypeUUID},
 	}
 	// DepositAddressesTable holds the schema information for the "deposit_addresses" table.
@@ -74,7 +192,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "deposit_addresses_signing_keyshares_signing_keyshare",
-				Columns:    []*schema.Column{DepositAddressesColumns[10]},
+				Columns:    []*schema.Column{DepositAddressesColumns[12]},
 				RefColumns: []*schema.Column{SigningKeysharesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -95,6 +213,120 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{DepositAddressesColumns[5]},
 			},
+			{
+				Name:    "depositaddress_expiry_time",
+				Unique:  false,
+				Columns: []*schema.Column{DepositAddressesColumns[11]},
+			},
+		},
+	}
+	// DkgSessionsColumns holds the columns for the "dkg_sessions" table.
+	DkgSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"INITIAL", "ROUND1", "ROUND1_SIGNATURE", "COMPLETED", "ABORTED"}},
+		{Name: "min_signers", Type: field.TypeUint64},
+		{Name: "max_signers", Type: field.TypeUint64},
+		{Name: "coordinator_index", Type: field.TypeUint64},
+		{Name: "participants", Type: field.TypeJSON},
+		{Name: "round1_package", Type: field.TypeJSON, Nullable: true},
+		{Name: "received_round1_packages", Type: field.TypeJSON, Nullable: true},
+		{Name: "deadline", Type: field.TypeTime},
+		{Name: "complaints", Type: field.TypeJSON, Nullable: true},
+		{Name: "abort_reason", Type: field.TypeString, Nullable: true},
+	}
+	// DkgSessionsTable holds the schema information for the "dkg_sessions" table.
+	DkgSessionsTable = &schema.Table{
+		Name:       "dkg_sessions",
+		Columns:    DkgSessionsColumns,
+		PrimaryKey: []*schema.Column{DkgSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dkgsession_status_deadline",
+				Unique:  false,
+				Columns: []*schema.Column{DkgSessionsColumns[3], DkgSessionsColumns[10]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "method", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString},
+		{Name: "request_hash", Type: field.TypeBytes},
+		{Name: "response_type", Type: field.TypeString, Nullable: true},
+		{Name: "response", Type: field.TypeBytes, Nullable: true},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_method_scope_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[3], IdempotencyKeysColumns[4], IdempotencyKeysColumns[5]},
+			},
+			{
+				Name:    "idempotencykey_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1]},
+			},
+		},
+	}
+	// NetworkPausesColumns holds the columns for the "network_pauses" table.
+	NetworkPausesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "network", Type: field.TypeEnum, Enums: []string{"UNSPECIFIED", "MAINNET", "REGTEST", "TESTNET", "SIGNET"}},
+		{Name: "transfers_paused", Type: field.TypeBool, Default: false},
+		{Name: "deposits_paused", Type: field.TypeBool, Default: false},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+	}
+	// NetworkPausesTable holds the schema information for the "network_pauses" table.
+	NetworkPausesTable = &schema.Table{
+		Name:       "network_pauses",
+		Columns:    NetworkPausesColumns,
+		PrimaryKey: []*schema.Column{NetworkPausesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "networkpause_network",
+				Unique:  true,
+				Columns: []*schema.Column{NetworkPausesColumns[3]},
+			},
+		},
+	}
+	// OperatorCallNoncesColumns holds the columns for the "operator_call_nonces" table.
+	OperatorCallNoncesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "operator_identity_public_key", Type: field.TypeBytes},
+		{Name: "nonce", Type: field.TypeBytes},
+		{Name: "expiration_time", Type: field.TypeTime},
+	}
+	// OperatorCallNoncesTable holds the schema information for the "operator_call_nonces" table.
+	OperatorCallNoncesTable = &schema.Table{
+		Name:       "operator_call_nonces",
+		Columns:    OperatorCallNoncesColumns,
+		PrimaryKey: []*schema.Column{OperatorCallNoncesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "operatorcallnonce_operator_identity_public_key_nonce",
+				Unique:  true,
+				Columns: []*schema.Column{OperatorCallNoncesColumns[3], OperatorCallNoncesColumns[4]},
+			},
+			{
+				Name:    "operatorcallnonce_expiration_time",
+				Unique:  false,
+				Columns: []*schema.Column{OperatorCallNoncesColumns[5]},
+			},
 		},
 	}
 	// PreimageRequestsColumns holds the columns for the "preimage_requests" table.
@@ -162,6 +394,146 @@ var (
 			},
 		},
 	}
+	// RecentWritesColumns holds the columns for the "recent_writes" table.
+	RecentWritesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "identity_public_key", Type: field.TypeBytes},
+		{Name: "expiration_time", Type: field.TypeTime},
+	}
+	// RecentWritesTable holds the schema information for the "recent_writes" table.
+	RecentWritesTable = &schema.Table{
+		Name:       "recent_writes",
+		Columns:    RecentWritesColumns,
+		PrimaryKey: []*schema.Column{RecentWritesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recentwrite_identity_public_key_expiration_time",
+				Unique:  false,
+				Columns: []*schema.Column{RecentWritesColumns[3], RecentWritesColumns[4]},
+			},
+			{
+				Name:    "recentwrite_expiration_time",
+				Unique:  false,
+				Columns: []*schema.Column{RecentWritesColumns[4]},
+			},
+		},
+	}
+	// ResharesColumns holds the columns for the "reshares" table.
+	ResharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PREPARED", "COMMITTED", "COMPLETED", "ABORTED"}},
+		{Name: "coordinator_index", Type: field.TypeUint64},
+		{Name: "keyshares", Type: field.TypeJSON},
+		{Name: "dealers", Type: field.TypeJSON},
+		{Name: "contributions", Type: field.TypeJSON, Nullable: true},
+		{Name: "previous_keyshares", Type: field.TypeJSON, Nullable: true},
+	}
+	// ResharesTable holds the schema information for the "reshares" table.
+	ResharesTable = &schema.Table{
+		Name:       "reshares",
+		Columns:    ResharesColumns,
+		PrimaryKey: []*schema.Column{ResharesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reshare_coordinator_index_status",
+				Unique:  false,
+				Columns: []*schema.Column{ResharesColumns[4], ResharesColumns[3]},
+			},
+		},
+	}
+	// SessionRevocationsColumns holds the columns for the "session_revocations" table.
+	SessionRevocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "identity_public_key", Type: field.TypeBytes},
+		{Name: "session_id", Type: field.TypeBytes, Nullable: true},
+		{Name: "expiration_time", Type: field.TypeTime},
+	}
+	// SessionRevocationsTable holds the schema information for the "session_revocations" table.
+	SessionRevocationsTable = &schema.Table{
+		Name:       "session_revocations",
+		Columns:    SessionRevocationsColumns,
+		PrimaryKey: []*schema.Column{SessionRevocationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sessionrevocation_identity_public_key_session_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionRevocationsColumns[3], SessionRevocationsColumns[4]},
+			},
+			{
+				Name:    "sessionrevocation_expiration_time",
+				Unique:  false,
+				Columns: []*schema.Column{SessionRevocationsColumns[5]},
+			},
+			{
+				Name:    "sessionrevocation_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{SessionRevocationsColumns[1]},
+			},
+		},
+	}
+	// ShareRefreshesColumns holds the columns for the "share_refreshes" table.
+	ShareRefreshesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PREPARED", "COMMITTED", "COMPLETED", "ABORTED"}},
+		{Name: "coordinator_index", Type: field.TypeUint64},
+		{Name: "keyshare_ids", Type: field.TypeJSON},
+		{Name: "contributions", Type: field.TypeJSON, Nullable: true},
+	}
+	// ShareRefreshesTable holds the schema information for the "share_refreshes" table.
+	ShareRefreshesTable = &schema.Table{
+		Name:       "share_refreshes",
+		Columns:    ShareRefreshesColumns,
+		PrimaryKey: []*schema.Column{ShareRefreshesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sharerefresh_coordinator_index_status",
+				Unique:  false,
+				Columns: []*schema.Column{ShareRefreshesColumns[4], ShareRefreshesColumns[3]},
+			},
+		},
+	}
+	// SigningIncidentsColumns holds the columns for the "signing_incidents" table.
+	SigningIncidentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "operator_identifier", Type: field.TypeString},
+		{Name: "keyshare_id", Type: field.TypeUUID},
+		{Name: "job_id", Type: field.TypeString},
+		{Name: "message", Type: field.TypeBytes},
+		{Name: "verifying_key", Type: field.TypeBytes},
+		{Name: "public_share", Type: field.TypeBytes},
+		{Name: "signature_share", Type: field.TypeBytes},
+		{Name: "commitments", Type: field.TypeJSON},
+		{Name: "user_commitment", Type: field.TypeBytes, Nullable: true},
+		{Name: "reason", Type: field.TypeString},
+	}
+	// SigningIncidentsTable holds the schema information for the "signing_incidents" table.
+	SigningIncidentsTable = &schema.Table{
+		Name:       "signing_incidents",
+		Columns:    SigningIncidentsColumns,
+		PrimaryKey: []*schema.Column{SigningIncidentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "signingincident_operator_identifier",
+				Unique:  false,
+				Columns: []*schema.Column{SigningIncidentsColumns[3]},
+			},
+			{
+				Name:    "signingincident_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{SigningIncidentsColumns[1]},
+			},
+		},
+	}
 	// SigningKeysharesColumns holds the columns for the "signing_keyshares" table.
 	SigningKeysharesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -173,6 +545,13 @@ var (
 		{Name: "public_key", Type: field.TypeBytes, Unique: true},
 		{Name: "min_signers", Type: field.TypeInt32},
 		{Name: "coordinator_index", Type: field.TypeUint64},
+		{Name: "encrypted_data_key", Type: field.TypeBytes, Nullable: true},
+		{Name: "key_version", Type: field.TypeString, Nullable: true},
+		{Name: "share_refresh_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "share_refresh_time", Type: field.TypeTime, Nullable: true},
+		{Name: "pending_share_refresh_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "pending_reshare_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "operator_set_epoch", Type: field.TypeUint64, Default: 0},
 	}
 	// SigningKeysharesTable holds the schema information for the "signing_keyshares" table.
 	SigningKeysharesTable = &schema.Table{
@@ -185,6 +564,16 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{SigningKeysharesColumns[8]},
 			},
+			{
+				Name:    "signingkeyshare_key_version",
+				Unique:  false,
+				Columns: []*schema.Column{SigningKeysharesColumns[10]},
+			},
+			{
+				Name:    "signingkeyshare_operator_set_epoch",
+				Unique:  false,
+				Columns: []*schema.Column{SigningKeysharesColumns[15]},
+			},
 		},
 	}
 	// SigningNoncesColumns holds the columns for the "signing_nonces" table.
@@ -195,6 +584,11 @@ var (
 		{Name: "nonce", Type: field.TypeBytes},
 		{Name: "nonce_commitment", Type: field.TypeBytes},
 		{Name: "message", Type: field.TypeBytes, Nullable: true},
+		{Name: "binding_hash", Type: field.TypeBytes, Nullable: true},
+		{Name: "used_time", Type: field.TypeTime, Nullable: true},
+		{Name: "encrypted_data_key", Type: field.TypeBytes, Nullable: true},
+		{Name: "key_version", Type: field.TypeString, Nullable: true},
+		{Name: "coordinator_public_key", Type: field.TypeBytes, Nullable: true},
 	}
 	// SigningNoncesTable holds the schema information for the "signing_nonces" table.
 	SigningNoncesTable = &schema.Table{
@@ -207,6 +601,62 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{SigningNoncesColumns[4]},
 			},
+			{
+				Name:    "signingnonce_key_version",
+				Unique:  false,
+				Columns: []*schema.Column{SigningNoncesColumns[9]},
+			},
+			{
+				Name:    "signingnonce_used_time",
+				Unique:  false,
+				Columns: []*schema.Column{SigningNoncesColumns[7]},
+			},
+			{
+				Name:    "signingnonce_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{SigningNoncesColumns[1]},
+			},
+		},
+	}
+	// TaskLocksColumns holds the columns for the "task_locks" table.
+	TaskLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "holder", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// TaskLocksTable holds the schema information for the "task_locks" table.
+	TaskLocksTable = &schema.Table{
+		Name:       "task_locks",
+		Columns:    TaskLocksColumns,
+		PrimaryKey: []*schema.Column{TaskLocksColumns[0]},
+	}
+	// TaskRunsColumns holds the columns for the "task_runs" table.
+	TaskRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "task_name", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"RUNNING", "SUCCEEDED", "FAILED"}},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"SCHEDULED", "MANUAL"}},
+		{Name: "holder", Type: field.TypeString},
+		{Name: "end_time", Type: field.TypeTime, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "items_processed", Type: field.TypeInt64, Default: 0},
+	}
+	// TaskRunsTable holds the schema information for the "task_runs" table.
+	TaskRunsTable = &schema.Table{
+		Name:       "task_runs",
+		Columns:    TaskRunsColumns,
+		PrimaryKey: []*schema.Column{TaskRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskrun_task_name_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{TaskRunsColumns[3], TaskRunsColumns[1]},
+			},
 		},
 	}
 	// TokenFreezesColumns holds the columns for the "token_freezes" table.
@@ -460,6 +910,8 @@ var (
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"PREIMAGE_SWAP", "COOPERATIVE_EXIT", "TRANSFER", "SWAP", "COUNTER_SWAP", "UTXO_SWAP"}},
 		{Name: "expiry_time", Type: field.TypeTime},
 		{Name: "completion_time", Type: field.TypeTime, Nullable: true},
+		{Name: "coordinator_identity_pubkey", Type: field.TypeBytes, Nullable: true},
+		{Name: "claim_coordinator_identity_pubkey", Type: field.TypeBytes, Nullable: true},
 	}
 	// TransfersTable holds the schema information for the "transfers" table.
 	TransfersTable = &schema.Table{
@@ -785,13 +1237,28 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ArchivesTable,
+		ArchivedTransfersTable,
 		BlockHeightsTable,
+		ConsistencyAuditCursorsTable,
+		ConsistencyDiscrepanciesTable,
 		CooperativeExitsTable,
 		DepositAddressesTable,
+		DkgSessionsTable,
+		IdempotencyKeysTable,
+		NetworkPausesTable,
+		OperatorCallNoncesTable,
 		PreimageRequestsTable,
 		PreimageSharesTable,
+		RecentWritesTable,
+		ResharesTable,
+		SessionRevocationsTable,
+		ShareRefreshesTable,
+		SigningIncidentsTable,
 		SigningKeysharesTable,
 		SigningNoncesTable,
+		TaskLocksTable,
+		TaskRunsTable,
 		TokenFreezesTable,
 		TokenLeafsTable,
 		TokenMintsTable,
@@ -809,6 +1276,7 @@ var (
 )
 
 func init() {
+	ArchivedTransfersTable.ForeignKeys[0].RefTable = ArchivesTable
 	CooperativeExitsTable.ForeignKeys[0].RefTable = TransfersTable
 	DepositAddressesTable.ForeignKeys[0].RefTable = SigningKeysharesTable
 	PreimageRequestsTable.ForeignKeys[0].RefTable = TransfersTable
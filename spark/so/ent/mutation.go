@@ -12,15 +12,30 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
 	"github.com/lightsparkdev/spark/so/ent/blockheight"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
 	"github.com/lightsparkdev/spark/so/ent/cooperativeexit"
 	"github.com/lightsparkdev/spark/so/ent/depositaddress"
+	"github.com/lightsparkdev/spark/so/ent/dkgsession"
+	"github.com/lightsparkdev/spark/so/ent/idempotencykey"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
 	"github.com/lightsparkdev/spark/so/ent/predicate"
 	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
 	"github.com/lightsparkdev/spark/so/ent/preimageshare"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+	"github.com/lightsparkdev/spark/so/ent/reshare"
 	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/sessionrevocation"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+	"github.com/lightsparkdev/spark/so/ent/signingincident"
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
+	"github.com/lightsparkdev/spark/so/ent/tasklock"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
 	"github.com/lightsparkdev/spark/so/ent/tokenleaf"
 	"github.com/lightsparkdev/spark/so/ent/tokenmint"
@@ -45,13 +60,28 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeArchive                 = "Archive"
+	TypeArchivedTransfer        = "ArchivedTransfer"
 	TypeBlockHeight             = "BlockHeight"
+	TypeConsistencyAuditCursor  = "ConsistencyAuditCursor"
+	TypeConsistencyDiscrepancy  = "ConsistencyDiscrepancy"
 	TypeCooperativeExit         = "CooperativeExit"
 	TypeDepositAddress          = "DepositAddress"
+	TypeDkgSession              = "DkgSession"
+	TypeIdempotencyKey          = "IdempotencyKey"
+	TypeNetworkPause            = "NetworkPause"
+	TypeOperatorCallNonce       = "OperatorCallNonce"
 	TypePreimageRequest         = "PreimageRequest"
 	TypePreimageShare           = "PreimageShare"
+	TypeRecentWrite             = "RecentWrite"
+	TypeReshare                 = "Reshare"
+	TypeSessionRevocation       = "SessionRevocation"
+	TypeShareRefresh            = "ShareRefresh"
+	TypeSigningIncident         = "SigningIncident"
 	TypeSigningKeyshare         = "SigningKeyshare"
 	TypeSigningNonce            = "SigningNonce"
+	TypeTaskLock                = "TaskLock"
+	TypeTaskRun                 = "TaskRun"
 	TypeTokenFreeze             = "TokenFreeze"
 	TypeTokenLeaf               = "TokenLeaf"
 	TypeTokenMint               = "TokenMint"
@@ -67,34 +97,35 @@ const (
 	TypeUtxoSwap                = "UtxoSwap"
 )
 
-// BlockHeightMutation represents an operation that mutates the BlockHeight nodes in the graph.
-type BlockHeightMutation struct {
+// ArchiveMutation represents an operation that mutates the Archive nodes in the graph.
+type ArchiveMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	create_time   *time.Time
-	update_time   *time.Time
-	height        *int64
-	addheight     *int64
-	network       *schema.Network
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*BlockHeight, error)
-	predicates    []predicate.BlockHeight
-}
-
-var _ ent.Mutation = (*BlockHeightMutation)(nil)
-
-// blockheightOption allows management of the mutation configuration using functional options.
-type blockheightOption func(*BlockHeightMutation)
-
-// newBlockHeightMutation creates new mutation for the BlockHeight entity.
-func newBlockHeightMutation(c config, op Op, opts ...blockheightOption) *BlockHeightMutation {
-	m := &BlockHeightMutation{
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	create_time     *time.Time
+	update_time     *time.Time
+	kind            *string
+	record_count    *int
+	addrecord_count *int
+	data            *[]byte
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Archive, error)
+	predicates      []predicate.Archive
+}
+
+var _ ent.Mutation = (*ArchiveMutation)(nil)
+
+// archiveOption allows management of the mutation configuration using functional options.
+type archiveOption func(*ArchiveMutation)
+
+// newArchiveMutation creates new mutation for the Archive entity.
+func newArchiveMutation(c config, op Op, opts ...archiveOption) *ArchiveMutation {
+	m := &ArchiveMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBlockHeight,
+		typ:           TypeArchive,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -103,20 +134,20 @@ func newBlockHeightMutation(c config, op Op, opts ...blockheightOption) *BlockHe
 	return m
 }
 
-// withBlockHeightID sets the ID field of the mutation.
-func withBlockHeightID(id uuid.UUID) blockheightOption {
-	return func(m *BlockHeightMutation) {
+// withArchiveID sets the ID field of the mutation.
+func withArchiveID(id uuid.UUID) archiveOption {
+	return func(m *ArchiveMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BlockHeight
+			value *Archive
 		)
-		m.oldValue = func(ctx context.Context) (*BlockHeight, error) {
+		m.oldValue = func(ctx context.Context) (*Archive, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BlockHeight.Get(ctx, id)
+					value, err = m.Client().Archive.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -125,10 +156,10 @@ func withBlockHeightID(id uuid.UUID) blockheightOption {
 	}
 }
 
-// withBlockHeight sets the old BlockHeight of the mutation.
-func withBlockHeight(node *BlockHeight) blockheightOption {
-	return func(m *BlockHeightMutation) {
-		m.oldValue = func(context.Context) (*BlockHeight, error) {
+// withArchive sets the old Archive of the mutation.
+func withArchive(node *Archive) archiveOption {
+	return func(m *ArchiveMutation) {
+		m.oldValue = func(context.Context) (*Archive, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -137,7 +168,7 @@ func withBlockHeight(node *BlockHeight) blockheightOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BlockHeightMutation) Client() *Client {
+func (m ArchiveMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -145,7 +176,7 @@ func (m BlockHeightMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BlockHeightMutation) Tx() (*Tx, error) {
+func (m ArchiveMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -155,14 +186,14 @@ func (m BlockHeightMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of BlockHeight entities.
-func (m *BlockHeightMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Archive entities.
+func (m *ArchiveMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BlockHeightMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ArchiveMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -173,7 +204,7 @@ func (m *BlockHeightMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BlockHeightMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ArchiveMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -182,19 +213,19 @@ func (m *BlockHeightMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BlockHeight.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Archive.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *BlockHeightMutation) SetCreateTime(t time.Time) {
+func (m *ArchiveMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *BlockHeightMutation) CreateTime() (r time.Time, exists bool) {
+func (m *ArchiveMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -202,10 +233,10 @@ func (m *BlockHeightMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the BlockHeight entity.
-// If the BlockHeight object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockHeightMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ArchiveMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -220,17 +251,17 @@ func (m *BlockHeightMutation) OldCreateTime(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *BlockHeightMutation) ResetCreateTime() {
+func (m *ArchiveMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *BlockHeightMutation) SetUpdateTime(t time.Time) {
+func (m *ArchiveMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *BlockHeightMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *ArchiveMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -238,10 +269,10 @@ func (m *BlockHeightMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the BlockHeight entity.
-// If the BlockHeight object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockHeightMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ArchiveMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -256,111 +287,147 @@ func (m *BlockHeightMutation) OldUpdateTime(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *BlockHeightMutation) ResetUpdateTime() {
+func (m *ArchiveMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetHeight sets the "height" field.
-func (m *BlockHeightMutation) SetHeight(i int64) {
-	m.height = &i
-	m.addheight = nil
+// SetKind sets the "kind" field.
+func (m *ArchiveMutation) SetKind(s string) {
+	m.kind = &s
 }
 
-// Height returns the value of the "height" field in the mutation.
-func (m *BlockHeightMutation) Height() (r int64, exists bool) {
-	v := m.height
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ArchiveMutation) Kind() (r string, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHeight returns the old "height" field's value of the BlockHeight entity.
-// If the BlockHeight object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockHeightMutation) OldHeight(ctx context.Context) (v int64, err error) {
+func (m *ArchiveMutation) OldKind(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHeight requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Height, nil
+	return oldValue.Kind, nil
 }
 
-// AddHeight adds i to the "height" field.
-func (m *BlockHeightMutation) AddHeight(i int64) {
-	if m.addheight != nil {
-		*m.addheight += i
+// ResetKind resets all changes to the "kind" field.
+func (m *ArchiveMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetRecordCount sets the "record_count" field.
+func (m *ArchiveMutation) SetRecordCount(i int) {
+	m.record_count = &i
+	m.addrecord_count = nil
+}
+
+// RecordCount returns the value of the "record_count" field in the mutation.
+func (m *ArchiveMutation) RecordCount() (r int, exists bool) {
+	v := m.record_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecordCount returns the old "record_count" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldRecordCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecordCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecordCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecordCount: %w", err)
+	}
+	return oldValue.RecordCount, nil
+}
+
+// AddRecordCount adds i to the "record_count" field.
+func (m *ArchiveMutation) AddRecordCount(i int) {
+	if m.addrecord_count != nil {
+		*m.addrecord_count += i
 	} else {
-		m.addheight = &i
+		m.addrecord_count = &i
 	}
 }
 
-// AddedHeight returns the value that was added to the "height" field in this mutation.
-func (m *BlockHeightMutation) AddedHeight() (r int64, exists bool) {
-	v := m.addheight
+// AddedRecordCount returns the value that was added to the "record_count" field in this mutation.
+func (m *ArchiveMutation) AddedRecordCount() (r int, exists bool) {
+	v := m.addrecord_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetHeight resets all changes to the "height" field.
-func (m *BlockHeightMutation) ResetHeight() {
-	m.height = nil
-	m.addheight = nil
+// ResetRecordCount resets all changes to the "record_count" field.
+func (m *ArchiveMutation) ResetRecordCount() {
+	m.record_count = nil
+	m.addrecord_count = nil
 }
 
-// SetNetwork sets the "network" field.
-func (m *BlockHeightMutation) SetNetwork(s schema.Network) {
-	m.network = &s
+// SetData sets the "data" field.
+func (m *ArchiveMutation) SetData(b []byte) {
+	m.data = &b
 }
 
-// Network returns the value of the "network" field in the mutation.
-func (m *BlockHeightMutation) Network() (r schema.Network, exists bool) {
-	v := m.network
+// Data returns the value of the "data" field in the mutation.
+func (m *ArchiveMutation) Data() (r []byte, exists bool) {
+	v := m.data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNetwork returns the old "network" field's value of the BlockHeight entity.
-// If the BlockHeight object wasn't provided to the builder, the object is fetched from the database.
+// OldData returns the old "data" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockHeightMutation) OldNetwork(ctx context.Context) (v schema.Network, err error) {
+func (m *ArchiveMutation) OldData(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNetwork requires an ID field in the mutation")
+		return v, errors.New("OldData requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
 	}
-	return oldValue.Network, nil
+	return oldValue.Data, nil
 }
 
-// ResetNetwork resets all changes to the "network" field.
-func (m *BlockHeightMutation) ResetNetwork() {
-	m.network = nil
+// ResetData resets all changes to the "data" field.
+func (m *ArchiveMutation) ResetData() {
+	m.data = nil
 }
 
-// Where appends a list predicates to the BlockHeightMutation builder.
-func (m *BlockHeightMutation) Where(ps ...predicate.BlockHeight) {
+// Where appends a list predicates to the ArchiveMutation builder.
+func (m *ArchiveMutation) Where(ps ...predicate.Archive) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BlockHeightMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ArchiveMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BlockHeightMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BlockHeight, len(ps))
+func (m *ArchiveMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Archive, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -368,36 +435,39 @@ func (m *BlockHeightMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BlockHeightMutation) Op() Op {
+func (m *ArchiveMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BlockHeightMutation) SetOp(op Op) {
+func (m *ArchiveMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (BlockHeight).
-func (m *BlockHeightMutation) Type() string {
+// Type returns the node type of this mutation (Archive).
+func (m *ArchiveMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BlockHeightMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *ArchiveMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.create_time != nil {
-		fields = append(fields, blockheight.FieldCreateTime)
+		fields = append(fields, archive.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, blockheight.FieldUpdateTime)
+		fields = append(fields, archive.FieldUpdateTime)
 	}
-	if m.height != nil {
-		fields = append(fields, blockheight.FieldHeight)
+	if m.kind != nil {
+		fields = append(fields, archive.FieldKind)
 	}
-	if m.network != nil {
-		fields = append(fields, blockheight.FieldNetwork)
+	if m.record_count != nil {
+		fields = append(fields, archive.FieldRecordCount)
+	}
+	if m.data != nil {
+		fields = append(fields, archive.FieldData)
 	}
 	return fields
 }
@@ -405,16 +475,18 @@ func (m *BlockHeightMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BlockHeightMutation) Field(name string) (ent.Value, bool) {
+func (m *ArchiveMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case blockheight.FieldCreateTime:
+	case archive.FieldCreateTime:
 		return m.CreateTime()
-	case blockheight.FieldUpdateTime:
+	case archive.FieldUpdateTime:
 		return m.UpdateTime()
-	case blockheight.FieldHeight:
-		return m.Height()
-	case blockheight.FieldNetwork:
-		return m.Network()
+	case archive.FieldKind:
+		return m.Kind()
+	case archive.FieldRecordCount:
+		return m.RecordCount()
+	case archive.FieldData:
+		return m.Data()
 	}
 	return nil, false
 }
@@ -422,63 +494,72 @@ func (m *BlockHeightMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BlockHeightMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ArchiveMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case blockheight.FieldCreateTime:
+	case archive.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case blockheight.FieldUpdateTime:
+	case archive.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case blockheight.FieldHeight:
-		return m.OldHeight(ctx)
-	case blockheight.FieldNetwork:
-		return m.OldNetwork(ctx)
+	case archive.FieldKind:
+		return m.OldKind(ctx)
+	case archive.FieldRecordCount:
+		return m.OldRecordCount(ctx)
+	case archive.FieldData:
+		return m.OldData(ctx)
 	}
-	return nil, fmt.Errorf("unknown BlockHeight field %s", name)
+	return nil, fmt.Errorf("unknown Archive field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BlockHeightMutation) SetField(name string, value ent.Value) error {
+func (m *ArchiveMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case blockheight.FieldCreateTime:
+	case archive.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case blockheight.FieldUpdateTime:
+	case archive.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case blockheight.FieldHeight:
-		v, ok := value.(int64)
+	case archive.FieldKind:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHeight(v)
+		m.SetKind(v)
 		return nil
-	case blockheight.FieldNetwork:
-		v, ok := value.(schema.Network)
+	case archive.FieldRecordCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNetwork(v)
+		m.SetRecordCount(v)
+		return nil
+	case archive.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BlockHeight field %s", name)
+	return fmt.Errorf("unknown Archive field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BlockHeightMutation) AddedFields() []string {
+func (m *ArchiveMutation) AddedFields() []string {
 	var fields []string
-	if m.addheight != nil {
-		fields = append(fields, blockheight.FieldHeight)
+	if m.addrecord_count != nil {
+		fields = append(fields, archive.FieldRecordCount)
 	}
 	return fields
 }
@@ -486,10 +567,10 @@ func (m *BlockHeightMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BlockHeightMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ArchiveMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case blockheight.FieldHeight:
-		return m.AddedHeight()
+	case archive.FieldRecordCount:
+		return m.AddedRecordCount()
 	}
 	return nil, false
 }
@@ -497,136 +578,143 @@ func (m *BlockHeightMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BlockHeightMutation) AddField(name string, value ent.Value) error {
+func (m *ArchiveMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case blockheight.FieldHeight:
-		v, ok := value.(int64)
+	case archive.FieldRecordCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddHeight(v)
+		m.AddRecordCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BlockHeight numeric field %s", name)
+	return fmt.Errorf("unknown Archive numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BlockHeightMutation) ClearedFields() []string {
+func (m *ArchiveMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BlockHeightMutation) FieldCleared(name string) bool {
+func (m *ArchiveMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BlockHeightMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown BlockHeight nullable field %s", name)
+func (m *ArchiveMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Archive nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BlockHeightMutation) ResetField(name string) error {
+func (m *ArchiveMutation) ResetField(name string) error {
 	switch name {
-	case blockheight.FieldCreateTime:
+	case archive.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case blockheight.FieldUpdateTime:
+	case archive.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case blockheight.FieldHeight:
-		m.ResetHeight()
+	case archive.FieldKind:
+		m.ResetKind()
 		return nil
-	case blockheight.FieldNetwork:
-		m.ResetNetwork()
+	case archive.FieldRecordCount:
+		m.ResetRecordCount()
+		return nil
+	case archive.FieldData:
+		m.ResetData()
 		return nil
 	}
-	return fmt.Errorf("unknown BlockHeight field %s", name)
+	return fmt.Errorf("unknown Archive field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BlockHeightMutation) AddedEdges() []string {
+func (m *ArchiveMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BlockHeightMutation) AddedIDs(name string) []ent.Value {
+func (m *ArchiveMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BlockHeightMutation) RemovedEdges() []string {
+func (m *ArchiveMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BlockHeightMutation) RemovedIDs(name string) []ent.Value {
+func (m *ArchiveMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BlockHeightMutation) ClearedEdges() []string {
+func (m *ArchiveMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BlockHeightMutation) EdgeCleared(name string) bool {
+func (m *ArchiveMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BlockHeightMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown BlockHeight unique edge %s", name)
+func (m *ArchiveMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Archive unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BlockHeightMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown BlockHeight edge %s", name)
+func (m *ArchiveMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Archive edge %s", name)
 }
 
-// CooperativeExitMutation represents an operation that mutates the CooperativeExit nodes in the graph.
-type CooperativeExitMutation struct {
+// ArchivedTransferMutation represents an operation that mutates the ArchivedTransfer nodes in the graph.
+type ArchivedTransferMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	create_time            *time.Time
-	update_time            *time.Time
-	exit_txid              *[]byte
-	confirmation_height    *int64
-	addconfirmation_height *int64
-	clearedFields          map[string]struct{}
-	transfer               *uuid.UUID
-	clearedtransfer        bool
-	done                   bool
-	oldValue               func(context.Context) (*CooperativeExit, error)
-	predicates             []predicate.CooperativeExit
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	create_time              *time.Time
+	update_time              *time.Time
+	sender_identity_pubkey   *[]byte
+	receiver_identity_pubkey *[]byte
+	total_value              *uint64
+	addtotal_value           *int64
+	status                   *schema.TransferStatus
+	_type                    *schema.TransferType
+	transfer_update_time     *time.Time
+	clearedFields            map[string]struct{}
+	archive                  *uuid.UUID
+	clearedarchive           bool
+	done                     bool
+	oldValue                 func(context.Context) (*ArchivedTransfer, error)
+	predicates               []predicate.ArchivedTransfer
 }
 
-var _ ent.Mutation = (*CooperativeExitMutation)(nil)
+var _ ent.Mutation = (*ArchivedTransferMutation)(nil)
 
-// cooperativeexitOption allows management of the mutation configuration using functional options.
-type cooperativeexitOption func(*CooperativeExitMutation)
+// archivedtransferOption allows management of the mutation configuration using functional options.
+type archivedtransferOption func(*ArchivedTransferMutation)
 
-// newCooperativeExitMutation creates new mutation for the CooperativeExit entity.
-func newCooperativeExitMutation(c config, op Op, opts ...cooperativeexitOption) *CooperativeExitMutation {
-	m := &CooperativeExitMutation{
+// newArchivedTransferMutation creates new mutation for the ArchivedTransfer entity.
+func newArchivedTransferMutation(c config, op Op, opts ...archivedtransferOption) *ArchivedTransferMutation {
+	m := &ArchivedTransferMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCooperativeExit,
+		typ:           TypeArchivedTransfer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -635,20 +723,20 @@ func newCooperativeExitMutation(c config, op Op, opts ...cooperativeexitOption)
 	return m
 }
 
-// withCooperativeExitID sets the ID field of the mutation.
-func withCooperativeExitID(id uuid.UUID) cooperativeexitOption {
-	return func(m *CooperativeExitMutation) {
+// withArchivedTransferID sets the ID field of the mutation.
+func withArchivedTransferID(id uuid.UUID) archivedtransferOption {
+	return func(m *ArchivedTransferMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CooperativeExit
+			value *ArchivedTransfer
 		)
-		m.oldValue = func(ctx context.Context) (*CooperativeExit, error) {
+		m.oldValue = func(ctx context.Context) (*ArchivedTransfer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CooperativeExit.Get(ctx, id)
+					value, err = m.Client().ArchivedTransfer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -657,10 +745,10 @@ func withCooperativeExitID(id uuid.UUID) cooperativeexitOption {
 	}
 }
 
-// withCooperativeExit sets the old CooperativeExit of the mutation.
-func withCooperativeExit(node *CooperativeExit) cooperativeexitOption {
-	return func(m *CooperativeExitMutation) {
-		m.oldValue = func(context.Context) (*CooperativeExit, error) {
+// withArchivedTransfer sets the old ArchivedTransfer of the mutation.
+func withArchivedTransfer(node *ArchivedTransfer) archivedtransferOption {
+	return func(m *ArchivedTransferMutation) {
+		m.oldValue = func(context.Context) (*ArchivedTransfer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -669,7 +757,7 @@ func withCooperativeExit(node *CooperativeExit) cooperativeexitOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CooperativeExitMutation) Client() *Client {
+func (m ArchivedTransferMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -677,7 +765,7 @@ func (m CooperativeExitMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CooperativeExitMutation) Tx() (*Tx, error) {
+func (m ArchivedTransferMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -687,14 +775,14 @@ func (m CooperativeExitMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of CooperativeExit entities.
-func (m *CooperativeExitMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ArchivedTransfer entities.
+func (m *ArchivedTransferMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CooperativeExitMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ArchivedTransferMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -705,7 +793,7 @@ func (m *CooperativeExitMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CooperativeExitMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ArchivedTransferMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -714,19 +802,19 @@ func (m *CooperativeExitMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CooperativeExit.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ArchivedTransfer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *CooperativeExitMutation) SetCreateTime(t time.Time) {
+func (m *ArchivedTransferMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *CooperativeExitMutation) CreateTime() (r time.Time, exists bool) {
+func (m *ArchivedTransferMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -734,10 +822,10 @@ func (m *CooperativeExitMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the CooperativeExit entity.
-// If the CooperativeExit object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the ArchivedTransfer entity.
+// If the ArchivedTransfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CooperativeExitMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ArchivedTransferMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -752,17 +840,17 @@ func (m *CooperativeExitMutation) OldCreateTime(ctx context.Context) (v time.Tim
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *CooperativeExitMutation) ResetCreateTime() {
+func (m *ArchivedTransferMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *CooperativeExitMutation) SetUpdateTime(t time.Time) {
+func (m *ArchivedTransferMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *CooperativeExitMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *ArchivedTransferMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -770,10 +858,10 @@ func (m *CooperativeExitMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the CooperativeExit entity.
-// If the CooperativeExit object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the ArchivedTransfer entity.
+// If the ArchivedTransfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CooperativeExitMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ArchivedTransferMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -788,164 +876,294 @@ func (m *CooperativeExitMutation) OldUpdateTime(ctx context.Context) (v time.Tim
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *CooperativeExitMutation) ResetUpdateTime() {
+func (m *ArchivedTransferMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetExitTxid sets the "exit_txid" field.
-func (m *CooperativeExitMutation) SetExitTxid(b []byte) {
-	m.exit_txid = &b
+// SetSenderIdentityPubkey sets the "sender_identity_pubkey" field.
+func (m *ArchivedTransferMutation) SetSenderIdentityPubkey(b []byte) {
+	m.sender_identity_pubkey = &b
 }
 
-// ExitTxid returns the value of the "exit_txid" field in the mutation.
-func (m *CooperativeExitMutation) ExitTxid() (r []byte, exists bool) {
-	v := m.exit_txid
+// SenderIdentityPubkey returns the value of the "sender_identity_pubkey" field in the mutation.
+func (m *ArchivedTransferMutation) SenderIdentityPubkey() (r []byte, exists bool) {
+	v := m.sender_identity_pubkey
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExitTxid returns the old "exit_txid" field's value of the CooperativeExit entity.
-// If the CooperativeExit object wasn't provided to the builder, the object is fetched from the database.
+// OldSenderIdentityPubkey returns the old "sender_identity_pubkey" field's value of the ArchivedTransfer entity.
+// If the ArchivedTransfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CooperativeExitMutation) OldExitTxid(ctx context.Context) (v []byte, err error) {
+func (m *ArchivedTransferMutation) OldSenderIdentityPubkey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExitTxid is only allowed on UpdateOne operations")
+		return v, errors.New("OldSenderIdentityPubkey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExitTxid requires an ID field in the mutation")
+		return v, errors.New("OldSenderIdentityPubkey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExitTxid: %w", err)
+		return v, fmt.Errorf("querying old value for OldSenderIdentityPubkey: %w", err)
 	}
-	return oldValue.ExitTxid, nil
+	return oldValue.SenderIdentityPubkey, nil
 }
 
-// ResetExitTxid resets all changes to the "exit_txid" field.
-func (m *CooperativeExitMutation) ResetExitTxid() {
-	m.exit_txid = nil
+// ResetSenderIdentityPubkey resets all changes to the "sender_identity_pubkey" field.
+func (m *ArchivedTransferMutation) ResetSenderIdentityPubkey() {
+	m.sender_identity_pubkey = nil
 }
 
-// SetConfirmationHeight sets the "confirmation_height" field.
-func (m *CooperativeExitMutation) SetConfirmationHeight(i int64) {
-	m.confirmation_height = &i
-	m.addconfirmation_height = nil
+// SetReceiverIdentityPubkey sets the "receiver_identity_pubkey" field.
+func (m *ArchivedTransferMutation) SetReceiverIdentityPubkey(b []byte) {
+	m.receiver_identity_pubkey = &b
 }
 
-// ConfirmationHeight returns the value of the "confirmation_height" field in the mutation.
-func (m *CooperativeExitMutation) ConfirmationHeight() (r int64, exists bool) {
-	v := m.confirmation_height
+// ReceiverIdentityPubkey returns the value of the "receiver_identity_pubkey" field in the mutation.
+func (m *ArchivedTransferMutation) ReceiverIdentityPubkey() (r []byte, exists bool) {
+	v := m.receiver_identity_pubkey
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfirmationHeight returns the old "confirmation_height" field's value of the CooperativeExit entity.
-// If the CooperativeExit object wasn't provided to the builder, the object is fetched from the database.
+// OldReceiverIdentityPubkey returns the old "receiver_identity_pubkey" field's value of the ArchivedTransfer entity.
+// If the ArchivedTransfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CooperativeExitMutation) OldConfirmationHeight(ctx context.Context) (v int64, err error) {
+func (m *ArchivedTransferMutation) OldReceiverIdentityPubkey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfirmationHeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldReceiverIdentityPubkey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfirmationHeight requires an ID field in the mutation")
+		return v, errors.New("OldReceiverIdentityPubkey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfirmationHeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldReceiverIdentityPubkey: %w", err)
 	}
-	return oldValue.ConfirmationHeight, nil
+	return oldValue.ReceiverIdentityPubkey, nil
 }
 
-// AddConfirmationHeight adds i to the "confirmation_height" field.
-func (m *CooperativeExitMutation) AddConfirmationHeight(i int64) {
-	if m.addconfirmation_height != nil {
-		*m.addconfirmation_height += i
+// ResetReceiverIdentityPubkey resets all changes to the "receiver_identity_pubkey" field.
+func (m *ArchivedTransferMutation) ResetReceiverIdentityPubkey() {
+	m.receiver_identity_pubkey = nil
+}
+
+// SetTotalValue sets the "total_value" field.
+func (m *ArchivedTransferMutation) SetTotalValue(u uint64) {
+	m.total_value = &u
+	m.addtotal_value = nil
+}
+
+// TotalValue returns the value of the "total_value" field in the mutation.
+func (m *ArchivedTransferMutation) TotalValue() (r uint64, exists bool) {
+	v := m.total_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalValue returns the old "total_value" field's value of the ArchivedTransfer entity.
+// If the ArchivedTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedTransferMutation) OldTotalValue(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalValue: %w", err)
+	}
+	return oldValue.TotalValue, nil
+}
+
+// AddTotalValue adds u to the "total_value" field.
+func (m *ArchivedTransferMutation) AddTotalValue(u int64) {
+	if m.addtotal_value != nil {
+		*m.addtotal_value += u
 	} else {
-		m.addconfirmation_height = &i
+		m.addtotal_value = &u
 	}
 }
 
-// AddedConfirmationHeight returns the value that was added to the "confirmation_height" field in this mutation.
-func (m *CooperativeExitMutation) AddedConfirmationHeight() (r int64, exists bool) {
-	v := m.addconfirmation_height
+// AddedTotalValue returns the value that was added to the "total_value" field in this mutation.
+func (m *ArchivedTransferMutation) AddedTotalValue() (r int64, exists bool) {
+	v := m.addtotal_value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearConfirmationHeight clears the value of the "confirmation_height" field.
-func (m *CooperativeExitMutation) ClearConfirmationHeight() {
-	m.confirmation_height = nil
-	m.addconfirmation_height = nil
-	m.clearedFields[cooperativeexit.FieldConfirmationHeight] = struct{}{}
+// ResetTotalValue resets all changes to the "total_value" field.
+func (m *ArchivedTransferMutation) ResetTotalValue() {
+	m.total_value = nil
+	m.addtotal_value = nil
 }
 
-// ConfirmationHeightCleared returns if the "confirmation_height" field was cleared in this mutation.
-func (m *CooperativeExitMutation) ConfirmationHeightCleared() bool {
-	_, ok := m.clearedFields[cooperativeexit.FieldConfirmationHeight]
-	return ok
+// SetStatus sets the "status" field.
+func (m *ArchivedTransferMutation) SetStatus(ss schema.TransferStatus) {
+	m.status = &ss
 }
 
-// ResetConfirmationHeight resets all changes to the "confirmation_height" field.
-func (m *CooperativeExitMutation) ResetConfirmationHeight() {
-	m.confirmation_height = nil
-	m.addconfirmation_height = nil
-	delete(m.clearedFields, cooperativeexit.FieldConfirmationHeight)
+// Status returns the value of the "status" field in the mutation.
+func (m *ArchivedTransferMutation) Status() (r schema.TransferStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetTransferID sets the "transfer" edge to the Transfer entity by id.
-func (m *CooperativeExitMutation) SetTransferID(id uuid.UUID) {
-	m.transfer = &id
+// OldStatus returns the old "status" field's value of the ArchivedTransfer entity.
+// If the ArchivedTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedTransferMutation) OldStatus(ctx context.Context) (v schema.TransferStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ClearTransfer clears the "transfer" edge to the Transfer entity.
-func (m *CooperativeExitMutation) ClearTransfer() {
-	m.clearedtransfer = true
+// ResetStatus resets all changes to the "status" field.
+func (m *ArchivedTransferMutation) ResetStatus() {
+	m.status = nil
 }
 
-// TransferCleared reports if the "transfer" edge to the Transfer entity was cleared.
-func (m *CooperativeExitMutation) TransferCleared() bool {
-	return m.clearedtransfer
+// SetType sets the "type" field.
+func (m *ArchivedTransferMutation) SetType(st schema.TransferType) {
+	m._type = &st
 }
 
-// TransferID returns the "transfer" edge ID in the mutation.
-func (m *CooperativeExitMutation) TransferID() (id uuid.UUID, exists bool) {
-	if m.transfer != nil {
-		return *m.transfer, true
+// GetType returns the value of the "type" field in the mutation.
+func (m *ArchivedTransferMutation) GetType() (r schema.TransferType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ArchivedTransfer entity.
+// If the ArchivedTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedTransferMutation) OldType(ctx context.Context) (v schema.TransferType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ArchivedTransferMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTransferUpdateTime sets the "transfer_update_time" field.
+func (m *ArchivedTransferMutation) SetTransferUpdateTime(t time.Time) {
+	m.transfer_update_time = &t
+}
+
+// TransferUpdateTime returns the value of the "transfer_update_time" field in the mutation.
+func (m *ArchivedTransferMutation) TransferUpdateTime() (r time.Time, exists bool) {
+	v := m.transfer_update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransferUpdateTime returns the old "transfer_update_time" field's value of the ArchivedTransfer entity.
+// If the ArchivedTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedTransferMutation) OldTransferUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransferUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransferUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransferUpdateTime: %w", err)
+	}
+	return oldValue.TransferUpdateTime, nil
+}
+
+// ResetTransferUpdateTime resets all changes to the "transfer_update_time" field.
+func (m *ArchivedTransferMutation) ResetTransferUpdateTime() {
+	m.transfer_update_time = nil
+}
+
+// SetArchiveID sets the "archive" edge to the Archive entity by id.
+func (m *ArchivedTransferMutation) SetArchiveID(id uuid.UUID) {
+	m.archive = &id
+}
+
+// ClearArchive clears the "archive" edge to the Archive entity.
+func (m *ArchivedTransferMutation) ClearArchive() {
+	m.clearedarchive = true
+}
+
+// ArchiveCleared reports if the "archive" edge to the Archive entity was cleared.
+func (m *ArchivedTransferMutation) ArchiveCleared() bool {
+	return m.clearedarchive
+}
+
+// ArchiveID returns the "archive" edge ID in the mutation.
+func (m *ArchivedTransferMutation) ArchiveID() (id uuid.UUID, exists bool) {
+	if m.archive != nil {
+		return *m.archive, true
 	}
 	return
 }
 
-// TransferIDs returns the "transfer" edge IDs in the mutation.
+// ArchiveIDs returns the "archive" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TransferID instead. It exists only for internal usage by the builders.
-func (m *CooperativeExitMutation) TransferIDs() (ids []uuid.UUID) {
-	if id := m.transfer; id != nil {
+// ArchiveID instead. It exists only for internal usage by the builders.
+func (m *ArchivedTransferMutation) ArchiveIDs() (ids []uuid.UUID) {
+	if id := m.archive; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTransfer resets all changes to the "transfer" edge.
-func (m *CooperativeExitMutation) ResetTransfer() {
-	m.transfer = nil
-	m.clearedtransfer = false
+// ResetArchive resets all changes to the "archive" edge.
+func (m *ArchivedTransferMutation) ResetArchive() {
+	m.archive = nil
+	m.clearedarchive = false
 }
 
-// Where appends a list predicates to the CooperativeExitMutation builder.
-func (m *CooperativeExitMutation) Where(ps ...predicate.CooperativeExit) {
+// Where appends a list predicates to the ArchivedTransferMutation builder.
+func (m *ArchivedTransferMutation) Where(ps ...predicate.ArchivedTransfer) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CooperativeExitMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ArchivedTransferMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CooperativeExitMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CooperativeExit, len(ps))
+func (m *ArchivedTransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ArchivedTransfer, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -953,36 +1171,48 @@ func (m *CooperativeExitMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CooperativeExitMutation) Op() Op {
+func (m *ArchivedTransferMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CooperativeExitMutation) SetOp(op Op) {
+func (m *ArchivedTransferMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CooperativeExit).
-func (m *CooperativeExitMutation) Type() string {
+// Type returns the node type of this mutation (ArchivedTransfer).
+func (m *ArchivedTransferMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CooperativeExitMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *ArchivedTransferMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.create_time != nil {
-		fields = append(fields, cooperativeexit.FieldCreateTime)
+		fields = append(fields, archivedtransfer.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, cooperativeexit.FieldUpdateTime)
+		fields = append(fields, archivedtransfer.FieldUpdateTime)
 	}
-	if m.exit_txid != nil {
-		fields = append(fields, cooperativeexit.FieldExitTxid)
+	if m.sender_identity_pubkey != nil {
+		fields = append(fields, archivedtransfer.FieldSenderIdentityPubkey)
 	}
-	if m.confirmation_height != nil {
-		fields = append(fields, cooperativeexit.FieldConfirmationHeight)
+	if m.receiver_identity_pubkey != nil {
+		fields = append(fields, archivedtransfer.FieldReceiverIdentityPubkey)
+	}
+	if m.total_value != nil {
+		fields = append(fields, archivedtransfer.FieldTotalValue)
+	}
+	if m.status != nil {
+		fields = append(fields, archivedtransfer.FieldStatus)
+	}
+	if m._type != nil {
+		fields = append(fields, archivedtransfer.FieldType)
+	}
+	if m.transfer_update_time != nil {
+		fields = append(fields, archivedtransfer.FieldTransferUpdateTime)
 	}
 	return fields
 }
@@ -990,16 +1220,24 @@ func (m *CooperativeExitMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CooperativeExitMutation) Field(name string) (ent.Value, bool) {
+func (m *ArchivedTransferMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case cooperativeexit.FieldCreateTime:
+	case archivedtransfer.FieldCreateTime:
 		return m.CreateTime()
-	case cooperativeexit.FieldUpdateTime:
+	case archivedtransfer.FieldUpdateTime:
 		return m.UpdateTime()
-	case cooperativeexit.FieldExitTxid:
-		return m.ExitTxid()
-	case cooperativeexit.FieldConfirmationHeight:
-		return m.ConfirmationHeight()
+	case archivedtransfer.FieldSenderIdentityPubkey:
+		return m.SenderIdentityPubkey()
+	case archivedtransfer.FieldReceiverIdentityPubkey:
+		return m.ReceiverIdentityPubkey()
+	case archivedtransfer.FieldTotalValue:
+		return m.TotalValue()
+	case archivedtransfer.FieldStatus:
+		return m.Status()
+	case archivedtransfer.FieldType:
+		return m.GetType()
+	case archivedtransfer.FieldTransferUpdateTime:
+		return m.TransferUpdateTime()
 	}
 	return nil, false
 }
@@ -1007,63 +1245,99 @@ func (m *CooperativeExitMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CooperativeExitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ArchivedTransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case cooperativeexit.FieldCreateTime:
+	case archivedtransfer.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case cooperativeexit.FieldUpdateTime:
+	case archivedtransfer.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case cooperativeexit.FieldExitTxid:
-		return m.OldExitTxid(ctx)
-	case cooperativeexit.FieldConfirmationHeight:
-		return m.OldConfirmationHeight(ctx)
+	case archivedtransfer.FieldSenderIdentityPubkey:
+		return m.OldSenderIdentityPubkey(ctx)
+	case archivedtransfer.FieldReceiverIdentityPubkey:
+		return m.OldReceiverIdentityPubkey(ctx)
+	case archivedtransfer.FieldTotalValue:
+		return m.OldTotalValue(ctx)
+	case archivedtransfer.FieldStatus:
+		return m.OldStatus(ctx)
+	case archivedtransfer.FieldType:
+		return m.OldType(ctx)
+	case archivedtransfer.FieldTransferUpdateTime:
+		return m.OldTransferUpdateTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown CooperativeExit field %s", name)
+	return nil, fmt.Errorf("unknown ArchivedTransfer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CooperativeExitMutation) SetField(name string, value ent.Value) error {
+func (m *ArchivedTransferMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case cooperativeexit.FieldCreateTime:
+	case archivedtransfer.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case cooperativeexit.FieldUpdateTime:
+	case archivedtransfer.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case cooperativeexit.FieldExitTxid:
+	case archivedtransfer.FieldSenderIdentityPubkey:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExitTxid(v)
+		m.SetSenderIdentityPubkey(v)
 		return nil
-	case cooperativeexit.FieldConfirmationHeight:
-		v, ok := value.(int64)
+	case archivedtransfer.FieldReceiverIdentityPubkey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfirmationHeight(v)
+		m.SetReceiverIdentityPubkey(v)
+		return nil
+	case archivedtransfer.FieldTotalValue:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalValue(v)
+		return nil
+	case archivedtransfer.FieldStatus:
+		v, ok := value.(schema.TransferStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case archivedtransfer.FieldType:
+		v, ok := value.(schema.TransferType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case archivedtransfer.FieldTransferUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransferUpdateTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CooperativeExit field %s", name)
+	return fmt.Errorf("unknown ArchivedTransfer field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CooperativeExitMutation) AddedFields() []string {
+func (m *ArchivedTransferMutation) AddedFields() []string {
 	var fields []string
-	if m.addconfirmation_height != nil {
-		fields = append(fields, cooperativeexit.FieldConfirmationHeight)
+	if m.addtotal_value != nil {
+		fields = append(fields, archivedtransfer.FieldTotalValue)
 	}
 	return fields
 }
@@ -1071,10 +1345,10 @@ func (m *CooperativeExitMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CooperativeExitMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ArchivedTransferMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case cooperativeexit.FieldConfirmationHeight:
-		return m.AddedConfirmationHeight()
+	case archivedtransfer.FieldTotalValue:
+		return m.AddedTotalValue()
 	}
 	return nil, false
 }
@@ -1082,82 +1356,85 @@ func (m *CooperativeExitMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CooperativeExitMutation) AddField(name string, value ent.Value) error {
+func (m *ArchivedTransferMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case cooperativeexit.FieldConfirmationHeight:
+	case archivedtransfer.FieldTotalValue:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddConfirmationHeight(v)
+		m.AddTotalValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CooperativeExit numeric field %s", name)
+	return fmt.Errorf("unknown ArchivedTransfer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CooperativeExitMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(cooperativeexit.FieldConfirmationHeight) {
-		fields = append(fields, cooperativeexit.FieldConfirmationHeight)
-	}
-	return fields
+func (m *ArchivedTransferMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CooperativeExitMutation) FieldCleared(name string) bool {
+func (m *ArchivedTransferMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CooperativeExitMutation) ClearField(name string) error {
-	switch name {
-	case cooperativeexit.FieldConfirmationHeight:
-		m.ClearConfirmationHeight()
-		return nil
-	}
-	return fmt.Errorf("unknown CooperativeExit nullable field %s", name)
+func (m *ArchivedTransferMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ArchivedTransfer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CooperativeExitMutation) ResetField(name string) error {
+func (m *ArchivedTransferMutation) ResetField(name string) error {
 	switch name {
-	case cooperativeexit.FieldCreateTime:
+	case archivedtransfer.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case cooperativeexit.FieldUpdateTime:
+	case archivedtransfer.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case cooperativeexit.FieldExitTxid:
-		m.ResetExitTxid()
+	case archivedtransfer.FieldSenderIdentityPubkey:
+		m.ResetSenderIdentityPubkey()
 		return nil
-	case cooperativeexit.FieldConfirmationHeight:
-		m.ResetConfirmationHeight()
+	case archivedtransfer.FieldReceiverIdentityPubkey:
+		m.ResetReceiverIdentityPubkey()
+		return nil
+	case archivedtransfer.FieldTotalValue:
+		m.ResetTotalValue()
+		return nil
+	case archivedtransfer.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case archivedtransfer.FieldType:
+		m.ResetType()
+		return nil
+	case archivedtransfer.FieldTransferUpdateTime:
+		m.ResetTransferUpdateTime()
 		return nil
 	}
-	return fmt.Errorf("unknown CooperativeExit field %s", name)
+	return fmt.Errorf("unknown ArchivedTransfer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CooperativeExitMutation) AddedEdges() []string {
+func (m *ArchivedTransferMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.transfer != nil {
-		edges = append(edges, cooperativeexit.EdgeTransfer)
+	if m.archive != nil {
+		edges = append(edges, archivedtransfer.EdgeArchive)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CooperativeExitMutation) AddedIDs(name string) []ent.Value {
+func (m *ArchivedTransferMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case cooperativeexit.EdgeTransfer:
-		if id := m.transfer; id != nil {
+	case archivedtransfer.EdgeArchive:
+		if id := m.archive; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1165,99 +1442,86 @@ func (m *CooperativeExitMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CooperativeExitMutation) RemovedEdges() []string {
+func (m *ArchivedTransferMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CooperativeExitMutation) RemovedIDs(name string) []ent.Value {
+func (m *ArchivedTransferMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CooperativeExitMutation) ClearedEdges() []string {
+func (m *ArchivedTransferMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedtransfer {
-		edges = append(edges, cooperativeexit.EdgeTransfer)
+	if m.clearedarchive {
+		edges = append(edges, archivedtransfer.EdgeArchive)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CooperativeExitMutation) EdgeCleared(name string) bool {
+func (m *ArchivedTransferMutation) EdgeCleared(name string) bool {
 	switch name {
-	case cooperativeexit.EdgeTransfer:
-		return m.clearedtransfer
+	case archivedtransfer.EdgeArchive:
+		return m.clearedarchive
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CooperativeExitMutation) ClearEdge(name string) error {
+func (m *ArchivedTransferMutation) ClearEdge(name string) error {
 	switch name {
-	case cooperativeexit.EdgeTransfer:
-		m.ClearTransfer()
+	case archivedtransfer.EdgeArchive:
+		m.ClearArchive()
 		return nil
 	}
-	return fmt.Errorf("unknown CooperativeExit unique edge %s", name)
+	return fmt.Errorf("unknown ArchivedTransfer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CooperativeExitMutation) ResetEdge(name string) error {
+func (m *ArchivedTransferMutation) ResetEdge(name string) error {
 	switch name {
-	case cooperativeexit.EdgeTransfer:
-		m.ResetTransfer()
+	case archivedtransfer.EdgeArchive:
+		m.ResetArchive()
 		return nil
 	}
-	return fmt.Errorf("unknown CooperativeExit edge %s", name)
+	return fmt.Errorf("unknown ArchivedTransfer edge %s", name)
 }
 
-// DepositAddressMutation represents an operation that mutates the DepositAddress nodes in the graph.
-type DepositAddressMutation struct {
+// BlockHeightMutation represents an operation that mutates the BlockHeight nodes in the graph.
+type BlockHeightMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	create_time             *time.Time
-	update_time             *time.Time
-	address                 *string
-	owner_identity_pubkey   *[]byte
-	owner_signing_pubkey    *[]byte
-	confirmation_height     *int64
-	addconfirmation_height  *int64
-	confirmation_txid       *string
-	node_id                 *uuid.UUID
-	is_static               *bool
-	clearedFields           map[string]struct{}
-	signing_keyshare        *uuid.UUID
-	clearedsigning_keyshare bool
-	utxo                    map[uuid.UUID]struct{}
-	removedutxo             map[uuid.UUID]struct{}
-	clearedutxo             bool
-	utxoswaps               map[uuid.UUID]struct{}
-	removedutxoswaps        map[uuid.UUID]struct{}
-	clearedutxoswaps        bool
-	done                    bool
-	oldValue                func(context.Context) (*DepositAddress, error)
-	predicates              []predicate.DepositAddress
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	height        *int64
+	addheight     *int64
+	network       *schema.Network
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*BlockHeight, error)
+	predicates    []predicate.BlockHeight
 }
 
-var _ ent.Mutation = (*DepositAddressMutation)(nil)
+var _ ent.Mutation = (*BlockHeightMutation)(nil)
 
-// depositaddressOption allows management of the mutation configuration using functional options.
-type depositaddressOption func(*DepositAddressMutation)
+// blockheightOption allows management of the mutation configuration using functional options.
+type blockheightOption func(*BlockHeightMutation)
 
-// newDepositAddressMutation creates new mutation for the DepositAddress entity.
-func newDepositAddressMutation(c config, op Op, opts ...depositaddressOption) *DepositAddressMutation {
-	m := &DepositAddressMutation{
+// newBlockHeightMutation creates new mutation for the BlockHeight entity.
+func newBlockHeightMutation(c config, op Op, opts ...blockheightOption) *BlockHeightMutation {
+	m := &BlockHeightMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDepositAddress,
+		typ:           TypeBlockHeight,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1266,20 +1530,20 @@ func newDepositAddressMutation(c config, op Op, opts ...depositaddressOption) *D
 	return m
 }
 
-// withDepositAddressID sets the ID field of the mutation.
-func withDepositAddressID(id uuid.UUID) depositaddressOption {
-	return func(m *DepositAddressMutation) {
+// withBlockHeightID sets the ID field of the mutation.
+func withBlockHeightID(id uuid.UUID) blockheightOption {
+	return func(m *BlockHeightMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DepositAddress
+			value *BlockHeight
 		)
-		m.oldValue = func(ctx context.Context) (*DepositAddress, error) {
+		m.oldValue = func(ctx context.Context) (*BlockHeight, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DepositAddress.Get(ctx, id)
+					value, err = m.Client().BlockHeight.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1288,10 +1552,10 @@ func withDepositAddressID(id uuid.UUID) depositaddressOption {
 	}
 }
 
-// withDepositAddress sets the old DepositAddress of the mutation.
-func withDepositAddress(node *DepositAddress) depositaddressOption {
-	return func(m *DepositAddressMutation) {
-		m.oldValue = func(context.Context) (*DepositAddress, error) {
+// withBlockHeight sets the old BlockHeight of the mutation.
+func withBlockHeight(node *BlockHeight) blockheightOption {
+	return func(m *BlockHeightMutation) {
+		m.oldValue = func(context.Context) (*BlockHeight, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1300,7 +1564,7 @@ func withDepositAddress(node *DepositAddress) depositaddressOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DepositAddressMutation) Client() *Client {
+func (m BlockHeightMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1308,7 +1572,7 @@ func (m DepositAddressMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DepositAddressMutation) Tx() (*Tx, error) {
+func (m BlockHeightMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1318,14 +1582,14 @@ func (m DepositAddressMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DepositAddress entities.
-func (m *DepositAddressMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of BlockHeight entities.
+func (m *BlockHeightMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DepositAddressMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BlockHeightMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1336,7 +1600,7 @@ func (m *DepositAddressMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DepositAddressMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BlockHeightMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1345,19 +1609,19 @@ func (m *DepositAddressMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DepositAddress.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BlockHeight.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *DepositAddressMutation) SetCreateTime(t time.Time) {
+func (m *BlockHeightMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *DepositAddressMutation) CreateTime() (r time.Time, exists bool) {
+func (m *BlockHeightMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -1365,10 +1629,10 @@ func (m *DepositAddressMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the DepositAddress entity.
-// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the BlockHeight entity.
+// If the BlockHeight object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepositAddressMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *BlockHeightMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -1383,17 +1647,17 @@ func (m *DepositAddressMutation) OldCreateTime(ctx context.Context) (v time.Time
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *DepositAddressMutation) ResetCreateTime() {
+func (m *BlockHeightMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *DepositAddressMutation) SetUpdateTime(t time.Time) {
+func (m *BlockHeightMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *DepositAddressMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *BlockHeightMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -1401,10 +1665,10 @@ func (m *DepositAddressMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the DepositAddress entity.
-// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the BlockHeight entity.
+// If the BlockHeight object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepositAddressMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *BlockHeightMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -1419,478 +1683,11241 @@ func (m *DepositAddressMutation) OldUpdateTime(ctx context.Context) (v time.Time
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *DepositAddressMutation) ResetUpdateTime() {
+func (m *BlockHeightMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetAddress sets the "address" field.
-func (m *DepositAddressMutation) SetAddress(s string) {
-	m.address = &s
+// SetHeight sets the "height" field.
+func (m *BlockHeightMutation) SetHeight(i int64) {
+	m.height = &i
+	m.addheight = nil
 }
 
-// Address returns the value of the "address" field in the mutation.
-func (m *DepositAddressMutation) Address() (r string, exists bool) {
-	v := m.address
+// Height returns the value of the "height" field in the mutation.
+func (m *BlockHeightMutation) Height() (r int64, exists bool) {
+	v := m.height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAddress returns the old "address" field's value of the DepositAddress entity.
-// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// OldHeight returns the old "height" field's value of the BlockHeight entity.
+// If the BlockHeight object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepositAddressMutation) OldAddress(ctx context.Context) (v string, err error) {
+func (m *BlockHeightMutation) OldHeight(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAddress requires an ID field in the mutation")
+		return v, errors.New("OldHeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
 	}
-	return oldValue.Address, nil
+	return oldValue.Height, nil
 }
 
-// ResetAddress resets all changes to the "address" field.
-func (m *DepositAddressMutation) ResetAddress() {
+// AddHeight adds i to the "height" field.
+func (m *BlockHeightMutation) AddHeight(i int64) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *BlockHeightMutation) AddedHeight() (r int64, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *BlockHeightMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetNetwork sets the "network" field.
+func (m *BlockHeightMutation) SetNetwork(s schema.Network) {
+	m.network = &s
+}
+
+// Network returns the value of the "network" field in the mutation.
+func (m *BlockHeightMutation) Network() (r schema.Network, exists bool) {
+	v := m.network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetwork returns the old "network" field's value of the BlockHeight entity.
+// If the BlockHeight object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockHeightMutation) OldNetwork(ctx context.Context) (v schema.Network, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
+	}
+	return oldValue.Network, nil
+}
+
+// ResetNetwork resets all changes to the "network" field.
+func (m *BlockHeightMutation) ResetNetwork() {
+	m.network = nil
+}
+
+// Where appends a list predicates to the BlockHeightMutation builder.
+func (m *BlockHeightMutation) Where(ps ...predicate.BlockHeight) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BlockHeightMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlockHeightMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BlockHeight, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BlockHeightMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BlockHeightMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BlockHeight).
+func (m *BlockHeightMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlockHeightMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.create_time != nil {
+		fields = append(fields, blockheight.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, blockheight.FieldUpdateTime)
+	}
+	if m.height != nil {
+		fields = append(fields, blockheight.FieldHeight)
+	}
+	if m.network != nil {
+		fields = append(fields, blockheight.FieldNetwork)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlockHeightMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case blockheight.FieldCreateTime:
+		return m.CreateTime()
+	case blockheight.FieldUpdateTime:
+		return m.UpdateTime()
+	case blockheight.FieldHeight:
+		return m.Height()
+	case blockheight.FieldNetwork:
+		return m.Network()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlockHeightMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case blockheight.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case blockheight.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case blockheight.FieldHeight:
+		return m.OldHeight(ctx)
+	case blockheight.FieldNetwork:
+		return m.OldNetwork(ctx)
+	}
+	return nil, fmt.Errorf("unknown BlockHeight field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockHeightMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case blockheight.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case blockheight.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case blockheight.FieldHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case blockheight.FieldNetwork:
+		v, ok := value.(schema.Network)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetwork(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BlockHeight field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlockHeightMutation) AddedFields() []string {
+	var fields []string
+	if m.addheight != nil {
+		fields = append(fields, blockheight.FieldHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlockHeightMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blockheight.FieldHeight:
+		return m.AddedHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockHeightMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case blockheight.FieldHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BlockHeight numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlockHeightMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlockHeightMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlockHeightMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BlockHeight nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlockHeightMutation) ResetField(name string) error {
+	switch name {
+	case blockheight.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case blockheight.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case blockheight.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case blockheight.FieldNetwork:
+		m.ResetNetwork()
+		return nil
+	}
+	return fmt.Errorf("unknown BlockHeight field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlockHeightMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlockHeightMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlockHeightMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlockHeightMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlockHeightMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlockHeightMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlockHeightMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BlockHeight unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlockHeightMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BlockHeight edge %s", name)
+}
+
+// ConsistencyAuditCursorMutation represents an operation that mutates the ConsistencyAuditCursor nodes in the graph.
+type ConsistencyAuditCursorMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	kind          *schema.ConsistencyKind
+	key           *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ConsistencyAuditCursor, error)
+	predicates    []predicate.ConsistencyAuditCursor
+}
+
+var _ ent.Mutation = (*ConsistencyAuditCursorMutation)(nil)
+
+// consistencyauditcursorOption allows management of the mutation configuration using functional options.
+type consistencyauditcursorOption func(*ConsistencyAuditCursorMutation)
+
+// newConsistencyAuditCursorMutation creates new mutation for the ConsistencyAuditCursor entity.
+func newConsistencyAuditCursorMutation(c config, op Op, opts ...consistencyauditcursorOption) *ConsistencyAuditCursorMutation {
+	m := &ConsistencyAuditCursorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConsistencyAuditCursor,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConsistencyAuditCursorID sets the ID field of the mutation.
+func withConsistencyAuditCursorID(id uuid.UUID) consistencyauditcursorOption {
+	return func(m *ConsistencyAuditCursorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConsistencyAuditCursor
+		)
+		m.oldValue = func(ctx context.Context) (*ConsistencyAuditCursor, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConsistencyAuditCursor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConsistencyAuditCursor sets the old ConsistencyAuditCursor of the mutation.
+func withConsistencyAuditCursor(node *ConsistencyAuditCursor) consistencyauditcursorOption {
+	return func(m *ConsistencyAuditCursorMutation) {
+		m.oldValue = func(context.Context) (*ConsistencyAuditCursor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConsistencyAuditCursorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConsistencyAuditCursorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConsistencyAuditCursor entities.
+func (m *ConsistencyAuditCursorMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConsistencyAuditCursorMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConsistencyAuditCursorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConsistencyAuditCursor.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ConsistencyAuditCursorMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ConsistencyAuditCursorMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ConsistencyAuditCursor entity.
+// If the ConsistencyAuditCursor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyAuditCursorMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ConsistencyAuditCursorMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ConsistencyAuditCursorMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ConsistencyAuditCursorMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ConsistencyAuditCursor entity.
+// If the ConsistencyAuditCursor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyAuditCursorMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ConsistencyAuditCursorMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *ConsistencyAuditCursorMutation) SetKind(sk schema.ConsistencyKind) {
+	m.kind = &sk
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ConsistencyAuditCursorMutation) Kind() (r schema.ConsistencyKind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the ConsistencyAuditCursor entity.
+// If the ConsistencyAuditCursor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyAuditCursorMutation) OldKind(ctx context.Context) (v schema.ConsistencyKind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ConsistencyAuditCursorMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetKey sets the "key" field.
+func (m *ConsistencyAuditCursorMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ConsistencyAuditCursorMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ConsistencyAuditCursor entity.
+// If the ConsistencyAuditCursor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyAuditCursorMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ConsistencyAuditCursorMutation) ResetKey() {
+	m.key = nil
+}
+
+// Where appends a list predicates to the ConsistencyAuditCursorMutation builder.
+func (m *ConsistencyAuditCursorMutation) Where(ps ...predicate.ConsistencyAuditCursor) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConsistencyAuditCursorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConsistencyAuditCursorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConsistencyAuditCursor, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConsistencyAuditCursorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConsistencyAuditCursorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConsistencyAuditCursor).
+func (m *ConsistencyAuditCursorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConsistencyAuditCursorMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.create_time != nil {
+		fields = append(fields, consistencyauditcursor.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, consistencyauditcursor.FieldUpdateTime)
+	}
+	if m.kind != nil {
+		fields = append(fields, consistencyauditcursor.FieldKind)
+	}
+	if m.key != nil {
+		fields = append(fields, consistencyauditcursor.FieldKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConsistencyAuditCursorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case consistencyauditcursor.FieldCreateTime:
+		return m.CreateTime()
+	case consistencyauditcursor.FieldUpdateTime:
+		return m.UpdateTime()
+	case consistencyauditcursor.FieldKind:
+		return m.Kind()
+	case consistencyauditcursor.FieldKey:
+		return m.Key()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConsistencyAuditCursorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case consistencyauditcursor.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case consistencyauditcursor.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case consistencyauditcursor.FieldKind:
+		return m.OldKind(ctx)
+	case consistencyauditcursor.FieldKey:
+		return m.OldKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConsistencyAuditCursor field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConsistencyAuditCursorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case consistencyauditcursor.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case consistencyauditcursor.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case consistencyauditcursor.FieldKind:
+		v, ok := value.(schema.ConsistencyKind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case consistencyauditcursor.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConsistencyAuditCursor field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConsistencyAuditCursorMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConsistencyAuditCursorMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConsistencyAuditCursorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ConsistencyAuditCursor numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConsistencyAuditCursorMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConsistencyAuditCursorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConsistencyAuditCursorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConsistencyAuditCursor nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConsistencyAuditCursorMutation) ResetField(name string) error {
+	switch name {
+	case consistencyauditcursor.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case consistencyauditcursor.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case consistencyauditcursor.FieldKind:
+		m.ResetKind()
+		return nil
+	case consistencyauditcursor.FieldKey:
+		m.ResetKey()
+		return nil
+	}
+	return fmt.Errorf("unknown ConsistencyAuditCursor field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConsistencyAuditCursorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConsistencyAuditCursorMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConsistencyAuditCursorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConsistencyAuditCursorMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConsistencyAuditCursorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConsistencyAuditCursorMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConsistencyAuditCursorMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConsistencyAuditCursor unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConsistencyAuditCursorMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConsistencyAuditCursor edge %s", name)
+}
+
+// ConsistencyDiscrepancyMutation represents an operation that mutates the ConsistencyDiscrepancy nodes in the graph.
+type ConsistencyDiscrepancyMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	create_time               *time.Time
+	update_time               *time.Time
+	kind                      *schema.ConsistencyKind
+	key                       *string
+	status                    *schema.ConsistencyDiscrepancyStatus
+	digests                   *map[string]string
+	divergent_operators       *[]string
+	appenddivergent_operators []string
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*ConsistencyDiscrepancy, error)
+	predicates                []predicate.ConsistencyDiscrepancy
+}
+
+var _ ent.Mutation = (*ConsistencyDiscrepancyMutation)(nil)
+
+// consistencydiscrepancyOption allows management of the mutation configuration using functional options.
+type consistencydiscrepancyOption func(*ConsistencyDiscrepancyMutation)
+
+// newConsistencyDiscrepancyMutation creates new mutation for the ConsistencyDiscrepancy entity.
+func newConsistencyDiscrepancyMutation(c config, op Op, opts ...consistencydiscrepancyOption) *ConsistencyDiscrepancyMutation {
+	m := &ConsistencyDiscrepancyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConsistencyDiscrepancy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConsistencyDiscrepancyID sets the ID field of the mutation.
+func withConsistencyDiscrepancyID(id uuid.UUID) consistencydiscrepancyOption {
+	return func(m *ConsistencyDiscrepancyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConsistencyDiscrepancy
+		)
+		m.oldValue = func(ctx context.Context) (*ConsistencyDiscrepancy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConsistencyDiscrepancy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConsistencyDiscrepancy sets the old ConsistencyDiscrepancy of the mutation.
+func withConsistencyDiscrepancy(node *ConsistencyDiscrepancy) consistencydiscrepancyOption {
+	return func(m *ConsistencyDiscrepancyMutation) {
+		m.oldValue = func(context.Context) (*ConsistencyDiscrepancy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConsistencyDiscrepancyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConsistencyDiscrepancyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConsistencyDiscrepancy entities.
+func (m *ConsistencyDiscrepancyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConsistencyDiscrepancyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConsistencyDiscrepancyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConsistencyDiscrepancy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ConsistencyDiscrepancyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ConsistencyDiscrepancyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ConsistencyDiscrepancy entity.
+// If the ConsistencyDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyDiscrepancyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ConsistencyDiscrepancyMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ConsistencyDiscrepancyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ConsistencyDiscrepancyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ConsistencyDiscrepancy entity.
+// If the ConsistencyDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyDiscrepancyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ConsistencyDiscrepancyMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *ConsistencyDiscrepancyMutation) SetKind(sk schema.ConsistencyKind) {
+	m.kind = &sk
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ConsistencyDiscrepancyMutation) Kind() (r schema.ConsistencyKind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the ConsistencyDiscrepancy entity.
+// If the ConsistencyDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyDiscrepancyMutation) OldKind(ctx context.Context) (v schema.ConsistencyKind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ConsistencyDiscrepancyMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetKey sets the "key" field.
+func (m *ConsistencyDiscrepancyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ConsistencyDiscrepancyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ConsistencyDiscrepancy entity.
+// If the ConsistencyDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyDiscrepancyMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ConsistencyDiscrepancyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ConsistencyDiscrepancyMutation) SetStatus(sds schema.ConsistencyDiscrepancyStatus) {
+	m.status = &sds
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ConsistencyDiscrepancyMutation) Status() (r schema.ConsistencyDiscrepancyStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ConsistencyDiscrepancy entity.
+// If the ConsistencyDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyDiscrepancyMutation) OldStatus(ctx context.Context) (v schema.ConsistencyDiscrepancyStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ConsistencyDiscrepancyMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDigests sets the "digests" field.
+func (m *ConsistencyDiscrepancyMutation) SetDigests(value map[string]string) {
+	m.digests = &value
+}
+
+// Digests returns the value of the "digests" field in the mutation.
+func (m *ConsistencyDiscrepancyMutation) Digests() (r map[string]string, exists bool) {
+	v := m.digests
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDigests returns the old "digests" field's value of the ConsistencyDiscrepancy entity.
+// If the ConsistencyDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyDiscrepancyMutation) OldDigests(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDigests is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDigests requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDigests: %w", err)
+	}
+	return oldValue.Digests, nil
+}
+
+// ResetDigests resets all changes to the "digests" field.
+func (m *ConsistencyDiscrepancyMutation) ResetDigests() {
+	m.digests = nil
+}
+
+// SetDivergentOperators sets the "divergent_operators" field.
+func (m *ConsistencyDiscrepancyMutation) SetDivergentOperators(s []string) {
+	m.divergent_operators = &s
+	m.appenddivergent_operators = nil
+}
+
+// DivergentOperators returns the value of the "divergent_operators" field in the mutation.
+func (m *ConsistencyDiscrepancyMutation) DivergentOperators() (r []string, exists bool) {
+	v := m.divergent_operators
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDivergentOperators returns the old "divergent_operators" field's value of the ConsistencyDiscrepancy entity.
+// If the ConsistencyDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsistencyDiscrepancyMutation) OldDivergentOperators(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDivergentOperators is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDivergentOperators requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDivergentOperators: %w", err)
+	}
+	return oldValue.DivergentOperators, nil
+}
+
+// AppendDivergentOperators adds s to the "divergent_operators" field.
+func (m *ConsistencyDiscrepancyMutation) AppendDivergentOperators(s []string) {
+	m.appenddivergent_operators = append(m.appenddivergent_operators, s...)
+}
+
+// AppendedDivergentOperators returns the list of values that were appended to the "divergent_operators" field in this mutation.
+func (m *ConsistencyDiscrepancyMutation) AppendedDivergentOperators() ([]string, bool) {
+	if len(m.appenddivergent_operators) == 0 {
+		return nil, false
+	}
+	return m.appenddivergent_operators, true
+}
+
+// ResetDivergentOperators resets all changes to the "divergent_operators" field.
+func (m *ConsistencyDiscrepancyMutation) ResetDivergentOperators() {
+	m.divergent_operators = nil
+	m.appenddivergent_operators = nil
+}
+
+// Where appends a list predicates to the ConsistencyDiscrepancyMutation builder.
+func (m *ConsistencyDiscrepancyMutation) Where(ps ...predicate.ConsistencyDiscrepancy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConsistencyDiscrepancyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConsistencyDiscrepancyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConsistencyDiscrepancy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConsistencyDiscrepancyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConsistencyDiscrepancyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConsistencyDiscrepancy).
+func (m *ConsistencyDiscrepancyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConsistencyDiscrepancyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, consistencydiscrepancy.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, consistencydiscrepancy.FieldUpdateTime)
+	}
+	if m.kind != nil {
+		fields = append(fields, consistencydiscrepancy.FieldKind)
+	}
+	if m.key != nil {
+		fields = append(fields, consistencydiscrepancy.FieldKey)
+	}
+	if m.status != nil {
+		fields = append(fields, consistencydiscrepancy.FieldStatus)
+	}
+	if m.digests != nil {
+		fields = append(fields, consistencydiscrepancy.FieldDigests)
+	}
+	if m.divergent_operators != nil {
+		fields = append(fields, consistencydiscrepancy.FieldDivergentOperators)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConsistencyDiscrepancyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case consistencydiscrepancy.FieldCreateTime:
+		return m.CreateTime()
+	case consistencydiscrepancy.FieldUpdateTime:
+		return m.UpdateTime()
+	case consistencydiscrepancy.FieldKind:
+		return m.Kind()
+	case consistencydiscrepancy.FieldKey:
+		return m.Key()
+	case consistencydiscrepancy.FieldStatus:
+		return m.Status()
+	case consistencydiscrepancy.FieldDigests:
+		return m.Digests()
+	case consistencydiscrepancy.FieldDivergentOperators:
+		return m.DivergentOperators()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConsistencyDiscrepancyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case consistencydiscrepancy.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case consistencydiscrepancy.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case consistencydiscrepancy.FieldKind:
+		return m.OldKind(ctx)
+	case consistencydiscrepancy.FieldKey:
+		return m.OldKey(ctx)
+	case consistencydiscrepancy.FieldStatus:
+		return m.OldStatus(ctx)
+	case consistencydiscrepancy.FieldDigests:
+		return m.OldDigests(ctx)
+	case consistencydiscrepancy.FieldDivergentOperators:
+		return m.OldDivergentOperators(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConsistencyDiscrepancy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConsistencyDiscrepancyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case consistencydiscrepancy.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case consistencydiscrepancy.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case consistencydiscrepancy.FieldKind:
+		v, ok := value.(schema.ConsistencyKind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case consistencydiscrepancy.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case consistencydiscrepancy.FieldStatus:
+		v, ok := value.(schema.ConsistencyDiscrepancyStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case consistencydiscrepancy.FieldDigests:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigests(v)
+		return nil
+	case consistencydiscrepancy.FieldDivergentOperators:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDivergentOperators(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConsistencyDiscrepancy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConsistencyDiscrepancyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConsistencyDiscrepancyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConsistencyDiscrepancyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ConsistencyDiscrepancy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConsistencyDiscrepancyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConsistencyDiscrepancyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConsistencyDiscrepancyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConsistencyDiscrepancy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConsistencyDiscrepancyMutation) ResetField(name string) error {
+	switch name {
+	case consistencydiscrepancy.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case consistencydiscrepancy.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case consistencydiscrepancy.FieldKind:
+		m.ResetKind()
+		return nil
+	case consistencydiscrepancy.FieldKey:
+		m.ResetKey()
+		return nil
+	case consistencydiscrepancy.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case consistencydiscrepancy.FieldDigests:
+		m.ResetDigests()
+		return nil
+	case consistencydiscrepancy.FieldDivergentOperators:
+		m.ResetDivergentOperators()
+		return nil
+	}
+	return fmt.Errorf("unknown ConsistencyDiscrepancy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConsistencyDiscrepancyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConsistencyDiscrepancyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConsistencyDiscrepancyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConsistencyDiscrepancyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConsistencyDiscrepancyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConsistencyDiscrepancyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConsistencyDiscrepancyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConsistencyDiscrepancy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConsistencyDiscrepancyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConsistencyDiscrepancy edge %s", name)
+}
+
+// CooperativeExitMutation represents an operation that mutates the CooperativeExit nodes in the graph.
+type CooperativeExitMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	create_time            *time.Time
+	update_time            *time.Time
+	exit_txid              *[]byte
+	confirmation_height    *int64
+	addconfirmation_height *int64
+	clearedFields          map[string]struct{}
+	transfer               *uuid.UUID
+	clearedtransfer        bool
+	done                   bool
+	oldValue               func(context.Context) (*CooperativeExit, error)
+	predicates             []predicate.CooperativeExit
+}
+
+var _ ent.Mutation = (*CooperativeExitMutation)(nil)
+
+// cooperativeexitOption allows management of the mutation configuration using functional options.
+type cooperativeexitOption func(*CooperativeExitMutation)
+
+// newCooperativeExitMutation creates new mutation for the CooperativeExit entity.
+func newCooperativeExitMutation(c config, op Op, opts ...cooperativeexitOption) *CooperativeExitMutation {
+	m := &CooperativeExitMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCooperativeExit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCooperativeExitID sets the ID field of the mutation.
+func withCooperativeExitID(id uuid.UUID) cooperativeexitOption {
+	return func(m *CooperativeExitMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CooperativeExit
+		)
+		m.oldValue = func(ctx context.Context) (*CooperativeExit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CooperativeExit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCooperativeExit sets the old CooperativeExit of the mutation.
+func withCooperativeExit(node *CooperativeExit) cooperativeexitOption {
+	return func(m *CooperativeExitMutation) {
+		m.oldValue = func(context.Context) (*CooperativeExit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CooperativeExitMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CooperativeExitMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CooperativeExit entities.
+func (m *CooperativeExitMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CooperativeExitMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CooperativeExitMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CooperativeExit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *CooperativeExitMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *CooperativeExitMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the CooperativeExit entity.
+// If the CooperativeExit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CooperativeExitMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *CooperativeExitMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *CooperativeExitMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *CooperativeExitMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the CooperativeExit entity.
+// If the CooperativeExit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CooperativeExitMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *CooperativeExitMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetExitTxid sets the "exit_txid" field.
+func (m *CooperativeExitMutation) SetExitTxid(b []byte) {
+	m.exit_txid = &b
+}
+
+// ExitTxid returns the value of the "exit_txid" field in the mutation.
+func (m *CooperativeExitMutation) ExitTxid() (r []byte, exists bool) {
+	v := m.exit_txid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExitTxid returns the old "exit_txid" field's value of the CooperativeExit entity.
+// If the CooperativeExit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CooperativeExitMutation) OldExitTxid(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitTxid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitTxid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitTxid: %w", err)
+	}
+	return oldValue.ExitTxid, nil
+}
+
+// ResetExitTxid resets all changes to the "exit_txid" field.
+func (m *CooperativeExitMutation) ResetExitTxid() {
+	m.exit_txid = nil
+}
+
+// SetConfirmationHeight sets the "confirmation_height" field.
+func (m *CooperativeExitMutation) SetConfirmationHeight(i int64) {
+	m.confirmation_height = &i
+	m.addconfirmation_height = nil
+}
+
+// ConfirmationHeight returns the value of the "confirmation_height" field in the mutation.
+func (m *CooperativeExitMutation) ConfirmationHeight() (r int64, exists bool) {
+	v := m.confirmation_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfirmationHeight returns the old "confirmation_height" field's value of the CooperativeExit entity.
+// If the CooperativeExit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CooperativeExitMutation) OldConfirmationHeight(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfirmationHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfirmationHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfirmationHeight: %w", err)
+	}
+	return oldValue.ConfirmationHeight, nil
+}
+
+// AddConfirmationHeight adds i to the "confirmation_height" field.
+func (m *CooperativeExitMutation) AddConfirmationHeight(i int64) {
+	if m.addconfirmation_height != nil {
+		*m.addconfirmation_height += i
+	} else {
+		m.addconfirmation_height = &i
+	}
+}
+
+// AddedConfirmationHeight returns the value that was added to the "confirmation_height" field in this mutation.
+func (m *CooperativeExitMutation) AddedConfirmationHeight() (r int64, exists bool) {
+	v := m.addconfirmation_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearConfirmationHeight clears the value of the "confirmation_height" field.
+func (m *CooperativeExitMutation) ClearConfirmationHeight() {
+	m.confirmation_height = nil
+	m.addconfirmation_height = nil
+	m.clearedFields[cooperativeexit.FieldConfirmationHeight] = struct{}{}
+}
+
+// ConfirmationHeightCleared returns if the "confirmation_height" field was cleared in this mutation.
+func (m *CooperativeExitMutation) ConfirmationHeightCleared() bool {
+	_, ok := m.clearedFields[cooperativeexit.FieldConfirmationHeight]
+	return ok
+}
+
+// ResetConfirmationHeight resets all changes to the "confirmation_height" field.
+func (m *CooperativeExitMutation) ResetConfirmationHeight() {
+	m.confirmation_height = nil
+	m.addconfirmation_height = nil
+	delete(m.clearedFields, cooperativeexit.FieldConfirmationHeight)
+}
+
+// SetTransferID sets the "transfer" edge to the Transfer entity by id.
+func (m *CooperativeExitMutation) SetTransferID(id uuid.UUID) {
+	m.transfer = &id
+}
+
+// ClearTransfer clears the "transfer" edge to the Transfer entity.
+func (m *CooperativeExitMutation) ClearTransfer() {
+	m.clearedtransfer = true
+}
+
+// TransferCleared reports if the "transfer" edge to the Transfer entity was cleared.
+func (m *CooperativeExitMutation) TransferCleared() bool {
+	return m.clearedtransfer
+}
+
+// TransferID returns the "transfer" edge ID in the mutation.
+func (m *CooperativeExitMutation) TransferID() (id uuid.UUID, exists bool) {
+	if m.transfer != nil {
+		return *m.transfer, true
+	}
+	return
+}
+
+// TransferIDs returns the "transfer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransferID instead. It exists only for internal usage by the builders.
+func (m *CooperativeExitMutation) TransferIDs() (ids []uuid.UUID) {
+	if id := m.transfer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransfer resets all changes to the "transfer" edge.
+func (m *CooperativeExitMutation) ResetTransfer() {
+	m.transfer = nil
+	m.clearedtransfer = false
+}
+
+// Where appends a list predicates to the CooperativeExitMutation builder.
+func (m *CooperativeExitMutation) Where(ps ...predicate.CooperativeExit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CooperativeExitMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CooperativeExitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CooperativeExit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CooperativeExitMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CooperativeExitMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CooperativeExit).
+func (m *CooperativeExitMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CooperativeExitMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.create_time != nil {
+		fields = append(fields, cooperativeexit.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, cooperativeexit.FieldUpdateTime)
+	}
+	if m.exit_txid != nil {
+		fields = append(fields, cooperativeexit.FieldExitTxid)
+	}
+	if m.confirmation_height != nil {
+		fields = append(fields, cooperativeexit.FieldConfirmationHeight)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CooperativeExitMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cooperativeexit.FieldCreateTime:
+		return m.CreateTime()
+	case cooperativeexit.FieldUpdateTime:
+		return m.UpdateTime()
+	case cooperativeexit.FieldExitTxid:
+		return m.ExitTxid()
+	case cooperativeexit.FieldConfirmationHeight:
+		return m.ConfirmationHeight()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CooperativeExitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cooperativeexit.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case cooperativeexit.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case cooperativeexit.FieldExitTxid:
+		return m.OldExitTxid(ctx)
+	case cooperativeexit.FieldConfirmationHeight:
+		return m.OldConfirmationHeight(ctx)
+	}
+	return nil, fmt.Errorf("unknown CooperativeExit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CooperativeExitMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cooperativeexit.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case cooperativeexit.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case cooperativeexit.FieldExitTxid:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExitTxid(v)
+		return nil
+	case cooperativeexit.FieldConfirmationHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfirmationHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CooperativeExit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CooperativeExitMutation) AddedFields() []string {
+	var fields []string
+	if m.addconfirmation_height != nil {
+		fields = append(fields, cooperativeexit.FieldConfirmationHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CooperativeExitMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case cooperativeexit.FieldConfirmationHeight:
+		return m.AddedConfirmationHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CooperativeExitMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case cooperativeexit.FieldConfirmationHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConfirmationHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CooperativeExit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CooperativeExitMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(cooperativeexit.FieldConfirmationHeight) {
+		fields = append(fields, cooperativeexit.FieldConfirmationHeight)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CooperativeExitMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CooperativeExitMutation) ClearField(name string) error {
+	switch name {
+	case cooperativeexit.FieldConfirmationHeight:
+		m.ClearConfirmationHeight()
+		return nil
+	}
+	return fmt.Errorf("unknown CooperativeExit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CooperativeExitMutation) ResetField(name string) error {
+	switch name {
+	case cooperativeexit.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case cooperativeexit.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case cooperativeexit.FieldExitTxid:
+		m.ResetExitTxid()
+		return nil
+	case cooperativeexit.FieldConfirmationHeight:
+		m.ResetConfirmationHeight()
+		return nil
+	}
+	return fmt.Errorf("unknown CooperativeExit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CooperativeExitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.transfer != nil {
+		edges = append(edges, cooperativeexit.EdgeTransfer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CooperativeExitMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case cooperativeexit.EdgeTransfer:
+		if id := m.transfer; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CooperativeExitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CooperativeExitMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CooperativeExitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtransfer {
+		edges = append(edges, cooperativeexit.EdgeTransfer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CooperativeExitMutation) EdgeCleared(name string) bool {
+	switch name {
+	case cooperativeexit.EdgeTransfer:
+		return m.clearedtransfer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CooperativeExitMutation) ClearEdge(name string) error {
+	switch name {
+	case cooperativeexit.EdgeTransfer:
+		m.ClearTransfer()
+		return nil
+	}
+	return fmt.Errorf("unknown CooperativeExit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CooperativeExitMutation) ResetEdge(name string) error {
+	switch name {
+	case cooperativeexit.EdgeTransfer:
+		m.ResetTransfer()
+		return nil
+	}
+	return fmt.Errorf("unknown CooperativeExit edge %s", name)
+}
+
+// DepositAddressMutation represents an operation that mutates the DepositAddress nodes in the graph.
+type DepositAddressMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	create_time             *time.Time
+	update_time             *time.Time
+	address                 *string
+	owner_identity_pubkey   *[]byte
+	owner_signing_pubkey    *[]byte
+	confirmation_height     *int64
+	addconfirmation_height  *int64
+	confirmation_txid       *string
+	node_id                 *uuid.UUID
+	is_static               *bool
+	is_tree_address         *bool
+	expiry_time             *time.Time
+	clearedFields           map[string]struct{}
+	signing_keyshare        *uuid.UUID
+	clearedsigning_keyshare bool
+	utxo                    map[uuid.UUID]struct{}
+	removedutxo             map[uuid.UUID]struct{}
+	clearedutxo             bool
+	utxoswaps               map[uuid.UUID]struct{}
+	removedutxoswaps        map[uuid.UUID]struct{}
+	clearedutxoswaps        bool
+	done                    bool
+	oldValue                func(context.Context) (*DepositAddress, error)
+	predicates              []predicate.DepositAddress
+}
+
+var _ ent.Mutation = (*DepositAddressMutation)(nil)
+
+// depositaddressOption allows management of the mutation configuration using functional options.
+type depositaddressOption func(*DepositAddressMutation)
+
+// newDepositAddressMutation creates new mutation for the DepositAddress entity.
+func newDepositAddressMutation(c config, op Op, opts ...depositaddressOption) *DepositAddressMutation {
+	m := &DepositAddressMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDepositAddress,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDepositAddressID sets the ID field of the mutation.
+func withDepositAddressID(id uuid.UUID) depositaddressOption {
+	return func(m *DepositAddressMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DepositAddress
+		)
+		m.oldValue = func(ctx context.Context) (*DepositAddress, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DepositAddress.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDepositAddress sets the old DepositAddress of the mutation.
+func withDepositAddress(node *DepositAddress) depositaddressOption {
+	return func(m *DepositAddressMutation) {
+		m.oldValue = func(context.Context) (*DepositAddress, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DepositAddressMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DepositAddressMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DepositAddress entities.
+func (m *DepositAddressMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DepositAddressMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DepositAddressMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DepositAddress.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DepositAddressMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DepositAddressMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DepositAddressMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DepositAddressMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DepositAddressMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DepositAddressMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetAddress sets the "address" field.
+func (m *DepositAddressMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *DepositAddressMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *DepositAddressMutation) ResetAddress() {
 	m.address = nil
 }
 
-// SetOwnerIdentityPubkey sets the "owner_identity_pubkey" field.
-func (m *DepositAddressMutation) SetOwnerIdentityPubkey(b []byte) {
-	m.owner_identity_pubkey = &b
+// SetOwnerIdentityPubkey sets the "owner_identity_pubkey" field.
+func (m *DepositAddressMutation) SetOwnerIdentityPubkey(b []byte) {
+	m.owner_identity_pubkey = &b
+}
+
+// OwnerIdentityPubkey returns the value of the "owner_identity_pubkey" field in the mutation.
+func (m *DepositAddressMutation) OwnerIdentityPubkey() (r []byte, exists bool) {
+	v := m.owner_identity_pubkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerIdentityPubkey returns the old "owner_identity_pubkey" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldOwnerIdentityPubkey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerIdentityPubkey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerIdentityPubkey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerIdentityPubkey: %w", err)
+	}
+	return oldValue.OwnerIdentityPubkey, nil
+}
+
+// ResetOwnerIdentityPubkey resets all changes to the "owner_identity_pubkey" field.
+func (m *DepositAddressMutation) ResetOwnerIdentityPubkey() {
+	m.owner_identity_pubkey = nil
+}
+
+// SetOwnerSigningPubkey sets the "owner_signing_pubkey" field.
+func (m *DepositAddressMutation) SetOwnerSigningPubkey(b []byte) {
+	m.owner_signing_pubkey = &b
+}
+
+// OwnerSigningPubkey returns the value of the "owner_signing_pubkey" field in the mutation.
+func (m *DepositAddressMutation) OwnerSigningPubkey() (r []byte, exists bool) {
+	v := m.owner_signing_pubkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerSigningPubkey returns the old "owner_signing_pubkey" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldOwnerSigningPubkey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerSigningPubkey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerSigningPubkey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerSigningPubkey: %w", err)
+	}
+	return oldValue.OwnerSigningPubkey, nil
+}
+
+// ResetOwnerSigningPubkey resets all changes to the "owner_signing_pubkey" field.
+func (m *DepositAddressMutation) ResetOwnerSigningPubkey() {
+	m.owner_signing_pubkey = nil
+}
+
+// SetConfirmationHeight sets the "confirmation_height" field.
+func (m *DepositAddressMutation) SetConfirmationHeight(i int64) {
+	m.confirmation_height = &i
+	m.addconfirmation_height = nil
+}
+
+// ConfirmationHeight returns the value of the "confirmation_height" field in the mutation.
+func (m *DepositAddressMutation) ConfirmationHeight() (r int64, exists bool) {
+	v := m.confirmation_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfirmationHeight returns the old "confirmation_height" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldConfirmationHeight(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfirmationHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfirmationHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfirmationHeight: %w", err)
+	}
+	return oldValue.ConfirmationHeight, nil
+}
+
+// AddConfirmationHeight adds i to the "confirmation_height" field.
+func (m *DepositAddressMutation) AddConfirmationHeight(i int64) {
+	if m.addconfirmation_height != nil {
+		*m.addconfirmation_height += i
+	} else {
+		m.addconfirmation_height = &i
+	}
+}
+
+// AddedConfirmationHeight returns the value that was added to the "confirmation_height" field in this mutation.
+func (m *DepositAddressMutation) AddedConfirmationHeight() (r int64, exists bool) {
+	v := m.addconfirmation_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearConfirmationHeight clears the value of the "confirmation_height" field.
+func (m *DepositAddressMutation) ClearConfirmationHeight() {
+	m.confirmation_height = nil
+	m.addconfirmation_height = nil
+	m.clearedFields[depositaddress.FieldConfirmationHeight] = struct{}{}
+}
+
+// ConfirmationHeightCleared returns if the "confirmation_height" field was cleared in this mutation.
+func (m *DepositAddressMutation) ConfirmationHeightCleared() bool {
+	_, ok := m.clearedFields[depositaddress.FieldConfirmationHeight]
+	return ok
+}
+
+// ResetConfirmationHeight resets all changes to the "confirmation_height" field.
+func (m *DepositAddressMutation) ResetConfirmationHeight() {
+	m.confirmation_height = nil
+	m.addconfirmation_height = nil
+	delete(m.clearedFields, depositaddress.FieldConfirmationHeight)
+}
+
+// SetConfirmationTxid sets the "confirmation_txid" field.
+func (m *DepositAddressMutation) SetConfirmationTxid(s string) {
+	m.confirmation_txid = &s
+}
+
+// ConfirmationTxid returns the value of the "confirmation_txid" field in the mutation.
+func (m *DepositAddressMutation) ConfirmationTxid() (r string, exists bool) {
+	v := m.confirmation_txid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfirmationTxid returns the old "confirmation_txid" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldConfirmationTxid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfirmationTxid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfirmationTxid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfirmationTxid: %w", err)
+	}
+	return oldValue.ConfirmationTxid, nil
+}
+
+// ClearConfirmationTxid clears the value of the "confirmation_txid" field.
+func (m *DepositAddressMutation) ClearConfirmationTxid() {
+	m.confirmation_txid = nil
+	m.clearedFields[depositaddress.FieldConfirmationTxid] = struct{}{}
+}
+
+// ConfirmationTxidCleared returns if the "confirmation_txid" field was cleared in this mutation.
+func (m *DepositAddressMutation) ConfirmationTxidCleared() bool {
+	_, ok := m.clearedFields[depositaddress.FieldConfirmationTxid]
+	return ok
+}
+
+// ResetConfirmationTxid resets all changes to the "confirmation_txid" field.
+func (m *DepositAddressMutation) ResetConfirmationTxid() {
+	m.confirmation_txid = nil
+	delete(m.clearedFields, depositaddress.FieldConfirmationTxid)
+}
+
+// SetNodeID sets the "node_id" field.
+func (m *DepositAddressMutation) SetNodeID(u uuid.UUID) {
+	m.node_id = &u
+}
+
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *DepositAddressMutation) NodeID() (r uuid.UUID, exists bool) {
+	v := m.node_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeID returns the old "node_id" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldNodeID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+	}
+	return oldValue.NodeID, nil
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (m *DepositAddressMutation) ClearNodeID() {
+	m.node_id = nil
+	m.clearedFields[depositaddress.FieldNodeID] = struct{}{}
+}
+
+// NodeIDCleared returns if the "node_id" field was cleared in this mutation.
+func (m *DepositAddressMutation) NodeIDCleared() bool {
+	_, ok := m.clearedFields[depositaddress.FieldNodeID]
+	return ok
+}
+
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *DepositAddressMutation) ResetNodeID() {
+	m.node_id = nil
+	delete(m.clearedFields, depositaddress.FieldNodeID)
+}
+
+// SetIsStatic sets the "is_static" field.
+func (m *DepositAddressMutation) SetIsStatic(b bool) {
+	m.is_static = &b
+}
+
+// IsStatic returns the value of the "is_static" field in the mutation.
+func (m *DepositAddressMutation) IsStatic() (r bool, exists bool) {
+	v := m.is_static
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsStatic returns the old "is_static" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldIsStatic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsStatic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsStatic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsStatic: %w", err)
+	}
+	return oldValue.IsStatic, nil
+}
+
+// ResetIsStatic resets all changes to the "is_static" field.
+func (m *DepositAddressMutation) ResetIsStatic() {
+	m.is_static = nil
+}
+
+// SetIsTreeAddress sets the "is_tree_address" field.
+func (m *DepositAddressMutation) SetIsTreeAddress(b bool) {
+	m.is_tree_address = &b
+}
+
+// IsTreeAddress returns the value of the "is_tree_address" field in the mutation.
+func (m *DepositAddressMutation) IsTreeAddress() (r bool, exists bool) {
+	v := m.is_tree_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsTreeAddress returns the old "is_tree_address" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldIsTreeAddress(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsTreeAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsTreeAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsTreeAddress: %w", err)
+	}
+	return oldValue.IsTreeAddress, nil
+}
+
+// ResetIsTreeAddress resets all changes to the "is_tree_address" field.
+func (m *DepositAddressMutation) ResetIsTreeAddress() {
+	m.is_tree_address = nil
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (m *DepositAddressMutation) SetExpiryTime(t time.Time) {
+	m.expiry_time = &t
+}
+
+// ExpiryTime returns the value of the "expiry_time" field in the mutation.
+func (m *DepositAddressMutation) ExpiryTime() (r time.Time, exists bool) {
+	v := m.expiry_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryTime returns the old "expiry_time" field's value of the DepositAddress entity.
+// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepositAddressMutation) OldExpiryTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryTime: %w", err)
+	}
+	return oldValue.ExpiryTime, nil
+}
+
+// ClearExpiryTime clears the value of the "expiry_time" field.
+func (m *DepositAddressMutation) ClearExpiryTime() {
+	m.expiry_time = nil
+	m.clearedFields[depositaddress.FieldExpiryTime] = struct{}{}
+}
+
+// ExpiryTimeCleared returns if the "expiry_time" field was cleared in this mutation.
+func (m *DepositAddressMutation) ExpiryTimeCleared() bool {
+	_, ok := m.clearedFields[depositaddress.FieldExpiryTime]
+	return ok
+}
+
+// ResetExpiryTime resets all changes to the "expiry_time" field.
+func (m *DepositAddressMutation) ResetExpiryTime() {
+	m.expiry_time = nil
+	delete(m.clearedFields, depositaddress.FieldExpiryTime)
+}
+
+// SetSigningKeyshareID sets the "signing_keyshare" edge to the SigningKeyshare entity by id.
+func (m *DepositAddressMutation) SetSigningKeyshareID(id uuid.UUID) {
+	m.signing_keyshare = &id
+}
+
+// ClearSigningKeyshare clears the "signing_keyshare" edge to the SigningKeyshare entity.
+func (m *DepositAddressMutation) ClearSigningKeyshare() {
+	m.clearedsigning_keyshare = true
+}
+
+// SigningKeyshareCleared reports if the "signing_keyshare" edge to the SigningKeyshare entity was cleared.
+func (m *DepositAddressMutation) SigningKeyshareCleared() bool {
+	return m.clearedsigning_keyshare
+}
+
+// SigningKeyshareID returns the "signing_keyshare" edge ID in the mutation.
+func (m *DepositAddressMutation) SigningKeyshareID() (id uuid.UUID, exists bool) {
+	if m.signing_keyshare != nil {
+		return *m.signing_keyshare, true
+	}
+	return
+}
+
+// SigningKeyshareIDs returns the "signing_keyshare" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SigningKeyshareID instead. It exists only for internal usage by the builders.
+func (m *DepositAddressMutation) SigningKeyshareIDs() (ids []uuid.UUID) {
+	if id := m.signing_keyshare; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSigningKeyshare resets all changes to the "signing_keyshare" edge.
+func (m *DepositAddressMutation) ResetSigningKeyshare() {
+	m.signing_keyshare = nil
+	m.clearedsigning_keyshare = false
+}
+
+// AddUtxoIDs adds the "utxo" edge to the Utxo entity by ids.
+func (m *DepositAddressMutation) AddUtxoIDs(ids ...uuid.UUID) {
+	if m.utxo == nil {
+		m.utxo = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.utxo[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUtxo clears the "utxo" edge to the Utxo entity.
+func (m *DepositAddressMutation) ClearUtxo() {
+	m.clearedutxo = true
+}
+
+// UtxoCleared reports if the "utxo" edge to the Utxo entity was cleared.
+func (m *DepositAddressMutation) UtxoCleared() bool {
+	return m.clearedutxo
+}
+
+// RemoveUtxoIDs removes the "utxo" edge to the Utxo entity by IDs.
+func (m *DepositAddressMutation) RemoveUtxoIDs(ids ...uuid.UUID) {
+	if m.removedutxo == nil {
+		m.removedutxo = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.utxo, ids[i])
+		m.removedutxo[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUtxo returns the removed IDs of the "utxo" edge to the Utxo entity.
+func (m *DepositAddressMutation) RemovedUtxoIDs() (ids []uuid.UUID) {
+	for id := range m.removedutxo {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UtxoIDs returns the "utxo" edge IDs in the mutation.
+func (m *DepositAddressMutation) UtxoIDs() (ids []uuid.UUID) {
+	for id := range m.utxo {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUtxo resets all changes to the "utxo" edge.
+func (m *DepositAddressMutation) ResetUtxo() {
+	m.utxo = nil
+	m.clearedutxo = false
+	m.removedutxo = nil
+}
+
+// AddUtxoswapIDs adds the "utxoswaps" edge to the UtxoSwap entity by ids.
+func (m *DepositAddressMutation) AddUtxoswapIDs(ids ...uuid.UUID) {
+	if m.utxoswaps == nil {
+		m.utxoswaps = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.utxoswaps[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUtxoswaps clears the "utxoswaps" edge to the UtxoSwap entity.
+func (m *DepositAddressMutation) ClearUtxoswaps() {
+	m.clearedutxoswaps = true
+}
+
+// UtxoswapsCleared reports if the "utxoswaps" edge to the UtxoSwap entity was cleared.
+func (m *DepositAddressMutation) UtxoswapsCleared() bool {
+	return m.clearedutxoswaps
+}
+
+// RemoveUtxoswapIDs removes the "utxoswaps" edge to the UtxoSwap entity by IDs.
+func (m *DepositAddressMutation) RemoveUtxoswapIDs(ids ...uuid.UUID) {
+	if m.removedutxoswaps == nil {
+		m.removedutxoswaps = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.utxoswaps, ids[i])
+		m.removedutxoswaps[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUtxoswaps returns the removed IDs of the "utxoswaps" edge to the UtxoSwap entity.
+func (m *DepositAddressMutation) RemovedUtxoswapsIDs() (ids []uuid.UUID) {
+	for id := range m.removedutxoswaps {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UtxoswapsIDs returns the "utxoswaps" edge IDs in the mutation.
+func (m *DepositAddressMutation) UtxoswapsIDs() (ids []uuid.UUID) {
+	for id := range m.utxoswaps {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUtxoswaps resets all changes to the "utxoswaps" edge.
+func (m *DepositAddressMutation) ResetUtxoswaps() {
+	m.utxoswaps = nil
+	m.clearedutxoswaps = false
+	m.removedutxoswaps = nil
+}
+
+// Where appends a list predicates to the DepositAddressMutation builder.
+func (m *DepositAddressMutation) Where(ps ...predicate.DepositAddress) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DepositAddressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DepositAddressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DepositAddress, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DepositAddressMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DepositAddressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DepositAddress).
+func (m *DepositAddressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DepositAddressMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, depositaddress.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, depositaddress.FieldUpdateTime)
+	}
+	if m.address != nil {
+		fields = append(fields, depositaddress.FieldAddress)
+	}
+	if m.owner_identity_pubkey != nil {
+		fields = append(fields, depositaddress.FieldOwnerIdentityPubkey)
+	}
+	if m.owner_signing_pubkey != nil {
+		fields = append(fields, depositaddress.FieldOwnerSigningPubkey)
+	}
+	if m.confirmation_height != nil {
+		fields = append(fields, depositaddress.FieldConfirmationHeight)
+	}
+	if m.confirmation_txid != nil {
+		fields = append(fields, depositaddress.FieldConfirmationTxid)
+	}
+	if m.node_id != nil {
+		fields = append(fields, depositaddress.FieldNodeID)
+	}
+	if m.is_static != nil {
+		fields = append(fields, depositaddress.FieldIsStatic)
+	}
+	if m.is_tree_address != nil {
+		fields = append(fields, depositaddress.FieldIsTreeAddress)
+	}
+	if m.expiry_time != nil {
+		fields = append(fields, depositaddress.FieldExpiryTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DepositAddressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case depositaddress.FieldCreateTime:
+		return m.CreateTime()
+	case depositaddress.FieldUpdateTime:
+		return m.UpdateTime()
+	case depositaddress.FieldAddress:
+		return m.Address()
+	case depositaddress.FieldOwnerIdentityPubkey:
+		return m.OwnerIdentityPubkey()
+	case depositaddress.FieldOwnerSigningPubkey:
+		return m.OwnerSigningPubkey()
+	case depositaddress.FieldConfirmationHeight:
+		return m.ConfirmationHeight()
+	case depositaddress.FieldConfirmationTxid:
+		return m.ConfirmationTxid()
+	case depositaddress.FieldNodeID:
+		return m.NodeID()
+	case depositaddress.FieldIsStatic:
+		return m.IsStatic()
+	case depositaddress.FieldIsTreeAddress:
+		return m.IsTreeAddress()
+	case depositaddress.FieldExpiryTime:
+		return m.ExpiryTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DepositAddressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case depositaddress.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case depositaddress.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case depositaddress.FieldAddress:
+		return m.OldAddress(ctx)
+	case depositaddress.FieldOwnerIdentityPubkey:
+		return m.OldOwnerIdentityPubkey(ctx)
+	case depositaddress.FieldOwnerSigningPubkey:
+		return m.OldOwnerSigningPubkey(ctx)
+	case depositaddress.FieldConfirmationHeight:
+		return m.OldConfirmationHeight(ctx)
+	case depositaddress.FieldConfirmationTxid:
+		return m.OldConfirmationTxid(ctx)
+	case depositaddress.FieldNodeID:
+		return m.OldNodeID(ctx)
+	case depositaddress.FieldIsStatic:
+		return m.OldIsStatic(ctx)
+	case depositaddress.FieldIsTreeAddress:
+		return m.OldIsTreeAddress(ctx)
+	case depositaddress.FieldExpiryTime:
+		return m.OldExpiryTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown DepositAddress field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DepositAddressMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case depositaddress.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case depositaddress.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case depositaddress.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case depositaddress.FieldOwnerIdentityPubkey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerIdentityPubkey(v)
+		return nil
+	case depositaddress.FieldOwnerSigningPubkey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerSigningPubkey(v)
+		return nil
+	case depositaddress.FieldConfirmationHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfirmationHeight(v)
+		return nil
+	case depositaddress.FieldConfirmationTxid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfirmationTxid(v)
+		return nil
+	case depositaddress.FieldNodeID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeID(v)
+		return nil
+	case depositaddress.FieldIsStatic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsStatic(v)
+		return nil
+	case depositaddress.FieldIsTreeAddress:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsTreeAddress(v)
+		return nil
+	case depositaddress.FieldExpiryTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DepositAddress field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DepositAddressMutation) AddedFields() []string {
+	var fields []string
+	if m.addconfirmation_height != nil {
+		fields = append(fields, depositaddress.FieldConfirmationHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DepositAddressMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case depositaddress.FieldConfirmationHeight:
+		return m.AddedConfirmationHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DepositAddressMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case depositaddress.FieldConfirmationHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConfirmationHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DepositAddress numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DepositAddressMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(depositaddress.FieldConfirmationHeight) {
+		fields = append(fields, depositaddress.FieldConfirmationHeight)
+	}
+	if m.FieldCleared(depositaddress.FieldConfirmationTxid) {
+		fields = append(fields, depositaddress.FieldConfirmationTxid)
+	}
+	if m.FieldCleared(depositaddress.FieldNodeID) {
+		fields = append(fields, depositaddress.FieldNodeID)
+	}
+	if m.FieldCleared(depositaddress.FieldExpiryTime) {
+		fields = append(fields, depositaddress.FieldExpiryTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DepositAddressMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DepositAddressMutation) ClearField(name string) error {
+	switch name {
+	case depositaddress.FieldConfirmationHeight:
+		m.ClearConfirmationHeight()
+		return nil
+	case depositaddress.FieldConfirmationTxid:
+		m.ClearConfirmationTxid()
+		return nil
+	case depositaddress.FieldNodeID:
+		m.ClearNodeID()
+		return nil
+	case depositaddress.FieldExpiryTime:
+		m.ClearExpiryTime()
+		return nil
+	}
+	return fmt.Errorf("unknown DepositAddress nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DepositAddressMutation) ResetField(name string) error {
+	switch name {
+	case depositaddress.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case depositaddress.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case depositaddress.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case depositaddress.FieldOwnerIdentityPubkey:
+		m.ResetOwnerIdentityPubkey()
+		return nil
+	case depositaddress.FieldOwnerSigningPubkey:
+		m.ResetOwnerSigningPubkey()
+		return nil
+	case depositaddress.FieldConfirmationHeight:
+		m.ResetConfirmationHeight()
+		return nil
+	case depositaddress.FieldConfirmationTxid:
+		m.ResetConfirmationTxid()
+		return nil
+	case depositaddress.FieldNodeID:
+		m.ResetNodeID()
+		return nil
+	case depositaddress.FieldIsStatic:
+		m.ResetIsStatic()
+		return nil
+	case depositaddress.FieldIsTreeAddress:
+		m.ResetIsTreeAddress()
+		return nil
+	case depositaddress.FieldExpiryTime:
+		m.ResetExpiryTime()
+		return nil
+	}
+	return fmt.Errorf("unknown DepositAddress field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DepositAddressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.signing_keyshare != nil {
+		edges = append(edges, depositaddress.EdgeSigningKeyshare)
+	}
+	if m.utxo != nil {
+		edges = append(edges, depositaddress.EdgeUtxo)
+	}
+	if m.utxoswaps != nil {
+		edges = append(edges, depositaddress.EdgeUtxoswaps)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DepositAddressMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case depositaddress.EdgeSigningKeyshare:
+		if id := m.signing_keyshare; id != nil {
+			return []ent.Value{*id}
+		}
+	case depositaddress.EdgeUtxo:
+		ids := make([]ent.Value, 0, len(m.utxo))
+		for id := range m.utxo {
+			ids = append(ids, id)
+		}
+		return ids
+	case depositaddress.EdgeUtxoswaps:
+		ids := make([]ent.Value, 0, len(m.utxoswaps))
+		for id := range m.utxoswaps {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DepositAddressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedutxo != nil {
+		edges = append(edges, depositaddress.EdgeUtxo)
+	}
+	if m.removedutxoswaps != nil {
+		edges = append(edges, depositaddress.EdgeUtxoswaps)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DepositAddressMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case depositaddress.EdgeUtxo:
+		ids := make([]ent.Value, 0, len(m.removedutxo))
+		for id := range m.removedutxo {
+			ids = append(ids, id)
+		}
+		return ids
+	case depositaddress.EdgeUtxoswaps:
+		ids := make([]ent.Value, 0, len(m.removedutxoswaps))
+		for id := range m.removedutxoswaps {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DepositAddressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedsigning_keyshare {
+		edges = append(edges, depositaddress.EdgeSigningKeyshare)
+	}
+	if m.clearedutxo {
+		edges = append(edges, depositaddress.EdgeUtxo)
+	}
+	if m.clearedutxoswaps {
+		edges = append(edges, depositaddress.EdgeUtxoswaps)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DepositAddressMutation) EdgeCleared(name string) bool {
+	switch name {
+	case depositaddress.EdgeSigningKeyshare:
+		return m.clearedsigning_keyshare
+	case depositaddress.EdgeUtxo:
+		return m.clearedutxo
+	case depositaddress.EdgeUtxoswaps:
+		return m.clearedutxoswaps
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DepositAddressMutation) ClearEdge(name string) error {
+	switch name {
+	case depositaddress.EdgeSigningKeyshare:
+		m.ClearSigningKeyshare()
+		return nil
+	}
+	return fmt.Errorf("unknown DepositAddress unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DepositAddressMutation) ResetEdge(name string) error {
+	switch name {
+	case depositaddress.EdgeSigningKeyshare:
+		m.ResetSigningKeyshare()
+		return nil
+	case depositaddress.EdgeUtxo:
+		m.ResetUtxo()
+		return nil
+	case depositaddress.EdgeUtxoswaps:
+		m.ResetUtxoswaps()
+		return nil
+	}
+	return fmt.Errorf("unknown DepositAddress edge %s", name)
+}
+
+// DkgSessionMutation represents an operation that mutates the DkgSession nodes in the graph.
+type DkgSessionMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *uuid.UUID
+	create_time                    *time.Time
+	update_time                    *time.Time
+	status                         *schema.DkgSessionStatus
+	min_signers                    *uint64
+	addmin_signers                 *int64
+	max_signers                    *uint64
+	addmax_signers                 *int64
+	coordinator_index              *uint64
+	addcoordinator_index           *int64
+	participants                   *[]string
+	appendparticipants             []string
+	round1_package                 *[][]uint8
+	appendround1_package           [][]uint8
+	received_round1_packages       *[]map[string][]uint8
+	appendreceived_round1_packages []map[string][]uint8
+	deadline                       *time.Time
+	complaints                     *map[string]string
+	abort_reason                   *string
+	clearedFields                  map[string]struct{}
+	done                           bool
+	oldValue                       func(context.Context) (*DkgSession, error)
+	predicates                     []predicate.DkgSession
+}
+
+var _ ent.Mutation = (*DkgSessionMutation)(nil)
+
+// dkgsessionOption allows management of the mutation configuration using functional options.
+type dkgsessionOption func(*DkgSessionMutation)
+
+// newDkgSessionMutation creates new mutation for the DkgSession entity.
+func newDkgSessionMutation(c config, op Op, opts ...dkgsessionOption) *DkgSessionMutation {
+	m := &DkgSessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDkgSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDkgSessionID sets the ID field of the mutation.
+func withDkgSessionID(id uuid.UUID) dkgsessionOption {
+	return func(m *DkgSessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DkgSession
+		)
+		m.oldValue = func(ctx context.Context) (*DkgSession, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DkgSession.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDkgSession sets the old DkgSession of the mutation.
+func withDkgSession(node *DkgSession) dkgsessionOption {
+	return func(m *DkgSessionMutation) {
+		m.oldValue = func(context.Context) (*DkgSession, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DkgSessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DkgSessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DkgSession entities.
+func (m *DkgSessionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DkgSessionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DkgSessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DkgSession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DkgSessionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DkgSessionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DkgSessionMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DkgSessionMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DkgSessionMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DkgSessionMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *DkgSessionMutation) SetStatus(sss schema.DkgSessionStatus) {
+	m.status = &sss
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DkgSessionMutation) Status() (r schema.DkgSessionStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldStatus(ctx context.Context) (v schema.DkgSessionStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DkgSessionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMinSigners sets the "min_signers" field.
+func (m *DkgSessionMutation) SetMinSigners(u uint64) {
+	m.min_signers = &u
+	m.addmin_signers = nil
+}
+
+// MinSigners returns the value of the "min_signers" field in the mutation.
+func (m *DkgSessionMutation) MinSigners() (r uint64, exists bool) {
+	v := m.min_signers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinSigners returns the old "min_signers" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldMinSigners(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinSigners is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinSigners requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinSigners: %w", err)
+	}
+	return oldValue.MinSigners, nil
+}
+
+// AddMinSigners adds u to the "min_signers" field.
+func (m *DkgSessionMutation) AddMinSigners(u int64) {
+	if m.addmin_signers != nil {
+		*m.addmin_signers += u
+	} else {
+		m.addmin_signers = &u
+	}
+}
+
+// AddedMinSigners returns the value that was added to the "min_signers" field in this mutation.
+func (m *DkgSessionMutation) AddedMinSigners() (r int64, exists bool) {
+	v := m.addmin_signers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinSigners resets all changes to the "min_signers" field.
+func (m *DkgSessionMutation) ResetMinSigners() {
+	m.min_signers = nil
+	m.addmin_signers = nil
+}
+
+// SetMaxSigners sets the "max_signers" field.
+func (m *DkgSessionMutation) SetMaxSigners(u uint64) {
+	m.max_signers = &u
+	m.addmax_signers = nil
+}
+
+// MaxSigners returns the value of the "max_signers" field in the mutation.
+func (m *DkgSessionMutation) MaxSigners() (r uint64, exists bool) {
+	v := m.max_signers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxSigners returns the old "max_signers" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldMaxSigners(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxSigners is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxSigners requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxSigners: %w", err)
+	}
+	return oldValue.MaxSigners, nil
+}
+
+// AddMaxSigners adds u to the "max_signers" field.
+func (m *DkgSessionMutation) AddMaxSigners(u int64) {
+	if m.addmax_signers != nil {
+		*m.addmax_signers += u
+	} else {
+		m.addmax_signers = &u
+	}
+}
+
+// AddedMaxSigners returns the value that was added to the "max_signers" field in this mutation.
+func (m *DkgSessionMutation) AddedMaxSigners() (r int64, exists bool) {
+	v := m.addmax_signers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxSigners resets all changes to the "max_signers" field.
+func (m *DkgSessionMutation) ResetMaxSigners() {
+	m.max_signers = nil
+	m.addmax_signers = nil
+}
+
+// SetCoordinatorIndex sets the "coordinator_index" field.
+func (m *DkgSessionMutation) SetCoordinatorIndex(u uint64) {
+	m.coordinator_index = &u
+	m.addcoordinator_index = nil
+}
+
+// CoordinatorIndex returns the value of the "coordinator_index" field in the mutation.
+func (m *DkgSessionMutation) CoordinatorIndex() (r uint64, exists bool) {
+	v := m.coordinator_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoordinatorIndex returns the old "coordinator_index" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldCoordinatorIndex(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoordinatorIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoordinatorIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoordinatorIndex: %w", err)
+	}
+	return oldValue.CoordinatorIndex, nil
+}
+
+// AddCoordinatorIndex adds u to the "coordinator_index" field.
+func (m *DkgSessionMutation) AddCoordinatorIndex(u int64) {
+	if m.addcoordinator_index != nil {
+		*m.addcoordinator_index += u
+	} else {
+		m.addcoordinator_index = &u
+	}
+}
+
+// AddedCoordinatorIndex returns the value that was added to the "coordinator_index" field in this mutation.
+func (m *DkgSessionMutation) AddedCoordinatorIndex() (r int64, exists bool) {
+	v := m.addcoordinator_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCoordinatorIndex resets all changes to the "coordinator_index" field.
+func (m *DkgSessionMutation) ResetCoordinatorIndex() {
+	m.coordinator_index = nil
+	m.addcoordinator_index = nil
+}
+
+// SetParticipants sets the "participants" field.
+func (m *DkgSessionMutation) SetParticipants(s []string) {
+	m.participants = &s
+	m.appendparticipants = nil
+}
+
+// Participants returns the value of the "participants" field in the mutation.
+func (m *DkgSessionMutation) Participants() (r []string, exists bool) {
+	v := m.participants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParticipants returns the old "participants" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldParticipants(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParticipants is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParticipants requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParticipants: %w", err)
+	}
+	return oldValue.Participants, nil
+}
+
+// AppendParticipants adds s to the "participants" field.
+func (m *DkgSessionMutation) AppendParticipants(s []string) {
+	m.appendparticipants = append(m.appendparticipants, s...)
+}
+
+// AppendedParticipants returns the list of values that were appended to the "participants" field in this mutation.
+func (m *DkgSessionMutation) AppendedParticipants() ([]string, bool) {
+	if len(m.appendparticipants) == 0 {
+		return nil, false
+	}
+	return m.appendparticipants, true
+}
+
+// ResetParticipants resets all changes to the "participants" field.
+func (m *DkgSessionMutation) ResetParticipants() {
+	m.participants = nil
+	m.appendparticipants = nil
+}
+
+// SetRound1Package sets the "round1_package" field.
+func (m *DkgSessionMutation) SetRound1Package(u [][]uint8) {
+	m.round1_package = &u
+	m.appendround1_package = nil
+}
+
+// Round1Package returns the value of the "round1_package" field in the mutation.
+func (m *DkgSessionMutation) Round1Package() (r [][]uint8, exists bool) {
+	v := m.round1_package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRound1Package returns the old "round1_package" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldRound1Package(ctx context.Context) (v [][]uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRound1Package is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRound1Package requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRound1Package: %w", err)
+	}
+	return oldValue.Round1Package, nil
+}
+
+// AppendRound1Package adds u to the "round1_package" field.
+func (m *DkgSessionMutation) AppendRound1Package(u [][]uint8) {
+	m.appendround1_package = append(m.appendround1_package, u...)
+}
+
+// AppendedRound1Package returns the list of values that were appended to the "round1_package" field in this mutation.
+func (m *DkgSessionMutation) AppendedRound1Package() ([][]uint8, bool) {
+	if len(m.appendround1_package) == 0 {
+		return nil, false
+	}
+	return m.appendround1_package, true
+}
+
+// ClearRound1Package clears the value of the "round1_package" field.
+func (m *DkgSessionMutation) ClearRound1Package() {
+	m.round1_package = nil
+	m.appendround1_package = nil
+	m.clearedFields[dkgsession.FieldRound1Package] = struct{}{}
+}
+
+// Round1PackageCleared returns if the "round1_package" field was cleared in this mutation.
+func (m *DkgSessionMutation) Round1PackageCleared() bool {
+	_, ok := m.clearedFields[dkgsession.FieldRound1Package]
+	return ok
+}
+
+// ResetRound1Package resets all changes to the "round1_package" field.
+func (m *DkgSessionMutation) ResetRound1Package() {
+	m.round1_package = nil
+	m.appendround1_package = nil
+	delete(m.clearedFields, dkgsession.FieldRound1Package)
+}
+
+// SetReceivedRound1Packages sets the "received_round1_packages" field.
+func (m *DkgSessionMutation) SetReceivedRound1Packages(value []map[string][]uint8) {
+	m.received_round1_packages = &value
+	m.appendreceived_round1_packages = nil
+}
+
+// ReceivedRound1Packages returns the value of the "received_round1_packages" field in the mutation.
+func (m *DkgSessionMutation) ReceivedRound1Packages() (r []map[string][]uint8, exists bool) {
+	v := m.received_round1_packages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceivedRound1Packages returns the old "received_round1_packages" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldReceivedRound1Packages(ctx context.Context) (v []map[string][]uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceivedRound1Packages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceivedRound1Packages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceivedRound1Packages: %w", err)
+	}
+	return oldValue.ReceivedRound1Packages, nil
+}
+
+// AppendReceivedRound1Packages adds value to the "received_round1_packages" field.
+func (m *DkgSessionMutation) AppendReceivedRound1Packages(value []map[string][]uint8) {
+	m.appendreceived_round1_packages = append(m.appendreceived_round1_packages, value...)
+}
+
+// AppendedReceivedRound1Packages returns the list of values that were appended to the "received_round1_packages" field in this mutation.
+func (m *DkgSessionMutation) AppendedReceivedRound1Packages() ([]map[string][]uint8, bool) {
+	if len(m.appendreceived_round1_packages) == 0 {
+		return nil, false
+	}
+	return m.appendreceived_round1_packages, true
+}
+
+// ClearReceivedRound1Packages clears the value of the "received_round1_packages" field.
+func (m *DkgSessionMutation) ClearReceivedRound1Packages() {
+	m.received_round1_packages = nil
+	m.appendreceived_round1_packages = nil
+	m.clearedFields[dkgsession.FieldReceivedRound1Packages] = struct{}{}
+}
+
+// ReceivedRound1PackagesCleared returns if the "received_round1_packages" field was cleared in this mutation.
+func (m *DkgSessionMutation) ReceivedRound1PackagesCleared() bool {
+	_, ok := m.clearedFields[dkgsession.FieldReceivedRound1Packages]
+	return ok
+}
+
+// ResetReceivedRound1Packages resets all changes to the "received_round1_packages" field.
+func (m *DkgSessionMutation) ResetReceivedRound1Packages() {
+	m.received_round1_packages = nil
+	m.appendreceived_round1_packages = nil
+	delete(m.clearedFields, dkgsession.FieldReceivedRound1Packages)
+}
+
+// SetDeadline sets the "deadline" field.
+func (m *DkgSessionMutation) SetDeadline(t time.Time) {
+	m.deadline = &t
+}
+
+// Deadline returns the value of the "deadline" field in the mutation.
+func (m *DkgSessionMutation) Deadline() (r time.Time, exists bool) {
+	v := m.deadline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadline returns the old "deadline" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldDeadline(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadline: %w", err)
+	}
+	return oldValue.Deadline, nil
+}
+
+// ResetDeadline resets all changes to the "deadline" field.
+func (m *DkgSessionMutation) ResetDeadline() {
+	m.deadline = nil
+}
+
+// SetComplaints sets the "complaints" field.
+func (m *DkgSessionMutation) SetComplaints(value map[string]string) {
+	m.complaints = &value
+}
+
+// Complaints returns the value of the "complaints" field in the mutation.
+func (m *DkgSessionMutation) Complaints() (r map[string]string, exists bool) {
+	v := m.complaints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComplaints returns the old "complaints" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldComplaints(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComplaints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComplaints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComplaints: %w", err)
+	}
+	return oldValue.Complaints, nil
+}
+
+// ClearComplaints clears the value of the "complaints" field.
+func (m *DkgSessionMutation) ClearComplaints() {
+	m.complaints = nil
+	m.clearedFields[dkgsession.FieldComplaints] = struct{}{}
+}
+
+// ComplaintsCleared returns if the "complaints" field was cleared in this mutation.
+func (m *DkgSessionMutation) ComplaintsCleared() bool {
+	_, ok := m.clearedFields[dkgsession.FieldComplaints]
+	return ok
+}
+
+// ResetComplaints resets all changes to the "complaints" field.
+func (m *DkgSessionMutation) ResetComplaints() {
+	m.complaints = nil
+	delete(m.clearedFields, dkgsession.FieldComplaints)
+}
+
+// SetAbortReason sets the "abort_reason" field.
+func (m *DkgSessionMutation) SetAbortReason(s string) {
+	m.abort_reason = &s
+}
+
+// AbortReason returns the value of the "abort_reason" field in the mutation.
+func (m *DkgSessionMutation) AbortReason() (r string, exists bool) {
+	v := m.abort_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAbortReason returns the old "abort_reason" field's value of the DkgSession entity.
+// If the DkgSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DkgSessionMutation) OldAbortReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAbortReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAbortReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbortReason: %w", err)
+	}
+	return oldValue.AbortReason, nil
+}
+
+// ClearAbortReason clears the value of the "abort_reason" field.
+func (m *DkgSessionMutation) ClearAbortReason() {
+	m.abort_reason = nil
+	m.clearedFields[dkgsession.FieldAbortReason] = struct{}{}
+}
+
+// AbortReasonCleared returns if the "abort_reason" field was cleared in this mutation.
+func (m *DkgSessionMutation) AbortReasonCleared() bool {
+	_, ok := m.clearedFields[dkgsession.FieldAbortReason]
+	return ok
+}
+
+// ResetAbortReason resets all changes to the "abort_reason" field.
+func (m *DkgSessionMutation) ResetAbortReason() {
+	m.abort_reason = nil
+	delete(m.clearedFields, dkgsession.FieldAbortReason)
+}
+
+// Where appends a list predicates to the DkgSessionMutation builder.
+func (m *DkgSessionMutation) Where(ps ...predicate.DkgSession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DkgSessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DkgSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DkgSession, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DkgSessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DkgSessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DkgSession).
+func (m *DkgSessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DkgSessionMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.create_time != nil {
+		fields = append(fields, dkgsession.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, dkgsession.FieldUpdateTime)
+	}
+	if m.status != nil {
+		fields = append(fields, dkgsession.FieldStatus)
+	}
+	if m.min_signers != nil {
+		fields = append(fields, dkgsession.FieldMinSigners)
+	}
+	if m.max_signers != nil {
+		fields = append(fields, dkgsession.FieldMaxSigners)
+	}
+	if m.coordinator_index != nil {
+		fields = append(fields, dkgsession.FieldCoordinatorIndex)
+	}
+	if m.participants != nil {
+		fields = append(fields, dkgsession.FieldParticipants)
+	}
+	if m.round1_package != nil {
+		fields = append(fields, dkgsession.FieldRound1Package)
+	}
+	if m.received_round1_packages != nil {
+		fields = append(fields, dkgsession.FieldReceivedRound1Packages)
+	}
+	if m.deadline != nil {
+		fields = append(fields, dkgsession.FieldDeadline)
+	}
+	if m.complaints != nil {
+		fields = append(fields, dkgsession.FieldComplaints)
+	}
+	if m.abort_reason != nil {
+		fields = append(fields, dkgsession.FieldAbortReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DkgSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case dkgsession.FieldCreateTime:
+		return m.CreateTime()
+	case dkgsession.FieldUpdateTime:
+		return m.UpdateTime()
+	case dkgsession.FieldStatus:
+		return m.Status()
+	case dkgsession.FieldMinSigners:
+		return m.MinSigners()
+	case dkgsession.FieldMaxSigners:
+		return m.MaxSigners()
+	case dkgsession.FieldCoordinatorIndex:
+		return m.CoordinatorIndex()
+	case dkgsession.FieldParticipants:
+		return m.Participants()
+	case dkgsession.FieldRound1Package:
+		return m.Round1Package()
+	case dkgsession.FieldReceivedRound1Packages:
+		return m.ReceivedRound1Packages()
+	case dkgsession.FieldDeadline:
+		return m.Deadline()
+	case dkgsession.FieldComplaints:
+		return m.Complaints()
+	case dkgsession.FieldAbortReason:
+		return m.AbortReason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DkgSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case dkgsession.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case dkgsession.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case dkgsession.FieldStatus:
+		return m.OldStatus(ctx)
+	case dkgsession.FieldMinSigners:
+		return m.OldMinSigners(ctx)
+	case dkgsession.FieldMaxSigners:
+		return m.OldMaxSigners(ctx)
+	case dkgsession.FieldCoordinatorIndex:
+		return m.OldCoordinatorIndex(ctx)
+	case dkgsession.FieldParticipants:
+		return m.OldParticipants(ctx)
+	case dkgsession.FieldRound1Package:
+		return m.OldRound1Package(ctx)
+	case dkgsession.FieldReceivedRound1Packages:
+		return m.OldReceivedRound1Packages(ctx)
+	case dkgsession.FieldDeadline:
+		return m.OldDeadline(ctx)
+	case dkgsession.FieldComplaints:
+		return m.OldComplaints(ctx)
+	case dkgsession.FieldAbortReason:
+		return m.OldAbortReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown DkgSession field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DkgSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case dkgsession.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case dkgsession.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case dkgsession.FieldStatus:
+		v, ok := value.(schema.DkgSessionStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case dkgsession.FieldMinSigners:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinSigners(v)
+		return nil
+	case dkgsession.FieldMaxSigners:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxSigners(v)
+		return nil
+	case dkgsession.FieldCoordinatorIndex:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoordinatorIndex(v)
+		return nil
+	case dkgsession.FieldParticipants:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParticipants(v)
+		return nil
+	case dkgsession.FieldRound1Package:
+		v, ok := value.([][]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRound1Package(v)
+		return nil
+	case dkgsession.FieldReceivedRound1Packages:
+		v, ok := value.([]map[string][]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceivedRound1Packages(v)
+		return nil
+	case dkgsession.FieldDeadline:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadline(v)
+		return nil
+	case dkgsession.FieldComplaints:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComplaints(v)
+		return nil
+	case dkgsession.FieldAbortReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAbortReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DkgSession field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DkgSessionMutation) AddedFields() []string {
+	var fields []string
+	if m.addmin_signers != nil {
+		fields = append(fields, dkgsession.FieldMinSigners)
+	}
+	if m.addmax_signers != nil {
+		fields = append(fields, dkgsession.FieldMaxSigners)
+	}
+	if m.addcoordinator_index != nil {
+		fields = append(fields, dkgsession.FieldCoordinatorIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DkgSessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case dkgsession.FieldMinSigners:
+		return m.AddedMinSigners()
+	case dkgsession.FieldMaxSigners:
+		return m.AddedMaxSigners()
+	case dkgsession.FieldCoordinatorIndex:
+		return m.AddedCoordinatorIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DkgSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case dkgsession.FieldMinSigners:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinSigners(v)
+		return nil
+	case dkgsession.FieldMaxSigners:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxSigners(v)
+		return nil
+	case dkgsession.FieldCoordinatorIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCoordinatorIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DkgSession numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DkgSessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(dkgsession.FieldRound1Package) {
+		fields = append(fields, dkgsession.FieldRound1Package)
+	}
+	if m.FieldCleared(dkgsession.FieldReceivedRound1Packages) {
+		fields = append(fields, dkgsession.FieldReceivedRound1Packages)
+	}
+	if m.FieldCleared(dkgsession.FieldComplaints) {
+		fields = append(fields, dkgsession.FieldComplaints)
+	}
+	if m.FieldCleared(dkgsession.FieldAbortReason) {
+		fields = append(fields, dkgsession.FieldAbortReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DkgSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DkgSessionMutation) ClearField(name string) error {
+	switch name {
+	case dkgsession.FieldRound1Package:
+		m.ClearRound1Package()
+		return nil
+	case dkgsession.FieldReceivedRound1Packages:
+		m.ClearReceivedRound1Packages()
+		return nil
+	case dkgsession.FieldComplaints:
+		m.ClearComplaints()
+		return nil
+	case dkgsession.FieldAbortReason:
+		m.ClearAbortReason()
+		return nil
+	}
+	return fmt.Errorf("unknown DkgSession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DkgSessionMutation) ResetField(name string) error {
+	switch name {
+	case dkgsession.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case dkgsession.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case dkgsession.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case dkgsession.FieldMinSigners:
+		m.ResetMinSigners()
+		return nil
+	case dkgsession.FieldMaxSigners:
+		m.ResetMaxSigners()
+		return nil
+	case dkgsession.FieldCoordinatorIndex:
+		m.ResetCoordinatorIndex()
+		return nil
+	case dkgsession.FieldParticipants:
+		m.ResetParticipants()
+		return nil
+	case dkgsession.FieldRound1Package:
+		m.ResetRound1Package()
+		return nil
+	case dkgsession.FieldReceivedRound1Packages:
+		m.ResetReceivedRound1Packages()
+		return nil
+	case dkgsession.FieldDeadline:
+		m.ResetDeadline()
+		return nil
+	case dkgsession.FieldComplaints:
+		m.ResetComplaints()
+		return nil
+	case dkgsession.FieldAbortReason:
+		m.ResetAbortReason()
+		return nil
+	}
+	return fmt.Errorf("unknown DkgSession field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DkgSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DkgSessionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DkgSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DkgSessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DkgSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DkgSessionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DkgSessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DkgSession unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DkgSessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DkgSession edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	method        *string
+	scope         *string
+	key           *string
+	request_hash  *[]byte
+	response_type *string
+	response      *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IdempotencyKey, error)
+	predicates    []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id uuid.UUID) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyKey entities.
+func (m *IdempotencyKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *IdempotencyKeyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *IdempotencyKeyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *IdempotencyKeyMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *IdempotencyKeyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *IdempotencyKeyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *IdempotencyKeyMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetMethod sets the "method" field.
+func (m *IdempotencyKeyMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *IdempotencyKeyMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *IdempotencyKeyMutation) ResetMethod() {
+	m.method = nil
+}
+
+// SetScope sets the "scope" field.
+func (m *IdempotencyKeyMutation) SetScope(s string) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *IdempotencyKeyMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *IdempotencyKeyMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetKey sets the "key" field.
+func (m *IdempotencyKeyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *IdempotencyKeyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *IdempotencyKeyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (m *IdempotencyKeyMutation) SetRequestHash(b []byte) {
+	m.request_hash = &b
+}
+
+// RequestHash returns the value of the "request_hash" field in the mutation.
+func (m *IdempotencyKeyMutation) RequestHash() (r []byte, exists bool) {
+	v := m.request_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestHash returns the old "request_hash" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldRequestHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestHash: %w", err)
+	}
+	return oldValue.RequestHash, nil
+}
+
+// ResetRequestHash resets all changes to the "request_hash" field.
+func (m *IdempotencyKeyMutation) ResetRequestHash() {
+	m.request_hash = nil
+}
+
+// SetResponseType sets the "response_type" field.
+func (m *IdempotencyKeyMutation) SetResponseType(s string) {
+	m.response_type = &s
+}
+
+// ResponseType returns the value of the "response_type" field in the mutation.
+func (m *IdempotencyKeyMutation) ResponseType() (r string, exists bool) {
+	v := m.response_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseType returns the old "response_type" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResponseType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseType: %w", err)
+	}
+	return oldValue.ResponseType, nil
+}
+
+// ClearResponseType clears the value of the "response_type" field.
+func (m *IdempotencyKeyMutation) ClearResponseType() {
+	m.response_type = nil
+	m.clearedFields[idempotencykey.FieldResponseType] = struct{}{}
+}
+
+// ResponseTypeCleared returns if the "response_type" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) ResponseTypeCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldResponseType]
+	return ok
+}
+
+// ResetResponseType resets all changes to the "response_type" field.
+func (m *IdempotencyKeyMutation) ResetResponseType() {
+	m.response_type = nil
+	delete(m.clearedFields, idempotencykey.FieldResponseType)
+}
+
+// SetResponse sets the "response" field.
+func (m *IdempotencyKeyMutation) SetResponse(b []byte) {
+	m.response = &b
+}
+
+// Response returns the value of the "response" field in the mutation.
+func (m *IdempotencyKeyMutation) Response() (r []byte, exists bool) {
+	v := m.response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponse returns the old "response" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResponse(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+	}
+	return oldValue.Response, nil
+}
+
+// ClearResponse clears the value of the "response" field.
+func (m *IdempotencyKeyMutation) ClearResponse() {
+	m.response = nil
+	m.clearedFields[idempotencykey.FieldResponse] = struct{}{}
+}
+
+// ResponseCleared returns if the "response" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) ResponseCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldResponse]
+	return ok
+}
+
+// ResetResponse resets all changes to the "response" field.
+func (m *IdempotencyKeyMutation) ResetResponse() {
+	m.response = nil
+	delete(m.clearedFields, idempotencykey.FieldResponse)
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, idempotencykey.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, idempotencykey.FieldUpdateTime)
+	}
+	if m.method != nil {
+		fields = append(fields, idempotencykey.FieldMethod)
+	}
+	if m.scope != nil {
+		fields = append(fields, idempotencykey.FieldScope)
+	}
+	if m.key != nil {
+		fields = append(fields, idempotencykey.FieldKey)
+	}
+	if m.request_hash != nil {
+		fields = append(fields, idempotencykey.FieldRequestHash)
+	}
+	if m.response_type != nil {
+		fields = append(fields, idempotencykey.FieldResponseType)
+	}
+	if m.response != nil {
+		fields = append(fields, idempotencykey.FieldResponse)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldCreateTime:
+		return m.CreateTime()
+	case idempotencykey.FieldUpdateTime:
+		return m.UpdateTime()
+	case idempotencykey.FieldMethod:
+		return m.Method()
+	case idempotencykey.FieldScope:
+		return m.Scope()
+	case idempotencykey.FieldKey:
+		return m.Key()
+	case idempotencykey.FieldRequestHash:
+		return m.RequestHash()
+	case idempotencykey.FieldResponseType:
+		return m.ResponseType()
+	case idempotencykey.FieldResponse:
+		return m.Response()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case idempotencykey.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case idempotencykey.FieldMethod:
+		return m.OldMethod(ctx)
+	case idempotencykey.FieldScope:
+		return m.OldScope(ctx)
+	case idempotencykey.FieldKey:
+		return m.OldKey(ctx)
+	case idempotencykey.FieldRequestHash:
+		return m.OldRequestHash(ctx)
+	case idempotencykey.FieldResponseType:
+		return m.OldResponseType(ctx)
+	case idempotencykey.FieldResponse:
+		return m.OldResponse(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case idempotencykey.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case idempotencykey.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case idempotencykey.FieldScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case idempotencykey.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case idempotencykey.FieldRequestHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHash(v)
+		return nil
+	case idempotencykey.FieldResponseType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseType(v)
+		return nil
+	case idempotencykey.FieldResponse:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponse(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotencykey.FieldResponseType) {
+		fields = append(fields, idempotencykey.FieldResponseType)
+	}
+	if m.FieldCleared(idempotencykey.FieldResponse) {
+		fields = append(fields, idempotencykey.FieldResponse)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	switch name {
+	case idempotencykey.FieldResponseType:
+		m.ClearResponseType()
+		return nil
+	case idempotencykey.FieldResponse:
+		m.ClearResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case idempotencykey.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case idempotencykey.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case idempotencykey.FieldScope:
+		m.ResetScope()
+		return nil
+	case idempotencykey.FieldKey:
+		m.ResetKey()
+		return nil
+	case idempotencykey.FieldRequestHash:
+		m.ResetRequestHash()
+		return nil
+	case idempotencykey.FieldResponseType:
+		m.ResetResponseType()
+		return nil
+	case idempotencykey.FieldResponse:
+		m.ResetResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// NetworkPauseMutation represents an operation that mutates the NetworkPause nodes in the graph.
+type NetworkPauseMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	create_time      *time.Time
+	update_time      *time.Time
+	network          *schema.Network
+	transfers_paused *bool
+	deposits_paused  *bool
+	reason           *string
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*NetworkPause, error)
+	predicates       []predicate.NetworkPause
+}
+
+var _ ent.Mutation = (*NetworkPauseMutation)(nil)
+
+// networkpauseOption allows management of the mutation configuration using functional options.
+type networkpauseOption func(*NetworkPauseMutation)
+
+// newNetworkPauseMutation creates new mutation for the NetworkPause entity.
+func newNetworkPauseMutation(c config, op Op, opts ...networkpauseOption) *NetworkPauseMutation {
+	m := &NetworkPauseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNetworkPause,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNetworkPauseID sets the ID field of the mutation.
+func withNetworkPauseID(id uuid.UUID) networkpauseOption {
+	return func(m *NetworkPauseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NetworkPause
+		)
+		m.oldValue = func(ctx context.Context) (*NetworkPause, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NetworkPause.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNetworkPause sets the old NetworkPause of the mutation.
+func withNetworkPause(node *NetworkPause) networkpauseOption {
+	return func(m *NetworkPauseMutation) {
+		m.oldValue = func(context.Context) (*NetworkPause, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NetworkPauseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NetworkPauseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NetworkPause entities.
+func (m *NetworkPauseMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NetworkPauseMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NetworkPauseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NetworkPause.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *NetworkPauseMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *NetworkPauseMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the NetworkPause entity.
+// If the NetworkPause object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkPauseMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *NetworkPauseMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *NetworkPauseMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *NetworkPauseMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the NetworkPause entity.
+// If the NetworkPause object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkPauseMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *NetworkPauseMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetNetwork sets the "network" field.
+func (m *NetworkPauseMutation) SetNetwork(s schema.Network) {
+	m.network = &s
+}
+
+// Network returns the value of the "network" field in the mutation.
+func (m *NetworkPauseMutation) Network() (r schema.Network, exists bool) {
+	v := m.network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetwork returns the old "network" field's value of the NetworkPause entity.
+// If the NetworkPause object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkPauseMutation) OldNetwork(ctx context.Context) (v schema.Network, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
+	}
+	return oldValue.Network, nil
+}
+
+// ResetNetwork resets all changes to the "network" field.
+func (m *NetworkPauseMutation) ResetNetwork() {
+	m.network = nil
+}
+
+// SetTransfersPaused sets the "transfers_paused" field.
+func (m *NetworkPauseMutation) SetTransfersPaused(b bool) {
+	m.transfers_paused = &b
+}
+
+// TransfersPaused returns the value of the "transfers_paused" field in the mutation.
+func (m *NetworkPauseMutation) TransfersPaused() (r bool, exists bool) {
+	v := m.transfers_paused
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransfersPaused returns the old "transfers_paused" field's value of the NetworkPause entity.
+// If the NetworkPause object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkPauseMutation) OldTransfersPaused(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransfersPaused is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransfersPaused requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransfersPaused: %w", err)
+	}
+	return oldValue.TransfersPaused, nil
+}
+
+// ResetTransfersPaused resets all changes to the "transfers_paused" field.
+func (m *NetworkPauseMutation) ResetTransfersPaused() {
+	m.transfers_paused = nil
+}
+
+// SetDepositsPaused sets the "deposits_paused" field.
+func (m *NetworkPauseMutation) SetDepositsPaused(b bool) {
+	m.deposits_paused = &b
+}
+
+// DepositsPaused returns the value of the "deposits_paused" field in the mutation.
+func (m *NetworkPauseMutation) DepositsPaused() (r bool, exists bool) {
+	v := m.deposits_paused
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDepositsPaused returns the old "deposits_paused" field's value of the NetworkPause entity.
+// If the NetworkPause object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkPauseMutation) OldDepositsPaused(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepositsPaused is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepositsPaused requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepositsPaused: %w", err)
+	}
+	return oldValue.DepositsPaused, nil
+}
+
+// ResetDepositsPaused resets all changes to the "deposits_paused" field.
+func (m *NetworkPauseMutation) ResetDepositsPaused() {
+	m.deposits_paused = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *NetworkPauseMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *NetworkPauseMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the NetworkPause entity.
+// If the NetworkPause object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkPauseMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *NetworkPauseMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[networkpause.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *NetworkPauseMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[networkpause.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *NetworkPauseMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, networkpause.FieldReason)
+}
+
+// Where appends a list predicates to the NetworkPauseMutation builder.
+func (m *NetworkPauseMutation) Where(ps ...predicate.NetworkPause) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NetworkPauseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NetworkPauseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NetworkPause, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NetworkPauseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NetworkPauseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NetworkPause).
+func (m *NetworkPauseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NetworkPauseMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, networkpause.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, networkpause.FieldUpdateTime)
+	}
+	if m.network != nil {
+		fields = append(fields, networkpause.FieldNetwork)
+	}
+	if m.transfers_paused != nil {
+		fields = append(fields, networkpause.FieldTransfersPaused)
+	}
+	if m.deposits_paused != nil {
+		fields = append(fields, networkpause.FieldDepositsPaused)
+	}
+	if m.reason != nil {
+		fields = append(fields, networkpause.FieldReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NetworkPauseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case networkpause.FieldCreateTime:
+		return m.CreateTime()
+	case networkpause.FieldUpdateTime:
+		return m.UpdateTime()
+	case networkpause.FieldNetwork:
+		return m.Network()
+	case networkpause.FieldTransfersPaused:
+		return m.TransfersPaused()
+	case networkpause.FieldDepositsPaused:
+		return m.DepositsPaused()
+	case networkpause.FieldReason:
+		return m.Reason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NetworkPauseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case networkpause.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case networkpause.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case networkpause.FieldNetwork:
+		return m.OldNetwork(ctx)
+	case networkpause.FieldTransfersPaused:
+		return m.OldTransfersPaused(ctx)
+	case networkpause.FieldDepositsPaused:
+		return m.OldDepositsPaused(ctx)
+	case networkpause.FieldReason:
+		return m.OldReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown NetworkPause field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NetworkPauseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case networkpause.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case networkpause.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case networkpause.FieldNetwork:
+		v, ok := value.(schema.Network)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetwork(v)
+		return nil
+	case networkpause.FieldTransfersPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransfersPaused(v)
+		return nil
+	case networkpause.FieldDepositsPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepositsPaused(v)
+		return nil
+	case networkpause.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NetworkPause field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NetworkPauseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NetworkPauseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NetworkPauseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NetworkPause numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NetworkPauseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(networkpause.FieldReason) {
+		fields = append(fields, networkpause.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NetworkPauseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NetworkPauseMutation) ClearField(name string) error {
+	switch name {
+	case networkpause.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown NetworkPause nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NetworkPauseMutation) ResetField(name string) error {
+	switch name {
+	case networkpause.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case networkpause.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case networkpause.FieldNetwork:
+		m.ResetNetwork()
+		return nil
+	case networkpause.FieldTransfersPaused:
+		m.ResetTransfersPaused()
+		return nil
+	case networkpause.FieldDepositsPaused:
+		m.ResetDepositsPaused()
+		return nil
+	case networkpause.FieldReason:
+		m.ResetReason()
+		return nil
+	}
+	return fmt.Errorf("unknown NetworkPause field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NetworkPauseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NetworkPauseMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NetworkPauseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NetworkPauseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NetworkPauseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NetworkPauseMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NetworkPauseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NetworkPause unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NetworkPauseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NetworkPause edge %s", name)
+}
+
+// OperatorCallNonceMutation represents an operation that mutates the OperatorCallNonce nodes in the graph.
+type OperatorCallNonceMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	create_time                  *time.Time
+	update_time                  *time.Time
+	operator_identity_public_key *[]byte
+	nonce                        *[]byte
+	expiration_time              *time.Time
+	clearedFields                map[string]struct{}
+	done                         bool
+	oldValue                     func(context.Context) (*OperatorCallNonce, error)
+	predicates                   []predicate.OperatorCallNonce
+}
+
+var _ ent.Mutation = (*OperatorCallNonceMutation)(nil)
+
+// operatorcallnonceOption allows management of the mutation configuration using functional options.
+type operatorcallnonceOption func(*OperatorCallNonceMutation)
+
+// newOperatorCallNonceMutation creates new mutation for the OperatorCallNonce entity.
+func newOperatorCallNonceMutation(c config, op Op, opts ...operatorcallnonceOption) *OperatorCallNonceMutation {
+	m := &OperatorCallNonceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOperatorCallNonce,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOperatorCallNonceID sets the ID field of the mutation.
+func withOperatorCallNonceID(id uuid.UUID) operatorcallnonceOption {
+	return func(m *OperatorCallNonceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OperatorCallNonce
+		)
+		m.oldValue = func(ctx context.Context) (*OperatorCallNonce, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OperatorCallNonce.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOperatorCallNonce sets the old OperatorCallNonce of the mutation.
+func withOperatorCallNonce(node *OperatorCallNonce) operatorcallnonceOption {
+	return func(m *OperatorCallNonceMutation) {
+		m.oldValue = func(context.Context) (*OperatorCallNonce, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OperatorCallNonceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OperatorCallNonceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OperatorCallNonce entities.
+func (m *OperatorCallNonceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OperatorCallNonceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OperatorCallNonceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OperatorCallNonce.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *OperatorCallNonceMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OperatorCallNonceMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the OperatorCallNonce entity.
+// If the OperatorCallNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperatorCallNonceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OperatorCallNonceMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *OperatorCallNonceMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *OperatorCallNonceMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the OperatorCallNonce entity.
+// If the OperatorCallNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperatorCallNonceMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *OperatorCallNonceMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOperatorIdentityPublicKey sets the "operator_identity_public_key" field.
+func (m *OperatorCallNonceMutation) SetOperatorIdentityPublicKey(b []byte) {
+	m.operator_identity_public_key = &b
+}
+
+// OperatorIdentityPublicKey returns the value of the "operator_identity_public_key" field in the mutation.
+func (m *OperatorCallNonceMutation) OperatorIdentityPublicKey() (r []byte, exists bool) {
+	v := m.operator_identity_public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperatorIdentityPublicKey returns the old "operator_identity_public_key" field's value of the OperatorCallNonce entity.
+// If the OperatorCallNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperatorCallNonceMutation) OldOperatorIdentityPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperatorIdentityPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperatorIdentityPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperatorIdentityPublicKey: %w", err)
+	}
+	return oldValue.OperatorIdentityPublicKey, nil
+}
+
+// ResetOperatorIdentityPublicKey resets all changes to the "operator_identity_public_key" field.
+func (m *OperatorCallNonceMutation) ResetOperatorIdentityPublicKey() {
+	m.operator_identity_public_key = nil
+}
+
+// SetNonce sets the "nonce" field.
+func (m *OperatorCallNonceMutation) SetNonce(b []byte) {
+	m.nonce = &b
+}
+
+// Nonce returns the value of the "nonce" field in the mutation.
+func (m *OperatorCallNonceMutation) Nonce() (r []byte, exists bool) {
+	v := m.nonce
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNonce returns the old "nonce" field's value of the OperatorCallNonce entity.
+// If the OperatorCallNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperatorCallNonceMutation) OldNonce(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNonce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
+	}
+	return oldValue.Nonce, nil
+}
+
+// ResetNonce resets all changes to the "nonce" field.
+func (m *OperatorCallNonceMutation) ResetNonce() {
+	m.nonce = nil
+}
+
+// SetExpirationTime sets the "expiration_time" field.
+func (m *OperatorCallNonceMutation) SetExpirationTime(t time.Time) {
+	m.expiration_time = &t
+}
+
+// ExpirationTime returns the value of the "expiration_time" field in the mutation.
+func (m *OperatorCallNonceMutation) ExpirationTime() (r time.Time, exists bool) {
+	v := m.expiration_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpirationTime returns the old "expiration_time" field's value of the OperatorCallNonce entity.
+// If the OperatorCallNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperatorCallNonceMutation) OldExpirationTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpirationTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpirationTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpirationTime: %w", err)
+	}
+	return oldValue.ExpirationTime, nil
+}
+
+// ResetExpirationTime resets all changes to the "expiration_time" field.
+func (m *OperatorCallNonceMutation) ResetExpirationTime() {
+	m.expiration_time = nil
+}
+
+// Where appends a list predicates to the OperatorCallNonceMutation builder.
+func (m *OperatorCallNonceMutation) Where(ps ...predicate.OperatorCallNonce) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OperatorCallNonceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OperatorCallNonceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OperatorCallNonce, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OperatorCallNonceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OperatorCallNonceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OperatorCallNonce).
+func (m *OperatorCallNonceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OperatorCallNonceMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, operatorcallnonce.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, operatorcallnonce.FieldUpdateTime)
+	}
+	if m.operator_identity_public_key != nil {
+		fields = append(fields, operatorcallnonce.FieldOperatorIdentityPublicKey)
+	}
+	if m.nonce != nil {
+		fields = append(fields, operatorcallnonce.FieldNonce)
+	}
+	if m.expiration_time != nil {
+		fields = append(fields, operatorcallnonce.FieldExpirationTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OperatorCallNonceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case operatorcallnonce.FieldCreateTime:
+		return m.CreateTime()
+	case operatorcallnonce.FieldUpdateTime:
+		return m.UpdateTime()
+	case operatorcallnonce.FieldOperatorIdentityPublicKey:
+		return m.OperatorIdentityPublicKey()
+	case operatorcallnonce.FieldNonce:
+		return m.Nonce()
+	case operatorcallnonce.FieldExpirationTime:
+		return m.ExpirationTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OperatorCallNonceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case operatorcallnonce.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case operatorcallnonce.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case operatorcallnonce.FieldOperatorIdentityPublicKey:
+		return m.OldOperatorIdentityPublicKey(ctx)
+	case operatorcallnonce.FieldNonce:
+		return m.OldNonce(ctx)
+	case operatorcallnonce.FieldExpirationTime:
+		return m.OldExpirationTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown OperatorCallNonce field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OperatorCallNonceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case operatorcallnonce.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case operatorcallnonce.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case operatorcallnonce.FieldOperatorIdentityPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperatorIdentityPublicKey(v)
+		return nil
+	case operatorcallnonce.FieldNonce:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNonce(v)
+		return nil
+	case operatorcallnonce.FieldExpirationTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpirationTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OperatorCallNonce field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OperatorCallNonceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OperatorCallNonceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OperatorCallNonceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OperatorCallNonce numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OperatorCallNonceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OperatorCallNonceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OperatorCallNonceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OperatorCallNonce nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OperatorCallNonceMutation) ResetField(name string) error {
+	switch name {
+	case operatorcallnonce.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case operatorcallnonce.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case operatorcallnonce.FieldOperatorIdentityPublicKey:
+		m.ResetOperatorIdentityPublicKey()
+		return nil
+	case operatorcallnonce.FieldNonce:
+		m.ResetNonce()
+		return nil
+	case operatorcallnonce.FieldExpirationTime:
+		m.ResetExpirationTime()
+		return nil
+	}
+	return fmt.Errorf("unknown OperatorCallNonce field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OperatorCallNonceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OperatorCallNonceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OperatorCallNonceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OperatorCallNonceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OperatorCallNonceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OperatorCallNonceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OperatorCallNonceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OperatorCallNonce unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OperatorCallNonceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OperatorCallNonce edge %s", name)
+}
+
+// PreimageRequestMutation represents an operation that mutates the PreimageRequest nodes in the graph.
+type PreimageRequestMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	create_time              *time.Time
+	update_time              *time.Time
+	payment_hash             *[]byte
+	status                   *schema.PreimageRequestStatus
+	receiver_identity_pubkey *[]byte
+	preimage                 *[]byte
+	clearedFields            map[string]struct{}
+	transactions             map[uuid.UUID]struct{}
+	removedtransactions      map[uuid.UUID]struct{}
+	clearedtransactions      bool
+	preimage_shares          *uuid.UUID
+	clearedpreimage_shares   bool
+	transfers                *uuid.UUID
+	clearedtransfers         bool
+	done                     bool
+	oldValue                 func(context.Context) (*PreimageRequest, error)
+	predicates               []predicate.PreimageRequest
+}
+
+var _ ent.Mutation = (*PreimageRequestMutation)(nil)
+
+// preimagerequestOption allows management of the mutation configuration using functional options.
+type preimagerequestOption func(*PreimageRequestMutation)
+
+// newPreimageRequestMutation creates new mutation for the PreimageRequest entity.
+func newPreimageRequestMutation(c config, op Op, opts ...preimagerequestOption) *PreimageRequestMutation {
+	m := &PreimageRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePreimageRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPreimageRequestID sets the ID field of the mutation.
+func withPreimageRequestID(id uuid.UUID) preimagerequestOption {
+	return func(m *PreimageRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PreimageRequest
+		)
+		m.oldValue = func(ctx context.Context) (*PreimageRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PreimageRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPreimageRequest sets the old PreimageRequest of the mutation.
+func withPreimageRequest(node *PreimageRequest) preimagerequestOption {
+	return func(m *PreimageRequestMutation) {
+		m.oldValue = func(context.Context) (*PreimageRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PreimageRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PreimageRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PreimageRequest entities.
+func (m *PreimageRequestMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PreimageRequestMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PreimageRequestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PreimageRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *PreimageRequestMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *PreimageRequestMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the PreimageRequest entity.
+// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageRequestMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *PreimageRequestMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *PreimageRequestMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *PreimageRequestMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the PreimageRequest entity.
+// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageRequestMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *PreimageRequestMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetPaymentHash sets the "payment_hash" field.
+func (m *PreimageRequestMutation) SetPaymentHash(b []byte) {
+	m.payment_hash = &b
+}
+
+// PaymentHash returns the value of the "payment_hash" field in the mutation.
+func (m *PreimageRequestMutation) PaymentHash() (r []byte, exists bool) {
+	v := m.payment_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentHash returns the old "payment_hash" field's value of the PreimageRequest entity.
+// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageRequestMutation) OldPaymentHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentHash: %w", err)
+	}
+	return oldValue.PaymentHash, nil
+}
+
+// ResetPaymentHash resets all changes to the "payment_hash" field.
+func (m *PreimageRequestMutation) ResetPaymentHash() {
+	m.payment_hash = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PreimageRequestMutation) SetStatus(srs schema.PreimageRequestStatus) {
+	m.status = &srs
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PreimageRequestMutation) Status() (r schema.PreimageRequestStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PreimageRequest entity.
+// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageRequestMutation) OldStatus(ctx context.Context) (v schema.PreimageRequestStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PreimageRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReceiverIdentityPubkey sets the "receiver_identity_pubkey" field.
+func (m *PreimageRequestMutation) SetReceiverIdentityPubkey(b []byte) {
+	m.receiver_identity_pubkey = &b
+}
+
+// ReceiverIdentityPubkey returns the value of the "receiver_identity_pubkey" field in the mutation.
+func (m *PreimageRequestMutation) ReceiverIdentityPubkey() (r []byte, exists bool) {
+	v := m.receiver_identity_pubkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiverIdentityPubkey returns the old "receiver_identity_pubkey" field's value of the PreimageRequest entity.
+// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageRequestMutation) OldReceiverIdentityPubkey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiverIdentityPubkey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiverIdentityPubkey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiverIdentityPubkey: %w", err)
+	}
+	return oldValue.ReceiverIdentityPubkey, nil
+}
+
+// ClearReceiverIdentityPubkey clears the value of the "receiver_identity_pubkey" field.
+func (m *PreimageRequestMutation) ClearReceiverIdentityPubkey() {
+	m.receiver_identity_pubkey = nil
+	m.clearedFields[preimagerequest.FieldReceiverIdentityPubkey] = struct{}{}
+}
+
+// ReceiverIdentityPubkeyCleared returns if the "receiver_identity_pubkey" field was cleared in this mutation.
+func (m *PreimageRequestMutation) ReceiverIdentityPubkeyCleared() bool {
+	_, ok := m.clearedFields[preimagerequest.FieldReceiverIdentityPubkey]
+	return ok
+}
+
+// ResetReceiverIdentityPubkey resets all changes to the "receiver_identity_pubkey" field.
+func (m *PreimageRequestMutation) ResetReceiverIdentityPubkey() {
+	m.receiver_identity_pubkey = nil
+	delete(m.clearedFields, preimagerequest.FieldReceiverIdentityPubkey)
+}
+
+// SetPreimage sets the "preimage" field.
+func (m *PreimageRequestMutation) SetPreimage(b []byte) {
+	m.preimage = &b
+}
+
+// Preimage returns the value of the "preimage" field in the mutation.
+func (m *PreimageRequestMutation) Preimage() (r []byte, exists bool) {
+	v := m.preimage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreimage returns the old "preimage" field's value of the PreimageRequest entity.
+// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageRequestMutation) OldPreimage(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreimage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreimage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreimage: %w", err)
+	}
+	return oldValue.Preimage, nil
+}
+
+// ClearPreimage clears the value of the "preimage" field.
+func (m *PreimageRequestMutation) ClearPreimage() {
+	m.preimage = nil
+	m.clearedFields[preimagerequest.FieldPreimage] = struct{}{}
+}
+
+// PreimageCleared returns if the "preimage" field was cleared in this mutation.
+func (m *PreimageRequestMutation) PreimageCleared() bool {
+	_, ok := m.clearedFields[preimagerequest.FieldPreimage]
+	return ok
+}
+
+// ResetPreimage resets all changes to the "preimage" field.
+func (m *PreimageRequestMutation) ResetPreimage() {
+	m.preimage = nil
+	delete(m.clearedFields, preimagerequest.FieldPreimage)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the UserSignedTransaction entity by ids.
+func (m *PreimageRequestMutation) AddTransactionIDs(ids ...uuid.UUID) {
+	if m.transactions == nil {
+		m.transactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactions clears the "transactions" edge to the UserSignedTransaction entity.
+func (m *PreimageRequestMutation) ClearTransactions() {
+	m.clearedtransactions = true
+}
+
+// TransactionsCleared reports if the "transactions" edge to the UserSignedTransaction entity was cleared.
+func (m *PreimageRequestMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to the UserSignedTransaction entity by IDs.
+func (m *PreimageRequestMutation) RemoveTransactionIDs(ids ...uuid.UUID) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the UserSignedTransaction entity.
+func (m *PreimageRequestMutation) RemovedTransactionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *PreimageRequestMutation) TransactionsIDs() (ids []uuid.UUID) {
+	for id := range m.transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *PreimageRequestMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
+}
+
+// SetPreimageSharesID sets the "preimage_shares" edge to the PreimageShare entity by id.
+func (m *PreimageRequestMutation) SetPreimageSharesID(id uuid.UUID) {
+	m.preimage_shares = &id
+}
+
+// ClearPreimageShares clears the "preimage_shares" edge to the PreimageShare entity.
+func (m *PreimageRequestMutation) ClearPreimageShares() {
+	m.clearedpreimage_shares = true
+}
+
+// PreimageSharesCleared reports if the "preimage_shares" edge to the PreimageShare entity was cleared.
+func (m *PreimageRequestMutation) PreimageSharesCleared() bool {
+	return m.clearedpreimage_shares
+}
+
+// PreimageSharesID returns the "preimage_shares" edge ID in the mutation.
+func (m *PreimageRequestMutation) PreimageSharesID() (id uuid.UUID, exists bool) {
+	if m.preimage_shares != nil {
+		return *m.preimage_shares, true
+	}
+	return
+}
+
+// PreimageSharesIDs returns the "preimage_shares" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PreimageSharesID instead. It exists only for internal usage by the builders.
+func (m *PreimageRequestMutation) PreimageSharesIDs() (ids []uuid.UUID) {
+	if id := m.preimage_shares; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPreimageShares resets all changes to the "preimage_shares" edge.
+func (m *PreimageRequestMutation) ResetPreimageShares() {
+	m.preimage_shares = nil
+	m.clearedpreimage_shares = false
+}
+
+// SetTransfersID sets the "transfers" edge to the Transfer entity by id.
+func (m *PreimageRequestMutation) SetTransfersID(id uuid.UUID) {
+	m.transfers = &id
+}
+
+// ClearTransfers clears the "transfers" edge to the Transfer entity.
+func (m *PreimageRequestMutation) ClearTransfers() {
+	m.clearedtransfers = true
+}
+
+// TransfersCleared reports if the "transfers" edge to the Transfer entity was cleared.
+func (m *PreimageRequestMutation) TransfersCleared() bool {
+	return m.clearedtransfers
+}
+
+// TransfersID returns the "transfers" edge ID in the mutation.
+func (m *PreimageRequestMutation) TransfersID() (id uuid.UUID, exists bool) {
+	if m.transfers != nil {
+		return *m.transfers, true
+	}
+	return
+}
+
+// TransfersIDs returns the "transfers" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransfersID instead. It exists only for internal usage by the builders.
+func (m *PreimageRequestMutation) TransfersIDs() (ids []uuid.UUID) {
+	if id := m.transfers; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransfers resets all changes to the "transfers" edge.
+func (m *PreimageRequestMutation) ResetTransfers() {
+	m.transfers = nil
+	m.clearedtransfers = false
+}
+
+// Where appends a list predicates to the PreimageRequestMutation builder.
+func (m *PreimageRequestMutation) Where(ps ...predicate.PreimageRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PreimageRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PreimageRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PreimageRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PreimageRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PreimageRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PreimageRequest).
+func (m *PreimageRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PreimageRequestMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, preimagerequest.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, preimagerequest.FieldUpdateTime)
+	}
+	if m.payment_hash != nil {
+		fields = append(fields, preimagerequest.FieldPaymentHash)
+	}
+	if m.status != nil {
+		fields = append(fields, preimagerequest.FieldStatus)
+	}
+	if m.receiver_identity_pubkey != nil {
+		fields = append(fields, preimagerequest.FieldReceiverIdentityPubkey)
+	}
+	if m.preimage != nil {
+		fields = append(fields, preimagerequest.FieldPreimage)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PreimageRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case preimagerequest.FieldCreateTime:
+		return m.CreateTime()
+	case preimagerequest.FieldUpdateTime:
+		return m.UpdateTime()
+	case preimagerequest.FieldPaymentHash:
+		return m.PaymentHash()
+	case preimagerequest.FieldStatus:
+		return m.Status()
+	case preimagerequest.FieldReceiverIdentityPubkey:
+		return m.ReceiverIdentityPubkey()
+	case preimagerequest.FieldPreimage:
+		return m.Preimage()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PreimageRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case preimagerequest.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case preimagerequest.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case preimagerequest.FieldPaymentHash:
+		return m.OldPaymentHash(ctx)
+	case preimagerequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case preimagerequest.FieldReceiverIdentityPubkey:
+		return m.OldReceiverIdentityPubkey(ctx)
+	case preimagerequest.FieldPreimage:
+		return m.OldPreimage(ctx)
+	}
+	return nil, fmt.Errorf("unknown PreimageRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PreimageRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case preimagerequest.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case preimagerequest.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case preimagerequest.FieldPaymentHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentHash(v)
+		return nil
+	case preimagerequest.FieldStatus:
+		v, ok := value.(schema.PreimageRequestStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case preimagerequest.FieldReceiverIdentityPubkey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiverIdentityPubkey(v)
+		return nil
+	case preimagerequest.FieldPreimage:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreimage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PreimageRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PreimageRequestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PreimageRequestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PreimageRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PreimageRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PreimageRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(preimagerequest.FieldReceiverIdentityPubkey) {
+		fields = append(fields, preimagerequest.FieldReceiverIdentityPubkey)
+	}
+	if m.FieldCleared(preimagerequest.FieldPreimage) {
+		fields = append(fields, preimagerequest.FieldPreimage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PreimageRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PreimageRequestMutation) ClearField(name string) error {
+	switch name {
+	case preimagerequest.FieldReceiverIdentityPubkey:
+		m.ClearReceiverIdentityPubkey()
+		return nil
+	case preimagerequest.FieldPreimage:
+		m.ClearPreimage()
+		return nil
+	}
+	return fmt.Errorf("unknown PreimageRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PreimageRequestMutation) ResetField(name string) error {
+	switch name {
+	case preimagerequest.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case preimagerequest.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case preimagerequest.FieldPaymentHash:
+		m.ResetPaymentHash()
+		return nil
+	case preimagerequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case preimagerequest.FieldReceiverIdentityPubkey:
+		m.ResetReceiverIdentityPubkey()
+		return nil
+	case preimagerequest.FieldPreimage:
+		m.ResetPreimage()
+		return nil
+	}
+	return fmt.Errorf("unknown PreimageRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PreimageRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.transactions != nil {
+		edges = append(edges, preimagerequest.EdgeTransactions)
+	}
+	if m.preimage_shares != nil {
+		edges = append(edges, preimagerequest.EdgePreimageShares)
+	}
+	if m.transfers != nil {
+		edges = append(edges, preimagerequest.EdgeTransfers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PreimageRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case preimagerequest.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case preimagerequest.EdgePreimageShares:
+		if id := m.preimage_shares; id != nil {
+			return []ent.Value{*id}
+		}
+	case preimagerequest.EdgeTransfers:
+		if id := m.transfers; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PreimageRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtransactions != nil {
+		edges = append(edges, preimagerequest.EdgeTransactions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PreimageRequestMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case preimagerequest.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PreimageRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtransactions {
+		edges = append(edges, preimagerequest.EdgeTransactions)
+	}
+	if m.clearedpreimage_shares {
+		edges = append(edges, preimagerequest.EdgePreimageShares)
+	}
+	if m.clearedtransfers {
+		edges = append(edges, preimagerequest.EdgeTransfers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PreimageRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case preimagerequest.EdgeTransactions:
+		return m.clearedtransactions
+	case preimagerequest.EdgePreimageShares:
+		return m.clearedpreimage_shares
+	case preimagerequest.EdgeTransfers:
+		return m.clearedtransfers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PreimageRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case preimagerequest.EdgePreimageShares:
+		m.ClearPreimageShares()
+		return nil
+	case preimagerequest.EdgeTransfers:
+		m.ClearTransfers()
+		return nil
+	}
+	return fmt.Errorf("unknown PreimageRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PreimageRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case preimagerequest.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	case preimagerequest.EdgePreimageShares:
+		m.ResetPreimageShares()
+		return nil
+	case preimagerequest.EdgeTransfers:
+		m.ResetTransfers()
+		return nil
+	}
+	return fmt.Errorf("unknown PreimageRequest edge %s", name)
+}
+
+// PreimageShareMutation represents an operation that mutates the PreimageShare nodes in the graph.
+type PreimageShareMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	create_time             *time.Time
+	update_time             *time.Time
+	payment_hash            *[]byte
+	preimage_share          *[]byte
+	threshold               *int32
+	addthreshold            *int32
+	owner_identity_pubkey   *[]byte
+	invoice_string          *string
+	clearedFields           map[string]struct{}
+	preimage_request        *uuid.UUID
+	clearedpreimage_request bool
+	done                    bool
+	oldValue                func(context.Context) (*PreimageShare, error)
+	predicates              []predicate.PreimageShare
+}
+
+var _ ent.Mutation = (*PreimageShareMutation)(nil)
+
+// preimageshareOption allows management of the mutation configuration using functional options.
+type preimageshareOption func(*PreimageShareMutation)
+
+// newPreimageShareMutation creates new mutation for the PreimageShare entity.
+func newPreimageShareMutation(c config, op Op, opts ...preimageshareOption) *PreimageShareMutation {
+	m := &PreimageShareMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePreimageShare,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPreimageShareID sets the ID field of the mutation.
+func withPreimageShareID(id uuid.UUID) preimageshareOption {
+	return func(m *PreimageShareMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PreimageShare
+		)
+		m.oldValue = func(ctx context.Context) (*PreimageShare, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PreimageShare.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPreimageShare sets the old PreimageShare of the mutation.
+func withPreimageShare(node *PreimageShare) preimageshareOption {
+	return func(m *PreimageShareMutation) {
+		m.oldValue = func(context.Context) (*PreimageShare, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PreimageShareMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PreimageShareMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PreimageShare entities.
+func (m *PreimageShareMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PreimageShareMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PreimageShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PreimageShare.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *PreimageShareMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *PreimageShareMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the PreimageShare entity.
+// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageShareMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *PreimageShareMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *PreimageShareMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *PreimageShareMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the PreimageShare entity.
+// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageShareMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *PreimageShareMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetPaymentHash sets the "payment_hash" field.
+func (m *PreimageShareMutation) SetPaymentHash(b []byte) {
+	m.payment_hash = &b
+}
+
+// PaymentHash returns the value of the "payment_hash" field in the mutation.
+func (m *PreimageShareMutation) PaymentHash() (r []byte, exists bool) {
+	v := m.payment_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentHash returns the old "payment_hash" field's value of the PreimageShare entity.
+// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageShareMutation) OldPaymentHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentHash: %w", err)
+	}
+	return oldValue.PaymentHash, nil
+}
+
+// ResetPaymentHash resets all changes to the "payment_hash" field.
+func (m *PreimageShareMutation) ResetPaymentHash() {
+	m.payment_hash = nil
+}
+
+// SetPreimageShare sets the "preimage_share" field.
+func (m *PreimageShareMutation) SetPreimageShare(b []byte) {
+	m.preimage_share = &b
+}
+
+// PreimageShare returns the value of the "preimage_share" field in the mutation.
+func (m *PreimageShareMutation) PreimageShare() (r []byte, exists bool) {
+	v := m.preimage_share
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreimageShare returns the old "preimage_share" field's value of the PreimageShare entity.
+// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageShareMutation) OldPreimageShare(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreimageShare is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreimageShare requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreimageShare: %w", err)
+	}
+	return oldValue.PreimageShare, nil
+}
+
+// ResetPreimageShare resets all changes to the "preimage_share" field.
+func (m *PreimageShareMutation) ResetPreimageShare() {
+	m.preimage_share = nil
+}
+
+// SetThreshold sets the "threshold" field.
+func (m *PreimageShareMutation) SetThreshold(i int32) {
+	m.threshold = &i
+	m.addthreshold = nil
+}
+
+// Threshold returns the value of the "threshold" field in the mutation.
+func (m *PreimageShareMutation) Threshold() (r int32, exists bool) {
+	v := m.threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThreshold returns the old "threshold" field's value of the PreimageShare entity.
+// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageShareMutation) OldThreshold(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreshold: %w", err)
+	}
+	return oldValue.Threshold, nil
+}
+
+// AddThreshold adds i to the "threshold" field.
+func (m *PreimageShareMutation) AddThreshold(i int32) {
+	if m.addthreshold != nil {
+		*m.addthreshold += i
+	} else {
+		m.addthreshold = &i
+	}
+}
+
+// AddedThreshold returns the value that was added to the "threshold" field in this mutation.
+func (m *PreimageShareMutation) AddedThreshold() (r int32, exists bool) {
+	v := m.addthreshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetThreshold resets all changes to the "threshold" field.
+func (m *PreimageShareMutation) ResetThreshold() {
+	m.threshold = nil
+	m.addthreshold = nil
+}
+
+// SetOwnerIdentityPubkey sets the "owner_identity_pubkey" field.
+func (m *PreimageShareMutation) SetOwnerIdentityPubkey(b []byte) {
+	m.owner_identity_pubkey = &b
+}
+
+// OwnerIdentityPubkey returns the value of the "owner_identity_pubkey" field in the mutation.
+func (m *PreimageShareMutation) OwnerIdentityPubkey() (r []byte, exists bool) {
+	v := m.owner_identity_pubkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerIdentityPubkey returns the old "owner_identity_pubkey" field's value of the PreimageShare entity.
+// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageShareMutation) OldOwnerIdentityPubkey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerIdentityPubkey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerIdentityPubkey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerIdentityPubkey: %w", err)
+	}
+	return oldValue.OwnerIdentityPubkey, nil
+}
+
+// ResetOwnerIdentityPubkey resets all changes to the "owner_identity_pubkey" field.
+func (m *PreimageShareMutation) ResetOwnerIdentityPubkey() {
+	m.owner_identity_pubkey = nil
+}
+
+// SetInvoiceString sets the "invoice_string" field.
+func (m *PreimageShareMutation) SetInvoiceString(s string) {
+	m.invoice_string = &s
+}
+
+// InvoiceString returns the value of the "invoice_string" field in the mutation.
+func (m *PreimageShareMutation) InvoiceString() (r string, exists bool) {
+	v := m.invoice_string
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvoiceString returns the old "invoice_string" field's value of the PreimageShare entity.
+// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreimageShareMutation) OldInvoiceString(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvoiceString is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvoiceString requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvoiceString: %w", err)
+	}
+	return oldValue.InvoiceString, nil
+}
+
+// ResetInvoiceString resets all changes to the "invoice_string" field.
+func (m *PreimageShareMutation) ResetInvoiceString() {
+	m.invoice_string = nil
+}
+
+// SetPreimageRequestID sets the "preimage_request" edge to the PreimageRequest entity by id.
+func (m *PreimageShareMutation) SetPreimageRequestID(id uuid.UUID) {
+	m.preimage_request = &id
+}
+
+// ClearPreimageRequest clears the "preimage_request" edge to the PreimageRequest entity.
+func (m *PreimageShareMutation) ClearPreimageRequest() {
+	m.clearedpreimage_request = true
+}
+
+// PreimageRequestCleared reports if the "preimage_request" edge to the PreimageRequest entity was cleared.
+func (m *PreimageShareMutation) PreimageRequestCleared() bool {
+	return m.clearedpreimage_request
+}
+
+// PreimageRequestID returns the "preimage_request" edge ID in the mutation.
+func (m *PreimageShareMutation) PreimageRequestID() (id uuid.UUID, exists bool) {
+	if m.preimage_request != nil {
+		return *m.preimage_request, true
+	}
+	return
+}
+
+// PreimageRequestIDs returns the "preimage_request" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PreimageRequestID instead. It exists only for internal usage by the builders.
+func (m *PreimageShareMutation) PreimageRequestIDs() (ids []uuid.UUID) {
+	if id := m.preimage_request; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPreimageRequest resets all changes to the "preimage_request" edge.
+func (m *PreimageShareMutation) ResetPreimageRequest() {
+	m.preimage_request = nil
+	m.clearedpreimage_request = false
+}
+
+// Where appends a list predicates to the PreimageShareMutation builder.
+func (m *PreimageShareMutation) Where(ps ...predicate.PreimageShare) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PreimageShareMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PreimageShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PreimageShare, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PreimageShareMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PreimageShareMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PreimageShare).
+func (m *PreimageShareMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PreimageShareMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, preimageshare.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, preimageshare.FieldUpdateTime)
+	}
+	if m.payment_hash != nil {
+		fields = append(fields, preimageshare.FieldPaymentHash)
+	}
+	if m.preimage_share != nil {
+		fields = append(fields, preimageshare.FieldPreimageShare)
+	}
+	if m.threshold != nil {
+		fields = append(fields, preimageshare.FieldThreshold)
+	}
+	if m.owner_identity_pubkey != nil {
+		fields = append(fields, preimageshare.FieldOwnerIdentityPubkey)
+	}
+	if m.invoice_string != nil {
+		fields = append(fields, preimageshare.FieldInvoiceString)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PreimageShareMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case preimageshare.FieldCreateTime:
+		return m.CreateTime()
+	case preimageshare.FieldUpdateTime:
+		return m.UpdateTime()
+	case preimageshare.FieldPaymentHash:
+		return m.PaymentHash()
+	case preimageshare.FieldPreimageShare:
+		return m.PreimageShare()
+	case preimageshare.FieldThreshold:
+		return m.Threshold()
+	case preimageshare.FieldOwnerIdentityPubkey:
+		return m.OwnerIdentityPubkey()
+	case preimageshare.FieldInvoiceString:
+		return m.InvoiceString()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PreimageShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case preimageshare.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case preimageshare.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case preimageshare.FieldPaymentHash:
+		return m.OldPaymentHash(ctx)
+	case preimageshare.FieldPreimageShare:
+		return m.OldPreimageShare(ctx)
+	case preimageshare.FieldThreshold:
+		return m.OldThreshold(ctx)
+	case preimageshare.FieldOwnerIdentityPubkey:
+		return m.OldOwnerIdentityPubkey(ctx)
+	case preimageshare.FieldInvoiceString:
+		return m.OldInvoiceString(ctx)
+	}
+	return nil, fmt.Errorf("unknown PreimageShare field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PreimageShareMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case preimageshare.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case preimageshare.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case preimageshare.FieldPaymentHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentHash(v)
+		return nil
+	case preimageshare.FieldPreimageShare:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreimageShare(v)
+		return nil
+	case preimageshare.FieldThreshold:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreshold(v)
+		return nil
+	case preimageshare.FieldOwnerIdentityPubkey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerIdentityPubkey(v)
+		return nil
+	case preimageshare.FieldInvoiceString:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvoiceString(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PreimageShare field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PreimageShareMutation) AddedFields() []string {
+	var fields []string
+	if m.addthreshold != nil {
+		fields = append(fields, preimageshare.FieldThreshold)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PreimageShareMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case preimageshare.FieldThreshold:
+		return m.AddedThreshold()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PreimageShareMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case preimageshare.FieldThreshold:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThreshold(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PreimageShare numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PreimageShareMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PreimageShareMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PreimageShareMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PreimageShare nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PreimageShareMutation) ResetField(name string) error {
+	switch name {
+	case preimageshare.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case preimageshare.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case preimageshare.FieldPaymentHash:
+		m.ResetPaymentHash()
+		return nil
+	case preimageshare.FieldPreimageShare:
+		m.ResetPreimageShare()
+		return nil
+	case preimageshare.FieldThreshold:
+		m.ResetThreshold()
+		return nil
+	case preimageshare.FieldOwnerIdentityPubkey:
+		m.ResetOwnerIdentityPubkey()
+		return nil
+	case preimageshare.FieldInvoiceString:
+		m.ResetInvoiceString()
+		return nil
+	}
+	return fmt.Errorf("unknown PreimageShare field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PreimageShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.preimage_request != nil {
+		edges = append(edges, preimageshare.EdgePreimageRequest)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PreimageShareMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case preimageshare.EdgePreimageRequest:
+		if id := m.preimage_request; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PreimageShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PreimageShareMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PreimageShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpreimage_request {
+		edges = append(edges, preimageshare.EdgePreimageRequest)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PreimageShareMutation) EdgeCleared(name string) bool {
+	switch name {
+	case preimageshare.EdgePreimageRequest:
+		return m.clearedpreimage_request
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PreimageShareMutation) ClearEdge(name string) error {
+	switch name {
+	case preimageshare.EdgePreimageRequest:
+		m.ClearPreimageRequest()
+		return nil
+	}
+	return fmt.Errorf("unknown PreimageShare unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PreimageShareMutation) ResetEdge(name string) error {
+	switch name {
+	case preimageshare.EdgePreimageRequest:
+		m.ResetPreimageRequest()
+		return nil
+	}
+	return fmt.Errorf("unknown PreimageShare edge %s", name)
+}
+
+// RecentWriteMutation represents an operation that mutates the RecentWrite nodes in the graph.
+type RecentWriteMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	create_time         *time.Time
+	update_time         *time.Time
+	identity_public_key *[]byte
+	expiration_time     *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*RecentWrite, error)
+	predicates          []predicate.RecentWrite
+}
+
+var _ ent.Mutation = (*RecentWriteMutation)(nil)
+
+// recentwriteOption allows management of the mutation configuration using functional options.
+type recentwriteOption func(*RecentWriteMutation)
+
+// newRecentWriteMutation creates new mutation for the RecentWrite entity.
+func newRecentWriteMutation(c config, op Op, opts ...recentwriteOption) *RecentWriteMutation {
+	m := &RecentWriteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecentWrite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecentWriteID sets the ID field of the mutation.
+func withRecentWriteID(id uuid.UUID) recentwriteOption {
+	return func(m *RecentWriteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecentWrite
+		)
+		m.oldValue = func(ctx context.Context) (*RecentWrite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecentWrite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecentWrite sets the old RecentWrite of the mutation.
+func withRecentWrite(node *RecentWrite) recentwriteOption {
+	return func(m *RecentWriteMutation) {
+		m.oldValue = func(context.Context) (*RecentWrite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecentWriteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecentWriteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RecentWrite entities.
+func (m *RecentWriteMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecentWriteMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecentWriteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecentWrite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *RecentWriteMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *RecentWriteMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the RecentWrite entity.
+// If the RecentWrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecentWriteMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *RecentWriteMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *RecentWriteMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *RecentWriteMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the RecentWrite entity.
+// If the RecentWrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecentWriteMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *RecentWriteMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetIdentityPublicKey sets the "identity_public_key" field.
+func (m *RecentWriteMutation) SetIdentityPublicKey(b []byte) {
+	m.identity_public_key = &b
+}
+
+// IdentityPublicKey returns the value of the "identity_public_key" field in the mutation.
+func (m *RecentWriteMutation) IdentityPublicKey() (r []byte, exists bool) {
+	v := m.identity_public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdentityPublicKey returns the old "identity_public_key" field's value of the RecentWrite entity.
+// If the RecentWrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecentWriteMutation) OldIdentityPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdentityPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdentityPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdentityPublicKey: %w", err)
+	}
+	return oldValue.IdentityPublicKey, nil
+}
+
+// ResetIdentityPublicKey resets all changes to the "identity_public_key" field.
+func (m *RecentWriteMutation) ResetIdentityPublicKey() {
+	m.identity_public_key = nil
+}
+
+// SetExpirationTime sets the "expiration_time" field.
+func (m *RecentWriteMutation) SetExpirationTime(t time.Time) {
+	m.expiration_time = &t
+}
+
+// ExpirationTime returns the value of the "expiration_time" field in the mutation.
+func (m *RecentWriteMutation) ExpirationTime() (r time.Time, exists bool) {
+	v := m.expiration_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpirationTime returns the old "expiration_time" field's value of the RecentWrite entity.
+// If the RecentWrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecentWriteMutation) OldExpirationTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpirationTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpirationTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpirationTime: %w", err)
+	}
+	return oldValue.ExpirationTime, nil
+}
+
+// ResetExpirationTime resets all changes to the "expiration_time" field.
+func (m *RecentWriteMutation) ResetExpirationTime() {
+	m.expiration_time = nil
+}
+
+// Where appends a list predicates to the RecentWriteMutation builder.
+func (m *RecentWriteMutation) Where(ps ...predicate.RecentWrite) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecentWriteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecentWriteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecentWrite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecentWriteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecentWriteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecentWrite).
+func (m *RecentWriteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecentWriteMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.create_time != nil {
+		fields = append(fields, recentwrite.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, recentwrite.FieldUpdateTime)
+	}
+	if m.identity_public_key != nil {
+		fields = append(fields, recentwrite.FieldIdentityPublicKey)
+	}
+	if m.expiration_time != nil {
+		fields = append(fields, recentwrite.FieldExpirationTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecentWriteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recentwrite.FieldCreateTime:
+		return m.CreateTime()
+	case recentwrite.FieldUpdateTime:
+		return m.UpdateTime()
+	case recentwrite.FieldIdentityPublicKey:
+		return m.IdentityPublicKey()
+	case recentwrite.FieldExpirationTime:
+		return m.ExpirationTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecentWriteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recentwrite.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case recentwrite.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case recentwrite.FieldIdentityPublicKey:
+		return m.OldIdentityPublicKey(ctx)
+	case recentwrite.FieldExpirationTime:
+		return m.OldExpirationTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecentWrite field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecentWriteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recentwrite.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case recentwrite.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case recentwrite.FieldIdentityPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdentityPublicKey(v)
+		return nil
+	case recentwrite.FieldExpirationTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpirationTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecentWrite field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecentWriteMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecentWriteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecentWriteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RecentWrite numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecentWriteMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecentWriteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecentWriteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RecentWrite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecentWriteMutation) ResetField(name string) error {
+	switch name {
+	case recentwrite.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case recentwrite.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case recentwrite.FieldIdentityPublicKey:
+		m.ResetIdentityPublicKey()
+		return nil
+	case recentwrite.FieldExpirationTime:
+		m.ResetExpirationTime()
+		return nil
+	}
+	return fmt.Errorf("unknown RecentWrite field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecentWriteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecentWriteMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecentWriteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecentWriteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecentWriteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecentWriteMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecentWriteMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RecentWrite unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecentWriteMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RecentWrite edge %s", name)
+}
+
+// ReshareMutation represents an operation that mutates the Reshare nodes in the graph.
+type ReshareMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	create_time              *time.Time
+	update_time              *time.Time
+	status                   *schema.ShareRefreshStatus
+	coordinator_index        *uint64
+	addcoordinator_index     *int64
+	keyshares                *[][]uint8
+	appendkeyshares          [][]uint8
+	dealers                  *[]string
+	appenddealers            []string
+	contributions            *[][]uint8
+	appendcontributions      [][]uint8
+	previous_keyshares       *[]schema.ResharedKeyshare
+	appendprevious_keyshares []schema.ResharedKeyshare
+	clearedFields            map[string]struct{}
+	done                     bool
+	oldValue                 func(context.Context) (*Reshare, error)
+	predicates               []predicate.Reshare
+}
+
+var _ ent.Mutation = (*ReshareMutation)(nil)
+
+// reshareOption allows management of the mutation configuration using functional options.
+type reshareOption func(*ReshareMutation)
+
+// newReshareMutation creates new mutation for the Reshare entity.
+func newReshareMutation(c config, op Op, opts ...reshareOption) *ReshareMutation {
+	m := &ReshareMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReshare,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReshareID sets the ID field of the mutation.
+func withReshareID(id uuid.UUID) reshareOption {
+	return func(m *ReshareMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Reshare
+		)
+		m.oldValue = func(ctx context.Context) (*Reshare, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Reshare.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReshare sets the old Reshare of the mutation.
+func withReshare(node *Reshare) reshareOption {
+	return func(m *ReshareMutation) {
+		m.oldValue = func(context.Context) (*Reshare, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReshareMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReshareMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Reshare entities.
+func (m *ReshareMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReshareMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReshareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Reshare.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ReshareMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ReshareMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Reshare entity.
+// If the Reshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReshareMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ReshareMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ReshareMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ReshareMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Reshare entity.
+// If the Reshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReshareMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ReshareMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ReshareMutation) SetStatus(srs schema.ShareRefreshStatus) {
+	m.status = &srs
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReshareMutation) Status() (r schema.ShareRefreshStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Reshare entity.
+// If the Reshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReshareMutation) OldStatus(ctx context.Context) (v schema.ShareRefreshStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReshareMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCoordinatorIndex sets the "coordinator_index" field.
+func (m *ReshareMutation) SetCoordinatorIndex(u uint64) {
+	m.coordinator_index = &u
+	m.addcoordinator_index = nil
+}
+
+// CoordinatorIndex returns the value of the "coordinator_index" field in the mutation.
+func (m *ReshareMutation) CoordinatorIndex() (r uint64, exists bool) {
+	v := m.coordinator_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoordinatorIndex returns the old "coordinator_index" field's value of the Reshare entity.
+// If the Reshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReshareMutation) OldCoordinatorIndex(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoordinatorIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoordinatorIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoordinatorIndex: %w", err)
+	}
+	return oldValue.CoordinatorIndex, nil
+}
+
+// AddCoordinatorIndex adds u to the "coordinator_index" field.
+func (m *ReshareMutation) AddCoordinatorIndex(u int64) {
+	if m.addcoordinator_index != nil {
+		*m.addcoordinator_index += u
+	} else {
+		m.addcoordinator_index = &u
+	}
+}
+
+// AddedCoordinatorIndex returns the value that was added to the "coordinator_index" field in this mutation.
+func (m *ReshareMutation) AddedCoordinatorIndex() (r int64, exists bool) {
+	v := m.addcoordinator_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCoordinatorIndex resets all changes to the "coordinator_index" field.
+func (m *ReshareMutation) ResetCoordinatorIndex() {
+	m.coordinator_index = nil
+	m.addcoordinator_index = nil
+}
+
+// SetKeyshares sets the "keyshares" field.
+func (m *ReshareMutation) SetKeyshares(u [][]uint8) {
+	m.keyshares = &u
+	m.appendkeyshares = nil
+}
+
+// Keyshares returns the value of the "keyshares" field in the mutation.
+func (m *ReshareMutation) Keyshares() (r [][]uint8, exists bool) {
+	v := m.keyshares
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyshares returns the old "keyshares" field's value of the Reshare entity.
+// If the Reshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReshareMutation) OldKeyshares(ctx context.Context) (v [][]uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyshares is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyshares requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyshares: %w", err)
+	}
+	return oldValue.Keyshares, nil
+}
+
+// AppendKeyshares adds u to the "keyshares" field.
+func (m *ReshareMutation) AppendKeyshares(u [][]uint8) {
+	m.appendkeyshares = append(m.appendkeyshares, u...)
+}
+
+// AppendedKeyshares returns the list of values that were appended to the "keyshares" field in this mutation.
+func (m *ReshareMutation) AppendedKeyshares() ([][]uint8, bool) {
+	if len(m.appendkeyshares) == 0 {
+		return nil, false
+	}
+	return m.appendkeyshares, true
+}
+
+// ResetKeyshares resets all changes to the "keyshares" field.
+func (m *ReshareMutation) ResetKeyshares() {
+	m.keyshares = nil
+	m.appendkeyshares = nil
+}
+
+// SetDealers sets the "dealers" field.
+func (m *ReshareMutation) SetDealers(s []string) {
+	m.dealers = &s
+	m.appenddealers = nil
+}
+
+// Dealers returns the value of the "dealers" field in the mutation.
+func (m *ReshareMutation) Dealers() (r []string, exists bool) {
+	v := m.dealers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDealers returns the old "dealers" field's value of the Reshare entity.
+// If the Reshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReshareMutation) OldDealers(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDealers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDealers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDealers: %w", err)
+	}
+	return oldValue.Dealers, nil
+}
+
+// AppendDealers adds s to the "dealers" field.
+func (m *ReshareMutation) AppendDealers(s []string) {
+	m.appenddealers = append(m.appenddealers, s...)
+}
+
+// AppendedDealers returns the list of values that were appended to the "dealers" field in this mutation.
+func (m *ReshareMutation) AppendedDealers() ([]string, bool) {
+	if len(m.appenddealers) == 0 {
+		return nil, false
+	}
+	return m.appenddealers, true
+}
+
+// ResetDealers resets all changes to the "dealers" field.
+func (m *ReshareMutation) ResetDealers() {
+	m.dealers = nil
+	m.appenddealers = nil
+}
+
+// SetContributions sets the "contributions" field.
+func (m *ReshareMutation) SetContributions(u [][]uint8) {
+	m.contributions = &u
+	m.appendcontributions = nil
+}
+
+// Contributions returns the value of the "contributions" field in the mutation.
+func (m *ReshareMutation) Contributions() (r [][]uint8, exists bool) {
+	v := m.contributions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContributions returns the old "contributions" field's value of the Reshare entity.
+// If the Reshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReshareMutation) OldContributions(ctx context.Context) (v [][]uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContributions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContributions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContributions: %w", err)
+	}
+	return oldValue.Contributions, nil
+}
+
+// AppendContributions adds u to the "contributions" field.
+func (m *ReshareMutation) AppendContributions(u [][]uint8) {
+	m.appendcontributions = append(m.appendcontributions, u...)
+}
+
+// AppendedContributions returns the list of values that were appended to the "contributions" field in this mutation.
+func (m *ReshareMutation) AppendedContributions() ([][]uint8, bool) {
+	if len(m.appendcontributions) == 0 {
+		return nil, false
+	}
+	return m.appendcontributions, true
+}
+
+// ClearContributions clears the value of the "contributions" field.
+func (m *ReshareMutation) ClearContributions() {
+	m.contributions = nil
+	m.appendcontributions = nil
+	m.clearedFields[reshare.FieldContributions] = struct{}{}
+}
+
+// ContributionsCleared returns if the "contributions" field was cleared in this mutation.
+func (m *ReshareMutation) ContributionsCleared() bool {
+	_, ok := m.clearedFields[reshare.FieldContributions]
+	return ok
+}
+
+// ResetContributions resets all changes to the "contributions" field.
+func (m *ReshareMutation) ResetContributions() {
+	m.contributions = nil
+	m.appendcontributions = nil
+	delete(m.clearedFields, reshare.FieldContributions)
+}
+
+// SetPreviousKeyshares sets the "previous_keyshares" field.
+func (m *ReshareMutation) SetPreviousKeyshares(sk []schema.ResharedKeyshare) {
+	m.previous_keyshares = &sk
+	m.appendprevious_keyshares = nil
+}
+
+// PreviousKeyshares returns the value of the "previous_keyshares" field in the mutation.
+func (m *ReshareMutation) PreviousKeyshares() (r []schema.ResharedKeyshare, exists bool) {
+	v := m.previous_keyshares
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousKeyshares returns the old "previous_keyshares" field's value of the Reshare entity.
+// If the Reshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReshareMutation) OldPreviousKeyshares(ctx context.Context) (v []schema.ResharedKeyshare, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousKeyshares is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousKeyshares requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousKeyshares: %w", err)
+	}
+	return oldValue.PreviousKeyshares, nil
+}
+
+// AppendPreviousKeyshares adds sk to the "previous_keyshares" field.
+func (m *ReshareMutation) AppendPreviousKeyshares(sk []schema.ResharedKeyshare) {
+	m.appendprevious_keyshares = append(m.appendprevious_keyshares, sk...)
+}
+
+// AppendedPreviousKeyshares returns the list of values that were appended to the "previous_keyshares" field in this mutation.
+func (m *ReshareMutation) AppendedPreviousKeyshares() ([]schema.ResharedKeyshare, bool) {
+	if len(m.appendprevious_keyshares) == 0 {
+		return nil, false
+	}
+	return m.appendprevious_keyshares, true
+}
+
+// ClearPreviousKeyshares clears the value of the "previous_keyshares" field.
+func (m *ReshareMutation) ClearPreviousKeyshares() {
+	m.previous_keyshares = nil
+	m.appendprevious_keyshares = nil
+	m.clearedFields[reshare.FieldPreviousKeyshares] = struct{}{}
+}
+
+// PreviousKeysharesCleared returns if the "previous_keyshares" field was cleared in this mutation.
+func (m *ReshareMutation) PreviousKeysharesCleared() bool {
+	_, ok := m.clearedFields[reshare.FieldPreviousKeyshares]
+	return ok
+}
+
+// ResetPreviousKeyshares resets all changes to the "previous_keyshares" field.
+func (m *ReshareMutation) ResetPreviousKeyshares() {
+	m.previous_keyshares = nil
+	m.appendprevious_keyshares = nil
+	delete(m.clearedFields, reshare.FieldPreviousKeyshares)
+}
+
+// Where appends a list predicates to the ReshareMutation builder.
+func (m *ReshareMutation) Where(ps ...predicate.Reshare) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReshareMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReshareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Reshare, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReshareMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReshareMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Reshare).
+func (m *ReshareMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReshareMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, reshare.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, reshare.FieldUpdateTime)
+	}
+	if m.status != nil {
+		fields = append(fields, reshare.FieldStatus)
+	}
+	if m.coordinator_index != nil {
+		fields = append(fields, reshare.FieldCoordinatorIndex)
+	}
+	if m.keyshares != nil {
+		fields = append(fields, reshare.FieldKeyshares)
+	}
+	if m.dealers != nil {
+		fields = append(fields, reshare.FieldDealers)
+	}
+	if m.contributions != nil {
+		fields = append(fields, reshare.FieldContributions)
+	}
+	if m.previous_keyshares != nil {
+		fields = append(fields, reshare.FieldPreviousKeyshares)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReshareMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reshare.FieldCreateTime:
+		return m.CreateTime()
+	case reshare.FieldUpdateTime:
+		return m.UpdateTime()
+	case reshare.FieldStatus:
+		return m.Status()
+	case reshare.FieldCoordinatorIndex:
+		return m.CoordinatorIndex()
+	case reshare.FieldKeyshares:
+		return m.Keyshares()
+	case reshare.FieldDealers:
+		return m.Dealers()
+	case reshare.FieldContributions:
+		return m.Contributions()
+	case reshare.FieldPreviousKeyshares:
+		return m.PreviousKeyshares()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReshareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reshare.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case reshare.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case reshare.FieldStatus:
+		return m.OldStatus(ctx)
+	case reshare.FieldCoordinatorIndex:
+		return m.OldCoordinatorIndex(ctx)
+	case reshare.FieldKeyshares:
+		return m.OldKeyshares(ctx)
+	case reshare.FieldDealers:
+		return m.OldDealers(ctx)
+	case reshare.FieldContributions:
+		return m.OldContributions(ctx)
+	case reshare.FieldPreviousKeyshares:
+		return m.OldPreviousKeyshares(ctx)
+	}
+	return nil, fmt.Errorf("unknown Reshare field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReshareMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reshare.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case reshare.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case reshare.FieldStatus:
+		v, ok := value.(schema.ShareRefreshStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case reshare.FieldCoordinatorIndex:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoordinatorIndex(v)
+		return nil
+	case reshare.FieldKeyshares:
+		v, ok := value.([][]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyshares(v)
+		return nil
+	case reshare.FieldDealers:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDealers(v)
+		return nil
+	case reshare.FieldContributions:
+		v, ok := value.([][]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContributions(v)
+		return nil
+	case reshare.FieldPreviousKeyshares:
+		v, ok := value.([]schema.ResharedKeyshare)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousKeyshares(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Reshare field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReshareMutation) AddedFields() []string {
+	var fields []string
+	if m.addcoordinator_index != nil {
+		fields = append(fields, reshare.FieldCoordinatorIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReshareMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case reshare.FieldCoordinatorIndex:
+		return m.AddedCoordinatorIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReshareMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case reshare.FieldCoordinatorIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCoordinatorIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Reshare numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReshareMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reshare.FieldContributions) {
+		fields = append(fields, reshare.FieldContributions)
+	}
+	if m.FieldCleared(reshare.FieldPreviousKeyshares) {
+		fields = append(fields, reshare.FieldPreviousKeyshares)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReshareMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReshareMutation) ClearField(name string) error {
+	switch name {
+	case reshare.FieldContributions:
+		m.ClearContributions()
+		return nil
+	case reshare.FieldPreviousKeyshares:
+		m.ClearPreviousKeyshares()
+		return nil
+	}
+	return fmt.Errorf("unknown Reshare nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReshareMutation) ResetField(name string) error {
+	switch name {
+	case reshare.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case reshare.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case reshare.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case reshare.FieldCoordinatorIndex:
+		m.ResetCoordinatorIndex()
+		return nil
+	case reshare.FieldKeyshares:
+		m.ResetKeyshares()
+		return nil
+	case reshare.FieldDealers:
+		m.ResetDealers()
+		return nil
+	case reshare.FieldContributions:
+		m.ResetContributions()
+		return nil
+	case reshare.FieldPreviousKeyshares:
+		m.ResetPreviousKeyshares()
+		return nil
+	}
+	return fmt.Errorf("unknown Reshare field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReshareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReshareMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReshareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReshareMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReshareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReshareMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReshareMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Reshare unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReshareMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Reshare edge %s", name)
+}
+
+// SessionRevocationMutation represents an operation that mutates the SessionRevocation nodes in the graph.
+type SessionRevocationMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	create_time         *time.Time
+	update_time         *time.Time
+	identity_public_key *[]byte
+	session_id          *[]byte
+	expiration_time     *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*SessionRevocation, error)
+	predicates          []predicate.SessionRevocation
+}
+
+var _ ent.Mutation = (*SessionRevocationMutation)(nil)
+
+// sessionrevocationOption allows management of the mutation configuration using functional options.
+type sessionrevocationOption func(*SessionRevocationMutation)
+
+// newSessionRevocationMutation creates new mutation for the SessionRevocation entity.
+func newSessionRevocationMutation(c config, op Op, opts ...sessionrevocationOption) *SessionRevocationMutation {
+	m := &SessionRevocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSessionRevocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionRevocationID sets the ID field of the mutation.
+func withSessionRevocationID(id uuid.UUID) sessionrevocationOption {
+	return func(m *SessionRevocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SessionRevocation
+		)
+		m.oldValue = func(ctx context.Context) (*SessionRevocation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SessionRevocation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSessionRevocation sets the old SessionRevocation of the mutation.
+func withSessionRevocation(node *SessionRevocation) sessionrevocationOption {
+	return func(m *SessionRevocationMutation) {
+		m.oldValue = func(context.Context) (*SessionRevocation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionRevocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionRevocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SessionRevocation entities.
+func (m *SessionRevocationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionRevocationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionRevocationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SessionRevocation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SessionRevocationMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SessionRevocationMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SessionRevocation entity.
+// If the SessionRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionRevocationMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SessionRevocationMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SessionRevocationMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SessionRevocationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SessionRevocation entity.
+// If the SessionRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionRevocationMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SessionRevocationMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetIdentityPublicKey sets the "identity_public_key" field.
+func (m *SessionRevocationMutation) SetIdentityPublicKey(b []byte) {
+	m.identity_public_key = &b
+}
+
+// IdentityPublicKey returns the value of the "identity_public_key" field in the mutation.
+func (m *SessionRevocationMutation) IdentityPublicKey() (r []byte, exists bool) {
+	v := m.identity_public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdentityPublicKey returns the old "identity_public_key" field's value of the SessionRevocation entity.
+// If the SessionRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionRevocationMutation) OldIdentityPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdentityPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdentityPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdentityPublicKey: %w", err)
+	}
+	return oldValue.IdentityPublicKey, nil
+}
+
+// ResetIdentityPublicKey resets all changes to the "identity_public_key" field.
+func (m *SessionRevocationMutation) ResetIdentityPublicKey() {
+	m.identity_public_key = nil
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *SessionRevocationMutation) SetSessionID(b []byte) {
+	m.session_id = &b
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *SessionRevocationMutation) SessionID() (r []byte, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the SessionRevocation entity.
+// If the SessionRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionRevocationMutation) OldSessionID(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (m *SessionRevocationMutation) ClearSessionID() {
+	m.session_id = nil
+	m.clearedFields[sessionrevocation.FieldSessionID] = struct{}{}
+}
+
+// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
+func (m *SessionRevocationMutation) SessionIDCleared() bool {
+	_, ok := m.clearedFields[sessionrevocation.FieldSessionID]
+	return ok
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *SessionRevocationMutation) ResetSessionID() {
+	m.session_id = nil
+	delete(m.clearedFields, sessionrevocation.FieldSessionID)
+}
+
+// SetExpirationTime sets the "expiration_time" field.
+func (m *SessionRevocationMutation) SetExpirationTime(t time.Time) {
+	m.expiration_time = &t
+}
+
+// ExpirationTime returns the value of the "expiration_time" field in the mutation.
+func (m *SessionRevocationMutation) ExpirationTime() (r time.Time, exists bool) {
+	v := m.expiration_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpirationTime returns the old "expiration_time" field's value of the SessionRevocation entity.
+// If the SessionRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionRevocationMutation) OldExpirationTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpirationTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpirationTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpirationTime: %w", err)
+	}
+	return oldValue.ExpirationTime, nil
+}
+
+// ResetExpirationTime resets all changes to the "expiration_time" field.
+func (m *SessionRevocationMutation) ResetExpirationTime() {
+	m.expiration_time = nil
+}
+
+// Where appends a list predicates to the SessionRevocationMutation builder.
+func (m *SessionRevocationMutation) Where(ps ...predicate.SessionRevocation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionRevocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionRevocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SessionRevocation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionRevocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionRevocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SessionRevocation).
+func (m *SessionRevocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionRevocationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, sessionrevocation.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, sessionrevocation.FieldUpdateTime)
+	}
+	if m.identity_public_key != nil {
+		fields = append(fields, sessionrevocation.FieldIdentityPublicKey)
+	}
+	if m.session_id != nil {
+		fields = append(fields, sessionrevocation.FieldSessionID)
+	}
+	if m.expiration_time != nil {
+		fields = append(fields, sessionrevocation.FieldExpirationTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionRevocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sessionrevocation.FieldCreateTime:
+		return m.CreateTime()
+	case sessionrevocation.FieldUpdateTime:
+		return m.UpdateTime()
+	case sessionrevocation.FieldIdentityPublicKey:
+		return m.IdentityPublicKey()
+	case sessionrevocation.FieldSessionID:
+		return m.SessionID()
+	case sessionrevocation.FieldExpirationTime:
+		return m.ExpirationTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionRevocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sessionrevocation.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case sessionrevocation.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case sessionrevocation.FieldIdentityPublicKey:
+		return m.OldIdentityPublicKey(ctx)
+	case sessionrevocation.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case sessionrevocation.FieldExpirationTime:
+		return m.OldExpirationTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown SessionRevocation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionRevocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sessionrevocation.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case sessionrevocation.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case sessionrevocation.FieldIdentityPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdentityPublicKey(v)
+		return nil
+	case sessionrevocation.FieldSessionID:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case sessionrevocation.FieldExpirationTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpirationTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SessionRevocation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionRevocationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionRevocationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionRevocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SessionRevocation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionRevocationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sessionrevocation.FieldSessionID) {
+		fields = append(fields, sessionrevocation.FieldSessionID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionRevocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionRevocationMutation) ClearField(name string) error {
+	switch name {
+	case sessionrevocation.FieldSessionID:
+		m.ClearSessionID()
+		return nil
+	}
+	return fmt.Errorf("unknown SessionRevocation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionRevocationMutation) ResetField(name string) error {
+	switch name {
+	case sessionrevocation.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case sessionrevocation.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case sessionrevocation.FieldIdentityPublicKey:
+		m.ResetIdentityPublicKey()
+		return nil
+	case sessionrevocation.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case sessionrevocation.FieldExpirationTime:
+		m.ResetExpirationTime()
+		return nil
+	}
+	return fmt.Errorf("unknown SessionRevocation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionRevocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionRevocationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionRevocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionRevocationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionRevocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionRevocationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionRevocationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SessionRevocation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionRevocationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SessionRevocation edge %s", name)
+}
+
+// ShareRefreshMutation represents an operation that mutates the ShareRefresh nodes in the graph.
+type ShareRefreshMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	create_time          *time.Time
+	update_time          *time.Time
+	status               *schema.ShareRefreshStatus
+	coordinator_index    *uint64
+	addcoordinator_index *int64
+	keyshare_ids         *[]uuid.UUID
+	appendkeyshare_ids   []uuid.UUID
+	contributions        *[][]uint8
+	appendcontributions  [][]uint8
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*ShareRefresh, error)
+	predicates           []predicate.ShareRefresh
+}
+
+var _ ent.Mutation = (*ShareRefreshMutation)(nil)
+
+// sharerefreshOption allows management of the mutation configuration using functional options.
+type sharerefreshOption func(*ShareRefreshMutation)
+
+// newShareRefreshMutation creates new mutation for the ShareRefresh entity.
+func newShareRefreshMutation(c config, op Op, opts ...sharerefreshOption) *ShareRefreshMutation {
+	m := &ShareRefreshMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShareRefresh,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShareRefreshID sets the ID field of the mutation.
+func withShareRefreshID(id uuid.UUID) sharerefreshOption {
+	return func(m *ShareRefreshMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ShareRefresh
+		)
+		m.oldValue = func(ctx context.Context) (*ShareRefresh, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ShareRefresh.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShareRefresh sets the old ShareRefresh of the mutation.
+func withShareRefresh(node *ShareRefresh) sharerefreshOption {
+	return func(m *ShareRefreshMutation) {
+		m.oldValue = func(context.Context) (*ShareRefresh, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShareRefreshMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShareRefreshMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ShareRefresh entities.
+func (m *ShareRefreshMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShareRefreshMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShareRefreshMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ShareRefresh.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ShareRefreshMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ShareRefreshMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ShareRefresh entity.
+// If the ShareRefresh object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareRefreshMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ShareRefreshMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ShareRefreshMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ShareRefreshMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ShareRefresh entity.
+// If the ShareRefresh object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareRefreshMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ShareRefreshMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ShareRefreshMutation) SetStatus(srs schema.ShareRefreshStatus) {
+	m.status = &srs
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ShareRefreshMutation) Status() (r schema.ShareRefreshStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ShareRefresh entity.
+// If the ShareRefresh object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareRefreshMutation) OldStatus(ctx context.Context) (v schema.ShareRefreshStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ShareRefreshMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCoordinatorIndex sets the "coordinator_index" field.
+func (m *ShareRefreshMutation) SetCoordinatorIndex(u uint64) {
+	m.coordinator_index = &u
+	m.addcoordinator_index = nil
+}
+
+// CoordinatorIndex returns the value of the "coordinator_index" field in the mutation.
+func (m *ShareRefreshMutation) CoordinatorIndex() (r uint64, exists bool) {
+	v := m.coordinator_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoordinatorIndex returns the old "coordinator_index" field's value of the ShareRefresh entity.
+// If the ShareRefresh object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareRefreshMutation) OldCoordinatorIndex(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoordinatorIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoordinatorIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoordinatorIndex: %w", err)
+	}
+	return oldValue.CoordinatorIndex, nil
+}
+
+// AddCoordinatorIndex adds u to the "coordinator_index" field.
+func (m *ShareRefreshMutation) AddCoordinatorIndex(u int64) {
+	if m.addcoordinator_index != nil {
+		*m.addcoordinator_index += u
+	} else {
+		m.addcoordinator_index = &u
+	}
+}
+
+// AddedCoordinatorIndex returns the value that was added to the "coordinator_index" field in this mutation.
+func (m *ShareRefreshMutation) AddedCoordinatorIndex() (r int64, exists bool) {
+	v := m.addcoordinator_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCoordinatorIndex resets all changes to the "coordinator_index" field.
+func (m *ShareRefreshMutation) ResetCoordinatorIndex() {
+	m.coordinator_index = nil
+	m.addcoordinator_index = nil
+}
+
+// SetKeyshareIds sets the "keyshare_ids" field.
+func (m *ShareRefreshMutation) SetKeyshareIds(u []uuid.UUID) {
+	m.keyshare_ids = &u
+	m.appendkeyshare_ids = nil
+}
+
+// KeyshareIds returns the value of the "keyshare_ids" field in the mutation.
+func (m *ShareRefreshMutation) KeyshareIds() (r []uuid.UUID, exists bool) {
+	v := m.keyshare_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyshareIds returns the old "keyshare_ids" field's value of the ShareRefresh entity.
+// If the ShareRefresh object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareRefreshMutation) OldKeyshareIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyshareIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyshareIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyshareIds: %w", err)
+	}
+	return oldValue.KeyshareIds, nil
+}
+
+// AppendKeyshareIds adds u to the "keyshare_ids" field.
+func (m *ShareRefreshMutation) AppendKeyshareIds(u []uuid.UUID) {
+	m.appendkeyshare_ids = append(m.appendkeyshare_ids, u...)
+}
+
+// AppendedKeyshareIds returns the list of values that were appended to the "keyshare_ids" field in this mutation.
+func (m *ShareRefreshMutation) AppendedKeyshareIds() ([]uuid.UUID, bool) {
+	if len(m.appendkeyshare_ids) == 0 {
+		return nil, false
+	}
+	return m.appendkeyshare_ids, true
+}
+
+// ResetKeyshareIds resets all changes to the "keyshare_ids" field.
+func (m *ShareRefreshMutation) ResetKeyshareIds() {
+	m.keyshare_ids = nil
+	m.appendkeyshare_ids = nil
+}
+
+// SetContributions sets the "contributions" field.
+func (m *ShareRefreshMutation) SetContributions(u [][]uint8) {
+	m.contributions = &u
+	m.appendcontributions = nil
+}
+
+// Contributions returns the value of the "contributions" field in the mutation.
+func (m *ShareRefreshMutation) Contributions() (r [][]uint8, exists bool) {
+	v := m.contributions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContributions returns the old "contributions" field's value of the ShareRefresh entity.
+// If the ShareRefresh object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareRefreshMutation) OldContributions(ctx context.Context) (v [][]uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContributions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContributions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContributions: %w", err)
+	}
+	return oldValue.Contributions, nil
+}
+
+// AppendContributions adds u to the "contributions" field.
+func (m *ShareRefreshMutation) AppendContributions(u [][]uint8) {
+	m.appendcontributions = append(m.appendcontributions, u...)
+}
+
+// AppendedContributions returns the list of values that were appended to the "contributions" field in this mutation.
+func (m *ShareRefreshMutation) AppendedContributions() ([][]uint8, bool) {
+	if len(m.appendcontributions) == 0 {
+		return nil, false
+	}
+	return m.appendcontributions, true
+}
+
+// ClearContributions clears the value of the "contributions" field.
+func (m *ShareRefreshMutation) ClearContributions() {
+	m.contributions = nil
+	m.appendcontributions = nil
+	m.clearedFields[sharerefresh.FieldContributions] = struct{}{}
+}
+
+// ContributionsCleared returns if the "contributions" field was cleared in this mutation.
+func (m *ShareRefreshMutation) ContributionsCleared() bool {
+	_, ok := m.clearedFields[sharerefresh.FieldContributions]
+	return ok
+}
+
+// ResetContributions resets all changes to the "contributions" field.
+func (m *ShareRefreshMutation) ResetContributions() {
+	m.contributions = nil
+	m.appendcontributions = nil
+	delete(m.clearedFields, sharerefresh.FieldContributions)
+}
+
+// Where appends a list predicates to the ShareRefreshMutation builder.
+func (m *ShareRefreshMutation) Where(ps ...predicate.ShareRefresh) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShareRefreshMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShareRefreshMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShareRefresh, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShareRefreshMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShareRefreshMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ShareRefresh).
+func (m *ShareRefreshMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShareRefreshMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, sharerefresh.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, sharerefresh.FieldUpdateTime)
+	}
+	if m.status != nil {
+		fields = append(fields, sharerefresh.FieldStatus)
+	}
+	if m.coordinator_index != nil {
+		fields = append(fields, sharerefresh.FieldCoordinatorIndex)
+	}
+	if m.keyshare_ids != nil {
+		fields = append(fields, sharerefresh.FieldKeyshareIds)
+	}
+	if m.contributions != nil {
+		fields = append(fields, sharerefresh.FieldContributions)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShareRefreshMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharerefresh.FieldCreateTime:
+		return m.CreateTime()
+	case sharerefresh.FieldUpdateTime:
+		return m.UpdateTime()
+	case sharerefresh.FieldStatus:
+		return m.Status()
+	case sharerefresh.FieldCoordinatorIndex:
+		return m.CoordinatorIndex()
+	case sharerefresh.FieldKeyshareIds:
+		return m.KeyshareIds()
+	case sharerefresh.FieldContributions:
+		return m.Contributions()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShareRefreshMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharerefresh.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case sharerefresh.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case sharerefresh.FieldStatus:
+		return m.OldStatus(ctx)
+	case sharerefresh.FieldCoordinatorIndex:
+		return m.OldCoordinatorIndex(ctx)
+	case sharerefresh.FieldKeyshareIds:
+		return m.OldKeyshareIds(ctx)
+	case sharerefresh.FieldContributions:
+		return m.OldContributions(ctx)
+	}
+	return nil, fmt.Errorf("unknown ShareRefresh field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareRefreshMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sharerefresh.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case sharerefresh.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case sharerefresh.FieldStatus:
+		v, ok := value.(schema.ShareRefreshStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case sharerefresh.FieldCoordinatorIndex:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoordinatorIndex(v)
+		return nil
+	case sharerefresh.FieldKeyshareIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyshareIds(v)
+		return nil
+	case sharerefresh.FieldContributions:
+		v, ok := value.([][]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContributions(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareRefresh field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShareRefreshMutation) AddedFields() []string {
+	var fields []string
+	if m.addcoordinator_index != nil {
+		fields = append(fields, sharerefresh.FieldCoordinatorIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShareRefreshMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sharerefresh.FieldCoordinatorIndex:
+		return m.AddedCoordinatorIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareRefreshMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sharerefresh.FieldCoordinatorIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCoordinatorIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareRefresh numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShareRefreshMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sharerefresh.FieldContributions) {
+		fields = append(fields, sharerefresh.FieldContributions)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShareRefreshMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShareRefreshMutation) ClearField(name string) error {
+	switch name {
+	case sharerefresh.FieldContributions:
+		m.ClearContributions()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareRefresh nullable field %s", name)
 }
 
-// OwnerIdentityPubkey returns the value of the "owner_identity_pubkey" field in the mutation.
-func (m *DepositAddressMutation) OwnerIdentityPubkey() (r []byte, exists bool) {
-	v := m.owner_identity_pubkey
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShareRefreshMutation) ResetField(name string) error {
+	switch name {
+	case sharerefresh.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case sharerefresh.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case sharerefresh.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case sharerefresh.FieldCoordinatorIndex:
+		m.ResetCoordinatorIndex()
+		return nil
+	case sharerefresh.FieldKeyshareIds:
+		m.ResetKeyshareIds()
+		return nil
+	case sharerefresh.FieldContributions:
+		m.ResetContributions()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareRefresh field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShareRefreshMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShareRefreshMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShareRefreshMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShareRefreshMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShareRefreshMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShareRefreshMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShareRefreshMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ShareRefresh unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShareRefreshMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ShareRefresh edge %s", name)
+}
+
+// SigningIncidentMutation represents an operation that mutates the SigningIncident nodes in the graph.
+type SigningIncidentMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	create_time         *time.Time
+	update_time         *time.Time
+	operator_identifier *string
+	keyshare_id         *uuid.UUID
+	job_id              *string
+	message             *[]byte
+	verifying_key       *[]byte
+	public_share        *[]byte
+	signature_share     *[]byte
+	commitments         *map[string][]uint8
+	user_commitment     *[]byte
+	reason              *string
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*SigningIncident, error)
+	predicates          []predicate.SigningIncident
+}
+
+var _ ent.Mutation = (*SigningIncidentMutation)(nil)
+
+// signingincidentOption allows management of the mutation configuration using functional options.
+type signingincidentOption func(*SigningIncidentMutation)
+
+// newSigningIncidentMutation creates new mutation for the SigningIncident entity.
+func newSigningIncidentMutation(c config, op Op, opts ...signingincidentOption) *SigningIncidentMutation {
+	m := &SigningIncidentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSigningIncident,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSigningIncidentID sets the ID field of the mutation.
+func withSigningIncidentID(id uuid.UUID) signingincidentOption {
+	return func(m *SigningIncidentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SigningIncident
+		)
+		m.oldValue = func(ctx context.Context) (*SigningIncident, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SigningIncident.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSigningIncident sets the old SigningIncident of the mutation.
+func withSigningIncident(node *SigningIncident) signingincidentOption {
+	return func(m *SigningIncidentMutation) {
+		m.oldValue = func(context.Context) (*SigningIncident, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SigningIncidentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SigningIncidentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SigningIncident entities.
+func (m *SigningIncidentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SigningIncidentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SigningIncidentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SigningIncident.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SigningIncidentMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SigningIncidentMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerIdentityPubkey returns the old "owner_identity_pubkey" field's value of the DepositAddress entity.
-// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepositAddressMutation) OldOwnerIdentityPubkey(ctx context.Context) (v []byte, err error) {
+func (m *SigningIncidentMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerIdentityPubkey is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerIdentityPubkey requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerIdentityPubkey: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.OwnerIdentityPubkey, nil
+	return oldValue.CreateTime, nil
 }
 
-// ResetOwnerIdentityPubkey resets all changes to the "owner_identity_pubkey" field.
-func (m *DepositAddressMutation) ResetOwnerIdentityPubkey() {
-	m.owner_identity_pubkey = nil
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SigningIncidentMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetOwnerSigningPubkey sets the "owner_signing_pubkey" field.
-func (m *DepositAddressMutation) SetOwnerSigningPubkey(b []byte) {
-	m.owner_signing_pubkey = &b
+// SetUpdateTime sets the "update_time" field.
+func (m *SigningIncidentMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// OwnerSigningPubkey returns the value of the "owner_signing_pubkey" field in the mutation.
-func (m *DepositAddressMutation) OwnerSigningPubkey() (r []byte, exists bool) {
-	v := m.owner_signing_pubkey
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SigningIncidentMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerSigningPubkey returns the old "owner_signing_pubkey" field's value of the DepositAddress entity.
-// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepositAddressMutation) OldOwnerSigningPubkey(ctx context.Context) (v []byte, err error) {
+func (m *SigningIncidentMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerSigningPubkey is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerSigningPubkey requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerSigningPubkey: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.OwnerSigningPubkey, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ResetOwnerSigningPubkey resets all changes to the "owner_signing_pubkey" field.
-func (m *DepositAddressMutation) ResetOwnerSigningPubkey() {
-	m.owner_signing_pubkey = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SigningIncidentMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetConfirmationHeight sets the "confirmation_height" field.
-func (m *DepositAddressMutation) SetConfirmationHeight(i int64) {
-	m.confirmation_height = &i
-	m.addconfirmation_height = nil
+// SetOperatorIdentifier sets the "operator_identifier" field.
+func (m *SigningIncidentMutation) SetOperatorIdentifier(s string) {
+	m.operator_identifier = &s
 }
 
-// ConfirmationHeight returns the value of the "confirmation_height" field in the mutation.
-func (m *DepositAddressMutation) ConfirmationHeight() (r int64, exists bool) {
-	v := m.confirmation_height
+// OperatorIdentifier returns the value of the "operator_identifier" field in the mutation.
+func (m *SigningIncidentMutation) OperatorIdentifier() (r string, exists bool) {
+	v := m.operator_identifier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfirmationHeight returns the old "confirmation_height" field's value of the DepositAddress entity.
-// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// OldOperatorIdentifier returns the old "operator_identifier" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepositAddressMutation) OldConfirmationHeight(ctx context.Context) (v int64, err error) {
+func (m *SigningIncidentMutation) OldOperatorIdentifier(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfirmationHeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperatorIdentifier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfirmationHeight requires an ID field in the mutation")
+		return v, errors.New("OldOperatorIdentifier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfirmationHeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperatorIdentifier: %w", err)
 	}
-	return oldValue.ConfirmationHeight, nil
+	return oldValue.OperatorIdentifier, nil
 }
 
-// AddConfirmationHeight adds i to the "confirmation_height" field.
-func (m *DepositAddressMutation) AddConfirmationHeight(i int64) {
-	if m.addconfirmation_height != nil {
-		*m.addconfirmation_height += i
-	} else {
-		m.addconfirmation_height = &i
-	}
+// ResetOperatorIdentifier resets all changes to the "operator_identifier" field.
+func (m *SigningIncidentMutation) ResetOperatorIdentifier() {
+	m.operator_identifier = nil
 }
 
-// AddedConfirmationHeight returns the value that was added to the "confirmation_height" field in this mutation.
-func (m *DepositAddressMutation) AddedConfirmationHeight() (r int64, exists bool) {
-	v := m.addconfirmation_height
+// SetKeyshareID sets the "keyshare_id" field.
+func (m *SigningIncidentMutation) SetKeyshareID(u uuid.UUID) {
+	m.keyshare_id = &u
+}
+
+// KeyshareID returns the value of the "keyshare_id" field in the mutation.
+func (m *SigningIncidentMutation) KeyshareID() (r uuid.UUID, exists bool) {
+	v := m.keyshare_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearConfirmationHeight clears the value of the "confirmation_height" field.
-func (m *DepositAddressMutation) ClearConfirmationHeight() {
-	m.confirmation_height = nil
-	m.addconfirmation_height = nil
-	m.clearedFields[depositaddress.FieldConfirmationHeight] = struct{}{}
+// OldKeyshareID returns the old "keyshare_id" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningIncidentMutation) OldKeyshareID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyshareID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyshareID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyshareID: %w", err)
+	}
+	return oldValue.KeyshareID, nil
 }
 
-// ConfirmationHeightCleared returns if the "confirmation_height" field was cleared in this mutation.
-func (m *DepositAddressMutation) ConfirmationHeightCleared() bool {
-	_, ok := m.clearedFields[depositaddress.FieldConfirmationHeight]
-	return ok
+// ResetKeyshareID resets all changes to the "keyshare_id" field.
+func (m *SigningIncidentMutation) ResetKeyshareID() {
+	m.keyshare_id = nil
+}
+
+// SetJobID sets the "job_id" field.
+func (m *SigningIncidentMutation) SetJobID(s string) {
+	m.job_id = &s
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *SigningIncidentMutation) JobID() (r string, exists bool) {
+	v := m.job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningIncidentMutation) OldJobID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
 }
 
-// ResetConfirmationHeight resets all changes to the "confirmation_height" field.
-func (m *DepositAddressMutation) ResetConfirmationHeight() {
-	m.confirmation_height = nil
-	m.addconfirmation_height = nil
-	delete(m.clearedFields, depositaddress.FieldConfirmationHeight)
+// ResetJobID resets all changes to the "job_id" field.
+func (m *SigningIncidentMutation) ResetJobID() {
+	m.job_id = nil
 }
 
-// SetConfirmationTxid sets the "confirmation_txid" field.
-func (m *DepositAddressMutation) SetConfirmationTxid(s string) {
-	m.confirmation_txid = &s
+// SetMessage sets the "message" field.
+func (m *SigningIncidentMutation) SetMessage(b []byte) {
+	m.message = &b
 }
 
-// ConfirmationTxid returns the value of the "confirmation_txid" field in the mutation.
-func (m *DepositAddressMutation) ConfirmationTxid() (r string, exists bool) {
-	v := m.confirmation_txid
+// Message returns the value of the "message" field in the mutation.
+func (m *SigningIncidentMutation) Message() (r []byte, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfirmationTxid returns the old "confirmation_txid" field's value of the DepositAddress entity.
-// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepositAddressMutation) OldConfirmationTxid(ctx context.Context) (v string, err error) {
+func (m *SigningIncidentMutation) OldMessage(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfirmationTxid is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfirmationTxid requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfirmationTxid: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.ConfirmationTxid, nil
-}
-
-// ClearConfirmationTxid clears the value of the "confirmation_txid" field.
-func (m *DepositAddressMutation) ClearConfirmationTxid() {
-	m.confirmation_txid = nil
-	m.clearedFields[depositaddress.FieldConfirmationTxid] = struct{}{}
-}
-
-// ConfirmationTxidCleared returns if the "confirmation_txid" field was cleared in this mutation.
-func (m *DepositAddressMutation) ConfirmationTxidCleared() bool {
-	_, ok := m.clearedFields[depositaddress.FieldConfirmationTxid]
-	return ok
+	return oldValue.Message, nil
 }
 
-// ResetConfirmationTxid resets all changes to the "confirmation_txid" field.
-func (m *DepositAddressMutation) ResetConfirmationTxid() {
-	m.confirmation_txid = nil
-	delete(m.clearedFields, depositaddress.FieldConfirmationTxid)
+// ResetMessage resets all changes to the "message" field.
+func (m *SigningIncidentMutation) ResetMessage() {
+	m.message = nil
 }
 
-// SetNodeID sets the "node_id" field.
-func (m *DepositAddressMutation) SetNodeID(u uuid.UUID) {
-	m.node_id = &u
+// SetVerifyingKey sets the "verifying_key" field.
+func (m *SigningIncidentMutation) SetVerifyingKey(b []byte) {
+	m.verifying_key = &b
 }
 
-// NodeID returns the value of the "node_id" field in the mutation.
-func (m *DepositAddressMutation) NodeID() (r uuid.UUID, exists bool) {
-	v := m.node_id
+// VerifyingKey returns the value of the "verifying_key" field in the mutation.
+func (m *SigningIncidentMutation) VerifyingKey() (r []byte, exists bool) {
+	v := m.verifying_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNodeID returns the old "node_id" field's value of the DepositAddress entity.
-// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// OldVerifyingKey returns the old "verifying_key" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepositAddressMutation) OldNodeID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SigningIncidentMutation) OldVerifyingKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldVerifyingKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNodeID requires an ID field in the mutation")
+		return v, errors.New("OldVerifyingKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldVerifyingKey: %w", err)
 	}
-	return oldValue.NodeID, nil
-}
-
-// ClearNodeID clears the value of the "node_id" field.
-func (m *DepositAddressMutation) ClearNodeID() {
-	m.node_id = nil
-	m.clearedFields[depositaddress.FieldNodeID] = struct{}{}
-}
-
-// NodeIDCleared returns if the "node_id" field was cleared in this mutation.
-func (m *DepositAddressMutation) NodeIDCleared() bool {
-	_, ok := m.clearedFields[depositaddress.FieldNodeID]
-	return ok
+	return oldValue.VerifyingKey, nil
 }
 
-// ResetNodeID resets all changes to the "node_id" field.
-func (m *DepositAddressMutation) ResetNodeID() {
-	m.node_id = nil
-	delete(m.clearedFields, depositaddress.FieldNodeID)
+// ResetVerifyingKey resets all changes to the "verifying_key" field.
+func (m *SigningIncidentMutation) ResetVerifyingKey() {
+	m.verifying_key = nil
 }
 
-// SetIsStatic sets the "is_static" field.
-func (m *DepositAddressMutation) SetIsStatic(b bool) {
-	m.is_static = &b
+// SetPublicShare sets the "public_share" field.
+func (m *SigningIncidentMutation) SetPublicShare(b []byte) {
+	m.public_share = &b
 }
 
-// IsStatic returns the value of the "is_static" field in the mutation.
-func (m *DepositAddressMutation) IsStatic() (r bool, exists bool) {
-	v := m.is_static
+// PublicShare returns the value of the "public_share" field in the mutation.
+func (m *SigningIncidentMutation) PublicShare() (r []byte, exists bool) {
+	v := m.public_share
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsStatic returns the old "is_static" field's value of the DepositAddress entity.
-// If the DepositAddress object wasn't provided to the builder, the object is fetched from the database.
+// OldPublicShare returns the old "public_share" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepositAddressMutation) OldIsStatic(ctx context.Context) (v bool, err error) {
+func (m *SigningIncidentMutation) OldPublicShare(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsStatic is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublicShare is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsStatic requires an ID field in the mutation")
+		return v, errors.New("OldPublicShare requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsStatic: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublicShare: %w", err)
 	}
-	return oldValue.IsStatic, nil
+	return oldValue.PublicShare, nil
 }
 
-// ResetIsStatic resets all changes to the "is_static" field.
-func (m *DepositAddressMutation) ResetIsStatic() {
-	m.is_static = nil
+// ResetPublicShare resets all changes to the "public_share" field.
+func (m *SigningIncidentMutation) ResetPublicShare() {
+	m.public_share = nil
 }
 
-// SetSigningKeyshareID sets the "signing_keyshare" edge to the SigningKeyshare entity by id.
-func (m *DepositAddressMutation) SetSigningKeyshareID(id uuid.UUID) {
-	m.signing_keyshare = &id
+// SetSignatureShare sets the "signature_share" field.
+func (m *SigningIncidentMutation) SetSignatureShare(b []byte) {
+	m.signature_share = &b
 }
 
-// ClearSigningKeyshare clears the "signing_keyshare" edge to the SigningKeyshare entity.
-func (m *DepositAddressMutation) ClearSigningKeyshare() {
-	m.clearedsigning_keyshare = true
+// SignatureShare returns the value of the "signature_share" field in the mutation.
+func (m *SigningIncidentMutation) SignatureShare() (r []byte, exists bool) {
+	v := m.signature_share
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SigningKeyshareCleared reports if the "signing_keyshare" edge to the SigningKeyshare entity was cleared.
-func (m *DepositAddressMutation) SigningKeyshareCleared() bool {
-	return m.clearedsigning_keyshare
+// OldSignatureShare returns the old "signature_share" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningIncidentMutation) OldSignatureShare(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignatureShare is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignatureShare requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignatureShare: %w", err)
+	}
+	return oldValue.SignatureShare, nil
 }
 
-// SigningKeyshareID returns the "signing_keyshare" edge ID in the mutation.
-func (m *DepositAddressMutation) SigningKeyshareID() (id uuid.UUID, exists bool) {
-	if m.signing_keyshare != nil {
-		return *m.signing_keyshare, true
-	}
-	return
+// ResetSignatureShare resets all changes to the "signature_share" field.
+func (m *SigningIncidentMutation) ResetSignatureShare() {
+	m.signature_share = nil
 }
 
-// SigningKeyshareIDs returns the "signing_keyshare" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SigningKeyshareID instead. It exists only for internal usage by the builders.
-func (m *DepositAddressMutation) SigningKeyshareIDs() (ids []uuid.UUID) {
-	if id := m.signing_keyshare; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetCommitments sets the "commitments" field.
+func (m *SigningIncidentMutation) SetCommitments(value map[string][]uint8) {
+	m.commitments = &value
 }
 
-// ResetSigningKeyshare resets all changes to the "signing_keyshare" edge.
-func (m *DepositAddressMutation) ResetSigningKeyshare() {
-	m.signing_keyshare = nil
-	m.clearedsigning_keyshare = false
+// Commitments returns the value of the "commitments" field in the mutation.
+func (m *SigningIncidentMutation) Commitments() (r map[string][]uint8, exists bool) {
+	v := m.commitments
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddUtxoIDs adds the "utxo" edge to the Utxo entity by ids.
-func (m *DepositAddressMutation) AddUtxoIDs(ids ...uuid.UUID) {
-	if m.utxo == nil {
-		m.utxo = make(map[uuid.UUID]struct{})
+// OldCommitments returns the old "commitments" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningIncidentMutation) OldCommitments(ctx context.Context) (v map[string][]uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitments is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.utxo[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitments: %w", err)
 	}
+	return oldValue.Commitments, nil
 }
 
-// ClearUtxo clears the "utxo" edge to the Utxo entity.
-func (m *DepositAddressMutation) ClearUtxo() {
-	m.clearedutxo = true
+// ResetCommitments resets all changes to the "commitments" field.
+func (m *SigningIncidentMutation) ResetCommitments() {
+	m.commitments = nil
 }
 
-// UtxoCleared reports if the "utxo" edge to the Utxo entity was cleared.
-func (m *DepositAddressMutation) UtxoCleared() bool {
-	return m.clearedutxo
+// SetUserCommitment sets the "user_commitment" field.
+func (m *SigningIncidentMutation) SetUserCommitment(b []byte) {
+	m.user_commitment = &b
 }
 
-// RemoveUtxoIDs removes the "utxo" edge to the Utxo entity by IDs.
-func (m *DepositAddressMutation) RemoveUtxoIDs(ids ...uuid.UUID) {
-	if m.removedutxo == nil {
-		m.removedutxo = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.utxo, ids[i])
-		m.removedutxo[ids[i]] = struct{}{}
+// UserCommitment returns the value of the "user_commitment" field in the mutation.
+func (m *SigningIncidentMutation) UserCommitment() (r []byte, exists bool) {
+	v := m.user_commitment
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUtxo returns the removed IDs of the "utxo" edge to the Utxo entity.
-func (m *DepositAddressMutation) RemovedUtxoIDs() (ids []uuid.UUID) {
-	for id := range m.removedutxo {
-		ids = append(ids, id)
+// OldUserCommitment returns the old "user_commitment" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningIncidentMutation) OldUserCommitment(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserCommitment is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// UtxoIDs returns the "utxo" edge IDs in the mutation.
-func (m *DepositAddressMutation) UtxoIDs() (ids []uuid.UUID) {
-	for id := range m.utxo {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserCommitment requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserCommitment: %w", err)
+	}
+	return oldValue.UserCommitment, nil
 }
 
-// ResetUtxo resets all changes to the "utxo" edge.
-func (m *DepositAddressMutation) ResetUtxo() {
-	m.utxo = nil
-	m.clearedutxo = false
-	m.removedutxo = nil
+// ClearUserCommitment clears the value of the "user_commitment" field.
+func (m *SigningIncidentMutation) ClearUserCommitment() {
+	m.user_commitment = nil
+	m.clearedFields[signingincident.FieldUserCommitment] = struct{}{}
 }
 
-// AddUtxoswapIDs adds the "utxoswaps" edge to the UtxoSwap entity by ids.
-func (m *DepositAddressMutation) AddUtxoswapIDs(ids ...uuid.UUID) {
-	if m.utxoswaps == nil {
-		m.utxoswaps = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.utxoswaps[ids[i]] = struct{}{}
-	}
+// UserCommitmentCleared returns if the "user_commitment" field was cleared in this mutation.
+func (m *SigningIncidentMutation) UserCommitmentCleared() bool {
+	_, ok := m.clearedFields[signingincident.FieldUserCommitment]
+	return ok
 }
 
-// ClearUtxoswaps clears the "utxoswaps" edge to the UtxoSwap entity.
-func (m *DepositAddressMutation) ClearUtxoswaps() {
-	m.clearedutxoswaps = true
+// ResetUserCommitment resets all changes to the "user_commitment" field.
+func (m *SigningIncidentMutation) ResetUserCommitment() {
+	m.user_commitment = nil
+	delete(m.clearedFields, signingincident.FieldUserCommitment)
 }
 
-// UtxoswapsCleared reports if the "utxoswaps" edge to the UtxoSwap entity was cleared.
-func (m *DepositAddressMutation) UtxoswapsCleared() bool {
-	return m.clearedutxoswaps
+// SetReason sets the "reason" field.
+func (m *SigningIncidentMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// RemoveUtxoswapIDs removes the "utxoswaps" edge to the UtxoSwap entity by IDs.
-func (m *DepositAddressMutation) RemoveUtxoswapIDs(ids ...uuid.UUID) {
-	if m.removedutxoswaps == nil {
-		m.removedutxoswaps = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.utxoswaps, ids[i])
-		m.removedutxoswaps[ids[i]] = struct{}{}
+// Reason returns the value of the "reason" field in the mutation.
+func (m *SigningIncidentMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUtxoswaps returns the removed IDs of the "utxoswaps" edge to the UtxoSwap entity.
-func (m *DepositAddressMutation) RemovedUtxoswapsIDs() (ids []uuid.UUID) {
-	for id := range m.removedutxoswaps {
-		ids = append(ids, id)
+// OldReason returns the old "reason" field's value of the SigningIncident entity.
+// If the SigningIncident object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningIncidentMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// UtxoswapsIDs returns the "utxoswaps" edge IDs in the mutation.
-func (m *DepositAddressMutation) UtxoswapsIDs() (ids []uuid.UUID) {
-	for id := range m.utxoswaps {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
 }
 
-// ResetUtxoswaps resets all changes to the "utxoswaps" edge.
-func (m *DepositAddressMutation) ResetUtxoswaps() {
-	m.utxoswaps = nil
-	m.clearedutxoswaps = false
-	m.removedutxoswaps = nil
+// ResetReason resets all changes to the "reason" field.
+func (m *SigningIncidentMutation) ResetReason() {
+	m.reason = nil
 }
 
-// Where appends a list predicates to the DepositAddressMutation builder.
-func (m *DepositAddressMutation) Where(ps ...predicate.DepositAddress) {
+// Where appends a list predicates to the SigningIncidentMutation builder.
+func (m *SigningIncidentMutation) Where(ps ...predicate.SigningIncident) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DepositAddressMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SigningIncidentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DepositAddressMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DepositAddress, len(ps))
+func (m *SigningIncidentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SigningIncident, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1898,51 +12925,60 @@ func (m *DepositAddressMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DepositAddressMutation) Op() Op {
+func (m *SigningIncidentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DepositAddressMutation) SetOp(op Op) {
+func (m *SigningIncidentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DepositAddress).
-func (m *DepositAddressMutation) Type() string {
+// Type returns the node type of this mutation (SigningIncident).
+func (m *SigningIncidentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DepositAddressMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *SigningIncidentMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.create_time != nil {
-		fields = append(fields, depositaddress.FieldCreateTime)
+		fields = append(fields, signingincident.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, depositaddress.FieldUpdateTime)
+		fields = append(fields, signingincident.FieldUpdateTime)
 	}
-	if m.address != nil {
-		fields = append(fields, depositaddress.FieldAddress)
+	if m.operator_identifier != nil {
+		fields = append(fields, signingincident.FieldOperatorIdentifier)
 	}
-	if m.owner_identity_pubkey != nil {
-		fields = append(fields, depositaddress.FieldOwnerIdentityPubkey)
+	if m.keyshare_id != nil {
+		fields = append(fields, signingincident.FieldKeyshareID)
+	}
+	if m.job_id != nil {
+		fields = append(fields, signingincident.FieldJobID)
+	}
+	if m.message != nil {
+		fields = append(fields, signingincident.FieldMessage)
+	}
+	if m.verifying_key != nil {
+		fields = append(fields, signingincident.FieldVerifyingKey)
 	}
-	if m.owner_signing_pubkey != nil {
-		fields = append(fields, depositaddress.FieldOwnerSigningPubkey)
+	if m.public_share != nil {
+		fields = append(fields, signingincident.FieldPublicShare)
 	}
-	if m.confirmation_height != nil {
-		fields = append(fields, depositaddress.FieldConfirmationHeight)
+	if m.signature_share != nil {
+		fields = append(fields, signingincident.FieldSignatureShare)
 	}
-	if m.confirmation_txid != nil {
-		fields = append(fields, depositaddress.FieldConfirmationTxid)
+	if m.commitments != nil {
+		fields = append(fields, signingincident.FieldCommitments)
 	}
-	if m.node_id != nil {
-		fields = append(fields, depositaddress.FieldNodeID)
+	if m.user_commitment != nil {
+		fields = append(fields, signingincident.FieldUserCommitment)
 	}
-	if m.is_static != nil {
-		fields = append(fields, depositaddress.FieldIsStatic)
+	if m.reason != nil {
+		fields = append(fields, signingincident.FieldReason)
 	}
 	return fields
 }
@@ -1950,26 +12986,32 @@ func (m *DepositAddressMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DepositAddressMutation) Field(name string) (ent.Value, bool) {
+func (m *SigningIncidentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case depositaddress.FieldCreateTime:
+	case signingincident.FieldCreateTime:
 		return m.CreateTime()
-	case depositaddress.FieldUpdateTime:
+	case signingincident.FieldUpdateTime:
 		return m.UpdateTime()
-	case depositaddress.FieldAddress:
-		return m.Address()
-	case depositaddress.FieldOwnerIdentityPubkey:
-		return m.OwnerIdentityPubkey()
-	case depositaddress.FieldOwnerSigningPubkey:
-		return m.OwnerSigningPubkey()
-	case depositaddress.FieldConfirmationHeight:
-		return m.ConfirmationHeight()
-	case depositaddress.FieldConfirmationTxid:
-		return m.ConfirmationTxid()
-	case depositaddress.FieldNodeID:
-		return m.NodeID()
-	case depositaddress.FieldIsStatic:
-		return m.IsStatic()
+	case signingincident.FieldOperatorIdentifier:
+		return m.OperatorIdentifier()
+	case signingincident.FieldKeyshareID:
+		return m.KeyshareID()
+	case signingincident.FieldJobID:
+		return m.JobID()
+	case signingincident.FieldMessage:
+		return m.Message()
+	case signingincident.FieldVerifyingKey:
+		return m.VerifyingKey()
+	case signingincident.FieldPublicShare:
+		return m.PublicShare()
+	case signingincident.FieldSignatureShare:
+		return m.SignatureShare()
+	case signingincident.FieldCommitments:
+		return m.Commitments()
+	case signingincident.FieldUserCommitment:
+		return m.UserCommitment()
+	case signingincident.FieldReason:
+		return m.Reason()
 	}
 	return nil, false
 }
@@ -1977,378 +13019,312 @@ func (m *DepositAddressMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DepositAddressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SigningIncidentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case depositaddress.FieldCreateTime:
+	case signingincident.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case depositaddress.FieldUpdateTime:
+	case signingincident.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case depositaddress.FieldAddress:
-		return m.OldAddress(ctx)
-	case depositaddress.FieldOwnerIdentityPubkey:
-		return m.OldOwnerIdentityPubkey(ctx)
-	case depositaddress.FieldOwnerSigningPubkey:
-		return m.OldOwnerSigningPubkey(ctx)
-	case depositaddress.FieldConfirmationHeight:
-		return m.OldConfirmationHeight(ctx)
-	case depositaddress.FieldConfirmationTxid:
-		return m.OldConfirmationTxid(ctx)
-	case depositaddress.FieldNodeID:
-		return m.OldNodeID(ctx)
-	case depositaddress.FieldIsStatic:
-		return m.OldIsStatic(ctx)
-	}
-	return nil, fmt.Errorf("unknown DepositAddress field %s", name)
+	case signingincident.FieldOperatorIdentifier:
+		return m.OldOperatorIdentifier(ctx)
+	case signingincident.FieldKeyshareID:
+		return m.OldKeyshareID(ctx)
+	case signingincident.FieldJobID:
+		return m.OldJobID(ctx)
+	case signingincident.FieldMessage:
+		return m.OldMessage(ctx)
+	case signingincident.FieldVerifyingKey:
+		return m.OldVerifyingKey(ctx)
+	case signingincident.FieldPublicShare:
+		return m.OldPublicShare(ctx)
+	case signingincident.FieldSignatureShare:
+		return m.OldSignatureShare(ctx)
+	case signingincident.FieldCommitments:
+		return m.OldCommitments(ctx)
+	case signingincident.FieldUserCommitment:
+		return m.OldUserCommitment(ctx)
+	case signingincident.FieldReason:
+		return m.OldReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown SigningIncident field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DepositAddressMutation) SetField(name string, value ent.Value) error {
+func (m *SigningIncidentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case depositaddress.FieldCreateTime:
+	case signingincident.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case depositaddress.FieldUpdateTime:
+	case signingincident.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case depositaddress.FieldAddress:
+	case signingincident.FieldOperatorIdentifier:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAddress(v)
+		m.SetOperatorIdentifier(v)
 		return nil
-	case depositaddress.FieldOwnerIdentityPubkey:
+	case signingincident.FieldKeyshareID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyshareID(v)
+		return nil
+	case signingincident.FieldJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case signingincident.FieldMessage:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerIdentityPubkey(v)
+		m.SetMessage(v)
 		return nil
-	case depositaddress.FieldOwnerSigningPubkey:
+	case signingincident.FieldVerifyingKey:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerSigningPubkey(v)
+		m.SetVerifyingKey(v)
 		return nil
-	case depositaddress.FieldConfirmationHeight:
-		v, ok := value.(int64)
+	case signingincident.FieldPublicShare:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfirmationHeight(v)
+		m.SetPublicShare(v)
 		return nil
-	case depositaddress.FieldConfirmationTxid:
-		v, ok := value.(string)
+	case signingincident.FieldSignatureShare:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfirmationTxid(v)
+		m.SetSignatureShare(v)
 		return nil
-	case depositaddress.FieldNodeID:
-		v, ok := value.(uuid.UUID)
+	case signingincident.FieldCommitments:
+		v, ok := value.(map[string][]uint8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNodeID(v)
+		m.SetCommitments(v)
 		return nil
-	case depositaddress.FieldIsStatic:
-		v, ok := value.(bool)
+	case signingincident.FieldUserCommitment:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsStatic(v)
+		m.SetUserCommitment(v)
+		return nil
+	case signingincident.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DepositAddress field %s", name)
+	return fmt.Errorf("unknown SigningIncident field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DepositAddressMutation) AddedFields() []string {
-	var fields []string
-	if m.addconfirmation_height != nil {
-		fields = append(fields, depositaddress.FieldConfirmationHeight)
-	}
-	return fields
+func (m *SigningIncidentMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DepositAddressMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case depositaddress.FieldConfirmationHeight:
-		return m.AddedConfirmationHeight()
-	}
+func (m *SigningIncidentMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DepositAddressMutation) AddField(name string, value ent.Value) error {
+func (m *SigningIncidentMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case depositaddress.FieldConfirmationHeight:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddConfirmationHeight(v)
-		return nil
 	}
-	return fmt.Errorf("unknown DepositAddress numeric field %s", name)
+	return fmt.Errorf("unknown SigningIncident numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DepositAddressMutation) ClearedFields() []string {
+func (m *SigningIncidentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(depositaddress.FieldConfirmationHeight) {
-		fields = append(fields, depositaddress.FieldConfirmationHeight)
-	}
-	if m.FieldCleared(depositaddress.FieldConfirmationTxid) {
-		fields = append(fields, depositaddress.FieldConfirmationTxid)
-	}
-	if m.FieldCleared(depositaddress.FieldNodeID) {
-		fields = append(fields, depositaddress.FieldNodeID)
+	if m.FieldCleared(signingincident.FieldUserCommitment) {
+		fields = append(fields, signingincident.FieldUserCommitment)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DepositAddressMutation) FieldCleared(name string) bool {
+func (m *SigningIncidentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DepositAddressMutation) ClearField(name string) error {
+func (m *SigningIncidentMutation) ClearField(name string) error {
 	switch name {
-	case depositaddress.FieldConfirmationHeight:
-		m.ClearConfirmationHeight()
-		return nil
-	case depositaddress.FieldConfirmationTxid:
-		m.ClearConfirmationTxid()
-		return nil
-	case depositaddress.FieldNodeID:
-		m.ClearNodeID()
+	case signingincident.FieldUserCommitment:
+		m.ClearUserCommitment()
 		return nil
 	}
-	return fmt.Errorf("unknown DepositAddress nullable field %s", name)
+	return fmt.Errorf("unknown SigningIncident nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DepositAddressMutation) ResetField(name string) error {
+func (m *SigningIncidentMutation) ResetField(name string) error {
 	switch name {
-	case depositaddress.FieldCreateTime:
+	case signingincident.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case depositaddress.FieldUpdateTime:
+	case signingincident.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case depositaddress.FieldAddress:
-		m.ResetAddress()
+	case signingincident.FieldOperatorIdentifier:
+		m.ResetOperatorIdentifier()
 		return nil
-	case depositaddress.FieldOwnerIdentityPubkey:
-		m.ResetOwnerIdentityPubkey()
+	case signingincident.FieldKeyshareID:
+		m.ResetKeyshareID()
 		return nil
-	case depositaddress.FieldOwnerSigningPubkey:
-		m.ResetOwnerSigningPubkey()
+	case signingincident.FieldJobID:
+		m.ResetJobID()
 		return nil
-	case depositaddress.FieldConfirmationHeight:
-		m.ResetConfirmationHeight()
+	case signingincident.FieldMessage:
+		m.ResetMessage()
 		return nil
-	case depositaddress.FieldConfirmationTxid:
-		m.ResetConfirmationTxid()
+	case signingincident.FieldVerifyingKey:
+		m.ResetVerifyingKey()
 		return nil
-	case depositaddress.FieldNodeID:
-		m.ResetNodeID()
+	case signingincident.FieldPublicShare:
+		m.ResetPublicShare()
 		return nil
-	case depositaddress.FieldIsStatic:
-		m.ResetIsStatic()
+	case signingincident.FieldSignatureShare:
+		m.ResetSignatureShare()
+		return nil
+	case signingincident.FieldCommitments:
+		m.ResetCommitments()
+		return nil
+	case signingincident.FieldUserCommitment:
+		m.ResetUserCommitment()
+		return nil
+	case signingincident.FieldReason:
+		m.ResetReason()
 		return nil
 	}
-	return fmt.Errorf("unknown DepositAddress field %s", name)
+	return fmt.Errorf("unknown SigningIncident field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DepositAddressMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.signing_keyshare != nil {
-		edges = append(edges, depositaddress.EdgeSigningKeyshare)
-	}
-	if m.utxo != nil {
-		edges = append(edges, depositaddress.EdgeUtxo)
-	}
-	if m.utxoswaps != nil {
-		edges = append(edges, depositaddress.EdgeUtxoswaps)
-	}
+func (m *SigningIncidentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DepositAddressMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case depositaddress.EdgeSigningKeyshare:
-		if id := m.signing_keyshare; id != nil {
-			return []ent.Value{*id}
-		}
-	case depositaddress.EdgeUtxo:
-		ids := make([]ent.Value, 0, len(m.utxo))
-		for id := range m.utxo {
-			ids = append(ids, id)
-		}
-		return ids
-	case depositaddress.EdgeUtxoswaps:
-		ids := make([]ent.Value, 0, len(m.utxoswaps))
-		for id := range m.utxoswaps {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SigningIncidentMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DepositAddressMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedutxo != nil {
-		edges = append(edges, depositaddress.EdgeUtxo)
-	}
-	if m.removedutxoswaps != nil {
-		edges = append(edges, depositaddress.EdgeUtxoswaps)
-	}
+func (m *SigningIncidentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DepositAddressMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case depositaddress.EdgeUtxo:
-		ids := make([]ent.Value, 0, len(m.removedutxo))
-		for id := range m.removedutxo {
-			ids = append(ids, id)
-		}
-		return ids
-	case depositaddress.EdgeUtxoswaps:
-		ids := make([]ent.Value, 0, len(m.removedutxoswaps))
-		for id := range m.removedutxoswaps {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SigningIncidentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DepositAddressMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedsigning_keyshare {
-		edges = append(edges, depositaddress.EdgeSigningKeyshare)
-	}
-	if m.clearedutxo {
-		edges = append(edges, depositaddress.EdgeUtxo)
-	}
-	if m.clearedutxoswaps {
-		edges = append(edges, depositaddress.EdgeUtxoswaps)
-	}
+func (m *SigningIncidentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DepositAddressMutation) EdgeCleared(name string) bool {
-	switch name {
-	case depositaddress.EdgeSigningKeyshare:
-		return m.clearedsigning_keyshare
-	case depositaddress.EdgeUtxo:
-		return m.clearedutxo
-	case depositaddress.EdgeUtxoswaps:
-		return m.clearedutxoswaps
-	}
+func (m *SigningIncidentMutation) EdgeCleared(name string) bool {
 	return false
 }
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *DepositAddressMutation) ClearEdge(name string) error {
-	switch name {
-	case depositaddress.EdgeSigningKeyshare:
-		m.ClearSigningKeyshare()
-		return nil
-	}
-	return fmt.Errorf("unknown DepositAddress unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *DepositAddressMutation) ResetEdge(name string) error {
-	switch name {
-	case depositaddress.EdgeSigningKeyshare:
-		m.ResetSigningKeyshare()
-		return nil
-	case depositaddress.EdgeUtxo:
-		m.ResetUtxo()
-		return nil
-	case depositaddress.EdgeUtxoswaps:
-		m.ResetUtxoswaps()
-		return nil
-	}
-	return fmt.Errorf("unknown DepositAddress edge %s", name)
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SigningIncidentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SigningIncident unique edge %s", name)
 }
 
-// PreimageRequestMutation represents an operation that mutates the PreimageRequest nodes in the graph.
-type PreimageRequestMutation struct {
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SigningIncidentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SigningIncident edge %s", name)
+}
+
+// SigningKeyshareMutation represents an operation that mutates the SigningKeyshare nodes in the graph.
+type SigningKeyshareMutation struct {
 	config
 	op                       Op
 	typ                      string
 	id                       *uuid.UUID
 	create_time              *time.Time
 	update_time              *time.Time
-	payment_hash             *[]byte
-	status                   *schema.PreimageRequestStatus
-	receiver_identity_pubkey *[]byte
-	preimage                 *[]byte
+	status                   *schema.SigningKeyshareStatus
+	secret_share             *[]byte
+	public_shares            *map[string][]uint8
+	public_key               *[]byte
+	min_signers              *int32
+	addmin_signers           *int32
+	coordinator_index        *uint64
+	addcoordinator_index     *int64
+	encrypted_data_key       *[]byte
+	key_version              *string
+	share_refresh_id         *uuid.UUID
+	share_refresh_time       *time.Time
+	pending_share_refresh_id *uuid.UUID
+	pending_reshare_id       *uuid.UUID
+	operator_set_epoch       *uint64
+	addoperator_set_epoch    *int64
 	clearedFields            map[string]struct{}
-	transactions             map[uuid.UUID]struct{}
-	removedtransactions      map[uuid.UUID]struct{}
-	clearedtransactions      bool
-	preimage_shares          *uuid.UUID
-	clearedpreimage_shares   bool
-	transfers                *uuid.UUID
-	clearedtransfers         bool
 	done                     bool
-	oldValue                 func(context.Context) (*PreimageRequest, error)
-	predicates               []predicate.PreimageRequest
+	oldValue                 func(context.Context) (*SigningKeyshare, error)
+	predicates               []predicate.SigningKeyshare
 }
 
-var _ ent.Mutation = (*PreimageRequestMutation)(nil)
+var _ ent.Mutation = (*SigningKeyshareMutation)(nil)
 
-// preimagerequestOption allows management of the mutation configuration using functional options.
-type preimagerequestOption func(*PreimageRequestMutation)
+// signingkeyshareOption allows management of the mutation configuration using functional options.
+type signingkeyshareOption func(*SigningKeyshareMutation)
 
-// newPreimageRequestMutation creates new mutation for the PreimageRequest entity.
-func newPreimageRequestMutation(c config, op Op, opts ...preimagerequestOption) *PreimageRequestMutation {
-	m := &PreimageRequestMutation{
+// newSigningKeyshareMutation creates new mutation for the SigningKeyshare entity.
+func newSigningKeyshareMutation(c config, op Op, opts ...signingkeyshareOption) *SigningKeyshareMutation {
+	m := &SigningKeyshareMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePreimageRequest,
+		typ:           TypeSigningKeyshare,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2357,20 +13333,20 @@ func newPreimageRequestMutation(c config, op Op, opts ...preimagerequestOption)
 	return m
 }
 
-// withPreimageRequestID sets the ID field of the mutation.
-func withPreimageRequestID(id uuid.UUID) preimagerequestOption {
-	return func(m *PreimageRequestMutation) {
+// withSigningKeyshareID sets the ID field of the mutation.
+func withSigningKeyshareID(id uuid.UUID) signingkeyshareOption {
+	return func(m *SigningKeyshareMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PreimageRequest
+			value *SigningKeyshare
 		)
-		m.oldValue = func(ctx context.Context) (*PreimageRequest, error) {
+		m.oldValue = func(ctx context.Context) (*SigningKeyshare, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PreimageRequest.Get(ctx, id)
+					value, err = m.Client().SigningKeyshare.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2379,10 +13355,10 @@ func withPreimageRequestID(id uuid.UUID) preimagerequestOption {
 	}
 }
 
-// withPreimageRequest sets the old PreimageRequest of the mutation.
-func withPreimageRequest(node *PreimageRequest) preimagerequestOption {
-	return func(m *PreimageRequestMutation) {
-		m.oldValue = func(context.Context) (*PreimageRequest, error) {
+// withSigningKeyshare sets the old SigningKeyshare of the mutation.
+func withSigningKeyshare(node *SigningKeyshare) signingkeyshareOption {
+	return func(m *SigningKeyshareMutation) {
+		m.oldValue = func(context.Context) (*SigningKeyshare, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2391,7 +13367,7 @@ func withPreimageRequest(node *PreimageRequest) preimagerequestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PreimageRequestMutation) Client() *Client {
+func (m SigningKeyshareMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2399,7 +13375,7 @@ func (m PreimageRequestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PreimageRequestMutation) Tx() (*Tx, error) {
+func (m SigningKeyshareMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2409,14 +13385,14 @@ func (m PreimageRequestMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PreimageRequest entities.
-func (m *PreimageRequestMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SigningKeyshare entities.
+func (m *SigningKeyshareMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PreimageRequestMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SigningKeyshareMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2427,7 +13403,7 @@ func (m *PreimageRequestMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PreimageRequestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SigningKeyshareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2436,19 +13412,19 @@ func (m *PreimageRequestMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PreimageRequest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SigningKeyshare.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *PreimageRequestMutation) SetCreateTime(t time.Time) {
+func (m *SigningKeyshareMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *PreimageRequestMutation) CreateTime() (r time.Time, exists bool) {
+func (m *SigningKeyshareMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2456,375 +13432,679 @@ func (m *PreimageRequestMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the PreimageRequest entity.
-// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyshareMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SigningKeyshareMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SigningKeyshareMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SigningKeyshareMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyshareMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SigningKeyshareMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SigningKeyshareMutation) SetStatus(sks schema.SigningKeyshareStatus) {
+	m.status = &sks
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SigningKeyshareMutation) Status() (r schema.SigningKeyshareStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyshareMutation) OldStatus(ctx context.Context) (v schema.SigningKeyshareStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SigningKeyshareMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetSecretShare sets the "secret_share" field.
+func (m *SigningKeyshareMutation) SetSecretShare(b []byte) {
+	m.secret_share = &b
+}
+
+// SecretShare returns the value of the "secret_share" field in the mutation.
+func (m *SigningKeyshareMutation) SecretShare() (r []byte, exists bool) {
+	v := m.secret_share
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretShare returns the old "secret_share" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyshareMutation) OldSecretShare(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretShare is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretShare requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretShare: %w", err)
+	}
+	return oldValue.SecretShare, nil
+}
+
+// ResetSecretShare resets all changes to the "secret_share" field.
+func (m *SigningKeyshareMutation) ResetSecretShare() {
+	m.secret_share = nil
+}
+
+// SetPublicShares sets the "public_shares" field.
+func (m *SigningKeyshareMutation) SetPublicShares(value map[string][]uint8) {
+	m.public_shares = &value
+}
+
+// PublicShares returns the value of the "public_shares" field in the mutation.
+func (m *SigningKeyshareMutation) PublicShares() (r map[string][]uint8, exists bool) {
+	v := m.public_shares
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicShares returns the old "public_shares" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyshareMutation) OldPublicShares(ctx context.Context) (v map[string][]uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicShares is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicShares requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicShares: %w", err)
+	}
+	return oldValue.PublicShares, nil
+}
+
+// ResetPublicShares resets all changes to the "public_shares" field.
+func (m *SigningKeyshareMutation) ResetPublicShares() {
+	m.public_shares = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *SigningKeyshareMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *SigningKeyshareMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageRequestMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *SigningKeyshareMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.PublicKey, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *PreimageRequestMutation) ResetCreateTime() {
-	m.create_time = nil
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *SigningKeyshareMutation) ResetPublicKey() {
+	m.public_key = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *PreimageRequestMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetMinSigners sets the "min_signers" field.
+func (m *SigningKeyshareMutation) SetMinSigners(i int32) {
+	m.min_signers = &i
+	m.addmin_signers = nil
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *PreimageRequestMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// MinSigners returns the value of the "min_signers" field in the mutation.
+func (m *SigningKeyshareMutation) MinSigners() (r int32, exists bool) {
+	v := m.min_signers
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the PreimageRequest entity.
-// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldMinSigners returns the old "min_signers" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageRequestMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *SigningKeyshareMutation) OldMinSigners(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldMinSigners is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldMinSigners requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldMinSigners: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.MinSigners, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *PreimageRequestMutation) ResetUpdateTime() {
-	m.update_time = nil
+// AddMinSigners adds i to the "min_signers" field.
+func (m *SigningKeyshareMutation) AddMinSigners(i int32) {
+	if m.addmin_signers != nil {
+		*m.addmin_signers += i
+	} else {
+		m.addmin_signers = &i
+	}
 }
 
-// SetPaymentHash sets the "payment_hash" field.
-func (m *PreimageRequestMutation) SetPaymentHash(b []byte) {
-	m.payment_hash = &b
+// AddedMinSigners returns the value that was added to the "min_signers" field in this mutation.
+func (m *SigningKeyshareMutation) AddedMinSigners() (r int32, exists bool) {
+	v := m.addmin_signers
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PaymentHash returns the value of the "payment_hash" field in the mutation.
-func (m *PreimageRequestMutation) PaymentHash() (r []byte, exists bool) {
-	v := m.payment_hash
+// ResetMinSigners resets all changes to the "min_signers" field.
+func (m *SigningKeyshareMutation) ResetMinSigners() {
+	m.min_signers = nil
+	m.addmin_signers = nil
+}
+
+// SetCoordinatorIndex sets the "coordinator_index" field.
+func (m *SigningKeyshareMutation) SetCoordinatorIndex(u uint64) {
+	m.coordinator_index = &u
+	m.addcoordinator_index = nil
+}
+
+// CoordinatorIndex returns the value of the "coordinator_index" field in the mutation.
+func (m *SigningKeyshareMutation) CoordinatorIndex() (r uint64, exists bool) {
+	v := m.coordinator_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPaymentHash returns the old "payment_hash" field's value of the PreimageRequest entity.
-// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldCoordinatorIndex returns the old "coordinator_index" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageRequestMutation) OldPaymentHash(ctx context.Context) (v []byte, err error) {
+func (m *SigningKeyshareMutation) OldCoordinatorIndex(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPaymentHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldCoordinatorIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPaymentHash requires an ID field in the mutation")
+		return v, errors.New("OldCoordinatorIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPaymentHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldCoordinatorIndex: %w", err)
 	}
-	return oldValue.PaymentHash, nil
+	return oldValue.CoordinatorIndex, nil
 }
 
-// ResetPaymentHash resets all changes to the "payment_hash" field.
-func (m *PreimageRequestMutation) ResetPaymentHash() {
-	m.payment_hash = nil
+// AddCoordinatorIndex adds u to the "coordinator_index" field.
+func (m *SigningKeyshareMutation) AddCoordinatorIndex(u int64) {
+	if m.addcoordinator_index != nil {
+		*m.addcoordinator_index += u
+	} else {
+		m.addcoordinator_index = &u
+	}
 }
 
-// SetStatus sets the "status" field.
-func (m *PreimageRequestMutation) SetStatus(srs schema.PreimageRequestStatus) {
-	m.status = &srs
+// AddedCoordinatorIndex returns the value that was added to the "coordinator_index" field in this mutation.
+func (m *SigningKeyshareMutation) AddedCoordinatorIndex() (r int64, exists bool) {
+	v := m.addcoordinator_index
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PreimageRequestMutation) Status() (r schema.PreimageRequestStatus, exists bool) {
-	v := m.status
+// ResetCoordinatorIndex resets all changes to the "coordinator_index" field.
+func (m *SigningKeyshareMutation) ResetCoordinatorIndex() {
+	m.coordinator_index = nil
+	m.addcoordinator_index = nil
+}
+
+// SetEncryptedDataKey sets the "encrypted_data_key" field.
+func (m *SigningKeyshareMutation) SetEncryptedDataKey(b []byte) {
+	m.encrypted_data_key = &b
+}
+
+// EncryptedDataKey returns the value of the "encrypted_data_key" field in the mutation.
+func (m *SigningKeyshareMutation) EncryptedDataKey() (r []byte, exists bool) {
+	v := m.encrypted_data_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the PreimageRequest entity.
-// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldEncryptedDataKey returns the old "encrypted_data_key" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageRequestMutation) OldStatus(ctx context.Context) (v schema.PreimageRequestStatus, err error) {
+func (m *SigningKeyshareMutation) OldEncryptedDataKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldEncryptedDataKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldEncryptedDataKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldEncryptedDataKey: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.EncryptedDataKey, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PreimageRequestMutation) ResetStatus() {
-	m.status = nil
+// ClearEncryptedDataKey clears the value of the "encrypted_data_key" field.
+func (m *SigningKeyshareMutation) ClearEncryptedDataKey() {
+	m.encrypted_data_key = nil
+	m.clearedFields[signingkeyshare.FieldEncryptedDataKey] = struct{}{}
 }
 
-// SetReceiverIdentityPubkey sets the "receiver_identity_pubkey" field.
-func (m *PreimageRequestMutation) SetReceiverIdentityPubkey(b []byte) {
-	m.receiver_identity_pubkey = &b
+// EncryptedDataKeyCleared returns if the "encrypted_data_key" field was cleared in this mutation.
+func (m *SigningKeyshareMutation) EncryptedDataKeyCleared() bool {
+	_, ok := m.clearedFields[signingkeyshare.FieldEncryptedDataKey]
+	return ok
 }
 
-// ReceiverIdentityPubkey returns the value of the "receiver_identity_pubkey" field in the mutation.
-func (m *PreimageRequestMutation) ReceiverIdentityPubkey() (r []byte, exists bool) {
-	v := m.receiver_identity_pubkey
+// ResetEncryptedDataKey resets all changes to the "encrypted_data_key" field.
+func (m *SigningKeyshareMutation) ResetEncryptedDataKey() {
+	m.encrypted_data_key = nil
+	delete(m.clearedFields, signingkeyshare.FieldEncryptedDataKey)
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (m *SigningKeyshareMutation) SetKeyVersion(s string) {
+	m.key_version = &s
+}
+
+// KeyVersion returns the value of the "key_version" field in the mutation.
+func (m *SigningKeyshareMutation) KeyVersion() (r string, exists bool) {
+	v := m.key_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReceiverIdentityPubkey returns the old "receiver_identity_pubkey" field's value of the PreimageRequest entity.
-// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyVersion returns the old "key_version" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageRequestMutation) OldReceiverIdentityPubkey(ctx context.Context) (v []byte, err error) {
+func (m *SigningKeyshareMutation) OldKeyVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReceiverIdentityPubkey is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReceiverIdentityPubkey requires an ID field in the mutation")
+		return v, errors.New("OldKeyVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReceiverIdentityPubkey: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyVersion: %w", err)
 	}
-	return oldValue.ReceiverIdentityPubkey, nil
+	return oldValue.KeyVersion, nil
 }
 
-// ClearReceiverIdentityPubkey clears the value of the "receiver_identity_pubkey" field.
-func (m *PreimageRequestMutation) ClearReceiverIdentityPubkey() {
-	m.receiver_identity_pubkey = nil
-	m.clearedFields[preimagerequest.FieldReceiverIdentityPubkey] = struct{}{}
+// ClearKeyVersion clears the value of the "key_version" field.
+func (m *SigningKeyshareMutation) ClearKeyVersion() {
+	m.key_version = nil
+	m.clearedFields[signingkeyshare.FieldKeyVersion] = struct{}{}
 }
 
-// ReceiverIdentityPubkeyCleared returns if the "receiver_identity_pubkey" field was cleared in this mutation.
-func (m *PreimageRequestMutation) ReceiverIdentityPubkeyCleared() bool {
-	_, ok := m.clearedFields[preimagerequest.FieldReceiverIdentityPubkey]
+// KeyVersionCleared returns if the "key_version" field was cleared in this mutation.
+func (m *SigningKeyshareMutation) KeyVersionCleared() bool {
+	_, ok := m.clearedFields[signingkeyshare.FieldKeyVersion]
 	return ok
 }
 
-// ResetReceiverIdentityPubkey resets all changes to the "receiver_identity_pubkey" field.
-func (m *PreimageRequestMutation) ResetReceiverIdentityPubkey() {
-	m.receiver_identity_pubkey = nil
-	delete(m.clearedFields, preimagerequest.FieldReceiverIdentityPubkey)
+// ResetKeyVersion resets all changes to the "key_version" field.
+func (m *SigningKeyshareMutation) ResetKeyVersion() {
+	m.key_version = nil
+	delete(m.clearedFields, signingkeyshare.FieldKeyVersion)
 }
 
-// SetPreimage sets the "preimage" field.
-func (m *PreimageRequestMutation) SetPreimage(b []byte) {
-	m.preimage = &b
+// SetShareRefreshID sets the "share_refresh_id" field.
+func (m *SigningKeyshareMutation) SetShareRefreshID(u uuid.UUID) {
+	m.share_refresh_id = &u
 }
 
-// Preimage returns the value of the "preimage" field in the mutation.
-func (m *PreimageRequestMutation) Preimage() (r []byte, exists bool) {
-	v := m.preimage
+// ShareRefreshID returns the value of the "share_refresh_id" field in the mutation.
+func (m *SigningKeyshareMutation) ShareRefreshID() (r uuid.UUID, exists bool) {
+	v := m.share_refresh_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPreimage returns the old "preimage" field's value of the PreimageRequest entity.
-// If the PreimageRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldShareRefreshID returns the old "share_refresh_id" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageRequestMutation) OldPreimage(ctx context.Context) (v []byte, err error) {
+func (m *SigningKeyshareMutation) OldShareRefreshID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPreimage is only allowed on UpdateOne operations")
+		return v, errors.New("OldShareRefreshID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPreimage requires an ID field in the mutation")
+		return v, errors.New("OldShareRefreshID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPreimage: %w", err)
+		return v, fmt.Errorf("querying old value for OldShareRefreshID: %w", err)
 	}
-	return oldValue.Preimage, nil
+	return oldValue.ShareRefreshID, nil
 }
 
-// ClearPreimage clears the value of the "preimage" field.
-func (m *PreimageRequestMutation) ClearPreimage() {
-	m.preimage = nil
-	m.clearedFields[preimagerequest.FieldPreimage] = struct{}{}
+// ClearShareRefreshID clears the value of the "share_refresh_id" field.
+func (m *SigningKeyshareMutation) ClearShareRefreshID() {
+	m.share_refresh_id = nil
+	m.clearedFields[signingkeyshare.FieldShareRefreshID] = struct{}{}
 }
 
-// PreimageCleared returns if the "preimage" field was cleared in this mutation.
-func (m *PreimageRequestMutation) PreimageCleared() bool {
-	_, ok := m.clearedFields[preimagerequest.FieldPreimage]
+// ShareRefreshIDCleared returns if the "share_refresh_id" field was cleared in this mutation.
+func (m *SigningKeyshareMutation) ShareRefreshIDCleared() bool {
+	_, ok := m.clearedFields[signingkeyshare.FieldShareRefreshID]
 	return ok
 }
 
-// ResetPreimage resets all changes to the "preimage" field.
-func (m *PreimageRequestMutation) ResetPreimage() {
-	m.preimage = nil
-	delete(m.clearedFields, preimagerequest.FieldPreimage)
+// ResetShareRefreshID resets all changes to the "share_refresh_id" field.
+func (m *SigningKeyshareMutation) ResetShareRefreshID() {
+	m.share_refresh_id = nil
+	delete(m.clearedFields, signingkeyshare.FieldShareRefreshID)
 }
 
-// AddTransactionIDs adds the "transactions" edge to the UserSignedTransaction entity by ids.
-func (m *PreimageRequestMutation) AddTransactionIDs(ids ...uuid.UUID) {
-	if m.transactions == nil {
-		m.transactions = make(map[uuid.UUID]struct{})
+// SetShareRefreshTime sets the "share_refresh_time" field.
+func (m *SigningKeyshareMutation) SetShareRefreshTime(t time.Time) {
+	m.share_refresh_time = &t
+}
+
+// ShareRefreshTime returns the value of the "share_refresh_time" field in the mutation.
+func (m *SigningKeyshareMutation) ShareRefreshTime() (r time.Time, exists bool) {
+	v := m.share_refresh_time
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.transactions[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldShareRefreshTime returns the old "share_refresh_time" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyshareMutation) OldShareRefreshTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareRefreshTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareRefreshTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareRefreshTime: %w", err)
 	}
+	return oldValue.ShareRefreshTime, nil
 }
 
-// ClearTransactions clears the "transactions" edge to the UserSignedTransaction entity.
-func (m *PreimageRequestMutation) ClearTransactions() {
-	m.clearedtransactions = true
+// ClearShareRefreshTime clears the value of the "share_refresh_time" field.
+func (m *SigningKeyshareMutation) ClearShareRefreshTime() {
+	m.share_refresh_time = nil
+	m.clearedFields[signingkeyshare.FieldShareRefreshTime] = struct{}{}
 }
 
-// TransactionsCleared reports if the "transactions" edge to the UserSignedTransaction entity was cleared.
-func (m *PreimageRequestMutation) TransactionsCleared() bool {
-	return m.clearedtransactions
+// ShareRefreshTimeCleared returns if the "share_refresh_time" field was cleared in this mutation.
+func (m *SigningKeyshareMutation) ShareRefreshTimeCleared() bool {
+	_, ok := m.clearedFields[signingkeyshare.FieldShareRefreshTime]
+	return ok
 }
 
-// RemoveTransactionIDs removes the "transactions" edge to the UserSignedTransaction entity by IDs.
-func (m *PreimageRequestMutation) RemoveTransactionIDs(ids ...uuid.UUID) {
-	if m.removedtransactions == nil {
-		m.removedtransactions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.transactions, ids[i])
-		m.removedtransactions[ids[i]] = struct{}{}
-	}
+// ResetShareRefreshTime resets all changes to the "share_refresh_time" field.
+func (m *SigningKeyshareMutation) ResetShareRefreshTime() {
+	m.share_refresh_time = nil
+	delete(m.clearedFields, signingkeyshare.FieldShareRefreshTime)
 }
 
-// RemovedTransactions returns the removed IDs of the "transactions" edge to the UserSignedTransaction entity.
-func (m *PreimageRequestMutation) RemovedTransactionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedtransactions {
-		ids = append(ids, id)
+// SetPendingShareRefreshID sets the "pending_share_refresh_id" field.
+func (m *SigningKeyshareMutation) SetPendingShareRefreshID(u uuid.UUID) {
+	m.pending_share_refresh_id = &u
+}
+
+// PendingShareRefreshID returns the value of the "pending_share_refresh_id" field in the mutation.
+func (m *SigningKeyshareMutation) PendingShareRefreshID() (r uuid.UUID, exists bool) {
+	v := m.pending_share_refresh_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TransactionsIDs returns the "transactions" edge IDs in the mutation.
-func (m *PreimageRequestMutation) TransactionsIDs() (ids []uuid.UUID) {
-	for id := range m.transactions {
-		ids = append(ids, id)
+// OldPendingShareRefreshID returns the old "pending_share_refresh_id" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyshareMutation) OldPendingShareRefreshID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingShareRefreshID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingShareRefreshID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingShareRefreshID: %w", err)
+	}
+	return oldValue.PendingShareRefreshID, nil
 }
 
-// ResetTransactions resets all changes to the "transactions" edge.
-func (m *PreimageRequestMutation) ResetTransactions() {
-	m.transactions = nil
-	m.clearedtransactions = false
-	m.removedtransactions = nil
+// ClearPendingShareRefreshID clears the value of the "pending_share_refresh_id" field.
+func (m *SigningKeyshareMutation) ClearPendingShareRefreshID() {
+	m.pending_share_refresh_id = nil
+	m.clearedFields[signingkeyshare.FieldPendingShareRefreshID] = struct{}{}
 }
 
-// SetPreimageSharesID sets the "preimage_shares" edge to the PreimageShare entity by id.
-func (m *PreimageRequestMutation) SetPreimageSharesID(id uuid.UUID) {
-	m.preimage_shares = &id
+// PendingShareRefreshIDCleared returns if the "pending_share_refresh_id" field was cleared in this mutation.
+func (m *SigningKeyshareMutation) PendingShareRefreshIDCleared() bool {
+	_, ok := m.clearedFields[signingkeyshare.FieldPendingShareRefreshID]
+	return ok
 }
 
-// ClearPreimageShares clears the "preimage_shares" edge to the PreimageShare entity.
-func (m *PreimageRequestMutation) ClearPreimageShares() {
-	m.clearedpreimage_shares = true
+// ResetPendingShareRefreshID resets all changes to the "pending_share_refresh_id" field.
+func (m *SigningKeyshareMutation) ResetPendingShareRefreshID() {
+	m.pending_share_refresh_id = nil
+	delete(m.clearedFields, signingkeyshare.FieldPendingShareRefreshID)
 }
 
-// PreimageSharesCleared reports if the "preimage_shares" edge to the PreimageShare entity was cleared.
-func (m *PreimageRequestMutation) PreimageSharesCleared() bool {
-	return m.clearedpreimage_shares
+// SetPendingReshareID sets the "pending_reshare_id" field.
+func (m *SigningKeyshareMutation) SetPendingReshareID(u uuid.UUID) {
+	m.pending_reshare_id = &u
 }
 
-// PreimageSharesID returns the "preimage_shares" edge ID in the mutation.
-func (m *PreimageRequestMutation) PreimageSharesID() (id uuid.UUID, exists bool) {
-	if m.preimage_shares != nil {
-		return *m.preimage_shares, true
+// PendingReshareID returns the value of the "pending_reshare_id" field in the mutation.
+func (m *SigningKeyshareMutation) PendingReshareID() (r uuid.UUID, exists bool) {
+	v := m.pending_reshare_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// PreimageSharesIDs returns the "preimage_shares" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PreimageSharesID instead. It exists only for internal usage by the builders.
-func (m *PreimageRequestMutation) PreimageSharesIDs() (ids []uuid.UUID) {
-	if id := m.preimage_shares; id != nil {
-		ids = append(ids, *id)
+// OldPendingReshareID returns the old "pending_reshare_id" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyshareMutation) OldPendingReshareID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingReshareID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingReshareID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingReshareID: %w", err)
+	}
+	return oldValue.PendingReshareID, nil
 }
 
-// ResetPreimageShares resets all changes to the "preimage_shares" edge.
-func (m *PreimageRequestMutation) ResetPreimageShares() {
-	m.preimage_shares = nil
-	m.clearedpreimage_shares = false
+// ClearPendingReshareID clears the value of the "pending_reshare_id" field.
+func (m *SigningKeyshareMutation) ClearPendingReshareID() {
+	m.pending_reshare_id = nil
+	m.clearedFields[signingkeyshare.FieldPendingReshareID] = struct{}{}
 }
 
-// SetTransfersID sets the "transfers" edge to the Transfer entity by id.
-func (m *PreimageRequestMutation) SetTransfersID(id uuid.UUID) {
-	m.transfers = &id
+// PendingReshareIDCleared returns if the "pending_reshare_id" field was cleared in this mutation.
+func (m *SigningKeyshareMutation) PendingReshareIDCleared() bool {
+	_, ok := m.clearedFields[signingkeyshare.FieldPendingReshareID]
+	return ok
 }
 
-// ClearTransfers clears the "transfers" edge to the Transfer entity.
-func (m *PreimageRequestMutation) ClearTransfers() {
-	m.clearedtransfers = true
+// ResetPendingReshareID resets all changes to the "pending_reshare_id" field.
+func (m *SigningKeyshareMutation) ResetPendingReshareID() {
+	m.pending_reshare_id = nil
+	delete(m.clearedFields, signingkeyshare.FieldPendingReshareID)
 }
 
-// TransfersCleared reports if the "transfers" edge to the Transfer entity was cleared.
-func (m *PreimageRequestMutation) TransfersCleared() bool {
-	return m.clearedtransfers
+// SetOperatorSetEpoch sets the "operator_set_epoch" field.
+func (m *SigningKeyshareMutation) SetOperatorSetEpoch(u uint64) {
+	m.operator_set_epoch = &u
+	m.addoperator_set_epoch = nil
 }
 
-// TransfersID returns the "transfers" edge ID in the mutation.
-func (m *PreimageRequestMutation) TransfersID() (id uuid.UUID, exists bool) {
-	if m.transfers != nil {
-		return *m.transfers, true
+// OperatorSetEpoch returns the value of the "operator_set_epoch" field in the mutation.
+func (m *SigningKeyshareMutation) OperatorSetEpoch() (r uint64, exists bool) {
+	v := m.operator_set_epoch
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TransfersIDs returns the "transfers" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TransfersID instead. It exists only for internal usage by the builders.
-func (m *PreimageRequestMutation) TransfersIDs() (ids []uuid.UUID) {
-	if id := m.transfers; id != nil {
-		ids = append(ids, *id)
+// OldOperatorSetEpoch returns the old "operator_set_epoch" field's value of the SigningKeyshare entity.
+// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyshareMutation) OldOperatorSetEpoch(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperatorSetEpoch is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperatorSetEpoch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperatorSetEpoch: %w", err)
+	}
+	return oldValue.OperatorSetEpoch, nil
 }
 
-// ResetTransfers resets all changes to the "transfers" edge.
-func (m *PreimageRequestMutation) ResetTransfers() {
-	m.transfers = nil
-	m.clearedtransfers = false
+// AddOperatorSetEpoch adds u to the "operator_set_epoch" field.
+func (m *SigningKeyshareMutation) AddOperatorSetEpoch(u int64) {
+	if m.addoperator_set_epoch != nil {
+		*m.addoperator_set_epoch += u
+	} else {
+		m.addoperator_set_epoch = &u
+	}
 }
 
-// Where appends a list predicates to the PreimageRequestMutation builder.
-func (m *PreimageRequestMutation) Where(ps ...predicate.PreimageRequest) {
+// AddedOperatorSetEpoch returns the value that was added to the "operator_set_epoch" field in this mutation.
+func (m *SigningKeyshareMutation) AddedOperatorSetEpoch() (r int64, exists bool) {
+	v := m.addoperator_set_epoch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOperatorSetEpoch resets all changes to the "operator_set_epoch" field.
+func (m *SigningKeyshareMutation) ResetOperatorSetEpoch() {
+	m.operator_set_epoch = nil
+	m.addoperator_set_epoch = nil
+}
+
+// Where appends a list predicates to the SigningKeyshareMutation builder.
+func (m *SigningKeyshareMutation) Where(ps ...predicate.SigningKeyshare) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PreimageRequestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SigningKeyshareMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PreimageRequestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PreimageRequest, len(ps))
+func (m *SigningKeyshareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SigningKeyshare, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2832,42 +14112,69 @@ func (m *PreimageRequestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PreimageRequestMutation) Op() Op {
+func (m *SigningKeyshareMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PreimageRequestMutation) SetOp(op Op) {
+func (m *SigningKeyshareMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PreimageRequest).
-func (m *PreimageRequestMutation) Type() string {
+// Type returns the node type of this mutation (SigningKeyshare).
+func (m *SigningKeyshareMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PreimageRequestMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *SigningKeyshareMutation) Fields() []string {
+	fields := make([]string, 0, 15)
 	if m.create_time != nil {
-		fields = append(fields, preimagerequest.FieldCreateTime)
+		fields = append(fields, signingkeyshare.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, preimagerequest.FieldUpdateTime)
-	}
-	if m.payment_hash != nil {
-		fields = append(fields, preimagerequest.FieldPaymentHash)
+		fields = append(fields, signingkeyshare.FieldUpdateTime)
 	}
 	if m.status != nil {
-		fields = append(fields, preimagerequest.FieldStatus)
+		fields = append(fields, signingkeyshare.FieldStatus)
 	}
-	if m.receiver_identity_pubkey != nil {
-		fields = append(fields, preimagerequest.FieldReceiverIdentityPubkey)
+	if m.secret_share != nil {
+		fields = append(fields, signingkeyshare.FieldSecretShare)
 	}
-	if m.preimage != nil {
-		fields = append(fields, preimagerequest.FieldPreimage)
+	if m.public_shares != nil {
+		fields = append(fields, signingkeyshare.FieldPublicShares)
+	}
+	if m.public_key != nil {
+		fields = append(fields, signingkeyshare.FieldPublicKey)
+	}
+	if m.min_signers != nil {
+		fields = append(fields, signingkeyshare.FieldMinSigners)
+	}
+	if m.coordinator_index != nil {
+		fields = append(fields, signingkeyshare.FieldCoordinatorIndex)
+	}
+	if m.encrypted_data_key != nil {
+		fields = append(fields, signingkeyshare.FieldEncryptedDataKey)
+	}
+	if m.key_version != nil {
+		fields = append(fields, signingkeyshare.FieldKeyVersion)
+	}
+	if m.share_refresh_id != nil {
+		fields = append(fields, signingkeyshare.FieldShareRefreshID)
+	}
+	if m.share_refresh_time != nil {
+		fields = append(fields, signingkeyshare.FieldShareRefreshTime)
+	}
+	if m.pending_share_refresh_id != nil {
+		fields = append(fields, signingkeyshare.FieldPendingShareRefreshID)
+	}
+	if m.pending_reshare_id != nil {
+		fields = append(fields, signingkeyshare.FieldPendingReshareID)
+	}
+	if m.operator_set_epoch != nil {
+		fields = append(fields, signingkeyshare.FieldOperatorSetEpoch)
 	}
 	return fields
 }
@@ -2875,20 +14182,38 @@ func (m *PreimageRequestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PreimageRequestMutation) Field(name string) (ent.Value, bool) {
+func (m *SigningKeyshareMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case preimagerequest.FieldCreateTime:
+	case signingkeyshare.FieldCreateTime:
 		return m.CreateTime()
-	case preimagerequest.FieldUpdateTime:
+	case signingkeyshare.FieldUpdateTime:
 		return m.UpdateTime()
-	case preimagerequest.FieldPaymentHash:
-		return m.PaymentHash()
-	case preimagerequest.FieldStatus:
+	case signingkeyshare.FieldStatus:
 		return m.Status()
-	case preimagerequest.FieldReceiverIdentityPubkey:
-		return m.ReceiverIdentityPubkey()
-	case preimagerequest.FieldPreimage:
-		return m.Preimage()
+	case signingkeyshare.FieldSecretShare:
+		return m.SecretShare()
+	case signingkeyshare.FieldPublicShares:
+		return m.PublicShares()
+	case signingkeyshare.FieldPublicKey:
+		return m.PublicKey()
+	case signingkeyshare.FieldMinSigners:
+		return m.MinSigners()
+	case signingkeyshare.FieldCoordinatorIndex:
+		return m.CoordinatorIndex()
+	case signingkeyshare.FieldEncryptedDataKey:
+		return m.EncryptedDataKey()
+	case signingkeyshare.FieldKeyVersion:
+		return m.KeyVersion()
+	case signingkeyshare.FieldShareRefreshID:
+		return m.ShareRefreshID()
+	case signingkeyshare.FieldShareRefreshTime:
+		return m.ShareRefreshTime()
+	case signingkeyshare.FieldPendingShareRefreshID:
+		return m.PendingShareRefreshID()
+	case signingkeyshare.FieldPendingReshareID:
+		return m.PendingReshareID()
+	case signingkeyshare.FieldOperatorSetEpoch:
+		return m.OperatorSetEpoch()
 	}
 	return nil, false
 }
@@ -2896,310 +14221,409 @@ func (m *PreimageRequestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PreimageRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SigningKeyshareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case preimagerequest.FieldCreateTime:
+	case signingkeyshare.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case preimagerequest.FieldUpdateTime:
+	case signingkeyshare.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case preimagerequest.FieldPaymentHash:
-		return m.OldPaymentHash(ctx)
-	case preimagerequest.FieldStatus:
+	case signingkeyshare.FieldStatus:
 		return m.OldStatus(ctx)
-	case preimagerequest.FieldReceiverIdentityPubkey:
-		return m.OldReceiverIdentityPubkey(ctx)
-	case preimagerequest.FieldPreimage:
-		return m.OldPreimage(ctx)
+	case signingkeyshare.FieldSecretShare:
+		return m.OldSecretShare(ctx)
+	case signingkeyshare.FieldPublicShares:
+		return m.OldPublicShares(ctx)
+	case signingkeyshare.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case signingkeyshare.FieldMinSigners:
+		return m.OldMinSigners(ctx)
+	case signingkeyshare.FieldCoordinatorIndex:
+		return m.OldCoordinatorIndex(ctx)
+	case signingkeyshare.FieldEncryptedDataKey:
+		return m.OldEncryptedDataKey(ctx)
+	case signingkeyshare.FieldKeyVersion:
+		return m.OldKeyVersion(ctx)
+	case signingkeyshare.FieldShareRefreshID:
+		return m.OldShareRefreshID(ctx)
+	case signingkeyshare.FieldShareRefreshTime:
+		return m.OldShareRefreshTime(ctx)
+	case signingkeyshare.FieldPendingShareRefreshID:
+		return m.OldPendingShareRefreshID(ctx)
+	case signingkeyshare.FieldPendingReshareID:
+		return m.OldPendingReshareID(ctx)
+	case signingkeyshare.FieldOperatorSetEpoch:
+		return m.OldOperatorSetEpoch(ctx)
 	}
-	return nil, fmt.Errorf("unknown PreimageRequest field %s", name)
+	return nil, fmt.Errorf("unknown SigningKeyshare field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PreimageRequestMutation) SetField(name string, value ent.Value) error {
+func (m *SigningKeyshareMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case preimagerequest.FieldCreateTime:
+	case signingkeyshare.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case preimagerequest.FieldUpdateTime:
+	case signingkeyshare.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateTime(v)
+		m.SetUpdateTime(v)
+		return nil
+	case signingkeyshare.FieldStatus:
+		v, ok := value.(schema.SigningKeyshareStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case signingkeyshare.FieldSecretShare:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretShare(v)
+		return nil
+	case signingkeyshare.FieldPublicShares:
+		v, ok := value.(map[string][]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicShares(v)
+		return nil
+	case signingkeyshare.FieldPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case signingkeyshare.FieldMinSigners:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinSigners(v)
+		return nil
+	case signingkeyshare.FieldCoordinatorIndex:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoordinatorIndex(v)
+		return nil
+	case signingkeyshare.FieldEncryptedDataKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedDataKey(v)
+		return nil
+	case signingkeyshare.FieldKeyVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyVersion(v)
+		return nil
+	case signingkeyshare.FieldShareRefreshID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareRefreshID(v)
 		return nil
-	case preimagerequest.FieldPaymentHash:
-		v, ok := value.([]byte)
+	case signingkeyshare.FieldShareRefreshTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPaymentHash(v)
+		m.SetShareRefreshTime(v)
 		return nil
-	case preimagerequest.FieldStatus:
-		v, ok := value.(schema.PreimageRequestStatus)
+	case signingkeyshare.FieldPendingShareRefreshID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetPendingShareRefreshID(v)
 		return nil
-	case preimagerequest.FieldReceiverIdentityPubkey:
-		v, ok := value.([]byte)
+	case signingkeyshare.FieldPendingReshareID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReceiverIdentityPubkey(v)
+		m.SetPendingReshareID(v)
 		return nil
-	case preimagerequest.FieldPreimage:
-		v, ok := value.([]byte)
+	case signingkeyshare.FieldOperatorSetEpoch:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPreimage(v)
+		m.SetOperatorSetEpoch(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PreimageRequest field %s", name)
+	return fmt.Errorf("unknown SigningKeyshare field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PreimageRequestMutation) AddedFields() []string {
-	return nil
+func (m *SigningKeyshareMutation) AddedFields() []string {
+	var fields []string
+	if m.addmin_signers != nil {
+		fields = append(fields, signingkeyshare.FieldMinSigners)
+	}
+	if m.addcoordinator_index != nil {
+		fields = append(fields, signingkeyshare.FieldCoordinatorIndex)
+	}
+	if m.addoperator_set_epoch != nil {
+		fields = append(fields, signingkeyshare.FieldOperatorSetEpoch)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PreimageRequestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SigningKeyshareMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case signingkeyshare.FieldMinSigners:
+		return m.AddedMinSigners()
+	case signingkeyshare.FieldCoordinatorIndex:
+		return m.AddedCoordinatorIndex()
+	case signingkeyshare.FieldOperatorSetEpoch:
+		return m.AddedOperatorSetEpoch()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PreimageRequestMutation) AddField(name string, value ent.Value) error {
+func (m *SigningKeyshareMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case signingkeyshare.FieldMinSigners:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinSigners(v)
+		return nil
+	case signingkeyshare.FieldCoordinatorIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCoordinatorIndex(v)
+		return nil
+	case signingkeyshare.FieldOperatorSetEpoch:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOperatorSetEpoch(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PreimageRequest numeric field %s", name)
+	return fmt.Errorf("unknown SigningKeyshare numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PreimageRequestMutation) ClearedFields() []string {
+func (m *SigningKeyshareMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(preimagerequest.FieldReceiverIdentityPubkey) {
-		fields = append(fields, preimagerequest.FieldReceiverIdentityPubkey)
+	if m.FieldCleared(signingkeyshare.FieldEncryptedDataKey) {
+		fields = append(fields, signingkeyshare.FieldEncryptedDataKey)
 	}
-	if m.FieldCleared(preimagerequest.FieldPreimage) {
-		fields = append(fields, preimagerequest.FieldPreimage)
+	if m.FieldCleared(signingkeyshare.FieldKeyVersion) {
+		fields = append(fields, signingkeyshare.FieldKeyVersion)
+	}
+	if m.FieldCleared(signingkeyshare.FieldShareRefreshID) {
+		fields = append(fields, signingkeyshare.FieldShareRefreshID)
+	}
+	if m.FieldCleared(signingkeyshare.FieldShareRefreshTime) {
+		fields = append(fields, signingkeyshare.FieldShareRefreshTime)
+	}
+	if m.FieldCleared(signingkeyshare.FieldPendingShareRefreshID) {
+		fields = append(fields, signingkeyshare.FieldPendingShareRefreshID)
+	}
+	if m.FieldCleared(signingkeyshare.FieldPendingReshareID) {
+		fields = append(fields, signingkeyshare.FieldPendingReshareID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PreimageRequestMutation) FieldCleared(name string) bool {
+func (m *SigningKeyshareMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PreimageRequestMutation) ClearField(name string) error {
+func (m *SigningKeyshareMutation) ClearField(name string) error {
 	switch name {
-	case preimagerequest.FieldReceiverIdentityPubkey:
-		m.ClearReceiverIdentityPubkey()
+	case signingkeyshare.FieldEncryptedDataKey:
+		m.ClearEncryptedDataKey()
 		return nil
-	case preimagerequest.FieldPreimage:
-		m.ClearPreimage()
+	case signingkeyshare.FieldKeyVersion:
+		m.ClearKeyVersion()
+		return nil
+	case signingkeyshare.FieldShareRefreshID:
+		m.ClearShareRefreshID()
+		return nil
+	case signingkeyshare.FieldShareRefreshTime:
+		m.ClearShareRefreshTime()
+		return nil
+	case signingkeyshare.FieldPendingShareRefreshID:
+		m.ClearPendingShareRefreshID()
+		return nil
+	case signingkeyshare.FieldPendingReshareID:
+		m.ClearPendingReshareID()
 		return nil
 	}
-	return fmt.Errorf("unknown PreimageRequest nullable field %s", name)
+	return fmt.Errorf("unknown SigningKeyshare nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PreimageRequestMutation) ResetField(name string) error {
+func (m *SigningKeyshareMutation) ResetField(name string) error {
 	switch name {
-	case preimagerequest.FieldCreateTime:
+	case signingkeyshare.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case preimagerequest.FieldUpdateTime:
+	case signingkeyshare.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case preimagerequest.FieldPaymentHash:
-		m.ResetPaymentHash()
-		return nil
-	case preimagerequest.FieldStatus:
+	case signingkeyshare.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case preimagerequest.FieldReceiverIdentityPubkey:
-		m.ResetReceiverIdentityPubkey()
+	case signingkeyshare.FieldSecretShare:
+		m.ResetSecretShare()
 		return nil
-	case preimagerequest.FieldPreimage:
-		m.ResetPreimage()
+	case signingkeyshare.FieldPublicShares:
+		m.ResetPublicShares()
+		return nil
+	case signingkeyshare.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case signingkeyshare.FieldMinSigners:
+		m.ResetMinSigners()
+		return nil
+	case signingkeyshare.FieldCoordinatorIndex:
+		m.ResetCoordinatorIndex()
+		return nil
+	case signingkeyshare.FieldEncryptedDataKey:
+		m.ResetEncryptedDataKey()
+		return nil
+	case signingkeyshare.FieldKeyVersion:
+		m.ResetKeyVersion()
+		return nil
+	case signingkeyshare.FieldShareRefreshID:
+		m.ResetShareRefreshID()
+		return nil
+	case signingkeyshare.FieldShareRefreshTime:
+		m.ResetShareRefreshTime()
+		return nil
+	case signingkeyshare.FieldPendingShareRefreshID:
+		m.ResetPendingShareRefreshID()
+		return nil
+	case signingkeyshare.FieldPendingReshareID:
+		m.ResetPendingReshareID()
+		return nil
+	case signingkeyshare.FieldOperatorSetEpoch:
+		m.ResetOperatorSetEpoch()
 		return nil
 	}
-	return fmt.Errorf("unknown PreimageRequest field %s", name)
+	return fmt.Errorf("unknown SigningKeyshare field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PreimageRequestMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.transactions != nil {
-		edges = append(edges, preimagerequest.EdgeTransactions)
-	}
-	if m.preimage_shares != nil {
-		edges = append(edges, preimagerequest.EdgePreimageShares)
-	}
-	if m.transfers != nil {
-		edges = append(edges, preimagerequest.EdgeTransfers)
-	}
+func (m *SigningKeyshareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PreimageRequestMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case preimagerequest.EdgeTransactions:
-		ids := make([]ent.Value, 0, len(m.transactions))
-		for id := range m.transactions {
-			ids = append(ids, id)
-		}
-		return ids
-	case preimagerequest.EdgePreimageShares:
-		if id := m.preimage_shares; id != nil {
-			return []ent.Value{*id}
-		}
-	case preimagerequest.EdgeTransfers:
-		if id := m.transfers; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SigningKeyshareMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PreimageRequestMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedtransactions != nil {
-		edges = append(edges, preimagerequest.EdgeTransactions)
-	}
+func (m *SigningKeyshareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PreimageRequestMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case preimagerequest.EdgeTransactions:
-		ids := make([]ent.Value, 0, len(m.removedtransactions))
-		for id := range m.removedtransactions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SigningKeyshareMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PreimageRequestMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedtransactions {
-		edges = append(edges, preimagerequest.EdgeTransactions)
-	}
-	if m.clearedpreimage_shares {
-		edges = append(edges, preimagerequest.EdgePreimageShares)
-	}
-	if m.clearedtransfers {
-		edges = append(edges, preimagerequest.EdgeTransfers)
-	}
+func (m *SigningKeyshareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PreimageRequestMutation) EdgeCleared(name string) bool {
-	switch name {
-	case preimagerequest.EdgeTransactions:
-		return m.clearedtransactions
-	case preimagerequest.EdgePreimageShares:
-		return m.clearedpreimage_shares
-	case preimagerequest.EdgeTransfers:
-		return m.clearedtransfers
-	}
+func (m *SigningKeyshareMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PreimageRequestMutation) ClearEdge(name string) error {
-	switch name {
-	case preimagerequest.EdgePreimageShares:
-		m.ClearPreimageShares()
-		return nil
-	case preimagerequest.EdgeTransfers:
-		m.ClearTransfers()
-		return nil
-	}
-	return fmt.Errorf("unknown PreimageRequest unique edge %s", name)
+func (m *SigningKeyshareMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SigningKeyshare unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PreimageRequestMutation) ResetEdge(name string) error {
-	switch name {
-	case preimagerequest.EdgeTransactions:
-		m.ResetTransactions()
-		return nil
-	case preimagerequest.EdgePreimageShares:
-		m.ResetPreimageShares()
-		return nil
-	case preimagerequest.EdgeTransfers:
-		m.ResetTransfers()
-		return nil
-	}
-	return fmt.Errorf("unknown PreimageRequest edge %s", name)
+func (m *SigningKeyshareMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SigningKeyshare edge %s", name)
 }
 
-// PreimageShareMutation represents an operation that mutates the PreimageShare nodes in the graph.
-type PreimageShareMutation struct {
+// SigningNonceMutation represents an operation that mutates the SigningNonce nodes in the graph.
+type SigningNonceMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	create_time             *time.Time
-	update_time             *time.Time
-	payment_hash            *[]byte
-	preimage_share          *[]byte
-	threshold               *int32
-	addthreshold            *int32
-	owner_identity_pubkey   *[]byte
-	invoice_string          *string
-	clearedFields           map[string]struct{}
-	preimage_request        *uuid.UUID
-	clearedpreimage_request bool
-	done                    bool
-	oldValue                func(context.Context) (*PreimageShare, error)
-	predicates              []predicate.PreimageShare
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	create_time            *time.Time
+	update_time            *time.Time
+	nonce                  *[]byte
+	nonce_commitment       *[]byte
+	message                *[]byte
+	binding_hash           *[]byte
+	used_time              *time.Time
+	encrypted_data_key     *[]byte
+	key_version            *string
+	coordinator_public_key *[]byte
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*SigningNonce, error)
+	predicates             []predicate.SigningNonce
 }
 
-var _ ent.Mutation = (*PreimageShareMutation)(nil)
+var _ ent.Mutation = (*SigningNonceMutation)(nil)
 
-// preimageshareOption allows management of the mutation configuration using functional options.
-type preimageshareOption func(*PreimageShareMutation)
+// signingnonceOption allows management of the mutation configuration using functional options.
+type signingnonceOption func(*SigningNonceMutation)
 
-// newPreimageShareMutation creates new mutation for the PreimageShare entity.
-func newPreimageShareMutation(c config, op Op, opts ...preimageshareOption) *PreimageShareMutation {
-	m := &PreimageShareMutation{
+// newSigningNonceMutation creates new mutation for the SigningNonce entity.
+func newSigningNonceMutation(c config, op Op, opts ...signingnonceOption) *SigningNonceMutation {
+	m := &SigningNonceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePreimageShare,
+		typ:           TypeSigningNonce,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3208,20 +14632,20 @@ func newPreimageShareMutation(c config, op Op, opts ...preimageshareOption) *Pre
 	return m
 }
 
-// withPreimageShareID sets the ID field of the mutation.
-func withPreimageShareID(id uuid.UUID) preimageshareOption {
-	return func(m *PreimageShareMutation) {
+// withSigningNonceID sets the ID field of the mutation.
+func withSigningNonceID(id uuid.UUID) signingnonceOption {
+	return func(m *SigningNonceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PreimageShare
+			value *SigningNonce
 		)
-		m.oldValue = func(ctx context.Context) (*PreimageShare, error) {
+		m.oldValue = func(ctx context.Context) (*SigningNonce, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PreimageShare.Get(ctx, id)
+					value, err = m.Client().SigningNonce.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3230,10 +14654,10 @@ func withPreimageShareID(id uuid.UUID) preimageshareOption {
 	}
 }
 
-// withPreimageShare sets the old PreimageShare of the mutation.
-func withPreimageShare(node *PreimageShare) preimageshareOption {
-	return func(m *PreimageShareMutation) {
-		m.oldValue = func(context.Context) (*PreimageShare, error) {
+// withSigningNonce sets the old SigningNonce of the mutation.
+func withSigningNonce(node *SigningNonce) signingnonceOption {
+	return func(m *SigningNonceMutation) {
+		m.oldValue = func(context.Context) (*SigningNonce, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3242,7 +14666,7 @@ func withPreimageShare(node *PreimageShare) preimageshareOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PreimageShareMutation) Client() *Client {
+func (m SigningNonceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3250,7 +14674,7 @@ func (m PreimageShareMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PreimageShareMutation) Tx() (*Tx, error) {
+func (m SigningNonceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3260,14 +14684,14 @@ func (m PreimageShareMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PreimageShare entities.
-func (m *PreimageShareMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SigningNonce entities.
+func (m *SigningNonceMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PreimageShareMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SigningNonceMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3278,7 +14702,7 @@ func (m *PreimageShareMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PreimageShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SigningNonceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3287,19 +14711,19 @@ func (m *PreimageShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PreimageShare.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SigningNonce.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *PreimageShareMutation) SetCreateTime(t time.Time) {
+func (m *SigningNonceMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *PreimageShareMutation) CreateTime() (r time.Time, exists bool) {
+func (m *SigningNonceMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -3307,10 +14731,10 @@ func (m *PreimageShareMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the PreimageShare entity.
-// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the SigningNonce entity.
+// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageShareMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *SigningNonceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -3325,17 +14749,17 @@ func (m *PreimageShareMutation) OldCreateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *PreimageShareMutation) ResetCreateTime() {
+func (m *SigningNonceMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *PreimageShareMutation) SetUpdateTime(t time.Time) {
+func (m *SigningNonceMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *PreimageShareMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *SigningNonceMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -3343,10 +14767,10 @@ func (m *PreimageShareMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the PreimageShare entity.
-// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the SigningNonce entity.
+// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageShareMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *SigningNonceMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -3361,258 +14785,385 @@ func (m *PreimageShareMutation) OldUpdateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *PreimageShareMutation) ResetUpdateTime() {
+func (m *SigningNonceMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetPaymentHash sets the "payment_hash" field.
-func (m *PreimageShareMutation) SetPaymentHash(b []byte) {
-	m.payment_hash = &b
+// SetNonce sets the "nonce" field.
+func (m *SigningNonceMutation) SetNonce(b []byte) {
+	m.nonce = &b
 }
 
-// PaymentHash returns the value of the "payment_hash" field in the mutation.
-func (m *PreimageShareMutation) PaymentHash() (r []byte, exists bool) {
-	v := m.payment_hash
+// Nonce returns the value of the "nonce" field in the mutation.
+func (m *SigningNonceMutation) Nonce() (r []byte, exists bool) {
+	v := m.nonce
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPaymentHash returns the old "payment_hash" field's value of the PreimageShare entity.
-// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// OldNonce returns the old "nonce" field's value of the SigningNonce entity.
+// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageShareMutation) OldPaymentHash(ctx context.Context) (v []byte, err error) {
+func (m *SigningNonceMutation) OldNonce(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPaymentHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPaymentHash requires an ID field in the mutation")
+		return v, errors.New("OldNonce requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPaymentHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
 	}
-	return oldValue.PaymentHash, nil
+	return oldValue.Nonce, nil
 }
 
-// ResetPaymentHash resets all changes to the "payment_hash" field.
-func (m *PreimageShareMutation) ResetPaymentHash() {
-	m.payment_hash = nil
+// ResetNonce resets all changes to the "nonce" field.
+func (m *SigningNonceMutation) ResetNonce() {
+	m.nonce = nil
 }
 
-// SetPreimageShare sets the "preimage_share" field.
-func (m *PreimageShareMutation) SetPreimageShare(b []byte) {
-	m.preimage_share = &b
+// SetNonceCommitment sets the "nonce_commitment" field.
+func (m *SigningNonceMutation) SetNonceCommitment(b []byte) {
+	m.nonce_commitment = &b
 }
 
-// PreimageShare returns the value of the "preimage_share" field in the mutation.
-func (m *PreimageShareMutation) PreimageShare() (r []byte, exists bool) {
-	v := m.preimage_share
+// NonceCommitment returns the value of the "nonce_commitment" field in the mutation.
+func (m *SigningNonceMutation) NonceCommitment() (r []byte, exists bool) {
+	v := m.nonce_commitment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPreimageShare returns the old "preimage_share" field's value of the PreimageShare entity.
-// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// OldNonceCommitment returns the old "nonce_commitment" field's value of the SigningNonce entity.
+// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageShareMutation) OldPreimageShare(ctx context.Context) (v []byte, err error) {
+func (m *SigningNonceMutation) OldNonceCommitment(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPreimageShare is only allowed on UpdateOne operations")
+		return v, errors.New("OldNonceCommitment is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPreimageShare requires an ID field in the mutation")
+		return v, errors.New("OldNonceCommitment requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPreimageShare: %w", err)
+		return v, fmt.Errorf("querying old value for OldNonceCommitment: %w", err)
 	}
-	return oldValue.PreimageShare, nil
+	return oldValue.NonceCommitment, nil
 }
 
-// ResetPreimageShare resets all changes to the "preimage_share" field.
-func (m *PreimageShareMutation) ResetPreimageShare() {
-	m.preimage_share = nil
+// ResetNonceCommitment resets all changes to the "nonce_commitment" field.
+func (m *SigningNonceMutation) ResetNonceCommitment() {
+	m.nonce_commitment = nil
 }
 
-// SetThreshold sets the "threshold" field.
-func (m *PreimageShareMutation) SetThreshold(i int32) {
-	m.threshold = &i
-	m.addthreshold = nil
+// SetMessage sets the "message" field.
+func (m *SigningNonceMutation) SetMessage(b []byte) {
+	m.message = &b
 }
 
-// Threshold returns the value of the "threshold" field in the mutation.
-func (m *PreimageShareMutation) Threshold() (r int32, exists bool) {
-	v := m.threshold
+// Message returns the value of the "message" field in the mutation.
+func (m *SigningNonceMutation) Message() (r []byte, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThreshold returns the old "threshold" field's value of the PreimageShare entity.
-// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the SigningNonce entity.
+// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageShareMutation) OldThreshold(ctx context.Context) (v int32, err error) {
+func (m *SigningNonceMutation) OldMessage(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThreshold is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThreshold requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThreshold: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.Threshold, nil
+	return oldValue.Message, nil
 }
 
-// AddThreshold adds i to the "threshold" field.
-func (m *PreimageShareMutation) AddThreshold(i int32) {
-	if m.addthreshold != nil {
-		*m.addthreshold += i
-	} else {
-		m.addthreshold = &i
+// ClearMessage clears the value of the "message" field.
+func (m *SigningNonceMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[signingnonce.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *SigningNonceMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[signingnonce.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *SigningNonceMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, signingnonce.FieldMessage)
+}
+
+// SetBindingHash sets the "binding_hash" field.
+func (m *SigningNonceMutation) SetBindingHash(b []byte) {
+	m.binding_hash = &b
+}
+
+// BindingHash returns the value of the "binding_hash" field in the mutation.
+func (m *SigningNonceMutation) BindingHash() (r []byte, exists bool) {
+	v := m.binding_hash
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// AddedThreshold returns the value that was added to the "threshold" field in this mutation.
-func (m *PreimageShareMutation) AddedThreshold() (r int32, exists bool) {
-	v := m.addthreshold
+// OldBindingHash returns the old "binding_hash" field's value of the SigningNonce entity.
+// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningNonceMutation) OldBindingHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBindingHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBindingHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBindingHash: %w", err)
+	}
+	return oldValue.BindingHash, nil
+}
+
+// ClearBindingHash clears the value of the "binding_hash" field.
+func (m *SigningNonceMutation) ClearBindingHash() {
+	m.binding_hash = nil
+	m.clearedFields[signingnonce.FieldBindingHash] = struct{}{}
+}
+
+// BindingHashCleared returns if the "binding_hash" field was cleared in this mutation.
+func (m *SigningNonceMutation) BindingHashCleared() bool {
+	_, ok := m.clearedFields[signingnonce.FieldBindingHash]
+	return ok
+}
+
+// ResetBindingHash resets all changes to the "binding_hash" field.
+func (m *SigningNonceMutation) ResetBindingHash() {
+	m.binding_hash = nil
+	delete(m.clearedFields, signingnonce.FieldBindingHash)
+}
+
+// SetUsedTime sets the "used_time" field.
+func (m *SigningNonceMutation) SetUsedTime(t time.Time) {
+	m.used_time = &t
+}
+
+// UsedTime returns the value of the "used_time" field in the mutation.
+func (m *SigningNonceMutation) UsedTime() (r time.Time, exists bool) {
+	v := m.used_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetThreshold resets all changes to the "threshold" field.
-func (m *PreimageShareMutation) ResetThreshold() {
-	m.threshold = nil
-	m.addthreshold = nil
+// OldUsedTime returns the old "used_time" field's value of the SigningNonce entity.
+// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningNonceMutation) OldUsedTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedTime: %w", err)
+	}
+	return oldValue.UsedTime, nil
 }
 
-// SetOwnerIdentityPubkey sets the "owner_identity_pubkey" field.
-func (m *PreimageShareMutation) SetOwnerIdentityPubkey(b []byte) {
-	m.owner_identity_pubkey = &b
+// ClearUsedTime clears the value of the "used_time" field.
+func (m *SigningNonceMutation) ClearUsedTime() {
+	m.used_time = nil
+	m.clearedFields[signingnonce.FieldUsedTime] = struct{}{}
 }
 
-// OwnerIdentityPubkey returns the value of the "owner_identity_pubkey" field in the mutation.
-func (m *PreimageShareMutation) OwnerIdentityPubkey() (r []byte, exists bool) {
-	v := m.owner_identity_pubkey
+// UsedTimeCleared returns if the "used_time" field was cleared in this mutation.
+func (m *SigningNonceMutation) UsedTimeCleared() bool {
+	_, ok := m.clearedFields[signingnonce.FieldUsedTime]
+	return ok
+}
+
+// ResetUsedTime resets all changes to the "used_time" field.
+func (m *SigningNonceMutation) ResetUsedTime() {
+	m.used_time = nil
+	delete(m.clearedFields, signingnonce.FieldUsedTime)
+}
+
+// SetEncryptedDataKey sets the "encrypted_data_key" field.
+func (m *SigningNonceMutation) SetEncryptedDataKey(b []byte) {
+	m.encrypted_data_key = &b
+}
+
+// EncryptedDataKey returns the value of the "encrypted_data_key" field in the mutation.
+func (m *SigningNonceMutation) EncryptedDataKey() (r []byte, exists bool) {
+	v := m.encrypted_data_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerIdentityPubkey returns the old "owner_identity_pubkey" field's value of the PreimageShare entity.
-// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// OldEncryptedDataKey returns the old "encrypted_data_key" field's value of the SigningNonce entity.
+// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageShareMutation) OldOwnerIdentityPubkey(ctx context.Context) (v []byte, err error) {
+func (m *SigningNonceMutation) OldEncryptedDataKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerIdentityPubkey is only allowed on UpdateOne operations")
+		return v, errors.New("OldEncryptedDataKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerIdentityPubkey requires an ID field in the mutation")
+		return v, errors.New("OldEncryptedDataKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerIdentityPubkey: %w", err)
+		return v, fmt.Errorf("querying old value for OldEncryptedDataKey: %w", err)
 	}
-	return oldValue.OwnerIdentityPubkey, nil
+	return oldValue.EncryptedDataKey, nil
 }
 
-// ResetOwnerIdentityPubkey resets all changes to the "owner_identity_pubkey" field.
-func (m *PreimageShareMutation) ResetOwnerIdentityPubkey() {
-	m.owner_identity_pubkey = nil
+// ClearEncryptedDataKey clears the value of the "encrypted_data_key" field.
+func (m *SigningNonceMutation) ClearEncryptedDataKey() {
+	m.encrypted_data_key = nil
+	m.clearedFields[signingnonce.FieldEncryptedDataKey] = struct{}{}
 }
 
-// SetInvoiceString sets the "invoice_string" field.
-func (m *PreimageShareMutation) SetInvoiceString(s string) {
-	m.invoice_string = &s
+// EncryptedDataKeyCleared returns if the "encrypted_data_key" field was cleared in this mutation.
+func (m *SigningNonceMutation) EncryptedDataKeyCleared() bool {
+	_, ok := m.clearedFields[signingnonce.FieldEncryptedDataKey]
+	return ok
 }
 
-// InvoiceString returns the value of the "invoice_string" field in the mutation.
-func (m *PreimageShareMutation) InvoiceString() (r string, exists bool) {
-	v := m.invoice_string
+// ResetEncryptedDataKey resets all changes to the "encrypted_data_key" field.
+func (m *SigningNonceMutation) ResetEncryptedDataKey() {
+	m.encrypted_data_key = nil
+	delete(m.clearedFields, signingnonce.FieldEncryptedDataKey)
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (m *SigningNonceMutation) SetKeyVersion(s string) {
+	m.key_version = &s
+}
+
+// KeyVersion returns the value of the "key_version" field in the mutation.
+func (m *SigningNonceMutation) KeyVersion() (r string, exists bool) {
+	v := m.key_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInvoiceString returns the old "invoice_string" field's value of the PreimageShare entity.
-// If the PreimageShare object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyVersion returns the old "key_version" field's value of the SigningNonce entity.
+// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PreimageShareMutation) OldInvoiceString(ctx context.Context) (v string, err error) {
+func (m *SigningNonceMutation) OldKeyVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInvoiceString is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInvoiceString requires an ID field in the mutation")
+		return v, errors.New("OldKeyVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInvoiceString: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyVersion: %w", err)
 	}
-	return oldValue.InvoiceString, nil
+	return oldValue.KeyVersion, nil
 }
 
-// ResetInvoiceString resets all changes to the "invoice_string" field.
-func (m *PreimageShareMutation) ResetInvoiceString() {
-	m.invoice_string = nil
+// ClearKeyVersion clears the value of the "key_version" field.
+func (m *SigningNonceMutation) ClearKeyVersion() {
+	m.key_version = nil
+	m.clearedFields[signingnonce.FieldKeyVersion] = struct{}{}
 }
 
-// SetPreimageRequestID sets the "preimage_request" edge to the PreimageRequest entity by id.
-func (m *PreimageShareMutation) SetPreimageRequestID(id uuid.UUID) {
-	m.preimage_request = &id
+// KeyVersionCleared returns if the "key_version" field was cleared in this mutation.
+func (m *SigningNonceMutation) KeyVersionCleared() bool {
+	_, ok := m.clearedFields[signingnonce.FieldKeyVersion]
+	return ok
 }
 
-// ClearPreimageRequest clears the "preimage_request" edge to the PreimageRequest entity.
-func (m *PreimageShareMutation) ClearPreimageRequest() {
-	m.clearedpreimage_request = true
+// ResetKeyVersion resets all changes to the "key_version" field.
+func (m *SigningNonceMutation) ResetKeyVersion() {
+	m.key_version = nil
+	delete(m.clearedFields, signingnonce.FieldKeyVersion)
 }
 
-// PreimageRequestCleared reports if the "preimage_request" edge to the PreimageRequest entity was cleared.
-func (m *PreimageShareMutation) PreimageRequestCleared() bool {
-	return m.clearedpreimage_request
+// SetCoordinatorPublicKey sets the "coordinator_public_key" field.
+func (m *SigningNonceMutation) SetCoordinatorPublicKey(b []byte) {
+	m.coordinator_public_key = &b
 }
 
-// PreimageRequestID returns the "preimage_request" edge ID in the mutation.
-func (m *PreimageShareMutation) PreimageRequestID() (id uuid.UUID, exists bool) {
-	if m.preimage_request != nil {
-		return *m.preimage_request, true
+// CoordinatorPublicKey returns the value of the "coordinator_public_key" field in the mutation.
+func (m *SigningNonceMutation) CoordinatorPublicKey() (r []byte, exists bool) {
+	v := m.coordinator_public_key
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// PreimageRequestIDs returns the "preimage_request" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PreimageRequestID instead. It exists only for internal usage by the builders.
-func (m *PreimageShareMutation) PreimageRequestIDs() (ids []uuid.UUID) {
-	if id := m.preimage_request; id != nil {
-		ids = append(ids, *id)
+// OldCoordinatorPublicKey returns the old "coordinator_public_key" field's value of the SigningNonce entity.
+// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningNonceMutation) OldCoordinatorPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoordinatorPublicKey is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoordinatorPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoordinatorPublicKey: %w", err)
+	}
+	return oldValue.CoordinatorPublicKey, nil
 }
 
-// ResetPreimageRequest resets all changes to the "preimage_request" edge.
-func (m *PreimageShareMutation) ResetPreimageRequest() {
-	m.preimage_request = nil
-	m.clearedpreimage_request = false
+// ClearCoordinatorPublicKey clears the value of the "coordinator_public_key" field.
+func (m *SigningNonceMutation) ClearCoordinatorPublicKey() {
+	m.coordinator_public_key = nil
+	m.clearedFields[signingnonce.FieldCoordinatorPublicKey] = struct{}{}
 }
 
-// Where appends a list predicates to the PreimageShareMutation builder.
-func (m *PreimageShareMutation) Where(ps ...predicate.PreimageShare) {
+// CoordinatorPublicKeyCleared returns if the "coordinator_public_key" field was cleared in this mutation.
+func (m *SigningNonceMutation) CoordinatorPublicKeyCleared() bool {
+	_, ok := m.clearedFields[signingnonce.FieldCoordinatorPublicKey]
+	return ok
+}
+
+// ResetCoordinatorPublicKey resets all changes to the "coordinator_public_key" field.
+func (m *SigningNonceMutation) ResetCoordinatorPublicKey() {
+	m.coordinator_public_key = nil
+	delete(m.clearedFields, signingnonce.FieldCoordinatorPublicKey)
+}
+
+// Where appends a list predicates to the SigningNonceMutation builder.
+func (m *SigningNonceMutation) Where(ps ...predicate.SigningNonce) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PreimageShareMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SigningNonceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PreimageShareMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PreimageShare, len(ps))
+func (m *SigningNonceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SigningNonce, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3620,45 +15171,54 @@ func (m *PreimageShareMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PreimageShareMutation) Op() Op {
+func (m *SigningNonceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PreimageShareMutation) SetOp(op Op) {
+func (m *SigningNonceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PreimageShare).
-func (m *PreimageShareMutation) Type() string {
+// Type returns the node type of this mutation (SigningNonce).
+func (m *SigningNonceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PreimageShareMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *SigningNonceMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.create_time != nil {
-		fields = append(fields, preimageshare.FieldCreateTime)
+		fields = append(fields, signingnonce.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, preimageshare.FieldUpdateTime)
+		fields = append(fields, signingnonce.FieldUpdateTime)
 	}
-	if m.payment_hash != nil {
-		fields = append(fields, preimageshare.FieldPaymentHash)
+	if m.nonce != nil {
+		fields = append(fields, signingnonce.FieldNonce)
 	}
-	if m.preimage_share != nil {
-		fields = append(fields, preimageshare.FieldPreimageShare)
+	if m.nonce_commitment != nil {
+		fields = append(fields, signingnonce.FieldNonceCommitment)
 	}
-	if m.threshold != nil {
-		fields = append(fields, preimageshare.FieldThreshold)
+	if m.message != nil {
+		fields = append(fields, signingnonce.FieldMessage)
 	}
-	if m.owner_identity_pubkey != nil {
-		fields = append(fields, preimageshare.FieldOwnerIdentityPubkey)
+	if m.binding_hash != nil {
+		fields = append(fields, signingnonce.FieldBindingHash)
 	}
-	if m.invoice_string != nil {
-		fields = append(fields, preimageshare.FieldInvoiceString)
+	if m.used_time != nil {
+		fields = append(fields, signingnonce.FieldUsedTime)
+	}
+	if m.encrypted_data_key != nil {
+		fields = append(fields, signingnonce.FieldEncryptedDataKey)
+	}
+	if m.key_version != nil {
+		fields = append(fields, signingnonce.FieldKeyVersion)
+	}
+	if m.coordinator_public_key != nil {
+		fields = append(fields, signingnonce.FieldCoordinatorPublicKey)
 	}
 	return fields
 }
@@ -3666,22 +15226,28 @@ func (m *PreimageShareMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PreimageShareMutation) Field(name string) (ent.Value, bool) {
+func (m *SigningNonceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case preimageshare.FieldCreateTime:
+	case signingnonce.FieldCreateTime:
 		return m.CreateTime()
-	case preimageshare.FieldUpdateTime:
+	case signingnonce.FieldUpdateTime:
 		return m.UpdateTime()
-	case preimageshare.FieldPaymentHash:
-		return m.PaymentHash()
-	case preimageshare.FieldPreimageShare:
-		return m.PreimageShare()
-	case preimageshare.FieldThreshold:
-		return m.Threshold()
-	case preimageshare.FieldOwnerIdentityPubkey:
-		return m.OwnerIdentityPubkey()
-	case preimageshare.FieldInvoiceString:
-		return m.InvoiceString()
+	case signingnonce.FieldNonce:
+		return m.Nonce()
+	case signingnonce.FieldNonceCommitment:
+		return m.NonceCommitment()
+	case signingnonce.FieldMessage:
+		return m.Message()
+	case signingnonce.FieldBindingHash:
+		return m.BindingHash()
+	case signingnonce.FieldUsedTime:
+		return m.UsedTime()
+	case signingnonce.FieldEncryptedDataKey:
+		return m.EncryptedDataKey()
+	case signingnonce.FieldKeyVersion:
+		return m.KeyVersion()
+	case signingnonce.FieldCoordinatorPublicKey:
+		return m.CoordinatorPublicKey()
 	}
 	return nil, false
 }
@@ -3689,276 +15255,305 @@ func (m *PreimageShareMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PreimageShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SigningNonceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case preimageshare.FieldCreateTime:
+	case signingnonce.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case preimageshare.FieldUpdateTime:
+	case signingnonce.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case preimageshare.FieldPaymentHash:
-		return m.OldPaymentHash(ctx)
-	case preimageshare.FieldPreimageShare:
-		return m.OldPreimageShare(ctx)
-	case preimageshare.FieldThreshold:
-		return m.OldThreshold(ctx)
-	case preimageshare.FieldOwnerIdentityPubkey:
-		return m.OldOwnerIdentityPubkey(ctx)
-	case preimageshare.FieldInvoiceString:
-		return m.OldInvoiceString(ctx)
+	case signingnonce.FieldNonce:
+		return m.OldNonce(ctx)
+	case signingnonce.FieldNonceCommitment:
+		return m.OldNonceCommitment(ctx)
+	case signingnonce.FieldMessage:
+		return m.OldMessage(ctx)
+	case signingnonce.FieldBindingHash:
+		return m.OldBindingHash(ctx)
+	case signingnonce.FieldUsedTime:
+		return m.OldUsedTime(ctx)
+	case signingnonce.FieldEncryptedDataKey:
+		return m.OldEncryptedDataKey(ctx)
+	case signingnonce.FieldKeyVersion:
+		return m.OldKeyVersion(ctx)
+	case signingnonce.FieldCoordinatorPublicKey:
+		return m.OldCoordinatorPublicKey(ctx)
 	}
-	return nil, fmt.Errorf("unknown PreimageShare field %s", name)
+	return nil, fmt.Errorf("unknown SigningNonce field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PreimageShareMutation) SetField(name string, value ent.Value) error {
+func (m *SigningNonceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case preimageshare.FieldCreateTime:
+	case signingnonce.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case preimageshare.FieldUpdateTime:
+	case signingnonce.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case preimageshare.FieldPaymentHash:
+	case signingnonce.FieldNonce:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPaymentHash(v)
+		m.SetNonce(v)
 		return nil
-	case preimageshare.FieldPreimageShare:
+	case signingnonce.FieldNonceCommitment:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPreimageShare(v)
+		m.SetNonceCommitment(v)
 		return nil
-	case preimageshare.FieldThreshold:
-		v, ok := value.(int32)
+	case signingnonce.FieldMessage:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetThreshold(v)
+		m.SetMessage(v)
 		return nil
-	case preimageshare.FieldOwnerIdentityPubkey:
+	case signingnonce.FieldBindingHash:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerIdentityPubkey(v)
+		m.SetBindingHash(v)
 		return nil
-	case preimageshare.FieldInvoiceString:
+	case signingnonce.FieldUsedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedTime(v)
+		return nil
+	case signingnonce.FieldEncryptedDataKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedDataKey(v)
+		return nil
+	case signingnonce.FieldKeyVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInvoiceString(v)
+		m.SetKeyVersion(v)
+		return nil
+	case signingnonce.FieldCoordinatorPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoordinatorPublicKey(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PreimageShare field %s", name)
+	return fmt.Errorf("unknown SigningNonce field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PreimageShareMutation) AddedFields() []string {
-	var fields []string
-	if m.addthreshold != nil {
-		fields = append(fields, preimageshare.FieldThreshold)
-	}
-	return fields
+func (m *SigningNonceMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PreimageShareMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case preimageshare.FieldThreshold:
-		return m.AddedThreshold()
-	}
+func (m *SigningNonceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PreimageShareMutation) AddField(name string, value ent.Value) error {
+func (m *SigningNonceMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case preimageshare.FieldThreshold:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddThreshold(v)
-		return nil
 	}
-	return fmt.Errorf("unknown PreimageShare numeric field %s", name)
+	return fmt.Errorf("unknown SigningNonce numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PreimageShareMutation) ClearedFields() []string {
-	return nil
+func (m *SigningNonceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(signingnonce.FieldMessage) {
+		fields = append(fields, signingnonce.FieldMessage)
+	}
+	if m.FieldCleared(signingnonce.FieldBindingHash) {
+		fields = append(fields, signingnonce.FieldBindingHash)
+	}
+	if m.FieldCleared(signingnonce.FieldUsedTime) {
+		fields = append(fields, signingnonce.FieldUsedTime)
+	}
+	if m.FieldCleared(signingnonce.FieldEncryptedDataKey) {
+		fields = append(fields, signingnonce.FieldEncryptedDataKey)
+	}
+	if m.FieldCleared(signingnonce.FieldKeyVersion) {
+		fields = append(fields, signingnonce.FieldKeyVersion)
+	}
+	if m.FieldCleared(signingnonce.FieldCoordinatorPublicKey) {
+		fields = append(fields, signingnonce.FieldCoordinatorPublicKey)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PreimageShareMutation) FieldCleared(name string) bool {
+func (m *SigningNonceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PreimageShareMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PreimageShare nullable field %s", name)
+func (m *SigningNonceMutation) ClearField(name string) error {
+	switch name {
+	case signingnonce.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case signingnonce.FieldBindingHash:
+		m.ClearBindingHash()
+		return nil
+	case signingnonce.FieldUsedTime:
+		m.ClearUsedTime()
+		return nil
+	case signingnonce.FieldEncryptedDataKey:
+		m.ClearEncryptedDataKey()
+		return nil
+	case signingnonce.FieldKeyVersion:
+		m.ClearKeyVersion()
+		return nil
+	case signingnonce.FieldCoordinatorPublicKey:
+		m.ClearCoordinatorPublicKey()
+		return nil
+	}
+	return fmt.Errorf("unknown SigningNonce nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PreimageShareMutation) ResetField(name string) error {
+func (m *SigningNonceMutation) ResetField(name string) error {
 	switch name {
-	case preimageshare.FieldCreateTime:
+	case signingnonce.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case preimageshare.FieldUpdateTime:
+	case signingnonce.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case preimageshare.FieldPaymentHash:
-		m.ResetPaymentHash()
+	case signingnonce.FieldNonce:
+		m.ResetNonce()
 		return nil
-	case preimageshare.FieldPreimageShare:
-		m.ResetPreimageShare()
+	case signingnonce.FieldNonceCommitment:
+		m.ResetNonceCommitment()
 		return nil
-	case preimageshare.FieldThreshold:
-		m.ResetThreshold()
+	case signingnonce.FieldMessage:
+		m.ResetMessage()
 		return nil
-	case preimageshare.FieldOwnerIdentityPubkey:
-		m.ResetOwnerIdentityPubkey()
+	case signingnonce.FieldBindingHash:
+		m.ResetBindingHash()
 		return nil
-	case preimageshare.FieldInvoiceString:
-		m.ResetInvoiceString()
+	case signingnonce.FieldUsedTime:
+		m.ResetUsedTime()
+		return nil
+	case signingnonce.FieldEncryptedDataKey:
+		m.ResetEncryptedDataKey()
+		return nil
+	case signingnonce.FieldKeyVersion:
+		m.ResetKeyVersion()
+		return nil
+	case signingnonce.FieldCoordinatorPublicKey:
+		m.ResetCoordinatorPublicKey()
 		return nil
 	}
-	return fmt.Errorf("unknown PreimageShare field %s", name)
+	return fmt.Errorf("unknown SigningNonce field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PreimageShareMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.preimage_request != nil {
-		edges = append(edges, preimageshare.EdgePreimageRequest)
-	}
+func (m *SigningNonceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PreimageShareMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case preimageshare.EdgePreimageRequest:
-		if id := m.preimage_request; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SigningNonceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PreimageShareMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *SigningNonceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PreimageShareMutation) RemovedIDs(name string) []ent.Value {
+func (m *SigningNonceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PreimageShareMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedpreimage_request {
-		edges = append(edges, preimageshare.EdgePreimageRequest)
-	}
+func (m *SigningNonceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PreimageShareMutation) EdgeCleared(name string) bool {
-	switch name {
-	case preimageshare.EdgePreimageRequest:
-		return m.clearedpreimage_request
-	}
+func (m *SigningNonceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PreimageShareMutation) ClearEdge(name string) error {
-	switch name {
-	case preimageshare.EdgePreimageRequest:
-		m.ClearPreimageRequest()
-		return nil
-	}
-	return fmt.Errorf("unknown PreimageShare unique edge %s", name)
+func (m *SigningNonceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SigningNonce unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PreimageShareMutation) ResetEdge(name string) error {
-	switch name {
-	case preimageshare.EdgePreimageRequest:
-		m.ResetPreimageRequest()
-		return nil
-	}
-	return fmt.Errorf("unknown PreimageShare edge %s", name)
+func (m *SigningNonceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SigningNonce edge %s", name)
 }
 
-// SigningKeyshareMutation represents an operation that mutates the SigningKeyshare nodes in the graph.
-type SigningKeyshareMutation struct {
+// TaskLockMutation represents an operation that mutates the TaskLock nodes in the graph.
+type TaskLockMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	create_time          *time.Time
-	update_time          *time.Time
-	status               *schema.SigningKeyshareStatus
-	secret_share         *[]byte
-	public_shares        *map[string][]uint8
-	public_key           *[]byte
-	min_signers          *int32
-	addmin_signers       *int32
-	coordinator_index    *uint64
-	addcoordinator_index *int64
-	clearedFields        map[string]struct{}
-	done                 bool
-	oldValue             func(context.Context) (*SigningKeyshare, error)
-	predicates           []predicate.SigningKeyshare
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	holder        *string
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TaskLock, error)
+	predicates    []predicate.TaskLock
 }
 
-var _ ent.Mutation = (*SigningKeyshareMutation)(nil)
+var _ ent.Mutation = (*TaskLockMutation)(nil)
 
-// signingkeyshareOption allows management of the mutation configuration using functional options.
-type signingkeyshareOption func(*SigningKeyshareMutation)
+// tasklockOption allows management of the mutation configuration using functional options.
+type tasklockOption func(*TaskLockMutation)
 
-// newSigningKeyshareMutation creates new mutation for the SigningKeyshare entity.
-func newSigningKeyshareMutation(c config, op Op, opts ...signingkeyshareOption) *SigningKeyshareMutation {
-	m := &SigningKeyshareMutation{
+// newTaskLockMutation creates new mutation for the TaskLock entity.
+func newTaskLockMutation(c config, op Op, opts ...tasklockOption) *TaskLockMutation {
+	m := &TaskLockMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSigningKeyshare,
+		typ:           TypeTaskLock,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3967,20 +15562,20 @@ func newSigningKeyshareMutation(c config, op Op, opts ...signingkeyshareOption)
 	return m
 }
 
-// withSigningKeyshareID sets the ID field of the mutation.
-func withSigningKeyshareID(id uuid.UUID) signingkeyshareOption {
-	return func(m *SigningKeyshareMutation) {
+// withTaskLockID sets the ID field of the mutation.
+func withTaskLockID(id uuid.UUID) tasklockOption {
+	return func(m *TaskLockMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SigningKeyshare
+			value *TaskLock
 		)
-		m.oldValue = func(ctx context.Context) (*SigningKeyshare, error) {
+		m.oldValue = func(ctx context.Context) (*TaskLock, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SigningKeyshare.Get(ctx, id)
+					value, err = m.Client().TaskLock.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3989,10 +15584,10 @@ func withSigningKeyshareID(id uuid.UUID) signingkeyshareOption {
 	}
 }
 
-// withSigningKeyshare sets the old SigningKeyshare of the mutation.
-func withSigningKeyshare(node *SigningKeyshare) signingkeyshareOption {
-	return func(m *SigningKeyshareMutation) {
-		m.oldValue = func(context.Context) (*SigningKeyshare, error) {
+// withTaskLock sets the old TaskLock of the mutation.
+func withTaskLock(node *TaskLock) tasklockOption {
+	return func(m *TaskLockMutation) {
+		m.oldValue = func(context.Context) (*TaskLock, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4001,7 +15596,7 @@ func withSigningKeyshare(node *SigningKeyshare) signingkeyshareOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SigningKeyshareMutation) Client() *Client {
+func (m TaskLockMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4009,386 +15604,238 @@ func (m SigningKeyshareMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SigningKeyshareMutation) Tx() (*Tx, error) {
+func (m TaskLockMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
 	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SigningKeyshare entities.
-func (m *SigningKeyshareMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SigningKeyshareMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SigningKeyshareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SigningKeyshare.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetCreateTime sets the "create_time" field.
-func (m *SigningKeyshareMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
-}
-
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *SigningKeyshareMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreateTime returns the old "create_time" field's value of the SigningKeyshare entity.
-// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningKeyshareMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
-	}
-	return oldValue.CreateTime, nil
-}
-
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *SigningKeyshareMutation) ResetCreateTime() {
-	m.create_time = nil
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (m *SigningKeyshareMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
-}
-
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *SigningKeyshareMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdateTime returns the old "update_time" field's value of the SigningKeyshare entity.
-// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningKeyshareMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
-	}
-	return oldValue.UpdateTime, nil
-}
-
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *SigningKeyshareMutation) ResetUpdateTime() {
-	m.update_time = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *SigningKeyshareMutation) SetStatus(sks schema.SigningKeyshareStatus) {
-	m.status = &sks
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *SigningKeyshareMutation) Status() (r schema.SigningKeyshareStatus, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the SigningKeyshare entity.
-// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningKeyshareMutation) OldStatus(ctx context.Context) (v schema.SigningKeyshareStatus, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TaskLock entities.
+func (m *TaskLockMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskLockMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.Status, nil
+	return *m.id, true
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SigningKeyshareMutation) ResetStatus() {
-	m.status = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskLockMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskLock.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetSecretShare sets the "secret_share" field.
-func (m *SigningKeyshareMutation) SetSecretShare(b []byte) {
-	m.secret_share = &b
+// SetCreateTime sets the "create_time" field.
+func (m *TaskLockMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// SecretShare returns the value of the "secret_share" field in the mutation.
-func (m *SigningKeyshareMutation) SecretShare() (r []byte, exists bool) {
-	v := m.secret_share
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TaskLockMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSecretShare returns the old "secret_share" field's value of the SigningKeyshare entity.
-// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TaskLock entity.
+// If the TaskLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningKeyshareMutation) OldSecretShare(ctx context.Context) (v []byte, err error) {
+func (m *TaskLockMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecretShare is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecretShare requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecretShare: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.SecretShare, nil
+	return oldValue.CreateTime, nil
 }
 
-// ResetSecretShare resets all changes to the "secret_share" field.
-func (m *SigningKeyshareMutation) ResetSecretShare() {
-	m.secret_share = nil
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TaskLockMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetPublicShares sets the "public_shares" field.
-func (m *SigningKeyshareMutation) SetPublicShares(value map[string][]uint8) {
-	m.public_shares = &value
+// SetUpdateTime sets the "update_time" field.
+func (m *TaskLockMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// PublicShares returns the value of the "public_shares" field in the mutation.
-func (m *SigningKeyshareMutation) PublicShares() (r map[string][]uint8, exists bool) {
-	v := m.public_shares
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TaskLockMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublicShares returns the old "public_shares" field's value of the SigningKeyshare entity.
-// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TaskLock entity.
+// If the TaskLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningKeyshareMutation) OldPublicShares(ctx context.Context) (v map[string][]uint8, err error) {
+func (m *TaskLockMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublicShares is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublicShares requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublicShares: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.PublicShares, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ResetPublicShares resets all changes to the "public_shares" field.
-func (m *SigningKeyshareMutation) ResetPublicShares() {
-	m.public_shares = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TaskLockMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetPublicKey sets the "public_key" field.
-func (m *SigningKeyshareMutation) SetPublicKey(b []byte) {
-	m.public_key = &b
+// SetName sets the "name" field.
+func (m *TaskLockMutation) SetName(s string) {
+	m.name = &s
 }
 
-// PublicKey returns the value of the "public_key" field in the mutation.
-func (m *SigningKeyshareMutation) PublicKey() (r []byte, exists bool) {
-	v := m.public_key
+// Name returns the value of the "name" field in the mutation.
+func (m *TaskLockMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublicKey returns the old "public_key" field's value of the SigningKeyshare entity.
-// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the TaskLock entity.
+// If the TaskLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningKeyshareMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
+func (m *TaskLockMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.PublicKey, nil
+	return oldValue.Name, nil
 }
 
-// ResetPublicKey resets all changes to the "public_key" field.
-func (m *SigningKeyshareMutation) ResetPublicKey() {
-	m.public_key = nil
+// ResetName resets all changes to the "name" field.
+func (m *TaskLockMutation) ResetName() {
+	m.name = nil
 }
 
-// SetMinSigners sets the "min_signers" field.
-func (m *SigningKeyshareMutation) SetMinSigners(i int32) {
-	m.min_signers = &i
-	m.addmin_signers = nil
+// SetHolder sets the "holder" field.
+func (m *TaskLockMutation) SetHolder(s string) {
+	m.holder = &s
 }
 
-// MinSigners returns the value of the "min_signers" field in the mutation.
-func (m *SigningKeyshareMutation) MinSigners() (r int32, exists bool) {
-	v := m.min_signers
+// Holder returns the value of the "holder" field in the mutation.
+func (m *TaskLockMutation) Holder() (r string, exists bool) {
+	v := m.holder
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMinSigners returns the old "min_signers" field's value of the SigningKeyshare entity.
-// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// OldHolder returns the old "holder" field's value of the TaskLock entity.
+// If the TaskLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningKeyshareMutation) OldMinSigners(ctx context.Context) (v int32, err error) {
+func (m *TaskLockMutation) OldHolder(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMinSigners is only allowed on UpdateOne operations")
+		return v, errors.New("OldHolder is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMinSigners requires an ID field in the mutation")
+		return v, errors.New("OldHolder requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMinSigners: %w", err)
-	}
-	return oldValue.MinSigners, nil
-}
-
-// AddMinSigners adds i to the "min_signers" field.
-func (m *SigningKeyshareMutation) AddMinSigners(i int32) {
-	if m.addmin_signers != nil {
-		*m.addmin_signers += i
-	} else {
-		m.addmin_signers = &i
-	}
-}
-
-// AddedMinSigners returns the value that was added to the "min_signers" field in this mutation.
-func (m *SigningKeyshareMutation) AddedMinSigners() (r int32, exists bool) {
-	v := m.addmin_signers
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldHolder: %w", err)
 	}
-	return *v, true
+	return oldValue.Holder, nil
 }
 
-// ResetMinSigners resets all changes to the "min_signers" field.
-func (m *SigningKeyshareMutation) ResetMinSigners() {
-	m.min_signers = nil
-	m.addmin_signers = nil
+// ResetHolder resets all changes to the "holder" field.
+func (m *TaskLockMutation) ResetHolder() {
+	m.holder = nil
 }
 
-// SetCoordinatorIndex sets the "coordinator_index" field.
-func (m *SigningKeyshareMutation) SetCoordinatorIndex(u uint64) {
-	m.coordinator_index = &u
-	m.addcoordinator_index = nil
+// SetExpiresAt sets the "expires_at" field.
+func (m *TaskLockMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// CoordinatorIndex returns the value of the "coordinator_index" field in the mutation.
-func (m *SigningKeyshareMutation) CoordinatorIndex() (r uint64, exists bool) {
-	v := m.coordinator_index
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TaskLockMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCoordinatorIndex returns the old "coordinator_index" field's value of the SigningKeyshare entity.
-// If the SigningKeyshare object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the TaskLock entity.
+// If the TaskLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningKeyshareMutation) OldCoordinatorIndex(ctx context.Context) (v uint64, err error) {
+func (m *TaskLockMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCoordinatorIndex is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCoordinatorIndex requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCoordinatorIndex: %w", err)
-	}
-	return oldValue.CoordinatorIndex, nil
-}
-
-// AddCoordinatorIndex adds u to the "coordinator_index" field.
-func (m *SigningKeyshareMutation) AddCoordinatorIndex(u int64) {
-	if m.addcoordinator_index != nil {
-		*m.addcoordinator_index += u
-	} else {
-		m.addcoordinator_index = &u
-	}
-}
-
-// AddedCoordinatorIndex returns the value that was added to the "coordinator_index" field in this mutation.
-func (m *SigningKeyshareMutation) AddedCoordinatorIndex() (r int64, exists bool) {
-	v := m.addcoordinator_index
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return *v, true
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetCoordinatorIndex resets all changes to the "coordinator_index" field.
-func (m *SigningKeyshareMutation) ResetCoordinatorIndex() {
-	m.coordinator_index = nil
-	m.addcoordinator_index = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TaskLockMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// Where appends a list predicates to the SigningKeyshareMutation builder.
-func (m *SigningKeyshareMutation) Where(ps ...predicate.SigningKeyshare) {
+// Where appends a list predicates to the TaskLockMutation builder.
+func (m *TaskLockMutation) Where(ps ...predicate.TaskLock) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SigningKeyshareMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TaskLockMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SigningKeyshareMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SigningKeyshare, len(ps))
+func (m *TaskLockMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskLock, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4396,48 +15843,39 @@ func (m *SigningKeyshareMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SigningKeyshareMutation) Op() Op {
+func (m *TaskLockMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SigningKeyshareMutation) SetOp(op Op) {
+func (m *TaskLockMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SigningKeyshare).
-func (m *SigningKeyshareMutation) Type() string {
+// Type returns the node type of this mutation (TaskLock).
+func (m *TaskLockMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SigningKeyshareMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *TaskLockMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.create_time != nil {
-		fields = append(fields, signingkeyshare.FieldCreateTime)
+		fields = append(fields, tasklock.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, signingkeyshare.FieldUpdateTime)
-	}
-	if m.status != nil {
-		fields = append(fields, signingkeyshare.FieldStatus)
-	}
-	if m.secret_share != nil {
-		fields = append(fields, signingkeyshare.FieldSecretShare)
+		fields = append(fields, tasklock.FieldUpdateTime)
 	}
-	if m.public_shares != nil {
-		fields = append(fields, signingkeyshare.FieldPublicShares)
-	}
-	if m.public_key != nil {
-		fields = append(fields, signingkeyshare.FieldPublicKey)
+	if m.name != nil {
+		fields = append(fields, tasklock.FieldName)
 	}
-	if m.min_signers != nil {
-		fields = append(fields, signingkeyshare.FieldMinSigners)
+	if m.holder != nil {
+		fields = append(fields, tasklock.FieldHolder)
 	}
-	if m.coordinator_index != nil {
-		fields = append(fields, signingkeyshare.FieldCoordinatorIndex)
+	if m.expires_at != nil {
+		fields = append(fields, tasklock.FieldExpiresAt)
 	}
 	return fields
 }
@@ -4445,24 +15883,18 @@ func (m *SigningKeyshareMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SigningKeyshareMutation) Field(name string) (ent.Value, bool) {
+func (m *TaskLockMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case signingkeyshare.FieldCreateTime:
+	case tasklock.FieldCreateTime:
 		return m.CreateTime()
-	case signingkeyshare.FieldUpdateTime:
+	case tasklock.FieldUpdateTime:
 		return m.UpdateTime()
-	case signingkeyshare.FieldStatus:
-		return m.Status()
-	case signingkeyshare.FieldSecretShare:
-		return m.SecretShare()
-	case signingkeyshare.FieldPublicShares:
-		return m.PublicShares()
-	case signingkeyshare.FieldPublicKey:
-		return m.PublicKey()
-	case signingkeyshare.FieldMinSigners:
-		return m.MinSigners()
-	case signingkeyshare.FieldCoordinatorIndex:
-		return m.CoordinatorIndex()
+	case tasklock.FieldName:
+		return m.Name()
+	case tasklock.FieldHolder:
+		return m.Holder()
+	case tasklock.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -4470,269 +15902,211 @@ func (m *SigningKeyshareMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SigningKeyshareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TaskLockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case signingkeyshare.FieldCreateTime:
+	case tasklock.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case signingkeyshare.FieldUpdateTime:
+	case tasklock.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case signingkeyshare.FieldStatus:
-		return m.OldStatus(ctx)
-	case signingkeyshare.FieldSecretShare:
-		return m.OldSecretShare(ctx)
-	case signingkeyshare.FieldPublicShares:
-		return m.OldPublicShares(ctx)
-	case signingkeyshare.FieldPublicKey:
-		return m.OldPublicKey(ctx)
-	case signingkeyshare.FieldMinSigners:
-		return m.OldMinSigners(ctx)
-	case signingkeyshare.FieldCoordinatorIndex:
-		return m.OldCoordinatorIndex(ctx)
+	case tasklock.FieldName:
+		return m.OldName(ctx)
+	case tasklock.FieldHolder:
+		return m.OldHolder(ctx)
+	case tasklock.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SigningKeyshare field %s", name)
+	return nil, fmt.Errorf("unknown TaskLock field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SigningKeyshareMutation) SetField(name string, value ent.Value) error {
+func (m *TaskLockMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case signingkeyshare.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case signingkeyshare.FieldUpdateTime:
+	case tasklock.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateTime(v)
-		return nil
-	case signingkeyshare.FieldStatus:
-		v, ok := value.(schema.SigningKeyshareStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case signingkeyshare.FieldSecretShare:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSecretShare(v)
+		m.SetCreateTime(v)
 		return nil
-	case signingkeyshare.FieldPublicShares:
-		v, ok := value.(map[string][]uint8)
+	case tasklock.FieldUpdateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublicShares(v)
+		m.SetUpdateTime(v)
 		return nil
-	case signingkeyshare.FieldPublicKey:
-		v, ok := value.([]byte)
+	case tasklock.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublicKey(v)
+		m.SetName(v)
 		return nil
-	case signingkeyshare.FieldMinSigners:
-		v, ok := value.(int32)
+	case tasklock.FieldHolder:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMinSigners(v)
+		m.SetHolder(v)
 		return nil
-	case signingkeyshare.FieldCoordinatorIndex:
-		v, ok := value.(uint64)
+	case tasklock.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCoordinatorIndex(v)
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SigningKeyshare field %s", name)
+	return fmt.Errorf("unknown TaskLock field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SigningKeyshareMutation) AddedFields() []string {
-	var fields []string
-	if m.addmin_signers != nil {
-		fields = append(fields, signingkeyshare.FieldMinSigners)
-	}
-	if m.addcoordinator_index != nil {
-		fields = append(fields, signingkeyshare.FieldCoordinatorIndex)
-	}
-	return fields
+func (m *TaskLockMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SigningKeyshareMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case signingkeyshare.FieldMinSigners:
-		return m.AddedMinSigners()
-	case signingkeyshare.FieldCoordinatorIndex:
-		return m.AddedCoordinatorIndex()
-	}
+func (m *TaskLockMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SigningKeyshareMutation) AddField(name string, value ent.Value) error {
+func (m *TaskLockMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case signingkeyshare.FieldMinSigners:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMinSigners(v)
-		return nil
-	case signingkeyshare.FieldCoordinatorIndex:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCoordinatorIndex(v)
-		return nil
 	}
-	return fmt.Errorf("unknown SigningKeyshare numeric field %s", name)
+	return fmt.Errorf("unknown TaskLock numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SigningKeyshareMutation) ClearedFields() []string {
+func (m *TaskLockMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SigningKeyshareMutation) FieldCleared(name string) bool {
+func (m *TaskLockMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SigningKeyshareMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SigningKeyshare nullable field %s", name)
+func (m *TaskLockMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TaskLock nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SigningKeyshareMutation) ResetField(name string) error {
+func (m *TaskLockMutation) ResetField(name string) error {
 	switch name {
-	case signingkeyshare.FieldCreateTime:
+	case tasklock.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case signingkeyshare.FieldUpdateTime:
+	case tasklock.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case signingkeyshare.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case signingkeyshare.FieldSecretShare:
-		m.ResetSecretShare()
-		return nil
-	case signingkeyshare.FieldPublicShares:
-		m.ResetPublicShares()
-		return nil
-	case signingkeyshare.FieldPublicKey:
-		m.ResetPublicKey()
+	case tasklock.FieldName:
+		m.ResetName()
 		return nil
-	case signingkeyshare.FieldMinSigners:
-		m.ResetMinSigners()
+	case tasklock.FieldHolder:
+		m.ResetHolder()
 		return nil
-	case signingkeyshare.FieldCoordinatorIndex:
-		m.ResetCoordinatorIndex()
+	case tasklock.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SigningKeyshare field %s", name)
+	return fmt.Errorf("unknown TaskLock field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SigningKeyshareMutation) AddedEdges() []string {
+func (m *TaskLockMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SigningKeyshareMutation) AddedIDs(name string) []ent.Value {
+func (m *TaskLockMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SigningKeyshareMutation) RemovedEdges() []string {
+func (m *TaskLockMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SigningKeyshareMutation) RemovedIDs(name string) []ent.Value {
+func (m *TaskLockMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SigningKeyshareMutation) ClearedEdges() []string {
+func (m *TaskLockMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SigningKeyshareMutation) EdgeCleared(name string) bool {
+func (m *TaskLockMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SigningKeyshareMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SigningKeyshare unique edge %s", name)
+func (m *TaskLockMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TaskLock unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SigningKeyshareMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SigningKeyshare edge %s", name)
+func (m *TaskLockMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TaskLock edge %s", name)
 }
 
-// SigningNonceMutation represents an operation that mutates the SigningNonce nodes in the graph.
-type SigningNonceMutation struct {
+// TaskRunMutation represents an operation that mutates the TaskRun nodes in the graph.
+type TaskRunMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	create_time      *time.Time
-	update_time      *time.Time
-	nonce            *[]byte
-	nonce_commitment *[]byte
-	message          *[]byte
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*SigningNonce, error)
-	predicates       []predicate.SigningNonce
-}
-
-var _ ent.Mutation = (*SigningNonceMutation)(nil)
-
-// signingnonceOption allows management of the mutation configuration using functional options.
-type signingnonceOption func(*SigningNonceMutation)
-
-// newSigningNonceMutation creates new mutation for the SigningNonce entity.
-func newSigningNonceMutation(c config, op Op, opts ...signingnonceOption) *SigningNonceMutation {
-	m := &SigningNonceMutation{
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	create_time        *time.Time
+	update_time        *time.Time
+	task_name          *string
+	status             *schema.TaskRunStatus
+	trigger            *schema.TaskRunTrigger
+	holder             *string
+	end_time           *time.Time
+	error              *string
+	items_processed    *int64
+	additems_processed *int64
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*TaskRun, error)
+	predicates         []predicate.TaskRun
+}
+
+var _ ent.Mutation = (*TaskRunMutation)(nil)
+
+// taskrunOption allows management of the mutation configuration using functional options.
+type taskrunOption func(*TaskRunMutation)
+
+// newTaskRunMutation creates new mutation for the TaskRun entity.
+func newTaskRunMutation(c config, op Op, opts ...taskrunOption) *TaskRunMutation {
+	m := &TaskRunMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSigningNonce,
+		typ:           TypeTaskRun,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4741,20 +16115,20 @@ func newSigningNonceMutation(c config, op Op, opts ...signingnonceOption) *Signi
 	return m
 }
 
-// withSigningNonceID sets the ID field of the mutation.
-func withSigningNonceID(id uuid.UUID) signingnonceOption {
-	return func(m *SigningNonceMutation) {
+// withTaskRunID sets the ID field of the mutation.
+func withTaskRunID(id uuid.UUID) taskrunOption {
+	return func(m *TaskRunMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SigningNonce
+			value *TaskRun
 		)
-		m.oldValue = func(ctx context.Context) (*SigningNonce, error) {
+		m.oldValue = func(ctx context.Context) (*TaskRun, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SigningNonce.Get(ctx, id)
+					value, err = m.Client().TaskRun.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4763,10 +16137,10 @@ func withSigningNonceID(id uuid.UUID) signingnonceOption {
 	}
 }
 
-// withSigningNonce sets the old SigningNonce of the mutation.
-func withSigningNonce(node *SigningNonce) signingnonceOption {
-	return func(m *SigningNonceMutation) {
-		m.oldValue = func(context.Context) (*SigningNonce, error) {
+// withTaskRun sets the old TaskRun of the mutation.
+func withTaskRun(node *TaskRun) taskrunOption {
+	return func(m *TaskRunMutation) {
+		m.oldValue = func(context.Context) (*TaskRun, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4775,7 +16149,7 @@ func withSigningNonce(node *SigningNonce) signingnonceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SigningNonceMutation) Client() *Client {
+func (m TaskRunMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4783,7 +16157,7 @@ func (m SigningNonceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SigningNonceMutation) Tx() (*Tx, error) {
+func (m TaskRunMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4793,14 +16167,14 @@ func (m SigningNonceMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SigningNonce entities.
-func (m *SigningNonceMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TaskRun entities.
+func (m *TaskRunMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SigningNonceMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TaskRunMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4811,7 +16185,7 @@ func (m *SigningNonceMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SigningNonceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TaskRunMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4820,19 +16194,19 @@ func (m *SigningNonceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SigningNonce.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TaskRun.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *SigningNonceMutation) SetCreateTime(t time.Time) {
+func (m *TaskRunMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *SigningNonceMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TaskRunMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -4840,10 +16214,10 @@ func (m *SigningNonceMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the SigningNonce entity.
-// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TaskRun entity.
+// If the TaskRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningNonceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TaskRunMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -4858,17 +16232,17 @@ func (m *SigningNonceMutation) OldCreateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *SigningNonceMutation) ResetCreateTime() {
+func (m *TaskRunMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *SigningNonceMutation) SetUpdateTime(t time.Time) {
+func (m *TaskRunMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *SigningNonceMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TaskRunMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -4876,10 +16250,10 @@ func (m *SigningNonceMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the SigningNonce entity.
-// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TaskRun entity.
+// If the TaskRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningNonceMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TaskRunMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -4894,140 +16268,317 @@ func (m *SigningNonceMutation) OldUpdateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *SigningNonceMutation) ResetUpdateTime() {
+func (m *TaskRunMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetNonce sets the "nonce" field.
-func (m *SigningNonceMutation) SetNonce(b []byte) {
-	m.nonce = &b
+// SetTaskName sets the "task_name" field.
+func (m *TaskRunMutation) SetTaskName(s string) {
+	m.task_name = &s
 }
 
-// Nonce returns the value of the "nonce" field in the mutation.
-func (m *SigningNonceMutation) Nonce() (r []byte, exists bool) {
-	v := m.nonce
+// TaskName returns the value of the "task_name" field in the mutation.
+func (m *TaskRunMutation) TaskName() (r string, exists bool) {
+	v := m.task_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNonce returns the old "nonce" field's value of the SigningNonce entity.
-// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskName returns the old "task_name" field's value of the TaskRun entity.
+// If the TaskRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningNonceMutation) OldNonce(ctx context.Context) (v []byte, err error) {
+func (m *TaskRunMutation) OldTaskName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNonce requires an ID field in the mutation")
+		return v, errors.New("OldTaskName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskName: %w", err)
 	}
-	return oldValue.Nonce, nil
+	return oldValue.TaskName, nil
 }
 
-// ResetNonce resets all changes to the "nonce" field.
-func (m *SigningNonceMutation) ResetNonce() {
-	m.nonce = nil
+// ResetTaskName resets all changes to the "task_name" field.
+func (m *TaskRunMutation) ResetTaskName() {
+	m.task_name = nil
 }
 
-// SetNonceCommitment sets the "nonce_commitment" field.
-func (m *SigningNonceMutation) SetNonceCommitment(b []byte) {
-	m.nonce_commitment = &b
+// SetStatus sets the "status" field.
+func (m *TaskRunMutation) SetStatus(srs schema.TaskRunStatus) {
+	m.status = &srs
 }
 
-// NonceCommitment returns the value of the "nonce_commitment" field in the mutation.
-func (m *SigningNonceMutation) NonceCommitment() (r []byte, exists bool) {
-	v := m.nonce_commitment
+// Status returns the value of the "status" field in the mutation.
+func (m *TaskRunMutation) Status() (r schema.TaskRunStatus, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNonceCommitment returns the old "nonce_commitment" field's value of the SigningNonce entity.
-// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the TaskRun entity.
+// If the TaskRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningNonceMutation) OldNonceCommitment(ctx context.Context) (v []byte, err error) {
+func (m *TaskRunMutation) OldStatus(ctx context.Context) (v schema.TaskRunStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNonceCommitment is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNonceCommitment requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TaskRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *TaskRunMutation) SetTrigger(srt schema.TaskRunTrigger) {
+	m.trigger = &srt
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *TaskRunMutation) Trigger() (r schema.TaskRunTrigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the TaskRun entity.
+// If the TaskRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRunMutation) OldTrigger(ctx context.Context) (v schema.TaskRunTrigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *TaskRunMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetHolder sets the "holder" field.
+func (m *TaskRunMutation) SetHolder(s string) {
+	m.holder = &s
+}
+
+// Holder returns the value of the "holder" field in the mutation.
+func (m *TaskRunMutation) Holder() (r string, exists bool) {
+	v := m.holder
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHolder returns the old "holder" field's value of the TaskRun entity.
+// If the TaskRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRunMutation) OldHolder(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHolder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHolder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHolder: %w", err)
+	}
+	return oldValue.Holder, nil
+}
+
+// ResetHolder resets all changes to the "holder" field.
+func (m *TaskRunMutation) ResetHolder() {
+	m.holder = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *TaskRunMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *TaskRunMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the TaskRun entity.
+// If the TaskRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRunMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (m *TaskRunMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[taskrun.FieldEndTime] = struct{}{}
+}
+
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *TaskRunMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[taskrun.FieldEndTime]
+	return ok
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *TaskRunMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, taskrun.FieldEndTime)
+}
+
+// SetError sets the "error" field.
+func (m *TaskRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *TaskRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the TaskRun entity.
+// If the TaskRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNonceCommitment: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.NonceCommitment, nil
+	return oldValue.Error, nil
 }
 
-// ResetNonceCommitment resets all changes to the "nonce_commitment" field.
-func (m *SigningNonceMutation) ResetNonceCommitment() {
-	m.nonce_commitment = nil
+// ClearError clears the value of the "error" field.
+func (m *TaskRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[taskrun.FieldError] = struct{}{}
 }
 
-// SetMessage sets the "message" field.
-func (m *SigningNonceMutation) SetMessage(b []byte) {
-	m.message = &b
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *TaskRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[taskrun.FieldError]
+	return ok
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *SigningNonceMutation) Message() (r []byte, exists bool) {
-	v := m.message
+// ResetError resets all changes to the "error" field.
+func (m *TaskRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, taskrun.FieldError)
+}
+
+// SetItemsProcessed sets the "items_processed" field.
+func (m *TaskRunMutation) SetItemsProcessed(i int64) {
+	m.items_processed = &i
+	m.additems_processed = nil
+}
+
+// ItemsProcessed returns the value of the "items_processed" field in the mutation.
+func (m *TaskRunMutation) ItemsProcessed() (r int64, exists bool) {
+	v := m.items_processed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the SigningNonce entity.
-// If the SigningNonce object wasn't provided to the builder, the object is fetched from the database.
+// OldItemsProcessed returns the old "items_processed" field's value of the TaskRun entity.
+// If the TaskRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SigningNonceMutation) OldMessage(ctx context.Context) (v []byte, err error) {
+func (m *TaskRunMutation) OldItemsProcessed(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldItemsProcessed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldItemsProcessed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldItemsProcessed: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.ItemsProcessed, nil
 }
 
-// ClearMessage clears the value of the "message" field.
-func (m *SigningNonceMutation) ClearMessage() {
-	m.message = nil
-	m.clearedFields[signingnonce.FieldMessage] = struct{}{}
+// AddItemsProcessed adds i to the "items_processed" field.
+func (m *TaskRunMutation) AddItemsProcessed(i int64) {
+	if m.additems_processed != nil {
+		*m.additems_processed += i
+	} else {
+		m.additems_processed = &i
+	}
 }
 
-// MessageCleared returns if the "message" field was cleared in this mutation.
-func (m *SigningNonceMutation) MessageCleared() bool {
-	_, ok := m.clearedFields[signingnonce.FieldMessage]
-	return ok
+// AddedItemsProcessed returns the value that was added to the "items_processed" field in this mutation.
+func (m *TaskRunMutation) AddedItemsProcessed() (r int64, exists bool) {
+	v := m.additems_processed
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *SigningNonceMutation) ResetMessage() {
-	m.message = nil
-	delete(m.clearedFields, signingnonce.FieldMessage)
+// ResetItemsProcessed resets all changes to the "items_processed" field.
+func (m *TaskRunMutation) ResetItemsProcessed() {
+	m.items_processed = nil
+	m.additems_processed = nil
 }
 
-// Where appends a list predicates to the SigningNonceMutation builder.
-func (m *SigningNonceMutation) Where(ps ...predicate.SigningNonce) {
+// Where appends a list predicates to the TaskRunMutation builder.
+func (m *TaskRunMutation) Where(ps ...predicate.TaskRun) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SigningNonceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TaskRunMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SigningNonceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SigningNonce, len(ps))
+func (m *TaskRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskRun, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5035,39 +16586,51 @@ func (m *SigningNonceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SigningNonceMutation) Op() Op {
+func (m *TaskRunMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SigningNonceMutation) SetOp(op Op) {
+func (m *TaskRunMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SigningNonce).
-func (m *SigningNonceMutation) Type() string {
+// Type returns the node type of this mutation (TaskRun).
+func (m *TaskRunMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SigningNonceMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *TaskRunMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.create_time != nil {
-		fields = append(fields, signingnonce.FieldCreateTime)
+		fields = append(fields, taskrun.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, signingnonce.FieldUpdateTime)
+		fields = append(fields, taskrun.FieldUpdateTime)
 	}
-	if m.nonce != nil {
-		fields = append(fields, signingnonce.FieldNonce)
+	if m.task_name != nil {
+		fields = append(fields, taskrun.FieldTaskName)
 	}
-	if m.nonce_commitment != nil {
-		fields = append(fields, signingnonce.FieldNonceCommitment)
+	if m.status != nil {
+		fields = append(fields, taskrun.FieldStatus)
 	}
-	if m.message != nil {
-		fields = append(fields, signingnonce.FieldMessage)
+	if m.trigger != nil {
+		fields = append(fields, taskrun.FieldTrigger)
+	}
+	if m.holder != nil {
+		fields = append(fields, taskrun.FieldHolder)
+	}
+	if m.end_time != nil {
+		fields = append(fields, taskrun.FieldEndTime)
+	}
+	if m.error != nil {
+		fields = append(fields, taskrun.FieldError)
+	}
+	if m.items_processed != nil {
+		fields = append(fields, taskrun.FieldItemsProcessed)
 	}
 	return fields
 }
@@ -5075,18 +16638,26 @@ func (m *SigningNonceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SigningNonceMutation) Field(name string) (ent.Value, bool) {
+func (m *TaskRunMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case signingnonce.FieldCreateTime:
+	case taskrun.FieldCreateTime:
 		return m.CreateTime()
-	case signingnonce.FieldUpdateTime:
+	case taskrun.FieldUpdateTime:
 		return m.UpdateTime()
-	case signingnonce.FieldNonce:
-		return m.Nonce()
-	case signingnonce.FieldNonceCommitment:
-		return m.NonceCommitment()
-	case signingnonce.FieldMessage:
-		return m.Message()
+	case taskrun.FieldTaskName:
+		return m.TaskName()
+	case taskrun.FieldStatus:
+		return m.Status()
+	case taskrun.FieldTrigger:
+		return m.Trigger()
+	case taskrun.FieldHolder:
+		return m.Holder()
+	case taskrun.FieldEndTime:
+		return m.EndTime()
+	case taskrun.FieldError:
+		return m.Error()
+	case taskrun.FieldItemsProcessed:
+		return m.ItemsProcessed()
 	}
 	return nil, false
 }
@@ -5094,185 +16665,254 @@ func (m *SigningNonceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SigningNonceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TaskRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case signingnonce.FieldCreateTime:
+	case taskrun.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case signingnonce.FieldUpdateTime:
+	case taskrun.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case signingnonce.FieldNonce:
-		return m.OldNonce(ctx)
-	case signingnonce.FieldNonceCommitment:
-		return m.OldNonceCommitment(ctx)
-	case signingnonce.FieldMessage:
-		return m.OldMessage(ctx)
+	case taskrun.FieldTaskName:
+		return m.OldTaskName(ctx)
+	case taskrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case taskrun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case taskrun.FieldHolder:
+		return m.OldHolder(ctx)
+	case taskrun.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case taskrun.FieldError:
+		return m.OldError(ctx)
+	case taskrun.FieldItemsProcessed:
+		return m.OldItemsProcessed(ctx)
 	}
-	return nil, fmt.Errorf("unknown SigningNonce field %s", name)
+	return nil, fmt.Errorf("unknown TaskRun field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SigningNonceMutation) SetField(name string, value ent.Value) error {
+func (m *TaskRunMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case signingnonce.FieldCreateTime:
+	case taskrun.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case signingnonce.FieldUpdateTime:
+	case taskrun.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case signingnonce.FieldNonce:
-		v, ok := value.([]byte)
+	case taskrun.FieldTaskName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNonce(v)
+		m.SetTaskName(v)
 		return nil
-	case signingnonce.FieldNonceCommitment:
-		v, ok := value.([]byte)
+	case taskrun.FieldStatus:
+		v, ok := value.(schema.TaskRunStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNonceCommitment(v)
+		m.SetStatus(v)
 		return nil
-	case signingnonce.FieldMessage:
-		v, ok := value.([]byte)
+	case taskrun.FieldTrigger:
+		v, ok := value.(schema.TaskRunTrigger)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessage(v)
+		m.SetTrigger(v)
+		return nil
+	case taskrun.FieldHolder:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHolder(v)
+		return nil
+	case taskrun.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case taskrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case taskrun.FieldItemsProcessed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemsProcessed(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SigningNonce field %s", name)
+	return fmt.Errorf("unknown TaskRun field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SigningNonceMutation) AddedFields() []string {
-	return nil
+func (m *TaskRunMutation) AddedFields() []string {
+	var fields []string
+	if m.additems_processed != nil {
+		fields = append(fields, taskrun.FieldItemsProcessed)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SigningNonceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TaskRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case taskrun.FieldItemsProcessed:
+		return m.AddedItemsProcessed()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SigningNonceMutation) AddField(name string, value ent.Value) error {
+func (m *TaskRunMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case taskrun.FieldItemsProcessed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddItemsProcessed(v)
+		return nil
 	}
-	return fmt.Errorf("unknown SigningNonce numeric field %s", name)
+	return fmt.Errorf("unknown TaskRun numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SigningNonceMutation) ClearedFields() []string {
+func (m *TaskRunMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(signingnonce.FieldMessage) {
-		fields = append(fields, signingnonce.FieldMessage)
+	if m.FieldCleared(taskrun.FieldEndTime) {
+		fields = append(fields, taskrun.FieldEndTime)
+	}
+	if m.FieldCleared(taskrun.FieldError) {
+		fields = append(fields, taskrun.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SigningNonceMutation) FieldCleared(name string) bool {
+func (m *TaskRunMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SigningNonceMutation) ClearField(name string) error {
+func (m *TaskRunMutation) ClearField(name string) error {
 	switch name {
-	case signingnonce.FieldMessage:
-		m.ClearMessage()
+	case taskrun.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	case taskrun.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown SigningNonce nullable field %s", name)
+	return fmt.Errorf("unknown TaskRun nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SigningNonceMutation) ResetField(name string) error {
+func (m *TaskRunMutation) ResetField(name string) error {
 	switch name {
-	case signingnonce.FieldCreateTime:
+	case taskrun.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case signingnonce.FieldUpdateTime:
+	case taskrun.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case signingnonce.FieldNonce:
-		m.ResetNonce()
+	case taskrun.FieldTaskName:
+		m.ResetTaskName()
 		return nil
-	case signingnonce.FieldNonceCommitment:
-		m.ResetNonceCommitment()
+	case taskrun.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case signingnonce.FieldMessage:
-		m.ResetMessage()
+	case taskrun.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case taskrun.FieldHolder:
+		m.ResetHolder()
+		return nil
+	case taskrun.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case taskrun.FieldError:
+		m.ResetError()
+		return nil
+	case taskrun.FieldItemsProcessed:
+		m.ResetItemsProcessed()
 		return nil
 	}
-	return fmt.Errorf("unknown SigningNonce field %s", name)
+	return fmt.Errorf("unknown TaskRun field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SigningNonceMutation) AddedEdges() []string {
+func (m *TaskRunMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SigningNonceMutation) AddedIDs(name string) []ent.Value {
+func (m *TaskRunMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SigningNonceMutation) RemovedEdges() []string {
+func (m *TaskRunMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SigningNonceMutation) RemovedIDs(name string) []ent.Value {
+func (m *TaskRunMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SigningNonceMutation) ClearedEdges() []string {
+func (m *TaskRunMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SigningNonceMutation) EdgeCleared(name string) bool {
+func (m *TaskRunMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SigningNonceMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SigningNonce unique edge %s", name)
+func (m *TaskRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TaskRun unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SigningNonceMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SigningNonce edge %s", name)
+func (m *TaskRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TaskRun edge %s", name)
 }
 
 // TokenFreezeMutation represents an operation that mutates the TokenFreeze nodes in the graph.
@@ -11978,26 +23618,28 @@ func (m *TokenTransactionReceiptMutation) ResetEdge(name string) error {
 // TransferMutation represents an operation that mutates the Transfer nodes in the graph.
 type TransferMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *uuid.UUID
-	create_time              *time.Time
-	update_time              *time.Time
-	sender_identity_pubkey   *[]byte
-	receiver_identity_pubkey *[]byte
-	total_value              *uint64
-	addtotal_value           *int64
-	status                   *schema.TransferStatus
-	_type                    *schema.TransferType
-	expiry_time              *time.Time
-	completion_time          *time.Time
-	clearedFields            map[string]struct{}
-	transfer_leaves          map[uuid.UUID]struct{}
-	removedtransfer_leaves   map[uuid.UUID]struct{}
-	clearedtransfer_leaves   bool
-	done                     bool
-	oldValue                 func(context.Context) (*Transfer, error)
-	predicates               []predicate.Transfer
+	op                                Op
+	typ                               string
+	id                                *uuid.UUID
+	create_time                       *time.Time
+	update_time                       *time.Time
+	sender_identity_pubkey            *[]byte
+	receiver_identity_pubkey          *[]byte
+	total_value                       *uint64
+	addtotal_value                    *int64
+	status                            *schema.TransferStatus
+	_type                             *schema.TransferType
+	expiry_time                       *time.Time
+	completion_time                   *time.Time
+	coordinator_identity_pubkey       *[]byte
+	claim_coordinator_identity_pubkey *[]byte
+	clearedFields                     map[string]struct{}
+	transfer_leaves                   map[uuid.UUID]struct{}
+	removedtransfer_leaves            map[uuid.UUID]struct{}
+	clearedtransfer_leaves            bool
+	done                              bool
+	oldValue                          func(context.Context) (*Transfer, error)
+	predicates                        []predicate.Transfer
 }
 
 var _ ent.Mutation = (*TransferMutation)(nil)
@@ -12461,6 +24103,104 @@ func (m *TransferMutation) ResetCompletionTime() {
 	delete(m.clearedFields, transfer.FieldCompletionTime)
 }
 
+// SetCoordinatorIdentityPubkey sets the "coordinator_identity_pubkey" field.
+func (m *TransferMutation) SetCoordinatorIdentityPubkey(b []byte) {
+	m.coordinator_identity_pubkey = &b
+}
+
+// CoordinatorIdentityPubkey returns the value of the "coordinator_identity_pubkey" field in the mutation.
+func (m *TransferMutation) CoordinatorIdentityPubkey() (r []byte, exists bool) {
+	v := m.coordinator_identity_pubkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoordinatorIdentityPubkey returns the old "coordinator_identity_pubkey" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldCoordinatorIdentityPubkey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoordinatorIdentityPubkey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoordinatorIdentityPubkey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoordinatorIdentityPubkey: %w", err)
+	}
+	return oldValue.CoordinatorIdentityPubkey, nil
+}
+
+// ClearCoordinatorIdentityPubkey clears the value of the "coordinator_identity_pubkey" field.
+func (m *TransferMutation) ClearCoordinatorIdentityPubkey() {
+	m.coordinator_identity_pubkey = nil
+	m.clearedFields[transfer.FieldCoordinatorIdentityPubkey] = struct{}{}
+}
+
+// CoordinatorIdentityPubkeyCleared returns if the "coordinator_identity_pubkey" field was cleared in this mutation.
+func (m *TransferMutation) CoordinatorIdentityPubkeyCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldCoordinatorIdentityPubkey]
+	return ok
+}
+
+// ResetCoordinatorIdentityPubkey resets all changes to the "coordinator_identity_pubkey" field.
+func (m *TransferMutation) ResetCoordinatorIdentityPubkey() {
+	m.coordinator_identity_pubkey = nil
+	delete(m.clearedFields, transfer.FieldCoordinatorIdentityPubkey)
+}
+
+// SetClaimCoordinatorIdentityPubkey sets the "claim_coordinator_identity_pubkey" field.
+func (m *TransferMutation) SetClaimCoordinatorIdentityPubkey(b []byte) {
+	m.claim_coordinator_identity_pubkey = &b
+}
+
+// ClaimCoordinatorIdentityPubkey returns the value of the "claim_coordinator_identity_pubkey" field in the mutation.
+func (m *TransferMutation) ClaimCoordinatorIdentityPubkey() (r []byte, exists bool) {
+	v := m.claim_coordinator_identity_pubkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimCoordinatorIdentityPubkey returns the old "claim_coordinator_identity_pubkey" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldClaimCoordinatorIdentityPubkey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimCoordinatorIdentityPubkey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimCoordinatorIdentityPubkey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimCoordinatorIdentityPubkey: %w", err)
+	}
+	return oldValue.ClaimCoordinatorIdentityPubkey, nil
+}
+
+// ClearClaimCoordinatorIdentityPubkey clears the value of the "claim_coordinator_identity_pubkey" field.
+func (m *TransferMutation) ClearClaimCoordinatorIdentityPubkey() {
+	m.claim_coordinator_identity_pubkey = nil
+	m.clearedFields[transfer.FieldClaimCoordinatorIdentityPubkey] = struct{}{}
+}
+
+// ClaimCoordinatorIdentityPubkeyCleared returns if the "claim_coordinator_identity_pubkey" field was cleared in this mutation.
+func (m *TransferMutation) ClaimCoordinatorIdentityPubkeyCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldClaimCoordinatorIdentityPubkey]
+	return ok
+}
+
+// ResetClaimCoordinatorIdentityPubkey resets all changes to the "claim_coordinator_identity_pubkey" field.
+func (m *TransferMutation) ResetClaimCoordinatorIdentityPubkey() {
+	m.claim_coordinator_identity_pubkey = nil
+	delete(m.clearedFields, transfer.FieldClaimCoordinatorIdentityPubkey)
+}
+
 // AddTransferLeafeIDs adds the "transfer_leaves" edge to the TransferLeaf entity by ids.
 func (m *TransferMutation) AddTransferLeafeIDs(ids ...uuid.UUID) {
 	if m.transfer_leaves == nil {
@@ -12549,7 +24289,7 @@ func (m *TransferMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TransferMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 11)
 	if m.create_time != nil {
 		fields = append(fields, transfer.FieldCreateTime)
 	}
@@ -12577,6 +24317,12 @@ func (m *TransferMutation) Fields() []string {
 	if m.completion_time != nil {
 		fields = append(fields, transfer.FieldCompletionTime)
 	}
+	if m.coordinator_identity_pubkey != nil {
+		fields = append(fields, transfer.FieldCoordinatorIdentityPubkey)
+	}
+	if m.claim_coordinator_identity_pubkey != nil {
+		fields = append(fields, transfer.FieldClaimCoordinatorIdentityPubkey)
+	}
 	return fields
 }
 
@@ -12603,6 +24349,10 @@ func (m *TransferMutation) Field(name string) (ent.Value, bool) {
 		return m.ExpiryTime()
 	case transfer.FieldCompletionTime:
 		return m.CompletionTime()
+	case transfer.FieldCoordinatorIdentityPubkey:
+		return m.CoordinatorIdentityPubkey()
+	case transfer.FieldClaimCoordinatorIdentityPubkey:
+		return m.ClaimCoordinatorIdentityPubkey()
 	}
 	return nil, false
 }
@@ -12630,6 +24380,10 @@ func (m *TransferMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldExpiryTime(ctx)
 	case transfer.FieldCompletionTime:
 		return m.OldCompletionTime(ctx)
+	case transfer.FieldCoordinatorIdentityPubkey:
+		return m.OldCoordinatorIdentityPubkey(ctx)
+	case transfer.FieldClaimCoordinatorIdentityPubkey:
+		return m.OldClaimCoordinatorIdentityPubkey(ctx)
 	}
 	return nil, fmt.Errorf("unknown Transfer field %s", name)
 }
@@ -12702,6 +24456,20 @@ func (m *TransferMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCompletionTime(v)
 		return nil
+	case transfer.FieldCoordinatorIdentityPubkey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoordinatorIdentityPubkey(v)
+		return nil
+	case transfer.FieldClaimCoordinatorIdentityPubkey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimCoordinatorIdentityPubkey(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Transfer field %s", name)
 }
@@ -12750,6 +24518,12 @@ func (m *TransferMutation) ClearedFields() []string {
 	if m.FieldCleared(transfer.FieldCompletionTime) {
 		fields = append(fields, transfer.FieldCompletionTime)
 	}
+	if m.FieldCleared(transfer.FieldCoordinatorIdentityPubkey) {
+		fields = append(fields, transfer.FieldCoordinatorIdentityPubkey)
+	}
+	if m.FieldCleared(transfer.FieldClaimCoordinatorIdentityPubkey) {
+		fields = append(fields, transfer.FieldClaimCoordinatorIdentityPubkey)
+	}
 	return fields
 }
 
@@ -12767,6 +24541,12 @@ func (m *TransferMutation) ClearField(name string) error {
 	case transfer.FieldCompletionTime:
 		m.ClearCompletionTime()
 		return nil
+	case transfer.FieldCoordinatorIdentityPubkey:
+		m.ClearCoordinatorIdentityPubkey()
+		return nil
+	case transfer.FieldClaimCoordinatorIdentityPubkey:
+		m.ClearClaimCoordinatorIdentityPubkey()
+		return nil
 	}
 	return fmt.Errorf("unknown Transfer nullable field %s", name)
 }
@@ -12802,6 +24582,12 @@ func (m *TransferMutation) ResetField(name string) error {
 	case transfer.FieldCompletionTime:
 		m.ResetCompletionTime()
 		return nil
+	case transfer.FieldCoordinatorIdentityPubkey:
+		m.ResetCoordinatorIdentityPubkey()
+		return nil
+	case transfer.FieldClaimCoordinatorIdentityPubkey:
+		m.ResetClaimCoordinatorIdentityPubkey()
+		return nil
 	}
 	return fmt.Errorf("unknown Transfer field %s", name)
 }
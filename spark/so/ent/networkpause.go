@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// NetworkPause is the model entity for the NetworkPause schema.
+type NetworkPause struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Network holds the value of the "network" field.
+	Network schema.Network `json:"network,omitempty"`
+	// TransfersPaused holds the value of the "transfers_paused" field.
+	TransfersPaused bool `json:"transfers_paused,omitempty"`
+	// DepositsPaused holds the value of the "deposits_paused" field.
+	DepositsPaused bool `json:"deposits_paused,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason       string `json:"reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NetworkPause) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case networkpause.FieldTransfersPaused, networkpause.FieldDepositsPaused:
+			values[i] = new(sql.NullBool)
+		case networkpause.FieldNetwork, networkpause.FieldReason:
+			values[i] = new(sql.NullString)
+		case networkpause.FieldCreateTime, networkpause.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case networkpause.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NetworkPause fields.
+func (np *NetworkPause) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case networkpause.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				np.ID = *value
+			}
+		case networkpause.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				np.CreateTime = value.Time
+			}
+		case networkpause.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				np.UpdateTime = value.Time
+			}
+		case networkpause.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				np.Network = schema.Network(value.String)
+			}
+		case networkpause.FieldTransfersPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field transfers_paused", values[i])
+			} else if value.Valid {
+				np.TransfersPaused = value.Bool
+			}
+		case networkpause.FieldDepositsPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field deposits_paused", values[i])
+			} else if value.Valid {
+				np.DepositsPaused = value.Bool
+			}
+		case networkpause.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				np.Reason = value.String
+			}
+		default:
+			np.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NetworkPause.
+// This includes values selected through modifiers, order, etc.
+func (np *NetworkPause) Value(name string) (ent.Value, error) {
+	return np.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NetworkPause.
+// Note that you need to call NetworkPause.Unwrap() before calling this method if this NetworkPause
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (np *NetworkPause) Update() *NetworkPauseUpdateOne {
+	return NewNetworkPauseClient(np.config).UpdateOne(np)
+}
+
+// Unwrap unwraps the NetworkPause entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (np *NetworkPause) Unwrap() *NetworkPause {
+	_tx, ok := np.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NetworkPause is not a transactional entity")
+	}
+	np.config.driver = _tx.drv
+	return np
+}
+
+// String implements the fmt.Stringer.
+func (np *NetworkPause) String() string {
+	var builder strings.Builder
+	builder.WriteString("NetworkPause(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", np.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(np.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(np.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(fmt.Sprintf("%v", np.Network))
+	builder.WriteString(", ")
+	builder.WriteString("transfers_paused=")
+	builder.WriteString(fmt.Sprintf("%v", np.TransfersPaused))
+	builder.WriteString(", ")
+	builder.WriteString("deposits_paused=")
+	builder.WriteString(fmt.Sprintf("%v", np.DepositsPaused))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(np.Reason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NetworkPauses is a parsable slice of NetworkPause.
+type NetworkPauses []*NetworkPause
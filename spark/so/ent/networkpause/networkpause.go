@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package networkpause
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+const (
+	// Label holds the string label denoting the networkpause type in the database.
+	Label = "network_pause"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldNetwork holds the string denoting the network field in the database.
+	FieldNetwork = "network"
+	// FieldTransfersPaused holds the string denoting the transfers_paused field in the database.
+	FieldTransfersPaused = "transfers_paused"
+	// FieldDepositsPaused holds the string denoting the deposits_paused field in the database.
+	FieldDepositsPaused = "deposits_paused"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// Table holds the table name of the networkpause in the database.
+	Table = "network_pauses"
+)
+
+// Columns holds all SQL columns for networkpause fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldNetwork,
+	FieldTransfersPaused,
+	FieldDepositsPaused,
+	FieldReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultTransfersPaused holds the default value on creation for the "transfers_paused" field.
+	DefaultTransfersPaused bool
+	// DefaultDepositsPaused holds the default value on creation for the "deposits_paused" field.
+	DefaultDepositsPaused bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// NetworkValidator is a validator for the "network" field enum values. It is called by the builders before save.
+func NetworkValidator(n schema.Network) error {
+	switch n {
+	case "UNSPECIFIED", "MAINNET", "REGTEST", "TESTNET", "SIGNET":
+		return nil
+	default:
+		return fmt.Errorf("networkpause: invalid enum value for network field: %q", n)
+	}
+}
+
+// OrderOption defines the ordering options for the NetworkPause queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByNetwork orders the results by the network field.
+func ByNetwork(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetwork, opts...).ToFunc()
+}
+
+// ByTransfersPaused orders the results by the transfers_paused field.
+func ByTransfersPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransfersPaused, opts...).ToFunc()
+}
+
+// ByDepositsPaused orders the results by the deposits_paused field.
+func ByDepositsPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepositsPaused, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package networkpause
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// TransfersPaused applies equality check predicate on the "transfers_paused" field. It's identical to TransfersPausedEQ.
+func TransfersPaused(v bool) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldTransfersPaused, v))
+}
+
+// DepositsPaused applies equality check predicate on the "deposits_paused" field. It's identical to DepositsPausedEQ.
+func DepositsPaused(v bool) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldDepositsPaused, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldReason, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NetworkEQ applies the EQ predicate on the "network" field.
+func NetworkEQ(v schema.Network) predicate.NetworkPause {
+	vc := v
+	return predicate.NetworkPause(sql.FieldEQ(FieldNetwork, vc))
+}
+
+// NetworkNEQ applies the NEQ predicate on the "network" field.
+func NetworkNEQ(v schema.Network) predicate.NetworkPause {
+	vc := v
+	return predicate.NetworkPause(sql.FieldNEQ(FieldNetwork, vc))
+}
+
+// NetworkIn applies the In predicate on the "network" field.
+func NetworkIn(vs ...schema.Network) predicate.NetworkPause {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NetworkPause(sql.FieldIn(FieldNetwork, v...))
+}
+
+// NetworkNotIn applies the NotIn predicate on the "network" field.
+func NetworkNotIn(vs ...schema.Network) predicate.NetworkPause {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NetworkPause(sql.FieldNotIn(FieldNetwork, v...))
+}
+
+// TransfersPausedEQ applies the EQ predicate on the "transfers_paused" field.
+func TransfersPausedEQ(v bool) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldTransfersPaused, v))
+}
+
+// TransfersPausedNEQ applies the NEQ predicate on the "transfers_paused" field.
+func TransfersPausedNEQ(v bool) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNEQ(FieldTransfersPaused, v))
+}
+
+// DepositsPausedEQ applies the EQ predicate on the "deposits_paused" field.
+func DepositsPausedEQ(v bool) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldDepositsPaused, v))
+}
+
+// DepositsPausedNEQ applies the NEQ predicate on the "deposits_paused" field.
+func DepositsPausedNEQ(v bool) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNEQ(FieldDepositsPaused, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NetworkPause) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NetworkPause) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NetworkPause) predicate.NetworkPause {
+	return predicate.NetworkPause(sql.NotPredicates(p))
+}
@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// NetworkPauseCreate is the builder for creating a NetworkPause entity.
+type NetworkPauseCreate struct {
+	config
+	mutation *NetworkPauseMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (npc *NetworkPauseCreate) SetCreateTime(t time.Time) *NetworkPauseCreate {
+	npc.mutation.SetCreateTime(t)
+	return npc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (npc *NetworkPauseCreate) SetNillableCreateTime(t *time.Time) *NetworkPauseCreate {
+	if t != nil {
+		npc.SetCreateTime(*t)
+	}
+	return npc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (npc *NetworkPauseCreate) SetUpdateTime(t time.Time) *NetworkPauseCreate {
+	npc.mutation.SetUpdateTime(t)
+	return npc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (npc *NetworkPauseCreate) SetNillableUpdateTime(t *time.Time) *NetworkPauseCreate {
+	if t != nil {
+		npc.SetUpdateTime(*t)
+	}
+	return npc
+}
+
+// SetNetwork sets the "network" field.
+func (npc *NetworkPauseCreate) SetNetwork(s schema.Network) *NetworkPauseCreate {
+	npc.mutation.SetNetwork(s)
+	return npc
+}
+
+// SetTransfersPaused sets the "transfers_paused" field.
+func (npc *NetworkPauseCreate) SetTransfersPaused(b bool) *NetworkPauseCreate {
+	npc.mutation.SetTransfersPaused(b)
+	return npc
+}
+
+// SetNillableTransfersPaused sets the "transfers_paused" field if the given value is not nil.
+func (npc *NetworkPauseCreate) SetNillableTransfersPaused(b *bool) *NetworkPauseCreate {
+	if b != nil {
+		npc.SetTransfersPaused(*b)
+	}
+	return npc
+}
+
+// SetDepositsPaused sets the "deposits_paused" field.
+func (npc *NetworkPauseCreate) SetDepositsPaused(b bool) *NetworkPauseCreate {
+	npc.mutation.SetDepositsPaused(b)
+	return npc
+}
+
+// SetNillableDepositsPaused sets the "deposits_paused" field if the given value is not nil.
+func (npc *NetworkPauseCreate) SetNillableDepositsPaused(b *bool) *NetworkPauseCreate {
+	if b != nil {
+		npc.SetDepositsPaused(*b)
+	}
+	return npc
+}
+
+// SetReason sets the "reason" field.
+func (npc *NetworkPauseCreate) SetReason(s string) *NetworkPauseCreate {
+	npc.mutation.SetReason(s)
+	return npc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (npc *NetworkPauseCreate) SetNillableReason(s *string) *NetworkPauseCreate {
+	if s != nil {
+		npc.SetReason(*s)
+	}
+	return npc
+}
+
+// SetID sets the "id" field.
+func (npc *NetworkPauseCreate) SetID(u uuid.UUID) *NetworkPauseCreate {
+	npc.mutation.SetID(u)
+	return npc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (npc *NetworkPauseCreate) SetNillableID(u *uuid.UUID) *NetworkPauseCreate {
+	if u != nil {
+		npc.SetID(*u)
+	}
+	return npc
+}
+
+// Mutation returns the NetworkPauseMutation object of the builder.
+func (npc *NetworkPauseCreate) Mutation() *NetworkPauseMutation {
+	return npc.mutation
+}
+
+// Save creates the NetworkPause in the database.
+func (npc *NetworkPauseCreate) Save(ctx context.Context) (*NetworkPause, error) {
+	npc.defaults()
+	return withHooks(ctx, npc.sqlSave, npc.mutation, npc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (npc *NetworkPauseCreate) SaveX(ctx context.Context) *NetworkPause {
+	v, err := npc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npc *NetworkPauseCreate) Exec(ctx context.Context) error {
+	_, err := npc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npc *NetworkPauseCreate) ExecX(ctx context.Context) {
+	if err := npc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npc *NetworkPauseCreate) defaults() {
+	if _, ok := npc.mutation.CreateTime(); !ok {
+		v := networkpause.DefaultCreateTime()
+		npc.mutation.SetCreateTime(v)
+	}
+	if _, ok := npc.mutation.UpdateTime(); !ok {
+		v := networkpause.DefaultUpdateTime()
+		npc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := npc.mutation.TransfersPaused(); !ok {
+		v := networkpause.DefaultTransfersPaused
+		npc.mutation.SetTransfersPaused(v)
+	}
+	if _, ok := npc.mutation.DepositsPaused(); !ok {
+		v := networkpause.DefaultDepositsPaused
+		npc.mutation.SetDepositsPaused(v)
+	}
+	if _, ok := npc.mutation.ID(); !ok {
+		v := networkpause.DefaultID()
+		npc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npc *NetworkPauseCreate) check() error {
+	if _, ok := npc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "NetworkPause.create_time"`)}
+	}
+	if _, ok := npc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "NetworkPause.update_time"`)}
+	}
+	if _, ok := npc.mutation.Network(); !ok {
+		return &ValidationError{Name: "network", err: errors.New(`ent: missing required field "NetworkPause.network"`)}
+	}
+	if v, ok := npc.mutation.Network(); ok {
+		if err := networkpause.NetworkValidator(v); err != nil {
+			return &ValidationError{Name: "network", err: fmt.Errorf(`ent: validator failed for field "NetworkPause.network": %w`, err)}
+		}
+	}
+	if _, ok := npc.mutation.TransfersPaused(); !ok {
+		return &ValidationError{Name: "transfers_paused", err: errors.New(`ent: missing required field "NetworkPause.transfers_paused"`)}
+	}
+	if _, ok := npc.mutation.DepositsPaused(); !ok {
+		return &ValidationError{Name: "deposits_paused", err: errors.New(`ent: missing required field "NetworkPause.deposits_paused"`)}
+	}
+	return nil
+}
+
+func (npc *NetworkPauseCreate) sqlSave(ctx context.Context) (*NetworkPause, error) {
+	if err := npc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := npc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, npc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	npc.mutation.id = &_node.ID
+	npc.mutation.done = true
+	return _node, nil
+}
+
+func (npc *NetworkPauseCreate) createSpec() (*NetworkPause, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NetworkPause{config: npc.config}
+		_spec = sqlgraph.NewCreateSpec(networkpause.Table, sqlgraph.NewFieldSpec(networkpause.FieldID, field.TypeUUID))
+	)
+	if id, ok := npc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := npc.mutation.CreateTime(); ok {
+		_spec.SetField(networkpause.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := npc.mutation.UpdateTime(); ok {
+		_spec.SetField(networkpause.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := npc.mutation.Network(); ok {
+		_spec.SetField(networkpause.FieldNetwork, field.TypeEnum, value)
+		_node.Network = value
+	}
+	if value, ok := npc.mutation.TransfersPaused(); ok {
+		_spec.SetField(networkpause.FieldTransfersPaused, field.TypeBool, value)
+		_node.TransfersPaused = value
+	}
+	if value, ok := npc.mutation.DepositsPaused(); ok {
+		_spec.SetField(networkpause.FieldDepositsPaused, field.TypeBool, value)
+		_node.DepositsPaused = value
+	}
+	if value, ok := npc.mutation.Reason(); ok {
+		_spec.SetField(networkpause.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	return _node, _spec
+}
+
+// NetworkPauseCreateBulk is the builder for creating many NetworkPause entities in bulk.
+type NetworkPauseCreateBulk struct {
+	config
+	err      error
+	builders []*NetworkPauseCreate
+}
+
+// Save creates the NetworkPause entities in the database.
+func (npcb *NetworkPauseCreateBulk) Save(ctx context.Context) ([]*NetworkPause, error) {
+	if npcb.err != nil {
+		return nil, npcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(npcb.builders))
+	nodes := make([]*NetworkPause, len(npcb.builders))
+	mutators := make([]Mutator, len(npcb.builders))
+	for i := range npcb.builders {
+		func(i int, root context.Context) {
+			builder := npcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NetworkPauseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, npcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, npcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, npcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npcb *NetworkPauseCreateBulk) SaveX(ctx context.Context) []*NetworkPause {
+	v, err := npcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npcb *NetworkPauseCreateBulk) Exec(ctx context.Context) error {
+	_, err := npcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npcb *NetworkPauseCreateBulk) ExecX(ctx context.Context) {
+	if err := npcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// NetworkPauseDelete is the builder for deleting a NetworkPause entity.
+type NetworkPauseDelete struct {
+	config
+	hooks    []Hook
+	mutation *NetworkPauseMutation
+}
+
+// Where appends a list predicates to the NetworkPauseDelete builder.
+func (npd *NetworkPauseDelete) Where(ps ...predicate.NetworkPause) *NetworkPauseDelete {
+	npd.mutation.Where(ps...)
+	return npd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (npd *NetworkPauseDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, npd.sqlExec, npd.mutation, npd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npd *NetworkPauseDelete) ExecX(ctx context.Context) int {
+	n, err := npd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (npd *NetworkPauseDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(networkpause.Table, sqlgraph.NewFieldSpec(networkpause.FieldID, field.TypeUUID))
+	if ps := npd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, npd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	npd.mutation.done = true
+	return affected, err
+}
+
+// NetworkPauseDeleteOne is the builder for deleting a single NetworkPause entity.
+type NetworkPauseDeleteOne struct {
+	npd *NetworkPauseDelete
+}
+
+// Where appends a list predicates to the NetworkPauseDelete builder.
+func (npdo *NetworkPauseDeleteOne) Where(ps ...predicate.NetworkPause) *NetworkPauseDeleteOne {
+	npdo.npd.mutation.Where(ps...)
+	return npdo
+}
+
+// Exec executes the deletion query.
+func (npdo *NetworkPauseDeleteOne) Exec(ctx context.Context) error {
+	n, err := npdo.npd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{networkpause.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npdo *NetworkPauseDeleteOne) ExecX(ctx context.Context) {
+	if err := npdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
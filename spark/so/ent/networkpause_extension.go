@@ -0,0 +1,62 @@
+package ent
+
+import (
+	"context"
+
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EnsureTransfersNotPaused returns a NETWORK_PAUSED error if new transfers are paused on the
+// given network.
+func EnsureTransfersNotPaused(ctx context.Context, network schema.Network) error {
+	pause, err := getNetworkPause(ctx, network)
+	if err != nil {
+		return err
+	}
+	if pause != nil && pause.TransfersPaused {
+		return errors.Reasonf(errors.ReasonNetworkPaused, "transfers on %s are paused by the operator", network)
+	}
+	return nil
+}
+
+// EnsureDepositsNotPaused returns a NETWORK_PAUSED error if new deposits are paused on the
+// given network.
+func EnsureDepositsNotPaused(ctx context.Context, network schema.Network) error {
+	pause, err := getNetworkPause(ctx, network)
+	if err != nil {
+		return err
+	}
+	if pause != nil && pause.DepositsPaused {
+		return errors.Reasonf(errors.ReasonNetworkPaused, "deposits on %s are paused by the operator", network)
+	}
+	return nil
+}
+
+func getNetworkPause(ctx context.Context, network schema.Network) (*NetworkPause, error) {
+	pause, err := GetDbFromContext(ctx).NetworkPause.Query().
+		Where(networkpause.NetworkEQ(network)).
+		Only(ctx)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	return pause, err
+}
+
+// MarshalProto converts a NetworkPause to a spark admin protobuf NetworkPause.
+func (p *NetworkPause) MarshalProto() (*pbadmin.NetworkPause, error) {
+	network, err := p.Network.MarshalProto()
+	if err != nil {
+		return nil, err
+	}
+	return &pbadmin.NetworkPause{
+		Network:         network,
+		TransfersPaused: p.TransfersPaused,
+		DepositsPaused:  p.DepositsPaused,
+		Reason:          p.Reason,
+		UpdateTime:      timestamppb.New(p.UpdateTime),
+	}, nil
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// NetworkPauseQuery is the builder for querying NetworkPause entities.
+type NetworkPauseQuery struct {
+	config
+	ctx        *QueryContext
+	order      []networkpause.OrderOption
+	inters     []Interceptor
+	predicates []predicate.NetworkPause
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NetworkPauseQuery builder.
+func (npq *NetworkPauseQuery) Where(ps ...predicate.NetworkPause) *NetworkPauseQuery {
+	npq.predicates = append(npq.predicates, ps...)
+	return npq
+}
+
+// Limit the number of records to be returned by this query.
+func (npq *NetworkPauseQuery) Limit(limit int) *NetworkPauseQuery {
+	npq.ctx.Limit = &limit
+	return npq
+}
+
+// Offset to start from.
+func (npq *NetworkPauseQuery) Offset(offset int) *NetworkPauseQuery {
+	npq.ctx.Offset = &offset
+	return npq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (npq *NetworkPauseQuery) Unique(unique bool) *NetworkPauseQuery {
+	npq.ctx.Unique = &unique
+	return npq
+}
+
+// Order specifies how the records should be ordered.
+func (npq *NetworkPauseQuery) Order(o ...networkpause.OrderOption) *NetworkPauseQuery {
+	npq.order = append(npq.order, o...)
+	return npq
+}
+
+// First returns the first NetworkPause entity from the query.
+// Returns a *NotFoundError when no NetworkPause was found.
+func (npq *NetworkPauseQuery) First(ctx context.Context) (*NetworkPause, error) {
+	nodes, err := npq.Limit(1).All(setContextOp(ctx, npq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{networkpause.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (npq *NetworkPauseQuery) FirstX(ctx context.Context) *NetworkPause {
+	node, err := npq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NetworkPause ID from the query.
+// Returns a *NotFoundError when no NetworkPause ID was found.
+func (npq *NetworkPauseQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = npq.Limit(1).IDs(setContextOp(ctx, npq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{networkpause.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (npq *NetworkPauseQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := npq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NetworkPause entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NetworkPause entity is found.
+// Returns a *NotFoundError when no NetworkPause entities are found.
+func (npq *NetworkPauseQuery) Only(ctx context.Context) (*NetworkPause, error) {
+	nodes, err := npq.Limit(2).All(setContextOp(ctx, npq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{networkpause.Label}
+	default:
+		return nil, &NotSingularError{networkpause.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (npq *NetworkPauseQuery) OnlyX(ctx context.Context) *NetworkPause {
+	node, err := npq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NetworkPause ID in the query.
+// Returns a *NotSingularError when more than one NetworkPause ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (npq *NetworkPauseQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = npq.Limit(2).IDs(setContextOp(ctx, npq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{networkpause.Label}
+	default:
+		err = &NotSingularError{networkpause.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (npq *NetworkPauseQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := npq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NetworkPauses.
+func (npq *NetworkPauseQuery) All(ctx context.Context) ([]*NetworkPause, error) {
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryAll)
+	if err := npq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NetworkPause, *NetworkPauseQuery]()
+	return withInterceptors[[]*NetworkPause](ctx, npq, qr, npq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (npq *NetworkPauseQuery) AllX(ctx context.Context) []*NetworkPause {
+	nodes, err := npq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NetworkPause IDs.
+func (npq *NetworkPauseQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if npq.ctx.Unique == nil && npq.path != nil {
+		npq.Unique(true)
+	}
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryIDs)
+	if err = npq.Select(networkpause.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (npq *NetworkPauseQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := npq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (npq *NetworkPauseQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryCount)
+	if err := npq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, npq, querierCount[*NetworkPauseQuery](), npq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (npq *NetworkPauseQuery) CountX(ctx context.Context) int {
+	count, err := npq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (npq *NetworkPauseQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryExist)
+	switch _, err := npq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (npq *NetworkPauseQuery) ExistX(ctx context.Context) bool {
+	exist, err := npq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NetworkPauseQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (npq *NetworkPauseQuery) Clone() *NetworkPauseQuery {
+	if npq == nil {
+		return nil
+	}
+	return &NetworkPauseQuery{
+		config:     npq.config,
+		ctx:        npq.ctx.Clone(),
+		order:      append([]networkpause.OrderOption{}, npq.order...),
+		inters:     append([]Interceptor{}, npq.inters...),
+		predicates: append([]predicate.NetworkPause{}, npq.predicates...),
+		// clone intermediate query.
+		sql:  npq.sql.Clone(),
+		path: npq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NetworkPause.Query().
+//		GroupBy(networkpause.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (npq *NetworkPauseQuery) GroupBy(field string, fields ...string) *NetworkPauseGroupBy {
+	npq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NetworkPauseGroupBy{build: npq}
+	grbuild.flds = &npq.ctx.Fields
+	grbuild.label = networkpause.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.NetworkPause.Query().
+//		Select(networkpause.FieldCreateTime).
+//		Scan(ctx, &v)
+func (npq *NetworkPauseQuery) Select(fields ...string) *NetworkPauseSelect {
+	npq.ctx.Fields = append(npq.ctx.Fields, fields...)
+	sbuild := &NetworkPauseSelect{NetworkPauseQuery: npq}
+	sbuild.label = networkpause.Label
+	sbuild.flds, sbuild.scan = &npq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NetworkPauseSelect configured with the given aggregations.
+func (npq *NetworkPauseQuery) Aggregate(fns ...AggregateFunc) *NetworkPauseSelect {
+	return npq.Select().Aggregate(fns...)
+}
+
+func (npq *NetworkPauseQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range npq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, npq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range npq.ctx.Fields {
+		if !networkpause.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if npq.path != nil {
+		prev, err := npq.path(ctx)
+		if err != nil {
+			return err
+		}
+		npq.sql = prev
+	}
+	return nil
+}
+
+func (npq *NetworkPauseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NetworkPause, error) {
+	var (
+		nodes = []*NetworkPause{}
+		_spec = npq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NetworkPause).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NetworkPause{config: npq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(npq.modifiers) > 0 {
+		_spec.Modifiers = npq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, npq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (npq *NetworkPauseQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := npq.querySpec()
+	if len(npq.modifiers) > 0 {
+		_spec.Modifiers = npq.modifiers
+	}
+	_spec.Node.Columns = npq.ctx.Fields
+	if len(npq.ctx.Fields) > 0 {
+		_spec.Unique = npq.ctx.Unique != nil && *npq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, npq.driver, _spec)
+}
+
+func (npq *NetworkPauseQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(networkpause.Table, networkpause.Columns, sqlgraph.NewFieldSpec(networkpause.FieldID, field.TypeUUID))
+	_spec.From = npq.sql
+	if unique := npq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if npq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := npq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, networkpause.FieldID)
+		for i := range fields {
+			if fields[i] != networkpause.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := npq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := npq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := npq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := npq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (npq *NetworkPauseQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(npq.driver.Dialect())
+	t1 := builder.Table(networkpause.Table)
+	columns := npq.ctx.Fields
+	if len(columns) == 0 {
+		columns = networkpause.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if npq.sql != nil {
+		selector = npq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if npq.ctx.Unique != nil && *npq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range npq.modifiers {
+		m(selector)
+	}
+	for _, p := range npq.predicates {
+		p(selector)
+	}
+	for _, p := range npq.order {
+		p(selector)
+	}
+	if offset := npq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := npq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (npq *NetworkPauseQuery) ForUpdate(opts ...sql.LockOption) *NetworkPauseQuery {
+	if npq.driver.Dialect() == dialect.Postgres {
+		npq.Unique(false)
+	}
+	npq.modifiers = append(npq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return npq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (npq *NetworkPauseQuery) ForShare(opts ...sql.LockOption) *NetworkPauseQuery {
+	if npq.driver.Dialect() == dialect.Postgres {
+		npq.Unique(false)
+	}
+	npq.modifiers = append(npq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return npq
+}
+
+// NetworkPauseGroupBy is the group-by builder for NetworkPause entities.
+type NetworkPauseGroupBy struct {
+	selector
+	build *NetworkPauseQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (npgb *NetworkPauseGroupBy) Aggregate(fns ...AggregateFunc) *NetworkPauseGroupBy {
+	npgb.fns = append(npgb.fns, fns...)
+	return npgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (npgb *NetworkPauseGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, npgb.build.ctx, ent.OpQueryGroupBy)
+	if err := npgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NetworkPauseQuery, *NetworkPauseGroupBy](ctx, npgb.build, npgb, npgb.build.inters, v)
+}
+
+func (npgb *NetworkPauseGroupBy) sqlScan(ctx context.Context, root *NetworkPauseQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(npgb.fns))
+	for _, fn := range npgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*npgb.flds)+len(npgb.fns))
+		for _, f := range *npgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*npgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := npgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NetworkPauseSelect is the builder for selecting fields of NetworkPause entities.
+type NetworkPauseSelect struct {
+	*NetworkPauseQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nps *NetworkPauseSelect) Aggregate(fns ...AggregateFunc) *NetworkPauseSelect {
+	nps.fns = append(nps.fns, fns...)
+	return nps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nps *NetworkPauseSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nps.ctx, ent.OpQuerySelect)
+	if err := nps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NetworkPauseQuery, *NetworkPauseSelect](ctx, nps.NetworkPauseQuery, nps, nps.inters, v)
+}
+
+func (nps *NetworkPauseSelect) sqlScan(ctx context.Context, root *NetworkPauseQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nps.fns))
+	for _, fn := range nps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// NetworkPauseUpdate is the builder for updating NetworkPause entities.
+type NetworkPauseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NetworkPauseMutation
+}
+
+// Where appends a list predicates to the NetworkPauseUpdate builder.
+func (npu *NetworkPauseUpdate) Where(ps ...predicate.NetworkPause) *NetworkPauseUpdate {
+	npu.mutation.Where(ps...)
+	return npu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (npu *NetworkPauseUpdate) SetUpdateTime(t time.Time) *NetworkPauseUpdate {
+	npu.mutation.SetUpdateTime(t)
+	return npu
+}
+
+// SetTransfersPaused sets the "transfers_paused" field.
+func (npu *NetworkPauseUpdate) SetTransfersPaused(b bool) *NetworkPauseUpdate {
+	npu.mutation.SetTransfersPaused(b)
+	return npu
+}
+
+// SetNillableTransfersPaused sets the "transfers_paused" field if the given value is not nil.
+func (npu *NetworkPauseUpdate) SetNillableTransfersPaused(b *bool) *NetworkPauseUpdate {
+	if b != nil {
+		npu.SetTransfersPaused(*b)
+	}
+	return npu
+}
+
+// SetDepositsPaused sets the "deposits_paused" field.
+func (npu *NetworkPauseUpdate) SetDepositsPaused(b bool) *NetworkPauseUpdate {
+	npu.mutation.SetDepositsPaused(b)
+	return npu
+}
+
+// SetNillableDepositsPaused sets the "deposits_paused" field if the given value is not nil.
+func (npu *NetworkPauseUpdate) SetNillableDepositsPaused(b *bool) *NetworkPauseUpdate {
+	if b != nil {
+		npu.SetDepositsPaused(*b)
+	}
+	return npu
+}
+
+// SetReason sets the "reason" field.
+func (npu *NetworkPauseUpdate) SetReason(s string) *NetworkPauseUpdate {
+	npu.mutation.SetReason(s)
+	return npu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (npu *NetworkPauseUpdate) SetNillableReason(s *string) *NetworkPauseUpdate {
+	if s != nil {
+		npu.SetReason(*s)
+	}
+	return npu
+}
+
+// ClearReason clears the value of the "reason" field.
+func (npu *NetworkPauseUpdate) ClearReason() *NetworkPauseUpdate {
+	npu.mutation.ClearReason()
+	return npu
+}
+
+// Mutation returns the NetworkPauseMutation object of the builder.
+func (npu *NetworkPauseUpdate) Mutation() *NetworkPauseMutation {
+	return npu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (npu *NetworkPauseUpdate) Save(ctx context.Context) (int, error) {
+	npu.defaults()
+	return withHooks(ctx, npu.sqlSave, npu.mutation, npu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npu *NetworkPauseUpdate) SaveX(ctx context.Context) int {
+	affected, err := npu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (npu *NetworkPauseUpdate) Exec(ctx context.Context) error {
+	_, err := npu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npu *NetworkPauseUpdate) ExecX(ctx context.Context) {
+	if err := npu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npu *NetworkPauseUpdate) defaults() {
+	if _, ok := npu.mutation.UpdateTime(); !ok {
+		v := networkpause.UpdateDefaultUpdateTime()
+		npu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (npu *NetworkPauseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(networkpause.Table, networkpause.Columns, sqlgraph.NewFieldSpec(networkpause.FieldID, field.TypeUUID))
+	if ps := npu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npu.mutation.UpdateTime(); ok {
+		_spec.SetField(networkpause.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := npu.mutation.TransfersPaused(); ok {
+		_spec.SetField(networkpause.FieldTransfersPaused, field.TypeBool, value)
+	}
+	if value, ok := npu.mutation.DepositsPaused(); ok {
+		_spec.SetField(networkpause.FieldDepositsPaused, field.TypeBool, value)
+	}
+	if value, ok := npu.mutation.Reason(); ok {
+		_spec.SetField(networkpause.FieldReason, field.TypeString, value)
+	}
+	if npu.mutation.ReasonCleared() {
+		_spec.ClearField(networkpause.FieldReason, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, npu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{networkpause.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	npu.mutation.done = true
+	return n, nil
+}
+
+// NetworkPauseUpdateOne is the builder for updating a single NetworkPause entity.
+type NetworkPauseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NetworkPauseMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (npuo *NetworkPauseUpdateOne) SetUpdateTime(t time.Time) *NetworkPauseUpdateOne {
+	npuo.mutation.SetUpdateTime(t)
+	return npuo
+}
+
+// SetTransfersPaused sets the "transfers_paused" field.
+func (npuo *NetworkPauseUpdateOne) SetTransfersPaused(b bool) *NetworkPauseUpdateOne {
+	npuo.mutation.SetTransfersPaused(b)
+	return npuo
+}
+
+// SetNillableTransfersPaused sets the "transfers_paused" field if the given value is not nil.
+func (npuo *NetworkPauseUpdateOne) SetNillableTransfersPaused(b *bool) *NetworkPauseUpdateOne {
+	if b != nil {
+		npuo.SetTransfersPaused(*b)
+	}
+	return npuo
+}
+
+// SetDepositsPaused sets the "deposits_paused" field.
+func (npuo *NetworkPauseUpdateOne) SetDepositsPaused(b bool) *NetworkPauseUpdateOne {
+	npuo.mutation.SetDepositsPaused(b)
+	return npuo
+}
+
+// SetNillableDepositsPaused sets the "deposits_paused" field if the given value is not nil.
+func (npuo *NetworkPauseUpdateOne) SetNillableDepositsPaused(b *bool) *NetworkPauseUpdateOne {
+	if b != nil {
+		npuo.SetDepositsPaused(*b)
+	}
+	return npuo
+}
+
+// SetReason sets the "reason" field.
+func (npuo *NetworkPauseUpdateOne) SetReason(s string) *NetworkPauseUpdateOne {
+	npuo.mutation.SetReason(s)
+	return npuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (npuo *NetworkPauseUpdateOne) SetNillableReason(s *string) *NetworkPauseUpdateOne {
+	if s != nil {
+		npuo.SetReason(*s)
+	}
+	return npuo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (npuo *NetworkPauseUpdateOne) ClearReason() *NetworkPauseUpdateOne {
+	npuo.mutation.ClearReason()
+	return npuo
+}
+
+// Mutation returns the NetworkPauseMutation object of the builder.
+func (npuo *NetworkPauseUpdateOne) Mutation() *NetworkPauseMutation {
+	return npuo.mutation
+}
+
+// Where appends a list predicates to the NetworkPauseUpdate builder.
+func (npuo *NetworkPauseUpdateOne) Where(ps ...predicate.NetworkPause) *NetworkPauseUpdateOne {
+	npuo.mutation.Where(ps...)
+	return npuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (npuo *NetworkPauseUpdateOne) Select(field string, fields ...string) *NetworkPauseUpdateOne {
+	npuo.fields = append([]string{field}, fields...)
+	return npuo
+}
+
+// Save executes the query and returns the updated NetworkPause entity.
+func (npuo *NetworkPauseUpdateOne) Save(ctx context.Context) (*NetworkPause, error) {
+	npuo.defaults()
+	return withHooks(ctx, npuo.sqlSave, npuo.mutation, npuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npuo *NetworkPauseUpdateOne) SaveX(ctx context.Context) *NetworkPause {
+	node, err := npuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (npuo *NetworkPauseUpdateOne) Exec(ctx context.Context) error {
+	_, err := npuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npuo *NetworkPauseUpdateOne) ExecX(ctx context.Context) {
+	if err := npuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npuo *NetworkPauseUpdateOne) defaults() {
+	if _, ok := npuo.mutation.UpdateTime(); !ok {
+		v := networkpause.UpdateDefaultUpdateTime()
+		npuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (npuo *NetworkPauseUpdateOne) sqlSave(ctx context.Context) (_node *NetworkPause, err error) {
+	_spec := sqlgraph.NewUpdateSpec(networkpause.Table, networkpause.Columns, sqlgraph.NewFieldSpec(networkpause.FieldID, field.TypeUUID))
+	id, ok := npuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NetworkPause.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := npuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, networkpause.FieldID)
+		for _, f := range fields {
+			if !networkpause.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != networkpause.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := npuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npuo.mutation.UpdateTime(); ok {
+		_spec.SetField(networkpause.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := npuo.mutation.TransfersPaused(); ok {
+		_spec.SetField(networkpause.FieldTransfersPaused, field.TypeBool, value)
+	}
+	if value, ok := npuo.mutation.DepositsPaused(); ok {
+		_spec.SetField(networkpause.FieldDepositsPaused, field.TypeBool, value)
+	}
+	if value, ok := npuo.mutation.Reason(); ok {
+		_spec.SetField(networkpause.FieldReason, field.TypeString, value)
+	}
+	if npuo.mutation.ReasonCleared() {
+		_spec.ClearField(networkpause.FieldReason, field.TypeString)
+	}
+	_node = &NetworkPause{config: npuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, npuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{networkpause.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	npuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
+)
+
+// OperatorCallNonce is the model entity for the OperatorCallNonce schema.
+type OperatorCallNonce struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// OperatorIdentityPublicKey holds the value of the "operator_identity_public_key" field.
+	OperatorIdentityPublicKey []byte `json:"operator_identity_public_key,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce []byte `json:"nonce,omitempty"`
+	// ExpirationTime holds the value of the "expiration_time" field.
+	ExpirationTime time.Time `json:"expiration_time,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OperatorCallNonce) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case operatorcallnonce.FieldOperatorIdentityPublicKey, operatorcallnonce.FieldNonce:
+			values[i] = new([]byte)
+		case operatorcallnonce.FieldCreateTime, operatorcallnonce.FieldUpdateTime, operatorcallnonce.FieldExpirationTime:
+			values[i] = new(sql.NullTime)
+		case operatorcallnonce.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OperatorCallNonce fields.
+func (ocn *OperatorCallNonce) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case operatorcallnonce.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ocn.ID = *value
+			}
+		case operatorcallnonce.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ocn.CreateTime = value.Time
+			}
+		case operatorcallnonce.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ocn.UpdateTime = value.Time
+			}
+		case operatorcallnonce.FieldOperatorIdentityPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_identity_public_key", values[i])
+			} else if value != nil {
+				ocn.OperatorIdentityPublicKey = *value
+			}
+		case operatorcallnonce.FieldNonce:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value != nil {
+				ocn.Nonce = *value
+			}
+		case operatorcallnonce.FieldExpirationTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiration_time", values[i])
+			} else if value.Valid {
+				ocn.ExpirationTime = value.Time
+			}
+		default:
+			ocn.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OperatorCallNonce.
+// This includes values selected through modifiers, order, etc.
+func (ocn *OperatorCallNonce) Value(name string) (ent.Value, error) {
+	return ocn.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OperatorCallNonce.
+// Note that you need to call OperatorCallNonce.Unwrap() before calling this method if this OperatorCallNonce
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ocn *OperatorCallNonce) Update() *OperatorCallNonceUpdateOne {
+	return NewOperatorCallNonceClient(ocn.config).UpdateOne(ocn)
+}
+
+// Unwrap unwraps the OperatorCallNonce entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ocn *OperatorCallNonce) Unwrap() *OperatorCallNonce {
+	_tx, ok := ocn.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OperatorCallNonce is not a transactional entity")
+	}
+	ocn.config.driver = _tx.drv
+	return ocn
+}
+
+// String implements the fmt.Stringer.
+func (ocn *OperatorCallNonce) String() string {
+	var builder strings.Builder
+	builder.WriteString("OperatorCallNonce(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ocn.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ocn.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ocn.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("operator_identity_public_key=")
+	builder.WriteString(fmt.Sprintf("%v", ocn.OperatorIdentityPublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("nonce=")
+	builder.WriteString(fmt.Sprintf("%v", ocn.Nonce))
+	builder.WriteString(", ")
+	builder.WriteString("expiration_time=")
+	builder.WriteString(ocn.ExpirationTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OperatorCallNonces is a parsable slice of OperatorCallNonce.
+type OperatorCallNonces []*OperatorCallNonce
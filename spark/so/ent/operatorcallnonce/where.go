@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package operatorcallnonce
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// OperatorIdentityPublicKey applies equality check predicate on the "operator_identity_public_key" field. It's identical to OperatorIdentityPublicKeyEQ.
+func OperatorIdentityPublicKey(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldOperatorIdentityPublicKey, v))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldNonce, v))
+}
+
+// ExpirationTime applies equality check predicate on the "expiration_time" field. It's identical to ExpirationTimeEQ.
+func ExpirationTime(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldExpirationTime, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// OperatorIdentityPublicKeyEQ applies the EQ predicate on the "operator_identity_public_key" field.
+func OperatorIdentityPublicKeyEQ(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldOperatorIdentityPublicKey, v))
+}
+
+// OperatorIdentityPublicKeyNEQ applies the NEQ predicate on the "operator_identity_public_key" field.
+func OperatorIdentityPublicKeyNEQ(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNEQ(FieldOperatorIdentityPublicKey, v))
+}
+
+// OperatorIdentityPublicKeyIn applies the In predicate on the "operator_identity_public_key" field.
+func OperatorIdentityPublicKeyIn(vs ...[]byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldIn(FieldOperatorIdentityPublicKey, vs...))
+}
+
+// OperatorIdentityPublicKeyNotIn applies the NotIn predicate on the "operator_identity_public_key" field.
+func OperatorIdentityPublicKeyNotIn(vs ...[]byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNotIn(FieldOperatorIdentityPublicKey, vs...))
+}
+
+// OperatorIdentityPublicKeyGT applies the GT predicate on the "operator_identity_public_key" field.
+func OperatorIdentityPublicKeyGT(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGT(FieldOperatorIdentityPublicKey, v))
+}
+
+// OperatorIdentityPublicKeyGTE applies the GTE predicate on the "operator_identity_public_key" field.
+func OperatorIdentityPublicKeyGTE(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGTE(FieldOperatorIdentityPublicKey, v))
+}
+
+// OperatorIdentityPublicKeyLT applies the LT predicate on the "operator_identity_public_key" field.
+func OperatorIdentityPublicKeyLT(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLT(FieldOperatorIdentityPublicKey, v))
+}
+
+// OperatorIdentityPublicKeyLTE applies the LTE predicate on the "operator_identity_public_key" field.
+func OperatorIdentityPublicKeyLTE(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLTE(FieldOperatorIdentityPublicKey, v))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...[]byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...[]byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v []byte) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLTE(FieldNonce, v))
+}
+
+// ExpirationTimeEQ applies the EQ predicate on the "expiration_time" field.
+func ExpirationTimeEQ(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldEQ(FieldExpirationTime, v))
+}
+
+// ExpirationTimeNEQ applies the NEQ predicate on the "expiration_time" field.
+func ExpirationTimeNEQ(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNEQ(FieldExpirationTime, v))
+}
+
+// ExpirationTimeIn applies the In predicate on the "expiration_time" field.
+func ExpirationTimeIn(vs ...time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldIn(FieldExpirationTime, vs...))
+}
+
+// ExpirationTimeNotIn applies the NotIn predicate on the "expiration_time" field.
+func ExpirationTimeNotIn(vs ...time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldNotIn(FieldExpirationTime, vs...))
+}
+
+// ExpirationTimeGT applies the GT predicate on the "expiration_time" field.
+func ExpirationTimeGT(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGT(FieldExpirationTime, v))
+}
+
+// ExpirationTimeGTE applies the GTE predicate on the "expiration_time" field.
+func ExpirationTimeGTE(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldGTE(FieldExpirationTime, v))
+}
+
+// ExpirationTimeLT applies the LT predicate on the "expiration_time" field.
+func ExpirationTimeLT(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLT(FieldExpirationTime, v))
+}
+
+// ExpirationTimeLTE applies the LTE predicate on the "expiration_time" field.
+func ExpirationTimeLTE(v time.Time) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.FieldLTE(FieldExpirationTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OperatorCallNonce) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OperatorCallNonce) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OperatorCallNonce) predicate.OperatorCallNonce {
+	return predicate.OperatorCallNonce(sql.NotPredicates(p))
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
+)
+
+// OperatorCallNonceCreate is the builder for creating a OperatorCallNonce entity.
+type OperatorCallNonceCreate struct {
+	config
+	mutation *OperatorCallNonceMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ocnc *OperatorCallNonceCreate) SetCreateTime(t time.Time) *OperatorCallNonceCreate {
+	ocnc.mutation.SetCreateTime(t)
+	return ocnc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ocnc *OperatorCallNonceCreate) SetNillableCreateTime(t *time.Time) *OperatorCallNonceCreate {
+	if t != nil {
+		ocnc.SetCreateTime(*t)
+	}
+	return ocnc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocnc *OperatorCallNonceCreate) SetUpdateTime(t time.Time) *OperatorCallNonceCreate {
+	ocnc.mutation.SetUpdateTime(t)
+	return ocnc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ocnc *OperatorCallNonceCreate) SetNillableUpdateTime(t *time.Time) *OperatorCallNonceCreate {
+	if t != nil {
+		ocnc.SetUpdateTime(*t)
+	}
+	return ocnc
+}
+
+// SetOperatorIdentityPublicKey sets the "operator_identity_public_key" field.
+func (ocnc *OperatorCallNonceCreate) SetOperatorIdentityPublicKey(b []byte) *OperatorCallNonceCreate {
+	ocnc.mutation.SetOperatorIdentityPublicKey(b)
+	return ocnc
+}
+
+// SetNonce sets the "nonce" field.
+func (ocnc *OperatorCallNonceCreate) SetNonce(b []byte) *OperatorCallNonceCreate {
+	ocnc.mutation.SetNonce(b)
+	return ocnc
+}
+
+// SetExpirationTime sets the "expiration_time" field.
+func (ocnc *OperatorCallNonceCreate) SetExpirationTime(t time.Time) *OperatorCallNonceCreate {
+	ocnc.mutation.SetExpirationTime(t)
+	return ocnc
+}
+
+// SetID sets the "id" field.
+func (ocnc *OperatorCallNonceCreate) SetID(u uuid.UUID) *OperatorCallNonceCreate {
+	ocnc.mutation.SetID(u)
+	return ocnc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ocnc *OperatorCallNonceCreate) SetNillableID(u *uuid.UUID) *OperatorCallNonceCreate {
+	if u != nil {
+		ocnc.SetID(*u)
+	}
+	return ocnc
+}
+
+// Mutation returns the OperatorCallNonceMutation object of the builder.
+func (ocnc *OperatorCallNonceCreate) Mutation() *OperatorCallNonceMutation {
+	return ocnc.mutation
+}
+
+// Save creates the OperatorCallNonce in the database.
+func (ocnc *OperatorCallNonceCreate) Save(ctx context.Context) (*OperatorCallNonce, error) {
+	ocnc.defaults()
+	return withHooks(ctx, ocnc.sqlSave, ocnc.mutation, ocnc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ocnc *OperatorCallNonceCreate) SaveX(ctx context.Context) *OperatorCallNonce {
+	v, err := ocnc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ocnc *OperatorCallNonceCreate) Exec(ctx context.Context) error {
+	_, err := ocnc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocnc *OperatorCallNonceCreate) ExecX(ctx context.Context) {
+	if err := ocnc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocnc *OperatorCallNonceCreate) defaults() {
+	if _, ok := ocnc.mutation.CreateTime(); !ok {
+		v := operatorcallnonce.DefaultCreateTime()
+		ocnc.mutation.SetCreateTime(v)
+	}
+	if _, ok := ocnc.mutation.UpdateTime(); !ok {
+		v := operatorcallnonce.DefaultUpdateTime()
+		ocnc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := ocnc.mutation.ID(); !ok {
+		v := operatorcallnonce.DefaultID()
+		ocnc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocnc *OperatorCallNonceCreate) check() error {
+	if _, ok := ocnc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OperatorCallNonce.create_time"`)}
+	}
+	if _, ok := ocnc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "OperatorCallNonce.update_time"`)}
+	}
+	if _, ok := ocnc.mutation.OperatorIdentityPublicKey(); !ok {
+		return &ValidationError{Name: "operator_identity_public_key", err: errors.New(`ent: missing required field "OperatorCallNonce.operator_identity_public_key"`)}
+	}
+	if v, ok := ocnc.mutation.OperatorIdentityPublicKey(); ok {
+		if err := operatorcallnonce.OperatorIdentityPublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "operator_identity_public_key", err: fmt.Errorf(`ent: validator failed for field "OperatorCallNonce.operator_identity_public_key": %w`, err)}
+		}
+	}
+	if _, ok := ocnc.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "OperatorCallNonce.nonce"`)}
+	}
+	if v, ok := ocnc.mutation.Nonce(); ok {
+		if err := operatorcallnonce.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "OperatorCallNonce.nonce": %w`, err)}
+		}
+	}
+	if _, ok := ocnc.mutation.ExpirationTime(); !ok {
+		return &ValidationError{Name: "expiration_time", err: errors.New(`ent: missing required field "OperatorCallNonce.expiration_time"`)}
+	}
+	return nil
+}
+
+func (ocnc *OperatorCallNonceCreate) sqlSave(ctx context.Context) (*OperatorCallNonce, error) {
+	if err := ocnc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ocnc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ocnc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ocnc.mutation.id = &_node.ID
+	ocnc.mutation.done = true
+	return _node, nil
+}
+
+func (ocnc *OperatorCallNonceCreate) createSpec() (*OperatorCallNonce, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OperatorCallNonce{config: ocnc.config}
+		_spec = sqlgraph.NewCreateSpec(operatorcallnonce.Table, sqlgraph.NewFieldSpec(operatorcallnonce.FieldID, field.TypeUUID))
+	)
+	if id, ok := ocnc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ocnc.mutation.CreateTime(); ok {
+		_spec.SetField(operatorcallnonce.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ocnc.mutation.UpdateTime(); ok {
+		_spec.SetField(operatorcallnonce.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ocnc.mutation.OperatorIdentityPublicKey(); ok {
+		_spec.SetField(operatorcallnonce.FieldOperatorIdentityPublicKey, field.TypeBytes, value)
+		_node.OperatorIdentityPublicKey = value
+	}
+	if value, ok := ocnc.mutation.Nonce(); ok {
+		_spec.SetField(operatorcallnonce.FieldNonce, field.TypeBytes, value)
+		_node.Nonce = value
+	}
+	if value, ok := ocnc.mutation.ExpirationTime(); ok {
+		_spec.SetField(operatorcallnonce.FieldExpirationTime, field.TypeTime, value)
+		_node.ExpirationTime = value
+	}
+	return _node, _spec
+}
+
+// OperatorCallNonceCreateBulk is the builder for creating many OperatorCallNonce entities in bulk.
+type OperatorCallNonceCreateBulk struct {
+	config
+	err      error
+	builders []*OperatorCallNonceCreate
+}
+
+// Save creates the OperatorCallNonce entities in the database.
+func (ocncb *OperatorCallNonceCreateBulk) Save(ctx context.Context) ([]*OperatorCallNonce, error) {
+	if ocncb.err != nil {
+		return nil, ocncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ocncb.builders))
+	nodes := make([]*OperatorCallNonce, len(ocncb.builders))
+	mutators := make([]Mutator, len(ocncb.builders))
+	for i := range ocncb.builders {
+		func(i int, root context.Context) {
+			builder := ocncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OperatorCallNonceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ocncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ocncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ocncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocncb *OperatorCallNonceCreateBulk) SaveX(ctx context.Context) []*OperatorCallNonce {
+	v, err := ocncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ocncb *OperatorCallNonceCreateBulk) Exec(ctx context.Context) error {
+	_, err := ocncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocncb *OperatorCallNonceCreateBulk) ExecX(ctx context.Context) {
+	if err := ocncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// OperatorCallNonceDelete is the builder for deleting a OperatorCallNonce entity.
+type OperatorCallNonceDelete struct {
+	config
+	hooks    []Hook
+	mutation *OperatorCallNonceMutation
+}
+
+// Where appends a list predicates to the OperatorCallNonceDelete builder.
+func (ocnd *OperatorCallNonceDelete) Where(ps ...predicate.OperatorCallNonce) *OperatorCallNonceDelete {
+	ocnd.mutation.Where(ps...)
+	return ocnd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ocnd *OperatorCallNonceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ocnd.sqlExec, ocnd.mutation, ocnd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocnd *OperatorCallNonceDelete) ExecX(ctx context.Context) int {
+	n, err := ocnd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ocnd *OperatorCallNonceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(operatorcallnonce.Table, sqlgraph.NewFieldSpec(operatorcallnonce.FieldID, field.TypeUUID))
+	if ps := ocnd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ocnd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ocnd.mutation.done = true
+	return affected, err
+}
+
+// OperatorCallNonceDeleteOne is the builder for deleting a single OperatorCallNonce entity.
+type OperatorCallNonceDeleteOne struct {
+	ocnd *OperatorCallNonceDelete
+}
+
+// Where appends a list predicates to the OperatorCallNonceDelete builder.
+func (ocndo *OperatorCallNonceDeleteOne) Where(ps ...predicate.OperatorCallNonce) *OperatorCallNonceDeleteOne {
+	ocndo.ocnd.mutation.Where(ps...)
+	return ocndo
+}
+
+// Exec executes the deletion query.
+func (ocndo *OperatorCallNonceDeleteOne) Exec(ctx context.Context) error {
+	n, err := ocndo.ocnd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{operatorcallnonce.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocndo *OperatorCallNonceDeleteOne) ExecX(ctx context.Context) {
+	if err := ocndo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,49 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
+)
+
+// UseOperatorCallNonce records the nonce of an internal call made by the operator with the given
+// identity public key until expirationTime, and returns false if the nonce was already used. The
+// nonce is recorded with the client, outside of the transaction of the call, so that it stays used
+// whether or not the call succeeds.
+func UseOperatorCallNonce(ctx context.Context, client *Client, identityPublicKey []byte, nonce []byte, expirationTime time.Time) (bool, error) {
+	err := client.OperatorCallNonce.Create().
+		SetOperatorIdentityPublicKey(identityPublicKey).
+		SetNonce(nonce).
+		SetExpirationTime(expirationTime).
+		Exec(ctx)
+	if IsConstraintError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to record operator call nonce: %w", err)
+	}
+	return true, nil
+}
+
+// PurgeOperatorCallNonces deletes up to batchSize operator call nonces past their expiration time,
+// and returns how many it deleted.
+func PurgeOperatorCallNonces(ctx context.Context, batchSize int) (int, error) {
+	db := GetDbFromContext(ctx)
+	ids, err := db.OperatorCallNonce.Query().
+		Where(operatorcallnonce.ExpirationTimeLT(time.Now())).
+		Limit(batchSize).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query expired operator call nonces: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	purged, err := db.OperatorCallNonce.Delete().Where(operatorcallnonce.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge operator call nonces: %w", err)
+	}
+	return purged, nil
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// OperatorCallNonceQuery is the builder for querying OperatorCallNonce entities.
+type OperatorCallNonceQuery struct {
+	config
+	ctx        *QueryContext
+	order      []operatorcallnonce.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OperatorCallNonce
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OperatorCallNonceQuery builder.
+func (ocnq *OperatorCallNonceQuery) Where(ps ...predicate.OperatorCallNonce) *OperatorCallNonceQuery {
+	ocnq.predicates = append(ocnq.predicates, ps...)
+	return ocnq
+}
+
+// Limit the number of records to be returned by this query.
+func (ocnq *OperatorCallNonceQuery) Limit(limit int) *OperatorCallNonceQuery {
+	ocnq.ctx.Limit = &limit
+	return ocnq
+}
+
+// Offset to start from.
+func (ocnq *OperatorCallNonceQuery) Offset(offset int) *OperatorCallNonceQuery {
+	ocnq.ctx.Offset = &offset
+	return ocnq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ocnq *OperatorCallNonceQuery) Unique(unique bool) *OperatorCallNonceQuery {
+	ocnq.ctx.Unique = &unique
+	return ocnq
+}
+
+// Order specifies how the records should be ordered.
+func (ocnq *OperatorCallNonceQuery) Order(o ...operatorcallnonce.OrderOption) *OperatorCallNonceQuery {
+	ocnq.order = append(ocnq.order, o...)
+	return ocnq
+}
+
+// First returns the first OperatorCallNonce entity from the query.
+// Returns a *NotFoundError when no OperatorCallNonce was found.
+func (ocnq *OperatorCallNonceQuery) First(ctx context.Context) (*OperatorCallNonce, error) {
+	nodes, err := ocnq.Limit(1).All(setContextOp(ctx, ocnq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{operatorcallnonce.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ocnq *OperatorCallNonceQuery) FirstX(ctx context.Context) *OperatorCallNonce {
+	node, err := ocnq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OperatorCallNonce ID from the query.
+// Returns a *NotFoundError when no OperatorCallNonce ID was found.
+func (ocnq *OperatorCallNonceQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ocnq.Limit(1).IDs(setContextOp(ctx, ocnq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{operatorcallnonce.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ocnq *OperatorCallNonceQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ocnq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OperatorCallNonce entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OperatorCallNonce entity is found.
+// Returns a *NotFoundError when no OperatorCallNonce entities are found.
+func (ocnq *OperatorCallNonceQuery) Only(ctx context.Context) (*OperatorCallNonce, error) {
+	nodes, err := ocnq.Limit(2).All(setContextOp(ctx, ocnq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{operatorcallnonce.Label}
+	default:
+		return nil, &NotSingularError{operatorcallnonce.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ocnq *OperatorCallNonceQuery) OnlyX(ctx context.Context) *OperatorCallNonce {
+	node, err := ocnq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OperatorCallNonce ID in the query.
+// Returns a *NotSingularError when more than one OperatorCallNonce ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ocnq *OperatorCallNonceQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ocnq.Limit(2).IDs(setContextOp(ctx, ocnq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{operatorcallnonce.Label}
+	default:
+		err = &NotSingularError{operatorcallnonce.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ocnq *OperatorCallNonceQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ocnq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OperatorCallNonces.
+func (ocnq *OperatorCallNonceQuery) All(ctx context.Context) ([]*OperatorCallNonce, error) {
+	ctx = setContextOp(ctx, ocnq.ctx, ent.OpQueryAll)
+	if err := ocnq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OperatorCallNonce, *OperatorCallNonceQuery]()
+	return withInterceptors[[]*OperatorCallNonce](ctx, ocnq, qr, ocnq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ocnq *OperatorCallNonceQuery) AllX(ctx context.Context) []*OperatorCallNonce {
+	nodes, err := ocnq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OperatorCallNonce IDs.
+func (ocnq *OperatorCallNonceQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ocnq.ctx.Unique == nil && ocnq.path != nil {
+		ocnq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ocnq.ctx, ent.OpQueryIDs)
+	if err = ocnq.Select(operatorcallnonce.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ocnq *OperatorCallNonceQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ocnq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ocnq *OperatorCallNonceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ocnq.ctx, ent.OpQueryCount)
+	if err := ocnq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ocnq, querierCount[*OperatorCallNonceQuery](), ocnq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ocnq *OperatorCallNonceQuery) CountX(ctx context.Context) int {
+	count, err := ocnq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ocnq *OperatorCallNonceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ocnq.ctx, ent.OpQueryExist)
+	switch _, err := ocnq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ocnq *OperatorCallNonceQuery) ExistX(ctx context.Context) bool {
+	exist, err := ocnq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OperatorCallNonceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ocnq *OperatorCallNonceQuery) Clone() *OperatorCallNonceQuery {
+	if ocnq == nil {
+		return nil
+	}
+	return &OperatorCallNonceQuery{
+		config:     ocnq.config,
+		ctx:        ocnq.ctx.Clone(),
+		order:      append([]operatorcallnonce.OrderOption{}, ocnq.order...),
+		inters:     append([]Interceptor{}, ocnq.inters...),
+		predicates: append([]predicate.OperatorCallNonce{}, ocnq.predicates...),
+		// clone intermediate query.
+		sql:  ocnq.sql.Clone(),
+		path: ocnq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OperatorCallNonce.Query().
+//		GroupBy(operatorcallnonce.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ocnq *OperatorCallNonceQuery) GroupBy(field string, fields ...string) *OperatorCallNonceGroupBy {
+	ocnq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OperatorCallNonceGroupBy{build: ocnq}
+	grbuild.flds = &ocnq.ctx.Fields
+	grbuild.label = operatorcallnonce.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.OperatorCallNonce.Query().
+//		Select(operatorcallnonce.FieldCreateTime).
+//		Scan(ctx, &v)
+func (ocnq *OperatorCallNonceQuery) Select(fields ...string) *OperatorCallNonceSelect {
+	ocnq.ctx.Fields = append(ocnq.ctx.Fields, fields...)
+	sbuild := &OperatorCallNonceSelect{OperatorCallNonceQuery: ocnq}
+	sbuild.label = operatorcallnonce.Label
+	sbuild.flds, sbuild.scan = &ocnq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OperatorCallNonceSelect configured with the given aggregations.
+func (ocnq *OperatorCallNonceQuery) Aggregate(fns ...AggregateFunc) *OperatorCallNonceSelect {
+	return ocnq.Select().Aggregate(fns...)
+}
+
+func (ocnq *OperatorCallNonceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ocnq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ocnq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ocnq.ctx.Fields {
+		if !operatorcallnonce.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ocnq.path != nil {
+		prev, err := ocnq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ocnq.sql = prev
+	}
+	return nil
+}
+
+func (ocnq *OperatorCallNonceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OperatorCallNonce, error) {
+	var (
+		nodes = []*OperatorCallNonce{}
+		_spec = ocnq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OperatorCallNonce).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OperatorCallNonce{config: ocnq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ocnq.modifiers) > 0 {
+		_spec.Modifiers = ocnq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ocnq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ocnq *OperatorCallNonceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ocnq.querySpec()
+	if len(ocnq.modifiers) > 0 {
+		_spec.Modifiers = ocnq.modifiers
+	}
+	_spec.Node.Columns = ocnq.ctx.Fields
+	if len(ocnq.ctx.Fields) > 0 {
+		_spec.Unique = ocnq.ctx.Unique != nil && *ocnq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ocnq.driver, _spec)
+}
+
+func (ocnq *OperatorCallNonceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(operatorcallnonce.Table, operatorcallnonce.Columns, sqlgraph.NewFieldSpec(operatorcallnonce.FieldID, field.TypeUUID))
+	_spec.From = ocnq.sql
+	if unique := ocnq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ocnq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ocnq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, operatorcallnonce.FieldID)
+		for i := range fields {
+			if fields[i] != operatorcallnonce.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ocnq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ocnq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ocnq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ocnq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ocnq *OperatorCallNonceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ocnq.driver.Dialect())
+	t1 := builder.Table(operatorcallnonce.Table)
+	columns := ocnq.ctx.Fields
+	if len(columns) == 0 {
+		columns = operatorcallnonce.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ocnq.sql != nil {
+		selector = ocnq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ocnq.ctx.Unique != nil && *ocnq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ocnq.modifiers {
+		m(selector)
+	}
+	for _, p := range ocnq.predicates {
+		p(selector)
+	}
+	for _, p := range ocnq.order {
+		p(selector)
+	}
+	if offset := ocnq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ocnq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ocnq *OperatorCallNonceQuery) ForUpdate(opts ...sql.LockOption) *OperatorCallNonceQuery {
+	if ocnq.driver.Dialect() == dialect.Postgres {
+		ocnq.Unique(false)
+	}
+	ocnq.modifiers = append(ocnq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ocnq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ocnq *OperatorCallNonceQuery) ForShare(opts ...sql.LockOption) *OperatorCallNonceQuery {
+	if ocnq.driver.Dialect() == dialect.Postgres {
+		ocnq.Unique(false)
+	}
+	ocnq.modifiers = append(ocnq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ocnq
+}
+
+// OperatorCallNonceGroupBy is the group-by builder for OperatorCallNonce entities.
+type OperatorCallNonceGroupBy struct {
+	selector
+	build *OperatorCallNonceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ocngb *OperatorCallNonceGroupBy) Aggregate(fns ...AggregateFunc) *OperatorCallNonceGroupBy {
+	ocngb.fns = append(ocngb.fns, fns...)
+	return ocngb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ocngb *OperatorCallNonceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ocngb.build.ctx, ent.OpQueryGroupBy)
+	if err := ocngb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OperatorCallNonceQuery, *OperatorCallNonceGroupBy](ctx, ocngb.build, ocngb, ocngb.build.inters, v)
+}
+
+func (ocngb *OperatorCallNonceGroupBy) sqlScan(ctx context.Context, root *OperatorCallNonceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ocngb.fns))
+	for _, fn := range ocngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ocngb.flds)+len(ocngb.fns))
+		for _, f := range *ocngb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ocngb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ocngb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OperatorCallNonceSelect is the builder for selecting fields of OperatorCallNonce entities.
+type OperatorCallNonceSelect struct {
+	*OperatorCallNonceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ocns *OperatorCallNonceSelect) Aggregate(fns ...AggregateFunc) *OperatorCallNonceSelect {
+	ocns.fns = append(ocns.fns, fns...)
+	return ocns
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ocns *OperatorCallNonceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ocns.ctx, ent.OpQuerySelect)
+	if err := ocns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OperatorCallNonceQuery, *OperatorCallNonceSelect](ctx, ocns.OperatorCallNonceQuery, ocns, ocns.inters, v)
+}
+
+func (ocns *OperatorCallNonceSelect) sqlScan(ctx context.Context, root *OperatorCallNonceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ocns.fns))
+	for _, fn := range ocns.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ocns.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ocns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
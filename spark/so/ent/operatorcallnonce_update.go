@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// OperatorCallNonceUpdate is the builder for updating OperatorCallNonce entities.
+type OperatorCallNonceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OperatorCallNonceMutation
+}
+
+// Where appends a list predicates to the OperatorCallNonceUpdate builder.
+func (ocnu *OperatorCallNonceUpdate) Where(ps ...predicate.OperatorCallNonce) *OperatorCallNonceUpdate {
+	ocnu.mutation.Where(ps...)
+	return ocnu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocnu *OperatorCallNonceUpdate) SetUpdateTime(t time.Time) *OperatorCallNonceUpdate {
+	ocnu.mutation.SetUpdateTime(t)
+	return ocnu
+}
+
+// Mutation returns the OperatorCallNonceMutation object of the builder.
+func (ocnu *OperatorCallNonceUpdate) Mutation() *OperatorCallNonceMutation {
+	return ocnu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocnu *OperatorCallNonceUpdate) Save(ctx context.Context) (int, error) {
+	ocnu.defaults()
+	return withHooks(ctx, ocnu.sqlSave, ocnu.mutation, ocnu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocnu *OperatorCallNonceUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocnu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocnu *OperatorCallNonceUpdate) Exec(ctx context.Context) error {
+	_, err := ocnu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocnu *OperatorCallNonceUpdate) ExecX(ctx context.Context) {
+	if err := ocnu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocnu *OperatorCallNonceUpdate) defaults() {
+	if _, ok := ocnu.mutation.UpdateTime(); !ok {
+		v := operatorcallnonce.UpdateDefaultUpdateTime()
+		ocnu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (ocnu *OperatorCallNonceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(operatorcallnonce.Table, operatorcallnonce.Columns, sqlgraph.NewFieldSpec(operatorcallnonce.FieldID, field.TypeUUID))
+	if ps := ocnu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocnu.mutation.UpdateTime(); ok {
+		_spec.SetField(operatorcallnonce.FieldUpdateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ocnu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{operatorcallnonce.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ocnu.mutation.done = true
+	return n, nil
+}
+
+// OperatorCallNonceUpdateOne is the builder for updating a single OperatorCallNonce entity.
+type OperatorCallNonceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OperatorCallNonceMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocnuo *OperatorCallNonceUpdateOne) SetUpdateTime(t time.Time) *OperatorCallNonceUpdateOne {
+	ocnuo.mutation.SetUpdateTime(t)
+	return ocnuo
+}
+
+// Mutation returns the OperatorCallNonceMutation object of the builder.
+func (ocnuo *OperatorCallNonceUpdateOne) Mutation() *OperatorCallNonceMutation {
+	return ocnuo.mutation
+}
+
+// Where appends a list predicates to the OperatorCallNonceUpdate builder.
+func (ocnuo *OperatorCallNonceUpdateOne) Where(ps ...predicate.OperatorCallNonce) *OperatorCallNonceUpdateOne {
+	ocnuo.mutation.Where(ps...)
+	return ocnuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocnuo *OperatorCallNonceUpdateOne) Select(field string, fields ...string) *OperatorCallNonceUpdateOne {
+	ocnuo.fields = append([]string{field}, fields...)
+	return ocnuo
+}
+
+// Save executes the query and returns the updated OperatorCallNonce entity.
+func (ocnuo *OperatorCallNonceUpdateOne) Save(ctx context.Context) (*OperatorCallNonce, error) {
+	ocnuo.defaults()
+	return withHooks(ctx, ocnuo.sqlSave, ocnuo.mutation, ocnuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocnuo *OperatorCallNonceUpdateOne) SaveX(ctx context.Context) *OperatorCallNonce {
+	node, err := ocnuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocnuo *OperatorCallNonceUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocnuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocnuo *OperatorCallNonceUpdateOne) ExecX(ctx context.Context) {
+	if err := ocnuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocnuo *OperatorCallNonceUpdateOne) defaults() {
+	if _, ok := ocnuo.mutation.UpdateTime(); !ok {
+		v := operatorcallnonce.UpdateDefaultUpdateTime()
+		ocnuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (ocnuo *OperatorCallNonceUpdateOne) sqlSave(ctx context.Context) (_node *OperatorCallNonce, err error) {
+	_spec := sqlgraph.NewUpdateSpec(operatorcallnonce.Table, operatorcallnonce.Columns, sqlgraph.NewFieldSpec(operatorcallnonce.FieldID, field.TypeUUID))
+	id, ok := ocnuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OperatorCallNonce.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocnuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, operatorcallnonce.FieldID)
+		for _, f := range fields {
+			if !operatorcallnonce.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != operatorcallnonce.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocnuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocnuo.mutation.UpdateTime(); ok {
+		_spec.SetField(operatorcallnonce.FieldUpdateTime, field.TypeTime, value)
+	}
+	_node = &OperatorCallNonce{config: ocnuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocnuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{operatorcallnonce.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ocnuo.mutation.done = true
+	return _node, nil
+}
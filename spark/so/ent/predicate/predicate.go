@@ -6,27 +6,72 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Archive is the predicate function for archive builders.
+type Archive func(*sql.Selector)
+
+// ArchivedTransfer is the predicate function for archivedtransfer builders.
+type ArchivedTransfer func(*sql.Selector)
+
 // BlockHeight is the predicate function for blockheight builders.
 type BlockHeight func(*sql.Selector)
 
+// ConsistencyAuditCursor is the predicate function for consistencyauditcursor builders.
+type ConsistencyAuditCursor func(*sql.Selector)
+
+// ConsistencyDiscrepancy is the predicate function for consistencydiscrepancy builders.
+type ConsistencyDiscrepancy func(*sql.Selector)
+
 // CooperativeExit is the predicate function for cooperativeexit builders.
 type CooperativeExit func(*sql.Selector)
 
 // DepositAddress is the predicate function for depositaddress builders.
 type DepositAddress func(*sql.Selector)
 
+// DkgSession is the predicate function for dkgsession builders.
+type DkgSession func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// NetworkPause is the predicate function for networkpause builders.
+type NetworkPause func(*sql.Selector)
+
+// OperatorCallNonce is the predicate function for operatorcallnonce builders.
+type OperatorCallNonce func(*sql.Selector)
+
 // PreimageRequest is the predicate function for preimagerequest builders.
 type PreimageRequest func(*sql.Selector)
 
 // PreimageShare is the predicate function for preimageshare builders.
 type PreimageShare func(*sql.Selector)
 
+// RecentWrite is the predicate function for recentwrite builders.
+type RecentWrite func(*sql.Selector)
+
+// Reshare is the predicate function for reshare builders.
+type Reshare func(*sql.Selector)
+
+// SessionRevocation is the predicate function for sessionrevocation builders.
+type SessionRevocation func(*sql.Selector)
+
+// ShareRefresh is the predicate function for sharerefresh builders.
+type ShareRefresh func(*sql.Selector)
+
+// SigningIncident is the predicate function for signingincident builders.
+type SigningIncident func(*sql.Selector)
+
 // SigningKeyshare is the predicate function for signingkeyshare builders.
 type SigningKeyshare func(*sql.Selector)
 
 // SigningNonce is the predicate function for signingnonce builders.
 type SigningNonce func(*sql.Selector)
 
+// TaskLock is the predicate function for tasklock builders.
+type TaskLock func(*sql.Selector)
+
+// TaskRun is the predicate function for taskrun builders.
+type TaskRun func(*sql.Selector)
+
 // TokenFreeze is the predicate function for tokenfreeze builders.
 type TokenFreeze func(*sql.Selector)
 
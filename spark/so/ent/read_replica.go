@@ -0,0 +1,219 @@
+package ent
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/common/logging"
+	"github.com/lightsparkdev/spark/so/authn"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	readReplicaLagGauge    metric.Float64Gauge
+	readReplicaReadCounter metric.Int64Counter
+)
+
+func init() {
+	var err error
+	meter := otel.Meter("read_replica")
+	readReplicaLagGauge, err = meter.Float64Gauge(
+		"spark_read_replica_lag",
+		metric.WithDescription("How far the read replica of the database lags behind the primary"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	readReplicaReadCounter, err = meter.Int64Counter(
+		"spark_read_replica_reads",
+		metric.WithDescription("Number of read-only RPCs, by the database they were served from and why"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// The reasons a read-only RPC is served from a database.
+const (
+	readReasonReplica       = "replica"
+	readReasonLag           = "lag"
+	readReasonRecentWrite   = "recent_write"
+	readReasonUnmeasuredLag = "unmeasured_lag"
+)
+
+// ReplicaLagFunc returns how far a read replica lags behind the primary.
+type ReplicaLagFunc func(ctx context.Context) (time.Duration, error)
+
+// ReadReplica routes read-only methods to a read replica of the database. Reads go to the primary
+// instead while the replica lags further behind than the maximum lag, and for the identities that
+// wrote to the primary too recently for the replica to have caught up, so that they read their
+// own writes. Writes are recorded in the primary, where every replica of the operator sees them,
+// including those made for an identity by the internal calls of another operator.
+type ReadReplica struct {
+	client  *Client
+	lag     ReplicaLagFunc
+	methods map[string]bool
+	maxLag  time.Duration
+	// lagNanos is the last measured lag, or -1 if the last measurement failed.
+	lagNanos atomic.Int64
+	// window is how long the reads of an identity go to the primary after it wrote.
+	window time.Duration
+}
+
+// NewReadReplica creates the routing of the given methods to the replica behind client. Reads go
+// to the primary until MonitorLag first measures the lag of the replica.
+func NewReadReplica(client *Client, lag ReplicaLagFunc, methods []string, maxLag time.Duration) *ReadReplica {
+	// A write is visible on the replica at most maxLag after it was committed, as of the last
+	// measurement of the lag.
+	r := &ReadReplica{
+		client:  client,
+		lag:     lag,
+		methods: make(map[string]bool, len(methods)),
+		maxLag:  maxLag,
+		window:  maxLag + spark.ReadReplicaLagCheckInterval,
+	}
+	for _, method := range methods {
+		r.methods[method] = true
+	}
+	r.lagNanos.Store(-1)
+	return r
+}
+
+// MonitorLag measures the lag of the replica every spark.ReadReplicaLagCheckInterval until the
+// context is done.
+func (r *ReadReplica) MonitorLag(ctx context.Context) {
+	logger := logging.GetLoggerFromContext(ctx)
+	ticker := time.NewTicker(spark.ReadReplicaLagCheckInterval)
+	defer ticker.Stop()
+	for {
+		lag, err := r.lag(ctx)
+		if err != nil {
+			if r.lagNanos.Swap(-1) >= 0 {
+				logger.Warn("Failed to measure read replica lag, reading from the primary", "error", err)
+			}
+		} else {
+			r.lagNanos.Store(int64(lag))
+			readReplicaLagGauge.Record(ctx, lag.Seconds())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// route returns whether the call to fullMethod reads from the replica. Recent writes are looked up
+// in the primary.
+func (r *ReadReplica) route(ctx context.Context, primary *Client, fullMethod string) bool {
+	if r == nil || !r.methods[fullMethod] {
+		return false
+	}
+	var reason string
+	switch lag := r.lagNanos.Load(); {
+	case lag < 0:
+		reason = readReasonUnmeasuredLag
+	case time.Duration(lag) > r.maxLag:
+		reason = readReasonLag
+	default:
+		reason = readReasonReplica
+		if identity := callerIdentity(ctx); identity != nil {
+			wrote, err := primary.RecentWrite.Query().
+				Where(
+					recentwrite.IdentityPublicKey(identity),
+					recentwrite.ExpirationTimeGT(time.Now()),
+				).
+				Exist(ctx)
+			if err != nil {
+				logging.GetLoggerFromContext(ctx).Warn("Failed to look up recent writes, reading from the primary", "error", err)
+			}
+			if err != nil || wrote {
+				reason = readReasonRecentWrite
+			}
+		}
+	}
+
+	database := "primary"
+	if reason == readReasonReplica {
+		database = "replica"
+	}
+	readReplicaReadCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("database", database),
+		attribute.String("reason", reason),
+	))
+	return reason == readReasonReplica
+}
+
+// recordWrite records the call to fullMethod in the transaction of the call, unless the method is
+// read-only, so that the reads of the caller go to the primary until the replica has caught up
+// with it.
+func (r *ReadReplica) recordWrite(ctx context.Context, tx *Tx, fullMethod string) error {
+	if r == nil || r.methods[fullMethod] {
+		return nil
+	}
+	identity := callerIdentity(ctx)
+	if identity == nil {
+		return nil
+	}
+	err := tx.RecentWrite.Create().
+		SetIdentityPublicKey(identity).
+		SetExpirationTime(time.Now().Add(r.window)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record write: %w", err)
+	}
+	return nil
+}
+
+type callerIdentityKey struct{}
+
+// WithCallerIdentity returns a context for an internal call another operator made for the identity
+// with the given public key, whose writes the call makes.
+func WithCallerIdentity(ctx context.Context, identityPublicKey []byte) context.Context {
+	return context.WithValue(ctx, callerIdentityKey{}, identityPublicKey)
+}
+
+// callerIdentity returns the identity public key of the caller's session, or of the identity the
+// internal call was made for, or nil if there is none.
+func callerIdentity(ctx context.Context) []byte {
+	if session, err := authn.GetSessionFromContext(ctx); err == nil {
+		return session.IdentityPublicKeyBytes()
+	}
+	identity, _ := ctx.Value(callerIdentityKey{}).([]byte)
+	return identity
+}
+
+// beginReadOnlyTx starts a read-only transaction on the replica.
+func (r *ReadReplica) beginReadOnlyTx(ctx context.Context) (*Tx, error) {
+	return r.client.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+}
+
+// PostgresReplicaLag measures the lag of the Postgres standby behind db as the age of the last
+// transaction it replayed. A standby that replayed all the WAL it received does not lag, however
+// old its last transaction is.
+func PostgresReplicaLag(db *stdsql.DB) ReplicaLagFunc {
+	return func(ctx context.Context) (time.Duration, error) {
+		var seconds stdsql.NullFloat64
+		err := db.QueryRowContext(ctx, `SELECT CASE
+			WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())
+		END`).Scan(&seconds)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query replica lag: %w", err)
+		}
+		if !seconds.Valid {
+			return 0, fmt.Errorf("replica has not replayed any transaction")
+		}
+		return time.Duration(seconds.Float64 * float64(time.Second)), nil
+	}
+}
@@ -0,0 +1,144 @@
+package ent_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lightsparkdev/spark/so/authn"
+	"github.com/lightsparkdev/spark/so/authninternal"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	testReadMethod  = "/spark.SparkService/query_nodes"
+	testWriteMethod = "/spark.SparkService/start_transfer"
+)
+
+func readReplicaSessionContext(t *testing.T) context.Context {
+	serverKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	verifier, err := authninternal.NewSessionTokenCreatorVerifier(serverKey.Serialize(), nil)
+	require.NoError(t, err)
+	identity, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	token, err := verifier.CreateToken(identity.PubKey().SerializeCompressed(), time.Hour)
+	require.NoError(t, err)
+
+	var ctx context.Context
+	_, err = authn.NewAuthnInterceptor(verifier).AuthnInterceptor(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token.Token)),
+		nil,
+		&grpc.UnaryServerInfo{},
+		func(c context.Context, _ interface{}) (interface{}, error) {
+			ctx = c
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+	return ctx
+}
+
+func TestDbSessionMiddlewareReadReplica(t *testing.T) {
+	primary := enttest.Open(t, "sqlite3", "file:read_replica_primary?mode=memory&_fk=1")
+	defer primary.Close()
+	replica := enttest.Open(t, "sqlite3", "file:read_replica_replica?mode=memory&_fk=1")
+	defer replica.Close()
+	// The replica is told apart from the primary by a row only it holds.
+	replica.BlockHeight.Create().SetHeight(1).SetNetwork(schema.NetworkRegtest).SaveX(context.Background())
+
+	lag, lagErr := time.Duration(0), error(nil)
+	readReplica := ent.NewReadReplica(replica, func(context.Context) (time.Duration, error) { return lag, lagErr },
+		[]string{testReadMethod}, time.Second)
+	measureLag := func(readReplicas ...*ent.ReadReplica) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if len(readReplicas) == 0 {
+			readReplicas = []*ent.ReadReplica{readReplica}
+		}
+		for _, r := range readReplicas {
+			r.MonitorLag(ctx)
+		}
+	}
+	middleware := ent.DbSessionMiddleware(primary, readReplica)
+
+	fromReplica := func(ctx context.Context, method string) bool {
+		resp, err := middleware(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return ent.GetDbFromContext(ctx).BlockHeight.Query().Exist(ctx)
+		})
+		require.NoError(t, err)
+		return resp.(bool)
+	}
+	ctx := readReplicaSessionContext(t)
+
+	// Reads go to the primary until the lag of the replica is known.
+	require.False(t, fromReplica(ctx, testReadMethod))
+	measureLag()
+	require.True(t, fromReplica(ctx, testReadMethod))
+	require.False(t, fromReplica(ctx, testWriteMethod))
+
+	// After a write, the reads of the caller go to the primary, but not those of others.
+	require.False(t, fromReplica(ctx, testReadMethod))
+	require.True(t, fromReplica(readReplicaSessionContext(t), testReadMethod))
+	require.True(t, fromReplica(context.Background(), testReadMethod))
+
+	// Other processes of the operator see the write too.
+	otherReplica := ent.NewReadReplica(replica, func(context.Context) (time.Duration, error) { return 0, nil },
+		[]string{testReadMethod}, time.Second)
+	measureLag(otherReplica)
+	_, err := ent.DbSessionMiddleware(primary, otherReplica)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: testReadMethod}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		exists, err := ent.GetDbFromContext(ctx).BlockHeight.Query().Exist(ctx)
+		require.False(t, exists)
+		return nil, err
+	})
+	require.NoError(t, err)
+
+	// Writes made by the internal calls of other operators for an identity count as its writes.
+	identity, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	internalCtx := ent.WithCallerIdentity(context.Background(), identity.PubKey().SerializeCompressed())
+	require.True(t, fromReplica(internalCtx, testReadMethod))
+	require.False(t, fromReplica(internalCtx, testWriteMethod))
+	require.False(t, fromReplica(internalCtx, testReadMethod))
+
+	// Writes are forgotten once the replica caught up with them.
+	tx, err := primary.Tx(context.Background())
+	require.NoError(t, err)
+	txCtx := context.WithValue(context.Background(), ent.TxKey, tx)
+	tx.RecentWrite.Create().
+		SetIdentityPublicKey(identity.PubKey().SerializeCompressed()).
+		SetExpirationTime(time.Now().Add(-time.Second)).
+		ExecX(txCtx)
+	purged, err := ent.PurgeRecentWrites(txCtx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 2, primary.RecentWrite.Query().CountX(context.Background()))
+
+	lag = 2 * time.Second
+	measureLag()
+	require.False(t, fromReplica(context.Background(), testReadMethod))
+
+	lag, lagErr = 0, errors.New("replica is down")
+	measureLag()
+	require.False(t, fromReplica(context.Background(), testReadMethod))
+}
+
+func TestDbSessionMiddlewareWithoutReadReplica(t *testing.T) {
+	primary := enttest.Open(t, "sqlite3", "file:without_read_replica?mode=memory&_fk=1")
+	defer primary.Close()
+
+	middleware := ent.DbSessionMiddleware(primary, nil)
+	_, err := middleware(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testReadMethod}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return ent.GetDbFromContext(ctx).BlockHeight.Create().SetHeight(1).SetNetwork(schema.NetworkRegtest).Save(ctx)
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, primary.BlockHeight.Query().CountX(context.Background()))
+}
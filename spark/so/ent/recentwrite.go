@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+)
+
+// RecentWrite is the model entity for the RecentWrite schema.
+type RecentWrite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// IdentityPublicKey holds the value of the "identity_public_key" field.
+	IdentityPublicKey []byte `json:"identity_public_key,omitempty"`
+	// ExpirationTime holds the value of the "expiration_time" field.
+	ExpirationTime time.Time `json:"expiration_time,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RecentWrite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recentwrite.FieldIdentityPublicKey:
+			values[i] = new([]byte)
+		case recentwrite.FieldCreateTime, recentwrite.FieldUpdateTime, recentwrite.FieldExpirationTime:
+			values[i] = new(sql.NullTime)
+		case recentwrite.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RecentWrite fields.
+func (rw *RecentWrite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recentwrite.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rw.ID = *value
+			}
+		case recentwrite.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				rw.CreateTime = value.Time
+			}
+		case recentwrite.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				rw.UpdateTime = value.Time
+			}
+		case recentwrite.FieldIdentityPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field identity_public_key", values[i])
+			} else if value != nil {
+				rw.IdentityPublicKey = *value
+			}
+		case recentwrite.FieldExpirationTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiration_time", values[i])
+			} else if value.Valid {
+				rw.ExpirationTime = value.Time
+			}
+		default:
+			rw.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RecentWrite.
+// This includes values selected through modifiers, order, etc.
+func (rw *RecentWrite) Value(name string) (ent.Value, error) {
+	return rw.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RecentWrite.
+// Note that you need to call RecentWrite.Unwrap() before calling this method if this RecentWrite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rw *RecentWrite) Update() *RecentWriteUpdateOne {
+	return NewRecentWriteClient(rw.config).UpdateOne(rw)
+}
+
+// Unwrap unwraps the RecentWrite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rw *RecentWrite) Unwrap() *RecentWrite {
+	_tx, ok := rw.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RecentWrite is not a transactional entity")
+	}
+	rw.config.driver = _tx.drv
+	return rw
+}
+
+// String implements the fmt.Stringer.
+func (rw *RecentWrite) String() string {
+	var builder strings.Builder
+	builder.WriteString("RecentWrite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rw.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(rw.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(rw.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("identity_public_key=")
+	builder.WriteString(fmt.Sprintf("%v", rw.IdentityPublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("expiration_time=")
+	builder.WriteString(rw.ExpirationTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RecentWrites is a parsable slice of RecentWrite.
+type RecentWrites []*RecentWrite
@@ -0,0 +1,82 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recentwrite
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the recentwrite type in the database.
+	Label = "recent_write"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldIdentityPublicKey holds the string denoting the identity_public_key field in the database.
+	FieldIdentityPublicKey = "identity_public_key"
+	// FieldExpirationTime holds the string denoting the expiration_time field in the database.
+	FieldExpirationTime = "expiration_time"
+	// Table holds the table name of the recentwrite in the database.
+	Table = "recent_writes"
+)
+
+// Columns holds all SQL columns for recentwrite fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldIdentityPublicKey,
+	FieldExpirationTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// IdentityPublicKeyValidator is a validator for the "identity_public_key" field. It is called by the builders before save.
+	IdentityPublicKeyValidator func([]byte) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the RecentWrite queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByExpirationTime orders the results by the expiration_time field.
+func ByExpirationTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpirationTime, opts...).ToFunc()
+}
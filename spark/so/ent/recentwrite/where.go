@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recentwrite
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// IdentityPublicKey applies equality check predicate on the "identity_public_key" field. It's identical to IdentityPublicKeyEQ.
+func IdentityPublicKey(v []byte) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldEQ(FieldIdentityPublicKey, v))
+}
+
+// ExpirationTime applies equality check predicate on the "expiration_time" field. It's identical to ExpirationTimeEQ.
+func ExpirationTime(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldEQ(FieldExpirationTime, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// IdentityPublicKeyEQ applies the EQ predicate on the "identity_public_key" field.
+func IdentityPublicKeyEQ(v []byte) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldEQ(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyNEQ applies the NEQ predicate on the "identity_public_key" field.
+func IdentityPublicKeyNEQ(v []byte) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldNEQ(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyIn applies the In predicate on the "identity_public_key" field.
+func IdentityPublicKeyIn(vs ...[]byte) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldIn(FieldIdentityPublicKey, vs...))
+}
+
+// IdentityPublicKeyNotIn applies the NotIn predicate on the "identity_public_key" field.
+func IdentityPublicKeyNotIn(vs ...[]byte) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldNotIn(FieldIdentityPublicKey, vs...))
+}
+
+// IdentityPublicKeyGT applies the GT predicate on the "identity_public_key" field.
+func IdentityPublicKeyGT(v []byte) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldGT(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyGTE applies the GTE predicate on the "identity_public_key" field.
+func IdentityPublicKeyGTE(v []byte) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldGTE(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyLT applies the LT predicate on the "identity_public_key" field.
+func IdentityPublicKeyLT(v []byte) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldLT(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyLTE applies the LTE predicate on the "identity_public_key" field.
+func IdentityPublicKeyLTE(v []byte) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldLTE(FieldIdentityPublicKey, v))
+}
+
+// ExpirationTimeEQ applies the EQ predicate on the "expiration_time" field.
+func ExpirationTimeEQ(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldEQ(FieldExpirationTime, v))
+}
+
+// ExpirationTimeNEQ applies the NEQ predicate on the "expiration_time" field.
+func ExpirationTimeNEQ(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldNEQ(FieldExpirationTime, v))
+}
+
+// ExpirationTimeIn applies the In predicate on the "expiration_time" field.
+func ExpirationTimeIn(vs ...time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldIn(FieldExpirationTime, vs...))
+}
+
+// ExpirationTimeNotIn applies the NotIn predicate on the "expiration_time" field.
+func ExpirationTimeNotIn(vs ...time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldNotIn(FieldExpirationTime, vs...))
+}
+
+// ExpirationTimeGT applies the GT predicate on the "expiration_time" field.
+func ExpirationTimeGT(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldGT(FieldExpirationTime, v))
+}
+
+// ExpirationTimeGTE applies the GTE predicate on the "expiration_time" field.
+func ExpirationTimeGTE(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldGTE(FieldExpirationTime, v))
+}
+
+// ExpirationTimeLT applies the LT predicate on the "expiration_time" field.
+func ExpirationTimeLT(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldLT(FieldExpirationTime, v))
+}
+
+// ExpirationTimeLTE applies the LTE predicate on the "expiration_time" field.
+func ExpirationTimeLTE(v time.Time) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.FieldLTE(FieldExpirationTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RecentWrite) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RecentWrite) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RecentWrite) predicate.RecentWrite {
+	return predicate.RecentWrite(sql.NotPredicates(p))
+}
@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+)
+
+// RecentWriteCreate is the builder for creating a RecentWrite entity.
+type RecentWriteCreate struct {
+	config
+	mutation *RecentWriteMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (rwc *RecentWriteCreate) SetCreateTime(t time.Time) *RecentWriteCreate {
+	rwc.mutation.SetCreateTime(t)
+	return rwc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (rwc *RecentWriteCreate) SetNillableCreateTime(t *time.Time) *RecentWriteCreate {
+	if t != nil {
+		rwc.SetCreateTime(*t)
+	}
+	return rwc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (rwc *RecentWriteCreate) SetUpdateTime(t time.Time) *RecentWriteCreate {
+	rwc.mutation.SetUpdateTime(t)
+	return rwc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (rwc *RecentWriteCreate) SetNillableUpdateTime(t *time.Time) *RecentWriteCreate {
+	if t != nil {
+		rwc.SetUpdateTime(*t)
+	}
+	return rwc
+}
+
+// SetIdentityPublicKey sets the "identity_public_key" field.
+func (rwc *RecentWriteCreate) SetIdentityPublicKey(b []byte) *RecentWriteCreate {
+	rwc.mutation.SetIdentityPublicKey(b)
+	return rwc
+}
+
+// SetExpirationTime sets the "expiration_time" field.
+func (rwc *RecentWriteCreate) SetExpirationTime(t time.Time) *RecentWriteCreate {
+	rwc.mutation.SetExpirationTime(t)
+	return rwc
+}
+
+// SetID sets the "id" field.
+func (rwc *RecentWriteCreate) SetID(u uuid.UUID) *RecentWriteCreate {
+	rwc.mutation.SetID(u)
+	return rwc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rwc *RecentWriteCreate) SetNillableID(u *uuid.UUID) *RecentWriteCreate {
+	if u != nil {
+		rwc.SetID(*u)
+	}
+	return rwc
+}
+
+// Mutation returns the RecentWriteMutation object of the builder.
+func (rwc *RecentWriteCreate) Mutation() *RecentWriteMutation {
+	return rwc.mutation
+}
+
+// Save creates the RecentWrite in the database.
+func (rwc *RecentWriteCreate) Save(ctx context.Context) (*RecentWrite, error) {
+	rwc.defaults()
+	return withHooks(ctx, rwc.sqlSave, rwc.mutation, rwc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rwc *RecentWriteCreate) SaveX(ctx context.Context) *RecentWrite {
+	v, err := rwc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rwc *RecentWriteCreate) Exec(ctx context.Context) error {
+	_, err := rwc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rwc *RecentWriteCreate) ExecX(ctx context.Context) {
+	if err := rwc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rwc *RecentWriteCreate) defaults() {
+	if _, ok := rwc.mutation.CreateTime(); !ok {
+		v := recentwrite.DefaultCreateTime()
+		rwc.mutation.SetCreateTime(v)
+	}
+	if _, ok := rwc.mutation.UpdateTime(); !ok {
+		v := recentwrite.DefaultUpdateTime()
+		rwc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := rwc.mutation.ID(); !ok {
+		v := recentwrite.DefaultID()
+		rwc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rwc *RecentWriteCreate) check() error {
+	if _, ok := rwc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "RecentWrite.create_time"`)}
+	}
+	if _, ok := rwc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "RecentWrite.update_time"`)}
+	}
+	if _, ok := rwc.mutation.IdentityPublicKey(); !ok {
+		return &ValidationError{Name: "identity_public_key", err: errors.New(`ent: missing required field "RecentWrite.identity_public_key"`)}
+	}
+	if v, ok := rwc.mutation.IdentityPublicKey(); ok {
+		if err := recentwrite.IdentityPublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "identity_public_key", err: fmt.Errorf(`ent: validator failed for field "RecentWrite.identity_public_key": %w`, err)}
+		}
+	}
+	if _, ok := rwc.mutation.ExpirationTime(); !ok {
+		return &ValidationError{Name: "expiration_time", err: errors.New(`ent: missing required field "RecentWrite.expiration_time"`)}
+	}
+	return nil
+}
+
+func (rwc *RecentWriteCreate) sqlSave(ctx context.Context) (*RecentWrite, error) {
+	if err := rwc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rwc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rwc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rwc.mutation.id = &_node.ID
+	rwc.mutation.done = true
+	return _node, nil
+}
+
+func (rwc *RecentWriteCreate) createSpec() (*RecentWrite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecentWrite{config: rwc.config}
+		_spec = sqlgraph.NewCreateSpec(recentwrite.Table, sqlgraph.NewFieldSpec(recentwrite.FieldID, field.TypeUUID))
+	)
+	if id, ok := rwc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rwc.mutation.CreateTime(); ok {
+		_spec.SetField(recentwrite.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := rwc.mutation.UpdateTime(); ok {
+		_spec.SetField(recentwrite.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := rwc.mutation.IdentityPublicKey(); ok {
+		_spec.SetField(recentwrite.FieldIdentityPublicKey, field.TypeBytes, value)
+		_node.IdentityPublicKey = value
+	}
+	if value, ok := rwc.mutation.ExpirationTime(); ok {
+		_spec.SetField(recentwrite.FieldExpirationTime, field.TypeTime, value)
+		_node.ExpirationTime = value
+	}
+	return _node, _spec
+}
+
+// RecentWriteCreateBulk is the builder for creating many RecentWrite entities in bulk.
+type RecentWriteCreateBulk struct {
+	config
+	err      error
+	builders []*RecentWriteCreate
+}
+
+// Save creates the RecentWrite entities in the database.
+func (rwcb *RecentWriteCreateBulk) Save(ctx context.Context) ([]*RecentWrite, error) {
+	if rwcb.err != nil {
+		return nil, rwcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rwcb.builders))
+	nodes := make([]*RecentWrite, len(rwcb.builders))
+	mutators := make([]Mutator, len(rwcb.builders))
+	for i := range rwcb.builders {
+		func(i int, root context.Context) {
+			builder := rwcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecentWriteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rwcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rwcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rwcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rwcb *RecentWriteCreateBulk) SaveX(ctx context.Context) []*RecentWrite {
+	v, err := rwcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rwcb *RecentWriteCreateBulk) Exec(ctx context.Context) error {
+	_, err := rwcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rwcb *RecentWriteCreateBulk) ExecX(ctx context.Context) {
+	if err := rwcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+)
+
+// RecentWriteDelete is the builder for deleting a RecentWrite entity.
+type RecentWriteDelete struct {
+	config
+	hooks    []Hook
+	mutation *RecentWriteMutation
+}
+
+// Where appends a list predicates to the RecentWriteDelete builder.
+func (rwd *RecentWriteDelete) Where(ps ...predicate.RecentWrite) *RecentWriteDelete {
+	rwd.mutation.Where(ps...)
+	return rwd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rwd *RecentWriteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rwd.sqlExec, rwd.mutation, rwd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rwd *RecentWriteDelete) ExecX(ctx context.Context) int {
+	n, err := rwd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rwd *RecentWriteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(recentwrite.Table, sqlgraph.NewFieldSpec(recentwrite.FieldID, field.TypeUUID))
+	if ps := rwd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rwd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rwd.mutation.done = true
+	return affected, err
+}
+
+// RecentWriteDeleteOne is the builder for deleting a single RecentWrite entity.
+type RecentWriteDeleteOne struct {
+	rwd *RecentWriteDelete
+}
+
+// Where appends a list predicates to the RecentWriteDelete builder.
+func (rwdo *RecentWriteDeleteOne) Where(ps ...predicate.RecentWrite) *RecentWriteDeleteOne {
+	rwdo.rwd.mutation.Where(ps...)
+	return rwdo
+}
+
+// Exec executes the deletion query.
+func (rwdo *RecentWriteDeleteOne) Exec(ctx context.Context) error {
+	n, err := rwdo.rwd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{recentwrite.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rwdo *RecentWriteDeleteOne) ExecX(ctx context.Context) {
+	if err := rwdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,30 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+)
+
+// PurgeRecentWrites deletes up to batchSize recent writes the read replica has caught up with, and
+// returns how many it deleted.
+func PurgeRecentWrites(ctx context.Context, batchSize int) (int, error) {
+	db := GetDbFromContext(ctx)
+	ids, err := db.RecentWrite.Query().
+		Where(recentwrite.ExpirationTimeLT(time.Now())).
+		Limit(batchSize).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query expired recent writes: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	purged, err := db.RecentWrite.Delete().Where(recentwrite.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge recent writes: %w", err)
+	}
+	return purged, nil
+}
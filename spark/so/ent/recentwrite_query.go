@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+)
+
+// RecentWriteQuery is the builder for querying RecentWrite entities.
+type RecentWriteQuery struct {
+	config
+	ctx        *QueryContext
+	order      []recentwrite.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RecentWrite
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RecentWriteQuery builder.
+func (rwq *RecentWriteQuery) Where(ps ...predicate.RecentWrite) *RecentWriteQuery {
+	rwq.predicates = append(rwq.predicates, ps...)
+	return rwq
+}
+
+// Limit the number of records to be returned by this query.
+func (rwq *RecentWriteQuery) Limit(limit int) *RecentWriteQuery {
+	rwq.ctx.Limit = &limit
+	return rwq
+}
+
+// Offset to start from.
+func (rwq *RecentWriteQuery) Offset(offset int) *RecentWriteQuery {
+	rwq.ctx.Offset = &offset
+	return rwq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rwq *RecentWriteQuery) Unique(unique bool) *RecentWriteQuery {
+	rwq.ctx.Unique = &unique
+	return rwq
+}
+
+// Order specifies how the records should be ordered.
+func (rwq *RecentWriteQuery) Order(o ...recentwrite.OrderOption) *RecentWriteQuery {
+	rwq.order = append(rwq.order, o...)
+	return rwq
+}
+
+// First returns the first RecentWrite entity from the query.
+// Returns a *NotFoundError when no RecentWrite was found.
+func (rwq *RecentWriteQuery) First(ctx context.Context) (*RecentWrite, error) {
+	nodes, err := rwq.Limit(1).All(setContextOp(ctx, rwq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{recentwrite.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rwq *RecentWriteQuery) FirstX(ctx context.Context) *RecentWrite {
+	node, err := rwq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RecentWrite ID from the query.
+// Returns a *NotFoundError when no RecentWrite ID was found.
+func (rwq *RecentWriteQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rwq.Limit(1).IDs(setContextOp(ctx, rwq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{recentwrite.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rwq *RecentWriteQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rwq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RecentWrite entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RecentWrite entity is found.
+// Returns a *NotFoundError when no RecentWrite entities are found.
+func (rwq *RecentWriteQuery) Only(ctx context.Context) (*RecentWrite, error) {
+	nodes, err := rwq.Limit(2).All(setContextOp(ctx, rwq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{recentwrite.Label}
+	default:
+		return nil, &NotSingularError{recentwrite.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rwq *RecentWriteQuery) OnlyX(ctx context.Context) *RecentWrite {
+	node, err := rwq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RecentWrite ID in the query.
+// Returns a *NotSingularError when more than one RecentWrite ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rwq *RecentWriteQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rwq.Limit(2).IDs(setContextOp(ctx, rwq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{recentwrite.Label}
+	default:
+		err = &NotSingularError{recentwrite.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rwq *RecentWriteQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rwq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RecentWrites.
+func (rwq *RecentWriteQuery) All(ctx context.Context) ([]*RecentWrite, error) {
+	ctx = setContextOp(ctx, rwq.ctx, ent.OpQueryAll)
+	if err := rwq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RecentWrite, *RecentWriteQuery]()
+	return withInterceptors[[]*RecentWrite](ctx, rwq, qr, rwq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rwq *RecentWriteQuery) AllX(ctx context.Context) []*RecentWrite {
+	nodes, err := rwq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RecentWrite IDs.
+func (rwq *RecentWriteQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rwq.ctx.Unique == nil && rwq.path != nil {
+		rwq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rwq.ctx, ent.OpQueryIDs)
+	if err = rwq.Select(recentwrite.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rwq *RecentWriteQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rwq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rwq *RecentWriteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rwq.ctx, ent.OpQueryCount)
+	if err := rwq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rwq, querierCount[*RecentWriteQuery](), rwq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rwq *RecentWriteQuery) CountX(ctx context.Context) int {
+	count, err := rwq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rwq *RecentWriteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rwq.ctx, ent.OpQueryExist)
+	switch _, err := rwq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rwq *RecentWriteQuery) ExistX(ctx context.Context) bool {
+	exist, err := rwq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RecentWriteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rwq *RecentWriteQuery) Clone() *RecentWriteQuery {
+	if rwq == nil {
+		return nil
+	}
+	return &RecentWriteQuery{
+		config:     rwq.config,
+		ctx:        rwq.ctx.Clone(),
+		order:      append([]recentwrite.OrderOption{}, rwq.order...),
+		inters:     append([]Interceptor{}, rwq.inters...),
+		predicates: append([]predicate.RecentWrite{}, rwq.predicates...),
+		// clone intermediate query.
+		sql:  rwq.sql.Clone(),
+		path: rwq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RecentWrite.Query().
+//		GroupBy(recentwrite.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rwq *RecentWriteQuery) GroupBy(field string, fields ...string) *RecentWriteGroupBy {
+	rwq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RecentWriteGroupBy{build: rwq}
+	grbuild.flds = &rwq.ctx.Fields
+	grbuild.label = recentwrite.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.RecentWrite.Query().
+//		Select(recentwrite.FieldCreateTime).
+//		Scan(ctx, &v)
+func (rwq *RecentWriteQuery) Select(fields ...string) *RecentWriteSelect {
+	rwq.ctx.Fields = append(rwq.ctx.Fields, fields...)
+	sbuild := &RecentWriteSelect{RecentWriteQuery: rwq}
+	sbuild.label = recentwrite.Label
+	sbuild.flds, sbuild.scan = &rwq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RecentWriteSelect configured with the given aggregations.
+func (rwq *RecentWriteQuery) Aggregate(fns ...AggregateFunc) *RecentWriteSelect {
+	return rwq.Select().Aggregate(fns...)
+}
+
+func (rwq *RecentWriteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rwq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rwq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rwq.ctx.Fields {
+		if !recentwrite.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rwq.path != nil {
+		prev, err := rwq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rwq.sql = prev
+	}
+	return nil
+}
+
+func (rwq *RecentWriteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RecentWrite, error) {
+	var (
+		nodes = []*RecentWrite{}
+		_spec = rwq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RecentWrite).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RecentWrite{config: rwq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(rwq.modifiers) > 0 {
+		_spec.Modifiers = rwq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rwq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rwq *RecentWriteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rwq.querySpec()
+	if len(rwq.modifiers) > 0 {
+		_spec.Modifiers = rwq.modifiers
+	}
+	_spec.Node.Columns = rwq.ctx.Fields
+	if len(rwq.ctx.Fields) > 0 {
+		_spec.Unique = rwq.ctx.Unique != nil && *rwq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rwq.driver, _spec)
+}
+
+func (rwq *RecentWriteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(recentwrite.Table, recentwrite.Columns, sqlgraph.NewFieldSpec(recentwrite.FieldID, field.TypeUUID))
+	_spec.From = rwq.sql
+	if unique := rwq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rwq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rwq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recentwrite.FieldID)
+		for i := range fields {
+			if fields[i] != recentwrite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rwq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rwq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rwq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rwq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rwq *RecentWriteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rwq.driver.Dialect())
+	t1 := builder.Table(recentwrite.Table)
+	columns := rwq.ctx.Fields
+	if len(columns) == 0 {
+		columns = recentwrite.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rwq.sql != nil {
+		selector = rwq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rwq.ctx.Unique != nil && *rwq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rwq.modifiers {
+		m(selector)
+	}
+	for _, p := range rwq.predicates {
+		p(selector)
+	}
+	for _, p := range rwq.order {
+		p(selector)
+	}
+	if offset := rwq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rwq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rwq *RecentWriteQuery) ForUpdate(opts ...sql.LockOption) *RecentWriteQuery {
+	if rwq.driver.Dialect() == dialect.Postgres {
+		rwq.Unique(false)
+	}
+	rwq.modifiers = append(rwq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rwq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rwq *RecentWriteQuery) ForShare(opts ...sql.LockOption) *RecentWriteQuery {
+	if rwq.driver.Dialect() == dialect.Postgres {
+		rwq.Unique(false)
+	}
+	rwq.modifiers = append(rwq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rwq
+}
+
+// RecentWriteGroupBy is the group-by builder for RecentWrite entities.
+type RecentWriteGroupBy struct {
+	selector
+	build *RecentWriteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rwgb *RecentWriteGroupBy) Aggregate(fns ...AggregateFunc) *RecentWriteGroupBy {
+	rwgb.fns = append(rwgb.fns, fns...)
+	return rwgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rwgb *RecentWriteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rwgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rwgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RecentWriteQuery, *RecentWriteGroupBy](ctx, rwgb.build, rwgb, rwgb.build.inters, v)
+}
+
+func (rwgb *RecentWriteGroupBy) sqlScan(ctx context.Context, root *RecentWriteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rwgb.fns))
+	for _, fn := range rwgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rwgb.flds)+len(rwgb.fns))
+		for _, f := range *rwgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rwgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rwgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RecentWriteSelect is the builder for selecting fields of RecentWrite entities.
+type RecentWriteSelect struct {
+	*RecentWriteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rws *RecentWriteSelect) Aggregate(fns ...AggregateFunc) *RecentWriteSelect {
+	rws.fns = append(rws.fns, fns...)
+	return rws
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rws *RecentWriteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rws.ctx, ent.OpQuerySelect)
+	if err := rws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RecentWriteQuery, *RecentWriteSelect](ctx, rws.RecentWriteQuery, rws, rws.inters, v)
+}
+
+func (rws *RecentWriteSelect) sqlScan(ctx context.Context, root *RecentWriteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rws.fns))
+	for _, fn := range rws.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rws.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+)
+
+// RecentWriteUpdate is the builder for updating RecentWrite entities.
+type RecentWriteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecentWriteMutation
+}
+
+// Where appends a list predicates to the RecentWriteUpdate builder.
+func (rwu *RecentWriteUpdate) Where(ps ...predicate.RecentWrite) *RecentWriteUpdate {
+	rwu.mutation.Where(ps...)
+	return rwu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (rwu *RecentWriteUpdate) SetUpdateTime(t time.Time) *RecentWriteUpdate {
+	rwu.mutation.SetUpdateTime(t)
+	return rwu
+}
+
+// Mutation returns the RecentWriteMutation object of the builder.
+func (rwu *RecentWriteUpdate) Mutation() *RecentWriteMutation {
+	return rwu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rwu *RecentWriteUpdate) Save(ctx context.Context) (int, error) {
+	rwu.defaults()
+	return withHooks(ctx, rwu.sqlSave, rwu.mutation, rwu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rwu *RecentWriteUpdate) SaveX(ctx context.Context) int {
+	affected, err := rwu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rwu *RecentWriteUpdate) Exec(ctx context.Context) error {
+	_, err := rwu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rwu *RecentWriteUpdate) ExecX(ctx context.Context) {
+	if err := rwu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rwu *RecentWriteUpdate) defaults() {
+	if _, ok := rwu.mutation.UpdateTime(); !ok {
+		v := recentwrite.UpdateDefaultUpdateTime()
+		rwu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (rwu *RecentWriteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(recentwrite.Table, recentwrite.Columns, sqlgraph.NewFieldSpec(recentwrite.FieldID, field.TypeUUID))
+	if ps := rwu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rwu.mutation.UpdateTime(); ok {
+		_spec.SetField(recentwrite.FieldUpdateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rwu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recentwrite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rwu.mutation.done = true
+	return n, nil
+}
+
+// RecentWriteUpdateOne is the builder for updating a single RecentWrite entity.
+type RecentWriteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecentWriteMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (rwuo *RecentWriteUpdateOne) SetUpdateTime(t time.Time) *RecentWriteUpdateOne {
+	rwuo.mutation.SetUpdateTime(t)
+	return rwuo
+}
+
+// Mutation returns the RecentWriteMutation object of the builder.
+func (rwuo *RecentWriteUpdateOne) Mutation() *RecentWriteMutation {
+	return rwuo.mutation
+}
+
+// Where appends a list predicates to the RecentWriteUpdate builder.
+func (rwuo *RecentWriteUpdateOne) Where(ps ...predicate.RecentWrite) *RecentWriteUpdateOne {
+	rwuo.mutation.Where(ps...)
+	return rwuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rwuo *RecentWriteUpdateOne) Select(field string, fields ...string) *RecentWriteUpdateOne {
+	rwuo.fields = append([]string{field}, fields...)
+	return rwuo
+}
+
+// Save executes the query and returns the updated RecentWrite entity.
+func (rwuo *RecentWriteUpdateOne) Save(ctx context.Context) (*RecentWrite, error) {
+	rwuo.defaults()
+	return withHooks(ctx, rwuo.sqlSave, rwuo.mutation, rwuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rwuo *RecentWriteUpdateOne) SaveX(ctx context.Context) *RecentWrite {
+	node, err := rwuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rwuo *RecentWriteUpdateOne) Exec(ctx context.Context) error {
+	_, err := rwuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rwuo *RecentWriteUpdateOne) ExecX(ctx context.Context) {
+	if err := rwuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rwuo *RecentWriteUpdateOne) defaults() {
+	if _, ok := rwuo.mutation.UpdateTime(); !ok {
+		v := recentwrite.UpdateDefaultUpdateTime()
+		rwuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (rwuo *RecentWriteUpdateOne) sqlSave(ctx context.Context) (_node *RecentWrite, err error) {
+	_spec := sqlgraph.NewUpdateSpec(recentwrite.Table, recentwrite.Columns, sqlgraph.NewFieldSpec(recentwrite.FieldID, field.TypeUUID))
+	id, ok := rwuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RecentWrite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rwuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recentwrite.FieldID)
+		for _, f := range fields {
+			if !recentwrite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recentwrite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rwuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rwuo.mutation.UpdateTime(); ok {
+		_spec.SetField(recentwrite.FieldUpdateTime, field.TypeTime, value)
+	}
+	_node = &RecentWrite{config: rwuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rwuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recentwrite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rwuo.mutation.done = true
+	return _node, nil
+}
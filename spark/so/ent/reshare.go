@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/reshare"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// Reshare is the model entity for the Reshare schema.
+type Reshare struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Status holds the value of the "status" field.
+	Status schema.ShareRefreshStatus `json:"status,omitempty"`
+	// CoordinatorIndex holds the value of the "coordinator_index" field.
+	CoordinatorIndex uint64 `json:"coordinator_index,omitempty"`
+	// Keyshares holds the value of the "keyshares" field.
+	Keyshares [][]uint8 `json:"keyshares,omitempty"`
+	// Dealers holds the value of the "dealers" field.
+	Dealers []string `json:"dealers,omitempty"`
+	// Contributions holds the value of the "contributions" field.
+	Contributions [][]uint8 `json:"contributions,omitempty"`
+	// PreviousKeyshares holds the value of the "previous_keyshares" field.
+	PreviousKeyshares []schema.ResharedKeyshare `json:"previous_keyshares,omitempty"`
+	selectValues      sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reshare) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reshare.FieldKeyshares, reshare.FieldDealers, reshare.FieldContributions, reshare.FieldPreviousKeyshares:
+			values[i] = new([]byte)
+		case reshare.FieldCoordinatorIndex:
+			values[i] = new(sql.NullInt64)
+		case reshare.FieldStatus:
+			values[i] = new(sql.NullString)
+		case reshare.FieldCreateTime, reshare.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case reshare.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reshare fields.
+func (r *Reshare) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reshare.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				r.ID = *value
+			}
+		case reshare.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				r.CreateTime = value.Time
+			}
+		case reshare.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				r.UpdateTime = value.Time
+			}
+		case reshare.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = schema.ShareRefreshStatus(value.String)
+			}
+		case reshare.FieldCoordinatorIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field coordinator_index", values[i])
+			} else if value.Valid {
+				r.CoordinatorIndex = uint64(value.Int64)
+			}
+		case reshare.FieldKeyshares:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keyshares", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Keyshares); err != nil {
+					return fmt.Errorf("unmarshal field keyshares: %w", err)
+				}
+			}
+		case reshare.FieldDealers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field dealers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Dealers); err != nil {
+					return fmt.Errorf("unmarshal field dealers: %w", err)
+				}
+			}
+		case reshare.FieldContributions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field contributions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Contributions); err != nil {
+					return fmt.Errorf("unmarshal field contributions: %w", err)
+				}
+			}
+		case reshare.FieldPreviousKeyshares:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_keyshares", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.PreviousKeyshares); err != nil {
+					return fmt.Errorf("unmarshal field previous_keyshares: %w", err)
+				}
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reshare.
+// This includes values selected through modifiers, order, etc.
+func (r *Reshare) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Reshare.
+// Note that you need to call Reshare.Unwrap() before calling this method if this Reshare
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reshare) Update() *ReshareUpdateOne {
+	return NewReshareClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reshare entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reshare) Unwrap() *Reshare {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reshare is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reshare) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reshare(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(r.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(r.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", ")
+	builder.WriteString("coordinator_index=")
+	builder.WriteString(fmt.Sprintf("%v", r.CoordinatorIndex))
+	builder.WriteString(", ")
+	builder.WriteString("keyshares=")
+	builder.WriteString(fmt.Sprintf("%v", r.Keyshares))
+	builder.WriteString(", ")
+	builder.WriteString("dealers=")
+	builder.WriteString(fmt.Sprintf("%v", r.Dealers))
+	builder.WriteString(", ")
+	builder.WriteString("contributions=")
+	builder.WriteString(fmt.Sprintf("%v", r.Contributions))
+	builder.WriteString(", ")
+	builder.WriteString("previous_keyshares=")
+	builder.WriteString(fmt.Sprintf("%v", r.PreviousKeyshares))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reshares is a parsable slice of Reshare.
+type Reshares []*Reshare
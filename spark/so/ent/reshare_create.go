@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/reshare"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ReshareCreate is the builder for creating a Reshare entity.
+type ReshareCreate struct {
+	config
+	mutation *ReshareMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (rc *ReshareCreate) SetCreateTime(t time.Time) *ReshareCreate {
+	rc.mutation.SetCreateTime(t)
+	return rc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (rc *ReshareCreate) SetNillableCreateTime(t *time.Time) *ReshareCreate {
+	if t != nil {
+		rc.SetCreateTime(*t)
+	}
+	return rc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (rc *ReshareCreate) SetUpdateTime(t time.Time) *ReshareCreate {
+	rc.mutation.SetUpdateTime(t)
+	return rc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (rc *ReshareCreate) SetNillableUpdateTime(t *time.Time) *ReshareCreate {
+	if t != nil {
+		rc.SetUpdateTime(*t)
+	}
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReshareCreate) SetStatus(srs schema.ShareRefreshStatus) *ReshareCreate {
+	rc.mutation.SetStatus(srs)
+	return rc
+}
+
+// SetCoordinatorIndex sets the "coordinator_index" field.
+func (rc *ReshareCreate) SetCoordinatorIndex(u uint64) *ReshareCreate {
+	rc.mutation.SetCoordinatorIndex(u)
+	return rc
+}
+
+// SetKeyshares sets the "keyshares" field.
+func (rc *ReshareCreate) SetKeyshares(u [][]uint8) *ReshareCreate {
+	rc.mutation.SetKeyshares(u)
+	return rc
+}
+
+// SetDealers sets the "dealers" field.
+func (rc *ReshareCreate) SetDealers(s []string) *ReshareCreate {
+	rc.mutation.SetDealers(s)
+	return rc
+}
+
+// SetContributions sets the "contributions" field.
+func (rc *ReshareCreate) SetContributions(u [][]uint8) *ReshareCreate {
+	rc.mutation.SetContributions(u)
+	return rc
+}
+
+// SetPreviousKeyshares sets the "previous_keyshares" field.
+func (rc *ReshareCreate) SetPreviousKeyshares(sk []schema.ResharedKeyshare) *ReshareCreate {
+	rc.mutation.SetPreviousKeyshares(sk)
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReshareCreate) SetID(u uuid.UUID) *ReshareCreate {
+	rc.mutation.SetID(u)
+	return rc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rc *ReshareCreate) SetNillableID(u *uuid.UUID) *ReshareCreate {
+	if u != nil {
+		rc.SetID(*u)
+	}
+	return rc
+}
+
+// Mutation returns the ReshareMutation object of the builder.
+func (rc *ReshareCreate) Mutation() *ReshareMutation {
+	return rc.mutation
+}
+
+// Save creates the Reshare in the database.
+func (rc *ReshareCreate) Save(ctx context.Context) (*Reshare, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReshareCreate) SaveX(ctx context.Context) *Reshare {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReshareCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReshareCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReshareCreate) defaults() {
+	if _, ok := rc.mutation.CreateTime(); !ok {
+		v := reshare.DefaultCreateTime()
+		rc.mutation.SetCreateTime(v)
+	}
+	if _, ok := rc.mutation.UpdateTime(); !ok {
+		v := reshare.DefaultUpdateTime()
+		rc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := rc.mutation.ID(); !ok {
+		v := reshare.DefaultID()
+		rc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReshareCreate) check() error {
+	if _, ok := rc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Reshare.create_time"`)}
+	}
+	if _, ok := rc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Reshare.update_time"`)}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Reshare.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := reshare.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reshare.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.CoordinatorIndex(); !ok {
+		return &ValidationError{Name: "coordinator_index", err: errors.New(`ent: missing required field "Reshare.coordinator_index"`)}
+	}
+	if _, ok := rc.mutation.Keyshares(); !ok {
+		return &ValidationError{Name: "keyshares", err: errors.New(`ent: missing required field "Reshare.keyshares"`)}
+	}
+	if _, ok := rc.mutation.Dealers(); !ok {
+		return &ValidationError{Name: "dealers", err: errors.New(`ent: missing required field "Reshare.dealers"`)}
+	}
+	return nil
+}
+
+func (rc *ReshareCreate) sqlSave(ctx context.Context) (*Reshare, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReshareCreate) createSpec() (*Reshare, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reshare{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(reshare.Table, sqlgraph.NewFieldSpec(reshare.FieldID, field.TypeUUID))
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rc.mutation.CreateTime(); ok {
+		_spec.SetField(reshare.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := rc.mutation.UpdateTime(); ok {
+		_spec.SetField(reshare.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(reshare.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.CoordinatorIndex(); ok {
+		_spec.SetField(reshare.FieldCoordinatorIndex, field.TypeUint64, value)
+		_node.CoordinatorIndex = value
+	}
+	if value, ok := rc.mutation.Keyshares(); ok {
+		_spec.SetField(reshare.FieldKeyshares, field.TypeJSON, value)
+		_node.Keyshares = value
+	}
+	if value, ok := rc.mutation.Dealers(); ok {
+		_spec.SetField(reshare.FieldDealers, field.TypeJSON, value)
+		_node.Dealers = value
+	}
+	if value, ok := rc.mutation.Contributions(); ok {
+		_spec.SetField(reshare.FieldContributions, field.TypeJSON, value)
+		_node.Contributions = value
+	}
+	if value, ok := rc.mutation.PreviousKeyshares(); ok {
+		_spec.SetField(reshare.FieldPreviousKeyshares, field.TypeJSON, value)
+		_node.PreviousKeyshares = value
+	}
+	return _node, _spec
+}
+
+// ReshareCreateBulk is the builder for creating many Reshare entities in bulk.
+type ReshareCreateBulk struct {
+	config
+	err      error
+	builders []*ReshareCreate
+}
+
+// Save creates the Reshare entities in the database.
+func (rcb *ReshareCreateBulk) Save(ctx context.Context) ([]*Reshare, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reshare, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReshareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReshareCreateBulk) SaveX(ctx context.Context) []*Reshare {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReshareCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReshareCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/reshare"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ReshareUpdate is the builder for updating Reshare entities.
+type ReshareUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReshareMutation
+}
+
+// Where appends a list predicates to the ReshareUpdate builder.
+func (ru *ReshareUpdate) Where(ps ...predicate.Reshare) *ReshareUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ru *ReshareUpdate) SetUpdateTime(t time.Time) *ReshareUpdate {
+	ru.mutation.SetUpdateTime(t)
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReshareUpdate) SetStatus(srs schema.ShareRefreshStatus) *ReshareUpdate {
+	ru.mutation.SetStatus(srs)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReshareUpdate) SetNillableStatus(srs *schema.ShareRefreshStatus) *ReshareUpdate {
+	if srs != nil {
+		ru.SetStatus(*srs)
+	}
+	return ru
+}
+
+// SetContributions sets the "contributions" field.
+func (ru *ReshareUpdate) SetContributions(u [][]uint8) *ReshareUpdate {
+	ru.mutation.SetContributions(u)
+	return ru
+}
+
+// AppendContributions appends u to the "contributions" field.
+func (ru *ReshareUpdate) AppendContributions(u [][]uint8) *ReshareUpdate {
+	ru.mutation.AppendContributions(u)
+	return ru
+}
+
+// ClearContributions clears the value of the "contributions" field.
+func (ru *ReshareUpdate) ClearContributions() *ReshareUpdate {
+	ru.mutation.ClearContributions()
+	return ru
+}
+
+// SetPreviousKeyshares sets the "previous_keyshares" field.
+func (ru *ReshareUpdate) SetPreviousKeyshares(sk []schema.ResharedKeyshare) *ReshareUpdate {
+	ru.mutation.SetPreviousKeyshares(sk)
+	return ru
+}
+
+// AppendPreviousKeyshares appends sk to the "previous_keyshares" field.
+func (ru *ReshareUpdate) AppendPreviousKeyshares(sk []schema.ResharedKeyshare) *ReshareUpdate {
+	ru.mutation.AppendPreviousKeyshares(sk)
+	return ru
+}
+
+// ClearPreviousKeyshares clears the value of the "previous_keyshares" field.
+func (ru *ReshareUpdate) ClearPreviousKeyshares() *ReshareUpdate {
+	ru.mutation.ClearPreviousKeyshares()
+	return ru
+}
+
+// Mutation returns the ReshareMutation object of the builder.
+func (ru *ReshareUpdate) Mutation() *ReshareMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReshareUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReshareUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReshareUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReshareUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *ReshareUpdate) defaults() {
+	if _, ok := ru.mutation.UpdateTime(); !ok {
+		v := reshare.UpdateDefaultUpdateTime()
+		ru.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReshareUpdate) check() error {
+	if v, ok := ru.mutation.Status(); ok {
+		if err := reshare.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reshare.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ru *ReshareUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reshare.Table, reshare.Columns, sqlgraph.NewFieldSpec(reshare.FieldID, field.TypeUUID))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.UpdateTime(); ok {
+		_spec.SetField(reshare.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(reshare.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.Contributions(); ok {
+		_spec.SetField(reshare.FieldContributions, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedContributions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reshare.FieldContributions, value)
+		})
+	}
+	if ru.mutation.ContributionsCleared() {
+		_spec.ClearField(reshare.FieldContributions, field.TypeJSON)
+	}
+	if value, ok := ru.mutation.PreviousKeyshares(); ok {
+		_spec.SetField(reshare.FieldPreviousKeyshares, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedPreviousKeyshares(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reshare.FieldPreviousKeyshares, value)
+		})
+	}
+	if ru.mutation.PreviousKeysharesCleared() {
+		_spec.ClearField(reshare.FieldPreviousKeyshares, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReshareUpdateOne is the builder for updating a single Reshare entity.
+type ReshareUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReshareMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ruo *ReshareUpdateOne) SetUpdateTime(t time.Time) *ReshareUpdateOne {
+	ruo.mutation.SetUpdateTime(t)
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReshareUpdateOne) SetStatus(srs schema.ShareRefreshStatus) *ReshareUpdateOne {
+	ruo.mutation.SetStatus(srs)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReshareUpdateOne) SetNillableStatus(srs *schema.ShareRefreshStatus) *ReshareUpdateOne {
+	if srs != nil {
+		ruo.SetStatus(*srs)
+	}
+	return ruo
+}
+
+// SetContributions sets the "contributions" field.
+func (ruo *ReshareUpdateOne) SetContributions(u [][]uint8) *ReshareUpdateOne {
+	ruo.mutation.SetContributions(u)
+	return ruo
+}
+
+// AppendContributions appends u to the "contributions" field.
+func (ruo *ReshareUpdateOne) AppendContributions(u [][]uint8) *ReshareUpdateOne {
+	ruo.mutation.AppendContributions(u)
+	return ruo
+}
+
+// ClearContributions clears the value of the "contributions" field.
+func (ruo *ReshareUpdateOne) ClearContributions() *ReshareUpdateOne {
+	ruo.mutation.ClearContributions()
+	return ruo
+}
+
+// SetPreviousKeyshares sets the "previous_keyshares" field.
+func (ruo *ReshareUpdateOne) SetPreviousKeyshares(sk []schema.ResharedKeyshare) *ReshareUpdateOne {
+	ruo.mutation.SetPreviousKeyshares(sk)
+	return ruo
+}
+
+// AppendPreviousKeyshares appends sk to the "previous_keyshares" field.
+func (ruo *ReshareUpdateOne) AppendPreviousKeyshares(sk []schema.ResharedKeyshare) *ReshareUpdateOne {
+	ruo.mutation.AppendPreviousKeyshares(sk)
+	return ruo
+}
+
+// ClearPreviousKeyshares clears the value of the "previous_keyshares" field.
+func (ruo *ReshareUpdateOne) ClearPreviousKeyshares() *ReshareUpdateOne {
+	ruo.mutation.ClearPreviousKeyshares()
+	return ruo
+}
+
+// Mutation returns the ReshareMutation object of the builder.
+func (ruo *ReshareUpdateOne) Mutation() *ReshareMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReshareUpdate builder.
+func (ruo *ReshareUpdateOne) Where(ps ...predicate.Reshare) *ReshareUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReshareUpdateOne) Select(field string, fields ...string) *ReshareUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reshare entity.
+func (ruo *ReshareUpdateOne) Save(ctx context.Context) (*Reshare, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReshareUpdateOne) SaveX(ctx context.Context) *Reshare {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReshareUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReshareUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *ReshareUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdateTime(); !ok {
+		v := reshare.UpdateDefaultUpdateTime()
+		ruo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReshareUpdateOne) check() error {
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := reshare.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reshare.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ruo *ReshareUpdateOne) sqlSave(ctx context.Context) (_node *Reshare, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reshare.Table, reshare.Columns, sqlgraph.NewFieldSpec(reshare.FieldID, field.TypeUUID))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reshare.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reshare.FieldID)
+		for _, f := range fields {
+			if !reshare.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.UpdateTime(); ok {
+		_spec.SetField(reshare.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(reshare.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.Contributions(); ok {
+		_spec.SetField(reshare.FieldContributions, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedContributions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reshare.FieldContributions, value)
+		})
+	}
+	if ruo.mutation.ContributionsCleared() {
+		_spec.ClearField(reshare.FieldContributions, field.TypeJSON)
+	}
+	if value, ok := ruo.mutation.PreviousKeyshares(); ok {
+		_spec.SetField(reshare.FieldPreviousKeyshares, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedPreviousKeyshares(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reshare.FieldPreviousKeyshares, value)
+		})
+	}
+	if ruo.mutation.PreviousKeysharesCleared() {
+		_spec.ClearField(reshare.FieldPreviousKeyshares, field.TypeJSON)
+	}
+	_node = &Reshare{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
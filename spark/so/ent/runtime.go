@@ -6,14 +6,29 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/archive"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
 	"github.com/lightsparkdev/spark/so/ent/blockheight"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
 	"github.com/lightsparkdev/spark/so/ent/cooperativeexit"
 	"github.com/lightsparkdev/spark/so/ent/depositaddress"
+	"github.com/lightsparkdev/spark/so/ent/dkgsession"
+	"github.com/lightsparkdev/spark/so/ent/idempotencykey"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/operatorcallnonce"
 	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
 	"github.com/lightsparkdev/spark/so/ent/preimageshare"
+	"github.com/lightsparkdev/spark/so/ent/recentwrite"
+	"github.com/lightsparkdev/spark/so/ent/reshare"
 	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/sessionrevocation"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+	"github.com/lightsparkdev/spark/so/ent/signingincident"
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
+	"github.com/lightsparkdev/spark/so/ent/tasklock"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
 	"github.com/lightsparkdev/spark/so/ent/tokenleaf"
 	"github.com/lightsparkdev/spark/so/ent/tokenmint"
@@ -33,6 +48,60 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	archiveMixin := schema.Archive{}.Mixin()
+	archiveMixinFields0 := archiveMixin[0].Fields()
+	_ = archiveMixinFields0
+	archiveFields := schema.Archive{}.Fields()
+	_ = archiveFields
+	// archiveDescCreateTime is the schema descriptor for create_time field.
+	archiveDescCreateTime := archiveMixinFields0[1].Descriptor()
+	// archive.DefaultCreateTime holds the default value on creation for the create_time field.
+	archive.DefaultCreateTime = archiveDescCreateTime.Default.(func() time.Time)
+	// archiveDescUpdateTime is the schema descriptor for update_time field.
+	archiveDescUpdateTime := archiveMixinFields0[2].Descriptor()
+	// archive.DefaultUpdateTime holds the default value on creation for the update_time field.
+	archive.DefaultUpdateTime = archiveDescUpdateTime.Default.(func() time.Time)
+	// archive.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	archive.UpdateDefaultUpdateTime = archiveDescUpdateTime.UpdateDefault.(func() time.Time)
+	// archiveDescKind is the schema descriptor for kind field.
+	archiveDescKind := archiveFields[0].Descriptor()
+	// archive.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	archive.KindValidator = archiveDescKind.Validators[0].(func(string) error)
+	// archiveDescData is the schema descriptor for data field.
+	archiveDescData := archiveFields[2].Descriptor()
+	// archive.DataValidator is a validator for the "data" field. It is called by the builders before save.
+	archive.DataValidator = archiveDescData.Validators[0].(func([]byte) error)
+	// archiveDescID is the schema descriptor for id field.
+	archiveDescID := archiveMixinFields0[0].Descriptor()
+	// archive.DefaultID holds the default value on creation for the id field.
+	archive.DefaultID = archiveDescID.Default.(func() uuid.UUID)
+	archivedtransferMixin := schema.ArchivedTransfer{}.Mixin()
+	archivedtransferMixinFields0 := archivedtransferMixin[0].Fields()
+	_ = archivedtransferMixinFields0
+	archivedtransferFields := schema.ArchivedTransfer{}.Fields()
+	_ = archivedtransferFields
+	// archivedtransferDescCreateTime is the schema descriptor for create_time field.
+	archivedtransferDescCreateTime := archivedtransferMixinFields0[1].Descriptor()
+	// archivedtransfer.DefaultCreateTime holds the default value on creation for the create_time field.
+	archivedtransfer.DefaultCreateTime = archivedtransferDescCreateTime.Default.(func() time.Time)
+	// archivedtransferDescUpdateTime is the schema descriptor for update_time field.
+	archivedtransferDescUpdateTime := archivedtransferMixinFields0[2].Descriptor()
+	// archivedtransfer.DefaultUpdateTime holds the default value on creation for the update_time field.
+	archivedtransfer.DefaultUpdateTime = archivedtransferDescUpdateTime.Default.(func() time.Time)
+	// archivedtransfer.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	archivedtransfer.UpdateDefaultUpdateTime = archivedtransferDescUpdateTime.UpdateDefault.(func() time.Time)
+	// archivedtransferDescSenderIdentityPubkey is the schema descriptor for sender_identity_pubkey field.
+	archivedtransferDescSenderIdentityPubkey := archivedtransferFields[0].Descriptor()
+	// archivedtransfer.SenderIdentityPubkeyValidator is a validator for the "sender_identity_pubkey" field. It is called by the builders before save.
+	archivedtransfer.SenderIdentityPubkeyValidator = archivedtransferDescSenderIdentityPubkey.Validators[0].(func([]byte) error)
+	// archivedtransferDescReceiverIdentityPubkey is the schema descriptor for receiver_identity_pubkey field.
+	archivedtransferDescReceiverIdentityPubkey := archivedtransferFields[1].Descriptor()
+	// archivedtransfer.ReceiverIdentityPubkeyValidator is a validator for the "receiver_identity_pubkey" field. It is called by the builders before save.
+	archivedtransfer.ReceiverIdentityPubkeyValidator = archivedtransferDescReceiverIdentityPubkey.Validators[0].(func([]byte) error)
+	// archivedtransferDescID is the schema descriptor for id field.
+	archivedtransferDescID := archivedtransferMixinFields0[0].Descriptor()
+	// archivedtransfer.DefaultID holds the default value on creation for the id field.
+	archivedtransfer.DefaultID = archivedtransferDescID.Default.(func() uuid.UUID)
 	blockheightMixin := schema.BlockHeight{}.Mixin()
 	blockheightMixinFields0 := blockheightMixin[0].Fields()
 	_ = blockheightMixinFields0
@@ -52,6 +121,48 @@ func init() {
 	blockheightDescID := blockheightMixinFields0[0].Descriptor()
 	// blockheight.DefaultID holds the default value on creation for the id field.
 	blockheight.DefaultID = blockheightDescID.Default.(func() uuid.UUID)
+	consistencyauditcursorMixin := schema.ConsistencyAuditCursor{}.Mixin()
+	consistencyauditcursorMixinFields0 := consistencyauditcursorMixin[0].Fields()
+	_ = consistencyauditcursorMixinFields0
+	consistencyauditcursorFields := schema.ConsistencyAuditCursor{}.Fields()
+	_ = consistencyauditcursorFields
+	// consistencyauditcursorDescCreateTime is the schema descriptor for create_time field.
+	consistencyauditcursorDescCreateTime := consistencyauditcursorMixinFields0[1].Descriptor()
+	// consistencyauditcursor.DefaultCreateTime holds the default value on creation for the create_time field.
+	consistencyauditcursor.DefaultCreateTime = consistencyauditcursorDescCreateTime.Default.(func() time.Time)
+	// consistencyauditcursorDescUpdateTime is the schema descriptor for update_time field.
+	consistencyauditcursorDescUpdateTime := consistencyauditcursorMixinFields0[2].Descriptor()
+	// consistencyauditcursor.DefaultUpdateTime holds the default value on creation for the update_time field.
+	consistencyauditcursor.DefaultUpdateTime = consistencyauditcursorDescUpdateTime.Default.(func() time.Time)
+	// consistencyauditcursor.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	consistencyauditcursor.UpdateDefaultUpdateTime = consistencyauditcursorDescUpdateTime.UpdateDefault.(func() time.Time)
+	// consistencyauditcursorDescKey is the schema descriptor for key field.
+	consistencyauditcursorDescKey := consistencyauditcursorFields[1].Descriptor()
+	// consistencyauditcursor.DefaultKey holds the default value on creation for the key field.
+	consistencyauditcursor.DefaultKey = consistencyauditcursorDescKey.Default.(string)
+	// consistencyauditcursorDescID is the schema descriptor for id field.
+	consistencyauditcursorDescID := consistencyauditcursorMixinFields0[0].Descriptor()
+	// consistencyauditcursor.DefaultID holds the default value on creation for the id field.
+	consistencyauditcursor.DefaultID = consistencyauditcursorDescID.Default.(func() uuid.UUID)
+	consistencydiscrepancyMixin := schema.ConsistencyDiscrepancy{}.Mixin()
+	consistencydiscrepancyMixinFields0 := consistencydiscrepancyMixin[0].Fields()
+	_ = consistencydiscrepancyMixinFields0
+	consistencydiscrepancyFields := schema.ConsistencyDiscrepancy{}.Fields()
+	_ = consistencydiscrepancyFields
+	// consistencydiscrepancyDescCreateTime is the schema descriptor for create_time field.
+	consistencydiscrepancyDescCreateTime := consistencydiscrepancyMixinFields0[1].Descriptor()
+	// consistencydiscrepancy.DefaultCreateTime holds the default value on creation for the create_time field.
+	consistencydiscrepancy.DefaultCreateTime = consistencydiscrepancyDescCreateTime.Default.(func() time.Time)
+	// consistencydiscrepancyDescUpdateTime is the schema descriptor for update_time field.
+	consistencydiscrepancyDescUpdateTime := consistencydiscrepancyMixinFields0[2].Descriptor()
+	// consistencydiscrepancy.DefaultUpdateTime holds the default value on creation for the update_time field.
+	consistencydiscrepancy.DefaultUpdateTime = consistencydiscrepancyDescUpdateTime.Default.(func() time.Time)
+	// consistencydiscrepancy.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	consistencydiscrepancy.UpdateDefaultUpdateTime = consistencydiscrepancyDescUpdateTime.UpdateDefault.(func() time.Time)
+	// consistencydiscrepancyDescID is the schema descriptor for id field.
+	consistencydiscrepancyDescID := consistencydiscrepancyMixinFields0[0].Descriptor()
+	// consistencydiscrepancy.DefaultID holds the default value on creation for the id field.
+	consistencydiscrepancy.DefaultID = consistencydiscrepancyDescID.Default.(func() uuid.UUID)
 	cooperativeexitMixin := schema.CooperativeExit{}.Mixin()
 	cooperativeexitMixinFields0 := cooperativeexitMixin[0].Fields()
 	_ = cooperativeexitMixinFields0
@@ -102,10 +213,106 @@ func init() {
 	depositaddressDescIsStatic := depositaddressFields[6].Descriptor()
 	// depositaddress.DefaultIsStatic holds the default value on creation for the is_static field.
 	depositaddress.DefaultIsStatic = depositaddressDescIsStatic.Default.(bool)
+	// depositaddressDescIsTreeAddress is the schema descriptor for is_tree_address field.
+	depositaddressDescIsTreeAddress := depositaddressFields[7].Descriptor()
+	// depositaddress.DefaultIsTreeAddress holds the default value on creation for the is_tree_address field.
+	depositaddress.DefaultIsTreeAddress = depositaddressDescIsTreeAddress.Default.(bool)
 	// depositaddressDescID is the schema descriptor for id field.
 	depositaddressDescID := depositaddressMixinFields0[0].Descriptor()
 	// depositaddress.DefaultID holds the default value on creation for the id field.
 	depositaddress.DefaultID = depositaddressDescID.Default.(func() uuid.UUID)
+	dkgsessionMixin := schema.DkgSession{}.Mixin()
+	dkgsessionMixinFields0 := dkgsessionMixin[0].Fields()
+	_ = dkgsessionMixinFields0
+	dkgsessionFields := schema.DkgSession{}.Fields()
+	_ = dkgsessionFields
+	// dkgsessionDescCreateTime is the schema descriptor for create_time field.
+	dkgsessionDescCreateTime := dkgsessionMixinFields0[1].Descriptor()
+	// dkgsession.DefaultCreateTime holds the default value on creation for the create_time field.
+	dkgsession.DefaultCreateTime = dkgsessionDescCreateTime.Default.(func() time.Time)
+	// dkgsessionDescUpdateTime is the schema descriptor for update_time field.
+	dkgsessionDescUpdateTime := dkgsessionMixinFields0[2].Descriptor()
+	// dkgsession.DefaultUpdateTime holds the default value on creation for the update_time field.
+	dkgsession.DefaultUpdateTime = dkgsessionDescUpdateTime.Default.(func() time.Time)
+	// dkgsession.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	dkgsession.UpdateDefaultUpdateTime = dkgsessionDescUpdateTime.UpdateDefault.(func() time.Time)
+	// dkgsessionDescID is the schema descriptor for id field.
+	dkgsessionDescID := dkgsessionMixinFields0[0].Descriptor()
+	// dkgsession.DefaultID holds the default value on creation for the id field.
+	dkgsession.DefaultID = dkgsessionDescID.Default.(func() uuid.UUID)
+	idempotencykeyMixin := schema.IdempotencyKey{}.Mixin()
+	idempotencykeyMixinFields0 := idempotencykeyMixin[0].Fields()
+	_ = idempotencykeyMixinFields0
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescCreateTime is the schema descriptor for create_time field.
+	idempotencykeyDescCreateTime := idempotencykeyMixinFields0[1].Descriptor()
+	// idempotencykey.DefaultCreateTime holds the default value on creation for the create_time field.
+	idempotencykey.DefaultCreateTime = idempotencykeyDescCreateTime.Default.(func() time.Time)
+	// idempotencykeyDescUpdateTime is the schema descriptor for update_time field.
+	idempotencykeyDescUpdateTime := idempotencykeyMixinFields0[2].Descriptor()
+	// idempotencykey.DefaultUpdateTime holds the default value on creation for the update_time field.
+	idempotencykey.DefaultUpdateTime = idempotencykeyDescUpdateTime.Default.(func() time.Time)
+	// idempotencykey.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	idempotencykey.UpdateDefaultUpdateTime = idempotencykeyDescUpdateTime.UpdateDefault.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyMixinFields0[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
+	networkpauseMixin := schema.NetworkPause{}.Mixin()
+	networkpauseMixinFields0 := networkpauseMixin[0].Fields()
+	_ = networkpauseMixinFields0
+	networkpauseFields := schema.NetworkPause{}.Fields()
+	_ = networkpauseFields
+	// networkpauseDescCreateTime is the schema descriptor for create_time field.
+	networkpauseDescCreateTime := networkpauseMixinFields0[1].Descriptor()
+	// networkpause.DefaultCreateTime holds the default value on creation for the create_time field.
+	networkpause.DefaultCreateTime = networkpauseDescCreateTime.Default.(func() time.Time)
+	// networkpauseDescUpdateTime is the schema descriptor for update_time field.
+	networkpauseDescUpdateTime := networkpauseMixinFields0[2].Descriptor()
+	// networkpause.DefaultUpdateTime holds the default value on creation for the update_time field.
+	networkpause.DefaultUpdateTime = networkpauseDescUpdateTime.Default.(func() time.Time)
+	// networkpause.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	networkpause.UpdateDefaultUpdateTime = networkpauseDescUpdateTime.UpdateDefault.(func() time.Time)
+	// networkpauseDescTransfersPaused is the schema descriptor for transfers_paused field.
+	networkpauseDescTransfersPaused := networkpauseFields[1].Descriptor()
+	// networkpause.DefaultTransfersPaused holds the default value on creation for the transfers_paused field.
+	networkpause.DefaultTransfersPaused = networkpauseDescTransfersPaused.Default.(bool)
+	// networkpauseDescDepositsPaused is the schema descriptor for deposits_paused field.
+	networkpauseDescDepositsPaused := networkpauseFields[2].Descriptor()
+	// networkpause.DefaultDepositsPaused holds the default value on creation for the deposits_paused field.
+	networkpause.DefaultDepositsPaused = networkpauseDescDepositsPaused.Default.(bool)
+	// networkpauseDescID is the schema descriptor for id field.
+	networkpauseDescID := networkpauseMixinFields0[0].Descriptor()
+	// networkpause.DefaultID holds the default value on creation for the id field.
+	networkpause.DefaultID = networkpauseDescID.Default.(func() uuid.UUID)
+	operatorcallnonceMixin := schema.OperatorCallNonce{}.Mixin()
+	operatorcallnonceMixinFields0 := operatorcallnonceMixin[0].Fields()
+	_ = operatorcallnonceMixinFields0
+	operatorcallnonceFields := schema.OperatorCallNonce{}.Fields()
+	_ = operatorcallnonceFields
+	// operatorcallnonceDescCreateTime is the schema descriptor for create_time field.
+	operatorcallnonceDescCreateTime := operatorcallnonceMixinFields0[1].Descriptor()
+	// operatorcallnonce.DefaultCreateTime holds the default value on creation for the create_time field.
+	operatorcallnonce.DefaultCreateTime = operatorcallnonceDescCreateTime.Default.(func() time.Time)
+	// operatorcallnonceDescUpdateTime is the schema descriptor for update_time field.
+	operatorcallnonceDescUpdateTime := operatorcallnonceMixinFields0[2].Descriptor()
+	// operatorcallnonce.DefaultUpdateTime holds the default value on creation for the update_time field.
+	operatorcallnonce.DefaultUpdateTime = operatorcallnonceDescUpdateTime.Default.(func() time.Time)
+	// operatorcallnonce.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	operatorcallnonce.UpdateDefaultUpdateTime = operatorcallnonceDescUpdateTime.UpdateDefault.(func() time.Time)
+	// operatorcallnonceDescOperatorIdentityPublicKey is the schema descriptor for operator_identity_public_key field.
+	operatorcallnonceDescOperatorIdentityPublicKey := operatorcallnonceFields[0].Descriptor()
+	// operatorcallnonce.OperatorIdentityPublicKeyValidator is a validator for the "operator_identity_public_key" field. It is called by the builders before save.
+	operatorcallnonce.OperatorIdentityPublicKeyValidator = operatorcallnonceDescOperatorIdentityPublicKey.Validators[0].(func([]byte) error)
+	// operatorcallnonceDescNonce is the schema descriptor for nonce field.
+	operatorcallnonceDescNonce := operatorcallnonceFields[1].Descriptor()
+	// operatorcallnonce.NonceValidator is a validator for the "nonce" field. It is called by the builders before save.
+	operatorcallnonce.NonceValidator = operatorcallnonceDescNonce.Validators[0].(func([]byte) error)
+	// operatorcallnonceDescID is the schema descriptor for id field.
+	operatorcallnonceDescID := operatorcallnonceMixinFields0[0].Descriptor()
+	// operatorcallnonce.DefaultID holds the default value on creation for the id field.
+	operatorcallnonce.DefaultID = operatorcallnonceDescID.Default.(func() uuid.UUID)
 	preimagerequestMixin := schema.PreimageRequest{}.Mixin()
 	preimagerequestMixinFields0 := preimagerequestMixin[0].Fields()
 	_ = preimagerequestMixinFields0
@@ -164,6 +371,109 @@ func init() {
 	preimageshareDescID := preimageshareMixinFields0[0].Descriptor()
 	// preimageshare.DefaultID holds the default value on creation for the id field.
 	preimageshare.DefaultID = preimageshareDescID.Default.(func() uuid.UUID)
+	recentwriteMixin := schema.RecentWrite{}.Mixin()
+	recentwriteMixinFields0 := recentwriteMixin[0].Fields()
+	_ = recentwriteMixinFields0
+	recentwriteFields := schema.RecentWrite{}.Fields()
+	_ = recentwriteFields
+	// recentwriteDescCreateTime is the schema descriptor for create_time field.
+	recentwriteDescCreateTime := recentwriteMixinFields0[1].Descriptor()
+	// recentwrite.DefaultCreateTime holds the default value on creation for the create_time field.
+	recentwrite.DefaultCreateTime = recentwriteDescCreateTime.Default.(func() time.Time)
+	// recentwriteDescUpdateTime is the schema descriptor for update_time field.
+	recentwriteDescUpdateTime := recentwriteMixinFields0[2].Descriptor()
+	// recentwrite.DefaultUpdateTime holds the default value on creation for the update_time field.
+	recentwrite.DefaultUpdateTime = recentwriteDescUpdateTime.Default.(func() time.Time)
+	// recentwrite.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	recentwrite.UpdateDefaultUpdateTime = recentwriteDescUpdateTime.UpdateDefault.(func() time.Time)
+	// recentwriteDescIdentityPublicKey is the schema descriptor for identity_public_key field.
+	recentwriteDescIdentityPublicKey := recentwriteFields[0].Descriptor()
+	// recentwrite.IdentityPublicKeyValidator is a validator for the "identity_public_key" field. It is called by the builders before save.
+	recentwrite.IdentityPublicKeyValidator = recentwriteDescIdentityPublicKey.Validators[0].(func([]byte) error)
+	// recentwriteDescID is the schema descriptor for id field.
+	recentwriteDescID := recentwriteMixinFields0[0].Descriptor()
+	// recentwrite.DefaultID holds the default value on creation for the id field.
+	recentwrite.DefaultID = recentwriteDescID.Default.(func() uuid.UUID)
+	reshareMixin := schema.Reshare{}.Mixin()
+	reshareMixinFields0 := reshareMixin[0].Fields()
+	_ = reshareMixinFields0
+	reshareFields := schema.Reshare{}.Fields()
+	_ = reshareFields
+	// reshareDescCreateTime is the schema descriptor for create_time field.
+	reshareDescCreateTime := reshareMixinFields0[1].Descriptor()
+	// reshare.DefaultCreateTime holds the default value on creation for the create_time field.
+	reshare.DefaultCreateTime = reshareDescCreateTime.Default.(func() time.Time)
+	// reshareDescUpdateTime is the schema descriptor for update_time field.
+	reshareDescUpdateTime := reshareMixinFields0[2].Descriptor()
+	// reshare.DefaultUpdateTime holds the default value on creation for the update_time field.
+	reshare.DefaultUpdateTime = reshareDescUpdateTime.Default.(func() time.Time)
+	// reshare.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	reshare.UpdateDefaultUpdateTime = reshareDescUpdateTime.UpdateDefault.(func() time.Time)
+	// reshareDescID is the schema descriptor for id field.
+	reshareDescID := reshareMixinFields0[0].Descriptor()
+	// reshare.DefaultID holds the default value on creation for the id field.
+	reshare.DefaultID = reshareDescID.Default.(func() uuid.UUID)
+	sessionrevocationMixin := schema.SessionRevocation{}.Mixin()
+	sessionrevocationMixinFields0 := sessionrevocationMixin[0].Fields()
+	_ = sessionrevocationMixinFields0
+	sessionrevocationFields := schema.SessionRevocation{}.Fields()
+	_ = sessionrevocationFields
+	// sessionrevocationDescCreateTime is the schema descriptor for create_time field.
+	sessionrevocationDescCreateTime := sessionrevocationMixinFields0[1].Descriptor()
+	// sessionrevocation.DefaultCreateTime holds the default value on creation for the create_time field.
+	sessionrevocation.DefaultCreateTime = sessionrevocationDescCreateTime.Default.(func() time.Time)
+	// sessionrevocationDescUpdateTime is the schema descriptor for update_time field.
+	sessionrevocationDescUpdateTime := sessionrevocationMixinFields0[2].Descriptor()
+	// sessionrevocation.DefaultUpdateTime holds the default value on creation for the update_time field.
+	sessionrevocation.DefaultUpdateTime = sessionrevocationDescUpdateTime.Default.(func() time.Time)
+	// sessionrevocation.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	sessionrevocation.UpdateDefaultUpdateTime = sessionrevocationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// sessionrevocationDescIdentityPublicKey is the schema descriptor for identity_public_key field.
+	sessionrevocationDescIdentityPublicKey := sessionrevocationFields[0].Descriptor()
+	// sessionrevocation.IdentityPublicKeyValidator is a validator for the "identity_public_key" field. It is called by the builders before save.
+	sessionrevocation.IdentityPublicKeyValidator = sessionrevocationDescIdentityPublicKey.Validators[0].(func([]byte) error)
+	// sessionrevocationDescID is the schema descriptor for id field.
+	sessionrevocationDescID := sessionrevocationMixinFields0[0].Descriptor()
+	// sessionrevocation.DefaultID holds the default value on creation for the id field.
+	sessionrevocation.DefaultID = sessionrevocationDescID.Default.(func() uuid.UUID)
+	sharerefreshMixin := schema.ShareRefresh{}.Mixin()
+	sharerefreshMixinFields0 := sharerefreshMixin[0].Fields()
+	_ = sharerefreshMixinFields0
+	sharerefreshFields := schema.ShareRefresh{}.Fields()
+	_ = sharerefreshFields
+	// sharerefreshDescCreateTime is the schema descriptor for create_time field.
+	sharerefreshDescCreateTime := sharerefreshMixinFields0[1].Descriptor()
+	// sharerefresh.DefaultCreateTime holds the default value on creation for the create_time field.
+	sharerefresh.DefaultCreateTime = sharerefreshDescCreateTime.Default.(func() time.Time)
+	// sharerefreshDescUpdateTime is the schema descriptor for update_time field.
+	sharerefreshDescUpdateTime := sharerefreshMixinFields0[2].Descriptor()
+	// sharerefresh.DefaultUpdateTime holds the default value on creation for the update_time field.
+	sharerefresh.DefaultUpdateTime = sharerefreshDescUpdateTime.Default.(func() time.Time)
+	// sharerefresh.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	sharerefresh.UpdateDefaultUpdateTime = sharerefreshDescUpdateTime.UpdateDefault.(func() time.Time)
+	// sharerefreshDescID is the schema descriptor for id field.
+	sharerefreshDescID := sharerefreshMixinFields0[0].Descriptor()
+	// sharerefresh.DefaultID holds the default value on creation for the id field.
+	sharerefresh.DefaultID = sharerefreshDescID.Default.(func() uuid.UUID)
+	signingincidentMixin := schema.SigningIncident{}.Mixin()
+	signingincidentMixinFields0 := signingincidentMixin[0].Fields()
+	_ = signingincidentMixinFields0
+	signingincidentFields := schema.SigningIncident{}.Fields()
+	_ = signingincidentFields
+	// signingincidentDescCreateTime is the schema descriptor for create_time field.
+	signingincidentDescCreateTime := signingincidentMixinFields0[1].Descriptor()
+	// signingincident.DefaultCreateTime holds the default value on creation for the create_time field.
+	signingincident.DefaultCreateTime = signingincidentDescCreateTime.Default.(func() time.Time)
+	// signingincidentDescUpdateTime is the schema descriptor for update_time field.
+	signingincidentDescUpdateTime := signingincidentMixinFields0[2].Descriptor()
+	// signingincident.DefaultUpdateTime holds the default value on creation for the update_time field.
+	signingincident.DefaultUpdateTime = signingincidentDescUpdateTime.Default.(func() time.Time)
+	// signingincident.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	signingincident.UpdateDefaultUpdateTime = signingincidentDescUpdateTime.UpdateDefault.(func() time.Time)
+	// signingincidentDescID is the schema descriptor for id field.
+	signingincidentDescID := signingincidentMixinFields0[0].Descriptor()
+	// signingincident.DefaultID holds the default value on creation for the id field.
+	signingincident.DefaultID = signingincidentDescID.Default.(func() uuid.UUID)
 	signingkeyshareMixin := schema.SigningKeyshare{}.Mixin()
 	signingkeyshareMixinFields0 := signingkeyshareMixin[0].Fields()
 	_ = signingkeyshareMixinFields0
@@ -179,6 +489,10 @@ func init() {
 	signingkeyshare.DefaultUpdateTime = signingkeyshareDescUpdateTime.Default.(func() time.Time)
 	// signingkeyshare.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	signingkeyshare.UpdateDefaultUpdateTime = signingkeyshareDescUpdateTime.UpdateDefault.(func() time.Time)
+	// signingkeyshareDescOperatorSetEpoch is the schema descriptor for operator_set_epoch field.
+	signingkeyshareDescOperatorSetEpoch := signingkeyshareFields[12].Descriptor()
+	// signingkeyshare.DefaultOperatorSetEpoch holds the default value on creation for the operator_set_epoch field.
+	signingkeyshare.DefaultOperatorSetEpoch = signingkeyshareDescOperatorSetEpoch.Default.(uint64)
 	// signingkeyshareDescID is the schema descriptor for id field.
 	signingkeyshareDescID := signingkeyshareMixinFields0[0].Descriptor()
 	// signingkeyshare.DefaultID holds the default value on creation for the id field.
@@ -202,6 +516,56 @@ func init() {
 	signingnonceDescID := signingnonceMixinFields0[0].Descriptor()
 	// signingnonce.DefaultID holds the default value on creation for the id field.
 	signingnonce.DefaultID = signingnonceDescID.Default.(func() uuid.UUID)
+	tasklockMixin := schema.TaskLock{}.Mixin()
+	tasklockMixinFields0 := tasklockMixin[0].Fields()
+	_ = tasklockMixinFields0
+	tasklockFields := schema.TaskLock{}.Fields()
+	_ = tasklockFields
+	// tasklockDescCreateTime is the schema descriptor for create_time field.
+	tasklockDescCreateTime := tasklockMixinFields0[1].Descriptor()
+	// tasklock.DefaultCreateTime holds the default value on creation for the create_time field.
+	tasklock.DefaultCreateTime = tasklockDescCreateTime.Default.(func() time.Time)
+	// tasklockDescUpdateTime is the schema descriptor for update_time field.
+	tasklockDescUpdateTime := tasklockMixinFields0[2].Descriptor()
+	// tasklock.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tasklock.DefaultUpdateTime = tasklockDescUpdateTime.Default.(func() time.Time)
+	// tasklock.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tasklock.UpdateDefaultUpdateTime = tasklockDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tasklockDescName is the schema descriptor for name field.
+	tasklockDescName := tasklockFields[0].Descriptor()
+	// tasklock.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tasklock.NameValidator = tasklockDescName.Validators[0].(func(string) error)
+	// tasklockDescID is the schema descriptor for id field.
+	tasklockDescID := tasklockMixinFields0[0].Descriptor()
+	// tasklock.DefaultID holds the default value on creation for the id field.
+	tasklock.DefaultID = tasklockDescID.Default.(func() uuid.UUID)
+	taskrunMixin := schema.TaskRun{}.Mixin()
+	taskrunMixinFields0 := taskrunMixin[0].Fields()
+	_ = taskrunMixinFields0
+	taskrunFields := schema.TaskRun{}.Fields()
+	_ = taskrunFields
+	// taskrunDescCreateTime is the schema descriptor for create_time field.
+	taskrunDescCreateTime := taskrunMixinFields0[1].Descriptor()
+	// taskrun.DefaultCreateTime holds the default value on creation for the create_time field.
+	taskrun.DefaultCreateTime = taskrunDescCreateTime.Default.(func() time.Time)
+	// taskrunDescUpdateTime is the schema descriptor for update_time field.
+	taskrunDescUpdateTime := taskrunMixinFields0[2].Descriptor()
+	// taskrun.DefaultUpdateTime holds the default value on creation for the update_time field.
+	taskrun.DefaultUpdateTime = taskrunDescUpdateTime.Default.(func() time.Time)
+	// taskrun.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	taskrun.UpdateDefaultUpdateTime = taskrunDescUpdateTime.UpdateDefault.(func() time.Time)
+	// taskrunDescTaskName is the schema descriptor for task_name field.
+	taskrunDescTaskName := taskrunFields[0].Descriptor()
+	// taskrun.TaskNameValidator is a validator for the "task_name" field. It is called by the builders before save.
+	taskrun.TaskNameValidator = taskrunDescTaskName.Validators[0].(func(string) error)
+	// taskrunDescItemsProcessed is the schema descriptor for items_processed field.
+	taskrunDescItemsProcessed := taskrunFields[6].Descriptor()
+	// taskrun.DefaultItemsProcessed holds the default value on creation for the items_processed field.
+	taskrun.DefaultItemsProcessed = taskrunDescItemsProcessed.Default.(int64)
+	// taskrunDescID is the schema descriptor for id field.
+	taskrunDescID := taskrunMixinFields0[0].Descriptor()
+	// taskrun.DefaultID holds the default value on creation for the id field.
+	taskrun.DefaultID = taskrunDescID.Default.(func() uuid.UUID)
 	tokenfreezeMixin := schema.TokenFreeze{}.Mixin()
 	tokenfreezeMixinFields0 := tokenfreezeMixin[0].Fields()
 	_ = tokenfreezeMixinFields0
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Archive is the schema for the archives table. Each row is a batch of records moved out of their
+// tables by a retention task, so that archived state is replicated and backed up with the rest of
+// the database.
+type Archive struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the archives table.
+func (Archive) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields are the fields for the archives table.
+func (Archive) Fields() []ent.Field {
+	return []ent.Field{
+		// The kind of the records, e.g. transfers.
+		field.String("kind").NotEmpty().Immutable(),
+		field.Int("record_count").Immutable(),
+		// The records, gzip compressed JSON, one per line.
+		field.Bytes("data").NotEmpty().Immutable(),
+	}
+}
+
+// Edges are the edges for the archives table.
+func (Archive) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes are the indexes for the archives table.
+func (Archive) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind"),
+	}
+}
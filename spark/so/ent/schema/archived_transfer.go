@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArchivedTransfer is the schema for the archived transfers table. Each row is the tombstone of a
+// finished transfer moved to an archive by the retention task, with the ID of the transfer and
+// what is needed to find it again.
+type ArchivedTransfer struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the archived transfers table.
+func (ArchivedTransfer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields are the fields for the archived transfers table.
+func (ArchivedTransfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("sender_identity_pubkey").NotEmpty().Immutable(),
+		field.Bytes("receiver_identity_pubkey").NotEmpty().Immutable(),
+		field.Uint64("total_value").Immutable(),
+		field.Enum("status").GoType(TransferStatus("")).Immutable(),
+		field.Enum("type").GoType(TransferType("")).Immutable(),
+		// The update time of the transfer when it was archived.
+		field.Time("transfer_update_time").Immutable(),
+	}
+}
+
+// Edges are the edges for the archived transfers table.
+func (ArchivedTransfer) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The archive holding the transfer.
+		edge.To("archive", Archive.Type).
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes are the indexes for the archived transfers table.
+func (ArchivedTransfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sender_identity_pubkey"),
+		index.Fields("receiver_identity_pubkey"),
+		index.Fields("transfer_update_time"),
+	}
+}
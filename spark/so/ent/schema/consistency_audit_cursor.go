@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConsistencyAuditCursor is the schema for the consistency audit cursors table. Each row is the last
+// key of a kind the consistency audit compared, so that its next round picks up where the previous
+// one stopped, on any replica and across restarts.
+type ConsistencyAuditCursor struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the consistency audit cursors table.
+func (ConsistencyAuditCursor) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the consistency audit cursors table.
+func (ConsistencyAuditCursor) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind").Unique(),
+	}
+}
+
+// Fields are the fields for the consistency audit cursors table.
+func (ConsistencyAuditCursor) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			GoType(ConsistencyKind("")).
+			Immutable(),
+		// The last key compared, or empty to start from the first key.
+		field.String("key").
+			Default(""),
+	}
+}
+
+// Edges are the edges for the consistency audit cursors table.
+func (ConsistencyAuditCursor) Edges() []ent.Edge {
+	return nil
+}
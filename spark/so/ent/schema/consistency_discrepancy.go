@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConsistencyKind is the kind of state compared by the consistency audit.
+type ConsistencyKind string
+
+const (
+	// ConsistencyKindTree is the kind of the nodes of a tree, keyed by tree ID.
+	ConsistencyKindTree ConsistencyKind = "TREE"
+	// ConsistencyKindTransfer is the kind of the transfers sent by an owner, keyed by hex encoded
+	// sender identity public key.
+	ConsistencyKindTransfer ConsistencyKind = "TRANSFER"
+	// ConsistencyKindTokenOutput is the kind of the token outputs of an owner, keyed by hex encoded
+	// owner public key.
+	ConsistencyKindTokenOutput ConsistencyKind = "TOKEN_OUTPUT"
+)
+
+// Values returns the values of the consistency kind.
+func (ConsistencyKind) Values() []string {
+	return []string{
+		string(ConsistencyKindTree),
+		string(ConsistencyKindTransfer),
+		string(ConsistencyKindTokenOutput),
+	}
+}
+
+// ConsistencyDiscrepancyStatus is the status of a consistency discrepancy.
+type ConsistencyDiscrepancyStatus string
+
+const (
+	// ConsistencyDiscrepancyStatusOpen is the status of a discrepancy the last audit of its key found.
+	ConsistencyDiscrepancyStatusOpen ConsistencyDiscrepancyStatus = "OPEN"
+	// ConsistencyDiscrepancyStatusResolved is the status of a discrepancy a later audit of its key
+	// no longer found.
+	ConsistencyDiscrepancyStatusResolved ConsistencyDiscrepancyStatus = "RESOLVED"
+	// ConsistencyDiscrepancyStatusRepaired is the status of a discrepancy fixed by overwriting the
+	// state of this operator with the state of the majority.
+	ConsistencyDiscrepancyStatusRepaired ConsistencyDiscrepancyStatus = "REPAIRED"
+)
+
+// Values returns the values of the consistency discrepancy status.
+func (ConsistencyDiscrepancyStatus) Values() []string {
+	return []string{
+		string(ConsistencyDiscrepancyStatusOpen),
+		string(ConsistencyDiscrepancyStatusResolved),
+		string(ConsistencyDiscrepancyStatusRepaired),
+	}
+}
+
+// ConsistencyDiscrepancy is the schema for the consistency discrepancies table. Each row records a
+// key for which the operators did not all hold the same state when this operator audited it.
+type ConsistencyDiscrepancy struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the consistency discrepancies table.
+func (ConsistencyDiscrepancy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the consistency discrepancies table.
+func (ConsistencyDiscrepancy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "key", "status"),
+		index.Fields("status", "update_time"),
+	}
+}
+
+// Fields are the fields for the consistency discrepancies table.
+func (ConsistencyDiscrepancy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			GoType(ConsistencyKind("")).
+			Immutable(),
+		field.String("key").
+			Immutable(),
+		field.Enum("status").
+			GoType(ConsistencyDiscrepancyStatus("")),
+		// The hex encoded digest of every operator, by operator identifier, as of the last audit.
+		// Empty for an operator that holds no state for the key.
+		field.JSON("digests", map[string]string{}),
+		// The operators that disagreed with the majority in the last audit.
+		field.JSON("divergent_operators", []string{}),
+	}
+}
+
+// Edges are the edges for the consistency discrepancies table.
+func (ConsistencyDiscrepancy) Edges() []ent.Edge {
+	return nil
+}
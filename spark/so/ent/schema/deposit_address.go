@@ -26,6 +26,7 @@ func (DepositAddress) Indexes() []ent.Index {
 		index.Fields("address"),
 		index.Fields("owner_identity_pubkey"),
 		index.Fields("owner_signing_pubkey"),
+		index.Fields("expiry_time"),
 	}
 }
 
@@ -39,6 +40,11 @@ func (DepositAddress) Fields() []ent.Field {
 		field.String("confirmation_txid").Optional(),
 		field.UUID("node_id", uuid.UUID{}).Optional(),
 		field.Bool("is_static").Default(false),
+		// Whether the address was prepared for a node of a tree, rather than generated for a deposit.
+		field.Bool("is_tree_address").Default(false),
+		// When the keyshare of the address is recycled if the address has not received funds by
+		// then, after a grace period. Static and tree addresses never expire.
+		field.Time("expiry_time").Optional(),
 	}
 }
 
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DkgSessionStatus is the status of a participant's DKG session.
+type DkgSessionStatus string
+
+const (
+	// DkgSessionStatusInitial is the status of a session that has not produced its round 1 package yet.
+	DkgSessionStatusInitial DkgSessionStatus = "INITIAL"
+	// DkgSessionStatusRound1 is the status of a session waiting for the round 1 packages of all participants.
+	DkgSessionStatusRound1 DkgSessionStatus = "ROUND1"
+	// DkgSessionStatusRound1Signature is the status of a session waiting for the round 1 signatures of all participants.
+	DkgSessionStatusRound1Signature DkgSessionStatus = "ROUND1_SIGNATURE"
+	// DkgSessionStatusCompleted is the status of a session whose keyshares are stored.
+	DkgSessionStatusCompleted DkgSessionStatus = "COMPLETED"
+	// DkgSessionStatusAborted is the status of a session that failed or timed out.
+	DkgSessionStatusAborted DkgSessionStatus = "ABORTED"
+)
+
+// Values returns the values of the DKG session status.
+func (DkgSessionStatus) Values() []string {
+	return []string{
+		string(DkgSessionStatusInitial),
+		string(DkgSessionStatusRound1),
+		string(DkgSessionStatusRound1Signature),
+		string(DkgSessionStatusCompleted),
+		string(DkgSessionStatusAborted),
+	}
+}
+
+// DkgSession is the schema for the DKG sessions table. Each row is this operator's state of one
+// DKG batch, keyed by the request id of the batch, so that a restarted operator can resume or
+// abort it.
+type DkgSession struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the DKG sessions table.
+func (DkgSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the DKG sessions table.
+func (DkgSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "deadline"),
+	}
+}
+
+// Fields are the fields for the DKG sessions table.
+func (DkgSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("status").
+			GoType(DkgSessionStatus("")),
+		field.Uint64("min_signers").
+			Immutable(),
+		field.Uint64("max_signers").
+			Immutable(),
+		field.Uint64("coordinator_index").
+			Immutable(),
+		field.JSON("participants", []string{}).
+			Immutable(),
+		// The round 1 packages of this operator and, once received, of all participants. Round 2
+		// packages carry secret shares and are never stored.
+		field.JSON("round1_package", [][]byte{}).
+			Optional(),
+		field.JSON("received_round1_packages", []map[string][]byte{}).
+			Optional(),
+		// Deadline is when the session is aborted if it has not moved on to the next round.
+		field.Time("deadline"),
+		// Complaints are the participants this operator blames for the session failing, with the
+		// reason, keyed by identifier.
+		field.JSON("complaints", map[string]string{}).
+			Optional(),
+		field.String("abort_reason").
+			Optional(),
+	}
+}
+
+// Edges are the edges for the DKG sessions table.
+func (DkgSession) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey is the schema for the idempotency keys table. Each row records a request sent
+// with an idempotency key and the response it got, so that retries of the request with the same
+// key get the same response instead of running the request again.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the idempotency keys table.
+func (IdempotencyKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the idempotency keys table.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("method", "scope", "key").Unique(),
+		index.Fields("create_time"),
+	}
+}
+
+// Fields are the fields for the idempotency keys table.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		// The full gRPC method name of the request.
+		field.String("method").
+			Immutable(),
+		// The hex encoded identity public key of the session that sent the request, or empty for
+		// requests without a session, so that callers cannot see each other's responses.
+		field.String("scope").
+			Immutable(),
+		field.String("key").
+			Immutable(),
+		// SHA-256 of the deterministically serialized request.
+		field.Bytes("request_hash").
+			Immutable(),
+		// The full name of the response message type and the serialized response, set once the
+		// request has succeeded.
+		field.String("response_type").
+			Optional(),
+		field.Bytes("response").
+			Optional(),
+	}
+}
+
+// Edges are the edges for the idempotency keys table.
+func (IdempotencyKey) Edges() []ent.Edge {
+	return nil
+}
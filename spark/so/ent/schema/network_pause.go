@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NetworkPause is the schema for the network pauses table. A row records which new operations an
+// operator has stopped accepting on a network, e.g. while an incident is investigated.
+type NetworkPause struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the network pauses table.
+func (NetworkPause) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields are the fields for the network pauses table.
+func (NetworkPause) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("network").
+			GoType(Network("")).
+			Immutable(),
+		field.Bool("transfers_paused").
+			Default(false),
+		field.Bool("deposits_paused").
+			Default(false),
+		field.String("reason").
+			Optional(),
+	}
+}
+
+// Edges are the edges for the network pauses table.
+func (NetworkPause) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes are the indexes for the network pauses table.
+func (NetworkPause) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("network").Unique(),
+	}
+}
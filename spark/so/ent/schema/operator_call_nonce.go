@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OperatorCallNonce is the schema for the operator call nonces table. Each row is the nonce of an
+// internal call another operator signed, kept until the call is stale so that it cannot be
+// replayed to any replica of this operator.
+type OperatorCallNonce struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the operator call nonces table.
+func (OperatorCallNonce) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the operator call nonces table.
+func (OperatorCallNonce) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("operator_identity_public_key", "nonce").Unique(),
+		index.Fields("expiration_time"),
+	}
+}
+
+// Fields are the fields for the operator call nonces table.
+func (OperatorCallNonce) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("operator_identity_public_key").
+			NotEmpty().
+			Immutable(),
+		field.Bytes("nonce").
+			NotEmpty().
+			Immutable(),
+		// When the call is stale, and the row can be deleted.
+		field.Time("expiration_time").
+			Immutable(),
+	}
+}
+
+// Edges are the edges for the operator call nonces table.
+func (OperatorCallNonce) Edges() []ent.Edge {
+	return nil
+}
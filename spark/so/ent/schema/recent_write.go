@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RecentWrite is the schema for the recent writes table. Each row is a write an identity made, kept
+// until the read replica has caught up with it, so that every replica of this operator sends the
+// reads of the identity to the primary until then.
+type RecentWrite struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the recent writes table.
+func (RecentWrite) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the recent writes table.
+func (RecentWrite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("identity_public_key", "expiration_time"),
+		index.Fields("expiration_time"),
+	}
+}
+
+// Fields are the fields for the recent writes table.
+func (RecentWrite) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("identity_public_key").
+			NotEmpty().
+			Immutable(),
+		// When the read replica has caught up with the write, and the row can be deleted.
+		field.Time("expiration_time").
+			Immutable(),
+	}
+}
+
+// Edges are the edges for the recent writes table.
+func (RecentWrite) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ResharedKeyshare is a participant's keyshare as it was before a reshare, so that the reshare can
+// be rolled back.
+type ResharedKeyshare struct {
+	// Existed is whether the participant held the keyshare. Keyshares created by the reshare are
+	// deleted when it is rolled back.
+	Existed bool `json:"existed"`
+	// SecretShare is the secret share. It is sealed at rest with the envelope the signing keyshares
+	// are encrypted with.
+	SecretShare []byte `json:"secret_share,omitempty"`
+	// PublicShares are the public shares of the operators of the previous operator set.
+	PublicShares map[string][]byte `json:"public_shares,omitempty"`
+	// MinSigners is the threshold of the previous operator set.
+	MinSigners int32 `json:"min_signers,omitempty"`
+	// OperatorSetEpoch is the epoch of the previous operator set.
+	OperatorSetEpoch uint64 `json:"operator_set_epoch,omitempty"`
+}
+
+// Reshare is the schema for the reshares table. Each row is this operator's state of one reshare,
+// keyed by its request id, so that the coordinator can resume committing it, or roll it back,
+// after any operator restarted. Its status is one of the share refresh statuses.
+type Reshare struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the reshares table.
+func (Reshare) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the reshares table.
+func (Reshare) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("coordinator_index", "status"),
+	}
+}
+
+// Fields are the fields for the reshares table.
+func (Reshare) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("status").
+			GoType(ShareRefreshStatus("")),
+		field.Uint64("coordinator_index").
+			Immutable(),
+		// The serialized keyshares to reshare, as the coordinator sent them.
+		field.JSON("keyshares", [][]byte{}).
+			Immutable(),
+		field.JSON("dealers", []string{}).
+			Immutable(),
+		// The serialized contributions of the dealers, each sealed at rest with the envelope the
+		// signing keyshares are encrypted with. They are cleared once the reshare is completed.
+		field.JSON("contributions", [][]byte{}).
+			Optional(),
+		// The keyshares of this operator before the reshare, in the order of the keyshares, set
+		// when it is committed and cleared once it is completed.
+		field.JSON("previous_keyshares", []ResharedKeyshare{}).
+			Optional(),
+	}
+}
+
+// Edges are the edges for the reshares table.
+func (Reshare) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SessionRevocation is the schema for the session revocations table. Each row revokes one session
+// of an identity, or every session and delegation grant of the identity issued before the row was
+// created.
+type SessionRevocation struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the session revocations table.
+func (SessionRevocation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the session revocations table.
+func (SessionRevocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("identity_public_key", "session_id"),
+		index.Fields("expiration_time"),
+		index.Fields("create_time"),
+	}
+}
+
+// Fields are the fields for the session revocations table.
+func (SessionRevocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("identity_public_key").
+			NotEmpty().
+			Immutable(),
+		// The revoked session, or empty to revoke every session of the identity issued so far.
+		field.Bytes("session_id").
+			Optional().
+			Immutable(),
+		// When every session the row revokes has expired anyway, and the row can be deleted.
+		field.Time("expiration_time").
+			Immutable(),
+	}
+}
+
+// Edges are the edges for the session revocations table.
+func (SessionRevocation) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ShareRefreshStatus is the status of a participant's share refresh or reshare.
+type ShareRefreshStatus string
+
+const (
+	// ShareRefreshStatusPrepared is the status of a share refresh whose contributions were
+	// validated, but which has not been applied to the keyshares yet.
+	ShareRefreshStatusPrepared ShareRefreshStatus = "PREPARED"
+	// ShareRefreshStatusCommitted is the status of a share refresh applied to the keyshares, which
+	// can still be rolled back until every participant committed it.
+	ShareRefreshStatusCommitted ShareRefreshStatus = "COMMITTED"
+	// ShareRefreshStatusCompleted is the status of a share refresh every participant committed.
+	ShareRefreshStatusCompleted ShareRefreshStatus = "COMPLETED"
+	// ShareRefreshStatusAborted is the status of a share refresh that was not applied, or was
+	// rolled back.
+	ShareRefreshStatusAborted ShareRefreshStatus = "ABORTED"
+)
+
+// Values returns the values of the share refresh status.
+func (ShareRefreshStatus) Values() []string {
+	return []string{
+		string(ShareRefreshStatusPrepared),
+		string(ShareRefreshStatusCommitted),
+		string(ShareRefreshStatusCompleted),
+		string(ShareRefreshStatusAborted),
+	}
+}
+
+// ShareRefresh is the schema for the share refreshes table. Each row is this operator's state of
+// one share refresh, keyed by its request id, so that the coordinator can resume committing it, or
+// roll it back, after any operator restarted.
+type ShareRefresh struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the share refreshes table.
+func (ShareRefresh) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the share refreshes table.
+func (ShareRefresh) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("coordinator_index", "status"),
+	}
+}
+
+// Fields are the fields for the share refreshes table.
+func (ShareRefresh) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("status").
+			GoType(ShareRefreshStatus("")),
+		field.Uint64("coordinator_index").
+			Immutable(),
+		field.JSON("keyshare_ids", []uuid.UUID{}).
+			Immutable(),
+		// The serialized contributions of all participants, so that this operator can recompute what
+		// its keyshares change by to commit the refresh or roll it back. Each is sealed at rest with
+		// the envelope the signing keyshares are encrypted with. They are cleared once the refresh is
+		// completed.
+		field.JSON("contributions", [][]byte{}).
+			Optional(),
+	}
+}
+
+// Edges are the edges for the share refreshes table.
+func (ShareRefresh) Edges() []ent.Edge {
+	return nil
+}
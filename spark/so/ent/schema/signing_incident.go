@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SigningIncident is the schema for the signing incidents table. Each row records an invalid
+// signature share an operator returned while this operator coordinated signing, with everything
+// needed to verify the share again.
+type SigningIncident struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the signing incidents table.
+func (SigningIncident) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the signing incidents table.
+func (SigningIncident) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("operator_identifier"),
+		index.Fields("create_time"),
+	}
+}
+
+// Fields are the fields for the signing incidents table.
+func (SigningIncident) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("operator_identifier").
+			Immutable(),
+		field.UUID("keyshare_id", uuid.UUID{}).
+			Immutable(),
+		field.String("job_id").
+			Immutable(),
+		field.Bytes("message").
+			Immutable(),
+		field.Bytes("verifying_key").
+			Immutable(),
+		field.Bytes("public_share").
+			Immutable(),
+		field.Bytes("signature_share").
+			Immutable(),
+		// The signing commitments of the operators, by operator identifier.
+		field.JSON("commitments", map[string][]byte{}).
+			Immutable(),
+		field.Bytes("user_commitment").
+			Optional().
+			Immutable(),
+		field.String("reason").
+			Immutable(),
+	}
+}
+
+// Edges are the edges for the signing incidents table.
+func (SigningIncident) Edges() []ent.Edge {
+	return nil
+}
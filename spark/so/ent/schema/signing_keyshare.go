@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 )
 
 // SigningKeyshareStatus is the status of a signing keyshare.
@@ -40,6 +41,8 @@ func (SigningKeyshare) Mixin() []ent.Mixin {
 func (SigningKeyshare) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("coordinator_index"),
+		index.Fields("key_version"),
+		index.Fields("operator_set_epoch"),
 	}
 }
 
@@ -53,6 +56,30 @@ func (SigningKeyshare) Fields() []ent.Field {
 		field.Bytes("public_key").Unique(),
 		field.Int32("min_signers"),
 		field.Uint64("coordinator_index"),
+		// The data key secret_share is encrypted with, wrapped by the key manager. Rows written before
+		// envelope encryption was enabled have no data key and a plaintext secret_share.
+		field.Bytes("encrypted_data_key").
+			Optional(),
+		field.String("key_version").
+			Optional(),
+		// The last share refresh applied to secret_share and public_shares, so that it is applied
+		// only once, and when.
+		field.UUID("share_refresh_id", uuid.UUID{}).
+			Optional(),
+		field.Time("share_refresh_time").
+			Optional(),
+		// The share refresh prepared or committed for the keyshare that not every operator has
+		// committed yet. The keyshare is not refreshed again until it is completed or aborted.
+		field.UUID("pending_share_refresh_id", uuid.UUID{}).
+			Optional(),
+		// The reshare prepared or committed for the keyshare that not every operator has committed
+		// yet. The keyshare is neither reshared nor refreshed until it is completed or aborted.
+		field.UUID("pending_reshare_id", uuid.UUID{}).
+			Optional(),
+		// The epoch of the operator set that holds the keyshare. Resharing moves keyshares from the
+		// previous operator set to the current one.
+		field.Uint64("operator_set_epoch").
+			Default(0),
 	}
 }
 
@@ -22,6 +22,9 @@ func (SigningNonce) Mixin() []ent.Mixin {
 func (SigningNonce) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("nonce_commitment"),
+		index.Fields("key_version"),
+		index.Fields("used_time"),
+		index.Fields("create_time"),
 	}
 }
 
@@ -32,8 +35,26 @@ func (SigningNonce) Fields() []ent.Field {
 			Immutable(),
 		field.Bytes("nonce_commitment").
 			Immutable(),
+		// The message the nonce signed, set the first time the nonce is used. A nonce can only ever
+		// be used again to produce the same signature share.
 		field.Bytes("message").
 			Optional(),
+		// The hash of everything else the signature share depends on, set with the message.
+		field.Bytes("binding_hash").
+			Optional(),
+		field.Time("used_time").
+			Optional().
+			Nillable(),
+		// The data key nonce is encrypted with, wrapped by the key manager. Rows written before
+		// envelope encryption was enabled have no data key and a plaintext nonce.
+		field.Bytes("encrypted_data_key").
+			Optional(),
+		field.String("key_version").
+			Optional(),
+		// The operator that requested the nonce, the only one that can use it to sign.
+		field.Bytes("coordinator_public_key").
+			Optional().
+			Immutable(),
 	}
 }
 
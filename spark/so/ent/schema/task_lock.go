@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskLock is the schema for the task locks table. A row is a lease on a background task that
+// ensures only one replica of an operator runs the task at a time.
+type TaskLock struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the task locks table.
+func (TaskLock) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields are the fields for the task locks table.
+func (TaskLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("holder"),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges are the edges for the task locks table.
+func (TaskLock) Edges() []ent.Edge {
+	return nil
+}
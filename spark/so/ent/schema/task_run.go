@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskRunStatus is the status of a single run of a background task.
+type TaskRunStatus string
+
+const (
+	// TaskRunStatusRunning is the status of a task run that has started but not yet finished.
+	TaskRunStatusRunning TaskRunStatus = "RUNNING"
+	// TaskRunStatusSucceeded is the status of a task run that finished without an error.
+	TaskRunStatusSucceeded TaskRunStatus = "SUCCEEDED"
+	// TaskRunStatusFailed is the status of a task run that finished with an error.
+	TaskRunStatusFailed TaskRunStatus = "FAILED"
+)
+
+// Values returns the values of the task run status.
+func (TaskRunStatus) Values() []string {
+	return []string{
+		string(TaskRunStatusRunning),
+		string(TaskRunStatusSucceeded),
+		string(TaskRunStatusFailed),
+	}
+}
+
+// TaskRunTrigger is what caused a task run to start.
+type TaskRunTrigger string
+
+const (
+	// TaskRunTriggerScheduled is a run started by the scheduler.
+	TaskRunTriggerScheduled TaskRunTrigger = "SCHEDULED"
+	// TaskRunTriggerManual is a run started on demand through the admin service.
+	TaskRunTriggerManual TaskRunTrigger = "MANUAL"
+)
+
+// Values returns the values of the task run trigger.
+func (TaskRunTrigger) Values() []string {
+	return []string{
+		string(TaskRunTriggerScheduled),
+		string(TaskRunTriggerManual),
+	}
+}
+
+// TaskRun is the schema for the task runs table. Each row records one execution of a background task.
+type TaskRun struct {
+	ent.Schema
+}
+
+// Mixin is the mixin for the task runs table.
+func (TaskRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Indexes are the indexes for the task runs table.
+func (TaskRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_name", "create_time"),
+	}
+}
+
+// Fields are the fields for the task runs table.
+func (TaskRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("task_name").
+			NotEmpty().
+			Immutable(),
+		field.Enum("status").
+			GoType(TaskRunStatus("")),
+		field.Enum("trigger").
+			GoType(TaskRunTrigger("")).
+			Immutable(),
+		// Holder is the scheduler instance that executed the run.
+		field.String("holder").
+			Immutable(),
+		field.Time("end_time").
+			Optional().
+			Nillable(),
+		field.String("error").
+			Optional(),
+		field.Int64("items_processed").
+			Default(0),
+	}
+}
+
+// Edges are the edges for the task runs table.
+func (TaskRun) Edges() []ent.Edge {
+	return nil
+}
@@ -104,6 +104,12 @@ func (Transfer) Fields() []ent.Field {
 		field.Enum("type").GoType(TransferType("")),
 		field.Time("expiry_time").Immutable(),
 		field.Time("completion_time").Optional().Nillable(),
+		// The operator the sender initiated the transfer with. Only it can settle or cancel the
+		// transfer on the other operators.
+		field.Bytes("coordinator_identity_pubkey").Optional().Immutable(),
+		// The operator the receiver claims the transfer with. Only it can settle the claim and
+		// finalize the transfer on the other operators.
+		field.Bytes("claim_coordinator_identity_pubkey").Optional(),
 	}
 }
 
@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/sessionrevocation"
+)
+
+// SessionRevocation is the model entity for the SessionRevocation schema.
+type SessionRevocation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// IdentityPublicKey holds the value of the "identity_public_key" field.
+	IdentityPublicKey []byte `json:"identity_public_key,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID []byte `json:"session_id,omitempty"`
+	// ExpirationTime holds the value of the "expiration_time" field.
+	ExpirationTime time.Time `json:"expiration_time,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SessionRevocation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sessionrevocation.FieldIdentityPublicKey, sessionrevocation.FieldSessionID:
+			values[i] = new([]byte)
+		case sessionrevocation.FieldCreateTime, sessionrevocation.FieldUpdateTime, sessionrevocation.FieldExpirationTime:
+			values[i] = new(sql.NullTime)
+		case sessionrevocation.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SessionRevocation fields.
+func (sr *SessionRevocation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sessionrevocation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sr.ID = *value
+			}
+		case sessionrevocation.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				sr.CreateTime = value.Time
+			}
+		case sessionrevocation.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				sr.UpdateTime = value.Time
+			}
+		case sessionrevocation.FieldIdentityPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field identity_public_key", values[i])
+			} else if value != nil {
+				sr.IdentityPublicKey = *value
+			}
+		case sessionrevocation.FieldSessionID:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value != nil {
+				sr.SessionID = *value
+			}
+		case sessionrevocation.FieldExpirationTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiration_time", values[i])
+			} else if value.Valid {
+				sr.ExpirationTime = value.Time
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SessionRevocation.
+// This includes values selected through modifiers, order, etc.
+func (sr *SessionRevocation) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SessionRevocation.
+// Note that you need to call SessionRevocation.Unwrap() before calling this method if this SessionRevocation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *SessionRevocation) Update() *SessionRevocationUpdateOne {
+	return NewSessionRevocationClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the SessionRevocation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *SessionRevocation) Unwrap() *SessionRevocation {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SessionRevocation is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *SessionRevocation) String() string {
+	var builder strings.Builder
+	builder.WriteString("SessionRevocation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(sr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(sr.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("identity_public_key=")
+	builder.WriteString(fmt.Sprintf("%v", sr.IdentityPublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.SessionID))
+	builder.WriteString(", ")
+	builder.WriteString("expiration_time=")
+	builder.WriteString(sr.ExpirationTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SessionRevocations is a parsable slice of SessionRevocation.
+type SessionRevocations []*SessionRevocation
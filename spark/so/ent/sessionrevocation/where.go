@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sessionrevocation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// IdentityPublicKey applies equality check predicate on the "identity_public_key" field. It's identical to IdentityPublicKeyEQ.
+func IdentityPublicKey(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldIdentityPublicKey, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldSessionID, v))
+}
+
+// ExpirationTime applies equality check predicate on the "expiration_time" field. It's identical to ExpirationTimeEQ.
+func ExpirationTime(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldExpirationTime, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// IdentityPublicKeyEQ applies the EQ predicate on the "identity_public_key" field.
+func IdentityPublicKeyEQ(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyNEQ applies the NEQ predicate on the "identity_public_key" field.
+func IdentityPublicKeyNEQ(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNEQ(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyIn applies the In predicate on the "identity_public_key" field.
+func IdentityPublicKeyIn(vs ...[]byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldIn(FieldIdentityPublicKey, vs...))
+}
+
+// IdentityPublicKeyNotIn applies the NotIn predicate on the "identity_public_key" field.
+func IdentityPublicKeyNotIn(vs ...[]byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNotIn(FieldIdentityPublicKey, vs...))
+}
+
+// IdentityPublicKeyGT applies the GT predicate on the "identity_public_key" field.
+func IdentityPublicKeyGT(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGT(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyGTE applies the GTE predicate on the "identity_public_key" field.
+func IdentityPublicKeyGTE(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGTE(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyLT applies the LT predicate on the "identity_public_key" field.
+func IdentityPublicKeyLT(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLT(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyLTE applies the LTE predicate on the "identity_public_key" field.
+func IdentityPublicKeyLTE(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLTE(FieldIdentityPublicKey, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...[]byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...[]byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v []byte) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDIsNil applies the IsNil predicate on the "session_id" field.
+func SessionIDIsNil() predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldIsNull(FieldSessionID))
+}
+
+// SessionIDNotNil applies the NotNil predicate on the "session_id" field.
+func SessionIDNotNil() predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNotNull(FieldSessionID))
+}
+
+// ExpirationTimeEQ applies the EQ predicate on the "expiration_time" field.
+func ExpirationTimeEQ(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldEQ(FieldExpirationTime, v))
+}
+
+// ExpirationTimeNEQ applies the NEQ predicate on the "expiration_time" field.
+func ExpirationTimeNEQ(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNEQ(FieldExpirationTime, v))
+}
+
+// ExpirationTimeIn applies the In predicate on the "expiration_time" field.
+func ExpirationTimeIn(vs ...time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldIn(FieldExpirationTime, vs...))
+}
+
+// ExpirationTimeNotIn applies the NotIn predicate on the "expiration_time" field.
+func ExpirationTimeNotIn(vs ...time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldNotIn(FieldExpirationTime, vs...))
+}
+
+// ExpirationTimeGT applies the GT predicate on the "expiration_time" field.
+func ExpirationTimeGT(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGT(FieldExpirationTime, v))
+}
+
+// ExpirationTimeGTE applies the GTE predicate on the "expiration_time" field.
+func ExpirationTimeGTE(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldGTE(FieldExpirationTime, v))
+}
+
+// ExpirationTimeLT applies the LT predicate on the "expiration_time" field.
+func ExpirationTimeLT(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLT(FieldExpirationTime, v))
+}
+
+// ExpirationTimeLTE applies the LTE predicate on the "expiration_time" field.
+func ExpirationTimeLTE(v time.Time) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.FieldLTE(FieldExpirationTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SessionRevocation) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SessionRevocation) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SessionRevocation) predicate.SessionRevocation {
+	return predicate.SessionRevocation(sql.NotPredicates(p))
+}
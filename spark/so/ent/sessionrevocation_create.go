@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/sessionrevocation"
+)
+
+// SessionRevocationCreate is the builder for creating a SessionRevocation entity.
+type SessionRevocationCreate struct {
+	config
+	mutation *SessionRevocationMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (src *SessionRevocationCreate) SetCreateTime(t time.Time) *SessionRevocationCreate {
+	src.mutation.SetCreateTime(t)
+	return src
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (src *SessionRevocationCreate) SetNillableCreateTime(t *time.Time) *SessionRevocationCreate {
+	if t != nil {
+		src.SetCreateTime(*t)
+	}
+	return src
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (src *SessionRevocationCreate) SetUpdateTime(t time.Time) *SessionRevocationCreate {
+	src.mutation.SetUpdateTime(t)
+	return src
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (src *SessionRevocationCreate) SetNillableUpdateTime(t *time.Time) *SessionRevocationCreate {
+	if t != nil {
+		src.SetUpdateTime(*t)
+	}
+	return src
+}
+
+// SetIdentityPublicKey sets the "identity_public_key" field.
+func (src *SessionRevocationCreate) SetIdentityPublicKey(b []byte) *SessionRevocationCreate {
+	src.mutation.SetIdentityPublicKey(b)
+	return src
+}
+
+// SetSessionID sets the "session_id" field.
+func (src *SessionRevocationCreate) SetSessionID(b []byte) *SessionRevocationCreate {
+	src.mutation.SetSessionID(b)
+	return src
+}
+
+// SetExpirationTime sets the "expiration_time" field.
+func (src *SessionRevocationCreate) SetExpirationTime(t time.Time) *SessionRevocationCreate {
+	src.mutation.SetExpirationTime(t)
+	return src
+}
+
+// SetID sets the "id" field.
+func (src *SessionRevocationCreate) SetID(u uuid.UUID) *SessionRevocationCreate {
+	src.mutation.SetID(u)
+	return src
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (src *SessionRevocationCreate) SetNillableID(u *uuid.UUID) *SessionRevocationCreate {
+	if u != nil {
+		src.SetID(*u)
+	}
+	return src
+}
+
+// Mutation returns the SessionRevocationMutation object of the builder.
+func (src *SessionRevocationCreate) Mutation() *SessionRevocationMutation {
+	return src.mutation
+}
+
+// Save creates the SessionRevocation in the database.
+func (src *SessionRevocationCreate) Save(ctx context.Context) (*SessionRevocation, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *SessionRevocationCreate) SaveX(ctx context.Context) *SessionRevocation {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *SessionRevocationCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *SessionRevocationCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *SessionRevocationCreate) defaults() {
+	if _, ok := src.mutation.CreateTime(); !ok {
+		v := sessionrevocation.DefaultCreateTime()
+		src.mutation.SetCreateTime(v)
+	}
+	if _, ok := src.mutation.UpdateTime(); !ok {
+		v := sessionrevocation.DefaultUpdateTime()
+		src.mutation.SetUpdateTime(v)
+	}
+	if _, ok := src.mutation.ID(); !ok {
+		v := sessionrevocation.DefaultID()
+		src.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *SessionRevocationCreate) check() error {
+	if _, ok := src.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "SessionRevocation.create_time"`)}
+	}
+	if _, ok := src.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "SessionRevocation.update_time"`)}
+	}
+	if _, ok := src.mutation.IdentityPublicKey(); !ok {
+		return &ValidationError{Name: "identity_public_key", err: errors.New(`ent: missing required field "SessionRevocation.identity_public_key"`)}
+	}
+	if v, ok := src.mutation.IdentityPublicKey(); ok {
+		if err := sessionrevocation.IdentityPublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "identity_public_key", err: fmt.Errorf(`ent: validator failed for field "SessionRevocation.identity_public_key": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.ExpirationTime(); !ok {
+		return &ValidationError{Name: "expiration_time", err: errors.New(`ent: missing required field "SessionRevocation.expiration_time"`)}
+	}
+	return nil
+}
+
+func (src *SessionRevocationCreate) sqlSave(ctx context.Context) (*SessionRevocation, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *SessionRevocationCreate) createSpec() (*SessionRevocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SessionRevocation{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(sessionrevocation.Table, sqlgraph.NewFieldSpec(sessionrevocation.FieldID, field.TypeUUID))
+	)
+	if id, ok := src.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := src.mutation.CreateTime(); ok {
+		_spec.SetField(sessionrevocation.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := src.mutation.UpdateTime(); ok {
+		_spec.SetField(sessionrevocation.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := src.mutation.IdentityPublicKey(); ok {
+		_spec.SetField(sessionrevocation.FieldIdentityPublicKey, field.TypeBytes, value)
+		_node.IdentityPublicKey = value
+	}
+	if value, ok := src.mutation.SessionID(); ok {
+		_spec.SetField(sessionrevocation.FieldSessionID, field.TypeBytes, value)
+		_node.SessionID = value
+	}
+	if value, ok := src.mutation.ExpirationTime(); ok {
+		_spec.SetField(sessionrevocation.FieldExpirationTime, field.TypeTime, value)
+		_node.ExpirationTime = value
+	}
+	return _node, _spec
+}
+
+// SessionRevocationCreateBulk is the builder for creating many SessionRevocation entities in bulk.
+type SessionRevocationCreateBulk struct {
+	config
+	err      error
+	builders []*SessionRevocationCreate
+}
+
+// Save creates the SessionRevocation entities in the database.
+func (srcb *SessionRevocationCreateBulk) Save(ctx context.Context) ([]*SessionRevocation, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*SessionRevocation, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SessionRevocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *SessionRevocationCreateBulk) SaveX(ctx context.Context) []*SessionRevocation {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *SessionRevocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *SessionRevocationCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
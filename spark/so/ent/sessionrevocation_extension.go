@@ -0,0 +1,150 @@
+package ent
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/common/logging"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/sessionrevocation"
+	"github.com/patrickmn/go-cache"
+)
+
+// SessionRevocationChecker checks sessions against the session revocations table. Results are cached
+// for spark.SessionRevocationCacheTTL. The cache is flushed when this process revokes a session, and
+// by WatchRevocations when a revocation made by another process, of this or another operator,
+// shows up in the table.
+type SessionRevocationChecker struct {
+	client *Client
+	cache  *cache.Cache
+}
+
+// NewSessionRevocationChecker creates a new SessionRevocationChecker reading from the given client.
+func NewSessionRevocationChecker(client *Client) *SessionRevocationChecker {
+	return &SessionRevocationChecker{
+		client: client,
+		cache:  cache.New(spark.SessionRevocationCacheTTL, spark.SessionRevocationCacheTTL),
+	}
+}
+
+// IsSessionRevoked returns whether the session with the given ID of the identity was revoked, or
+// every session of the identity issued at issuedTimestampMillis or earlier was. Without a session
+// ID, only revocations of every session are checked.
+func (r *SessionRevocationChecker) IsSessionRevoked(ctx context.Context, identityPublicKey []byte, sessionID []byte, issuedTimestampMillis int64) (bool, error) {
+	cacheKey := fmt.Sprintf("%x:%x:%d", identityPublicKey, sessionID, issuedTimestampMillis)
+	if revoked, ok := r.cache.Get(cacheKey); ok {
+		return revoked.(bool), nil
+	}
+
+	revocations := []predicate.SessionRevocation{
+		sessionrevocation.And(
+			sessionrevocation.SessionIDIsNil(),
+			sessionrevocation.CreateTimeGT(time.UnixMilli(issuedTimestampMillis)),
+		),
+	}
+	if len(sessionID) > 0 {
+		revocations = append(revocations, sessionrevocation.SessionIDEQ(sessionID))
+	}
+	revoked, err := r.client.SessionRevocation.Query().
+		Where(
+			sessionrevocation.IdentityPublicKeyEQ(identityPublicKey),
+			sessionrevocation.Or(revocations...),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check session revocation: %w", err)
+	}
+	r.cache.SetDefault(cacheKey, revoked)
+	return revoked, nil
+}
+
+// Revoke revokes the session with the given ID of the identity, or every session of the identity
+// issued before revokeTime if sessionID is empty, in the transaction in the context. The revocation
+// is kept until expirationTime, by when every session it revokes must have expired.
+func (r *SessionRevocationChecker) Revoke(ctx context.Context, identityPublicKey []byte, sessionID []byte, revokeTime time.Time, expirationTime time.Time) error {
+	if err := CreateSessionRevocation(ctx, identityPublicKey, sessionID, revokeTime, expirationTime); err != nil {
+		return err
+	}
+	r.cache.Flush()
+	return nil
+}
+
+// WatchRevocations flushes the cache every spark.SessionRevocationPollInterval in which a revocation
+// this process has not seen yet was made, until the context is done. Revocations are looked for
+// among those made in the last spark.SessionRevocationWatchWindow, which must be longer than the
+// time a revocation takes to be committed after it was made.
+func (r *SessionRevocationChecker) WatchRevocations(ctx context.Context) {
+	logger := logging.GetLoggerFromContext(ctx)
+	ticker := time.NewTicker(spark.SessionRevocationPollInterval)
+	defer ticker.Stop()
+	seen := make(map[uuid.UUID]bool)
+	for {
+		ids, err := r.client.SessionRevocation.Query().
+			Where(sessionrevocation.CreateTimeGT(time.Now().Add(-spark.SessionRevocationWatchWindow))).
+			IDs(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warn("Failed to look for new session revocations", "error", err)
+			}
+		} else {
+			recent := make(map[uuid.UUID]bool, len(ids))
+			flush := false
+			for _, id := range ids {
+				recent[id] = true
+				flush = flush || !seen[id]
+			}
+			if flush {
+				r.cache.Flush()
+			}
+			seen = recent
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CreateSessionRevocation records the revocation of the session with the given ID of the identity,
+// or of every session of the identity issued before revokeTime if sessionID is empty, in the
+// transaction in the context, without flushing the cache of any checker.
+func CreateSessionRevocation(ctx context.Context, identityPublicKey []byte, sessionID []byte, revokeTime time.Time, expirationTime time.Time) error {
+	create := GetDbFromContext(ctx).SessionRevocation.Create().
+		SetCreateTime(revokeTime).
+		SetIdentityPublicKey(identityPublicKey).
+		SetExpirationTime(expirationTime)
+	if len(sessionID) > 0 {
+		create = create.SetSessionID(sessionID)
+	}
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to revoke session %s: %w", hex.EncodeToString(sessionID), err)
+	}
+	return nil
+}
+
+// PurgeSessionRevocations deletes up to batchSize session revocations past their expiration
+// time, and returns how many it deleted.
+func PurgeSessionRevocations(ctx context.Context, batchSize int) (int, error) {
+	db := GetDbFromContext(ctx)
+	ids, err := db.SessionRevocation.Query().
+		Where(sessionrevocation.ExpirationTimeLT(time.Now())).
+		Limit(batchSize).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query expired session revocations: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	purged, err := db.SessionRevocation.Delete().Where(sessionrevocation.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge session revocations: %w", err)
+	}
+	return purged, nil
+}
@@ -0,0 +1,64 @@
+package ent_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchRevocationsFlushesOtherProcesses(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:watch_revocations?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { db.Close() })
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// Two processes of the operator share the database.
+	revoking := ent.NewSessionRevocationChecker(db)
+	watching := ent.NewSessionRevocationChecker(db)
+	go watching.WatchRevocations(ctx)
+
+	identity := []byte("identity")
+	sessionID := []byte("session")
+	issued := time.Now().Add(-time.Minute).UnixMilli()
+	revoked, err := watching.IsSessionRevoked(ctx, identity, sessionID, issued)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	tx, err := db.Tx(ctx)
+	require.NoError(t, err)
+	now := time.Now()
+	require.NoError(t, revoking.Revoke(context.WithValue(ctx, ent.TxKey, tx), identity, sessionID, now, now.Add(time.Hour)))
+	require.NoError(t, tx.Commit())
+
+	revoked, err = revoking.IsSessionRevoked(ctx, identity, sessionID, issued)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	// The other process drops the result it cached once it sees the revocation.
+	require.Eventually(t, func() bool {
+		revoked, err := watching.IsSessionRevoked(ctx, identity, sessionID, issued)
+		return err == nil && revoked
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestCreateSessionRevocationKeepsRevokeTime(t *testing.T) {
+	ctx := newTestTxContext(t, "create_session_revocation")
+
+	// A revocation of every session made at the coordinator revokes the sessions issued before it
+	// was made there, however late it is recorded here.
+	identity := []byte("identity")
+	revokeTime := time.Now().Add(-time.Minute)
+	require.NoError(t, ent.CreateSessionRevocation(ctx, identity, nil, revokeTime, time.Now().Add(time.Hour)))
+
+	checker := ent.NewSessionRevocationChecker(ent.GetDbFromContext(ctx).Client())
+	revoked, err := checker.IsSessionRevoked(ctx, identity, nil, revokeTime.Add(-time.Second).UnixMilli())
+	require.NoError(t, err)
+	require.True(t, revoked)
+	revoked, err = checker.IsSessionRevoked(ctx, identity, nil, revokeTime.Add(time.Second).UnixMilli())
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/sessionrevocation"
+)
+
+// SessionRevocationUpdate is the builder for updating SessionRevocation entities.
+type SessionRevocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SessionRevocationMutation
+}
+
+// Where appends a list predicates to the SessionRevocationUpdate builder.
+func (sru *SessionRevocationUpdate) Where(ps ...predicate.SessionRevocation) *SessionRevocationUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (sru *SessionRevocationUpdate) SetUpdateTime(t time.Time) *SessionRevocationUpdate {
+	sru.mutation.SetUpdateTime(t)
+	return sru
+}
+
+// Mutation returns the SessionRevocationMutation object of the builder.
+func (sru *SessionRevocationUpdate) Mutation() *SessionRevocationMutation {
+	return sru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *SessionRevocationUpdate) Save(ctx context.Context) (int, error) {
+	sru.defaults()
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *SessionRevocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *SessionRevocationUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *SessionRevocationUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sru *SessionRevocationUpdate) defaults() {
+	if _, ok := sru.mutation.UpdateTime(); !ok {
+		v := sessionrevocation.UpdateDefaultUpdateTime()
+		sru.mutation.SetUpdateTime(v)
+	}
+}
+
+func (sru *SessionRevocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sessionrevocation.Table, sessionrevocation.Columns, sqlgraph.NewFieldSpec(sessionrevocation.FieldID, field.TypeUUID))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sru.mutation.UpdateTime(); ok {
+		_spec.SetField(sessionrevocation.FieldUpdateTime, field.TypeTime, value)
+	}
+	if sru.mutation.SessionIDCleared() {
+		_spec.ClearField(sessionrevocation.FieldSessionID, field.TypeBytes)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessionrevocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// SessionRevocationUpdateOne is the builder for updating a single SessionRevocation entity.
+type SessionRevocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SessionRevocationMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (sruo *SessionRevocationUpdateOne) SetUpdateTime(t time.Time) *SessionRevocationUpdateOne {
+	sruo.mutation.SetUpdateTime(t)
+	return sruo
+}
+
+// Mutation returns the SessionRevocationMutation object of the builder.
+func (sruo *SessionRevocationUpdateOne) Mutation() *SessionRevocationMutation {
+	return sruo.mutation
+}
+
+// Where appends a list predicates to the SessionRevocationUpdate builder.
+func (sruo *SessionRevocationUpdateOne) Where(ps ...predicate.SessionRevocation) *SessionRevocationUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *SessionRevocationUpdateOne) Select(field string, fields ...string) *SessionRevocationUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated SessionRevocation entity.
+func (sruo *SessionRevocationUpdateOne) Save(ctx context.Context) (*SessionRevocation, error) {
+	sruo.defaults()
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *SessionRevocationUpdateOne) SaveX(ctx context.Context) *SessionRevocation {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *SessionRevocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *SessionRevocationUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sruo *SessionRevocationUpdateOne) defaults() {
+	if _, ok := sruo.mutation.UpdateTime(); !ok {
+		v := sessionrevocation.UpdateDefaultUpdateTime()
+		sruo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (sruo *SessionRevocationUpdateOne) sqlSave(ctx context.Context) (_node *SessionRevocation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sessionrevocation.Table, sessionrevocation.Columns, sqlgraph.NewFieldSpec(sessionrevocation.FieldID, field.TypeUUID))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SessionRevocation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sessionrevocation.FieldID)
+		for _, f := range fields {
+			if !sessionrevocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sessionrevocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sruo.mutation.UpdateTime(); ok {
+		_spec.SetField(sessionrevocation.FieldUpdateTime, field.TypeTime, value)
+	}
+	if sruo.mutation.SessionIDCleared() {
+		_spec.ClearField(sessionrevocation.FieldSessionID, field.TypeBytes)
+	}
+	_node = &SessionRevocation{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessionrevocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+)
+
+// ShareRefresh is the model entity for the ShareRefresh schema.
+type ShareRefresh struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Status holds the value of the "status" field.
+	Status schema.ShareRefreshStatus `json:"status,omitempty"`
+	// CoordinatorIndex holds the value of the "coordinator_index" field.
+	CoordinatorIndex uint64 `json:"coordinator_index,omitempty"`
+	// KeyshareIds holds the value of the "keyshare_ids" field.
+	KeyshareIds []uuid.UUID `json:"keyshare_ids,omitempty"`
+	// Contributions holds the value of the "contributions" field.
+	Contributions [][]uint8 `json:"contributions,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareRefresh) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sharerefresh.FieldKeyshareIds, sharerefresh.FieldContributions:
+			values[i] = new([]byte)
+		case sharerefresh.FieldCoordinatorIndex:
+			values[i] = new(sql.NullInt64)
+		case sharerefresh.FieldStatus:
+			values[i] = new(sql.NullString)
+		case sharerefresh.FieldCreateTime, sharerefresh.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case sharerefresh.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareRefresh fields.
+func (sr *ShareRefresh) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sharerefresh.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sr.ID = *value
+			}
+		case sharerefresh.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				sr.CreateTime = value.Time
+			}
+		case sharerefresh.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				sr.UpdateTime = value.Time
+			}
+		case sharerefresh.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sr.Status = schema.ShareRefreshStatus(value.String)
+			}
+		case sharerefresh.FieldCoordinatorIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field coordinator_index", values[i])
+			} else if value.Valid {
+				sr.CoordinatorIndex = uint64(value.Int64)
+			}
+		case sharerefresh.FieldKeyshareIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keyshare_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sr.KeyshareIds); err != nil {
+					return fmt.Errorf("unmarshal field keyshare_ids: %w", err)
+				}
+			}
+		case sharerefresh.FieldContributions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field contributions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sr.Contributions); err != nil {
+					return fmt.Errorf("unmarshal field contributions: %w", err)
+				}
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShareRefresh.
+// This includes values selected through modifiers, order, etc.
+func (sr *ShareRefresh) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ShareRefresh.
+// Note that you need to call ShareRefresh.Unwrap() before calling this method if this ShareRefresh
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *ShareRefresh) Update() *ShareRefreshUpdateOne {
+	return NewShareRefreshClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the ShareRefresh entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *ShareRefresh) Unwrap() *ShareRefresh {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareRefresh is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *ShareRefresh) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareRefresh(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(sr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(sr.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("coordinator_index=")
+	builder.WriteString(fmt.Sprintf("%v", sr.CoordinatorIndex))
+	builder.WriteString(", ")
+	builder.WriteString("keyshare_ids=")
+	builder.WriteString(fmt.Sprintf("%v", sr.KeyshareIds))
+	builder.WriteString(", ")
+	builder.WriteString("contributions=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Contributions))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareRefreshes is a parsable slice of ShareRefresh.
+type ShareRefreshes []*ShareRefresh
@@ -0,0 +1,103 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharerefresh
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+const (
+	// Label holds the string label denoting the sharerefresh type in the database.
+	Label = "share_refresh"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCoordinatorIndex holds the string denoting the coordinator_index field in the database.
+	FieldCoordinatorIndex = "coordinator_index"
+	// FieldKeyshareIds holds the string denoting the keyshare_ids field in the database.
+	FieldKeyshareIds = "keyshare_ids"
+	// FieldContributions holds the string denoting the contributions field in the database.
+	FieldContributions = "contributions"
+	// Table holds the table name of the sharerefresh in the database.
+	Table = "share_refreshes"
+)
+
+// Columns holds all SQL columns for sharerefresh fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldStatus,
+	FieldCoordinatorIndex,
+	FieldKeyshareIds,
+	FieldContributions,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s schema.ShareRefreshStatus) error {
+	switch s {
+	case "PREPARED", "COMMITTED", "COMPLETED", "ABORTED":
+		return nil
+	default:
+		return fmt.Errorf("sharerefresh: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ShareRefresh queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCoordinatorIndex orders the results by the coordinator_index field.
+func ByCoordinatorIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCoordinatorIndex, opts...).ToFunc()
+}
@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharerefresh
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// CoordinatorIndex applies equality check predicate on the "coordinator_index" field. It's identical to CoordinatorIndexEQ.
+func CoordinatorIndex(v uint64) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldEQ(FieldCoordinatorIndex, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v schema.ShareRefreshStatus) predicate.ShareRefresh {
+	vc := v
+	return predicate.ShareRefresh(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v schema.ShareRefreshStatus) predicate.ShareRefresh {
+	vc := v
+	return predicate.ShareRefresh(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...schema.ShareRefreshStatus) predicate.ShareRefresh {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareRefresh(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...schema.ShareRefreshStatus) predicate.ShareRefresh {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareRefresh(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// CoordinatorIndexEQ applies the EQ predicate on the "coordinator_index" field.
+func CoordinatorIndexEQ(v uint64) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldEQ(FieldCoordinatorIndex, v))
+}
+
+// CoordinatorIndexNEQ applies the NEQ predicate on the "coordinator_index" field.
+func CoordinatorIndexNEQ(v uint64) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldNEQ(FieldCoordinatorIndex, v))
+}
+
+// CoordinatorIndexIn applies the In predicate on the "coordinator_index" field.
+func CoordinatorIndexIn(vs ...uint64) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldIn(FieldCoordinatorIndex, vs...))
+}
+
+// CoordinatorIndexNotIn applies the NotIn predicate on the "coordinator_index" field.
+func CoordinatorIndexNotIn(vs ...uint64) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldNotIn(FieldCoordinatorIndex, vs...))
+}
+
+// CoordinatorIndexGT applies the GT predicate on the "coordinator_index" field.
+func CoordinatorIndexGT(v uint64) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldGT(FieldCoordinatorIndex, v))
+}
+
+// CoordinatorIndexGTE applies the GTE predicate on the "coordinator_index" field.
+func CoordinatorIndexGTE(v uint64) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldGTE(FieldCoordinatorIndex, v))
+}
+
+// CoordinatorIndexLT applies the LT predicate on the "coordinator_index" field.
+func CoordinatorIndexLT(v uint64) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldLT(FieldCoordinatorIndex, v))
+}
+
+// CoordinatorIndexLTE applies the LTE predicate on the "coordinator_index" field.
+func CoordinatorIndexLTE(v uint64) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldLTE(FieldCoordinatorIndex, v))
+}
+
+// ContributionsIsNil applies the IsNil predicate on the "contributions" field.
+func ContributionsIsNil() predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldIsNull(FieldContributions))
+}
+
+// ContributionsNotNil applies the NotNil predicate on the "contributions" field.
+func ContributionsNotNil() predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.FieldNotNull(FieldContributions))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShareRefresh) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShareRefresh) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShareRefresh) predicate.ShareRefresh {
+	return predicate.ShareRefresh(sql.NotPredicates(p))
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+)
+
+// ShareRefreshCreate is the builder for creating a ShareRefresh entity.
+type ShareRefreshCreate struct {
+	config
+	mutation *ShareRefreshMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (src *ShareRefreshCreate) SetCreateTime(t time.Time) *ShareRefreshCreate {
+	src.mutation.SetCreateTime(t)
+	return src
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (src *ShareRefreshCreate) SetNillableCreateTime(t *time.Time) *ShareRefreshCreate {
+	if t != nil {
+		src.SetCreateTime(*t)
+	}
+	return src
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (src *ShareRefreshCreate) SetUpdateTime(t time.Time) *ShareRefreshCreate {
+	src.mutation.SetUpdateTime(t)
+	return src
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (src *ShareRefreshCreate) SetNillableUpdateTime(t *time.Time) *ShareRefreshCreate {
+	if t != nil {
+		src.SetUpdateTime(*t)
+	}
+	return src
+}
+
+// SetStatus sets the "status" field.
+func (src *ShareRefreshCreate) SetStatus(srs schema.ShareRefreshStatus) *ShareRefreshCreate {
+	src.mutation.SetStatus(srs)
+	return src
+}
+
+// SetCoordinatorIndex sets the "coordinator_index" field.
+func (src *ShareRefreshCreate) SetCoordinatorIndex(u uint64) *ShareRefreshCreate {
+	src.mutation.SetCoordinatorIndex(u)
+	return src
+}
+
+// SetKeyshareIds sets the "keyshare_ids" field.
+func (src *ShareRefreshCreate) SetKeyshareIds(u []uuid.UUID) *ShareRefreshCreate {
+	src.mutation.SetKeyshareIds(u)
+	return src
+}
+
+// SetContributions sets the "contributions" field.
+func (src *ShareRefreshCreate) SetContributions(u [][]uint8) *ShareRefreshCreate {
+	src.mutation.SetContributions(u)
+	return src
+}
+
+// SetID sets the "id" field.
+func (src *ShareRefreshCreate) SetID(u uuid.UUID) *ShareRefreshCreate {
+	src.mutation.SetID(u)
+	return src
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (src *ShareRefreshCreate) SetNillableID(u *uuid.UUID) *ShareRefreshCreate {
+	if u != nil {
+		src.SetID(*u)
+	}
+	return src
+}
+
+// Mutation returns the ShareRefreshMutation object of the builder.
+func (src *ShareRefreshCreate) Mutation() *ShareRefreshMutation {
+	return src.mutation
+}
+
+// Save creates the ShareRefresh in the database.
+func (src *ShareRefreshCreate) Save(ctx context.Context) (*ShareRefresh, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *ShareRefreshCreate) SaveX(ctx context.Context) *ShareRefresh {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *ShareRefreshCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *ShareRefreshCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *ShareRefreshCreate) defaults() {
+	if _, ok := src.mutation.CreateTime(); !ok {
+		v := sharerefresh.DefaultCreateTime()
+		src.mutation.SetCreateTime(v)
+	}
+	if _, ok := src.mutation.UpdateTime(); !ok {
+		v := sharerefresh.DefaultUpdateTime()
+		src.mutation.SetUpdateTime(v)
+	}
+	if _, ok := src.mutation.ID(); !ok {
+		v := sharerefresh.DefaultID()
+		src.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *ShareRefreshCreate) check() error {
+	if _, ok := src.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ShareRefresh.create_time"`)}
+	}
+	if _, ok := src.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ShareRefresh.update_time"`)}
+	}
+	if _, ok := src.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ShareRefresh.status"`)}
+	}
+	if v, ok := src.mutation.Status(); ok {
+		if err := sharerefresh.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ShareRefresh.status": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.CoordinatorIndex(); !ok {
+		return &ValidationError{Name: "coordinator_index", err: errors.New(`ent: missing required field "ShareRefresh.coordinator_index"`)}
+	}
+	if _, ok := src.mutation.KeyshareIds(); !ok {
+		return &ValidationError{Name: "keyshare_ids", err: errors.New(`ent: missing required field "ShareRefresh.keyshare_ids"`)}
+	}
+	return nil
+}
+
+func (src *ShareRefreshCreate) sqlSave(ctx context.Context) (*ShareRefresh, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *ShareRefreshCreate) createSpec() (*ShareRefresh, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareRefresh{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(sharerefresh.Table, sqlgraph.NewFieldSpec(sharerefresh.FieldID, field.TypeUUID))
+	)
+	if id, ok := src.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := src.mutation.CreateTime(); ok {
+		_spec.SetField(sharerefresh.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := src.mutation.UpdateTime(); ok {
+		_spec.SetField(sharerefresh.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := src.mutation.Status(); ok {
+		_spec.SetField(sharerefresh.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := src.mutation.CoordinatorIndex(); ok {
+		_spec.SetField(sharerefresh.FieldCoordinatorIndex, field.TypeUint64, value)
+		_node.CoordinatorIndex = value
+	}
+	if value, ok := src.mutation.KeyshareIds(); ok {
+		_spec.SetField(sharerefresh.FieldKeyshareIds, field.TypeJSON, value)
+		_node.KeyshareIds = value
+	}
+	if value, ok := src.mutation.Contributions(); ok {
+		_spec.SetField(sharerefresh.FieldContributions, field.TypeJSON, value)
+		_node.Contributions = value
+	}
+	return _node, _spec
+}
+
+// ShareRefreshCreateBulk is the builder for creating many ShareRefresh entities in bulk.
+type ShareRefreshCreateBulk struct {
+	config
+	err      error
+	builders []*ShareRefreshCreate
+}
+
+// Save creates the ShareRefresh entities in the database.
+func (srcb *ShareRefreshCreateBulk) Save(ctx context.Context) ([]*ShareRefresh, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*ShareRefresh, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareRefreshMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *ShareRefreshCreateBulk) SaveX(ctx context.Context) []*ShareRefresh {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *ShareRefreshCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *ShareRefreshCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
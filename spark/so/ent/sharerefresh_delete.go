@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+)
+
+// ShareRefreshDelete is the builder for deleting a ShareRefresh entity.
+type ShareRefreshDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShareRefreshMutation
+}
+
+// Where appends a list predicates to the ShareRefreshDelete builder.
+func (srd *ShareRefreshDelete) Where(ps ...predicate.ShareRefresh) *ShareRefreshDelete {
+	srd.mutation.Where(ps...)
+	return srd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (srd *ShareRefreshDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, srd.sqlExec, srd.mutation, srd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srd *ShareRefreshDelete) ExecX(ctx context.Context) int {
+	n, err := srd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (srd *ShareRefreshDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sharerefresh.Table, sqlgraph.NewFieldSpec(sharerefresh.FieldID, field.TypeUUID))
+	if ps := srd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, srd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	srd.mutation.done = true
+	return affected, err
+}
+
+// ShareRefreshDeleteOne is the builder for deleting a single ShareRefresh entity.
+type ShareRefreshDeleteOne struct {
+	srd *ShareRefreshDelete
+}
+
+// Where appends a list predicates to the ShareRefreshDelete builder.
+func (srdo *ShareRefreshDeleteOne) Where(ps ...predicate.ShareRefresh) *ShareRefreshDeleteOne {
+	srdo.srd.mutation.Where(ps...)
+	return srdo
+}
+
+// Exec executes the deletion query.
+func (srdo *ShareRefreshDeleteOne) Exec(ctx context.Context) error {
+	n, err := srdo.srd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sharerefresh.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srdo *ShareRefreshDeleteOne) ExecX(ctx context.Context) {
+	if err := srdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
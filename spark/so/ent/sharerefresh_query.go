@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+)
+
+// ShareRefreshQuery is the builder for querying ShareRefresh entities.
+type ShareRefreshQuery struct {
+	config
+	ctx        *QueryContext
+	order      []sharerefresh.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ShareRefresh
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShareRefreshQuery builder.
+func (srq *ShareRefreshQuery) Where(ps ...predicate.ShareRefresh) *ShareRefreshQuery {
+	srq.predicates = append(srq.predicates, ps...)
+	return srq
+}
+
+// Limit the number of records to be returned by this query.
+func (srq *ShareRefreshQuery) Limit(limit int) *ShareRefreshQuery {
+	srq.ctx.Limit = &limit
+	return srq
+}
+
+// Offset to start from.
+func (srq *ShareRefreshQuery) Offset(offset int) *ShareRefreshQuery {
+	srq.ctx.Offset = &offset
+	return srq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (srq *ShareRefreshQuery) Unique(unique bool) *ShareRefreshQuery {
+	srq.ctx.Unique = &unique
+	return srq
+}
+
+// Order specifies how the records should be ordered.
+func (srq *ShareRefreshQuery) Order(o ...sharerefresh.OrderOption) *ShareRefreshQuery {
+	srq.order = append(srq.order, o...)
+	return srq
+}
+
+// First returns the first ShareRefresh entity from the query.
+// Returns a *NotFoundError when no ShareRefresh was found.
+func (srq *ShareRefreshQuery) First(ctx context.Context) (*ShareRefresh, error) {
+	nodes, err := srq.Limit(1).All(setContextOp(ctx, srq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sharerefresh.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (srq *ShareRefreshQuery) FirstX(ctx context.Context) *ShareRefresh {
+	node, err := srq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShareRefresh ID from the query.
+// Returns a *NotFoundError when no ShareRefresh ID was found.
+func (srq *ShareRefreshQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = srq.Limit(1).IDs(setContextOp(ctx, srq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sharerefresh.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (srq *ShareRefreshQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := srq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShareRefresh entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShareRefresh entity is found.
+// Returns a *NotFoundError when no ShareRefresh entities are found.
+func (srq *ShareRefreshQuery) Only(ctx context.Context) (*ShareRefresh, error) {
+	nodes, err := srq.Limit(2).All(setContextOp(ctx, srq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sharerefresh.Label}
+	default:
+		return nil, &NotSingularError{sharerefresh.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (srq *ShareRefreshQuery) OnlyX(ctx context.Context) *ShareRefresh {
+	node, err := srq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShareRefresh ID in the query.
+// Returns a *NotSingularError when more than one ShareRefresh ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (srq *ShareRefreshQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = srq.Limit(2).IDs(setContextOp(ctx, srq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sharerefresh.Label}
+	default:
+		err = &NotSingularError{sharerefresh.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (srq *ShareRefreshQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := srq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShareRefreshes.
+func (srq *ShareRefreshQuery) All(ctx context.Context) ([]*ShareRefresh, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryAll)
+	if err := srq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShareRefresh, *ShareRefreshQuery]()
+	return withInterceptors[[]*ShareRefresh](ctx, srq, qr, srq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (srq *ShareRefreshQuery) AllX(ctx context.Context) []*ShareRefresh {
+	nodes, err := srq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShareRefresh IDs.
+func (srq *ShareRefreshQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if srq.ctx.Unique == nil && srq.path != nil {
+		srq.Unique(true)
+	}
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryIDs)
+	if err = srq.Select(sharerefresh.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (srq *ShareRefreshQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := srq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (srq *ShareRefreshQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryCount)
+	if err := srq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, srq, querierCount[*ShareRefreshQuery](), srq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (srq *ShareRefreshQuery) CountX(ctx context.Context) int {
+	count, err := srq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (srq *ShareRefreshQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryExist)
+	switch _, err := srq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (srq *ShareRefreshQuery) ExistX(ctx context.Context) bool {
+	exist, err := srq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShareRefreshQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (srq *ShareRefreshQuery) Clone() *ShareRefreshQuery {
+	if srq == nil {
+		return nil
+	}
+	return &ShareRefreshQuery{
+		config:     srq.config,
+		ctx:        srq.ctx.Clone(),
+		order:      append([]sharerefresh.OrderOption{}, srq.order...),
+		inters:     append([]Interceptor{}, srq.inters...),
+		predicates: append([]predicate.ShareRefresh{}, srq.predicates...),
+		// clone intermediate query.
+		sql:  srq.sql.Clone(),
+		path: srq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShareRefresh.Query().
+//		GroupBy(sharerefresh.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (srq *ShareRefreshQuery) GroupBy(field string, fields ...string) *ShareRefreshGroupBy {
+	srq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShareRefreshGroupBy{build: srq}
+	grbuild.flds = &srq.ctx.Fields
+	grbuild.label = sharerefresh.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ShareRefresh.Query().
+//		Select(sharerefresh.FieldCreateTime).
+//		Scan(ctx, &v)
+func (srq *ShareRefreshQuery) Select(fields ...string) *ShareRefreshSelect {
+	srq.ctx.Fields = append(srq.ctx.Fields, fields...)
+	sbuild := &ShareRefreshSelect{ShareRefreshQuery: srq}
+	sbuild.label = sharerefresh.Label
+	sbuild.flds, sbuild.scan = &srq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShareRefreshSelect configured with the given aggregations.
+func (srq *ShareRefreshQuery) Aggregate(fns ...AggregateFunc) *ShareRefreshSelect {
+	return srq.Select().Aggregate(fns...)
+}
+
+func (srq *ShareRefreshQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range srq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, srq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range srq.ctx.Fields {
+		if !sharerefresh.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if srq.path != nil {
+		prev, err := srq.path(ctx)
+		if err != nil {
+			return err
+		}
+		srq.sql = prev
+	}
+	return nil
+}
+
+func (srq *ShareRefreshQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShareRefresh, error) {
+	var (
+		nodes = []*ShareRefresh{}
+		_spec = srq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShareRefresh).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShareRefresh{config: srq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(srq.modifiers) > 0 {
+		_spec.Modifiers = srq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, srq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (srq *ShareRefreshQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := srq.querySpec()
+	if len(srq.modifiers) > 0 {
+		_spec.Modifiers = srq.modifiers
+	}
+	_spec.Node.Columns = srq.ctx.Fields
+	if len(srq.ctx.Fields) > 0 {
+		_spec.Unique = srq.ctx.Unique != nil && *srq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, srq.driver, _spec)
+}
+
+func (srq *ShareRefreshQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sharerefresh.Table, sharerefresh.Columns, sqlgraph.NewFieldSpec(sharerefresh.FieldID, field.TypeUUID))
+	_spec.From = srq.sql
+	if unique := srq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if srq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := srq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharerefresh.FieldID)
+		for i := range fields {
+			if fields[i] != sharerefresh.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := srq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := srq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (srq *ShareRefreshQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(srq.driver.Dialect())
+	t1 := builder.Table(sharerefresh.Table)
+	columns := srq.ctx.Fields
+	if len(columns) == 0 {
+		columns = sharerefresh.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if srq.sql != nil {
+		selector = srq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if srq.ctx.Unique != nil && *srq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range srq.modifiers {
+		m(selector)
+	}
+	for _, p := range srq.predicates {
+		p(selector)
+	}
+	for _, p := range srq.order {
+		p(selector)
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (srq *ShareRefreshQuery) ForUpdate(opts ...sql.LockOption) *ShareRefreshQuery {
+	if srq.driver.Dialect() == dialect.Postgres {
+		srq.Unique(false)
+	}
+	srq.modifiers = append(srq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return srq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (srq *ShareRefreshQuery) ForShare(opts ...sql.LockOption) *ShareRefreshQuery {
+	if srq.driver.Dialect() == dialect.Postgres {
+		srq.Unique(false)
+	}
+	srq.modifiers = append(srq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return srq
+}
+
+// ShareRefreshGroupBy is the group-by builder for ShareRefresh entities.
+type ShareRefreshGroupBy struct {
+	selector
+	build *ShareRefreshQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (srgb *ShareRefreshGroupBy) Aggregate(fns ...AggregateFunc) *ShareRefreshGroupBy {
+	srgb.fns = append(srgb.fns, fns...)
+	return srgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srgb *ShareRefreshGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srgb.build.ctx, ent.OpQueryGroupBy)
+	if err := srgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShareRefreshQuery, *ShareRefreshGroupBy](ctx, srgb.build, srgb, srgb.build.inters, v)
+}
+
+func (srgb *ShareRefreshGroupBy) sqlScan(ctx context.Context, root *ShareRefreshQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(srgb.fns))
+	for _, fn := range srgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*srgb.flds)+len(srgb.fns))
+		for _, f := range *srgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*srgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShareRefreshSelect is the builder for selecting fields of ShareRefresh entities.
+type ShareRefreshSelect struct {
+	*ShareRefreshQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (srs *ShareRefreshSelect) Aggregate(fns ...AggregateFunc) *ShareRefreshSelect {
+	srs.fns = append(srs.fns, fns...)
+	return srs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srs *ShareRefreshSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srs.ctx, ent.OpQuerySelect)
+	if err := srs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShareRefreshQuery, *ShareRefreshSelect](ctx, srs.ShareRefreshQuery, srs, srs.inters, v)
+}
+
+func (srs *ShareRefreshSelect) sqlScan(ctx context.Context, root *ShareRefreshQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(srs.fns))
+	for _, fn := range srs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*srs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/sharerefresh"
+)
+
+// ShareRefreshUpdate is the builder for updating ShareRefresh entities.
+type ShareRefreshUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareRefreshMutation
+}
+
+// Where appends a list predicates to the ShareRefreshUpdate builder.
+func (sru *ShareRefreshUpdate) Where(ps ...predicate.ShareRefresh) *ShareRefreshUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (sru *ShareRefreshUpdate) SetUpdateTime(t time.Time) *ShareRefreshUpdate {
+	sru.mutation.SetUpdateTime(t)
+	return sru
+}
+
+// SetStatus sets the "status" field.
+func (sru *ShareRefreshUpdate) SetStatus(srs schema.ShareRefreshStatus) *ShareRefreshUpdate {
+	sru.mutation.SetStatus(srs)
+	return sru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sru *ShareRefreshUpdate) SetNillableStatus(srs *schema.ShareRefreshStatus) *ShareRefreshUpdate {
+	if srs != nil {
+		sru.SetStatus(*srs)
+	}
+	return sru
+}
+
+// SetContributions sets the "contributions" field.
+func (sru *ShareRefreshUpdate) SetContributions(u [][]uint8) *ShareRefreshUpdate {
+	sru.mutation.SetContributions(u)
+	return sru
+}
+
+// AppendContributions appends u to the "contributions" field.
+func (sru *ShareRefreshUpdate) AppendContributions(u [][]uint8) *ShareRefreshUpdate {
+	sru.mutation.AppendContributions(u)
+	return sru
+}
+
+// ClearContributions clears the value of the "contributions" field.
+func (sru *ShareRefreshUpdate) ClearContributions() *ShareRefreshUpdate {
+	sru.mutation.ClearContributions()
+	return sru
+}
+
+// Mutation returns the ShareRefreshMutation object of the builder.
+func (sru *ShareRefreshUpdate) Mutation() *ShareRefreshMutation {
+	return sru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *ShareRefreshUpdate) Save(ctx context.Context) (int, error) {
+	sru.defaults()
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *ShareRefreshUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *ShareRefreshUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *ShareRefreshUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sru *ShareRefreshUpdate) defaults() {
+	if _, ok := sru.mutation.UpdateTime(); !ok {
+		v := sharerefresh.UpdateDefaultUpdateTime()
+		sru.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sru *ShareRefreshUpdate) check() error {
+	if v, ok := sru.mutation.Status(); ok {
+		if err := sharerefresh.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ShareRefresh.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sru *ShareRefreshUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharerefresh.Table, sharerefresh.Columns, sqlgraph.NewFieldSpec(sharerefresh.FieldID, field.TypeUUID))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sru.mutation.UpdateTime(); ok {
+		_spec.SetField(sharerefresh.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := sru.mutation.Status(); ok {
+		_spec.SetField(sharerefresh.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := sru.mutation.Contributions(); ok {
+		_spec.SetField(sharerefresh.FieldContributions, field.TypeJSON, value)
+	}
+	if value, ok := sru.mutation.AppendedContributions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sharerefresh.FieldContributions, value)
+		})
+	}
+	if sru.mutation.ContributionsCleared() {
+		_spec.ClearField(sharerefresh.FieldContributions, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharerefresh.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// ShareRefreshUpdateOne is the builder for updating a single ShareRefresh entity.
+type ShareRefreshUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareRefreshMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (sruo *ShareRefreshUpdateOne) SetUpdateTime(t time.Time) *ShareRefreshUpdateOne {
+	sruo.mutation.SetUpdateTime(t)
+	return sruo
+}
+
+// SetStatus sets the "status" field.
+func (sruo *ShareRefreshUpdateOne) SetStatus(srs schema.ShareRefreshStatus) *ShareRefreshUpdateOne {
+	sruo.mutation.SetStatus(srs)
+	return sruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sruo *ShareRefreshUpdateOne) SetNillableStatus(srs *schema.ShareRefreshStatus) *ShareRefreshUpdateOne {
+	if srs != nil {
+		sruo.SetStatus(*srs)
+	}
+	return sruo
+}
+
+// SetContributions sets the "contributions" field.
+func (sruo *ShareRefreshUpdateOne) SetContributions(u [][]uint8) *ShareRefreshUpdateOne {
+	sruo.mutation.SetContributions(u)
+	return sruo
+}
+
+// AppendContributions appends u to the "contributions" field.
+func (sruo *ShareRefreshUpdateOne) AppendContributions(u [][]uint8) *ShareRefreshUpdateOne {
+	sruo.mutation.AppendContributions(u)
+	return sruo
+}
+
+// ClearContributions clears the value of the "contributions" field.
+func (sruo *ShareRefreshUpdateOne) ClearContributions() *ShareRefreshUpdateOne {
+	sruo.mutation.ClearContributions()
+	return sruo
+}
+
+// Mutation returns the ShareRefreshMutation object of the builder.
+func (sruo *ShareRefreshUpdateOne) Mutation() *ShareRefreshMutation {
+	return sruo.mutation
+}
+
+// Where appends a list predicates to the ShareRefreshUpdate builder.
+func (sruo *ShareRefreshUpdateOne) Where(ps ...predicate.ShareRefresh) *ShareRefreshUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *ShareRefreshUpdateOne) Select(field string, fields ...string) *ShareRefreshUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated ShareRefresh entity.
+func (sruo *ShareRefreshUpdateOne) Save(ctx context.Context) (*ShareRefresh, error) {
+	sruo.defaults()
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *ShareRefreshUpdateOne) SaveX(ctx context.Context) *ShareRefresh {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *ShareRefreshUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *ShareRefreshUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sruo *ShareRefreshUpdateOne) defaults() {
+	if _, ok := sruo.mutation.UpdateTime(); !ok {
+		v := sharerefresh.UpdateDefaultUpdateTime()
+		sruo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sruo *ShareRefreshUpdateOne) check() error {
+	if v, ok := sruo.mutation.Status(); ok {
+		if err := sharerefresh.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ShareRefresh.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sruo *ShareRefreshUpdateOne) sqlSave(ctx context.Context) (_node *ShareRefresh, err error) {
+	if err := sruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharerefresh.Table, sharerefresh.Columns, sqlgraph.NewFieldSpec(sharerefresh.FieldID, field.TypeUUID))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareRefresh.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharerefresh.FieldID)
+		for _, f := range fields {
+			if !sharerefresh.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharerefresh.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sruo.mutation.UpdateTime(); ok {
+		_spec.SetField(sharerefresh.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := sruo.mutation.Status(); ok {
+		_spec.SetField(sharerefresh.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := sruo.mutation.Contributions(); ok {
+		_spec.SetField(sharerefresh.FieldContributions, field.TypeJSON, value)
+	}
+	if value, ok := sruo.mutation.AppendedContributions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sharerefresh.FieldContributions, value)
+		})
+	}
+	if sruo.mutation.ContributionsCleared() {
+		_spec.ClearField(sharerefresh.FieldContributions, field.TypeJSON)
+	}
+	_node = &ShareRefresh{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharerefresh.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
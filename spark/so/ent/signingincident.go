@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/signingincident"
+)
+
+// SigningIncident is the model entity for the SigningIncident schema.
+type SigningIncident struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// OperatorIdentifier holds the value of the "operator_identifier" field.
+	OperatorIdentifier string `json:"operator_identifier,omitempty"`
+	// KeyshareID holds the value of the "keyshare_id" field.
+	KeyshareID uuid.UUID `json:"keyshare_id,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID string `json:"job_id,omitempty"`
+	// Message holds the value of the "message" field.
+	Message []byte `json:"message,omitempty"`
+	// VerifyingKey holds the value of the "verifying_key" field.
+	VerifyingKey []byte `json:"verifying_key,omitempty"`
+	// PublicShare holds the value of the "public_share" field.
+	PublicShare []byte `json:"public_share,omitempty"`
+	// SignatureShare holds the value of the "signature_share" field.
+	SignatureShare []byte `json:"signature_share,omitempty"`
+	// Commitments holds the value of the "commitments" field.
+	Commitments map[string][]uint8 `json:"commitments,omitempty"`
+	// UserCommitment holds the value of the "user_commitment" field.
+	UserCommitment []byte `json:"user_commitment,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason       string `json:"reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SigningIncident) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signingincident.FieldMessage, signingincident.FieldVerifyingKey, signingincident.FieldPublicShare, signingincident.FieldSignatureShare, signingincident.FieldCommitments, signingincident.FieldUserCommitment:
+			values[i] = new([]byte)
+		case signingincident.FieldOperatorIdentifier, signingincident.FieldJobID, signingincident.FieldReason:
+			values[i] = new(sql.NullString)
+		case signingincident.FieldCreateTime, signingincident.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case signingincident.FieldID, signingincident.FieldKeyshareID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SigningIncident fields.
+func (si *SigningIncident) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signingincident.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				si.ID = *value
+			}
+		case signingincident.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				si.CreateTime = value.Time
+			}
+		case signingincident.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				si.UpdateTime = value.Time
+			}
+		case signingincident.FieldOperatorIdentifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_identifier", values[i])
+			} else if value.Valid {
+				si.OperatorIdentifier = value.String
+			}
+		case signingincident.FieldKeyshareID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field keyshare_id", values[i])
+			} else if value != nil {
+				si.KeyshareID = *value
+			}
+		case signingincident.FieldJobID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value.Valid {
+				si.JobID = value.String
+			}
+		case signingincident.FieldMessage:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value != nil {
+				si.Message = *value
+			}
+		case signingincident.FieldVerifyingKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field verifying_key", values[i])
+			} else if value != nil {
+				si.VerifyingKey = *value
+			}
+		case signingincident.FieldPublicShare:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_share", values[i])
+			} else if value != nil {
+				si.PublicShare = *value
+			}
+		case signingincident.FieldSignatureShare:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field signature_share", values[i])
+			} else if value != nil {
+				si.SignatureShare = *value
+			}
+		case signingincident.FieldCommitments:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field commitments", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &si.Commitments); err != nil {
+					return fmt.Errorf("unmarshal field commitments: %w", err)
+				}
+			}
+		case signingincident.FieldUserCommitment:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field user_commitment", values[i])
+			} else if value != nil {
+				si.UserCommitment = *value
+			}
+		case signingincident.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				si.Reason = value.String
+			}
+		default:
+			si.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SigningIncident.
+// This includes values selected through modifiers, order, etc.
+func (si *SigningIncident) Value(name string) (ent.Value, error) {
+	return si.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SigningIncident.
+// Note that you need to call SigningIncident.Unwrap() before calling this method if this SigningIncident
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (si *SigningIncident) Update() *SigningIncidentUpdateOne {
+	return NewSigningIncidentClient(si.config).UpdateOne(si)
+}
+
+// Unwrap unwraps the SigningIncident entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (si *SigningIncident) Unwrap() *SigningIncident {
+	_tx, ok := si.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SigningIncident is not a transactional entity")
+	}
+	si.config.driver = _tx.drv
+	return si
+}
+
+// String implements the fmt.Stringer.
+func (si *SigningIncident) String() string {
+	var builder strings.Builder
+	builder.WriteString("SigningIncident(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", si.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(si.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(si.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("operator_identifier=")
+	builder.WriteString(si.OperatorIdentifier)
+	builder.WriteString(", ")
+	builder.WriteString("keyshare_id=")
+	builder.WriteString(fmt.Sprintf("%v", si.KeyshareID))
+	builder.WriteString(", ")
+	builder.WriteString("job_id=")
+	builder.WriteString(si.JobID)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(fmt.Sprintf("%v", si.Message))
+	builder.WriteString(", ")
+	builder.WriteString("verifying_key=")
+	builder.WriteString(fmt.Sprintf("%v", si.VerifyingKey))
+	builder.WriteString(", ")
+	builder.WriteString("public_share=")
+	builder.WriteString(fmt.Sprintf("%v", si.PublicShare))
+	builder.WriteString(", ")
+	builder.WriteString("signature_share=")
+	builder.WriteString(fmt.Sprintf("%v", si.SignatureShare))
+	builder.WriteString(", ")
+	builder.WriteString("commitments=")
+	builder.WriteString(fmt.Sprintf("%v", si.Commitments))
+	builder.WriteString(", ")
+	builder.WriteString("user_commitment=")
+	builder.WriteString(fmt.Sprintf("%v", si.UserCommitment))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(si.Reason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SigningIncidents is a parsable slice of SigningIncident.
+type SigningIncidents []*SigningIncident
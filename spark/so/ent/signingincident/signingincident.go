@@ -0,0 +1,119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package signingincident
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the signingincident type in the database.
+	Label = "signing_incident"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldOperatorIdentifier holds the string denoting the operator_identifier field in the database.
+	FieldOperatorIdentifier = "operator_identifier"
+	// FieldKeyshareID holds the string denoting the keyshare_id field in the database.
+	FieldKeyshareID = "keyshare_id"
+	// FieldJobID holds the string denoting the job_id field in the database.
+	FieldJobID = "job_id"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldVerifyingKey holds the string denoting the verifying_key field in the database.
+	FieldVerifyingKey = "verifying_key"
+	// FieldPublicShare holds the string denoting the public_share field in the database.
+	FieldPublicShare = "public_share"
+	// FieldSignatureShare holds the string denoting the signature_share field in the database.
+	FieldSignatureShare = "signature_share"
+	// FieldCommitments holds the string denoting the commitments field in the database.
+	FieldCommitments = "commitments"
+	// FieldUserCommitment holds the string denoting the user_commitment field in the database.
+	FieldUserCommitment = "user_commitment"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// Table holds the table name of the signingincident in the database.
+	Table = "signing_incidents"
+)
+
+// Columns holds all SQL columns for signingincident fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldOperatorIdentifier,
+	FieldKeyshareID,
+	FieldJobID,
+	FieldMessage,
+	FieldVerifyingKey,
+	FieldPublicShare,
+	FieldSignatureShare,
+	FieldCommitments,
+	FieldUserCommitment,
+	FieldReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SigningIncident queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByOperatorIdentifier orders the results by the operator_identifier field.
+func ByOperatorIdentifier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperatorIdentifier, opts...).ToFunc()
+}
+
+// ByKeyshareID orders the results by the keyshare_id field.
+func ByKeyshareID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyshareID, opts...).ToFunc()
+}
+
+// ByJobID orders the results by the job_id field.
+func ByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
@@ -0,0 +1,651 @@
+// Code generated by ent, DO NOT EDIT.
+
+package signingincident
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// OperatorIdentifier applies equality check predicate on the "operator_identifier" field. It's identical to OperatorIdentifierEQ.
+func OperatorIdentifier(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldOperatorIdentifier, v))
+}
+
+// KeyshareID applies equality check predicate on the "keyshare_id" field. It's identical to KeyshareIDEQ.
+func KeyshareID(v uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldKeyshareID, v))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldJobID, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldMessage, v))
+}
+
+// VerifyingKey applies equality check predicate on the "verifying_key" field. It's identical to VerifyingKeyEQ.
+func VerifyingKey(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldVerifyingKey, v))
+}
+
+// PublicShare applies equality check predicate on the "public_share" field. It's identical to PublicShareEQ.
+func PublicShare(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldPublicShare, v))
+}
+
+// SignatureShare applies equality check predicate on the "signature_share" field. It's identical to SignatureShareEQ.
+func SignatureShare(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldSignatureShare, v))
+}
+
+// UserCommitment applies equality check predicate on the "user_commitment" field. It's identical to UserCommitmentEQ.
+func UserCommitment(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldUserCommitment, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldReason, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// OperatorIdentifierEQ applies the EQ predicate on the "operator_identifier" field.
+func OperatorIdentifierEQ(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldOperatorIdentifier, v))
+}
+
+// OperatorIdentifierNEQ applies the NEQ predicate on the "operator_identifier" field.
+func OperatorIdentifierNEQ(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldOperatorIdentifier, v))
+}
+
+// OperatorIdentifierIn applies the In predicate on the "operator_identifier" field.
+func OperatorIdentifierIn(vs ...string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldOperatorIdentifier, vs...))
+}
+
+// OperatorIdentifierNotIn applies the NotIn predicate on the "operator_identifier" field.
+func OperatorIdentifierNotIn(vs ...string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldOperatorIdentifier, vs...))
+}
+
+// OperatorIdentifierGT applies the GT predicate on the "operator_identifier" field.
+func OperatorIdentifierGT(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldOperatorIdentifier, v))
+}
+
+// OperatorIdentifierGTE applies the GTE predicate on the "operator_identifier" field.
+func OperatorIdentifierGTE(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldOperatorIdentifier, v))
+}
+
+// OperatorIdentifierLT applies the LT predicate on the "operator_identifier" field.
+func OperatorIdentifierLT(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldOperatorIdentifier, v))
+}
+
+// OperatorIdentifierLTE applies the LTE predicate on the "operator_identifier" field.
+func OperatorIdentifierLTE(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldOperatorIdentifier, v))
+}
+
+// OperatorIdentifierContains applies the Contains predicate on the "operator_identifier" field.
+func OperatorIdentifierContains(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldContains(FieldOperatorIdentifier, v))
+}
+
+// OperatorIdentifierHasPrefix applies the HasPrefix predicate on the "operator_identifier" field.
+func OperatorIdentifierHasPrefix(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldHasPrefix(FieldOperatorIdentifier, v))
+}
+
+// OperatorIdentifierHasSuffix applies the HasSuffix predicate on the "operator_identifier" field.
+func OperatorIdentifierHasSuffix(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldHasSuffix(FieldOperatorIdentifier, v))
+}
+
+// OperatorIdentifierEqualFold applies the EqualFold predicate on the "operator_identifier" field.
+func OperatorIdentifierEqualFold(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEqualFold(FieldOperatorIdentifier, v))
+}
+
+// OperatorIdentifierContainsFold applies the ContainsFold predicate on the "operator_identifier" field.
+func OperatorIdentifierContainsFold(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldContainsFold(FieldOperatorIdentifier, v))
+}
+
+// KeyshareIDEQ applies the EQ predicate on the "keyshare_id" field.
+func KeyshareIDEQ(v uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldKeyshareID, v))
+}
+
+// KeyshareIDNEQ applies the NEQ predicate on the "keyshare_id" field.
+func KeyshareIDNEQ(v uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldKeyshareID, v))
+}
+
+// KeyshareIDIn applies the In predicate on the "keyshare_id" field.
+func KeyshareIDIn(vs ...uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldKeyshareID, vs...))
+}
+
+// KeyshareIDNotIn applies the NotIn predicate on the "keyshare_id" field.
+func KeyshareIDNotIn(vs ...uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldKeyshareID, vs...))
+}
+
+// KeyshareIDGT applies the GT predicate on the "keyshare_id" field.
+func KeyshareIDGT(v uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldKeyshareID, v))
+}
+
+// KeyshareIDGTE applies the GTE predicate on the "keyshare_id" field.
+func KeyshareIDGTE(v uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldKeyshareID, v))
+}
+
+// KeyshareIDLT applies the LT predicate on the "keyshare_id" field.
+func KeyshareIDLT(v uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldKeyshareID, v))
+}
+
+// KeyshareIDLTE applies the LTE predicate on the "keyshare_id" field.
+func KeyshareIDLTE(v uuid.UUID) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldKeyshareID, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldJobID, v))
+}
+
+// JobIDContains applies the Contains predicate on the "job_id" field.
+func JobIDContains(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldContains(FieldJobID, v))
+}
+
+// JobIDHasPrefix applies the HasPrefix predicate on the "job_id" field.
+func JobIDHasPrefix(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldHasPrefix(FieldJobID, v))
+}
+
+// JobIDHasSuffix applies the HasSuffix predicate on the "job_id" field.
+func JobIDHasSuffix(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldHasSuffix(FieldJobID, v))
+}
+
+// JobIDEqualFold applies the EqualFold predicate on the "job_id" field.
+func JobIDEqualFold(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEqualFold(FieldJobID, v))
+}
+
+// JobIDContainsFold applies the ContainsFold predicate on the "job_id" field.
+func JobIDContainsFold(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldContainsFold(FieldJobID, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...[]byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...[]byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldMessage, v))
+}
+
+// VerifyingKeyEQ applies the EQ predicate on the "verifying_key" field.
+func VerifyingKeyEQ(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldVerifyingKey, v))
+}
+
+// VerifyingKeyNEQ applies the NEQ predicate on the "verifying_key" field.
+func VerifyingKeyNEQ(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldVerifyingKey, v))
+}
+
+// VerifyingKeyIn applies the In predicate on the "verifying_key" field.
+func VerifyingKeyIn(vs ...[]byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldVerifyingKey, vs...))
+}
+
+// VerifyingKeyNotIn applies the NotIn predicate on the "verifying_key" field.
+func VerifyingKeyNotIn(vs ...[]byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldVerifyingKey, vs...))
+}
+
+// VerifyingKeyGT applies the GT predicate on the "verifying_key" field.
+func VerifyingKeyGT(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldVerifyingKey, v))
+}
+
+// VerifyingKeyGTE applies the GTE predicate on the "verifying_key" field.
+func VerifyingKeyGTE(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldVerifyingKey, v))
+}
+
+// VerifyingKeyLT applies the LT predicate on the "verifying_key" field.
+func VerifyingKeyLT(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldVerifyingKey, v))
+}
+
+// VerifyingKeyLTE applies the LTE predicate on the "verifying_key" field.
+func VerifyingKeyLTE(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldVerifyingKey, v))
+}
+
+// PublicShareEQ applies the EQ predicate on the "public_share" field.
+func PublicShareEQ(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldPublicShare, v))
+}
+
+// PublicShareNEQ applies the NEQ predicate on the "public_share" field.
+func PublicShareNEQ(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldPublicShare, v))
+}
+
+// PublicShareIn applies the In predicate on the "public_share" field.
+func PublicShareIn(vs ...[]byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldPublicShare, vs...))
+}
+
+// PublicShareNotIn applies the NotIn predicate on the "public_share" field.
+func PublicShareNotIn(vs ...[]byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldPublicShare, vs...))
+}
+
+// PublicShareGT applies the GT predicate on the "public_share" field.
+func PublicShareGT(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldPublicShare, v))
+}
+
+// PublicShareGTE applies the GTE predicate on the "public_share" field.
+func PublicShareGTE(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldPublicShare, v))
+}
+
+// PublicShareLT applies the LT predicate on the "public_share" field.
+func PublicShareLT(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldPublicShare, v))
+}
+
+// PublicShareLTE applies the LTE predicate on the "public_share" field.
+func PublicShareLTE(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldPublicShare, v))
+}
+
+// SignatureShareEQ applies the EQ predicate on the "signature_share" field.
+func SignatureShareEQ(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldSignatureShare, v))
+}
+
+// SignatureShareNEQ applies the NEQ predicate on the "signature_share" field.
+func SignatureShareNEQ(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldSignatureShare, v))
+}
+
+// SignatureShareIn applies the In predicate on the "signature_share" field.
+func SignatureShareIn(vs ...[]byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldSignatureShare, vs...))
+}
+
+// SignatureShareNotIn applies the NotIn predicate on the "signature_share" field.
+func SignatureShareNotIn(vs ...[]byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldSignatureShare, vs...))
+}
+
+// SignatureShareGT applies the GT predicate on the "signature_share" field.
+func SignatureShareGT(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldSignatureShare, v))
+}
+
+// SignatureShareGTE applies the GTE predicate on the "signature_share" field.
+func SignatureShareGTE(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldSignatureShare, v))
+}
+
+// SignatureShareLT applies the LT predicate on the "signature_share" field.
+func SignatureShareLT(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldSignatureShare, v))
+}
+
+// SignatureShareLTE applies the LTE predicate on the "signature_share" field.
+func SignatureShareLTE(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldSignatureShare, v))
+}
+
+// UserCommitmentEQ applies the EQ predicate on the "user_commitment" field.
+func UserCommitmentEQ(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldUserCommitment, v))
+}
+
+// UserCommitmentNEQ applies the NEQ predicate on the "user_commitment" field.
+func UserCommitmentNEQ(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldUserCommitment, v))
+}
+
+// UserCommitmentIn applies the In predicate on the "user_commitment" field.
+func UserCommitmentIn(vs ...[]byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldUserCommitment, vs...))
+}
+
+// UserCommitmentNotIn applies the NotIn predicate on the "user_commitment" field.
+func UserCommitmentNotIn(vs ...[]byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldUserCommitment, vs...))
+}
+
+// UserCommitmentGT applies the GT predicate on the "user_commitment" field.
+func UserCommitmentGT(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldUserCommitment, v))
+}
+
+// UserCommitmentGTE applies the GTE predicate on the "user_commitment" field.
+func UserCommitmentGTE(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldUserCommitment, v))
+}
+
+// UserCommitmentLT applies the LT predicate on the "user_commitment" field.
+func UserCommitmentLT(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldUserCommitment, v))
+}
+
+// UserCommitmentLTE applies the LTE predicate on the "user_commitment" field.
+func UserCommitmentLTE(v []byte) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldUserCommitment, v))
+}
+
+// UserCommitmentIsNil applies the IsNil predicate on the "user_commitment" field.
+func UserCommitmentIsNil() predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIsNull(FieldUserCommitment))
+}
+
+// UserCommitmentNotNil applies the NotNil predicate on the "user_commitment" field.
+func UserCommitmentNotNil() predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotNull(FieldUserCommitment))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SigningIncident) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SigningIncident) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SigningIncident) predicate.SigningIncident {
+	return predicate.SigningIncident(sql.NotPredicates(p))
+}
@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/signingincident"
+)
+
+// SigningIncidentCreate is the builder for creating a SigningIncident entity.
+type SigningIncidentCreate struct {
+	config
+	mutation *SigningIncidentMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (sic *SigningIncidentCreate) SetCreateTime(t time.Time) *SigningIncidentCreate {
+	sic.mutation.SetCreateTime(t)
+	return sic
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (sic *SigningIncidentCreate) SetNillableCreateTime(t *time.Time) *SigningIncidentCreate {
+	if t != nil {
+		sic.SetCreateTime(*t)
+	}
+	return sic
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (sic *SigningIncidentCreate) SetUpdateTime(t time.Time) *SigningIncidentCreate {
+	sic.mutation.SetUpdateTime(t)
+	return sic
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (sic *SigningIncidentCreate) SetNillableUpdateTime(t *time.Time) *SigningIncidentCreate {
+	if t != nil {
+		sic.SetUpdateTime(*t)
+	}
+	return sic
+}
+
+// SetOperatorIdentifier sets the "operator_identifier" field.
+func (sic *SigningIncidentCreate) SetOperatorIdentifier(s string) *SigningIncidentCreate {
+	sic.mutation.SetOperatorIdentifier(s)
+	return sic
+}
+
+// SetKeyshareID sets the "keyshare_id" field.
+func (sic *SigningIncidentCreate) SetKeyshareID(u uuid.UUID) *SigningIncidentCreate {
+	sic.mutation.SetKeyshareID(u)
+	return sic
+}
+
+// SetJobID sets the "job_id" field.
+func (sic *SigningIncidentCreate) SetJobID(s string) *SigningIncidentCreate {
+	sic.mutation.SetJobID(s)
+	return sic
+}
+
+// SetMessage sets the "message" field.
+func (sic *SigningIncidentCreate) SetMessage(b []byte) *SigningIncidentCreate {
+	sic.mutation.SetMessage(b)
+	return sic
+}
+
+// SetVerifyingKey sets the "verifying_key" field.
+func (sic *SigningIncidentCreate) SetVerifyingKey(b []byte) *SigningIncidentCreate {
+	sic.mutation.SetVerifyingKey(b)
+	return sic
+}
+
+// SetPublicShare sets the "public_share" field.
+func (sic *SigningIncidentCreate) SetPublicShare(b []byte) *SigningIncidentCreate {
+	sic.mutation.SetPublicShare(b)
+	return sic
+}
+
+// SetSignatureShare sets the "signature_share" field.
+func (sic *SigningIncidentCreate) SetSignatureShare(b []byte) *SigningIncidentCreate {
+	sic.mutation.SetSignatureShare(b)
+	return sic
+}
+
+// SetCommitments sets the "commitments" field.
+func (sic *SigningIncidentCreate) SetCommitments(m map[string][]uint8) *SigningIncidentCreate {
+	sic.mutation.SetCommitments(m)
+	return sic
+}
+
+// SetUserCommitment sets the "user_commitment" field.
+func (sic *SigningIncidentCreate) SetUserCommitment(b []byte) *SigningIncidentCreate {
+	sic.mutation.SetUserCommitment(b)
+	return sic
+}
+
+// SetReason sets the "reason" field.
+func (sic *SigningIncidentCreate) SetReason(s string) *SigningIncidentCreate {
+	sic.mutation.SetReason(s)
+	return sic
+}
+
+// SetID sets the "id" field.
+func (sic *SigningIncidentCreate) SetID(u uuid.UUID) *SigningIncidentCreate {
+	sic.mutation.SetID(u)
+	return sic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sic *SigningIncidentCreate) SetNillableID(u *uuid.UUID) *SigningIncidentCreate {
+	if u != nil {
+		sic.SetID(*u)
+	}
+	return sic
+}
+
+// Mutation returns the SigningIncidentMutation object of the builder.
+func (sic *SigningIncidentCreate) Mutation() *SigningIncidentMutation {
+	return sic.mutation
+}
+
+// Save creates the SigningIncident in the database.
+func (sic *SigningIncidentCreate) Save(ctx context.Context) (*SigningIncident, error) {
+	sic.defaults()
+	return withHooks(ctx, sic.sqlSave, sic.mutation, sic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sic *SigningIncidentCreate) SaveX(ctx context.Context) *SigningIncident {
+	v, err := sic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sic *SigningIncidentCreate) Exec(ctx context.Context) error {
+	_, err := sic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sic *SigningIncidentCreate) ExecX(ctx context.Context) {
+	if err := sic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sic *SigningIncidentCreate) defaults() {
+	if _, ok := sic.mutation.CreateTime(); !ok {
+		v := signingincident.DefaultCreateTime()
+		sic.mutation.SetCreateTime(v)
+	}
+	if _, ok := sic.mutation.UpdateTime(); !ok {
+		v := signingincident.DefaultUpdateTime()
+		sic.mutation.SetUpdateTime(v)
+	}
+	if _, ok := sic.mutation.ID(); !ok {
+		v := signingincident.DefaultID()
+		sic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sic *SigningIncidentCreate) check() error {
+	if _, ok := sic.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "SigningIncident.create_time"`)}
+	}
+	if _, ok := sic.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "SigningIncident.update_time"`)}
+	}
+	if _, ok := sic.mutation.OperatorIdentifier(); !ok {
+		return &ValidationError{Name: "operator_identifier", err: errors.New(`ent: missing required field "SigningIncident.operator_identifier"`)}
+	}
+	if _, ok := sic.mutation.KeyshareID(); !ok {
+		return &ValidationError{Name: "keyshare_id", err: errors.New(`ent: missing required field "SigningIncident.keyshare_id"`)}
+	}
+	if _, ok := sic.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`ent: missing required field "SigningIncident.job_id"`)}
+	}
+	if _, ok := sic.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "SigningIncident.message"`)}
+	}
+	if _, ok := sic.mutation.VerifyingKey(); !ok {
+		return &ValidationError{Name: "verifying_key", err: errors.New(`ent: missing required field "SigningIncident.verifying_key"`)}
+	}
+	if _, ok := sic.mutation.PublicShare(); !ok {
+		return &ValidationError{Name: "public_share", err: errors.New(`ent: missing required field "SigningIncident.public_share"`)}
+	}
+	if _, ok := sic.mutation.SignatureShare(); !ok {
+		return &ValidationError{Name: "signature_share", err: errors.New(`ent: missing required field "SigningIncident.signature_share"`)}
+	}
+	if _, ok := sic.mutation.Commitments(); !ok {
+		return &ValidationError{Name: "commitments", err: errors.New(`ent: missing required field "SigningIncident.commitments"`)}
+	}
+	if _, ok := sic.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "SigningIncident.reason"`)}
+	}
+	return nil
+}
+
+func (sic *SigningIncidentCreate) sqlSave(ctx context.Context) (*SigningIncident, error) {
+	if err := sic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sic.mutation.id = &_node.ID
+	sic.mutation.done = true
+	return _node, nil
+}
+
+func (sic *SigningIncidentCreate) createSpec() (*SigningIncident, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SigningIncident{config: sic.config}
+		_spec = sqlgraph.NewCreateSpec(signingincident.Table, sqlgraph.NewFieldSpec(signingincident.FieldID, field.TypeUUID))
+	)
+	if id, ok := sic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sic.mutation.CreateTime(); ok {
+		_spec.SetField(signingincident.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := sic.mutation.UpdateTime(); ok {
+		_spec.SetField(signingincident.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := sic.mutation.OperatorIdentifier(); ok {
+		_spec.SetField(signingincident.FieldOperatorIdentifier, field.TypeString, value)
+		_node.OperatorIdentifier = value
+	}
+	if value, ok := sic.mutation.KeyshareID(); ok {
+		_spec.SetField(signingincident.FieldKeyshareID, field.TypeUUID, value)
+		_node.KeyshareID = value
+	}
+	if value, ok := sic.mutation.JobID(); ok {
+		_spec.SetField(signingincident.FieldJobID, field.TypeString, value)
+		_node.JobID = value
+	}
+	if value, ok := sic.mutation.Message(); ok {
+		_spec.SetField(signingincident.FieldMessage, field.TypeBytes, value)
+		_node.Message = value
+	}
+	if value, ok := sic.mutation.VerifyingKey(); ok {
+		_spec.SetField(signingincident.FieldVerifyingKey, field.TypeBytes, value)
+		_node.VerifyingKey = value
+	}
+	if value, ok := sic.mutation.PublicShare(); ok {
+		_spec.SetField(signingincident.FieldPublicShare, field.TypeBytes, value)
+		_node.PublicShare = value
+	}
+	if value, ok := sic.mutation.SignatureShare(); ok {
+		_spec.SetField(signingincident.FieldSignatureShare, field.TypeBytes, value)
+		_node.SignatureShare = value
+	}
+	if value, ok := sic.mutation.Commitments(); ok {
+		_spec.SetField(signingincident.FieldCommitments, field.TypeJSON, value)
+		_node.Commitments = value
+	}
+	if value, ok := sic.mutation.UserCommitment(); ok {
+		_spec.SetField(signingincident.FieldUserCommitment, field.TypeBytes, value)
+		_node.UserCommitment = value
+	}
+	if value, ok := sic.mutation.Reason(); ok {
+		_spec.SetField(signingincident.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	return _node, _spec
+}
+
+// SigningIncidentCreateBulk is the builder for creating many SigningIncident entities in bulk.
+type SigningIncidentCreateBulk struct {
+	config
+	err      error
+	builders []*SigningIncidentCreate
+}
+
+// Save creates the SigningIncident entities in the database.
+func (sicb *SigningIncidentCreateBulk) Save(ctx context.Context) ([]*SigningIncident, error) {
+	if sicb.err != nil {
+		return nil, sicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sicb.builders))
+	nodes := make([]*SigningIncident, len(sicb.builders))
+	mutators := make([]Mutator, len(sicb.builders))
+	for i := range sicb.builders {
+		func(i int, root context.Context) {
+			builder := sicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SigningIncidentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sicb *SigningIncidentCreateBulk) SaveX(ctx context.Context) []*SigningIncident {
+	v, err := sicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sicb *SigningIncidentCreateBulk) Exec(ctx context.Context) error {
+	_, err := sicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sicb *SigningIncidentCreateBulk) ExecX(ctx context.Context) {
+	if err := sicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
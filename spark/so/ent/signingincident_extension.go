@@ -0,0 +1,53 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var invalidSignatureSharesCounter metric.Int64Counter
+
+func init() {
+	var err error
+	invalidSignatureSharesCounter, err = otel.Meter("signing_incident").Int64Counter(
+		"spark_invalid_signature_shares",
+		metric.WithDescription("Number of invalid signature shares returned by signing operators"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// RecordSigningIncident stores an invalid signature share returned by an operator, with the
+// evidence in incident, and counts it by operator. The incident is stored outside of the
+// transaction in the context when possible, so that it is kept even if the signing request fails.
+func RecordSigningIncident(ctx context.Context, incident *SigningIncident) (*SigningIncident, error) {
+	invalidSignatureSharesCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("operator", incident.OperatorIdentifier)))
+
+	var create *SigningIncidentCreate
+	if client := GetClientFromContext(ctx); client != nil {
+		create = client.SigningIncident.Create()
+	} else {
+		create = GetDbFromContext(ctx).SigningIncident.Create()
+	}
+	stored, err := create.
+		SetOperatorIdentifier(incident.OperatorIdentifier).
+		SetKeyshareID(incident.KeyshareID).
+		SetJobID(incident.JobID).
+		SetMessage(incident.Message).
+		SetVerifyingKey(incident.VerifyingKey).
+		SetPublicShare(incident.PublicShare).
+		SetSignatureShare(incident.SignatureShare).
+		SetCommitments(incident.Commitments).
+		SetUserCommitment(incident.UserCommitment).
+		SetReason(incident.Reason).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record signing incident for operator %s: %w", incident.OperatorIdentifier, err)
+	}
+	return stored, nil
+}
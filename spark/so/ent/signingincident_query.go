@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/signingincident"
+)
+
+// SigningIncidentQuery is the builder for querying SigningIncident entities.
+type SigningIncidentQuery struct {
+	config
+	ctx        *QueryContext
+	order      []signingincident.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SigningIncident
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SigningIncidentQuery builder.
+func (siq *SigningIncidentQuery) Where(ps ...predicate.SigningIncident) *SigningIncidentQuery {
+	siq.predicates = append(siq.predicates, ps...)
+	return siq
+}
+
+// Limit the number of records to be returned by this query.
+func (siq *SigningIncidentQuery) Limit(limit int) *SigningIncidentQuery {
+	siq.ctx.Limit = &limit
+	return siq
+}
+
+// Offset to start from.
+func (siq *SigningIncidentQuery) Offset(offset int) *SigningIncidentQuery {
+	siq.ctx.Offset = &offset
+	return siq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (siq *SigningIncidentQuery) Unique(unique bool) *SigningIncidentQuery {
+	siq.ctx.Unique = &unique
+	return siq
+}
+
+// Order specifies how the records should be ordered.
+func (siq *SigningIncidentQuery) Order(o ...signingincident.OrderOption) *SigningIncidentQuery {
+	siq.order = append(siq.order, o...)
+	return siq
+}
+
+// First returns the first SigningIncident entity from the query.
+// Returns a *NotFoundError when no SigningIncident was found.
+func (siq *SigningIncidentQuery) First(ctx context.Context) (*SigningIncident, error) {
+	nodes, err := siq.Limit(1).All(setContextOp(ctx, siq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{signingincident.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (siq *SigningIncidentQuery) FirstX(ctx context.Context) *SigningIncident {
+	node, err := siq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SigningIncident ID from the query.
+// Returns a *NotFoundError when no SigningIncident ID was found.
+func (siq *SigningIncidentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = siq.Limit(1).IDs(setContextOp(ctx, siq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{signingincident.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (siq *SigningIncidentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := siq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SigningIncident entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SigningIncident entity is found.
+// Returns a *NotFoundError when no SigningIncident entities are found.
+func (siq *SigningIncidentQuery) Only(ctx context.Context) (*SigningIncident, error) {
+	nodes, err := siq.Limit(2).All(setContextOp(ctx, siq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{signingincident.Label}
+	default:
+		return nil, &NotSingularError{signingincident.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (siq *SigningIncidentQuery) OnlyX(ctx context.Context) *SigningIncident {
+	node, err := siq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SigningIncident ID in the query.
+// Returns a *NotSingularError when more than one SigningIncident ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (siq *SigningIncidentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = siq.Limit(2).IDs(setContextOp(ctx, siq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{signingincident.Label}
+	default:
+		err = &NotSingularError{signingincident.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (siq *SigningIncidentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := siq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SigningIncidents.
+func (siq *SigningIncidentQuery) All(ctx context.Context) ([]*SigningIncident, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryAll)
+	if err := siq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SigningIncident, *SigningIncidentQuery]()
+	return withInterceptors[[]*SigningIncident](ctx, siq, qr, siq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (siq *SigningIncidentQuery) AllX(ctx context.Context) []*SigningIncident {
+	nodes, err := siq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SigningIncident IDs.
+func (siq *SigningIncidentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if siq.ctx.Unique == nil && siq.path != nil {
+		siq.Unique(true)
+	}
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryIDs)
+	if err = siq.Select(signingincident.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (siq *SigningIncidentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := siq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (siq *SigningIncidentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryCount)
+	if err := siq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, siq, querierCount[*SigningIncidentQuery](), siq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (siq *SigningIncidentQuery) CountX(ctx context.Context) int {
+	count, err := siq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (siq *SigningIncidentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryExist)
+	switch _, err := siq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (siq *SigningIncidentQuery) ExistX(ctx context.Context) bool {
+	exist, err := siq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SigningIncidentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (siq *SigningIncidentQuery) Clone() *SigningIncidentQuery {
+	if siq == nil {
+		return nil
+	}
+	return &SigningIncidentQuery{
+		config:     siq.config,
+		ctx:        siq.ctx.Clone(),
+		order:      append([]signingincident.OrderOption{}, siq.order...),
+		inters:     append([]Interceptor{}, siq.inters...),
+		predicates: append([]predicate.SigningIncident{}, siq.predicates...),
+		// clone intermediate query.
+		sql:  siq.sql.Clone(),
+		path: siq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SigningIncident.Query().
+//		GroupBy(signingincident.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (siq *SigningIncidentQuery) GroupBy(field string, fields ...string) *SigningIncidentGroupBy {
+	siq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SigningIncidentGroupBy{build: siq}
+	grbuild.flds = &siq.ctx.Fields
+	grbuild.label = signingincident.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.SigningIncident.Query().
+//		Select(signingincident.FieldCreateTime).
+//		Scan(ctx, &v)
+func (siq *SigningIncidentQuery) Select(fields ...string) *SigningIncidentSelect {
+	siq.ctx.Fields = append(siq.ctx.Fields, fields...)
+	sbuild := &SigningIncidentSelect{SigningIncidentQuery: siq}
+	sbuild.label = signingincident.Label
+	sbuild.flds, sbuild.scan = &siq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SigningIncidentSelect configured with the given aggregations.
+func (siq *SigningIncidentQuery) Aggregate(fns ...AggregateFunc) *SigningIncidentSelect {
+	return siq.Select().Aggregate(fns...)
+}
+
+func (siq *SigningIncidentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range siq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, siq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range siq.ctx.Fields {
+		if !signingincident.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if siq.path != nil {
+		prev, err := siq.path(ctx)
+		if err != nil {
+			return err
+		}
+		siq.sql = prev
+	}
+	return nil
+}
+
+func (siq *SigningIncidentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SigningIncident, error) {
+	var (
+		nodes = []*SigningIncident{}
+		_spec = siq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SigningIncident).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SigningIncident{config: siq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, siq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (siq *SigningIncidentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := siq.querySpec()
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	_spec.Node.Columns = siq.ctx.Fields
+	if len(siq.ctx.Fields) > 0 {
+		_spec.Unique = siq.ctx.Unique != nil && *siq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, siq.driver, _spec)
+}
+
+func (siq *SigningIncidentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(signingincident.Table, signingincident.Columns, sqlgraph.NewFieldSpec(signingincident.FieldID, field.TypeUUID))
+	_spec.From = siq.sql
+	if unique := siq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if siq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := siq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingincident.FieldID)
+		for i := range fields {
+			if fields[i] != signingincident.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := siq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := siq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (siq *SigningIncidentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(siq.driver.Dialect())
+	t1 := builder.Table(signingincident.Table)
+	columns := siq.ctx.Fields
+	if len(columns) == 0 {
+		columns = signingincident.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if siq.sql != nil {
+		selector = siq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if siq.ctx.Unique != nil && *siq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range siq.modifiers {
+		m(selector)
+	}
+	for _, p := range siq.predicates {
+		p(selector)
+	}
+	for _, p := range siq.order {
+		p(selector)
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (siq *SigningIncidentQuery) ForUpdate(opts ...sql.LockOption) *SigningIncidentQuery {
+	if siq.driver.Dialect() == dialect.Postgres {
+		siq.Unique(false)
+	}
+	siq.modifiers = append(siq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return siq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (siq *SigningIncidentQuery) ForShare(opts ...sql.LockOption) *SigningIncidentQuery {
+	if siq.driver.Dialect() == dialect.Postgres {
+		siq.Unique(false)
+	}
+	siq.modifiers = append(siq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return siq
+}
+
+// SigningIncidentGroupBy is the group-by builder for SigningIncident entities.
+type SigningIncidentGroupBy struct {
+	selector
+	build *SigningIncidentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sigb *SigningIncidentGroupBy) Aggregate(fns ...AggregateFunc) *SigningIncidentGroupBy {
+	sigb.fns = append(sigb.fns, fns...)
+	return sigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sigb *SigningIncidentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sigb.build.ctx, ent.OpQueryGroupBy)
+	if err := sigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SigningIncidentQuery, *SigningIncidentGroupBy](ctx, sigb.build, sigb, sigb.build.inters, v)
+}
+
+func (sigb *SigningIncidentGroupBy) sqlScan(ctx context.Context, root *SigningIncidentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sigb.fns))
+	for _, fn := range sigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sigb.flds)+len(sigb.fns))
+		for _, f := range *sigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SigningIncidentSelect is the builder for selecting fields of SigningIncident entities.
+type SigningIncidentSelect struct {
+	*SigningIncidentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sis *SigningIncidentSelect) Aggregate(fns ...AggregateFunc) *SigningIncidentSelect {
+	sis.fns = append(sis.fns, fns...)
+	return sis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sis *SigningIncidentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sis.ctx, ent.OpQuerySelect)
+	if err := sis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SigningIncidentQuery, *SigningIncidentSelect](ctx, sis.SigningIncidentQuery, sis, sis.inters, v)
+}
+
+func (sis *SigningIncidentSelect) sqlScan(ctx context.Context, root *SigningIncidentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sis.fns))
+	for _, fn := range sis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/signingincident"
+)
+
+// SigningIncidentUpdate is the builder for updating SigningIncident entities.
+type SigningIncidentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SigningIncidentMutation
+}
+
+// Where appends a list predicates to the SigningIncidentUpdate builder.
+func (siu *SigningIncidentUpdate) Where(ps ...predicate.SigningIncident) *SigningIncidentUpdate {
+	siu.mutation.Where(ps...)
+	return siu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (siu *SigningIncidentUpdate) SetUpdateTime(t time.Time) *SigningIncidentUpdate {
+	siu.mutation.SetUpdateTime(t)
+	return siu
+}
+
+// Mutation returns the SigningIncidentMutation object of the builder.
+func (siu *SigningIncidentUpdate) Mutation() *SigningIncidentMutation {
+	return siu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (siu *SigningIncidentUpdate) Save(ctx context.Context) (int, error) {
+	siu.defaults()
+	return withHooks(ctx, siu.sqlSave, siu.mutation, siu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siu *SigningIncidentUpdate) SaveX(ctx context.Context) int {
+	affected, err := siu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (siu *SigningIncidentUpdate) Exec(ctx context.Context) error {
+	_, err := siu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siu *SigningIncidentUpdate) ExecX(ctx context.Context) {
+	if err := siu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (siu *SigningIncidentUpdate) defaults() {
+	if _, ok := siu.mutation.UpdateTime(); !ok {
+		v := signingincident.UpdateDefaultUpdateTime()
+		siu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (siu *SigningIncidentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(signingincident.Table, signingincident.Columns, sqlgraph.NewFieldSpec(signingincident.FieldID, field.TypeUUID))
+	if ps := siu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siu.mutation.UpdateTime(); ok {
+		_spec.SetField(signingincident.FieldUpdateTime, field.TypeTime, value)
+	}
+	if siu.mutation.UserCommitmentCleared() {
+		_spec.ClearField(signingincident.FieldUserCommitment, field.TypeBytes)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, siu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingincident.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	siu.mutation.done = true
+	return n, nil
+}
+
+// SigningIncidentUpdateOne is the builder for updating a single SigningIncident entity.
+type SigningIncidentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SigningIncidentMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (siuo *SigningIncidentUpdateOne) SetUpdateTime(t time.Time) *SigningIncidentUpdateOne {
+	siuo.mutation.SetUpdateTime(t)
+	return siuo
+}
+
+// Mutation returns the SigningIncidentMutation object of the builder.
+func (siuo *SigningIncidentUpdateOne) Mutation() *SigningIncidentMutation {
+	return siuo.mutation
+}
+
+// Where appends a list predicates to the SigningIncidentUpdate builder.
+func (siuo *SigningIncidentUpdateOne) Where(ps ...predicate.SigningIncident) *SigningIncidentUpdateOne {
+	siuo.mutation.Where(ps...)
+	return siuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (siuo *SigningIncidentUpdateOne) Select(field string, fields ...string) *SigningIncidentUpdateOne {
+	siuo.fields = append([]string{field}, fields...)
+	return siuo
+}
+
+// Save executes the query and returns the updated SigningIncident entity.
+func (siuo *SigningIncidentUpdateOne) Save(ctx context.Context) (*SigningIncident, error) {
+	siuo.defaults()
+	return withHooks(ctx, siuo.sqlSave, siuo.mutation, siuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siuo *SigningIncidentUpdateOne) SaveX(ctx context.Context) *SigningIncident {
+	node, err := siuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (siuo *SigningIncidentUpdateOne) Exec(ctx context.Context) error {
+	_, err := siuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siuo *SigningIncidentUpdateOne) ExecX(ctx context.Context) {
+	if err := siuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (siuo *SigningIncidentUpdateOne) defaults() {
+	if _, ok := siuo.mutation.UpdateTime(); !ok {
+		v := signingincident.UpdateDefaultUpdateTime()
+		siuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (siuo *SigningIncidentUpdateOne) sqlSave(ctx context.Context) (_node *SigningIncident, err error) {
+	_spec := sqlgraph.NewUpdateSpec(signingincident.Table, signingincident.Columns, sqlgraph.NewFieldSpec(signingincident.FieldID, field.TypeUUID))
+	id, ok := siuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SigningIncident.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := siuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingincident.FieldID)
+		for _, f := range fields {
+			if !signingincident.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signingincident.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := siuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siuo.mutation.UpdateTime(); ok {
+		_spec.SetField(signingincident.FieldUpdateTime, field.TypeTime, value)
+	}
+	if siuo.mutation.UserCommitmentCleared() {
+		_spec.ClearField(signingincident.FieldUserCommitment, field.TypeBytes)
+	}
+	_node = &SigningIncident{config: siuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, siuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingincident.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	siuo.mutation.done = true
+	return _node, nil
+}
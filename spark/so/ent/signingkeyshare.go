@@ -36,6 +36,20 @@ type SigningKeyshare struct {
 	MinSigners int32 `json:"min_signers,omitempty"`
 	// CoordinatorIndex holds the value of the "coordinator_index" field.
 	CoordinatorIndex uint64 `json:"coordinator_index,omitempty"`
+	// EncryptedDataKey holds the value of the "encrypted_data_key" field.
+	EncryptedDataKey []byte `json:"encrypted_data_key,omitempty"`
+	// KeyVersion holds the value of the "key_version" field.
+	KeyVersion string `json:"key_version,omitempty"`
+	// ShareRefreshID holds the value of the "share_refresh_id" field.
+	ShareRefreshID uuid.UUID `json:"share_refresh_id,omitempty"`
+	// ShareRefreshTime holds the value of the "share_refresh_time" field.
+	ShareRefreshTime time.Time `json:"share_refresh_time,omitempty"`
+	// PendingShareRefreshID holds the value of the "pending_share_refresh_id" field.
+	PendingShareRefreshID uuid.UUID `json:"pending_share_refresh_id,omitempty"`
+	// PendingReshareID holds the value of the "pending_reshare_id" field.
+	PendingReshareID uuid.UUID `json:"pending_reshare_id,omitempty"`
+	// OperatorSetEpoch holds the value of the "operator_set_epoch" field.
+	OperatorSetEpoch uint64 `json:"operator_set_epoch,omitempty"`
 	selectValues     sql.SelectValues
 }
 
@@ -44,15 +58,15 @@ func (*SigningKeyshare) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case signingkeyshare.FieldSecretShare, signingkeyshare.FieldPublicShares, signingkeyshare.FieldPublicKey:
+		case signingkeyshare.FieldSecretShare, signingkeyshare.FieldPublicShares, signingkeyshare.FieldPublicKey, signingkeyshare.FieldEncryptedDataKey:
 			values[i] = new([]byte)
-		case signingkeyshare.FieldMinSigners, signingkeyshare.FieldCoordinatorIndex:
+		case signingkeyshare.FieldMinSigners, signingkeyshare.FieldCoordinatorIndex, signingkeyshare.FieldOperatorSetEpoch:
 			values[i] = new(sql.NullInt64)
-		case signingkeyshare.FieldStatus:
+		case signingkeyshare.FieldStatus, signingkeyshare.FieldKeyVersion:
 			values[i] = new(sql.NullString)
-		case signingkeyshare.FieldCreateTime, signingkeyshare.FieldUpdateTime:
+		case signingkeyshare.FieldCreateTime, signingkeyshare.FieldUpdateTime, signingkeyshare.FieldShareRefreshTime:
 			values[i] = new(sql.NullTime)
-		case signingkeyshare.FieldID:
+		case signingkeyshare.FieldID, signingkeyshare.FieldShareRefreshID, signingkeyshare.FieldPendingShareRefreshID, signingkeyshare.FieldPendingReshareID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -125,6 +139,48 @@ func (sk *SigningKeyshare) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sk.CoordinatorIndex = uint64(value.Int64)
 			}
+		case signingkeyshare.FieldEncryptedDataKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_data_key", values[i])
+			} else if value != nil {
+				sk.EncryptedDataKey = *value
+			}
+		case signingkeyshare.FieldKeyVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_version", values[i])
+			} else if value.Valid {
+				sk.KeyVersion = value.String
+			}
+		case signingkeyshare.FieldShareRefreshID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field share_refresh_id", values[i])
+			} else if value != nil {
+				sk.ShareRefreshID = *value
+			}
+		case signingkeyshare.FieldShareRefreshTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field share_refresh_time", values[i])
+			} else if value.Valid {
+				sk.ShareRefreshTime = value.Time
+			}
+		case signingkeyshare.FieldPendingShareRefreshID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_share_refresh_id", values[i])
+			} else if value != nil {
+				sk.PendingShareRefreshID = *value
+			}
+		case signingkeyshare.FieldPendingReshareID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_reshare_id", values[i])
+			} else if value != nil {
+				sk.PendingReshareID = *value
+			}
+		case signingkeyshare.FieldOperatorSetEpoch:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_set_epoch", values[i])
+			} else if value.Valid {
+				sk.OperatorSetEpoch = uint64(value.Int64)
+			}
 		default:
 			sk.selectValues.Set(columns[i], values[i])
 		}
@@ -184,6 +240,27 @@ func (sk *SigningKeyshare) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("coordinator_index=")
 	builder.WriteString(fmt.Sprintf("%v", sk.CoordinatorIndex))
+	builder.WriteString(", ")
+	builder.WriteString("encrypted_data_key=")
+	builder.WriteString(fmt.Sprintf("%v", sk.EncryptedDataKey))
+	builder.WriteString(", ")
+	builder.WriteString("key_version=")
+	builder.WriteString(sk.KeyVersion)
+	builder.WriteString(", ")
+	builder.WriteString("share_refresh_id=")
+	builder.WriteString(fmt.Sprintf("%v", sk.ShareRefreshID))
+	builder.WriteString(", ")
+	builder.WriteString("share_refresh_time=")
+	builder.WriteString(sk.ShareRefreshTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("pending_share_refresh_id=")
+	builder.WriteString(fmt.Sprintf("%v", sk.PendingShareRefreshID))
+	builder.WriteString(", ")
+	builder.WriteString("pending_reshare_id=")
+	builder.WriteString(fmt.Sprintf("%v", sk.PendingReshareID))
+	builder.WriteString(", ")
+	builder.WriteString("operator_set_epoch=")
+	builder.WriteString(fmt.Sprintf("%v", sk.OperatorSetEpoch))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -32,6 +32,20 @@ const (
 	FieldMinSigners = "min_signers"
 	// FieldCoordinatorIndex holds the string denoting the coordinator_index field in the database.
 	FieldCoordinatorIndex = "coordinator_index"
+	// FieldEncryptedDataKey holds the string denoting the encrypted_data_key field in the database.
+	FieldEncryptedDataKey = "encrypted_data_key"
+	// FieldKeyVersion holds the string denoting the key_version field in the database.
+	FieldKeyVersion = "key_version"
+	// FieldShareRefreshID holds the string denoting the share_refresh_id field in the database.
+	FieldShareRefreshID = "share_refresh_id"
+	// FieldShareRefreshTime holds the string denoting the share_refresh_time field in the database.
+	FieldShareRefreshTime = "share_refresh_time"
+	// FieldPendingShareRefreshID holds the string denoting the pending_share_refresh_id field in the database.
+	FieldPendingShareRefreshID = "pending_share_refresh_id"
+	// FieldPendingReshareID holds the string denoting the pending_reshare_id field in the database.
+	FieldPendingReshareID = "pending_reshare_id"
+	// FieldOperatorSetEpoch holds the string denoting the operator_set_epoch field in the database.
+	FieldOperatorSetEpoch = "operator_set_epoch"
 	// Table holds the table name of the signingkeyshare in the database.
 	Table = "signing_keyshares"
 )
@@ -47,6 +61,13 @@ var Columns = []string{
 	FieldPublicKey,
 	FieldMinSigners,
 	FieldCoordinatorIndex,
+	FieldEncryptedDataKey,
+	FieldKeyVersion,
+	FieldShareRefreshID,
+	FieldShareRefreshTime,
+	FieldPendingShareRefreshID,
+	FieldPendingReshareID,
+	FieldOperatorSetEpoch,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -66,6 +87,8 @@ var (
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultOperatorSetEpoch holds the default value on creation for the "operator_set_epoch" field.
+	DefaultOperatorSetEpoch uint64
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -112,3 +135,33 @@ func ByMinSigners(opts ...sql.OrderTermOption) OrderOption {
 func ByCoordinatorIndex(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCoordinatorIndex, opts...).ToFunc()
 }
+
+// ByKeyVersion orders the results by the key_version field.
+func ByKeyVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyVersion, opts...).ToFunc()
+}
+
+// ByShareRefreshID orders the results by the share_refresh_id field.
+func ByShareRefreshID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareRefreshID, opts...).ToFunc()
+}
+
+// ByShareRefreshTime orders the results by the share_refresh_time field.
+func ByShareRefreshTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareRefreshTime, opts...).ToFunc()
+}
+
+// ByPendingShareRefreshID orders the results by the pending_share_refresh_id field.
+func ByPendingShareRefreshID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingShareRefreshID, opts...).ToFunc()
+}
+
+// ByPendingReshareID orders the results by the pending_reshare_id field.
+func ByPendingReshareID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingReshareID, opts...).ToFunc()
+}
+
+// ByOperatorSetEpoch orders the results by the operator_set_epoch field.
+func ByOperatorSetEpoch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperatorSetEpoch, opts...).ToFunc()
+}
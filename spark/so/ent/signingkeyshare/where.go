@@ -86,6 +86,41 @@ func CoordinatorIndex(v uint64) predicate.SigningKeyshare {
 	return predicate.SigningKeyshare(sql.FieldEQ(FieldCoordinatorIndex, v))
 }
 
+// EncryptedDataKey applies equality check predicate on the "encrypted_data_key" field. It's identical to EncryptedDataKeyEQ.
+func EncryptedDataKey(v []byte) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldEncryptedDataKey, v))
+}
+
+// KeyVersion applies equality check predicate on the "key_version" field. It's identical to KeyVersionEQ.
+func KeyVersion(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldKeyVersion, v))
+}
+
+// ShareRefreshID applies equality check predicate on the "share_refresh_id" field. It's identical to ShareRefreshIDEQ.
+func ShareRefreshID(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldShareRefreshID, v))
+}
+
+// ShareRefreshTime applies equality check predicate on the "share_refresh_time" field. It's identical to ShareRefreshTimeEQ.
+func ShareRefreshTime(v time.Time) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldShareRefreshTime, v))
+}
+
+// PendingShareRefreshID applies equality check predicate on the "pending_share_refresh_id" field. It's identical to PendingShareRefreshIDEQ.
+func PendingShareRefreshID(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldPendingShareRefreshID, v))
+}
+
+// PendingReshareID applies equality check predicate on the "pending_reshare_id" field. It's identical to PendingReshareIDEQ.
+func PendingReshareID(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldPendingReshareID, v))
+}
+
+// OperatorSetEpoch applies equality check predicate on the "operator_set_epoch" field. It's identical to OperatorSetEpochEQ.
+func OperatorSetEpoch(v uint64) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldOperatorSetEpoch, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.SigningKeyshare {
 	return predicate.SigningKeyshare(sql.FieldEQ(FieldCreateTime, v))
@@ -356,6 +391,371 @@ func CoordinatorIndexLTE(v uint64) predicate.SigningKeyshare {
 	return predicate.SigningKeyshare(sql.FieldLTE(FieldCoordinatorIndex, v))
 }
 
+// EncryptedDataKeyEQ applies the EQ predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyEQ(v []byte) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyNEQ applies the NEQ predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyNEQ(v []byte) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNEQ(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyIn applies the In predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyIn(vs ...[]byte) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIn(FieldEncryptedDataKey, vs...))
+}
+
+// EncryptedDataKeyNotIn applies the NotIn predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyNotIn(vs ...[]byte) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotIn(FieldEncryptedDataKey, vs...))
+}
+
+// EncryptedDataKeyGT applies the GT predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyGT(v []byte) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGT(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyGTE applies the GTE predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyGTE(v []byte) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGTE(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyLT applies the LT predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyLT(v []byte) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLT(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyLTE applies the LTE predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyLTE(v []byte) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLTE(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyIsNil applies the IsNil predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyIsNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIsNull(FieldEncryptedDataKey))
+}
+
+// EncryptedDataKeyNotNil applies the NotNil predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyNotNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotNull(FieldEncryptedDataKey))
+}
+
+// KeyVersionEQ applies the EQ predicate on the "key_version" field.
+func KeyVersionEQ(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldKeyVersion, v))
+}
+
+// KeyVersionNEQ applies the NEQ predicate on the "key_version" field.
+func KeyVersionNEQ(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNEQ(FieldKeyVersion, v))
+}
+
+// KeyVersionIn applies the In predicate on the "key_version" field.
+func KeyVersionIn(vs ...string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIn(FieldKeyVersion, vs...))
+}
+
+// KeyVersionNotIn applies the NotIn predicate on the "key_version" field.
+func KeyVersionNotIn(vs ...string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotIn(FieldKeyVersion, vs...))
+}
+
+// KeyVersionGT applies the GT predicate on the "key_version" field.
+func KeyVersionGT(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGT(FieldKeyVersion, v))
+}
+
+// KeyVersionGTE applies the GTE predicate on the "key_version" field.
+func KeyVersionGTE(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGTE(FieldKeyVersion, v))
+}
+
+// KeyVersionLT applies the LT predicate on the "key_version" field.
+func KeyVersionLT(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLT(FieldKeyVersion, v))
+}
+
+// KeyVersionLTE applies the LTE predicate on the "key_version" field.
+func KeyVersionLTE(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLTE(FieldKeyVersion, v))
+}
+
+// KeyVersionContains applies the Contains predicate on the "key_version" field.
+func KeyVersionContains(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldContains(FieldKeyVersion, v))
+}
+
+// KeyVersionHasPrefix applies the HasPrefix predicate on the "key_version" field.
+func KeyVersionHasPrefix(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldHasPrefix(FieldKeyVersion, v))
+}
+
+// KeyVersionHasSuffix applies the HasSuffix predicate on the "key_version" field.
+func KeyVersionHasSuffix(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldHasSuffix(FieldKeyVersion, v))
+}
+
+// KeyVersionIsNil applies the IsNil predicate on the "key_version" field.
+func KeyVersionIsNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIsNull(FieldKeyVersion))
+}
+
+// KeyVersionNotNil applies the NotNil predicate on the "key_version" field.
+func KeyVersionNotNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotNull(FieldKeyVersion))
+}
+
+// KeyVersionEqualFold applies the EqualFold predicate on the "key_version" field.
+func KeyVersionEqualFold(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEqualFold(FieldKeyVersion, v))
+}
+
+// KeyVersionContainsFold applies the ContainsFold predicate on the "key_version" field.
+func KeyVersionContainsFold(v string) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldContainsFold(FieldKeyVersion, v))
+}
+
+// ShareRefreshIDEQ applies the EQ predicate on the "share_refresh_id" field.
+func ShareRefreshIDEQ(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldShareRefreshID, v))
+}
+
+// ShareRefreshIDNEQ applies the NEQ predicate on the "share_refresh_id" field.
+func ShareRefreshIDNEQ(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNEQ(FieldShareRefreshID, v))
+}
+
+// ShareRefreshIDIn applies the In predicate on the "share_refresh_id" field.
+func ShareRefreshIDIn(vs ...uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIn(FieldShareRefreshID, vs...))
+}
+
+// ShareRefreshIDNotIn applies the NotIn predicate on the "share_refresh_id" field.
+func ShareRefreshIDNotIn(vs ...uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotIn(FieldShareRefreshID, vs...))
+}
+
+// ShareRefreshIDGT applies the GT predicate on the "share_refresh_id" field.
+func ShareRefreshIDGT(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGT(FieldShareRefreshID, v))
+}
+
+// ShareRefreshIDGTE applies the GTE predicate on the "share_refresh_id" field.
+func ShareRefreshIDGTE(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGTE(FieldShareRefreshID, v))
+}
+
+// ShareRefreshIDLT applies the LT predicate on the "share_refresh_id" field.
+func ShareRefreshIDLT(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLT(FieldShareRefreshID, v))
+}
+
+// ShareRefreshIDLTE applies the LTE predicate on the "share_refresh_id" field.
+func ShareRefreshIDLTE(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLTE(FieldShareRefreshID, v))
+}
+
+// ShareRefreshIDIsNil applies the IsNil predicate on the "share_refresh_id" field.
+func ShareRefreshIDIsNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIsNull(FieldShareRefreshID))
+}
+
+// ShareRefreshIDNotNil applies the NotNil predicate on the "share_refresh_id" field.
+func ShareRefreshIDNotNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotNull(FieldShareRefreshID))
+}
+
+// ShareRefreshTimeEQ applies the EQ predicate on the "share_refresh_time" field.
+func ShareRefreshTimeEQ(v time.Time) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldShareRefreshTime, v))
+}
+
+// ShareRefreshTimeNEQ applies the NEQ predicate on the "share_refresh_time" field.
+func ShareRefreshTimeNEQ(v time.Time) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNEQ(FieldShareRefreshTime, v))
+}
+
+// ShareRefreshTimeIn applies the In predicate on the "share_refresh_time" field.
+func ShareRefreshTimeIn(vs ...time.Time) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIn(FieldShareRefreshTime, vs...))
+}
+
+// ShareRefreshTimeNotIn applies the NotIn predicate on the "share_refresh_time" field.
+func ShareRefreshTimeNotIn(vs ...time.Time) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotIn(FieldShareRefreshTime, vs...))
+}
+
+// ShareRefreshTimeGT applies the GT predicate on the "share_refresh_time" field.
+func ShareRefreshTimeGT(v time.Time) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGT(FieldShareRefreshTime, v))
+}
+
+// ShareRefreshTimeGTE applies the GTE predicate on the "share_refresh_time" field.
+func ShareRefreshTimeGTE(v time.Time) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGTE(FieldShareRefreshTime, v))
+}
+
+// ShareRefreshTimeLT applies the LT predicate on the "share_refresh_time" field.
+func ShareRefreshTimeLT(v time.Time) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLT(FieldShareRefreshTime, v))
+}
+
+// ShareRefreshTimeLTE applies the LTE predicate on the "share_refresh_time" field.
+func ShareRefreshTimeLTE(v time.Time) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLTE(FieldShareRefreshTime, v))
+}
+
+// ShareRefreshTimeIsNil applies the IsNil predicate on the "share_refresh_time" field.
+func ShareRefreshTimeIsNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIsNull(FieldShareRefreshTime))
+}
+
+// ShareRefreshTimeNotNil applies the NotNil predicate on the "share_refresh_time" field.
+func ShareRefreshTimeNotNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotNull(FieldShareRefreshTime))
+}
+
+// PendingShareRefreshIDEQ applies the EQ predicate on the "pending_share_refresh_id" field.
+func PendingShareRefreshIDEQ(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldPendingShareRefreshID, v))
+}
+
+// PendingShareRefreshIDNEQ applies the NEQ predicate on the "pending_share_refresh_id" field.
+func PendingShareRefreshIDNEQ(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNEQ(FieldPendingShareRefreshID, v))
+}
+
+// PendingShareRefreshIDIn applies the In predicate on the "pending_share_refresh_id" field.
+func PendingShareRefreshIDIn(vs ...uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIn(FieldPendingShareRefreshID, vs...))
+}
+
+// PendingShareRefreshIDNotIn applies the NotIn predicate on the "pending_share_refresh_id" field.
+func PendingShareRefreshIDNotIn(vs ...uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotIn(FieldPendingShareRefreshID, vs...))
+}
+
+// PendingShareRefreshIDGT applies the GT predicate on the "pending_share_refresh_id" field.
+func PendingShareRefreshIDGT(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGT(FieldPendingShareRefreshID, v))
+}
+
+// PendingShareRefreshIDGTE applies the GTE predicate on the "pending_share_refresh_id" field.
+func PendingShareRefreshIDGTE(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGTE(FieldPendingShareRefreshID, v))
+}
+
+// PendingShareRefreshIDLT applies the LT predicate on the "pending_share_refresh_id" field.
+func PendingShareRefreshIDLT(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLT(FieldPendingShareRefreshID, v))
+}
+
+// PendingShareRefreshIDLTE applies the LTE predicate on the "pending_share_refresh_id" field.
+func PendingShareRefreshIDLTE(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLTE(FieldPendingShareRefreshID, v))
+}
+
+// PendingShareRefreshIDIsNil applies the IsNil predicate on the "pending_share_refresh_id" field.
+func PendingShareRefreshIDIsNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIsNull(FieldPendingShareRefreshID))
+}
+
+// PendingShareRefreshIDNotNil applies the NotNil predicate on the "pending_share_refresh_id" field.
+func PendingShareRefreshIDNotNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotNull(FieldPendingShareRefreshID))
+}
+
+// PendingReshareIDEQ applies the EQ predicate on the "pending_reshare_id" field.
+func PendingReshareIDEQ(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldPendingReshareID, v))
+}
+
+// PendingReshareIDNEQ applies the NEQ predicate on the "pending_reshare_id" field.
+func PendingReshareIDNEQ(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNEQ(FieldPendingReshareID, v))
+}
+
+// PendingReshareIDIn applies the In predicate on the "pending_reshare_id" field.
+func PendingReshareIDIn(vs ...uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIn(FieldPendingReshareID, vs...))
+}
+
+// PendingReshareIDNotIn applies the NotIn predicate on the "pending_reshare_id" field.
+func PendingReshareIDNotIn(vs ...uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotIn(FieldPendingReshareID, vs...))
+}
+
+// PendingReshareIDGT applies the GT predicate on the "pending_reshare_id" field.
+func PendingReshareIDGT(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGT(FieldPendingReshareID, v))
+}
+
+// PendingReshareIDGTE applies the GTE predicate on the "pending_reshare_id" field.
+func PendingReshareIDGTE(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGTE(FieldPendingReshareID, v))
+}
+
+// PendingReshareIDLT applies the LT predicate on the "pending_reshare_id" field.
+func PendingReshareIDLT(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLT(FieldPendingReshareID, v))
+}
+
+// PendingReshareIDLTE applies the LTE predicate on the "pending_reshare_id" field.
+func PendingReshareIDLTE(v uuid.UUID) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLTE(FieldPendingReshareID, v))
+}
+
+// PendingReshareIDIsNil applies the IsNil predicate on the "pending_reshare_id" field.
+func PendingReshareIDIsNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIsNull(FieldPendingReshareID))
+}
+
+// PendingReshareIDNotNil applies the NotNil predicate on the "pending_reshare_id" field.
+func PendingReshareIDNotNil() predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotNull(FieldPendingReshareID))
+}
+
+// OperatorSetEpochEQ applies the EQ predicate on the "operator_set_epoch" field.
+func OperatorSetEpochEQ(v uint64) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldEQ(FieldOperatorSetEpoch, v))
+}
+
+// OperatorSetEpochNEQ applies the NEQ predicate on the "operator_set_epoch" field.
+func OperatorSetEpochNEQ(v uint64) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNEQ(FieldOperatorSetEpoch, v))
+}
+
+// OperatorSetEpochIn applies the In predicate on the "operator_set_epoch" field.
+func OperatorSetEpochIn(vs ...uint64) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldIn(FieldOperatorSetEpoch, vs...))
+}
+
+// OperatorSetEpochNotIn applies the NotIn predicate on the "operator_set_epoch" field.
+func OperatorSetEpochNotIn(vs ...uint64) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldNotIn(FieldOperatorSetEpoch, vs...))
+}
+
+// OperatorSetEpochGT applies the GT predicate on the "operator_set_epoch" field.
+func OperatorSetEpochGT(v uint64) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGT(FieldOperatorSetEpoch, v))
+}
+
+// OperatorSetEpochGTE applies the GTE predicate on the "operator_set_epoch" field.
+func OperatorSetEpochGTE(v uint64) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldGTE(FieldOperatorSetEpoch, v))
+}
+
+// OperatorSetEpochLT applies the LT predicate on the "operator_set_epoch" field.
+func OperatorSetEpochLT(v uint64) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLT(FieldOperatorSetEpoch, v))
+}
+
+// OperatorSetEpochLTE applies the LTE predicate on the "operator_set_epoch" field.
+func OperatorSetEpochLTE(v uint64) predicate.SigningKeyshare {
+	return predicate.SigningKeyshare(sql.FieldLTE(FieldOperatorSetEpoch, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SigningKeyshare) predicate.SigningKeyshare {
 	return predicate.SigningKeyshare(sql.AndPredicates(predicates...))
@@ -86,6 +86,96 @@ func (skc *SigningKeyshareCreate) SetCoordinatorIndex(u uint64) *SigningKeyshare
 	return skc
 }
 
+// SetEncryptedDataKey sets the "encrypted_data_key" field.
+func (skc *SigningKeyshareCreate) SetEncryptedDataKey(b []byte) *SigningKeyshareCreate {
+	skc.mutation.SetEncryptedDataKey(b)
+	return skc
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (skc *SigningKeyshareCreate) SetKeyVersion(s string) *SigningKeyshareCreate {
+	skc.mutation.SetKeyVersion(s)
+	return skc
+}
+
+// SetNillableKeyVersion sets the "key_version" field if the given value is not nil.
+func (skc *SigningKeyshareCreate) SetNillableKeyVersion(s *string) *SigningKeyshareCreate {
+	if s != nil {
+		skc.SetKeyVersion(*s)
+	}
+	return skc
+}
+
+// SetShareRefreshID sets the "share_refresh_id" field.
+func (skc *SigningKeyshareCreate) SetShareRefreshID(u uuid.UUID) *SigningKeyshareCreate {
+	skc.mutation.SetShareRefreshID(u)
+	return skc
+}
+
+// SetNillableShareRefreshID sets the "share_refresh_id" field if the given value is not nil.
+func (skc *SigningKeyshareCreate) SetNillableShareRefreshID(u *uuid.UUID) *SigningKeyshareCreate {
+	if u != nil {
+		skc.SetShareRefreshID(*u)
+	}
+	return skc
+}
+
+// SetShareRefreshTime sets the "share_refresh_time" field.
+func (skc *SigningKeyshareCreate) SetShareRefreshTime(t time.Time) *SigningKeyshareCreate {
+	skc.mutation.SetShareRefreshTime(t)
+	return skc
+}
+
+// SetNillableShareRefreshTime sets the "share_refresh_time" field if the given value is not nil.
+func (skc *SigningKeyshareCreate) SetNillableShareRefreshTime(t *time.Time) *SigningKeyshareCreate {
+	if t != nil {
+		skc.SetShareRefreshTime(*t)
+	}
+	return skc
+}
+
+// SetPendingShareRefreshID sets the "pending_share_refresh_id" field.
+func (skc *SigningKeyshareCreate) SetPendingShareRefreshID(u uuid.UUID) *SigningKeyshareCreate {
+	skc.mutation.SetPendingShareRefreshID(u)
+	return skc
+}
+
+// SetNillablePendingShareRefreshID sets the "pending_share_refresh_id" field if the given value is not nil.
+func (skc *SigningKeyshareCreate) SetNillablePendingShareRefreshID(u *uuid.UUID) *SigningKeyshareCreate {
+	if u != nil {
+		skc.SetPendingShareRefreshID(*u)
+	}
+	return skc
+}
+
+// SetPendingReshareID sets the "pending_reshare_id" field.
+func (skc *SigningKeyshareCreate) SetPendingReshareID(u uuid.UUID) *SigningKeyshareCreate {
+	skc.mutation.SetPendingReshareID(u)
+	return skc
+}
+
+// SetNillablePendingReshareID sets the "pending_reshare_id" field if the given value is not nil.
+func (skc *SigningKeyshareCreate) SetNillablePendingReshareID(u *uuid.UUID) *SigningKeyshareCreate {
+	if u != nil {
+		skc.SetPendingReshareID(*u)
+	}
+	return skc
+}
+
+// SetOperatorSetEpoch sets the "operator_set_epoch" field.
+func (skc *SigningKeyshareCreate) SetOperatorSetEpoch(u uint64) *SigningKeyshareCreate {
+	skc.mutation.SetOperatorSetEpoch(u)
+	return skc
+}
+
+// SetNillableOperatorSetEpoch sets the "operator_set_epoch" field if the given value is not nil.
+func (skc *SigningKeyshareCreate) SetNillableOperatorSetEpoch(u *uint64) *SigningKeyshareCreate {
+	if u != nil {
+		skc.SetOperatorSetEpoch(*u)
+	}
+	return skc
+}
+
 // SetID sets the "id" field.
 func (skc *SigningKeyshareCreate) SetID(u uuid.UUID) *SigningKeyshareCreate {
 	skc.mutation.SetID(u)
@@ -143,6 +233,10 @@ func (skc *SigningKeyshareCreate) defaults() {
 		v := signingkeyshare.DefaultUpdateTime()
 		skc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := skc.mutation.OperatorSetEpoch(); !ok {
+		v := signingkeyshare.DefaultOperatorSetEpoch
+		skc.mutation.SetOperatorSetEpoch(v)
+	}
 	if _, ok := skc.mutation.ID(); !ok {
 		v := signingkeyshare.DefaultID()
 		skc.mutation.SetID(v)
@@ -180,6 +274,9 @@ func (skc *SigningKeyshareCreate) check() error {
 	if _, ok := skc.mutation.CoordinatorIndex(); !ok {
 		return &ValidationError{Name: "coordinator_index", err: errors.New(`ent: missing required field "SigningKeyshare.coordinator_index"`)}
 	}
+	if _, ok := skc.mutation.OperatorSetEpoch(); !ok {
+		return &ValidationError{Name: "operator_set_epoch", err: errors.New(`ent: missing required field "SigningKeyshare.operator_set_epoch"`)}
+	}
 	return nil
 }
 
@@ -247,6 +344,34 @@ func (skc *SigningKeyshareCreate) createSpec() (*SigningKeyshare, *sqlgraph.Crea
 		_spec.SetField(signingkeyshare.FieldCoordinatorIndex, field.TypeUint64, value)
 		_node.CoordinatorIndex = value
 	}
+	if value, ok := skc.mutation.EncryptedDataKey(); ok {
+		_spec.SetField(signingkeyshare.FieldEncryptedDataKey, field.TypeBytes, value)
+		_node.EncryptedDataKey = value
+	}
+	if value, ok := skc.mutation.KeyVersion(); ok {
+		_spec.SetField(signingkeyshare.FieldKeyVersion, field.TypeString, value)
+		_node.KeyVersion = value
+	}
+	if value, ok := skc.mutation.ShareRefreshID(); ok {
+		_spec.SetField(signingkeyshare.FieldShareRefreshID, field.TypeUUID, value)
+		_node.ShareRefreshID = value
+	}
+	if value, ok := skc.mutation.ShareRefreshTime(); ok {
+		_spec.SetField(signingkeyshare.FieldShareRefreshTime, field.TypeTime, value)
+		_node.ShareRefreshTime = value
+	}
+	if value, ok := skc.mutation.PendingShareRefreshID(); ok {
+		_spec.SetField(signingkeyshare.FieldPendingShareRefreshID, field.TypeUUID, value)
+		_node.PendingShareRefreshID = value
+	}
+	if value, ok := skc.mutation.PendingReshareID(); ok {
+		_spec.SetField(signingkeyshare.FieldPendingReshareID, field.TypeUUID, value)
+		_node.PendingReshareID = value
+	}
+	if value, ok := skc.mutation.OperatorSetEpoch(); ok {
+		_spec.SetField(signingkeyshare.FieldOperatorSetEpoch, field.TypeUint64, value)
+		_node.OperatorSetEpoch = value
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/decred/dcrd/dcrec/secp256k1"
 	"github.com/google/uuid"
@@ -16,8 +18,69 @@ import (
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/ent/schema"
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
+	"github.com/lightsparkdev/spark/so/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
+var (
+	keysharePoolMeter = otel.Meter("keyshare_pool")
+
+	keysharePoolAvailableGauge metric.Int64Gauge
+	keysharesGauge             metric.Int64Gauge
+	keysharePoolDrainRateGauge metric.Float64Gauge
+	keysharePoolDrainAlerts    metric.Int64Counter
+	keysharesConsumedCounter   metric.Int64Counter
+	keysharesRecycledCounter   metric.Int64Counter
+)
+
+func init() {
+	var err error
+	keysharePoolAvailableGauge, err = keysharePoolMeter.Int64Gauge(
+		"spark_keyshare_pool_available",
+		metric.WithDescription("Number of available keyshares this operator coordinates"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	keysharesGauge, err = keysharePoolMeter.Int64Gauge(
+		"spark_keyshares",
+		metric.WithDescription("Number of signing keyshares held by this operator, by status"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	keysharePoolDrainRateGauge, err = keysharePoolMeter.Float64Gauge(
+		"spark_keyshare_pool_drain_rate",
+		metric.WithDescription("Net number of keyshares taken from the pool per hour, after DKG refills"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	keysharePoolDrainAlerts, err = keysharePoolMeter.Int64Counter(
+		"spark_keyshare_pool_drain_alerts",
+		metric.WithDescription("Number of times the keyshare pool was found on course to run out"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	keysharesConsumedCounter, err = keysharePoolMeter.Int64Counter(
+		"spark_keyshares_consumed",
+		metric.WithDescription("Number of keyshares taken from the pool for new addresses"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	keysharesRecycledCounter, err = keysharePoolMeter.Int64Counter(
+		"spark_keyshares_recycled",
+		metric.WithDescription("Number of keyshares of expired deposit addresses returned to the pool"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
 // TweakKeyShare tweaks the given keyshare with the given tweak, updates the keyshare in the database and returns the updated keyshare.
 func (keyshare *SigningKeyshare) TweakKeyShare(ctx context.Context, shareTweak []byte, pubkeyTweak []byte, pubkeySharesTweak map[string][]byte) (*SigningKeyshare, error) {
 	ctx, span := tracer.Start(ctx, "SigningKeyshare.TweakKeyShare")
@@ -79,6 +142,7 @@ func GetUnusedSigningKeyshares(ctx context.Context, dbClient *Client, config *so
 	signingKeyshares, err := tx.SigningKeyshare.Query().Where(
 		signingkeyshare.StatusEQ(schema.KeyshareStatusAvailable),
 		signingkeyshare.CoordinatorIndexEQ(config.Index),
+		signingkeyshare.OperatorSetEpochEQ(config.OperatorSetEpoch),
 		signingkeyshare.IDGT(uuid.MustParse("01954639-8d50-7e47-b3f0-ddb307fab7c2")),
 	).
 		Limit(keyshareCount).
@@ -97,7 +161,7 @@ func GetUnusedSigningKeyshares(ctx context.Context, dbClient *Client, config *so
 			logger.Error("Failed to rollback transaction", "error", rollbackErr)
 		}
 
-		return nil, fmt.Errorf("not enough signing keyshares available (needed %d, got %d)", keyshareCount, len(signingKeyshares))
+		return nil, errors.Reasonf(errors.ReasonKeysharePoolEmpty, "not enough signing keyshares available (needed %d, got %d)", keyshareCount, len(signingKeyshares))
 	}
 
 	for _, keyshare := range signingKeyshares {
@@ -117,6 +181,7 @@ func GetUnusedSigningKeyshares(ctx context.Context, dbClient *Client, config *so
 	if err != nil {
 		return nil, err
 	}
+	keysharesConsumedCounter.Add(ctx, int64(len(signingKeyshares)))
 
 	return signingKeyshares, nil
 }
@@ -250,6 +315,9 @@ func sumOfSigningKeyshares(keyshares []*SigningKeyshare) (*SigningKeyshare, erro
 		if i == 0 {
 			continue
 		}
+		if keyshare.OperatorSetEpoch != resultKeyshares.OperatorSetEpoch {
+			return nil, fmt.Errorf("keyshares %s and %s belong to different operator sets", resultKeyshares.ID, keyshare.ID)
+		}
 		sum, err := common.AddPrivateKeys(resultKeyshares.SecretShare, keyshare.SecretShare)
 		if err != nil {
 			return nil, err
@@ -340,6 +408,7 @@ func CalculateAndStoreLastKey(ctx context.Context, _ *so.Config, target *Signing
 		SetStatus(schema.KeyshareStatusInUse).
 		SetCoordinatorIndex(0).
 		SetMinSigners(target.MinSigners).
+		SetOperatorSetEpoch(target.OperatorSetEpoch).
 		Save(ctx)
 	if err != nil {
 		return nil, err
@@ -376,22 +445,59 @@ func RunDKGIfNeeded(ctx context.Context, db *Client, config *so.Config) error {
 	ctx, span := tracer.Start(ctx, "SigningKeyshare.RunDKGIfNeeded")
 	defer span.End()
 
-	count, err := db.SigningKeyshare.Query().Where(
+	// An operator that is being removed from the operator set does not generate new keyshares.
+	if !config.InOperatorSet() {
+		return nil
+	}
+
+	count, err := CountAvailableSigningKeyshares(ctx, db, config)
+	if err != nil {
+		return err
+	}
+	if uint64(count) >= MinAvailableSigningKeyshares(config) {
+		return nil
+	}
+
+	return RunDKG(ctx, config)
+}
+
+// CountAvailableSigningKeyshares counts the available keyshares this operator coordinated, which
+// is the pool RunDKGIfNeeded keeps filled.
+func CountAvailableSigningKeyshares(ctx context.Context, db *Client, config *so.Config) (int, error) {
+	return db.SigningKeyshare.Query().Where(
 		signingkeyshare.StatusEQ(schema.KeyshareStatusAvailable),
 		signingkeyshare.CoordinatorIndexEQ(config.Index),
+		signingkeyshare.OperatorSetEpochEQ(config.OperatorSetEpoch),
 		signingkeyshare.IDGT(uuid.MustParse("01954639-8d50-7e47-b3f0-ddb307fab7c2")),
 	).Count(ctx)
-	if err != nil {
-		return err
+}
+
+// CountSigningKeysharesByStatus counts all the keyshares this operator holds, by status.
+func CountSigningKeysharesByStatus(ctx context.Context, db *Client) (map[schema.SigningKeyshareStatus]int, error) {
+	var statusCounts []struct {
+		Status schema.SigningKeyshareStatus `json:"status"`
+		Count  int                          `json:"count"`
 	}
-	if config.DKGLimitOverride > 0 && uint64(count) >= config.DKGLimitOverride {
-		return nil
+	err := db.SigningKeyshare.Query().
+		GroupBy(signingkeyshare.FieldStatus).
+		Aggregate(Count()).
+		Scan(ctx, &statusCounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count keyshares: %w", err)
 	}
-	if uint64(count) >= spark.DKGKeyThreshold {
-		return nil
+	countByStatus := make(map[schema.SigningKeyshareStatus]int, len(statusCounts))
+	for _, c := range statusCounts {
+		countByStatus[c.Status] = c.Count
 	}
+	return countByStatus, nil
+}
 
-	return RunDKG(ctx, config)
+// MinAvailableSigningKeyshares is the pool size below which RunDKGIfNeeded runs a new DKG round.
+func MinAvailableSigningKeyshares(config *so.Config) uint64 {
+	if config.DKGLimitOverride > 0 {
+		return min(config.DKGLimitOverride, spark.DKGKeyThreshold)
+	}
+	return spark.DKGKeyThreshold
 }
 
 func RunDKG(ctx context.Context, config *so.Config) error {
@@ -400,11 +506,7 @@ func RunDKG(ctx context.Context, config *so.Config) error {
 
 	logger := logging.GetLoggerFromContext(ctx)
 
-	connection, err := common.NewGRPCConnection(
-		config.DKGCoordinatorAddress,
-		config.SigningOperatorMap[config.Identifier].CertPath,
-		nil,
-	)
+	connection, err := config.NewDKGCoordinatorConnection()
 	if err != nil {
 		logger.Error("Failed to create connection to DKG coordinator", "error", err)
 		return err
@@ -422,3 +524,135 @@ func RunDKG(ctx context.Context, config *so.Config) error {
 
 	return nil
 }
+
+// RunShareRefresh asks the DKG coordinator to refresh a batch of the keyshares it coordinates, and
+// returns the number of keyshares refreshed.
+func RunShareRefresh(ctx context.Context, config *so.Config) (int, error) {
+	ctx, span := tracer.Start(ctx, "SigningKeyshare.RunShareRefresh")
+	defer span.End()
+
+	if !config.InOperatorSet() {
+		return 0, nil
+	}
+
+	connection, err := config.NewDKGCoordinatorConnection()
+	if err != nil {
+		return 0, fmt.Errorf("failed to create connection to DKG coordinator: %w", err)
+	}
+	defer connection.Close()
+	client := pbdkg.NewDKGServiceClient(connection)
+
+	resp, err := client.StartShareRefresh(ctx, &pbdkg.StartShareRefreshRequest{
+		BatchSize: spark.ShareRefreshBatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh shares: %w", err)
+	}
+	return int(resp.RefreshedCount), nil
+}
+
+// RunReshare asks the DKG coordinator to reshare a batch of the keyshares it coordinates from the
+// previous operator set to the current one, and returns the number of keyshares reshared. It does
+// nothing if no resharing is in progress.
+func RunReshare(ctx context.Context, config *so.Config) (int, error) {
+	ctx, span := tracer.Start(ctx, "SigningKeyshare.RunReshare")
+	defer span.End()
+
+	if config.PreviousOperatorSet == nil {
+		return 0, nil
+	}
+
+	connection, err := config.NewDKGCoordinatorConnection()
+	if err != nil {
+		return 0, fmt.Errorf("failed to create connection to DKG coordinator: %w", err)
+	}
+	defer connection.Close()
+	client := pbdkg.NewDKGServiceClient(connection)
+
+	resp, err := client.StartReshare(ctx, &pbdkg.StartReshareRequest{
+		BatchSize: spark.ReshareBatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to reshare keyshares: %w", err)
+	}
+	return int(resp.ResharedCount), nil
+}
+
+// KeysharePoolMonitor samples the pool of available keyshares, to alert when it drains faster than
+// DKG refills it.
+type KeysharePoolMonitor struct {
+	mu      sync.Mutex
+	samples []keysharePoolSample
+}
+
+type keysharePoolSample struct {
+	time      time.Time
+	available int
+}
+
+// NewKeysharePoolMonitor creates a new KeysharePoolMonitor.
+func NewKeysharePoolMonitor() *KeysharePoolMonitor {
+	return &KeysharePoolMonitor{}
+}
+
+// Check samples the pool of available keyshares this operator coordinates, and raises an alert if
+// at the rate it drained over the last spark.KeysharePoolDrainWindow it runs out within
+// spark.KeysharePoolExhaustionAlertHorizon.
+func (m *KeysharePoolMonitor) Check(ctx context.Context, db *Client, config *so.Config) error {
+	if !config.InOperatorSet() {
+		return nil
+	}
+
+	countByStatus, err := CountSigningKeysharesByStatus(ctx, db)
+	if err != nil {
+		return err
+	}
+	for _, status := range schema.SigningKeyshareStatus("").Values() {
+		keysharesGauge.Record(ctx, int64(countByStatus[schema.SigningKeyshareStatus(status)]),
+			metric.WithAttributes(attribute.String("status", status)))
+	}
+
+	available, err := CountAvailableSigningKeyshares(ctx, db, config)
+	if err != nil {
+		return err
+	}
+	keysharePoolAvailableGauge.Record(ctx, int64(available))
+
+	drainRate, ok := m.observe(time.Now(), available)
+	if !ok {
+		return nil
+	}
+	keysharePoolDrainRateGauge.Record(ctx, drainRate*time.Hour.Seconds())
+	if drainRate <= 0 {
+		return nil
+	}
+	timeToExhaustion := time.Duration(float64(available) / drainRate * float64(time.Second))
+	if timeToExhaustion < spark.KeysharePoolExhaustionAlertHorizon {
+		keysharePoolDrainAlerts.Add(ctx, 1)
+		logging.GetLoggerFromContext(ctx).Error("Keyshare pool is draining faster than DKG refills it",
+			"available", available,
+			"drain_per_hour", drainRate*time.Hour.Seconds(),
+			"time_to_exhaustion", timeToExhaustion.String())
+	}
+	return nil
+}
+
+// observe records a sample of the pool, and returns the net number of keyshares taken from the
+// pool per second over the samples of the last spark.KeysharePoolDrainWindow. It returns false
+// until the samples span at least half of the window.
+func (m *KeysharePoolMonitor) observe(now time.Time, available int) (float64, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.samples = append(m.samples, keysharePoolSample{time: now, available: available})
+	for len(m.samples) > 0 && now.Sub(m.samples[0].time) > spark.KeysharePoolDrainWindow {
+		m.samples = m.samples[1:]
+	}
+
+	oldest := m.samples[0]
+	elapsed := now.Sub(oldest.time)
+	if elapsed < spark.KeysharePoolDrainWindow/2 {
+		return 0, false
+	}
+	return float64(oldest.available-available) / elapsed.Seconds(), true
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/so/ent/predicate"
 	"github.com/lightsparkdev/spark/so/ent/schema"
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
@@ -109,6 +110,139 @@ func (sku *SigningKeyshareUpdate) AddCoordinatorIndex(u int64) *SigningKeyshareU
 	return sku
 }
 
+// SetEncryptedDataKey sets the "encrypted_data_key" field.
+func (sku *SigningKeyshareUpdate) SetEncryptedDataKey(b []byte) *SigningKeyshareUpdate {
+	sku.mutation.SetEncryptedDataKey(b)
+	return sku
+}
+
+// ClearEncryptedDataKey clears the value of the "encrypted_data_key" field.
+func (sku *SigningKeyshareUpdate) ClearEncryptedDataKey() *SigningKeyshareUpdate {
+	sku.mutation.ClearEncryptedDataKey()
+	return sku
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (sku *SigningKeyshareUpdate) SetKeyVersion(s string) *SigningKeyshareUpdate {
+	sku.mutation.SetKeyVersion(s)
+	return sku
+}
+
+// SetNillableKeyVersion sets the "key_version" field if the given value is not nil.
+func (sku *SigningKeyshareUpdate) SetNillableKeyVersion(s *string) *SigningKeyshareUpdate {
+	if s != nil {
+		sku.SetKeyVersion(*s)
+	}
+	return sku
+}
+
+// ClearKeyVersion clears the value of the "key_version" field.
+func (sku *SigningKeyshareUpdate) ClearKeyVersion() *SigningKeyshareUpdate {
+	sku.mutation.ClearKeyVersion()
+	return sku
+}
+
+// SetShareRefreshID sets the "share_refresh_id" field.
+func (sku *SigningKeyshareUpdate) SetShareRefreshID(u uuid.UUID) *SigningKeyshareUpdate {
+	sku.mutation.SetShareRefreshID(u)
+	return sku
+}
+
+// SetNillableShareRefreshID sets the "share_refresh_id" field if the given value is not nil.
+func (sku *SigningKeyshareUpdate) SetNillableShareRefreshID(u *uuid.UUID) *SigningKeyshareUpdate {
+	if u != nil {
+		sku.SetShareRefreshID(*u)
+	}
+	return sku
+}
+
+// ClearShareRefreshID clears the value of the "share_refresh_id" field.
+func (sku *SigningKeyshareUpdate) ClearShareRefreshID() *SigningKeyshareUpdate {
+	sku.mutation.ClearShareRefreshID()
+	return sku
+}
+
+// SetShareRefreshTime sets the "share_refresh_time" field.
+func (sku *SigningKeyshareUpdate) SetShareRefreshTime(t time.Time) *SigningKeyshareUpdate {
+	sku.mutation.SetShareRefreshTime(t)
+	return sku
+}
+
+// SetNillableShareRefreshTime sets the "share_refresh_time" field if the given value is not nil.
+func (sku *SigningKeyshareUpdate) SetNillableShareRefreshTime(t *time.Time) *SigningKeyshareUpdate {
+	if t != nil {
+		sku.SetShareRefreshTime(*t)
+	}
+	return sku
+}
+
+// ClearShareRefreshTime clears the value of the "share_refresh_time" field.
+func (sku *SigningKeyshareUpdate) ClearShareRefreshTime() *SigningKeyshareUpdate {
+	sku.mutation.ClearShareRefreshTime()
+	return sku
+}
+
+// SetPendingShareRefreshID sets the "pending_share_refresh_id" field.
+func (sku *SigningKeyshareUpdate) SetPendingShareRefreshID(u uuid.UUID) *SigningKeyshareUpdate {
+	sku.mutation.SetPendingShareRefreshID(u)
+	return sku
+}
+
+// SetNillablePendingShareRefreshID sets the "pending_share_refresh_id" field if the given value is not nil.
+func (sku *SigningKeyshareUpdate) SetNillablePendingShareRefreshID(u *uuid.UUID) *SigningKeyshareUpdate {
+	if u != nil {
+		sku.SetPendingShareRefreshID(*u)
+	}
+	return sku
+}
+
+// ClearPendingShareRefreshID clears the value of the "pending_share_refresh_id" field.
+func (sku *SigningKeyshareUpdate) ClearPendingShareRefreshID() *SigningKeyshareUpdate {
+	sku.mutation.ClearPendingShareRefreshID()
+	return sku
+}
+
+// SetPendingReshareID sets the "pending_reshare_id" field.
+func (sku *SigningKeyshareUpdate) SetPendingReshareID(u uuid.UUID) *SigningKeyshareUpdate {
+	sku.mutation.SetPendingReshareID(u)
+	return sku
+}
+
+// SetNillablePendingReshareID sets the "pending_reshare_id" field if the given value is not nil.
+func (sku *SigningKeyshareUpdate) SetNillablePendingReshareID(u *uuid.UUID) *SigningKeyshareUpdate {
+	if u != nil {
+		sku.SetPendingReshareID(*u)
+	}
+	return sku
+}
+
+// ClearPendingReshareID clears the value of the "pending_reshare_id" field.
+func (sku *SigningKeyshareUpdate) ClearPendingReshareID() *SigningKeyshareUpdate {
+	sku.mutation.ClearPendingReshareID()
+	return sku
+}
+
+// SetOperatorSetEpoch sets the "operator_set_epoch" field.
+func (sku *SigningKeyshareUpdate) SetOperatorSetEpoch(u uint64) *SigningKeyshareUpdate {
+	sku.mutation.ResetOperatorSetEpoch()
+	sku.mutation.SetOperatorSetEpoch(u)
+	return sku
+}
+
+// SetNillableOperatorSetEpoch sets the "operator_set_epoch" field if the given value is not nil.
+func (sku *SigningKeyshareUpdate) SetNillableOperatorSetEpoch(u *uint64) *SigningKeyshareUpdate {
+	if u != nil {
+		sku.SetOperatorSetEpoch(*u)
+	}
+	return sku
+}
+
+// AddOperatorSetEpoch adds u to the "operator_set_epoch" field.
+func (sku *SigningKeyshareUpdate) AddOperatorSetEpoch(u int64) *SigningKeyshareUpdate {
+	sku.mutation.AddOperatorSetEpoch(u)
+	return sku
+}
+
 // Mutation returns the SigningKeyshareMutation object of the builder.
 func (sku *SigningKeyshareUpdate) Mutation() *SigningKeyshareMutation {
 	return sku.mutation
@@ -199,6 +333,48 @@ func (sku *SigningKeyshareUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := sku.mutation.AddedCoordinatorIndex(); ok {
 		_spec.AddField(signingkeyshare.FieldCoordinatorIndex, field.TypeUint64, value)
 	}
+	if value, ok := sku.mutation.EncryptedDataKey(); ok {
+		_spec.SetField(signingkeyshare.FieldEncryptedDataKey, field.TypeBytes, value)
+	}
+	if sku.mutation.EncryptedDataKeyCleared() {
+		_spec.ClearField(signingkeyshare.FieldEncryptedDataKey, field.TypeBytes)
+	}
+	if value, ok := sku.mutation.KeyVersion(); ok {
+		_spec.SetField(signingkeyshare.FieldKeyVersion, field.TypeString, value)
+	}
+	if sku.mutation.KeyVersionCleared() {
+		_spec.ClearField(signingkeyshare.FieldKeyVersion, field.TypeString)
+	}
+	if value, ok := sku.mutation.ShareRefreshID(); ok {
+		_spec.SetField(signingkeyshare.FieldShareRefreshID, field.TypeUUID, value)
+	}
+	if sku.mutation.ShareRefreshIDCleared() {
+		_spec.ClearField(signingkeyshare.FieldShareRefreshID, field.TypeUUID)
+	}
+	if value, ok := sku.mutation.ShareRefreshTime(); ok {
+		_spec.SetField(signingkeyshare.FieldShareRefreshTime, field.TypeTime, value)
+	}
+	if sku.mutation.ShareRefreshTimeCleared() {
+		_spec.ClearField(signingkeyshare.FieldShareRefreshTime, field.TypeTime)
+	}
+	if value, ok := sku.mutation.PendingShareRefreshID(); ok {
+		_spec.SetField(signingkeyshare.FieldPendingShareRefreshID, field.TypeUUID, value)
+	}
+	if sku.mutation.PendingShareRefreshIDCleared() {
+		_spec.ClearField(signingkeyshare.FieldPendingShareRefreshID, field.TypeUUID)
+	}
+	if value, ok := sku.mutation.PendingReshareID(); ok {
+		_spec.SetField(signingkeyshare.FieldPendingReshareID, field.TypeUUID, value)
+	}
+	if sku.mutation.PendingReshareIDCleared() {
+		_spec.ClearField(signingkeyshare.FieldPendingReshareID, field.TypeUUID)
+	}
+	if value, ok := sku.mutation.OperatorSetEpoch(); ok {
+		_spec.SetField(signingkeyshare.FieldOperatorSetEpoch, field.TypeUint64, value)
+	}
+	if value, ok := sku.mutation.AddedOperatorSetEpoch(); ok {
+		_spec.AddField(signingkeyshare.FieldOperatorSetEpoch, field.TypeUint64, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, sku.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{signingkeyshare.Label}
@@ -299,6 +475,139 @@ func (skuo *SigningKeyshareUpdateOne) AddCoordinatorIndex(u int64) *SigningKeysh
 	return skuo
 }
 
+// SetEncryptedDataKey sets the "encrypted_data_key" field.
+func (skuo *SigningKeyshareUpdateOne) SetEncryptedDataKey(b []byte) *SigningKeyshareUpdateOne {
+	skuo.mutation.SetEncryptedDataKey(b)
+	return skuo
+}
+
+// ClearEncryptedDataKey clears the value of the "encrypted_data_key" field.
+func (skuo *SigningKeyshareUpdateOne) ClearEncryptedDataKey() *SigningKeyshareUpdateOne {
+	skuo.mutation.ClearEncryptedDataKey()
+	return skuo
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (skuo *SigningKeyshareUpdateOne) SetKeyVersion(s string) *SigningKeyshareUpdateOne {
+	skuo.mutation.SetKeyVersion(s)
+	return skuo
+}
+
+// SetNillableKeyVersion sets the "key_version" field if the given value is not nil.
+func (skuo *SigningKeyshareUpdateOne) SetNillableKeyVersion(s *string) *SigningKeyshareUpdateOne {
+	if s != nil {
+		skuo.SetKeyVersion(*s)
+	}
+	return skuo
+}
+
+// ClearKeyVersion clears the value of the "key_version" field.
+func (skuo *SigningKeyshareUpdateOne) ClearKeyVersion() *SigningKeyshareUpdateOne {
+	skuo.mutation.ClearKeyVersion()
+	return skuo
+}
+
+// SetShareRefreshID sets the "share_refresh_id" field.
+func (skuo *SigningKeyshareUpdateOne) SetShareRefreshID(u uuid.UUID) *SigningKeyshareUpdateOne {
+	skuo.mutation.SetShareRefreshID(u)
+	return skuo
+}
+
+// SetNillableShareRefreshID sets the "share_refresh_id" field if the given value is not nil.
+func (skuo *SigningKeyshareUpdateOne) SetNillableShareRefreshID(u *uuid.UUID) *SigningKeyshareUpdateOne {
+	if u != nil {
+		skuo.SetShareRefreshID(*u)
+	}
+	return skuo
+}
+
+// ClearShareRefreshID clears the value of the "share_refresh_id" field.
+func (skuo *SigningKeyshareUpdateOne) ClearShareRefreshID() *SigningKeyshareUpdateOne {
+	skuo.mutation.ClearShareRefreshID()
+	return skuo
+}
+
+// SetShareRefreshTime sets the "share_refresh_time" field.
+func (skuo *SigningKeyshareUpdateOne) SetShareRefreshTime(t time.Time) *SigningKeyshareUpdateOne {
+	skuo.mutation.SetShareRefreshTime(t)
+	return skuo
+}
+
+// SetNillableShareRefreshTime sets the "share_refresh_time" field if the given value is not nil.
+func (skuo *SigningKeyshareUpdateOne) SetNillableShareRefreshTime(t *time.Time) *SigningKeyshareUpdateOne {
+	if t != nil {
+		skuo.SetShareRefreshTime(*t)
+	}
+	return skuo
+}
+
+// ClearShareRefreshTime clears the value of the "share_refresh_time" field.
+func (skuo *SigningKeyshareUpdateOne) ClearShareRefreshTime() *SigningKeyshareUpdateOne {
+	skuo.mutation.ClearShareRefreshTime()
+	return skuo
+}
+
+// SetPendingShareRefreshID sets the "pending_share_refresh_id" field.
+func (skuo *SigningKeyshareUpdateOne) SetPendingShareRefreshID(u uuid.UUID) *SigningKeyshareUpdateOne {
+	skuo.mutation.SetPendingShareRefreshID(u)
+	return skuo
+}
+
+// SetNillablePendingShareRefreshID sets the "pending_share_refresh_id" field if the given value is not nil.
+func (skuo *SigningKeyshareUpdateOne) SetNillablePendingShareRefreshID(u *uuid.UUID) *SigningKeyshareUpdateOne {
+	if u != nil {
+		skuo.SetPendingShareRefreshID(*u)
+	}
+	return skuo
+}
+
+// ClearPendingShareRefreshID clears the value of the "pending_share_refresh_id" field.
+func (skuo *SigningKeyshareUpdateOne) ClearPendingShareRefreshID() *SigningKeyshareUpdateOne {
+	skuo.mutation.ClearPendingShareRefreshID()
+	return skuo
+}
+
+// SetPendingReshareID sets the "pending_reshare_id" field.
+func (skuo *SigningKeyshareUpdateOne) SetPendingReshareID(u uuid.UUID) *SigningKeyshareUpdateOne {
+	skuo.mutation.SetPendingReshareID(u)
+	return skuo
+}
+
+// SetNillablePendingReshareID sets the "pending_reshare_id" field if the given value is not nil.
+func (skuo *SigningKeyshareUpdateOne) SetNillablePendingReshareID(u *uuid.UUID) *SigningKeyshareUpdateOne {
+	if u != nil {
+		skuo.SetPendingReshareID(*u)
+	}
+	return skuo
+}
+
+// ClearPendingReshareID clears the value of the "pending_reshare_id" field.
+func (skuo *SigningKeyshareUpdateOne) ClearPendingReshareID() *SigningKeyshareUpdateOne {
+	skuo.mutation.ClearPendingReshareID()
+	return skuo
+}
+
+// SetOperatorSetEpoch sets the "operator_set_epoch" field.
+func (skuo *SigningKeyshareUpdateOne) SetOperatorSetEpoch(u uint64) *SigningKeyshareUpdateOne {
+	skuo.mutation.ResetOperatorSetEpoch()
+	skuo.mutation.SetOperatorSetEpoch(u)
+	return skuo
+}
+
+// SetNillableOperatorSetEpoch sets the "operator_set_epoch" field if the given value is not nil.
+func (skuo *SigningKeyshareUpdateOne) SetNillableOperatorSetEpoch(u *uint64) *SigningKeyshareUpdateOne {
+	if u != nil {
+		skuo.SetOperatorSetEpoch(*u)
+	}
+	return skuo
+}
+
+// AddOperatorSetEpoch adds u to the "operator_set_epoch" field.
+func (skuo *SigningKeyshareUpdateOne) AddOperatorSetEpoch(u int64) *SigningKeyshareUpdateOne {
+	skuo.mutation.AddOperatorSetEpoch(u)
+	return skuo
+}
+
 // Mutation returns the SigningKeyshareMutation object of the builder.
 func (skuo *SigningKeyshareUpdateOne) Mutation() *SigningKeyshareMutation {
 	return skuo.mutation
@@ -419,6 +728,48 @@ func (skuo *SigningKeyshareUpdateOne) sqlSave(ctx context.Context) (_node *Signi
 	if value, ok := skuo.mutation.AddedCoordinatorIndex(); ok {
 		_spec.AddField(signingkeyshare.FieldCoordinatorIndex, field.TypeUint64, value)
 	}
+	if value, ok := skuo.mutation.EncryptedDataKey(); ok {
+		_spec.SetField(signingkeyshare.FieldEncryptedDataKey, field.TypeBytes, value)
+	}
+	if skuo.mutation.EncryptedDataKeyCleared() {
+		_spec.ClearField(signingkeyshare.FieldEncryptedDataKey, field.TypeBytes)
+	}
+	if value, ok := skuo.mutation.KeyVersion(); ok {
+		_spec.SetField(signingkeyshare.FieldKeyVersion, field.TypeString, value)
+	}
+	if skuo.mutation.KeyVersionCleared() {
+		_spec.ClearField(signingkeyshare.FieldKeyVersion, field.TypeString)
+	}
+	if value, ok := skuo.mutation.ShareRefreshID(); ok {
+		_spec.SetField(signingkeyshare.FieldShareRefreshID, field.TypeUUID, value)
+	}
+	if skuo.mutation.ShareRefreshIDCleared() {
+		_spec.ClearField(signingkeyshare.FieldShareRefreshID, field.TypeUUID)
+	}
+	if value, ok := skuo.mutation.ShareRefreshTime(); ok {
+		_spec.SetField(signingkeyshare.FieldShareRefreshTime, field.TypeTime, value)
+	}
+	if skuo.mutation.ShareRefreshTimeCleared() {
+		_spec.ClearField(signingkeyshare.FieldShareRefreshTime, field.TypeTime)
+	}
+	if value, ok := skuo.mutation.PendingShareRefreshID(); ok {
+		_spec.SetField(signingkeyshare.FieldPendingShareRefreshID, field.TypeUUID, value)
+	}
+	if skuo.mutation.PendingShareRefreshIDCleared() {
+		_spec.ClearField(signingkeyshare.FieldPendingShareRefreshID, field.TypeUUID)
+	}
+	if value, ok := skuo.mutation.PendingReshareID(); ok {
+		_spec.SetField(signingkeyshare.FieldPendingReshareID, field.TypeUUID, value)
+	}
+	if skuo.mutation.PendingReshareIDCleared() {
+		_spec.ClearField(signingkeyshare.FieldPendingReshareID, field.TypeUUID)
+	}
+	if value, ok := skuo.mutation.OperatorSetEpoch(); ok {
+		_spec.SetField(signingkeyshare.FieldOperatorSetEpoch, field.TypeUint64, value)
+	}
+	if value, ok := skuo.mutation.AddedOperatorSetEpoch(); ok {
+		_spec.AddField(signingkeyshare.FieldOperatorSetEpoch, field.TypeUint64, value)
+	}
 	_node = &SigningKeyshare{config: skuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
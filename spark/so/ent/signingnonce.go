@@ -27,8 +27,18 @@ type SigningNonce struct {
 	// NonceCommitment holds the value of the "nonce_commitment" field.
 	NonceCommitment []byte `json:"nonce_commitment,omitempty"`
 	// Message holds the value of the "message" field.
-	Message      []byte `json:"message,omitempty"`
-	selectValues sql.SelectValues
+	Message []byte `json:"message,omitempty"`
+	// BindingHash holds the value of the "binding_hash" field.
+	BindingHash []byte `json:"binding_hash,omitempty"`
+	// UsedTime holds the value of the "used_time" field.
+	UsedTime *time.Time `json:"used_time,omitempty"`
+	// EncryptedDataKey holds the value of the "encrypted_data_key" field.
+	EncryptedDataKey []byte `json:"encrypted_data_key,omitempty"`
+	// KeyVersion holds the value of the "key_version" field.
+	KeyVersion string `json:"key_version,omitempty"`
+	// CoordinatorPublicKey holds the value of the "coordinator_public_key" field.
+	CoordinatorPublicKey []byte `json:"coordinator_public_key,omitempty"`
+	selectValues         sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -36,9 +46,11 @@ func (*SigningNonce) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case signingnonce.FieldNonce, signingnonce.FieldNonceCommitment, signingnonce.FieldMessage:
+		case signingnonce.FieldNonce, signingnonce.FieldNonceCommitment, signingnonce.FieldMessage, signingnonce.FieldBindingHash, signingnonce.FieldEncryptedDataKey, signingnonce.FieldCoordinatorPublicKey:
 			values[i] = new([]byte)
-		case signingnonce.FieldCreateTime, signingnonce.FieldUpdateTime:
+		case signingnonce.FieldKeyVersion:
+			values[i] = new(sql.NullString)
+		case signingnonce.FieldCreateTime, signingnonce.FieldUpdateTime, signingnonce.FieldUsedTime:
 			values[i] = new(sql.NullTime)
 		case signingnonce.FieldID:
 			values[i] = new(uuid.UUID)
@@ -93,6 +105,37 @@ func (sn *SigningNonce) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				sn.Message = *value
 			}
+		case signingnonce.FieldBindingHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field binding_hash", values[i])
+			} else if value != nil {
+				sn.BindingHash = *value
+			}
+		case signingnonce.FieldUsedTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_time", values[i])
+			} else if value.Valid {
+				sn.UsedTime = new(time.Time)
+				*sn.UsedTime = value.Time
+			}
+		case signingnonce.FieldEncryptedDataKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_data_key", values[i])
+			} else if value != nil {
+				sn.EncryptedDataKey = *value
+			}
+		case signingnonce.FieldKeyVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_version", values[i])
+			} else if value.Valid {
+				sn.KeyVersion = value.String
+			}
+		case signingnonce.FieldCoordinatorPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field coordinator_public_key", values[i])
+			} else if value != nil {
+				sn.CoordinatorPublicKey = *value
+			}
 		default:
 			sn.selectValues.Set(columns[i], values[i])
 		}
@@ -143,6 +186,23 @@ func (sn *SigningNonce) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("message=")
 	builder.WriteString(fmt.Sprintf("%v", sn.Message))
+	builder.WriteString(", ")
+	builder.WriteString("binding_hash=")
+	builder.WriteString(fmt.Sprintf("%v", sn.BindingHash))
+	builder.WriteString(", ")
+	if v := sn.UsedTime; v != nil {
+		builder.WriteString("used_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("encrypted_data_key=")
+	builder.WriteString(fmt.Sprintf("%v", sn.EncryptedDataKey))
+	builder.WriteString(", ")
+	builder.WriteString("key_version=")
+	builder.WriteString(sn.KeyVersion)
+	builder.WriteString(", ")
+	builder.WriteString("coordinator_public_key=")
+	builder.WriteString(fmt.Sprintf("%v", sn.CoordinatorPublicKey))
 	builder.WriteByte(')')
 	return builder.String()
 }
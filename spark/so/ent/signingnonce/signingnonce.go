@@ -24,6 +24,16 @@ const (
 	FieldNonceCommitment = "nonce_commitment"
 	// FieldMessage holds the string denoting the message field in the database.
 	FieldMessage = "message"
+	// FieldBindingHash holds the string denoting the binding_hash field in the database.
+	FieldBindingHash = "binding_hash"
+	// FieldUsedTime holds the string denoting the used_time field in the database.
+	FieldUsedTime = "used_time"
+	// FieldEncryptedDataKey holds the string denoting the encrypted_data_key field in the database.
+	FieldEncryptedDataKey = "encrypted_data_key"
+	// FieldKeyVersion holds the string denoting the key_version field in the database.
+	FieldKeyVersion = "key_version"
+	// FieldCoordinatorPublicKey holds the string denoting the coordinator_public_key field in the database.
+	FieldCoordinatorPublicKey = "coordinator_public_key"
 	// Table holds the table name of the signingnonce in the database.
 	Table = "signing_nonces"
 )
@@ -36,6 +46,11 @@ var Columns = []string{
 	FieldNonce,
 	FieldNonceCommitment,
 	FieldMessage,
+	FieldBindingHash,
+	FieldUsedTime,
+	FieldEncryptedDataKey,
+	FieldKeyVersion,
+	FieldCoordinatorPublicKey,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -76,3 +91,13 @@ func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
 func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
+
+// ByUsedTime orders the results by the used_time field.
+func ByUsedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsedTime, opts...).ToFunc()
+}
+
+// ByKeyVersion orders the results by the key_version field.
+func ByKeyVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyVersion, opts...).ToFunc()
+}
@@ -80,6 +80,31 @@ func Message(v []byte) predicate.SigningNonce {
 	return predicate.SigningNonce(sql.FieldEQ(FieldMessage, v))
 }
 
+// BindingHash applies equality check predicate on the "binding_hash" field. It's identical to BindingHashEQ.
+func BindingHash(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEQ(FieldBindingHash, v))
+}
+
+// UsedTime applies equality check predicate on the "used_time" field. It's identical to UsedTimeEQ.
+func UsedTime(v time.Time) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEQ(FieldUsedTime, v))
+}
+
+// EncryptedDataKey applies equality check predicate on the "encrypted_data_key" field. It's identical to EncryptedDataKeyEQ.
+func EncryptedDataKey(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEQ(FieldEncryptedDataKey, v))
+}
+
+// KeyVersion applies equality check predicate on the "key_version" field. It's identical to KeyVersionEQ.
+func KeyVersion(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEQ(FieldKeyVersion, v))
+}
+
+// CoordinatorPublicKey applies equality check predicate on the "coordinator_public_key" field. It's identical to CoordinatorPublicKeyEQ.
+func CoordinatorPublicKey(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEQ(FieldCoordinatorPublicKey, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.SigningNonce {
 	return predicate.SigningNonce(sql.FieldEQ(FieldCreateTime, v))
@@ -290,6 +315,281 @@ func MessageNotNil() predicate.SigningNonce {
 	return predicate.SigningNonce(sql.FieldNotNull(FieldMessage))
 }
 
+// BindingHashEQ applies the EQ predicate on the "binding_hash" field.
+func BindingHashEQ(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEQ(FieldBindingHash, v))
+}
+
+// BindingHashNEQ applies the NEQ predicate on the "binding_hash" field.
+func BindingHashNEQ(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNEQ(FieldBindingHash, v))
+}
+
+// BindingHashIn applies the In predicate on the "binding_hash" field.
+func BindingHashIn(vs ...[]byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldIn(FieldBindingHash, vs...))
+}
+
+// BindingHashNotIn applies the NotIn predicate on the "binding_hash" field.
+func BindingHashNotIn(vs ...[]byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNotIn(FieldBindingHash, vs...))
+}
+
+// BindingHashGT applies the GT predicate on the "binding_hash" field.
+func BindingHashGT(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldGT(FieldBindingHash, v))
+}
+
+// BindingHashGTE applies the GTE predicate on the "binding_hash" field.
+func BindingHashGTE(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldGTE(FieldBindingHash, v))
+}
+
+// BindingHashLT applies the LT predicate on the "binding_hash" field.
+func BindingHashLT(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldLT(FieldBindingHash, v))
+}
+
+// BindingHashLTE applies the LTE predicate on the "binding_hash" field.
+func BindingHashLTE(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldLTE(FieldBindingHash, v))
+}
+
+// BindingHashIsNil applies the IsNil predicate on the "binding_hash" field.
+func BindingHashIsNil() predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldIsNull(FieldBindingHash))
+}
+
+// BindingHashNotNil applies the NotNil predicate on the "binding_hash" field.
+func BindingHashNotNil() predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNotNull(FieldBindingHash))
+}
+
+// UsedTimeEQ applies the EQ predicate on the "used_time" field.
+func UsedTimeEQ(v time.Time) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEQ(FieldUsedTime, v))
+}
+
+// UsedTimeNEQ applies the NEQ predicate on the "used_time" field.
+func UsedTimeNEQ(v time.Time) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNEQ(FieldUsedTime, v))
+}
+
+// UsedTimeIn applies the In predicate on the "used_time" field.
+func UsedTimeIn(vs ...time.Time) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldIn(FieldUsedTime, vs...))
+}
+
+// UsedTimeNotIn applies the NotIn predicate on the "used_time" field.
+func UsedTimeNotIn(vs ...time.Time) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNotIn(FieldUsedTime, vs...))
+}
+
+// UsedTimeGT applies the GT predicate on the "used_time" field.
+func UsedTimeGT(v time.Time) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldGT(FieldUsedTime, v))
+}
+
+// UsedTimeGTE applies the GTE predicate on the "used_time" field.
+func UsedTimeGTE(v time.Time) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldGTE(FieldUsedTime, v))
+}
+
+// UsedTimeLT applies the LT predicate on the "used_time" field.
+func UsedTimeLT(v time.Time) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldLT(FieldUsedTime, v))
+}
+
+// UsedTimeLTE applies the LTE predicate on the "used_time" field.
+func UsedTimeLTE(v time.Time) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldLTE(FieldUsedTime, v))
+}
+
+// UsedTimeIsNil applies the IsNil predicate on the "used_time" field.
+func UsedTimeIsNil() predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldIsNull(FieldUsedTime))
+}
+
+// UsedTimeNotNil applies the NotNil predicate on the "used_time" field.
+func UsedTimeNotNil() predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNotNull(FieldUsedTime))
+}
+
+// EncryptedDataKeyEQ applies the EQ predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyEQ(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEQ(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyNEQ applies the NEQ predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyNEQ(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNEQ(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyIn applies the In predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyIn(vs ...[]byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldIn(FieldEncryptedDataKey, vs...))
+}
+
+// EncryptedDataKeyNotIn applies the NotIn predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyNotIn(vs ...[]byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNotIn(FieldEncryptedDataKey, vs...))
+}
+
+// EncryptedDataKeyGT applies the GT predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyGT(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldGT(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyGTE applies the GTE predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyGTE(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldGTE(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyLT applies the LT predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyLT(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldLT(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyLTE applies the LTE predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyLTE(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldLTE(FieldEncryptedDataKey, v))
+}
+
+// EncryptedDataKeyIsNil applies the IsNil predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyIsNil() predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldIsNull(FieldEncryptedDataKey))
+}
+
+// EncryptedDataKeyNotNil applies the NotNil predicate on the "encrypted_data_key" field.
+func EncryptedDataKeyNotNil() predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNotNull(FieldEncryptedDataKey))
+}
+
+// KeyVersionEQ applies the EQ predicate on the "key_version" field.
+func KeyVersionEQ(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEQ(FieldKeyVersion, v))
+}
+
+// KeyVersionNEQ applies the NEQ predicate on the "key_version" field.
+func KeyVersionNEQ(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNEQ(FieldKeyVersion, v))
+}
+
+// KeyVersionIn applies the In predicate on the "key_version" field.
+func KeyVersionIn(vs ...string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldIn(FieldKeyVersion, vs...))
+}
+
+// KeyVersionNotIn applies the NotIn predicate on the "key_version" field.
+func KeyVersionNotIn(vs ...string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNotIn(FieldKeyVersion, vs...))
+}
+
+// KeyVersionGT applies the GT predicate on the "key_version" field.
+func KeyVersionGT(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldGT(FieldKeyVersion, v))
+}
+
+// KeyVersionGTE applies the GTE predicate on the "key_version" field.
+func KeyVersionGTE(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldGTE(FieldKeyVersion, v))
+}
+
+// KeyVersionLT applies the LT predicate on the "key_version" field.
+func KeyVersionLT(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldLT(FieldKeyVersion, v))
+}
+
+// KeyVersionLTE applies the LTE predicate on the "key_version" field.
+func KeyVersionLTE(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldLTE(FieldKeyVersion, v))
+}
+
+// KeyVersionContains applies the Contains predicate on the "key_version" field.
+func KeyVersionContains(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldContains(FieldKeyVersion, v))
+}
+
+// KeyVersionHasPrefix applies the HasPrefix predicate on the "key_version" field.
+func KeyVersionHasPrefix(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldHasPrefix(FieldKeyVersion, v))
+}
+
+// KeyVersionHasSuffix applies the HasSuffix predicate on the "key_version" field.
+func KeyVersionHasSuffix(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldHasSuffix(FieldKeyVersion, v))
+}
+
+// KeyVersionIsNil applies the IsNil predicate on the "key_version" field.
+func KeyVersionIsNil() predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldIsNull(FieldKeyVersion))
+}
+
+// KeyVersionNotNil applies the NotNil predicate on the "key_version" field.
+func KeyVersionNotNil() predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNotNull(FieldKeyVersion))
+}
+
+// KeyVersionEqualFold applies the EqualFold predicate on the "key_version" field.
+func KeyVersionEqualFold(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEqualFold(FieldKeyVersion, v))
+}
+
+// KeyVersionContainsFold applies the ContainsFold predicate on the "key_version" field.
+func KeyVersionContainsFold(v string) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldContainsFold(FieldKeyVersion, v))
+}
+
+// CoordinatorPublicKeyEQ applies the EQ predicate on the "coordinator_public_key" field.
+func CoordinatorPublicKeyEQ(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldEQ(FieldCoordinatorPublicKey, v))
+}
+
+// CoordinatorPublicKeyNEQ applies the NEQ predicate on the "coordinator_public_key" field.
+func CoordinatorPublicKeyNEQ(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNEQ(FieldCoordinatorPublicKey, v))
+}
+
+// CoordinatorPublicKeyIn applies the In predicate on the "coordinator_public_key" field.
+func CoordinatorPublicKeyIn(vs ...[]byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldIn(FieldCoordinatorPublicKey, vs...))
+}
+
+// CoordinatorPublicKeyNotIn applies the NotIn predicate on the "coordinator_public_key" field.
+func CoordinatorPublicKeyNotIn(vs ...[]byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNotIn(FieldCoordinatorPublicKey, vs...))
+}
+
+// CoordinatorPublicKeyGT applies the GT predicate on the "coordinator_public_key" field.
+func CoordinatorPublicKeyGT(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldGT(FieldCoordinatorPublicKey, v))
+}
+
+// CoordinatorPublicKeyGTE applies the GTE predicate on the "coordinator_public_key" field.
+func CoordinatorPublicKeyGTE(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldGTE(FieldCoordinatorPublicKey, v))
+}
+
+// CoordinatorPublicKeyLT applies the LT predicate on the "coordinator_public_key" field.
+func CoordinatorPublicKeyLT(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldLT(FieldCoordinatorPublicKey, v))
+}
+
+// CoordinatorPublicKeyLTE applies the LTE predicate on the "coordinator_public_key" field.
+func CoordinatorPublicKeyLTE(v []byte) predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldLTE(FieldCoordinatorPublicKey, v))
+}
+
+// CoordinatorPublicKeyIsNil applies the IsNil predicate on the "coordinator_public_key" field.
+func CoordinatorPublicKeyIsNil() predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldIsNull(FieldCoordinatorPublicKey))
+}
+
+// CoordinatorPublicKeyNotNil applies the NotNil predicate on the "coordinator_public_key" field.
+func CoordinatorPublicKeyNotNil() predicate.SigningNonce {
+	return predicate.SigningNonce(sql.FieldNotNull(FieldCoordinatorPublicKey))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SigningNonce) predicate.SigningNonce {
 	return predicate.SigningNonce(sql.AndPredicates(predicates...))
@@ -67,6 +67,52 @@ func (snc *SigningNonceCreate) SetMessage(b []byte) *SigningNonceCreate {
 	return snc
 }
 
+// SetBindingHash sets the "binding_hash" field.
+func (snc *SigningNonceCreate) SetBindingHash(b []byte) *SigningNonceCreate {
+	snc.mutation.SetBindingHash(b)
+	return snc
+}
+
+// SetUsedTime sets the "used_time" field.
+func (snc *SigningNonceCreate) SetUsedTime(t time.Time) *SigningNonceCreate {
+	snc.mutation.SetUsedTime(t)
+	return snc
+}
+
+// SetNillableUsedTime sets the "used_time" field if the given value is not nil.
+func (snc *SigningNonceCreate) SetNillableUsedTime(t *time.Time) *SigningNonceCreate {
+	if t != nil {
+		snc.SetUsedTime(*t)
+	}
+	return snc
+}
+
+// SetEncryptedDataKey sets the "encrypted_data_key" field.
+func (snc *SigningNonceCreate) SetEncryptedDataKey(b []byte) *SigningNonceCreate {
+	snc.mutation.SetEncryptedDataKey(b)
+	return snc
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (snc *SigningNonceCreate) SetKeyVersion(s string) *SigningNonceCreate {
+	snc.mutation.SetKeyVersion(s)
+	return snc
+}
+
+// SetNillableKeyVersion sets the "key_version" field if the given value is not nil.
+func (snc *SigningNonceCreate) SetNillableKeyVersion(s *string) *SigningNonceCreate {
+	if s != nil {
+		snc.SetKeyVersion(*s)
+	}
+	return snc
+}
+
+// SetCoordinatorPublicKey sets the "coordinator_public_key" field.
+func (snc *SigningNonceCreate) SetCoordinatorPublicKey(b []byte) *SigningNonceCreate {
+	snc.mutation.SetCoordinatorPublicKey(b)
+	return snc
+}
+
 // SetID sets the "id" field.
 func (snc *SigningNonceCreate) SetID(u uuid.UUID) *SigningNonceCreate {
 	snc.mutation.SetID(u)
@@ -199,6 +245,26 @@ func (snc *SigningNonceCreate) createSpec() (*SigningNonce, *sqlgraph.CreateSpec
 		_spec.SetField(signingnonce.FieldMessage, field.TypeBytes, value)
 		_node.Message = value
 	}
+	if value, ok := snc.mutation.BindingHash(); ok {
+		_spec.SetField(signingnonce.FieldBindingHash, field.TypeBytes, value)
+		_node.BindingHash = value
+	}
+	if value, ok := snc.mutation.UsedTime(); ok {
+		_spec.SetField(signingnonce.FieldUsedTime, field.TypeTime, value)
+		_node.UsedTime = &value
+	}
+	if value, ok := snc.mutation.EncryptedDataKey(); ok {
+		_spec.SetField(signingnonce.FieldEncryptedDataKey, field.TypeBytes, value)
+		_node.EncryptedDataKey = value
+	}
+	if value, ok := snc.mutation.KeyVersion(); ok {
+		_spec.SetField(signingnonce.FieldKeyVersion, field.TypeString, value)
+		_node.KeyVersion = value
+	}
+	if value, ok := snc.mutation.CoordinatorPublicKey(); ok {
+		_spec.SetField(signingnonce.FieldCoordinatorPublicKey, field.TypeBytes, value)
+		_node.CoordinatorPublicKey = value
+	}
 	return _node, _spec
 }
 
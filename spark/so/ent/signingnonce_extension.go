@@ -1,15 +1,64 @@
 package ent
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/lightsparkdev/spark"
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
 	"github.com/lightsparkdev/spark/so/objects"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
-// StoreSigningNonce stores the given signing nonce and commitment in the database.
-func StoreSigningNonce(ctx context.Context, _ *so.Config, nonce objects.SigningNonce, commitment objects.SigningCommitment) error {
+var (
+	// ErrSigningNonceReused is returned when a signing nonce that was already used is used to sign
+	// anything else, which would leak the keyshare it signs with.
+	ErrSigningNonceReused = errors.New("signing nonce was already used")
+
+	// ErrSigningNonceExpired is returned when a signing nonce is used after spark.SigningNonceExpiry.
+	ErrSigningNonceExpired = errors.New("signing nonce expired")
+
+	signingNonceMeter = otel.Meter("signing_nonce")
+
+	signingNoncesGauge         metric.Int64Gauge
+	signingNoncesPurgedCounter metric.Int64Counter
+	signingNonceReusesCounter  metric.Int64Counter
+)
+
+func init() {
+	var err error
+	signingNoncesGauge, err = signingNonceMeter.Int64Gauge(
+		"spark_signing_nonces",
+		metric.WithDescription("Number of stored signing nonces"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	signingNoncesPurgedCounter, err = signingNonceMeter.Int64Counter(
+		"spark_signing_nonces_purged",
+		metric.WithDescription("Number of signing nonces purged by the retention job"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	signingNonceReusesCounter, err = signingNonceMeter.Int64Counter(
+		"spark_signing_nonce_reuses_rejected",
+		metric.WithDescription("Number of signing jobs rejected because they reused a signing nonce"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// StoreSigningNonce stores the given signing nonce and commitment in the database, for the operator
+// coordinating the call of the context.
+func StoreSigningNonce(ctx context.Context, config *so.Config, nonce objects.SigningNonce, commitment objects.SigningCommitment) error {
 	nonceBytes, err := nonce.MarshalBinary()
 	if err != nil {
 		return err
@@ -19,6 +68,7 @@ func StoreSigningNonce(ctx context.Context, _ *so.Config, nonce objects.SigningN
 	_, err = GetDbFromContext(ctx).SigningNonce.Create().
 		SetNonce(nonceBytes).
 		SetNonceCommitment(commitmentBytes).
+		SetCoordinatorPublicKey(config.CallCoordinator(ctx)).
 		Save(ctx)
 	return err
 }
@@ -58,3 +108,80 @@ func GetSigningNonces(ctx context.Context, _ *so.Config, commitments []objects.S
 	}
 	return result, nil
 }
+
+// UseSigningNonce binds the signing nonce to the message and the binding hash of the signing job
+// it is used for. The first use binds the nonce, and any later use is only allowed for the same
+// message and binding hash, which produces the same signature share. Binding is a conditional
+// update, so two concurrent signing jobs cannot both bind the same nonce.
+func UseSigningNonce(ctx context.Context, nonce *SigningNonce, message []byte, bindingHash []byte) error {
+	if nonce.CreateTime.Before(time.Now().Add(-spark.SigningNonceExpiry)) {
+		return fmt.Errorf("%w: signing nonce %s was generated at %s", ErrSigningNonceExpired, nonce.ID, nonce.CreateTime)
+	}
+
+	db := GetDbFromContext(ctx)
+	bound, err := db.SigningNonce.Update().
+		Where(signingnonce.ID(nonce.ID), signingnonce.MessageIsNil()).
+		SetMessage(message).
+		SetBindingHash(bindingHash).
+		SetUsedTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to bind signing nonce %s: %w", nonce.ID, err)
+	}
+	if bound > 0 {
+		return nil
+	}
+
+	used, err := db.SigningNonce.Query().
+		Where(signingnonce.ID(nonce.ID)).
+		Select(signingnonce.FieldMessage, signingnonce.FieldBindingHash).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load signing nonce %s: %w", nonce.ID, err)
+	}
+	if !bytes.Equal(used.Message, message) || len(used.BindingHash) == 0 || !bytes.Equal(used.BindingHash, bindingHash) {
+		signingNonceReusesCounter.Add(ctx, 1)
+		return fmt.Errorf("%w: signing nonce %s is bound to message %x, cannot use it for message %x", ErrSigningNonceReused, nonce.ID, used.Message, message)
+	}
+	return nil
+}
+
+// PurgeSigningNonces deletes up to batchSize signing nonces that can no longer be used: nonces
+// used more than spark.UsedSigningNonceRetention ago, and unused nonces older than
+// spark.SigningNonceExpiry. It returns how many nonces it deleted, and records the size of the
+// remaining nonce pool.
+func PurgeSigningNonces(ctx context.Context, batchSize int) (int, error) {
+	db := GetDbFromContext(ctx)
+	now := time.Now()
+	expired := signingnonce.Or(
+		signingnonce.UsedTimeLT(now.Add(-spark.UsedSigningNonceRetention)),
+		signingnonce.And(
+			signingnonce.UsedTimeIsNil(),
+			signingnonce.CreateTimeLT(now.Add(-spark.SigningNonceExpiry)),
+		),
+	)
+	ids, err := db.SigningNonce.Query().Where(expired).Limit(batchSize).IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query expired signing nonces: %w", err)
+	}
+	purged := 0
+	if len(ids) > 0 {
+		purged, err = db.SigningNonce.Delete().Where(signingnonce.IDIn(ids...)).Exec(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to purge signing nonces: %w", err)
+		}
+		signingNoncesPurgedCounter.Add(ctx, int64(purged))
+	}
+
+	unused, err := db.SigningNonce.Query().Where(signingnonce.UsedTimeIsNil(), signingnonce.MessageIsNil()).Count(ctx)
+	if err != nil {
+		return purged, fmt.Errorf("failed to count unused signing nonces: %w", err)
+	}
+	total, err := db.SigningNonce.Query().Count(ctx)
+	if err != nil {
+		return purged, fmt.Errorf("failed to count signing nonces: %w", err)
+	}
+	signingNoncesGauge.Record(ctx, int64(unused), metric.WithAttributes(attribute.String("state", "unused")))
+	signingNoncesGauge.Record(ctx, int64(total-unused), metric.WithAttributes(attribute.String("state", "used")))
+	return purged, nil
+}
@@ -0,0 +1,69 @@
+package ent_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/so/ent"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSigningNonce(ctx context.Context, t *testing.T, createTime time.Time) *ent.SigningNonce {
+	nonce, err := ent.GetDbFromContext(ctx).SigningNonce.Create().
+		SetNonce([]byte("nonce")).
+		SetNonceCommitment([]byte("commitment")).
+		SetCreateTime(createTime).
+		Save(ctx)
+	require.NoError(t, err)
+	return nonce
+}
+
+func TestUseSigningNonce(t *testing.T) {
+	ctx := newTestTxContext(t, "use_signing_nonce")
+	nonce := createTestSigningNonce(ctx, t, time.Now())
+
+	require.NoError(t, ent.UseSigningNonce(ctx, nonce, []byte("message"), []byte("binding")))
+	used, err := ent.GetDbFromContext(ctx).SigningNonce.Get(ctx, nonce.ID)
+	require.NoError(t, err)
+	require.Equal(t, []byte("message"), used.Message)
+	require.NotNil(t, used.UsedTime)
+
+	// Retrying the same signing job is allowed, anything else is not.
+	require.NoError(t, ent.UseSigningNonce(ctx, nonce, []byte("message"), []byte("binding")))
+	err = ent.UseSigningNonce(ctx, nonce, []byte("other message"), []byte("binding"))
+	require.ErrorIs(t, err, ent.ErrSigningNonceReused)
+	err = ent.UseSigningNonce(ctx, nonce, []byte("message"), []byte("other binding"))
+	require.ErrorIs(t, err, ent.ErrSigningNonceReused)
+
+	expired := createTestSigningNonce(ctx, t, time.Now().Add(-spark.SigningNonceExpiry-time.Minute))
+	err = ent.UseSigningNonce(ctx, expired, []byte("message"), []byte("binding"))
+	require.ErrorIs(t, err, ent.ErrSigningNonceExpired)
+}
+
+func TestPurgeSigningNonces(t *testing.T) {
+	ctx := newTestTxContext(t, "purge_signing_nonces")
+	db := ent.GetDbFromContext(ctx)
+
+	fresh := createTestSigningNonce(ctx, t, time.Now())
+	createTestSigningNonce(ctx, t, time.Now().Add(-spark.SigningNonceExpiry-time.Minute))
+	recentlyUsed := createTestSigningNonce(ctx, t, time.Now())
+	require.NoError(t, ent.UseSigningNonce(ctx, recentlyUsed, []byte("message"), []byte("binding")))
+	used := createTestSigningNonce(ctx, t, time.Now())
+	require.NoError(t, ent.UseSigningNonce(ctx, used, []byte("message"), []byte("binding")))
+	require.NoError(t, db.SigningNonce.UpdateOneID(used.ID).SetUsedTime(time.Now().Add(-spark.UsedSigningNonceRetention-time.Minute)).Exec(ctx))
+
+	purged, err := ent.PurgeSigningNonces(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+	purged, err = ent.PurgeSigningNonces(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+
+	remaining, err := db.SigningNonce.Query().IDs(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{fresh.ID, recentlyUsed.ID}, remaining)
+}
@@ -46,6 +46,70 @@ func (snu *SigningNonceUpdate) ClearMessage() *SigningNonceUpdate {
 	return snu
 }
 
+// SetBindingHash sets the "binding_hash" field.
+func (snu *SigningNonceUpdate) SetBindingHash(b []byte) *SigningNonceUpdate {
+	snu.mutation.SetBindingHash(b)
+	return snu
+}
+
+// ClearBindingHash clears the value of the "binding_hash" field.
+func (snu *SigningNonceUpdate) ClearBindingHash() *SigningNonceUpdate {
+	snu.mutation.ClearBindingHash()
+	return snu
+}
+
+// SetUsedTime sets the "used_time" field.
+func (snu *SigningNonceUpdate) SetUsedTime(t time.Time) *SigningNonceUpdate {
+	snu.mutation.SetUsedTime(t)
+	return snu
+}
+
+// SetNillableUsedTime sets the "used_time" field if the given value is not nil.
+func (snu *SigningNonceUpdate) SetNillableUsedTime(t *time.Time) *SigningNonceUpdate {
+	if t != nil {
+		snu.SetUsedTime(*t)
+	}
+	return snu
+}
+
+// ClearUsedTime clears the value of the "used_time" field.
+func (snu *SigningNonceUpdate) ClearUsedTime() *SigningNonceUpdate {
+	snu.mutation.ClearUsedTime()
+	return snu
+}
+
+// SetEncryptedDataKey sets the "encrypted_data_key" field.
+func (snu *SigningNonceUpdate) SetEncryptedDataKey(b []byte) *SigningNonceUpdate {
+	snu.mutation.SetEncryptedDataKey(b)
+	return snu
+}
+
+// ClearEncryptedDataKey clears the value of the "encrypted_data_key" field.
+func (snu *SigningNonceUpdate) ClearEncryptedDataKey() *SigningNonceUpdate {
+	snu.mutation.ClearEncryptedDataKey()
+	return snu
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (snu *SigningNonceUpdate) SetKeyVersion(s string) *SigningNonceUpdate {
+	snu.mutation.SetKeyVersion(s)
+	return snu
+}
+
+// SetNillableKeyVersion sets the "key_version" field if the given value is not nil.
+func (snu *SigningNonceUpdate) SetNillableKeyVersion(s *string) *SigningNonceUpdate {
+	if s != nil {
+		snu.SetKeyVersion(*s)
+	}
+	return snu
+}
+
+// ClearKeyVersion clears the value of the "key_version" field.
+func (snu *SigningNonceUpdate) ClearKeyVersion() *SigningNonceUpdate {
+	snu.mutation.ClearKeyVersion()
+	return snu
+}
+
 // Mutation returns the SigningNonceMutation object of the builder.
 func (snu *SigningNonceUpdate) Mutation() *SigningNonceMutation {
 	return snu.mutation
@@ -105,6 +169,33 @@ func (snu *SigningNonceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if snu.mutation.MessageCleared() {
 		_spec.ClearField(signingnonce.FieldMessage, field.TypeBytes)
 	}
+	if value, ok := snu.mutation.BindingHash(); ok {
+		_spec.SetField(signingnonce.FieldBindingHash, field.TypeBytes, value)
+	}
+	if snu.mutation.BindingHashCleared() {
+		_spec.ClearField(signingnonce.FieldBindingHash, field.TypeBytes)
+	}
+	if value, ok := snu.mutation.UsedTime(); ok {
+		_spec.SetField(signingnonce.FieldUsedTime, field.TypeTime, value)
+	}
+	if snu.mutation.UsedTimeCleared() {
+		_spec.ClearField(signingnonce.FieldUsedTime, field.TypeTime)
+	}
+	if value, ok := snu.mutation.EncryptedDataKey(); ok {
+		_spec.SetField(signingnonce.FieldEncryptedDataKey, field.TypeBytes, value)
+	}
+	if snu.mutation.EncryptedDataKeyCleared() {
+		_spec.ClearField(signingnonce.FieldEncryptedDataKey, field.TypeBytes)
+	}
+	if value, ok := snu.mutation.KeyVersion(); ok {
+		_spec.SetField(signingnonce.FieldKeyVersion, field.TypeString, value)
+	}
+	if snu.mutation.KeyVersionCleared() {
+		_spec.ClearField(signingnonce.FieldKeyVersion, field.TypeString)
+	}
+	if snu.mutation.CoordinatorPublicKeyCleared() {
+		_spec.ClearField(signingnonce.FieldCoordinatorPublicKey, field.TypeBytes)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, snu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{signingnonce.Label}
@@ -143,6 +234,70 @@ func (snuo *SigningNonceUpdateOne) ClearMessage() *SigningNonceUpdateOne {
 	return snuo
 }
 
+// SetBindingHash sets the "binding_hash" field.
+func (snuo *SigningNonceUpdateOne) SetBindingHash(b []byte) *SigningNonceUpdateOne {
+	snuo.mutation.SetBindingHash(b)
+	return snuo
+}
+
+// ClearBindingHash clears the value of the "binding_hash" field.
+func (snuo *SigningNonceUpdateOne) ClearBindingHash() *SigningNonceUpdateOne {
+	snuo.mutation.ClearBindingHash()
+	return snuo
+}
+
+// SetUsedTime sets the "used_time" field.
+func (snuo *SigningNonceUpdateOne) SetUsedTime(t time.Time) *SigningNonceUpdateOne {
+	snuo.mutation.SetUsedTime(t)
+	return snuo
+}
+
+// SetNillableUsedTime sets the "used_time" field if the given value is not nil.
+func (snuo *SigningNonceUpdateOne) SetNillableUsedTime(t *time.Time) *SigningNonceUpdateOne {
+	if t != nil {
+		snuo.SetUsedTime(*t)
+	}
+	return snuo
+}
+
+// ClearUsedTime clears the value of the "used_time" field.
+func (snuo *SigningNonceUpdateOne) ClearUsedTime() *SigningNonceUpdateOne {
+	snuo.mutation.ClearUsedTime()
+	return snuo
+}
+
+// SetEncryptedDataKey sets the "encrypted_data_key" field.
+func (snuo *SigningNonceUpdateOne) SetEncryptedDataKey(b []byte) *SigningNonceUpdateOne {
+	snuo.mutation.SetEncryptedDataKey(b)
+	return snuo
+}
+
+// ClearEncryptedDataKey clears the value of the "encrypted_data_key" field.
+func (snuo *SigningNonceUpdateOne) ClearEncryptedDataKey() *SigningNonceUpdateOne {
+	snuo.mutation.ClearEncryptedDataKey()
+	return snuo
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (snuo *SigningNonceUpdateOne) SetKeyVersion(s string) *SigningNonceUpdateOne {
+	snuo.mutation.SetKeyVersion(s)
+	return snuo
+}
+
+// SetNillableKeyVersion sets the "key_version" field if the given value is not nil.
+func (snuo *SigningNonceUpdateOne) SetNillableKeyVersion(s *string) *SigningNonceUpdateOne {
+	if s != nil {
+		snuo.SetKeyVersion(*s)
+	}
+	return snuo
+}
+
+// ClearKeyVersion clears the value of the "key_version" field.
+func (snuo *SigningNonceUpdateOne) ClearKeyVersion() *SigningNonceUpdateOne {
+	snuo.mutation.ClearKeyVersion()
+	return snuo
+}
+
 // Mutation returns the SigningNonceMutation object of the builder.
 func (snuo *SigningNonceUpdateOne) Mutation() *SigningNonceMutation {
 	return snuo.mutation
@@ -232,6 +387,33 @@ func (snuo *SigningNonceUpdateOne) sqlSave(ctx context.Context) (_node *SigningN
 	if snuo.mutation.MessageCleared() {
 		_spec.ClearField(signingnonce.FieldMessage, field.TypeBytes)
 	}
+	if value, ok := snuo.mutation.BindingHash(); ok {
+		_spec.SetField(signingnonce.FieldBindingHash, field.TypeBytes, value)
+	}
+	if snuo.mutation.BindingHashCleared() {
+		_spec.ClearField(signingnonce.FieldBindingHash, field.TypeBytes)
+	}
+	if value, ok := snuo.mutation.UsedTime(); ok {
+		_spec.SetField(signingnonce.FieldUsedTime, field.TypeTime, value)
+	}
+	if snuo.mutation.UsedTimeCleared() {
+		_spec.ClearField(signingnonce.FieldUsedTime, field.TypeTime)
+	}
+	if value, ok := snuo.mutation.EncryptedDataKey(); ok {
+		_spec.SetField(signingnonce.FieldEncryptedDataKey, field.TypeBytes, value)
+	}
+	if snuo.mutation.EncryptedDataKeyCleared() {
+		_spec.ClearField(signingnonce.FieldEncryptedDataKey, field.TypeBytes)
+	}
+	if value, ok := snuo.mutation.KeyVersion(); ok {
+		_spec.SetField(signingnonce.FieldKeyVersion, field.TypeString, value)
+	}
+	if snuo.mutation.KeyVersionCleared() {
+		_spec.ClearField(signingnonce.FieldKeyVersion, field.TypeString)
+	}
+	if snuo.mutation.CoordinatorPublicKeyCleared() {
+		_spec.ClearField(signingnonce.FieldCoordinatorPublicKey, field.TypeBytes)
+	}
 	_node = &SigningNonce{config: snuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
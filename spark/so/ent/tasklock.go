@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/tasklock"
+)
+
+// TaskLock is the model entity for the TaskLock schema.
+type TaskLock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Holder holds the value of the "holder" field.
+	Holder string `json:"holder,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskLock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tasklock.FieldName, tasklock.FieldHolder:
+			values[i] = new(sql.NullString)
+		case tasklock.FieldCreateTime, tasklock.FieldUpdateTime, tasklock.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case tasklock.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskLock fields.
+func (tl *TaskLock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tasklock.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tl.ID = *value
+			}
+		case tasklock.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				tl.CreateTime = value.Time
+			}
+		case tasklock.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				tl.UpdateTime = value.Time
+			}
+		case tasklock.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				tl.Name = value.String
+			}
+		case tasklock.FieldHolder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holder", values[i])
+			} else if value.Valid {
+				tl.Holder = value.String
+			}
+		case tasklock.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				tl.ExpiresAt = value.Time
+			}
+		default:
+			tl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskLock.
+// This includes values selected through modifiers, order, etc.
+func (tl *TaskLock) Value(name string) (ent.Value, error) {
+	return tl.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TaskLock.
+// Note that you need to call TaskLock.Unwrap() before calling this method if this TaskLock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tl *TaskLock) Update() *TaskLockUpdateOne {
+	return NewTaskLockClient(tl.config).UpdateOne(tl)
+}
+
+// Unwrap unwraps the TaskLock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tl *TaskLock) Unwrap() *TaskLock {
+	_tx, ok := tl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskLock is not a transactional entity")
+	}
+	tl.config.driver = _tx.drv
+	return tl
+}
+
+// String implements the fmt.Stringer.
+func (tl *TaskLock) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskLock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tl.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(tl.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(tl.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(tl.Name)
+	builder.WriteString(", ")
+	builder.WriteString("holder=")
+	builder.WriteString(tl.Holder)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(tl.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskLocks is a parsable slice of TaskLock.
+type TaskLocks []*TaskLock
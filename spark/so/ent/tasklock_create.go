@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/tasklock"
+)
+
+// TaskLockCreate is the builder for creating a TaskLock entity.
+type TaskLockCreate struct {
+	config
+	mutation *TaskLockMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (tlc *TaskLockCreate) SetCreateTime(t time.Time) *TaskLockCreate {
+	tlc.mutation.SetCreateTime(t)
+	return tlc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (tlc *TaskLockCreate) SetNillableCreateTime(t *time.Time) *TaskLockCreate {
+	if t != nil {
+		tlc.SetCreateTime(*t)
+	}
+	return tlc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tlc *TaskLockCreate) SetUpdateTime(t time.Time) *TaskLockCreate {
+	tlc.mutation.SetUpdateTime(t)
+	return tlc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (tlc *TaskLockCreate) SetNillableUpdateTime(t *time.Time) *TaskLockCreate {
+	if t != nil {
+		tlc.SetUpdateTime(*t)
+	}
+	return tlc
+}
+
+// SetName sets the "name" field.
+func (tlc *TaskLockCreate) SetName(s string) *TaskLockCreate {
+	tlc.mutation.SetName(s)
+	return tlc
+}
+
+// SetHolder sets the "holder" field.
+func (tlc *TaskLockCreate) SetHolder(s string) *TaskLockCreate {
+	tlc.mutation.SetHolder(s)
+	return tlc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tlc *TaskLockCreate) SetExpiresAt(t time.Time) *TaskLockCreate {
+	tlc.mutation.SetExpiresAt(t)
+	return tlc
+}
+
+// SetID sets the "id" field.
+func (tlc *TaskLockCreate) SetID(u uuid.UUID) *TaskLockCreate {
+	tlc.mutation.SetID(u)
+	return tlc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tlc *TaskLockCreate) SetNillableID(u *uuid.UUID) *TaskLockCreate {
+	if u != nil {
+		tlc.SetID(*u)
+	}
+	return tlc
+}
+
+// Mutation returns the TaskLockMutation object of the builder.
+func (tlc *TaskLockCreate) Mutation() *TaskLockMutation {
+	return tlc.mutation
+}
+
+// Save creates the TaskLock in the database.
+func (tlc *TaskLockCreate) Save(ctx context.Context) (*TaskLock, error) {
+	tlc.defaults()
+	return withHooks(ctx, tlc.sqlSave, tlc.mutation, tlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tlc *TaskLockCreate) SaveX(ctx context.Context) *TaskLock {
+	v, err := tlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tlc *TaskLockCreate) Exec(ctx context.Context) error {
+	_, err := tlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tlc *TaskLockCreate) ExecX(ctx context.Context) {
+	if err := tlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tlc *TaskLockCreate) defaults() {
+	if _, ok := tlc.mutation.CreateTime(); !ok {
+		v := tasklock.DefaultCreateTime()
+		tlc.mutation.SetCreateTime(v)
+	}
+	if _, ok := tlc.mutation.UpdateTime(); !ok {
+		v := tasklock.DefaultUpdateTime()
+		tlc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := tlc.mutation.ID(); !ok {
+		v := tasklock.DefaultID()
+		tlc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tlc *TaskLockCreate) check() error {
+	if _, ok := tlc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TaskLock.create_time"`)}
+	}
+	if _, ok := tlc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "TaskLock.update_time"`)}
+	}
+	if _, ok := tlc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TaskLock.name"`)}
+	}
+	if v, ok := tlc.mutation.Name(); ok {
+		if err := tasklock.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskLock.name": %w`, err)}
+		}
+	}
+	if _, ok := tlc.mutation.Holder(); !ok {
+		return &ValidationError{Name: "holder", err: errors.New(`ent: missing required field "TaskLock.holder"`)}
+	}
+	if _, ok := tlc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "TaskLock.expires_at"`)}
+	}
+	return nil
+}
+
+func (tlc *TaskLockCreate) sqlSave(ctx context.Context) (*TaskLock, error) {
+	if err := tlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tlc.mutation.id = &_node.ID
+	tlc.mutation.done = true
+	return _node, nil
+}
+
+func (tlc *TaskLockCreate) createSpec() (*TaskLock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskLock{config: tlc.config}
+		_spec = sqlgraph.NewCreateSpec(tasklock.Table, sqlgraph.NewFieldSpec(tasklock.FieldID, field.TypeUUID))
+	)
+	if id, ok := tlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tlc.mutation.CreateTime(); ok {
+		_spec.SetField(tasklock.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := tlc.mutation.UpdateTime(); ok {
+		_spec.SetField(tasklock.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := tlc.mutation.Name(); ok {
+		_spec.SetField(tasklock.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tlc.mutation.Holder(); ok {
+		_spec.SetField(tasklock.FieldHolder, field.TypeString, value)
+		_node.Holder = value
+	}
+	if value, ok := tlc.mutation.ExpiresAt(); ok {
+		_spec.SetField(tasklock.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// TaskLockCreateBulk is the builder for creating many TaskLock entities in bulk.
+type TaskLockCreateBulk struct {
+	config
+	err      error
+	builders []*TaskLockCreate
+}
+
+// Save creates the TaskLock entities in the database.
+func (tlcb *TaskLockCreateBulk) Save(ctx context.Context) ([]*TaskLock, error) {
+	if tlcb.err != nil {
+		return nil, tlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tlcb.builders))
+	nodes := make([]*TaskLock, len(tlcb.builders))
+	mutators := make([]Mutator, len(tlcb.builders))
+	for i := range tlcb.builders {
+		func(i int, root context.Context) {
+			builder := tlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskLockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tlcb *TaskLockCreateBulk) SaveX(ctx context.Context) []*TaskLock {
+	v, err := tlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tlcb *TaskLockCreateBulk) Exec(ctx context.Context) error {
+	_, err := tlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tlcb *TaskLockCreateBulk) ExecX(ctx context.Context) {
+	if err := tlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/tasklock"
+)
+
+// TaskLockDelete is the builder for deleting a TaskLock entity.
+type TaskLockDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskLockMutation
+}
+
+// Where appends a list predicates to the TaskLockDelete builder.
+func (tld *TaskLockDelete) Where(ps ...predicate.TaskLock) *TaskLockDelete {
+	tld.mutation.Where(ps...)
+	return tld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tld *TaskLockDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tld.sqlExec, tld.mutation, tld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tld *TaskLockDelete) ExecX(ctx context.Context) int {
+	n, err := tld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tld *TaskLockDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tasklock.Table, sqlgraph.NewFieldSpec(tasklock.FieldID, field.TypeUUID))
+	if ps := tld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tld.mutation.done = true
+	return affected, err
+}
+
+// TaskLockDeleteOne is the builder for deleting a single TaskLock entity.
+type TaskLockDeleteOne struct {
+	tld *TaskLockDelete
+}
+
+// Where appends a list predicates to the TaskLockDelete builder.
+func (tldo *TaskLockDeleteOne) Where(ps ...predicate.TaskLock) *TaskLockDeleteOne {
+	tldo.tld.mutation.Where(ps...)
+	return tldo
+}
+
+// Exec executes the deletion query.
+func (tldo *TaskLockDeleteOne) Exec(ctx context.Context) error {
+	n, err := tldo.tld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tasklock.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tldo *TaskLockDeleteOne) ExecX(ctx context.Context) {
+	if err := tldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/tasklock"
+)
+
+// TaskLockUpdate is the builder for updating TaskLock entities.
+type TaskLockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskLockMutation
+}
+
+// Where appends a list predicates to the TaskLockUpdate builder.
+func (tlu *TaskLockUpdate) Where(ps ...predicate.TaskLock) *TaskLockUpdate {
+	tlu.mutation.Where(ps...)
+	return tlu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tlu *TaskLockUpdate) SetUpdateTime(t time.Time) *TaskLockUpdate {
+	tlu.mutation.SetUpdateTime(t)
+	return tlu
+}
+
+// SetHolder sets the "holder" field.
+func (tlu *TaskLockUpdate) SetHolder(s string) *TaskLockUpdate {
+	tlu.mutation.SetHolder(s)
+	return tlu
+}
+
+// SetNillableHolder sets the "holder" field if the given value is not nil.
+func (tlu *TaskLockUpdate) SetNillableHolder(s *string) *TaskLockUpdate {
+	if s != nil {
+		tlu.SetHolder(*s)
+	}
+	return tlu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tlu *TaskLockUpdate) SetExpiresAt(t time.Time) *TaskLockUpdate {
+	tlu.mutation.SetExpiresAt(t)
+	return tlu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tlu *TaskLockUpdate) SetNillableExpiresAt(t *time.Time) *TaskLockUpdate {
+	if t != nil {
+		tlu.SetExpiresAt(*t)
+	}
+	return tlu
+}
+
+// Mutation returns the TaskLockMutation object of the builder.
+func (tlu *TaskLockUpdate) Mutation() *TaskLockMutation {
+	return tlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tlu *TaskLockUpdate) Save(ctx context.Context) (int, error) {
+	tlu.defaults()
+	return withHooks(ctx, tlu.sqlSave, tlu.mutation, tlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tlu *TaskLockUpdate) SaveX(ctx context.Context) int {
+	affected, err := tlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tlu *TaskLockUpdate) Exec(ctx context.Context) error {
+	_, err := tlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tlu *TaskLockUpdate) ExecX(ctx context.Context) {
+	if err := tlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tlu *TaskLockUpdate) defaults() {
+	if _, ok := tlu.mutation.UpdateTime(); !ok {
+		v := tasklock.UpdateDefaultUpdateTime()
+		tlu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (tlu *TaskLockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tasklock.Table, tasklock.Columns, sqlgraph.NewFieldSpec(tasklock.FieldID, field.TypeUUID))
+	if ps := tlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tlu.mutation.UpdateTime(); ok {
+		_spec.SetField(tasklock.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := tlu.mutation.Holder(); ok {
+		_spec.SetField(tasklock.FieldHolder, field.TypeString, value)
+	}
+	if value, ok := tlu.mutation.ExpiresAt(); ok {
+		_spec.SetField(tasklock.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tasklock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tlu.mutation.done = true
+	return n, nil
+}
+
+// TaskLockUpdateOne is the builder for updating a single TaskLock entity.
+type TaskLockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskLockMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tluo *TaskLockUpdateOne) SetUpdateTime(t time.Time) *TaskLockUpdateOne {
+	tluo.mutation.SetUpdateTime(t)
+	return tluo
+}
+
+// SetHolder sets the "holder" field.
+func (tluo *TaskLockUpdateOne) SetHolder(s string) *TaskLockUpdateOne {
+	tluo.mutation.SetHolder(s)
+	return tluo
+}
+
+// SetNillableHolder sets the "holder" field if the given value is not nil.
+func (tluo *TaskLockUpdateOne) SetNillableHolder(s *string) *TaskLockUpdateOne {
+	if s != nil {
+		tluo.SetHolder(*s)
+	}
+	return tluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tluo *TaskLockUpdateOne) SetExpiresAt(t time.Time) *TaskLockUpdateOne {
+	tluo.mutation.SetExpiresAt(t)
+	return tluo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tluo *TaskLockUpdateOne) SetNillableExpiresAt(t *time.Time) *TaskLockUpdateOne {
+	if t != nil {
+		tluo.SetExpiresAt(*t)
+	}
+	return tluo
+}
+
+// Mutation returns the TaskLockMutation object of the builder.
+func (tluo *TaskLockUpdateOne) Mutation() *TaskLockMutation {
+	return tluo.mutation
+}
+
+// Where appends a list predicates to the TaskLockUpdate builder.
+func (tluo *TaskLockUpdateOne) Where(ps ...predicate.TaskLock) *TaskLockUpdateOne {
+	tluo.mutation.Where(ps...)
+	return tluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tluo *TaskLockUpdateOne) Select(field string, fields ...string) *TaskLockUpdateOne {
+	tluo.fields = append([]string{field}, fields...)
+	return tluo
+}
+
+// Save executes the query and returns the updated TaskLock entity.
+func (tluo *TaskLockUpdateOne) Save(ctx context.Context) (*TaskLock, error) {
+	tluo.defaults()
+	return withHooks(ctx, tluo.sqlSave, tluo.mutation, tluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tluo *TaskLockUpdateOne) SaveX(ctx context.Context) *TaskLock {
+	node, err := tluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tluo *TaskLockUpdateOne) Exec(ctx context.Context) error {
+	_, err := tluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tluo *TaskLockUpdateOne) ExecX(ctx context.Context) {
+	if err := tluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tluo *TaskLockUpdateOne) defaults() {
+	if _, ok := tluo.mutation.UpdateTime(); !ok {
+		v := tasklock.UpdateDefaultUpdateTime()
+		tluo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (tluo *TaskLockUpdateOne) sqlSave(ctx context.Context) (_node *TaskLock, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tasklock.Table, tasklock.Columns, sqlgraph.NewFieldSpec(tasklock.FieldID, field.TypeUUID))
+	id, ok := tluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskLock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tasklock.FieldID)
+		for _, f := range fields {
+			if !tasklock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tasklock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tluo.mutation.UpdateTime(); ok {
+		_spec.SetField(tasklock.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := tluo.mutation.Holder(); ok {
+		_spec.SetField(tasklock.FieldHolder, field.TypeString, value)
+	}
+	if value, ok := tluo.mutation.ExpiresAt(); ok {
+		_spec.SetField(tasklock.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &TaskLock{config: tluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tasklock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tluo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
+)
+
+// TaskRun is the model entity for the TaskRun schema.
+type TaskRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TaskName holds the value of the "task_name" field.
+	TaskName string `json:"task_name,omitempty"`
+	// Status holds the value of the "status" field.
+	Status schema.TaskRunStatus `json:"status,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger schema.TaskRunTrigger `json:"trigger,omitempty"`
+	// Holder holds the value of the "holder" field.
+	Holder string `json:"holder,omitempty"`
+	// EndTime holds the value of the "end_time" field.
+	EndTime *time.Time `json:"end_time,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// ItemsProcessed holds the value of the "items_processed" field.
+	ItemsProcessed int64 `json:"items_processed,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskrun.FieldItemsProcessed:
+			values[i] = new(sql.NullInt64)
+		case taskrun.FieldTaskName, taskrun.FieldStatus, taskrun.FieldTrigger, taskrun.FieldHolder, taskrun.FieldError:
+			values[i] = new(sql.NullString)
+		case taskrun.FieldCreateTime, taskrun.FieldUpdateTime, taskrun.FieldEndTime:
+			values[i] = new(sql.NullTime)
+		case taskrun.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskRun fields.
+func (tr *TaskRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskrun.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tr.ID = *value
+			}
+		case taskrun.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				tr.CreateTime = value.Time
+			}
+		case taskrun.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				tr.UpdateTime = value.Time
+			}
+		case taskrun.FieldTaskName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_name", values[i])
+			} else if value.Valid {
+				tr.TaskName = value.String
+			}
+		case taskrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				tr.Status = schema.TaskRunStatus(value.String)
+			}
+		case taskrun.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				tr.Trigger = schema.TaskRunTrigger(value.String)
+			}
+		case taskrun.FieldHolder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holder", values[i])
+			} else if value.Valid {
+				tr.Holder = value.String
+			}
+		case taskrun.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				tr.EndTime = new(time.Time)
+				*tr.EndTime = value.Time
+			}
+		case taskrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				tr.Error = value.String
+			}
+		case taskrun.FieldItemsProcessed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field items_processed", values[i])
+			} else if value.Valid {
+				tr.ItemsProcessed = value.Int64
+			}
+		default:
+			tr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskRun.
+// This includes values selected through modifiers, order, etc.
+func (tr *TaskRun) Value(name string) (ent.Value, error) {
+	return tr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TaskRun.
+// Note that you need to call TaskRun.Unwrap() before calling this method if this TaskRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TaskRun) Update() *TaskRunUpdateOne {
+	return NewTaskRunClient(tr.config).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TaskRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TaskRun) Unwrap() *TaskRun {
+	_tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskRun is not a transactional entity")
+	}
+	tr.config.driver = _tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TaskRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tr.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(tr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(tr.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("task_name=")
+	builder.WriteString(tr.TaskName)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("holder=")
+	builder.WriteString(tr.Holder)
+	builder.WriteString(", ")
+	if v := tr.EndTime; v != nil {
+		builder.WriteString("end_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(tr.Error)
+	builder.WriteString(", ")
+	builder.WriteString("items_processed=")
+	builder.WriteString(fmt.Sprintf("%v", tr.ItemsProcessed))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskRuns is a parsable slice of TaskRun.
+type TaskRuns []*TaskRun
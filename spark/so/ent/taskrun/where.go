@@ -0,0 +1,542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// TaskName applies equality check predicate on the "task_name" field. It's identical to TaskNameEQ.
+func TaskName(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTaskName, v))
+}
+
+// Holder applies equality check predicate on the "holder" field. It's identical to HolderEQ.
+func Holder(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldHolder, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldEndTime, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldError, v))
+}
+
+// ItemsProcessed applies equality check predicate on the "items_processed" field. It's identical to ItemsProcessedEQ.
+func ItemsProcessed(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldItemsProcessed, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// TaskNameEQ applies the EQ predicate on the "task_name" field.
+func TaskNameEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTaskName, v))
+}
+
+// TaskNameNEQ applies the NEQ predicate on the "task_name" field.
+func TaskNameNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldTaskName, v))
+}
+
+// TaskNameIn applies the In predicate on the "task_name" field.
+func TaskNameIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldTaskName, vs...))
+}
+
+// TaskNameNotIn applies the NotIn predicate on the "task_name" field.
+func TaskNameNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldTaskName, vs...))
+}
+
+// TaskNameGT applies the GT predicate on the "task_name" field.
+func TaskNameGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldTaskName, v))
+}
+
+// TaskNameGTE applies the GTE predicate on the "task_name" field.
+func TaskNameGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldTaskName, v))
+}
+
+// TaskNameLT applies the LT predicate on the "task_name" field.
+func TaskNameLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldTaskName, v))
+}
+
+// TaskNameLTE applies the LTE predicate on the "task_name" field.
+func TaskNameLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldTaskName, v))
+}
+
+// TaskNameContains applies the Contains predicate on the "task_name" field.
+func TaskNameContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldTaskName, v))
+}
+
+// TaskNameHasPrefix applies the HasPrefix predicate on the "task_name" field.
+func TaskNameHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldTaskName, v))
+}
+
+// TaskNameHasSuffix applies the HasSuffix predicate on the "task_name" field.
+func TaskNameHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldTaskName, v))
+}
+
+// TaskNameEqualFold applies the EqualFold predicate on the "task_name" field.
+func TaskNameEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldTaskName, v))
+}
+
+// TaskNameContainsFold applies the ContainsFold predicate on the "task_name" field.
+func TaskNameContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldTaskName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v schema.TaskRunStatus) predicate.TaskRun {
+	vc := v
+	return predicate.TaskRun(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v schema.TaskRunStatus) predicate.TaskRun {
+	vc := v
+	return predicate.TaskRun(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...schema.TaskRunStatus) predicate.TaskRun {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskRun(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...schema.TaskRunStatus) predicate.TaskRun {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskRun(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v schema.TaskRunTrigger) predicate.TaskRun {
+	vc := v
+	return predicate.TaskRun(sql.FieldEQ(FieldTrigger, vc))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v schema.TaskRunTrigger) predicate.TaskRun {
+	vc := v
+	return predicate.TaskRun(sql.FieldNEQ(FieldTrigger, vc))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...schema.TaskRunTrigger) predicate.TaskRun {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskRun(sql.FieldIn(FieldTrigger, v...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...schema.TaskRunTrigger) predicate.TaskRun {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TaskRun(sql.FieldNotIn(FieldTrigger, v...))
+}
+
+// HolderEQ applies the EQ predicate on the "holder" field.
+func HolderEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldHolder, v))
+}
+
+// HolderNEQ applies the NEQ predicate on the "holder" field.
+func HolderNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldHolder, v))
+}
+
+// HolderIn applies the In predicate on the "holder" field.
+func HolderIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldHolder, vs...))
+}
+
+// HolderNotIn applies the NotIn predicate on the "holder" field.
+func HolderNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldHolder, vs...))
+}
+
+// HolderGT applies the GT predicate on the "holder" field.
+func HolderGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldHolder, v))
+}
+
+// HolderGTE applies the GTE predicate on the "holder" field.
+func HolderGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldHolder, v))
+}
+
+// HolderLT applies the LT predicate on the "holder" field.
+func HolderLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldHolder, v))
+}
+
+// HolderLTE applies the LTE predicate on the "holder" field.
+func HolderLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldHolder, v))
+}
+
+// HolderContains applies the Contains predicate on the "holder" field.
+func HolderContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldHolder, v))
+}
+
+// HolderHasPrefix applies the HasPrefix predicate on the "holder" field.
+func HolderHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldHolder, v))
+}
+
+// HolderHasSuffix applies the HasSuffix predicate on the "holder" field.
+func HolderHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldHolder, v))
+}
+
+// HolderEqualFold applies the EqualFold predicate on the "holder" field.
+func HolderEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldHolder, v))
+}
+
+// HolderContainsFold applies the ContainsFold predicate on the "holder" field.
+func HolderContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldHolder, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldEndTime, v))
+}
+
+// EndTimeIsNil applies the IsNil predicate on the "end_time" field.
+func EndTimeIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldEndTime))
+}
+
+// EndTimeNotNil applies the NotNil predicate on the "end_time" field.
+func EndTimeNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldEndTime))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// ItemsProcessedEQ applies the EQ predicate on the "items_processed" field.
+func ItemsProcessedEQ(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldItemsProcessed, v))
+}
+
+// ItemsProcessedNEQ applies the NEQ predicate on the "items_processed" field.
+func ItemsProcessedNEQ(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldItemsProcessed, v))
+}
+
+// ItemsProcessedIn applies the In predicate on the "items_processed" field.
+func ItemsProcessedIn(vs ...int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldItemsProcessed, vs...))
+}
+
+// ItemsProcessedNotIn applies the NotIn predicate on the "items_processed" field.
+func ItemsProcessedNotIn(vs ...int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldItemsProcessed, vs...))
+}
+
+// ItemsProcessedGT applies the GT predicate on the "items_processed" field.
+func ItemsProcessedGT(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldItemsProcessed, v))
+}
+
+// ItemsProcessedGTE applies the GTE predicate on the "items_processed" field.
+func ItemsProcessedGTE(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldItemsProcessed, v))
+}
+
+// ItemsProcessedLT applies the LT predicate on the "items_processed" field.
+func ItemsProcessedLT(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldItemsProcessed, v))
+}
+
+// ItemsProcessedLTE applies the LTE predicate on the "items_processed" field.
+func ItemsProcessedLTE(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldItemsProcessed, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
+)
+
+// TaskRunCreate is the builder for creating a TaskRun entity.
+type TaskRunCreate struct {
+	config
+	mutation *TaskRunMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (trc *TaskRunCreate) SetCreateTime(t time.Time) *TaskRunCreate {
+	trc.mutation.SetCreateTime(t)
+	return trc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (trc *TaskRunCreate) SetNillableCreateTime(t *time.Time) *TaskRunCreate {
+	if t != nil {
+		trc.SetCreateTime(*t)
+	}
+	return trc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (trc *TaskRunCreate) SetUpdateTime(t time.Time) *TaskRunCreate {
+	trc.mutation.SetUpdateTime(t)
+	return trc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (trc *TaskRunCreate) SetNillableUpdateTime(t *time.Time) *TaskRunCreate {
+	if t != nil {
+		trc.SetUpdateTime(*t)
+	}
+	return trc
+}
+
+// SetTaskName sets the "task_name" field.
+func (trc *TaskRunCreate) SetTaskName(s string) *TaskRunCreate {
+	trc.mutation.SetTaskName(s)
+	return trc
+}
+
+// SetStatus sets the "status" field.
+func (trc *TaskRunCreate) SetStatus(srs schema.TaskRunStatus) *TaskRunCreate {
+	trc.mutation.SetStatus(srs)
+	return trc
+}
+
+// SetTrigger sets the "trigger" field.
+func (trc *TaskRunCreate) SetTrigger(srt schema.TaskRunTrigger) *TaskRunCreate {
+	trc.mutation.SetTrigger(srt)
+	return trc
+}
+
+// SetHolder sets the "holder" field.
+func (trc *TaskRunCreate) SetHolder(s string) *TaskRunCreate {
+	trc.mutation.SetHolder(s)
+	return trc
+}
+
+// SetEndTime sets the "end_time" field.
+func (trc *TaskRunCreate) SetEndTime(t time.Time) *TaskRunCreate {
+	trc.mutation.SetEndTime(t)
+	return trc
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (trc *TaskRunCreate) SetNillableEndTime(t *time.Time) *TaskRunCreate {
+	if t != nil {
+		trc.SetEndTime(*t)
+	}
+	return trc
+}
+
+// SetError sets the "error" field.
+func (trc *TaskRunCreate) SetError(s string) *TaskRunCreate {
+	trc.mutation.SetError(s)
+	return trc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (trc *TaskRunCreate) SetNillableError(s *string) *TaskRunCreate {
+	if s != nil {
+		trc.SetError(*s)
+	}
+	return trc
+}
+
+// SetItemsProcessed sets the "items_processed" field.
+func (trc *TaskRunCreate) SetItemsProcessed(i int64) *TaskRunCreate {
+	trc.mutation.SetItemsProcessed(i)
+	return trc
+}
+
+// SetNillableItemsProcessed sets the "items_processed" field if the given value is not nil.
+func (trc *TaskRunCreate) SetNillableItemsProcessed(i *int64) *TaskRunCreate {
+	if i != nil {
+		trc.SetItemsProcessed(*i)
+	}
+	return trc
+}
+
+// SetID sets the "id" field.
+func (trc *TaskRunCreate) SetID(u uuid.UUID) *TaskRunCreate {
+	trc.mutation.SetID(u)
+	return trc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (trc *TaskRunCreate) SetNillableID(u *uuid.UUID) *TaskRunCreate {
+	if u != nil {
+		trc.SetID(*u)
+	}
+	return trc
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (trc *TaskRunCreate) Mutation() *TaskRunMutation {
+	return trc.mutation
+}
+
+// Save creates the TaskRun in the database.
+func (trc *TaskRunCreate) Save(ctx context.Context) (*TaskRun, error) {
+	trc.defaults()
+	return withHooks(ctx, trc.sqlSave, trc.mutation, trc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TaskRunCreate) SaveX(ctx context.Context) *TaskRun {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TaskRunCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TaskRunCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trc *TaskRunCreate) defaults() {
+	if _, ok := trc.mutation.CreateTime(); !ok {
+		v := taskrun.DefaultCreateTime()
+		trc.mutation.SetCreateTime(v)
+	}
+	if _, ok := trc.mutation.UpdateTime(); !ok {
+		v := taskrun.DefaultUpdateTime()
+		trc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := trc.mutation.ItemsProcessed(); !ok {
+		v := taskrun.DefaultItemsProcessed
+		trc.mutation.SetItemsProcessed(v)
+	}
+	if _, ok := trc.mutation.ID(); !ok {
+		v := taskrun.DefaultID()
+		trc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TaskRunCreate) check() error {
+	if _, ok := trc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TaskRun.create_time"`)}
+	}
+	if _, ok := trc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "TaskRun.update_time"`)}
+	}
+	if _, ok := trc.mutation.TaskName(); !ok {
+		return &ValidationError{Name: "task_name", err: errors.New(`ent: missing required field "TaskRun.task_name"`)}
+	}
+	if v, ok := trc.mutation.TaskName(); ok {
+		if err := taskrun.TaskNameValidator(v); err != nil {
+			return &ValidationError{Name: "task_name", err: fmt.Errorf(`ent: validator failed for field "TaskRun.task_name": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TaskRun.status"`)}
+	}
+	if v, ok := trc.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "TaskRun.trigger"`)}
+	}
+	if v, ok := trc.mutation.Trigger(); ok {
+		if err := taskrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "TaskRun.trigger": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.Holder(); !ok {
+		return &ValidationError{Name: "holder", err: errors.New(`ent: missing required field "TaskRun.holder"`)}
+	}
+	if _, ok := trc.mutation.ItemsProcessed(); !ok {
+		return &ValidationError{Name: "items_processed", err: errors.New(`ent: missing required field "TaskRun.items_processed"`)}
+	}
+	return nil
+}
+
+func (trc *TaskRunCreate) sqlSave(ctx context.Context) (*TaskRun, error) {
+	if err := trc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	trc.mutation.id = &_node.ID
+	trc.mutation.done = true
+	return _node, nil
+}
+
+func (trc *TaskRunCreate) createSpec() (*TaskRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskRun{config: trc.config}
+		_spec = sqlgraph.NewCreateSpec(taskrun.Table, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUUID))
+	)
+	if id, ok := trc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := trc.mutation.CreateTime(); ok {
+		_spec.SetField(taskrun.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := trc.mutation.UpdateTime(); ok {
+		_spec.SetField(taskrun.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := trc.mutation.TaskName(); ok {
+		_spec.SetField(taskrun.FieldTaskName, field.TypeString, value)
+		_node.TaskName = value
+	}
+	if value, ok := trc.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := trc.mutation.Trigger(); ok {
+		_spec.SetField(taskrun.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := trc.mutation.Holder(); ok {
+		_spec.SetField(taskrun.FieldHolder, field.TypeString, value)
+		_node.Holder = value
+	}
+	if value, ok := trc.mutation.EndTime(); ok {
+		_spec.SetField(taskrun.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = &value
+	}
+	if value, ok := trc.mutation.Error(); ok {
+		_spec.SetField(taskrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := trc.mutation.ItemsProcessed(); ok {
+		_spec.SetField(taskrun.FieldItemsProcessed, field.TypeInt64, value)
+		_node.ItemsProcessed = value
+	}
+	return _node, _spec
+}
+
+// TaskRunCreateBulk is the builder for creating many TaskRun entities in bulk.
+type TaskRunCreateBulk struct {
+	config
+	err      error
+	builders []*TaskRunCreate
+}
+
+// Save creates the TaskRun entities in the database.
+func (trcb *TaskRunCreateBulk) Save(ctx context.Context) ([]*TaskRun, error) {
+	if trcb.err != nil {
+		return nil, trcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TaskRun, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TaskRunCreateBulk) SaveX(ctx context.Context) []*TaskRun {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TaskRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TaskRunCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
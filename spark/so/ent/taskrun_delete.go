@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
+)
+
+// TaskRunDelete is the builder for deleting a TaskRun entity.
+type TaskRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskRunMutation
+}
+
+// Where appends a list predicates to the TaskRunDelete builder.
+func (trd *TaskRunDelete) Where(ps ...predicate.TaskRun) *TaskRunDelete {
+	trd.mutation.Where(ps...)
+	return trd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (trd *TaskRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, trd.sqlExec, trd.mutation, trd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trd *TaskRunDelete) ExecX(ctx context.Context) int {
+	n, err := trd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (trd *TaskRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskrun.Table, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUUID))
+	if ps := trd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, trd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	trd.mutation.done = true
+	return affected, err
+}
+
+// TaskRunDeleteOne is the builder for deleting a single TaskRun entity.
+type TaskRunDeleteOne struct {
+	trd *TaskRunDelete
+}
+
+// Where appends a list predicates to the TaskRunDelete builder.
+func (trdo *TaskRunDeleteOne) Where(ps ...predicate.TaskRun) *TaskRunDeleteOne {
+	trdo.trd.mutation.Where(ps...)
+	return trdo
+}
+
+// Exec executes the deletion query.
+func (trdo *TaskRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := trdo.trd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trdo *TaskRunDeleteOne) ExecX(ctx context.Context) {
+	if err := trdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
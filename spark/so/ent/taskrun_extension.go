@@ -0,0 +1,49 @@
+package ent
+
+import (
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MarshalProto converts a TaskRun to a spark admin protobuf TaskRun.
+func (r *TaskRun) MarshalProto() *pbadmin.TaskRun {
+	run := &pbadmin.TaskRun{
+		Id:             r.ID.String(),
+		TaskName:       r.TaskName,
+		Status:         taskRunStatusProto(r.Status),
+		Trigger:        taskRunTriggerProto(r.Trigger),
+		Holder:         r.Holder,
+		StartTime:      timestamppb.New(r.CreateTime),
+		Error:          r.Error,
+		ItemsProcessed: r.ItemsProcessed,
+	}
+	if r.EndTime != nil {
+		run.EndTime = timestamppb.New(*r.EndTime)
+	}
+	return run
+}
+
+func taskRunStatusProto(status schema.TaskRunStatus) pbadmin.TaskRunStatus {
+	switch status {
+	case schema.TaskRunStatusRunning:
+		return pbadmin.TaskRunStatus_TASK_RUN_STATUS_RUNNING
+	case schema.TaskRunStatusSucceeded:
+		return pbadmin.TaskRunStatus_TASK_RUN_STATUS_SUCCEEDED
+	case schema.TaskRunStatusFailed:
+		return pbadmin.TaskRunStatus_TASK_RUN_STATUS_FAILED
+	default:
+		return pbadmin.TaskRunStatus_TASK_RUN_STATUS_UNSPECIFIED
+	}
+}
+
+func taskRunTriggerProto(trigger schema.TaskRunTrigger) pbadmin.TaskRunTrigger {
+	switch trigger {
+	case schema.TaskRunTriggerScheduled:
+		return pbadmin.TaskRunTrigger_TASK_RUN_TRIGGER_SCHEDULED
+	case schema.TaskRunTriggerManual:
+		return pbadmin.TaskRunTrigger_TASK_RUN_TRIGGER_MANUAL
+	default:
+		return pbadmin.TaskRunTrigger_TASK_RUN_TRIGGER_UNSPECIFIED
+	}
+}
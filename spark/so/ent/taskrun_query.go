@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
+)
+
+// TaskRunQuery is the builder for querying TaskRun entities.
+type TaskRunQuery struct {
+	config
+	ctx        *QueryContext
+	order      []taskrun.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TaskRun
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskRunQuery builder.
+func (trq *TaskRunQuery) Where(ps ...predicate.TaskRun) *TaskRunQuery {
+	trq.predicates = append(trq.predicates, ps...)
+	return trq
+}
+
+// Limit the number of records to be returned by this query.
+func (trq *TaskRunQuery) Limit(limit int) *TaskRunQuery {
+	trq.ctx.Limit = &limit
+	return trq
+}
+
+// Offset to start from.
+func (trq *TaskRunQuery) Offset(offset int) *TaskRunQuery {
+	trq.ctx.Offset = &offset
+	return trq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (trq *TaskRunQuery) Unique(unique bool) *TaskRunQuery {
+	trq.ctx.Unique = &unique
+	return trq
+}
+
+// Order specifies how the records should be ordered.
+func (trq *TaskRunQuery) Order(o ...taskrun.OrderOption) *TaskRunQuery {
+	trq.order = append(trq.order, o...)
+	return trq
+}
+
+// First returns the first TaskRun entity from the query.
+// Returns a *NotFoundError when no TaskRun was found.
+func (trq *TaskRunQuery) First(ctx context.Context) (*TaskRun, error) {
+	nodes, err := trq.Limit(1).All(setContextOp(ctx, trq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskrun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (trq *TaskRunQuery) FirstX(ctx context.Context) *TaskRun {
+	node, err := trq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskRun ID from the query.
+// Returns a *NotFoundError when no TaskRun ID was found.
+func (trq *TaskRunQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = trq.Limit(1).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskrun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (trq *TaskRunQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := trq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskRun entity is found.
+// Returns a *NotFoundError when no TaskRun entities are found.
+func (trq *TaskRunQuery) Only(ctx context.Context) (*TaskRun, error) {
+	nodes, err := trq.Limit(2).All(setContextOp(ctx, trq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskrun.Label}
+	default:
+		return nil, &NotSingularError{taskrun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (trq *TaskRunQuery) OnlyX(ctx context.Context) *TaskRun {
+	node, err := trq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskRun ID in the query.
+// Returns a *NotSingularError when more than one TaskRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (trq *TaskRunQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = trq.Limit(2).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskrun.Label}
+	default:
+		err = &NotSingularError{taskrun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (trq *TaskRunQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := trq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskRuns.
+func (trq *TaskRunQuery) All(ctx context.Context) ([]*TaskRun, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryAll)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskRun, *TaskRunQuery]()
+	return withInterceptors[[]*TaskRun](ctx, trq, qr, trq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (trq *TaskRunQuery) AllX(ctx context.Context) []*TaskRun {
+	nodes, err := trq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskRun IDs.
+func (trq *TaskRunQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if trq.ctx.Unique == nil && trq.path != nil {
+		trq.Unique(true)
+	}
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryIDs)
+	if err = trq.Select(taskrun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (trq *TaskRunQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := trq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (trq *TaskRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryCount)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, trq, querierCount[*TaskRunQuery](), trq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (trq *TaskRunQuery) CountX(ctx context.Context) int {
+	count, err := trq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (trq *TaskRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryExist)
+	switch _, err := trq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (trq *TaskRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := trq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (trq *TaskRunQuery) Clone() *TaskRunQuery {
+	if trq == nil {
+		return nil
+	}
+	return &TaskRunQuery{
+		config:     trq.config,
+		ctx:        trq.ctx.Clone(),
+		order:      append([]taskrun.OrderOption{}, trq.order...),
+		inters:     append([]Interceptor{}, trq.inters...),
+		predicates: append([]predicate.TaskRun{}, trq.predicates...),
+		// clone intermediate query.
+		sql:  trq.sql.Clone(),
+		path: trq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskRun.Query().
+//		GroupBy(taskrun.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (trq *TaskRunQuery) GroupBy(field string, fields ...string) *TaskRunGroupBy {
+	trq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskRunGroupBy{build: trq}
+	grbuild.flds = &trq.ctx.Fields
+	grbuild.label = taskrun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.TaskRun.Query().
+//		Select(taskrun.FieldCreateTime).
+//		Scan(ctx, &v)
+func (trq *TaskRunQuery) Select(fields ...string) *TaskRunSelect {
+	trq.ctx.Fields = append(trq.ctx.Fields, fields...)
+	sbuild := &TaskRunSelect{TaskRunQuery: trq}
+	sbuild.label = taskrun.Label
+	sbuild.flds, sbuild.scan = &trq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskRunSelect configured with the given aggregations.
+func (trq *TaskRunQuery) Aggregate(fns ...AggregateFunc) *TaskRunSelect {
+	return trq.Select().Aggregate(fns...)
+}
+
+func (trq *TaskRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range trq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, trq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range trq.ctx.Fields {
+		if !taskrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if trq.path != nil {
+		prev, err := trq.path(ctx)
+		if err != nil {
+			return err
+		}
+		trq.sql = prev
+	}
+	return nil
+}
+
+func (trq *TaskRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskRun, error) {
+	var (
+		nodes = []*TaskRun{}
+		_spec = trq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskRun{config: trq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(trq.modifiers) > 0 {
+		_spec.Modifiers = trq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, trq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (trq *TaskRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := trq.querySpec()
+	if len(trq.modifiers) > 0 {
+		_spec.Modifiers = trq.modifiers
+	}
+	_spec.Node.Columns = trq.ctx.Fields
+	if len(trq.ctx.Fields) > 0 {
+		_spec.Unique = trq.ctx.Unique != nil && *trq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, trq.driver, _spec)
+}
+
+func (trq *TaskRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskrun.Table, taskrun.Columns, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUUID))
+	_spec.From = trq.sql
+	if unique := trq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if trq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := trq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskrun.FieldID)
+		for i := range fields {
+			if fields[i] != taskrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := trq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := trq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (trq *TaskRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(trq.driver.Dialect())
+	t1 := builder.Table(taskrun.Table)
+	columns := trq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskrun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if trq.sql != nil {
+		selector = trq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if trq.ctx.Unique != nil && *trq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range trq.modifiers {
+		m(selector)
+	}
+	for _, p := range trq.predicates {
+		p(selector)
+	}
+	for _, p := range trq.order {
+		p(selector)
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (trq *TaskRunQuery) ForUpdate(opts ...sql.LockOption) *TaskRunQuery {
+	if trq.driver.Dialect() == dialect.Postgres {
+		trq.Unique(false)
+	}
+	trq.modifiers = append(trq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return trq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (trq *TaskRunQuery) ForShare(opts ...sql.LockOption) *TaskRunQuery {
+	if trq.driver.Dialect() == dialect.Postgres {
+		trq.Unique(false)
+	}
+	trq.modifiers = append(trq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return trq
+}
+
+// TaskRunGroupBy is the group-by builder for TaskRun entities.
+type TaskRunGroupBy struct {
+	selector
+	build *TaskRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (trgb *TaskRunGroupBy) Aggregate(fns ...AggregateFunc) *TaskRunGroupBy {
+	trgb.fns = append(trgb.fns, fns...)
+	return trgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trgb *TaskRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trgb.build.ctx, ent.OpQueryGroupBy)
+	if err := trgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskRunQuery, *TaskRunGroupBy](ctx, trgb.build, trgb, trgb.build.inters, v)
+}
+
+func (trgb *TaskRunGroupBy) sqlScan(ctx context.Context, root *TaskRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(trgb.fns))
+	for _, fn := range trgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*trgb.flds)+len(trgb.fns))
+		for _, f := range *trgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*trgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskRunSelect is the builder for selecting fields of TaskRun entities.
+type TaskRunSelect struct {
+	*TaskRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (trs *TaskRunSelect) Aggregate(fns ...AggregateFunc) *TaskRunSelect {
+	trs.fns = append(trs.fns, fns...)
+	return trs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trs *TaskRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trs.ctx, ent.OpQuerySelect)
+	if err := trs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskRunQuery, *TaskRunSelect](ctx, trs.TaskRunQuery, trs, trs.inters, v)
+}
+
+func (trs *TaskRunSelect) sqlScan(ctx context.Context, root *TaskRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(trs.fns))
+	for _, fn := range trs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*trs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
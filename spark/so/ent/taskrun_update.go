@@ -0,0 +1,431 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
+)
+
+// TaskRunUpdate is the builder for updating TaskRun entities.
+type TaskRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskRunMutation
+}
+
+// Where appends a list predicates to the TaskRunUpdate builder.
+func (tru *TaskRunUpdate) Where(ps ...predicate.TaskRun) *TaskRunUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tru *TaskRunUpdate) SetUpdateTime(t time.Time) *TaskRunUpdate {
+	tru.mutation.SetUpdateTime(t)
+	return tru
+}
+
+// SetStatus sets the "status" field.
+func (tru *TaskRunUpdate) SetStatus(srs schema.TaskRunStatus) *TaskRunUpdate {
+	tru.mutation.SetStatus(srs)
+	return tru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tru *TaskRunUpdate) SetNillableStatus(srs *schema.TaskRunStatus) *TaskRunUpdate {
+	if srs != nil {
+		tru.SetStatus(*srs)
+	}
+	return tru
+}
+
+// SetEndTime sets the "end_time" field.
+func (tru *TaskRunUpdate) SetEndTime(t time.Time) *TaskRunUpdate {
+	tru.mutation.SetEndTime(t)
+	return tru
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (tru *TaskRunUpdate) SetNillableEndTime(t *time.Time) *TaskRunUpdate {
+	if t != nil {
+		tru.SetEndTime(*t)
+	}
+	return tru
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (tru *TaskRunUpdate) ClearEndTime() *TaskRunUpdate {
+	tru.mutation.ClearEndTime()
+	return tru
+}
+
+// SetError sets the "error" field.
+func (tru *TaskRunUpdate) SetError(s string) *TaskRunUpdate {
+	tru.mutation.SetError(s)
+	return tru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (tru *TaskRunUpdate) SetNillableError(s *string) *TaskRunUpdate {
+	if s != nil {
+		tru.SetError(*s)
+	}
+	return tru
+}
+
+// ClearError clears the value of the "error" field.
+func (tru *TaskRunUpdate) ClearError() *TaskRunUpdate {
+	tru.mutation.ClearError()
+	return tru
+}
+
+// SetItemsProcessed sets the "items_processed" field.
+func (tru *TaskRunUpdate) SetItemsProcessed(i int64) *TaskRunUpdate {
+	tru.mutation.ResetItemsProcessed()
+	tru.mutation.SetItemsProcessed(i)
+	return tru
+}
+
+// SetNillableItemsProcessed sets the "items_processed" field if the given value is not nil.
+func (tru *TaskRunUpdate) SetNillableItemsProcessed(i *int64) *TaskRunUpdate {
+	if i != nil {
+		tru.SetItemsProcessed(*i)
+	}
+	return tru
+}
+
+// AddItemsProcessed adds i to the "items_processed" field.
+func (tru *TaskRunUpdate) AddItemsProcessed(i int64) *TaskRunUpdate {
+	tru.mutation.AddItemsProcessed(i)
+	return tru
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (tru *TaskRunUpdate) Mutation() *TaskRunMutation {
+	return tru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TaskRunUpdate) Save(ctx context.Context) (int, error) {
+	tru.defaults()
+	return withHooks(ctx, tru.sqlSave, tru.mutation, tru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TaskRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TaskRunUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TaskRunUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tru *TaskRunUpdate) defaults() {
+	if _, ok := tru.mutation.UpdateTime(); !ok {
+		v := taskrun.UpdateDefaultUpdateTime()
+		tru.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tru *TaskRunUpdate) check() error {
+	if v, ok := tru.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tru *TaskRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrun.Table, taskrun.Columns, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUUID))
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tru.mutation.UpdateTime(); ok {
+		_spec.SetField(taskrun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := tru.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tru.mutation.EndTime(); ok {
+		_spec.SetField(taskrun.FieldEndTime, field.TypeTime, value)
+	}
+	if tru.mutation.EndTimeCleared() {
+		_spec.ClearField(taskrun.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := tru.mutation.Error(); ok {
+		_spec.SetField(taskrun.FieldError, field.TypeString, value)
+	}
+	if tru.mutation.ErrorCleared() {
+		_spec.ClearField(taskrun.FieldError, field.TypeString)
+	}
+	if value, ok := tru.mutation.ItemsProcessed(); ok {
+		_spec.SetField(taskrun.FieldItemsProcessed, field.TypeInt64, value)
+	}
+	if value, ok := tru.mutation.AddedItemsProcessed(); ok {
+		_spec.AddField(taskrun.FieldItemsProcessed, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tru.mutation.done = true
+	return n, nil
+}
+
+// TaskRunUpdateOne is the builder for updating a single TaskRun entity.
+type TaskRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskRunMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (truo *TaskRunUpdateOne) SetUpdateTime(t time.Time) *TaskRunUpdateOne {
+	truo.mutation.SetUpdateTime(t)
+	return truo
+}
+
+// SetStatus sets the "status" field.
+func (truo *TaskRunUpdateOne) SetStatus(srs schema.TaskRunStatus) *TaskRunUpdateOne {
+	truo.mutation.SetStatus(srs)
+	return truo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (truo *TaskRunUpdateOne) SetNillableStatus(srs *schema.TaskRunStatus) *TaskRunUpdateOne {
+	if srs != nil {
+		truo.SetStatus(*srs)
+	}
+	return truo
+}
+
+// SetEndTime sets the "end_time" field.
+func (truo *TaskRunUpdateOne) SetEndTime(t time.Time) *TaskRunUpdateOne {
+	truo.mutation.SetEndTime(t)
+	return truo
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (truo *TaskRunUpdateOne) SetNillableEndTime(t *time.Time) *TaskRunUpdateOne {
+	if t != nil {
+		truo.SetEndTime(*t)
+	}
+	return truo
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (truo *TaskRunUpdateOne) ClearEndTime() *TaskRunUpdateOne {
+	truo.mutation.ClearEndTime()
+	return truo
+}
+
+// SetError sets the "error" field.
+func (truo *TaskRunUpdateOne) SetError(s string) *TaskRunUpdateOne {
+	truo.mutation.SetError(s)
+	return truo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (truo *TaskRunUpdateOne) SetNillableError(s *string) *TaskRunUpdateOne {
+	if s != nil {
+		truo.SetError(*s)
+	}
+	return truo
+}
+
+// ClearError clears the value of the "error" field.
+func (truo *TaskRunUpdateOne) ClearError() *TaskRunUpdateOne {
+	truo.mutation.ClearError()
+	return truo
+}
+
+// SetItemsProcessed sets the "items_processed" field.
+func (truo *TaskRunUpdateOne) SetItemsProcessed(i int64) *TaskRunUpdateOne {
+	truo.mutation.ResetItemsProcessed()
+	truo.mutation.SetItemsProcessed(i)
+	return truo
+}
+
+// SetNillableItemsProcessed sets the "items_processed" field if the given value is not nil.
+func (truo *TaskRunUpdateOne) SetNillableItemsProcessed(i *int64) *TaskRunUpdateOne {
+	if i != nil {
+		truo.SetItemsProcessed(*i)
+	}
+	return truo
+}
+
+// AddItemsProcessed adds i to the "items_processed" field.
+func (truo *TaskRunUpdateOne) AddItemsProcessed(i int64) *TaskRunUpdateOne {
+	truo.mutation.AddItemsProcessed(i)
+	return truo
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (truo *TaskRunUpdateOne) Mutation() *TaskRunMutation {
+	return truo.mutation
+}
+
+// Where appends a list predicates to the TaskRunUpdate builder.
+func (truo *TaskRunUpdateOne) Where(ps ...predicate.TaskRun) *TaskRunUpdateOne {
+	truo.mutation.Where(ps...)
+	return truo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TaskRunUpdateOne) Select(field string, fields ...string) *TaskRunUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TaskRun entity.
+func (truo *TaskRunUpdateOne) Save(ctx context.Context) (*TaskRun, error) {
+	truo.defaults()
+	return withHooks(ctx, truo.sqlSave, truo.mutation, truo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TaskRunUpdateOne) SaveX(ctx context.Context) *TaskRun {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TaskRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TaskRunUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (truo *TaskRunUpdateOne) defaults() {
+	if _, ok := truo.mutation.UpdateTime(); !ok {
+		v := taskrun.UpdateDefaultUpdateTime()
+		truo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (truo *TaskRunUpdateOne) check() error {
+	if v, ok := truo.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (truo *TaskRunUpdateOne) sqlSave(ctx context.Context) (_node *TaskRun, err error) {
+	if err := truo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrun.Table, taskrun.Columns, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUUID))
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskrun.FieldID)
+		for _, f := range fields {
+			if !taskrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := truo.mutation.UpdateTime(); ok {
+		_spec.SetField(taskrun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := truo.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := truo.mutation.EndTime(); ok {
+		_spec.SetField(taskrun.FieldEndTime, field.TypeTime, value)
+	}
+	if truo.mutation.EndTimeCleared() {
+		_spec.ClearField(taskrun.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := truo.mutation.Error(); ok {
+		_spec.SetField(taskrun.FieldError, field.TypeString, value)
+	}
+	if truo.mutation.ErrorCleared() {
+		_spec.ClearField(taskrun.FieldError, field.TypeString)
+	}
+	if value, ok := truo.mutation.ItemsProcessed(); ok {
+		_spec.SetField(taskrun.FieldItemsProcessed, field.TypeInt64, value)
+	}
+	if value, ok := truo.mutation.AddedItemsProcessed(); ok {
+		_spec.AddField(taskrun.FieldItemsProcessed, field.TypeInt64, value)
+	}
+	_node = &TaskRun{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	truo.mutation.done = true
+	return _node, nil
+}
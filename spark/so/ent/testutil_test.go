@@ -0,0 +1,22 @@
+package ent_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTxContext opens an in-memory database of the given name and returns a context holding a
+// transaction on it, which is rolled back when the test ends.
+func newTestTxContext(t *testing.T, name string) context.Context {
+	db := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&_fk=1")
+	t.Cleanup(func() { db.Close() })
+	tx, err := db.Tx(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	return context.WithValue(context.Background(), ent.TxKey, tx)
+}
@@ -37,6 +37,10 @@ type Transfer struct {
 	ExpiryTime time.Time `json:"expiry_time,omitempty"`
 	// CompletionTime holds the value of the "completion_time" field.
 	CompletionTime *time.Time `json:"completion_time,omitempty"`
+	// CoordinatorIdentityPubkey holds the value of the "coordinator_identity_pubkey" field.
+	CoordinatorIdentityPubkey []byte `json:"coordinator_identity_pubkey,omitempty"`
+	// ClaimCoordinatorIdentityPubkey holds the value of the "claim_coordinator_identity_pubkey" field.
+	ClaimCoordinatorIdentityPubkey []byte `json:"claim_coordinator_identity_pubkey,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TransferQuery when eager-loading is set.
 	Edges        TransferEdges `json:"edges"`
@@ -66,7 +70,7 @@ func (*Transfer) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case transfer.FieldSenderIdentityPubkey, transfer.FieldReceiverIdentityPubkey:
+		case transfer.FieldSenderIdentityPubkey, transfer.FieldReceiverIdentityPubkey, transfer.FieldCoordinatorIdentityPubkey, transfer.FieldClaimCoordinatorIdentityPubkey:
 			values[i] = new([]byte)
 		case transfer.FieldTotalValue:
 			values[i] = new(sql.NullInt64)
@@ -152,6 +156,18 @@ func (t *Transfer) assignValues(columns []string, values []any) error {
 				t.CompletionTime = new(time.Time)
 				*t.CompletionTime = value.Time
 			}
+		case transfer.FieldCoordinatorIdentityPubkey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field coordinator_identity_pubkey", values[i])
+			} else if value != nil {
+				t.CoordinatorIdentityPubkey = *value
+			}
+		case transfer.FieldClaimCoordinatorIdentityPubkey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_coordinator_identity_pubkey", values[i])
+			} else if value != nil {
+				t.ClaimCoordinatorIdentityPubkey = *value
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -221,6 +237,12 @@ func (t *Transfer) String() string {
 		builder.WriteString("completion_time=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("coordinator_identity_pubkey=")
+	builder.WriteString(fmt.Sprintf("%v", t.CoordinatorIdentityPubkey))
+	builder.WriteString(", ")
+	builder.WriteString("claim_coordinator_identity_pubkey=")
+	builder.WriteString(fmt.Sprintf("%v", t.ClaimCoordinatorIdentityPubkey))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -35,6 +35,10 @@ const (
 	FieldExpiryTime = "expiry_time"
 	// FieldCompletionTime holds the string denoting the completion_time field in the database.
 	FieldCompletionTime = "completion_time"
+	// FieldCoordinatorIdentityPubkey holds the string denoting the coordinator_identity_pubkey field in the database.
+	FieldCoordinatorIdentityPubkey = "coordinator_identity_pubkey"
+	// FieldClaimCoordinatorIdentityPubkey holds the string denoting the claim_coordinator_identity_pubkey field in the database.
+	FieldClaimCoordinatorIdentityPubkey = "claim_coordinator_identity_pubkey"
 	// EdgeTransferLeaves holds the string denoting the transfer_leaves edge name in mutations.
 	EdgeTransferLeaves = "transfer_leaves"
 	// Table holds the table name of the transfer in the database.
@@ -60,6 +64,8 @@ var Columns = []string{
 	FieldType,
 	FieldExpiryTime,
 	FieldCompletionTime,
+	FieldCoordinatorIdentityPubkey,
+	FieldClaimCoordinatorIdentityPubkey,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
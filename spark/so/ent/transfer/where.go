@@ -92,6 +92,16 @@ func CompletionTime(v time.Time) predicate.Transfer {
 	return predicate.Transfer(sql.FieldEQ(FieldCompletionTime, v))
 }
 
+// CoordinatorIdentityPubkey applies equality check predicate on the "coordinator_identity_pubkey" field. It's identical to CoordinatorIdentityPubkeyEQ.
+func CoordinatorIdentityPubkey(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldCoordinatorIdentityPubkey, v))
+}
+
+// ClaimCoordinatorIdentityPubkey applies equality check predicate on the "claim_coordinator_identity_pubkey" field. It's identical to ClaimCoordinatorIdentityPubkeyEQ.
+func ClaimCoordinatorIdentityPubkey(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldClaimCoordinatorIdentityPubkey, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Transfer {
 	return predicate.Transfer(sql.FieldEQ(FieldCreateTime, v))
@@ -442,6 +452,106 @@ func CompletionTimeNotNil() predicate.Transfer {
 	return predicate.Transfer(sql.FieldNotNull(FieldCompletionTime))
 }
 
+// CoordinatorIdentityPubkeyEQ applies the EQ predicate on the "coordinator_identity_pubkey" field.
+func CoordinatorIdentityPubkeyEQ(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldCoordinatorIdentityPubkey, v))
+}
+
+// CoordinatorIdentityPubkeyNEQ applies the NEQ predicate on the "coordinator_identity_pubkey" field.
+func CoordinatorIdentityPubkeyNEQ(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldCoordinatorIdentityPubkey, v))
+}
+
+// CoordinatorIdentityPubkeyIn applies the In predicate on the "coordinator_identity_pubkey" field.
+func CoordinatorIdentityPubkeyIn(vs ...[]byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldCoordinatorIdentityPubkey, vs...))
+}
+
+// CoordinatorIdentityPubkeyNotIn applies the NotIn predicate on the "coordinator_identity_pubkey" field.
+func CoordinatorIdentityPubkeyNotIn(vs ...[]byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldCoordinatorIdentityPubkey, vs...))
+}
+
+// CoordinatorIdentityPubkeyGT applies the GT predicate on the "coordinator_identity_pubkey" field.
+func CoordinatorIdentityPubkeyGT(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldCoordinatorIdentityPubkey, v))
+}
+
+// CoordinatorIdentityPubkeyGTE applies the GTE predicate on the "coordinator_identity_pubkey" field.
+func CoordinatorIdentityPubkeyGTE(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldCoordinatorIdentityPubkey, v))
+}
+
+// CoordinatorIdentityPubkeyLT applies the LT predicate on the "coordinator_identity_pubkey" field.
+func CoordinatorIdentityPubkeyLT(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldCoordinatorIdentityPubkey, v))
+}
+
+// CoordinatorIdentityPubkeyLTE applies the LTE predicate on the "coordinator_identity_pubkey" field.
+func CoordinatorIdentityPubkeyLTE(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldCoordinatorIdentityPubkey, v))
+}
+
+// CoordinatorIdentityPubkeyIsNil applies the IsNil predicate on the "coordinator_identity_pubkey" field.
+func CoordinatorIdentityPubkeyIsNil() predicate.Transfer {
+	return predicate.Transfer(sql.FieldIsNull(FieldCoordinatorIdentityPubkey))
+}
+
+// CoordinatorIdentityPubkeyNotNil applies the NotNil predicate on the "coordinator_identity_pubkey" field.
+func CoordinatorIdentityPubkeyNotNil() predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotNull(FieldCoordinatorIdentityPubkey))
+}
+
+// ClaimCoordinatorIdentityPubkeyEQ applies the EQ predicate on the "claim_coordinator_identity_pubkey" field.
+func ClaimCoordinatorIdentityPubkeyEQ(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldClaimCoordinatorIdentityPubkey, v))
+}
+
+// ClaimCoordinatorIdentityPubkeyNEQ applies the NEQ predicate on the "claim_coordinator_identity_pubkey" field.
+func ClaimCoordinatorIdentityPubkeyNEQ(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldClaimCoordinatorIdentityPubkey, v))
+}
+
+// ClaimCoordinatorIdentityPubkeyIn applies the In predicate on the "claim_coordinator_identity_pubkey" field.
+func ClaimCoordinatorIdentityPubkeyIn(vs ...[]byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldClaimCoordinatorIdentityPubkey, vs...))
+}
+
+// ClaimCoordinatorIdentityPubkeyNotIn applies the NotIn predicate on the "claim_coordinator_identity_pubkey" field.
+func ClaimCoordinatorIdentityPubkeyNotIn(vs ...[]byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldClaimCoordinatorIdentityPubkey, vs...))
+}
+
+// ClaimCoordinatorIdentityPubkeyGT applies the GT predicate on the "claim_coordinator_identity_pubkey" field.
+func ClaimCoordinatorIdentityPubkeyGT(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldClaimCoordinatorIdentityPubkey, v))
+}
+
+// ClaimCoordinatorIdentityPubkeyGTE applies the GTE predicate on the "claim_coordinator_identity_pubkey" field.
+func ClaimCoordinatorIdentityPubkeyGTE(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldClaimCoordinatorIdentityPubkey, v))
+}
+
+// ClaimCoordinatorIdentityPubkeyLT applies the LT predicate on the "claim_coordinator_identity_pubkey" field.
+func ClaimCoordinatorIdentityPubkeyLT(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldClaimCoordinatorIdentityPubkey, v))
+}
+
+// ClaimCoordinatorIdentityPubkeyLTE applies the LTE predicate on the "claim_coordinator_identity_pubkey" field.
+func ClaimCoordinatorIdentityPubkeyLTE(v []byte) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldClaimCoordinatorIdentityPubkey, v))
+}
+
+// ClaimCoordinatorIdentityPubkeyIsNil applies the IsNil predicate on the "claim_coordinator_identity_pubkey" field.
+func ClaimCoordinatorIdentityPubkeyIsNil() predicate.Transfer {
+	return predicate.Transfer(sql.FieldIsNull(FieldClaimCoordinatorIdentityPubkey))
+}
+
+// ClaimCoordinatorIdentityPubkeyNotNil applies the NotNil predicate on the "claim_coordinator_identity_pubkey" field.
+func ClaimCoordinatorIdentityPubkeyNotNil() predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotNull(FieldClaimCoordinatorIdentityPubkey))
+}
+
 // HasTransferLeaves applies the HasEdge predicate on the "transfer_leaves" edge.
 func HasTransferLeaves() predicate.Transfer {
 	return predicate.Transfer(func(s *sql.Selector) {
@@ -101,6 +101,18 @@ func (tc *TransferCreate) SetNillableCompletionTime(t *time.Time) *TransferCreat
 	return tc
 }
 
+// SetCoordinatorIdentityPubkey sets the "coordinator_identity_pubkey" field.
+func (tc *TransferCreate) SetCoordinatorIdentityPubkey(b []byte) *TransferCreate {
+	tc.mutation.SetCoordinatorIdentityPubkey(b)
+	return tc
+}
+
+// SetClaimCoordinatorIdentityPubkey sets the "claim_coordinator_identity_pubkey" field.
+func (tc *TransferCreate) SetClaimCoordinatorIdentityPubkey(b []byte) *TransferCreate {
+	tc.mutation.SetClaimCoordinatorIdentityPubkey(b)
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TransferCreate) SetID(u uuid.UUID) *TransferCreate {
 	tc.mutation.SetID(u)
@@ -296,6 +308,14 @@ func (tc *TransferCreate) createSpec() (*Transfer, *sqlgraph.CreateSpec) {
 		_spec.SetField(transfer.FieldCompletionTime, field.TypeTime, value)
 		_node.CompletionTime = &value
 	}
+	if value, ok := tc.mutation.CoordinatorIdentityPubkey(); ok {
+		_spec.SetField(transfer.FieldCoordinatorIdentityPubkey, field.TypeBytes, value)
+		_node.CoordinatorIdentityPubkey = value
+	}
+	if value, ok := tc.mutation.ClaimCoordinatorIdentityPubkey(); ok {
+		_spec.SetField(transfer.FieldClaimCoordinatorIdentityPubkey, field.TypeBytes, value)
+		_node.ClaimCoordinatorIdentityPubkey = value
+	}
 	if nodes := tc.mutation.TransferLeavesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
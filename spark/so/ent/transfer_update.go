@@ -106,6 +106,18 @@ func (tu *TransferUpdate) ClearCompletionTime() *TransferUpdate {
 	return tu
 }
 
+// SetClaimCoordinatorIdentityPubkey sets the "claim_coordinator_identity_pubkey" field.
+func (tu *TransferUpdate) SetClaimCoordinatorIdentityPubkey(b []byte) *TransferUpdate {
+	tu.mutation.SetClaimCoordinatorIdentityPubkey(b)
+	return tu
+}
+
+// ClearClaimCoordinatorIdentityPubkey clears the value of the "claim_coordinator_identity_pubkey" field.
+func (tu *TransferUpdate) ClearClaimCoordinatorIdentityPubkey() *TransferUpdate {
+	tu.mutation.ClearClaimCoordinatorIdentityPubkey()
+	return tu
+}
+
 // AddTransferLeafeIDs adds the "transfer_leaves" edge to the TransferLeaf entity by IDs.
 func (tu *TransferUpdate) AddTransferLeafeIDs(ids ...uuid.UUID) *TransferUpdate {
 	tu.mutation.AddTransferLeafeIDs(ids...)
@@ -231,6 +243,15 @@ func (tu *TransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.CompletionTimeCleared() {
 		_spec.ClearField(transfer.FieldCompletionTime, field.TypeTime)
 	}
+	if tu.mutation.CoordinatorIdentityPubkeyCleared() {
+		_spec.ClearField(transfer.FieldCoordinatorIdentityPubkey, field.TypeBytes)
+	}
+	if value, ok := tu.mutation.ClaimCoordinatorIdentityPubkey(); ok {
+		_spec.SetField(transfer.FieldClaimCoordinatorIdentityPubkey, field.TypeBytes, value)
+	}
+	if tu.mutation.ClaimCoordinatorIdentityPubkeyCleared() {
+		_spec.ClearField(transfer.FieldClaimCoordinatorIdentityPubkey, field.TypeBytes)
+	}
 	if tu.mutation.TransferLeavesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -371,6 +392,18 @@ func (tuo *TransferUpdateOne) ClearCompletionTime() *TransferUpdateOne {
 	return tuo
 }
 
+// SetClaimCoordinatorIdentityPubkey sets the "claim_coordinator_identity_pubkey" field.
+func (tuo *TransferUpdateOne) SetClaimCoordinatorIdentityPubkey(b []byte) *TransferUpdateOne {
+	tuo.mutation.SetClaimCoordinatorIdentityPubkey(b)
+	return tuo
+}
+
+// ClearClaimCoordinatorIdentityPubkey clears the value of the "claim_coordinator_identity_pubkey" field.
+func (tuo *TransferUpdateOne) ClearClaimCoordinatorIdentityPubkey() *TransferUpdateOne {
+	tuo.mutation.ClearClaimCoordinatorIdentityPubkey()
+	return tuo
+}
+
 // AddTransferLeafeIDs adds the "transfer_leaves" edge to the TransferLeaf entity by IDs.
 func (tuo *TransferUpdateOne) AddTransferLeafeIDs(ids ...uuid.UUID) *TransferUpdateOne {
 	tuo.mutation.AddTransferLeafeIDs(ids...)
@@ -526,6 +559,15 @@ func (tuo *TransferUpdateOne) sqlSave(ctx context.Context) (_node *Transfer, err
 	if tuo.mutation.CompletionTimeCleared() {
 		_spec.ClearField(transfer.FieldCompletionTime, field.TypeTime)
 	}
+	if tuo.mutation.CoordinatorIdentityPubkeyCleared() {
+		_spec.ClearField(transfer.FieldCoordinatorIdentityPubkey, field.TypeBytes)
+	}
+	if value, ok := tuo.mutation.ClaimCoordinatorIdentityPubkey(); ok {
+		_spec.SetField(transfer.FieldClaimCoordinatorIdentityPubkey, field.TypeBytes, value)
+	}
+	if tuo.mutation.ClaimCoordinatorIdentityPubkeyCleared() {
+		_spec.ClearField(transfer.FieldClaimCoordinatorIdentityPubkey, field.TypeBytes)
+	}
 	if tuo.mutation.TransferLeavesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -9,6 +9,7 @@ import (
 	pbspark "github.com/lightsparkdev/spark/proto/spark"
 	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
 	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/errors"
 )
 
 // MarshalSparkProto converts a TreeNode to a spark protobuf TreeNode.
@@ -92,6 +93,16 @@ func (tn *TreeNode) getParentNodeID(ctx context.Context) *string {
 	return &parentNodeIDStr
 }
 
+// UnavailableReason returns the reason of the errors for the node when it is not available.
+func (tn *TreeNode) UnavailableReason() errors.Reason {
+	switch tn.Status {
+	case schema.TreeNodeStatusTransferLocked, schema.TreeNodeStatusSplitLocked, schema.TreeNodeStatusAggregateLock:
+		return errors.ReasonLeafLocked
+	default:
+		return errors.ReasonLeafNotAvailable
+	}
+}
+
 // MarkNodeAsLocked marks the node as locked.
 // It will only update the node status if it is in a state to be locked.
 func MarkNodeAsLocked(ctx context.Context, nodeID uuid.UUID, nodeStatus schema.TreeNodeStatus) error {
@@ -105,7 +116,7 @@ func MarkNodeAsLocked(ctx context.Context, nodeID uuid.UUID, nodeStatus schema.T
 		return err
 	}
 	if node.Status != schema.TreeNodeStatusAvailable {
-		return fmt.Errorf("node not in a state to be locked: %s", node.Status)
+		return errors.Reasonf(node.UnavailableReason(), "node not in a state to be locked: %s", node.Status)
 	}
 
 	return db.TreeNode.UpdateOne(node).SetStatus(nodeStatus).Exec(ctx)
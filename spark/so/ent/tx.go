@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,20 +14,50 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Archive is the client for interacting with the Archive builders.
+	Archive *ArchiveClient
+	// ArchivedTransfer is the client for interacting with the ArchivedTransfer builders.
+	ArchivedTransfer *ArchivedTransferClient
 	// BlockHeight is the client for interacting with the BlockHeight builders.
 	BlockHeight *BlockHeightClient
+	// ConsistencyAuditCursor is the client for interacting with the ConsistencyAuditCursor builders.
+	ConsistencyAuditCursor *ConsistencyAuditCursorClient
+	// ConsistencyDiscrepancy is the client for interacting with the ConsistencyDiscrepancy builders.
+	ConsistencyDiscrepancy *ConsistencyDiscrepancyClient
 	// CooperativeExit is the client for interacting with the CooperativeExit builders.
 	CooperativeExit *CooperativeExitClient
 	// DepositAddress is the client for interacting with the DepositAddress builders.
 	DepositAddress *DepositAddressClient
+	// DkgSession is the client for interacting with the DkgSession builders.
+	DkgSession *DkgSessionClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// NetworkPause is the client for interacting with the NetworkPause builders.
+	NetworkPause *NetworkPauseClient
+	// OperatorCallNonce is the client for interacting with the OperatorCallNonce builders.
+	OperatorCallNonce *OperatorCallNonceClient
 	// PreimageRequest is the client for interacting with the PreimageRequest builders.
 	PreimageRequest *PreimageRequestClient
 	// PreimageShare is the client for interacting with the PreimageShare builders.
 	PreimageShare *PreimageShareClient
+	// RecentWrite is the client for interacting with the RecentWrite builders.
+	RecentWrite *RecentWriteClient
+	// Reshare is the client for interacting with the Reshare builders.
+	Reshare *ReshareClient
+	// SessionRevocation is the client for interacting with the SessionRevocation builders.
+	SessionRevocation *SessionRevocationClient
+	// ShareRefresh is the client for interacting with the ShareRefresh builders.
+	ShareRefresh *ShareRefreshClient
+	// SigningIncident is the client for interacting with the SigningIncident builders.
+	SigningIncident *SigningIncidentClient
 	// SigningKeyshare is the client for interacting with the SigningKeyshare builders.
 	SigningKeyshare *SigningKeyshareClient
 	// SigningNonce is the client for interacting with the SigningNonce builders.
 	SigningNonce *SigningNonceClient
+	// TaskLock is the client for interacting with the TaskLock builders.
+	TaskLock *TaskLockClient
+	// TaskRun is the client for interacting with the TaskRun builders.
+	TaskRun *TaskRunClient
 	// TokenFreeze is the client for interacting with the TokenFreeze builders.
 	TokenFreeze *TokenFreezeClient
 	// TokenLeaf is the client for interacting with the TokenLeaf builders.
@@ -183,13 +215,28 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Archive = NewArchiveClient(tx.config)
+	tx.ArchivedTransfer = NewArchivedTransferClient(tx.config)
 	tx.BlockHeight = NewBlockHeightClient(tx.config)
+	tx.ConsistencyAuditCursor = NewConsistencyAuditCursorClient(tx.config)
+	tx.ConsistencyDiscrepancy = NewConsistencyDiscrepancyClient(tx.config)
 	tx.CooperativeExit = NewCooperativeExitClient(tx.config)
 	tx.DepositAddress = NewDepositAddressClient(tx.config)
+	tx.DkgSession = NewDkgSessionClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.NetworkPause = NewNetworkPauseClient(tx.config)
+	tx.OperatorCallNonce = NewOperatorCallNonceClient(tx.config)
 	tx.PreimageRequest = NewPreimageRequestClient(tx.config)
 	tx.PreimageShare = NewPreimageShareClient(tx.config)
+	tx.RecentWrite = NewRecentWriteClient(tx.config)
+	tx.Reshare = NewReshareClient(tx.config)
+	tx.SessionRevocation = NewSessionRevocationClient(tx.config)
+	tx.ShareRefresh = NewShareRefreshClient(tx.config)
+	tx.SigningIncident = NewSigningIncidentClient(tx.config)
 	tx.SigningKeyshare = NewSigningKeyshareClient(tx.config)
 	tx.SigningNonce = NewSigningNonceClient(tx.config)
+	tx.TaskLock = NewTaskLockClient(tx.config)
+	tx.TaskRun = NewTaskRunClient(tx.config)
 	tx.TokenFreeze = NewTokenFreezeClient(tx.config)
 	tx.TokenLeaf = NewTokenLeafClient(tx.config)
 	tx.TokenMint = NewTokenMintClient(tx.config)
@@ -212,7 +259,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: BlockHeight.QueryXXX(), the query will be executed
+// applies a query, for example: Archive.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -265,3 +312,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -21,6 +22,8 @@ type Error interface {
 type grpcError struct {
 	Code  codes.Code
 	Cause error
+	// Reason is attached to the status of the error as the reason of an ErrorInfo detail.
+	Reason Reason
 }
 
 // newGRPCError creates a new gRPC error with the given code and cause
@@ -39,11 +42,25 @@ func (e *grpcError) Unwrap() error {
 	return e.Cause
 }
 
+// Is matches the reason of the error, so that errors.Is(err, ReasonLeafLocked) can be used.
+func (e *grpcError) Is(target error) bool {
+	reason, ok := target.(Reason)
+	return ok && e.Reason != "" && e.Reason == reason
+}
+
 // This is important so that when we return a grpcError, the gRPC
 // server can infer the proper status from it.
 // Docs: https://pkg.go.dev/google.golang.org/grpc/status#FromError
 func (e *grpcError) GRPCStatus() *status.Status {
-	return status.New(e.Code, e.Cause.Error())
+	st := status.New(e.Code, e.Cause.Error())
+	if e.Reason == "" {
+		return st
+	}
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: string(e.Reason), Domain: Domain})
+	if err != nil {
+		return st
+	}
+	return detailed
 }
 
 // wrapWithGRPCError wraps a response and an error into a gRPC error
@@ -84,3 +101,13 @@ func FailedPreconditionErrorf(format string, args ...any) error {
 func NotFoundErrorf(format string, args ...any) error {
 	return newGRPCError(codes.NotFound, fmt.Errorf(format, args...))
 }
+
+// AbortedErrorf returns an Aborted error, for requests that conflict with a concurrent one.
+func AbortedErrorf(format string, args ...any) error {
+	return newGRPCError(codes.Aborted, fmt.Errorf(format, args...))
+}
+
+// ResourceExhaustedErrorf returns a ResourceExhausted error, for requests over a quota.
+func ResourceExhaustedErrorf(format string, args ...any) error {
+	return newGRPCError(codes.ResourceExhausted, fmt.Errorf(format, args...))
+}
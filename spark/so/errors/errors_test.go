@@ -2,12 +2,15 @@ package errors_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/lightsparkdev/spark/so/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestInternalErrorDetailMask(t *testing.T) {
@@ -19,3 +22,30 @@ func TestInternalErrorDetailMask(t *testing.T) {
 	_, err := errors.ErrorInterceptor()(context.Background(), nil, nil, handler)
 	require.NotContains(t, err.Error(), msg)
 }
+
+func TestReasonErrorInfo(t *testing.T) {
+	err := fmt.Errorf("unable to transfer: %w", errors.Reasonf(errors.ReasonLeafLocked, "leaf %s is locked", "abc"))
+	require.ErrorIs(t, err, errors.ReasonLeafLocked)
+	require.NotErrorIs(t, err, errors.ReasonLeafNotAvailable)
+	require.Equal(t, errors.ReasonLeafLocked, errors.ReasonOf(err))
+
+	_, grpcErr := errors.WrapWithGRPCError[*emptypb.Empty](nil, err)
+	st := status.Convert(grpcErr)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Equal(t, "leaf abc is locked", st.Message())
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, "LEAF_LOCKED", info.Reason)
+	require.Equal(t, errors.Domain, info.Domain)
+
+	// The reason survives the trip to a client, as a status error without the original error.
+	received := errors.FromStatusError(st.Err())
+	require.ErrorIs(t, fmt.Errorf("failed to transfer: %w", received), errors.ReasonLeafLocked)
+	require.Equal(t, codes.FailedPrecondition, status.Code(received))
+
+	plain := status.Error(codes.NotFound, "not found")
+	require.Equal(t, plain, errors.FromStatusError(plain))
+	require.Empty(t, errors.ReasonOf(plain))
+	require.NoError(t, errors.FromStatusError(nil))
+}
@@ -0,0 +1,112 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain is the domain of the google.rpc.ErrorInfo details that the operators attach to their
+// errors.
+const Domain = "spark.lightspark.com"
+
+// Reason is a stable, machine-readable reason for an error, attached to it as the reason of a
+// google.rpc.ErrorInfo detail. Unlike messages, reasons never change once released, so clients
+// can branch on them. A Reason is itself an error, so that errors.Is(err, ReasonLeafLocked)
+// matches an error with that reason.
+type Reason string
+
+const (
+	// ReasonLeafLocked is for a leaf that is locked by another transfer, split or aggregation.
+	ReasonLeafLocked Reason = "LEAF_LOCKED"
+	// ReasonLeafNotAvailable is for a leaf that is neither available nor locked, such as one that
+	// was split, aggregated or exited on chain.
+	ReasonLeafNotAvailable Reason = "LEAF_NOT_AVAILABLE"
+	// ReasonTransferNotFound is for a transfer that does not exist.
+	ReasonTransferNotFound Reason = "TRANSFER_NOT_FOUND"
+	// ReasonTransferAlreadyExists is for a transfer whose ID is already used.
+	ReasonTransferAlreadyExists Reason = "TRANSFER_ALREADY_EXISTS"
+	// ReasonTransferExpired is for a transfer that expired or was returned to its sender.
+	ReasonTransferExpired Reason = "TRANSFER_EXPIRED"
+	// ReasonTransferNotExpired is for a transfer that cannot be cancelled before it expires.
+	ReasonTransferNotExpired Reason = "TRANSFER_NOT_EXPIRED"
+	// ReasonInsufficientTimelock is for a transaction whose timelock is not low enough below the
+	// one it replaces.
+	ReasonInsufficientTimelock Reason = "INSUFFICIENT_TIMELOCK"
+	// ReasonTokenOutputFrozen is for a token output whose owner or token is frozen by the issuer.
+	ReasonTokenOutputFrozen Reason = "TOKEN_OUTPUT_FROZEN"
+	// ReasonTokenTransactionExpired is for a token transaction signed after its expiry.
+	ReasonTokenTransactionExpired Reason = "TOKEN_TRANSACTION_EXPIRED"
+	// ReasonKeysharePoolEmpty is for an operator that has run out of available signing keyshares
+	// until its next DKG.
+	ReasonKeysharePoolEmpty Reason = "KEYSHARE_POOL_EMPTY"
+	// ReasonNetworkPaused is for a transfer or deposit on a network paused by the operator.
+	ReasonNetworkPaused Reason = "NETWORK_PAUSED"
+	// ReasonIdempotencyKeyReused is for an idempotency key used for a different request.
+	ReasonIdempotencyKeyReused Reason = "IDEMPOTENCY_KEY_REUSED"
+)
+
+// reasonCodes are the status codes of the errors with every reason.
+var reasonCodes = map[Reason]codes.Code{
+	ReasonLeafLocked:              codes.FailedPrecondition,
+	ReasonLeafNotAvailable:        codes.FailedPrecondition,
+	ReasonTransferNotFound:        codes.NotFound,
+	ReasonTransferAlreadyExists:   codes.AlreadyExists,
+	ReasonTransferExpired:         codes.FailedPrecondition,
+	ReasonTransferNotExpired:      codes.FailedPrecondition,
+	ReasonInsufficientTimelock:    codes.InvalidArgument,
+	ReasonTokenOutputFrozen:       codes.FailedPrecondition,
+	ReasonTokenTransactionExpired: codes.FailedPrecondition,
+	ReasonKeysharePoolEmpty:       codes.Unavailable,
+	ReasonNetworkPaused:           codes.FailedPrecondition,
+	ReasonIdempotencyKeyReused:    codes.InvalidArgument,
+}
+
+func (r Reason) Error() string {
+	return string(r)
+}
+
+// Code returns the status code of the errors with the reason.
+func (r Reason) Code() codes.Code {
+	if code, ok := reasonCodes[r]; ok {
+		return code
+	}
+	return codes.Unknown
+}
+
+// Reasonf returns an error with the reason, and the status code of the reason.
+func Reasonf(reason Reason, format string, args ...any) error {
+	err := newGRPCError(reason.Code(), fmt.Errorf(format, args...))
+	err.Reason = reason
+	return err
+}
+
+// ReasonOf returns the reason of the first error with one in the chain of err, or an empty reason
+// if there is none.
+func ReasonOf(err error) Reason {
+	var grpcErr *grpcError
+	if errors.As(err, &grpcErr) {
+		return grpcErr.Reason
+	}
+	return ""
+}
+
+// FromStatusError converts a status error received from an operator with an ErrorInfo detail of
+// the operators into an error with its reason. Other errors are returned as they are.
+func FromStatusError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.OK {
+		return err
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == Domain && info.Reason != "" {
+			grpcErr := newGRPCError(st.Code(), errors.New(st.Message()))
+			grpcErr.Reason = Reason(info.Reason)
+			return grpcErr
+		}
+	}
+	return err
+}
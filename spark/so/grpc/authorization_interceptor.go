@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+
+	"github.com/lightsparkdev/spark/common/logging"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/authn"
+	"github.com/lightsparkdev/spark/so/authz"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+var authorizationDenialsCounter metric.Int64Counter
+
+func init() {
+	var err error
+	authorizationDenialsCounter, err = otel.Meter("authz").Int64Counter(
+		"spark_authorization_denials",
+		metric.WithDescription("Number of RPCs denied by the authorization policy"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// AuthorizationInterceptor authorizes unary calls against the operator's authorization policy,
+// and logs every denial. It must run after the authentication interceptor.
+func AuthorizationInterceptor(config *so.Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, config, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthorizationInterceptor authorizes streaming calls against the operator's authorization
+// policy. Rules with conditions never allow streaming calls, since there is no request to check.
+func StreamAuthorizationInterceptor(config *so.Config) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		var authzErr *authz.Error
+		if err := authorize(ss.Context(), config, info.FullMethod, nil); errors.As(err, &authzErr) {
+			return authzErr.ToGRPCError()
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, config *so.Config, fullMethod string, req interface{}) error {
+	if !config.AuthzEnforced() {
+		return nil
+	}
+
+	held := callerRoles(ctx, config)
+	err := config.AuthorizationPolicy.Authorize(ctx, fullMethod, req, held)
+	if err == nil {
+		return nil
+	}
+
+	identity := ""
+	if session, sessionErr := authn.GetSessionFromContext(ctx); sessionErr == nil {
+		identity = hex.EncodeToString(session.IdentityPublicKeyBytes())
+	}
+	logging.GetLoggerFromContext(ctx).Warn("Authorization denied",
+		"method", fullMethod,
+		"identity_public_key", identity,
+		"error", err)
+	authorizationDenialsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("method", fullMethod)))
+	return err
+}
+
+// callerRoles returns the roles held by the caller of the request in the context.
+func callerRoles(ctx context.Context, config *so.Config) map[authz.Role]bool {
+	held := config.AuthorizationPolicy.SessionRoles(ctx)
+	if config.AuthorizationPolicy.IsAdminClientCertificate(verifiedClientCertificate(ctx)) {
+		held[authz.RoleAdmin] = true
+	}
+	if _, ok := so.CallingOperatorFromContext(ctx); ok {
+		held[authz.RoleOperator] = true
+	}
+	session, err := authn.GetSessionFromContext(ctx)
+	if err != nil {
+		return held
+	}
+	identity := session.IdentityPublicKeyBytes()
+	if bytes.Equal(identity, config.IdentityPublicKey()) {
+		held[authz.RoleAdmin] = true
+	}
+	for _, operator := range config.SigningOperatorMap {
+		if bytes.Equal(identity, operator.IdentityPublicKey) {
+			held[authz.RoleOperator] = true
+		}
+	}
+	return held
+}
+
+// verifiedClientCertificate returns the client certificate the caller presented, if it verified
+// against the admin client CA. The server only verifies client certificates when an admin client
+// CA is configured. Verified certificates only grant the admin role if the authorization policy
+// lists them.
+func verifiedClientCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return tlsInfo.State.VerifiedChains[0][0]
+}
@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/common/logging"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so/authn"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// IdempotencyKeyHeader is the metadata key clients send an idempotency key in.
+const IdempotencyKeyHeader = "idempotency-key"
+
+// IdempotentMethods are the methods that honor idempotency keys.
+var IdempotentMethods = []string{
+	pb.SparkService_GenerateDepositAddress_FullMethodName,
+	pb.SparkService_StartTransfer_FullMethodName,
+	pb.SparkService_CooperativeExit_FullMethodName,
+	pb.SparkService_InitiatePreimageSwap_FullMethodName,
+	pb.SparkService_StartTokenTransaction_FullMethodName,
+	pb.SparkService_SignTokenTransaction_FullMethodName,
+	pb.SparkService_FinalizeTokenTransaction_FullMethodName,
+}
+
+var idempotentReplaysCounter metric.Int64Counter
+
+func init() {
+	var err error
+	idempotentReplaysCounter, err = otel.Meter("idempotency").Int64Counter(
+		"spark_idempotent_replays",
+		metric.WithDescription("Number of requests answered with the stored response of an earlier request with the same idempotency key"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// IdempotencyInterceptor makes the given methods idempotent for clients that send an idempotency
+// key in the IdempotencyKeyHeader metadata. The first request with a key reserves it in its own
+// committed transaction and runs as usual, and its response is stored in the same database
+// transaction as the rest of the request. Retries with the same key and the same request get the
+// stored response, retries with the same key and a different request get an InvalidArgument
+// error, and retries while the first request is still running get an Aborted error. Failed
+// requests release their reservation, so they can be retried with the same key.
+//
+// Keys are scoped to the method and the identity of the session, and expire after
+// spark.IdempotencyKeyRetention. It must run after the database session and authentication
+// interceptors.
+func IdempotencyInterceptor(methods []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !slices.Contains(methods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		key := idempotencyKeyFromContext(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > spark.IdempotencyKeyMaxLength {
+			return nil, errors.InvalidUserInputErrorf("idempotency key is longer than %d characters", spark.IdempotencyKeyMaxLength)
+		}
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		requestBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %w", err)
+		}
+		requestHash := sha256.Sum256(requestBytes)
+		scope := ""
+		if session, err := authn.GetSessionFromContext(ctx); err == nil && session != nil {
+			scope = hex.EncodeToString(session.IdentityPublicKeyBytes())
+		}
+
+		reserved, err := ent.ReserveIdempotencyKey(ctx, info.FullMethod, scope, key, requestHash[:])
+		if err != nil {
+			return nil, err
+		}
+		if reserved.Completed() {
+			idempotentReplaysCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("method", info.FullMethod)))
+			return reserved.StoredResponse()
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			// The reservation was committed on its own, so it has to be dropped explicitly.
+			if releaseErr := ent.ReleaseIdempotencyKey(ctx, reserved); releaseErr != nil {
+				logging.GetLoggerFromContext(ctx).Error("Failed to release idempotency key", "method", info.FullMethod, "error", releaseErr)
+			}
+			return nil, err
+		}
+		response, ok := resp.(proto.Message)
+		if !ok {
+			return resp, nil
+		}
+		if err := ent.CompleteIdempotencyKey(ctx, reserved, response); err != nil {
+			return nil, fmt.Errorf("failed to store response for idempotency key: %w", err)
+		}
+		return resp, nil
+	}
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,159 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightsparkdev/spark"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:idempotency_interceptor?mode=memory&_fk=1")
+	defer db.Close()
+	tx, err := db.Tx(context.Background())
+	require.NoError(t, err)
+	defer tx.Rollback() //nolint:errcheck
+	ctx := context.WithValue(context.Background(), ent.TxKey, tx)
+
+	info := &grpc.UnaryServerInfo{FullMethod: pb.SparkService_GenerateDepositAddress_FullMethodName}
+	interceptor := IdempotencyInterceptor(IdempotentMethods)
+	calls := 0
+	fail := false
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		calls++
+		if fail {
+			return nil, fmt.Errorf("failed")
+		}
+		return &pb.GenerateDepositAddressResponse{DepositAddress: &pb.Address{Address: fmt.Sprintf("address-%d", calls)}}, nil
+	}
+	withKey := func(key string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyHeader, key))
+	}
+	req := &pb.GenerateDepositAddressRequest{SigningPublicKey: []byte{1}, Network: pb.Network_REGTEST}
+
+	first, err := interceptor(withKey("key-1"), req, info, handler)
+	require.NoError(t, err)
+	retry, err := interceptor(withKey("key-1"), proto.Clone(req), info, handler)
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.True(t, proto.Equal(first.(proto.Message), retry.(proto.Message)))
+
+	// A different request with the same key is rejected.
+	_, err = interceptor(withKey("key-1"), &pb.GenerateDepositAddressRequest{SigningPublicKey: []byte{2}}, info, handler)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Requests without a key, or to other methods, always run.
+	_, err = interceptor(ctx, req, info, handler)
+	require.NoError(t, err)
+	_, err = interceptor(withKey("key-1"), req, &grpc.UnaryServerInfo{FullMethod: pb.SparkService_QueryNodes_FullMethodName}, handler)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	// Failed requests are not stored and can be retried with the same key.
+	fail = true
+	_, err = interceptor(withKey("key-2"), req, info, handler)
+	require.Error(t, err)
+	fail = false
+	resp, err := interceptor(withKey("key-2"), req, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, "address-5", resp.(*pb.GenerateDepositAddressResponse).DepositAddress.Address)
+
+	// Expired keys can be reused, even for a different request.
+	for _, key := range []string{"key-3", "key-4"} {
+		_, err = tx.IdempotencyKey.Create().
+			SetMethod(info.FullMethod).
+			SetScope("").
+			SetKey(key).
+			SetRequestHash([]byte("other request")).
+			SetCreateTime(time.Now().Add(-48 * time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	resp, err = interceptor(withKey("key-3"), req, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, "address-6", resp.(*pb.GenerateDepositAddressResponse).DepositAddress.Address)
+	purged, err := ent.PurgeIdempotencyKeys(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+}
+
+func TestIdempotencyInterceptorConcurrentRetries(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:idempotency_interceptor_concurrent?mode=memory&cache=shared&_fk=1")
+	defer db.Close()
+
+	info := &grpc.UnaryServerInfo{FullMethod: pb.SparkService_GenerateDepositAddress_FullMethodName}
+	session := ent.DbSessionMiddleware(db, nil)
+	interceptor := IdempotencyInterceptor(IdempotentMethods)
+	calls := 0
+	call := func(key string, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, key))
+		req := &pb.GenerateDepositAddressRequest{SigningPublicKey: []byte{1}, Network: pb.Network_REGTEST}
+		return session(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, handler)
+		})
+	}
+	succeed := func(context.Context, interface{}) (interface{}, error) {
+		calls++
+		return &pb.GenerateDepositAddressResponse{DepositAddress: &pb.Address{Address: fmt.Sprintf("address-%d", calls)}}, nil
+	}
+
+	// Retries while the first request runs see its reservation, and get its response once it has
+	// committed.
+	var retryErr error
+	_, err := call("key-1", func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, retryErr = call("key-1", succeed)
+		return succeed(ctx, req)
+	})
+	require.NoError(t, err)
+	require.Equal(t, codes.Aborted, status.Code(retryErr))
+	resp, err := call("key-1", succeed)
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.Equal(t, "address-1", resp.(*pb.GenerateDepositAddressResponse).DepositAddress.Address)
+
+	// A failed request releases its reservation even though its transaction is rolled back.
+	_, err = call("key-2", func(context.Context, interface{}) (interface{}, error) {
+		_, retryErr = call("key-2", succeed)
+		return nil, fmt.Errorf("failed")
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.Aborted, status.Code(retryErr))
+	resp, err = call("key-2", succeed)
+	require.NoError(t, err)
+	require.Equal(t, "address-2", resp.(*pb.GenerateDepositAddressResponse).DepositAddress.Address)
+
+	// A reservation abandoned by a request that never finished is taken over, and the request it
+	// was made for can no longer complete.
+	requestBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(&pb.GenerateDepositAddressRequest{SigningPublicKey: []byte{1}, Network: pb.Network_REGTEST})
+	require.NoError(t, err)
+	requestHash := sha256.Sum256(requestBytes)
+	abandoned, err := db.IdempotencyKey.Create().
+		SetMethod(info.FullMethod).
+		SetScope("").
+		SetKey("key-3").
+		SetRequestHash(requestHash[:]).
+		SetCreateTime(time.Now().Add(-spark.IdempotencyKeyReservationTimeout - time.Minute)).
+		Save(context.Background())
+	require.NoError(t, err)
+	resp, err = call("key-3", succeed)
+	require.NoError(t, err)
+	require.Equal(t, "address-3", resp.(*pb.GenerateDepositAddressResponse).DepositAddress.Address)
+	tx, err := db.Tx(context.Background())
+	require.NoError(t, err)
+	defer tx.Rollback() //nolint:errcheck
+	err = ent.CompleteIdempotencyKey(context.WithValue(context.Background(), ent.TxKey, tx), abandoned, resp.(proto.Message))
+	require.Error(t, err)
+}
@@ -0,0 +1,274 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/lightsparkdev/spark/common/logging"
+	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var operatorCallRejectionsCounter metric.Int64Counter
+
+func init() {
+	var err error
+	operatorCallRejectionsCounter, err = otel.Meter("authn").Int64Counter(
+		"spark_operator_call_rejections",
+		metric.WithDescription("Number of internal calls rejected because they were not signed by an operator allowed to make them"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// operatorServices are the services only the operators can call.
+var operatorServices = []string{
+	pbinternal.SparkInternalService_ServiceDesc.ServiceName,
+	pbdkg.DKGService_ServiceDesc.ServiceName,
+}
+
+// operatorCaller is the operators allowed to call a method of the operator services.
+type operatorCaller int
+
+const (
+	// operatorSetCaller allows the operators of the current operator set. They coordinate the
+	// requests of users, the signing jobs of these requests and the DKG sessions, and are the only
+	// operators that make calls to the others for them.
+	operatorSetCaller operatorCaller = iota
+	// anyOperatorCaller allows the operators of the current and the previous operator set.
+	anyOperatorCaller
+)
+
+// operatorMethodCallers are the methods of the operator services that operators outside of the
+// current operator set can call. The other methods can only be called by operators of the current
+// operator set. Methods that continue a DKG session, a transfer, a signing job or a token
+// transaction also check that the call was made by the coordinator this operator recorded for it.
+var operatorMethodCallers = map[string]operatorCaller{
+	pbdkg.DKGService_StartDkg_FullMethodName:          anyOperatorCaller,
+	pbdkg.DKGService_StartShareRefresh_FullMethodName: anyOperatorCaller,
+	pbdkg.DKGService_StartReshare_FullMethodName:      anyOperatorCaller,
+}
+
+// OperatorAuthenticator authenticates the calls to the operator services, which must be signed
+// with the identity key of an operator allowed to make them, for this operator. The signature
+// covers the method, the identity public key of this operator, the request, the time of the call
+// and a nonce, so that it cannot be used for another method, another operator or another request,
+// or once it is stale. Nonces are remembered in the database until their calls are stale, so that
+// a signed call cannot be replayed to any replica of this operator, and in memory, so that replays
+// to this process are rejected without a write.
+type OperatorAuthenticator struct {
+	config *so.Config
+	db     *ent.Client
+	now    func() time.Time
+
+	mu         sync.Mutex
+	nonces     map[string]time.Time
+	lastPruned time.Time
+}
+
+// NewOperatorAuthenticator creates an authenticator of the calls to the operator services, which
+// records their nonces with the given client.
+func NewOperatorAuthenticator(config *so.Config, db *ent.Client) *OperatorAuthenticator {
+	return &OperatorAuthenticator{
+		config: config,
+		db:     db,
+		now:    time.Now,
+		nonces: make(map[string]time.Time),
+	}
+}
+
+// UnaryServerInterceptor authenticates unary calls to the operator services, and adds the operator
+// that made them to their context. It must run before the authorization interceptor.
+func (a *OperatorAuthenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates streaming calls to the operator services, and adds the
+// operator that made them to their context.
+func (a *OperatorAuthenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &operatorServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type operatorServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *operatorServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (a *OperatorAuthenticator) authenticate(ctx context.Context, fullMethod string, req any) (context.Context, error) {
+	if !isOperatorMethod(fullMethod) {
+		return ctx, nil
+	}
+	logger := logging.GetLoggerFromContext(ctx)
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get(so.OperatorSignatureMetadataKey)) == 0 {
+		if a.config.OperatorAuthentication.Permissive {
+			logger.Warn("Allowed an internal call that is not signed by an operator", "method", fullMethod)
+			return so.WithUnsignedOperatorCall(ctx), nil
+		}
+		return nil, a.reject(ctx, fullMethod, status.Error(codes.Unauthenticated, "internal calls must be signed by an operator"))
+	}
+
+	call, err := a.verify(md, fullMethod, req)
+	if err != nil {
+		return nil, a.reject(ctx, fullMethod, status.Errorf(codes.Unauthenticated, "invalid operator signature: %v", err))
+	}
+	if operatorMethodCallers[fullMethod] == operatorSetCaller && !call.current {
+		return nil, a.reject(ctx, fullMethod, status.Errorf(codes.PermissionDenied, "operator %s is not in the current operator set", call.operator.Identifier))
+	}
+	if a.db != nil {
+		fresh, err := ent.UseOperatorCallNonce(ctx, a.db, call.operator.IdentityPublicKey, call.nonce, call.expiration)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to check operator call nonce: %v", err)
+		}
+		if !fresh {
+			return nil, a.reject(ctx, fullMethod, status.Errorf(codes.Unauthenticated, "call of operator %s was replayed", call.operator.Identifier))
+		}
+	}
+	ctx = so.WithCallingOperator(ctx, call.operator)
+	if values := md.Get(so.OperatorCallerIdentityMetadataKey); len(values) == 1 {
+		if identity, err := hex.DecodeString(values[0]); err == nil && len(identity) > 0 {
+			ctx = ent.WithCallerIdentity(ctx, identity)
+		}
+	}
+	return ctx, nil
+}
+
+// verifiedCall is a call whose signature was verified.
+type verifiedCall struct {
+	operator *so.SigningOperator
+	// current is whether the operator is in the current operator set.
+	current bool
+	nonce   []byte
+	// expiration is when the call is stale, and its nonce can be forgotten.
+	expiration time.Time
+}
+
+// verify verifies the signature of the call, and that this process did not receive it before.
+func (a *OperatorAuthenticator) verify(md metadata.MD, fullMethod string, req any) (*verifiedCall, error) {
+	value := func(key string) string {
+		if values := md.Get(key); len(values) == 1 {
+			return values[0]
+		}
+		return ""
+	}
+
+	identityPublicKey, err := hex.DecodeString(value(so.OperatorIdentityMetadataKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode identity public key: %w", err)
+	}
+	operator, current, ok := a.config.OperatorByIdentityPublicKey(identityPublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%x is not the identity public key of an operator", identityPublicKey)
+	}
+	publicKey, err := secp256k1.ParsePubKey(identityPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse identity public key of operator %s: %w", operator.Identifier, err)
+	}
+
+	timestamp, err := strconv.ParseInt(value(so.OperatorTimestampMetadataKey), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse timestamp: %w", err)
+	}
+	maxSkew := a.config.OperatorAuthentication.MaxCallClockSkew()
+	signedAt := time.Unix(0, timestamp)
+	now := a.now()
+	if signedAt.Before(now.Add(-maxSkew)) || signedAt.After(now.Add(maxSkew)) {
+		return nil, fmt.Errorf("call was signed at %s, more than %s from now", signedAt.UTC().Format(time.RFC3339Nano), maxSkew)
+	}
+
+	nonce, err := hex.DecodeString(value(so.OperatorNonceMetadataKey))
+	if err != nil || len(nonce) == 0 {
+		return nil, fmt.Errorf("invalid nonce")
+	}
+	signatureBytes, err := hex.DecodeString(value(so.OperatorSignatureMetadataKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signature: %w", err)
+	}
+	signature, err := ecdsa.ParseDERSignature(signatureBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signature: %w", err)
+	}
+	requestHash, err := so.OperatorCallRequestHash(req)
+	if err != nil {
+		return nil, err
+	}
+	if !signature.Verify(so.OperatorCallDigest(fullMethod, a.config.IdentityPublicKey(), requestHash, timestamp, nonce), publicKey) {
+		return nil, fmt.Errorf("signature of operator %s does not match the call", operator.Identifier)
+	}
+
+	expiration := signedAt.Add(maxSkew)
+	if !a.useNonce(hex.EncodeToString(identityPublicKey)+":"+hex.EncodeToString(nonce), expiration, now, maxSkew) {
+		return nil, fmt.Errorf("call of operator %s was replayed", operator.Identifier)
+	}
+	return &verifiedCall{operator: operator, current: current, nonce: nonce, expiration: expiration}, nil
+}
+
+// useNonce remembers the nonce until it expires, and returns false if it was already used.
+func (a *OperatorAuthenticator) useNonce(nonce string, expiry time.Time, now time.Time, maxSkew time.Duration) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if now.Sub(a.lastPruned) > maxSkew {
+		for used, usedExpiry := range a.nonces {
+			if usedExpiry.Before(now) {
+				delete(a.nonces, used)
+			}
+		}
+		a.lastPruned = now
+	}
+	if _, ok := a.nonces[nonce]; ok {
+		return false
+	}
+	a.nonces[nonce] = expiry
+	return true
+}
+
+func (a *OperatorAuthenticator) reject(ctx context.Context, fullMethod string, err error) error {
+	logging.GetLoggerFromContext(ctx).Warn("Rejected internal call", "method", fullMethod, "error", err)
+	operatorCallRejectionsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("method", fullMethod)))
+	return err
+}
+
+// isOperatorMethod returns whether the method is one of the operator services.
+func isOperatorMethod(fullMethod string) bool {
+	for _, service := range operatorServices {
+		if strings.HasPrefix(fullMethod, "/"+service+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/lightsparkdev/spark/common"
+	pbdkg "github.com/lightsparkdev/spark/proto/dkg"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/utils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// callerDKGServer answers with the identifier of the operator that made the call.
+type callerDKGServer struct {
+	pbdkg.UnimplementedDKGServiceServer
+}
+
+func (s *callerDKGServer) StartDkg(context.Context, *pbdkg.StartDkgRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (s *callerDKGServer) InitiateDkg(ctx context.Context, _ *pbdkg.InitiateDkgRequest) (*pbdkg.InitiateDkgResponse, error) {
+	response := &pbdkg.InitiateDkgResponse{}
+	if operator, ok := so.CallingOperatorFromContext(ctx); ok {
+		response.Identifier = operator.Identifier
+	}
+	return response, nil
+}
+
+type testOperator struct {
+	key      *secp256k1.PrivateKey
+	operator *so.SigningOperator
+}
+
+func newTestOperator(t *testing.T, index uint64, address string) *testOperator {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	return &testOperator{key: key, operator: &so.SigningOperator{
+		ID:                index,
+		Identifier:        utils.IndexToIdentifier(index),
+		Address:           address,
+		IdentityPublicKey: key.PubKey().SerializeCompressed(),
+	}}
+}
+
+// config returns the config of the operator, in a set with the given operators.
+func (o *testOperator) config(current []*testOperator, previous []*testOperator) *so.Config {
+	config := &so.Config{
+		Identifier:         o.operator.Identifier,
+		IdentityPrivateKey: o.key.Serialize(),
+		SigningOperatorMap: make(map[string]*so.SigningOperator),
+	}
+	for _, operator := range current {
+		config.SigningOperatorMap[operator.operator.Identifier] = operator.operator
+	}
+	if len(previous) > 0 {
+		config.PreviousOperatorSet = &so.OperatorSet{SigningOperatorMap: make(map[string]*so.SigningOperator)}
+		for _, operator := range previous {
+			config.PreviousOperatorSet.SigningOperatorMap[operator.operator.Identifier] = operator.operator
+		}
+	}
+	return config
+}
+
+func TestOperatorAuthenticator(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	receiver := newTestOperator(t, 0, listener.Addr().String())
+	caller := newTestOperator(t, 1, "127.0.0.1:1")
+	previous := newTestOperator(t, 2, "127.0.0.1:2")
+	stranger := newTestOperator(t, 3, "127.0.0.1:3")
+	current := []*testOperator{receiver, caller}
+
+	config := receiver.config(current, []*testOperator{previous})
+	authenticator := NewOperatorAuthenticator(config, nil)
+	server := grpc.NewServer(grpc.UnaryInterceptor(authenticator.UnaryServerInterceptor()))
+	pbdkg.RegisterDKGServiceServer(server, &callerDKGServer{})
+	go server.Serve(listener) //nolint:errcheck
+	defer server.Stop()
+
+	ctx := context.Background()
+	client := func(from *testOperator, set []*testOperator) pbdkg.DKGServiceClient {
+		connection, err := from.config(set, []*testOperator{previous}).NewOperatorConnection(receiver.operator)
+		require.NoError(t, err)
+		t.Cleanup(func() { connection.Close() })
+		return pbdkg.NewDKGServiceClient(connection)
+	}
+
+	// Operators of the current set can call every method, and are known to the handlers.
+	response, err := client(caller, current).InitiateDkg(ctx, &pbdkg.InitiateDkgRequest{})
+	require.NoError(t, err)
+	require.Equal(t, caller.operator.Identifier, response.Identifier)
+	response, err = client(receiver, current).InitiateDkg(ctx, &pbdkg.InitiateDkgRequest{})
+	require.NoError(t, err)
+	require.Equal(t, receiver.operator.Identifier, response.Identifier)
+
+	// Operators of the previous set can only call the methods that are open to them.
+	_, err = client(previous, current).StartDkg(ctx, &pbdkg.StartDkgRequest{})
+	require.NoError(t, err)
+	_, err = client(previous, current).InitiateDkg(ctx, &pbdkg.InitiateDkgRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Other keys are rejected.
+	_, err = client(stranger, current).StartDkg(ctx, &pbdkg.StartDkgRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Calls signed for another operator are rejected.
+	misdirected := *caller.operator
+	misdirected.Address = receiver.operator.Address
+	connection, err := caller.config(current, nil).NewOperatorConnection(&misdirected)
+	require.NoError(t, err)
+	defer connection.Close()
+	_, err = pbdkg.NewDKGServiceClient(connection).StartDkg(ctx, &pbdkg.StartDkgRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Unsigned calls are rejected.
+	unsigned, err := common.NewGRPCConnectionWithoutTLS(receiver.operator.Address, nil)
+	require.NoError(t, err)
+	defer unsigned.Close()
+	_, err = pbdkg.NewDKGServiceClient(unsigned).InitiateDkg(ctx, &pbdkg.InitiateDkgRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestOperatorAuthenticatorReplays(t *testing.T) {
+	receiver := newTestOperator(t, 0, "127.0.0.1:0")
+	caller := newTestOperator(t, 1, "127.0.0.1:1")
+	config := receiver.config([]*testOperator{receiver, caller}, nil)
+	db := enttest.Open(t, "sqlite3", "file:operator_call_nonces?mode=memory&_fk=1")
+	defer db.Close()
+	authenticator := NewOperatorAuthenticator(config, db)
+	replica := NewOperatorAuthenticator(config, db)
+	now := time.Now()
+	authenticator.now = func() time.Time { return now }
+	replica.now = authenticator.now
+
+	method := pbdkg.DKGService_StartDkg_FullMethodName
+	request := &pbdkg.StartDkgRequest{Count: 1}
+	signed := func(method string, request any, signedAt time.Time, nonce string) context.Context {
+		nonceBytes, err := hex.DecodeString(nonce)
+		require.NoError(t, err)
+		requestHash, err := so.OperatorCallRequestHash(request)
+		require.NoError(t, err)
+		timestamp := signedAt.UnixNano()
+		signature := ecdsa.Sign(caller.key, so.OperatorCallDigest(method, receiver.operator.IdentityPublicKey, requestHash, timestamp, nonceBytes))
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			so.OperatorIdentityMetadataKey, hex.EncodeToString(caller.operator.IdentityPublicKey),
+			so.OperatorTimestampMetadataKey, strconv.FormatInt(timestamp, 10),
+			so.OperatorNonceMetadataKey, nonce,
+			so.OperatorSignatureMetadataKey, hex.EncodeToString(signature.Serialize()),
+		))
+	}
+
+	ctx, err := authenticator.authenticate(signed(method, request, now, "01"), method, request)
+	require.NoError(t, err)
+	operator, ok := so.CallingOperatorFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, caller.operator.Identifier, operator.Identifier)
+
+	// A call cannot be replayed, to this process or to another replica, nor its signature used for
+	// another method or another request.
+	_, err = authenticator.authenticate(signed(method, request, now, "01"), method, request)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = replica.authenticate(signed(method, request, now, "01"), method, request)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = authenticator.authenticate(signed(method, request, now, "02"), pbdkg.DKGService_InitiateDkg_FullMethodName, request)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = authenticator.authenticate(signed(method, request, now, "02"), method, &pbdkg.StartDkgRequest{Count: 2})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Calls signed too long before or after they are received are rejected.
+	skew := config.OperatorAuthentication.MaxCallClockSkew()
+	_, err = authenticator.authenticate(signed(method, request, now.Add(-skew-time.Second), "03"), method, request)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = authenticator.authenticate(signed(method, request, now.Add(skew+time.Second), "04"), method, request)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Used nonces are forgotten once their calls are stale.
+	now = now.Add(2*skew + time.Second)
+	_, err = authenticator.authenticate(signed(method, request, now, "05"), method, request)
+	require.NoError(t, err)
+	require.Len(t, authenticator.nonces, 1)
+
+	// Methods of other services do not need a signature, nor those of the operator services when
+	// the authentication is permissive.
+	_, err = authenticator.authenticate(context.Background(), pb.SparkService_QueryNodes_FullMethodName, nil)
+	require.NoError(t, err)
+	_, err = authenticator.authenticate(context.Background(), method, request)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	config.OperatorAuthentication.Permissive = true
+	ctx, err = authenticator.authenticate(context.Background(), method, request)
+	require.NoError(t, err)
+	_, ok = so.CallingOperatorFromContext(ctx)
+	require.False(t, ok)
+	require.Nil(t, config.CallCoordinator(ctx))
+}
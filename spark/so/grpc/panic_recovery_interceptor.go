@@ -22,7 +22,7 @@ func PanicRecoveryInterceptor(returnDetailedPanicErrors bool) grpc.UnaryServerIn
 				logger.Error("Panic in handler",
 					"panic", fmt.Sprintf("%v", r),
 					"stack", string(stack),
-					"request", req,
+					"request", logging.RedactedPayload(req),
 					"server_method", info.FullMethod,
 				)
 
@@ -0,0 +1,20 @@
+package grpc
+
+import (
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	pbtree "github.com/lightsparkdev/spark/proto/spark_tree"
+)
+
+// ReadOnlyMethods are the methods that never write to the database, which can be served by a read
+// replica.
+var ReadOnlyMethods = []string{
+	pb.SparkService_QueryNodes_FullMethodName,
+	pb.SparkService_QueryBalance_FullMethodName,
+	pb.SparkService_QueryAllTransfers_FullMethodName,
+	pb.SparkService_QueryPendingTransfers_FullMethodName,
+	pb.SparkService_QueryUserSignedRefunds_FullMethodName,
+	pb.SparkService_QueryTokenOutputs_FullMethodName,
+	pb.SparkService_QueryTokenTransactions_FullMethodName,
+	pb.SparkService_QueryUnusedDepositAddresses_FullMethodName,
+	pbtree.SparkTreeService_GetLeafDenominationCounts_FullMethodName,
+}
@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/authz"
+	sparkerrors "github.com/lightsparkdev/spark/so/errors"
+	"github.com/lightsparkdev/spark/so/handler"
+	"github.com/lightsparkdev/spark/so/lrc20"
+	"github.com/lightsparkdev/spark/so/task"
+)
+
+// SparkAdminServer is the grpc server for operating a signing operator.
+// Only the operator itself, authenticated with its identity key or with one of the admin client
+// certificates of its authorization policy, may call it.
+type SparkAdminServer struct {
+	pbadmin.UnimplementedSparkAdminServiceServer
+	config      *so.Config
+	scheduler   *task.Scheduler
+	lrc20Client *lrc20.Client
+}
+
+// NewSparkAdminServer creates a new SparkAdminServer.
+func NewSparkAdminServer(config *so.Config, scheduler *task.Scheduler, lrc20Client *lrc20.Client) *SparkAdminServer {
+	return &SparkAdminServer{config: config, scheduler: scheduler, lrc20Client: lrc20Client}
+}
+
+func (s *SparkAdminServer) authorize(ctx context.Context) error {
+	if s.config.AuthorizationPolicy.IsAdminClientCertificate(verifiedClientCertificate(ctx)) {
+		return nil
+	}
+	if err := authz.EnforceSessionIdentityPublicKeyMatches(ctx, s.config, s.config.IdentityPublicKey()); err != nil {
+		var authzErr *authz.Error
+		if errors.As(err, &authzErr) {
+			return authzErr.ToGRPCError()
+		}
+		return err
+	}
+	return nil
+}
+
+// ListTasks lists the background tasks of the operator together with their most recent runs.
+func (s *SparkAdminServer) ListTasks(ctx context.Context, req *pbadmin.ListTasksRequest) (*pbadmin.ListTasksResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.RecentRunsLimit)
+	if limit == 0 {
+		limit = 1
+	}
+
+	tasks := make([]*pbadmin.TaskInfo, 0, len(s.scheduler.Tasks()))
+	for _, t := range s.scheduler.Tasks() {
+		runs, err := s.scheduler.RecentRuns(ctx, t.Name, limit)
+		if err != nil {
+			return nil, err
+		}
+		runProtos := make([]*pbadmin.TaskRun, 0, len(runs))
+		for _, run := range runs {
+			runProtos = append(runProtos, run.MarshalProto())
+		}
+		tasks = append(tasks, &pbadmin.TaskInfo{
+			Name:            t.Name,
+			IntervalSeconds: uint64(t.Duration.Seconds()),
+			RecentRuns:      runProtos,
+		})
+	}
+
+	return &pbadmin.ListTasksResponse{Tasks: tasks}, nil
+}
+
+// TriggerTask runs a background task now, outside of its schedule.
+func (s *SparkAdminServer) TriggerTask(ctx context.Context, req *pbadmin.TriggerTaskRequest) (*pbadmin.TriggerTaskResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	run, err := s.scheduler.Trigger(ctx, req.Name)
+	switch {
+	case errors.Is(err, task.ErrTaskNotFound):
+		return nil, sparkerrors.NotFoundErrorf("task %s not found", req.Name)
+	case errors.Is(err, task.ErrTaskLocked):
+		return nil, sparkerrors.FailedPreconditionErrorf("task %s is already running", req.Name)
+	case err != nil:
+		return nil, err
+	}
+
+	return &pbadmin.TriggerTaskResponse{Run: run.MarshalProto()}, nil
+}
+
+// GetKeysharePool counts the signing keyshares of the operator by status and network.
+func (s *SparkAdminServer) GetKeysharePool(ctx context.Context, req *pbadmin.GetKeysharePoolRequest) (*pbadmin.GetKeysharePoolResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	adminHandler := handler.NewAdminHandler(s.config, s.lrc20Client)
+	return sparkerrors.WrapWithGRPCError(adminHandler.GetKeysharePool(ctx, req))
+}
+
+// TriggerDkg runs a DKG round now, regardless of the size of the keyshare pool.
+func (s *SparkAdminServer) TriggerDkg(ctx context.Context, req *pbadmin.TriggerDkgRequest) (*pbadmin.TriggerDkgResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	adminHandler := handler.NewAdminHandler(s.config, s.lrc20Client)
+	return sparkerrors.WrapWithGRPCError(adminHandler.TriggerDkg(ctx, req))
+}
+
+// ListStuckTransfers counts the transfers that have not reached a final status, grouped by
+// status and age.
+func (s *SparkAdminServer) ListStuckTransfers(ctx context.Context, req *pbadmin.ListStuckTransfersRequest) (*pbadmin.ListStuckTransfersResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	adminHandler := handler.NewAdminHandler(s.config, s.lrc20Client)
+	return sparkerrors.WrapWithGRPCError(adminHandler.ListStuckTransfers(ctx, req))
+}
+
+// ListStuckPreimageRequests lists the preimage requests that have been waiting for too long.
+func (s *SparkAdminServer) ListStuckPreimageRequests(ctx context.Context, req *pbadmin.ListStuckPreimageRequestsRequest) (*pbadmin.ListStuckPreimageRequestsResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	adminHandler := handler.NewAdminHandler(s.config, s.lrc20Client)
+	return sparkerrors.WrapWithGRPCError(adminHandler.ListStuckPreimageRequests(ctx, req))
+}
+
+// GetChainStatus gets the height and lag of the chain watcher of every network.
+func (s *SparkAdminServer) GetChainStatus(ctx context.Context, req *pbadmin.GetChainStatusRequest) (*pbadmin.GetChainStatusResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	adminHandler := handler.NewAdminHandler(s.config, s.lrc20Client)
+	return sparkerrors.WrapWithGRPCError(adminHandler.GetChainStatus(ctx, req))
+}
+
+// GetLrc20PoolStatus gets the health of the LRC20 connection pool of every network.
+func (s *SparkAdminServer) GetLrc20PoolStatus(ctx context.Context, req *pbadmin.GetLrc20PoolStatusRequest) (*pbadmin.GetLrc20PoolStatusResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	adminHandler := handler.NewAdminHandler(s.config, s.lrc20Client)
+	return sparkerrors.WrapWithGRPCError(adminHandler.GetLrc20PoolStatus(ctx, req))
+}
+
+// SetNetworkPause pauses or resumes new transfers or deposits on a network.
+func (s *SparkAdminServer) SetNetworkPause(ctx context.Context, req *pbadmin.SetNetworkPauseRequest) (*pbadmin.SetNetworkPauseResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	adminHandler := handler.NewAdminHandler(s.config, s.lrc20Client)
+	return sparkerrors.WrapWithGRPCError(adminHandler.SetNetworkPause(ctx, req))
+}
+
+// ListNetworkPauses lists the networks on which new transfers or deposits are paused.
+func (s *SparkAdminServer) ListNetworkPauses(ctx context.Context, req *pbadmin.ListNetworkPausesRequest) (*pbadmin.ListNetworkPausesResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	adminHandler := handler.NewAdminHandler(s.config, s.lrc20Client)
+	return sparkerrors.WrapWithGRPCError(adminHandler.ListNetworkPauses(ctx, req))
+}
+
+// ListConsistencyDiscrepancies lists the discrepancies found by the consistency audit.
+func (s *SparkAdminServer) ListConsistencyDiscrepancies(ctx context.Context, req *pbadmin.ListConsistencyDiscrepanciesRequest) (*pbadmin.ListConsistencyDiscrepanciesResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	adminHandler := handler.NewAdminHandler(s.config, s.lrc20Client)
+	return sparkerrors.WrapWithGRPCError(adminHandler.ListConsistencyDiscrepancies(ctx, req))
+}
@@ -12,11 +12,21 @@ import (
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/common"
 	"github.com/lightsparkdev/spark/common/logging"
 	pb "github.com/lightsparkdev/spark/proto/spark_authn"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/authn"
 	"github.com/lightsparkdev/spark/so/authninternal"
+	"github.com/lightsparkdev/spark/so/authz"
+	"github.com/lightsparkdev/spark/so/ent"
+	sparkerrors "github.com/lightsparkdev/spark/so/errors"
+	"github.com/lightsparkdev/spark/so/helper"
 	"github.com/patrickmn/go-cache"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -77,6 +87,13 @@ type AuthnServerConfig struct {
 	ChallengeTimeout time.Duration
 	// Session duration
 	SessionDuration time.Duration
+	// Refresh token duration; no refresh tokens are issued if zero
+	RefreshTokenDuration time.Duration
+	// Server-side session revocation list; sessions cannot be revoked if nil
+	Revocations *ent.SessionRevocationChecker
+	// Config of the operator, whose other operators revocations are sent to; revocations are only
+	// recorded by this operator if nil
+	Operator *so.Config
 	// Clock to use for time-based operations
 	Clock authninternal.Clock
 }
@@ -108,6 +125,10 @@ var (
 	ErrInvalidPublicKeyFormat = errors.New("invalid public key format")
 	// ErrInvalidSignature is returned when the client signature is invalid.
 	ErrInvalidSignature = errors.New("invalid client signature")
+	// ErrInvalidDelegationGrant is returned when a delegation grant is invalid, expired or revoked.
+	ErrInvalidDelegationGrant = errors.New("invalid delegation grant")
+	// ErrSessionRevoked is returned when refreshing a session that has been revoked.
+	ErrSessionRevoked = errors.New("session has been revoked")
 	// ErrInternalError is returned when an unexpected internal error occurs.
 	ErrInternalError = errors.New("internal error")
 )
@@ -235,17 +256,199 @@ func (s *AuthnServer) VerifyChallenge(ctx context.Context, req *pb.VerifyChallen
 		return nil, fmt.Errorf("signature verification failed: %w", err)
 	}
 
-	result, err := s.sessionTokenCreatorVerifier.CreateToken(req.PublicKey, s.config.SessionDuration)
+	// A delegate authenticates as the identity that granted it access, limited to the grant's
+	// scopes and lifetime.
+	identityPublicKey := req.PublicKey
+	options := authninternal.SessionOptions{}
+	var notAfter time.Time
+	if req.DelegationGrant != nil {
+		grant, err := s.verifyDelegationGrant(ctx, req.DelegationGrant, req.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("delegation grant verification failed: %w", err)
+		}
+		identityPublicKey = grant.IdentityPublicKey
+		options.Scopes = grant.Scopes
+		options.DelegatePublicKey = req.PublicKey
+		notAfter = time.Unix(grant.ExpirationTimestamp, 0)
+	}
+
+	result, err := s.sessionTokenCreatorVerifier.CreateTokenWithOptions(identityPublicKey, s.cappedDuration(s.config.SessionDuration, notAfter), options)
 	if err != nil {
 		return nil, fmt.Errorf("internal error: failed to create session token: %w", err)
 	}
 
-	return &pb.VerifyChallengeResponse{
+	response := &pb.VerifyChallengeResponse{
+		SessionToken:        result.Token,
+		ExpirationTimestamp: result.ExpirationTimestamp,
+		SessionId:           result.Session.SessionId,
+	}
+	if s.config.RefreshTokenDuration > 0 {
+		refresh, err := s.sessionTokenCreatorVerifier.CreateRefreshToken(identityPublicKey, s.cappedDuration(s.config.RefreshTokenDuration, notAfter), authninternal.SessionOptionsOf(result.Session))
+		if err != nil {
+			return nil, fmt.Errorf("internal error: failed to create refresh token: %w", err)
+		}
+		response.RefreshToken = refresh.Token
+		response.RefreshExpirationTimestamp = refresh.ExpirationTimestamp
+	}
+	return response, nil
+}
+
+// RefreshSession exchanges a refresh token for a new session token of the same session. The new
+// token expires no later than the refresh token.
+func (s *AuthnServer) RefreshSession(ctx context.Context, req *pb.RefreshSessionRequest) (*pb.RefreshSessionResponse, error) {
+	session, err := s.sessionTokenCreatorVerifier.VerifyRefreshToken(req.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("refresh token verification failed: %w", err)
+	}
+
+	if s.config.Revocations != nil {
+		revoked, err := s.config.Revocations.IsSessionRevoked(ctx, session.PublicKey, session.SessionId, session.IssuedTimestampMillis)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInternalError, err)
+		}
+		if revoked {
+			return nil, ErrSessionRevoked
+		}
+	}
+
+	duration := s.cappedDuration(s.config.SessionDuration, time.Unix(session.ExpirationTimestamp, 0))
+	result, err := s.sessionTokenCreatorVerifier.CreateTokenWithOptions(session.PublicKey, duration, authninternal.SessionOptionsOf(session))
+	if err != nil {
+		return nil, fmt.Errorf("internal error: failed to create session token: %w", err)
+	}
+
+	return &pb.RefreshSessionResponse{
 		SessionToken:        result.Token,
 		ExpirationTimestamp: result.ExpirationTimestamp,
 	}, nil
 }
 
+// RevokeSession revokes a session of the caller's identity, or every session and delegation grant
+// of the identity issued so far. A session limited to scopes can only revoke itself.
+func (s *AuthnServer) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	if s.config.Revocations == nil {
+		return nil, sparkerrors.FailedPreconditionErrorf("session revocation is not enabled")
+	}
+
+	session, err := authn.GetSessionFromContext(ctx)
+	if err != nil {
+		return nil, &authz.Error{
+			Code:    authz.ErrorCodeNoSession,
+			Message: "no valid session found",
+			Cause:   err,
+		}
+	}
+
+	sessionID := req.SessionId
+	if len(sessionID) == 0 && !req.AllSessions {
+		sessionID = session.SessionID()
+	}
+	if len(session.Scopes()) > 0 && (req.AllSessions || !bytes.Equal(sessionID, session.SessionID())) {
+		return nil, &authz.Error{
+			Code:    authz.ErrorCodeScope,
+			Message: "a session limited to scopes can only revoke itself",
+		}
+	}
+
+	// Keep the revocation for as long as anything it revokes could still be used.
+	retention := max(s.config.SessionDuration, s.config.RefreshTokenDuration)
+	if req.AllSessions {
+		sessionID = nil
+		retention = max(retention, spark.DelegationGrantMaxDuration)
+	}
+	revokeTime := s.clock.Now()
+	if err := s.config.Revocations.Revoke(ctx, session.IdentityPublicKeyBytes(), sessionID, revokeTime, revokeTime.Add(retention)); err != nil {
+		return nil, err
+	}
+	if err := s.revokeSessionAtOtherOperators(ctx, &pbinternal.RevokeSessionInternalRequest{
+		IdentityPublicKey: session.IdentityPublicKeyBytes(),
+		SessionId:         sessionID,
+		RevokeTime:        timestamppb.New(revokeTime),
+		ExpirationTime:    timestamppb.New(revokeTime.Add(retention)),
+	}); err != nil {
+		return nil, err
+	}
+	return &pb.RevokeSessionResponse{}, nil
+}
+
+// revokeSessionAtOtherOperators records the revocation at every other operator, so that the session
+// cannot be used with any of them. If any of them fails, the revocation fails and is rolled back
+// here, and the client retries it; recording it again at the operators that succeeded is harmless.
+func (s *AuthnServer) revokeSessionAtOtherOperators(ctx context.Context, req *pbinternal.RevokeSessionInternalRequest) error {
+	if s.config.Operator == nil {
+		return nil
+	}
+	selection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionExcludeSelf}
+	_, err := helper.ExecuteTaskWithAllOperators(ctx, s.config.Operator, &selection, func(ctx context.Context, operator *so.SigningOperator) (any, error) {
+		conn, err := s.config.Operator.NewOperatorConnection(operator)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		return pbinternal.NewSparkInternalServiceClient(conn).RevokeSessionInternal(ctx, req)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke session at the other operators: %w", err)
+	}
+	return nil
+}
+
+// cappedDuration returns duration, shortened to end at notAfter if it is set and earlier.
+func (s *AuthnServer) cappedDuration(duration time.Duration, notAfter time.Time) time.Duration {
+	if !notAfter.IsZero() {
+		if remaining := notAfter.Sub(s.clock.Now()); remaining < duration {
+			return remaining
+		}
+	}
+	return duration
+}
+
+// verifyDelegationGrant checks that the grant was signed by its identity for the given delegate,
+// is currently valid and has not been revoked, and returns it.
+func (s *AuthnServer) verifyDelegationGrant(ctx context.Context, signed *pb.SignedDelegationGrant, delegatePublicKey []byte) (*pb.DelegationGrant, error) {
+	grant := signed.Grant
+	if grant == nil {
+		return nil, fmt.Errorf("%w: grant cannot be nil", ErrInvalidDelegationGrant)
+	}
+	if !bytes.Equal(grant.DelegatePublicKey, delegatePublicKey) {
+		return nil, fmt.Errorf("%w: grant is for a different delegate", ErrInvalidDelegationGrant)
+	}
+
+	if err := s.verifyClientSignature(common.DelegationGrantMessage(grant), grant.IdentityPublicKey, signed.Signature); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDelegationGrant, err)
+	}
+
+	now := s.clock.Now().Unix()
+	if grant.IssuedTimestamp > now+clockSkewSeconds {
+		return nil, fmt.Errorf("%w: grant issued in the future", ErrInvalidDelegationGrant)
+	}
+	if grant.ExpirationTimestamp <= now {
+		return nil, fmt.Errorf("%w: grant expired", ErrInvalidDelegationGrant)
+	}
+	if grant.ExpirationTimestamp-grant.IssuedTimestamp > int64(spark.DelegationGrantMaxDuration.Seconds()) {
+		return nil, fmt.Errorf("%w: grant is valid for longer than %s", ErrInvalidDelegationGrant, spark.DelegationGrantMaxDuration)
+	}
+	if len(grant.Scopes) == 0 {
+		return nil, fmt.Errorf("%w: grant has no scopes", ErrInvalidDelegationGrant)
+	}
+	for _, scope := range grant.Scopes {
+		if !authz.ValidScope(scope) {
+			return nil, fmt.Errorf("%w: unknown scope %q", ErrInvalidDelegationGrant, scope)
+		}
+	}
+
+	if s.config.Revocations != nil {
+		revoked, err := s.config.Revocations.IsSessionRevoked(ctx, grant.IdentityPublicKey, nil, grant.IssuedTimestamp*1000)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInternalError, err)
+		}
+		if revoked {
+			return nil, fmt.Errorf("%w: grant has been revoked", ErrInvalidDelegationGrant)
+		}
+	}
+	return grant, nil
+}
+
 func (s *AuthnServer) computeChallengeHmac(challengeBytes []byte) []byte {
 	h := hmac.New(sha256.New, s.challengeHmacKey)
 	h.Write(challengeBytes)
@@ -12,13 +12,24 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/common"
+	pbspark "github.com/lightsparkdev/spark/proto/spark"
 	pb "github.com/lightsparkdev/spark/proto/spark_authn"
 	pb_authn_internal "github.com/lightsparkdev/spark/proto/spark_authn_internal"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
 	"github.com/lightsparkdev/spark/so/authn"
 	"github.com/lightsparkdev/spark/so/authninternal"
+	"github.com/lightsparkdev/spark/so/authz"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -32,7 +43,9 @@ const (
 )
 
 type testServerConfig struct {
-	clock authninternal.Clock
+	clock                authninternal.Clock
+	refreshTokenDuration time.Duration
+	revocations          *ent.SessionRevocationChecker
 }
 
 // newTestServerAndTokenVerifier creates an AuthenticationServer and SessionTokenCreatorVerifier with default test configuration
@@ -52,10 +65,12 @@ func newTestServerAndTokenVerifier(
 	require.NoError(t, err)
 
 	config := AuthnServerConfig{
-		IdentityPrivateKey: testIdentityKeyBytes,
-		ChallengeTimeout:   testChallengeTimeout,
-		SessionDuration:    testSessionDuration,
-		Clock:              cfg.clock,
+		IdentityPrivateKey:   testIdentityKeyBytes,
+		ChallengeTimeout:     testChallengeTimeout,
+		SessionDuration:      testSessionDuration,
+		RefreshTokenDuration: cfg.refreshTokenDuration,
+		Revocations:          cfg.revocations,
+		Clock:                cfg.clock,
 	}
 
 	server, err := NewAuthnServer(config, tokenVerifier)
@@ -70,6 +85,18 @@ func withClock(clock authninternal.Clock) func(*testServerConfig) {
 	}
 }
 
+func withRefreshTokens(duration time.Duration) func(*testServerConfig) {
+	return func(cfg *testServerConfig) {
+		cfg.refreshTokenDuration = duration
+	}
+}
+
+func withRevocations(revocations *ent.SessionRevocationChecker) func(*testServerConfig) {
+	return func(cfg *testServerConfig) {
+		cfg.revocations = revocations
+	}
+}
+
 func TestGetChallenge_InvalidPublicKey(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -453,3 +480,197 @@ func TestNewAuthnServer_InvalidChallengeTimeoutFails(t *testing.T) {
 	assert.Contains(t, err.Error(), "challenge timeout must be at least one second")
 	assert.Nil(t, server)
 }
+
+// authenticatedContext runs the token through the authn interceptor and returns the resulting context.
+func authenticatedContext(t *testing.T, ctx context.Context, interceptor *authn.AuthnInterceptor, token string) context.Context {
+	t.Helper()
+	var capturedCtx context.Context
+	_, err := interceptor.AuthnInterceptor(metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token)), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		capturedCtx = ctx
+		return nil, nil
+	})
+	require.NoError(t, err)
+	return capturedCtx
+}
+
+func createSignedDelegationGrant(t *testing.T, identityKey *secp256k1.PrivateKey, grant *pb.DelegationGrant) *pb.SignedDelegationGrant {
+	grant.IdentityPublicKey = identityKey.PubKey().SerializeCompressed()
+	hash := sha256.Sum256(common.DelegationGrantMessage(grant))
+	return &pb.SignedDelegationGrant{Grant: grant, Signature: ecdsa.Sign(identityKey, hash[:]).Serialize()}
+}
+
+func TestRefreshSession(t *testing.T) {
+	clock := authninternal.NewTestClock(time.Now())
+	server, tokenVerifier := newTestServerAndTokenVerifier(t, withClock(clock), withRefreshTokens(testSessionDuration+time.Hour))
+	privKey, pubKey := createTestKeyPair()
+
+	challengeResp, signature := createSignedChallenge(t, server, privKey)
+	verifyResp := verifyChallenge(t, server, challengeResp, pubKey, signature)
+	require.NotEmpty(t, verifyResp.RefreshToken)
+	require.Equal(t, clock.Now().Add(testSessionDuration+time.Hour).Unix(), verifyResp.RefreshExpirationTimestamp)
+
+	// Access and refresh tokens cannot be used in place of each other.
+	_, err := server.RefreshSession(context.Background(), &pb.RefreshSessionRequest{RefreshToken: verifyResp.SessionToken})
+	require.ErrorIs(t, err, authninternal.ErrWrongTokenType)
+	assertNoSessionInContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+verifyResp.RefreshToken)), t)
+
+	// The refreshed token belongs to the same session and expires no later than the refresh token.
+	clock.Advance(testSessionDuration)
+	refreshResp, err := server.RefreshSession(context.Background(), &pb.RefreshSessionRequest{RefreshToken: verifyResp.RefreshToken})
+	require.NoError(t, err)
+	require.Equal(t, verifyResp.RefreshExpirationTimestamp, refreshResp.ExpirationTimestamp)
+	session, err := authn.GetSessionFromContext(authenticatedContext(t, context.Background(), authn.NewAuthnInterceptor(tokenVerifier), refreshResp.SessionToken))
+	require.NoError(t, err)
+	require.Equal(t, pubKey.SerializeCompressed(), session.IdentityPublicKeyBytes())
+	require.Equal(t, verifyResp.SessionId, session.SessionID())
+
+	clock.Advance(2 * time.Hour)
+	_, err = server.RefreshSession(context.Background(), &pb.RefreshSessionRequest{RefreshToken: verifyResp.RefreshToken})
+	require.ErrorIs(t, err, authninternal.ErrTokenExpired)
+}
+
+func TestRevokeSession(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:revoke_session?mode=memory&cache=shared&_fk=1")
+	defer db.Close()
+	revocations := ent.NewSessionRevocationChecker(db)
+	server, tokenVerifier := newTestServerAndTokenVerifier(t, withRefreshTokens(time.Hour), withRevocations(revocations))
+	interceptor := authn.NewAuthnInterceptor(tokenVerifier).WithRevocationChecker(revocations)
+	privKey, pubKey := createTestKeyPair()
+	login := func() *pb.VerifyChallengeResponse {
+		challengeResp, signature := createSignedChallenge(t, server, privKey)
+		return verifyChallenge(t, server, challengeResp, pubKey, signature)
+	}
+	revoke := func(token string, req *pb.RevokeSessionRequest) error {
+		tx, err := db.Tx(context.Background())
+		require.NoError(t, err)
+		ctx := authenticatedContext(t, context.WithValue(context.Background(), ent.TxKey, tx), interceptor, token)
+		if _, err := server.RevokeSession(ctx, req); err != nil {
+			require.NoError(t, tx.Rollback())
+			return err
+		}
+		return tx.Commit()
+	}
+
+	first, second := login(), login()
+	require.NoError(t, revoke(first.SessionToken, &pb.RevokeSessionRequest{}))
+	_, err := authn.GetSessionFromContext(authenticatedContext(t, context.Background(), interceptor, first.SessionToken))
+	require.ErrorContains(t, err, "revoked")
+	_, err = server.RefreshSession(context.Background(), &pb.RefreshSessionRequest{RefreshToken: first.RefreshToken})
+	require.ErrorIs(t, err, ErrSessionRevoked)
+
+	// Other sessions are unaffected until every session is revoked.
+	_, err = authn.GetSessionFromContext(authenticatedContext(t, context.Background(), interceptor, second.SessionToken))
+	require.NoError(t, err)
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, revoke(second.SessionToken, &pb.RevokeSessionRequest{AllSessions: true}))
+	_, err = authn.GetSessionFromContext(authenticatedContext(t, context.Background(), interceptor, second.SessionToken))
+	require.ErrorContains(t, err, "revoked")
+
+	// Sessions opened afterwards are valid.
+	time.Sleep(2 * time.Millisecond)
+	third := login()
+	thirdSession, err := authn.GetSessionFromContext(authenticatedContext(t, context.Background(), interceptor, third.SessionToken))
+	require.NoError(t, err)
+
+	// A revocation made at the coordinator and sent to this operator revokes the session here too,
+	// once the revocation watcher flushes the cached check.
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	go revocations.WatchRevocations(watchCtx)
+	tx, err := db.Tx(context.Background())
+	require.NoError(t, err)
+	_, err = NewSparkInternalServer(nil, nil).RevokeSessionInternal(context.WithValue(context.Background(), ent.TxKey, tx), &pbinternal.RevokeSessionInternalRequest{
+		IdentityPublicKey: thirdSession.IdentityPublicKeyBytes(),
+		SessionId:         thirdSession.SessionID(),
+		RevokeTime:        timestamppb.Now(),
+		ExpirationTime:    timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.Eventually(t, func() bool {
+		_, err := authn.GetSessionFromContext(authenticatedContext(t, context.Background(), interceptor, third.SessionToken))
+		return err != nil
+	}, 5*spark.SessionRevocationPollInterval, 100*time.Millisecond)
+}
+
+func TestVerifyChallenge_DelegationGrant(t *testing.T) {
+	clock := authninternal.NewTestClock(time.Now())
+	server, tokenVerifier := newTestServerAndTokenVerifier(t, withClock(clock), withRefreshTokens(time.Hour))
+	identityKey, _ := createTestKeyPair()
+	delegateKey, delegatePubKey := createTestKeyPair()
+	newGrant := func() *pb.DelegationGrant {
+		return &pb.DelegationGrant{
+			DelegatePublicKey:   delegatePubKey.SerializeCompressed(),
+			Scopes:              []string{authz.ScopeRead},
+			IssuedTimestamp:     clock.Now().Unix(),
+			ExpirationTimestamp: clock.Now().Add(10 * time.Minute).Unix(),
+		}
+	}
+	verifyDelegate := func(grant *pb.SignedDelegationGrant) (*pb.VerifyChallengeResponse, error) {
+		challengeResp, signature := createSignedChallenge(t, server, delegateKey)
+		return server.VerifyChallenge(context.Background(), &pb.VerifyChallengeRequest{
+			ProtectedChallenge: challengeResp.ProtectedChallenge,
+			Signature:          signature,
+			PublicKey:          delegatePubKey.SerializeCompressed(),
+			DelegationGrant:    grant,
+		})
+	}
+
+	grant := newGrant()
+	resp, err := verifyDelegate(createSignedDelegationGrant(t, identityKey, grant))
+	require.NoError(t, err)
+	require.Equal(t, grant.ExpirationTimestamp, resp.ExpirationTimestamp)
+	require.Equal(t, grant.ExpirationTimestamp, resp.RefreshExpirationTimestamp)
+	ctx := authenticatedContext(t, context.Background(), authn.NewAuthnInterceptor(tokenVerifier), resp.SessionToken)
+	session, err := authn.GetSessionFromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, identityKey.PubKey().SerializeCompressed(), session.IdentityPublicKeyBytes())
+	require.Equal(t, delegatePubKey.SerializeCompressed(), session.DelegatePublicKey())
+	require.Equal(t, []string{authz.ScopeRead}, session.Scopes())
+
+	// The session is limited to the grant's scopes.
+	require.NoError(t, authz.EnforceSessionScope(ctx, pbspark.SparkService_QueryNodes_FullMethodName))
+	require.NoError(t, authz.EnforceSessionScope(ctx, pb.SparkAuthnService_RefreshSession_FullMethodName))
+	_, err = authz.ScopeInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: pbspark.SparkService_StartTransfer_FullMethodName}, func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	var authzErr *authz.Error
+	require.ErrorAs(t, err, &authzErr)
+	require.Equal(t, codes.PermissionDenied, status.Code(authzErr.ToGRPCError()))
+
+	tests := []struct {
+		name   string
+		modify func(*pb.DelegationGrant)
+	}{
+		{name: "different delegate", modify: func(g *pb.DelegationGrant) { g.DelegatePublicKey = testIdentityKey.PubKey().SerializeCompressed() }},
+		{name: "unknown scope", modify: func(g *pb.DelegationGrant) { g.Scopes = []string{"admin"} }},
+		{name: "no scopes", modify: func(g *pb.DelegationGrant) { g.Scopes = nil }},
+		{name: "expired", modify: func(g *pb.DelegationGrant) { g.ExpirationTimestamp = clock.Now().Add(-time.Second).Unix() }},
+		{name: "too long", modify: func(g *pb.DelegationGrant) { g.ExpirationTimestamp = clock.Now().Add(100 * 24 * time.Hour).Unix() }},
+		{name: "issued in the future", modify: func(g *pb.DelegationGrant) { g.IssuedTimestamp = clock.Now().Add(time.Hour).Unix() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant := newGrant()
+			tt.modify(grant)
+			_, err := verifyDelegate(createSignedDelegationGrant(t, identityKey, grant))
+			require.ErrorIs(t, err, ErrInvalidDelegationGrant)
+		})
+	}
+
+	// A grant signed by a key other than its identity is rejected.
+	signed := createSignedDelegationGrant(t, identityKey, newGrant())
+	signed.Grant.IdentityPublicKey = testIdentityKey.PubKey().SerializeCompressed()
+	_, err = verifyDelegate(signed)
+	require.ErrorIs(t, err, ErrInvalidDelegationGrant)
+
+	// A signature over anything but the canonical encoding of the grant, such as its protobuf
+	// encoding, is rejected.
+	grant = newGrant()
+	grant.IdentityPublicKey = identityKey.PubKey().SerializeCompressed()
+	grantBytes, err := proto.Marshal(grant)
+	require.NoError(t, err)
+	hash := sha256.Sum256(grantBytes)
+	_, err = verifyDelegate(&pb.SignedDelegationGrant{Grant: grant, Signature: ecdsa.Sign(identityKey, hash[:]).Serialize()})
+	require.ErrorIs(t, err, ErrInvalidDelegationGrant)
+}
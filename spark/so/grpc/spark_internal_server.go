@@ -1,10 +1,11 @@
 package grpc
 
 import (
-	"bytes"
 	"context"
-	"encoding/hex"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
+	"sort"
 
 	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/common"
@@ -58,6 +59,12 @@ func (s *SparkInternalServer) MarkKeyshareForDepositAddress(ctx context.Context,
 	return depositHandler.MarkKeyshareForDepositAddress(ctx, req)
 }
 
+// RecycleDepositAddresses recycles the keyshares of expired deposit addresses that never received funds.
+func (s *SparkInternalServer) RecycleDepositAddresses(ctx context.Context, req *pb.RecycleDepositAddressesRequest) (*pb.RecycleDepositAddressesResponse, error) {
+	depositHandler := handler.NewInternalDepositHandler(s.config)
+	return errors.WrapWithGRPCError(depositHandler.RecycleDepositAddresses(ctx, req))
+}
+
 // FrostRound1 handles the FROST nonce generation.
 func (s *SparkInternalServer) FrostRound1(ctx context.Context, req *pb.FrostRound1Request) (*pb.FrostRound1Response, error) {
 	uuids := make([]uuid.UUID, len(req.KeyshareIds))
@@ -163,17 +170,16 @@ func (s *SparkInternalServer) FrostRound2(ctx context.Context, req *pb.FrostRoun
 		if err != nil {
 			return nil, err
 		}
-		nonceEnt := nonces[commitment.Key()]
-		// TODO(zhenlu): Add a test for this (LIG-7596).
-		if len(nonceEnt.Message) > 0 {
-			if !bytes.Equal(nonceEnt.Message, job.Message) {
-				return nil, fmt.Errorf("this signing nonce is already used for a different message %s, cannot use it for this message %s", hex.EncodeToString(nonceEnt.Message), hex.EncodeToString(job.Message))
-			}
-		} else {
-			_, err = nonceEnt.Update().SetMessage(job.Message).Save(ctx)
-			if err != nil {
-				return nil, err
-			}
+		nonceEnt, ok := nonces[commitment.Key()]
+		if !ok {
+			return nil, fmt.Errorf("no signing nonce found for the commitment of job %s", job.JobId)
+		}
+		if err := s.config.CheckRecordedCoordinator(ctx, nonceEnt.CoordinatorPublicKey); err != nil {
+			return nil, err
+		}
+		err = ent.UseSigningNonce(ctx, nonceEnt, job.Message, signingJobBindingHash(job))
+		if err != nil {
+			return nil, err
 		}
 		nonceObject := objects.SigningNonce{}
 		err = nonceObject.UnmarshalBinary(nonceEnt.Nonce)
@@ -218,6 +224,33 @@ func (s *SparkInternalServer) FrostRound2(ctx context.Context, req *pb.FrostRoun
 	}, nil
 }
 
+// signingJobBindingHash hashes everything other than the message that the signature share of the
+// job depends on, so that a nonce is never used to sign the same message for another key or with
+// other commitments.
+func signingJobBindingHash(job *pb.SigningJob) []byte {
+	hash := sha256.New()
+	writeField := func(field []byte) {
+		_ = binary.Write(hash, binary.BigEndian, uint32(len(field)))
+		hash.Write(field)
+	}
+	writeField([]byte(job.KeyshareId))
+	writeField(job.VerifyingKey)
+	identifiers := make([]string, 0, len(job.Commitments))
+	for identifier := range job.Commitments {
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+	for _, identifier := range identifiers {
+		writeField([]byte(identifier))
+		writeField(job.Commitments[identifier].GetHiding())
+		writeField(job.Commitments[identifier].GetBinding())
+	}
+	writeField(job.UserCommitments.GetHiding())
+	writeField(job.UserCommitments.GetBinding())
+	writeField(job.AdaptorPublicKey)
+	return hash.Sum(nil)
+}
+
 // PrepareSplitKeyshares prepares the keyshares for a split.
 func (s *SparkInternalServer) PrepareSplitKeyshares(ctx context.Context, req *pb.PrepareSplitKeysharesRequest) (*emptypb.Empty, error) {
 	splitHandler := handler.NewInternalSplitHandler(s.config)
@@ -302,8 +335,12 @@ func (s *SparkInternalServer) ReturnLightningPayment(ctx context.Context, req *p
 	return errors.WrapWithGRPCError(lightningHandler.ReturnLightningPayment(ctx, req, true))
 }
 
-// StartTokenTransactionInternal validates a token transaction and saves it to the database.
+// StartTokenTransactionInternal validates a token transaction and saves it to the database. It can
+// only be called by the coordinator of the transaction.
 func (s *SparkInternalServer) StartTokenTransactionInternal(ctx context.Context, req *pb.StartTokenTransactionInternalRequest) (*emptypb.Empty, error) {
+	if err := s.config.CheckCallingOperator(ctx, req.CoordinatorPublicKey); err != nil {
+		return nil, err
+	}
 	tokenTransactionHandler := handler.NewInternalTokenTransactionHandler(s.config, s.lrc20Client)
 	return errors.WrapWithGRPCError(tokenTransactionHandler.StartTokenTransactionInternal(ctx, s.config, req))
 }
@@ -340,3 +377,26 @@ func (s *SparkInternalServer) QueryTokenOutputsInternal(ctx context.Context, req
 	tokenTransactionHandler := handler.NewInternalTokenTransactionHandler(s.config, s.lrc20Client)
 	return errors.WrapWithGRPCError(tokenTransactionHandler.QueryTokenOutputsInternal(ctx, req))
 }
+
+// GetConsistencyDigests computes a digest of the state this operator holds for each of the given keys.
+func (s *SparkInternalServer) GetConsistencyDigests(ctx context.Context, req *pb.GetConsistencyDigestsRequest) (*pb.GetConsistencyDigestsResponse, error) {
+	consistencyHandler := handler.NewConsistencyHandler(s.config)
+	return errors.WrapWithGRPCError(consistencyHandler.GetConsistencyDigests(ctx, req))
+}
+
+// GetConsistencyRecords gets the state this operator holds for a key.
+func (s *SparkInternalServer) GetConsistencyRecords(ctx context.Context, req *pb.GetConsistencyRecordsRequest) (*pb.GetConsistencyRecordsResponse, error) {
+	consistencyHandler := handler.NewConsistencyHandler(s.config)
+	return errors.WrapWithGRPCError(consistencyHandler.GetConsistencyRecords(ctx, req))
+}
+
+// RevokeSessionInternal records the revocation of a session made at the coordinator.
+func (s *SparkInternalServer) RevokeSessionInternal(ctx context.Context, req *pb.RevokeSessionInternalRequest) (*emptypb.Empty, error) {
+	if len(req.IdentityPublicKey) == 0 || req.RevokeTime == nil || req.ExpirationTime == nil {
+		return nil, errors.InvalidUserInputErrorf("identity public key, revoke time and expiration time are required")
+	}
+	if err := ent.CreateSessionRevocation(ctx, req.IdentityPublicKey, req.SessionId, req.RevokeTime.AsTime(), req.ExpirationTime.AsTime()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
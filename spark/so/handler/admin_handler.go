@@ -0,0 +1,413 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightsparkdev/spark/common"
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/blockheight"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
+	"github.com/lightsparkdev/spark/so/ent/networkpause"
+	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/transfer"
+	"github.com/lightsparkdev/spark/so/ent/tree"
+	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/errors"
+	"github.com/lightsparkdev/spark/so/lrc20"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultStuckTransferMinAge         = time.Hour
+	defaultStuckPreimageRequestAge     = 10 * time.Minute
+	defaultStuckPreimageRequestLimit   = 100
+	defaultConsistencyDiscrepancyLimit = 100
+	bitcoindRequestTimeout             = 5 * time.Second
+)
+
+// transferAgeBuckets are the age ranges stuck transfers are grouped by.
+var transferAgeBuckets = []struct {
+	label  string
+	minAge time.Duration
+	maxAge time.Duration
+}{
+	{"<1h", 0, time.Hour},
+	{"1h-6h", time.Hour, 6 * time.Hour},
+	{"6h-1d", 6 * time.Hour, 24 * time.Hour},
+	{"1d-7d", 24 * time.Hour, 7 * 24 * time.Hour},
+	{">7d", 7 * 24 * time.Hour, 0},
+}
+
+// finalTransferStatuses are the statuses a transfer never leaves.
+var finalTransferStatuses = []schema.TransferStatus{
+	schema.TransferStatusCompleted,
+	schema.TransferStatusExpired,
+	schema.TransferStatusReturned,
+}
+
+// AdminHandler is the handler for the operator admin service.
+type AdminHandler struct {
+	config      *so.Config
+	lrc20Client *lrc20.Client
+}
+
+// NewAdminHandler creates a new AdminHandler.
+func NewAdminHandler(config *so.Config, lrc20Client *lrc20.Client) *AdminHandler {
+	return &AdminHandler{config: config, lrc20Client: lrc20Client}
+}
+
+// GetKeysharePool counts the signing keyshares of the operator.
+func (h *AdminHandler) GetKeysharePool(ctx context.Context, _ *pbadmin.GetKeysharePoolRequest) (*pbadmin.GetKeysharePoolResponse, error) {
+	db := ent.GetDbFromContext(ctx)
+
+	statusCounts, err := ent.CountSigningKeysharesByStatus(ctx, db.Client())
+	if err != nil {
+		return nil, err
+	}
+	countByStatus := make(map[string]uint64, len(statusCounts))
+	for status, count := range statusCounts {
+		countByStatus[string(status)] = uint64(count)
+	}
+
+	available, err := ent.CountAvailableSigningKeyshares(ctx, db.Client(), h.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count available keyshares: %w", err)
+	}
+
+	inUseByNetwork := make([]*pbadmin.NetworkKeyshareCount, 0, len(h.config.SupportedNetworks))
+	for _, network := range h.config.SupportedNetworks {
+		count, err := db.TreeNode.Query().
+			Where(treenode.HasTreeWith(tree.NetworkEQ(common.SchemaNetwork(network)))).
+			QuerySigningKeyshare().
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count keyshares on %s: %w", network, err)
+		}
+		protoNetwork, err := common.ProtoNetworkFromNetwork(network)
+		if err != nil {
+			return nil, err
+		}
+		inUseByNetwork = append(inUseByNetwork, &pbadmin.NetworkKeyshareCount{
+			Network: protoNetwork,
+			Count:   uint64(count),
+		})
+	}
+
+	return &pbadmin.GetKeysharePoolResponse{
+		CountByStatus:        countByStatus,
+		AvailableCoordinated: uint64(available),
+		MinAvailable:         ent.MinAvailableSigningKeyshares(h.config),
+		InUseByNetwork:       inUseByNetwork,
+	}, nil
+}
+
+// TriggerDkg runs a DKG round now, regardless of the size of the keyshare pool.
+func (h *AdminHandler) TriggerDkg(ctx context.Context, _ *pbadmin.TriggerDkgRequest) (*pbadmin.TriggerDkgResponse, error) {
+	if err := ent.RunDKG(ctx, h.config); err != nil {
+		return nil, fmt.Errorf("failed to run DKG: %w", err)
+	}
+	return &pbadmin.TriggerDkgResponse{}, nil
+}
+
+// ListStuckTransfers counts the transfers that have not reached a final status and have not been
+// updated for a while, grouped by status and age.
+func (h *AdminHandler) ListStuckTransfers(ctx context.Context, req *pbadmin.ListStuckTransfersRequest) (*pbadmin.ListStuckTransfersResponse, error) {
+	db := ent.GetDbFromContext(ctx)
+
+	minAge := time.Duration(req.MinAgeSeconds) * time.Second
+	if minAge == 0 {
+		minAge = defaultStuckTransferMinAge
+	}
+
+	now := time.Now()
+	groups := make([]*pbadmin.StuckTransferGroup, 0)
+	for _, bucket := range transferAgeBuckets {
+		if bucket.maxAge != 0 && bucket.maxAge <= minAge {
+			continue
+		}
+		bucketQuery := db.Transfer.Query().Where(
+			transfer.StatusNotIn(finalTransferStatuses...),
+			transfer.UpdateTimeLTE(now.Add(-max(bucket.minAge, minAge))),
+		)
+		if bucket.maxAge != 0 {
+			bucketQuery = bucketQuery.Where(transfer.UpdateTimeGT(now.Add(-bucket.maxAge)))
+		}
+
+		var statusCounts []struct {
+			Status schema.TransferStatus `json:"status"`
+			Count  int                   `json:"count"`
+		}
+		err := bucketQuery.Clone().
+			GroupBy(transfer.FieldStatus).
+			Aggregate(ent.Count()).
+			Scan(ctx, &statusCounts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count transfers: %w", err)
+		}
+
+		for _, c := range statusCounts {
+			oldest, err := bucketQuery.Clone().
+				Where(transfer.StatusEQ(c.Status)).
+				Order(ent.Asc(transfer.FieldUpdateTime)).
+				First(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load oldest transfer: %w", err)
+			}
+			groups = append(groups, &pbadmin.StuckTransferGroup{
+				Status:           string(c.Status),
+				AgeBucket:        bucket.label,
+				Count:            uint64(c.Count),
+				OldestTransferId: oldest.ID.String(),
+				OldestUpdateTime: timestamppb.New(oldest.UpdateTime),
+			})
+		}
+	}
+
+	return &pbadmin.ListStuckTransfersResponse{Groups: groups}, nil
+}
+
+// ListStuckPreimageRequests lists the preimage requests that have been waiting for their preimage
+// for a while, oldest first.
+func (h *AdminHandler) ListStuckPreimageRequests(ctx context.Context, req *pbadmin.ListStuckPreimageRequestsRequest) (*pbadmin.ListStuckPreimageRequestsResponse, error) {
+	db := ent.GetDbFromContext(ctx)
+
+	minAge := time.Duration(req.MinAgeSeconds) * time.Second
+	if minAge == 0 {
+		minAge = defaultStuckPreimageRequestAge
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultStuckPreimageRequestLimit
+	}
+
+	query := db.PreimageRequest.Query().Where(
+		preimagerequest.StatusEQ(schema.PreimageRequestStatusWaitingForPreimage),
+		preimagerequest.CreateTimeLTE(time.Now().Add(-minAge)),
+	)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count preimage requests: %w", err)
+	}
+	preimageRequests, err := query.
+		Order(ent.Asc(preimagerequest.FieldCreateTime)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load preimage requests: %w", err)
+	}
+
+	requests := make([]*pbadmin.StuckPreimageRequest, 0, len(preimageRequests))
+	for _, r := range preimageRequests {
+		requests = append(requests, &pbadmin.StuckPreimageRequest{
+			Id:                        r.ID.String(),
+			PaymentHash:               r.PaymentHash,
+			ReceiverIdentityPublicKey: r.ReceiverIdentityPubkey,
+			CreateTime:                timestamppb.New(r.CreateTime),
+		})
+	}
+
+	return &pbadmin.ListStuckPreimageRequestsResponse{Requests: requests, Total: uint64(total)}, nil
+}
+
+// GetChainStatus compares the height processed by the chain watcher of every network with the
+// height of its bitcoin node.
+func (h *AdminHandler) GetChainStatus(ctx context.Context, _ *pbadmin.GetChainStatusRequest) (*pbadmin.GetChainStatusResponse, error) {
+	db := ent.GetDbFromContext(ctx)
+
+	bitcoindConfigs := h.config.Reloadable().BitcoindConfigs
+	statuses := make([]*pbadmin.ChainStatus, 0, len(bitcoindConfigs))
+	for _, bitcoindConfig := range bitcoindConfigs {
+		network, err := common.NetworkFromString(bitcoindConfig.Network)
+		if err != nil {
+			return nil, err
+		}
+		protoNetwork, err := common.ProtoNetworkFromNetwork(network)
+		if err != nil {
+			return nil, err
+		}
+		status := &pbadmin.ChainStatus{Network: protoNetwork}
+		statuses = append(statuses, status)
+
+		blockHeight, err := db.BlockHeight.Query().
+			Where(blockheight.NetworkEQ(common.SchemaNetwork(network))).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			status.Error = "chain watcher has not started"
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load block height for %s: %w", network, err)
+		}
+		status.ProcessedHeight = blockHeight.Height
+		status.ProcessedTime = timestamppb.New(blockHeight.UpdateTime)
+
+		nodeHeight, err := getBitcoindBlockCount(ctx, bitcoindConfig)
+		if err != nil {
+			status.Error = fmt.Sprintf("failed to get block count from bitcoind: %v", err)
+			continue
+		}
+		status.NodeHeight = &nodeHeight
+		status.LagBlocks = nodeHeight - blockHeight.Height
+	}
+
+	slices.SortFunc(statuses, func(a, b *pbadmin.ChainStatus) int {
+		return int(a.Network) - int(b.Network)
+	})
+	return &pbadmin.GetChainStatusResponse{Networks: statuses}, nil
+}
+
+// getBitcoindBlockCount gets the block height of a bitcoin node, using the same connection
+// settings as the chain watcher.
+func getBitcoindBlockCount(ctx context.Context, bitcoindConfig so.BitcoindConfig) (int64, error) {
+	client, err := rpcclient.New(&rpcclient.ConnConfig{
+		Host:         bitcoindConfig.Host,
+		User:         bitcoindConfig.User,
+		Pass:         bitcoindConfig.Password,
+		Params:       bitcoindConfig.Network,
+		DisableTLS:   true,
+		HTTPPostMode: true,
+	}, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Shutdown()
+
+	ctx, cancel := context.WithTimeout(ctx, bitcoindRequestTimeout)
+	defer cancel()
+
+	// The RPC client does not take a context, so wait for the result in the background.
+	type blockCount struct {
+		height int64
+		err    error
+	}
+	result := client.GetBlockCountAsync()
+	done := make(chan blockCount, 1)
+	go func() {
+		height, err := result.Receive()
+		done <- blockCount{height, err}
+	}()
+	select {
+	case count := <-done:
+		return count.height, count.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// GetLrc20PoolStatus reports the health of the LRC20 connection pool of every network.
+func (h *AdminHandler) GetLrc20PoolStatus(_ context.Context, _ *pbadmin.GetLrc20PoolStatusRequest) (*pbadmin.GetLrc20PoolStatusResponse, error) {
+	poolStats := h.lrc20Client.PoolStats()
+	pools := make([]*pbadmin.Lrc20PoolStatus, 0, len(poolStats))
+	for _, stats := range poolStats {
+		network, err := common.ProtoNetworkFromNetwork(stats.Network)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, &pbadmin.Lrc20PoolStatus{
+			Network:              network,
+			MaxSize:              uint32(stats.MaxSize),
+			OpenConnections:      uint32(stats.Open),
+			IdleConnections:      uint32(stats.Idle),
+			UnhealthyConnections: uint32(stats.Unhealthy),
+		})
+	}
+	return &pbadmin.GetLrc20PoolStatusResponse{Pools: pools}, nil
+}
+
+// SetNetworkPause pauses or resumes new transfers and deposits on a network.
+func (h *AdminHandler) SetNetworkPause(ctx context.Context, req *pbadmin.SetNetworkPauseRequest) (*pbadmin.SetNetworkPauseResponse, error) {
+	network, err := common.NetworkFromProtoNetwork(req.Network)
+	if err != nil {
+		return nil, errors.InvalidUserInputErrorf("invalid network %s", req.Network)
+	}
+	if !h.config.IsNetworkSupported(network) {
+		return nil, errors.InvalidUserInputErrorf("network %s is not supported by this operator", network)
+	}
+	schemaNetwork := common.SchemaNetwork(network)
+
+	db := ent.GetDbFromContext(ctx)
+	pause, err := db.NetworkPause.Query().
+		Where(networkpause.NetworkEQ(schemaNetwork)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		pause, err = db.NetworkPause.Create().
+			SetNetwork(schemaNetwork).
+			SetTransfersPaused(req.PauseTransfers).
+			SetDepositsPaused(req.PauseDeposits).
+			SetReason(req.Reason).
+			Save(ctx)
+	case err == nil:
+		pause, err = pause.Update().
+			SetTransfersPaused(req.PauseTransfers).
+			SetDepositsPaused(req.PauseDeposits).
+			SetReason(req.Reason).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save pause for %s: %w", network, err)
+	}
+
+	pauseProto, err := pause.MarshalProto()
+	if err != nil {
+		return nil, err
+	}
+	return &pbadmin.SetNetworkPauseResponse{Pause: pauseProto}, nil
+}
+
+// ListNetworkPauses lists the networks on which new transfers or deposits are paused.
+func (h *AdminHandler) ListNetworkPauses(ctx context.Context, _ *pbadmin.ListNetworkPausesRequest) (*pbadmin.ListNetworkPausesResponse, error) {
+	pauses, err := ent.GetDbFromContext(ctx).NetworkPause.Query().
+		Where(networkpause.Or(
+			networkpause.TransfersPaused(true),
+			networkpause.DepositsPaused(true),
+		)).
+		Order(ent.Asc(networkpause.FieldNetwork)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pauses: %w", err)
+	}
+
+	pauseProtos := make([]*pbadmin.NetworkPause, 0, len(pauses))
+	for _, pause := range pauses {
+		pauseProto, err := pause.MarshalProto()
+		if err != nil {
+			return nil, err
+		}
+		pauseProtos = append(pauseProtos, pauseProto)
+	}
+	return &pbadmin.ListNetworkPausesResponse{Pauses: pauseProtos}, nil
+}
+
+// ListConsistencyDiscrepancies lists the discrepancies found by the consistency audit, most
+// recently updated first.
+func (h *AdminHandler) ListConsistencyDiscrepancies(ctx context.Context, req *pbadmin.ListConsistencyDiscrepanciesRequest) (*pbadmin.ListConsistencyDiscrepanciesResponse, error) {
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultConsistencyDiscrepancyLimit
+	}
+	query := ent.GetDbFromContext(ctx).ConsistencyDiscrepancy.Query()
+	if !req.IncludeClosed {
+		query = query.Where(consistencydiscrepancy.StatusEQ(schema.ConsistencyDiscrepancyStatusOpen))
+	}
+	discrepancies, err := query.
+		Order(ent.Desc(consistencydiscrepancy.FieldUpdateTime)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load consistency discrepancies: %w", err)
+	}
+
+	discrepancyProtos := make([]*pbadmin.ConsistencyDiscrepancy, 0, len(discrepancies))
+	for _, discrepancy := range discrepancies {
+		discrepancyProtos = append(discrepancyProtos, discrepancy.MarshalProto())
+	}
+	return &pbadmin.ListConsistencyDiscrepanciesResponse{Discrepancies: discrepancyProtos}, nil
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightsparkdev/spark/common"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetNetworkPause(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:admin_network_pause?mode=memory&_fk=1")
+	defer db.Close()
+	tx, err := db.Tx(context.Background())
+	require.NoError(t, err)
+	defer tx.Rollback() //nolint:errcheck
+	ctx := context.WithValue(context.Background(), ent.TxKey, tx)
+
+	h := NewAdminHandler(&so.Config{SupportedNetworks: []common.Network{common.Regtest}}, nil)
+
+	_, err = h.SetNetworkPause(ctx, &pbadmin.SetNetworkPauseRequest{
+		Network:        pb.Network_REGTEST,
+		PauseTransfers: true,
+		Reason:         "incident",
+	})
+	require.NoError(t, err)
+
+	err = ent.EnsureTransfersNotPaused(ctx, schema.NetworkRegtest)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.NoError(t, ent.EnsureDepositsNotPaused(ctx, schema.NetworkRegtest))
+	require.NoError(t, ent.EnsureTransfersNotPaused(ctx, schema.NetworkMainnet))
+
+	pauses, err := h.ListNetworkPauses(ctx, &pbadmin.ListNetworkPausesRequest{})
+	require.NoError(t, err)
+	require.Len(t, pauses.Pauses, 1)
+	require.Equal(t, "incident", pauses.Pauses[0].Reason)
+
+	_, err = h.SetNetworkPause(ctx, &pbadmin.SetNetworkPauseRequest{Network: pb.Network_REGTEST})
+	require.NoError(t, err)
+	require.NoError(t, ent.EnsureTransfersNotPaused(ctx, schema.NetworkRegtest))
+
+	pauses, err = h.ListNetworkPauses(ctx, &pbadmin.ListNetworkPausesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, pauses.Pauses)
+
+	_, err = h.SetNetworkPause(ctx, &pbadmin.SetNetworkPauseRequest{Network: pb.Network_MAINNET})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
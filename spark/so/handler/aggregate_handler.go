@@ -161,7 +161,7 @@ func (h *AggregateHandler) AggregateNodes(ctx context.Context, req *pb.Aggregate
 		Option: helper.OperatorSelectionOptionExcludeSelf,
 	}
 	_, err = helper.ExecuteTaskWithAllOperators(ctx, h.config, &selection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-		conn, err := operator.NewGRPCConnection()
+		conn, err := h.config.NewOperatorConnection(operator)
 		if err != nil {
 			logger.Error("Failed to connect to operator", "error", err)
 			return nil, err
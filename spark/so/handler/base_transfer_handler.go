@@ -19,6 +19,7 @@ import (
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/authz"
 	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
 	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
 	"github.com/lightsparkdev/spark/so/ent/schema"
 	enttransfer "github.com/lightsparkdev/spark/so/ent/transfer"
@@ -58,11 +59,11 @@ func validateLeafRefundTxOutput(refundTx *wire.MsgTx, receiverIdentityPublicKey
 	}
 	receiverIdentityPubkey, err := secp256k1.ParsePubKey(receiverIdentityPublicKey)
 	if err != nil {
-		return fmt.Errorf("unable to parse receiver pubkey: %v", err)
+		return fmt.Errorf("unable to parse receiver pubkey: %w", err)
 	}
 	recieverP2trScript, err := common.P2TRScriptFromPubKey(receiverIdentityPubkey)
 	if err != nil {
-		return fmt.Errorf("unable to generate p2tr script from receiver pubkey: %v", err)
+		return fmt.Errorf("unable to generate p2tr script from receiver pubkey: %w", err)
 	}
 	if !bytes.Equal(recieverP2trScript, refundTx.TxOut[0].PkScript) {
 		return fmt.Errorf("refund tx is expected to send to receiver identity pubkey")
@@ -74,7 +75,7 @@ func validateLeafRefundTxInput(refundTx *wire.MsgTx, oldSequence uint32, leafOut
 	newTimeLock := refundTx.TxIn[0].Sequence & 0xFFFF
 	oldTimeLock := oldSequence & 0xFFFF
 	if newTimeLock+spark.TimeLockInterval > oldTimeLock {
-		return fmt.Errorf("time lock on the new refund tx %d must be less than the old one %d", newTimeLock, oldTimeLock)
+		return errors.Reasonf(errors.ReasonInsufficientTimelock, "time lock on the new refund tx %d must be less than the old one %d", newTimeLock, oldTimeLock)
 	}
 	if len(refundTx.TxIn) != int(expectedInputCount) {
 		return fmt.Errorf("refund tx should have %d inputs, but has %d", expectedInputCount, len(refundTx.TxIn))
@@ -88,11 +89,11 @@ func validateLeafRefundTxInput(refundTx *wire.MsgTx, oldSequence uint32, leafOut
 func validateSendLeafRefundTx(leaf *ent.TreeNode, rawTx []byte, receiverIdentityKey []byte, expectedInputCount uint32) error {
 	newRefundTx, err := common.TxFromRawTxBytes(rawTx)
 	if err != nil {
-		return fmt.Errorf("unable to load new refund tx: %v", err)
+		return fmt.Errorf("unable to load new refund tx: %w", err)
 	}
 	oldRefundTx, err := common.TxFromRawTxBytes(leaf.RawRefundTx)
 	if err != nil {
-		return fmt.Errorf("unable to load old refund tx: %v", err)
+		return fmt.Errorf("unable to load old refund tx: %w", err)
 	}
 	oldRefundTxIn := oldRefundTx.TxIn[0]
 	leafOutPoint := wire.OutPoint{
@@ -102,12 +103,12 @@ func validateSendLeafRefundTx(leaf *ent.TreeNode, rawTx []byte, receiverIdentity
 
 	err = validateLeafRefundTxInput(newRefundTx, oldRefundTxIn.Sequence, &leafOutPoint, expectedInputCount)
 	if err != nil {
-		return fmt.Errorf("unable to validate refund tx inputs: %v", err)
+		return fmt.Errorf("unable to validate refund tx inputs: %w", err)
 	}
 
 	err = validateLeafRefundTxOutput(newRefundTx, receiverIdentityKey)
 	if err != nil {
-		return fmt.Errorf("unable to validate refund tx output: %v", err)
+		return fmt.Errorf("unable to validate refund tx output: %w", err)
 	}
 
 	return nil
@@ -126,11 +127,11 @@ func (h *BaseTransferHandler) createTransfer(
 ) (*ent.Transfer, map[string]*ent.TreeNode, error) {
 	transferUUID, err := uuid.Parse(transferID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to parse transfer_id as a uuid %s: %v", transferID, err)
+		return nil, nil, fmt.Errorf("unable to parse transfer_id as a uuid %s: %w", transferID, err)
 	}
 
 	if expiryTime.Unix() != 0 && expiryTime.Before(time.Now()) {
-		return nil, nil, fmt.Errorf("invalid expiry_time %s: %v", expiryTime.String(), err)
+		return nil, nil, fmt.Errorf("invalid expiry_time %s: %w", expiryTime.String(), err)
 	}
 
 	var status schema.TransferStatus
@@ -145,6 +146,14 @@ func (h *BaseTransferHandler) createTransfer(
 	}
 
 	db := ent.GetDbFromContext(ctx)
+	// Archived transfers are gone from the transfers table, but their IDs stay taken.
+	archived, err := db.ArchivedTransfer.Query().Where(archivedtransfer.ID(transferUUID)).Exist(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to check for archived transfer %s: %w", transferID, err)
+	}
+	if archived {
+		return nil, nil, errors.Reasonf(errors.ReasonTransferAlreadyExists, "transfer %s already exists", transferID)
+	}
 	transfer, err := db.Transfer.Create().
 		SetID(transferUUID).
 		SetSenderIdentityPubkey(senderIdentityPublicKey).
@@ -153,18 +162,22 @@ func (h *BaseTransferHandler) createTransfer(
 		SetTotalValue(0).
 		SetExpiryTime(expiryTime).
 		SetType(transferType).
+		SetCoordinatorIdentityPubkey(h.config.CallCoordinator(ctx)).
 		Save(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to create transfer: %v", err)
+		return nil, nil, fmt.Errorf("unable to create transfer: %w", err)
 	}
 
 	if len(leafRefundMap) == 0 {
 		return nil, nil, errors.InvalidUserInputErrorf("must provide at least one leaf for transfer")
 	}
 
-	leaves, err := loadLeaves(ctx, db, leafRefundMap)
+	leaves, network, err := loadLeaves(ctx, db, leafRefundMap)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to load leaves: %v", err)
+		return nil, nil, fmt.Errorf("unable to load leaves: %w", err)
+	}
+	if err := ent.EnsureTransfersNotPaused(ctx, network); err != nil {
+		return nil, nil, err
 	}
 
 	switch transferType {
@@ -178,22 +191,22 @@ func (h *BaseTransferHandler) createTransfer(
 		// do nothing
 	}
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to validate transfer leaves: %v", err)
+		return nil, nil, fmt.Errorf("unable to validate transfer leaves: %w", err)
 	}
 
 	err = createTransferLeaves(ctx, db, transfer, leaves, leafRefundMap, leafTweakMap)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to create transfer leaves: %v", err)
+		return nil, nil, fmt.Errorf("unable to create transfer leaves: %w", err)
 	}
 
 	err = setTotalTransferValue(ctx, db, transfer, leaves)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to update transfer total value: %v", err)
+		return nil, nil, fmt.Errorf("unable to update transfer total value: %w", err)
 	}
 
 	leaves, err = lockLeaves(ctx, db, leaves)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to lock leaves: %v", err)
+		return nil, nil, fmt.Errorf("unable to lock leaves: %w", err)
 	}
 
 	leafMap := make(map[string]*ent.TreeNode)
@@ -204,31 +217,33 @@ func (h *BaseTransferHandler) createTransfer(
 	return transfer, leafMap, nil
 }
 
-func loadLeaves(ctx context.Context, db *ent.Tx, leafRefundMap map[string][]byte) ([]*ent.TreeNode, error) {
+// loadLeaves loads the given leaves, which must all be on the same network, and returns them
+// together with their network.
+func loadLeaves(ctx context.Context, db *ent.Tx, leafRefundMap map[string][]byte) ([]*ent.TreeNode, schema.Network, error) {
 	leaves := make([]*ent.TreeNode, 0)
-	var network *schema.Network
+	var network schema.Network
 	for leafID := range leafRefundMap {
 		leafUUID, err := uuid.Parse(leafID)
 		if err != nil {
-			return nil, fmt.Errorf("unable to parse leaf_id %s: %v", leafID, err)
+			return nil, "", fmt.Errorf("unable to parse leaf_id %s: %w", leafID, err)
 		}
 
 		leaf, err := db.TreeNode.Get(ctx, leafUUID)
 		if err != nil || leaf == nil {
-			return nil, fmt.Errorf("unable to find leaf %s: %v", leafID, err)
+			return nil, "", fmt.Errorf("unable to find leaf %s: %w", leafID, err)
 		}
 		tree, err := leaf.QueryTree().Only(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("unable to find tree for leaf %s: %v", leafID, err)
+			return nil, "", fmt.Errorf("unable to find tree for leaf %s: %w", leafID, err)
 		}
-		if network == nil {
-			network = &tree.Network
-		} else if tree.Network != *network {
-			return nil, fmt.Errorf("leaves sent for transfer must be on the same network")
+		if network == "" {
+			network = tree.Network
+		} else if tree.Network != network {
+			return nil, "", fmt.Errorf("leaves sent for transfer must be on the same network")
 		}
 		leaves = append(leaves, leaf)
 	}
-	return leaves, nil
+	return leaves, network, nil
 }
 
 func (h *BaseTransferHandler) validateCooperativeExitLeaves(ctx context.Context, transfer *ent.Transfer, leaves []*ent.TreeNode, leafRefundMap map[string][]byte, receiverIdentityPublicKey []byte) error {
@@ -236,11 +251,11 @@ func (h *BaseTransferHandler) validateCooperativeExitLeaves(ctx context.Context,
 		rawRefundTx := leafRefundMap[leaf.ID.String()]
 		err := validateSendLeafRefundTx(leaf, rawRefundTx, receiverIdentityPublicKey, 2)
 		if err != nil {
-			return fmt.Errorf("unable to validate refund tx for leaf %s: %v", leaf.ID, err)
+			return fmt.Errorf("unable to validate refund tx for leaf %s: %w", leaf.ID, err)
 		}
 		err = h.leafAvailableToTransfer(ctx, leaf, transfer)
 		if err != nil {
-			return fmt.Errorf("unable to validate leaf %s: %v", leaf.ID, err)
+			return fmt.Errorf("unable to validate leaf %s: %w", leaf.ID, err)
 		}
 	}
 	return nil
@@ -251,11 +266,11 @@ func (h *BaseTransferHandler) validateUtxoSwapLeaves(ctx context.Context, transf
 		rawRefundTx := leafRefundMap[leaf.ID.String()]
 		err := validateSendLeafRefundTx(leaf, rawRefundTx, receiverIdentityPublicKey, 1)
 		if err != nil {
-			return fmt.Errorf("unable to validate refund tx for leaf %s: %v", leaf.ID, err)
+			return fmt.Errorf("unable to validate refund tx for leaf %s: %w", leaf.ID, err)
 		}
 		err = h.leafAvailableToTransfer(ctx, leaf, transfer)
 		if err != nil {
-			return fmt.Errorf("unable to validate leaf %s: %v", leaf.ID, err)
+			return fmt.Errorf("unable to validate leaf %s: %w", leaf.ID, err)
 		}
 	}
 	return nil
@@ -266,11 +281,11 @@ func (h *BaseTransferHandler) validateTransferLeaves(ctx context.Context, transf
 		rawRefundTx := leafRefundMap[leaf.ID.String()]
 		err := validateSendLeafRefundTx(leaf, rawRefundTx, receiverIdentityPublicKey, 1)
 		if err != nil {
-			return fmt.Errorf("unable to validate refund tx for leaf %s: %v", leaf.ID, err)
+			return fmt.Errorf("unable to validate refund tx for leaf %s: %w", leaf.ID, err)
 		}
 		err = h.leafAvailableToTransfer(ctx, leaf, transfer)
 		if err != nil {
-			return fmt.Errorf("unable to validate leaf %s: %v", leaf.ID, err)
+			return fmt.Errorf("unable to validate leaf %s: %w", leaf.ID, err)
 		}
 	}
 	return nil
@@ -283,7 +298,7 @@ func (h *BaseTransferHandler) leafAvailableToTransfer(ctx context.Context, leaf
 				enttransferleaf.HasLeafWith(treenode.IDEQ(leaf.ID)),
 			).WithTransfer().All(ctx)
 			if err != nil {
-				return fmt.Errorf("unable to find transfer leaf for leaf %s: %v", leaf.ID.String(), err)
+				return fmt.Errorf("unable to find transfer leaf for leaf %s: %w", leaf.ID.String(), err)
 			}
 			now := time.Now()
 			for _, transferLeaf := range transferLeaves {
@@ -293,12 +308,12 @@ func (h *BaseTransferHandler) leafAvailableToTransfer(ctx context.Context, leaf
 						SenderIdentityPublicKey: transfer.SenderIdentityPubkey,
 					}, CancelTransferIntentTask)
 					if err != nil {
-						return fmt.Errorf("unable to cancel transfer: %v", err)
+						return fmt.Errorf("unable to cancel transfer: %w", err)
 					}
 				}
 			}
 		}
-		return fmt.Errorf("leaf %s is not available to transfer, status: %s", leaf.ID.String(), leaf.Status)
+		return errors.Reasonf(leaf.UnavailableReason(), "leaf %s is not available to transfer, status: %s", leaf.ID.String(), leaf.Status)
 	}
 	if !bytes.Equal(leaf.OwnerIdentityPubkey, transfer.SenderIdentityPubkey) {
 		return fmt.Errorf("leaf %s is not owned by sender", leaf.ID.String())
@@ -326,14 +341,14 @@ func createTransferLeaves(
 			if ok {
 				leafTweakBinary, err := proto.Marshal(leafTweak)
 				if err != nil {
-					return fmt.Errorf("unable to marshal leaf tweak: %v", err)
+					return fmt.Errorf("unable to marshal leaf tweak: %w", err)
 				}
 				mutator = mutator.SetKeyTweak(leafTweakBinary)
 			}
 		}
 		_, err := mutator.Save(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to create transfer leaf: %v", err)
+			return fmt.Errorf("unable to create transfer leaf: %w", err)
 		}
 	}
 	return nil
@@ -343,7 +358,7 @@ func setTotalTransferValue(ctx context.Context, db *ent.Tx, transfer *ent.Transf
 	totalAmount := getTotalTransferValue(leaves)
 	_, err := db.Transfer.UpdateOne(transfer).SetTotalValue(totalAmount).Save(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to update transfer total value: %v", err)
+		return fmt.Errorf("unable to update transfer total value: %w", err)
 	}
 	return nil
 }
@@ -362,7 +377,7 @@ func lockLeaves(ctx context.Context, db *ent.Tx, leaves []*ent.TreeNode) ([]*ent
 		lockedLeaf, err := db.TreeNode.UpdateOne(leaf).SetStatus(schema.TreeNodeStatusTransferLocked).Save(ctx)
 		lockedLeaves = append(lockedLeaves, lockedLeaf)
 		if err != nil {
-			return nil, fmt.Errorf("unable to update leaf status: %v", err)
+			return nil, fmt.Errorf("unable to update leaf status: %w", err)
 		}
 	}
 	return lockedLeaves, nil
@@ -387,7 +402,7 @@ func (h *BaseTransferHandler) CancelTransfer(
 		}
 		operatorSelection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionExcludeSelf}
 		_, err := helper.ExecuteTaskWithAllOperators(ctx, h.config, &operatorSelection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-			conn, err := operator.NewGRPCConnection()
+			conn, err := h.config.NewOperatorConnection(operator)
 			if err != nil {
 				return nil, err
 			}
@@ -396,12 +411,12 @@ func (h *BaseTransferHandler) CancelTransfer(
 			client := pbinternal.NewSparkInternalServiceClient(conn)
 			_, err = client.CancelTransfer(ctx, req)
 			if err != nil {
-				return nil, fmt.Errorf("unable to cancel transfer: %v", err)
+				return nil, fmt.Errorf("unable to cancel transfer: %w", err)
 			}
 			return nil, nil
 		})
 		if err != nil {
-			return nil, fmt.Errorf("unable to cancel transfer: %v", err)
+			return nil, fmt.Errorf("unable to cancel transfer: %w", err)
 		}
 	}
 
@@ -413,6 +428,11 @@ func (h *BaseTransferHandler) CancelTransfer(
 	if !bytes.Equal(transfer.SenderIdentityPubkey, req.SenderIdentityPublicKey) {
 		return nil, fmt.Errorf("only sender is eligible to cancel the transfer %s", req.TransferId)
 	}
+	if intent == CancelTransferIntentInternal {
+		if err := h.config.CheckRecordedCoordinator(ctx, transfer.CoordinatorIdentityPubkey); err != nil {
+			return nil, err
+		}
+	}
 	// Don't error if the transfer is already returned.
 	if transfer.Status == schema.TransferStatusReturned {
 		return &pbspark.CancelTransferResponse{}, nil
@@ -421,22 +441,22 @@ func (h *BaseTransferHandler) CancelTransfer(
 		return nil, fmt.Errorf("transfer %s is expected to be at status TransferStatusSenderInitiated or TransferStatusSenderKeyTweakPending but %s found", req.TransferId, transfer.Status)
 	}
 	if intent == CancelTransferIntentExternal && transfer.Status != schema.TransferStatusSenderInitiated && transfer.ExpiryTime.After(time.Now()) {
-		return nil, fmt.Errorf("transfer %s has not expired, expires at %s", req.TransferId, transfer.ExpiryTime.String())
+		return nil, errors.Reasonf(errors.ReasonTransferNotExpired, "transfer %s has not expired, expires at %s", req.TransferId, transfer.ExpiryTime.String())
 	}
 
 	transfer, err = transfer.Update().SetStatus(schema.TransferStatusReturned).Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to update transfer status: %v", err)
+		return nil, fmt.Errorf("unable to update transfer status: %w", err)
 	}
 
 	err = h.cancelTransferUnlockLeaves(ctx, transfer)
 	if err != nil {
-		return nil, fmt.Errorf("unable to unlock leaves in the transfer: %v", err)
+		return nil, fmt.Errorf("unable to unlock leaves in the transfer: %w", err)
 	}
 
 	err = h.cancelTransferCancelRequest(ctx, transfer)
 	if err != nil {
-		return nil, fmt.Errorf("unable to cancel associated request: %v", err)
+		return nil, fmt.Errorf("unable to cancel associated request: %w", err)
 	}
 
 	return &pbspark.CancelTransferResponse{}, nil
@@ -445,17 +465,17 @@ func (h *BaseTransferHandler) CancelTransfer(
 func (h *BaseTransferHandler) cancelTransferUnlockLeaves(ctx context.Context, transfer *ent.Transfer) error {
 	transferLeaves, err := transfer.QueryTransferLeaves().All(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to get transfer leaves: %v", err)
+		return fmt.Errorf("unable to get transfer leaves: %w", err)
 	}
 
 	for _, leaf := range transferLeaves {
 		treenode, err := leaf.QueryLeaf().Only(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to get tree node: %v", err)
+			return fmt.Errorf("unable to get tree node: %w", err)
 		}
 		_, err = treenode.Update().SetStatus(schema.TreeNodeStatusAvailable).Save(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to update tree node status: %v", err)
+			return fmt.Errorf("unable to update tree node status: %w", err)
 		}
 	}
 	return nil
@@ -470,22 +490,30 @@ func (h *BaseTransferHandler) cancelTransferCancelRequest(ctx context.Context, t
 		}
 		err = preimageRequest.Update().SetStatus(schema.PreimageRequestStatusReturned).Exec(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to update preimage request status: %v", err)
+			return fmt.Errorf("unable to update preimage request status: %w", err)
 		}
 	}
 	return nil
 }
 
+// transferExpired returns whether the transfer expired or was returned to its sender.
+func transferExpired(transfer *ent.Transfer) bool {
+	return transfer.Status == schema.TransferStatusExpired || transfer.Status == schema.TransferStatusReturned
+}
+
 func (h *BaseTransferHandler) loadTransfer(ctx context.Context, transferID string) (*ent.Transfer, error) {
 	transferUUID, err := uuid.Parse(transferID)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse transfer_id as a uuid %s: %v", transferID, err)
+		return nil, fmt.Errorf("unable to parse transfer_id as a uuid %s: %w", transferID, err)
 	}
 
 	db := ent.GetDbFromContext(ctx)
 	transfer, err := db.Transfer.Query().Where(enttransfer.ID(transferUUID)).ForUpdate().Only(ctx)
-	if err != nil || transfer == nil {
-		return nil, fmt.Errorf("unable to find transfer %s: %v", transferID, err)
+	if ent.IsNotFound(err) {
+		return nil, errors.Reasonf(errors.ReasonTransferNotFound, "transfer %s not found", transferID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to find transfer %s: %w", transferID, err)
 	}
 	return transfer, nil
 }
@@ -493,13 +521,16 @@ func (h *BaseTransferHandler) loadTransfer(ctx context.Context, transferID strin
 func (h *BaseTransferHandler) loadTransferWithoutUpdate(ctx context.Context, transferID string) (*ent.Transfer, error) {
 	transferUUID, err := uuid.Parse(transferID)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse transfer_id as a uuid %s: %v", transferID, err)
+		return nil, fmt.Errorf("unable to parse transfer_id as a uuid %s: %w", transferID, err)
 	}
 
 	db := ent.GetDbFromContext(ctx)
 	transfer, err := db.Transfer.Query().Where(enttransfer.ID(transferUUID)).Only(ctx)
-	if err != nil || transfer == nil {
-		return nil, fmt.Errorf("unable to find transfer %s: %v", transferID, err)
+	if ent.IsNotFound(err) {
+		return nil, errors.Reasonf(errors.ReasonTransferNotFound, "transfer %s not found", transferID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to find transfer %s: %w", transferID, err)
 	}
 	return transfer, nil
 }
@@ -520,13 +551,13 @@ func (h *BaseTransferHandler) validateTransferPackage(_ context.Context, transfe
 	decryptionPrivateKey := eciesgo.NewPrivateKeyFromBytes(h.config.IdentityPrivateKey)
 	leafTweaksBinary, err := eciesgo.Decrypt(decryptionPrivateKey, leafTweaksCipherText)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decrypt key tweaks: %v", err)
+		return nil, fmt.Errorf("failed to decrypt key tweaks: %w", err)
 	}
 
 	leafTweaks := &pb.SendLeafKeyTweaks{}
 	err = proto.Unmarshal(leafTweaksBinary, leafTweaks)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal key tweaks: %v", err)
+		return nil, fmt.Errorf("failed to unmarshal key tweaks: %w", err)
 	}
 
 	leafTweaksMap := make(map[string]*pb.SendLeafKeyTweak)
@@ -536,17 +567,17 @@ func (h *BaseTransferHandler) validateTransferPackage(_ context.Context, transfe
 
 	transferIDUUID, err := uuid.Parse(transferID)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse transfer_id as a uuid %s: %v", transferID, err)
+		return nil, fmt.Errorf("unable to parse transfer_id as a uuid %s: %w", transferID, err)
 	}
 	payloadToVerify := common.GetTransferPackageSigningPayload(transferIDUUID, req)
 
 	signature, err := ecdsa.ParseDERSignature(req.UserSignature)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse user signature: %v", err)
+		return nil, fmt.Errorf("unable to parse user signature: %w", err)
 	}
 	userPublicKey, err := secp256k1.ParsePubKey(senderIdentityPublicKey)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse user public key: %v", err)
+		return nil, fmt.Errorf("unable to parse user public key: %w", err)
 	}
 	valid := signature.Verify(payloadToVerify, userPublicKey)
 	if !valid {
@@ -567,30 +598,30 @@ func (h *BaseTransferHandler) commitSenderKeyTweaks(ctx context.Context, transfe
 	}
 	transferLeaves, err := transfer.QueryTransferLeaves().All(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to get transfer leaves: %v", err)
+		return fmt.Errorf("unable to get transfer leaves: %w", err)
 	}
 	for _, leaf := range transferLeaves {
 		keyTweak := &pb.SendLeafKeyTweak{}
 		err := proto.Unmarshal(leaf.KeyTweak, keyTweak)
 		if err != nil {
-			return fmt.Errorf("unable to unmarshal key tweak: %v", err)
+			return fmt.Errorf("unable to unmarshal key tweak: %w", err)
 		}
 		treeNode, err := leaf.QueryLeaf().Only(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to get tree node: %v", err)
+			return fmt.Errorf("unable to get tree node: %w", err)
 		}
 		err = helper.TweakLeafKey(ctx, treeNode, keyTweak, nil)
 		if err != nil {
-			return fmt.Errorf("unable to tweak leaf key: %v", err)
+			return fmt.Errorf("unable to tweak leaf key: %w", err)
 		}
 		_, err = leaf.Update().SetKeyTweak(nil).Save(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to update leaf key tweak: %v", err)
+			return fmt.Errorf("unable to update leaf key tweak: %w", err)
 		}
 	}
 	_, err = transfer.Update().SetStatus(schema.TransferStatusSenderKeyTweaked).Save(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to update transfer status: %v", err)
+		return fmt.Errorf("unable to update transfer status: %w", err)
 	}
 	return nil
 }
@@ -0,0 +1,752 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/common/logging"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
+	"github.com/lightsparkdev/spark/so/ent/consistencyauditcursor"
+	"github.com/lightsparkdev/spark/so/ent/consistencydiscrepancy"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
+	"github.com/lightsparkdev/spark/so/ent/transfer"
+	"github.com/lightsparkdev/spark/so/ent/tree"
+	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/errors"
+	"github.com/lightsparkdev/spark/so/helper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	consistencyDiscrepanciesCounter metric.Int64Counter
+	consistencyRepairsCounter       metric.Int64Counter
+)
+
+func init() {
+	meter := otel.Meter("consistency")
+	var err error
+	consistencyDiscrepanciesCounter, err = meter.Int64Counter(
+		"spark_consistency_discrepancies",
+		metric.WithDescription("Number of keys found to differ between operators by the consistency audit"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	consistencyRepairsCounter, err = meter.Int64Counter(
+		"spark_consistency_repairs",
+		metric.WithDescription("Number of keys whose local state the consistency audit overwrote with the state of the majority"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+var consistencyKindToProto = map[schema.ConsistencyKind]pbinternal.ConsistencyKind{
+	schema.ConsistencyKindTree:        pbinternal.ConsistencyKind_CONSISTENCY_KIND_TREE,
+	schema.ConsistencyKindTransfer:    pbinternal.ConsistencyKind_CONSISTENCY_KIND_TRANSFER,
+	schema.ConsistencyKindTokenOutput: pbinternal.ConsistencyKind_CONSISTENCY_KIND_TOKEN_OUTPUT,
+}
+
+func consistencyKindFromProto(kind pbinternal.ConsistencyKind) (schema.ConsistencyKind, error) {
+	for k, v := range consistencyKindToProto {
+		if v == kind {
+			return k, nil
+		}
+	}
+	return "", errors.InvalidUserInputErrorf("invalid consistency kind %s", kind)
+}
+
+// consistencyState is the state an operator holds for one key of the consistency audit. Records
+// are added in order of ID, and hashed as they are added, so that operators holding the same state
+// compute the same digest without holding every record.
+type consistencyState struct {
+	hash           hash.Hash
+	count          int
+	lastUpdateTime time.Time
+	// records holds the records added, if they are kept.
+	records []*pbinternal.ConsistencyRecord
+}
+
+func newConsistencyState(keepRecords bool) *consistencyState {
+	state := &consistencyState{hash: sha256.New()}
+	if keepRecords {
+		state.records = []*pbinternal.ConsistencyRecord{}
+	}
+	return state
+}
+
+func (s *consistencyState) add(record *pbinternal.ConsistencyRecord, updateTime time.Time) error {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_ = binary.Write(s.hash, binary.BigEndian, uint32(len(data)))
+	s.hash.Write(data)
+	s.count++
+	if updateTime.After(s.lastUpdateTime) {
+		s.lastUpdateTime = updateTime
+	}
+	if s.records != nil {
+		s.records = append(s.records, record)
+	}
+	return nil
+}
+
+func (s *consistencyState) digest() []byte {
+	return s.hash.Sum(nil)
+}
+
+// consistencyRecordsDigest returns the digest of the records, as computed by the operator holding
+// them.
+func consistencyRecordsDigest(records []*pbinternal.ConsistencyRecord) ([]byte, error) {
+	records = slices.Clone(records)
+	slices.SortFunc(records, func(a, b *pbinternal.ConsistencyRecord) int {
+		return bytes.Compare([]byte(a.Id), []byte(b.Id))
+	})
+	state := newConsistencyState(false)
+	for _, record := range records {
+		if err := state.add(record, time.Time{}); err != nil {
+			return nil, err
+		}
+	}
+	return state.digest(), nil
+}
+
+// consistencyRecord is a record of the consistency audit, along with its ID and the key it
+// belongs to.
+type consistencyRecord struct {
+	id         uuid.UUID
+	key        string
+	record     *pbinternal.ConsistencyRecord
+	updateTime time.Time
+}
+
+// consistencyRecordPages reads the records of a table in order of ID, one page at a time.
+type consistencyRecordPages struct {
+	// load loads up to limit records with an ID greater than after, in order of ID.
+	load  func(ctx context.Context, after uuid.UUID, limit int) ([]consistencyRecord, error)
+	page  []consistencyRecord
+	after uuid.UUID
+	done  bool
+}
+
+// peek returns the next record, or nil once every record was read.
+func (p *consistencyRecordPages) peek(ctx context.Context) (*consistencyRecord, error) {
+	if len(p.page) == 0 && !p.done {
+		page, err := p.load(ctx, p.after, spark.ConsistencyAuditPageSize)
+		if err != nil {
+			return nil, err
+		}
+		p.page = page
+		p.done = len(page) < spark.ConsistencyAuditPageSize
+		if len(page) > 0 {
+			p.after = page[len(page)-1].id
+		}
+	}
+	if len(p.page) == 0 {
+		return nil, nil
+	}
+	return &p.page[0], nil
+}
+
+func (p *consistencyRecordPages) pop() {
+	p.page = p.page[1:]
+}
+
+// ConsistencyHandler compares the state of this operator with the state of the other operators.
+//
+// The state is compared by key: the nodes of a tree by tree ID, and the transfers and token outputs
+// of an owner by owner public key. Every operator audits the keys it holds, so a key missing from
+// one operator is found by the audit of the others.
+type ConsistencyHandler struct {
+	config *so.Config
+}
+
+// NewConsistencyHandler creates a new ConsistencyHandler.
+func NewConsistencyHandler(config *so.Config) *ConsistencyHandler {
+	return &ConsistencyHandler{config: config}
+}
+
+// GetConsistencyDigests computes the digest of the state this operator holds for each of the
+// requested keys.
+func (h *ConsistencyHandler) GetConsistencyDigests(ctx context.Context, req *pbinternal.GetConsistencyDigestsRequest) (*pbinternal.GetConsistencyDigestsResponse, error) {
+	kind, err := consistencyKindFromProto(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Keys) > spark.ConsistencyAuditBatchSize {
+		return nil, errors.InvalidUserInputErrorf("too many keys: %d, the maximum is %d", len(req.Keys), spark.ConsistencyAuditBatchSize)
+	}
+	states, err := loadConsistencyStates(ctx, kind, req.Keys, false)
+	if err != nil {
+		return nil, err
+	}
+	digests := make(map[string]*pbinternal.ConsistencyDigest, len(states))
+	for key, state := range states {
+		digests[key] = &pbinternal.ConsistencyDigest{
+			Digest:         state.digest(),
+			RecordCount:    uint32(state.count),
+			LastUpdateTime: timestamppb.New(state.lastUpdateTime),
+		}
+	}
+	return &pbinternal.GetConsistencyDigestsResponse{Digests: digests}, nil
+}
+
+// GetConsistencyRecords gets the state this operator holds for a key.
+func (h *ConsistencyHandler) GetConsistencyRecords(ctx context.Context, req *pbinternal.GetConsistencyRecordsRequest) (*pbinternal.GetConsistencyRecordsResponse, error) {
+	kind, err := consistencyKindFromProto(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+	states, err := loadConsistencyStates(ctx, kind, []string{req.Key}, true)
+	if err != nil {
+		return nil, err
+	}
+	state, ok := states[req.Key]
+	if !ok {
+		return &pbinternal.GetConsistencyRecordsResponse{}, nil
+	}
+	return &pbinternal.GetConsistencyRecordsResponse{Records: state.records}, nil
+}
+
+// loadConsistencyStates loads the state this operator holds for each of the keys, reading the
+// records a page at a time. Keys without any records are left out. The records of each state are
+// only kept if keepRecords is set.
+func loadConsistencyStates(ctx context.Context, kind schema.ConsistencyKind, keys []string, keepRecords bool) (map[string]*consistencyState, error) {
+	tables, err := consistencyRecordTables(ctx, kind, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]*consistencyState)
+	for {
+		// Merges the records of the tables in order of ID, so that they are added to their state
+		// in order.
+		var next *consistencyRecordPages
+		var nextRecord *consistencyRecord
+		for _, table := range tables {
+			record, err := table.peek(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if record != nil && (nextRecord == nil || bytes.Compare(record.id[:], nextRecord.id[:]) < 0) {
+				next, nextRecord = table, record
+			}
+		}
+		if next == nil {
+			return states, nil
+		}
+		state, ok := states[nextRecord.key]
+		if !ok {
+			state = newConsistencyState(keepRecords)
+			states[nextRecord.key] = state
+		}
+		if err := state.add(nextRecord.record, nextRecord.updateTime); err != nil {
+			return nil, err
+		}
+		next.pop()
+	}
+}
+
+// consistencyRecordTables returns the tables holding the records of the keys.
+func consistencyRecordTables(ctx context.Context, kind schema.ConsistencyKind, keys []string) ([]*consistencyRecordPages, error) {
+	db := ent.GetDbFromContext(ctx)
+	switch kind {
+	case schema.ConsistencyKindTree:
+		treeIDs := make([]uuid.UUID, len(keys))
+		for i, key := range keys {
+			treeID, err := uuid.Parse(key)
+			if err != nil {
+				return nil, errors.InvalidUserInputErrorf("invalid tree id %s: %w", key, err)
+			}
+			treeIDs[i] = treeID
+		}
+		return []*consistencyRecordPages{{load: func(ctx context.Context, after uuid.UUID, limit int) ([]consistencyRecord, error) {
+			nodes, err := db.TreeNode.Query().
+				Where(treenode.HasTreeWith(tree.IDIn(treeIDs...)), treenode.IDGT(after)).
+				WithTree(func(q *ent.TreeQuery) { q.Select(tree.FieldID) }).
+				Order(ent.Asc(treenode.FieldID)).
+				Limit(limit).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load tree nodes: %w", err)
+			}
+			records := make([]consistencyRecord, len(nodes))
+			for i, node := range nodes {
+				records[i] = consistencyRecord{
+					id:  node.ID,
+					key: node.Edges.Tree.ID.String(),
+					record: &pbinternal.ConsistencyRecord{
+						Id:                     node.ID.String(),
+						Status:                 string(node.Status),
+						OwnerIdentityPublicKey: node.OwnerIdentityPubkey,
+						OwnerSigningPublicKey:  node.OwnerSigningPubkey,
+						RawRefundTx:            node.RawRefundTx,
+					},
+					updateTime: node.UpdateTime,
+				}
+			}
+			return records, nil
+		}}}, nil
+	case schema.ConsistencyKindTransfer:
+		senders, err := decodeConsistencyKeys(keys)
+		if err != nil {
+			return nil, err
+		}
+		transfers := &consistencyRecordPages{load: func(ctx context.Context, after uuid.UUID, limit int) ([]consistencyRecord, error) {
+			transfers, err := db.Transfer.Query().
+				Where(transfer.SenderIdentityPubkeyIn(senders...), transfer.IDGT(after)).
+				Order(ent.Asc(transfer.FieldID)).
+				Limit(limit).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load transfers: %w", err)
+			}
+			records := make([]consistencyRecord, len(transfers))
+			for i, transfer := range transfers {
+				records[i] = consistencyRecord{
+					id:  transfer.ID,
+					key: hex.EncodeToString(transfer.SenderIdentityPubkey),
+					record: &pbinternal.ConsistencyRecord{
+						Id:     transfer.ID.String(),
+						Status: string(transfer.Status),
+					},
+					updateTime: transfer.UpdateTime,
+				}
+			}
+			return records, nil
+		}}
+		// Operators archive transfers at different times, so archived transfers count the same as
+		// the others.
+		archived := &consistencyRecordPages{load: func(ctx context.Context, after uuid.UUID, limit int) ([]consistencyRecord, error) {
+			transfers, err := db.ArchivedTransfer.Query().
+				Where(archivedtransfer.SenderIdentityPubkeyIn(senders...), archivedtransfer.IDGT(after)).
+				Order(ent.Asc(archivedtransfer.FieldID)).
+				Limit(limit).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load archived transfers: %w", err)
+			}
+			records := make([]consistencyRecord, len(transfers))
+			for i, transfer := range transfers {
+				records[i] = consistencyRecord{
+					id:  transfer.ID,
+					key: hex.EncodeToString(transfer.SenderIdentityPubkey),
+					record: &pbinternal.ConsistencyRecord{
+						Id:     transfer.ID.String(),
+						Status: string(transfer.Status),
+					},
+					updateTime: transfer.TransferUpdateTime,
+				}
+			}
+			return records, nil
+		}}
+		return []*consistencyRecordPages{transfers, archived}, nil
+	case schema.ConsistencyKindTokenOutput:
+		owners, err := decodeConsistencyKeys(keys)
+		if err != nil {
+			return nil, err
+		}
+		return []*consistencyRecordPages{{load: func(ctx context.Context, after uuid.UUID, limit int) ([]consistencyRecord, error) {
+			outputs, err := db.TokenOutput.Query().
+				Where(tokenoutput.OwnerPublicKeyIn(owners...), tokenoutput.IDGT(after)).
+				Order(ent.Asc(tokenoutput.FieldID)).
+				Limit(limit).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load token outputs: %w", err)
+			}
+			records := make([]consistencyRecord, len(outputs))
+			for i, output := range outputs {
+				records[i] = consistencyRecord{
+					id:  output.ID,
+					key: hex.EncodeToString(output.OwnerPublicKey),
+					record: &pbinternal.ConsistencyRecord{
+						Id:                     output.ID.String(),
+						Status:                 string(output.Status),
+						OwnerIdentityPublicKey: output.OwnerPublicKey,
+					},
+					updateTime: output.UpdateTime,
+				}
+			}
+			return records, nil
+		}}}, nil
+	default:
+		return nil, fmt.Errorf("unknown consistency kind %s", kind)
+	}
+}
+
+func decodeConsistencyKeys(keys []string) ([][]byte, error) {
+	decoded := make([][]byte, len(keys))
+	for i, key := range keys {
+		publicKey, err := hex.DecodeString(key)
+		if err != nil {
+			return nil, errors.InvalidUserInputErrorf("invalid public key %s: %w", key, err)
+		}
+		decoded[i] = publicKey
+	}
+	return decoded, nil
+}
+
+// nextConsistencyKeys returns up to limit keys of the kind held by this operator that come after
+// cursor, in order.
+func nextConsistencyKeys(ctx context.Context, kind schema.ConsistencyKind, cursor string, limit int) ([]string, error) {
+	db := ent.GetDbFromContext(ctx)
+	var keys []string
+	switch kind {
+	case schema.ConsistencyKindTree:
+		query := db.Tree.Query()
+		if cursor != "" {
+			after, err := uuid.Parse(cursor)
+			if err != nil {
+				return nil, err
+			}
+			query = query.Where(tree.IDGT(after))
+		}
+		treeIDs, err := query.Order(ent.Asc(tree.FieldID)).Limit(limit).IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list trees: %w", err)
+		}
+		for _, treeID := range treeIDs {
+			keys = append(keys, treeID.String())
+		}
+	case schema.ConsistencyKindTransfer:
+		query := db.Transfer.Query()
+		archivedQuery := db.ArchivedTransfer.Query()
+		if cursor != "" {
+			after, err := hex.DecodeString(cursor)
+			if err != nil {
+				return nil, err
+			}
+			query = query.Where(transfer.SenderIdentityPubkeyGT(after))
+			archivedQuery = archivedQuery.Where(archivedtransfer.SenderIdentityPubkeyGT(after))
+		}
+		var senders, archivedSenders []struct {
+			SenderIdentityPubkey []byte `json:"sender_identity_pubkey"`
+		}
+		err := query.
+			Order(ent.Asc(transfer.FieldSenderIdentityPubkey)).
+			Limit(limit).
+			GroupBy(transfer.FieldSenderIdentityPubkey).
+			Scan(ctx, &senders)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list transfer senders: %w", err)
+		}
+		err = archivedQuery.
+			Order(ent.Asc(archivedtransfer.FieldSenderIdentityPubkey)).
+			Limit(limit).
+			GroupBy(archivedtransfer.FieldSenderIdentityPubkey).
+			Scan(ctx, &archivedSenders)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list archived transfer senders: %w", err)
+		}
+		for _, sender := range append(senders, archivedSenders...) {
+			keys = append(keys, hex.EncodeToString(sender.SenderIdentityPubkey))
+		}
+		slices.Sort(keys)
+		keys = slices.Compact(keys)
+		if len(keys) > limit {
+			keys = keys[:limit]
+		}
+	case schema.ConsistencyKindTokenOutput:
+		query := db.TokenOutput.Query()
+		if cursor != "" {
+			after, err := hex.DecodeString(cursor)
+			if err != nil {
+				return nil, err
+			}
+			query = query.Where(tokenoutput.OwnerPublicKeyGT(after))
+		}
+		var owners []struct {
+			OwnerPublicKey []byte `json:"owner_public_key"`
+		}
+		err := query.
+			Order(ent.Asc(tokenoutput.FieldOwnerPublicKey)).
+			Limit(limit).
+			GroupBy(tokenoutput.FieldOwnerPublicKey).
+			Scan(ctx, &owners)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list token output owners: %w", err)
+		}
+		for _, owner := range owners {
+			keys = append(keys, hex.EncodeToString(owner.OwnerPublicKey))
+		}
+	default:
+		return nil, fmt.Errorf("unknown consistency kind %s", kind)
+	}
+	return keys, nil
+}
+
+// Audit compares the state of the next batch of keys of the kind with the state of the other
+// operators, and returns the number of keys compared. Keys updated on any operator within
+// spark.ConsistencyAuditSettleTime are skipped. Keys on which the operators disagree are stored as
+// open discrepancies; when repair is enabled and this operator disagrees with a majority, its
+// state is overwritten with the state of the majority.
+func (h *ConsistencyHandler) Audit(ctx context.Context, kind schema.ConsistencyKind) (int, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	cursor, err := h.loadConsistencyAuditCursor(ctx, kind)
+	if err != nil {
+		return 0, err
+	}
+	keys, err := nextConsistencyKeys(ctx, kind, cursor.Key, spark.ConsistencyAuditBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	nextCursor := ""
+	if len(keys) == spark.ConsistencyAuditBatchSize {
+		nextCursor = keys[len(keys)-1]
+	}
+	if len(keys) == 0 {
+		return 0, cursor.Update().SetKey(nextCursor).Exec(ctx)
+	}
+
+	req := &pbinternal.GetConsistencyDigestsRequest{Kind: consistencyKindToProto[kind], Keys: keys}
+	localDigests, err := h.GetConsistencyDigests(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	selection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionExcludeSelf}
+	operatorDigests, err := helper.ExecuteTaskWithAllOperators(ctx, h.config, &selection, func(ctx context.Context, operator *so.SigningOperator) (map[string]*pbinternal.ConsistencyDigest, error) {
+		conn, err := h.config.NewOperatorConnection(operator)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		client := pbinternal.NewSparkInternalServiceClient(conn)
+		resp, err := client.GetConsistencyDigests(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Digests, nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get consistency digests: %w", err)
+	}
+	operatorDigests[h.config.Identifier] = localDigests.Digests
+
+	settledBefore := time.Now().Add(-spark.ConsistencyAuditSettleTime)
+	compared := 0
+	for _, key := range keys {
+		digests := make(map[string]string, len(operatorDigests))
+		settled := true
+		for identifier, keyDigests := range operatorDigests {
+			digest, ok := keyDigests[key]
+			if !ok {
+				digests[identifier] = ""
+				continue
+			}
+			if digest.LastUpdateTime.AsTime().After(settledBefore) {
+				settled = false
+				break
+			}
+			digests[identifier] = hex.EncodeToString(digest.Digest)
+		}
+		if !settled {
+			continue
+		}
+		compared++
+		if err := h.recordConsistency(ctx, kind, key, digests); err != nil {
+			logger.Error("Failed to record consistency audit result", "kind", kind, "key", key, "error", err)
+		}
+	}
+
+	if err := cursor.Update().SetKey(nextCursor).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to store consistency audit cursor: %w", err)
+	}
+	return compared, nil
+}
+
+// loadConsistencyAuditCursor loads the cursor of the kind, creating it on the first round. On
+// Postgres, the cursor stays locked until the end of the transaction, so that replicas do not
+// audit the same keys at once.
+func (h *ConsistencyHandler) loadConsistencyAuditCursor(ctx context.Context, kind schema.ConsistencyKind) (*ent.ConsistencyAuditCursor, error) {
+	db := ent.GetDbFromContext(ctx)
+	query := db.ConsistencyAuditCursor.Query().Where(consistencyauditcursor.KindEQ(kind))
+	if h.config.DatabaseDriver() == "postgres" {
+		query = query.ForUpdate()
+	}
+	cursor, err := query.Only(ctx)
+	if ent.IsNotFound(err) {
+		cursor, err = db.ConsistencyAuditCursor.Create().SetKind(kind).Save(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load consistency audit cursor: %w", err)
+	}
+	return cursor, nil
+}
+
+// majorityDigest returns the digest held by more than half of the operators, if any, and the
+// operators that do not hold it. Without a majority, every operator is divergent.
+func majorityDigest(digests map[string]string) (string, bool, []string) {
+	counts := make(map[string]int)
+	for _, digest := range digests {
+		counts[digest]++
+	}
+	majority, found := "", false
+	for digest, count := range counts {
+		if count*2 > len(digests) {
+			majority, found = digest, true
+		}
+	}
+	var divergent []string
+	for identifier, digest := range digests {
+		if !found || digest != majority {
+			divergent = append(divergent, identifier)
+		}
+	}
+	sort.Strings(divergent)
+	return majority, found, divergent
+}
+
+func (h *ConsistencyHandler) recordConsistency(ctx context.Context, kind schema.ConsistencyKind, key string, digests map[string]string) error {
+	logger := logging.GetLoggerFromContext(ctx)
+	db := ent.GetDbFromContext(ctx)
+
+	open, err := db.ConsistencyDiscrepancy.Query().
+		Where(
+			consistencydiscrepancy.KindEQ(kind),
+			consistencydiscrepancy.KeyEQ(key),
+			consistencydiscrepancy.StatusEQ(schema.ConsistencyDiscrepancyStatusOpen),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	majority, hasMajority, divergent := majorityDigest(digests)
+	if len(divergent) == 0 {
+		if open != nil {
+			logger.Info("Consistency discrepancy resolved", "kind", kind, "key", key)
+			return open.Update().SetStatus(schema.ConsistencyDiscrepancyStatusResolved).SetDigests(digests).SetDivergentOperators([]string{}).Exec(ctx)
+		}
+		return nil
+	}
+
+	consistencyDiscrepanciesCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("kind", string(kind))))
+	logger.Warn("Operators disagree on state", "kind", kind, "key", key, "digests", digests, "divergent_operators", divergent)
+	if open == nil {
+		open, err = db.ConsistencyDiscrepancy.Create().
+			SetKind(kind).
+			SetKey(key).
+			SetStatus(schema.ConsistencyDiscrepancyStatusOpen).
+			SetDigests(digests).
+			SetDivergentOperators(divergent).
+			Save(ctx)
+	} else {
+		open, err = open.Update().SetDigests(digests).SetDivergentOperators(divergent).Save(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Only state the majority holds can be repaired; state missing from the majority is left for
+	// an operator to look into.
+	if !h.config.ConsistencyAudit.Repair || !hasMajority || majority == "" || !slices.Contains(divergent, h.config.Identifier) {
+		return nil
+	}
+	var source string
+	for identifier, digest := range digests {
+		if digest == majority {
+			source = identifier
+			break
+		}
+	}
+	if err := h.repair(ctx, kind, key, source, majority); err != nil {
+		return fmt.Errorf("failed to repair %s %s from operator %s: %w", kind, key, source, err)
+	}
+	consistencyRepairsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("kind", string(kind))))
+	logger.Info("Repaired state from the majority of operators", "kind", kind, "key", key, "source_operator", source)
+	return open.Update().SetStatus(schema.ConsistencyDiscrepancyStatusRepaired).Exec(ctx)
+}
+
+// repair overwrites the local state of the key with the records of the source operator. Only the
+// mutable fields of records this operator already holds are overwritten, so nothing is written
+// unless the records of the source match the majority and cover exactly the local records.
+func (h *ConsistencyHandler) repair(ctx context.Context, kind schema.ConsistencyKind, key string, source string, majority string) error {
+	operator, ok := h.config.SigningOperatorMap[source]
+	if !ok {
+		return fmt.Errorf("operator %s not found", source)
+	}
+	conn, err := h.config.NewOperatorConnection(operator)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := pbinternal.NewSparkInternalServiceClient(conn).GetConsistencyRecords(ctx, &pbinternal.GetConsistencyRecordsRequest{
+		Kind: consistencyKindToProto[kind],
+		Key:  key,
+	})
+	if err != nil {
+		return err
+	}
+	digest, err := consistencyRecordsDigest(resp.Records)
+	if err != nil {
+		return err
+	}
+	if hex.EncodeToString(digest) != majority {
+		return fmt.Errorf("records do not match the majority digest")
+	}
+
+	states, err := loadConsistencyStates(ctx, kind, []string{key}, true)
+	if err != nil {
+		return err
+	}
+	localIDs := make(map[string]bool)
+	if state, ok := states[key]; ok {
+		for _, record := range state.records {
+			localIDs[record.Id] = true
+		}
+	}
+	if len(localIDs) != len(resp.Records) {
+		return fmt.Errorf("holds %d records where the majority holds %d", len(localIDs), len(resp.Records))
+	}
+	for _, record := range resp.Records {
+		if !localIDs[record.Id] {
+			return fmt.Errorf("record %s is missing", record.Id)
+		}
+	}
+
+	db := ent.GetDbFromContext(ctx)
+	for _, record := range resp.Records {
+		id, err := uuid.Parse(record.Id)
+		if err != nil {
+			return err
+		}
+		switch kind {
+		case schema.ConsistencyKindTree:
+			err = db.TreeNode.UpdateOneID(id).
+				SetStatus(schema.TreeNodeStatus(record.Status)).
+				SetOwnerIdentityPubkey(record.OwnerIdentityPublicKey).
+				SetOwnerSigningPubkey(record.OwnerSigningPublicKey).
+				SetRawRefundTx(record.RawRefundTx).
+				Exec(ctx)
+		case schema.ConsistencyKindTransfer:
+			err = db.Transfer.UpdateOneID(id).SetStatus(schema.TransferStatus(record.Status)).Exec(ctx)
+		case schema.ConsistencyKindTokenOutput:
+			err = db.TokenOutput.UpdateOneID(id).SetStatus(schema.TokenOutputStatus(record.Status)).Exec(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update %s: %w", record.Id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/utils"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// consistencyTestOperator serves the consistency RPCs of an operator from its own database.
+type consistencyTestOperator struct {
+	pbinternal.UnimplementedSparkInternalServiceServer
+
+	ctx     context.Context
+	handler *ConsistencyHandler
+}
+
+func (o *consistencyTestOperator) GetConsistencyDigests(_ context.Context, req *pbinternal.GetConsistencyDigestsRequest) (*pbinternal.GetConsistencyDigestsResponse, error) {
+	return o.handler.GetConsistencyDigests(o.ctx, req)
+}
+
+func (o *consistencyTestOperator) GetConsistencyRecords(_ context.Context, req *pbinternal.GetConsistencyRecordsRequest) (*pbinternal.GetConsistencyRecordsResponse, error) {
+	return o.handler.GetConsistencyRecords(o.ctx, req)
+}
+
+// newConsistencyTestOperators starts count operators, each with its own database, and returns the
+// config of the first operator along with the context of every operator.
+func newConsistencyTestOperators(t *testing.T, count int) (*so.Config, []context.Context) {
+	operatorMap := make(map[string]*so.SigningOperator, count)
+	for i := range count {
+		identifier := utils.IndexToIdentifier(uint64(i))
+		operatorMap[identifier] = &so.SigningOperator{ID: uint64(i), Identifier: identifier}
+	}
+	config := &so.Config{
+		Index:              0,
+		Identifier:         utils.IndexToIdentifier(0),
+		SigningOperatorMap: operatorMap,
+		Threshold:          2,
+	}
+
+	ctxs := make([]context.Context, count)
+	for i := range count {
+		db := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s_%d?mode=memory&_fk=1", t.Name(), i))
+		t.Cleanup(func() { db.Close() })
+		tx, err := db.Tx(context.Background())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = tx.Rollback() })
+		ctxs[i] = context.WithValue(context.Background(), ent.TxKey, tx)
+		if i == 0 {
+			continue
+		}
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		server := grpc.NewServer()
+		pbinternal.RegisterSparkInternalServiceServer(server, &consistencyTestOperator{ctx: ctxs[i], handler: NewConsistencyHandler(config)})
+		go func() { _ = server.Serve(listener) }()
+		t.Cleanup(server.Stop)
+		operatorMap[utils.IndexToIdentifier(uint64(i))].Address = listener.Addr().String()
+	}
+	return config, ctxs
+}
+
+func createConsistencyTestNode(t *testing.T, ctx context.Context, treeID uuid.UUID, nodeID uuid.UUID, status schema.TreeNodeStatus, updateTime time.Time) {
+	db := ent.GetDbFromContext(ctx)
+	keyshare, err := db.SigningKeyshare.Create().
+		SetStatus(schema.KeyshareStatusInUse).
+		SetSecretShare(bytes.Repeat([]byte{1}, 32)).
+		SetPublicShares(map[string][]byte{}).
+		SetPublicKey(bytes.Repeat([]byte{2}, 33)).
+		SetMinSigners(2).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+	tree, err := db.Tree.Create().
+		SetID(treeID).
+		SetOwnerIdentityPubkey(bytes.Repeat([]byte{3}, 33)).
+		SetStatus(schema.TreeStatusAvailable).
+		SetNetwork(schema.NetworkRegtest).
+		SetBaseTxid(bytes.Repeat([]byte{4}, 32)).
+		SetVout(0).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = db.TreeNode.Create().
+		SetID(nodeID).
+		SetTree(tree).
+		SetSigningKeyshare(keyshare).
+		SetValue(1000).
+		SetStatus(status).
+		SetVerifyingPubkey(bytes.Repeat([]byte{5}, 33)).
+		SetOwnerIdentityPubkey(bytes.Repeat([]byte{3}, 33)).
+		SetOwnerSigningPubkey(bytes.Repeat([]byte{6}, 33)).
+		SetRawTx([]byte{7}).
+		SetRawRefundTx([]byte{8}).
+		SetVout(0).
+		SetUpdateTime(updateTime).
+		Save(ctx)
+	require.NoError(t, err)
+}
+
+func TestConsistencyAuditRecordsAndRepairsDiscrepancies(t *testing.T) {
+	config, ctxs := newConsistencyTestOperators(t, 3)
+	treeID, nodeID := uuid.New(), uuid.New()
+	settled := time.Now().Add(-time.Hour)
+	createConsistencyTestNode(t, ctxs[0], treeID, nodeID, schema.TreeNodeStatusTransferLocked, settled)
+	createConsistencyTestNode(t, ctxs[1], treeID, nodeID, schema.TreeNodeStatusAvailable, settled)
+	createConsistencyTestNode(t, ctxs[2], treeID, nodeID, schema.TreeNodeStatusAvailable, settled)
+
+	h := NewConsistencyHandler(config)
+	db := ent.GetDbFromContext(ctxs[0])
+	for range 2 {
+		count, err := h.Audit(ctxs[0], schema.ConsistencyKindTree)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	}
+	discrepancies, err := db.ConsistencyDiscrepancy.Query().All(ctxs[0])
+	require.NoError(t, err)
+	require.Len(t, discrepancies, 1)
+	require.Equal(t, treeID.String(), discrepancies[0].Key)
+	require.Equal(t, schema.ConsistencyDiscrepancyStatusOpen, discrepancies[0].Status)
+	require.Equal(t, []string{config.Identifier}, discrepancies[0].DivergentOperators)
+	require.Len(t, discrepancies[0].Digests, 3)
+	require.Equal(t, discrepancies[0].Digests[utils.IndexToIdentifier(1)], discrepancies[0].Digests[utils.IndexToIdentifier(2)])
+
+	config.ConsistencyAudit.Repair = true
+	_, err = h.Audit(ctxs[0], schema.ConsistencyKindTree)
+	require.NoError(t, err)
+	node, err := db.TreeNode.Get(ctxs[0], nodeID)
+	require.NoError(t, err)
+	require.Equal(t, schema.TreeNodeStatusAvailable, node.Status)
+	discrepancy, err := db.ConsistencyDiscrepancy.Get(ctxs[0], discrepancies[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, schema.ConsistencyDiscrepancyStatusRepaired, discrepancy.Status)
+}
+
+func TestConsistencyAuditSkipsUnsettledState(t *testing.T) {
+	config, ctxs := newConsistencyTestOperators(t, 3)
+	treeID, nodeID := uuid.New(), uuid.New()
+	settled := time.Now().Add(-time.Hour)
+	createConsistencyTestNode(t, ctxs[0], treeID, nodeID, schema.TreeNodeStatusTransferLocked, settled)
+	createConsistencyTestNode(t, ctxs[1], treeID, nodeID, schema.TreeNodeStatusAvailable, time.Now())
+	createConsistencyTestNode(t, ctxs[2], treeID, nodeID, schema.TreeNodeStatusAvailable, settled)
+
+	count, err := NewConsistencyHandler(config).Audit(ctxs[0], schema.ConsistencyKindTree)
+	require.NoError(t, err)
+	require.Zero(t, count)
+	exists, err := ent.GetDbFromContext(ctxs[0]).ConsistencyDiscrepancy.Query().Exist(ctxs[0])
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestNextConsistencyKeysPagesThroughOwners(t *testing.T) {
+	_, ctxs := newConsistencyTestOperators(t, 1)
+	ctx := ctxs[0]
+	for _, sender := range []byte{3, 1, 2, 1} {
+		_, err := ent.GetDbFromContext(ctx).Transfer.Create().
+			SetSenderIdentityPubkey(bytes.Repeat([]byte{sender}, 33)).
+			SetReceiverIdentityPubkey(bytes.Repeat([]byte{9}, 33)).
+			SetTotalValue(1000).
+			SetStatus(schema.TransferStatusCompleted).
+			SetType(schema.TransferTypeTransfer).
+			SetExpiryTime(time.Now()).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	keys, err := nextConsistencyKeys(ctx, schema.ConsistencyKindTransfer, "", 2)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Equal(t, fmt.Sprintf("%x", bytes.Repeat([]byte{1}, 33)), keys[0])
+	require.Equal(t, fmt.Sprintf("%x", bytes.Repeat([]byte{2}, 33)), keys[1])
+
+	keys, err = nextConsistencyKeys(ctx, schema.ConsistencyKindTransfer, keys[1], 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{fmt.Sprintf("%x", bytes.Repeat([]byte{3}, 33))}, keys)
+
+	digests, err := NewConsistencyHandler(&so.Config{}).GetConsistencyDigests(ctx, &pbinternal.GetConsistencyDigestsRequest{
+		Kind: pbinternal.ConsistencyKind_CONSISTENCY_KIND_TRANSFER,
+		Keys: []string{fmt.Sprintf("%x", bytes.Repeat([]byte{1}, 33)), fmt.Sprintf("%x", bytes.Repeat([]byte{4}, 33))},
+	})
+	require.NoError(t, err)
+	require.Len(t, digests.Digests, 1)
+	require.Equal(t, uint32(2), digests.Digests[fmt.Sprintf("%x", bytes.Repeat([]byte{1}, 33))].RecordCount)
+}
+
+func TestConsistencyDigestsPageThroughRecords(t *testing.T) {
+	_, ctxs := newConsistencyTestOperators(t, 1)
+	ctx := ctxs[0]
+	db := ent.GetDbFromContext(ctx)
+	sender := bytes.Repeat([]byte{1}, 33)
+	key := fmt.Sprintf("%x", sender)
+
+	// More transfers than fit in a page, some of them archived.
+	count := spark.ConsistencyAuditPageSize + 10
+	archive, err := db.Archive.Create().SetKind("transfers").SetRecordCount(count / 3).SetData([]byte{1}).Save(ctx)
+	require.NoError(t, err)
+	var transfers []*ent.TransferCreate
+	var archived []*ent.ArchivedTransferCreate
+	for i := range count {
+		if i%3 == 0 {
+			archived = append(archived, db.ArchivedTransfer.Create().
+				SetSenderIdentityPubkey(sender).
+				SetReceiverIdentityPubkey(bytes.Repeat([]byte{9}, 33)).
+				SetTotalValue(1000).
+				SetStatus(schema.TransferStatusCompleted).
+				SetType(schema.TransferTypeTransfer).
+				SetTransferUpdateTime(time.Now()).
+				SetArchive(archive))
+			continue
+		}
+		transfers = append(transfers, db.Transfer.Create().
+			SetSenderIdentityPubkey(sender).
+			SetReceiverIdentityPubkey(bytes.Repeat([]byte{9}, 33)).
+			SetTotalValue(1000).
+			SetStatus(schema.TransferStatusCompleted).
+			SetType(schema.TransferTypeTransfer).
+			SetExpiryTime(time.Now()))
+	}
+	require.NoError(t, db.Transfer.CreateBulk(transfers...).Exec(ctx))
+	require.NoError(t, db.ArchivedTransfer.CreateBulk(archived...).Exec(ctx))
+
+	h := NewConsistencyHandler(&so.Config{})
+	digests, err := h.GetConsistencyDigests(ctx, &pbinternal.GetConsistencyDigestsRequest{
+		Kind: pbinternal.ConsistencyKind_CONSISTENCY_KIND_TRANSFER,
+		Keys: []string{key},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(count), digests.Digests[key].RecordCount)
+
+	// The digest is the one the records hash to, whichever table they are in.
+	records, err := h.GetConsistencyRecords(ctx, &pbinternal.GetConsistencyRecordsRequest{
+		Kind: pbinternal.ConsistencyKind_CONSISTENCY_KIND_TRANSFER,
+		Key:  key,
+	})
+	require.NoError(t, err)
+	require.Len(t, records.Records, count)
+	require.True(t, slices.IsSortedFunc(records.Records, func(a, b *pbinternal.ConsistencyRecord) int {
+		return strings.Compare(a.Id, b.Id)
+	}))
+	digest, err := consistencyRecordsDigest(records.Records)
+	require.NoError(t, err)
+	require.Equal(t, digest, digests.Digests[key].Digest)
+}
+
+func TestConsistencyAuditCursorIsStored(t *testing.T) {
+	config, ctxs := newConsistencyTestOperators(t, 2)
+	settled := time.Now().Add(-time.Hour)
+	for i := range spark.ConsistencyAuditBatchSize + 1 {
+		transferID := uuid.New()
+		for _, ctx := range ctxs {
+			_, err := ent.GetDbFromContext(ctx).Transfer.Create().
+				SetID(transferID).
+				SetSenderIdentityPubkey(binary.BigEndian.AppendUint32(bytes.Repeat([]byte{1}, 29), uint32(i))).
+				SetReceiverIdentityPubkey(bytes.Repeat([]byte{9}, 33)).
+				SetTotalValue(1000).
+				SetStatus(schema.TransferStatusCompleted).
+				SetType(schema.TransferTypeTransfer).
+				SetExpiryTime(time.Now()).
+				SetUpdateTime(settled).
+				Save(ctx)
+			require.NoError(t, err)
+		}
+	}
+
+	// Each round continues where the last one stopped, even with a new handler.
+	count, err := NewConsistencyHandler(config).Audit(ctxs[0], schema.ConsistencyKindTransfer)
+	require.NoError(t, err)
+	require.Equal(t, spark.ConsistencyAuditBatchSize, count)
+	cursor, err := ent.GetDbFromContext(ctxs[0]).ConsistencyAuditCursor.Query().Only(ctxs[0])
+	require.NoError(t, err)
+	require.Equal(t, schema.ConsistencyKindTransfer, cursor.Kind)
+	require.NotEmpty(t, cursor.Key)
+
+	count, err = NewConsistencyHandler(config).Audit(ctxs[0], schema.ConsistencyKindTransfer)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	cursor, err = ent.GetDbFromContext(ctxs[0]).ConsistencyAuditCursor.Get(ctxs[0], cursor.ID)
+	require.NoError(t, err)
+	require.Empty(t, cursor.Key)
+}
+
+func TestMajorityDigest(t *testing.T) {
+	majority, found, divergent := majorityDigest(map[string]string{"a": "x", "b": "x", "c": "y"})
+	require.True(t, found)
+	require.Equal(t, "x", majority)
+	require.Equal(t, []string{"c"}, divergent)
+
+	_, found, divergent = majorityDigest(map[string]string{"a": "x", "b": "y", "c": "", "d": "x"})
+	require.False(t, found)
+	require.Equal(t, []string{"a", "b", "c", "d"}, divergent)
+
+	_, _, divergent = majorityDigest(map[string]string{"a": "x", "b": "x"})
+	require.Empty(t, divergent)
+}
@@ -53,12 +53,12 @@ func (h *CooperativeExitHandler) CooperativeExit(ctx context.Context, req *pb.Co
 		TransferRoleCoordinator,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create transfer: %v", err)
+		return nil, fmt.Errorf("failed to create transfer: %w", err)
 	}
 
 	exitUUID, err := uuid.Parse(req.ExitId)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse exit_id %s: %v", req.ExitId, err)
+		return nil, fmt.Errorf("unable to parse exit_id %s: %w", req.ExitId, err)
 	}
 
 	if len(req.ExitTxid) != 32 {
@@ -73,22 +73,22 @@ func (h *CooperativeExitHandler) CooperativeExit(ctx context.Context, req *pb.Co
 		// ConfirmationHeight is nil since the transaction is not confirmed yet.
 		Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create cooperative exit: %v", err)
+		return nil, fmt.Errorf("failed to create cooperative exit: %w", err)
 	}
 
 	transferProto, err := transfer.MarshalProto(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal transfer: %v", err)
+		return nil, fmt.Errorf("failed to marshal transfer: %w", err)
 	}
 
 	signingResults, err := signRefunds(ctx, h.config, req.Transfer, leafMap, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign refund transactions: %v", err)
+		return nil, fmt.Errorf("failed to sign refund transactions: %w", err)
 	}
 
 	err = transferHandler.syncCoopExitInit(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sync transfer init: %v", err)
+		return nil, fmt.Errorf("failed to sync transfer init: %w", err)
 	}
 
 	response := &pb.CooperativeExitResponse{
@@ -125,7 +125,7 @@ func (h *TransferHandler) syncCoopExitInit(ctx context.Context, req *pb.Cooperat
 	_, err := helper.ExecuteTaskWithAllOperators(ctx, h.config, &selection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
 		logger := logging.GetLoggerFromContext(ctx)
 
-		conn, err := operator.NewGRPCConnection()
+		conn, err := h.config.NewOperatorConnection(operator)
 		if err != nil {
 			logger.Error("Failed to connect to operator", "error", err)
 			return nil, err
@@ -5,9 +5,12 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/btcsuite/btcd/wire"
 	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
 	"github.com/lightsparkdev/spark/common"
 	"github.com/lightsparkdev/spark/common/logging"
 	pb "github.com/lightsparkdev/spark/proto/spark"
@@ -21,6 +24,7 @@ import (
 	"github.com/lightsparkdev/spark/so/errors"
 	"github.com/lightsparkdev/spark/so/helper"
 	"github.com/lightsparkdev/spark/so/objects"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // The DepositHandler is responsible for handling deposit related requests.
@@ -37,6 +41,39 @@ func NewDepositHandler(config *so.Config, db *ent.Client) *DepositHandler {
 	}
 }
 
+// ensureDepositsNotPaused returns an error if new deposits are paused on the given network.
+func ensureDepositsNotPaused(ctx context.Context, network common.Network) error {
+	schemaNetwork, err := common.SchemaNetworkFromNetwork(network)
+	if err != nil {
+		return err
+	}
+	return ent.EnsureDepositsNotPaused(ctx, schemaNetwork)
+}
+
+// ensureAddressQuota returns an error if count more unused addresses would put the identity over
+// its limit, either of tree addresses or of other deposit addresses. A limit of 0 is no limit.
+func ensureAddressQuota(ctx context.Context, identityPublicKey []byte, treeAddresses bool, count int, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+	// The addresses are created in the same transaction, so the lock is held until they are.
+	if err := ent.LockAddressQuota(ctx, identityPublicKey); err != nil {
+		return err
+	}
+	unused, err := ent.CountUnusedDepositAddresses(ctx, identityPublicKey, treeAddresses)
+	if err != nil {
+		return err
+	}
+	if unused+count > limit {
+		kind := "deposit addresses"
+		if treeAddresses {
+			kind = "tree addresses"
+		}
+		return errors.ResourceExhaustedErrorf("too many unused %s: %d, the maximum is %d", kind, unused, limit)
+	}
+	return nil
+}
+
 // GenerateDepositAddress generates a deposit address for the given public key.
 func (o *DepositHandler) GenerateDepositAddress(ctx context.Context, config *so.Config, req *pb.GenerateDepositAddressRequest) (*pb.GenerateDepositAddressResponse, error) {
 	ctx, span := tracer.Start(ctx, "DepositHandler.GenerateDepositAddress")
@@ -50,9 +87,15 @@ func (o *DepositHandler) GenerateDepositAddress(ctx context.Context, config *so.
 	if !config.IsNetworkSupported(network) {
 		return nil, fmt.Errorf("network not supported")
 	}
+	if err := ensureDepositsNotPaused(ctx, network); err != nil {
+		return nil, err
+	}
 	if err := authz.EnforceSessionIdentityPublicKeyMatches(ctx, o.config, req.IdentityPublicKey); err != nil {
 		return nil, err
 	}
+	if err := ensureAddressQuota(ctx, req.IdentityPublicKey, false, 1, config.Quotas.UnusedDepositAddressLimit()); err != nil {
+		return nil, err
+	}
 	logger.Info("Generating deposit address for public key", "public_key", hex.EncodeToString(req.SigningPublicKey), "identity_public_key", hex.EncodeToString(req.IdentityPublicKey))
 	keyshares, err := ent.GetUnusedSigningKeyshares(ctx, o.db, config, 1)
 	if err != nil {
@@ -60,14 +103,14 @@ func (o *DepositHandler) GenerateDepositAddress(ctx context.Context, config *so.
 	}
 
 	if len(keyshares) == 0 {
-		return nil, fmt.Errorf("no keyshares available")
+		return nil, errors.Reasonf(errors.ReasonKeysharePoolEmpty, "no keyshares available")
 	}
 
 	keyshare := keyshares[0]
 
 	selection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionExcludeSelf}
 	_, err = helper.ExecuteTaskWithAllOperators(ctx, config, &selection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-		conn, err := operator.NewGRPCConnection()
+		conn, err := config.NewOperatorConnection(operator)
 		if err != nil {
 			return nil, err
 		}
@@ -97,8 +140,14 @@ func (o *DepositHandler) GenerateDepositAddress(ctx context.Context, config *so.
 		SetAddress(*depositAddress)
 	// Confirmation height is not set since nothing has been confirmed yet.
 
-	if req.IsStatic != nil && *req.IsStatic {
+	// Static addresses can receive funds any number of times, so they never expire.
+	isStatic := req.IsStatic != nil && *req.IsStatic
+	var expiryTime *timestamppb.Timestamp
+	if isStatic {
 		depositAddressMutator.SetIsStatic(true)
+	} else if expiry := config.Quotas.AddressExpiry(); expiry > 0 {
+		expiryTime = timestamppb.New(time.Now().Add(expiry).Truncate(time.Second))
+		depositAddressMutator.SetExpiryTime(expiryTime.AsTime())
 	}
 
 	if req.LeafId != nil {
@@ -115,7 +164,7 @@ func (o *DepositHandler) GenerateDepositAddress(ctx context.Context, config *so.
 	}
 
 	response, err := helper.ExecuteTaskWithAllOperators(ctx, config, &selection, func(ctx context.Context, operator *so.SigningOperator) ([]byte, error) {
-		conn, err := operator.NewGRPCConnection()
+		conn, err := config.NewOperatorConnection(operator)
 		if err != nil {
 			return nil, err
 		}
@@ -128,6 +177,7 @@ func (o *DepositHandler) GenerateDepositAddress(ctx context.Context, config *so.
 			OwnerIdentityPublicKey: req.IdentityPublicKey,
 			OwnerSigningPublicKey:  req.SigningPublicKey,
 			IsStatic:               req.IsStatic,
+			ExpiryTime:             expiryTime,
 		})
 		if err != nil {
 			return nil, err
@@ -156,7 +206,8 @@ func (o *DepositHandler) GenerateDepositAddress(ctx context.Context, config *so.
 				AddressSignatures:          response,
 				ProofOfPossessionSignature: proofOfPossessionSignature[0],
 			},
-			IsStatic: req.IsStatic != nil && *req.IsStatic,
+			IsStatic:   isStatic,
+			ExpiryTime: expiryTime,
 		},
 	}, nil
 }
@@ -189,6 +240,9 @@ func (o *DepositHandler) StartTreeCreation(ctx context.Context, config *so.Confi
 	if !config.IsNetworkSupported(network) {
 		return nil, fmt.Errorf("network not supported")
 	}
+	if err := ensureDepositsNotPaused(ctx, network); err != nil {
+		return nil, err
+	}
 	utxoAddress, err := common.P2TRAddressFromPkScript(onChainOutput.PkScript, network)
 	if err != nil {
 		return nil, err
@@ -375,6 +429,9 @@ func (o *DepositHandler) StartDepositTreeCreation(ctx context.Context, config *s
 	if !config.IsNetworkSupported(network) {
 		return nil, fmt.Errorf("network not supported")
 	}
+	if err := ensureDepositsNotPaused(ctx, network); err != nil {
+		return nil, err
+	}
 	utxoAddress, err := common.P2TRAddressFromPkScript(onChainOutput.PkScript, network)
 	if err != nil {
 		return nil, err
@@ -624,7 +681,7 @@ func (o *DepositHandler) InitiateUtxoSwap(ctx context.Context, config *so.Config
 	// blocking any other swap requests. Then it will create a transfer to the user.
 	allSelection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionAll}
 	responses, err := helper.ExecuteTaskWithAllOperators(ctx, config, &allSelection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-		conn, err := operator.NewGRPCConnection()
+		conn, err := config.NewOperatorConnection(operator)
 		if err != nil {
 			logger.Info("Failed to connect to operator for validating transaction state before cancelling", "error", err)
 			return nil, err
@@ -641,7 +698,7 @@ func (o *DepositHandler) InitiateUtxoSwap(ctx context.Context, config *so.Config
 	})
 	if err != nil {
 		// TODO(oleg): cancel the utxo swap for every operator
-		return nil, fmt.Errorf("failed to successfully execute create utxo swap task with all operators: %v", err)
+		return nil, fmt.Errorf("failed to successfully execute create utxo swap task with all operators: %w", err)
 	}
 
 	transfer := responses[config.Identifier].(*pbinternal.CreateUtxoSwapResponse).Transfer
@@ -652,7 +709,7 @@ func (o *DepositHandler) InitiateUtxoSwap(ctx context.Context, config *so.Config
 	db := ent.GetDbFromContext(ctx)
 	schemaNetwork, err := common.SchemaNetworkFromProtoNetwork(req.OnChainUtxo.Network)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get schema network: %v", err)
+		return nil, fmt.Errorf("failed to get schema network: %w", err)
 	}
 	targetUtxo, err := db.Utxo.Query().
 		Where(utxo.NetworkEQ(schemaNetwork)).
@@ -660,22 +717,22 @@ func (o *DepositHandler) InitiateUtxoSwap(ctx context.Context, config *so.Config
 		Where(utxo.Vout(req.OnChainUtxo.Vout)).
 		First(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get target utxo: %v", err)
+		return nil, fmt.Errorf("failed to get target utxo: %w", err)
 	}
 	depositAddress, err := targetUtxo.QueryDepositAddress().Only(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get deposit address: %v", err)
+		return nil, fmt.Errorf("failed to get deposit address: %w", err)
 	}
 
 	// Recover the signature for the utxo spend
 	// Execute signing jobs with all operators and create a refund transaction
 	userRootTxNonceCommitment, err := objects.NewSigningCommitment(req.SpendTxSigningJob.SigningNonceCommitment.Binding, req.SpendTxSigningJob.SigningNonceCommitment.Hiding)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create signing commitment: %v", err)
+		return nil, fmt.Errorf("failed to create signing commitment: %w", err)
 	}
 	verifyingKeyBytes, spendTxSigningResult, err := getSpendTxSigningResult(ctx, config, depositAddress, targetUtxo, req.SpendTxSigningJob.RawTx, userRootTxNonceCommitment)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get spend tx signing result: %v", err)
+		return nil, fmt.Errorf("failed to get spend tx signing result: %w", err)
 	}
 
 	nodeIDStr := depositAddress.NodeID.String()
@@ -713,22 +770,22 @@ func getSpendTxSigningResult(ctx context.Context, config *so.Config, depositAddr
 
 	signingKeyShare, err := depositAddress.QuerySigningKeyshare().Only(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get signing keyshare: %v", err)
+		return nil, nil, fmt.Errorf("failed to get signing keyshare: %w", err)
 	}
 	verifyingKeyBytes, err := common.AddPublicKeys(signingKeyShare.PublicKey, depositAddress.OwnerSigningPubkey)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to add public keys: %v", err)
+		return nil, nil, fmt.Errorf("failed to add public keys: %w", err)
 	}
 
 	onChainTxOut := wire.NewTxOut(int64(targetUtxo.Amount), targetUtxo.PkScript)
 	spendTx, err := common.TxFromRawTxBytes(spendTxRaw)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to parse spend tx: %v", err)
+		return nil, nil, fmt.Errorf("failed to parse spend tx: %w", err)
 	}
 
 	spendTxSigHash, err := common.SigHashFromTx(spendTx, 0, onChainTxOut)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get spend tx sig hash: %v", err)
+		return nil, nil, fmt.Errorf("failed to get spend tx sig hash: %w", err)
 	}
 	logger.Debug("spendTxSigHash", "spendTxSigHash", hex.EncodeToString(spendTxSigHash))
 
@@ -746,12 +803,89 @@ func getSpendTxSigningResult(ctx context.Context, config *so.Config, depositAddr
 	)
 	signingResults, err := helper.SignFrost(ctx, config, signingJobs)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to sign spend tx: %v", err)
+		return nil, nil, fmt.Errorf("failed to sign spend tx: %w", err)
 	}
 
 	spendTxSigningResult, err := signingResults[0].MarshalProto()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to marshal spend tx signing result: %v", err)
+		return nil, nil, fmt.Errorf("failed to marshal spend tx signing result: %w", err)
 	}
 	return verifyingKeyBytes, spendTxSigningResult, nil
 }
+
+// RecycleExpiredDepositAddresses recycles a batch of the deposit addresses with keyshares this
+// operator coordinated that expired without receiving funds, and returns the number recycled.
+// Addresses are only recycled if every operator agrees they are expired and unused, and has scanned
+// the chain for spark.DepositAddressRecycleGracePeriod past their expiry, so that a keyshare is
+// never handed out again while any operator has seen funds sent to its address.
+func (o *DepositHandler) RecycleExpiredDepositAddresses(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "DepositHandler.RecycleExpiredDepositAddresses")
+	defer span.End()
+
+	logger := logging.GetLoggerFromContext(ctx)
+
+	candidates, err := ent.GetExpiredDepositAddresses(ctx, o.config, spark.DepositAddressRecycleBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+
+	// Check with every operator first, so that addresses are only deleted if all of them can be.
+	agreed, err := o.recycleDepositAddressesWithOperators(ctx, candidates, true)
+	if err != nil {
+		return 0, err
+	}
+	if len(agreed) == 0 {
+		return 0, nil
+	}
+	recycledByOperators, err := o.recycleDepositAddressesWithOperators(ctx, agreed, false)
+	if err != nil {
+		return 0, err
+	}
+	if len(recycledByOperators) < len(agreed) {
+		// The keyshares of these addresses stay in use on this operator, so they are never handed
+		// out again even though some operators released them.
+		logger.Error("Deposit addresses were recycled by only some operators",
+			"addresses", slices.DeleteFunc(agreed, func(address string) bool { return slices.Contains(recycledByOperators, address) }))
+	}
+
+	recycled, err := ent.RecycleDepositAddresses(ctx, recycledByOperators, false)
+	if err != nil {
+		return 0, err
+	}
+	return len(recycled), nil
+}
+
+// recycleDepositAddressesWithOperators asks the other operators to recycle the addresses, and
+// returns the addresses every operator recycled, or would recycle with dryRun.
+func (o *DepositHandler) recycleDepositAddressesWithOperators(ctx context.Context, addresses []string, dryRun bool) ([]string, error) {
+	selection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionExcludeSelf}
+	responses, err := helper.ExecuteTaskWithAllOperators(ctx, o.config, &selection, func(ctx context.Context, operator *so.SigningOperator) ([]string, error) {
+		conn, err := o.config.NewOperatorConnection(operator)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+
+		client := pbinternal.NewSparkInternalServiceClient(conn)
+		response, err := client.RecycleDepositAddresses(ctx, &pbinternal.RecycleDepositAddressesRequest{
+			Addresses: addresses,
+			DryRun:    dryRun,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.RecycledAddresses, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	agreed := slices.Clone(addresses)
+	for _, recycled := range responses {
+		agreed = slices.DeleteFunc(agreed, func(address string) bool { return !slices.Contains(recycled, address) })
+	}
+	return agreed, nil
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEnsureAddressQuota(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:deposit_address_quota?mode=memory&_fk=1")
+	defer db.Close()
+	tx, err := db.Tx(context.Background())
+	require.NoError(t, err)
+	defer tx.Rollback() //nolint:errcheck
+	ctx := context.WithValue(context.Background(), ent.TxKey, tx)
+
+	identity := []byte("identity")
+	for i, address := range []string{"first", "second"} {
+		keyshare := tx.SigningKeyshare.Create().
+			SetStatus(schema.KeyshareStatusInUse).
+			SetSecretShare([]byte("secret")).
+			SetPublicShares(map[string][]byte{}).
+			SetPublicKey([]byte{byte(i)}).
+			SetMinSigners(2).
+			SetCoordinatorIndex(0).
+			SaveX(ctx)
+		tx.DepositAddress.Create().
+			SetAddress(address).
+			SetOwnerIdentityPubkey(identity).
+			SetOwnerSigningPubkey([]byte("signing")).
+			SetSigningKeyshare(keyshare).
+			SaveX(ctx)
+	}
+
+	require.NoError(t, ensureAddressQuota(ctx, identity, false, 1, 3))
+	err = ensureAddressQuota(ctx, identity, false, 2, 3)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.NoError(t, ensureAddressQuota(ctx, identity, false, 100, 0), "a limit of 0 is no limit")
+	require.NoError(t, ensureAddressQuota(ctx, identity, true, 3, 3), "tree addresses are counted separately")
+	require.NoError(t, ensureAddressQuota(ctx, []byte("other"), false, 3, 3))
+}
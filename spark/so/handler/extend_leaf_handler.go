@@ -12,6 +12,7 @@ import (
 	"github.com/lightsparkdev/spark/so/authz"
 	"github.com/lightsparkdev/spark/so/ent"
 	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/errors"
 	"github.com/lightsparkdev/spark/so/helper"
 	"github.com/lightsparkdev/spark/so/objects"
 )
@@ -45,7 +46,7 @@ func (h *ExtendLeafHandler) ExtendLeaf(ctx context.Context, req *pb.ExtendLeafRe
 	}
 
 	if leaf.Status != schema.TreeNodeStatusAvailable {
-		return nil, fmt.Errorf("leaf %s is not available, status: %s", leafUUID, leaf.Status)
+		return nil, errors.Reasonf(leaf.UnavailableReason(), "leaf %s is not available, status: %s", leafUUID, leaf.Status)
 	}
 
 	nodeTx, err := common.TxFromRawTxBytes(leaf.RawTx)
@@ -71,7 +72,7 @@ func (h *ExtendLeafHandler) ExtendLeaf(ctx context.Context, req *pb.ExtendLeafRe
 	// Validate new transactions
 	// TODO: make some shared validation across different handlers
 	if newNodeTx.TxIn[0].Sequence >= refundTx.TxIn[0].Sequence {
-		return nil, fmt.Errorf("new node tx sequence must be less than the refund tx sequence %d, got %d", refundTx.TxIn[0].Sequence, newNodeTx.TxIn[0].Sequence)
+		return nil, errors.Reasonf(errors.ReasonInsufficientTimelock, "new node tx sequence must be less than the refund tx sequence %d, got %d", refundTx.TxIn[0].Sequence, newNodeTx.TxIn[0].Sequence)
 	}
 
 	newNodeOutPoint := newNodeTx.TxIn[0].PreviousOutPoint
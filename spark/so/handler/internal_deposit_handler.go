@@ -13,6 +13,7 @@ import (
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
 	"github.com/lightsparkdev/spark/common"
 	"github.com/lightsparkdev/spark/common/logging"
 	pbfrost "github.com/lightsparkdev/spark/proto/frost"
@@ -25,6 +26,7 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/utxo"
 	"github.com/lightsparkdev/spark/so/ent/utxoswap"
+	"github.com/lightsparkdev/spark/so/errors"
 )
 
 // InternalDepositHandler is the deposit handler for so internal
@@ -57,6 +59,8 @@ func (h *InternalDepositHandler) MarkKeyshareForDepositAddress(ctx context.Conte
 
 	if req.IsStatic != nil && *req.IsStatic {
 		depositAddressMutator.SetIsStatic(true)
+	} else if req.ExpiryTime != nil {
+		depositAddressMutator.SetExpiryTime(req.ExpiryTime.AsTime())
 	}
 
 	_, err = depositAddressMutator.Save(ctx)
@@ -120,7 +124,7 @@ func (h *InternalDepositHandler) FinalizeTreeCreation(ctx context.Context, req *
 		}
 		address, err := db.DepositAddress.Query().Where(depositaddress.HasSigningKeyshareWith(signingkeyshare.IDEQ(signingKeyshareID))).Only(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to get deposit address: %v", err)
+			return fmt.Errorf("failed to get deposit address: %w", err)
 		}
 		markNodeAsAvailable = address.ConfirmationHeight != 0
 		logger.Info(fmt.Sprintf("Marking node as available: %v", markNodeAsAvailable))
@@ -276,12 +280,12 @@ func (h *InternalDepositHandler) CreateUtxoSwap(ctx context.Context, config *so.
 		Where(utxo.Vout(req.OnChainUtxo.Vout)).
 		First(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to confirm that the utxo (%s:%d) is confirmed on the blockchain: %v", hex.EncodeToString(req.OnChainUtxo.Txid), req.OnChainUtxo.Vout, err)
+		return nil, fmt.Errorf("unable to confirm that the utxo (%s:%d) is confirmed on the blockchain: %w", hex.EncodeToString(req.OnChainUtxo.Txid), req.OnChainUtxo.Vout, err)
 	}
 
 	// Validate general transfer signatures and leaves
 	if err = validateTransfer(ctx, config, req.Transfer); err != nil {
-		return nil, fmt.Errorf("transfer validation failed: %v", err)
+		return nil, fmt.Errorf("transfer validation failed: %w", err)
 	}
 
 	// Validate user signature, receiver identitypubkey and amount in transfer
@@ -289,13 +293,13 @@ func (h *InternalDepositHandler) CreateUtxoSwap(ctx context.Context, config *so.
 	for _, transaction := range req.Transfer.LeavesToSend {
 		leafRefundMap[transaction.LeafId] = transaction.RawTx
 	}
-	leaves, err := loadLeaves(ctx, db, leafRefundMap)
+	leaves, _, err := loadLeaves(ctx, db, leafRefundMap)
 	if err != nil {
-		return nil, fmt.Errorf("unable to load leaves: %v", err)
+		return nil, fmt.Errorf("unable to load leaves: %w", err)
 	}
 	totalAmount := getTotalTransferValue(leaves)
 	if err = validateUserSignature(req.Transfer.ReceiverIdentityPublicKey, req.UserSignature, req.SspSignature, network, targetUtxo.Txid, targetUtxo.Vout, totalAmount); err != nil {
-		return nil, fmt.Errorf("user signature validation failed: %v", err)
+		return nil, fmt.Errorf("user signature validation failed: %w", err)
 	}
 
 	// Check that the utxo swap is not already registered
@@ -304,7 +308,7 @@ func (h *InternalDepositHandler) CreateUtxoSwap(ctx context.Context, config *so.
 		Where(utxoswap.StatusNEQ(schema.UtxoSwapStatusCancelled)).
 		First(ctx)
 	if err != nil && !ent.IsNotFound(err) {
-		return nil, fmt.Errorf("unable to check if utxo swap is already registered: %v", err)
+		return nil, fmt.Errorf("unable to check if utxo swap is already registered: %w", err)
 	}
 	if utxoSwap != nil {
 		return nil, fmt.Errorf("utxo swap is already registered")
@@ -335,7 +339,7 @@ func (h *InternalDepositHandler) CreateUtxoSwap(ctx context.Context, config *so.
 		SetUserIdentityPublicKey(req.Transfer.ReceiverIdentityPublicKey).
 		Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to store utxo swap: %v", err)
+		return nil, fmt.Errorf("unable to store utxo swap: %w", err)
 	}
 
 	// Validate and create a transfer to the user.
@@ -356,21 +360,21 @@ func (h *InternalDepositHandler) CreateUtxoSwap(ctx context.Context, config *so.
 	)
 	if err != nil {
 		// if transfer creation fails, the utxo swap insert will be rolled back
-		return nil, fmt.Errorf("unable to create transfer: %v", err)
+		return nil, fmt.Errorf("unable to create transfer: %w", err)
 	}
 
 	transferProto, err := transfer.MarshalProto(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to marshal transfer: %v", err)
+		return nil, fmt.Errorf("unable to marshal transfer: %w", err)
 	}
 
 	depositAddress, err := targetUtxo.QueryDepositAddress().Only(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get utxo deposit address: %v", err)
+		return nil, fmt.Errorf("unable to get utxo deposit address: %w", err)
 	}
 	_, err = db.DepositAddress.UpdateOneID(depositAddress.ID).AddUtxoswaps(utxoSwap).Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to add utxo swap to deposit address: %v", err)
+		return nil, fmt.Errorf("unable to add utxo swap to deposit address: %w", err)
 	}
 	if !bytes.Equal(depositAddress.OwnerIdentityPubkey, req.Transfer.ReceiverIdentityPublicKey) {
 		return nil, fmt.Errorf("transfer is not to the recepient of the deposit")
@@ -380,7 +384,7 @@ func (h *InternalDepositHandler) CreateUtxoSwap(ctx context.Context, config *so.
 		SetTransfer(transfer).
 		Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to update utxo swap: %v", err)
+		return nil, fmt.Errorf("unable to update utxo swap: %w", err)
 	}
 	return &pbinternal.CreateUtxoSwapResponse{
 		UtxoDepositAddress: depositAddress.Address,
@@ -391,11 +395,11 @@ func (h *InternalDepositHandler) CreateUtxoSwap(ctx context.Context, config *so.
 func ValidateUtxoIsNotSpent(bitcoinClient *rpcclient.Client, txid []byte, vout uint32) error {
 	txidHash, err := chainhash.NewHash(txid)
 	if err != nil {
-		return fmt.Errorf("failed to create txid hash: %v", err)
+		return fmt.Errorf("failed to create txid hash: %w", err)
 	}
 	txOut, err := bitcoinClient.GetTxOut(txidHash, vout, true)
 	if err != nil {
-		return fmt.Errorf("failed to call gettxout: %v", err)
+		return fmt.Errorf("failed to call gettxout: %w", err)
 	}
 	if txOut == nil {
 		return fmt.Errorf("utxo is spent on blockchain: %s:%d", hex.EncodeToString(txidHash[:]), vout)
@@ -426,7 +430,7 @@ func validateTransfer(ctx context.Context, config *so.Config, transferRequest *p
 
 	conn, err := common.NewGRPCConnectionWithoutTLS(config.SignerAddress, nil)
 	if err != nil {
-		return fmt.Errorf("unable to connect to signer: %v", err)
+		return fmt.Errorf("unable to connect to signer: %w", err)
 	}
 	defer conn.Close()
 
@@ -446,23 +450,23 @@ func validateTransfer(ctx context.Context, config *so.Config, transferRequest *p
 		// First fetch the node tx in order to calculate the sighash
 		nodeID, err := uuid.Parse(transaction.LeafId)
 		if err != nil {
-			return fmt.Errorf("unable to parse node id: %v", err)
+			return fmt.Errorf("unable to parse node id: %w", err)
 		}
 		node, err := db.TreeNode.Get(ctx, nodeID)
 		if err != nil {
-			return fmt.Errorf("unable to get node: %v", err)
+			return fmt.Errorf("unable to get node: %w", err)
 		}
 		if node.Status != schema.TreeNodeStatusAvailable {
-			return fmt.Errorf("node %v is not available: %v", node.ID, node.Status)
+			return errors.Reasonf(node.UnavailableReason(), "node %v is not available: %v", node.ID, node.Status)
 		}
 		// check that the keyshare exists
 		_, err = node.QuerySigningKeyshare().First(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to get keyshare: %v", err)
+			return fmt.Errorf("unable to get keyshare: %w", err)
 		}
 		tx, err := common.TxFromRawTxBytes(node.RawTx)
 		if err != nil {
-			return fmt.Errorf("unable to get tx: %v", err)
+			return fmt.Errorf("unable to get tx: %w", err)
 		}
 		if len(tx.TxOut) <= 0 {
 			return fmt.Errorf("tx vout out of bounds")
@@ -470,7 +474,7 @@ func validateTransfer(ctx context.Context, config *so.Config, transferRequest *p
 
 		refundTx, err := common.TxFromRawTxBytes(transaction.RawTx)
 		if err != nil {
-			return fmt.Errorf("unable to get refund tx: %v", err)
+			return fmt.Errorf("unable to get refund tx: %w", err)
 		}
 		if len(refundTx.TxOut) <= 0 {
 			return fmt.Errorf("refund tx vout out of bounds")
@@ -478,7 +482,7 @@ func validateTransfer(ctx context.Context, config *so.Config, transferRequest *p
 
 		sighash, err := common.SigHashFromTx(refundTx, 0, tx.TxOut[0])
 		if err != nil {
-			return fmt.Errorf("unable to get sighash for refund tx: %v", err)
+			return fmt.Errorf("unable to get sighash for refund tx: %w", err)
 		}
 
 		// Validate that the user's signature for the refund transaction is valid.
@@ -514,19 +518,19 @@ func validateUserSignature(userIdentityPublicKey []byte, userSignature []byte, s
 		sspSignature,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to create user statement: %v", err)
+		return fmt.Errorf("failed to create user statement: %w", err)
 	}
 
 	// Parse the user's identity public key
 	userPubKey, err := secp256k1.ParsePubKey(userIdentityPublicKey)
 	if err != nil {
-		return fmt.Errorf("failed to parse user identity public key: %v", err)
+		return fmt.Errorf("failed to parse user identity public key: %w", err)
 	}
 
 	// Parse and verify the signature
 	sig, err := ecdsa.ParseDERSignature(userSignature)
 	if err != nil {
-		return fmt.Errorf("failed to parse user signature: %v", err)
+		return fmt.Errorf("failed to parse user signature: %w", err)
 	}
 
 	if !sig.Verify(messageHash[:], userPubKey) {
@@ -602,3 +606,19 @@ func CreateUserFixedQuoteStatement(
 
 	return hash[:], nil
 }
+
+// RecycleDepositAddresses recycles the keyshares of the given deposit addresses that expired
+// without receiving funds on this operator.
+func (h *InternalDepositHandler) RecycleDepositAddresses(ctx context.Context, req *pbinternal.RecycleDepositAddressesRequest) (*pbinternal.RecycleDepositAddressesResponse, error) {
+	if len(req.Addresses) > spark.DepositAddressRecycleBatchSize {
+		return nil, errors.InvalidUserInputErrorf("too many addresses: %d, the maximum is %d", len(req.Addresses), spark.DepositAddressRecycleBatchSize)
+	}
+	recycled, err := ent.RecycleDepositAddresses(ctx, req.Addresses, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	if !req.DryRun {
+		logging.GetLoggerFromContext(ctx).Info("Recycled expired deposit addresses", "count", len(recycled))
+	}
+	return &pbinternal.RecycleDepositAddressesResponse{RecycledAddresses: recycled}, nil
+}
@@ -311,8 +311,9 @@ func validateFinalTokenTransaction(
 		logger.Error("Failed to get network from proto network", "error", err)
 		return err
 	}
-	expectedBondSats := config.Lrc20Configs[network.String()].WithdrawBondSats
-	expectedRelativeBlockLocktime := config.Lrc20Configs[network.String()].WithdrawRelativeBlockLocktime
+	lrc20Config, _ := config.Lrc20Config(network.String())
+	expectedBondSats := lrc20Config.WithdrawBondSats
+	expectedRelativeBlockLocktime := lrc20Config.WithdrawRelativeBlockLocktime
 	sparkOperatorsFromConfig := config.GetSigningOperatorList()
 	// Repeat same validations as for the partial token transaction.
 	err = utils.ValidatePartialTokenTransaction(tokenTransaction, tokenTransactionSignatures, sparkOperatorsFromConfig, config.SupportedNetworks)
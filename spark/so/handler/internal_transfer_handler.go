@@ -27,7 +27,10 @@ func (h *InternalTransferHandler) FinalizeTransfer(ctx context.Context, req *pbi
 	db := ent.GetDbFromContext(ctx)
 	transfer, err := h.loadTransfer(ctx, req.TransferId)
 	if err != nil {
-		return fmt.Errorf("unable to load transfer %s: %v", req.TransferId, err)
+		return fmt.Errorf("unable to load transfer %s: %w", req.TransferId, err)
+	}
+	if err := h.config.CheckRecordedCoordinator(ctx, transfer.ClaimCoordinatorIdentityPubkey); err != nil {
+		return err
 	}
 
 	switch transfer.Status {
@@ -41,12 +44,12 @@ func (h *InternalTransferHandler) FinalizeTransfer(ctx context.Context, req *pbi
 	}
 
 	if err := checkCoopExitTxBroadcasted(ctx, db, transfer); err != nil {
-		return fmt.Errorf("failed to unlock transfer id: %s. with status: %s and error: %v", req.TransferId, transfer.Status, err)
+		return fmt.Errorf("failed to unlock transfer id: %s. with status: %s and error: %w", req.TransferId, transfer.Status, err)
 	}
 
 	transferNodes, err := transfer.QueryTransferLeaves().QueryLeaf().All(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to query transfer leaves for transfer id: %s. with status: %s and error: %v", req.TransferId, transfer.Status, err)
+		return fmt.Errorf("failed to query transfer leaves for transfer id: %s. with status: %s and error: %w", req.TransferId, transfer.Status, err)
 	}
 	if len(transferNodes) != len(req.Nodes) {
 		return fmt.Errorf("transfer nodes count mismatch. transfer id: %s. with status: %s. transfer nodes count: %d. request nodes count: %d", req.TransferId, transfer.Status, len(transferNodes), len(req.Nodes))
@@ -67,7 +70,7 @@ func (h *InternalTransferHandler) FinalizeTransfer(ctx context.Context, req *pbi
 		}
 		dbNode, err := db.TreeNode.Get(ctx, nodeID)
 		if err != nil {
-			return fmt.Errorf("failed to get dbNode. transfer id: %s. with status: %s. node id: %s with uuid: %s and error: %v", req.TransferId, transfer.Status, node.Id, nodeID, err)
+			return fmt.Errorf("failed to get dbNode. transfer id: %s. with status: %s. node id: %s with uuid: %s and error: %w", req.TransferId, transfer.Status, node.Id, nodeID, err)
 		}
 		_, err = dbNode.Update().
 			SetRawTx(node.RawTx).
@@ -75,13 +78,13 @@ func (h *InternalTransferHandler) FinalizeTransfer(ctx context.Context, req *pbi
 			SetStatus(schema.TreeNodeStatusAvailable).
 			Save(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to update dbNode. transfer id: %s. with status: %s. node id: %s with uuid: %s and error: %v", req.TransferId, transfer.Status, node.Id, nodeID, err)
+			return fmt.Errorf("failed to update dbNode. transfer id: %s. with status: %s. node id: %s with uuid: %s and error: %w", req.TransferId, transfer.Status, node.Id, nodeID, err)
 		}
 	}
 
 	_, err = transfer.Update().SetStatus(schema.TransferStatusCompleted).SetCompletionTime(req.Timestamp.AsTime()).Save(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to update transfer status to completed for transfer id: %s. with status: %s and error: %v", req.TransferId, transfer.Status, err)
+		return fmt.Errorf("failed to update transfer status to completed for transfer id: %s. with status: %s and error: %w", req.TransferId, transfer.Status, err)
 	}
 	return nil
 }
@@ -105,7 +108,7 @@ func (h *InternalTransferHandler) InitiateTransfer(ctx context.Context, req *pbi
 	leafRefundMap := h.loadLeafRefundMap(req)
 	transferType, err := ent.TransferTypeSchema(req.Type)
 	if err != nil {
-		return fmt.Errorf("failed to parse transfer type during initiate transfer for transfer id: %s with req.Type: %s and error: %v", req.TransferId, req.Type, err)
+		return fmt.Errorf("failed to parse transfer type during initiate transfer for transfer id: %s with req.Type: %s and error: %w", req.TransferId, req.Type, err)
 	}
 
 	keyTweakMap, err := h.validateTransferPackage(ctx, req.TransferId, req.TransferPackage, req.SenderIdentityPublicKey)
@@ -124,7 +127,7 @@ func (h *InternalTransferHandler) InitiateTransfer(ctx context.Context, req *pbi
 		TransferRoleParticipant,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to initiate transfer for transfer id: %s and error: %v", req.TransferId, err)
+		return fmt.Errorf("failed to initiate transfer for transfer id: %s and error: %w", req.TransferId, err)
 	}
 	return nil
 }
@@ -149,12 +152,12 @@ func (h *InternalTransferHandler) InitiateCooperativeExit(ctx context.Context, r
 		TransferRoleParticipant,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to initiate cooperative exit for transfer id: %s and error: %v", transferReq.TransferId, err)
+		return fmt.Errorf("failed to initiate cooperative exit for transfer id: %s and error: %w", transferReq.TransferId, err)
 	}
 
 	exitID, err := uuid.Parse(req.ExitId)
 	if err != nil {
-		return fmt.Errorf("failed to parse exit id for cooperative exit. transfer id: %s. exit id: %s and error: %v", transferReq.TransferId, req.ExitId, err)
+		return fmt.Errorf("failed to parse exit id for cooperative exit. transfer id: %s. exit id: %s and error: %w", transferReq.TransferId, req.ExitId, err)
 	}
 
 	db := ent.GetDbFromContext(ctx)
@@ -164,7 +167,7 @@ func (h *InternalTransferHandler) InitiateCooperativeExit(ctx context.Context, r
 		SetExitTxid(req.ExitTxid).
 		Save(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create cooperative exit in db for transfer id: %s. exit id: %s and error: %v", transferReq.TransferId, req.ExitId, err)
+		return fmt.Errorf("failed to create cooperative exit in db for transfer id: %s. exit id: %s and error: %w", transferReq.TransferId, req.ExitId, err)
 	}
 	return err
 }
@@ -173,7 +176,10 @@ func (h *InternalTransferHandler) SettleSenderKeyTweak(ctx context.Context, req
 	if req.Action == pbinternal.SettleKeyTweakAction_COMMIT {
 		transfer, err := h.loadTransfer(ctx, req.TransferId)
 		if err != nil {
-			return fmt.Errorf("unable to load transfer %s: %v", req.TransferId, err)
+			return fmt.Errorf("unable to load transfer %s: %w", req.TransferId, err)
+		}
+		if err := h.config.CheckRecordedCoordinator(ctx, transfer.CoordinatorIdentityPubkey); err != nil {
+			return err
 		}
 		return h.commitSenderKeyTweaks(ctx, transfer)
 	}
@@ -90,6 +90,7 @@ func (h *InternalTreeCreationHandler) generateAndStoreDepositAddress(ctx context
 			SetOwnerIdentityPubkey(identityPubkey).
 			SetOwnerSigningPubkey(userPubkey).
 			SetAddress(*address).
+			SetIsTreeAddress(true).
 			Save(ctx)
 		if err != nil {
 			return "", nil, err
@@ -14,6 +14,7 @@ import (
 	"github.com/lightsparkdev/spark/so/authz"
 	"github.com/lightsparkdev/spark/so/ent"
 	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/errors"
 	"github.com/lightsparkdev/spark/so/helper"
 	"github.com/lightsparkdev/spark/so/objects"
 )
@@ -66,7 +67,7 @@ func (h *RefreshTimelockHandler) RefreshTimelock(ctx context.Context, req *pb.Re
 			rawTxBytes = node.RawTx
 		}
 		if i == len(req.SigningJobs)-1 && node.Status != schema.TreeNodeStatusAvailable {
-			return nil, fmt.Errorf("cannot refresh leaf node %s because it is not available", node.ID)
+			return nil, errors.Reasonf(node.UnavailableReason(), "cannot refresh leaf node %s because it is not available", node.ID)
 		}
 
 		currentTx, err := common.TxFromRawTxBytes(rawTxBytes)
@@ -108,7 +109,7 @@ func (h *RefreshTimelockHandler) RefreshTimelock(ctx context.Context, req *pb.Re
 			return nil, fmt.Errorf("sequence %d should be %d", signingSequence, spark.InitialSequence())
 		} else if i == 0 && signingSequence >= currentSequence {
 			// We should be decrementing the timelocks for the very first tx
-			return nil, fmt.Errorf("sequence %d should be less than %d", signingSequence, currentSequence)
+			return nil, errors.Reasonf(errors.ReasonInsufficientTimelock, "sequence %d should be less than %d", signingSequence, currentSequence)
 		}
 	}
 
@@ -145,11 +146,11 @@ func (h *RefreshTimelockHandler) RefreshTimelock(ctx context.Context, req *pb.Re
 
 		sigHash, err := common.SigHashFromTx(signingTxs[i], 0, parentTxOut)
 		if err != nil {
-			return nil, fmt.Errorf("unable to calculate sighash from refund tx: %v", err)
+			return nil, fmt.Errorf("unable to calculate sighash from refund tx: %w", err)
 		}
 		userNonceCommitment, err := objects.NewSigningCommitment(signingJob.SigningNonceCommitment.Binding, signingJob.SigningNonceCommitment.Hiding)
 		if err != nil {
-			return nil, fmt.Errorf("unable to create user nonce commitment: %v", err)
+			return nil, fmt.Errorf("unable to create user nonce commitment: %w", err)
 		}
 
 		signingKeyshare, err := nodes[i].QuerySigningKeyshare().Only(ctx)
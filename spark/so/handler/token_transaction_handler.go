@@ -24,6 +24,7 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/schema"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
+	"github.com/lightsparkdev/spark/so/errors"
 	"github.com/lightsparkdev/spark/so/helper"
 	"github.com/lightsparkdev/spark/so/lrc20"
 	"github.com/lightsparkdev/spark/so/utils"
@@ -137,7 +138,7 @@ func (o TokenTransactionHandler) StartTokenTransaction(ctx context.Context, conf
 	}
 
 	if len(keyshares) < numRevocationKeysharesNeeded {
-		return nil, fmt.Errorf("%s: %d needed, %d available", errNotEnoughUnusedKeyshares, numRevocationKeysharesNeeded, len(keyshares))
+		return nil, errors.Reasonf(errors.ReasonKeysharePoolEmpty, "%s: %d needed, %d available", errNotEnoughUnusedKeyshares, numRevocationKeysharesNeeded, len(keyshares))
 	}
 
 	keyshareIDs := make([]uuid.UUID, len(keyshares))
@@ -161,9 +162,10 @@ func (o TokenTransactionHandler) StartTokenTransaction(ctx context.Context, conf
 		idStr := id.String()
 		output.Id = &idStr
 		output.RevocationCommitment = keyshares[i].PublicKey
-		withdrawalBondSats := config.Lrc20Configs[network.String()].WithdrawBondSats
+		lrc20Config, _ := config.Lrc20Config(network.String())
+		withdrawalBondSats := lrc20Config.WithdrawBondSats
 		output.WithdrawBondSats = &withdrawalBondSats
-		withdrawRelativeBlockLocktime := config.Lrc20Configs[network.String()].WithdrawRelativeBlockLocktime
+		withdrawRelativeBlockLocktime := lrc20Config.WithdrawRelativeBlockLocktime
 		output.WithdrawRelativeBlockLocktime = &withdrawRelativeBlockLocktime
 	}
 
@@ -172,7 +174,7 @@ func (o TokenTransactionHandler) StartTokenTransaction(ctx context.Context, conf
 	// This property should be help because the coordinator blocks on the other SO responses.
 	allExceptSelfSelection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionExcludeSelf}
 	_, err = helper.ExecuteTaskWithAllOperators(ctx, config, &allExceptSelfSelection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-		return callStartTokenTransactionInternal(ctx, operator, finalTokenTransaction, req.TokenTransactionSignatures, keyshareIDStrings, config.IdentityPublicKey())
+		return callStartTokenTransactionInternal(ctx, config, operator, finalTokenTransaction, req.TokenTransactionSignatures, keyshareIDStrings, config.IdentityPublicKey())
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", errFailedToExecuteWithNonCoordinator, err)
@@ -181,7 +183,7 @@ func (o TokenTransactionHandler) StartTokenTransaction(ctx context.Context, conf
 	// Only save in the coordinator SO after receiving confirmation from all other SOs. This ensures that if
 	// a follow up call is made that the coordiantor has only saved the data if the initial Start call reached the SO threshold.
 	selfOperator := config.SigningOperatorMap[config.Identifier]
-	_, err = callStartTokenTransactionInternal(ctx, selfOperator, finalTokenTransaction, req.TokenTransactionSignatures, keyshareIDStrings, config.IdentityPublicKey())
+	_, err = callStartTokenTransactionInternal(ctx, config, selfOperator, finalTokenTransaction, req.TokenTransactionSignatures, keyshareIDStrings, config.IdentityPublicKey())
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", errFailedToExecuteWithCoordinator, err)
 	}
@@ -199,11 +201,11 @@ func (o TokenTransactionHandler) StartTokenTransaction(ctx context.Context, conf
 }
 
 // callStartTokenTransactionInternal handles calling the StartTokenTransactionInternal RPC on an operator
-func callStartTokenTransactionInternal(ctx context.Context, operator *so.SigningOperator,
+func callStartTokenTransactionInternal(ctx context.Context, config *so.Config, operator *so.SigningOperator,
 	finalTokenTransaction *pb.TokenTransaction, tokenTransactionSignatures *pb.TokenTransactionSignatures,
 	keyshareIDStrings []string, coordinatorPublicKey []byte,
 ) (*emptypb.Empty, error) {
-	conn, err := operator.NewGRPCConnection()
+	conn, err := config.NewOperatorConnection(operator)
 	if err != nil {
 		return nil, formatErrorWithTransactionProto(fmt.Sprintf(errFailedToConnectToOperator, operator.Identifier), finalTokenTransaction, err)
 	}
@@ -397,7 +399,7 @@ func validateTokenTransactionForSigning(tokenTransactionProto *pb.TokenTransacti
 		return fmt.Errorf("signing failed because transaction is no longer mapped to spent TTXOs. Expected %d TTXOs, found %d. TTXOs were likely remapped to a more recent started transaction", len(tokenTransactionProto.GetTransferInput().GetOutputsToSpend()), len(tokenTransactionEnt.Edges.SpentOutput))
 	}
 	if !tokenTransactionEnt.ExpiryTime.IsZero() && time.Now().After(tokenTransactionEnt.ExpiryTime) {
-		return fmt.Errorf("signing failed because token transaction %s has expired at %s", tokenTransactionEnt.ID, tokenTransactionEnt.ExpiryTime.Format(time.RFC3339))
+		return errors.Reasonf(errors.ReasonTokenTransactionExpired, "signing failed because token transaction %s has expired at %s", tokenTransactionEnt.ID, tokenTransactionEnt.ExpiryTime.Format(time.RFC3339))
 	}
 	return nil
 }
@@ -478,7 +480,7 @@ func (o TokenTransactionHandler) SignTokenTransaction(
 			for _, freeze := range activeFreezes {
 				logger.Info("Found active freeze", "owner", freeze.OwnerPublicKey, "token", freeze.TokenPublicKey, "freeze_timestamp", freeze.WalletProvidedFreezeTimestamp)
 			}
-			return nil, fmt.Errorf("at least one input is frozen. Cannot proceed with transaction")
+			return nil, errors.Reasonf(errors.ReasonTokenOutputFrozen, "at least one input is frozen. Cannot proceed with transaction")
 		}
 	}
 
@@ -844,7 +846,7 @@ func (o TokenTransactionHandler) QueryTokenTransactions(ctx context.Context, con
 		for _, idStr := range req.OutputIds {
 			id, err := uuid.Parse(idStr)
 			if err != nil {
-				return nil, fmt.Errorf("invalid output ID format: %v", err)
+				return nil, fmt.Errorf("invalid output ID format: %w", err)
 			}
 			outputUUIDs = append(outputUUIDs, id)
 		}
@@ -893,7 +895,7 @@ func (o TokenTransactionHandler) QueryTokenTransactions(ctx context.Context, con
 	// Execute the query
 	transactions, err := query.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query token transactions: %v", err)
+		return nil, fmt.Errorf("unable to query token transactions: %w", err)
 	}
 
 	// Convert to response protos
@@ -940,7 +942,7 @@ func (o TokenTransactionHandler) QueryTokenOutputs(
 	allSelection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionAll}
 	responses, err := helper.ExecuteTaskWithAllOperators(ctx, config, &allSelection,
 		func(ctx context.Context, operator *so.SigningOperator) (map[string]*pb.OutputWithPreviousTransactionData, error) {
-			conn, err := operator.NewGRPCConnection()
+			conn, err := config.NewOperatorConnection(operator)
 			if err != nil {
 				return nil, fmt.Errorf("failed to connect to operator %s: %w", operator.Identifier, err)
 			}
@@ -1024,7 +1026,7 @@ func (o TokenTransactionHandler) CancelSignedTokenTransaction(
 	// b) Update (2) to not ping every SO in parallel but ping one at a time until # SOs - threshold have validated that they have not yet signed.
 	allSelection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionAll}
 	responses, err := helper.ExecuteTaskWithAllOperators(ctx, config, &allSelection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-		conn, err := operator.NewGRPCConnection()
+		conn, err := config.NewOperatorConnection(operator)
 		if err != nil {
 			return nil, formatErrorWithTransactionEnt(
 				fmt.Sprintf(errFailedToConnectToOperatorForCancel, operator.Identifier),
@@ -17,6 +17,7 @@ import (
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/authz"
 	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/archivedtransfer"
 	"github.com/lightsparkdev/spark/so/ent/blockheight"
 	"github.com/lightsparkdev/spark/so/ent/cooperativeexit"
 	"github.com/lightsparkdev/spark/so/ent/predicate"
@@ -98,7 +99,7 @@ func (h *TransferHandler) startTransferInternal(ctx context.Context, req *pb.Sta
 
 	transferProto, err := transfer.MarshalProto(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to marshal transfer: %v", err)
+		return nil, fmt.Errorf("unable to marshal transfer: %w", err)
 	}
 
 	signingResults, err := signRefunds(ctx, h.config, req, leafMap, adaptorPubKey)
@@ -149,7 +150,7 @@ func (h *TransferHandler) settleSenderKeyTweaks(ctx context.Context, transferID
 		action = pbinternal.SettleKeyTweakAction_ROLLBACK
 	}
 	_, err := helper.ExecuteTaskWithAllOperators(ctx, h.config, &operatorSelection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-		conn, err := operator.NewGRPCConnection()
+		conn, err := h.config.NewOperatorConnection(operator)
 		if err != nil {
 			return nil, err
 		}
@@ -197,7 +198,7 @@ func (h *TransferHandler) syncTransferInit(ctx context.Context, req *pb.StartTra
 	}
 	transferTypeProto, err := ent.TransferTypeProto(transferType)
 	if err != nil {
-		return fmt.Errorf("unable to get transfer type proto: %v", err)
+		return fmt.Errorf("unable to get transfer type proto: %w", err)
 	}
 	initTransferRequest := &pbinternal.InitiateTransferRequest{
 		TransferId:                req.TransferId,
@@ -212,7 +213,7 @@ func (h *TransferHandler) syncTransferInit(ctx context.Context, req *pb.StartTra
 		Option: helper.OperatorSelectionOptionExcludeSelf,
 	}
 	_, err = helper.ExecuteTaskWithAllOperators(ctx, h.config, &selection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-		conn, err := operator.NewGRPCConnection()
+		conn, err := h.config.NewOperatorConnection(operator)
 		if err != nil {
 			return nil, err
 		}
@@ -235,19 +236,19 @@ func signRefunds(ctx context.Context, config *so.Config, requests *pb.StartTrans
 			leaf := leafMap[req.LeafId]
 			refundTx, err := common.TxFromRawTxBytes(req.RefundTxSigningJob.RawTx)
 			if err != nil {
-				return nil, fmt.Errorf("unable to load new refund tx: %v", err)
+				return nil, fmt.Errorf("unable to load new refund tx: %w", err)
 			}
 
 			leafTx, err := common.TxFromRawTxBytes(leaf.RawTx)
 			if err != nil {
-				return nil, fmt.Errorf("unable to load leaf tx: %v", err)
+				return nil, fmt.Errorf("unable to load leaf tx: %w", err)
 			}
 			if len(leafTx.TxOut) <= 0 {
 				return nil, fmt.Errorf("vout out of bounds")
 			}
 			refundTxSigHash, err := common.SigHashFromTx(refundTx, 0, leafTx.TxOut[0])
 			if err != nil {
-				return nil, fmt.Errorf("unable to calculate sighash from refund tx: %v", err)
+				return nil, fmt.Errorf("unable to calculate sighash from refund tx: %w", err)
 			}
 
 			userNonceCommitment, err := objects.NewSigningCommitment(req.RefundTxSigningJob.SigningNonceCommitment.Binding, req.RefundTxSigningJob.SigningNonceCommitment.Hiding)
@@ -308,10 +309,16 @@ func (h *TransferHandler) FinalizeTransfer(ctx context.Context, req *pb.Finalize
 
 	transfer, err := h.loadTransfer(ctx, req.TransferId)
 	if err != nil {
-		return nil, fmt.Errorf("unable to load transfer %s: %v", req.TransferId, err)
+		return nil, fmt.Errorf("unable to load transfer %s: %w", req.TransferId, err)
 	}
 	span.SetAttributes(transferTypeKey.String(string(transfer.Type)))
-	if !bytes.Equal(transfer.SenderIdentityPubkey, req.OwnerIdentityPublicKey) || transfer.Status != schema.TransferStatusSenderInitiated {
+	if !bytes.Equal(transfer.SenderIdentityPubkey, req.OwnerIdentityPublicKey) {
+		return nil, fmt.Errorf("send transfer cannot be completed %s", req.TransferId)
+	}
+	if transferExpired(transfer) {
+		return nil, errors.Reasonf(errors.ReasonTransferExpired, "send transfer cannot be completed %s, status: %s", req.TransferId, transfer.Status)
+	}
+	if transfer.Status != schema.TransferStatusSenderInitiated {
 		return nil, fmt.Errorf("send transfer cannot be completed %s", req.TransferId)
 	}
 
@@ -321,7 +328,7 @@ func (h *TransferHandler) FinalizeTransfer(ctx context.Context, req *pb.Finalize
 	case schema.TransferTypePreimageSwap:
 		preimageRequest, err := db.PreimageRequest.Query().Where(preimagerequest.HasTransfersWith(enttransfer.ID(transfer.ID))).Only(ctx)
 		if err != nil || preimageRequest == nil {
-			return nil, fmt.Errorf("unable to find preimage request for transfer %s: %v", transfer.ID.String(), err)
+			return nil, fmt.Errorf("unable to find preimage request for transfer %s: %w", transfer.ID.String(), err)
 		}
 		shouldTweakKey = preimageRequest.Status == schema.PreimageRequestStatusPreimageShared
 	case schema.TransferTypeCooperativeExit:
@@ -334,7 +341,7 @@ func (h *TransferHandler) FinalizeTransfer(ctx context.Context, req *pb.Finalize
 	for _, leaf := range req.LeavesToSend {
 		err = h.completeSendLeaf(ctx, transfer, leaf, shouldTweakKey)
 		if err != nil {
-			return nil, fmt.Errorf("unable to complete send leaf transfer for leaf %s: %v", leaf.LeafId, err)
+			return nil, fmt.Errorf("unable to complete send leaf transfer for leaf %s: %w", leaf.LeafId, err)
 		}
 	}
 
@@ -345,11 +352,11 @@ func (h *TransferHandler) FinalizeTransfer(ctx context.Context, req *pb.Finalize
 	}
 	transfer, err = transfer.Update().SetStatus(statusToSet).Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to update transfer status %s: %v", transfer.ID.String(), err)
+		return nil, fmt.Errorf("unable to update transfer status %s: %w", transfer.ID.String(), err)
 	}
 	transferProto, err := transfer.MarshalProto(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to marshal transfer: %v", err)
+		return nil, fmt.Errorf("unable to marshal transfer: %w", err)
 	}
 	eventRouter := events.GetDefaultRouter()
 	err = eventRouter.NotifyUser(transfer.ReceiverIdentityPubkey, &pb.SubscribeToEventsResponse{
@@ -387,7 +394,7 @@ func (h *TransferHandler) completeSendLeaf(ctx context.Context, transfer *ent.Tr
 		},
 	)
 	if err != nil {
-		return fmt.Errorf("unable to validate share: %v", err)
+		return fmt.Errorf("unable to validate share: %w", err)
 	}
 
 	// TODO (zhen): Verify possession
@@ -395,13 +402,13 @@ func (h *TransferHandler) completeSendLeaf(ctx context.Context, transfer *ent.Tr
 	// Find leaves in db
 	leafID, err := uuid.Parse(req.LeafId)
 	if err != nil {
-		return fmt.Errorf("unable to parse leaf_id %s: %v", req.LeafId, err)
+		return fmt.Errorf("unable to parse leaf_id %s: %w", req.LeafId, err)
 	}
 
 	db := ent.GetDbFromContext(ctx)
 	leaf, err := db.TreeNode.Get(ctx, leafID)
 	if err != nil || leaf == nil {
-		return fmt.Errorf("unable to find leaf %s: %v", req.LeafId, err)
+		return fmt.Errorf("unable to find leaf %s: %w", req.LeafId, err)
 	}
 	if leaf.Status != schema.TreeNodeStatusTransferLocked ||
 		!bytes.Equal(leaf.OwnerIdentityPubkey, transfer.SenderIdentityPubkey) {
@@ -416,7 +423,7 @@ func (h *TransferHandler) completeSendLeaf(ctx context.Context, transfer *ent.Tr
 		).
 		Only(ctx)
 	if err != nil || transferLeaf == nil {
-		return fmt.Errorf("unable to get transfer leaf %s: %v", req.LeafId, err)
+		return fmt.Errorf("unable to get transfer leaf %s: %w", req.LeafId, err)
 	}
 
 	// Optional verify if the sender key tweak proof is the same as the one in previous call.
@@ -424,7 +431,7 @@ func (h *TransferHandler) completeSendLeaf(ctx context.Context, transfer *ent.Tr
 		proof := &pb.SecretProof{}
 		err = proto.Unmarshal(transferLeaf.SenderKeyTweakProof, proof)
 		if err != nil {
-			return fmt.Errorf("unable to unmarshal sender key tweak proof: %v", err)
+			return fmt.Errorf("unable to unmarshal sender key tweak proof: %w", err)
 		}
 		shareProof := req.SecretShareTweak.Proofs
 		for i, proof := range proof.Proofs {
@@ -436,18 +443,18 @@ func (h *TransferHandler) completeSendLeaf(ctx context.Context, transfer *ent.Tr
 
 	refundTxBytes, err := common.UpdateTxWithSignature(transferLeaf.IntermediateRefundTx, 0, req.RefundSignature)
 	if err != nil {
-		return fmt.Errorf("unable to update refund tx with signature: %v", err)
+		return fmt.Errorf("unable to update refund tx with signature: %w", err)
 	}
 
 	if transfer.Type != schema.TransferTypePreimageSwap && transfer.Type != schema.TransferTypeUtxoSwap {
 		// Verify signature
 		refundTx, err := common.TxFromRawTxBytes(refundTxBytes)
 		if err != nil {
-			return fmt.Errorf("unable to deserialize refund tx: %v", err)
+			return fmt.Errorf("unable to deserialize refund tx: %w", err)
 		}
 		leafNodeTx, err := common.TxFromRawTxBytes(leaf.RawTx)
 		if err != nil {
-			return fmt.Errorf("unable to deserialize leaf tx: %v", err)
+			return fmt.Errorf("unable to deserialize leaf tx: %w", err)
 		}
 		if len(leafNodeTx.TxOut) <= 0 {
 			return fmt.Errorf("vout out of bounds")
@@ -458,7 +465,7 @@ func (h *TransferHandler) completeSendLeaf(ctx context.Context, transfer *ent.Tr
 		err = common.VerifySignatureSingleInput(refundTx, 0, leafNodeTx.TxOut[0])
 		if err != nil {
 			logger.Error("unable to verify refund tx signature", "error", err, "refundTx", refundTx)
-			return fmt.Errorf("unable to verify refund tx signature: %v", err)
+			return fmt.Errorf("unable to verify refund tx signature: %w", err)
 		}
 	}
 
@@ -470,19 +477,19 @@ func (h *TransferHandler) completeSendLeaf(ctx context.Context, transfer *ent.Tr
 	if !shouldTweakKey {
 		keyTweak, err := proto.Marshal(req)
 		if err != nil {
-			return fmt.Errorf("unable to marshal key tweak: %v", err)
+			return fmt.Errorf("unable to marshal key tweak: %w", err)
 		}
 		transferLeafMutator.SetKeyTweak(keyTweak)
 	}
 	_, err = transferLeafMutator.Save(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to update transfer leaf: %v", err)
+		return fmt.Errorf("unable to update transfer leaf: %w", err)
 	}
 
 	if shouldTweakKey {
 		err = helper.TweakLeafKey(ctx, leaf, req, refundTxBytes)
 		if err != nil {
-			return fmt.Errorf("unable to tweak leaf key: %v", err)
+			return fmt.Errorf("unable to tweak leaf key: %w", err)
 		}
 	}
 
@@ -553,12 +560,13 @@ func (h *TransferHandler) queryTransfers(ctx context.Context, filter *pb.Transfe
 		}
 	}
 
+	var transferUUIDs []uuid.UUID
 	if filter.TransferIds != nil {
-		transferUUIDs := make([]uuid.UUID, len(filter.TransferIds))
+		transferUUIDs = make([]uuid.UUID, 0, len(filter.TransferIds))
 		for _, transferID := range filter.TransferIds {
 			transferUUID, err := uuid.Parse(transferID)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse transfer id as a uuid %s: %v", transferID, err)
+				return nil, fmt.Errorf("unable to parse transfer id as a uuid %s: %w", transferID, err)
 			}
 			transferUUIDs = append(transferUUIDs, transferUUID)
 		}
@@ -578,7 +586,7 @@ func (h *TransferHandler) queryTransfers(ctx context.Context, filter *pb.Transfe
 		baseQuery = baseQuery.Where(enttransfer.And(transferPredicate...))
 	}
 
-	query := baseQuery.Order(ent.Desc(enttransfer.FieldUpdateTime))
+	query := baseQuery.Clone().Order(ent.Desc(enttransfer.FieldUpdateTime))
 
 	if filter.Limit > 100 || filter.Limit == 0 {
 		filter.Limit = 100
@@ -591,21 +599,46 @@ func (h *TransferHandler) queryTransfers(ctx context.Context, filter *pb.Transfe
 
 	transfers, err := query.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query transfers: %v", err)
+		return nil, fmt.Errorf("unable to query transfers: %w", err)
 	}
 
 	transferProtos := []*pb.Transfer{}
 	for _, transfer := range transfers {
 		transferProto, err := transfer.MarshalProto(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal transfer: %v", err)
+			return nil, fmt.Errorf("unable to marshal transfer: %w", err)
 		}
 		transferProtos = append(transferProtos, transferProto)
 	}
 
+	// Archived transfers come after all the others, so they are only reached from the last page of
+	// the others on.
+	if filter.IncludeArchived && !isPending && len(transferProtos) < int(filter.Limit) {
+		liveCount, err := baseQuery.Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to count transfers: %w", err)
+		}
+		archivedQuery := db.ArchivedTransfer.Query().
+			Where(archivedtransfer.And(archivedTransferPredicates(filter, transferUUIDs)...)).
+			Order(ent.Desc(archivedtransfer.FieldTransferUpdateTime), ent.Asc(archivedtransfer.FieldID)).
+			Limit(int(filter.Limit) - len(transferProtos))
+		if archivedOffset := int(filter.Offset) - liveCount; archivedOffset > 0 {
+			archivedQuery = archivedQuery.Offset(archivedOffset)
+		}
+		archived, err := archivedQuery.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query archived transfers: %w", err)
+		}
+		archivedProtos, err := ent.ReadArchivedTransfers(ctx, archived)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read archived transfers: %w", err)
+		}
+		transferProtos = append(transferProtos, archivedProtos...)
+	}
+
 	var nextOffset int64
-	if len(transfers) == int(filter.Limit) {
-		nextOffset = filter.Offset + int64(len(transfers))
+	if len(transferProtos) == int(filter.Limit) {
+		nextOffset = filter.Offset + int64(len(transferProtos))
 	} else {
 		nextOffset = -1
 	}
@@ -616,6 +649,35 @@ func (h *TransferHandler) queryTransfers(ctx context.Context, filter *pb.Transfe
 	}, nil
 }
 
+// archivedTransferPredicates returns the predicates on archived transfers that match the filter of
+// a query of all transfers.
+func archivedTransferPredicates(filter *pb.TransferFilter, transferUUIDs []uuid.UUID) []predicate.ArchivedTransfer {
+	var predicates []predicate.ArchivedTransfer
+	switch filter.Participant.(type) {
+	case *pb.TransferFilter_ReceiverIdentityPublicKey:
+		predicates = append(predicates, archivedtransfer.ReceiverIdentityPubkeyEQ(filter.GetReceiverIdentityPublicKey()))
+	case *pb.TransferFilter_SenderIdentityPublicKey:
+		predicates = append(predicates, archivedtransfer.SenderIdentityPubkeyEQ(filter.GetSenderIdentityPublicKey()))
+	case *pb.TransferFilter_SenderOrReceiverIdentityPublicKey:
+		identityPubkey := filter.GetSenderOrReceiverIdentityPublicKey()
+		predicates = append(predicates, archivedtransfer.Or(
+			archivedtransfer.ReceiverIdentityPubkeyEQ(identityPubkey),
+			archivedtransfer.SenderIdentityPubkeyEQ(identityPubkey),
+		))
+	}
+	if filter.TransferIds != nil {
+		predicates = append(predicates, archivedtransfer.IDIn(transferUUIDs...))
+	}
+	if len(filter.Types) > 0 {
+		transferTypes := make([]schema.TransferType, len(filter.Types))
+		for i, transferType := range filter.Types {
+			transferTypes[i] = schema.TransferType(transferType.String())
+		}
+		predicates = append(predicates, archivedtransfer.TypeIn(transferTypes...))
+	}
+	return predicates
+}
+
 func (h *TransferHandler) QueryPendingTransfers(ctx context.Context, filter *pb.TransferFilter) (*pb.QueryTransfersResponse, error) {
 	return h.queryTransfers(ctx, filter, true)
 }
@@ -637,12 +699,12 @@ func checkCoopExitTxBroadcasted(ctx context.Context, db *ent.Tx, transfer *ent.T
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("failed to find coop exit for transfer %s: %v", transfer.ID.String(), err)
+		return fmt.Errorf("failed to find coop exit for transfer %s: %w", transfer.ID.String(), err)
 	}
 
 	transferLeaves, err := transfer.QueryTransferLeaves().All(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to find leaves for transfer %s: %v", transfer.ID.String(), err)
+		return fmt.Errorf("failed to find leaves for transfer %s: %w", transfer.ID.String(), err)
 	}
 	// Leaf and tree are required to exist by our schema and
 	// transfers must be initialized with at least 1 leaf
@@ -652,7 +714,7 @@ func checkCoopExitTxBroadcasted(ctx context.Context, db *ent.Tx, transfer *ent.T
 		blockheight.NetworkEQ(tree.Network),
 	).Only(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to find block height: %v", err)
+		return fmt.Errorf("failed to find block height: %w", err)
 	}
 	if coopExit.ConfirmationHeight == 0 {
 		return errors.FailedPreconditionErrorf("coop exit tx hasn't been broadcasted")
@@ -674,12 +736,15 @@ func (h *TransferHandler) ClaimTransferTweakKeys(ctx context.Context, req *pb.Cl
 
 	transfer, err := h.loadTransfer(ctx, req.TransferId)
 	if err != nil {
-		return fmt.Errorf("unable to load transfer %s: %v", req.TransferId, err)
+		return fmt.Errorf("unable to load transfer %s: %w", req.TransferId, err)
 	}
 	span.SetAttributes(transferTypeKey.String(string(transfer.Type)))
 	if !bytes.Equal(transfer.ReceiverIdentityPubkey, req.OwnerIdentityPublicKey) {
 		return fmt.Errorf("cannot claim transfer %s, receiver identity public key mismatch", req.TransferId)
 	}
+	if transferExpired(transfer) {
+		return errors.Reasonf(errors.ReasonTransferExpired, "transfer cannot be claimed %s, status: %s", req.TransferId, transfer.Status)
+	}
 	if transfer.Status != schema.TransferStatusSenderKeyTweaked && transfer.Status != schema.TransferStatusReceiverKeyTweaked {
 		return errors.FailedPreconditionErrorf("transfer cannot be claimed %s, status: %s", req.TransferId, transfer.Status)
 	}
@@ -692,7 +757,7 @@ func (h *TransferHandler) ClaimTransferTweakKeys(ctx context.Context, req *pb.Cl
 	// Validate leaves count
 	transferLeaves, err := transfer.QueryTransferLeaves().WithLeaf().All(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to get transfer leaves for transfer %s: %v", req.TransferId, err)
+		return fmt.Errorf("unable to get transfer leaves for transfer %s: %w", req.TransferId, err)
 	}
 	if len(transferLeaves) != len(req.LeavesToReceive) {
 		return fmt.Errorf("inconsistent leaves to claim for transfer %s", req.TransferId)
@@ -711,18 +776,18 @@ func (h *TransferHandler) ClaimTransferTweakKeys(ctx context.Context, req *pb.Cl
 		}
 		leafTweakBytes, err := proto.Marshal(leafTweak)
 		if err != nil {
-			return fmt.Errorf("unable to marshal leaf tweak: %v", err)
+			return fmt.Errorf("unable to marshal leaf tweak: %w", err)
 		}
 		leaf, err = leaf.Update().SetKeyTweak(leafTweakBytes).Save(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to update leaf %s: %v", leaf.ID.String(), err)
+			return fmt.Errorf("unable to update leaf %s: %w", leaf.ID.String(), err)
 		}
 	}
 
 	// Update transfer status
 	_, err = transfer.Update().SetStatus(schema.TransferStatusReceiverKeyTweaked).Save(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to update transfer status %s: %v", transfer.ID.String(), err)
+		return fmt.Errorf("unable to update transfer status %s: %w", transfer.ID.String(), err)
 	}
 
 	return nil
@@ -750,7 +815,7 @@ func (h *TransferHandler) claimLeafTweakKey(ctx context.Context, leaf *ent.TreeN
 		},
 	)
 	if err != nil {
-		return fmt.Errorf("unable to validate share: %v", err)
+		return fmt.Errorf("unable to validate share: %w", err)
 	}
 
 	if leaf.Status != schema.TreeNodeStatusTransferLocked {
@@ -760,7 +825,7 @@ func (h *TransferHandler) claimLeafTweakKey(ctx context.Context, leaf *ent.TreeN
 	// Tweak keyshare
 	keyshare, err := leaf.QuerySigningKeyshare().First(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to load keyshare for leaf %s: %v", leaf.ID.String(), err)
+		return fmt.Errorf("unable to load keyshare for leaf %s: %w", leaf.ID.String(), err)
 	}
 	keyshare, err = keyshare.TweakKeyShare(
 		ctx,
@@ -769,12 +834,12 @@ func (h *TransferHandler) claimLeafTweakKey(ctx context.Context, leaf *ent.TreeN
 		req.PubkeySharesTweak,
 	)
 	if err != nil {
-		return fmt.Errorf("unable to tweak keyshare %s for leaf %s: %v", keyshare.ID.String(), leaf.ID.String(), err)
+		return fmt.Errorf("unable to tweak keyshare %s for leaf %s: %w", keyshare.ID.String(), leaf.ID.String(), err)
 	}
 
 	signingPubkey, err := common.SubtractPublicKeys(leaf.VerifyingPubkey, keyshare.PublicKey)
 	if err != nil {
-		return fmt.Errorf("unable to calculate new signing pubkey for leaf %s: %v", req.LeafId, err)
+		return fmt.Errorf("unable to calculate new signing pubkey for leaf %s: %w", req.LeafId, err)
 	}
 	_, err = leaf.
 		Update().
@@ -782,7 +847,7 @@ func (h *TransferHandler) claimLeafTweakKey(ctx context.Context, leaf *ent.TreeN
 		SetOwnerSigningPubkey(signingPubkey).
 		Save(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to update leaf %s: %v", req.LeafId, err)
+		return fmt.Errorf("unable to update leaf %s: %w", req.LeafId, err)
 	}
 	return nil
 }
@@ -795,7 +860,7 @@ func (h *TransferHandler) getLeavesFromTransfer(ctx context.Context, transfer *e
 
 	transferLeaves, err := transfer.QueryTransferLeaves().WithLeaf().All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get leaves for transfer %s: %v", transfer.ID.String(), err)
+		return nil, fmt.Errorf("unable to get leaves for transfer %s: %w", transfer.ID.String(), err)
 	}
 	leaves := make(map[string]*ent.TreeNode)
 	for _, transferLeaf := range transferLeaves {
@@ -811,7 +876,7 @@ func (h *TransferHandler) ValidateKeyTweakProof(ctx context.Context, transferLea
 	for _, leaf := range transferLeaves {
 		treeNode, err := leaf.QueryLeaf().Only(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to get tree node for leaf %s: %v", leaf.ID.String(), err)
+			return fmt.Errorf("unable to get tree node for leaf %s: %w", leaf.ID.String(), err)
 		}
 		proof, exists := keyTweakProofs[treeNode.ID.String()]
 		if !exists {
@@ -820,7 +885,7 @@ func (h *TransferHandler) ValidateKeyTweakProof(ctx context.Context, transferLea
 		keyTweakProto := &pb.ClaimLeafKeyTweak{}
 		err = proto.Unmarshal(leaf.KeyTweak, keyTweakProto)
 		if err != nil {
-			return fmt.Errorf("unable to unmarshal key tweak for leaf %s: %v", leaf.ID.String(), err)
+			return fmt.Errorf("unable to unmarshal key tweak for leaf %s: %w", leaf.ID.String(), err)
 		}
 		for i, proof := range proof.Proofs {
 			if !bytes.Equal(keyTweakProto.SecretShareTweak.Proofs[i], proof) {
@@ -839,12 +904,12 @@ func (h *TransferHandler) revertClaimTransfer(ctx context.Context, transfer *ent
 
 	_, err := transfer.Update().SetStatus(schema.TransferStatusSenderKeyTweaked).Save(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to update transfer status %s: %v", transfer.ID.String(), err)
+		return fmt.Errorf("unable to update transfer status %s: %w", transfer.ID.String(), err)
 	}
 	for _, leaf := range transferLeaves {
 		leaf, err := leaf.Update().SetKeyTweak(nil).Save(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to update leaf %s: %v", leaf.ID.String(), err)
+			return fmt.Errorf("unable to update leaf %s: %w", leaf.ID.String(), err)
 		}
 	}
 	return nil
@@ -858,10 +923,11 @@ func (h *TransferHandler) settleReceiverKeyTweak(ctx context.Context, transfer *
 
 	tweakKey := true
 	if keyTweakProofs != nil {
-		// Only validate key tweak proof if it is provided for backward compatibility.
-		selection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionExcludeSelf}
+		// Only validate key tweak proof if it is provided for backward compatibility. This operator
+		// locks the claim too, so that every operator records it as the coordinator of the claim.
+		selection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionAll}
 		_, err := helper.ExecuteTaskWithAllOperators(ctx, h.config, &selection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-			conn, err := operator.NewGRPCConnection()
+			conn, err := h.config.NewOperatorConnection(operator)
 			if err != nil {
 				return nil, err
 			}
@@ -879,7 +945,7 @@ func (h *TransferHandler) settleReceiverKeyTweak(ctx context.Context, transfer *
 
 	selection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionAll}
 	_, err := helper.ExecuteTaskWithAllOperators(ctx, h.config, &selection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-		conn, err := operator.NewGRPCConnection()
+		conn, err := h.config.NewOperatorConnection(operator)
 		if err != nil {
 			return nil, err
 		}
@@ -892,7 +958,7 @@ func (h *TransferHandler) settleReceiverKeyTweak(ctx context.Context, transfer *
 	})
 	if err != nil {
 		// At this point, this is not recoverable. But this should not happen in theory.
-		return fmt.Errorf("unable to settle receiver key tweak: %v", err)
+		return fmt.Errorf("unable to settle receiver key tweak: %w", err)
 	}
 	if !tweakKey {
 		return fmt.Errorf("unable to settle receiver key tweak: %v, you might have a race condition in your implementation", err)
@@ -911,7 +977,7 @@ func (h *TransferHandler) ClaimTransferSignRefunds(ctx context.Context, req *pb.
 
 	transfer, err := h.loadTransferWithoutUpdate(ctx, req.TransferId)
 	if err != nil {
-		return nil, fmt.Errorf("unable to load transfer %s: %v", req.TransferId, err)
+		return nil, fmt.Errorf("unable to load transfer %s: %w", req.TransferId, err)
 	}
 	span.SetAttributes(transferTypeKey.String(string(transfer.Type)))
 	if !bytes.Equal(transfer.ReceiverIdentityPubkey, req.OwnerIdentityPublicKey) {
@@ -930,7 +996,7 @@ func (h *TransferHandler) ClaimTransferSignRefunds(ctx context.Context, req *pb.
 	// Validate leaves count
 	leavesToTransfer, err := transfer.QueryTransferLeaves().All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to load leaves to transfer for transfer %s: %v", req.TransferId, err)
+		return nil, fmt.Errorf("unable to load leaves to transfer for transfer %s: %w", req.TransferId, err)
 	}
 	if len(leavesToTransfer) != len(req.SigningJobs) {
 		return nil, fmt.Errorf("inconsistent leaves to claim for transfer %s", req.TransferId)
@@ -944,14 +1010,14 @@ func (h *TransferHandler) ClaimTransferSignRefunds(ctx context.Context, req *pb.
 	if transfer.Status != schema.TransferStatusReceiverRefundSigned {
 		err = h.settleReceiverKeyTweak(ctx, transfer, req.KeyTweakProofs)
 		if err != nil {
-			return nil, fmt.Errorf("unable to settle receiver key tweak: %v", err)
+			return nil, fmt.Errorf("unable to settle receiver key tweak: %w", err)
 		}
 	}
 
 	// Update transfer status.
 	_, err = transfer.Update().SetStatus(schema.TransferStatusReceiverRefundSigned).Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to update transfer status %s: %v", transfer.ID.String(), err)
+		return nil, fmt.Errorf("unable to update transfer status %s: %w", transfer.ID.String(), err)
 	}
 
 	signingJobs := []*helper.SigningJob{}
@@ -964,12 +1030,12 @@ func (h *TransferHandler) ClaimTransferSignRefunds(ctx context.Context, req *pb.
 
 		leaf, err := leaf.Update().SetRawRefundTx(job.RefundTxSigningJob.RawTx).Save(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("unable to update leaf refund tx %s: %v", leaf.ID.String(), err)
+			return nil, fmt.Errorf("unable to update leaf refund tx %s: %w", leaf.ID.String(), err)
 		}
 
 		signingJob, err := h.getRefundTxSigningJob(ctx, leaf, job.RefundTxSigningJob)
 		if err != nil {
-			return nil, fmt.Errorf("unable to create signing job for leaf %s: %v", leaf.ID.String(), err)
+			return nil, fmt.Errorf("unable to create signing job for leaf %s: %w", leaf.ID.String(), err)
 		}
 		signingJobs = append(signingJobs, signingJob)
 		jobToLeafMap[signingJob.JobID] = leaf.ID
@@ -1004,18 +1070,18 @@ func (h *TransferHandler) getRefundTxSigningJob(ctx context.Context, leaf *ent.T
 
 	keyshare, err := leaf.QuerySigningKeyshare().First(ctx)
 	if err != nil || keyshare == nil {
-		return nil, fmt.Errorf("unable to load keyshare for leaf %s: %v", leaf.ID.String(), err)
+		return nil, fmt.Errorf("unable to load keyshare for leaf %s: %w", leaf.ID.String(), err)
 	}
 	leafTx, err := common.TxFromRawTxBytes(leaf.RawTx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to load leaf tx for leaf %s: %v", leaf.ID.String(), err)
+		return nil, fmt.Errorf("unable to load leaf tx for leaf %s: %w", leaf.ID.String(), err)
 	}
 	if len(leafTx.TxOut) <= 0 {
 		return nil, fmt.Errorf("vout out of bounds")
 	}
 	refundSigningJob, _, err := helper.NewSigningJob(keyshare, job, leafTx.TxOut[0], nil)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create signing job for leaf %s: %v", leaf.ID.String(), err)
+		return nil, fmt.Errorf("unable to create signing job for leaf %s: %w", leaf.ID.String(), err)
 	}
 	return refundSigningJob, nil
 }
@@ -1026,7 +1092,7 @@ func (h *TransferHandler) InitiateSettleReceiverKeyTweak(ctx context.Context, re
 
 	transfer, err := h.loadTransfer(ctx, req.TransferId)
 	if err != nil {
-		return fmt.Errorf("unable to load transfer %s: %v", req.TransferId, err)
+		return fmt.Errorf("unable to load transfer %s: %w", req.TransferId, err)
 	}
 	span.SetAttributes(transferTypeKey.String(string(transfer.Type)))
 
@@ -1036,17 +1102,20 @@ func (h *TransferHandler) InitiateSettleReceiverKeyTweak(ctx context.Context, re
 
 	leaves, err := transfer.QueryTransferLeaves().All(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to get leaves from transfer %s: %v", req.TransferId, err)
+		return fmt.Errorf("unable to get leaves from transfer %s: %w", req.TransferId, err)
 	}
 
 	err = h.ValidateKeyTweakProof(ctx, leaves, req.KeyTweakProofs)
 	if err != nil {
-		return fmt.Errorf("unable to validate key tweak proof: %v", err)
+		return fmt.Errorf("unable to validate key tweak proof: %w", err)
 	}
 
-	_, err = transfer.Update().SetStatus(schema.TransferStatusReceiverKeyTweakLocked).Save(ctx)
+	_, err = transfer.Update().
+		SetStatus(schema.TransferStatusReceiverKeyTweakLocked).
+		SetClaimCoordinatorIdentityPubkey(h.config.CallCoordinator(ctx)).
+		Save(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to update transfer status %s: %v", transfer.ID.String(), err)
+		return fmt.Errorf("unable to update transfer status %s: %w", transfer.ID.String(), err)
 	}
 
 	return nil
@@ -1058,20 +1127,23 @@ func (h *TransferHandler) SettleReceiverKeyTweak(ctx context.Context, req *pbint
 
 	transfer, err := h.loadTransfer(ctx, req.TransferId)
 	if err != nil {
-		return fmt.Errorf("unable to load transfer %s: %v", req.TransferId, err)
+		return fmt.Errorf("unable to load transfer %s: %w", req.TransferId, err)
 	}
 	span.SetAttributes(transferTypeKey.String(string(transfer.Type)))
+	if err := h.config.CheckRecordedCoordinator(ctx, transfer.ClaimCoordinatorIdentityPubkey); err != nil {
+		return err
+	}
 
 	leaves, err := transfer.QueryTransferLeaves().All(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to get leaves from transfer %s: %v", req.TransferId, err)
+		return fmt.Errorf("unable to get leaves from transfer %s: %w", req.TransferId, err)
 	}
 
 	if req.TweakKey {
 		for _, leaf := range leaves {
 			treeNode, err := leaf.QueryLeaf().Only(ctx)
 			if err != nil {
-				return fmt.Errorf("unable to get tree node for leaf %s: %v", leaf.ID.String(), err)
+				return fmt.Errorf("unable to get tree node for leaf %s: %w", leaf.ID.String(), err)
 			}
 			if len(leaf.KeyTweak) == 0 {
 				return fmt.Errorf("key tweak for leaf %s is not set", leaf.ID.String())
@@ -1079,20 +1151,20 @@ func (h *TransferHandler) SettleReceiverKeyTweak(ctx context.Context, req *pbint
 			keyTweakProto := &pb.ClaimLeafKeyTweak{}
 			err = proto.Unmarshal(leaf.KeyTweak, keyTweakProto)
 			if err != nil {
-				return fmt.Errorf("unable to unmarshal key tweak for leaf %s: %v", leaf.ID.String(), err)
+				return fmt.Errorf("unable to unmarshal key tweak for leaf %s: %w", leaf.ID.String(), err)
 			}
 			err = h.claimLeafTweakKey(ctx, treeNode, keyTweakProto, transfer.ReceiverIdentityPubkey)
 			if err != nil {
-				return fmt.Errorf("unable to claim leaf tweak key for leaf %s: %v", leaf.ID.String(), err)
+				return fmt.Errorf("unable to claim leaf tweak key for leaf %s: %w", leaf.ID.String(), err)
 			}
 			_, err = leaf.Update().SetKeyTweak(nil).Save(ctx)
 			if err != nil {
-				return fmt.Errorf("unable to update leaf key tweak %s: %v", leaf.ID.String(), err)
+				return fmt.Errorf("unable to update leaf key tweak %s: %w", leaf.ID.String(), err)
 			}
 		}
 		_, err = transfer.Update().SetStatus(schema.TransferStatusReceiverKeyTweakApplied).Save(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to update transfer status %s: %v", transfer.ID.String(), err)
+			return fmt.Errorf("unable to update transfer status %s: %w", transfer.ID.String(), err)
 		}
 	} else {
 		return h.revertClaimTransfer(ctx, transfer, leaves)
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryAllTransfersIncludeArchived(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:query_all_transfers_include_archived?mode=memory&_fk=1")
+	defer db.Close()
+	tx, err := db.Tx(context.Background())
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback() }()
+	ctx := context.WithValue(context.Background(), ent.TxKey, tx)
+	config := &so.Config{}
+
+	sender := bytes.Repeat([]byte{1}, 33)
+	now := time.Now()
+	var ids []string
+	for i := range 4 {
+		transfer, err := tx.Transfer.Create().
+			SetSenderIdentityPubkey(sender).
+			SetReceiverIdentityPubkey(bytes.Repeat([]byte{2}, 33)).
+			SetTotalValue(1000).
+			SetStatus(schema.TransferStatusCompleted).
+			SetType(schema.TransferTypeTransfer).
+			SetExpiryTime(now).
+			SetUpdateTime(now.Add(-time.Duration(i) * 24 * time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+		ids = append(ids, transfer.ID.String())
+	}
+	// The two oldest transfers are archived.
+	_, err = ent.ArchiveTransfers(ctx, now.Add(-36*time.Hour), 10)
+	require.NoError(t, err)
+
+	handler := NewTransferHandler(config)
+	query := func(includeArchived bool, offset int64) *pb.QueryTransfersResponse {
+		resp, err := handler.QueryAllTransfers(ctx, &pb.TransferFilter{
+			Participant:     &pb.TransferFilter_SenderIdentityPublicKey{SenderIdentityPublicKey: sender},
+			Limit:           3,
+			Offset:          offset,
+			IncludeArchived: includeArchived,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+	transferIDs := func(resp *pb.QueryTransfersResponse) []string {
+		var ids []string
+		for _, transfer := range resp.Transfers {
+			ids = append(ids, transfer.Id)
+		}
+		return ids
+	}
+
+	resp := query(false, 0)
+	require.Equal(t, ids[:2], transferIDs(resp))
+	require.Equal(t, int64(-1), resp.Offset)
+
+	// Archived transfers follow the others, newest first, across pages.
+	resp = query(true, 0)
+	require.Equal(t, ids[:3], transferIDs(resp))
+	require.Equal(t, int64(3), resp.Offset)
+	resp = query(true, resp.Offset)
+	require.Equal(t, ids[3:], transferIDs(resp))
+	require.Equal(t, int64(-1), resp.Offset)
+}
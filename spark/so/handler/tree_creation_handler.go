@@ -16,6 +16,7 @@ import (
 	"github.com/lightsparkdev/spark/so/ent"
 	"github.com/lightsparkdev/spark/so/ent/depositaddress"
 	"github.com/lightsparkdev/spark/so/ent/schema"
+	sparkerrors "github.com/lightsparkdev/spark/so/errors"
 	"github.com/lightsparkdev/spark/so/helper"
 )
 
@@ -239,6 +240,7 @@ func (h *TreeCreationHandler) createAddressNodeFromPrepareTreeAddressNode(
 			SetOwnerIdentityPubkey(userIdentityPublicKey).
 			SetOwnerSigningPubkey(node.UserPublicKey).
 			SetAddress(*depositAddress).
+			SetIsTreeAddress(true).
 			Save(ctx)
 		if err != nil {
 			return nil, err
@@ -312,13 +314,17 @@ func (h *TreeCreationHandler) PrepareTreeAddress(ctx context.Context, req *pb.Pr
 		return nil, err
 	}
 
+	if err := ensureAddressQuota(ctx, req.UserIdentityPublicKey, true, keyCount, h.config.Quotas.PendingTreeAddressLimit()); err != nil {
+		return nil, err
+	}
+
 	keyshares, err := ent.GetUnusedSigningKeyshares(ctx, h.db, h.config, keyCount)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(keyshares) < keyCount {
-		return nil, fmt.Errorf("not enough keyshares available, need: %d, available: %d", keyCount, len(keyshares))
+		return nil, sparkerrors.Reasonf(sparkerrors.ReasonKeysharePoolEmpty, "not enough keyshares available, need: %d, available: %d", keyCount, len(keyshares))
 	}
 
 	addressNode, err := h.createPrepareTreeAddressNodeFromAddressNode(ctx, req.Node)
@@ -336,7 +342,7 @@ func (h *TreeCreationHandler) PrepareTreeAddress(ctx context.Context, req *pb.Pr
 	}
 	// TODO: Extract the address signature from response and adds to the proofs.
 	_, err = helper.ExecuteTaskWithAllOperators(ctx, h.config, operatorSelection, func(ctx context.Context, operator *so.SigningOperator) (interface{}, error) {
-		conn, err := operator.NewGRPCConnection()
+		conn, err := h.config.NewOperatorConnection(operator)
 		if err != nil {
 			return nil, err
 		}
@@ -698,7 +704,7 @@ func (h *TreeCreationHandler) updateParentNodeStatus(ctx context.Context, parent
 
 	err = db.TreeNode.UpdateOneID(parentNodeID).SetStatus(schema.TreeNodeStatusSplitted).Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to update status of parent node: %v", err)
+		return fmt.Errorf("unable to update status of parent node: %w", err)
 	}
 	return nil
 }
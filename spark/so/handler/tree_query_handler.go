@@ -14,6 +14,7 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/treenode"
 	enttreenode "github.com/lightsparkdev/spark/so/ent/treenode"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // TreeQueryHandler handles queries related to tree nodes.
@@ -60,7 +61,7 @@ func (h *TreeQueryHandler) QueryNodes(ctx context.Context, req *pb.QueryNodesReq
 		for _, nodeID := range req.GetNodeIds().NodeIds {
 			nodeUUID, err := uuid.Parse(nodeID)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse node id as a uuid %s: %v", nodeID, err)
+				return nil, fmt.Errorf("unable to parse node id as a uuid %s: %w", nodeID, err)
 			}
 			nodeIDs = append(nodeIDs, nodeUUID)
 		}
@@ -76,7 +77,7 @@ func (h *TreeQueryHandler) QueryNodes(ctx context.Context, req *pb.QueryNodesReq
 	for _, node := range nodes {
 		protoNodeMap[node.ID.String()], err = node.MarshalSparkProto(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal node %s: %v", node.ID.String(), err)
+			return nil, fmt.Errorf("unable to marshal node %s: %w", node.ID.String(), err)
 		}
 		if req.IncludeParents {
 			err := getAncestorChain(ctx, db, node, protoNodeMap)
@@ -136,7 +137,7 @@ func getAncestorChain(ctx context.Context, db *ent.Tx, node *ent.TreeNode, nodeM
 	// Parent exists, continue search
 	nodeMap[parent.ID.String()], err = parent.MarshalSparkProto(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to marshal node %s: %v", parent.ID.String(), err)
+		return fmt.Errorf("unable to marshal node %s: %w", parent.ID.String(), err)
 	}
 
 	return getAncestorChain(ctx, db, parent, nodeMap)
@@ -168,12 +169,16 @@ func (h *TreeQueryHandler) QueryUnusedDepositAddresses(ctx context.Context, req
 				return nil, err
 			}
 			nodeIDStr := depositAddress.NodeID.String()
-			unusedDepositAddresses = append(unusedDepositAddresses, &pb.DepositAddressQueryResult{
+			result := &pb.DepositAddressQueryResult{
 				DepositAddress:       depositAddress.Address,
 				UserSigningPublicKey: depositAddress.OwnerSigningPubkey,
 				VerifyingPublicKey:   verifyingPublicKey,
 				LeafId:               &nodeIDStr,
-			})
+			}
+			if !depositAddress.ExpiryTime.IsZero() {
+				result.ExpiryTime = timestamppb.New(depositAddress.ExpiryTime)
+			}
+			unusedDepositAddresses = append(unusedDepositAddresses, result)
 		}
 	}
 
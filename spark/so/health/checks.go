@@ -0,0 +1,103 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	pbfrost "github.com/lightsparkdev/spark/proto/frost"
+	"github.com/lightsparkdev/spark/so"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Pinger is a database that can be pinged, such as a *sql.DB.
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// DatabaseCheck checks that the database accepts connections.
+func DatabaseCheck(db Pinger) func(context.Context) error {
+	return db.PingContext
+}
+
+// SignerCheck checks that the FROST signer answers an echo.
+func SignerCheck(conn grpc.ClientConnInterface) func(context.Context) error {
+	client := pbfrost.NewFrostServiceClient(conn)
+	return func(ctx context.Context) error {
+		_, err := client.Echo(ctx, &pbfrost.EchoRequest{Message: "health"})
+		return err
+	}
+}
+
+// BitcoindCheck checks that bitcoind answers RPCs.
+func BitcoindCheck(client *rpcclient.Client) func(context.Context) error {
+	return func(ctx context.Context) error {
+		// The RPC client does not take a context, so the probe stops waiting for it on timeout.
+		done := make(chan error, 1)
+		go func() {
+			_, err := client.GetBlockCount()
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// OperatorsCheck checks that enough operators are serving to reach the threshold of the operator
+// set, counting this one. Other operators are asked for the status of their whole server, which
+// does not depend on their own peers.
+func OperatorsCheck(config *so.Config) func(context.Context) error {
+	return func(ctx context.Context) error {
+		var mu sync.Mutex
+		var unreachable []string
+		var wg sync.WaitGroup
+		for identifier, operator := range config.SigningOperatorMap {
+			if identifier == config.Identifier {
+				continue
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := operatorCheck(ctx, config, operator); err != nil {
+					mu.Lock()
+					unreachable = append(unreachable, fmt.Sprintf("%d: %v", operator.ID, err))
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		serving := uint64(len(config.SigningOperatorMap) - len(unreachable))
+		if serving < config.Threshold {
+			sort.Strings(unreachable)
+			return fmt.Errorf("%d of %d operators are serving, below the threshold of %d: %s",
+				serving, len(config.SigningOperatorMap), config.Threshold, strings.Join(unreachable, "; "))
+		}
+		return nil
+	}
+}
+
+func operatorCheck(ctx context.Context, config *so.Config, operator *so.SigningOperator) error {
+	conn, err := config.NewOperatorConnection(operator)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	response, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: ServerService})
+	if err != nil {
+		return err
+	}
+	if response.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("operator is %s", response.Status)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/common/logging"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// The health services that do not match a gRPC service of the operator.
+const (
+	// ServerService is the status of the whole server, checked by default by health probes. It
+	// decides whether the operator is ready.
+	ServerService = ""
+	// OperatorService is the historical name of the status of the whole server.
+	OperatorService = "spark-operator"
+	// TokenService is the status of the token RPCs of spark.SparkService.
+	TokenService = "spark.SparkService.tokens"
+	// DepositService is the status of the deposit RPCs of spark.SparkService.
+	DepositService = "spark.SparkService.deposits"
+)
+
+var dependencyUpGauge metric.Int64Gauge
+
+func init() {
+	var err error
+	dependencyUpGauge, err = otel.Meter("health").Int64Gauge(
+		"spark_dependency_up",
+		metric.WithDescription("Whether the last probe of a dependency of the operator succeeded"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// Dependency is a system the operator depends on, such as the database or the signer.
+type Dependency struct {
+	Name string
+	// Check returns an error if the dependency cannot be used.
+	Check func(ctx context.Context) error
+}
+
+// Service is a health service, whose status is SERVING while all its dependencies are healthy.
+type Service struct {
+	Name         string
+	Dependencies []string
+}
+
+// Result is the result of the last probe of a dependency.
+type Result struct {
+	Healthy       bool      `json:"healthy"`
+	Error         string    `json:"error,omitempty"`
+	CheckedAt     time.Time `json:"checked_at"`
+	LatencyMillis int64     `json:"latency_ms"`
+}
+
+// Report is the readiness report of the operator.
+type Report struct {
+	Ready        bool              `json:"ready"`
+	Dependencies map[string]Result `json:"dependencies"`
+	Services     map[string]string `json:"services"`
+}
+
+// Monitor probes the dependencies of the operator, and sets the status of its services on a gRPC
+// health server from the results.
+type Monitor struct {
+	server       *health.Server
+	dependencies []Dependency
+	services     []Service
+
+	mu       sync.RWMutex
+	results  map[string]Result
+	statuses map[string]grpc_health_v1.HealthCheckResponse_ServingStatus
+}
+
+// NewMonitor creates a monitor of the dependencies for the services. Every service is NOT_SERVING
+// until the dependencies are first probed.
+func NewMonitor(server *health.Server, dependencies []Dependency, services []Service) *Monitor {
+	m := &Monitor{
+		server:       server,
+		dependencies: dependencies,
+		services:     services,
+		results:      make(map[string]Result),
+		statuses:     make(map[string]grpc_health_v1.HealthCheckResponse_ServingStatus),
+	}
+	for _, service := range services {
+		m.statuses[service.Name] = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		server.SetServingStatus(service.Name, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	}
+	return m
+}
+
+// Run probes the dependencies every interval until the context is done. Every service is then
+// NOT_SERVING for good, so that traffic drains while the server shuts down.
+func (m *Monitor) Run(ctx context.Context, interval time.Duration) {
+	m.Check(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			m.mu.Lock()
+			for name := range m.statuses {
+				m.statuses[name] = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			}
+			m.mu.Unlock()
+			m.server.Shutdown()
+			return
+		case <-ticker.C:
+			m.Check(ctx)
+		}
+	}
+}
+
+// Check probes every dependency once, concurrently, and updates the status of the services.
+func (m *Monitor) Check(ctx context.Context) {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	results := make([]Result, len(m.dependencies))
+	var wg sync.WaitGroup
+	for i, dependency := range m.dependencies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = probe(ctx, dependency)
+		}()
+	}
+	wg.Wait()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, dependency := range m.dependencies {
+		result := results[i]
+		previous, probed := m.results[dependency.Name]
+		switch {
+		case !result.Healthy && (!probed || previous.Healthy):
+			logger.Warn("Dependency is unhealthy", "dependency", dependency.Name, "error", result.Error)
+		case result.Healthy && probed && !previous.Healthy:
+			logger.Info("Dependency is healthy again", "dependency", dependency.Name)
+		}
+		m.results[dependency.Name] = result
+
+		up := int64(0)
+		if result.Healthy {
+			up = 1
+		}
+		dependencyUpGauge.Record(ctx, up, metric.WithAttributes(attribute.String("dependency", dependency.Name)))
+	}
+
+	for _, service := range m.services {
+		status := grpc_health_v1.HealthCheckResponse_SERVING
+		for _, name := range service.Dependencies {
+			if !m.results[name].Healthy {
+				status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+				break
+			}
+		}
+		m.statuses[service.Name] = status
+		// Once the server is shut down, the statuses are no longer changed.
+		m.server.SetServingStatus(service.Name, status)
+	}
+}
+
+// probe runs the check of the dependency with a timeout.
+func probe(ctx context.Context, dependency Dependency) Result {
+	ctx, cancel := context.WithTimeout(ctx, spark.HealthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := dependency.Check(ctx)
+	result := Result{
+		Healthy:       err == nil,
+		CheckedAt:     start,
+		LatencyMillis: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Report returns the last results of the probes and the status of every service.
+func (m *Monitor) Report() Report {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	report := Report{
+		Ready:        m.statuses[ServerService] == grpc_health_v1.HealthCheckResponse_SERVING,
+		Dependencies: make(map[string]Result, len(m.results)),
+		Services:     make(map[string]string, len(m.statuses)),
+	}
+	for name, result := range m.results {
+		report.Dependencies[name] = result
+	}
+	for name, status := range m.statuses {
+		if name != ServerService {
+			report.Services[name] = status.String()
+		}
+	}
+	return report
+}
+
+// ServeHTTP serves the readiness report as JSON, with status 503 while the operator is not ready.
+func (m *Monitor) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	report := m.Report()
+	w.Header().Set("Content-Type", "application/json")
+	if !report.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,76 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func servingStatus(t *testing.T, server *health.Server, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	response, err := server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return response.Status
+}
+
+func TestMonitor(t *testing.T) {
+	var lrc20Err error
+	server := health.NewServer()
+	monitor := NewMonitor(server,
+		[]Dependency{
+			{Name: "database", Check: func(context.Context) error { return nil }},
+			{Name: "lrc20/regtest", Check: func(context.Context) error { return lrc20Err }},
+		},
+		[]Service{
+			{Name: ServerService, Dependencies: []string{"database"}},
+			{Name: TokenService, Dependencies: []string{"database", "lrc20/regtest"}},
+			{Name: DepositService, Dependencies: []string{"database", "bitcoind/regtest"}},
+		},
+	)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ServerService))
+	require.False(t, monitor.Report().Ready)
+
+	monitor.Check(context.Background())
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, server, ServerService))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, server, TokenService))
+	// A dependency that is never probed is never healthy.
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, DepositService))
+
+	lrc20Err = errors.New("connection refused")
+	monitor.Check(context.Background())
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, server, ServerService))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, TokenService))
+
+	recorder := httptest.NewRecorder()
+	monitor.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/-/ready", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var report Report
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+	require.True(t, report.Ready)
+	require.True(t, report.Dependencies["database"].Healthy)
+	require.Equal(t, "connection refused", report.Dependencies["lrc20/regtest"].Error)
+	require.Equal(t, "NOT_SERVING", report.Services[TokenService])
+}
+
+func TestMonitorRunShutsDown(t *testing.T) {
+	server := health.NewServer()
+	monitor := NewMonitor(server,
+		[]Dependency{{Name: "database", Check: func(context.Context) error { return nil }}},
+		[]Service{{Name: ServerService, Dependencies: []string{"database"}}},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	monitor.Run(ctx, time.Hour)
+
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ServerService))
+	recorder := httptest.NewRecorder()
+	monitor.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/-/ready", nil))
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
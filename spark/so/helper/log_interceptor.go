@@ -66,6 +66,11 @@ func LogInterceptor(enableStats bool) grpc.UnaryServerInterceptor {
 		if ok {
 			logger.Info("grpc call started", "request", proto.MessageName(reqProto))
 		}
+		// Payloads are only logged when debugging, and never with their sensitive fields.
+		verbose := logger.Enabled(ctx, slog.LevelDebug)
+		if verbose {
+			logger.Debug("grpc request", "payload", logging.RedactedPayload(req))
+		}
 
 		startTime := time.Now()
 		response, err := handler(ctx, req)
@@ -82,6 +87,9 @@ func LogInterceptor(enableStats bool) grpc.UnaryServerInterceptor {
 			if ok {
 				logger.Info("grpc call successful", "response", proto.MessageName(responseProto), "duration", duration.Seconds())
 			}
+			if verbose {
+				logger.Debug("grpc response", "payload", logging.RedactedPayload(response))
+			}
 		}
 
 		return response, err
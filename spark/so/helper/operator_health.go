@@ -0,0 +1,124 @@
+package helper
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightsparkdev/spark"
+	"github.com/lightsparkdev/spark/so"
+)
+
+// OperatorHealth tracks how signing operators responded to recent signing requests, so that
+// signing can prefer the operators that are responding.
+type OperatorHealth struct {
+	mu       sync.Mutex
+	failures map[string]*operatorFailures
+	now      func() time.Time
+}
+
+type operatorFailures struct {
+	consecutive int
+	last        time.Time
+}
+
+// NewOperatorHealth creates an OperatorHealth where every operator is healthy.
+func NewOperatorHealth() *OperatorHealth {
+	return &OperatorHealth{
+		failures: make(map[string]*operatorFailures),
+		now:      time.Now,
+	}
+}
+
+// signingOperatorHealth is the health of the signing operators as seen by this operator when it
+// coordinates signing.
+var signingOperatorHealth = NewOperatorHealth()
+
+// RecordSuccess records that the operator responded correctly.
+func (h *OperatorHealth) RecordSuccess(identifier string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.failures, identifier)
+}
+
+// RecordFailure records that the operator failed to respond, or responded incorrectly.
+func (h *OperatorHealth) RecordFailure(identifier string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	failures, ok := h.failures[identifier]
+	if !ok {
+		failures = &operatorFailures{}
+		h.failures[identifier] = failures
+	}
+	failures.consecutive++
+	failures.last = h.now()
+}
+
+// Healthy returns whether the operator is healthy. An operator is unhealthy after
+// spark.SigningOperatorUnhealthyFailures consecutive failures, until
+// spark.SigningOperatorCooldown has passed since the last one.
+func (h *OperatorHealth) Healthy(identifier string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.healthy(identifier)
+}
+
+func (h *OperatorHealth) healthy(identifier string) bool {
+	failures, ok := h.failures[identifier]
+	if !ok {
+		return true
+	}
+	return failures.consecutive < spark.SigningOperatorUnhealthyFailures || h.now().Sub(failures.last) > spark.SigningOperatorCooldown
+}
+
+// SelectOperators selects threshold of the candidate operators, leaving out the excluded ones.
+// Healthy operators are picked first, in random order, then unhealthy ones, fewest consecutive
+// failures first, only if there are not enough healthy operators.
+func (h *OperatorHealth) SelectOperators(config *so.Config, candidates []string, threshold int, excluded map[string]error) (*OperatorSelection, error) {
+	available := make([]string, 0, len(candidates))
+	for _, identifier := range candidates {
+		if _, ok := excluded[identifier]; !ok {
+			available = append(available, identifier)
+		}
+	}
+	if len(available) < threshold {
+		return nil, fmt.Errorf("%d operators available for signing, fewer than the threshold %d", len(available), threshold)
+	}
+
+	// Fisher-Yates shuffle
+	for i := len(available) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return nil, err
+		}
+		available[i], available[j.Int64()] = available[j.Int64()], available[i]
+	}
+
+	h.mu.Lock()
+	rank := make(map[string]int, len(available))
+	for _, identifier := range available {
+		if !h.healthy(identifier) {
+			rank[identifier] = h.failures[identifier].consecutive
+		}
+	}
+	h.mu.Unlock()
+	sort.SliceStable(available, func(i, j int) bool {
+		return rank[available[i]] < rank[available[j]]
+	})
+
+	operators := make([]*so.SigningOperator, 0, threshold)
+	for _, identifier := range available[:threshold] {
+		operator, ok := config.SigningOperator(identifier)
+		if !ok {
+			return nil, fmt.Errorf("keyshare holder %s is in neither operator set", identifier)
+		}
+		operators = append(operators, operator)
+	}
+	return &OperatorSelection{
+		Option:       OperatorSelectionOptionPreSelected,
+		operatorList: &operators,
+	}, nil
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
+	"fmt"
 	"math/big"
+	"slices"
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/common/logging"
+	pbfrost "github.com/lightsparkdev/spark/proto/frost"
 	"github.com/lightsparkdev/spark/so"
 )
 
@@ -40,7 +44,8 @@ type OperatorSelection struct {
 func NewPreSelectedOperatorSelection(config *so.Config, operatorIDs []string) *OperatorSelection {
 	operators := make([]*so.SigningOperator, 0, len(operatorIDs))
 	for _, id := range operatorIDs {
-		operators = append(operators, config.SigningOperatorMap[id])
+		operator, _ := config.SigningOperator(id)
+		operators = append(operators, operator)
 	}
 	return &OperatorSelection{
 		Option:       OperatorSelectionOptionPreSelected,
@@ -48,6 +53,38 @@ func NewPreSelectedOperatorSelection(config *so.Config, operatorIDs []string) *O
 	}
 }
 
+// keyshareHolders returns the operators holding all of the given keyshares, and the number of
+// them needed to sign with every keyshare.
+func keyshareHolders(config *so.Config, keyPackages map[uuid.UUID]*pbfrost.KeyPackage) ([]string, int, error) {
+	if len(keyPackages) == 0 {
+		holders := make([]string, 0, len(config.SigningOperatorMap))
+		for identifier := range config.SigningOperatorMap {
+			holders = append(holders, identifier)
+		}
+		return holders, int(config.Threshold), nil
+	}
+
+	var holders []string
+	threshold := 0
+	for _, keyPackage := range keyPackages {
+		threshold = max(threshold, int(keyPackage.MinSigners))
+		if holders == nil {
+			for identifier := range keyPackage.PublicShares {
+				holders = append(holders, identifier)
+			}
+			continue
+		}
+		holders = slices.DeleteFunc(holders, func(identifier string) bool {
+			_, ok := keyPackage.PublicShares[identifier]
+			return !ok
+		})
+	}
+	if len(holders) < threshold {
+		return nil, 0, fmt.Errorf("keyshares have %d common holders, fewer than the threshold %d", len(holders), threshold)
+	}
+	return holders, threshold, nil
+}
+
 // OperatorCount returns the number of operators based on the option.
 func (o OperatorSelection) OperatorCount(config *so.Config) int {
 	switch o.Option {
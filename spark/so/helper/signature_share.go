@@ -0,0 +1,171 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/common"
+	"github.com/lightsparkdev/spark/common/logging"
+	pbcommon "github.com/lightsparkdev/spark/proto/common"
+	pbfrost "github.com/lightsparkdev/spark/proto/frost"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/objects"
+)
+
+// InvalidSignatureShareError is the error of an operator that returned a signature share that
+// does not verify against its public share.
+type InvalidSignatureShareError struct {
+	// OperatorIdentifier is the identifier of the operator that returned the signature share.
+	OperatorIdentifier string
+	// JobID is the ID of the signing job the signature share is for.
+	JobID string
+	// Reason is why the signature share is invalid.
+	Reason string
+}
+
+func (e *InvalidSignatureShareError) Error() string {
+	return fmt.Sprintf("operator %s returned an invalid signature share for job %s: %s", e.OperatorIdentifier, e.JobID, e.Reason)
+}
+
+// validateSignatureShares validates the signature shares of every operator with the signer, in a
+// single call, and returns an *InvalidSignatureShareError for every operator that returned a share
+// that is malformed or does not verify. Every invalid share is recorded as a signing incident.
+// Failures of the signer itself are returned as errors, and do not blame any operator.
+//
+// Signature shares of adaptor signing jobs are not validated, since the signer cannot validate
+// adaptor signature shares. They are only logged as skipped.
+func validateSignatureShares(
+	ctx context.Context,
+	config *so.Config,
+	jobs []*pbinternal.SigningJob,
+	keyPackages map[uuid.UUID]*pbfrost.KeyPackage,
+	shares map[string]map[string][]byte,
+) (map[string]error, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+	if len(shares) == 0 {
+		return nil, nil
+	}
+
+	type signatureShare struct {
+		identifier string
+		job        *pbinternal.SigningJob
+		keyshareID uuid.UUID
+		keyPackage *pbfrost.KeyPackage
+	}
+	var signatureShares []signatureShare
+	var requests []*pbfrost.ValidateSignatureShareRequest
+	var skippedJobIDs []string
+	for _, job := range jobs {
+		if len(job.AdaptorPublicKey) > 0 {
+			skippedJobIDs = append(skippedJobIDs, job.JobId)
+			continue
+		}
+		keyshareID, err := uuid.Parse(job.KeyshareId)
+		if err != nil {
+			return nil, err
+		}
+		keyPackage, ok := keyPackages[keyshareID]
+		if !ok {
+			return nil, fmt.Errorf("keyshare %s not found", keyshareID)
+		}
+		for identifier, operatorShares := range shares {
+			signatureShares = append(signatureShares, signatureShare{identifier: identifier, job: job, keyshareID: keyshareID, keyPackage: keyPackage})
+			requests = append(requests, &pbfrost.ValidateSignatureShareRequest{
+				Identifier:      identifier,
+				Role:            pbfrost.SigningRole_STATECHAIN,
+				Message:         job.Message,
+				SignatureShare:  operatorShares[job.JobId],
+				PublicShare:     keyPackage.PublicShares[identifier],
+				VerifyingKey:    job.VerifyingKey,
+				Commitments:     job.Commitments,
+				UserCommitments: job.UserCommitments,
+			})
+		}
+	}
+	if len(skippedJobIDs) > 0 {
+		logger.Info("Skipped validating the signature shares of adaptor signing jobs", "job_ids", skippedJobIDs)
+	}
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	conn, err := common.NewGRPCConnectionWithoutTLS(config.SignerAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	response, err := pbfrost.NewFrostServiceClient(conn).ValidateSignatureShares(ctx, &pbfrost.ValidateSignatureSharesRequest{
+		SignatureShares: requests,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate signature shares: %w", err)
+	}
+	if len(response.Results) != len(requests) {
+		return nil, fmt.Errorf("signer validated %d signature shares, expected %d", len(response.Results), len(requests))
+	}
+
+	invalid := make(map[string]error)
+	for i, result := range response.Results {
+		share := signatureShares[i]
+		// One invalid share is enough to fail the operator.
+		if result.Valid || invalid[share.identifier] != nil {
+			continue
+		}
+		signature := requests[i].SignatureShare
+		invalidErr := &InvalidSignatureShareError{
+			OperatorIdentifier: share.identifier,
+			JobID:              share.job.JobId,
+			Reason:             result.Reason,
+		}
+		logger.Error("Operator returned an invalid signature share", "operator", share.identifier, "job_id", share.job.JobId, "keyshare_id", share.keyshareID, "error", invalidErr)
+		signingOperatorHealth.RecordFailure(share.identifier)
+		if err := recordSigningIncident(ctx, share.identifier, share.job, share.keyshareID, share.keyPackage, signature, invalidErr.Reason); err != nil {
+			logger.Error("Failed to record signing incident", "operator", share.identifier, "job_id", share.job.JobId, "error", err)
+		}
+		invalid[share.identifier] = invalidErr
+	}
+	return invalid, nil
+}
+
+func recordSigningIncident(ctx context.Context, identifier string, job *pbinternal.SigningJob, keyshareID uuid.UUID, keyPackage *pbfrost.KeyPackage, share []byte, reason string) error {
+	commitments := make(map[string][]byte, len(job.Commitments))
+	for operatorID, commitmentProto := range job.Commitments {
+		commitment, err := signingCommitmentBytes(commitmentProto)
+		if err != nil {
+			return err
+		}
+		commitments[operatorID] = commitment
+	}
+	var userCommitment []byte
+	if job.UserCommitments != nil {
+		var err error
+		userCommitment, err = signingCommitmentBytes(job.UserCommitments)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := ent.RecordSigningIncident(ctx, &ent.SigningIncident{
+		OperatorIdentifier: identifier,
+		KeyshareID:         keyshareID,
+		JobID:              job.JobId,
+		Message:            job.Message,
+		VerifyingKey:       job.VerifyingKey,
+		PublicShare:        keyPackage.PublicShares[identifier],
+		SignatureShare:     share,
+		Commitments:        commitments,
+		UserCommitment:     userCommitment,
+		Reason:             reason,
+	})
+	return err
+}
+
+func signingCommitmentBytes(commitmentProto *pbcommon.SigningCommitment) ([]byte, error) {
+	commitment := objects.SigningCommitment{}
+	if err := commitment.UnmarshalProto(commitmentProto); err != nil {
+		return nil, err
+	}
+	return commitment.MarshalBinary(), nil
+}
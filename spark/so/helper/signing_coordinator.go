@@ -3,9 +3,16 @@ package helper
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/btcsuite/btcd/wire"
 	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
 	"github.com/lightsparkdev/spark/common"
 	"github.com/lightsparkdev/spark/common/logging"
 	"github.com/lightsparkdev/spark/so"
@@ -55,8 +62,8 @@ func (s *SigningResult) MarshalProto() (*pbspark.SigningResult, error) {
 
 // frostRound1 performs the first round of the Frost signing. It gathers the signing commitments from all operators.
 func frostRound1(ctx context.Context, config *so.Config, signingKeyshareIDs []uuid.UUID, operatorSelection *OperatorSelection) (map[string][]objects.SigningCommitment, error) {
-	return ExecuteTaskWithAllOperators(ctx, config, operatorSelection, func(ctx context.Context, operator *so.SigningOperator) ([]objects.SigningCommitment, error) {
-		conn, err := operator.NewGRPCConnection()
+	return executeSigningRound(ctx, config, operatorSelection, func(ctx context.Context, operator *so.SigningOperator) ([]objects.SigningCommitment, error) {
+		conn, err := config.NewOperatorConnection(operator)
 		if err != nil {
 			return nil, err
 		}
@@ -74,6 +81,9 @@ func frostRound1(ctx context.Context, config *so.Config, signingKeyshareIDs []uu
 		if err != nil {
 			return nil, err
 		}
+		if len(response.SigningCommitments) != len(signingKeyshareIDs) {
+			return nil, fmt.Errorf("expected %d signing commitments, got %d", len(signingKeyshareIDs), len(response.SigningCommitments))
+		}
 
 		commitments := make([]objects.SigningCommitment, len(response.SigningCommitments))
 		for i, commitment := range response.SigningCommitments {
@@ -88,54 +98,58 @@ func frostRound1(ctx context.Context, config *so.Config, signingKeyshareIDs []uu
 }
 
 // frostRound2 performs the second round of the Frost signing. It gathers the signature shares from all operators.
+// Every signature share is validated, and operators that return invalid shares fail the round like
+// operators that do not respond.
 func frostRound2(
 	ctx context.Context,
 	config *so.Config,
 	jobs []*SigningJob,
 	round1 map[string][]objects.SigningCommitment,
 	operatorSelection *OperatorSelection,
+	keyPackages map[uuid.UUID]*pbfrost.KeyPackage,
 ) (map[string]map[string][]byte, error) {
 	logger := logging.GetLoggerFromContext(ctx)
 	for _, job := range jobs {
 		logger.Info("FrostRound2 signing job message", "message", hex.EncodeToString(job.Message))
 		logger.Info("FrostRound2 signing job verifying key", "verifyingKey", hex.EncodeToString(job.VerifyingKey))
 	}
-	operatorResult, err := ExecuteTaskWithAllOperators(ctx, config, operatorSelection, func(ctx context.Context, operator *so.SigningOperator) (map[string][]byte, error) {
-		conn, err := operator.NewGRPCConnection()
-		if err != nil {
-			return nil, err
-		}
-		defer conn.Close()
 
-		commitmentsArray := common.MapOfArrayToArrayOfMap(round1)
-
-		signingJobs := make([]*pbinternal.SigningJob, len(jobs))
-		for i, job := range jobs {
-			commitments := make(map[string]*pbcommon.SigningCommitment)
-			for operatorID, commitment := range commitmentsArray[i] {
-				commitmentProto, err := commitment.MarshalProto()
-				if err != nil {
-					return nil, err
-				}
-				commitments[operatorID] = commitmentProto
-			}
-			var userCommitmentProto *pbcommon.SigningCommitment
-			if job.UserCommitment != nil {
-				userCommitmentProto, err = job.UserCommitment.MarshalProto()
-				if err != nil {
-					return nil, err
-				}
+	commitmentsArray := common.MapOfArrayToArrayOfMap(round1)
+	signingJobs := make([]*pbinternal.SigningJob, len(jobs))
+	for i, job := range jobs {
+		commitments := make(map[string]*pbcommon.SigningCommitment)
+		for operatorID, commitment := range commitmentsArray[i] {
+			commitmentProto, err := commitment.MarshalProto()
+			if err != nil {
+				return nil, err
 			}
-			signingJobs[i] = &pbinternal.SigningJob{
-				JobId:            job.JobID,
-				Message:          job.Message,
-				KeyshareId:       job.SigningKeyshareID.String(),
-				VerifyingKey:     job.VerifyingKey,
-				Commitments:      commitments,
-				UserCommitments:  userCommitmentProto,
-				AdaptorPublicKey: job.AdaptorPublicKey,
+			commitments[operatorID] = commitmentProto
+		}
+		var userCommitmentProto *pbcommon.SigningCommitment
+		if job.UserCommitment != nil {
+			var err error
+			userCommitmentProto, err = job.UserCommitment.MarshalProto()
+			if err != nil {
+				return nil, err
 			}
 		}
+		signingJobs[i] = &pbinternal.SigningJob{
+			JobId:            job.JobID,
+			Message:          job.Message,
+			KeyshareId:       job.SigningKeyshareID.String(),
+			VerifyingKey:     job.VerifyingKey,
+			Commitments:      commitments,
+			UserCommitments:  userCommitmentProto,
+			AdaptorPublicKey: job.AdaptorPublicKey,
+		}
+	}
+
+	operatorResult, err := executeSigningRound(ctx, config, operatorSelection, func(ctx context.Context, operator *so.SigningOperator) (map[string][]byte, error) {
+		conn, err := config.NewOperatorConnection(operator)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
 
 		client := pbinternal.NewSparkInternalServiceClient(conn)
 		response, err := client.FrostRound2(ctx, &pbinternal.FrostRound2Request{
@@ -149,13 +163,35 @@ func frostRound2(
 		for operatorID, result := range response.Results {
 			results[operatorID] = result.SignatureShare
 		}
+		for _, job := range jobs {
+			if len(results[job.JobID]) != 32 {
+				return nil, fmt.Errorf("invalid signature share for job %s", job.JobID)
+			}
+		}
 
 		return results, nil
 	})
-	if err != nil {
+	var failures *OperatorFailuresError
+	if err != nil && !errors.As(err, &failures) {
 		return nil, err
 	}
 
+	invalid, validationErr := validateSignatureShares(ctx, config, signingJobs, keyPackages, operatorResult)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	if len(invalid) > 0 {
+		if failures == nil {
+			failures = &OperatorFailuresError{Failures: make(map[string]error)}
+		}
+		for identifier, invalidErr := range invalid {
+			failures.Failures[identifier] = invalidErr
+		}
+	}
+	if failures != nil {
+		return nil, failures
+	}
+
 	result := common.SwapMapKeys(operatorResult)
 	return result, nil
 }
@@ -243,24 +279,136 @@ func SignFrost(
 	config *so.Config,
 	jobs []*SigningJob,
 ) ([]*SigningResult, error) {
-	selection := OperatorSelection{Option: OperatorSelectionOptionThreshold, Threshold: int(config.Threshold)}
+	logger := logging.GetLoggerFromContext(ctx)
 	signingKeyshareIDs := SigningKeyshareIDsFromSigningJobs(jobs)
 	signingKeyshares, err := ent.GetKeyPackages(ctx, config, signingKeyshareIDs)
 	if err != nil {
 		return nil, err
 	}
-	round1, err := frostRound1(ctx, config, signingKeyshareIDs, &selection)
+	holders, threshold, err := keyshareHolders(config, signingKeyshares)
 	if err != nil {
 		return nil, err
 	}
 
-	round2, err := frostRound2(ctx, config, jobs, round1, &selection)
+	// Operators that fail either round are replaced by other keyshare holders. Round 2 binds the
+	// nonces of round 1 to the signing job, so every retry of round 2 starts with fresh nonces.
+	failed := make(map[string]error)
+	for attempt := 1; attempt <= spark.SigningMaxAttempts; attempt++ {
+		selection, round1, err := frostRound1WithFailover(ctx, config, signingKeyshareIDs, holders, threshold, failed)
+		if err != nil {
+			return nil, err
+		}
+
+		round2, err := frostRound2(ctx, config, jobs, round1, selection, signingKeyshares)
+		var failures *OperatorFailuresError
+		if errors.As(err, &failures) {
+			logger.Warn("FROST round 2 failed, retrying with other operators", "attempt", attempt, "error", failures)
+			maps.Copy(failed, failures.Failures)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		round1Array := common.MapOfArrayToArrayOfMap(round1)
+		return prepareResults(config, selection, jobs, signingKeyshares, round1Array, round2)
+	}
+	return nil, fmt.Errorf("signing failed after %d attempts: %w", spark.SigningMaxAttempts, &OperatorFailuresError{Failures: failed})
+}
+
+// frostRound1WithFailover gathers signing commitments from a threshold of the keyshare holders,
+// leaving out the failed operators. Operators that fail round 1 are added to failed and replaced
+// by other holders, keeping the commitments of the operators that succeeded.
+func frostRound1WithFailover(
+	ctx context.Context,
+	config *so.Config,
+	signingKeyshareIDs []uuid.UUID,
+	holders []string,
+	threshold int,
+	failed map[string]error,
+) (*OperatorSelection, map[string][]objects.SigningCommitment, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+	round1 := make(map[string][]objects.SigningCommitment, threshold)
+	for attempt := 1; attempt <= spark.SigningMaxAttempts; attempt++ {
+		excluded := maps.Clone(failed)
+		for identifier := range round1 {
+			excluded[identifier] = nil
+		}
+		selection, err := signingOperatorHealth.SelectOperators(config, holders, threshold-len(round1), excluded)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", err, &OperatorFailuresError{Failures: failed})
+		}
+
+		commitments, err := frostRound1(ctx, config, signingKeyshareIDs, selection)
+		maps.Copy(round1, commitments)
+		var failures *OperatorFailuresError
+		if errors.As(err, &failures) {
+			logger.Warn("FROST round 1 failed, retrying with other operators", "attempt", attempt, "error", failures)
+			maps.Copy(failed, failures.Failures)
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return NewPreSelectedOperatorSelection(config, slices.Collect(maps.Keys(round1))), round1, nil
+	}
+	return nil, nil, fmt.Errorf("signing round 1 failed after %d attempts: %w", spark.SigningMaxAttempts, &OperatorFailuresError{Failures: failed})
+}
+
+// OperatorFailuresError is returned when some of the operators failed a signing round.
+type OperatorFailuresError struct {
+	// Failures is the error of every operator that failed, by operator identifier.
+	Failures map[string]error
+}
+
+func (e *OperatorFailuresError) Error() string {
+	identifiers := slices.Sorted(maps.Keys(e.Failures))
+	failures := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		failures[i] = fmt.Sprintf("operator %s: %v", identifier, e.Failures[identifier])
+	}
+	return strings.Join(failures, "; ")
+}
+
+// executeSigningRound executes the task with every selected operator, giving each operator
+// spark.SigningOperatorTimeout to respond, and records the health of the operators. Unlike
+// ExecuteTaskWithAllOperators, it returns the results of the operators that succeeded even if
+// others failed, along with an *OperatorFailuresError for the failed operators.
+func executeSigningRound[V any](ctx context.Context, config *so.Config, selection *OperatorSelection, task func(ctx context.Context, operator *so.SigningOperator) (V, error)) (map[string]V, error) {
+	var mu sync.Mutex
+	failures := make(map[string]error)
+	results, err := ExecuteTaskWithAllOperators(ctx, config, selection, func(ctx context.Context, operator *so.SigningOperator) (V, error) {
+		operatorCtx, cancel := context.WithTimeout(ctx, spark.SigningOperatorTimeout)
+		defer cancel()
+		result, err := task(operatorCtx, operator)
+		if err != nil {
+			// The operator is not to blame if the request itself was cancelled.
+			if ctx.Err() == nil {
+				signingOperatorHealth.RecordFailure(operator.Identifier)
+			}
+			mu.Lock()
+			failures[operator.Identifier] = err
+			mu.Unlock()
+			var zero V
+			return zero, nil
+		}
+		signingOperatorHealth.RecordSuccess(operator.Identifier)
+		return result, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	round1Array := common.MapOfArrayToArrayOfMap(round1)
-	return prepareResults(config, &selection, jobs, signingKeyshares, round1Array, round2)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if len(failures) > 0 {
+		for identifier := range failures {
+			delete(results, identifier)
+		}
+		return results, &OperatorFailuresError{Failures: failures}
+	}
+	return results, nil
 }
 
 func SignFrostWithPregeneratedNonce(ctx context.Context, config *so.Config, jobs []*SigningJobWithPregeneratedNonce) ([]*SigningResult, error) {
@@ -286,7 +434,7 @@ func SignFrostWithPregeneratedNonce(ctx context.Context, config *so.Config, jobs
 	}
 	selection := NewPreSelectedOperatorSelection(config, operatorIDs)
 
-	round2, err := frostRound2(ctx, config, signingJobs, round1, selection)
+	round2, err := frostRound2(ctx, config, signingJobs, round1, selection, signingKeyshares)
 	if err != nil {
 		return nil, err
 	}
@@ -332,8 +480,15 @@ func prepareResults(
 
 // GetSigningCommitments gets the signing commitments for the given keyshare ids.
 func GetSigningCommitments(ctx context.Context, config *so.Config, keyshareIDs []uuid.UUID) (map[string][]objects.SigningCommitment, error) {
-	selection := OperatorSelection{Option: OperatorSelectionOptionThreshold, Threshold: int(config.Threshold)}
-	round1, err := frostRound1(ctx, config, keyshareIDs, &selection)
+	keyPackages, err := ent.GetKeyPackages(ctx, config, keyshareIDs)
+	if err != nil {
+		return nil, err
+	}
+	holders, threshold, err := keyshareHolders(config, keyPackages)
+	if err != nil {
+		return nil, err
+	}
+	_, round1, err := frostRound1WithFailover(ctx, config, keyshareIDs, holders, threshold, make(map[string]error))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,357 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
+	pbcommon "github.com/lightsparkdev/spark/proto/common"
+	pbfrost "github.com/lightsparkdev/spark/proto/frost"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/utils"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeSigningOperator struct {
+	pbinternal.UnimplementedSparkInternalServiceServer
+
+	mu            sync.Mutex
+	failRound     int
+	invalidShares bool
+	round1Calls   int
+	round2Calls   int
+}
+
+// invalidSignatureShare is the signature share the fake signer rejects.
+var invalidSignatureShare = bytes.Repeat([]byte{0xff}, 32)
+
+type fakeSigner struct {
+	pbfrost.UnimplementedFrostServiceServer
+
+	mu    sync.Mutex
+	calls int
+	fail  bool
+}
+
+func (s *fakeSigner) ValidateSignatureShares(_ context.Context, req *pbfrost.ValidateSignatureSharesRequest) (*pbfrost.ValidateSignatureSharesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if s.fail {
+		return nil, status.Error(codes.Internal, "signer is unavailable")
+	}
+	results := make([]*pbfrost.SignatureShareValidation, len(req.SignatureShares))
+	for i, share := range req.SignatureShares {
+		results[i] = &pbfrost.SignatureShareValidation{Valid: true}
+		if bytes.Equal(share.SignatureShare, invalidSignatureShare) {
+			results[i] = &pbfrost.SignatureShareValidation{Reason: "Failed to verify signature share"}
+		}
+	}
+	return &pbfrost.ValidateSignatureSharesResponse{Results: results}, nil
+}
+
+func (o *fakeSigningOperator) FrostRound1(_ context.Context, req *pbinternal.FrostRound1Request) (*pbinternal.FrostRound1Response, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.round1Calls++
+	if o.failRound == 1 {
+		return nil, fmt.Errorf("round 1 failed")
+	}
+	commitments := make([]*pbcommon.SigningCommitment, len(req.KeyshareIds))
+	for i := range commitments {
+		commitments[i] = &pbcommon.SigningCommitment{
+			Hiding:  bytes.Repeat([]byte{2}, 33),
+			Binding: bytes.Repeat([]byte{3}, 33),
+		}
+	}
+	return &pbinternal.FrostRound1Response{SigningCommitments: commitments}, nil
+}
+
+func (o *fakeSigningOperator) FrostRound2(_ context.Context, req *pbinternal.FrostRound2Request) (*pbinternal.FrostRound2Response, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.round2Calls++
+	if o.failRound == 2 {
+		return nil, fmt.Errorf("round 2 failed")
+	}
+	share := bytes.Repeat([]byte{1}, 32)
+	if o.invalidShares {
+		share = invalidSignatureShare
+	}
+	results := make(map[string]*pbcommon.SigningResult, len(req.SigningJobs))
+	for _, job := range req.SigningJobs {
+		results[job.JobId] = &pbcommon.SigningResult{SignatureShare: share}
+	}
+	return &pbinternal.FrostRound2Response{Results: results}, nil
+}
+
+func startTestServer(t *testing.T, register func(*grpc.Server)) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	register(server)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+// newSigningTestOperators starts a fake signing operator for each of the given rounds to fail, 0
+// for none, and returns the config of the first operator along with a keyshare held by all of them.
+func newSigningTestOperators(t *testing.T, failRounds []int) (context.Context, *so.Config, []*fakeSigningOperator, uuid.UUID) {
+	previousHealth := signingOperatorHealth
+	signingOperatorHealth = NewOperatorHealth()
+	t.Cleanup(func() { signingOperatorHealth = previousHealth })
+
+	fakes := make([]*fakeSigningOperator, len(failRounds))
+	operatorMap := make(map[string]*so.SigningOperator, len(failRounds))
+	publicShares := make(map[string][]byte, len(failRounds))
+	for i, failRound := range failRounds {
+		fakes[i] = &fakeSigningOperator{failRound: failRound}
+		address := startTestServer(t, func(server *grpc.Server) {
+			pbinternal.RegisterSparkInternalServiceServer(server, fakes[i])
+		})
+
+		identifier := utils.IndexToIdentifier(uint64(i))
+		operatorMap[identifier] = &so.SigningOperator{
+			ID:         uint64(i),
+			Identifier: identifier,
+			Address:    address,
+		}
+		publicShares[identifier] = bytes.Repeat([]byte{byte(i)}, 33)
+	}
+	config := &so.Config{
+		Index:              0,
+		Identifier:         utils.IndexToIdentifier(0),
+		SigningOperatorMap: operatorMap,
+		Threshold:          2,
+		SignerAddress: startTestServer(t, func(server *grpc.Server) {
+			pbfrost.RegisterFrostServiceServer(server, &fakeSigner{})
+		}),
+	}
+
+	db := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&_fk=1", t.Name()))
+	t.Cleanup(func() { db.Close() })
+	tx, err := db.Tx(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	ctx := context.WithValue(context.Background(), ent.TxKey, tx)
+	keyshare, err := tx.SigningKeyshare.Create().
+		SetStatus(schema.KeyshareStatusInUse).
+		SetSecretShare(bytes.Repeat([]byte{1}, 32)).
+		SetPublicShares(publicShares).
+		SetPublicKey(bytes.Repeat([]byte{2}, 33)).
+		SetMinSigners(2).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+	return ctx, config, fakes, keyshare.ID
+}
+
+func TestSignFrostFailsOverInRound2(t *testing.T) {
+	ctx, config, fakes, keyshareID := newSigningTestOperators(t, []int{0, 0, 2})
+	// Make operator 1 unhealthy so that the failing operator 2 is selected first.
+	for range 3 {
+		signingOperatorHealth.RecordFailure(utils.IndexToIdentifier(1))
+	}
+
+	results, err := SignFrost(ctx, config, []*SigningJob{{
+		JobID:             uuid.NewString(),
+		SigningKeyshareID: keyshareID,
+		Message:           []byte("message"),
+		VerifyingKey:      bytes.Repeat([]byte{2}, 33),
+	}})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.ElementsMatch(t, []string{utils.IndexToIdentifier(0), utils.IndexToIdentifier(1)}, slices.Collect(maps.Keys(results[0].SignatureShares)))
+	require.ElementsMatch(t, []string{utils.IndexToIdentifier(0), utils.IndexToIdentifier(1)}, slices.Collect(maps.Keys(results[0].SigningCommitments)))
+
+	require.Equal(t, 1, fakes[2].round2Calls)
+	require.Equal(t, 1, signingOperatorHealth.failures[utils.IndexToIdentifier(2)].consecutive)
+	require.True(t, signingOperatorHealth.Healthy(utils.IndexToIdentifier(1)))
+	// Round 2 binds the nonces, so the retry starts over with fresh ones.
+	require.Equal(t, 2, fakes[0].round1Calls)
+}
+
+func TestSignFrostBlamesInvalidSignatureShares(t *testing.T) {
+	ctx, config, fakes, keyshareID := newSigningTestOperators(t, []int{0, 0, 0})
+	fakes[2].invalidShares = true
+	culprit := utils.IndexToIdentifier(2)
+	for range 3 {
+		signingOperatorHealth.RecordFailure(utils.IndexToIdentifier(1))
+	}
+
+	jobID := uuid.NewString()
+	results, err := SignFrost(ctx, config, []*SigningJob{{
+		JobID:             jobID,
+		SigningKeyshareID: keyshareID,
+		Message:           []byte("message"),
+		VerifyingKey:      bytes.Repeat([]byte{2}, 33),
+	}})
+	require.NoError(t, err)
+	require.NotContains(t, results[0].SignatureShares, culprit)
+
+	incidents, err := ent.GetDbFromContext(ctx).SigningIncident.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, incidents, 1)
+	require.Equal(t, culprit, incidents[0].OperatorIdentifier)
+	require.Equal(t, keyshareID, incidents[0].KeyshareID)
+	require.Equal(t, jobID, incidents[0].JobID)
+	require.Equal(t, invalidSignatureShare, incidents[0].SignatureShare)
+	require.Equal(t, []byte("message"), incidents[0].Message)
+	require.Len(t, incidents[0].Commitments, 2)
+
+	// Without enough other operators, the error names the operator.
+	fakes[0].mu.Lock()
+	fakes[0].invalidShares = true
+	fakes[0].mu.Unlock()
+	_, err = SignFrost(ctx, config, []*SigningJob{{
+		JobID:             uuid.NewString(),
+		SigningKeyshareID: keyshareID,
+		Message:           []byte("message"),
+		VerifyingKey:      bytes.Repeat([]byte{2}, 33),
+	}})
+	var failures *OperatorFailuresError
+	require.ErrorAs(t, err, &failures)
+	var invalidErr *InvalidSignatureShareError
+	require.ErrorAs(t, failures.Failures[utils.IndexToIdentifier(0)], &invalidErr)
+	require.Equal(t, utils.IndexToIdentifier(0), invalidErr.OperatorIdentifier)
+}
+
+func TestSignFrostValidatesSignatureSharesInOneCall(t *testing.T) {
+	ctx, config, _, keyshareID := newSigningTestOperators(t, []int{0, 0, 0})
+	signer := &fakeSigner{}
+	config.SignerAddress = startTestServer(t, func(server *grpc.Server) {
+		pbfrost.RegisterFrostServiceServer(server, signer)
+	})
+	jobs := make([]*SigningJob, 3)
+	for i := range jobs {
+		jobs[i] = &SigningJob{
+			JobID:             uuid.NewString(),
+			SigningKeyshareID: keyshareID,
+			Message:           []byte("message"),
+			VerifyingKey:      bytes.Repeat([]byte{2}, 33),
+		}
+	}
+
+	_, err := SignFrost(ctx, config, jobs)
+	require.NoError(t, err)
+	require.Equal(t, 1, signer.calls)
+
+	// Failures of the signer are not blamed on the operators.
+	signer.mu.Lock()
+	signer.fail = true
+	signer.mu.Unlock()
+	_, err = SignFrost(ctx, config, jobs)
+	require.ErrorContains(t, err, "signer is unavailable")
+	var failures *OperatorFailuresError
+	require.False(t, errors.As(err, &failures))
+	for identifier := range config.SigningOperatorMap {
+		require.True(t, signingOperatorHealth.Healthy(identifier))
+	}
+	incidents, err := ent.GetDbFromContext(ctx).SigningIncident.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, incidents)
+}
+
+func TestGetSigningCommitmentsFailsOverInRound1(t *testing.T) {
+	ctx, config, fakes, keyshareID := newSigningTestOperators(t, []int{0, 1, 1})
+	now := time.Now()
+	signingOperatorHealth.now = func() time.Time { return now }
+	round1Calls := func() []int {
+		calls := make([]int, len(fakes))
+		for i, fake := range fakes {
+			fake.mu.Lock()
+			calls[i] = fake.round1Calls
+			fake.round1Calls = 0
+			fake.mu.Unlock()
+		}
+		return calls
+	}
+	makeUnhealthy := func(index uint64) {
+		for range spark.SigningOperatorUnhealthyFailures {
+			signingOperatorHealth.RecordFailure(utils.IndexToIdentifier(index))
+		}
+	}
+	// Make operator 2 unhealthy so that operators 0 and 1 are selected first.
+	makeUnhealthy(2)
+
+	// Operator 1 fails, and operator 2 is tried in its place and fails too, so only operator 0
+	// responds, fewer than the threshold. Operator 0 keeps its commitments and is not asked again.
+	commitments, err := GetSigningCommitments(ctx, config, []uuid.UUID{keyshareID})
+	require.ErrorContains(t, err, "fewer than the threshold")
+	require.Nil(t, commitments)
+	require.Equal(t, []int{1, 1, 1}, round1Calls())
+
+	// Once operator 2 responds, it replaces operator 1.
+	fakes[2].mu.Lock()
+	fakes[2].failRound = 0
+	fakes[2].mu.Unlock()
+	commitments, err = GetSigningCommitments(ctx, config, []uuid.UUID{keyshareID})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{utils.IndexToIdentifier(0), utils.IndexToIdentifier(2)}, slices.Collect(maps.Keys(commitments)))
+	require.Equal(t, []int{1, 1, 1}, round1Calls())
+	require.True(t, signingOperatorHealth.Healthy(utils.IndexToIdentifier(2)))
+
+	// An unhealthy operator is not asked while enough healthy operators respond.
+	makeUnhealthy(1)
+	commitments, err = GetSigningCommitments(ctx, config, []uuid.UUID{keyshareID})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{utils.IndexToIdentifier(0), utils.IndexToIdentifier(2)}, slices.Collect(maps.Keys(commitments)))
+	require.Equal(t, []int{1, 0, 1}, round1Calls())
+
+	// After the cooldown, it is tried again before the operators that failed since.
+	now = now.Add(spark.SigningOperatorCooldown + time.Second)
+	makeUnhealthy(2)
+	commitments, err = GetSigningCommitments(ctx, config, []uuid.UUID{keyshareID})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{utils.IndexToIdentifier(0), utils.IndexToIdentifier(2)}, slices.Collect(maps.Keys(commitments)))
+	require.Equal(t, []int{1, 1, 1}, round1Calls())
+}
+
+func TestSelectOperatorsPrefersHealthyOperators(t *testing.T) {
+	_, config, _, _ := newSigningTestOperators(t, []int{0, 0, 0})
+	candidates := slices.Collect(maps.Keys(config.SigningOperatorMap))
+	unhealthy := utils.IndexToIdentifier(0)
+	for range 3 {
+		signingOperatorHealth.RecordFailure(unhealthy)
+	}
+	require.False(t, signingOperatorHealth.Healthy(unhealthy))
+
+	for range 10 {
+		selection, err := signingOperatorHealth.SelectOperators(config, candidates, 2, nil)
+		require.NoError(t, err)
+		operators, err := selection.OperatorList(config)
+		require.NoError(t, err)
+		require.Len(t, operators, 2)
+		for _, operator := range operators {
+			require.NotEqual(t, unhealthy, operator.Identifier)
+		}
+	}
+
+	// An unhealthy operator is still selected if it is needed to reach the threshold.
+	selection, err := signingOperatorHealth.SelectOperators(config, candidates, 2, map[string]error{utils.IndexToIdentifier(1): nil})
+	require.NoError(t, err)
+	operators, err := selection.OperatorList(config)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{unhealthy, utils.IndexToIdentifier(2)}, []string{operators[0].Identifier, operators[1].Identifier})
+
+	_, err = signingOperatorHealth.SelectOperators(config, candidates, 3, map[string]error{unhealthy: nil})
+	require.ErrorContains(t, err, "fewer than the threshold")
+}
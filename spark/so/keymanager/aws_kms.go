@@ -0,0 +1,65 @@
+package keymanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+)
+
+// kmsEncryptionContext is bound to every data key wrapped by KMS, so that the wrapped keys cannot
+// be decrypted through KMS for another purpose.
+var kmsEncryptionContext = map[string]string{"purpose": "spark-operator-data-key"}
+
+// AWSKMSKeyManager wraps data keys with a symmetric AWS KMS key. Key versions are KMS key IDs.
+type AWSKMSKeyManager struct {
+	client *kms.Client
+	keyID  string
+}
+
+// NewAWSKMSKeyManager creates an AWSKMSKeyManager that wraps new data keys with the given KMS key.
+// Credentials and region are taken from the environment.
+func NewAWSKMSKeyManager(ctx context.Context, keyID string) (*AWSKMSKeyManager, error) {
+	if keyID == "" {
+		return nil, errors.New("aws_kms_key_id is required")
+	}
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	return &AWSKMSKeyManager{client: kms.NewFromConfig(cfg), keyID: keyID}, nil
+}
+
+// CurrentKeyVersion implements KeyManager.
+func (m *AWSKMSKeyManager) CurrentKeyVersion() string {
+	return m.keyID
+}
+
+// EncryptDataKey implements KeyManager.
+func (m *AWSKMSKeyManager) EncryptDataKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	out, err := m.client.Encrypt(ctx, &kms.EncryptInput{
+		KeyId:             aws.String(m.keyID),
+		Plaintext:         dataKey,
+		EncryptionContext: kmsEncryptionContext,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt data key with KMS: %w", err)
+	}
+	return out.CiphertextBlob, nil
+}
+
+// DecryptDataKey implements KeyManager.
+func (m *AWSKMSKeyManager) DecryptDataKey(ctx context.Context, wrappedKey []byte, keyVersion string) ([]byte, error) {
+	out, err := m.client.Decrypt(ctx, &kms.DecryptInput{
+		KeyId:             aws.String(keyVersion),
+		CiphertextBlob:    wrappedKey,
+		EncryptionContext: kmsEncryptionContext,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key with KMS: %w", err)
+	}
+	return out.Plaintext, nil
+}
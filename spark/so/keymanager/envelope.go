@@ -0,0 +1,181 @@
+package keymanager
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+)
+
+const (
+	dataKeySize = 32
+	// dataKeyCacheTTL bounds how long unwrapped data keys stay in memory. Caching them saves a call
+	// to the key manager every time a secret is read.
+	dataKeyCacheTTL = 10 * time.Minute
+	// maxBatchSecrets is the most secrets sealed with the data key of a batch. Secrets are sealed
+	// with random nonces, which only stay unique with overwhelming probability for far fewer
+	// encryptions under one key than the 2^32 AES-GCM allows.
+	maxBatchSecrets = 1 << 20
+)
+
+// Sealed is a secret encrypted by an Envelope, together with what is needed to decrypt it.
+type Sealed struct {
+	// Ciphertext is the secret encrypted with AES-256-GCM under the data key.
+	Ciphertext []byte
+	// WrappedKey is the data key, wrapped by the key manager.
+	WrappedKey []byte
+	// KeyVersion is the version of the key encryption key that wrapped the data key.
+	KeyVersion string
+}
+
+// Envelope encrypts secrets with data keys wrapped by a KeyManager: every secret with a new data
+// key, or the secrets of a DataKeyBatch with the data key of the batch.
+type Envelope struct {
+	keyManager KeyManager
+	dataKeys   *cache.Cache
+	// rewrapped holds the data keys rewrapped with the current key encryption key, by the wrapped
+	// key they replace, so that the secrets of a batch share the rewrapped key as well.
+	rewrapped *cache.Cache
+}
+
+// NewEnvelope creates a new Envelope that wraps data keys with the given key manager.
+func NewEnvelope(keyManager KeyManager) *Envelope {
+	return &Envelope{
+		keyManager: keyManager,
+		dataKeys:   cache.New(dataKeyCacheTTL, dataKeyCacheTTL),
+		rewrapped:  cache.New(dataKeyCacheTTL, dataKeyCacheTTL),
+	}
+}
+
+// DataKeyBatch is a data key shared by secrets sealed together, such as the rows written by one
+// transaction, so that sealing them calls the key manager once rather than once per secret. Each
+// secret is still encrypted with its own nonce and bound to its own additional data.
+type DataKeyBatch struct {
+	mu         sync.Mutex
+	dataKey    []byte
+	wrappedKey []byte
+	keyVersion string
+	sealed     int
+}
+
+// NewDataKeyBatch creates a batch, whose data key is created by the first secret sealed in it.
+func NewDataKeyBatch() *DataKeyBatch {
+	return &DataKeyBatch{}
+}
+
+// KeyVersion returns the version of the key encryption key new secrets are sealed with.
+func (e *Envelope) KeyVersion() string {
+	return e.keyManager.CurrentKeyVersion()
+}
+
+// Seal encrypts a secret under a new data key. The additional data is authenticated but not
+// encrypted, and must be passed again to open the secret; it binds the secret to where it is
+// stored so that it cannot be moved elsewhere.
+func (e *Envelope) Seal(ctx context.Context, plaintext []byte, additionalData []byte) (*Sealed, error) {
+	dataKey, wrappedKey, keyVersion, err := e.newDataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sealWithDataKey(dataKey, wrappedKey, keyVersion, plaintext, additionalData)
+}
+
+// SealInBatch encrypts a secret like Seal, under the data key of the batch. The batch gets a new
+// data key when it has none yet, or has sealed maxBatchSecrets secrets with it.
+func (e *Envelope) SealInBatch(ctx context.Context, batch *DataKeyBatch, plaintext []byte, additionalData []byte) (*Sealed, error) {
+	batch.mu.Lock()
+	if batch.dataKey == nil || batch.sealed >= maxBatchSecrets {
+		dataKey, wrappedKey, keyVersion, err := e.newDataKey(ctx)
+		if err != nil {
+			batch.mu.Unlock()
+			return nil, err
+		}
+		batch.dataKey, batch.wrappedKey, batch.keyVersion, batch.sealed = dataKey, wrappedKey, keyVersion, 0
+	}
+	batch.sealed++
+	dataKey, wrappedKey, keyVersion := batch.dataKey, batch.wrappedKey, batch.keyVersion
+	batch.mu.Unlock()
+	return sealWithDataKey(dataKey, wrappedKey, keyVersion, plaintext, additionalData)
+}
+
+// newDataKey creates a data key, wraps it with the current key encryption key and caches it.
+func (e *Envelope) newDataKey(ctx context.Context) ([]byte, []byte, string, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, "", err
+	}
+	keyVersion := e.keyManager.CurrentKeyVersion()
+	wrappedKey, err := e.keyManager.EncryptDataKey(ctx, dataKey)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	e.dataKeys.SetDefault(dataKeyCacheKey(wrappedKey, keyVersion), dataKey)
+	return dataKey, wrappedKey, keyVersion, nil
+}
+
+func sealWithDataKey(dataKey []byte, wrappedKey []byte, keyVersion string, plaintext []byte, additionalData []byte) (*Sealed, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := seal(aead, plaintext, additionalData)
+	if err != nil {
+		return nil, err
+	}
+	return &Sealed{Ciphertext: ciphertext, WrappedKey: wrappedKey, KeyVersion: keyVersion}, nil
+}
+
+// Open decrypts a sealed secret.
+func (e *Envelope) Open(ctx context.Context, sealed *Sealed, additionalData []byte) ([]byte, error) {
+	dataKey, err := e.dataKey(ctx, sealed.WrappedKey, sealed.KeyVersion)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return open(aead, sealed.Ciphertext, additionalData)
+}
+
+// Rewrap wraps the data key of a secret with the current key encryption key, without
+// re-encrypting the secret itself. It returns the new wrapped key and its key version. Secrets
+// sharing a data key get the same new wrapped key while it is cached.
+func (e *Envelope) Rewrap(ctx context.Context, wrappedKey []byte, keyVersion string) ([]byte, string, error) {
+	newKeyVersion := e.keyManager.CurrentKeyVersion()
+	cacheKey := dataKeyCacheKey(wrappedKey, keyVersion)
+	if rewrapped, ok := e.rewrapped.Get(cacheKey); ok {
+		if sealed := rewrapped.(*Sealed); sealed.KeyVersion == newKeyVersion {
+			return sealed.WrappedKey, sealed.KeyVersion, nil
+		}
+	}
+	dataKey, err := e.dataKey(ctx, wrappedKey, keyVersion)
+	if err != nil {
+		return nil, "", err
+	}
+	newWrappedKey, err := e.keyManager.EncryptDataKey(ctx, dataKey)
+	if err != nil {
+		return nil, "", err
+	}
+	e.dataKeys.SetDefault(dataKeyCacheKey(newWrappedKey, newKeyVersion), dataKey)
+	e.rewrapped.SetDefault(cacheKey, &Sealed{WrappedKey: newWrappedKey, KeyVersion: newKeyVersion})
+	return newWrappedKey, newKeyVersion, nil
+}
+
+func (e *Envelope) dataKey(ctx context.Context, wrappedKey []byte, keyVersion string) ([]byte, error) {
+	cacheKey := dataKeyCacheKey(wrappedKey, keyVersion)
+	if dataKey, ok := e.dataKeys.Get(cacheKey); ok {
+		return dataKey.([]byte), nil
+	}
+	dataKey, err := e.keyManager.DecryptDataKey(ctx, wrappedKey, keyVersion)
+	if err != nil {
+		return nil, err
+	}
+	e.dataKeys.SetDefault(cacheKey, dataKey)
+	return dataKey, nil
+}
+
+func dataKeyCacheKey(wrappedKey []byte, keyVersion string) string {
+	return keyVersion + "/" + string(wrappedKey)
+}
@@ -0,0 +1,142 @@
+package keymanager
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyManager(t *testing.T, currentVersion string) *LocalKeyManager {
+	keyManager, err := NewLocalKeyManager(map[string][]byte{
+		"v1": bytes.Repeat([]byte{1}, 32),
+		"v2": bytes.Repeat([]byte{2}, 32),
+	}, currentVersion)
+	require.NoError(t, err)
+	return keyManager
+}
+
+func TestEnvelopeSealOpen(t *testing.T) {
+	ctx := context.Background()
+	envelope := NewEnvelope(newTestKeyManager(t, "v1"))
+	secret := []byte("secret share")
+
+	sealed, err := envelope.Seal(ctx, secret, []byte("signing_keyshares/1"))
+	require.NoError(t, err)
+	require.Equal(t, "v1", sealed.KeyVersion)
+	require.NotContains(t, string(sealed.Ciphertext), string(secret))
+
+	opened, err := envelope.Open(ctx, sealed, []byte("signing_keyshares/1"))
+	require.NoError(t, err)
+	require.Equal(t, secret, opened)
+
+	// A secret cannot be opened in another place than where it was sealed.
+	_, err = envelope.Open(ctx, sealed, []byte("signing_keyshares/2"))
+	require.Error(t, err)
+
+	// Every secret gets its own data key.
+	other, err := envelope.Seal(ctx, secret, []byte("signing_keyshares/1"))
+	require.NoError(t, err)
+	require.NotEqual(t, sealed.WrappedKey, other.WrappedKey)
+}
+
+func TestEnvelopeRewrap(t *testing.T) {
+	ctx := context.Background()
+	sealed, err := NewEnvelope(newTestKeyManager(t, "v1")).Seal(ctx, []byte("nonce"), nil)
+	require.NoError(t, err)
+
+	// A fresh envelope has no cached data keys, so it has to unwrap with the old version.
+	rotated := NewEnvelope(newTestKeyManager(t, "v2"))
+	wrappedKey, keyVersion, err := rotated.Rewrap(ctx, sealed.WrappedKey, sealed.KeyVersion)
+	require.NoError(t, err)
+	require.Equal(t, "v2", keyVersion)
+
+	_, err = newTestKeyManager(t, "v2").DecryptDataKey(ctx, wrappedKey, "v1")
+	require.Error(t, err)
+
+	opened, err := NewEnvelope(newTestKeyManager(t, "v2")).Open(ctx, &Sealed{
+		Ciphertext: sealed.Ciphertext,
+		WrappedKey: wrappedKey,
+		KeyVersion: keyVersion,
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("nonce"), opened)
+}
+
+func TestNewLocalKeyManagerRequiresCurrentVersion(t *testing.T) {
+	_, err := NewLocalKeyManager(map[string][]byte{"v1": bytes.Repeat([]byte{1}, 32)}, "v2")
+	require.Error(t, err)
+	_, err = NewLocalKeyManager(map[string][]byte{"v1": {1}}, "v1")
+	require.Error(t, err)
+}
+
+// countingKeyManager counts the data keys wrapped by a key manager.
+type countingKeyManager struct {
+	KeyManager
+	encrypted int
+}
+
+func (k *countingKeyManager) EncryptDataKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	k.encrypted++
+	return k.KeyManager.EncryptDataKey(ctx, dataKey)
+}
+
+func TestEnvelopeSealInBatch(t *testing.T) {
+	ctx := context.Background()
+	keyManager := &countingKeyManager{KeyManager: newTestKeyManager(t, "v1")}
+	envelope := NewEnvelope(keyManager)
+	batch := NewDataKeyBatch()
+
+	first, err := envelope.SealInBatch(ctx, batch, []byte("first"), []byte("signing_nonces/1"))
+	require.NoError(t, err)
+	second, err := envelope.SealInBatch(ctx, batch, []byte("second"), []byte("signing_nonces/2"))
+	require.NoError(t, err)
+	// The secrets of a batch share one data key, wrapped once.
+	require.Equal(t, 1, keyManager.encrypted)
+	require.Equal(t, first.WrappedKey, second.WrappedKey)
+
+	// Each is still bound to where it is stored, and opens without the batch.
+	fresh := NewEnvelope(newTestKeyManager(t, "v1"))
+	opened, err := fresh.Open(ctx, second, []byte("signing_nonces/2"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("second"), opened)
+	_, err = fresh.Open(ctx, second, []byte("signing_nonces/1"))
+	require.Error(t, err)
+
+	// Rewrapping the key of the batch for each of its secrets wraps it once.
+	rotatedKeyManager := &countingKeyManager{KeyManager: newTestKeyManager(t, "v2")}
+	rotated := NewEnvelope(rotatedKeyManager)
+	firstKey, _, err := rotated.Rewrap(ctx, first.WrappedKey, first.KeyVersion)
+	require.NoError(t, err)
+	secondKey, _, err := rotated.Rewrap(ctx, second.WrappedKey, second.KeyVersion)
+	require.NoError(t, err)
+	require.Equal(t, firstKey, secondKey)
+	require.Equal(t, 1, rotatedKeyManager.encrypted)
+
+	// Another batch gets another data key.
+	other, err := envelope.SealInBatch(ctx, NewDataKeyBatch(), []byte("third"), nil)
+	require.NoError(t, err)
+	require.NotEqual(t, first.WrappedKey, other.WrappedKey)
+	require.Equal(t, 2, keyManager.encrypted)
+}
+
+func TestSealedMarshal(t *testing.T) {
+	ctx := context.Background()
+	envelope := NewEnvelope(newTestKeyManager(t, "v1"))
+	sealed, err := envelope.Seal(ctx, []byte("contribution"), []byte("reshares/1"))
+	require.NoError(t, err)
+
+	encoded := sealed.Marshal()
+	require.True(t, IsMarshaledSealed(encoded))
+	decoded, err := UnmarshalSealed(encoded)
+	require.NoError(t, err)
+	require.Equal(t, sealed, decoded)
+	opened, err := envelope.Open(ctx, decoded, []byte("reshares/1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("contribution"), opened)
+
+	require.False(t, IsMarshaledSealed([]byte("contribution")))
+	_, err = UnmarshalSealed(encoded[:len(sealedPrefix)+6])
+	require.Error(t, err)
+}
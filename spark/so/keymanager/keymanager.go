@@ -0,0 +1,70 @@
+// Package keymanager implements envelope encryption of secrets stored by the signing operator.
+//
+// Every secret is encrypted with a random data key, and the data key is stored next to it wrapped
+// by a key encryption key (KEK) held by a KeyManager. The KEK never leaves the key manager, so a
+// copy of the database alone does not reveal any secret. Secrets written together, such as the
+// keyshares of a DKG batch or a batch of nonces, share a data key, so that writing them makes one
+// call to the key manager rather than one per secret.
+package keymanager
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+)
+
+// KeyManager wraps and unwraps data keys with a key encryption key. Key encryption keys are
+// versioned so that they can be rotated: data keys are always wrapped with the current version,
+// and can be unwrapped with any version the key manager still knows.
+type KeyManager interface {
+	// CurrentKeyVersion returns the version of the key encryption key new data keys are wrapped with.
+	CurrentKeyVersion() string
+	// EncryptDataKey wraps a data key with the current key encryption key.
+	EncryptDataKey(ctx context.Context, dataKey []byte) ([]byte, error)
+	// DecryptDataKey unwraps a data key that was wrapped with the given key encryption key version.
+	DecryptDataKey(ctx context.Context, wrappedKey []byte, keyVersion string) ([]byte, error)
+}
+
+const (
+	// ProviderLocal keeps key encryption keys in local files. It is meant for development and tests.
+	ProviderLocal = "local"
+	// ProviderAWSKMS keeps key encryption keys in AWS KMS.
+	ProviderAWSKMS = "aws_kms"
+)
+
+// Config is the configuration of the key manager.
+type Config struct {
+	// Provider is either "local" or "aws_kms". Secrets are stored in plaintext if it is empty.
+	Provider string `yaml:"provider"`
+	// LocalKeyPaths maps key versions to files holding hex encoded 32 byte keys, for the local
+	// provider. Relative paths are resolved against the run directory.
+	LocalKeyPaths map[string]string `yaml:"local_key_paths"`
+	// LocalCurrentKeyVersion is the version in LocalKeyPaths new data keys are wrapped with.
+	LocalCurrentKeyVersion string `yaml:"local_current_key_version"`
+	// AWSKMSKeyID is the ID or ARN of the KMS key new data keys are wrapped with, for the aws_kms
+	// provider. Data keys wrapped with previous keys can be unwrapped as long as the operator is
+	// still allowed to use them.
+	AWSKMSKeyID string `yaml:"aws_kms_key_id"`
+}
+
+// New creates the key manager described by the config. It returns nil if no provider is
+// configured.
+func New(ctx context.Context, config Config, runDirectory string) (KeyManager, error) {
+	switch config.Provider {
+	case "":
+		return nil, nil
+	case ProviderLocal:
+		keyPaths := make(map[string]string, len(config.LocalKeyPaths))
+		for version, path := range config.LocalKeyPaths {
+			if !filepath.IsAbs(path) && runDirectory != "" {
+				path = filepath.Join(runDirectory, path)
+			}
+			keyPaths[version] = path
+		}
+		return NewLocalKeyManagerFromFiles(keyPaths, config.LocalCurrentKeyVersion)
+	case ProviderAWSKMS:
+		return NewAWSKMSKeyManager(ctx, config.AWSKMSKeyID)
+	default:
+		return nil, fmt.Errorf("unknown key manager provider %q", config.Provider)
+	}
+}
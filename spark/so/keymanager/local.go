@@ -0,0 +1,100 @@
+package keymanager
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// LocalKeyManager wraps data keys with AES-256-GCM under key encryption keys held in memory.
+type LocalKeyManager struct {
+	keys           map[string]cipher.AEAD
+	currentVersion string
+}
+
+// NewLocalKeyManager creates a LocalKeyManager from 32 byte key encryption keys by version.
+func NewLocalKeyManager(keys map[string][]byte, currentVersion string) (*LocalKeyManager, error) {
+	if _, ok := keys[currentVersion]; !ok {
+		return nil, fmt.Errorf("no key for current key version %q", currentVersion)
+	}
+
+	aeads := make(map[string]cipher.AEAD, len(keys))
+	for version, key := range keys {
+		if len(key) != 32 {
+			return nil, fmt.Errorf("key version %q must be 32 bytes, got %d", version, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		aeads[version] = aead
+	}
+	return &LocalKeyManager{keys: aeads, currentVersion: currentVersion}, nil
+}
+
+// NewLocalKeyManagerFromFiles creates a LocalKeyManager from files holding hex encoded key
+// encryption keys by version.
+func NewLocalKeyManagerFromFiles(keyPaths map[string]string, currentVersion string) (*LocalKeyManager, error) {
+	keys := make(map[string][]byte, len(keyPaths))
+	for version, path := range keyPaths {
+		keyHex, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key version %q: %w", version, err)
+		}
+		key, err := hex.DecodeString(strings.TrimSpace(string(keyHex)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key version %q: %w", version, err)
+		}
+		keys[version] = key
+	}
+	return NewLocalKeyManager(keys, currentVersion)
+}
+
+// CurrentKeyVersion implements KeyManager.
+func (m *LocalKeyManager) CurrentKeyVersion() string {
+	return m.currentVersion
+}
+
+// EncryptDataKey implements KeyManager.
+func (m *LocalKeyManager) EncryptDataKey(_ context.Context, dataKey []byte) ([]byte, error) {
+	return seal(m.keys[m.currentVersion], dataKey, []byte(m.currentVersion))
+}
+
+// DecryptDataKey implements KeyManager.
+func (m *LocalKeyManager) DecryptDataKey(_ context.Context, wrappedKey []byte, keyVersion string) ([]byte, error) {
+	aead, ok := m.keys[keyVersion]
+	if !ok {
+		return nil, fmt.Errorf("unknown key version %q", keyVersion)
+	}
+	return open(aead, wrappedKey, []byte(keyVersion))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce, which is prepended to the ciphertext.
+func seal(aead cipher.AEAD, plaintext []byte, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, ciphertext []byte, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
@@ -0,0 +1,49 @@
+package keymanager
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// sealedPrefix starts every secret encoded by Sealed.Marshal.
+var sealedPrefix = []byte("SPARKSEALED\x01")
+
+// Marshal encodes the sealed secret into a single value, for secrets stored where there is no
+// room for the wrapped key and key version next to them, such as the elements of a JSON column.
+func (s *Sealed) Marshal() []byte {
+	var encoded bytes.Buffer
+	encoded.Write(sealedPrefix)
+	for _, field := range [][]byte{[]byte(s.KeyVersion), s.WrappedKey} {
+		_ = binary.Write(&encoded, binary.BigEndian, uint32(len(field)))
+		encoded.Write(field)
+	}
+	encoded.Write(s.Ciphertext)
+	return encoded.Bytes()
+}
+
+// IsMarshaledSealed returns whether the value was encoded by Sealed.Marshal.
+func IsMarshaledSealed(data []byte) bool {
+	return bytes.HasPrefix(data, sealedPrefix)
+}
+
+// UnmarshalSealed decodes a sealed secret encoded by Sealed.Marshal.
+func UnmarshalSealed(data []byte) (*Sealed, error) {
+	if !IsMarshaledSealed(data) {
+		return nil, errors.New("not a sealed secret")
+	}
+	data = data[len(sealedPrefix):]
+	fields := make([][]byte, 2)
+	for i := range fields {
+		if len(data) < 4 {
+			return nil, errors.New("truncated sealed secret")
+		}
+		length := binary.BigEndian.Uint32(data)
+		data = data[4:]
+		if uint64(len(data)) < uint64(length) {
+			return nil, errors.New("truncated sealed secret")
+		}
+		fields[i], data = data[:length], data[length:]
+	}
+	return &Sealed{KeyVersion: string(fields[0]), WrappedKey: fields[1], Ciphertext: data}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +18,9 @@ import (
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/ent"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 )
@@ -294,6 +298,38 @@ func (p *connPool) Close() error {
 	return lastErr
 }
 
+// PoolStats is a snapshot of the health of a connection pool
+type PoolStats struct {
+	Network common.Network
+	// MaxSize is the configured pool size; the pool may burst to twice this size
+	MaxSize int
+	// Open is the number of connections owned by the pool, idle or in use
+	Open int
+	// Idle is the number of connections waiting in the pool
+	Idle int
+	// Unhealthy is the number of connections that will be replaced on their next health check
+	Unhealthy int
+}
+
+// stats returns a snapshot of the pool's connections
+func (p *connPool) stats() PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := PoolStats{
+		Network: p.network,
+		MaxSize: p.maxSize,
+		Open:    len(p.allConns),
+		Idle:    len(p.availableConns),
+	}
+	for conn := range p.allConns {
+		if !p.isConnectionHealthy(conn) {
+			stats.Unhealthy++
+		}
+	}
+	return stats
+}
+
 // createConnection creates a new gRPC connection with retry policy
 func createConnection(config *so.Config, network common.Network) (*grpc.ClientConn, error) {
 	if !slices.Contains(config.SupportedNetworks, network) {
@@ -326,6 +362,8 @@ type Client struct {
 	config *so.Config
 	pools  map[string]*connPool // Map of network name to connection pool
 	logger *slog.Logger
+	// metrics reports the PoolStats of the pools until the client is closed
+	metrics metric.Registration
 }
 
 // NewClient creates a new LRC20 client with connection pools for each supported network
@@ -351,11 +389,46 @@ func NewClient(config *so.Config, logger *slog.Logger) (*Client, error) {
 		return nil, fmt.Errorf("failed to create any valid connection pools for supported networks")
 	}
 
-	return &Client{
+	client := &Client{
 		config: config,
 		pools:  pools,
 		logger: logger,
-	}, nil
+	}
+	metrics, err := client.registerPoolMetrics()
+	if err != nil {
+		logger.Warn("Failed to register connection pool metrics", "error", err)
+	}
+	client.metrics = metrics
+	return client, nil
+}
+
+// registerPoolMetrics reports the PoolStats of every pool as gauges whenever metrics are collected.
+func (c *Client) registerPoolMetrics() (metric.Registration, error) {
+	meter := otel.Meter("lrc20")
+	connections, err := meter.Int64ObservableGauge(
+		"spark_lrc20_pool_connections",
+		metric.WithDescription("Number of connections in the LRC20 connection pool, by state"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	maxSize, err := meter.Int64ObservableGauge(
+		"spark_lrc20_pool_max_size",
+		metric.WithDescription("Configured size of the LRC20 connection pool, which may burst to twice this size"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		for _, stats := range c.PoolStats() {
+			network := attribute.String("network", stats.Network.String())
+			observer.ObserveInt64(maxSize, int64(stats.MaxSize), metric.WithAttributes(network))
+			observer.ObserveInt64(connections, int64(stats.Open), metric.WithAttributes(network, attribute.String("state", "open")))
+			observer.ObserveInt64(connections, int64(stats.Idle), metric.WithAttributes(network, attribute.String("state", "idle")))
+			observer.ObserveInt64(connections, int64(stats.Unhealthy), metric.WithAttributes(network, attribute.String("state", "unhealthy")))
+		}
+		return nil
+	}, connections, maxSize)
 }
 
 // executeLrc20Call handles common LRC20 RPC call pattern with proper connection management
@@ -436,7 +509,7 @@ func (c *Client) shouldSkipLrc20Call(ctx context.Context, network common.Network
 	logger := logging.GetLoggerFromContext(ctx)
 	networkStr := network.String()
 
-	if lrc20Config, ok := c.config.Lrc20Configs[networkStr]; ok && lrc20Config.DisableRpcs {
+	if lrc20Config, ok := c.config.Lrc20Config(networkStr); ok && lrc20Config.DisableRpcs {
 		logger.Info("Skipping LRC20 node call due to DisableRpcs flag", "network", networkStr)
 		return true
 	}
@@ -454,11 +527,11 @@ func (c *Client) MarkWithdrawnTokenOutputs(
 ) error {
 	logger := logging.GetLoggerFromContext(ctx)
 	networkStr := network.String()
-	if lrc20Config, ok := c.config.Lrc20Configs[networkStr]; ok && lrc20Config.DisableRpcs {
+	if lrc20Config, ok := c.config.Lrc20Config(networkStr); ok && lrc20Config.DisableRpcs {
 		logger.Info("Skipping LRC20 node call due to DisableRpcs flag")
 		return nil
 	}
-	if lrc20Config, ok := c.config.Lrc20Configs[networkStr]; ok && lrc20Config.DisableL1 {
+	if lrc20Config, ok := c.config.Lrc20Config(networkStr); ok && lrc20Config.DisableL1 {
 		logger.Info("Skipping LRC20 node call due to DisableL1 flag")
 		return nil
 	}
@@ -480,7 +553,8 @@ func (c *Client) MarkWithdrawnTokenOutputs(
 
 		client := pblrc20.NewSparkServiceClient(conn)
 
-		pageSize := uint32(c.config.Lrc20Configs[networkStr].GRPCPageSize)
+		lrc20Config, _ := c.config.Lrc20Config(networkStr)
+		pageSize := uint32(lrc20Config.GRPCPageSize)
 		pageResponse, err := client.ListWithdrawnOutputs(ctx, &pblrc20.ListWithdrawnOutputsRequest{
 			// TODO(DL-99): Fetch just for the latest blockhash instead of all withdrawn outputs.
 			// TODO(DL-98): Add support for pagination.
@@ -580,8 +654,53 @@ func (c *Client) UnmarkWithdrawnTokenOutputs(
 	return nil
 }
 
+// PoolStats returns a snapshot of the health of the connection pool of every network
+func (c *Client) PoolStats() []PoolStats {
+	stats := make([]PoolStats, 0, len(c.pools))
+	for _, pool := range c.pools {
+		stats = append(stats, pool.stats())
+	}
+	slices.SortFunc(stats, func(a, b PoolStats) int {
+		return strings.Compare(a.Network.String(), b.Network.String())
+	})
+	return stats
+}
+
+// CheckHealth checks that the LRC20 node of the network can be connected to. Networks whose LRC20
+// RPCs are disabled are always healthy, since their node is never called.
+func (c *Client) CheckHealth(ctx context.Context, network common.Network) error {
+	networkStr := network.String()
+	if lrc20Config, ok := c.config.Lrc20Config(networkStr); ok && lrc20Config.DisableRpcs {
+		return nil
+	}
+	pool, ok := c.pools[networkStr]
+	if !ok {
+		return fmt.Errorf("no connection pool available for network %s", networkStr)
+	}
+
+	conn, err := pool.getConn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection from pool for network %s: %w", networkStr, err)
+	}
+	defer pool.returnConn(conn)
+
+	// Idle connections only connect when used, so wait for the connection to be established.
+	conn.Connect()
+	for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection to LRC20 node for network %s is %s", networkStr, state)
+		}
+	}
+	return nil
+}
+
 // Close closes the client and all its connection pools
 func (c *Client) Close() error {
+	if c.metrics != nil {
+		if err := c.metrics.Unregister(); err != nil {
+			c.logger.Error("Error unregistering connection pool metrics", "error", err)
+		}
+	}
 	var lastErr error
 	for network, pool := range c.pools {
 		if err := pool.Close(); err != nil {
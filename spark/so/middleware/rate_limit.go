@@ -2,20 +2,41 @@ package middleware
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"math"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 	"unicode"
 
-	"github.com/sethvargo/go-limiter"
-	"github.com/sethvargo/go-limiter/memorystore"
+	"github.com/lightsparkdev/spark/common/logging"
+	"github.com/lightsparkdev/spark/so/authn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+var rateLimitStoreErrorsCounter metric.Int64Counter
+
+func init() {
+	var err error
+	rateLimitStoreErrorsCounter, err = otel.Meter("ratelimit").Int64Counter(
+		"spark_rate_limit_store_errors",
+		metric.WithDescription("Number of requests the rate limit store failed to count, which are counted in memory instead"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
 // sanitizeKey removes control characters and limits key length
 func sanitizeKey(key string) string {
 	key = strings.Map(func(r rune) rune {
@@ -36,16 +57,35 @@ func sanitizeKey(key string) string {
 type RateLimiterConfig struct {
 	Window      time.Duration
 	MaxRequests int
-	Methods     []string
+	// Methods are the methods limited to MaxRequests per Window
+	Methods []string
+	// MethodLimits are the methods with a limit of their own
+	MethodLimits map[string]RateLimit
+	// StreamMethods are the streaming methods limited to MaxStreams concurrent streams per client
+	StreamMethods []string
+	// MaxStreams is the maximum number of concurrent streams per client and method, 0 for no limit
+	MaxStreams int
+	// Store is the kind of store requests are counted in: memory (the default), memcached or redis
+	Store string
+	// MemcachedAddrs are the addresses of the memcached servers of the memcached store
+	MemcachedAddrs []string
+	// RedisAddr is the address of the Redis server of the redis store
+	RedisAddr string
 }
 
 type RateLimiterConfigProvider interface {
 	GetRateLimiterConfig() *RateLimiterConfig
 }
 
+// RateLimiter limits the rate of requests per method of every client, identified by the identity
+// public key of its session and by its IP address. Requests are counted in a store that can be
+// shared by every server, or in memory on this server while the shared store fails. Concurrent
+// streams are limited per server.
 type RateLimiter struct {
-	config *RateLimiterConfig
-	store  limiter.Store
+	config   atomic.Pointer[RateLimiterConfig]
+	store    RateLimitStore
+	fallback RateLimitStore
+	streams  *streamCounter
 }
 
 func NewRateLimiter(configOrProvider interface{}) (*RateLimiter, error) {
@@ -59,43 +99,197 @@ func NewRateLimiter(configOrProvider interface{}) (*RateLimiter, error) {
 		return nil, fmt.Errorf("invalid config type: %T", configOrProvider)
 	}
 
-	store, err := memorystore.New(&memorystore.Config{
-		Tokens:   uint64(config.MaxRequests),
-		Interval: config.Window,
-	})
+	store, err := NewRateLimitStore(config)
 	if err != nil {
 		return nil, err
 	}
 
-	return &RateLimiter{
-		config: config,
-		store:  store,
-	}, nil
+	rateLimiter := &RateLimiter{
+		store:   store,
+		streams: &streamCounter{counts: make(map[string]int)},
+	}
+	if _, ok := store.(*memoryRateLimitStore); !ok {
+		rateLimiter.fallback = newMemoryRateLimitStore()
+	}
+	rateLimiter.config.Store(config)
+	return rateLimiter, nil
+}
+
+// UpdateLimits replaces the limits of the rate limiter with those of config. The store requests
+// are counted in is kept, so the store settings of config are ignored.
+func (r *RateLimiter) UpdateLimits(config *RateLimiterConfig) {
+	limits := *r.config.Load()
+	limits.Window = config.Window
+	limits.MaxRequests = config.MaxRequests
+	limits.Methods = config.Methods
+	limits.MethodLimits = config.MethodLimits
+	limits.StreamMethods = config.StreamMethods
+	limits.MaxStreams = config.MaxStreams
+	r.config.Store(&limits)
+}
+
+// Close releases the stores of the rate limiter.
+func (r *RateLimiter) Close() error {
+	if r.fallback != nil {
+		if err := r.fallback.Close(); err != nil {
+			return err
+		}
+	}
+	return r.store.Close()
 }
 
 func (r *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		shouldLimit := slices.Contains(r.config.Methods, info.FullMethod)
+		header, err := r.take(ctx, info.FullMethod)
+		if header != nil {
+			// Fails only outside of a server, e.g. in tests.
+			_ = grpc.SetHeader(ctx, header)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
 
-		if !shouldLimit {
-			return handler(ctx, req)
+func (r *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		header, err := r.take(ctx, info.FullMethod)
+		if header != nil {
+			_ = ss.SetHeader(header)
+		}
+		if err != nil {
+			return err
 		}
 
-		ip := getClientIP(ctx)
-		if ip == "" {
-			return handler(ctx, req)
+		config := r.config.Load()
+		if config.MaxStreams > 0 && slices.Contains(config.StreamMethods, info.FullMethod) {
+			keys := clientKeys(ctx)
+			for i, key := range keys {
+				keys[i] = sanitizeKey(fmt.Sprintf("streams:%s:%s", info.FullMethod, key))
+			}
+			if !r.streams.acquire(keys, config.MaxStreams) {
+				return status.Errorf(codes.ResourceExhausted, "too many concurrent streams")
+			}
+			defer r.streams.release(keys)
 		}
+		return handler(srv, ss)
+	}
+}
+
+// limit returns the limit of the method, if it is limited.
+func (r *RateLimiter) limit(method string) (RateLimit, bool) {
+	config := r.config.Load()
+	if limit, ok := config.MethodLimits[method]; ok {
+		return limit, true
+	}
+	if slices.Contains(config.Methods, method) {
+		return RateLimit{MaxRequests: config.MaxRequests, Window: config.Window}, true
+	}
+	return RateLimit{}, false
+}
 
-		key := sanitizeKey(fmt.Sprintf("rl:%s:%s", info.FullMethod, ip))
-		_, _, _, ok, err := r.store.Take(ctx, key)
+// take counts the request against every key of its client, and returns the rate limit headers of
+// the response along with a ResourceExhausted error if any of the keys is over its limit. Requests
+// that cannot be counted at all are allowed.
+func (r *RateLimiter) take(ctx context.Context, method string) (metadata.MD, error) {
+	limit, ok := r.limit(method)
+	if !ok {
+		return nil, nil
+	}
+	keys := clientKeys(ctx)
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	remaining := uint64(limit.MaxRequests)
+	var reset time.Time
+	allowed := true
+	for _, key := range keys {
+		keyRemaining, keyReset, ok, err := r.takeKey(ctx, sanitizeKey(fmt.Sprintf("rl:%s:%s", method, key)), limit)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "rate limit error: %v", err)
+			logging.GetLoggerFromContext(ctx).Error("Failed to count request for rate limiting, allowing it", "method", method, "error", err)
+			return nil, nil
+		}
+		remaining = min(remaining, keyRemaining)
+		if keyReset.After(reset) {
+			reset = keyReset
 		}
 		if !ok {
-			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
+			allowed = false
+			break
 		}
+	}
 
-		return handler(ctx, req)
+	resetSeconds := strconv.FormatInt(max(0, int64(math.Ceil(time.Until(reset).Seconds()))), 10)
+	header := metadata.Pairs(
+		"ratelimit-limit", strconv.Itoa(limit.MaxRequests),
+		"ratelimit-remaining", strconv.FormatUint(remaining, 10),
+		"ratelimit-reset", resetSeconds,
+	)
+	if !allowed {
+		header.Set("retry-after", resetSeconds)
+		return header, status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	return header, nil
+}
+
+// takeKey takes a token from the bucket of the key in the store. While a shared store fails, the
+// request is counted in memory on this server instead, so that an outage of the store does not
+// fail every request.
+func (r *RateLimiter) takeKey(ctx context.Context, key string, limit RateLimit) (uint64, time.Time, bool, error) {
+	remaining, reset, ok, err := r.store.Take(ctx, key, limit)
+	if err == nil || r.fallback == nil {
+		return remaining, reset, ok, err
+	}
+	rateLimitStoreErrorsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("store", r.config.Load().Store)))
+	logging.GetLoggerFromContext(ctx).Warn("Rate limit store failed, counting the request in memory", "error", err)
+	return r.fallback.Take(ctx, key, limit)
+}
+
+// clientKeys returns the keys the client of the request is counted under: the identity public key
+// of its session and its IP address, whichever are known.
+func clientKeys(ctx context.Context) []string {
+	var keys []string
+	if session, err := authn.GetSessionFromContext(ctx); err == nil {
+		keys = append(keys, "id:"+hex.EncodeToString(session.IdentityPublicKeyBytes()))
+	}
+	if ip := getClientIP(ctx); ip != "" {
+		keys = append(keys, "ip:"+ip)
+	}
+	return keys
+}
+
+// streamCounter counts the open streams per key.
+type streamCounter struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// acquire counts a stream under every key, unless one of them is already at maxStreams.
+func (c *streamCounter) acquire(keys []string, maxStreams int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		if c.counts[key] >= maxStreams {
+			return false
+		}
+	}
+	for _, key := range keys {
+		c.counts[key]++
+	}
+	return true
+}
+
+func (c *streamCounter) release(keys []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		c.counts[key]--
+		if c.counts[key] <= 0 {
+			delete(c.counts, key)
+		}
 	}
 }
 
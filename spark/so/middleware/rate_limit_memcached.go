@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+// memcachedRateLimitStore counts requests in memcached, spreading keys over the servers by hash.
+type memcachedRateLimitStore struct {
+	client *memcache.Client
+}
+
+func newMemcachedRateLimitStore(addrs []string) *memcachedRateLimitStore {
+	servers := make([]string, len(addrs))
+	for i, addr := range addrs {
+		servers[i] = strings.TrimSpace(addr)
+	}
+	client := memcache.New(servers...)
+	client.Timeout = rateLimitStoreTimeout
+	client.MaxIdleConns = rateLimitStoreMaxIdle
+	return &memcachedRateLimitStore{client: client}
+}
+
+func (s *memcachedRateLimitStore) Take(_ context.Context, key string, limit RateLimit) (uint64, time.Time, bool, error) {
+	// Memcached keys cannot contain whitespace.
+	key, reset := windowedKey(strings.ReplaceAll(key, " ", "_"), limit.Window, time.Now())
+	expiration := int32(math.Ceil(limit.Window.Seconds())) + 1
+
+	// Create the counter of the window if it does not exist yet, then count the request.
+	err := s.client.Add(&memcache.Item{Key: key, Value: []byte("0"), Expiration: expiration})
+	if err != nil && !errors.Is(err, memcache.ErrNotStored) {
+		return 0, time.Time{}, false, err
+	}
+	count, err := s.client.Increment(key, 1)
+	if err != nil {
+		return 0, time.Time{}, false, err
+	}
+	remaining, ok := takeFromCount(count, limit)
+	return remaining, reset, ok, nil
+}
+
+func (s *memcachedRateLimitStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisRateLimitStore counts requests in Redis.
+type redisRateLimitStore struct {
+	client *redis.Client
+}
+
+// newRedisRateLimitStore creates a store for the Redis server at addr, either host:port or a
+// redis:// or rediss:// URL.
+func newRedisRateLimitStore(addr string) (*redisRateLimitStore, error) {
+	options := &redis.Options{Addr: addr}
+	if strings.HasPrefix(addr, "redis://") || strings.HasPrefix(addr, "rediss://") {
+		var err error
+		if options, err = redis.ParseURL(addr); err != nil {
+			return nil, fmt.Errorf("invalid redis address: %w", err)
+		}
+	}
+	options.DialTimeout = rateLimitStoreTimeout
+	options.ReadTimeout = rateLimitStoreTimeout
+	options.WriteTimeout = rateLimitStoreTimeout
+	options.MaxIdleConns = rateLimitStoreMaxIdle
+	return &redisRateLimitStore{client: redis.NewClient(options)}, nil
+}
+
+func (s *redisRateLimitStore) Take(ctx context.Context, key string, limit RateLimit) (uint64, time.Time, bool, error) {
+	key, reset := windowedKey(key, limit.Window, time.Now())
+
+	// Create the counter of the window with its expiration if it does not exist yet, then count
+	// the request. INCR keeps the expiration.
+	pipe := s.client.Pipeline()
+	pipe.SetNX(ctx, key, 0, limit.Window+time.Second)
+	count := pipe.Incr(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, time.Time{}, false, err
+	}
+	remaining, ok := takeFromCount(uint64(count.Val()), limit)
+	return remaining, reset, ok, nil
+}
+
+func (s *redisRateLimitStore) Close() error {
+	return s.client.Close()
+}
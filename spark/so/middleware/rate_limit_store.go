@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sethvargo/go-limiter"
+	"github.com/sethvargo/go-limiter/memorystore"
+)
+
+const (
+	// RateLimitStoreMemory keeps request counts in memory, separately on every server.
+	RateLimitStoreMemory = "memory"
+	// RateLimitStoreMemcached keeps request counts in memcached, shared by every server.
+	RateLimitStoreMemcached = "memcached"
+	// RateLimitStoreRedis keeps request counts in Redis, shared by every server.
+	RateLimitStoreRedis = "redis"
+
+	rateLimitStoreTimeout = time.Second
+	rateLimitStoreMaxIdle = 16
+)
+
+// RateLimit is the number of requests allowed per window.
+type RateLimit struct {
+	MaxRequests int           `yaml:"max_requests"`
+	Window      time.Duration `yaml:"window"`
+}
+
+// RateLimitStore keeps a bucket of tokens per key, refilled at the start of every window of the
+// limit.
+type RateLimitStore interface {
+	// Take takes a token from the bucket of the key. It returns the tokens remaining in the bucket,
+	// when the bucket is refilled, and whether there was a token to take.
+	Take(ctx context.Context, key string, limit RateLimit) (remaining uint64, reset time.Time, ok bool, err error)
+	// Close releases the resources of the store.
+	Close() error
+}
+
+// NewRateLimitStore creates the rate limit store of the given kind.
+func NewRateLimitStore(config *RateLimiterConfig) (RateLimitStore, error) {
+	switch config.Store {
+	case "", RateLimitStoreMemory:
+		return newMemoryRateLimitStore(), nil
+	case RateLimitStoreMemcached:
+		if len(config.MemcachedAddrs) == 0 {
+			return nil, fmt.Errorf("memcached rate limit store requires at least one address")
+		}
+		return newMemcachedRateLimitStore(config.MemcachedAddrs), nil
+	case RateLimitStoreRedis:
+		if config.RedisAddr == "" {
+			return nil, fmt.Errorf("redis rate limit store requires an address")
+		}
+		return newRedisRateLimitStore(config.RedisAddr)
+	default:
+		return nil, fmt.Errorf("unknown rate limit store %q", config.Store)
+	}
+}
+
+// memoryRateLimitStore keeps a go-limiter memory store for every distinct limit.
+type memoryRateLimitStore struct {
+	mu     sync.Mutex
+	stores map[RateLimit]limiter.Store
+}
+
+func newMemoryRateLimitStore() *memoryRateLimitStore {
+	return &memoryRateLimitStore{stores: make(map[RateLimit]limiter.Store)}
+}
+
+func (s *memoryRateLimitStore) Take(ctx context.Context, key string, limit RateLimit) (uint64, time.Time, bool, error) {
+	s.mu.Lock()
+	store, ok := s.stores[limit]
+	if !ok {
+		var err error
+		store, err = memorystore.New(&memorystore.Config{
+			Tokens:   uint64(limit.MaxRequests),
+			Interval: limit.Window,
+		})
+		if err != nil {
+			s.mu.Unlock()
+			return 0, time.Time{}, false, err
+		}
+		s.stores[limit] = store
+	}
+	s.mu.Unlock()
+
+	_, remaining, reset, ok, err := store.Take(ctx, key)
+	return remaining, time.Unix(0, int64(reset)), ok, err
+}
+
+func (s *memoryRateLimitStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, store := range s.stores {
+		if err := store.Close(context.Background()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// windowedKey returns the key of the counter of the current window of the limit, and when the
+// window ends. Shared stores count requests in fixed windows, which expire on their own.
+func windowedKey(key string, window time.Duration, now time.Time) (string, time.Time) {
+	index := now.UnixNano() / int64(window)
+	return fmt.Sprintf("%s:%d", key, index), time.Unix(0, (index+1)*int64(window))
+}
+
+// takeFromCount converts the count of requests in a window to the result of a Take.
+func takeFromCount(count uint64, limit RateLimit) (uint64, bool) {
+	if count > uint64(limit.MaxRequests) {
+		return 0, false
+	}
+	return uint64(limit.MaxRequests) - count, true
+}
+
+// ParseMethodLimits parses comma separated method limits of the form method=max_requests/window,
+// e.g. /spark.SparkService/start_transfer=10/1m.
+func ParseMethodLimits(s string) (map[string]RateLimit, error) {
+	limits := make(map[string]RateLimit)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		method, limit, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid method limit %q: missing =", entry)
+		}
+		maxRequests, window, ok := strings.Cut(limit, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid method limit %q: missing /", entry)
+		}
+		parsedMax, err := strconv.Atoi(maxRequests)
+		if err != nil || parsedMax <= 0 {
+			return nil, fmt.Errorf("invalid method limit %q: max requests must be a positive integer", entry)
+		}
+		parsedWindow, err := time.ParseDuration(window)
+		if err != nil || parsedWindow <= 0 {
+			return nil, fmt.Errorf("invalid method limit %q: window must be a positive duration", entry)
+		}
+		limits[method] = RateLimit{MaxRequests: parsedMax, Window: parsedWindow}
+	}
+	return limits, nil
+}
@@ -1,10 +1,19 @@
 package middleware
 
 import (
+	"bufio"
 	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lightsparkdev/spark/so/authn"
+	"github.com/lightsparkdev/spark/so/authninternal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -142,3 +151,264 @@ func TestRateLimiter(t *testing.T) {
 		assert.Equal(t, "rate limit exceeded", status.Convert(err).Message())
 	})
 }
+
+// sessionContext returns an incoming context with a session for the given identity and the given
+// client IP.
+func sessionContext(t *testing.T, identityPublicKey []byte, ip string) context.Context {
+	serverKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	verifier, err := authninternal.NewSessionTokenCreatorVerifier(serverKey.Serialize(), nil)
+	require.NoError(t, err)
+	token, err := verifier.CreateToken(identityPublicKey, time.Hour)
+	require.NoError(t, err)
+
+	var ctx context.Context
+	_, err = authn.NewAuthnInterceptor(verifier).AuthnInterceptor(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token.Token, "x-forwarded-for", ip)),
+		nil,
+		&grpc.UnaryServerInfo{},
+		func(c context.Context, _ interface{}) (interface{}, error) {
+			ctx = c
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+	return ctx
+}
+
+func newTestIdentity(t *testing.T) []byte {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	return key.PubKey().SerializeCompressed()
+}
+
+func TestRateLimiterPerIdentityAndMethod(t *testing.T) {
+	rateLimiter, err := NewRateLimiter(&RateLimiterConfig{
+		Window:       time.Minute,
+		MaxRequests:  2,
+		Methods:      []string{"/test.Service/TestMethod"},
+		MethodLimits: map[string]RateLimit{"/test.Service/Strict": {MaxRequests: 1, Window: time.Minute}},
+	})
+	require.NoError(t, err)
+	defer rateLimiter.Close()
+
+	// The same identity is limited across IPs.
+	identity := newTestIdentity(t)
+	header, err := rateLimiter.take(sessionContext(t, identity, "1.1.1.1"), "/test.Service/TestMethod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2"}, header.Get("ratelimit-limit"))
+	assert.Equal(t, []string{"1"}, header.Get("ratelimit-remaining"))
+	_, err = rateLimiter.take(sessionContext(t, identity, "2.2.2.2"), "/test.Service/TestMethod")
+	require.NoError(t, err)
+	header, err = rateLimiter.take(sessionContext(t, identity, "3.3.3.3"), "/test.Service/TestMethod")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"0"}, header.Get("ratelimit-remaining"))
+	assert.NotEmpty(t, header.Get("retry-after"))
+
+	// The same IP is limited across identities.
+	_, err = rateLimiter.take(sessionContext(t, newTestIdentity(t), "2.2.2.2"), "/test.Service/TestMethod")
+	require.NoError(t, err)
+	_, err = rateLimiter.take(sessionContext(t, newTestIdentity(t), "2.2.2.2"), "/test.Service/TestMethod")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Methods with a limit of their own are counted separately.
+	strictIdentity := newTestIdentity(t)
+	_, err = rateLimiter.take(sessionContext(t, strictIdentity, "4.4.4.4"), "/test.Service/Strict")
+	require.NoError(t, err)
+	_, err = rateLimiter.take(sessionContext(t, strictIdentity, "5.5.5.5"), "/test.Service/Strict")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestRateLimiterUpdateLimits(t *testing.T) {
+	rateLimiter, err := NewRateLimiter(&RateLimiterConfig{
+		Window:      time.Minute,
+		MaxRequests: 1,
+		Methods:     []string{"/test.Service/TestMethod"},
+	})
+	require.NoError(t, err)
+	defer rateLimiter.Close()
+
+	ctx := sessionContext(t, newTestIdentity(t), "1.1.1.1")
+	_, err = rateLimiter.take(ctx, "/test.Service/TestMethod")
+	require.NoError(t, err)
+	_, err = rateLimiter.take(ctx, "/test.Service/TestMethod")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	rateLimiter.UpdateLimits(&RateLimiterConfig{
+		Window:       time.Minute,
+		MaxRequests:  3,
+		Methods:      []string{"/test.Service/TestMethod"},
+		MethodLimits: map[string]RateLimit{"/test.Service/Strict": {MaxRequests: 1, Window: time.Minute}},
+	})
+	header, err := rateLimiter.take(ctx, "/test.Service/TestMethod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3"}, header.Get("ratelimit-limit"))
+	_, err = rateLimiter.take(ctx, "/test.Service/Strict")
+	require.NoError(t, err)
+	_, err = rateLimiter.take(ctx, "/test.Service/Strict")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context      { return s.ctx }
+func (s *testServerStream) SetHeader(_ metadata.MD) error { return nil }
+
+func TestRateLimiterLimitsConcurrentStreams(t *testing.T) {
+	rateLimiter, err := NewRateLimiter(&RateLimiterConfig{
+		StreamMethods: []string{"/test.Service/Subscribe"},
+		MaxStreams:    1,
+	})
+	require.NoError(t, err)
+	defer rateLimiter.Close()
+
+	interceptor := rateLimiter.StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Subscribe"}
+	stream := &testServerStream{ctx: sessionContext(t, newTestIdentity(t), "1.1.1.1")}
+
+	err = interceptor(nil, stream, info, func(_ interface{}, ss grpc.ServerStream) error {
+		// A second stream of the same client is rejected while the first is open.
+		err := interceptor(nil, ss, info, func(interface{}, grpc.ServerStream) error { return nil })
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, interceptor(nil, stream, info, func(interface{}, grpc.ServerStream) error { return nil }))
+}
+
+func TestParseMethodLimits(t *testing.T) {
+	limits, err := ParseMethodLimits("/spark.SparkService/start_transfer=10/1m, /spark.SparkService/query_nodes=100/1s")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]RateLimit{
+		"/spark.SparkService/start_transfer": {MaxRequests: 10, Window: time.Minute},
+		"/spark.SparkService/query_nodes":    {MaxRequests: 100, Window: time.Second},
+	}, limits)
+
+	for _, invalid := range []string{"/a/b", "/a/b=10", "/a/b=x/1m", "/a/b=0/1m", "/a/b=10/x"} {
+		_, err := ParseMethodLimits(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+// serveFakeMemcached serves the memcached commands the store sends, add and incr, until the test
+// ends.
+func serveFakeMemcached(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	values := make(map[string]uint64)
+	var mu sync.Mutex
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				rw := bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+				for {
+					mu.Lock()
+					err := fakeMemcached(rw, values)
+					mu.Unlock()
+					if err != nil || rw.Flush() != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func fakeMemcached(rw *bufio.ReadWriter, values map[string]uint64) error {
+	line, err := rw.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(line)
+	switch fields[0] {
+	case "add":
+		if _, err := rw.ReadString('\n'); err != nil {
+			return err
+		}
+		if _, ok := values[fields[1]]; ok {
+			_, err = rw.WriteString("NOT_STORED\r\n")
+			return err
+		}
+		values[fields[1]] = 0
+		_, err = rw.WriteString("STORED\r\n")
+	case "incr":
+		value, ok := values[fields[1]]
+		if !ok {
+			_, err = rw.WriteString("NOT_FOUND\r\n")
+			return err
+		}
+		values[fields[1]] = value + 1
+		_, err = fmt.Fprintf(rw, "%d\r\n", value+1)
+	default:
+		return fmt.Errorf("unexpected command %q", line)
+	}
+	return err
+}
+
+func TestSharedRateLimitStores(t *testing.T) {
+	redisServer := miniredis.RunT(t)
+	redisServer.RequireAuth("secret")
+	stores := map[string]*RateLimiterConfig{
+		RateLimitStoreMemcached: {Store: RateLimitStoreMemcached, MemcachedAddrs: []string{serveFakeMemcached(t), serveFakeMemcached(t)}},
+		RateLimitStoreRedis:     {Store: RateLimitStoreRedis, RedisAddr: "redis://:secret@" + redisServer.Addr()},
+	}
+	for name, config := range stores {
+		t.Run(name, func(t *testing.T) {
+			store, err := NewRateLimitStore(config)
+			require.NoError(t, err)
+			defer store.Close()
+
+			limit := RateLimit{MaxRequests: 2, Window: time.Hour}
+			for i, want := range []bool{true, true, false} {
+				remaining, reset, ok, err := store.Take(context.Background(), "rl:key", limit)
+				require.NoError(t, err)
+				assert.Equal(t, want, ok, "request %d", i)
+				assert.Equal(t, uint64(max(0, 1-i)), remaining, "request %d", i)
+				assert.True(t, reset.After(time.Now()))
+			}
+			_, _, ok, err := store.Take(context.Background(), "rl:other key", limit)
+			require.NoError(t, err)
+			assert.True(t, ok)
+		})
+	}
+
+	store, err := NewRateLimitStore(&RateLimiterConfig{Store: RateLimitStoreRedis, RedisAddr: "redis://:wrong@" + redisServer.Addr()})
+	require.NoError(t, err)
+	defer store.Close()
+	_, _, _, err = store.Take(context.Background(), "rl:key", RateLimit{MaxRequests: 1, Window: time.Hour})
+	assert.ErrorContains(t, err, "WRONGPASS")
+}
+
+func TestRateLimiterFallsBackToMemoryWhenStoreFails(t *testing.T) {
+	redisServer := miniredis.RunT(t)
+	rateLimiter, err := NewRateLimiter(&RateLimiterConfig{
+		Window:      time.Hour,
+		MaxRequests: 1,
+		Methods:     []string{"/test.Service/method"},
+		Store:       RateLimitStoreRedis,
+		RedisAddr:   redisServer.Addr(),
+	})
+	require.NoError(t, err)
+	defer rateLimiter.Close()
+
+	ctx := sessionContext(t, newTestIdentity(t), "1.2.3.4")
+	_, err = rateLimiter.take(ctx, "/test.Service/method")
+	require.NoError(t, err)
+
+	// While the store is down, requests are still limited, in memory.
+	redisServer.Close()
+	_, err = rateLimiter.take(ctx, "/test.Service/method")
+	require.NoError(t, err)
+	_, err = rateLimiter.take(ctx, "/test.Service/method")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -5,10 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/lightsparkdev/spark/common"
 	pb "github.com/lightsparkdev/spark/proto/spark"
 	"github.com/lightsparkdev/spark/so/utils"
-	"google.golang.org/grpc"
 )
 
 // SigningOperator is the information about a signing operator.
@@ -67,8 +65,3 @@ func (s *SigningOperator) MarshalProto() *pb.SigningOperatorInfo {
 		Address:    s.ExternalAddress,
 	}
 }
-
-// NewGRPConnection creates a new gRPC connection to the signing operator.
-func (s *SigningOperator) NewGRPCConnection() (*grpc.ClientConn, error) {
-	return common.NewGRPCConnection(s.Address, s.CertPath, nil)
-}
@@ -0,0 +1,293 @@
+package so
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/lightsparkdev/spark/common"
+	"github.com/lightsparkdev/spark/so/authn"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// The metadata keys of the signature an operator sends with every internal call it makes to
+// another operator.
+const (
+	// OperatorIdentityMetadataKey is the hex encoded identity public key of the calling operator.
+	OperatorIdentityMetadataKey = "x-spark-operator-identity"
+	// OperatorTimestampMetadataKey is the time the call was signed at, in unix nanoseconds.
+	OperatorTimestampMetadataKey = "x-spark-operator-timestamp"
+	// OperatorNonceMetadataKey is the hex encoded random nonce of the call.
+	OperatorNonceMetadataKey = "x-spark-operator-nonce"
+	// OperatorSignatureMetadataKey is the hex encoded DER signature of the digest of the call.
+	OperatorSignatureMetadataKey = "x-spark-operator-signature"
+)
+
+// OperatorCallerIdentityMetadataKey is the hex encoded identity public key of the user an internal
+// call is made for. The receiver only uses it to send the reads of the user to its primary database
+// after the call, so the signature does not cover it.
+const OperatorCallerIdentityMetadataKey = "x-spark-caller-identity"
+
+// operatorCallNonceSize is the size of the nonces of internal calls.
+const operatorCallNonceSize = 16
+
+// OperatorCallDigest returns the digest an operator signs with its identity key to call the method
+// of another operator, whose identity public key is the audience, with the request of the given
+// hash, at the given time in unix nanoseconds.
+func OperatorCallDigest(method string, audience []byte, requestHash []byte, timestamp int64, nonce []byte) []byte {
+	digest := sha256.New()
+	for _, field := range [][]byte{[]byte("spark operator call"), []byte(method), audience, requestHash, nonce} {
+		_ = binary.Write(digest, binary.BigEndian, uint32(len(field)))
+		digest.Write(field)
+	}
+	_ = binary.Write(digest, binary.BigEndian, timestamp)
+	return digest.Sum(nil)
+}
+
+// OperatorCallRequestHash returns the hash of the request of an internal call, which its signature
+// covers. Requests are hashed in their deterministic encoding, which the caller and the receiver
+// produce alike as long as fields the receiver does not know yet are numbered after those of their
+// message it knows. Streaming calls have no request and hash as a nil request.
+func OperatorCallRequestHash(request any) ([]byte, error) {
+	var encoded []byte
+	if message, ok := request.(proto.Message); ok {
+		var err error
+		encoded, err = proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode call request: %w", err)
+		}
+	}
+	hash := sha256.Sum256(encoded)
+	return hash[:], nil
+}
+
+type operatorCallRequestHashKey struct{}
+
+// operatorCallUnaryClientInterceptor hashes the request of every unary call to an operator, for
+// the credentials of the connection to sign it, and passes on the identity of the user the call is
+// made for.
+func operatorCallUnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	requestHash, err := OperatorCallRequestHash(req)
+	if err != nil {
+		return err
+	}
+	if session, err := authn.GetSessionFromContext(ctx); err == nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, OperatorCallerIdentityMetadataKey, hex.EncodeToString(session.IdentityPublicKeyBytes()))
+	}
+	return invoker(context.WithValue(ctx, operatorCallRequestHashKey{}, requestHash), method, req, reply, cc, opts...)
+}
+
+// operatorCallCredentials signs every call made with a connection to an operator with the identity
+// key of this operator. Each attempt of a call is signed with a new nonce, so that retries are not
+// rejected as replays.
+type operatorCallCredentials struct {
+	identityKey *secp256k1.PrivateKey
+	audience    []byte
+	// requireTransportSecurity is set for operators that are not on this host, whose calls must
+	// not be sent, nor their signatures exposed, without TLS.
+	requireTransportSecurity bool
+}
+
+func (c *operatorCallCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	requestInfo, ok := credentials.RequestInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no method to sign the call for")
+	}
+	requestHash, ok := ctx.Value(operatorCallRequestHashKey{}).([]byte)
+	if !ok {
+		var err error
+		if requestHash, err = OperatorCallRequestHash(nil); err != nil {
+			return nil, err
+		}
+	}
+	nonce := make([]byte, operatorCallNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate call nonce: %w", err)
+	}
+	timestamp := time.Now().UnixNano()
+	signature := ecdsa.Sign(c.identityKey, OperatorCallDigest(requestInfo.Method, c.audience, requestHash, timestamp, nonce))
+	return map[string]string{
+		OperatorIdentityMetadataKey:  hex.EncodeToString(c.identityKey.PubKey().SerializeCompressed()),
+		OperatorTimestampMetadataKey: strconv.FormatInt(timestamp, 10),
+		OperatorNonceMetadataKey:     hex.EncodeToString(nonce),
+		OperatorSignatureMetadataKey: hex.EncodeToString(signature.Serialize()),
+	}, nil
+}
+
+// RequireTransportSecurity returns whether the operator is on another host. Operators that run
+// locally connect without TLS.
+func (c *operatorCallCredentials) RequireTransportSecurity() bool {
+	return c.requireTransportSecurity
+}
+
+// NewOperatorConnection creates a new gRPC connection to the signing operator, whose calls are
+// signed with the identity key of this operator. Configs without an identity key, such as those of
+// tests, make unsigned calls, which operators reject unless their authentication is permissive.
+func (c *Config) NewOperatorConnection(operator *SigningOperator) (*grpc.ClientConn, error) {
+	if len(c.IdentityPrivateKey) == 0 {
+		return common.NewGRPCConnection(operator.Address, operator.CertPath, nil)
+	}
+	return common.NewGRPCConnection(operator.Address, operator.CertPath, nil,
+		grpc.WithChainUnaryInterceptor(operatorCallUnaryClientInterceptor),
+		grpc.WithPerRPCCredentials(&operatorCallCredentials{
+			identityKey:              secp256k1.PrivKeyFromBytes(c.IdentityPrivateKey),
+			audience:                 operator.IdentityPublicKey,
+			requireTransportSecurity: !isLocalAddress(operator.Address),
+		}),
+	)
+}
+
+// isLocalAddress returns whether the address, with or without a scheme, is a loopback address.
+func isLocalAddress(address string) bool {
+	if _, rest, ok := strings.Cut(address, "://"); ok {
+		address = rest
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// NewDKGCoordinatorConnection creates a new gRPC connection to the DKG coordinator, which must be
+// one of the operators.
+func (c *Config) NewDKGCoordinatorConnection() (*grpc.ClientConn, error) {
+	coordinator, err := c.dkgCoordinator()
+	if err != nil {
+		return nil, err
+	}
+	return c.NewOperatorConnection(coordinator)
+}
+
+// dkgCoordinator returns the operator whose address is the DKG coordinator address.
+func (c *Config) dkgCoordinator() (*SigningOperator, error) {
+	for _, operators := range c.operatorSets() {
+		for _, operator := range operators {
+			if operator.Address == c.DKGCoordinatorAddress {
+				return operator, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("DKG coordinator address %s is not the address of an operator", c.DKGCoordinatorAddress)
+}
+
+// OperatorByIdentityPublicKey returns the operator of the current or the previous operator set with
+// the identity public key, and whether it is in the current operator set.
+func (c *Config) OperatorByIdentityPublicKey(identityPublicKey []byte) (operator *SigningOperator, current bool, ok bool) {
+	for i, operators := range c.operatorSets() {
+		for _, operator := range operators {
+			if bytes.Equal(operator.IdentityPublicKey, identityPublicKey) {
+				return operator, i == 0, true
+			}
+		}
+	}
+	return nil, false, false
+}
+
+// operatorSets returns the operators of the current operator set, followed by those of the
+// previous one if a resharing is in progress.
+func (c *Config) operatorSets() []map[string]*SigningOperator {
+	sets := []map[string]*SigningOperator{c.SigningOperatorMap}
+	if c.PreviousOperatorSet != nil {
+		sets = append(sets, c.PreviousOperatorSet.SigningOperatorMap)
+	}
+	return sets
+}
+
+type callingOperatorKey struct{}
+
+// unsignedOperatorCallKey marks the context of an internal call that was not signed by an
+// operator, but let through by permissive authentication.
+type unsignedOperatorCallKey struct{}
+
+// WithCallingOperator returns a context for a call made by the operator, once its signature has
+// been verified.
+func WithCallingOperator(ctx context.Context, operator *SigningOperator) context.Context {
+	return context.WithValue(ctx, callingOperatorKey{}, operator)
+}
+
+// WithUnsignedOperatorCall returns a context for an internal call that is not signed by an
+// operator, which permissive authentication let through.
+func WithUnsignedOperatorCall(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unsignedOperatorCallKey{}, true)
+}
+
+// CallingOperatorFromContext returns the operator that made the call of the context, if the call
+// was signed by one.
+func CallingOperatorFromContext(ctx context.Context) (*SigningOperator, bool) {
+	operator, ok := ctx.Value(callingOperatorKey{}).(*SigningOperator)
+	return operator, ok
+}
+
+// CallCoordinator returns the identity public key of the operator coordinating the call of the
+// context: the operator that made it if it is an internal call, or this operator if it is a call
+// of a user. It returns nil for internal calls that are not signed, whose coordinator is unknown.
+func (c *Config) CallCoordinator(ctx context.Context) []byte {
+	if caller, ok := CallingOperatorFromContext(ctx); ok {
+		return caller.IdentityPublicKey
+	}
+	if _, ok := ctx.Value(unsignedOperatorCallKey{}).(bool); ok {
+		return nil
+	}
+	return c.IdentityPublicKey()
+}
+
+// CheckCallingOperator checks that the call of the context was made by the operator with the
+// identity public key. Calls that are not signed by an operator are only accepted while operator
+// authentication is permissive.
+func (c *Config) CheckCallingOperator(ctx context.Context, identityPublicKey []byte) error {
+	caller, ok := CallingOperatorFromContext(ctx)
+	if !ok {
+		if c.OperatorAuthentication.Permissive {
+			return nil
+		}
+		return status.Errorf(codes.Unauthenticated, "call must be signed by operator %x", identityPublicKey)
+	}
+	if !bytes.Equal(caller.IdentityPublicKey, identityPublicKey) {
+		return status.Errorf(codes.PermissionDenied, "operator %s cannot make this call on behalf of operator %x", caller.Identifier, identityPublicKey)
+	}
+	return nil
+}
+
+// CheckRecordedCoordinator checks that the call of the context was made by the coordinator this
+// operator recorded for the state the call continues, such as a transfer or a signing job. State
+// recorded before coordinators were, or by unsigned calls, has no coordinator, and can be
+// continued by any operator allowed to make the call.
+func (c *Config) CheckRecordedCoordinator(ctx context.Context, coordinator []byte) error {
+	if len(coordinator) == 0 {
+		return nil
+	}
+	return c.CheckCallingOperator(ctx, coordinator)
+}
+
+// CheckDKGCoordinator checks that the call of the context was made by the DKG coordinator of this
+// operator, and returns it.
+func (c *Config) CheckDKGCoordinator(ctx context.Context) (*SigningOperator, error) {
+	coordinator, err := c.dkgCoordinator()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.CheckCallingOperator(ctx, coordinator.IdentityPublicKey); err != nil {
+		return nil, err
+	}
+	return coordinator, nil
+}
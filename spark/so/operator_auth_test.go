@@ -0,0 +1,64 @@
+package so
+
+import (
+	"context"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lightsparkdev/spark/so/utils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckCallingOperator(t *testing.T) {
+	operators := make([]*SigningOperator, 3)
+	keys := make([]*secp256k1.PrivateKey, len(operators))
+	config := &Config{SigningOperatorMap: make(map[string]*SigningOperator)}
+	for i := range operators {
+		key, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		operators[i] = &SigningOperator{
+			ID:                uint64(i),
+			Identifier:        utils.IndexToIdentifier(uint64(i)),
+			Address:           utils.IndexToIdentifier(uint64(i)) + ":8535",
+			IdentityPublicKey: key.PubKey().SerializeCompressed(),
+		}
+		config.SigningOperatorMap[operators[i].Identifier] = operators[i]
+	}
+	config.Identifier = operators[0].Identifier
+	config.IdentityPrivateKey = keys[0].Serialize()
+	config.DKGCoordinatorAddress = operators[1].Address
+
+	ctx := context.Background()
+	calledBy := func(operator *SigningOperator) context.Context {
+		return WithCallingOperator(ctx, operator)
+	}
+
+	// Calls of users are coordinated by this operator, internal calls by the operator that made them.
+	require.Equal(t, operators[0].IdentityPublicKey, config.CallCoordinator(ctx))
+	require.Equal(t, operators[2].IdentityPublicKey, config.CallCoordinator(calledBy(operators[2])))
+	require.Nil(t, config.CallCoordinator(WithUnsignedOperatorCall(ctx)))
+
+	require.NoError(t, config.CheckCallingOperator(calledBy(operators[2]), operators[2].IdentityPublicKey))
+	require.Equal(t, codes.PermissionDenied, status.Code(config.CheckCallingOperator(calledBy(operators[1]), operators[2].IdentityPublicKey)))
+	require.Equal(t, codes.Unauthenticated, status.Code(config.CheckCallingOperator(ctx, operators[2].IdentityPublicKey)))
+
+	// State without a recorded coordinator can be continued by any operator.
+	require.NoError(t, config.CheckRecordedCoordinator(calledBy(operators[1]), nil))
+	require.Equal(t, codes.PermissionDenied, status.Code(config.CheckRecordedCoordinator(calledBy(operators[1]), operators[2].IdentityPublicKey)))
+
+	// Only the DKG coordinator of this operator coordinates DKG sessions.
+	coordinator, err := config.CheckDKGCoordinator(calledBy(operators[1]))
+	require.NoError(t, err)
+	require.Equal(t, operators[1].Identifier, coordinator.Identifier)
+	_, err = config.CheckDKGCoordinator(calledBy(operators[2]))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Unsigned calls are accepted while the authentication is permissive.
+	config.OperatorAuthentication.Permissive = true
+	require.NoError(t, config.CheckCallingOperator(ctx, operators[2].IdentityPublicKey))
+	_, err = config.CheckDKGCoordinator(ctx)
+	require.NoError(t, err)
+}
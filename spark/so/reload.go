@@ -0,0 +1,183 @@
+package so
+
+import (
+	"maps"
+	"reflect"
+	"sort"
+
+	"github.com/lightsparkdev/spark/common"
+)
+
+// ReloadableConfig is the part of the configuration that can change while the operator runs. It
+// is reloaded from the config file on SIGHUP, and read through Reloadable rather than the fields
+// of Config, which keep the values the operator was started with.
+type ReloadableConfig struct {
+	// Version is the version of the configuration, which starts at 1 and is incremented by every
+	// reload that changes it.
+	Version uint64
+	// RateLimiter is the configuration for the rate limiter. Only its limits are reloaded, as the
+	// store requests are counted in is only created on startup.
+	RateLimiter RateLimiterConfig
+	// Lrc20Configs are the configurations for the LRC20 nodes. Only their flags and page size are
+	// reloaded, as their connections are only created on startup. Their withdrawal parameters are
+	// checked by every operator against those of the coordinator, so they must change on all
+	// operators at once, with a restart.
+	Lrc20Configs map[string]Lrc20Config
+	// BitcoindConfigs are the configurations for the bitcoin nodes. The chain watcher of a network
+	// is restarted when its configuration changes.
+	BitcoindConfigs map[string]BitcoindConfig
+	// Tracing is the configuration for tracing. Only its sampling rates are reloaded.
+	Tracing common.TracingConfig
+}
+
+// ReloadResult describes the settings that changed in a reload of the configuration. Settings are
+// named after their key in the config file.
+type ReloadResult struct {
+	// Version is the version of the configuration after the reload.
+	Version uint64
+	// Changed are the settings whose changes were applied.
+	Changed []string
+	// RestartRequired are the settings whose changes were not applied, as they only take effect
+	// when the operator is restarted.
+	RestartRequired []string
+}
+
+// Reloadable returns the current reloadable configuration, which must not be modified.
+func (c *Config) Reloadable() *ReloadableConfig {
+	if c.reloadable != nil {
+		return c.reloadable.Load()
+	}
+	return &ReloadableConfig{
+		RateLimiter:     c.RateLimiter,
+		Lrc20Configs:    c.Lrc20Configs,
+		BitcoindConfigs: c.BitcoindConfigs,
+		Tracing:         c.Tracing,
+	}
+}
+
+// Lrc20Config returns the current configuration for the LRC20 node of the network.
+func (c *Config) Lrc20Config(network string) (Lrc20Config, bool) {
+	lrc20Config, ok := c.Reloadable().Lrc20Configs[network]
+	return lrc20Config, ok
+}
+
+// Reload applies the settings of newConfig that can change at runtime, which is expected to be
+// loaded from the same flags and files as c. Changes to other settings are reported but ignored.
+func (c *Config) Reload(newConfig *Config) ReloadResult {
+	current := c.Reloadable()
+	next := &ReloadableConfig{
+		Version:         current.Version,
+		RateLimiter:     current.RateLimiter,
+		Lrc20Configs:    maps.Clone(current.Lrc20Configs),
+		BitcoindConfigs: maps.Clone(current.BitcoindConfigs),
+		Tracing:         current.Tracing,
+	}
+	var result ReloadResult
+
+	rateLimiter := newConfig.RateLimiter
+	if rateLimiter.Enabled != current.RateLimiter.Enabled ||
+		rateLimiter.Store != current.RateLimiter.Store ||
+		!reflect.DeepEqual(rateLimiter.MemcachedAddrs, current.RateLimiter.MemcachedAddrs) ||
+		rateLimiter.RedisAddr != current.RateLimiter.RedisAddr {
+		result.RestartRequired = append(result.RestartRequired, "rate_limiter")
+	}
+	next.RateLimiter.Window = rateLimiter.Window
+	next.RateLimiter.MaxRequests = rateLimiter.MaxRequests
+	next.RateLimiter.Methods = rateLimiter.Methods
+	next.RateLimiter.MethodLimits = rateLimiter.MethodLimits
+	next.RateLimiter.MaxStreams = rateLimiter.MaxStreams
+	if !reflect.DeepEqual(next.RateLimiter, current.RateLimiter) {
+		result.Changed = append(result.Changed, "rate_limiter")
+	}
+
+	for _, network := range sortedKeys(current.Lrc20Configs, newConfig.Lrc20Configs) {
+		name := "lrc20." + network
+		lrc20Config, ok := current.Lrc20Configs[network]
+		newLrc20Config, newOk := newConfig.Lrc20Configs[network]
+		if !ok || !newOk {
+			result.RestartRequired = append(result.RestartRequired, name)
+			continue
+		}
+		updated := lrc20Config
+		updated.DisableRpcs = newLrc20Config.DisableRpcs
+		updated.DisableL1 = newLrc20Config.DisableL1
+		updated.GRPCPageSize = newLrc20Config.GRPCPageSize
+		if updated != newLrc20Config {
+			result.RestartRequired = append(result.RestartRequired, name)
+		}
+		if updated != lrc20Config {
+			next.Lrc20Configs[network] = updated
+			result.Changed = append(result.Changed, name)
+		}
+	}
+
+	for _, network := range sortedKeys(current.BitcoindConfigs, newConfig.BitcoindConfigs) {
+		name := "bitcoind." + network
+		bitcoindConfig, ok := current.BitcoindConfigs[network]
+		newBitcoindConfig, newOk := newConfig.BitcoindConfigs[network]
+		if !ok || !newOk || newBitcoindConfig.Network != bitcoindConfig.Network {
+			result.RestartRequired = append(result.RestartRequired, name)
+			continue
+		}
+		if newBitcoindConfig != bitcoindConfig {
+			next.BitcoindConfigs[network] = newBitcoindConfig
+			result.Changed = append(result.Changed, name)
+		}
+	}
+
+	tracing := newConfig.Tracing
+	if tracing.Enabled != current.Tracing.Enabled ||
+		tracing.OTelCollectorEndpoint != current.Tracing.OTelCollectorEndpoint ||
+		tracing.OTelCollectorCertPath != current.Tracing.OTelCollectorCertPath {
+		result.RestartRequired = append(result.RestartRequired, "tracing")
+	}
+	next.Tracing.GlobalSamplingRate = tracing.GlobalSamplingRate
+	next.Tracing.SpanSamplingConfig = tracing.SpanSamplingConfig
+	if !reflect.DeepEqual(next.Tracing, current.Tracing) {
+		result.Changed = append(result.Changed, "tracing")
+	}
+
+	for _, setting := range []struct {
+		name          string
+		current, next any
+	}{
+		{"identity key", c.IdentityPrivateKey, newConfig.IdentityPrivateKey},
+		{"operators", c.SigningOperatorMap, newConfig.SigningOperatorMap},
+		{"encryption", c.Encryption, newConfig.Encryption},
+		{"operator_set", []any{c.OperatorSetEpoch, c.PreviousOperatorSet}, []any{newConfig.OperatorSetEpoch, newConfig.PreviousOperatorSet}},
+		{"consistency_audit", c.ConsistencyAudit, newConfig.ConsistencyAudit},
+		{"authorization", c.AuthorizationPolicy, newConfig.AuthorizationPolicy},
+		{"quotas", c.Quotas, newConfig.Quotas},
+		{"read_replica", c.ReadReplica, newConfig.ReadReplica},
+		{"retention", c.Retention, newConfig.Retention},
+		{"operator_authentication", c.OperatorAuthentication, newConfig.OperatorAuthentication},
+	} {
+		if !reflect.DeepEqual(setting.current, setting.next) {
+			result.RestartRequired = append(result.RestartRequired, setting.name)
+		}
+	}
+
+	if len(result.Changed) > 0 && c.reloadable != nil {
+		next.Version++
+		c.reloadable.Store(next)
+	}
+	result.Version = c.Reloadable().Version
+	return result
+}
+
+// sortedKeys returns the keys of both maps, sorted.
+func sortedKeys[V any](a, b map[string]V) []string {
+	keys := make(map[string]struct{}, len(a)+len(b))
+	for key := range a {
+		keys[key] = struct{}{}
+	}
+	for key := range b {
+		keys[key] = struct{}{}
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
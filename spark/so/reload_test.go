@@ -0,0 +1,137 @@
+package so
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lightsparkdev/spark/common"
+	"github.com/stretchr/testify/require"
+)
+
+// testOperatorFiles are the key and operators files of the operator 0 of a set of two.
+type testOperatorFiles struct {
+	dir           string
+	keyPath       string
+	operatorsPath string
+}
+
+func newTestOperatorFiles(t *testing.T) *testOperatorFiles {
+	files := &testOperatorFiles{dir: t.TempDir()}
+	files.keyPath = filepath.Join(files.dir, "key")
+	files.operatorsPath = filepath.Join(files.dir, "operators.yaml")
+	var operators string
+	for i := range 2 {
+		key, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		if i == 0 {
+			require.NoError(t, os.WriteFile(files.keyPath, []byte(hex.EncodeToString(key.Serialize())), 0o600))
+		}
+		operators += fmt.Sprintf("- id: %d\n  address: localhost:%d\n  identity_public_key: %x\n", i, 8535+i, key.PubKey().SerializeCompressed())
+	}
+	require.NoError(t, os.WriteFile(files.operatorsPath, []byte(operators), 0o600))
+	return files
+}
+
+// load creates the config of the operator with the given config file.
+func (f *testOperatorFiles) load(t *testing.T, configFile string) *Config {
+	configPath := filepath.Join(f.dir, "so_config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(configFile), 0o600))
+	config, err := NewConfig(configPath, 0, f.keyPath, f.operatorsPath, 2, "localhost:9999", "file::memory:", true, "",
+		[]common.Network{common.Regtest}, false, "", "", 0, f.dir, false,
+		RateLimiterConfig{Window: time.Minute, MaxRequests: 10, Store: "memory"})
+	require.NoError(t, err)
+	return config
+}
+
+const testConfigFile = `
+bitcoind:
+  regtest:
+    network: regtest
+    host: 127.0.0.1:8332
+    rpcuser: user
+    rpcpassword: password
+lrc20:
+  regtest:
+    network: regtest
+    host: 127.0.0.1:18530
+    grpcspagesize: 100
+`
+
+func TestConfigReload(t *testing.T) {
+	files := newTestOperatorFiles(t)
+	config := files.load(t, testConfigFile)
+	require.NoError(t, config.Validate())
+	require.Equal(t, uint64(1), config.Reloadable().Version)
+
+	result := config.Reload(files.load(t, testConfigFile))
+	require.Equal(t, ReloadResult{Version: 1}, result)
+
+	result = config.Reload(files.load(t, `
+rate_limiter:
+  window: 1m
+  max_requests: 5
+  store: memory
+bitcoind:
+  regtest:
+    network: regtest
+    host: 127.0.0.1:18443
+    rpcuser: user
+    rpcpassword: password
+lrc20:
+  regtest:
+    network: regtest
+    host: 127.0.0.1:18531
+    disablerpcs: true
+    withdrawbondsats: 10000
+    grpcspagesize: 100
+quotas:
+  max_unused_deposit_addresses: 3
+`))
+	require.Equal(t, uint64(2), result.Version)
+	require.Equal(t, []string{"rate_limiter", "lrc20.regtest", "bitcoind.regtest"}, result.Changed)
+	require.Equal(t, []string{"lrc20.regtest", "quotas"}, result.RestartRequired)
+
+	require.Equal(t, 5, config.GetRateLimiterConfig().MaxRequests)
+	require.Equal(t, "127.0.0.1:18443", config.Reloadable().BitcoindConfigs["regtest"].Host)
+	lrc20Config, ok := config.Lrc20Config("regtest")
+	require.True(t, ok)
+	require.True(t, lrc20Config.DisableRpcs)
+	// Settings that only take effect on restart keep their values.
+	require.Equal(t, "127.0.0.1:18530", lrc20Config.Host)
+	require.Zero(t, lrc20Config.WithdrawBondSats)
+	require.Equal(t, 0, config.Quotas.MaxUnusedDepositAddresses)
+	// The fields of the config keep the values it was created with.
+	require.Equal(t, 10, config.RateLimiter.MaxRequests)
+	require.False(t, config.Lrc20Configs["regtest"].DisableRpcs)
+}
+
+func TestConfigValidate(t *testing.T) {
+	files := newTestOperatorFiles(t)
+	config := files.load(t, `
+bitcoind:
+  regtest:
+    network: mainnet
+lrc20:
+  regtest:
+    network: regtest
+    host: 127.0.0.1:18530
+    relativecertpath: missing.pem
+`)
+	config.Threshold = 3
+	config.DKGCoordinatorAddress = "localhost:9000"
+	config.IdentityPrivateKey = make([]byte, 32)
+	config.IdentityPrivateKey[31] = 1
+
+	err := config.Validate()
+	require.ErrorContains(t, err, "threshold 3 must be between 1 and the 2 operators of the set")
+	require.ErrorContains(t, err, "identity private key does not match the identity public key of operator 0")
+	require.ErrorContains(t, err, "DKG coordinator address localhost:9000 is not the address of an operator")
+	require.ErrorContains(t, err, "bitcoind regtest is configured for network mainnet")
+	require.ErrorContains(t, err, "bitcoind regtest has no host")
+	require.ErrorContains(t, err, "failed to read the certificate of lrc20 regtest")
+}
@@ -6,6 +6,8 @@ import (
 	"sync"
 
 	pb "github.com/lightsparkdev/spark/proto/spark"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
@@ -14,8 +16,21 @@ import (
 var (
 	defaultRouter *EventRouter
 	routerOnce    sync.Once
+
+	subscribersCounter metric.Int64UpDownCounter
 )
 
+func init() {
+	var err error
+	subscribersCounter, err = otel.Meter("events").Int64UpDownCounter(
+		"spark_event_stream_subscribers",
+		metric.WithDescription("Number of open event streams"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
 func GetDefaultRouter() *EventRouter {
 	routerOnce.Do(func() {
 		defaultRouter = NewEventRouter()
@@ -99,6 +114,8 @@ func SubscribeToEvents(identityPublicKey []byte, st pb.SparkService_SubscribeToE
 	if err := streamRouter.RegisterStream(identityPublicKey, st); err != nil {
 		return err
 	}
+	subscribersCounter.Add(st.Context(), 1)
+	defer subscribersCounter.Add(st.Context(), -1)
 
 	connectedEvent := &pb.SubscribeToEventsResponse{
 		Event: &pb.SubscribeToEventsResponse_Connected{
@@ -0,0 +1,198 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+	"github.com/lightsparkdev/spark/common/logging"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/tasklock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ErrTaskLocked is returned when a task lock is held by another scheduler instance.
+var ErrTaskLocked = errors.New("task is locked by another instance")
+
+// ErrTaskLockLost is the cause of the cancellation of a task run whose lock could not be renewed,
+// and may have been taken over by another instance.
+var ErrTaskLockLost = errors.New("task lock was lost")
+
+// DefaultLockLease is how long a task lock is held without being renewed before other instances
+// may take it over, in case the holder dies without releasing it. Held locks are renewed every
+// third of their lease.
+const DefaultLockLease = 1 * time.Minute
+
+// DBLocker is a gocron.Locker backed by the task_locks table. All replicas of an operator share a
+// database, so a lease held in the table guarantees that a task runs on at most one replica. The
+// lease of a held lock is renewed until it is unlocked, so that tasks can run for longer than it.
+type DBLocker struct {
+	db     *ent.Client
+	holder string
+	lease  time.Duration
+
+	mu   sync.Mutex
+	held map[string]*dbLock
+}
+
+// NewDBLocker creates a new DBLocker that takes locks on behalf of the given holder.
+func NewDBLocker(db *ent.Client, holder string, lease time.Duration) *DBLocker {
+	return &DBLocker{db: db, holder: holder, lease: lease, held: make(map[string]*dbLock)}
+}
+
+// Lock takes the lock with the given key. It fails with ErrTaskLocked if another holder has an
+// unexpired lease on it, including another run from the same holder.
+func (l *DBLocker) Lock(ctx context.Context, key string) (gocron.Lock, error) {
+	now := time.Now()
+	expiresAt := now.Add(l.lease)
+
+	// Take over the lock if the current lease has expired. This is a single conditional update so
+	// that two instances racing for an expired lock cannot both win.
+	updated, err := l.db.TaskLock.Update().
+		Where(
+			tasklock.NameEQ(key),
+			tasklock.ExpiresAtLT(now),
+		).
+		SetHolder(l.holder).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update task lock %s: %w", key, err)
+	}
+
+	if updated == 0 {
+		err := l.db.TaskLock.Create().
+			SetName(key).
+			SetHolder(l.holder).
+			SetExpiresAt(expiresAt).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			taskLockSkipsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("task", key)))
+			return nil, ErrTaskLocked
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create task lock %s: %w", key, err)
+		}
+	}
+
+	lock := &dbLock{db: l.db, name: key, holder: l.holder, locker: l}
+	lock.lost, lock.loseLease = context.WithCancel(context.Background())
+	lock.stopRenewing = make(chan struct{})
+	lock.renewed = make(chan struct{})
+	l.mu.Lock()
+	l.held[key] = lock
+	l.mu.Unlock()
+	go l.renew(context.WithoutCancel(ctx), lock, expiresAt)
+	return lock, nil
+}
+
+// LeaseContext returns a context derived from ctx, which is cancelled with ErrTaskLockLost if the
+// lease of the lock held on the key is lost before it is unlocked. Task runs use it so that they
+// stop once another instance may have taken them over.
+func (l *DBLocker) LeaseContext(ctx context.Context, key string) (context.Context, context.CancelFunc) {
+	l.mu.Lock()
+	lock, ok := l.held[key]
+	l.mu.Unlock()
+	ctx, cancel := context.WithCancelCause(ctx)
+	if !ok {
+		return ctx, func() { cancel(context.Canceled) }
+	}
+	stop := context.AfterFunc(lock.lost, func() { cancel(ErrTaskLockLost) })
+	return ctx, func() {
+		stop()
+		cancel(context.Canceled)
+	}
+}
+
+// renew extends the lease of the lock every third of the lease, until it is unlocked or the lease
+// is lost, because another instance took the lock over or it could not be renewed before it
+// expired.
+func (l *DBLocker) renew(ctx context.Context, lock *dbLock, expiresAt time.Time) {
+	defer close(lock.renewed)
+	ticker := time.NewTicker(l.lease / 3)
+	defer ticker.Stop()
+	logger := logging.GetLoggerFromContext(ctx).With("task.name", lock.name)
+	for {
+		select {
+		case <-lock.stopRenewing:
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		updated, err := l.db.TaskLock.Update().
+			Where(
+				tasklock.NameEQ(lock.name),
+				tasklock.HolderEQ(lock.holder),
+				tasklock.ExpiresAtGT(now),
+			).
+			SetExpiresAt(now.Add(l.lease)).
+			Save(ctx)
+		switch {
+		case err == nil && updated > 0:
+			expiresAt = now.Add(l.lease)
+			continue
+		case err == nil:
+			logger.Error("Task lock was taken over by another instance")
+		case now.Add(l.lease / 3).Before(expiresAt):
+			logger.Warn("Failed to renew task lock, retrying", "error", err)
+			continue
+		default:
+			logger.Error("Failed to renew task lock before its lease expired", "error", err)
+		}
+		taskLockLossesCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("task", lock.name)))
+		lock.loseLease()
+		return
+	}
+}
+
+type dbLock struct {
+	db     *ent.Client
+	name   string
+	holder string
+
+	locker *DBLocker
+
+	// lost is cancelled when the lease is lost while the lock is held.
+	lost      context.Context
+	loseLease context.CancelFunc
+	// stopRenewing is closed by Unlock, and renewed once the lease is no longer being renewed.
+	stopRenewing chan struct{}
+	renewed      chan struct{}
+	stopOnce     sync.Once
+}
+
+// Unlock stops renewing the lease and releases the lock by expiring its lease, if it is still held
+// by this holder.
+func (l *dbLock) Unlock(ctx context.Context) error {
+	l.stopRenewal()
+	_, err := l.db.TaskLock.Update().
+		Where(
+			tasklock.NameEQ(l.name),
+			tasklock.HolderEQ(l.holder),
+		).
+		SetExpiresAt(time.Now()).
+		Save(ctx)
+	return err
+}
+
+// stopRenewal stops renewing the lease, and waits until it is no longer being renewed.
+func (l *dbLock) stopRenewal() {
+	l.stopOnce.Do(func() {
+		if l.stopRenewing != nil {
+			close(l.stopRenewing)
+			<-l.renewed
+		}
+		if l.locker != nil {
+			l.locker.mu.Lock()
+			if l.locker.held[l.name] == l {
+				delete(l.locker.held, l.name)
+			}
+			l.locker.mu.Unlock()
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightsparkdev/spark/so/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBLocker(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:task_lock?mode=memory&_fk=1")
+	defer db.Close()
+
+	first := NewDBLocker(db, "first", time.Minute)
+	second := NewDBLocker(db, "second", time.Minute)
+
+	lock, err := first.Lock(ctx, "dkg")
+	require.NoError(t, err)
+
+	_, err = second.Lock(ctx, "dkg")
+	require.ErrorIs(t, err, ErrTaskLocked)
+
+	// The same holder cannot take the lock twice either.
+	_, err = first.Lock(ctx, "dkg")
+	require.ErrorIs(t, err, ErrTaskLocked)
+
+	// Locks on other tasks are independent.
+	otherLock, err := second.Lock(ctx, "cancel_expired_transfers")
+	require.NoError(t, err)
+	require.NoError(t, otherLock.Unlock(ctx))
+
+	require.NoError(t, lock.Unlock(ctx))
+	lock, err = second.Lock(ctx, "dkg")
+	require.NoError(t, err)
+
+	// Unlocking a lock that has been taken over does not release the new holder's lease.
+	require.NoError(t, (&dbLock{db: db, name: "dkg", holder: "first"}).Unlock(ctx))
+	_, err = first.Lock(ctx, "dkg")
+	require.ErrorIs(t, err, ErrTaskLocked)
+	require.NoError(t, lock.Unlock(ctx))
+}
+
+func TestDBLockerExpiredLease(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:task_lock_expired?mode=memory&_fk=1")
+	defer db.Close()
+
+	crashed, err := NewDBLocker(db, "crashed", time.Millisecond).Lock(ctx, "dkg")
+	require.NoError(t, err)
+	// The holder crashes, so its lease is no longer renewed.
+	crashed.(*dbLock).stopRenewal()
+
+	time.Sleep(5 * time.Millisecond)
+
+	lock, err := NewDBLocker(db, "healthy", time.Minute).Lock(ctx, "dkg")
+	require.NoError(t, err)
+	require.NoError(t, lock.Unlock(ctx))
+}
+
+func TestDBLockerRenewsLease(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:task_lock_renew?mode=memory&_fk=1")
+	defer db.Close()
+
+	locker := NewDBLocker(db, "long", 30*time.Millisecond)
+	lock, err := locker.Lock(ctx, "dkg")
+	require.NoError(t, err)
+	leaseCtx, cancel := locker.LeaseContext(ctx, "dkg")
+	defer cancel()
+
+	// The lease is renewed while the task runs for longer than it.
+	time.Sleep(100 * time.Millisecond)
+	_, err = NewDBLocker(db, "other", time.Minute).Lock(ctx, "dkg")
+	require.ErrorIs(t, err, ErrTaskLocked)
+	require.NoError(t, leaseCtx.Err())
+
+	// Once the lock is taken over, the run is cancelled.
+	_, err = db.TaskLock.Update().SetHolder("other").Save(ctx)
+	require.NoError(t, err)
+	select {
+	case <-leaseCtx.Done():
+		require.ErrorIs(t, context.Cause(leaseCtx), ErrTaskLockLost)
+	case <-time.After(time.Second):
+		t.Fatal("run was not cancelled after its lease was lost")
+	}
+	require.NoError(t, lock.Unlock(ctx))
+}
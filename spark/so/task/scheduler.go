@@ -0,0 +1,237 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/common/logging"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/schema"
+	"github.com/lightsparkdev/spark/so/ent/taskrun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ErrTaskNotFound is returned when no task with the requested name is registered.
+var ErrTaskNotFound = errors.New("task not found")
+
+type itemsProcessedContextKey string
+
+const itemsProcessedKey = itemsProcessedContextKey("itemsProcessed")
+
+// AddItemsProcessed adds n to the number of items processed by the task run in the context.
+// It does nothing when called outside of a task run.
+func AddItemsProcessed(ctx context.Context, n int) {
+	if items, ok := ctx.Value(itemsProcessedKey).(*atomic.Int64); ok {
+		items.Add(int64(n))
+	}
+}
+
+// Scheduler runs the background tasks of an operator, both on their schedule and on demand.
+// Every run takes the task's lock so that it only runs on one replica of the operator at a time,
+// and is recorded in the task_runs table.
+type Scheduler struct {
+	ctx       context.Context
+	config    *so.Config
+	db        *ent.Client
+	holder    string
+	locker    *DBLocker
+	tasks     []Task
+	scheduler gocron.Scheduler
+}
+
+// NewScheduler creates a new Scheduler for the given tasks. Runs use the given context, so
+// cancelling it cancels all in-flight runs. Call Start to begin running the tasks on schedule.
+func NewScheduler(ctx context.Context, config *so.Config, db *ent.Client, tasks []Task) (*Scheduler, error) {
+	holder := newHolderID()
+	locker := NewDBLocker(db, holder, DefaultLockLease)
+
+	scheduler, err := gocron.NewScheduler(
+		gocron.WithGlobalJobOptions(gocron.WithContext(ctx)),
+		gocron.WithLogger(logging.GetLoggerFromContext(ctx)),
+		gocron.WithDistributedLocker(locker),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scheduler: %w", err)
+	}
+
+	s := &Scheduler{
+		ctx:       ctx,
+		config:    config,
+		db:        db,
+		holder:    holder,
+		locker:    locker,
+		tasks:     tasks,
+		scheduler: scheduler,
+	}
+
+	for i := range s.tasks {
+		task := &s.tasks[i]
+		_, err := scheduler.NewJob(
+			gocron.DurationJob(task.Duration),
+			gocron.NewTask(func(ctx context.Context) error {
+				return s.run(ctx, task, schema.TaskRunTriggerScheduled)
+			}),
+			gocron.WithName(task.Name),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create job for task %s: %w", task.Name, err)
+		}
+	}
+
+	return s, nil
+}
+
+// Start starts running the tasks on their schedule.
+func (s *Scheduler) Start() {
+	s.scheduler.Start()
+}
+
+// Shutdown stops the schedule and waits for running tasks to finish.
+func (s *Scheduler) Shutdown() error {
+	return s.scheduler.Shutdown()
+}
+
+// Tasks returns the tasks of the scheduler.
+func (s *Scheduler) Tasks() []Task {
+	return s.tasks
+}
+
+// RecentRuns returns the most recent runs of the given task, newest first.
+func (s *Scheduler) RecentRuns(ctx context.Context, name string, limit int) ([]*ent.TaskRun, error) {
+	return s.db.TaskRun.Query().
+		Where(taskrun.TaskNameEQ(name)).
+		Order(ent.Desc(taskrun.FieldCreateTime)).
+		Limit(limit).
+		All(ctx)
+}
+
+// Trigger runs the given task now, outside of its schedule. It returns once the run is recorded
+// and the task is running in the background. It fails with ErrTaskLocked if the task is already
+// running on any replica.
+func (s *Scheduler) Trigger(ctx context.Context, name string) (*ent.TaskRun, error) {
+	task := s.task(name)
+	if task == nil {
+		return nil, ErrTaskNotFound
+	}
+
+	lock, err := s.locker.Lock(ctx, task.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The run must outlive the request that triggered it, so it uses the scheduler context.
+	runCtx := s.ctx
+	run, err := s.startRun(runCtx, task, schema.TaskRunTriggerManual)
+	if err != nil {
+		_ = lock.Unlock(runCtx)
+		return nil, err
+	}
+
+	go func() {
+		defer func() {
+			if err := lock.Unlock(runCtx); err != nil {
+				logging.GetLoggerFromContext(runCtx).Error("Failed to release task lock", "task.name", task.Name, "error", err)
+			}
+		}()
+		leaseCtx, cancel := s.locker.LeaseContext(runCtx, task.Name)
+		defer cancel()
+		_ = s.execute(leaseCtx, task, run)
+	}()
+
+	return run, nil
+}
+
+func (s *Scheduler) task(name string) *Task {
+	for i := range s.tasks {
+		if s.tasks[i].Name == name {
+			return &s.tasks[i]
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) run(ctx context.Context, task *Task, trigger schema.TaskRunTrigger) error {
+	// The scheduler holds the lock of the task while it runs, and the run stops if its lease is lost.
+	ctx, cancel := s.locker.LeaseContext(ctx, task.Name)
+	defer cancel()
+	run, err := s.startRun(ctx, task, trigger)
+	if err != nil {
+		return err
+	}
+	return s.execute(ctx, task, run)
+}
+
+func (s *Scheduler) startRun(ctx context.Context, task *Task, trigger schema.TaskRunTrigger) (*ent.TaskRun, error) {
+	run, err := s.db.TaskRun.Create().
+		SetTaskName(task.Name).
+		SetStatus(schema.TaskRunStatusRunning).
+		SetTrigger(trigger).
+		SetHolder(s.holder).
+		Save(ctx)
+	if err != nil {
+		logging.GetLoggerFromContext(ctx).Error("Failed to record task run", "task.name", task.Name, "error", err)
+		return nil, fmt.Errorf("failed to record run of task %s: %w", task.Name, err)
+	}
+	return run, nil
+}
+
+func (s *Scheduler) execute(ctx context.Context, task *Task, run *ent.TaskRun) error {
+	logger := logging.GetLoggerFromContext(ctx).
+		With("task.name", task.Name).
+		With("task.id", run.ID.String())
+	ctx = logging.Inject(ctx, logger)
+
+	items := new(atomic.Int64)
+	ctx = context.WithValue(ctx, itemsProcessedKey, items)
+
+	start := time.Now()
+	err := task.Task(ctx, s.config, s.db)
+	duration := time.Since(start)
+	if err != nil && errors.Is(context.Cause(ctx), ErrTaskLockLost) {
+		err = fmt.Errorf("%w: %w", ErrTaskLockLost, err)
+	}
+
+	status := schema.TaskRunStatusSucceeded
+	update := run.Update().
+		SetEndTime(time.Now()).
+		SetItemsProcessed(items.Load())
+	if err != nil {
+		logger.Error("Task failed!", "error", err)
+		status = schema.TaskRunStatusFailed
+		update = update.SetError(err.Error())
+	}
+
+	// Record the result even if the run context was cancelled, e.g. on shutdown.
+	if _, updateErr := update.SetStatus(status).Save(context.WithoutCancel(ctx)); updateErr != nil {
+		logger.Error("Failed to record task run result", "error", updateErr)
+	}
+
+	attrs := metric.WithAttributes(
+		attribute.String("task", task.Name),
+		attribute.String("status", string(status)),
+		attribute.String("trigger", string(run.Trigger)),
+	)
+	taskRunsCounter.Add(ctx, 1, attrs)
+	taskDuration.Record(ctx, duration.Seconds(), attrs)
+	taskItemsCounter.Add(ctx, items.Load(), metric.WithAttributes(attribute.String("task", task.Name)))
+
+	return err
+}
+
+// newHolderID returns an identifier for this scheduler instance that is unique across replicas
+// and restarts.
+func newHolderID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8])
+}
@@ -4,8 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/go-co-op/gocron/v2"
-	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark"
 	"github.com/lightsparkdev/spark/common/logging"
 	pbspark "github.com/lightsparkdev/spark/proto/spark"
 	"github.com/lightsparkdev/spark/so"
@@ -13,6 +12,7 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/schema"
 	"github.com/lightsparkdev/spark/so/ent/transfer"
 	"github.com/lightsparkdev/spark/so/handler"
+	"github.com/lightsparkdev/spark/so/keymanager"
 )
 
 // Task is a task that is scheduled to run.
@@ -27,6 +27,7 @@ type Task struct {
 
 // AllTasks returns all the tasks that are scheduled to run.
 func AllTasks() []Task {
+	keysharePoolMonitor := ent.NewKeysharePoolMonitor()
 	return []Task{
 		{
 			Name:     "dkg",
@@ -35,6 +36,155 @@ func AllTasks() []Task {
 				return ent.RunDKGIfNeeded(ctx, db, config)
 			},
 		},
+		{
+			Name:     "monitor_keyshare_pool",
+			Duration: 1 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				return keysharePoolMonitor.Check(ctx, db, config)
+			},
+		},
+		{
+			Name:     "recycle_deposit_addresses",
+			Duration: 10 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				return DBTransactionTask(ctx, config, db, func(ctx context.Context, config *so.Config) error {
+					count, err := handler.NewDepositHandler(config, db).RecycleExpiredDepositAddresses(ctx)
+					AddItemsProcessed(ctx, count)
+					return err
+				})
+			},
+		},
+		{
+			Name:     "abort_expired_dkg_sessions",
+			Duration: 1 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				return DBTransactionTask(ctx, config, db, func(ctx context.Context, _ *so.Config) error {
+					count, err := ent.AbortExpiredDkgSessions(ctx)
+					AddItemsProcessed(ctx, count)
+					return err
+				})
+			},
+		},
+		{
+			Name:     "purge_signing_nonces",
+			Duration: 1 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				return DBTransactionTask(ctx, config, db, func(ctx context.Context, _ *so.Config) error {
+					count, err := ent.PurgeSigningNonces(ctx, spark.SigningNoncePurgeBatchSize)
+					AddItemsProcessed(ctx, count)
+					return err
+				})
+			},
+		},
+		{
+			Name:     "archive_transfers",
+			Duration: 1 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				if config.Retention.TransferRetention <= 0 {
+					return nil
+				}
+				return DBTransactionTask(ctx, config, db, func(ctx context.Context, config *so.Config) error {
+					cutoff := time.Now().Add(-config.Retention.TransferRetention)
+					count, err := ent.ArchiveTransfers(ctx, cutoff, spark.RetentionArchiveBatchSize)
+					AddItemsProcessed(ctx, count)
+					return err
+				})
+			},
+		},
+		{
+			Name:     "archive_token_outputs",
+			Duration: 1 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				if config.Retention.TokenOutputRetention <= 0 {
+					return nil
+				}
+				return DBTransactionTask(ctx, config, db, func(ctx context.Context, config *so.Config) error {
+					cutoff := time.Now().Add(-config.Retention.TokenOutputRetention)
+					count, err := ent.ArchiveTokenOutputs(ctx, cutoff, spark.RetentionArchiveBatchSize)
+					AddItemsProcessed(ctx, count)
+					return err
+				})
+			},
+		},
+		{
+			Name:     "purge_idempotency_keys",
+			Duration: 10 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				return DBTransactionTask(ctx, config, db, func(ctx context.Context, _ *so.Config) error {
+					count, err := ent.PurgeIdempotencyKeys(ctx, spark.IdempotencyKeyPurgeBatchSize)
+					AddItemsProcessed(ctx, count)
+					return err
+				})
+			},
+		},
+		{
+			Name:     "purge_session_revocations",
+			Duration: 10 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				return DBTransactionTask(ctx, config, db, func(ctx context.Context, _ *so.Config) error {
+					count, err := ent.PurgeSessionRevocations(ctx, spark.SessionRevocationPurgeBatchSize)
+					AddItemsProcessed(ctx, count)
+					return err
+				})
+			},
+		},
+		{
+			Name:     "purge_operator_call_nonces",
+			Duration: 10 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				return DBTransactionTask(ctx, config, db, func(ctx context.Context, _ *so.Config) error {
+					count, err := ent.PurgeOperatorCallNonces(ctx, spark.OperatorCallNoncePurgeBatchSize)
+					AddItemsProcessed(ctx, count)
+					return err
+				})
+			},
+		},
+		{
+			Name:     "purge_recent_writes",
+			Duration: 1 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				return DBTransactionTask(ctx, config, db, func(ctx context.Context, _ *so.Config) error {
+					count, err := ent.PurgeRecentWrites(ctx, spark.RecentWritePurgeBatchSize)
+					AddItemsProcessed(ctx, count)
+					return err
+				})
+			},
+		},
+		{
+			Name:     "consistency_audit",
+			Duration: 5 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, db *ent.Client) error {
+				return DBTransactionTask(ctx, config, db, func(ctx context.Context, config *so.Config) error {
+					h := handler.NewConsistencyHandler(config)
+					for _, kind := range schema.ConsistencyKind("").Values() {
+						count, err := h.Audit(ctx, schema.ConsistencyKind(kind))
+						AddItemsProcessed(ctx, count)
+						if err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			},
+		},
+		{
+			Name:     "share_refresh",
+			Duration: 1 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, _ *ent.Client) error {
+				count, err := ent.RunShareRefresh(ctx, config)
+				AddItemsProcessed(ctx, count)
+				return err
+			},
+		},
+		{
+			Name:     "reshare",
+			Duration: 1 * time.Minute,
+			Task: func(ctx context.Context, config *so.Config, _ *ent.Client) error {
+				count, err := ent.RunReshare(ctx, config)
+				AddItemsProcessed(ctx, count)
+				return err
+			},
+		},
 		{
 			Name:     "cancel_expired_transfers",
 			Duration: 1 * time.Minute,
@@ -63,7 +213,9 @@ func AllTasks() []Task {
 						}, handler.CancelTransferIntentTask)
 						if err != nil {
 							logger.Error("failed to cancel transfer", "error", err)
+							continue
 						}
+						AddItemsProcessed(ctx, 1)
 					}
 
 					return nil
@@ -73,33 +225,21 @@ func AllTasks() []Task {
 	}
 }
 
-func (t *Task) Schedule(scheduler gocron.Scheduler, config *so.Config, db *ent.Client) error {
-	_, err := scheduler.NewJob(
-		gocron.DurationJob(t.Duration),
-		gocron.NewTask(t.createWrappedTask(), config, db),
-		gocron.WithName(t.Name),
-	)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (t *Task) createWrappedTask() func(context.Context, *so.Config, *ent.Client) error {
-	return func(ctx context.Context, config *so.Config, db *ent.Client) error {
-		logger := logging.GetLoggerFromContext(ctx).
-			With("task.name", t.Name).
-			With("task.id", uuid.New().String())
-
-		ctx = logging.Inject(ctx, logger)
-
-		err := t.Task(ctx, config, db)
-		if err != nil {
-			logger.Error("Task failed!", "error", err)
-		}
-
-		return err
+// ReencryptSigningSecretsTask returns a task that encrypts signing keyshares stored in plaintext and
+// rewraps data keys after the key encryption key is rotated.
+func ReencryptSigningSecretsTask(envelope *keymanager.Envelope) Task {
+	return Task{
+		Name:     "reencrypt_signing_secrets",
+		Duration: 10 * time.Minute,
+		Task: func(ctx context.Context, _ *so.Config, db *ent.Client) error {
+			for {
+				updated, err := ent.ReencryptSigningSecrets(ctx, db, envelope, 1000)
+				AddItemsProcessed(ctx, updated)
+				if err != nil || updated == 0 {
+					return err
+				}
+			}
+		},
 	}
 }
 
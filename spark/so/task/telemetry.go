@@ -0,0 +1,56 @@
+package task
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	meter = otel.Meter("task")
+
+	taskRunsCounter       metric.Int64Counter
+	taskDuration          metric.Float64Histogram
+	taskItemsCounter      metric.Int64Counter
+	taskLockSkipsCounter  metric.Int64Counter
+	taskLockLossesCounter metric.Int64Counter
+)
+
+func init() {
+	var err error
+	taskRunsCounter, err = meter.Int64Counter(
+		"spark_task_runs",
+		metric.WithDescription("Number of finished background task runs"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	taskDuration, err = meter.Float64Histogram(
+		"spark_task_duration",
+		metric.WithDescription("Duration of background task runs"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	taskItemsCounter, err = meter.Int64Counter(
+		"spark_task_items_processed",
+		metric.WithDescription("Number of items processed by background task runs"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	taskLockSkipsCounter, err = meter.Int64Counter(
+		"spark_task_lock_skips",
+		metric.WithDescription("Number of task runs skipped because another instance held the task lock"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	taskLockLossesCounter, err = meter.Int64Counter(
+		"spark_task_lock_losses",
+		metric.WithDescription("Number of task runs cancelled because the lease of their task lock could not be renewed"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
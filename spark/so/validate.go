@@ -0,0 +1,152 @@
+package so
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lightsparkdev/spark/common"
+)
+
+// Validate checks the consistency of the configuration with the files it refers to: the identity
+// key, the operators, the networks and the certificates. It does not connect to anything. Every
+// problem found is returned, joined into one error.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	check(c.validateOperators())
+	if c.DKGCoordinatorAddress != "" {
+		_, err := c.dkgCoordinator()
+		check(err)
+	}
+	check(c.validateIdentityKey())
+	check(c.validateNetworks())
+	if (c.ServerCertPath == "") != (c.ServerKeyPath == "") {
+		check(fmt.Errorf("server certificate and key must be set together"))
+	} else if c.ServerCertPath != "" {
+		if _, err := tls.LoadX509KeyPair(c.ServerCertPath, c.ServerKeyPath); err != nil {
+			check(fmt.Errorf("failed to load server certificate: %w", err))
+		}
+	}
+	if c.Tracing.Enabled {
+		check(validateCertificate("tracing collector", c.Tracing.OTelCollectorCertPath))
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Config) validateOperators() error {
+	if len(c.SigningOperatorMap) == 0 {
+		return fmt.Errorf("the operator set is empty")
+	}
+	if c.Threshold == 0 || c.Threshold > uint64(len(c.SigningOperatorMap)) {
+		return fmt.Errorf("threshold %d must be between 1 and the %d operators of the set", c.Threshold, len(c.SigningOperatorMap))
+	}
+	if _, ok := c.SigningOperator(c.Identifier); !ok {
+		return fmt.Errorf("operator %d is not in the operator set", c.Index)
+	}
+
+	var errs []error
+	addresses := make(map[string]string)
+	for _, operator := range c.sortedOperators() {
+		if operator.Address == "" {
+			errs = append(errs, fmt.Errorf("operator %s has no address", operator.Identifier))
+		} else if other, ok := addresses[operator.Address]; ok {
+			errs = append(errs, fmt.Errorf("operators %s and %s have the same address %s", other, operator.Identifier, operator.Address))
+		} else {
+			addresses[operator.Address] = operator.Identifier
+		}
+		if _, err := secp256k1.ParsePubKey(operator.IdentityPublicKey); err != nil {
+			errs = append(errs, fmt.Errorf("operator %s has an invalid identity public key: %w", operator.Identifier, err))
+		}
+		if operator.CertPath != nil {
+			errs = append(errs, validateCertificate("operator "+operator.Identifier, *operator.CertPath))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Config) validateIdentityKey() error {
+	if len(c.IdentityPrivateKey) != 32 {
+		return fmt.Errorf("identity private key must be 32 bytes, got %d", len(c.IdentityPrivateKey))
+	}
+	privateKey := secp256k1.PrivKeyFromBytes(c.IdentityPrivateKey)
+	publicKey := c.IdentityPublicKey()
+	if publicKey != nil && !bytes.Equal(privateKey.PubKey().SerializeCompressed(), publicKey) {
+		return fmt.Errorf("identity private key does not match the identity public key of operator %d", c.Index)
+	}
+	return nil
+}
+
+func (c *Config) validateNetworks() error {
+	if len(c.SupportedNetworks) == 0 {
+		return fmt.Errorf("no network is supported")
+	}
+
+	var errs []error
+	reloadable := c.Reloadable()
+	for _, name := range sortedKeys(reloadable.BitcoindConfigs, nil) {
+		bitcoindConfig := reloadable.BitcoindConfigs[name]
+		network, err := common.NetworkFromString(bitcoindConfig.Network)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("bitcoind %s: %w", name, err))
+			continue
+		}
+		if network.String() != name {
+			errs = append(errs, fmt.Errorf("bitcoind %s is configured for network %s", name, network))
+		}
+		if bitcoindConfig.Host == "" {
+			errs = append(errs, fmt.Errorf("bitcoind %s has no host", name))
+		}
+	}
+	for _, name := range sortedKeys(reloadable.Lrc20Configs, nil) {
+		lrc20Config := reloadable.Lrc20Configs[name]
+		network, err := common.NetworkFromString(name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("lrc20 %s: %w", name, err))
+			continue
+		}
+		if !c.IsNetworkSupported(network) || lrc20Config.DisableRpcs {
+			continue
+		}
+		if lrc20Config.Host == "" {
+			errs = append(errs, fmt.Errorf("lrc20 %s has no host", name))
+		}
+		if lrc20Config.RelativeCertPath != "" {
+			errs = append(errs, validateCertificate("lrc20 "+name, filepath.Join(c.RunDirectory, lrc20Config.RelativeCertPath)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sortedOperators returns the operators of the current operator set by identifier.
+func (c *Config) sortedOperators() []*SigningOperator {
+	operators := make([]*SigningOperator, 0, len(c.SigningOperatorMap))
+	for _, operator := range c.SigningOperatorMap {
+		operators = append(operators, operator)
+	}
+	sort.Slice(operators, func(i, j int) bool { return operators[i].Identifier < operators[j].Identifier })
+	return operators
+}
+
+// validateCertificate checks that the file holds a PEM encoded certificate.
+func validateCertificate(name string, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read the certificate of %s: %w", name, err)
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM(data) {
+		return fmt.Errorf("the certificate of %s at %s is not a PEM encoded certificate", name, path)
+	}
+	return nil
+}
@@ -10,10 +10,37 @@ import (
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/lightsparkdev/spark/common"
 	"github.com/lightsparkdev/spark/so/ent"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
-// BroadcastTransaction broadcasts a transaction to the network
-func BroadcastTransaction(ctx context.Context, bitcoinClient *rpcclient.Client, nodeID string, txBytes []byte) error {
+// The kinds of transactions the watchtower broadcasts.
+const (
+	TxTypeNode   = "node"
+	TxTypeRefund = "refund"
+)
+
+var broadcastsCounter metric.Int64Counter
+
+func init() {
+	var err error
+	broadcastsCounter, err = otel.Meter("watchtower").Int64Counter(
+		"spark_watchtower_broadcasts",
+		metric.WithDescription("Number of transactions the watchtower broadcast, by transaction type and result"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// BroadcastTransaction broadcasts a transaction of the given type to the network
+func BroadcastTransaction(ctx context.Context, bitcoinClient *rpcclient.Client, nodeID string, txType string, txBytes []byte) error {
+	result := "failed"
+	defer func() {
+		broadcastsCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("type", txType), attribute.String("result", result)))
+	}()
+
 	tx, err := common.TxFromRawTxBytes(txBytes)
 	if err != nil {
 		return fmt.Errorf("failed to parse transaction: %v", err)
@@ -25,12 +52,14 @@ func BroadcastTransaction(ctx context.Context, bitcoinClient *rpcclient.Client,
 		if rpcErr, ok := err.(*btcjson.RPCError); ok && rpcErr.Code == -27 {
 			// This means another SO has already broadcasted the tx
 			slog.InfoContext(ctx, "Transaction already in mempool", "node_id", nodeID)
+			result = "already_in_mempool"
 			return nil
 		}
 		return fmt.Errorf("failed to broadcast transaction: %v", err)
 	}
 
 	slog.InfoContext(ctx, "Successfully broadcast transaction", "tx_hash", hex.EncodeToString(txHash[:]))
+	result = "broadcast"
 	return nil
 }
 
@@ -51,7 +80,7 @@ func CheckExpiredTimeLocks(ctx context.Context, bitcoinClient *rpcclient.Client,
 			if parent.NodeConfirmationHeight > 0 {
 				timelockExpiryHeight := uint64(nodeTx.TxIn[0].Sequence&0xFFFF) + parent.NodeConfirmationHeight
 				if timelockExpiryHeight <= uint64(blockHeight) {
-					if err := BroadcastTransaction(ctx, bitcoinClient, node.ID.String(), node.RawTx); err != nil {
+					if err := BroadcastTransaction(ctx, bitcoinClient, node.ID.String(), TxTypeNode, node.RawTx); err != nil {
 						return fmt.Errorf("failed to broadcast node tx: %v", err)
 					}
 				}
@@ -65,7 +94,7 @@ func CheckExpiredTimeLocks(ctx context.Context, bitcoinClient *rpcclient.Client,
 
 		timelockExpiryHeight := uint64(refundTx.TxIn[0].Sequence&0xFFFF) + node.NodeConfirmationHeight
 		if timelockExpiryHeight <= uint64(blockHeight) {
-			if err := BroadcastTransaction(ctx, bitcoinClient, node.ID.String(), node.RawRefundTx); err != nil {
+			if err := BroadcastTransaction(ctx, bitcoinClient, node.ID.String(), TxTypeRefund, node.RawRefundTx); err != nil {
 				return fmt.Errorf("failed to broadcast refund tx: %v", err)
 			}
 		}
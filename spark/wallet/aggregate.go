@@ -57,11 +57,11 @@ func AggregateTreeNodes(
 
 	parentRefundTx, err := common.TxFromRawTxBytes(parentNode.RefundTx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse parent refund tx: %v", err)
+		return nil, fmt.Errorf("failed to parse parent refund tx: %w", err)
 	}
 	sequence, err := spark.NextSequence(uint32(parentRefundTx.TxIn[0].Sequence))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get next sequence: %v", err)
+		return nil, fmt.Errorf("failed to get next sequence: %w", err)
 	}
 	parentOutPoint := wire.OutPoint{Hash: parentTx.TxHash(), Index: uint32(parentNode.Vout)}
 	cpfpRefundTx, _, err := createRefundTxs(sequence, &parentOutPoint,
@@ -72,7 +72,7 @@ func AggregateTreeNodes(
 	var refundBuf bytes.Buffer
 	err = cpfpRefundTx.Serialize(&refundBuf)
 	if err != nil {
-		return nil, fmt.Errorf("failed to serialize refund tx: %v", err)
+		return nil, fmt.Errorf("failed to serialize refund tx: %w", err)
 	}
 
 	signingNonce, err := objects.RandomSigningNonce()
@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"time"
 
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"github.com/lightsparkdev/spark/common"
 	pbauthn "github.com/lightsparkdev/spark/proto/spark_authn"
@@ -17,7 +19,7 @@ import (
 func AuthenticateWithServer(ctx context.Context, config *Config) (string, error) {
 	conn, err := common.NewGRPCConnectionWithTestTLS(config.CoodinatorAddress(), nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to connect to coordinator: %v", err)
+		return "", fmt.Errorf("failed to connect to coordinator: %w", err)
 	}
 	defer conn.Close()
 	return AuthenticateWithConnection(ctx, config, conn)
@@ -25,30 +27,61 @@ func AuthenticateWithServer(ctx context.Context, config *Config) (string, error)
 
 // AuthenticateWithConnection authenticates to the server using an existing GRPC connection.
 func AuthenticateWithConnection(ctx context.Context, config *Config, conn *grpc.ClientConn) (string, error) {
+	return authenticate(ctx, conn, &config.IdentityPrivateKey, nil)
+}
+
+// CreateDelegationGrant signs a grant allowing the delegate key to open sessions for the wallet's
+// identity, limited to the given scopes, for the given duration.
+func CreateDelegationGrant(config *Config, delegatePublicKey []byte, scopes []string, duration time.Duration) (*pbauthn.SignedDelegationGrant, error) {
+	now := time.Now()
+	grant := &pbauthn.DelegationGrant{
+		IdentityPublicKey:   config.IdentityPublicKey(),
+		DelegatePublicKey:   delegatePublicKey,
+		Scopes:              scopes,
+		IssuedTimestamp:     now.Unix(),
+		ExpirationTimestamp: now.Add(duration).Unix(),
+	}
+	hash := sha256.Sum256(common.DelegationGrantMessage(grant))
+	signature := ecdsa.Sign(&config.IdentityPrivateKey, hash[:])
+	return &pbauthn.SignedDelegationGrant{
+		Grant:     grant,
+		Signature: signature.Serialize(),
+	}, nil
+}
+
+// AuthenticateDelegateWithConnection authenticates a delegate key using a grant from an identity,
+// and returns a session token for the identity limited to the grant's scopes.
+func AuthenticateDelegateWithConnection(ctx context.Context, delegatePrivateKey *secp256k1.PrivateKey, grant *pbauthn.SignedDelegationGrant, conn *grpc.ClientConn) (string, error) {
+	return authenticate(ctx, conn, delegatePrivateKey, grant)
+}
+
+func authenticate(ctx context.Context, conn *grpc.ClientConn, privateKey *secp256k1.PrivateKey, grant *pbauthn.SignedDelegationGrant) (string, error) {
 	client := pbauthn.NewSparkAuthnServiceClient(conn)
+	publicKey := privateKey.PubKey().SerializeCompressed()
 
 	challengeResp, err := client.GetChallenge(ctx, &pbauthn.GetChallengeRequest{
-		PublicKey: config.IdentityPublicKey(),
+		PublicKey: publicKey,
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to get challenge: %v", err)
+		return "", fmt.Errorf("failed to get challenge: %w", err)
 	}
 
 	challengeBytes, err := proto.Marshal(challengeResp.ProtectedChallenge.Challenge)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal challenge: %v", err)
+		return "", fmt.Errorf("failed to marshal challenge: %w", err)
 	}
 
 	hash := sha256.Sum256(challengeBytes)
-	signature := ecdsa.Sign(&config.IdentityPrivateKey, hash[:])
+	signature := ecdsa.Sign(privateKey, hash[:])
 
 	verifyResp, err := client.VerifyChallenge(ctx, &pbauthn.VerifyChallengeRequest{
 		ProtectedChallenge: challengeResp.ProtectedChallenge,
 		Signature:          signature.Serialize(),
-		PublicKey:          config.IdentityPublicKey(),
+		PublicKey:          publicKey,
+		DelegationGrant:    grant,
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to verify challenge: %v", err)
+		return "", fmt.Errorf("failed to verify challenge: %w", err)
 	}
 
 	return verifyResp.SessionToken, nil
@@ -44,12 +44,12 @@ func GetConnectorRefundSignatures(
 		ctx, config, leaves, exitTxid, connectorOutputs, receiverPubKey, expiryTime,
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to sign refund transactions: %v", err)
+		return nil, nil, fmt.Errorf("failed to sign refund transactions: %w", err)
 	}
 
 	transfer, err = SendTransferTweakKey(ctx, config, transfer, leaves, signaturesMap)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to send transfer: %v", err)
+		return nil, nil, fmt.Errorf("failed to send transfer: %w", err)
 	}
 
 	return transfer, signaturesMap, nil
@@ -64,7 +64,7 @@ func createConnectorRefundTransactionSigningJob(
 	var refundBuf bytes.Buffer
 	err := refundTx.Serialize(&refundBuf)
 	if err != nil {
-		return nil, fmt.Errorf("failed to serialize refund tx: %v", err)
+		return nil, fmt.Errorf("failed to serialize refund tx: %w", err)
 	}
 	rawTx := refundBuf.Bytes()
 	// TODO(alec): we don't handle errors for this elsewhere, should we here?
@@ -106,17 +106,17 @@ func signCoopExitRefunds(
 
 		currentRefundTx, err := common.TxFromRawTxBytes(leaf.Leaf.RefundTx)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to parse refund tx: %v", err)
+			return nil, nil, fmt.Errorf("failed to parse refund tx: %w", err)
 		}
 		sequence, err := spark.NextSequence(currentRefundTx.TxIn[0].Sequence)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to get next sequence: %v", err)
+			return nil, nil, fmt.Errorf("failed to get next sequence: %w", err)
 		}
 		refundTx, err := createConnectorRefundTransaction(
 			sequence, &currentRefundTx.TxIn[0].PreviousOutPoint, connectorOutput, int64(leaf.Leaf.Value), receiverPubKey,
 		)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to create refund transaction: %v", err)
+			return nil, nil, fmt.Errorf("failed to create refund transaction: %w", err)
 		}
 		nonce, _ := objects.RandomSigningNonce()
 		signingPrivKey := secp256k1.PrivKeyFromBytes(leaf.SigningPrivKey)
@@ -125,7 +125,7 @@ func signCoopExitRefunds(
 			leaf.Leaf.Id, signingPubKey.SerializeCompressed(), nonce, refundTx,
 		)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to create signing job: %v", err)
+			return nil, nil, fmt.Errorf("failed to create signing job: %w", err)
 		}
 		signingJobs = append(signingJobs, signingJob)
 
@@ -142,22 +142,22 @@ func signCoopExitRefunds(
 
 	sparkConn, err := common.NewGRPCConnectionWithTestTLS(config.CoodinatorAddress(), nil)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create grpc connection: %v", err)
+		return nil, nil, fmt.Errorf("failed to create grpc connection: %w", err)
 	}
 	defer sparkConn.Close()
 	sparkClient := pb.NewSparkServiceClient(sparkConn)
 	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to authenticate with coordinator: %v", err)
+		return nil, nil, fmt.Errorf("failed to authenticate with coordinator: %w", err)
 	}
 	tmpCtx := ContextWithToken(ctx, token)
 	transferID, err := uuid.NewV7()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to generate transfer id: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate transfer id: %w", err)
 	}
 	exitID, err := uuid.NewV7()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to generate exit id: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate exit id: %w", err)
 	}
 	response, err := sparkClient.CooperativeExit(tmpCtx, &pb.CooperativeExitRequest{
 		Transfer: &pb.StartTransferRequest{
@@ -171,11 +171,11 @@ func signCoopExitRefunds(
 		ExitTxid: exitTxid,
 	})
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to initiate cooperative exit: %v", err)
+		return nil, nil, fmt.Errorf("failed to initiate cooperative exit: %w", err)
 	}
 	signatures, err := signRefunds(config, leafDataMap, response.SigningResults, nil)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to sign refund transactions: %v", err)
+		return nil, nil, fmt.Errorf("failed to sign refund transactions: %w", err)
 	}
 
 	signaturesMap := make(map[string][]byte)
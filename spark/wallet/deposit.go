@@ -370,7 +370,7 @@ func ClaimStaticDeposit(
 		prevTxOut,
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get sighash: %v", err)
+		return nil, nil, fmt.Errorf("failed to get sighash: %w", err)
 	}
 
 	hidingPriv, err := secp256k1.GeneratePrivateKey()
@@ -406,7 +406,7 @@ func ClaimStaticDeposit(
 	}
 	transferID, err := uuid.NewV7()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to generate transfer id: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate transfer id: %w", err)
 	}
 
 	nodeIDs := make([]string, len(leavesToTransfer))
@@ -430,7 +430,7 @@ func ClaimStaticDeposit(
 	// signerClient := pbfrost.NewFrostServiceClient(sspConn)
 	conn, err := common.NewGRPCConnectionWithoutTLS(config.FrostSignerAddress, nil)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to connect to frost signer: %v", err)
+		return nil, nil, fmt.Errorf("failed to connect to frost signer: %w", err)
 	}
 	defer conn.Close()
 	signerClient := pbfrost.NewFrostServiceClient(conn)
@@ -439,7 +439,7 @@ func ClaimStaticDeposit(
 		Role:        pbfrost.SigningRole_USER,
 	})
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to sign frost: %v", err)
+		return nil, nil, fmt.Errorf("failed to sign frost: %w", err)
 	}
 	leafSigningJobs, err := prepareLeafSigningJobs(
 		leavesToTransfer,
@@ -449,15 +449,15 @@ func ClaimStaticDeposit(
 		signingCommitments.SigningCommitments,
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to prepare leaf signing jobs: %v", err)
+		return nil, nil, fmt.Errorf("failed to prepare leaf signing jobs: %w", err)
 	}
 	protoNetwork, err := common.ProtoNetworkFromNetwork(network)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get proto network: %v", err)
+		return nil, nil, fmt.Errorf("failed to get proto network: %w", err)
 	}
 	depositTxID, err := hex.DecodeString(spendTx.TxIn[0].PreviousOutPoint.Hash.String())
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to decode deposit txid: %v", err)
+		return nil, nil, fmt.Errorf("failed to decode deposit txid: %w", err)
 	}
 	swapResponse, err := sparkClient.InitiateUtxoSwap(ctx, &pb.InitiateUtxoSwapRequest{
 		OnChainUtxo: &pb.UTXO{
@@ -479,7 +479,7 @@ func ClaimStaticDeposit(
 		SpendTxSigningJob: signingJob,
 	})
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to initiate utxo swap: %v", err)
+		return nil, nil, fmt.Errorf("failed to initiate utxo swap: %w", err)
 	}
 	// Similar to CreateUserKeyPackage(depositAddressSecretKey.Serialize())
 	frostUserIdentifier := "0000000000000000000000000000000000000000000000000000000000000063"
@@ -520,7 +520,7 @@ func ClaimStaticDeposit(
 
 	frostConn, err := common.NewGRPCConnectionWithoutTLS(config.FrostSignerAddress, nil)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to connect to frost signer: %v", err)
+		return nil, nil, fmt.Errorf("failed to connect to frost signer: %w", err)
 	}
 	defer frostConn.Close()
 
@@ -531,7 +531,7 @@ func ClaimStaticDeposit(
 		Role:        pbfrost.SigningRole_USER,
 	})
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to sign frost: %v", err)
+		return nil, nil, fmt.Errorf("failed to sign frost: %w", err)
 	}
 
 	signatureResult, err := frostClient.AggregateFrost(ctx, &pbfrost.AggregateFrostRequest{
@@ -545,7 +545,7 @@ func ClaimStaticDeposit(
 		UserSignatureShare: userSignatures.Results[userJobID].SignatureShare,
 	})
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to aggregate frost: %v", err)
+		return nil, nil, fmt.Errorf("failed to aggregate frost: %w", err)
 	}
 
 	// Step 9: Verify signature using go lib.
@@ -568,7 +568,7 @@ func ClaimStaticDeposit(
 
 	transferToAliceKeysTweaked, err := SendTransferTweakKey(context.Background(), config, swapResponse.Transfer, leavesToTransfer[:], nil)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to send transfer tweak key: %v", err)
+		return nil, nil, fmt.Errorf("failed to send transfer tweak key: %w", err)
 	}
 	if transferToAliceKeysTweaked.Status != pb.TransferStatus_TRANSFER_STATUS_SENDER_KEY_TWEAKED {
 		return nil, nil, fmt.Errorf("transfer to alice keys tweaked status is not TRANSFER_STATUS_SENDER_KEY_TWEAKED")
@@ -0,0 +1,29 @@
+package wallet
+
+import (
+	sparkerrors "github.com/lightsparkdev/spark/so/errors"
+)
+
+// Errors of the signing operators that callers can branch on with errors.Is, rather than by
+// matching messages. The errors of the functions of this package match them when the connections
+// to the operators are made with common.NewGRPCConnection or its variants.
+var (
+	ErrLeafLocked              = sparkerrors.ReasonLeafLocked
+	ErrLeafNotAvailable        = sparkerrors.ReasonLeafNotAvailable
+	ErrTransferNotFound        = sparkerrors.ReasonTransferNotFound
+	ErrTransferAlreadyExists   = sparkerrors.ReasonTransferAlreadyExists
+	ErrTransferExpired         = sparkerrors.ReasonTransferExpired
+	ErrTransferNotExpired      = sparkerrors.ReasonTransferNotExpired
+	ErrInsufficientTimelock    = sparkerrors.ReasonInsufficientTimelock
+	ErrTokenOutputFrozen       = sparkerrors.ReasonTokenOutputFrozen
+	ErrTokenTransactionExpired = sparkerrors.ReasonTokenTransactionExpired
+	ErrKeysharePoolEmpty       = sparkerrors.ReasonKeysharePoolEmpty
+	ErrNetworkPaused           = sparkerrors.ReasonNetworkPaused
+	ErrIdempotencyKeyReused    = sparkerrors.ReasonIdempotencyKeyReused
+)
+
+// ErrorReason returns the reason that a signing operator gave for err, or an empty reason if err
+// has none, for callers that handle every reason rather than a few of them.
+func ErrorReason(err error) sparkerrors.Reason {
+	return sparkerrors.ReasonOf(err)
+}
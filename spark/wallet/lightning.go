@@ -34,7 +34,7 @@ func SwapNodesForPreimage(
 
 	token, err := AuthenticateWithConnection(ctx, config, conn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	tmpCtx := ContextWithToken(ctx, token)
 
@@ -89,7 +89,7 @@ func SwapNodesForPreimage(
 	// SSP calls SO to get the preimage
 	transferID, err := uuid.NewV7()
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate transfer id: %v", err)
+		return nil, fmt.Errorf("failed to generate transfer id: %w", err)
 	}
 	bolt11String := ""
 	var amountSats uint64
@@ -97,7 +97,7 @@ func SwapNodesForPreimage(
 		bolt11String = *invoiceString
 		bolt11, err := decodepay.Decodepay(bolt11String)
 		if err != nil {
-			return nil, fmt.Errorf("unable to decode invoice: %v", err)
+			return nil, fmt.Errorf("unable to decode invoice: %w", err)
 		}
 		amountSats = uint64(bolt11.MSatoshi / 1000)
 	}
@@ -12,13 +12,13 @@ import (
 func QueryUserSignedRefunds(ctx context.Context, config *Config, paymentHash []byte) ([]*pb.UserSignedRefund, error) {
 	conn, err := common.NewGRPCConnectionWithTestTLS(config.CoodinatorAddress(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("unable to connect to coordinator: %v", err)
+		return nil, fmt.Errorf("unable to connect to coordinator: %w", err)
 	}
 	defer conn.Close()
 
 	token, err := AuthenticateWithConnection(ctx, config, conn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	tmpCtx := ContextWithToken(ctx, token)
 	client := pb.NewSparkServiceClient(conn)
@@ -30,7 +30,7 @@ func QueryUserSignedRefunds(ctx context.Context, config *Config, paymentHash []b
 
 	response, err := client.QueryUserSignedRefunds(tmpCtx, request)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query user signed refunds: %v", err)
+		return nil, fmt.Errorf("failed to query user signed refunds: %w", err)
 	}
 	return response.UserSignedRefunds, nil
 }
@@ -39,7 +39,7 @@ func ValidateUserSignedRefund(userSignedRefund *pb.UserSignedRefund) (int64, err
 	// TODO: Validate the signed refund from user's public key
 	refundTx, err := common.TxFromRawTxBytes(userSignedRefund.RefundTx)
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse refund transaction: %v", err)
+		return 0, fmt.Errorf("failed to parse refund transaction: %w", err)
 	}
 
 	return refundTx.TxOut[0].Value, nil
@@ -48,13 +48,13 @@ func ValidateUserSignedRefund(userSignedRefund *pb.UserSignedRefund) (int64, err
 func ProvidePreimage(ctx context.Context, config *Config, preimage []byte) (*pb.Transfer, error) {
 	conn, err := common.NewGRPCConnectionWithTestTLS(config.CoodinatorAddress(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("unable to connect to coordinator: %v", err)
+		return nil, fmt.Errorf("unable to connect to coordinator: %w", err)
 	}
 	defer conn.Close()
 
 	token, err := AuthenticateWithConnection(ctx, config, conn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	tmpCtx := ContextWithToken(ctx, token)
 	client := pb.NewSparkServiceClient(conn)
@@ -69,7 +69,7 @@ func ProvidePreimage(ctx context.Context, config *Config, preimage []byte) (*pb.
 
 	response, err := client.ProvidePreimage(tmpCtx, request)
 	if err != nil {
-		return nil, fmt.Errorf("failed to provide preimage: %v", err)
+		return nil, fmt.Errorf("failed to provide preimage: %w", err)
 	}
 
 	return response.Transfer, nil
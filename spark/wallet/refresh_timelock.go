@@ -21,7 +21,7 @@ func NeedToRefreshTimelock(
 ) (bool, error) {
 	refundTx, err := common.TxFromRawTxBytes(leaf.RefundTx)
 	if err != nil {
-		return false, fmt.Errorf("failed to parse refund tx: %v", err)
+		return false, fmt.Errorf("failed to parse refund tx: %w", err)
 	}
 	if refundTx.TxIn[0].Sequence&0xFFFF-spark.TimeLockInterval <= 0 {
 		return true, nil
@@ -49,27 +49,27 @@ func RefreshTimelockRefundTx(
 	// be integrated into the aggregation process).
 	newRefundTx, err := common.TxFromRawTxBytes(leaf.RefundTx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse refund tx: %v", err)
+		return nil, fmt.Errorf("failed to parse refund tx: %w", err)
 	}
 	currSequence := newRefundTx.TxIn[0].Sequence
 	newRefundTx.TxIn[0].Sequence, err = spark.NextSequence(currSequence)
 	if err != nil {
-		return nil, fmt.Errorf("failed to increment sequence: %v", err)
+		return nil, fmt.Errorf("failed to increment sequence: %w", err)
 	}
 
 	var newRefundTxBuf bytes.Buffer
 	err = newRefundTx.Serialize(&newRefundTxBuf)
 	if err != nil {
-		return nil, fmt.Errorf("failed to serialize new refund tx: %v", err)
+		return nil, fmt.Errorf("failed to serialize new refund tx: %w", err)
 	}
 
 	nonce, err := objects.RandomSigningNonce()
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate nonce: %v", err)
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
 	}
 	nonceCommitmentProto, err := nonce.SigningCommitment().MarshalProto()
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal nonce commitment: %v", err)
+		return nil, fmt.Errorf("failed to marshal nonce commitment: %w", err)
 	}
 	signingJobs := make([]*pb.SigningJob, 0)
 	signingJobs = append(signingJobs, &pb.SigningJob{
@@ -83,13 +83,13 @@ func RefreshTimelockRefundTx(
 	// Connect and call GRPC
 	sparkConn, err := common.NewGRPCConnectionWithTestTLS(config.CoodinatorAddress(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create grpc connection: %v", err)
+		return nil, fmt.Errorf("failed to create grpc connection: %w", err)
 	}
 	defer sparkConn.Close()
 
 	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	authCtx := ContextWithToken(ctx, token)
 
@@ -100,7 +100,7 @@ func RefreshTimelockRefundTx(
 		SigningJobs:            signingJobs,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to refresh timelock: %v", err)
+		return nil, fmt.Errorf("failed to refresh timelock: %w", err)
 	}
 
 	if len(signingJobs) != len(response.SigningResults) {
@@ -116,24 +116,24 @@ func RefreshTimelockRefundTx(
 		signingJob := signingJobs[i]
 		refundTx, err := common.TxFromRawTxBytes(signingJob.RawTx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse refund tx: %v", err)
+			return nil, fmt.Errorf("failed to parse refund tx: %w", err)
 		}
 		nodeTx, err := common.TxFromRawTxBytes(leaf.NodeTx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse node tx: %v", err)
+			return nil, fmt.Errorf("failed to parse node tx: %w", err)
 		}
 		refundTxSighash, err := common.SigHashFromTx(refundTx, 0, nodeTx.TxOut[0])
 		if err != nil {
-			return nil, fmt.Errorf("failed to calculate sighash: %v", err)
+			return nil, fmt.Errorf("failed to calculate sighash: %w", err)
 		}
 
 		signingNonce, err := nonce.MarshalProto()
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nonce: %v", err)
+			return nil, fmt.Errorf("failed to marshal nonce: %w", err)
 		}
 		signingNonceCommitment, err := nonce.SigningCommitment().MarshalProto()
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nonce commitment: %v", err)
+			return nil, fmt.Errorf("failed to marshal nonce commitment: %w", err)
 		}
 		userKeyPackage := CreateUserKeyPackage(signingPrivKey.Serialize())
 
@@ -192,7 +192,7 @@ func RefreshTimelockRefundTx(
 		NodeSignatures: nodeSignatures,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to finalize node signatures: %v", err)
+		return nil, fmt.Errorf("failed to finalize node signatures: %w", err)
 	}
 
 	return resp.Nodes[0], nil
@@ -205,16 +205,16 @@ func signingJobFromTx(
 	var newTxBuf bytes.Buffer
 	err := newTx.Serialize(&newTxBuf)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to serialize new refund tx: %v", err)
+		return nil, nil, fmt.Errorf("failed to serialize new refund tx: %w", err)
 	}
 
 	nonce, err := objects.RandomSigningNonce()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to generate nonce: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate nonce: %w", err)
 	}
 	nonceCommitmentProto, err := nonce.SigningCommitment().MarshalProto()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to marshal nonce commitment: %v", err)
+		return nil, nil, fmt.Errorf("failed to marshal nonce commitment: %w", err)
 	}
 
 	signingJob := &pb.SigningJob{
@@ -246,13 +246,13 @@ func RefreshTimelockNodes(
 	for i, node := range nodes {
 		newTx, err := common.TxFromRawTxBytes(node.NodeTx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse node tx: %v", err)
+			return nil, fmt.Errorf("failed to parse node tx: %w", err)
 		}
 		if i == 0 {
 			currSequence := newTx.TxIn[0].Sequence
 			newTx.TxIn[0].Sequence, err = spark.NextSequence(currSequence)
 			if err != nil {
-				return nil, fmt.Errorf("failed to increment sequence: %v", err)
+				return nil, fmt.Errorf("failed to increment sequence: %w", err)
 			}
 			// No need to change outpoint since parent did not change
 		} else {
@@ -262,7 +262,7 @@ func RefreshTimelockNodes(
 
 		signingJob, nonce, err := signingJobFromTx(newTx, signingPrivKey)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create signing job: %v", err)
+			return nil, fmt.Errorf("failed to create signing job: %w", err)
 		}
 		signingJobs[i] = signingJob
 		nonces[i] = nonce
@@ -273,13 +273,13 @@ func RefreshTimelockNodes(
 	leaf := nodes[len(nodes)-1]
 	newRefundTx, err := common.TxFromRawTxBytes(leaf.RefundTx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse refund tx: %v", err)
+		return nil, fmt.Errorf("failed to parse refund tx: %w", err)
 	}
 	newRefundTx.TxIn[0].Sequence = spark.InitialSequence()
 	newRefundTx.TxIn[0].PreviousOutPoint.Hash = newNodeTxs[len(newNodeTxs)-1].TxHash()
 	signingJob, nonce, err := signingJobFromTx(newRefundTx, signingPrivKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create signing job: %v", err)
+		return nil, fmt.Errorf("failed to create signing job: %w", err)
 	}
 	signingJobs[len(signingJobs)-1] = signingJob
 	nonces[len(nonces)-1] = nonce
@@ -287,13 +287,13 @@ func RefreshTimelockNodes(
 	// Connect and call GRPC
 	sparkConn, err := common.NewGRPCConnectionWithTestTLS(config.CoodinatorAddress(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create grpc connection: %v", err)
+		return nil, fmt.Errorf("failed to create grpc connection: %w", err)
 	}
 	defer sparkConn.Close()
 
 	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	authCtx := ContextWithToken(ctx, token)
 
@@ -304,7 +304,7 @@ func RefreshTimelockNodes(
 		SigningJobs:            signingJobs,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to refresh timelock: %v", err)
+		return nil, fmt.Errorf("failed to refresh timelock: %w", err)
 	}
 
 	if len(signingJobs) != len(response.SigningResults) {
@@ -322,7 +322,7 @@ func RefreshTimelockNodes(
 		signingJob := signingJobs[i]
 		rawTx, err := common.TxFromRawTxBytes(signingJob.RawTx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse refund tx: %v", err)
+			return nil, fmt.Errorf("failed to parse refund tx: %w", err)
 		}
 
 		// Get parent node for txout for sighash
@@ -339,7 +339,7 @@ func RefreshTimelockNodes(
 			nodeID = nodes[i].Id
 			parentTx, err = common.TxFromRawTxBytes(parentNode.NodeTx)
 			if err != nil {
-				return nil, fmt.Errorf("failed to parse parent tx: %v", err)
+				return nil, fmt.Errorf("failed to parse parent tx: %w", err)
 			}
 			vout = int(nodes[i].Vout)
 		} else {
@@ -351,16 +351,16 @@ func RefreshTimelockNodes(
 
 		rawTxSighash, err := common.SigHashFromTx(rawTx, 0, txOut)
 		if err != nil {
-			return nil, fmt.Errorf("failed to calculate sighash: %v", err)
+			return nil, fmt.Errorf("failed to calculate sighash: %w", err)
 		}
 
 		signingNonce, err := nonce.MarshalProto()
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nonce: %v", err)
+			return nil, fmt.Errorf("failed to marshal nonce: %w", err)
 		}
 		signingNonceCommitment, err := nonce.SigningCommitment().MarshalProto()
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nonce commitment: %v", err)
+			return nil, fmt.Errorf("failed to marshal nonce commitment: %w", err)
 		}
 		userKeyPackage := CreateUserKeyPackage(signingPrivKey.Serialize())
 
@@ -445,7 +445,7 @@ func RefreshTimelockNodes(
 		NodeSignatures: nodeSignatures,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to finalize node signatures: %v", err)
+		return nil, fmt.Errorf("failed to finalize node signatures: %w", err)
 	}
 
 	return finalResp.Nodes, nil
@@ -460,19 +460,19 @@ func ExtendTimelock(
 	// Insert a new node in between the current refund and the node tx
 	nodeTx, err := common.TxFromRawTxBytes(node.NodeTx)
 	if err != nil {
-		return fmt.Errorf("failed to parse node tx: %v", err)
+		return fmt.Errorf("failed to parse node tx: %w", err)
 	}
 
 	refundTx, err := common.TxFromRawTxBytes(node.RefundTx)
 	if err != nil {
-		return fmt.Errorf("failed to parse refund tx: %v", err)
+		return fmt.Errorf("failed to parse refund tx: %w", err)
 	}
 
 	// Create new node tx to spend the node tx and send to a new refund tx
 	refundSequence := refundTx.TxIn[0].Sequence
 	newNodeSequence, err := spark.NextSequence(refundSequence)
 	if err != nil {
-		return fmt.Errorf("failed to increment sequence: %v", err)
+		return fmt.Errorf("failed to increment sequence: %w", err)
 	}
 	newNodeOutPoint := wire.OutPoint{Hash: nodeTx.TxHash(), Index: 0}
 	newNodeTx := createLeafNodeTx(newNodeSequence, &newNodeOutPoint, nodeTx.TxOut[0])
@@ -483,29 +483,29 @@ func ExtendTimelock(
 	newRefundOutPoint := wire.OutPoint{Hash: newNodeTx.TxHash(), Index: 0}
 	cpfpRefundTx, _, err := createRefundTxs(spark.InitialSequence(), &newRefundOutPoint, refundTx.TxOut[0].Value, signingPrivKey.PubKey(), false)
 	if err != nil {
-		return fmt.Errorf("failed to create refund tx: %v", err)
+		return fmt.Errorf("failed to create refund tx: %w", err)
 	}
 
 	// Create signing jobs
 	newNodeSigningJob, newNodeNonce, err := signingJobFromTx(newNodeTx, signingPrivKey)
 	if err != nil {
-		return fmt.Errorf("failed to create signing job: %v", err)
+		return fmt.Errorf("failed to create signing job: %w", err)
 	}
 	newRefundSigningJob, newRefundNonce, err := signingJobFromTx(cpfpRefundTx, signingPrivKey)
 	if err != nil {
-		return fmt.Errorf("failed to create signing job: %v", err)
+		return fmt.Errorf("failed to create signing job: %w", err)
 	}
 
 	// Send to SO to sign
 	sparkConn, err := common.NewGRPCConnectionWithTestTLS(config.CoodinatorAddress(), nil)
 	if err != nil {
-		return fmt.Errorf("failed to create grpc connection: %v", err)
+		return fmt.Errorf("failed to create grpc connection: %w", err)
 	}
 	defer sparkConn.Close()
 
 	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 	if err != nil {
-		return fmt.Errorf("failed to authenticate with server: %v", err)
+		return fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	authCtx := ContextWithToken(ctx, token)
 
@@ -517,17 +517,17 @@ func ExtendTimelock(
 		RefundTxSigningJob:     newRefundSigningJob,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to extend leaf: %v", err)
+		return fmt.Errorf("failed to extend leaf: %w", err)
 	}
 
 	// Sign and aggregate
 	newNodeSignFrostJob, newNodeAggFrostJob, err := createFrostJobsFromTx(newNodeTx, nodeTx.TxOut[0], newNodeNonce, signingPrivKey, response.NodeTxSigningResult)
 	if err != nil {
-		return fmt.Errorf("failed to create node frost signing job: %v", err)
+		return fmt.Errorf("failed to create node frost signing job: %w", err)
 	}
 	newRefundSignFrostJob, newRefundAggFrostJob, err := createFrostJobsFromTx(cpfpRefundTx, newNodeTx.TxOut[0], newRefundNonce, signingPrivKey, response.RefundTxSigningResult)
 	if err != nil {
-		return fmt.Errorf("failed to create refund frost signing job: %v", err)
+		return fmt.Errorf("failed to create refund frost signing job: %w", err)
 	}
 
 	frostConn, _ := common.NewGRPCConnectionWithoutTLS(config.FrostSignerAddress, nil)
@@ -549,11 +549,11 @@ func ExtendTimelock(
 	// Aggregate
 	newNodeResp, err := frostClient.AggregateFrost(context.Background(), newNodeAggFrostJob)
 	if err != nil {
-		return fmt.Errorf("failed to aggregate node tx: %v", err)
+		return fmt.Errorf("failed to aggregate node tx: %w", err)
 	}
 	newRefundResp, err := frostClient.AggregateFrost(context.Background(), newRefundAggFrostJob)
 	if err != nil {
-		return fmt.Errorf("failed to aggregate refund tx: %v", err)
+		return fmt.Errorf("failed to aggregate refund tx: %w", err)
 	}
 
 	// Finalize signatures
@@ -566,7 +566,7 @@ func ExtendTimelock(
 		}},
 	})
 	if err != nil {
-		return fmt.Errorf("failed to finalize node signatures: %v", err)
+		return fmt.Errorf("failed to finalize node signatures: %w", err)
 	}
 
 	// Call it a day
@@ -582,15 +582,15 @@ func createFrostJobsFromTx(
 ) (*pbfrost.FrostSigningJob, *pbfrost.AggregateFrostRequest, error) {
 	sigHash, err := common.SigHashFromTx(tx, 0, parentTxOut)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to calculate sighash: %v", err)
+		return nil, nil, fmt.Errorf("failed to calculate sighash: %w", err)
 	}
 	signingNonce, err := nonce.MarshalProto()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to marshal nonce: %v", err)
+		return nil, nil, fmt.Errorf("failed to marshal nonce: %w", err)
 	}
 	signingNonceCommitment, err := nonce.SigningCommitment().MarshalProto()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to marshal nonce commitment: %v", err)
+		return nil, nil, fmt.Errorf("failed to marshal nonce commitment: %w", err)
 	}
 	frostKeyPackage := CreateUserKeyPackage(signingPrivKey.Serialize())
 	userSigningJobID := uuid.New().String()
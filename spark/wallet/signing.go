@@ -41,16 +41,16 @@ func prepareFrostSigningJobsForUserSignedRefund(
 	for i, leaf := range leaves {
 		nodeTx, err := common.TxFromRawTxBytes(leaf.Leaf.NodeTx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to parse node tx: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to parse node tx: %w", err)
 		}
 		nodeOutPoint := wire.OutPoint{Hash: nodeTx.TxHash(), Index: 0}
 		currRefundTx, err := common.TxFromRawTxBytes(leaf.Leaf.RefundTx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to parse refund tx: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to parse refund tx: %w", err)
 		}
 		nextSequence, err := spark.NextSequence(currRefundTx.TxIn[0].Sequence)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to get next sequence: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to get next sequence: %w", err)
 		}
 		amountSats := nodeTx.TxOut[0].Value
 		cpfpRefundTx, _, err := createRefundTxs(nextSequence, &nodeOutPoint, amountSats, receiverIdentityPubkey, false)
@@ -66,7 +66,7 @@ func prepareFrostSigningJobsForUserSignedRefund(
 
 		sighash, err := common.SigHashFromTx(cpfpRefundTx, 0, nodeTx.TxOut[0])
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to calculate sighash: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to calculate sighash: %w", err)
 		}
 
 		signingNonce, err := objects.RandomSigningNonce()
@@ -50,7 +50,7 @@ func StartTokenTransaction(
 
 	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, nil, nil, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	tmpCtx := ContextWithToken(ctx, token)
 	sparkClient := pb.NewSparkServiceClient(sparkConn)
@@ -75,7 +75,7 @@ func StartTokenTransaction(
 		signingPrivKeySecp := secp256k1.PrivKeyFromBytes(config.IdentityPrivateKey.Serialize())
 		sig, err := createTokenTransactionSignature(config, signingPrivKeySecp, partialTokenTransactionHash)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to create signature: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to create signature: %w", err)
 		}
 		sigWithIndex := &pb.SignatureWithIndex{
 			InputIndex: 0,
@@ -93,7 +93,7 @@ func StartTokenTransaction(
 		for i, privKey := range ownerPrivateKeys {
 			sig, err := createTokenTransactionSignature(config, privKey, partialTokenTransactionHash)
 			if err != nil {
-				return nil, nil, nil, fmt.Errorf("failed to create signature: %v", err)
+				return nil, nil, nil, fmt.Errorf("failed to create signature: %w", err)
 			}
 			sigWithIndex := &pb.SignatureWithIndex{
 				InputIndex: uint32(i),
@@ -174,12 +174,12 @@ func createOperatorSpecificSignature(
 	}
 	payloadHash, err := utils.HashOperatorSpecificTokenTransactionSignablePayload(payload)
 	if err != nil {
-		return nil, fmt.Errorf("error while hashing operator-specific payload: %v", err)
+		return nil, fmt.Errorf("error while hashing operator-specific payload: %w", err)
 	}
 
 	sig, err := createTokenTransactionSignature(config, privKey, payloadHash)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create signature: %v", err)
+		return nil, fmt.Errorf("failed to create signature: %w", err)
 	}
 
 	return &pb.OperatorSpecificOwnerSignature{
@@ -265,7 +265,7 @@ func SignTokenTransaction(
 
 		operatorToken, err := AuthenticateWithConnection(ctx, config, operatorConn)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to authenticate with operator %s: %v", operator.Identifier, err)
+			return nil, nil, fmt.Errorf("failed to authenticate with operator %s: %w", operator.Identifier, err)
 		}
 		operatorCtx := ContextWithToken(ctx, operatorToken)
 		operatorClient := pb.NewSparkServiceClient(operatorConn)
@@ -305,7 +305,7 @@ func SignTokenTransaction(
 		// Validate signature
 		operatorSig := signTokenTransactionResponse.SparkOperatorSignature
 		if err := utils.ValidateOwnershipSignature(operatorSig, finalTxHash, operator.IdentityPublicKey); err != nil {
-			return nil, nil, fmt.Errorf("invalid signature from operator with public key %x: %v", operator.IdentityPublicKey, err)
+			return nil, nil, fmt.Errorf("invalid signature from operator with public key %x: %w", operator.IdentityPublicKey, err)
 		}
 
 		// Store output keyshares if transfer
@@ -415,7 +415,7 @@ func FinalizeTokenTransaction(
 
 		operatorToken, err := AuthenticateWithConnection(ctx, config, operatorConn)
 		if err != nil {
-			return fmt.Errorf("failed to authenticate with operator %s: %v", operator.Identifier, err)
+			return fmt.Errorf("failed to authenticate with operator %s: %w", operator.Identifier, err)
 		}
 		operatorCtx := ContextWithToken(ctx, operatorToken)
 		operatorClient := pb.NewSparkServiceClient(operatorConn)
@@ -512,7 +512,7 @@ func FreezeTokens(
 
 		token, err := AuthenticateWithConnection(ctx, config, operatorConn)
 		if err != nil {
-			return nil, fmt.Errorf("failed to authenticate with server: %v", err)
+			return nil, fmt.Errorf("failed to authenticate with server: %w", err)
 		}
 		tmpCtx := ContextWithToken(ctx, token)
 		sparkClient := pb.NewSparkServiceClient(operatorConn)
@@ -527,13 +527,13 @@ func FreezeTokens(
 
 		payloadHash, err := utils.HashFreezeTokensPayload(payload)
 		if err != nil {
-			return nil, fmt.Errorf("failed to hash freeze tokens payload: %v", err)
+			return nil, fmt.Errorf("failed to hash freeze tokens payload: %w", err)
 		}
 
 		signingPrivKeySecp := secp256k1.PrivKeyFromBytes(config.IdentityPrivateKey.Serialize())
 		sig, err := createTokenTransactionSignature(config, signingPrivKeySecp, payloadHash)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create signature: %v", err)
+			return nil, fmt.Errorf("failed to create signature: %w", err)
 		}
 		issuerSignature := sig
 
@@ -544,7 +544,7 @@ func FreezeTokens(
 
 		lastResponse, err = sparkClient.FreezeTokens(tmpCtx, request)
 		if err != nil {
-			return nil, fmt.Errorf("failed to freeze/unfreeze tokens: %v", err)
+			return nil, fmt.Errorf("failed to freeze/unfreeze tokens: %w", err)
 		}
 	}
 	return lastResponse, nil
@@ -566,7 +566,7 @@ func QueryTokenOutputs(
 
 	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	tmpCtx := ContextWithToken(ctx, token)
 	sparkClient := pb.NewSparkServiceClient(sparkConn)
@@ -578,7 +578,7 @@ func QueryTokenOutputs(
 
 	response, err := sparkClient.QueryTokenOutputs(tmpCtx, request)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get token outputs: %v", err)
+		return nil, fmt.Errorf("failed to get token outputs: %w", err)
 	}
 	return response, nil
 }
@@ -603,7 +603,7 @@ func QueryTokenTransactions(
 
 	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	tmpCtx := ContextWithToken(ctx, token)
 	sparkClient := pb.NewSparkServiceClient(sparkConn)
@@ -619,7 +619,7 @@ func QueryTokenTransactions(
 
 	response, err := sparkClient.QueryTokenTransactions(tmpCtx, request)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query token transactions: %v", err)
+		return nil, fmt.Errorf("failed to query token transactions: %w", err)
 	}
 
 	return response, nil
@@ -650,7 +650,7 @@ func CancelTokenTransaction(
 
 		operatorToken, err := AuthenticateWithConnection(ctx, config, operatorConn)
 		if err != nil {
-			return fmt.Errorf("failed to authenticate with operator %s: %v", operator.Identifier, err)
+			return fmt.Errorf("failed to authenticate with operator %s: %w", operator.Identifier, err)
 		}
 		operatorCtx := ContextWithToken(ctx, operatorToken)
 		operatorClient := pb.NewSparkServiceClient(operatorConn)
@@ -660,7 +660,7 @@ func CancelTokenTransaction(
 			SenderIdentityPublicKey: config.IdentityPublicKey(),
 		})
 		if err != nil {
-			return fmt.Errorf("failed to cancel token transaction with operator %s: %v", operator.Identifier, err)
+			return fmt.Errorf("failed to cancel token transaction with operator %s: %w", operator.Identifier, err)
 		}
 	}
 
@@ -677,7 +677,7 @@ func createTokenTransactionSignature(config *Config, privKey *secp256k1.PrivateK
 	if config.UseTokenTransactionSchnorrSignatures {
 		sig, err := schnorr.Sign(privKey, hash)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create Schnorr signature: %v", err)
+			return nil, fmt.Errorf("failed to create Schnorr signature: %w", err)
 		}
 		return sig.Serialize(), nil
 	}
@@ -96,7 +96,7 @@ func createRefundTxs(
 
 	refundPkScript, err := common.P2TRScriptFromPubKey(receivingPubkey)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create refund pkscript: %v", err)
+		return nil, nil, fmt.Errorf("failed to create refund pkscript: %w", err)
 	}
 	cpfpRefundTx.AddTxOut(wire.NewTxOut(amountSats, refundPkScript))
 	cpfpRefundTx.AddTxOut(EphemeralAnchorOutput())
@@ -141,7 +141,7 @@ func createConnectorRefundTransaction(
 	refundTx.AddTxIn(wire.NewTxIn(connectorOutput, nil, nil))
 	receiverScript, err := common.P2TRScriptFromPubKey(receiverPubKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create receiver script: %v", err)
+		return nil, fmt.Errorf("failed to create receiver script: %w", err)
 	}
 	refundTx.AddTxOut(wire.NewTxOut(amountSats, receiverScript))
 	refundTx.AddTxOut(EphemeralAnchorOutput())
@@ -47,7 +47,7 @@ func SendTransfer(
 	}
 	transfer, err = SendTransferTweakKey(ctx, config, transfer, leaves, refundSignatureMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to tweak key: %v", err)
+		return nil, fmt.Errorf("failed to tweak key: %w", err)
 	}
 	return transfer, nil
 }
@@ -62,16 +62,16 @@ func SendTransferWithKeyTweaks(
 ) (*pb.Transfer, error) {
 	transferID, err := uuid.NewV7()
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate transfer id: %v", err)
+		return nil, fmt.Errorf("failed to generate transfer id: %w", err)
 	}
 	keyTweakInputMap, err := prepareSendTransferKeyTweaks(config, transferID.String(), receiverIdentityPubkey, leaves, map[string][]byte{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to prepare transfer data: %v", err)
+		return nil, fmt.Errorf("failed to prepare transfer data: %w", err)
 	}
 
 	transferPackage, err := prepareTransferPackage(ctx, config, client, transferID, keyTweakInputMap, leaves, receiverIdentityPubkey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to prepare transfer data: %v", err)
+		return nil, fmt.Errorf("failed to prepare transfer data: %w", err)
 	}
 
 	resp, err := client.StartTransfer(ctx, &pb.StartTransferRequest{
@@ -82,7 +82,7 @@ func SendTransferWithKeyTweaks(
 		TransferPackage:           transferPackage,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to start transfer: %v", err)
+		return nil, fmt.Errorf("failed to start transfer: %w", err)
 	}
 
 	return resp.Transfer, nil
@@ -106,7 +106,7 @@ func prepareTransferPackage(
 		NodeIds: nodes,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get signing commitments: %v", err)
+		return nil, fmt.Errorf("failed to get signing commitments: %w", err)
 	}
 
 	// Sign user refund.
@@ -153,16 +153,16 @@ func prepareTransferPackage(
 		}
 		protoToEncryptBinary, err := proto.Marshal(&protoToEncrypt)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal proto to encrypt: %v", err)
+			return nil, fmt.Errorf("failed to marshal proto to encrypt: %w", err)
 		}
 		encryptionKeyBytes := config.SigningOperators[identifier].IdentityPublicKey
 		encryptionKey, err := eciesgo.NewPublicKeyFromBytes(encryptionKeyBytes)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse encryption key: %v", err)
+			return nil, fmt.Errorf("failed to parse encryption key: %w", err)
 		}
 		encryptedProto, err := eciesgo.Encrypt(encryptionKey, protoToEncryptBinary)
 		if err != nil {
-			return nil, fmt.Errorf("failed to encrypt proto: %v", err)
+			return nil, fmt.Errorf("failed to encrypt proto: %w", err)
 		}
 		encryptedKeyTweaks[identifier] = encryptedProto
 	}
@@ -188,7 +188,7 @@ func SendTransferTweakKey(
 ) (*pb.Transfer, error) {
 	keyTweakInputMap, err := prepareSendTransferKeyTweaks(config, transfer.Id, transfer.ReceiverIdentityPublicKey, leaves, refundSignatureMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to prepare transfer data: %v", err)
+		return nil, fmt.Errorf("failed to prepare transfer data: %w", err)
 	}
 
 	var updatedTransfer *pb.Transfer
@@ -207,7 +207,7 @@ func SendTransferTweakKey(
 			sparkClient := pb.NewSparkServiceClient(sparkConn)
 			token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 			if err != nil {
-				results <- fmt.Errorf("failed to authenticate with server: %v", err)
+				results <- fmt.Errorf("failed to authenticate with server: %w", err)
 				return
 			}
 			tmpCtx := ContextWithToken(ctx, token)
@@ -217,7 +217,7 @@ func SendTransferTweakKey(
 				LeavesToSend:           (*keyTweakInputMap)[identifier],
 			})
 			if err != nil {
-				results <- fmt.Errorf("failed to call SendTransfer: %v", err)
+				results <- fmt.Errorf("failed to call SendTransfer: %w", err)
 				return
 			}
 			if updatedTransfer == nil {
@@ -284,7 +284,7 @@ func sendTransferSignRefund(
 ) (*pb.Transfer, map[string][]byte, map[string]*LeafRefundSigningData, []*pb.LeafRefundTxSigningResult, error) {
 	transferID, err := uuid.NewRandom()
 	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("failed to generate transfer id: %v", err)
+		return nil, nil, nil, nil, fmt.Errorf("failed to generate transfer id: %w", err)
 	}
 
 	leafDataMap := make(map[string]*LeafRefundSigningData)
@@ -303,7 +303,7 @@ func sendTransferSignRefund(
 
 	signingJobs, err := prepareRefundSoSigningJobs(leaves, leafDataMap)
 	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("failed to prepare signing jobs for sending transfer: %v", err)
+		return nil, nil, nil, nil, fmt.Errorf("failed to prepare signing jobs for sending transfer: %w", err)
 	}
 
 	sparkConn, err := common.NewGRPCConnectionWithTestTLS(config.CoodinatorAddress(), nil)
@@ -314,7 +314,7 @@ func sendTransferSignRefund(
 
 	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, nil, nil, nil, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	tmpCtx := ContextWithToken(ctx, token)
 
@@ -333,7 +333,7 @@ func sendTransferSignRefund(
 	if adaptorPublicKey != nil {
 		swapID, err := uuid.NewV7()
 		if err != nil {
-			return nil, nil, nil, nil, fmt.Errorf("failed to generate swap id: %v", err)
+			return nil, nil, nil, nil, fmt.Errorf("failed to generate swap id: %w", err)
 		}
 		response, err := sparkClient.CounterLeafSwap(tmpCtx, &pb.CounterLeafSwapRequest{
 			Transfer:         startTransferRequest,
@@ -341,14 +341,14 @@ func sendTransferSignRefund(
 			AdaptorPublicKey: adaptorPublicKey.SerializeCompressed(),
 		})
 		if err != nil {
-			return nil, nil, nil, nil, fmt.Errorf("failed to start transfer: %v", err)
+			return nil, nil, nil, nil, fmt.Errorf("failed to start transfer: %w", err)
 		}
 		transfer = response.Transfer
 		signingResults = response.SigningResults
 	} else if forSwap {
 		response, err := sparkClient.StartLeafSwap(tmpCtx, startTransferRequest)
 		if err != nil {
-			return nil, nil, nil, nil, fmt.Errorf("failed to start transfer: %v", err)
+			return nil, nil, nil, nil, fmt.Errorf("failed to start transfer: %w", err)
 		}
 		transfer = response.Transfer
 		signingResults = response.SigningResults
@@ -363,7 +363,7 @@ func sendTransferSignRefund(
 
 	signatures, err := signRefunds(config, leafDataMap, signingResults, adaptorPublicKey)
 	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("failed to sign refunds for send: %v", err)
+		return nil, nil, nil, nil, fmt.Errorf("failed to sign refunds for send: %w", err)
 	}
 	signatureMap := make(map[string][]byte)
 	for _, signature := range signatures {
@@ -384,14 +384,14 @@ func compareTransfers(transfer1, transfer2 *pb.Transfer) bool {
 func prepareSendTransferKeyTweaks(config *Config, transferID string, receiverIdentityPubkey []byte, leaves []LeafKeyTweak, refundSignatureMap map[string][]byte) (*map[string][]*pb.SendLeafKeyTweak, error) {
 	receiverEciesPubKey, err := eciesgo.NewPublicKeyFromBytes(receiverIdentityPubkey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse receiver public key: %v", err)
+		return nil, fmt.Errorf("failed to parse receiver public key: %w", err)
 	}
 
 	leavesTweaksMap := make(map[string][]*pb.SendLeafKeyTweak)
 	for _, leaf := range leaves {
 		leafTweaksMap, err := prepareSingleSendTransferKeyTweak(config, transferID, leaf, receiverEciesPubKey, refundSignatureMap[leaf.Leaf.Id])
 		if err != nil {
-			return nil, fmt.Errorf("failed to prepare single leaf transfer: %v", err)
+			return nil, fmt.Errorf("failed to prepare single leaf transfer: %w", err)
 		}
 		for identifier, leafTweak := range *leafTweaksMap {
 			leavesTweaksMap[identifier] = append(leavesTweaksMap[identifier], leafTweak)
@@ -403,7 +403,7 @@ func prepareSendTransferKeyTweaks(config *Config, transferID string, receiverIde
 func prepareSingleSendTransferKeyTweak(config *Config, transferID string, leaf LeafKeyTweak, receiverEciesPubKey *eciesgo.PublicKey, refundSignature []byte) (*map[string]*pb.SendLeafKeyTweak, error) {
 	privKeyTweak, err := common.SubtractPrivateKeys(leaf.SigningPrivKey, leaf.NewSigningPrivKey)
 	if err != nil {
-		return nil, fmt.Errorf("fail to calculate private key tweak: %v", err)
+		return nil, fmt.Errorf("fail to calculate private key tweak: %w", err)
 	}
 
 	// Calculate secret tweak shares
@@ -414,7 +414,7 @@ func prepareSingleSendTransferKeyTweak(config *Config, transferID string, leaf L
 		len(config.SigningOperators),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("fail to split private key tweak: %v", err)
+		return nil, fmt.Errorf("fail to split private key tweak: %w", err)
 	}
 
 	// Calculate pubkey shares tweak
@@ -432,7 +432,7 @@ func prepareSingleSendTransferKeyTweak(config *Config, transferID string, leaf L
 
 	secretCipher, err := eciesgo.Encrypt(receiverEciesPubKey, leaf.NewSigningPrivKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to encrypt new signing private key: %v", err)
+		return nil, fmt.Errorf("failed to encrypt new signing private key: %w", err)
 	}
 
 	// Generate signature over Sha256(leaf_id||transfer_id||secret_cipher)
@@ -517,7 +517,7 @@ func VerifyPendingTransfer(
 	leafPrivKeyMap := make(map[string][]byte)
 	senderPubkey, err := secp256k1.ParsePubKey(transfer.SenderIdentityPublicKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse sender public key: %v", err)
+		return nil, fmt.Errorf("failed to parse sender public key: %w", err)
 	}
 
 	receiverEciesPrivKey := eciesgo.NewPrivateKeyFromBytes(config.IdentityPrivateKey.Serialize())
@@ -532,19 +532,19 @@ func VerifyPendingTransfer(
 				s.SetByteSlice(leaf.Signature[32:64])
 				signature = ecdsa.NewSignature(&r, &s)
 			} else {
-				return nil, fmt.Errorf("failed to parse signature: %v", err)
+				return nil, fmt.Errorf("failed to parse signature: %w", err)
 			}
 		}
 		payload := append(append([]byte(leaf.Leaf.Id), []byte(transfer.Id)...), leaf.SecretCipher...)
 		payloadHash := sha256.Sum256(payload)
 		if !signature.Verify(payloadHash[:], senderPubkey) {
-			return nil, fmt.Errorf("failed to verify signature: %v", err)
+			return nil, fmt.Errorf("failed to verify signature: %w", err)
 		}
 
 		// Decrypt secret cipher
 		leafSecret, err := eciesgo.Decrypt(receiverEciesPrivKey, leaf.SecretCipher)
 		if err != nil {
-			return nil, fmt.Errorf("failed to decrypt secret cipher: %v", err)
+			return nil, fmt.Errorf("failed to decrypt secret cipher: %w", err)
 		}
 		leafPrivKeyMap[leaf.Leaf.Id] = leafSecret
 
@@ -570,7 +570,7 @@ func ClaimTransfer(
 
 	signatures, err := ClaimTransferSignRefunds(ctx, transfer, config, leaves, proofMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign refunds when claiming leaves: %v", err)
+		return nil, fmt.Errorf("failed to sign refunds when claiming leaves: %w", err)
 	}
 
 	return finalizeTransfer(ctx, config, signatures)
@@ -584,7 +584,7 @@ func ClaimTransferTweakKeys(
 ) (map[string][][]byte, error) {
 	leavesTweaksMap, proofMap, err := prepareClaimLeavesKeyTweaks(config, leaves)
 	if err != nil {
-		return nil, fmt.Errorf("failed to prepare transfer data: %v", err)
+		return nil, fmt.Errorf("failed to prepare transfer data: %w", err)
 	}
 
 	wg := sync.WaitGroup{}
@@ -633,7 +633,7 @@ func prepareClaimLeavesKeyTweaks(config *Config, leaves []LeafKeyTweak) (*map[st
 	for _, leaf := range leaves {
 		leafTweaksMap, proof, err := prepareClaimLeafKeyTweaks(config, leaf)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to prepare single leaf transfer: %v", err)
+			return nil, nil, fmt.Errorf("failed to prepare single leaf transfer: %w", err)
 		}
 		proofMap[leaf.Leaf.Id] = proof
 		for identifier, leafTweak := range *leafTweaksMap {
@@ -646,7 +646,7 @@ func prepareClaimLeavesKeyTweaks(config *Config, leaves []LeafKeyTweak) (*map[st
 func prepareClaimLeafKeyTweaks(config *Config, leaf LeafKeyTweak) (*map[string]*pb.ClaimLeafKeyTweak, [][]byte, error) {
 	privKeyTweak, err := common.SubtractPrivateKeys(leaf.SigningPrivKey, leaf.NewSigningPrivKey)
 	if err != nil {
-		return nil, nil, fmt.Errorf("fail to calculate private key tweak: %v", err)
+		return nil, nil, fmt.Errorf("fail to calculate private key tweak: %w", err)
 	}
 
 	// Calculate secret tweak shares
@@ -657,7 +657,7 @@ func prepareClaimLeafKeyTweaks(config *Config, leaf LeafKeyTweak) (*map[string]*
 		len(config.SigningOperators),
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("fail to split private key tweak: %v", err)
+		return nil, nil, fmt.Errorf("fail to split private key tweak: %w", err)
 	}
 
 	// Calculate pubkey shares tweak
@@ -723,7 +723,7 @@ func ClaimTransferSignRefunds(
 
 	signingJobs, err := prepareRefundSoSigningJobs(leafKeys, leafDataMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to prepare signing jobs for claiming transfer: %v", err)
+		return nil, fmt.Errorf("failed to prepare signing jobs for claiming transfer: %w", err)
 	}
 	sparkConn, err := common.NewGRPCConnectionWithTestTLS(config.CoodinatorAddress(), nil)
 	if err != nil {
@@ -744,7 +744,7 @@ func ClaimTransferSignRefunds(
 		KeyTweakProofs:         secretProofMap,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to call ClaimTransferSignRefunds: %v", err)
+		return nil, fmt.Errorf("failed to call ClaimTransferSignRefunds: %w", err)
 	}
 
 	return signRefunds(config, leafDataMap, response.SigningResults, nil)
@@ -766,7 +766,7 @@ func finalizeTransfer(
 		NodeSignatures: signatures,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to call FinalizeNodeSignatures: %v", err)
+		return nil, fmt.Errorf("failed to call FinalizeNodeSignatures: %w", err)
 	}
 	return response.Nodes, nil
 }
@@ -853,31 +853,31 @@ func prepareRefundSoSigningJobs(
 		refundSigningData := leafDataMap[leaf.Leaf.Id]
 		nodeTx, err := common.TxFromRawTxBytes(leaf.Leaf.NodeTx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse node tx: %v", err)
+			return nil, fmt.Errorf("failed to parse node tx: %w", err)
 		}
 		nodeOutPoint := wire.OutPoint{Hash: nodeTx.TxHash(), Index: 0}
 		currRefundTx, err := common.TxFromRawTxBytes(leaf.Leaf.RefundTx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse refund tx: %v", err)
+			return nil, fmt.Errorf("failed to parse refund tx: %w", err)
 		}
 		amountSats := nodeTx.TxOut[0].Value
 		receivingPubkey, err := secp256k1.ParsePubKey(refundSigningData.ReceivingPubkey)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse receiving pubkey: %v", err)
+			return nil, fmt.Errorf("failed to parse receiving pubkey: %w", err)
 		}
 		nextSequence, err := spark.NextSequence(currRefundTx.TxIn[0].Sequence)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get next sequence: %v", err)
+			return nil, fmt.Errorf("failed to get next sequence: %w", err)
 		}
 		cpfpRefundTx, _, err := createRefundTxs(nextSequence, &nodeOutPoint, amountSats, receivingPubkey, false)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create refund tx: %v", err)
+			return nil, fmt.Errorf("failed to create refund tx: %w", err)
 		}
 		refundSigningData.RefundTx = cpfpRefundTx
 		var refundBuf bytes.Buffer
 		err = cpfpRefundTx.Serialize(&refundBuf)
 		if err != nil {
-			return nil, fmt.Errorf("failed to serialize refund tx: %v", err)
+			return nil, fmt.Errorf("failed to serialize refund tx: %w", err)
 		}
 		refundNonceCommitmentProto, _ := refundSigningData.Nonce.SigningCommitment().MarshalProto()
 
@@ -903,7 +903,7 @@ func CancelTransfer(ctx context.Context, config *Config, transfer *pb.Transfer)
 
 	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	authCtx := ContextWithToken(ctx, token)
 
@@ -913,7 +913,7 @@ func CancelTransfer(ctx context.Context, config *Config, transfer *pb.Transfer)
 		SenderIdentityPublicKey: config.IdentityPublicKey(),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to call CancelTransfer: %v", err)
+		return nil, fmt.Errorf("failed to call CancelTransfer: %w", err)
 	}
 	return response.Transfer, nil
 }
@@ -931,7 +931,7 @@ func QueryAllTransfersWithTypes(ctx context.Context, config *Config, limit int64
 
 	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to authenticate with server: %v", err)
+		return nil, 0, fmt.Errorf("failed to authenticate with server: %w", err)
 	}
 	authCtx := ContextWithToken(ctx, token)
 
@@ -945,7 +945,7 @@ func QueryAllTransfersWithTypes(ctx context.Context, config *Config, limit int64
 		Types:  types,
 	})
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to call QueryAllTransfers: %v", err)
+		return nil, 0, fmt.Errorf("failed to call QueryAllTransfers: %w", err)
 	}
 	return response.Transfers, response.Offset, nil
 }
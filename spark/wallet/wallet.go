@@ -560,7 +560,7 @@ func (w *SingleKeyWallet) RefreshTimelocks(ctx context.Context, nodeUUID *uuid.U
 		for _, node := range w.OwnedNodes {
 			refundTx, err := common.TxFromRawTxBytes(node.RefundTx)
 			if err != nil {
-				return fmt.Errorf("failed to parse refund tx: %v", err)
+				return fmt.Errorf("failed to parse refund tx: %w", err)
 			}
 			_, err = spark.NextSequence(refundTx.TxIn[0].Sequence)
 			needRefresh := err != nil